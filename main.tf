@@ -75,6 +75,9 @@ module "buckets" {
   fc_template_bucket_name     = length(var.template_bucket_name) > 0 ? var.template_bucket_name : "${var.gcp_project_id}-fc-templates"
   fc_template_bucket_location = var.template_bucket_location
 
+  loki_retention_days        = var.sandbox_logs_retention_days
+  diagnostics_retention_days = var.sandbox_diagnostics_retention_days
+
   labels = var.labels
 }
 
@@ -129,6 +132,7 @@ module "cluster" {
   docker_contexts_bucket_name = module.buckets.envs_docker_context_bucket_name
   cluster_setup_bucket_name   = module.buckets.cluster_setup_bucket_name
   fc_env_pipeline_bucket_name = module.buckets.fc_env_pipeline_bucket_name
+  kernels_bucket_name         = module.buckets.fc_kernels_bucket_name
   fc_kernels_bucket_name      = module.buckets.fc_kernels_bucket_name
   fc_versions_bucket_name     = module.buckets.fc_versions_bucket_name
 
@@ -179,26 +183,35 @@ module "nomad" {
   otel_tracing_print      = var.otel_tracing_print
 
   # API
-  api_machine_count                         = var.api_cluster_size
-  logs_proxy_address                        = "http://${module.cluster.logs_proxy_ip}"
-  api_port                                  = var.api_port
-  environment                               = var.environment
-  docker_contexts_bucket_name               = module.buckets.envs_docker_context_bucket_name
-  google_service_account_key                = module.init.google_service_account_key
-  api_docker_image_digest                   = module.api.api_docker_image_digest
-  api_secret                                = module.api.api_secret
-  custom_envs_repository_name               = module.api.custom_envs_repository_name
-  postgres_connection_string_secret_name    = module.api.postgres_connection_string_secret_name
-  posthog_api_key_secret_name               = module.api.posthog_api_key_secret_name
-  analytics_collector_host_secret_name      = module.init.analytics_collector_host_secret_name
-  analytics_collector_api_token_secret_name = module.init.analytics_collector_api_token_secret_name
-  api_admin_token_name                      = module.api.api_admin_token_name
+  api_machine_count                                   = var.api_cluster_size
+  logs_proxy_address                                  = "http://${module.cluster.logs_proxy_ip}"
+  api_port                                            = var.api_port
+  environment                                         = var.environment
+  docker_contexts_bucket_name                         = module.buckets.envs_docker_context_bucket_name
+  google_service_account_key                          = module.init.google_service_account_key
+  api_docker_image_digest                             = module.api.api_docker_image_digest
+  api_secret                                          = module.api.api_secret
+  custom_envs_repository_name                         = module.api.custom_envs_repository_name
+  postgres_connection_string_secret_name              = module.api.postgres_connection_string_secret_name
+  postgres_read_replica_connection_string_secret_name = module.api.postgres_read_replica_connection_string_secret_name
+  posthog_api_key_secret_name                         = module.api.posthog_api_key_secret_name
+  analytics_collector_host_secret_name                = module.init.analytics_collector_host_secret_name
+  analytics_collector_api_token_secret_name           = module.init.analytics_collector_api_token_secret_name
+  api_admin_token_name                                = module.api.api_admin_token_name
+  secrets_encryption_keys_secret_name                 = module.api.secrets_encryption_keys_secret_name
+  secrets_encryption                                  = var.secrets_encryption
+  allowed_kernel_versions                             = var.allowed_kernel_versions
+  firecracker_canary                                  = var.firecracker_canary
   # Proxies
   session_proxy_service_name = var.session_proxy_service_name
   session_proxy_port         = var.session_proxy_port
 
-  client_proxy_port        = var.client_proxy_port
-  client_proxy_health_port = var.client_proxy_health_port
+  client_proxy_port                      = var.client_proxy_port
+  client_proxy_health_port               = var.client_proxy_health_port
+  load_balancer_ip                       = module.cluster.https_lb_ip
+  client_proxy_limits                    = var.client_proxy_limits
+  client_proxy_timeouts                  = var.client_proxy_timeouts
+  client_proxy_access_log_sample_percent = var.client_proxy_access_log_sample_percent
 
   domain_name = var.domain_name
 
@@ -215,8 +228,9 @@ module "nomad" {
   grafana_traces_username_secret_name  = module.init.grafana_traces_username_secret_name
 
   # Logs
-  loki_bucket_name  = module.buckets.loki_bucket_name
-  loki_service_port = var.loki_service_port
+  loki_bucket_name            = module.buckets.loki_bucket_name
+  loki_service_port           = var.loki_service_port
+  sandbox_logs_retention_days = var.sandbox_logs_retention_days
 
   # Docker reverse proxy
   docker_reverse_proxy_image_digest        = module.docker_reverse_proxy.docker_reverse_proxy_image_digest
@@ -224,12 +238,20 @@ module "nomad" {
   docker_reverse_proxy_service_account_key = module.docker_reverse_proxy.docker_reverse_proxy_service_account_key
 
   # Orchestrator
-  orchestrator_port           = var.orchestrator_port
-  fc_env_pipeline_bucket_name = module.buckets.fc_env_pipeline_bucket_name
+  orchestrator_port             = var.orchestrator_port
+  orchestrator_health_port      = var.orchestrator_health_port
+  orchestrator_diagnostics_port = var.orchestrator_diagnostics_port
+  orchestrator_discovery        = var.orchestrator_discovery
+  fc_env_pipeline_bucket_name   = module.buckets.fc_env_pipeline_bucket_name
+  storage_cache                 = var.storage_cache
+  snapshot_encryption           = var.snapshot_encryption
+  firecracker_jailer            = var.firecracker_jailer
+  eviction_policy               = var.eviction_policy
 
   # Template manager
-  template_manager_port = var.template_manager_port
-  template_bucket_name  = module.buckets.fc_template_bucket_name
+  template_manager_port    = var.template_manager_port
+  template_bucket_name     = module.buckets.fc_template_bucket_name
+  template_bucket_replicas = var.template_bucket_replicas
 
   # Redis
   redis_port = var.redis_port
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/benchmark"
+)
+
+func main() {
+	output := flag.String("output", "", "path of the merged CSV, empty to skip")
+	summary := flag.String("summary", "", "path of the JSON summary with the histograms, empty to skip")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <results.csv>...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var records []benchmark.Record
+	for _, path := range flag.Args() {
+		fileRecords, err := benchmark.ReadCSVFile(path)
+		if err != nil {
+			log.Fatalf("failed to read the results: %v", err)
+		}
+
+		records = append(records, fileRecords...)
+	}
+
+	summaries := benchmark.Summarize(records, benchmark.DefaultBounds)
+	benchmark.PrintSummaries(os.Stdout, summaries)
+
+	if *output != "" {
+		err := benchmark.WriteCSVFile(*output, records)
+		if err != nil {
+			log.Fatalf("failed to write the merged results: %v", err)
+		}
+	}
+
+	if *summary != "" {
+		f, err := os.Create(*summary)
+		if err != nil {
+			log.Fatalf("failed to create the summary: %v", err)
+		}
+		defer f.Close()
+
+		err = benchmark.WriteJSON(f, summaries)
+		if err != nil {
+			log.Fatalf("failed to write the summary: %v", err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/e2b-dev/infra/packages/api/internal/dns"
+)
+
+// The DNS server running independently of the API, the records are resolved from Redis where the API instances write them.
+// Multiple replicas can run behind a load balancer, each replica caches the records and serves them stale if Redis is not reachable.
+func main() {
+	address := flag.String("address", "0.0.0.0", "address the DNS server listens on")
+	port := flag.Int("port", 53, "port the DNS server listens on")
+
+	flag.Parse()
+
+	redisURL := os.Getenv("REDIS_URL")
+	if redisURL == "" {
+		log.Fatal("REDIS_URL env var is required")
+	}
+
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		log.Fatalf("invalid redis URL: %v", err)
+	}
+
+	redisClient := redis.NewClient(opts)
+	defer redisClient.Close()
+
+	ctx := context.Background()
+
+	log.Printf("Starting DNS server on %s:%d", *address, *port)
+
+	err = dns.New(redisClient).Start(ctx, *address, *port)
+	if err != nil {
+		log.Fatalf("DNS server failed: %v", err)
+	}
+}
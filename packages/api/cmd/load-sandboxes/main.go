@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/api/internal/loadtest"
+	"github.com/e2b-dev/infra/packages/shared/pkg/client"
+)
+
+const (
+	formatJSON = "json"
+	formatHTML = "html"
+	formatOTLP = "otlp"
+)
+
+func main() {
+	apiURL := flag.String("api", "https://api.e2b.dev", "address of the API")
+	domain := flag.String("domain", "e2b.dev", "domain of the sandboxes, used to reach envd")
+	templateID := flag.String("template", "base", "template the sandboxes are created from")
+	count := flag.Int("count", 100, "total number of sandboxes")
+	concurrency := flag.Int("concurrency", 10, "maximum number of sandboxes in progress at the same time")
+	rate := flag.Float64("rate", 0, "arrival rate of the new sandboxes per second, 0 means as fast as the concurrency allows")
+	command := flag.String("cmd", "echo hello", "command executed in each sandbox, empty to skip the exec")
+	sandboxTimeout := flag.Int("sandbox-timeout", 60, "timeout of the sandboxes in seconds, the sandboxes are killed by the API if the kill fails")
+	requestTimeout := flag.Duration("request-timeout", time.Minute, "timeout of each request")
+	runName := flag.String("run", "", "name of the run in the CSV report, e.g. the commit the API was built from")
+	csvPath := flag.String("csv", "load-sandboxes.csv", "path of the CSV report, empty to skip")
+	format := flag.String("format", formatHTML, "format of the report, 'json', 'html' or 'otlp'")
+	output := flag.String("output", "", "path of the JSON or HTML report, defaults to load-sandboxes.<format>")
+	otlpEndpoint := flag.String("otlp-endpoint", os.Getenv("OTEL_COLLECTOR_GRPC_ENDPOINT"), "address of the OTLP collector the spans are exported to")
+
+	flag.Parse()
+
+	apiKey := os.Getenv("E2B_API_KEY")
+	if apiKey == "" {
+		log.Fatal("E2B_API_KEY env var is required")
+	}
+
+	if *format != formatJSON && *format != formatHTML && *format != formatOTLP {
+		log.Fatalf("unknown report format '%s'", *format)
+	}
+
+	if *format == formatOTLP && *otlpEndpoint == "" {
+		log.Fatal("otlp-endpoint is required for the otlp format")
+	}
+
+	if *output == "" {
+		*output = "load-sandboxes." + *format
+	}
+
+	if *count <= 0 || *concurrency <= 0 || *rate < 0 {
+		log.Fatal("count and concurrency must be positive and rate can't be negative")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt)
+
+	go func() {
+		<-done
+
+		log.Printf("interrupted, waiting for the sandboxes in progress")
+
+		cancel()
+	}()
+
+	sdk, err := client.NewSDK(client.Config{
+		APIURL:  *apiURL,
+		Domain:  *domain,
+		APIKey:  apiKey,
+		Timeout: *requestTimeout,
+		// The failed requests are reported, not retried
+		Retries: -1,
+		HTTPClient: &http.Client{
+			Transport: &http.Transport{
+				MaxIdleConnsPerHost: 100,
+			},
+		},
+	})
+	if err != nil {
+		log.Fatalf("failed to create the client: %v", err)
+	}
+
+	config := loadtest.Config{
+		Run:            *runName,
+		TemplateID:     *templateID,
+		Command:        *command,
+		SandboxTimeout: int32(*sandboxTimeout),
+	}
+
+	start := time.Now()
+	results := run(ctx, sdk, config, *count, *concurrency, *rate)
+	elapsed := time.Since(start)
+
+	report := loadtest.New(config, results, *concurrency, *rate, elapsed)
+	report.Print(os.Stdout)
+
+	if *csvPath != "" {
+		host, hostErr := os.Hostname()
+		if hostErr != nil {
+			log.Printf("failed to get the hostname: %v", hostErr)
+		}
+
+		err = errors.Join(err, report.WriteCSV(*csvPath, host))
+	}
+
+	switch *format {
+	case formatJSON:
+		err = errors.Join(err, report.WriteJSON(*output))
+	case formatHTML:
+		err = errors.Join(err, report.WriteHTML(*output))
+	case formatOTLP:
+		// The report is exported even if the test was interrupted
+		err = errors.Join(err, report.ExportOTLP(context.WithoutCancel(ctx), *otlpEndpoint))
+	}
+
+	if err != nil {
+		log.Fatalf("failed to write the report: %v", err)
+	}
+}
+
+// run starts the sandboxes at the arrival rate, at most concurrency sandboxes are in progress at the same time.
+// The sandboxes that would exceed the concurrency wait, so the actual rate can be lower than the requested one.
+func run(ctx context.Context, sdk *client.SDK, config loadtest.Config, count, concurrency int, rate float64) []*loadtest.Result {
+	results := make([]*loadtest.Result, 0, count)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	slots := make(chan struct{}, concurrency)
+
+	var ticker *time.Ticker
+	if rate > 0 {
+		ticker = time.NewTicker(time.Duration(float64(time.Second) / rate))
+		defer ticker.Stop()
+	}
+
+	for i := 0; i < count; i++ {
+		if ticker != nil && i > 0 {
+			select {
+			case <-ctx.Done():
+			case <-ticker.C:
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+		case slots <- struct{}{}:
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			r := runSandbox(ctx, sdk, config)
+
+			mu.Lock()
+			results = append(results, r)
+			completed := len(results)
+			mu.Unlock()
+
+			if completed%max(count/20, 1) == 0 {
+				log.Printf("completed %d/%d sandboxes", completed, count)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// runSandbox creates the sandbox, executes the command in it and kills it. The sandbox is killed even if the exec fails.
+func runSandbox(ctx context.Context, sdk *client.SDK, config loadtest.Config) *loadtest.Result {
+	r := loadtest.NewResult()
+	defer func() {
+		r.Total = time.Since(r.StartedAt)
+	}()
+
+	// The sandbox is always cleaned up, even if the test was interrupted
+	cleanupCtx := context.WithoutCancel(ctx)
+
+	stageStart := time.Now()
+	sbx, err := sdk.CreateSandbox(ctx, client.NewSandbox{
+		TemplateID: config.TemplateID,
+		Timeout:    &config.SandboxTimeout,
+		Metadata:   &client.SandboxMetadata{"source": "load-sandboxes"},
+	})
+	r.Durations[loadtest.StageCreate] = time.Since(stageStart)
+	if err != nil {
+		r.Fail(loadtest.StageCreate, err)
+
+		return r
+	}
+
+	r.SandboxID = sbx.SandboxID
+
+	if config.Command != "" {
+		stageStart = time.Now()
+		err = sbx.Exec(ctx, config.Command, nil, nil)
+		r.Durations[loadtest.StageExec] = time.Since(stageStart)
+		if err != nil {
+			r.Fail(loadtest.StageExec, err)
+		}
+	}
+
+	stageStart = time.Now()
+	err = sbx.Kill(cleanupCtx)
+	r.Durations[loadtest.StageKill] = time.Since(stageStart)
+	if err != nil {
+		r.Fail(loadtest.StageKill, err)
+	}
+
+	return r
+}
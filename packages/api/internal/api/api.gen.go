@@ -14,9 +14,39 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /artifacts)
+	GetArtifacts(c *gin.Context, params GetArtifactsParams)
+
+	// (DELETE /budget)
+	DeleteBudget(c *gin.Context, params DeleteBudgetParams)
+
+	// (GET /budget)
+	GetBudget(c *gin.Context, params GetBudgetParams)
+
+	// (PUT /budget)
+	PutBudget(c *gin.Context, params PutBudgetParams)
+
+	// (GET /build-logs)
+	GetBuildLogs(c *gin.Context, params GetBuildLogsParams)
+
+	// (POST /build-reconciliation)
+	PostBuildReconciliation(c *gin.Context)
+
+	// (GET /egress-presets)
+	GetEgressPresets(c *gin.Context)
+
 	// (GET /health)
 	GetHealth(c *gin.Context)
 
+	// (GET /health/deep)
+	GetHealthDeep(c *gin.Context)
+
+	// (GET /kernels)
+	GetKernels(c *gin.Context)
+
+	// (POST /kernels)
+	PostKernels(c *gin.Context)
+
 	// (GET /nodes)
 	GetNodes(c *gin.Context)
 
@@ -26,11 +56,32 @@ type ServerInterface interface {
 	// (POST /nodes/{nodeID})
 	PostNodesNodeID(c *gin.Context, nodeID NodeID)
 
+	// (GET /pinned-builds)
+	GetPinnedBuilds(c *gin.Context)
+
+	// (POST /pinned-builds)
+	PostPinnedBuilds(c *gin.Context)
+
+	// (DELETE /pinned-builds/{buildID})
+	DeletePinnedBuildsBuildID(c *gin.Context, buildID BuildID, params DeletePinnedBuildsBuildIDParams)
+
+	// (GET /registry-credentials)
+	GetRegistryCredentials(c *gin.Context)
+
+	// (DELETE /registry-credentials/{registry})
+	DeleteRegistryCredentialsRegistry(c *gin.Context, registry Registry)
+
+	// (PUT /registry-credentials/{registry})
+	PutRegistryCredentialsRegistry(c *gin.Context, registry Registry)
+
+	// (DELETE /sandboxes)
+	DeleteSandboxes(c *gin.Context, params DeleteSandboxesParams)
+
 	// (GET /sandboxes)
 	GetSandboxes(c *gin.Context, params GetSandboxesParams)
 
 	// (POST /sandboxes)
-	PostSandboxes(c *gin.Context)
+	PostSandboxes(c *gin.Context, params PostSandboxesParams)
 
 	// (DELETE /sandboxes/{sandboxID})
 	DeleteSandboxesSandboxID(c *gin.Context, sandboxID SandboxID)
@@ -38,12 +89,36 @@ type ServerInterface interface {
 	// (GET /sandboxes/{sandboxID})
 	GetSandboxesSandboxID(c *gin.Context, sandboxID SandboxID)
 
+	// (PATCH /sandboxes/{sandboxID})
+	PatchSandboxesSandboxID(c *gin.Context, sandboxID SandboxID, params PatchSandboxesSandboxIDParams)
+
+	// (POST /sandboxes/{sandboxID}/artifacts)
+	PostSandboxesSandboxIDArtifacts(c *gin.Context, sandboxID SandboxID)
+
+	// (GET /sandboxes/{sandboxID}/artifacts/{artifactName})
+	GetSandboxesSandboxIDArtifactsArtifactName(c *gin.Context, sandboxID SandboxID, artifactName ArtifactName)
+
+	// (GET /sandboxes/{sandboxID}/changes)
+	GetSandboxesSandboxIDChanges(c *gin.Context, sandboxID SandboxID, params GetSandboxesSandboxIDChangesParams)
+
+	// (POST /sandboxes/{sandboxID}/checkpoints)
+	PostSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID SandboxID)
+
+	// (GET /sandboxes/{sandboxID}/console)
+	GetSandboxesSandboxIDConsole(c *gin.Context, sandboxID SandboxID, params GetSandboxesSandboxIDConsoleParams)
+
+	// (GET /sandboxes/{sandboxID}/diagnostics)
+	GetSandboxesSandboxIDDiagnostics(c *gin.Context, sandboxID SandboxID)
+
 	// (GET /sandboxes/{sandboxID}/logs)
 	GetSandboxesSandboxIDLogs(c *gin.Context, sandboxID SandboxID, params GetSandboxesSandboxIDLogsParams)
 
 	// (GET /sandboxes/{sandboxID}/metrics)
 	GetSandboxesSandboxIDMetrics(c *gin.Context, sandboxID SandboxID)
 
+	// (GET /sandboxes/{sandboxID}/network)
+	GetSandboxesSandboxIDNetwork(c *gin.Context, sandboxID SandboxID)
+
 	// (POST /sandboxes/{sandboxID}/pause)
 	PostSandboxesSandboxIDPause(c *gin.Context, sandboxID SandboxID)
 
@@ -53,32 +128,89 @@ type ServerInterface interface {
 	// (POST /sandboxes/{sandboxID}/resume)
 	PostSandboxesSandboxIDResume(c *gin.Context, sandboxID SandboxID)
 
+	// (GET /sandboxes/{sandboxID}/routing)
+	GetSandboxesSandboxIDRouting(c *gin.Context, sandboxID SandboxID)
+
 	// (POST /sandboxes/{sandboxID}/timeout)
 	PostSandboxesSandboxIDTimeout(c *gin.Context, sandboxID SandboxID)
 
+	// (GET /secrets)
+	GetSecrets(c *gin.Context)
+
+	// (POST /secrets)
+	PostSecrets(c *gin.Context)
+
+	// (DELETE /secrets/{secretName})
+	DeleteSecretsSecretName(c *gin.Context, secretName SecretName, params DeleteSecretsSecretNameParams)
+
+	// (GET /secrets/{secretName})
+	GetSecretsSecretName(c *gin.Context, secretName SecretName)
+
+	// (PUT /secrets/{secretName})
+	PutSecretsSecretName(c *gin.Context, secretName SecretName, params PutSecretsSecretNameParams)
+
+	// (GET /secrets/{secretName}/versions)
+	GetSecretsSecretNameVersions(c *gin.Context, secretName SecretName)
+
+	// (GET /state)
+	GetState(c *gin.Context)
+
+	// (GET /status)
+	GetStatus(c *gin.Context)
+
 	// (GET /teams)
 	GetTeams(c *gin.Context)
 
+	// (DELETE /teams/{teamID}/budget/override)
+	DeleteTeamsTeamIDBudgetOverride(c *gin.Context, teamID TeamID)
+
+	// (PUT /teams/{teamID}/budget/override)
+	PutTeamsTeamIDBudgetOverride(c *gin.Context, teamID TeamID)
+
 	// (GET /templates)
 	GetTemplates(c *gin.Context, params GetTemplatesParams)
 
 	// (POST /templates)
-	PostTemplates(c *gin.Context)
+	PostTemplates(c *gin.Context, params PostTemplatesParams)
 
 	// (DELETE /templates/{templateID})
-	DeleteTemplatesTemplateID(c *gin.Context, templateID TemplateID)
+	DeleteTemplatesTemplateID(c *gin.Context, templateID TemplateID, params DeleteTemplatesTemplateIDParams)
+
+	// (GET /templates/{templateID})
+	GetTemplatesTemplateID(c *gin.Context, templateID TemplateID)
 
 	// (PATCH /templates/{templateID})
-	PatchTemplatesTemplateID(c *gin.Context, templateID TemplateID)
+	PatchTemplatesTemplateID(c *gin.Context, templateID TemplateID, params PatchTemplatesTemplateIDParams)
 
 	// (POST /templates/{templateID})
-	PostTemplatesTemplateID(c *gin.Context, templateID TemplateID)
+	PostTemplatesTemplateID(c *gin.Context, templateID TemplateID, params PostTemplatesTemplateIDParams)
 
 	// (POST /templates/{templateID}/builds/{buildID})
-	PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, templateID TemplateID, buildID BuildID)
+	PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, templateID TemplateID, buildID BuildID, params PostTemplatesTemplateIDBuildsBuildIDParams)
+
+	// (POST /templates/{templateID}/builds/{buildID}/cancel)
+	PostTemplatesTemplateIDBuildsBuildIDCancel(c *gin.Context, templateID TemplateID, buildID BuildID)
+
+	// (POST /templates/{templateID}/builds/{buildID}/logs/export)
+	PostTemplatesTemplateIDBuildsBuildIDLogsExport(c *gin.Context, templateID TemplateID, buildID BuildID)
 
 	// (GET /templates/{templateID}/builds/{buildID}/status)
 	GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context, templateID TemplateID, buildID BuildID, params GetTemplatesTemplateIDBuildsBuildIDStatusParams)
+
+	// (DELETE /templates/{templateID}/rebuild-schedule)
+	DeleteTemplatesTemplateIDRebuildSchedule(c *gin.Context, templateID TemplateID)
+
+	// (GET /templates/{templateID}/rebuild-schedule)
+	GetTemplatesTemplateIDRebuildSchedule(c *gin.Context, templateID TemplateID)
+
+	// (PUT /templates/{templateID}/rebuild-schedule)
+	PutTemplatesTemplateIDRebuildSchedule(c *gin.Context, templateID TemplateID)
+
+	// (GET /usage/daily)
+	GetUsageDaily(c *gin.Context, params GetUsageDailyParams)
+
+	// (GET /usage/storage)
+	GetUsageStorage(c *gin.Context, params GetUsageStorageParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -90,8 +222,31 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+// GetArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifacts(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactsParams
+
+	// ------------- Optional query parameter "sandboxID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sandboxID", c.Request.URL.Query(), &params.SandboxID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", c.Request.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter query: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -100,13 +255,26 @@ func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetHealth(c)
+	siw.Handler.GetArtifacts(c, params)
 }
 
-// GetNodes operation middleware
-func (siw *ServerInterfaceWrapper) GetNodes(c *gin.Context) {
+// DeleteBudget operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBudget(c *gin.Context) {
 
-	c.Set(AdminTokenAuthScopes, []string{})
+	var err error
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBudgetParams
+
+	// ------------- Optional query parameter "teamID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -115,25 +283,27 @@ func (siw *ServerInterfaceWrapper) GetNodes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetNodes(c)
+	siw.Handler.DeleteBudget(c, params)
 }
 
-// GetNodesNodeID operation middleware
-func (siw *ServerInterfaceWrapper) GetNodesNodeID(c *gin.Context) {
+// GetBudget operation middleware
+func (siw *ServerInterfaceWrapper) GetBudget(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "nodeID" -------------
-	var nodeID NodeID
+	c.Set(AccessTokenAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeID", c.Param("nodeID"), &nodeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBudgetParams
+
+	// ------------- Optional query parameter "teamID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nodeID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(AdminTokenAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -141,25 +311,27 @@ func (siw *ServerInterfaceWrapper) GetNodesNodeID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetNodesNodeID(c, nodeID)
+	siw.Handler.GetBudget(c, params)
 }
 
-// PostNodesNodeID operation middleware
-func (siw *ServerInterfaceWrapper) PostNodesNodeID(c *gin.Context) {
+// PutBudget operation middleware
+func (siw *ServerInterfaceWrapper) PutBudget(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "nodeID" -------------
-	var nodeID NodeID
+	c.Set(AccessTokenAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeID", c.Param("nodeID"), &nodeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutBudgetParams
+
+	// ------------- Optional query parameter "teamID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nodeID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(AdminTokenAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -167,27 +339,66 @@ func (siw *ServerInterfaceWrapper) PostNodesNodeID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostNodesNodeID(c, nodeID)
+	siw.Handler.PutBudget(c, params)
 }
 
-// GetSandboxes operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
+// GetBuildLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetBuildLogs(c *gin.Context) {
 
 	var err error
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(AccessTokenAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesParams
+	var params GetBuildLogsParams
 
-	// ------------- Optional query parameter "query" -------------
+	// ------------- Optional query parameter "teamID" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "query", c.Request.URL.Query(), &params.Query)
+	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "query" -------------
+
+	if paramValue := c.Query("query"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument query is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "query", c.Request.URL.Query(), &params.Query)
 	if err != nil {
 		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter query: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	// ------------- Optional query parameter "templateID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "templateID", c.Request.URL.Query(), &params.TemplateID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdAfter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -195,11 +406,26 @@ func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSandboxes(c, params)
+	siw.Handler.GetBuildLogs(c, params)
 }
 
-// PostSandboxes operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
+// PostBuildReconciliation operation middleware
+func (siw *ServerInterfaceWrapper) PostBuildReconciliation(c *gin.Context) {
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostBuildReconciliation(c)
+}
+
+// GetEgressPresets operation middleware
+func (siw *ServerInterfaceWrapper) GetEgressPresets(c *gin.Context) {
 
 	c.Set(ApiKeyAuthScopes, []string{})
 
@@ -210,24 +436,39 @@ func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSandboxes(c)
+	siw.Handler.GetEgressPresets(c)
 }
 
-// DeleteSandboxesSandboxID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxID(c *gin.Context) {
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	siw.Handler.GetHealth(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
-		return
+// GetHealthDeep operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthDeep(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	siw.Handler.GetHealthDeep(c)
+}
+
+// GetKernels operation middleware
+func (siw *ServerInterfaceWrapper) GetKernels(c *gin.Context) {
+
+	c.Set(AdminTokenAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -236,24 +477,54 @@ func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteSandboxesSandboxID(c, sandboxID)
+	siw.Handler.GetKernels(c)
 }
 
-// GetSandboxesSandboxID operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesSandboxID(c *gin.Context) {
+// PostKernels operation middleware
+func (siw *ServerInterfaceWrapper) PostKernels(c *gin.Context) {
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostKernels(c)
+}
+
+// GetNodes operation middleware
+func (siw *ServerInterfaceWrapper) GetNodes(c *gin.Context) {
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetNodes(c)
+}
+
+// GetNodesNodeID operation middleware
+func (siw *ServerInterfaceWrapper) GetNodesNodeID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Path parameter "nodeID" -------------
+	var nodeID NodeID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeID", c.Param("nodeID"), &nodeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nodeID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(AdminTokenAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -262,42 +533,1313 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSandboxesSandboxID(c, sandboxID)
+	siw.Handler.GetNodesNodeID(c, nodeID)
 }
 
-// GetSandboxesSandboxIDLogs operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
+// PostNodesNodeID operation middleware
+func (siw *ServerInterfaceWrapper) PostNodesNodeID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Path parameter "nodeID" -------------
+	var nodeID NodeID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeID", c.Param("nodeID"), &nodeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nodeID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostNodesNodeID(c, nodeID)
+}
+
+// GetPinnedBuilds operation middleware
+func (siw *ServerInterfaceWrapper) GetPinnedBuilds(c *gin.Context) {
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPinnedBuilds(c)
+}
+
+// PostPinnedBuilds operation middleware
+func (siw *ServerInterfaceWrapper) PostPinnedBuilds(c *gin.Context) {
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPinnedBuilds(c)
+}
+
+// DeletePinnedBuildsBuildID operation middleware
+func (siw *ServerInterfaceWrapper) DeletePinnedBuildsBuildID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeletePinnedBuildsBuildIDParams
+
+	// ------------- Optional query parameter "nodeID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nodeID", c.Request.URL.Query(), &params.NodeID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nodeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeletePinnedBuildsBuildID(c, buildID, params)
+}
+
+// GetRegistryCredentials operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistryCredentials(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRegistryCredentials(c)
+}
+
+// DeleteRegistryCredentialsRegistry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRegistryCredentialsRegistry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "registry" -------------
+	var registry Registry
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry", c.Param("registry"), &registry, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter registry: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteRegistryCredentialsRegistry(c, registry)
+}
+
+// PutRegistryCredentialsRegistry operation middleware
+func (siw *ServerInterfaceWrapper) PutRegistryCredentialsRegistry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "registry" -------------
+	var registry Registry
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry", c.Param("registry"), &registry, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter registry: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutRegistryCredentialsRegistry(c, registry)
+}
+
+// DeleteSandboxes operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSandboxes(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSandboxesParams
+
+	// ------------- Required query parameter "label" -------------
+
+	if paramValue := c.Query("label"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument label is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "label", c.Request.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter label: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSandboxes(c, params)
+}
+
+// GetSandboxes operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesParams
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", c.Request.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter query: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "templateID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "templateID", c.Request.URL.Query(), &params.TemplateID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", c.Request.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter label: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdAfter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", c.Request.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nextToken: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxes(c, params)
+}
+
+// PostSandboxes operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSandboxesParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxes(c, params)
+}
+
+// DeleteSandboxesSandboxID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSandboxesSandboxID(c, sandboxID)
+}
+
+// GetSandboxesSandboxID operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxID(c, sandboxID)
+}
+
+// PatchSandboxesSandboxID operation middleware
+func (siw *ServerInterfaceWrapper) PatchSandboxesSandboxID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchSandboxesSandboxIDParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchSandboxesSandboxID(c, sandboxID, params)
+}
+
+// PostSandboxesSandboxIDArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDArtifacts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDArtifacts(c, sandboxID)
+}
+
+// GetSandboxesSandboxIDArtifactsArtifactName operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDArtifactsArtifactName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "artifactName" -------------
+	var artifactName ArtifactName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "artifactName", c.Param("artifactName"), &artifactName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter artifactName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDArtifactsArtifactName(c, sandboxID, artifactName)
+}
+
+// GetSandboxesSandboxIDChanges operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDChanges(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesSandboxIDChangesParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDChanges(c, sandboxID, params)
+}
+
+// PostSandboxesSandboxIDCheckpoints operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDCheckpoints(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDCheckpoints(c, sandboxID)
+}
+
+// GetSandboxesSandboxIDConsole operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDConsole(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesSandboxIDConsoleParams
+
+	// ------------- Optional query parameter "writable" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "writable", c.Request.URL.Query(), &params.Writable)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter writable: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDConsole(c, sandboxID, params)
+}
+
+// GetSandboxesSandboxIDDiagnostics operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDDiagnostics(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDDiagnostics(c, sandboxID)
+}
+
+// GetSandboxesSandboxIDLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesSandboxIDLogsParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", c.Request.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", c.Request.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter level: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDLogs(c, sandboxID, params)
+}
+
+// GetSandboxesSandboxIDMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDMetrics(c, sandboxID)
+}
+
+// GetSandboxesSandboxIDNetwork operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDNetwork(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDNetwork(c, sandboxID)
+}
+
+// PostSandboxesSandboxIDPause operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDPause(c, sandboxID)
+}
+
+// PostSandboxesSandboxIDRefreshes operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDRefreshes(c, sandboxID)
+}
+
+// PostSandboxesSandboxIDResume operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDResume(c, sandboxID)
+}
+
+// GetSandboxesSandboxIDRouting operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDRouting(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDRouting(c, sandboxID)
+}
+
+// PostSandboxesSandboxIDTimeout operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDTimeout(c, sandboxID)
+}
+
+// GetSecrets operation middleware
+func (siw *ServerInterfaceWrapper) GetSecrets(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSecrets(c)
+}
+
+// PostSecrets operation middleware
+func (siw *ServerInterfaceWrapper) PostSecrets(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSecrets(c)
+}
+
+// DeleteSecretsSecretName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSecretsSecretName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "secretName" -------------
+	var secretName SecretName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "secretName", c.Param("secretName"), &secretName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter secretName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSecretsSecretNameParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSecretsSecretName(c, secretName, params)
+}
+
+// GetSecretsSecretName operation middleware
+func (siw *ServerInterfaceWrapper) GetSecretsSecretName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "secretName" -------------
+	var secretName SecretName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "secretName", c.Param("secretName"), &secretName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter secretName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSecretsSecretName(c, secretName)
+}
+
+// PutSecretsSecretName operation middleware
+func (siw *ServerInterfaceWrapper) PutSecretsSecretName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "secretName" -------------
+	var secretName SecretName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "secretName", c.Param("secretName"), &secretName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter secretName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesSandboxIDLogsParams
+	var params PutSecretsSecretNameParams
 
-	// ------------- Optional query parameter "start" -------------
+	headers := c.Request.Header
 
-	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutSecretsSecretName(c, secretName, params)
+}
+
+// GetSecretsSecretNameVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetSecretsSecretNameVersions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "secretName" -------------
+	var secretName SecretName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "secretName", c.Param("secretName"), &secretName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter secretName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSecretsSecretNameVersions(c, secretName)
+}
+
+// GetState operation middleware
+func (siw *ServerInterfaceWrapper) GetState(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetState(c)
+}
+
+// GetStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStatus(c *gin.Context) {
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatus(c)
+}
+
+// GetTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeams(c)
+}
+
+// DeleteTeamsTeamIDBudgetOverride operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeamsTeamIDBudgetOverride(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTeamsTeamIDBudgetOverride(c, teamID)
+}
+
+// PutTeamsTeamIDBudgetOverride operation middleware
+func (siw *ServerInterfaceWrapper) PutTeamsTeamIDBudgetOverride(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutTeamsTeamIDBudgetOverride(c, teamID)
+}
+
+// GetTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplates(c *gin.Context) {
+
+	var err error
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplatesParams
+
+	// ------------- Optional query parameter "teamID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "public" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "public", c.Request.URL.Query(), &params.Public)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter public: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdAfter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", c.Request.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nextToken: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTemplates(c, params)
+}
+
+// PostTemplates operation middleware
+func (siw *ServerInterfaceWrapper) PostTemplates(c *gin.Context) {
+
+	var err error
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTemplatesParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTemplates(c, params)
+}
+
+// DeleteTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTemplatesTemplateIDParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
-		return
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -307,24 +1849,24 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSandboxesSandboxIDLogs(c, sandboxID, params)
+	siw.Handler.DeleteTemplatesTemplateID(c, templateID, params)
 }
 
-// GetSandboxesSandboxIDMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context) {
+// GetTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(AccessTokenAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -333,51 +1875,49 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context)
 		}
 	}
 
-	siw.Handler.GetSandboxesSandboxIDMetrics(c, sandboxID)
+	siw.Handler.GetTemplatesTemplateID(c, templateID)
 }
 
-// PostSandboxesSandboxIDPause operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
+// PatchTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTemplatesTemplateID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(AccessTokenAuthScopes, []string{})
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTemplatesTemplateIDParams
 
-	siw.Handler.PostSandboxesSandboxIDPause(c, sandboxID)
-}
+	headers := c.Request.Header
 
-// PostSandboxesSandboxIDRefreshes operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Context) {
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-	var err error
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+		params.IfMatch = &IfMatch
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
-		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -385,24 +1925,48 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Contex
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDRefreshes(c, sandboxID)
+	siw.Handler.PatchTemplatesTemplateID(c, templateID, params)
 }
 
-// PostSandboxesSandboxIDResume operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context) {
+// PostTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) PostTemplatesTemplateID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTemplatesTemplateIDParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -411,39 +1975,57 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDResume(c, sandboxID)
+	siw.Handler.PostTemplatesTemplateID(c, templateID, params)
 }
 
-// PostSandboxesSandboxIDTimeout operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context) {
+// PostTemplatesTemplateIDBuildsBuildID operation middleware
+func (siw *ServerInterfaceWrapper) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTemplatesTemplateIDBuildsBuildIDParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
 			return
 		}
-	}
 
-	siw.Handler.PostSandboxesSandboxIDTimeout(c, sandboxID)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
 
-// GetTeams operation middleware
-func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
+		params.IdempotencyKey = &IdempotencyKey
 
-	c.Set(AccessTokenAuthScopes, []string{})
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -452,27 +2034,34 @@ func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTeams(c)
+	siw.Handler.PostTemplatesTemplateIDBuildsBuildID(c, templateID, buildID, params)
 }
 
-// GetTemplates operation middleware
-func (siw *ServerInterfaceWrapper) GetTemplates(c *gin.Context) {
+// PostTemplatesTemplateIDBuildsBuildIDCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostTemplatesTemplateIDBuildsBuildIDCancel(c *gin.Context) {
 
 	var err error
 
-	c.Set(AccessTokenAuthScopes, []string{})
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTemplatesParams
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// ------------- Optional query parameter "teamID" -------------
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
 
-	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(AccessTokenAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -480,11 +2069,31 @@ func (siw *ServerInterfaceWrapper) GetTemplates(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTemplates(c, params)
+	siw.Handler.PostTemplatesTemplateIDBuildsBuildIDCancel(c, templateID, buildID)
 }
 
-// PostTemplates operation middleware
-func (siw *ServerInterfaceWrapper) PostTemplates(c *gin.Context) {
+// PostTemplatesTemplateIDBuildsBuildIDLogsExport operation middleware
+func (siw *ServerInterfaceWrapper) PostTemplatesTemplateIDBuildsBuildIDLogsExport(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(AccessTokenAuthScopes, []string{})
 
@@ -495,11 +2104,11 @@ func (siw *ServerInterfaceWrapper) PostTemplates(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostTemplates(c)
+	siw.Handler.PostTemplatesTemplateIDBuildsBuildIDLogsExport(c, templateID, buildID)
 }
 
-// DeleteTemplatesTemplateID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateID(c *gin.Context) {
+// GetTemplatesTemplateIDBuildsBuildIDStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context) {
 
 	var err error
 
@@ -512,8 +2121,28 @@ func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateID(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(AccessTokenAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplatesTemplateIDBuildsBuildIDStatusParams
+
+	// ------------- Optional query parameter "logsOffset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "logsOffset", c.Request.URL.Query(), &params.LogsOffset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter logsOffset: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -521,11 +2150,11 @@ func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteTemplatesTemplateID(c, templateID)
+	siw.Handler.GetTemplatesTemplateIDBuildsBuildIDStatus(c, templateID, buildID, params)
 }
 
-// PatchTemplatesTemplateID operation middleware
-func (siw *ServerInterfaceWrapper) PatchTemplatesTemplateID(c *gin.Context) {
+// DeleteTemplatesTemplateIDRebuildSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateIDRebuildSchedule(c *gin.Context) {
 
 	var err error
 
@@ -547,11 +2176,11 @@ func (siw *ServerInterfaceWrapper) PatchTemplatesTemplateID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchTemplatesTemplateID(c, templateID)
+	siw.Handler.DeleteTemplatesTemplateIDRebuildSchedule(c, templateID)
 }
 
-// PostTemplatesTemplateID operation middleware
-func (siw *ServerInterfaceWrapper) PostTemplatesTemplateID(c *gin.Context) {
+// GetTemplatesTemplateIDRebuildSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDRebuildSchedule(c *gin.Context) {
 
 	var err error
 
@@ -573,11 +2202,11 @@ func (siw *ServerInterfaceWrapper) PostTemplatesTemplateID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostTemplatesTemplateID(c, templateID)
+	siw.Handler.GetTemplatesTemplateIDRebuildSchedule(c, templateID)
 }
 
-// PostTemplatesTemplateIDBuildsBuildID operation middleware
-func (siw *ServerInterfaceWrapper) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context) {
+// PutTemplatesTemplateIDRebuildSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PutTemplatesTemplateIDRebuildSchedule(c *gin.Context) {
 
 	var err error
 
@@ -590,15 +2219,6 @@ func (siw *ServerInterfaceWrapper) PostTemplatesTemplateIDBuildsBuildID(c *gin.C
 		return
 	}
 
-	// ------------- Path parameter "buildID" -------------
-	var buildID BuildID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(AccessTokenAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -608,42 +2228,68 @@ func (siw *ServerInterfaceWrapper) PostTemplatesTemplateIDBuildsBuildID(c *gin.C
 		}
 	}
 
-	siw.Handler.PostTemplatesTemplateIDBuildsBuildID(c, templateID, buildID)
+	siw.Handler.PutTemplatesTemplateIDRebuildSchedule(c, templateID)
 }
 
-// GetTemplatesTemplateIDBuildsBuildIDStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context) {
+// GetUsageDaily operation middleware
+func (siw *ServerInterfaceWrapper) GetUsageDaily(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "templateID" -------------
-	var templateID TemplateID
+	c.Set(AccessTokenAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsageDailyParams
+
+	// ------------- Optional query parameter "teamID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Path parameter "buildID" -------------
-	var buildID BuildID
+	// ------------- Optional query parameter "start" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsageDaily(c, params)
+}
+
+// GetUsageStorage operation middleware
+func (siw *ServerInterfaceWrapper) GetUsageStorage(c *gin.Context) {
+
+	var err error
+
 	c.Set(AccessTokenAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTemplatesTemplateIDBuildsBuildIDStatusParams
+	var params GetUsageStorageParams
 
-	// ------------- Optional query parameter "logsOffset" -------------
+	// ------------- Optional query parameter "teamID" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "logsOffset", c.Request.URL.Query(), &params.LogsOffset)
+	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter logsOffset: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -654,7 +2300,7 @@ func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDStatus(c *
 		}
 	}
 
-	siw.Handler.GetTemplatesTemplateIDBuildsBuildIDStatus(c, templateID, buildID, params)
+	siw.Handler.GetUsageStorage(c, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -684,26 +2330,70 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/artifacts", wrapper.GetArtifacts)
+	router.DELETE(options.BaseURL+"/budget", wrapper.DeleteBudget)
+	router.GET(options.BaseURL+"/budget", wrapper.GetBudget)
+	router.PUT(options.BaseURL+"/budget", wrapper.PutBudget)
+	router.GET(options.BaseURL+"/build-logs", wrapper.GetBuildLogs)
+	router.POST(options.BaseURL+"/build-reconciliation", wrapper.PostBuildReconciliation)
+	router.GET(options.BaseURL+"/egress-presets", wrapper.GetEgressPresets)
 	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
+	router.GET(options.BaseURL+"/health/deep", wrapper.GetHealthDeep)
+	router.GET(options.BaseURL+"/kernels", wrapper.GetKernels)
+	router.POST(options.BaseURL+"/kernels", wrapper.PostKernels)
 	router.GET(options.BaseURL+"/nodes", wrapper.GetNodes)
 	router.GET(options.BaseURL+"/nodes/:nodeID", wrapper.GetNodesNodeID)
 	router.POST(options.BaseURL+"/nodes/:nodeID", wrapper.PostNodesNodeID)
+	router.GET(options.BaseURL+"/pinned-builds", wrapper.GetPinnedBuilds)
+	router.POST(options.BaseURL+"/pinned-builds", wrapper.PostPinnedBuilds)
+	router.DELETE(options.BaseURL+"/pinned-builds/:buildID", wrapper.DeletePinnedBuildsBuildID)
+	router.GET(options.BaseURL+"/registry-credentials", wrapper.GetRegistryCredentials)
+	router.DELETE(options.BaseURL+"/registry-credentials/:registry", wrapper.DeleteRegistryCredentialsRegistry)
+	router.PUT(options.BaseURL+"/registry-credentials/:registry", wrapper.PutRegistryCredentialsRegistry)
+	router.DELETE(options.BaseURL+"/sandboxes", wrapper.DeleteSandboxes)
 	router.GET(options.BaseURL+"/sandboxes", wrapper.GetSandboxes)
 	router.POST(options.BaseURL+"/sandboxes", wrapper.PostSandboxes)
 	router.DELETE(options.BaseURL+"/sandboxes/:sandboxID", wrapper.DeleteSandboxesSandboxID)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID", wrapper.GetSandboxesSandboxID)
+	router.PATCH(options.BaseURL+"/sandboxes/:sandboxID", wrapper.PatchSandboxesSandboxID)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/artifacts", wrapper.PostSandboxesSandboxIDArtifacts)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/artifacts/:artifactName", wrapper.GetSandboxesSandboxIDArtifactsArtifactName)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/changes", wrapper.GetSandboxesSandboxIDChanges)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/checkpoints", wrapper.PostSandboxesSandboxIDCheckpoints)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/console", wrapper.GetSandboxesSandboxIDConsole)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/diagnostics", wrapper.GetSandboxesSandboxIDDiagnostics)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/logs", wrapper.GetSandboxesSandboxIDLogs)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/metrics", wrapper.GetSandboxesSandboxIDMetrics)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/network", wrapper.GetSandboxesSandboxIDNetwork)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/pause", wrapper.PostSandboxesSandboxIDPause)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/refreshes", wrapper.PostSandboxesSandboxIDRefreshes)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/resume", wrapper.PostSandboxesSandboxIDResume)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/routing", wrapper.GetSandboxesSandboxIDRouting)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/timeout", wrapper.PostSandboxesSandboxIDTimeout)
+	router.GET(options.BaseURL+"/secrets", wrapper.GetSecrets)
+	router.POST(options.BaseURL+"/secrets", wrapper.PostSecrets)
+	router.DELETE(options.BaseURL+"/secrets/:secretName", wrapper.DeleteSecretsSecretName)
+	router.GET(options.BaseURL+"/secrets/:secretName", wrapper.GetSecretsSecretName)
+	router.PUT(options.BaseURL+"/secrets/:secretName", wrapper.PutSecretsSecretName)
+	router.GET(options.BaseURL+"/secrets/:secretName/versions", wrapper.GetSecretsSecretNameVersions)
+	router.GET(options.BaseURL+"/state", wrapper.GetState)
+	router.GET(options.BaseURL+"/status", wrapper.GetStatus)
 	router.GET(options.BaseURL+"/teams", wrapper.GetTeams)
+	router.DELETE(options.BaseURL+"/teams/:teamID/budget/override", wrapper.DeleteTeamsTeamIDBudgetOverride)
+	router.PUT(options.BaseURL+"/teams/:teamID/budget/override", wrapper.PutTeamsTeamIDBudgetOverride)
 	router.GET(options.BaseURL+"/templates", wrapper.GetTemplates)
 	router.POST(options.BaseURL+"/templates", wrapper.PostTemplates)
 	router.DELETE(options.BaseURL+"/templates/:templateID", wrapper.DeleteTemplatesTemplateID)
+	router.GET(options.BaseURL+"/templates/:templateID", wrapper.GetTemplatesTemplateID)
 	router.PATCH(options.BaseURL+"/templates/:templateID", wrapper.PatchTemplatesTemplateID)
 	router.POST(options.BaseURL+"/templates/:templateID", wrapper.PostTemplatesTemplateID)
 	router.POST(options.BaseURL+"/templates/:templateID/builds/:buildID", wrapper.PostTemplatesTemplateIDBuildsBuildID)
+	router.POST(options.BaseURL+"/templates/:templateID/builds/:buildID/cancel", wrapper.PostTemplatesTemplateIDBuildsBuildIDCancel)
+	router.POST(options.BaseURL+"/templates/:templateID/builds/:buildID/logs/export", wrapper.PostTemplatesTemplateIDBuildsBuildIDLogsExport)
 	router.GET(options.BaseURL+"/templates/:templateID/builds/:buildID/status", wrapper.GetTemplatesTemplateIDBuildsBuildIDStatus)
+	router.DELETE(options.BaseURL+"/templates/:templateID/rebuild-schedule", wrapper.DeleteTemplatesTemplateIDRebuildSchedule)
+	router.GET(options.BaseURL+"/templates/:templateID/rebuild-schedule", wrapper.GetTemplatesTemplateIDRebuildSchedule)
+	router.PUT(options.BaseURL+"/templates/:templateID/rebuild-schedule", wrapper.PutTemplatesTemplateIDRebuildSchedule)
+	router.GET(options.BaseURL+"/usage/daily", wrapper.GetUsageDaily)
+	router.GET(options.BaseURL+"/usage/storage", wrapper.GetUsageStorage)
 }
@@ -18,60 +18,247 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcX2/jNhL/KgTvHu4Ar+1Nt0UboA/J7l4v6O42lz+9A7bBgZbGMbsSqZJUEiPwdz/w",
-	"n0RJlC07iTcp7mmzIjUczvxmOJwZ+R4nPC84A6YkPrzHBREkBwXC/G9W0iw9eaf/pAwf4oKoBR5hRnLA",
-	"h9XoCAv4o6QCUnyoRAkjLJMF5ES/ppaFniqVoOwar1YjzHgKvSTd4HYUJWHpjN/1Eq3Ht6OrIC8yovq5",
-	"DSZsQ3mlJ8uCMwlGym+mU/1PwpkCpvSfpCgymhBFOZv8LjnTz2p6fxUwx4f4L5NadRM7KifvheDCrpGC",
-	"TAQtNBF8iI9JijSLIBVejfCb6eunX/OoVAtgylFFYOfpxd88/eKfuEJzXrLUrvjD06/4lrN5RhMj32/3",
-	"odNzEDcgvFxXHnMGVG9PL9/y0i7dYvP0EiVcgERzLpBaAHIGgkd4zkVOFD7ElKlvDvAI5+SO5mWOD78f",
-	"4Zwy+/frkcc0ZQquwSj1Pbv5lVi3QdKU6sVIdip4AUJRC/QmH+/ZDRWc5cAUuiGCklkW5alrmFYg2ls1",
-	"yCc8hcgyejIyY5H9dfeRg5Tkuo9QlJ/a9D9jt5CncrUa4Y+Qc7H8eNwlaUfae0aUoY/H67Xx+oeDUCEH",
-	"38e28gluz50YO8KCWl1rseemGcEokhK1Ea5uyY9+eseTNmVwkmoXMacgEJ8bMXhxIv9aV+gjrGgOvHTw",
-	"npMyU/jw9bdtC7mgOSDFUUZvICZmCQlnqRxHhe2lO+3KtqX0YH9a4Z8cEJsSJ1nGE6IgfXt62RXDpzKf",
-	"WRFU81BlqcOQW73oAEcjiDvKtVNoLpNbFGrU0eNhS9WH+CZlMmsPHf05JfT4qFoabh5IJErGKLtGnIWE",
-	"BzArFVHlRqRrpZ3bmW31VlGJo9TiftRUbVQRHhbvQBGaRXwXSRaQHuuIKuIqP1BpdGZnIRN4SUTTliyo",
-	"glxGIo5KKEQIsnxU/cEabjeprmJ3nVrO7Kvej0X28nTqNYbX0IxX43m1ZutENs9bsgOmvchnLICkSzzC",
-	"qSBU7wlfReRaU3+7IOw64kcevF9HQO/lDGSZQ9p7SnxlL6s5bOo/4lUpiSjiSD/2elh3jiQZBaaG2YKd",
-	"G6VSlJUnW6eVKipbaVikRxHXZ4R5uwDWkOItzTIEdwUVDaeXEgWvtJJiTOVB3LGOqSo+edgh37iGbRJl",
-	"b3hnrFAo2EY2RCL30mDZbBeR+NloLniObhc0WSAqG0wkAohlYH182LgzhjfTCoihBAJkBfr02NEW/MwN",
-	"A9hN+isISe2Fp0nIDXgqem51VFC2ESePhLdnDYVQfoG6P/DryLnLrxEwJZbolqoF0tCXiuQFIixFGWVa",
-	"zU2MmIdROnoE+dtrT/htiMeN1K7rRJZ5vgZaZ1tM1VIjy3BTDrIL/cw97WxLduEwKAgJpN4JQFrcmrUD",
-	"Dj8GDnXYvdi/sRG6jUUETaKkBE22BEV4lvVF5VveTJKivJSQniY96YhS35VRASIBpvS1OaA6zzgJIMgM",
-	"D+54u+CKZNF7jhlZe7P57k3P9T/XrEaJuut6KSHdiuY2xpIHKnu4vQSnR6CDxi6bgtTIvQCSR86Tgv4M",
-	"y8iBcnqCvkCdxFD67YjHoPKdjyDbJP69ALWA+nXvUF3I2SI54zwDwsw1xuRhOzAlOdTuOs6Nfj7U4cco",
-	"dFy5Iec4Gnlhhbv2kr2UEMldQe6uha2ck37sOSn1mzHJpkP24d6uAFWWdPMRZaZY3iz/Ll6IRxvQF29A",
-	"LOIYfls1992NPsngvnlG68hQv6yGuamg3LFJmhmRCskySUDKeZnZK7mxgWt6o+PTdZHVDpcFF1NsDoob",
-	"e68jkWFRsZt/vHT5ql/m+PDzeiYrSK+uRpiVWUZmGdgayGqEtZjOC3LLtmbdCFh72ie97hTlLIsdnE2P",
-	"5NiiEtn5iAvEWbZExOifzjJAs2XEWwSuSmop7IrhthzWHDU7BbMxcZZFugPirNrsqzseX2FUXJcY4/cg",
-	"p7/QPkLOQ0S3wdhQScPHhJ7O5H267m4LT2GmxgRcR6nuWPx81SnaGa9iJm7jL+Wg7FSgfJ+hMrxqoqMq",
-	"WWWLS1ePdm/aVf9Vbq4KsBsqOnOlzce/Bu/grFOefAExp1kkOHlXjQURU//yuzg1kz54m6dRAAiFEp7n",
-	"OvpXHMEdJKV2bS1TJnPlvF8vfB85ggpkFir30thyr3b35b9NKlJCUgqqluda5nb9I0Pggn8BdlSqhXEN",
-	"QASIf3jHZ5f4r9JTsKvQGtJmWr3UQqlCi/UozSlrEDSNBwsgqZnuWg/+88pMfHXh6HoXYONOTcf8tYnG",
-	"6ckrG6e23tfbpWzObQ5YaSDj9wfH6Oj0BI/wjU/o4On49Xiql+MFMFJQfIi/GU/HU+2aiVoYGU0WQDLL",
-	"xjVETpN/mmGULCD5gg0lYarkJyk+xD+BsuO41TRxYAvsTVIOJzYjWAVnQb9DzIQqshM9yap6wnhq14my",
-	"bCocJMuQnRZh+pMbiPE8uCmg8vjDQjFTdlxddTMU3caBSjbZEglQpWCQBhvaSmBVs8P6uXpSaEVmO220",
-	"f77SYaQi+mT8jIkexVe1Qib3tlaz6tXMT6DMHpBBb59iPvmKT9ju1CPdesrEFYo0iw/S6yYluiLhYMVV",
-	"taYt9eb6cDbNfbMPHY9wwWUsPWSqYEhWoQvxZbWmak+5fDzdGi9yzNPlo6q1UdZbdfvADqw6WrG2062X",
-	"gLnWGRJp4OKy5UvWvbbvRj15vdP1JYKwStux8/NgsIWEViSI/ijBZ/UUR3Oa+dinLmD/DcbXY/QbLiWI",
-	"H8ks+a2cTg++I0XxYyF4+hv++xj9y1DRcRWQZGFSYvo/NyQrQaK8lArNAF2efUDAEp5COtYxvebArF8f",
-	"y/6//Q2EV/s9V9ol+IedMF3tGTROh6BxuseTKYifmqitGV/jtcy1E5GqJmSqRa1gv+vAQtA+iReqG8JW",
-	"zQDc5WtasHq8HtHGsl0PF5Zy3ZU94t1eJkYa3m1yXxX6VhY2GajINfFnmmU1fDpgeWdeq+ByHhQPtzv2",
-	"6rJjxKv0HEehsr7QLHsZJ9FAe+6NKmtbni2RyYv3nzlPpI/HizLbLn2bSFPWrVgvVc29JjnxSbleGHgQ",
-	"uKTcAAx8sDN3xsEomsXRR6iKVNolUguikFzwMkt1xFHpjjKU0yyjrhurJ/owyaNG9NHJOq9vjO0UTW3P",
-	"MmJVknsdlz1cZTSnTa7qdrTpdLptX9lTmlbYmbCLXVlk/SmNy9a2h9mXnzvIxD5Wk7+at92mj8S1a+wc",
-	"Rodo8XL6UwKmIKW0xeZolH2qh1s9MmuC6gou5r29h0pmM81QydwQE8KsBzStuE+pSPcV1Ka5P3xdpQuY",
-	"C5ALl+CPKv7MTmkYAtwpYKlpHVTSHI2+JXkgKs6qdR+KjN0ubs3KRlpahiMVJDdi6ke2ATGUQ32mfoFC",
-	"IWKasusm7PArom++00fnhu9c3CM++x0SNTht1XJcVrJ7ih8fH5DaMtehUY/v4Ifsi18JbmuvB83PAp5v",
-	"rsA5zb3dP1+GBw2+2ogj9hyUTUbZie1vNsboIv7pAbrzbiRIaNG6285hcYzekiwzN5QFlTpEWfAU5WWm",
-	"aJGBa3LhNyBuBVWu3+Xi4sPIZk4NwVLa1wElpRDAVNi56tqt/TWo4FSPc5QDkaWAxta8Hx0PtMkLJ7vn",
-	"cAY0vr5pN+DozdVuvdZHKC9Xx+89JLoflOzy8aPj8upRzgrpoOk59dRfeHyrgOQDSht2WuTOc+EG9pn3",
-	"N33AD8z22w3tLyPb7sdoFZz0M68Qm4cfpBQ/NaqYerDlMWKJjKpROMxk7NQ+c7VvMLi6xYMB4eX1XEBR",
-	"czSgnsPgdn0JJ8TDU4Rm0a63QQHawaPz0Beh2WZoHZ+RJIFCbX+r3YuyG25gcl83Hq6tzNjSCyL9MLAz",
-	"KiBchA2N2wUVQS/k8JxDox/X7uJhAfK+LI+oZNHdku0AXGN0+rUnEfbTGW+zq3GQ9U4HKNs1Pr+EyunD",
-	"XfIZWDdD2ECH/DKg8X+//oR+fWJ/OWNy7/rKV2uuyKZVOuyAHgQt+zsRx1Xb+u44G22c7ZvjI0fDQdxb",
-	"WAUugu/lX7j+JvWnDr0VpcpF2t33NYZuUua5/wBhLyrtlFFPWAp31SfBPvUx8x+I9FZ97Ve/rS/vYhVW",
-	"fi1/mc8l9JRZn1WNtfl1zlZ1s0oMzzOhsIWVmHfFjcdhKTL3GYE8nExIQcdwMBuncIMDCvftny6UBmrN",
-	"H0psPjR35tXV6n8BAAD//xML/VkqUgAA",
+	"H4sIAAAAAAAC/+y9eXPbOLYo/lVw9Zuq7twfvcRZbidVU/UcO5nO607iZzs9U3c6LwWRkIQxBXAA0LY6",
+	"5e/+6mAjSIISqcVx0vevxCKWA+Dg4Oznyyjl84IzwpQcvfwymhGcEaH/SxSewr8ZkamghaKcjV6OfiNC",
+	"Us4QnyA1I2hCSZ5J95cgkpciJUjNsEIpZmhMUDrDbEqyBFH/kyRMIcp0n7eTvXdYpTNkpnZDlUWGFRkl",
+	"I5nOyBwDIGpRkNHLkVSCsuno7i4ZMXKrLvkVYW04T0ohuR8NGqICT4mGgkrEuEKSKET1d0EQFgQxjuZc",
+	"EEQVmcsVUwuixOJ4oohoz31BUs4yiTB8Rjczms7s9vy7JFIhOeNlnsFGwCiUZLG5KFNkSsToDmYrsMBz",
+	"ouzJYKHoBKfqPZ4T+JvCpAVWs1EyYvq3ehOA9t8lFSQbvVSiJMtXNi5pnr097RjYfR02ZioIViTr2K9z",
+	"okrBEGf5Qu+T3n9k+9hdhN8V1YvRUP27JGJRgVWbIIRlwsUcq9HLEaDTnh2hDSDNyLzgirB08QtZtEH8",
+	"yOi/S4KuyKLCdX2Yif1Dn6P7Ed1QZY5c4rnpJfQapW0tC84kqS6RkMr3pUwqgjP4OCaUTVEheEqkhK2Y",
+	"Ysr20eXMjEkluiKFQhMu0NFTNOOlkA6eIscLklVTzbCZ+61bqNo7d43Mzdt3W2v+rPb2bbU3e7A54fbO",
+	"8e2vhE3VbPTy6NmzZDSnzP39OLrPE33Z2xv8+hJPW2TEbBrJ0NggRiHINeWlbG6+/gNNMM2l2fqnj48Q",
+	"bQx2g6WjRUhSlhKzkb+P/vP3EbrGeUnQHGAjEmG2QOSWSgXb7wbo3h9LwVZQjByPSX5BcpIqHrkEv8Jn",
+	"JO13abGHZWN+SySa4WuCFDcQJgjnYdN5KZX5so8uyqLgAu5N9R1o2++jK7L4q17m76PE/Pkfjb9/H6Ef",
+	"YVoNqdkA+QhhlqHfR//R+p5xItkPyrR7tN9xMXXb2s4Y6treIo8uWAi8MPSdZ6STEtmPwwhRgaeUYdjy",
+	"X+mcqvYxvMO3dF7OESvnY/MaGWqkuMXGBBDLPR9wDuY77LFD166t0DNGiRNl6snRKBnNzeyjl48PDw/1",
+	"bbJ/Ju1nIVzM+5UPoeJIKiyUxqucwnURfO6eQ3/R7KP8jz0YcU8P2XiY/R2E57RjpdW7vOoRnVKpRITe",
+	"/sylqsiBaZUgsj/dR9NZKvYpT1DG0ysC/0VcoMdHT54+e/5fP704fHy0n12JfZKK/VLuESzV3uN9PMd/",
+	"cIZv5H7K56Mkhk8emGEYZe9oJ5pW3weOS1JBlj3xQYOBI3OhPogs9hLrn92+S0NHHDcUO2gussZ7+xdB",
+	"JqOXo//voOIrD8xXeXDhJwYwFMHzzl2zH4ctTJF5kWNFlozqGwwZWWOqeUc14To6PIJ/Us4UYZqG4KLI",
+	"aaqv4sG/JNfXsOeeGPT4OxZzP1n9TOCRcoD7ozG94OoaHmFC4OXK4JrDd6CNSa3lDdbEWtMATaECTv8c",
+	"eNk9z5zFwLWtDwK2V4P69PBwa1vxWgguYjvwCnvOaqTnfLz7OY9LNSNM2VERMe1g8ie7n/wNF2OaZYSZ",
+	"GZ/ufsb3HNjIkmVmxhe7n/GEs0lOU3Oij492P+GZ0GIZhT81r0gyc0W6OUT9WXOmHcIq9PCvPizk6B52",
+	"7pIDH8gW7k5I+zAqTSjwXPP6gmBDEPRzP6f+NU05S0shCFMVewmgP7uPm3xBxDUR1W16dvjkfialKUEl",
+	"w9eY5nick0RLbAsENNW8RnYUmOTYys6aI9e/4MygDc7PBC+IUJTEOdgISy9RKQ1hnlBmzsMJ5zJBWKE5",
+	"lwo9fm64allJp3z8L2KuxyuQuX/l09fM8kpFDYpAYK/P/zYjTNEJrZ503bQtACejnDLSHuCMS3NdbHdo",
+	"5a6CHgrlfDpK2nxsk1lNRnMiJZ5G5viVT5H7GAGs/qavWp9rHR2JzolUeF60B7qkc1ItEC51zqdTkoVL",
+	"W64/qJiJf9a5jEpfore42ogQoE/BIcvXtyDAxY45vSIRiQU4wOp8oY1ZCp9KdEMEQeTWSoSKdx29jAzr",
+	"BSA1C8bI+RSZHr2O3SLxUqD92KZx4k/CSFVScUEyhCVi5AZ+RhnRFI1k6H9ffHi/8jzsxnlg3JIju35u",
+	"uYy1Nj8tpeJzIn6Q6G8nF62zwPWjMNoH20hL8EbGhw05Gu8BId+jmZW1vT7p7WmF6niuZXP4Q1oSh9OU",
+	"l8zT+uOzt2boMQFRld/oma1mzW63hAtN1X4MNQpBJvQ2Qhf07x3nhxjucUnshsIZnJx9PAGoI7Lr2UeU",
+	"ckGkVnEF7OwoWSI7/7RccE5GJ3kpFREXCqtSts86nZH0imTHqoNQOGKI4cwIztUM6S7VC+s16v3oR9JQ",
+	"wnsNybLn7sT9ZJfR0p8kI+kX2Gugn/VaWidlR0mCfakBrI+wMUZbnte/t3YI2WNNzYmMkhFhcGz/HJmN",
+	"XYzgTZ0KnGliXDL386fIJnogfqEsa4MAv7YACGYEmWkEOM9vYVogO4ZOZ1jhMZZEy4wZlcsn3zZW9cah",
+	"K7vqXmett0grJaUyjFNEISmV4dRau5YYvXOlmfdfEDDZ10TI6EvjJuu1CfGpe28HvMAsXbyTsZXpT90X",
+	"mTI0p3lOpTHkNKjN86fDOJxzgiVnjX2iEgWI3YKe4XlkpNprUx2Gk/jhdXCEEv6OHsJ2iIKl8RrnkopE",
+	"VJsekgugEKeEFBVxqF+NQvAx6U/5zDBn0OkeqJ4FDtbweiqIlGc8p2lEZfmhVGOQoJESeDKhqX9xQdFa",
+	"V+cnTpMqidYjG21/Ria4zPUfWFl2QRGWVfYPN+KMSyUNA6H/a7XPkufXJEM3M8LC+YzSR2pm4fT9BaAe",
+	"zm/wQrrxRknjQOzvoISVcd0snH/TRpFiBtIlI6lCisMlcnKTU0sZYCtFstRsWbdBYI5v35qPz5+2D9oO",
+	"sEosNIem28L9aZ20HSY4YD9uZFeO87y9I3+fETUjAvZTL403UYGGe23XMeY8J1ireNbbb88UWkSq7//S",
+	"jW1uZW3C5vyn1V+ts+skXBsdiaUuIRhJtf2N/Woe3PtO0pkhotuhQl/hpM95oR8nZZ4/ShDjy5sxzsgj",
+	"xEVlxrYGBUpkQLPnnKECp1d4CuZGhqdEuOuL0xnoJtCP9vue+74HQz76nQXMCgA1SkYwKdzeWIcom/Ka",
+	"Xf+GxVKlRsMuy66p4GxOmELXWFCAMMaOt7l9z1Y0+CCeRY5HN0apYcF6yJWaOTiJDvUOpzPK9IZmej+J",
+	"Hxv9qBVlr49efb44fn/66sM/Pr//cPn5zYeP708fxbC58003i4v0sDq5fuoKb8bGueQRI5wVR/feniJv",
+	"dF4uVtkdrJQM1UaFsMGd+RmLjDDKpr+Sa5LHrr19jiywM9fevV7GK0FjL5gWBAGYUuUvg2l3RQQjOcIZ",
+	"yGRSCaNPlwuZ4jzXnREMC72kwizDItPXqEJPe50yMi6nUzB2wEOmCWKBUxIbqglhigFAcJ5AhaDXNCdT",
+	"Yt7DgzHnKkEHRKXm71IKdx0zfY3Qj2ZZ9ftnL56DWP8XWkUvXciwtO5DVpotiTGqp/ZbRXb5mKzHna5m",
+	"KPXgGcpIQVgGXNzOuUfPYwV7oDGT86s3mOalICGzpdERVjVlXDTfB3iEMehQioIwWfFAM86vjGeIQYqJ",
+	"GRdRaQi1UfShH82gj5xAKogsYWsEKnApW3Y3PSD6Ef55FGCFhww+RFHhF30bOqRDWc7NSmva65+P946e",
+	"PUeuhQPF3qsxZVgs0I8zcosIg+ufRSmZc5Dqkrz8htlxjWUDHjAieitChzMSZrbYSNfG0XClB2LXCA2U",
+	"c8Ml1VaHmwJo9wvNc5JdeKNI65C8KV8uI+6eYF7p8QIrSzLE9yUEPpgYAP2VTki6SHMCFyX2ws7nOKYB",
+	"OTEfELklaamqh8YOn1QXRpZpSkhm7xHVTj7Kcpx/EMHdS91axqR5bZcKcq17btX0vFS1K//kMOlw01FO",
+	"c6/BBg5YlAzWFSeOdUXhkx4+NvXH1WwsnME7Mudi8e5VhP/QX5osEsD07tVyveXjF0chPEc/xQj5e3Jz",
+	"X0SkwEoRAf3/7z/x3uRw78WnL8+f3v3lIV18g7R2AVQ6awENiZl0avaSZVphRSWq6EF9lX8c7/334d6L",
+	"/c97n/7/v6xDVT6ZMzqjjJFMmxe2YrHzaFOWNK4x8r5yq4aEltXYsGmFBhZxlnT8riUl6CcD77ceOn6z",
+	"TLsllrJ2CNbOAya89xOcS5J0CNv6cmmXtsbjbJ3MM2K8Wy0Jk5UTDdWuMKkxkFvNXOUxEzSLudV4+ydO",
+	"r6ZCi4POCuMcUY2zUGbp5dHhkVHVdLp/B4rUwBXH+RioGWFJTJ8zwVIh7twUQrDN0vajugYjAvcU0T1V",
+	"JpXcuLSbbXaXjOi8Qxk6IYIA0HyCMCrKcU5T9OHkLdIdausE3lxaP0UtuHmPv4OcjgUWi4NioWacvXyy",
+	"/9htMudqIrVjVElzVSnfzPDBaetjs0jgiDXXmFWpWPik6tvAjkLwa5oRGdCZABGMR4nV0dXXE/h3ay4T",
+	"JBroNDfvhmS4kDOumibtBEluQyh+0AY9zZhmZgazOKaf7IMxZQdy5tdEGW1eEdMJRCGsbGcjPhWlZlm8",
+	"Zsn5RKJUEE1HcF6zP064qLcLN2w/rpZ3ThU9vOKsB4YWxBUGO0zPju9cc60mpFxQtejZ9cw1t/6pnAGL",
+	"AjaNGmkyPoNLKFOdGBU5Ts2zhJlBMjO21x+NF7XTrvhxsMESIUhme1hfcMZRigucAqSxa24bx2239fGa",
+	"PoVmXu5cQQvhrqy+AYuWRbkBeh3nqQzWHgY76NV7RHNr2R+ktjQusIFOqy3d+uURdg0KrEC7bnqbO7qw",
+	"Qv+cX0c09vpiA99rlkGVdLe1pWun0l5MfV+VHRmubEGEpDJku+1NtwA4F38dH6VMrEfwMgQTGOE0W2ev",
+	"+t68C9t6sAeOe/9XuuI0efzHz5KoVw5HOb0mMXbasvj7UabacdGHMR5aKzLZStPBpV3Cb6Z5VFUd7E6d",
+	"zXFeZG12px8JbHih9VLhOMeywK/s6CeUE6WIkAnK6JQqmaAf9n9I0A+ff0BcoB/2fjAX1vVtkoN6KBPA",
+	"YFDQ3OrY6Wqf6zbdwZX9f0Jz4jQtGRU6TGXRkkYFybGCs4cBGzYuf0NnvFq+xSVUyh6aUquD0sDak7sk",
+	"eG4Qv31oqzffXDAXUQG/sOCrJT+NRRrxz0dMHf1UF0eO9/4b7/3x+ZP9z+Hei8+f/jMqfulAnojIBD9H",
+	"APRMhABOBFjZfXRBlHK8iA9+Mn2sx5DUlI+RGyc97dfhf/7s2ZPnPffdAKw33mrx2zJBihXJTs4+LnNO",
+	"8+2QdxbqZ0HwHa0kTyOi/PHceVNV01jCD+I8fdVvKuvd0o+A2sahsLa5uBe3VEyjYva5/n1Vb4vAHY5b",
+	"1flUvLQoGVgPQha83/b10zkDGjlXqCbKuUgxr3CuQZ/UkS2KGg5RT4nCNKZ60XKEFvdjDifURDOZVka+",
+	"lohmjb3o/5hvfvoyVHPGoV11dL2cNc5NVyf7r+2vsc7xalJQOxl3jMYucaL9q9b11FrLSYvEfa1eh75O",
+	"JjahOX5rKOcK1+mN4AVdq4+o9Bjh0AhL4z7KplFBor8f1aYuVI1jrTwAQ8fDChx3lpXDXUPnqX9v3ANn",
+	"qYF3D4bOBKaAn1FjTTX6iY4KaaPKxrhrB4C13LvGsLdJSI+oLUJGIdgb2x+wTrJp/DknOKNMO5FYM21T",
+	"bAbTqzI2Rh0ObG0kaEwmXJC2jIazhZYbBUkJvSbSeY1o5jwnRnkXuvNp24zlin++vDxDBRc2OtfCn2zV",
+	"0tOCdqixZ6ZUceaZfWeePWhZZkPWXy+MsKzglKnOQW0ARGMYvR29F9KaraGXxeYEpUT+3ei2zQBr+2yV",
+	"X3lMrH2+wnTF0Q2mqiXeGj2AWU1Pa9bzldYsjeYpZym8L0643RLBMVcDaMQciysTr2Eeso63S6gxwepY",
+	"dTgee8OeGV2QwkUj60ksR5IgVkII/gR+ZwRiyzLaSZ+gLXgEOe3dzoKN+gUEhZtgSFCHys5+aLj/NaAI",
+	"jJ9JxDHTfEEmbBCGg00j80ItQk5uJeN5bhW9J14f/JuTPBs+vVjKGy6ymCLAfNFOPuYCKp1nwFFCP3SH",
+	"qBoVOpcmHklGpSQiLsJ/tF9i02ujw/HfL/T1fH1yDiB/hqjEz1dk8RliAp4/1d+csgadV0kEaqlRnq9O",
+	"jRJijAc3qTbSoIgxLAA3ERWaKZYxlv7YfGjhTAuBxgTRub1q42GoEc8XFcvrklT6pCB+tqLarp0j6DEK",
+	"QrN+2shwRtbS1qy8upqY6JXZ7QfF6/8YMh+CIXMDu876uvCvqrjWD3hdno6TgA4C0CdKNs0pYaqvqoqS",
+	"OBOXFqXXDC11FHShf9rE3EMUqbTSOWQoKqggAyKC1jQ9Vp4+yzp6j6DNzJW1fDKrTqDT/1orQ0Qv6S7I",
+	"EBKkB+m3pdK9Q32ukW67NpdlVP4mmR6VdTppJLlhfFiYl8cjfbhtARYHSODwFJ6DB34JCbvOfuvpTAVt",
+	"vZqvZaMYqjsOD0eUTCLKluggN0b1B41Q4SkESNNtHlyllgkNfUYp4wzLW6aEm1ggd2cX3BYiLdlHjRnR",
+	"eegf5NVCxTjsC/qH3wSFxf70D4RFOqPX/tdga4aqQkPUCu2W/iRD0JqaLYtwRoWZvaF5VFpbdTQuL44+",
+	"otUnYn5o4e+iIM0BCTPiko9sAgCTkT/+iGa2sTt2K3Sj2oJJemXUTHEVv/7Wk8hWY22iSK2G0QhXkZk1",
+	"sozUltBx5qcUTxmXiqYRb3YgTD2f7mCc19BrlSFDiwtV3lt/4whIRnHKmowmcOICg4cfJOTo0AuZvCD2",
+	"WN5UXRAvVVGqBFGW5mXmDNlTI6gQQXGOUs4kz4dZ2gKo+rylAUTRGH3tJ/jbhqENjh0ZfnqGR4URBJ4P",
+	"Y2mFjmKPWZsW3YfsLjXn888mHGKUjPSZfC4wo6n/C1TOo9puf04FlnCvy8kks3/EjDTG4XP4Vpybft8a",
+	"x31/vE4yqo6y/5pqx99vSddpUfYXFJc8jXV2LGDkawvxqOyIWPNaRi+9BdNdnDa5MpyeFxVGHjPj9Pi1",
+	"pb5N1zBpY+Y0eX/dw1oMXZzJGCBYaTeu5lj0ysNhrSSTMo+O3/OMdyGHdARjxDf8nSeZTdZnSt7oQNdV",
+	"ObCgJbIxsWAxr1xfzZGjGWZZTgT68eObN6ePwr3pjsuEQYF3XM5R2gk0BJShsWX0hvKRfrIkXHZ8v849",
+	"XW0YhnKeXq2G2CA/0q0HgaxZP7V4BR1XHkk4i0Q3gipFmDsVR5J+fP+q72ks52qA1qU8z0nqHeUsAFJh",
+	"JVfbnv3W1RcZHMDqnIOxrOHGmS4ZmI/QJAiv24pGASh8Gs/Wpzl26xfqUthp1bHNcNcgaS66vI/Sjdtg",
+	"9M6MhL/a9HPIpYyMvsOC4FgQnDEqONrmVpK4FBBFqYJYaBs6bqVRcg3wtpkAx+BIlfFS6WcnI0LAfxZS",
+	"kXmUZVmRiVB/aoG5Zi5CP5Xd0U+1A+4I/b8ASypVi8Z6a8C4leuw/FEyomzCR8noBovqZY0tvpo8Qlzy",
+	"OOPPp5Gt7+V+Vs22MshWzx1sz7tAh9vvMroeK3nF2iSCptGhBE0HXrVQ695FNQd6yaZF+VGS7CztyM5X",
+	"SniSCiJSwpTJOOFHneQcBxfUZNQ3BF5eXXKF86jTrf6CTG6HZtg9zYm5V3H/284HRV7BKqLTwYetzjYn",
+	"81WLW+ZD3D1q5xJsxLGm60PG5AVhb2L+qh8KwvTykfudm7B2cLmqaGOLP+sxp+8d2ZsZ6RwcldLHyXGp",
+	"NBrDPfDM9hBqcGYmsXcvIvQPIdDz4KZuTqMDk0Nw9WrHX8ewgGC9J+qGi6vjVNFraxctmtRK2YIRMhqk",
+	"7b/WJUSTU8oyDTovNvWZ5BOw4xcgfBREUJ7RVCdsMbFPYCEWyg2gB7Y27TmVkmQDz80uMAA0dnjbEeeX",
+	"iJm1bWzvfwhem3sGNvjceARGPBi0Btd5DGZNRtYHvQQQ9Lt1etoLwlTXlJIw1ZxO8fUmC457Ff8eNF17",
+	"Ohq5pW/PEM4yoX2LOjC5SzS+IITFr30lEldgR6A2ah1CauAvFY6LbnfHLugT45npnAsKwRVPuS1/xEul",
+	"fUd7vvGuc9TpVX+JHJfLOZ8WCSqzAnGBaDovqgk6LhKFJnrBwcR1nAnRNWncmOCMgpt3FriCVI6oDJae",
+	"t71RbWOU5ljKVmT0351Gy/jISAShvz4+telap7M0XtusVybw85G7R/p3QAiTbi50dU4sb38D2b2cI8ue",
+	"CY21rXVv6doh1yiY2zR3PjszOp3FWsF+Baty4UVUIsghlyAc74kKQci8UNIuCwpBRQGxzrTOpZkqia5P",
+	"zj6a9Frnx+/CeHdwddXVSiza+qhf9MElzVOUCIkIU1Tl5sWxZQOr6kUdKzW+Se4wYHa7hDAHj3t/ghBa",
+	"J8jk/GaUVEgDsywTYOpMRMQSbn3YDIdskh2YLm2+qcHGz5d4dGvhty6ldjjg9ODbKXOsOwzJGfHiQS8+",
+	"fk7m51JGWdJzIik8tuuwusPZ0vpm9CF5MfdBe6Lo7WmfQZqqNe0pCEfX5tzsJlUrC0jXOS+V9Rbc0AHb",
+	"/uGUp0sMFcxGXPZguSx4OkbzzibHNkU8tE5s5QP/7PbW1yCUgb8KHNctJYH3Z8RPzr+3j5+hOWWlIjJp",
+	"VO7U4yxMgv0UvBGtszDUqaIk64dwdsuWR1IFQQYWtBQrDBqRKtm+9bu02UFb/gZYWY9Nd0aVbzMvVUdu",
+	"WFk5GQ2Ls9uedw2e9xsEWvZGwyUMtu0buSYdscKG1Ys4XJkPDiYu0hnRKSj5yvjIykriowRXhXiFzdcL",
+	"furAHCotgnSwrduLoHQ7mYSBaQ032O6UPrKGCNbJ1t2W98fvXiMu9L//67fX5xdvP7xH5nJabggrIpUL",
+	"LYdFG4WGGTL42VIA8967WUxCD4WwDMPtW2oEeEJtE63ege8HomQH5Gh8ECYE8QN7hsEu0sdSaaV7O70I",
+	"lugvX9xIsNg7WHX9J7f+O6S4LQRiR4NlmDiWznQhTiVdJQYKMg77k4CBIEl/4kXxYKOwUsZn3CQZsTKM",
+	"5Ylqe2U/BfyTrcrrZdF62heoOAKlaU0qomDel6UkSKa8IMPSltScR6Mxnk29XWKNdRp7mhVUnas9WIL1",
+	"ArU3p8Ycu1QV2D/1IGHUqCVMycgMv4w//GgKWK+Z/aPhhRxojIPwgvrA265IPSgyf8mxVLULWSwS98au",
+	"Z6Wbl4/SCxaqyVO9nKWT/rBMg1nMXwBl9MwurcGo8a4UNFoM2iH5xN9EHHUXpPLUgbOMs4DuzivDwt8Y",
+	"MmAHVrtgdkEz7B3v4fuqh/MOiXazwlV/sjv7qsym0YT6aSpKkn2UUXlHNuNhgKqZHp4Ds4XsjOoRfv14",
+	"UWPeM16OcxKVXThTs3yhqwBHAfi1XjQvBg1lCCM90KCpQaEgaEbOOzyqzO9uOtc6dqTu20emaN6htCrh",
+	"mxUT4BgsC0rYhItU62+J1/zpJN39qxZpInjRnUgiRPIGP+jyzRkq7b2ITKVCCs8Mtwri9hUwp30BZDxK",
+	"eqpAqjp+2IdB/99M//HyxJyfHBTxsJrXqrC+qs4EllHKpmdG4I6In5UkXm1FSB9gAHiz1RriaRPdW+C0",
+	"TjMJr2d902vcYbXUDw5TI0/Tpphero/h6xllzIT1BXZWh/uKxCR2B/sGHW7xTsaQ24LxUysCfjNMD2uk",
+	"D0rf3Dyl+uH2SyPiMCz2/oT+rlfVDYOfb1NCsg5+EUBoh1UPjwvxBxbk6hzuZT68KDv8daoTs6Kfy3FQ",
+	"kKgq3OjztkavdpGttS6tGRJcDVrcOnHgm/gz1oPZf4BrDucBFwykvEWV5yBYHZWxdfWk9EEZ+yCKPEhU",
+	"7dEo3Hp3F7rS3/VGPpswbh3EMwqAvs7x+viv2/nt+kkuO0vntz56R9ZS4bmNllnvLbM4UN/ffpjQkd1h",
+	"y+kGMzrRigt3oC7dIHwL0g3WR14n+WCVdTBYYoVwa+K8A28dpO9LSPojd3eK+lqIkN6AeBqJWm64flne",
+	"ankplmef3c6ATquwtSE7QjvlqAI+TCzntvAjGNJOcVdZ7nfGbNHlCJY1Cvy4WV2KwAllVM6MwGstIP3Y",
+	"wnFHJsK6daZrup5udXixQv4C+Qpa9Wc9QANp08qdvXgWSzr34pmaOaulDhedVGo7qpCCOiCKuwI+LR47",
+	"TEpXMyYxXovLMf177oSfwSYIWR1J0FBOrjfbhY0dWjFbNY1VfbaVt+sEE5iDbQET2Q2Pjkn9UlR3iEQK",
+	"xZE5jol8r+Fnt7R4pt++2WFs7xWJ8qIZYTRsBn6zg5sn4xmkFre514OUUctJn2nmCMNKL2G4TbKRkB7b",
+	"kgr9GKwB5utmlJHuqpWrU3pN2PJMCWskGun9rtfWPvRht+1fLWxioA+T0ct/rtYa6btw96mZrMw5pxX4",
+	"hg0GXW9wKQcAv07OE1PKY5Wqu8rtY9r7ipkmFRiFgo3jRUQNHZrEYRfWxeHmPnTT2W1lgxsgA0SOzXTt",
+	"z0+aXPVyiUeSa2Kuc5XgHf7Mkkg+Lp9705qL+jvuNjLo9+a6Gpny4olYLMJ1CTLhFWzenhoO1YhiSNO3",
+	"l4u1Lev6QBerJfvnp6aWTM+uPVsGvQyylx4rwFans9KwGn2Vy47bHcVzv5kSq/riLkandkSdetmN8/Cs",
+	"8boYXdfEptlouNj7b4HtsHv69Uo1+TqtfS+nLwSre3N+1fta/6wb+7wGx2Iad8KxsVO+3BznCmExlVWZ",
+	"tsVfjeTvHEK8S4MrMqObW1+MshE6sKRu+OPn7RuyThKG1nlFQLSSdhPMrTyuopWeeDm/V2vtsyS86o7d",
+	"1Z+QbEfwgm5G+gjeBGFU8Bt7tW84GhN1QwhDT9Ev9JX2qDgCT0/jDZJjMSXChefKkqraHprsjqAE0g2N",
+	"R471sJ7jPK+61ntBnC/00o1ML9An5cY53nIPOV7wKqDTPHV2SbV00d32haPDF//1+FlYkvHp4YvnUYls",
+	"3XyCWiA7ifn7GjnaZYVW3KVydlfGcwpVysXOx2ZTy/8wriKmW2hyGWHVMs0K6rJjxmebX1O4RjqjZUoS",
+	"RHA6c6NDY+cHpVyhMqpkVXENYVOlhN+wcKpABq6int2g44XuYtWk6zA27gWq0Z6nK5id4KUIn7SfQ/rd",
+	"MMC4T+04ZSfajxcmh0JdMd2WNq3PHcT/wkWoeZ8ENbLIrSIsq26VqV02ocSI8834MkZJdmHrcUdw2n5x",
+	"Bb/tmJKksMOwGJddysXbev8z15NPKrgTC7Mey7eAVBgGL16fvT5/1/edOPqpeVpJv9D1Rhl17fgJ9T99",
+	"nfFVgY7x4uSmUv01xUiWGUdcwCmVNDOlTimRjxJvMK8dXm2LOkrD4QziLSC9V/yUFJnboku6AA7JgnLo",
+	"kdMxTRvz9tn1J0erYrL1YLXb4fiTpmmSX8l+6e6trzhT2LSiQqvfctVGaM7OtAfgChSo12O+S0acGdXX",
+	"wI53wTrPiaZeF+CmWcZStlGmiLjG+c+8FNENKYX0r7Ox2Fotb5vx7aHPAfnp1UCdjp2xy71aD9fP7B4O",
+	"105ubJOxeK2mbWg4NO7iVW1J67jE41PVWz1UVOhh5Fadl2xV1hxoFqx9l3md4sqGqcAZOcPpFZ6ucssq",
+	"bKta4U5N+e0wVg1gl9NVJOaGjEFwqKzHLfdTQZR3MoYXpuBSVfmobX89saRTZp92c9Q/vzs+2bv4+RgK",
+	"WDt2jmeLIBLjH3uvj17tXdApw6oUxCac3kfH2npobalUoilhRGDVNr5Le80MNkWzilgQP4qITvrj+a/B",
+	"4gBIM75DRBqsd5gMXL/l7cMNkfJTN/nolJAjVMQqJI6eJlslKZ6t/q+jw1WFNKIo3LPw6nCM9i7yVW1f",
+	"CkJHWTj2pNSRhka1JJddgF1gR3CqF4oLPCXa5tg+S8cY98hQ6prKTotUp6loYDIn160PUMZfKI5Sa057",
+	"j7pbaQ6nUt3OCZalWNdpo0YG6ruYNI667b5hWpsQhCXasTVtSPel6Q9R/7eqZOt24iSt8JeEf1Rlw+vi",
+	"6q50hetogpzn0qaFa620u1zJ/ql9Au9Xek6JhkogsnthkVNT2PST/Xfv05fD5Mnju2iV0w6pu+25822e",
+	"RfwEjH5JP0HwnM/NEo/1JbqEwjnHpUnePCZYEPHGkRpzzT7r2jqjZKTh0ddLN6u2d6ZUAYs5zuaU1Qak",
+	"cECGl3JxHi9H/9jTDfcu7bh2FBv+AePo/60a4+zt3i9k0e5/d2dTiYHkSBVIPqPXR68gsCxwY3w5Otx/",
+	"vH/oosVxQUcvR0/2D/cPbWpsvUcHLuW2/mtKVEetyzA7dy2GsZntIeMgqgeFuquAMEA/7crzNhu9HP2N",
+	"qGM/N0Ak8JwoIqS2AUdAqJTJTTiChGfQGgKbF9VOhmGzBuMiIvdd8iWeCbA+oa7rrhdnlA+/j/7Fx3/F",
+	"4/T38vDw6PkVZdlfTR2u30eP9tH/AUhMDB1o6K6I+cMGMroi8cD8EJbyzNYrj6zB/dkN/6dk5IPI4fvR",
+	"4SH843IA6lCtIqepPoCDf9lYg2q8IUmG3LlFbJWthIoX3mkhX1R209quwjBPDw+7JvfLOoBGuu3jPm0f",
+	"Q9tnfcaFRiEN0RgY3tF/foL9VXgqgzhs7Z1zl4wOxj52KyM5icVgnurfbWou7WHvvOTrSuX6DTG9bGRY",
+	"64bE0MQHnFXn2kednbTi6kL3n+WlI9t49zSuzbMLBtbPbFMWOLTki10iwdPDp33aPt0QYZrPTR1rcGmr",
+	"qEeJ7N+IGooefyPqm8ONYTSpX/jYMKpjL+ufBtuKMqpsWoVtSRWGtSKgVOoY+hZ2npXfBHZq7vgVzxY7",
+	"QExv8arzstZX7SHcDGnx4M91KcybTfNsz7kYTeMaWSzSWUvtYowjAQ7+UFUbtJZ5Rm6Ir8OBBQky+FAh",
+	"VZyS0zyzSfIf5nVpMciXoMN3+ZfNWq3JyBIPLLVqg04ZNyqeZZxt/X6ECwuKgj7pAZY9Ni8t1I+r8iOO",
+	"7mpYHGGZqBDDzerYDpy3nU7A0AbRlTdm3hGz2kJtVq7lmtCY0wGxjgttoIGruXh4GCjVYpGhq0JD70Wk",
+	"cIj/WieH3UigmGOVzrSrjNvN756iecJTI2vCVrCmVYZVLiMU7g22QeHNIBdTqJoIgrTxQ5SFTmOrRd6x",
+	"y6NTq8cK+T6csQB+mBufEjueoYGurHY9Da7iHOgFlVULqUdAdD4nGcWK5Iv9Nn/BrXX0vL7Y+8DZrgrh",
+	"w7HXb4nXo8p7lHAb2qzGYwlfLVoZL8u9QhBJ+miKTHtk2xt0sh5/Pq+S4g2XNZelUwb5FVrvpPXktIDc",
+	"x2GHM25Gn+q78hA1GaZuTef5/lwva9M6HPO941SaPnEmq5JOBOx3bNie3AUwH2SEFJ2AnxJS1IryoELw",
+	"sQ+tJQVhGWEprUyix2dvDQXLiLWIAoUzxynR06MXiU0RdcKZLHOk4MXXnnUY2aQGhgsqmZl3URvg2eET",
+	"M3xl8LS+amMiTRiBNjZOuEDPwmLIHXsOCxztUKaA8e3pRlDeZTWj0m6y0eYcvbj/+d1x6ZhqSDVuC3Nr",
+	"RknPLM1lenL/sHlUcKhr/KZX0FPw3zUJC4ggGXJ9Iqjwi/+0e6po5lqfHsKq3FIe2IOXdHBL5/YQENYu",
+	"M77yYJsvCQ9i+wqG9+TG7X4fxcLjrU0cztpGcrMfNjDYoetuGfAXfdq+uC8mifGM9LjLplnk+r63H7Zz",
+	"efuFU5qkxZ82usZmQQ+Qa9WAHXwxmVvvOk8GVO9MJ7I35ZniB/Pe5X9taGdWaALM5KOdqsIBtFOiMM2H",
+	"8aPMZqx+gFLvRoTalHFG0rvHYpdQs02qt3a2O6DzPhmxWZDlGvpZ2zRC2x3Qodm2lHTb5PZtnj3c74Iy",
+	"RrK9Ko/HcqHUtEOml7Nm6H1KQSKN0uQz3fiVmeE++Kpgws2ETbvMh6lT6Lq5Z7QWTd06Iu2GSpW0iamr",
+	"TNC2pEX0irfOcCcsWe3g7pcva00dM77DhuoK/rrxd6AYXY9MHHyx3nx3y/w1PrKihonew3cZuTDuGiG2",
+	"vfKOg8MeFgtixHKwJDG+d5Qumb37AHUSVrTZu6GZpgxGwzDn1ySrG31iNgaf+X6I81GnE4jDQwflt45d",
+	"LpfgXpWcsMdbFDSuFQSoilXRa6x8CknqLIsFlvKGiyykfY7sr8ourxPL+fTyraeunV/zfl68jtyeGz1+",
+	"4VkMxq8nfdo+2ZlK1pzWEuw6+OJ+vevpc1Z1jiKbG24VAkFMOenCIDNdBInOq2Sbw6igA2vUn7w00p+a",
+	"rdnxY9cfXe6JdPVArQ63oBNttkZc2NyqQ5DH5m51hbMhXL1GyXSMkWw4C+ssF/BMtSLQbWhNFbmvIaKc",
+	"wQw+6WUF20a4e1aqHSLu9tnNNrAmB+lXcDSKUe44C9q4my57712yVV54I5hc/rIHQi92/7zIelL0+EPy",
+	"C81zY4Vo5UL3HhcmAHlMcps7gosu/jjMnr/c578+XINyuAADDUFS1VbkjPg+Lh0X7fY+0jAv9T5aEqNP",
+	"mcuM0OJVdqnug/MgWbWPHbjtz6baNO3RcaW7f8s+98kqa1kTT2Psbm88PNaF8hb+ZZvQXNXr3flUFL/r",
+	"dOJVOAguir8Wgmf3HQvSEhvfxGB2qUVUo0BWtOKBZ6/HC+c/qJP2F7kuN2frKMTA1eOPkjVDTTozEQ9Z",
+	"ondvenuKuEAm5dmOXQA1ZbmwlGi0uc/gG0pyjX+yUfNYL7NjNdD21SLuHzjyRb6CdA/13+DfT8kai5e+",
+	"3lSPxgWe2rLQuuzEsC7vya0yYXZ3n+7XdNasJrmZES1Gs0xIoAbpH3uwUBtQ2HFzbPMDVm3J3TdN5Tus",
+	"O0ZIwe2S74F3b1sLvITer0A2mpF5wXW+bR1++WlnOmSPS/erP65N22YkwpTflki1zEhHh0erkQEaPRB/",
+	"gKdHfdoevbgvxzv/98EXH656t5opDyJfl/LaF0EI7DDk99AM0MKECGO4zW/B7LgJ6wkuBBVBglx52VKe",
+	"c0fnsT0Zo/m4DVG+VjgZPGCvL/F01cNFFJ66N+tbRY8CpK6IQUmnGWknXDOVTi2HXeQ4NQYZY4xpPGIw",
+	"8nYxaDWjRSfv9IJ29ejVC8Hes/5qNZI3qVmVaXwD1P76Vtenj3u81tDoa75+9cwUHf4CpgA0wtohQMt2",
+	"KKNCy1yLZolwLBFmPutAOyyu+lKqnF7XldXOW1tfAe/GrRPn1fpSaYMFlzOh/vouSYEx8CXYJUdaJXz4",
+	"Kpxpffol71BVEDw8lO/A2WFbV+ngi/svJNnp9ok85Tcs5zhrXIz2hUIKi/3pHwhiLuk1qacRzTiR/bPB",
+	"LLkcxwHQu33wwu0ZymJN/6BFHbd9+KXOSLuIZ67rny9Fb7E7hTp2f28Ya/wVe3hSGE8w595YT2prPppc",
+	"vT4n4w03IUAFp0zJfoh4YqHZDPUarjyn7iQrcIwuo6o9b3dB84e5JmxJkICa19Yqbd3hmB4Qhh2mMY5C",
+	"VxYw6TDwXLoIpwaOgaf4g0ltZE46e0NzsqELisVIjYLf6x2trtHLL6vUdVXr5jtS3dKkhlZznLnc/dTH",
+	"jxo8Q6DnEz15rJPadd+ivL11RqeCtNNiXe3iMn3cd4hsnEmek84H4VjHFXuiSAQY9W2nJsJByvi/k/EF",
+	"FBdQhrN3Lams8rhbo5ip+K/xD/tJqAL2B7xKoADZfs9nxK5hm8/IMWQo9eU+HNE1EyXO/G3T2MFCkEt2",
+	"GCPEbj1xi5E18rVSkDYvxmODfA1KeUNVGPft9x8Vgiue8jwJQQchrCiNPAXPB6S21TwUmhMptSuRixen",
+	"zDaEgzMvaKPpn9oBLFnlzdrv/mUUTxmXiqZyaSSV5sm4IEzSFI1LluVwprktPFNlArZXURExB1MeyVDJ",
+	"yG2hm+ULa1D/8OFdgt6AQC+wrp6eCixnCQj6Uy2I26DDAjOaPup3CU+DhTxQ7auFNYR0HQ0sCs/su3wU",
+	"luZvAmwMC1r0Q494DqYNCLSuUqRpM50TqfC88BUb+LQz30+7Dm9GCkFSbEt3TPA11zEAkrK0i692IkFE",
+	"IHVpwX0CoMNYMdvmWj7UkipVzpmwtJqbSBK0Ap8jUqjAYxRuCOXMpZBrvM6Nsd3P1BmTuhZrNyKy2KUZ",
+	"xZev0Se5NmWAtIZxzgVBEnzvifY3CxfeAZyr+DLo/v/KXcGcnimktps9Kpo+ahm63ANJ1LdzLVqoKcB3",
+	"SQTnRIlVr7LbBde2Fyl85xt/tVdyiOhuwN1Mam/u03eJMIwoyIyyko2T5XyOKzsKL9WYlywDFp2RVOk6",
+	"jg3abR3uMiKVddDqh2rvLUgPmyGzUB6nil5TNRC17K4jbHs3tu77RLXC1QbrsN9hF83R5cgS1+jofvfu",
+	"y+IKwIRHDEKo1UwJW8p/l4bb+0l5suGhCzIRRM7IEq3guWlSox2mjiJwylRJW3eKI7DE9sSKcz/v17Gl",
+	"Nio9lsKnX2wY1+wXzRFX2nS3DxX7ptlmDDsALL+VBMIknk+eHx6uYMr8T3z8L2JMqL2SSDQImdnZ7H4o",
+	"1vYR0lUa7MJG+L4GHTIdH6Dp3gCWPXyHUks0N3Qo/frUdjcOpT5R3aq2T3ZwaXipbIXdTi7xZkaEuTdK",
+	"4MmEpk1+ELSyvFROIeB/tqnkscKQq7fKuZiYKsM8c8V3sVT1xI1h2gOnARYkJUyhZ7e3yG+Ka5jmFL4V",
+	"gt9SIr0SoubWowdrQK4laardF5xvPpVI4SvCKo9zO/jbU1QEuXDdEG9PE5TTK+JSO2oA9HaEW9bTdnBu",
+	"j+Nhc8gOykGcscW0DRni+0j2EL8pis4JL1X3++LKLdiGcST0VBE8u8ltQQVBt+7Rr1BO0bCKmH459tEJ",
+	"znMThkklmhM14xmal7miRW56SF0IWQdBG1vQ5eWvtmy4HrCUpntlr6+0lVi6ODGjxzTWR8Vd8b7a0hzX",
+	"s9/zBb00/R4ExxacY7uIISyOsvZ5hPtltZadLJ051dFQvVqj6KGF8tNWODtXAcKTPTv6ty6N2jDnle5D",
+	"tmHbG9TGZW49zcqFhey+UquY+TbUilmYv9lY9xWxZNUaQzxAXPhEE7aonvtMbqnUtND02iz5BNDFACl2",
+	"4scbYsL9ygLNmSPigNl5nQl810VoHlTyBfPXwRfzH++Q2yOhTwRZtaEKIkmBgbboapNGtSO6rwgpwoFK",
+	"pkwliIUmeFbRxYW1uG0hMZDF8Au/1OEvftV1cOBKL01fhYa1vEFf3xXkq4drBHR0ucGghZbBWwri0y5e",
+	"0i2h1K5r1XXTwFXv7v0F0n2XqazkCuQMn3daeVRq1z86qeNvRicTotl9z//r2iU674yvke+ihLxdoNBD",
+	"bpqi6uuS0N0UyjOL+WrZq4YwJoBLFNTxQJYWaIazCjc2uaJfjdmymP4wgxkHkKIH8zp2MXUHjh1bLY66",
+	"ll4XZo6ryj+rKwvei4Ra0ZnfHPhf830dKPBamDeTe/25/SleUo2+CiuyGk2BSrSKKsrEa1NAMd+WOmpP",
+	"r+RBrEeGBJG8FCmR7tWcaFcTkGpA8wYo6yrLgZTjiyfZTsYFz/rtoxkRHahtg5B2+6goH8vem9FTmlmp",
+	"9udBJOPR66jwolzt+G0tNXwSZp1GFTC+VKApuSURZ4GHJVa2Bpez62jrDxGCi8qc48dycRt+bMABbSNq",
+	"VBispcCu1WFy/F2FoikGg8+YuASknVhUyl2i0YkB1k40GJVK2TiCh2hLATTvUS7HNIucwqX9cJ85vy71",
+	"1dws05dZ0P0dSO+SyBqwgy+mtPDdgakJfQB2I0Ezskwzda5TyWuEc80bBZLHrhR4TDekT/JST2vqVn9w",
+	"cw5lOAzsA5Q+Hlzji2Ay4reD6b7/QgldheJLCYRaHyVhEy5SMidMRU+30iIi62vfEmF3dNK7LOLuIXy4",
+	"Vdw1EldlIL6TUu4DHhLLf/Z5TFzT6INSfdxdCfY+IShBLlMPsXOtvqaSjmkO2xQP6ijKcU7TWEB9FbG5",
+	"g2ykIaDrZCN1E4bZSMPfbOql/8lIuoxkmCPYnEOpLsK2cpA+AFYnrJW+MrkoaIWX5hNdQi0eRj7RWmly",
+	"W2653yN2tHUYVpelwmlKirVsbvfiET+sCr//++CL+++KdJ7Wtou7cc6xynbkyzBP9VDWyXfdiSHVjR+a",
+	"UjdMUDHAD/d+tMFDKM1SjYnfLEgaqmSYodzryLy3iW9sdWR0XnARK3sXcjNbwpTd2ke7yUS3piO4Kn9W",
+	"++igB295ZtLOtw66fW2ys7vX0Sx/0PN42IPsWW61TvYeoqHt4ZHLrhAdwytg1pMtuz9M/R927k/Jzh3E",
+	"Cop2+P8rLIIaxH0Rd7MiosOwOCw5ug7CtzGuCz1m2Jd3+zNhx0GKWUryJYny9Peaw6bum9Sru0rFiwIE",
+	"dZahORZg7MISTTDNyXqIZea9L/Rao1Ks2bhvs4zjN42xkDLlgNyCjNGNtq/1d2t65IJkJhGN1XhOKKM6",
+	"btgcp88JKhWfE6FNB5ADbi3EhVwwZvZ7Rd7tP+16PdVqhjOju4Ci6403WZGIALHUSp9/rux6u7lrfRws",
+	"vKrAVsef8J6Cf+3aeO+F+2InGlYRlpFb797mYgrNkiAWuCsfmncTCdj/aE4rPpUfJhNTQSNiaHhQWa1q",
+	"TP2a2o+Hab3byi0RRujbg+3KynypD8KF4iakBZeKz7GiKbLdW45q/XWrVuq8cPNvV4HWwflYsJFb9UMM",
+	"SXkIqlO/P3yy7sHHyeVuT3371KMJ77Dg+wa2/ckwLO714jCrH1pV7tm6yDl8pkoa70Xdw7gq+uYgxREB",
+	"nka+ozsF7b6qq8pQZZSHJLNRfEbE8y0hhM/4UxaCXFNeSjtX3O9m90i+O5VXA9SvxB8PuG2dVPwBxbc+",
+	"SD6glHhKDjJM88VKX2PdCpXS3rh6UJf5WWef0fUHy6LhEix50I5PaqkcMryArhnJ8SJuXPsI3U41mLv0",
+	"FTJrsayr/sV9LSUR4L3MuLLF4lY6FZ1rqt9edYYXjfQiQeqdJ4fme22qgamGB2XfXQpl3cEwQYzfrIaM",
+	"sGw4XPcWrWIxabFZoEpwF8zh8Twj0mDyhAr5PfgD9vZjNkREKi7wlKwkI7adKe8+XoRurUFgS9iSSpf5",
+	"ph5n0EkoLiwoD5VU3BOym820m6E3ZjOkd+fRDkMy5EtM4Qr8udBfdxPXDsFKkY9ejmZKFfLlwQEu6D45",
+	"Gu9n5HoUdP7SrH0vtdrG/lhl1gl+1NOFjZR1O/x/AwD8AfRwLGUBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
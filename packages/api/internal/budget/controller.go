@@ -0,0 +1,210 @@
+// Package budget meters the cost of the running sandboxes against the monthly budgets of the teams. The teams are warned
+// when they cross the warning threshold of the budget and blocked from starting the sandboxes when they cross its limit.
+// The cost of the sandboxes since the last metering is accrued when they end, see db.EndSandbox, so the sandboxes
+// shorter than the metering interval are charged too.
+package budget
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posthog/posthog-go"
+	"go.uber.org/zap"
+
+	analyticscollector "github.com/e2b-dev/infra/packages/api/internal/analytics_collector"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+const meteringInterval = time.Minute
+
+// Controller meters the budgets and keeps the teams over their budget, the budgets are metered in the database,
+// so the cost of every interval is accrued by one API instance only. Every API instance blocks the teams over their budget.
+type Controller struct {
+	db           *db.DB
+	orchestrator *orchestrator.Orchestrator
+	posthog      *analyticscollector.PosthogClient
+	logger       *zap.SugaredLogger
+
+	overBudget atomic.Pointer[map[uuid.UUID]struct{}]
+}
+
+func NewController(dbClient *db.DB, orch *orchestrator.Orchestrator, posthogClient *analyticscollector.PosthogClient, logger *zap.SugaredLogger) *Controller {
+	return &Controller{
+		db:           dbClient,
+		orchestrator: orch,
+		posthog:      posthogClient,
+		logger:       logger,
+	}
+}
+
+// Start meters the budgets periodically until the context is canceled.
+func (c *Controller) Start(ctx context.Context) {
+	ticker := time.NewTicker(meteringInterval)
+	defer ticker.Stop()
+
+	for {
+		c.meter(ctx)
+		c.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Exceeded returns true if the team is over its budget and the budget isn't overridden by the admins,
+// the changes of the budgets are picked up in one metering interval.
+func (c *Controller) Exceeded(teamID uuid.UUID) bool {
+	overBudget := c.overBudget.Load()
+	if overBudget == nil {
+		return false
+	}
+
+	_, ok := (*overBudget)[teamID]
+
+	return ok
+}
+
+func (c *Controller) refresh(ctx context.Context) {
+	teamIDs, err := c.db.GetOverBudgetTeams(ctx)
+	if err != nil {
+		c.logger.Errorf("Error getting teams over budget: %v", err)
+
+		return
+	}
+
+	overBudget := make(map[uuid.UUID]struct{}, len(teamIDs))
+	for _, teamID := range teamIDs {
+		overBudget[teamID] = struct{}{}
+	}
+
+	c.overBudget.Store(&overBudget)
+}
+
+func (c *Controller) meter(ctx context.Context) {
+	budgets, tiers, err := c.db.GetTeamBudgets(ctx)
+	if err != nil {
+		c.logger.Errorf("Error getting team budgets: %v", err)
+
+		return
+	}
+
+	if len(budgets) == 0 {
+		return
+	}
+
+	now := time.Now()
+
+	sandboxes := make(map[uuid.UUID][]instance.InstanceInfo)
+	for _, sbx := range c.orchestrator.GetSandboxes(ctx, nil) {
+		sandboxes[*sbx.TeamID] = append(sandboxes[*sbx.TeamID], sbx)
+	}
+
+	for _, budget := range budgets {
+		tier, ok := tiers[budget.TeamID]
+		if !ok || tier == nil {
+			continue
+		}
+
+		// The cost of the previous period isn't accrued to the new one
+		from := budget.MeteredAt
+		if period := db.BudgetPeriodStart(now); from.Before(period) {
+			from = period
+		}
+
+		var cost float64
+		for _, sbx := range sandboxes[budget.TeamID] {
+			start := sbx.StartTime
+			if start.Before(from) {
+				start = from
+			}
+
+			hours := now.Sub(start).Hours()
+			if hours <= 0 {
+				continue
+			}
+
+			cost += hours * (float64(sbx.VCpu)*tier.VcpuHourPriceUsd + float64(sbx.RamMB)/1024*tier.RAMGibHourPriceUsd)
+		}
+
+		metered, err := c.db.MeterTeamBudget(ctx, budget, cost, now)
+		if err != nil {
+			c.logger.Errorf("Error metering budget of team '%s': %v", budget.TeamID, err)
+
+			continue
+		}
+
+		// The budget was metered by another API instance
+		if metered == nil {
+			continue
+		}
+
+		c.enforce(ctx, metered, now)
+	}
+}
+
+func (c *Controller) enforce(ctx context.Context, budget *models.TeamBudget, now time.Time) {
+	warnedAt := budget.WarnedAt
+	exceededAt := budget.ExceededAt
+
+	warning := budget.MonthlyLimitUsd * float64(budget.WarningPercent) / 100
+	if budget.AccruedUsd < warning {
+		// The warning is emitted again if the limit was raised and the team crosses the threshold again
+		warnedAt = nil
+	} else if warnedAt == nil {
+		warnedAt = &now
+
+		c.logger.Infof("Team '%s' crossed %d%% of its monthly budget", budget.TeamID, budget.WarningPercent)
+		c.posthog.CreateAnalyticsTeamEvent(budget.TeamID.String(), "budget_warning", budgetProperties(budget))
+	}
+
+	exceeded := budget.AccruedUsd >= budget.MonthlyLimitUsd
+	if !exceeded {
+		exceededAt = nil
+	} else if exceededAt == nil {
+		exceededAt = &now
+
+		c.logger.Infof("Team '%s' crossed its monthly budget", budget.TeamID)
+		c.posthog.CreateAnalyticsTeamEvent(budget.TeamID.String(), "budget_exceeded", budgetProperties(budget))
+	}
+
+	if !sameTime(warnedAt, budget.WarnedAt) || !sameTime(exceededAt, budget.ExceededAt) {
+		err := c.db.SetTeamBudgetNotified(ctx, budget.ID, warnedAt, exceededAt)
+		if err != nil {
+			c.logger.Errorf("Error recording budget notifications of team '%s': %v", budget.TeamID, err)
+		}
+	}
+
+	overridden := budget.OverrideUntil != nil && budget.OverrideUntil.After(now)
+	if !exceeded || overridden || !budget.PauseSandboxes {
+		return
+	}
+
+	paused := c.orchestrator.PauseTeamSandboxes(ctx, budget.TeamID, "the team is over its monthly budget")
+	if paused > 0 {
+		c.logger.Infof("Paused %d sandboxes of team '%s' over its monthly budget", paused, budget.TeamID)
+	}
+}
+
+func budgetProperties(budget *models.TeamBudget) posthog.Properties {
+	return posthog.NewProperties().
+		Set("monthly_limit_usd", budget.MonthlyLimitUsd).
+		Set("accrued_usd", budget.AccruedUsd).
+		Set("warning_percent", budget.WarningPercent).
+		Set("pause_sandboxes", budget.PauseSandboxes)
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
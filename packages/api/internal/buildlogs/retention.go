@@ -0,0 +1,35 @@
+// Package buildlogs deletes the stored template build logs after the build log retention of their team.
+package buildlogs
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+)
+
+const retentionInterval = time.Hour
+
+// StartRetention deletes the expired build logs periodically until the context is canceled.
+// The API instances delete the logs independently, deleting the expired logs is idempotent.
+func StartRetention(ctx context.Context, dbClient *db.DB, logger *zap.SugaredLogger) {
+	ticker := time.NewTicker(retentionInterval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := dbClient.DeleteExpiredEnvBuildLogs(ctx)
+		if err != nil {
+			logger.Errorf("Error deleting expired build logs: %v", err)
+		} else if deleted > 0 {
+			logger.Infof("Deleted %d expired build log lines", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Package buildstate keeps the state of the running template builds in the database, so the builds interrupted by the restart
+// of the API building them are failed instead of building forever. The builds can't be resumed, the template manager
+// builds the template in one call, so the interrupted build has to be started again.
+package buildstate
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/builds"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+)
+
+const (
+	// heartbeatInterval is how often the running build records it's still running and stores its logs.
+	heartbeatInterval = 30 * time.Second
+	// interruptedAfter is how long after the last heartbeat the build is considered interrupted,
+	// it's a few heartbeats, so the slow database doesn't fail the running builds.
+	interruptedAfter = 5 * heartbeatInterval
+
+	reconcileInterval = time.Minute
+)
+
+// Heartbeat records the build is running and stores the logs received so far periodically until the context is canceled.
+// The build is stopped by the cancel function when it isn't building anymore, e.g. it was canceled by the request to the other API instance.
+func Heartbeat(
+	ctx context.Context,
+	dbClient *db.DB,
+	buildCache *builds.BuildCache,
+	logger *zap.SugaredLogger,
+	envID string,
+	buildID uuid.UUID,
+	cancelBuild context.CancelFunc,
+) {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		building, err := dbClient.EnvBuildHeartbeat(ctx, envID, buildID)
+		if err != nil {
+			logger.Errorf("Error recording heartbeat of build '%s': %v", buildID, err)
+		} else if !building && ctx.Err() == nil {
+			logger.Infof("Build '%s' of '%s' isn't building anymore, stopping it", buildID, envID)
+
+			cancelBuild()
+
+			return
+		}
+
+		err = buildCache.Checkpoint(envID, buildID)
+		if err != nil {
+			logger.Errorf("Error storing logs of build '%s': %v", buildID, err)
+		}
+	}
+}
+
+// Reconciler fails the builds that were interrupted. Failing the build is conditional in the database,
+// so the API instances reconcile the builds independently.
+type Reconciler struct {
+	db     *db.DB
+	logger *zap.SugaredLogger
+}
+
+func NewReconciler(dbClient *db.DB, logger *zap.SugaredLogger) *Reconciler {
+	return &Reconciler{
+		db:     dbClient,
+		logger: logger,
+	}
+}
+
+// Start reconciles the builds periodically until the context is canceled.
+func (r *Reconciler) Start(ctx context.Context) {
+	ticker := time.NewTicker(reconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := r.Reconcile(ctx)
+		if err != nil {
+			r.logger.Errorf("Error reconciling template builds: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile fails the interrupted builds and returns them, the failed scheduled rebuilds are recorded with their schedules.
+func (r *Reconciler) Reconcile(ctx context.Context) ([]*models.EnvBuild, error) {
+	failed, err := r.db.FailInterruptedEnvBuilds(ctx, time.Now().Add(-interruptedAfter))
+
+	for _, build := range failed {
+		r.logger.Warnf("Template build '%s' of '%s' was interrupted, marked as failed", build.ID, *build.EnvID)
+
+		statusErr := r.db.SetEnvRebuildStatus(ctx, *build.EnvID, build.ID, envrebuildschedule.LastBuildStatusFailed)
+		if statusErr != nil {
+			r.logger.Errorf("Error recording the interrupted rebuild '%s': %v", build.ID, statusErr)
+		}
+	}
+
+	return failed, err
+}
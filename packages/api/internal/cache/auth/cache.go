@@ -3,9 +3,11 @@ package autchcache
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jellydator/ttlcache/v3"
 	"golang.org/x/sync/singleflight"
 
@@ -16,14 +18,29 @@ import (
 const authInfoExpiration = 5 * time.Minute
 const refreshInterval = 1 * time.Minute
 
+const (
+	ScopeSecretsRead  = "secrets:read"
+	ScopeSecretsWrite = "secrets:write"
+	// ScopeSecretsUse allows attaching the secrets to the sandboxes and the templates without reading them.
+	ScopeSecretsUse = "secrets:use"
+)
+
 type AuthTeamInfo struct {
 	Team *models.Team
 	Tier *models.Tier
+	// Scopes of the API key, nil if the key isn't limited.
+	Scopes []string
+}
+
+// HasScope returns whether the API key has the scope, the keys without the scopes have all of them.
+func (t AuthTeamInfo) HasScope(scope string) bool {
+	return t.Scopes == nil || slices.Contains(t.Scopes, scope)
 }
 
 type TeamInfo struct {
-	team *models.Team
-	tier *models.Tier
+	team   *models.Team
+	tier   *models.Tier
+	scopes []string
 
 	lastRefresh time.Time
 	once        singleflight.Group
@@ -46,21 +63,21 @@ func NewTeamAuthCache(db *db.DB) *TeamAuthCache {
 }
 
 // TODO: save blocked teams to cache as well, handle the condition in the Get method
-func (c *TeamAuthCache) Get(ctx context.Context, apiKey string) (team *models.Team, tier *models.Tier, err error) {
+func (c *TeamAuthCache) Get(ctx context.Context, apiKey string) (team *models.Team, tier *models.Tier, scopes []string, err error) {
 	var item *ttlcache.Item[string, *TeamInfo]
 	var templateInfo *TeamInfo
 
 	item = c.cache.Get(apiKey)
 	if item == nil {
-		team, tier, err = c.db.GetTeamAuth(ctx, apiKey)
+		team, tier, scopes, err = c.db.GetTeamAuth(ctx, apiKey)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to get the team from db for an api key: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to get the team from db for an api key: %w", err)
 		}
 
-		templateInfo = &TeamInfo{team: team, tier: tier, lastRefresh: time.Now()}
+		templateInfo = &TeamInfo{team: team, tier: tier, scopes: scopes, lastRefresh: time.Now()}
 		c.cache.Set(apiKey, templateInfo, authInfoExpiration)
 
-		return team, tier, nil
+		return team, tier, scopes, nil
 	}
 
 	templateInfo = item.Value()
@@ -71,7 +88,7 @@ func (c *TeamAuthCache) Get(ctx context.Context, apiKey string) (team *models.Te
 		})
 	}
 
-	return templateInfo.team, templateInfo.tier, nil
+	return templateInfo.team, templateInfo.tier, templateInfo.scopes, nil
 }
 
 // Refresh refreshes the cache for the given team ID.
@@ -79,12 +96,26 @@ func (c *TeamAuthCache) Refresh(apiKey string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	team, tier, err := c.db.GetTeamAuth(ctx, apiKey)
+	team, tier, scopes, err := c.db.GetTeamAuth(ctx, apiKey)
 	if err != nil {
 		c.cache.Delete(apiKey)
 
 		return
 	}
 
-	c.cache.Set(apiKey, &TeamInfo{team: team, tier: tier, lastRefresh: time.Now()}, authInfoExpiration)
+	c.cache.Set(apiKey, &TeamInfo{team: team, tier: tier, scopes: scopes, lastRefresh: time.Now()}, authInfoExpiration)
+}
+
+// InvalidateTeam removes all cached API keys of the team.
+func (c *TeamAuthCache) InvalidateTeam(teamID uuid.UUID) {
+	for apiKey, item := range c.cache.Items() {
+		if item.Value().team.ID == teamID {
+			c.cache.Delete(apiKey)
+		}
+	}
+}
+
+// InvalidateAll removes all cached API keys.
+func (c *TeamAuthCache) InvalidateAll() {
+	c.cache.DeleteAll()
 }
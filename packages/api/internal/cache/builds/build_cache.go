@@ -3,6 +3,7 @@ package builds
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,11 +13,14 @@ import (
 	"go.opentelemetry.io/otel/metric"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
 )
 
 const (
 	buildInfoExpiration = time.Minute * 5 // 5 minutes
+
+	saveLogsTimeout = 30 * time.Second
 )
 
 type BuildInfo struct {
@@ -24,8 +28,14 @@ type BuildInfo struct {
 	teamID  uuid.UUID
 	status  api.TemplateBuildStatus
 	logs    []string
+	// Times the log lines were received at
+	loggedAt []time.Time
+	// cancel stops the build running in this API instance
+	cancel context.CancelFunc
 
 	mu sync.RWMutex
+	// saveMu serializes storing the logs, so the logs stored when the build is done aren't replaced by the older checkpoint.
+	saveMu sync.Mutex
 }
 
 func (b *BuildInfo) GetLogs() []string {
@@ -61,6 +71,38 @@ func (b *BuildInfo) addLog(log string) {
 	defer b.mu.Unlock()
 
 	b.logs = append(b.logs, log)
+	b.loggedAt = append(b.loggedAt, time.Now())
+}
+
+// logLines returns the lines of the log to store, the progress updates overwriting the previous line (ending with \r) are skipped.
+func (b *BuildInfo) logLines() []db.BuildLogLine {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	lines := make([]db.BuildLogLine, 0, len(b.logs))
+	for i, log := range b.logs {
+		if strings.HasSuffix(log, "\r") {
+			continue
+		}
+
+		lines = append(lines, db.BuildLogLine{Message: log, Timestamp: b.loggedAt[i]})
+	}
+
+	return lines
+}
+
+func (b *BuildInfo) setCancel(cancel context.CancelFunc) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.cancel = cancel
+}
+
+func (b *BuildInfo) getCancel() context.CancelFunc {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.cancel
 }
 
 func (b *BuildInfo) setStatus(status api.TemplateBuildStatus) {
@@ -73,11 +115,13 @@ func (b *BuildInfo) setStatus(status api.TemplateBuildStatus) {
 type BuildCache struct {
 	cache   *ttlcache.Cache[string, *BuildInfo]
 	counter metric.Int64UpDownCounter
+	db      *db.DB
 
 	mu sync.Mutex
 }
 
-func NewBuildCache() *BuildCache {
+// NewBuildCache returns the cache of the running builds, the logs of the finished builds are stored in the database.
+func NewBuildCache(dbClient *db.DB) *BuildCache {
 	cache := ttlcache.New(ttlcache.WithTTL[string, *BuildInfo](buildInfoExpiration))
 	counter, err := meters.GetUpDownCounter(meters.BuildCounterMeterName)
 	if err != nil {
@@ -89,6 +133,7 @@ func NewBuildCache() *BuildCache {
 	return &BuildCache{
 		cache:   cache,
 		counter: counter,
+		db:      dbClient,
 	}
 }
 
@@ -152,7 +197,36 @@ func (c *BuildCache) Create(envID string, buildID uuid.UUID, teamID uuid.UUID) e
 	return nil
 }
 
-// SetDone marks the build as finished.
+// SetCancel registers the function stopping the build running in this API instance.
+func (c *BuildCache) SetCancel(envID string, buildID uuid.UUID, cancel context.CancelFunc) error {
+	item, err := c.Get(envID, buildID)
+	if err != nil {
+		return fmt.Errorf("build %s not found in cache: %w", buildID, err)
+	}
+
+	item.setCancel(cancel)
+
+	return nil
+}
+
+// Cancel stops the build if it's running in this API instance, it returns false if the build isn't running here.
+func (c *BuildCache) Cancel(envID string, buildID uuid.UUID) bool {
+	item, err := c.Get(envID, buildID)
+	if err != nil || item.GetStatus() != api.TemplateBuildStatusBuilding {
+		return false
+	}
+
+	cancel := item.getCancel()
+	if cancel == nil {
+		return false
+	}
+
+	cancel()
+
+	return true
+}
+
+// SetDone marks the build as finished and stores its logs, so they are available after the build expires from the cache.
 func (c *BuildCache) SetDone(envID string, buildID uuid.UUID, status api.TemplateBuildStatus) error {
 	item, err := c.Get(envID, buildID)
 	if err != nil {
@@ -162,6 +236,39 @@ func (c *BuildCache) SetDone(envID string, buildID uuid.UUID, status api.Templat
 	item.setStatus(status)
 	c.updateCounter(envID, buildID, item.teamID, -1)
 
+	item.saveMu.Lock()
+	defer item.saveMu.Unlock()
+
+	return c.saveLogs(envID, item)
+}
+
+// Checkpoint stores the logs of the running build received so far, so they are available if the build is interrupted.
+func (c *BuildCache) Checkpoint(envID string, buildID uuid.UUID) error {
+	item, err := c.Get(envID, buildID)
+	if err != nil {
+		return fmt.Errorf("build %s not found in cache: %w", buildID, err)
+	}
+
+	item.saveMu.Lock()
+	defer item.saveMu.Unlock()
+
+	// The logs of the finished build were already stored
+	if item.GetStatus() != api.TemplateBuildStatusBuilding {
+		return nil
+	}
+
+	return c.saveLogs(envID, item)
+}
+
+func (c *BuildCache) saveLogs(envID string, item *BuildInfo) error {
+	ctx, cancel := context.WithTimeout(context.Background(), saveLogsTimeout)
+	defer cancel()
+
+	err := c.db.SaveEnvBuildLogs(ctx, item.GetTeamID(), envID, item.GetBuildID(), item.logLines())
+	if err != nil {
+		return fmt.Errorf("failed to store logs of build %s: %w", item.GetBuildID(), err)
+	}
+
 	return nil
 }
 
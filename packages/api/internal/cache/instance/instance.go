@@ -15,6 +15,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/node"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 )
 
 const (
@@ -28,6 +29,7 @@ type InstanceInfo struct {
 	TeamID             *uuid.UUID
 	BuildID            *uuid.UUID
 	Metadata           map[string]string
+	Labels             map[string]string
 	MaxInstanceLength  time.Duration
 	StartTime          time.Time
 	EndTime            time.Time
@@ -37,12 +39,16 @@ type InstanceInfo struct {
 	KernelVersion      string
 	FirecrackerVersion string
 	EnvdVersion        string
+	ReadinessProbe     *schema.ReadinessProbe
+	Hooks              *schema.LifecycleHooks
+	Hardening          *schema.HardeningPolicy
+	Egress             *schema.EgressPolicy
+	SecretRefs         []string
+	Priority           api.SandboxPriority
 	Node               *node.NodeInfo
 }
 
 type InstanceCache struct {
-	reservations *ReservationCache
-
 	cache *ttlcache.Cache[string, InstanceInfo]
 
 	logger *zap.SugaredLogger
@@ -82,7 +88,6 @@ func NewCache(
 		analytics:      analytics,
 		sandboxCounter: sandboxCounter,
 		createdCounter: createdCounter,
-		reservations:   NewReservationCache(),
 	}
 
 	cache.OnInsertion(func(ctx context.Context, i *ttlcache.Item[string, InstanceInfo]) {
@@ -1,6 +1,7 @@
 package instance
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -8,6 +9,9 @@ import (
 	"github.com/jellydator/ttlcache/v3"
 )
 
+// ErrInstanceChanged is returned when the instance was changed since the caller read it.
+var ErrInstanceChanged = errors.New("instance was changed")
+
 func (c *InstanceCache) Count() int {
 	return c.cache.Len()
 }
@@ -108,9 +112,6 @@ func (c *InstanceCache) Add(instance InstanceInfo, newlyCreated bool) error {
 	c.cache.Set(instance.Instance.SandboxID, instance, ttl)
 	c.UpdateCounters(instance, 1, newlyCreated)
 
-	// Release the reservation if it exists
-	c.reservations.release(instance.Instance.SandboxID)
-
 	return nil
 }
 
@@ -128,3 +129,40 @@ func (c *InstanceCache) Items() (infos []InstanceInfo) {
 
 	return infos
 }
+
+// UpdateLabels replaces the labels of the instance, the expiration is kept. If the precondition is set, the labels are replaced
+// only if it returns true for the current instance, the error is ErrInstanceChanged otherwise. The precondition and the update
+// are atomic with the other updates of the instance.
+func (c *InstanceCache) UpdateLabels(instanceID string, labels map[string]string, precondition func(InstanceInfo) (bool, error)) (*InstanceInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, err := c.Get(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	instance := item.Value()
+
+	if precondition != nil {
+		ok, err := precondition(instance)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("instance \"%s\": %w", instanceID, ErrInstanceChanged)
+		}
+	}
+
+	instance.Labels = labels
+
+	ttl := time.Until(item.ExpiresAt())
+	if ttl <= 0 {
+		return nil, fmt.Errorf("instance \"%s\" has already expired", instanceID)
+	}
+
+	c.cache.Set(instanceID, instance, ttl)
+
+	return &instance, nil
+}
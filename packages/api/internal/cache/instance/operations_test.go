@@ -0,0 +1,80 @@
+package instance
+
+import (
+	"errors"
+	"maps"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+)
+
+func TestUpdateLabelsPreconditionIsAtomic(t *testing.T) {
+	c := &InstanceCache{cache: ttlcache.New[string, InstanceInfo]()}
+	c.cache.Set("sbx", InstanceInfo{
+		Instance: &api.Sandbox{SandboxID: "sbx"},
+		Labels:   map[string]string{"version": "1"},
+	}, time.Minute)
+
+	// Both updates read the version 1, only one of them may replace it
+	unchanged := func(current InstanceInfo) (bool, error) {
+		return current.Labels["version"] == "1", nil
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		updated int
+		changed int
+	)
+
+	for i := range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			labels := map[string]string{"version": "2", "writer": string(rune('a' + i))}
+
+			_, err := c.UpdateLabels("sbx", labels, unchanged)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if errors.Is(err, ErrInstanceChanged) {
+				changed++
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			} else {
+				updated++
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if updated != 1 || changed != 1 {
+		t.Fatalf("expected 1 update and 1 precondition failure, got %d updates and %d failures", updated, changed)
+	}
+}
+
+func TestUpdateLabelsWithoutPrecondition(t *testing.T) {
+	c := &InstanceCache{cache: ttlcache.New[string, InstanceInfo]()}
+	c.cache.Set("sbx", InstanceInfo{
+		Instance: &api.Sandbox{SandboxID: "sbx"},
+		Labels:   map[string]string{"version": "1"},
+	}, time.Minute)
+
+	labels := map[string]string{"version": "2"}
+
+	info, err := c.UpdateLabels("sbx", labels, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !maps.Equal(info.Labels, labels) {
+		t.Fatalf("expected labels %v, got %v", labels, info.Labels)
+	}
+}
@@ -21,6 +21,10 @@ func getMaxAllowedTTL(now time.Time, startTime time.Time, duration, maxInstanceL
 
 // KeepAliveFor the instance's expiration timer.
 func (c *InstanceCache) KeepAliveFor(instanceID string, duration time.Duration, allowShorter bool) (*InstanceInfo, error) {
+	// The instance is replaced in the cache, so the concurrent update of the labels isn't lost
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	item, err := c.Get(instanceID)
 	if err != nil {
 		return nil, err
@@ -51,6 +55,27 @@ func (c *InstanceCache) KeepAliveFor(instanceID string, duration time.Duration,
 	return &instance, nil
 }
 
+// SyncRemoved removes the instance that stopped running on the node, the same as Sync when the instance is missing.
+func (c *InstanceCache) SyncRemoved(instanceID string, nodeID string) {
+	item, err := c.Get(instanceID)
+	if err != nil {
+		return
+	}
+
+	if item.Value().Instance.ClientID == nodeID {
+		c.cache.Delete(instanceID)
+	}
+}
+
+// SyncAdded adds the instance that started running on the node if it's not in the cache yet, the same as Sync.
+func (c *InstanceCache) SyncAdded(instance *InstanceInfo) error {
+	if c.Exists(instance.Instance.SandboxID) {
+		return nil
+	}
+
+	return c.Add(*instance, false)
+}
+
 func (c *InstanceCache) Sync(instances []*InstanceInfo, nodeID string) {
 	instanceMap := make(map[string]*InstanceInfo)
 
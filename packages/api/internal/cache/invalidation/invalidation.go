@@ -0,0 +1,91 @@
+package invalidation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+)
+
+const retryInterval = 10 * time.Second
+
+// Listener keeps the API caches coherent with the database changes made by the other API instances.
+type Listener struct {
+	authCache     *authcache.TeamAuthCache
+	templateCache *templatecache.TemplateCache
+	logger        *zap.SugaredLogger
+	lag           metric.Float64Histogram
+}
+
+func NewListener(authCache *authcache.TeamAuthCache, templateCache *templatecache.TemplateCache, logger *zap.SugaredLogger) *Listener {
+	lag, err := meters.GetHistogram(meters.CacheInvalidationLagMeterName)
+	if err != nil {
+		logger.Errorw("error getting histogram", "error", err)
+	}
+
+	return &Listener{
+		authCache:     authCache,
+		templateCache: templateCache,
+		logger:        logger,
+		lag:           lag,
+	}
+}
+
+// Start listens for the database changes until the context is canceled.
+func (l *Listener) Start(ctx context.Context) {
+	for {
+		err := db.ListenCacheInvalidations(ctx, l.invalidate)
+		if err == nil {
+			return
+		}
+
+		l.logger.Errorf("Error listening for cache invalidations: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (l *Listener) invalidate(invalidation db.CacheInvalidation) {
+	switch invalidation.Table {
+	case "":
+		l.logger.Info("Cache invalidations could have been lost, invalidating all caches")
+
+		l.authCache.InvalidateAll()
+		l.templateCache.InvalidateAll()
+
+		return
+	case "tiers":
+		l.authCache.InvalidateAll()
+	case "teams", "team_api_keys":
+		teamID, err := uuid.Parse(invalidation.Key)
+		if err != nil {
+			l.logger.Errorf("Error parsing team ID '%s' for cache invalidation: %v", invalidation.Key, err)
+
+			return
+		}
+
+		l.authCache.InvalidateTeam(teamID)
+	case "envs", "env_aliases", "env_builds":
+		l.templateCache.Invalidate(invalidation.Key)
+	default:
+		return
+	}
+
+	if l.lag != nil {
+		l.lag.Record(context.Background(), float64(time.Since(invalidation.Time()).Milliseconds()),
+			metric.WithAttributes(attribute.String("table", invalidation.Table)),
+		)
+	}
+}
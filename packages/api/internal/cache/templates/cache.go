@@ -12,6 +12,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 )
 
 const templateInfoExpiration = 5 * time.Minute
@@ -20,6 +21,8 @@ type TemplateInfo struct {
 	template *api.Template
 	teamID   uuid.UUID
 	build    *models.EnvBuild
+	// Resource variants of the build
+	variants []*models.EnvBuild
 }
 
 type AliasCache struct {
@@ -46,6 +49,15 @@ func (c *AliasCache) Get(alias string) (templateID string, found bool) {
 	return item.Value(), true
 }
 
+// invalidate removes all aliases of the template
+func (c *AliasCache) invalidate(templateID string) {
+	for alias, item := range c.cache.Items() {
+		if item.Value() == templateID {
+			c.cache.Delete(alias)
+		}
+	}
+}
+
 type TemplateCache struct {
 	cache      *ttlcache.Cache[string, *TemplateInfo]
 	db         *db.DB
@@ -65,7 +77,35 @@ func NewTemplateCache(db *db.DB) *TemplateCache {
 }
 
 func (c *TemplateCache) Get(ctx context.Context, aliasOrEnvID string, teamID uuid.UUID, public bool) (env *api.Template, build *models.EnvBuild, apiErr *api.APIError) {
+	templateInfo, apiErr := c.get(ctx, aliasOrEnvID, teamID, public)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	return templateInfo.template, templateInfo.build, nil
+}
+
+// GetVariant returns the template with the build of its resource variant, the variant has to be built with the last build of the template.
+func (c *TemplateCache) GetVariant(ctx context.Context, aliasOrEnvID string, teamID uuid.UUID, public bool, variant string) (env *api.Template, build *models.EnvBuild, apiErr *api.APIError) {
+	templateInfo, apiErr := c.get(ctx, aliasOrEnvID, teamID, public)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	for _, b := range templateInfo.variants {
+		if b.Variant != nil && *b.Variant == variant && b.Status == envbuild.StatusUploaded {
+			return templateInfo.template, b, nil
+		}
+	}
+
+	err := fmt.Errorf("variant '%s' of the template '%s' not found in build '%s'", variant, aliasOrEnvID, templateInfo.build.ID)
+
+	return nil, nil, &api.APIError{Code: http.StatusBadRequest, ClientMsg: fmt.Sprintf("Variant '%s' isn't built with the last build of the template '%s'", variant, aliasOrEnvID), Err: err}
+}
+
+func (c *TemplateCache) get(ctx context.Context, aliasOrEnvID string, teamID uuid.UUID, public bool) (*TemplateInfo, *api.APIError) {
 	var envDB *db.Template
+	var build *models.EnvBuild
 	var item *ttlcache.Item[string, *TemplateInfo]
 	var templateInfo *TemplateInfo
 	var err error
@@ -79,9 +119,9 @@ func (c *TemplateCache) Get(ctx context.Context, aliasOrEnvID string, teamID uui
 		envDB, build, err = c.db.GetEnv(ctx, aliasOrEnvID)
 		if err != nil {
 			if models.IsNotFound(err) == true {
-				return nil, nil, &api.APIError{Code: http.StatusNotFound, ClientMsg: fmt.Sprintf("template '%s' not found", aliasOrEnvID), Err: err}
+				return nil, &api.APIError{Code: http.StatusNotFound, ClientMsg: fmt.Sprintf("template '%s' not found", aliasOrEnvID), Err: err}
 			}
-			return nil, nil, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: fmt.Sprintf("error while getting template: %v", err), Err: err}
+			return nil, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: fmt.Sprintf("error while getting template: %v", err), Err: err}
 		}
 
 		c.aliasCache.cache.Set(envDB.TemplateID, envDB.TemplateID, templateInfoExpiration)
@@ -93,7 +133,12 @@ func (c *TemplateCache) Get(ctx context.Context, aliasOrEnvID string, teamID uui
 
 		// Check if the team has access to the environment
 		if envDB.TeamID != teamID && (!public || !envDB.Public) {
-			return nil, nil, &api.APIError{Code: http.StatusForbidden, ClientMsg: fmt.Sprintf("Team  '%s' does not have access to the template '%s'", teamID, aliasOrEnvID), Err: fmt.Errorf("team  '%s' does not have access to the template '%s'", teamID, aliasOrEnvID)}
+			return nil, &api.APIError{Code: http.StatusForbidden, ClientMsg: fmt.Sprintf("Team  '%s' does not have access to the template '%s'", teamID, aliasOrEnvID), Err: fmt.Errorf("team  '%s' does not have access to the template '%s'", teamID, aliasOrEnvID)}
+		}
+
+		variants, err := c.db.GetEnvBuildVariants(ctx, build.ID)
+		if err != nil {
+			return nil, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: fmt.Sprintf("error while getting template: %v", err), Err: err}
 		}
 
 		templateInfo = &TemplateInfo{template: &api.Template{
@@ -101,21 +146,32 @@ func (c *TemplateCache) Get(ctx context.Context, aliasOrEnvID string, teamID uui
 			BuildID:    build.ID.String(),
 			Public:     envDB.Public,
 			Aliases:    envDB.Aliases,
-		}, teamID: teamID, build: build}
+		}, teamID: teamID, build: build, variants: variants}
+
+		if len(envDB.AllowedRegions) > 0 {
+			templateInfo.template.AllowedRegions = &envDB.AllowedRegions
+		}
 
 		c.cache.Set(envDB.TemplateID, templateInfo, templateInfoExpiration)
 	} else {
 		templateInfo = item.Value()
 
 		if templateInfo.teamID != teamID && !templateInfo.template.Public {
-			return nil, nil, &api.APIError{Code: http.StatusForbidden, ClientMsg: fmt.Sprintf("Team  '%s' does not have access to the template '%s'", teamID, aliasOrEnvID), Err: fmt.Errorf("team  '%s' does not have access to the template '%s'", teamID, aliasOrEnvID)}
+			return nil, &api.APIError{Code: http.StatusForbidden, ClientMsg: fmt.Sprintf("Team  '%s' does not have access to the template '%s'", teamID, aliasOrEnvID), Err: fmt.Errorf("team  '%s' does not have access to the template '%s'", teamID, aliasOrEnvID)}
 		}
 	}
 
-	return templateInfo.template, templateInfo.build, nil
+	return templateInfo, nil
 }
 
 // Invalidate invalidates the cache for the given templateID
 func (c *TemplateCache) Invalidate(templateID string) {
 	c.cache.Delete(templateID)
+	c.aliasCache.invalidate(templateID)
+}
+
+// InvalidateAll invalidates the cache for all templates
+func (c *TemplateCache) InvalidateAll() {
+	c.cache.DeleteAll()
+	c.aliasCache.cache.DeleteAll()
 }
@@ -2,14 +2,18 @@ package dns
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/jellydator/ttlcache/v3"
 	resolver "github.com/miekg/dns"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 )
@@ -18,31 +22,137 @@ const ttl = 0
 
 const defaultRoutingIP = "127.0.0.1"
 
+const (
+	redisKeyPrefix = "dns:"
+	redisTimeout   = 500 * time.Millisecond
+
+	// cacheTTL is how long the record fetched from Redis is served without asking Redis again.
+	cacheTTL = time.Second
+	// staleTTL is how long the record is kept after it expired, it's served if Redis is not reachable.
+	staleTTL = 5 * time.Minute
+)
+
+// removeScript deletes the record only if it still points to the IP, the sandbox could have been resumed on another node.
+var removeScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type cachedRecord struct {
+	ip        string
+	found     bool
+	fetchedAt time.Time
+}
+
+// DNS resolves the sandbox hostnames to the node IPs.
+// The records added to this instance are resolved locally, the others are resolved from Redis (if configured),
+// so the DNS server can run as an independent replicated component without its own records.
 type DNS struct {
 	mu      sync.Mutex
 	redis   *redis.Client
 	records *smap.Map[string]
+
+	cache   *ttlcache.Cache[string, cachedRecord]
+	lookups singleflight.Group
 }
 
 func New(rc *redis.Client) *DNS {
+	cache := ttlcache.New(ttlcache.WithTTL[string, cachedRecord](cacheTTL + staleTTL))
+	go cache.Start()
+
 	return &DNS{
 		redis:   rc,
 		records: smap.New[string](),
+		cache:   cache,
 	}
 }
 
-func (d *DNS) Add(_ context.Context, sandboxID, ip string) {
-	d.records.Insert(d.hostname(sandboxID), ip)
+func (d *DNS) Add(ctx context.Context, sandboxID, ip string) {
+	hostname := d.hostname(sandboxID)
+
+	d.records.Insert(hostname, ip)
+
+	if d.redis == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	err := d.redis.Set(ctx, redisKeyPrefix+hostname, ip, 0).Err()
+	if err != nil {
+		log.Printf("Failed to add DNS record for '%s' to Redis: %s\n", sandboxID, err.Error())
+	}
 }
 
-func (d *DNS) Remove(_ context.Context, sandboxID, ip string) {
-	d.records.RemoveCb(d.hostname(sandboxID), func(key string, v string, exists bool) bool {
+func (d *DNS) Remove(ctx context.Context, sandboxID, ip string) {
+	hostname := d.hostname(sandboxID)
+
+	d.records.RemoveCb(hostname, func(key string, v string, exists bool) bool {
 		return v == ip
 	})
+
+	if d.redis == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	err := removeScript.Run(ctx, d.redis, []string{redisKeyPrefix + hostname}, ip).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("Failed to remove DNS record for '%s' from Redis: %s\n", sandboxID, err.Error())
+	}
 }
 
 func (d *DNS) get(hostname string) (string, bool) {
-	return d.records.Get(hostname)
+	ip, found := d.records.Get(hostname)
+	if found || d.redis == nil {
+		return ip, found
+	}
+
+	item := d.cache.Get(hostname, ttlcache.WithDisableTouchOnHit[string, cachedRecord]())
+	if item != nil && time.Since(item.Value().fetchedAt) < cacheTTL {
+		return item.Value().ip, item.Value().found
+	}
+
+	record, err, _ := d.lookups.Do(hostname, func() (interface{}, error) {
+		return d.fetch(hostname)
+	})
+	if err != nil {
+		if item != nil {
+			// Serve the stale record, it's most likely still valid
+			return item.Value().ip, item.Value().found
+		}
+
+		log.Printf("Failed to resolve '%s' from Redis: %s\n", hostname, err.Error())
+
+		return "", false
+	}
+
+	return record.(cachedRecord).ip, record.(cachedRecord).found
+}
+
+func (d *DNS) fetch(hostname string) (cachedRecord, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	ip, err := d.redis.Get(ctx, redisKeyPrefix+hostname).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return cachedRecord{}, err
+	}
+
+	record := cachedRecord{
+		ip:        ip,
+		found:     err == nil,
+		fetchedAt: time.Now(),
+	}
+
+	d.cache.Set(hostname, record, ttlcache.DefaultTTL)
+
+	return record, nil
 }
 
 func (*DNS) hostname(sandboxID string) string {
@@ -5,14 +5,21 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/grafana/loki/pkg/loghttp"
+	"github.com/grafana/loki/pkg/logproto"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
+// proxyServerErrorsWindow is how far back the 5xx responses of the client proxies for the sandbox are counted.
+const proxyServerErrorsWindow = "15m"
+
 func (a *APIStore) GetNodes(c *gin.Context) {
 	nodes := a.orchestrator.GetNodes()
 
@@ -57,3 +64,170 @@ func (a *APIStore) PostNodesNodeID(c *gin.Context, nodeId api.NodeID) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// GetSandboxesSandboxIDRouting returns where the traffic of the sandbox is routed, for debugging the errors of the sandbox URL.
+func (a *APIStore) GetSandboxesSandboxIDRouting(c *gin.Context, sandboxID api.SandboxID) {
+	clientID, _ := getSandboxIDClient(sandboxID)
+	sandboxID = utils.ShortID(sandboxID)
+
+	ctx := c.Request.Context()
+
+	routing := a.orchestrator.GetSandboxRouting(ctx, sandboxID, clientID)
+
+	result := api.SandboxRouting{
+		SandboxID: sandboxID,
+		Running:   routing.Sandbox != nil,
+	}
+
+	if info := routing.Sandbox; info != nil {
+		sbx := api.RunningSandbox{
+			ClientID:   info.Instance.ClientID,
+			TemplateID: info.Instance.TemplateID,
+			Alias:      info.Instance.Alias,
+			SandboxID:  info.Instance.SandboxID,
+			StartedAt:  info.StartTime,
+			CpuCount:   api.CPUCount(info.VCpu),
+			MemoryMB:   api.MemoryMB(info.RamMB),
+			EndAt:      info.EndTime,
+		}
+
+		if info.Metadata != nil {
+			meta := api.SandboxMetadata(info.Metadata)
+			sbx.Metadata = &meta
+		}
+
+		if info.Labels != nil {
+			labels := api.SandboxLabels(info.Labels)
+			sbx.Labels = &labels
+		}
+
+		result.Sandbox = &sbx
+
+		if info.TeamID != nil {
+			teamID := info.TeamID.String()
+			result.TeamID = &teamID
+		}
+
+		if info.BuildID != nil {
+			buildID := info.BuildID.String()
+			result.BuildID = &buildID
+		}
+	}
+
+	if routing.Node != nil {
+		node := api.SandboxRoutingNode{
+			NodeID:  routing.Node.Info.ID,
+			Address: routing.Node.Info.OrchestratorAddress,
+			Status:  routing.Node.Status(),
+		}
+
+		if health := routing.Health; health != nil {
+			node.LastHealthCheck = &api.NodeHealthCheck{
+				Healthy:   health.Err == nil,
+				CheckedAt: health.CheckedAt,
+				LatencyMs: health.Latency.Milliseconds(),
+			}
+
+			if health.Err != nil {
+				message := health.Err.Error()
+				node.LastHealthCheck.Error = &message
+			}
+		}
+
+		result.Node = &node
+	}
+
+	proxyErrors, err := a.countProxyServerErrors(sandboxID)
+	if err != nil {
+		telemetry.ReportError(ctx, fmt.Errorf("failed to count client proxy errors of sandbox '%s': %w", sandboxID, err))
+	} else {
+		result.ProxyServerErrors = &proxyErrors
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// countProxyServerErrors returns the number of the 5xx responses the client proxies returned for the sandbox recently,
+// the logs collector ships the 5xx responses from the access logs of the proxies to Loki.
+func (a *APIStore) countProxyServerErrors(sandboxID string) (int64, error) {
+	// Sanitize ID
+	// https://grafana.com/blog/2021/01/05/how-to-escape-special-characters-with-lokis-logql/
+	id := strings.ReplaceAll(sandboxID, "`", "")
+
+	query := fmt.Sprintf("sum(count_over_time({source=\"client-proxy\", sandboxID=`%s`}[%s]))", id, proxyServerErrorsWindow)
+
+	res, err := a.lokiClient.Query(query, 1, time.Now(), logproto.BACKWARD, true)
+	if err != nil {
+		return 0, err
+	}
+
+	vector, ok := res.Data.Result.(loghttp.Vector)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value type %s", res.Data.Result.Type())
+	}
+
+	// There is no sample when the proxies didn't log any error for the sandbox
+	if len(vector) == 0 {
+		return 0, nil
+	}
+
+	return int64(vector[0].Value), nil
+}
+
+// GetStatus returns the health of the cluster components from their last check, the components are checked now if they weren't checked yet.
+func (a *APIStore) GetStatus(c *gin.Context) {
+	s := a.status.Status()
+	if s == nil {
+		s = a.status.Check(c.Request.Context())
+	}
+
+	components := make([]api.ComponentStatus, len(s.Components))
+	for i, component := range s.Components {
+		components[i] = api.ComponentStatus{
+			Name:        component.Name,
+			Kind:        api.ComponentKind(component.Kind),
+			Status:      api.ComponentHealth(component.Health),
+			LatencyMs:   component.Latency.Milliseconds(),
+			CheckedAt:   component.CheckedAt,
+			LastErrorAt: component.LastErrorAt,
+		}
+
+		if component.Message != "" {
+			components[i].Message = &component.Message
+		}
+
+		if component.LastError != "" {
+			components[i].LastError = &component.LastError
+		}
+	}
+
+	c.JSON(http.StatusOK, api.ClusterStatus{
+		Status:     api.ComponentHealth(s.Health),
+		CheckedAt:  s.CheckedAt,
+		Components: components,
+	})
+}
+
+// PostBuildReconciliation fails the interrupted template builds now instead of waiting for the periodic reconciliation.
+func (a *APIStore) PostBuildReconciliation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	failed, err := a.buildReconciler.Reconcile(ctx)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error reconciling template builds: %s", err))
+
+		return
+	}
+
+	result := make([]api.ReconciledTemplateBuild, 0, len(failed))
+	for _, build := range failed {
+		result = append(result, api.ReconciledTemplateBuild{
+			TemplateID:  *build.EnvID,
+			BuildID:     build.ID.String(),
+			HeartbeatAt: build.HeartbeatAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	defaultBuildLogsSearchLimit = 100
+
+	// exportBucketTeamLabel is the label of the customer's bucket with the ID of the team the bucket belongs to,
+	// the logs are exported only to the buckets of the team, so the team can't write to the buckets of the other customers.
+	exportBucketTeamLabel = "e2b-team-id"
+)
+
+// GetBuildLogs searches the stored build logs of the team's templates
+func (a *APIStore) GetBuildLogs(c *gin.Context, params api.GetBuildLogsParams) {
+	ctx := c.Request.Context()
+
+	team, ok := a.getRequestedTeam(c, params.TeamID)
+	if !ok {
+		return
+	}
+
+	limit := defaultBuildLogsSearchLimit
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+
+	logs, err := a.db.SearchEnvBuildLogs(ctx, team.ID, db.SearchEnvBuildLogsOptions{
+		Query:        params.Query,
+		EnvID:        params.TemplateID,
+		CreatedAfter: params.CreatedAfter,
+		Limit:        limit,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when searching build logs")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when searching build logs: %w", err))
+
+		return
+	}
+
+	result := make([]api.BuildLogEntry, len(logs))
+	for i, log := range logs {
+		result[i] = buildLogToAPI(log)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// PostTemplatesTemplateIDBuildsBuildIDLogsExport exports the stored logs of the build to the customer's bucket
+func (a *APIStore) PostTemplatesTemplateIDBuildsBuildIDLogsExport(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.BuildLogsExportRequest](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+
+		return
+	}
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+
+		telemetry.ReportError(ctx, fmt.Errorf("error when parsing build id: %w", err))
+
+		return
+	}
+
+	userID, teams, err := a.GetUserAndTeams(c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting teams")
+
+		err = fmt.Errorf("error when getting teams: %w", err)
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	build, err := a.db.Client.EnvBuild.Query().Where(envbuild.ID(buildUUID), envbuild.EnvID(templateID)).WithEnv().Only(ctx)
+	if models.IsNotFound(err) {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.BuildNotFound, fmt.Sprintf("Build (%s) not found", buildID))
+
+		return
+	} else if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting build")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting build: %w", err))
+
+		return
+	}
+
+	var team *models.Team
+	for _, t := range teams {
+		if t.ID == build.Edges.Env.TeamID {
+			team = t
+			break
+		}
+	}
+
+	if team == nil {
+		telemetry.ReportError(ctx, fmt.Errorf("user '%s' doesn't have access to env '%s'", userID, templateID))
+
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to this sandbox template (%s)", templateID))
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("user.id", userID.String()),
+		attribute.String("team.id", team.ID.String()),
+		attribute.String("env.id", templateID),
+		attribute.String("build.id", buildID),
+	)
+
+	bucket := gcs.NewBucket(body.Bucket)
+
+	attrs, err := bucket.Attrs(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when getting bucket '%s', the service account of the API needs access to the bucket", body.Bucket))
+
+		telemetry.ReportError(ctx, fmt.Errorf("error when getting export bucket: %w", err))
+
+		return
+	}
+
+	if attrs.Labels[exportBucketTeamLabel] != team.ID.String() {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("The bucket '%s' must have the '%s' label with the ID of the team", body.Bucket, exportBucketTeamLabel))
+
+		return
+	}
+
+	logs, err := a.db.GetEnvBuildLogs(ctx, buildUUID, 0)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting build logs")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting build logs: %w", err))
+
+		return
+	}
+
+	if len(logs) == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("The build (%s) has no stored logs, the logs are stored when the build finishes", buildID))
+
+		return
+	}
+
+	var content bytes.Buffer
+
+	encoder := json.NewEncoder(&content)
+	for _, log := range logs {
+		err = encoder.Encode(buildLogToAPI(log))
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when encoding build logs")
+
+			telemetry.ReportCriticalError(ctx, fmt.Errorf("error when encoding build logs: %w", err))
+
+			return
+		}
+	}
+
+	var prefix string
+	if body.Prefix != nil {
+		prefix = *body.Prefix
+	}
+
+	objectName := path.Join(prefix, templateID, buildID+".ndjson")
+
+	_, err = gcs.NewObject(ctx, bucket, objectName).ReadFrom(&content)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when writing to bucket '%s', the service account of the API needs to be allowed to create objects in it", body.Bucket))
+
+		telemetry.ReportError(ctx, fmt.Errorf("error when exporting build logs: %w", err))
+
+		return
+	}
+
+	a.logger.Infof("Exported logs of build '%s' of template '%s' to bucket '%s'", buildID, templateID, body.Bucket)
+
+	c.JSON(http.StatusOK, api.BuildLogsExport{
+		Bucket: body.Bucket,
+		Object: objectName,
+		Lines:  int32(len(logs)),
+	})
+}
+
+func buildLogToAPI(log *models.EnvBuildLog) api.BuildLogEntry {
+	return api.BuildLogEntry{
+		TemplateID: log.EnvID,
+		BuildID:    log.BuildID.String(),
+		Line:       log.Line,
+		Message:    log.Message,
+		Timestamp:  log.CreatedAt,
+	}
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/egress"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+// resolveEgress resolves the preset of the request into the policy enforced in the sandboxes.
+func resolveEgress(policy *api.EgressPolicy) (*schema.EgressPolicy, error) {
+	var allowedHosts []string
+	if policy.AllowedHosts != nil {
+		allowedHosts = *policy.AllowedHosts
+	}
+
+	return egress.Resolve(string(policy.Preset), allowedHosts)
+}
+
+func (a *APIStore) GetEgressPresets(c *gin.Context) {
+	presets := egress.Presets()
+
+	result := make([]api.EgressPreset, 0, len(presets))
+	for _, preset := range presets {
+		allowedHosts := preset.AllowedHosts
+		if allowedHosts == nil {
+			allowedHosts = []string{}
+		}
+
+		result = append(result, api.EgressPreset{
+			Name:         api.EgressPresetName(preset.Name),
+			Description:  preset.Description,
+			AllowAll:     preset.AllowAll,
+			AllowedHosts: allowedHosts,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
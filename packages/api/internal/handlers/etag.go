@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+// ETagHeader is the response header with the version of the returned resource, the client sends it back in the If-Match header.
+const ETagHeader = "ETag"
+
+// etag returns the strong entity tag of the fields the client can change, so the tag changes only when the resource is changed.
+func etag(fields ...any) (string, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal etag fields: %w", err)
+	}
+
+	hash := sha256.Sum256(data)
+
+	return `"` + hex.EncodeToString(hash[:16]) + `"`, nil
+}
+
+// secretETag returns the entity tag of the secret, the versions of the secret are increasing, so the version is the tag.
+func secretETag(version int32) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// templateETag returns the entity tag of the template, it changes with the new build and with the update of the template.
+func templateETag(template *db.Template) (string, error) {
+	return etag(template.TemplateID, template.BuildID, template.Public, template.Aliases, template.VCPU, template.RAMMB, template.AllowedRegions)
+}
+
+// getTemplateETag returns the current entity tag of the template, it's empty if the template has no finished build.
+func (a *APIStore) getTemplateETag(ctx context.Context, templateID string) (string, error) {
+	template, _, err := a.db.GetEnv(ctx, templateID)
+	if models.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return templateETag(template)
+}
+
+// sandboxETag returns the entity tag of the running sandbox.
+func sandboxETag(templateID string, metadata, labels map[string]string) (string, error) {
+	return etag(templateID, metadata, labels)
+}
+
+// matchesIfMatch returns true if the current entity tag is in the If-Match header value, the empty current tag means the resource doesn't exist.
+func matchesIfMatch(ifMatch string, current string) bool {
+	if current == "" {
+		return false
+	}
+
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == current {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkIfMatch sends the 412 error if the If-Match header is set and it doesn't match the current entity tag of the resource.
+func (a *APIStore) checkIfMatch(c *gin.Context, ifMatch *string, current string) bool {
+	if ifMatch == nil || matchesIfMatch(*ifMatch, current) {
+		return true
+	}
+
+	a.sendPreconditionFailed(c)
+
+	return false
+}
+
+// sendPreconditionFailed sends the 412 error, also when the resource was changed between the check of If-Match and the write.
+func (a *APIStore) sendPreconditionFailed(c *gin.Context) {
+	a.sendAPIStoreErrorCode(c, http.StatusPreconditionFailed, errcode.PreconditionFailed, "The resource was changed since it was read, read it again and retry the request")
+}
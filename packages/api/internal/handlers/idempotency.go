@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header with the key of the request the client can safely retry.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on the response replayed for the retried request.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	idempotencyKeyTTL = 24 * time.Hour
+	// idempotencyInProgressTimeout is how long the request can be in progress, the key of the request that never finished,
+	// e.g. because the API replica was restarted, can be used again after it.
+	idempotencyInProgressTimeout = 10 * time.Minute
+)
+
+// idempotentWriter keeps the response body, so it can be replayed for the retried request.
+type idempotentWriter struct {
+	gin.ResponseWriter
+
+	body bytes.Buffer
+}
+
+func (w *idempotentWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *idempotentWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+
+	return w.ResponseWriter.WriteString(s)
+}
+
+// startIdempotentRequest claims the idempotency key of the request for the team or the user, if the key is set.
+// The response of the request with the key used before is replayed and false is returned, the request must not be processed then.
+// The returned function must be called when the request is processed, only the successful responses are kept,
+// so the failed or delayed (202) request is processed again when it's retried.
+func (a *APIStore) startIdempotentRequest(c *gin.Context, ownerID uuid.UUID, key *string) (func(), bool) {
+	if key == nil {
+		return func() {}, true
+	}
+
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when reading request: %s", err))
+
+		return nil, false
+	}
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	hash := sha256.New()
+	hash.Write([]byte(c.Request.Method + " " + c.Request.URL.Path + "\n"))
+	hash.Write(body)
+	requestHash := hex.EncodeToString(hash.Sum(nil))
+
+	record, claimed, err := a.db.ClaimIdempotencyKey(ctx, ownerID, *key, requestHash, time.Now().Add(idempotencyKeyTTL))
+	if err == nil && !claimed && record.StatusCode == nil && time.Since(record.CreatedAt) > idempotencyInProgressTimeout {
+		err = a.db.DeleteIdempotencyKey(ctx, record.ID)
+		if err == nil {
+			record, claimed, err = a.db.ClaimIdempotencyKey(ctx, ownerID, *key, requestHash, time.Now().Add(idempotencyKeyTTL))
+		}
+	}
+
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when checking the idempotency key")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when claiming idempotency key: %w", err))
+
+		return nil, false
+	}
+
+	if !claimed {
+		switch {
+		case record.RequestHash != requestHash:
+			a.sendAPIStoreErrorCode(c, http.StatusConflict, errcode.IdempotencyKeyReused, fmt.Sprintf("Idempotency key '%s' was already used for a different request", *key))
+		case record.StatusCode == nil:
+			a.sendAPIStoreErrorCode(c, http.StatusConflict, errcode.IdempotencyKeyInProgress, fmt.Sprintf("Request with the idempotency key '%s' is still in progress", *key))
+		default:
+			telemetry.ReportEvent(ctx, "replayed idempotent request")
+
+			c.Header(IdempotentReplayedHeader, "true")
+			if len(record.Response) == 0 {
+				c.Status(*record.StatusCode)
+			} else {
+				c.Data(*record.StatusCode, "application/json; charset=utf-8", record.Response)
+			}
+		}
+
+		return nil, false
+	}
+
+	writer := &idempotentWriter{ResponseWriter: c.Writer}
+	c.Writer = writer
+
+	return func() {
+		// The key is stored even if the client has disconnected, the client retries the request after the timeout
+		ctx := context.WithoutCancel(ctx)
+
+		status := writer.Status()
+		if status < 200 || status >= 300 || status == http.StatusAccepted {
+			err := a.db.DeleteIdempotencyKey(ctx, record.ID)
+			if err != nil {
+				telemetry.ReportError(ctx, fmt.Errorf("error when deleting idempotency key: %w", err))
+			}
+
+			return
+		}
+
+		err := a.db.CompleteIdempotencyKey(ctx, record.ID, status, writer.body.Bytes())
+		if err != nil {
+			telemetry.ReportError(ctx, fmt.Errorf("error when completing idempotency key: %w", err))
+		}
+	}, true
+}
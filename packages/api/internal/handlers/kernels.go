@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func kernelToAPI(k *models.Kernel) api.Kernel {
+	return api.Kernel{
+		Version:     k.ID,
+		Checksum:    k.Checksum,
+		Description: k.Description,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+func (a *APIStore) GetKernels(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	kernels, err := a.db.GetKernels(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting kernels")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	result := make([]api.Kernel, 0, len(kernels))
+	for _, k := range kernels {
+		result = append(result, kernelToAPI(k))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostKernels(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.PostKernelsJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("kernel.version", body.Version),
+		attribute.String("kernel.checksum", body.Checksum),
+	)
+
+	k, err := a.db.RegisterKernel(ctx, body.Version, body.Checksum, body.Description)
+	if err != nil {
+		if errors.Is(err, db.ErrKernelChecksumMismatch) {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Kernel '%s' is already registered with a different checksum", body.Version))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when registering kernel")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "registered kernel")
+
+	c.JSON(http.StatusCreated, kernelToAPI(k))
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func pinnedBuildToAPI(pin *models.PinnedBuild) api.PinnedBuild {
+	result := api.PinnedBuild{
+		BuildID:   pin.BuildID,
+		CreatedAt: pin.CreatedAt,
+	}
+
+	if pin.NodeID != "" {
+		result.NodeID = &pin.NodeID
+	}
+
+	return result
+}
+
+func (a *APIStore) GetPinnedBuilds(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	pins, err := a.db.GetPinnedBuilds(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting pinned builds")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	result := make([]api.PinnedBuild, 0, len(pins))
+	for _, pin := range pins {
+		result = append(result, pinnedBuildToAPI(pin))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostPinnedBuilds(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.PostPinnedBuildsJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	nodeID := ""
+	if body.NodeID != nil {
+		nodeID = *body.NodeID
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("build.id", body.BuildID.String()),
+		attribute.String("node.id", nodeID),
+	)
+
+	pin, err := a.db.PinBuild(ctx, body.BuildID, nodeID)
+	if err != nil {
+		if models.IsNotFound(err) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", body.BuildID))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when pinning build")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "pinned build")
+
+	c.JSON(http.StatusCreated, pinnedBuildToAPI(pin))
+}
+
+func (a *APIStore) DeletePinnedBuildsBuildID(c *gin.Context, buildID api.BuildID, params api.DeletePinnedBuildsBuildIDParams) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid build ID: %s", buildID))
+
+		telemetry.ReportError(ctx, err)
+
+		return
+	}
+
+	nodeID := ""
+	if params.NodeID != nil {
+		nodeID = *params.NodeID
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("build.id", buildID),
+		attribute.String("node.id", nodeID),
+	)
+
+	err = a.db.UnpinBuild(ctx, buildUUID, nodeID)
+	if err != nil {
+		if errors.Is(err, db.ErrBuildNotPinned) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' is not pinned", buildID))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when unpinning build")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "unpinned build")
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/secrets"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// registryCredentialSecretName is the name the password of the credential is sealed with,
+// so it can't be opened as the team secret or as the credential for the other registry.
+func registryCredentialSecretName(registry string) string {
+	return "registry/" + registry
+}
+
+func teamRegistryCredentialToAPI(credential *models.TeamRegistryCredential) api.TeamRegistryCredential {
+	return api.TeamRegistryCredential{
+		Registry:  credential.Registry,
+		Username:  credential.Username,
+		Version:   credential.Version,
+		CreatedAt: credential.CreatedAt,
+		UpdatedAt: credential.UpdatedAt,
+	}
+}
+
+func (a *APIStore) GetRegistryCredentials(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsRead) {
+		return
+	}
+
+	credentials, err := a.db.GetTeamRegistryCredentials(ctx, teamInfo.Team.ID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting registry credentials")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	result := make([]api.TeamRegistryCredential, 0, len(credentials))
+	for _, credential := range credentials {
+		result = append(result, teamRegistryCredentialToAPI(credential))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// PutRegistryCredentialsRegistry creates or rotates the credential, the running sandboxes keep the images they were booted from.
+func (a *APIStore) PutRegistryCredentialsRegistry(c *gin.Context, registry api.Registry) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsWrite) {
+		return
+	}
+
+	host, err := secrets.NormalizeRegistry(registry)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	body, err := utils.ParseBody[api.PutRegistryCredentialsRegistryJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("team.id", teamInfo.Team.ID.String()),
+		attribute.String("registry", host),
+	)
+
+	credential, created, err := a.db.SetTeamRegistryCredential(ctx, teamInfo.Team.ID, host, body.Username, func(version int32) (*db.SealedSecret, error) {
+		return a.secretsVault.Seal(ctx, teamInfo.Team.ID, registryCredentialSecretName(host), version, body.Password)
+	})
+	if err != nil {
+		if errors.Is(err, secrets.ErrEncryptionNotConfigured) {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Registry credentials are not available, their encryption is not configured")
+		} else {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting registry credential")
+		}
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	a.logger.Infof("Set version %d of registry credential for '%s' of team '%s'", credential.Version, host, teamInfo.Team.ID)
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+
+	c.JSON(status, teamRegistryCredentialToAPI(credential))
+}
+
+func (a *APIStore) DeleteRegistryCredentialsRegistry(c *gin.Context, registry api.Registry) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsWrite) {
+		return
+	}
+
+	host, err := secrets.NormalizeRegistry(registry)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("team.id", teamInfo.Team.ID.String()),
+		attribute.String("registry", host),
+	)
+
+	err = a.db.DeleteTeamRegistryCredential(ctx, teamInfo.Team.ID, host)
+	if err != nil {
+		if errors.Is(err, db.ErrTeamRegistryCredentialNotFound) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Registry credential for '%s' not found", host))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting registry credential")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	a.logger.Infof("Deleted registry credential for '%s' of team '%s'", host, teamInfo.Team.ID)
+
+	c.Status(http.StatusNoContent)
+}
+
+// resolveImage returns the image the sandbox boots from with the credential of the team for its registry,
+// the image is pulled anonymously if the team has no credential for the registry. The error is sent to the client.
+func (a *APIStore) resolveImage(ctx context.Context, c *gin.Context, teamID uuid.UUID, imageRef string) (*orchestrator.Image, bool) {
+	image := &orchestrator.Image{Ref: imageRef}
+
+	registry := secrets.ImageRegistry(imageRef)
+
+	credential, err := a.db.GetTeamRegistryCredential(ctx, teamID, registry)
+	if errors.Is(err, db.ErrTeamRegistryCredentialNotFound) {
+		return image, true
+	}
+
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting registry credential")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, false
+	}
+
+	password, err := a.secretsVault.Open(ctx, teamID, registryCredentialSecretName(registry), credential.Version, db.SealedSecret{
+		KeyID:        credential.KeyID,
+		EncryptedKey: credential.EncryptedKey,
+		Ciphertext:   credential.Ciphertext,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when decrypting registry credential")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("failed to decrypt version %d of registry credential for '%s': %w", credential.Version, registry, err))
+
+		return nil, false
+	}
+
+	image.Username = credential.Username
+	image.Password = password
+
+	a.logger.Infof("Using version %d of registry credential for '%s' of team '%s' to pull image '%s'", credential.Version, registry, teamID, imageRef)
+
+	return image, true
+}
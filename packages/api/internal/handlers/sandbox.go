@@ -2,17 +2,23 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -20,8 +26,12 @@ func (a *APIStore) startSandbox(
 	ctx context.Context,
 	sandboxID string,
 	timeout time.Duration,
-	envVars,
-	metadata map[string]string,
+	envVars map[string]string,
+	secretEnvVars []string,
+	secrets map[string]string,
+	secretRefs []string,
+	metadata,
+	labels map[string]string,
 	alias string,
 	team authcache.AuthTeamInfo,
 	build *models.EnvBuild,
@@ -30,7 +40,18 @@ func (a *APIStore) startSandbox(
 	isResume bool,
 	clientID *string,
 	baseTemplateID string,
+	priority *api.SandboxPriority,
+	placement *orchestrator.Placement,
+	image *orchestrator.Image,
+	allowWarming bool,
+	egress *schema.EgressPolicy,
 ) (*api.Sandbox, error) {
+	requestStart := time.Now()
+
+	if a.budgets.Exceeded(team.Team.ID) {
+		return nil, errcode.Wrap(errcode.BudgetExceeded, fmt.Errorf("the team is over its monthly budget, raise the budget to start sandboxes"))
+	}
+
 	_, rateSpan := a.Tracer.Start(ctx, "rate-limit")
 	counter, err := meters.GetUpDownCounter(meters.RateLimitCounterMeterName)
 	if err != nil {
@@ -63,7 +84,11 @@ func (a *APIStore) startSandbox(
 		team,
 		build,
 		metadata,
+		labels,
 		envVars,
+		secretEnvVars,
+		secrets,
+		secretRefs,
 		startTime,
 		endTime,
 		timeout,
@@ -71,7 +96,18 @@ func (a *APIStore) startSandbox(
 		isResume,
 		clientID,
 		baseTemplateID,
+		priority,
+		placement,
+		image,
+		allowWarming,
+		egress,
 	)
+	if errors.Is(instanceErr, orchestrator.ErrBuildWarming) {
+		logger.Infof("Sandbox start delayed until the template is fetched to the node")
+
+		return nil, instanceErr
+	}
+
 	if instanceErr != nil {
 		errMsg := fmt.Errorf("error when creating instance: %w", instanceErr)
 		telemetry.ReportCriticalError(ctx, errMsg)
@@ -98,6 +134,15 @@ func (a *APIStore) startSandbox(
 		a.templateSpawnCounter.IncreaseTemplateSpawnCount(baseTemplateID, time.Now())
 	}()
 
+	// The start includes the wait for the sandbox slot, like the team experiences it
+	startDuration := time.Since(requestStart)
+	go func() {
+		err := a.db.RecordSandboxStart(context.Background(), team.Team.ID, baseTemplateID, sandbox.SandboxID, isResume, startDuration)
+		if err != nil {
+			logger.Errorf("Error recording sandbox start: %v", err)
+		}
+	}()
+
 	telemetry.SetAttributes(ctx,
 		attribute.String("instance.id", sandbox.SandboxID),
 	)
@@ -112,3 +157,11 @@ func (a *APIStore) startSandbox(
 		EnvdVersion: *build.EnvdVersion,
 	}, nil
 }
+
+// sendSandboxWarming responds to the start of the sandbox that was delayed until its template is fetched to the node.
+func (a *APIStore) sendSandboxWarming(c *gin.Context) {
+	retryAfter := int32(orchestrator.WarmingRetryAfter.Seconds())
+
+	c.Header("Retry-After", strconv.Itoa(int(retryAfter)))
+	c.JSON(http.StatusAccepted, &api.SandboxWarming{Status: api.Warming, RetryAfter: retryAfter})
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/artifacts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func artifactToAPI(artifact *artifacts.Artifact) api.SandboxArtifact {
+	labels := artifact.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	return api.SandboxArtifact{
+		SandboxID: artifact.SandboxID,
+		Name:      artifact.Name,
+		Path:      artifact.Path,
+		Labels:    labels,
+		SizeBytes: artifact.SizeBytes,
+		CreatedAt: artifact.CreatedAt,
+	}
+}
+
+func (a *APIStore) PostSandboxesSandboxIDArtifacts(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.String("team.id", teamID.String()),
+	)
+
+	body, err := utils.ParseBody[api.NewSandboxArtifact](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	var labels map[string]string
+	if body.Labels != nil {
+		labels = *body.Labels
+	}
+
+	err = artifacts.Validate(body.Name, labels)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid artifact: %s", err))
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Error saving artifact - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamID {
+		errMsg := fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String())
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error saving artifact - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	res, err := a.orchestrator.SaveArtifact(ctx, sbx, body.Name, body.Path, labels)
+	if errors.As(err, &orchestrator.ErrArtifactPath{}) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error saving artifact - %s", err))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error saving artifact '%s' of sandbox '%s'", body.Name, sandboxID))
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, artifactToAPI(&artifacts.Artifact{
+		SandboxID: sandboxID,
+		Name:      body.Name,
+		Path:      body.Path,
+		Labels:    labels,
+		SizeBytes: res.SizeBytes,
+		CreatedAt: res.CreatedAt.AsTime(),
+	}))
+}
+
+func (a *APIStore) GetSandboxesSandboxIDArtifactsArtifactName(c *gin.Context, sandboxID api.SandboxID, artifactName api.ArtifactName) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.String("team.id", teamID.String()),
+		attribute.String("artifact.name", artifactName),
+	)
+
+	err := artifacts.Validate(artifactName, nil)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Artifact '%s' of sandbox '%s' was not found", artifactName, sandboxID))
+
+		return
+	}
+
+	// The artifacts are scoped by the team, so the artifacts of the other teams are never found
+	artifact, err := artifacts.Get(ctx, gcs.TemplateBucket, teamID.String(), sandboxID, artifactName)
+	if errors.Is(err, gcs.ErrObjectNotExist) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Artifact '%s' of sandbox '%s' was not found", artifactName, sandboxID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error getting artifact '%s' of sandbox '%s'", artifactName, sandboxID))
+
+		return
+	}
+
+	c.Header("Content-Type", "application/gzip")
+	c.Header("Content-Length", strconv.FormatInt(artifact.SizeBytes, 10))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", artifact.Name+".tar.gz"))
+	c.Status(http.StatusOK)
+
+	// The archive is streamed, the errors after the first write can only abort the response
+	err = artifacts.Download(ctx, gcs.TemplateBucket, teamID.String(), sandboxID, artifactName, c.Writer)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		c.Abort()
+	}
+}
+
+func (a *APIStore) GetArtifacts(c *gin.Context, params api.GetArtifactsParams) {
+	ctx := c.Request.Context()
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("team.id", teamID.String()),
+	)
+
+	var labels map[string]string
+	if params.Query != nil {
+		var err error
+
+		labels, err = parseQueryFilters(*params.Query)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+			return
+		}
+	}
+
+	var sandboxID string
+	if params.SandboxID != nil {
+		sandboxID = utils.ShortID(*params.SandboxID)
+	}
+
+	result, err := artifacts.List(ctx, gcs.TemplateBucket, teamID.String(), sandboxID, labels)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error listing artifacts")
+
+		return
+	}
+
+	response := make([]api.SandboxArtifact, 0, len(result))
+	for _, artifact := range result {
+		response = append(response, artifactToAPI(artifact))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
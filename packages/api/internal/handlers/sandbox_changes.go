@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PostSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.String("team.id", teamID.String()),
+	)
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Error creating checkpoint - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamID {
+		errMsg := fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String())
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error creating checkpoint - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	checkpoint, err := a.orchestrator.CreateCheckpoint(ctx, sbx)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error creating checkpoint for sandbox '%s'", sandboxID))
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, api.SandboxCheckpoint{
+		CheckpointID: checkpoint.ID,
+		CreatedAt:    checkpoint.CreatedAt,
+	})
+}
+
+func (a *APIStore) GetSandboxesSandboxIDChanges(c *gin.Context, sandboxID api.SandboxID, params api.GetSandboxesSandboxIDChangesParams) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.String("team.id", teamID.String()),
+	)
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Error listing changes - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamID {
+		errMsg := fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String())
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error listing changes - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	files, err := a.orchestrator.ChangedFiles(ctx, sbx, params.From, params.To)
+	if errors.Is(err, orchestrator.ErrCheckpointNotFound{}) {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.CheckpointNotFound, fmt.Sprintf("Error listing changes - checkpoint for sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error listing changes for sandbox '%s'", sandboxID))
+
+		return
+	}
+
+	changes := make([]api.SandboxChangedFile, 0, len(files))
+	for _, file := range files {
+		fileType := api.File
+		if file.IsDir {
+			fileType = api.Directory
+		}
+
+		changes = append(changes, api.SandboxChangedFile{
+			Path: file.Path,
+			Type: fileType,
+		})
+	}
+
+	c.JSON(http.StatusOK, changes)
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+var consoleUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// The requests are authenticated by the headers, not by cookies.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+func (a *APIStore) GetSandboxesSandboxIDConsole(c *gin.Context, sandboxID api.SandboxID, params api.GetSandboxesSandboxIDConsoleParams) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	writable := params.Writable != nil && *params.Writable
+
+	// The team is not set if the request was authenticated by the admin token.
+	teamInfo, isTeam := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.Bool("console.writable", writable),
+		attribute.Bool("console.admin", !isTeam),
+	)
+
+	if writable && isTeam {
+		a.sendAPIStoreError(c, http.StatusForbidden, "Only admins can attach to the console as writable")
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Error attaching console - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if isTeam && *sbx.TeamID != teamInfo.Team.ID {
+		errMsg := fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamInfo.Team.ID.String())
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error attaching console - sandbox '%s' does not belong to your team '%s'", sandboxID, teamInfo.Team.ID.String()))
+
+		return
+	}
+
+	if !websocket.IsWebSocketUpgrade(c.Request) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "The console is available only via WebSocket")
+
+		return
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	console, err := a.orchestrator.AttachConsole(streamCtx, sbx, writable)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error attaching console for sandbox '%s'", sandboxID))
+
+		return
+	}
+
+	conn, err := consoleUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader already responded with the error.
+		telemetry.ReportError(ctx, fmt.Errorf("error upgrading console connection: %w", err))
+
+		return
+	}
+	defer conn.Close()
+
+	telemetry.ReportEvent(ctx, "console attached")
+
+	// Forward the input from the client.
+	go func() {
+		defer cancel()
+
+		for {
+			messageType, data, readErr := conn.ReadMessage()
+			if readErr != nil {
+				return
+			}
+
+			if messageType != websocket.BinaryMessage && messageType != websocket.TextMessage {
+				continue
+			}
+
+			writeErr := console.Write(data)
+			if writeErr != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		output, readErr := console.Read()
+		if readErr != nil {
+			closeMessage := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "console detached")
+			if !errors.Is(readErr, io.EOF) && streamCtx.Err() == nil {
+				closeMessage = websocket.FormatCloseMessage(websocket.CloseInternalServerErr, readErr.Error())
+			}
+
+			conn.WriteMessage(websocket.CloseMessage, closeMessage)
+
+			return
+		}
+
+		writeErr := conn.WriteMessage(websocket.BinaryMessage, output)
+		if writeErr != nil {
+			return
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -13,15 +16,20 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
 const InstanceIDPrefix = "i"
 
-func (a *APIStore) PostSandboxes(c *gin.Context) {
+func (a *APIStore) PostSandboxes(c *gin.Context, params api.PostSandboxesParams) {
 	ctx := c.Request.Context()
 
 	// Get team from context, use TeamContextKey
@@ -29,6 +37,12 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	c.Set("teamID", teamInfo.Team.ID.String())
 
+	done, ok := a.startIdempotentRequest(c, teamInfo.Team.ID, params.IdempotencyKey)
+	if !ok {
+		return
+	}
+	defer done()
+
 	span := trace.SpanFromContext(ctx)
 	traceID := span.SpanContext().TraceID().String()
 	c.Set("traceID", traceID)
@@ -59,7 +73,16 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	_, templateSpan := a.Tracer.Start(ctx, "get-template")
 	// Check if team has access to the environment
-	env, build, checkErr := a.templateCache.Get(ctx, cleanedAliasOrEnvID, teamInfo.Team.ID, true)
+	var env *api.Template
+	var build *models.EnvBuild
+	var checkErr *api.APIError
+	// The variant is the build of the template with the other resources, built with the last build of the template
+	if body.Variant != nil {
+		env, build, checkErr = a.templateCache.GetVariant(ctx, cleanedAliasOrEnvID, teamInfo.Team.ID, true, *body.Variant)
+	} else {
+		env, build, checkErr = a.templateCache.Get(ctx, cleanedAliasOrEnvID, teamInfo.Team.ID, true)
+	}
+
 	if checkErr != nil {
 		telemetry.ReportCriticalError(ctx, checkErr.Err)
 
@@ -104,11 +127,92 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		metadata = *body.Metadata
 	}
 
+	var labels map[string]string
+	if body.Labels != nil {
+		labels = *body.Labels
+
+		err = sandbox.ValidateLabels(labels)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid labels: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+	}
+
 	var envVars map[string]string
 	if body.EnvVars != nil {
 		envVars = *body.EnvVars
 	}
 
+	var secretEnvVars []string
+	if body.SecretEnvVars != nil {
+		secretEnvVars = *body.SecretEnvVars
+
+		for _, name := range secretEnvVars {
+			if _, ok := envVars[name]; !ok {
+				a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Secret env var '%s' is not in the env vars", name))
+
+				return
+			}
+		}
+	}
+
+	secretRefs := build.Secrets
+	if body.Secrets != nil {
+		secretRefs = append(slices.Clone(secretRefs), *body.Secrets...)
+	}
+
+	sandboxSecrets, ok := a.resolveSandboxSecrets(ctx, c, sandboxID, secretRefs, envVars)
+	if !ok {
+		return
+	}
+
+	var allowedRegions, preferredRegions []string
+	if env.AllowedRegions != nil {
+		allowedRegions = *env.AllowedRegions
+	}
+
+	if body.Regions != nil {
+		preferredRegions = *body.Regions
+	}
+
+	placement, err := orchestrator.NewPlacement(allowedRegions, preferredRegions, body.RegionFailover == nil || *body.RegionFailover, build.ReplicatedRegions)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid regions: %s", err))
+
+		return
+	}
+
+	var image *orchestrator.Image
+	if body.Image != nil {
+		if strings.TrimSpace(*body.Image) == "" {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Image reference cannot be empty")
+
+			return
+		}
+
+		image, ok = a.resolveImage(ctx, c, teamInfo.Team.ID, *body.Image)
+		if !ok {
+			return
+		}
+	}
+
+	var egressPolicy *schema.EgressPolicy
+	if body.Egress != nil {
+		egressPolicy, err = resolveEgress(body.Egress)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid egress: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+
+		telemetry.SetAttributes(ctx, attribute.String("instance.egress.preset", string(body.Egress.Preset)))
+	}
+
 	timeout := instance.InstanceExpiration
 	if body.Timeout != nil {
 		timeout = time.Duration(*body.Timeout) * time.Second
@@ -120,12 +224,23 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		}
 	}
 
-	sandbox, err := a.startSandbox(
+	// The high priority sandboxes preempt the sandboxes of the other teams, only the tiers entitled to it can start them
+	if body.Priority != nil && *body.Priority == api.High && !teamInfo.Tier.HighPriority {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("The tier '%s' of the team can't start high priority sandboxes", teamInfo.Tier.ID))
+
+		return
+	}
+
+	sbx, err := a.startSandbox(
 		ctx,
 		sandboxID,
 		timeout,
-		envVars,
+		sandboxSecrets.envVars,
+		append(secretEnvVars, sandboxSecrets.secretEnvVars...),
+		sandboxSecrets.values,
+		sandboxSecrets.refs,
 		metadata,
+		labels,
 		alias,
 		teamInfo,
 		build,
@@ -134,14 +249,39 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		false,
 		nil,
 		env.TemplateID,
+		body.Priority,
+		placement,
+		image,
+		body.AllowWarming != nil && *body.AllowWarming,
+		egressPolicy,
 	)
+	if errors.Is(err, orchestrator.ErrBuildWarming) {
+		a.sendSandboxWarming(c)
+
+		return
+	}
+
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusInternalServerError, err.Error())
+		errorCode, ok := errcode.Of(err)
+		if !ok {
+			errorCode = errcode.Internal
+		}
+
+		statusCode := http.StatusInternalServerError
+		switch errorCode {
+		case errcode.RateLimited:
+			// The team has reached the limit of the concurrent sandboxes
+			statusCode = http.StatusTooManyRequests
+		case errcode.BudgetExceeded:
+			statusCode = http.StatusForbidden
+		}
+
+		a.sendAPIStoreErrorCode(c, statusCode, errorCode, err.Error())
 
 		return
 	}
 
-	c.Set("nodeID", sandbox.ClientID)
+	c.Set("nodeID", sbx.ClientID)
 
-	c.JSON(http.StatusCreated, &sandbox)
+	c.JSON(http.StatusCreated, &sbx)
 }
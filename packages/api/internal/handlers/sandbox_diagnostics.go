@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetSandboxesSandboxIDDiagnostics(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.String("team.id", teamID.String()),
+	)
+
+	bundle, bundleTeamID, err := a.orchestrator.GetDiagnostics(ctx, sandboxID)
+	if errors.Is(err, orchestrator.ErrDiagnosticsNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Diagnostics for sandbox '%s' were not found", sandboxID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error getting diagnostics for sandbox '%s'", sandboxID))
+
+		return
+	}
+
+	// Don't reveal that the sandbox of another team exists.
+	if bundleTeamID != teamID.String() {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Diagnostics for sandbox '%s' were not found", sandboxID))
+
+		return
+	}
+
+	var diagnostics api.SandboxDiagnostics
+
+	err = json.Unmarshal(bundle, &diagnostics)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error parsing diagnostics for sandbox '%s': %w", sandboxID, err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error getting diagnostics for sandbox '%s'", sandboxID))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, diagnostics)
+}
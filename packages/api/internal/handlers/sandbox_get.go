@@ -71,5 +71,19 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 		instance.Metadata = &meta
 	}
 
+	if info.Labels != nil {
+		labels := api.SandboxLabels(info.Labels)
+		instance.Labels = &labels
+	}
+
+	currentETag, err := sandboxETag(info.Instance.TemplateID, info.Metadata, info.Labels)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		c.JSON(http.StatusInternalServerError, fmt.Sprintf("Error getting etag for instance %s", id))
+
+		return
+	}
+
+	c.Header(ETagHeader, currentETag)
 	c.JSON(http.StatusOK, instance)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -29,7 +30,7 @@ func (a *APIStore) DeleteSandboxesSandboxID(
 
 	sbx, err := a.orchestrator.GetSandbox(sandboxID)
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error killing sandbox - sandbox '%s' was not found", sandboxID))
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Error killing sandbox - sandbox '%s' was not found", sandboxID))
 
 		return
 	}
@@ -47,7 +48,7 @@ func (a *APIStore) DeleteSandboxesSandboxID(
 
 	found := a.orchestrator.DeleteInstance(ctx, sandboxID)
 	if !found {
-		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error killing sandbox - sandbox '%s' was not found", sandboxID))
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Error killing sandbox - sandbox '%s' was not found", sandboxID))
 
 		return
 	}
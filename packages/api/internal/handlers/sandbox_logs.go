@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,12 +17,26 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
-const (
-	oldestLogsLimit = 168 * time.Hour // 7 days
-)
+const defaultSandboxLogsRetentionDays = 7
+
+// oldestLogsLimit is the retention of the sandbox logs in Loki, the logs are available after the sandbox is killed until then
+var oldestLogsLimit = sandboxLogsRetention()
+
+// sandboxLogLevels are the levels of the envd logs ordered by severity, the levels more severe than error are returned as error
+var sandboxLogLevels = []string{"debug", "info", "warn", "error", "fatal", "panic"}
+
+func sandboxLogsRetention() time.Duration {
+	days, err := strconv.Atoi(env.GetEnv("SANDBOX_LOGS_RETENTION_DAYS", strconv.Itoa(defaultSandboxLogsRetentionDays)))
+	if err != nil || days <= 0 {
+		days = defaultSandboxLogsRetentionDays
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
 
 func (a *APIStore) GetSandboxesSandboxIDLogs(
 	c *gin.Context,
@@ -42,16 +57,32 @@ func (a *APIStore) GetSandboxesSandboxIDLogs(
 
 	end := time.Now()
 
-	if params.Start != nil {
+	switch {
+	case params.Since != nil:
+		start = *params.Since
+	case params.Start != nil:
 		start = time.UnixMilli(int64(*params.Start))
-	} else {
+	default:
 		start = end.Add(-oldestLogsLimit)
 	}
 
 	// Sanitize ID
 	// https://grafana.com/blog/2021/01/05/how-to-escape-special-characters-with-lokis-logql/
 	id := strings.ReplaceAll(sandboxID, "`", "")
-	query := fmt.Sprintf("{source=\"logs-collector\", service=\"envd\", teamID=`%s`, sandboxID=`%s`}", teamID.String(), id)
+
+	var levelSelector string
+	if params.Level != nil {
+		i := slices.Index(sandboxLogLevels, string(*params.Level))
+		if i < 0 {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid log level '%s'", *params.Level))
+
+			return
+		}
+
+		levelSelector = fmt.Sprintf(", level=~`%s`", strings.Join(sandboxLogLevels[i:], "|"))
+	}
+
+	query := fmt.Sprintf("{source=\"logs-collector\", service=\"envd\", teamID=`%s`, sandboxID=`%s`%s}", teamID.String(), id, levelSelector)
 
 	res, err := a.lokiClient.QueryRange(query, int(*params.Limit), start, end, logproto.FORWARD, time.Duration(0), time.Duration(0), true)
 	if err != nil {
@@ -69,10 +100,14 @@ func (a *APIStore) GetSandboxesSandboxIDLogs(
 		logs := make([]api.SandboxLog, 0)
 
 		for _, stream := range value {
+			level, source := sandboxLogLabels(stream.Labels)
+
 			for _, entry := range stream.Entries {
 				logs = append(logs, api.SandboxLog{
 					Timestamp: entry.Timestamp,
 					Line:      entry.Line,
+					Level:     level,
+					Stream:    source,
 				})
 			}
 		}
@@ -94,3 +129,25 @@ func (a *APIStore) GetSandboxesSandboxIDLogs(
 		return
 	}
 }
+
+// sandboxLogLabels returns the level and the stream of the log entries from the labels set by the logs collector,
+// the entries collected before the labels were added have neither.
+func sandboxLogLabels(labels loghttp.LabelSet) (*api.SandboxLogLevel, *api.SandboxLogStream) {
+	var level *api.SandboxLogLevel
+	if l := labels["level"]; l != "" {
+		value := api.SandboxLogLevel(l)
+		if l == "fatal" || l == "panic" {
+			value = api.SandboxLogLevelError
+		}
+
+		level = &value
+	}
+
+	var stream *api.SandboxLogStream
+	if s := labels["stream"]; s != "" {
+		value := api.SandboxLogStream(s)
+		stream = &value
+	}
+
+	return level, stream
+}
@@ -69,6 +69,11 @@ func (a *APIStore) GetSandboxesSandboxIDMetrics(
 					CPUCount    int32   `json:"cpuCount"`
 					MemTotalMiB int64   `json:"memTotalMiB"`
 					MemUsedMiB  int64   `json:"memUsedMiB"`
+					// Not reported by the older envd versions
+					DiskTotalMiB *int64                      `json:"diskTotalMiB"`
+					DiskUsedMiB  *int64                      `json:"diskUsedMiB"`
+					OpenFDs      *int64                      `json:"openFds"`
+					Processes    *[]api.SandboxProcessMetric `json:"processes"`
 				}
 
 				err := json.Unmarshal([]byte(entry.Line), &metric)
@@ -77,11 +82,15 @@ func (a *APIStore) GetSandboxesSandboxIDMetrics(
 					continue
 				}
 				metrics = append(metrics, api.SandboxMetric{
-					Timestamp:   entry.Timestamp,
-					CpuUsedPct:  metric.CPUUsedPct,
-					CpuCount:    metric.CPUCount,
-					MemTotalMiB: metric.MemTotalMiB,
-					MemUsedMiB:  metric.MemUsedMiB,
+					Timestamp:    entry.Timestamp,
+					CpuUsedPct:   metric.CPUUsedPct,
+					CpuCount:     metric.CPUCount,
+					MemTotalMiB:  metric.MemTotalMiB,
+					MemUsedMiB:   metric.MemUsedMiB,
+					DiskTotalMiB: metric.DiskTotalMiB,
+					DiskUsedMiB:  metric.DiskUsedMiB,
+					OpenFds:      metric.OpenFDs,
+					Processes:    metric.Processes,
 				})
 			}
 		}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetSandboxesSandboxIDNetwork(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.String("team.id", teamID.String()),
+	)
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Error getting network activity - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamID {
+		errMsg := fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String())
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error getting network activity - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	activity, err := a.orchestrator.NetworkActivity(ctx, sbx)
+	if code, ok := errcode.Of(err); ok && code == errcode.SandboxNotFound {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Error getting network activity - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error getting network activity for sandbox '%s'", sandboxID))
+
+		return
+	}
+
+	destinations := make([]api.SandboxNetworkDestination, 0, len(activity))
+	for _, destination := range activity {
+		destinations = append(destinations, api.SandboxNetworkDestination{
+			Ip:            destination.Ip,
+			Port:          int32(destination.Port),
+			Protocol:      destination.Protocol,
+			Connections:   destination.Connections,
+			BytesSent:     int64(destination.BytesSent),
+			BytesReceived: int64(destination.BytesReceived),
+			LastSeen:      destination.LastSeen.AsTime(),
+		})
+	}
+
+	c.JSON(http.StatusOK, api.SandboxNetworkActivity{
+		SandboxID:    sandboxID,
+		Destinations: destinations,
+	})
+}
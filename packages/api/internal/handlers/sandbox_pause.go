@@ -10,7 +10,7 @@ import (
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
-	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/gin-gonic/gin"
@@ -31,7 +31,7 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 
 	sbx, err := a.orchestrator.GetSandbox(sandboxID)
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error pausing sandbox - sandbox '%s' was not found", sandboxID))
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Error pausing sandbox - sandbox '%s' was not found", sandboxID))
 
 		// TODO: Check if sandbox is already paused to return 409
 		return
@@ -46,21 +46,9 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 		return
 	}
 
-	snapshotConfig := &db.SnapshotInfo{
-		BaseTemplateID:     sbx.Instance.TemplateID,
-		SandboxID:          sandboxID,
-		VCPU:               sbx.VCpu,
-		RAMMB:              sbx.RamMB,
-		TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
-		Metadata:           sbx.Metadata,
-		KernelVersion:      sbx.KernelVersion,
-		FirecrackerVersion: sbx.FirecrackerVersion,
-		EnvdVersion:        sbx.Instance.EnvdVersion,
-	}
-
 	envBuild, err := a.db.NewSnapshotBuild(
 		ctx,
-		snapshotConfig,
+		orchestrator.SnapshotInfo(sbx),
 		teamID,
 	)
 	if err != nil {
@@ -71,7 +59,19 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 
 	err = a.orchestrator.PauseInstance(ctx, sbx, *envBuild.EnvID, envBuild.ID.String())
 	if errors.Is(err, orchestrator.ErrPauseQueueExhausted{}) {
-		a.sendAPIStoreError(c, http.StatusTooManyRequests, "Too many pause requests in progress, please retry later.")
+		a.sendAPIStoreErrorCode(c, http.StatusTooManyRequests, errcode.NodeCapacity, "Too many pause requests in progress, please retry later.")
+
+		return
+	}
+
+	// The sandbox keeps running when its on-pause hook failed
+	if code, ok := errcode.Of(err); ok && code == errcode.HookFailed {
+		statusErr := a.db.EnvBuildSetStatus(ctx, *envBuild.EnvID, envBuild.ID, envbuild.StatusFailed)
+		if statusErr != nil {
+			telemetry.ReportError(ctx, fmt.Errorf("error when setting the snapshot build status: %w", statusErr))
+		}
+
+		a.sendAPIStoreErrorCode(c, http.StatusConflict, errcode.HookFailed, fmt.Sprintf("Error pausing sandbox: %s", err))
 
 		return
 	}
@@ -84,7 +84,8 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 		return
 	}
 
-	err = a.db.EnvBuildSetStatus(ctx, *envBuild.EnvID, envBuild.ID, envbuild.StatusSuccess)
+	// The snapshot is resumable from the node right away, the node uploads it to the storage in the background
+	err = a.db.SnapshotBuildSetPaused(ctx, *envBuild.EnvID, envBuild.ID, sbx.Instance.ClientID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error pausing sandbox: %s", err))
 
@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -10,7 +12,9 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/gin-gonic/gin"
@@ -59,6 +63,13 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		}
 	}
 
+	// The high priority sandboxes preempt the sandboxes of the other teams, only the tiers entitled to it can start them
+	if body.Priority != nil && *body.Priority == api.High && !teamInfo.Tier.HighPriority {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("The tier '%s' of the team can't start high priority sandboxes", teamInfo.Tier.ID))
+
+		return
+	}
+
 	clientID, ok := getSandboxIDClient(sandboxID)
 	if !ok {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid sandbox ID — missing client ID part: %s", sandboxID))
@@ -70,7 +81,7 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 
 	_, err = a.orchestrator.GetSandbox(sandboxID)
 	if err == nil {
-		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox %s is already running", sandboxID))
+		a.sendAPIStoreErrorCode(c, http.StatusConflict, errcode.SandboxAlreadyRunning, fmt.Sprintf("Sandbox %s is already running", sandboxID))
 
 		return
 	}
@@ -82,6 +93,17 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		return
 	}
 
+	// The references of the secrets attached before the pause are kept with the snapshot
+	secretRefs := build.Secrets
+	if body.Secrets != nil {
+		secretRefs = append(slices.Clone(secretRefs), *body.Secrets...)
+	}
+
+	sandboxSecrets, ok := a.resolveSandboxSecrets(ctx, c, sandboxID, secretRefs, nil)
+	if !ok {
+		return
+	}
+
 	sandboxLogger := logs.NewSandboxLogger(
 		sandboxID,
 		*build.EnvID,
@@ -92,12 +114,20 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 	)
 	sandboxLogger.Debugf("Started resuming sandbox")
 
+	// The snapshot is resumed only in the regions it's readable in without reading the storage cross-region,
+	// the placement can't fail without the allowed regions
+	placement, _ := orchestrator.NewPlacement(nil, nil, true, build.ReplicatedRegions)
+
 	sbx, err := a.startSandbox(
 		ctx,
 		snapshot.SandboxID,
 		timeout,
 		nil,
+		nil,
+		sandboxSecrets.values,
+		sandboxSecrets.refs,
 		snapshot.Metadata,
+		nil,
 		"",
 		teamInfo,
 		build,
@@ -106,9 +136,37 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		true,
 		&clientID,
 		snapshot.BaseEnvID,
+		body.Priority,
+		placement,
+		nil,
+		body.AllowWarming != nil && *body.AllowWarming,
+		nil,
 	)
+	if errors.Is(err, orchestrator.ErrBuildWarming) {
+		a.sendSandboxWarming(c)
+
+		return
+	}
+
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error resuming sandbox: %s", err))
+		errorCode, ok := errcode.Of(err)
+		if !ok {
+			errorCode = errcode.Internal
+		}
+
+		statusCode := http.StatusInternalServerError
+		switch errorCode {
+		case errcode.Unavailable:
+			// The snapshot can't be resumed until it's uploaded or its node is back
+			statusCode = http.StatusServiceUnavailable
+		case errcode.RateLimited:
+			// The team has reached the limit of the concurrent sandboxes
+			statusCode = http.StatusTooManyRequests
+		case errcode.BudgetExceeded:
+			statusCode = http.StatusForbidden
+		}
+
+		a.sendAPIStoreErrorCode(c, statusCode, errorCode, fmt.Sprintf("Error resuming sandbox: %s", err))
 
 		return
 	}
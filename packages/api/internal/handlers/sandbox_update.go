@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PatchSandboxesSandboxID(c *gin.Context, sandboxID string, params api.PatchSandboxesSandboxIDParams) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.String("team.id", teamID.String()),
+	)
+
+	body, err := utils.ParseBody[api.PatchSandboxesSandboxIDJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	info, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil || *info.TeamID != teamID {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.SandboxNotFound, fmt.Sprintf("Sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	currentETag, err := sandboxETag(info.Instance.TemplateID, info.Metadata, info.Labels)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting sandbox")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	if !a.checkIfMatch(c, params.IfMatch, currentETag) {
+		return
+	}
+
+	if body.Labels != nil {
+		labels := map[string]string(*body.Labels)
+
+		err = sandbox.ValidateLabels(labels)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid labels: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+
+		// The sandbox is checked again with the update, so the concurrent update with the same tag isn't lost
+		var precondition func(instance.InstanceInfo) (bool, error)
+		if params.IfMatch != nil {
+			precondition = func(current instance.InstanceInfo) (bool, error) {
+				tag, err := sandboxETag(current.Instance.TemplateID, current.Metadata, current.Labels)
+				if err != nil {
+					return false, err
+				}
+
+				return matchesIfMatch(*params.IfMatch, tag), nil
+			}
+		}
+
+		info, err = a.orchestrator.UpdateSandboxLabels(ctx, sandboxID, labels, precondition)
+		if errors.Is(err, instance.ErrInstanceChanged) {
+			a.sendPreconditionFailed(c)
+
+			return
+		} else if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when updating sandbox labels")
+
+			telemetry.ReportCriticalError(ctx, err)
+
+			return
+		}
+	}
+
+	instance := api.RunningSandbox{
+		ClientID:   info.Instance.ClientID,
+		TemplateID: info.Instance.TemplateID,
+		Alias:      info.Instance.Alias,
+		SandboxID:  info.Instance.SandboxID,
+		StartedAt:  info.StartTime,
+		CpuCount:   int32(info.VCpu),
+		MemoryMB:   int32(info.RamMB),
+		EndAt:      info.EndTime,
+	}
+
+	if info.Metadata != nil {
+		meta := api.SandboxMetadata(info.Metadata)
+		instance.Metadata = &meta
+	}
+
+	if info.Labels != nil {
+		labels := api.SandboxLabels(info.Labels)
+		instance.Labels = &labels
+	}
+
+	updatedETag, err := sandboxETag(info.Instance.TemplateID, info.Metadata, info.Labels)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting sandbox")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	c.Header(ETagHeader, updatedETag)
+	c.JSON(http.StatusOK, instance)
+}
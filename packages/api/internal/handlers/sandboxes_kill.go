@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// DeleteSandboxes kills all running sandboxes of the team matching the label selector.
+func (a *APIStore) DeleteSandboxes(c *gin.Context, params api.DeleteSandboxesParams) {
+	ctx := c.Request.Context()
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("team.id", teamID.String()),
+		attribute.StringSlice("label.selector", params.Label),
+	)
+
+	// The selector is required, so all sandboxes can't be killed by mistake
+	if len(params.Label) == 0 {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "At least one label selector is required")
+
+		return
+	}
+
+	selector, err := sandbox.ParseLabelSelector(params.Label)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid label selector: %s", err))
+
+		telemetry.ReportError(ctx, err)
+
+		return
+	}
+
+	killed := make([]string, 0)
+	for _, info := range a.orchestrator.GetSandboxes(ctx, &teamID) {
+		if info.TeamID == nil || *info.TeamID != teamID || !selector.Matches(info.Labels) {
+			continue
+		}
+
+		if a.orchestrator.DeleteInstance(ctx, info.Instance.SandboxID) {
+			killed = append(killed, info.Instance.SandboxID)
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "killed sandboxes matching the label selector", attribute.Int("sandboxes.count", len(killed)))
+
+	c.JSON(http.StatusOK, api.KilledSandboxes{SandboxIDs: killed})
+}
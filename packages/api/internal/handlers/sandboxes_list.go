@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"slices"
@@ -12,6 +14,9 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -25,74 +30,57 @@ func (a *APIStore) GetSandboxes(c *gin.Context, params api.GetSandboxesParams) {
 
 	telemetry.ReportEvent(ctx, "list running instances")
 
-	instanceInfo := a.orchestrator.GetSandboxes(ctx, &team.ID)
-
+	var filters map[string]string
 	if params.Query != nil {
-		// Unescape query
-		query, err := url.QueryUnescape(*params.Query)
+		var err error
+
+		filters, err = parseQueryFilters(*params.Query)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, "Error when unescaping query")
+			c.JSON(http.StatusBadRequest, err.Error())
 
 			return
 		}
+	}
 
-		// Parse filters, both key and value are also unescaped
-		filters := make(map[string]string)
-
-		for _, filter := range strings.Split(query, "&") {
-			parts := strings.Split(filter, "=")
-			if len(parts) != 2 {
-				c.JSON(http.StatusBadRequest, "Invalid key value pair in query")
-
-				return
-			}
-
-			key, err := url.QueryUnescape(parts[0])
-			if err != nil {
-				c.JSON(http.StatusBadRequest, "Error when unescaping key")
-
-				return
-			}
+	var cursor *db.PageCursor
+	if params.NextToken != nil {
+		var err error
 
-			value, err := url.QueryUnescape(parts[1])
-			if err != nil {
-				c.JSON(http.StatusBadRequest, "Error when unescaping value")
+		cursor, err = utils.DecodeNextToken(*params.NextToken)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid next token: %s", err))
 
-				return
-			}
+			telemetry.ReportError(ctx, err)
 
-			filters[key] = value
+			return
 		}
+	}
 
-		// Filter instances to match all filters
-		n := 0
-		for _, instance := range instanceInfo {
-			if instance.Metadata == nil {
-				continue
-			}
+	var selector sandbox.LabelSelector
+	if params.Label != nil {
+		var err error
 
-			matchesAll := true
-			for key, value := range filters {
-				if metadataValue, ok := instance.Metadata[key]; !ok || metadataValue != value {
-					matchesAll = false
-					break
-				}
-			}
+		selector, err = sandbox.ParseLabelSelector(*params.Label)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid label selector: %s", err))
 
-			if matchesAll {
-				instanceInfo[n] = instance
-				n++
-			}
+			telemetry.ReportError(ctx, err)
+
+			return
 		}
+	}
 
-		// Trim slice
-		instanceInfo = instanceInfo[:n]
+	states := []api.SandboxState{api.Running}
+	if params.State != nil && len(*params.State) > 0 {
+		states = *params.State
 	}
 
 	a.posthog.IdentifyAnalyticsTeam(team.ID.String(), team.Name)
 	properties := a.posthog.GetPackageToPosthogProperties(&c.Request.Header)
 	a.posthog.CreateAnalyticsTeamEvent(team.ID.String(), "listed running instances", properties)
 
+	instanceInfo := a.orchestrator.GetSandboxes(ctx, &team.ID)
+
 	buildIDs := make([]uuid.UUID, 0)
 	for _, info := range instanceInfo {
 		if info.TeamID == nil {
@@ -119,6 +107,7 @@ func (a *APIStore) GetSandboxes(c *gin.Context, params api.GetSandboxesParams) {
 	}
 
 	sandboxes := make([]api.RunningSandbox, 0)
+	running := make(map[string]bool, len(instanceInfo))
 
 	for _, info := range instanceInfo {
 		if info.TeamID == nil {
@@ -133,6 +122,13 @@ func (a *APIStore) GetSandboxes(c *gin.Context, params api.GetSandboxesParams) {
 			continue
 		}
 
+		running[info.Instance.SandboxID] = true
+
+		if !slices.Contains(states, api.Running) {
+			continue
+		}
+
+		state := api.Running
 		instance := api.RunningSandbox{
 			ClientID:   info.Instance.ClientID,
 			TemplateID: info.Instance.TemplateID,
@@ -142,6 +138,7 @@ func (a *APIStore) GetSandboxes(c *gin.Context, params api.GetSandboxesParams) {
 			CpuCount:   int32(buildsMap[*info.BuildID].Vcpu),
 			MemoryMB:   int32(buildsMap[*info.BuildID].RAMMB),
 			EndAt:      info.EndTime,
+			State:      &state,
 		}
 
 		if info.Metadata != nil {
@@ -149,13 +146,174 @@ func (a *APIStore) GetSandboxes(c *gin.Context, params api.GetSandboxesParams) {
 			instance.Metadata = &meta
 		}
 
+		if info.Labels != nil {
+			labels := api.SandboxLabels(info.Labels)
+			instance.Labels = &labels
+		}
+
 		sandboxes = append(sandboxes, instance)
 	}
 
-	// Sort sandboxes by start time descending
+	if slices.Contains(states, api.Paused) {
+		paused, err := a.db.ReadOnly().GetPausedSandboxes(ctx, team.ID)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting paused sandboxes")
+
+			telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting paused sandboxes: %w", err))
+
+			return
+		}
+
+		for _, sbx := range paused {
+			// The snapshot of the resumed sandbox is kept
+			if running[sbx.SandboxID] {
+				continue
+			}
+
+			state := api.Paused
+			instance := api.RunningSandbox{
+				TemplateID: sbx.TemplateID,
+				SandboxID:  sbx.SandboxID,
+				StartedAt:  sbx.PausedAt,
+				CpuCount:   int32(sbx.VCPU),
+				MemoryMB:   int32(sbx.RAMMB),
+				EndAt:      sbx.PausedAt,
+				State:      &state,
+			}
+
+			if sbx.Metadata != nil {
+				meta := api.SandboxMetadata(sbx.Metadata)
+				instance.Metadata = &meta
+			}
+
+			sandboxes = append(sandboxes, instance)
+		}
+	}
+
+	// Filter sandboxes to match all filters
+	n := 0
+	for _, sbx := range sandboxes {
+		if !sandboxMatches(sbx, params, filters, selector) {
+			continue
+		}
+
+		sandboxes[n] = sbx
+		n++
+	}
+
+	// Trim slice
+	sandboxes = sandboxes[:n]
+
+	sortKey := func(sbx api.RunningSandbox) db.PageCursor {
+		if params.SortBy != nil && *params.SortBy == api.EndAt {
+			return db.PageCursor{Time: sbx.EndAt, ID: sbx.SandboxID}
+		}
+
+		return db.PageCursor{Time: sbx.StartedAt, ID: sbx.SandboxID}
+	}
+
+	desc := params.Order != nil && *params.Order == api.Desc
+	compare := func(a, b db.PageCursor) int {
+		result := a.Time.Compare(b.Time)
+		if result == 0 {
+			result = strings.Compare(a.ID, b.ID)
+		}
+
+		if desc {
+			return -result
+		}
+
+		return result
+	}
+
 	slices.SortFunc(sandboxes, func(a, b api.RunningSandbox) int {
-		return a.StartedAt.Compare(b.StartedAt)
+		return compare(sortKey(a), sortKey(b))
 	})
 
+	if cursor != nil {
+		start, _ := slices.BinarySearchFunc(sandboxes, *cursor, func(sbx api.RunningSandbox, cursor db.PageCursor) int {
+			// The sandbox equal to the cursor was already returned
+			if compare(sortKey(sbx), cursor) <= 0 {
+				return -1
+			}
+
+			return 1
+		})
+
+		sandboxes = sandboxes[start:]
+	}
+
+	if params.Limit != nil && len(sandboxes) > int(*params.Limit) {
+		sandboxes = sandboxes[:*params.Limit]
+
+		c.Header(utils.NextTokenHeader, utils.EncodeNextToken(sortKey(sandboxes[len(sandboxes)-1])))
+	}
+
 	c.JSON(http.StatusOK, sandboxes)
 }
+
+func sandboxMatches(sbx api.RunningSandbox, params api.GetSandboxesParams, filters map[string]string, selector sandbox.LabelSelector) bool {
+	if params.TemplateID != nil && sbx.TemplateID != *params.TemplateID && (sbx.Alias == nil || *sbx.Alias != *params.TemplateID) {
+		return false
+	}
+
+	if params.CreatedAfter != nil && !sbx.StartedAt.After(*params.CreatedAfter) {
+		return false
+	}
+
+	var labels map[string]string
+	if sbx.Labels != nil {
+		labels = *sbx.Labels
+	}
+
+	if !selector.Matches(labels) {
+		return false
+	}
+
+	if filters == nil {
+		return true
+	}
+
+	if sbx.Metadata == nil {
+		return false
+	}
+
+	for key, value := range filters {
+		if metadataValue, ok := (*sbx.Metadata)[key]; !ok || metadataValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseQueryFilters parses the key value pairs of the query filter, both key and value are also unescaped.
+func parseQueryFilters(rawQuery string) (map[string]string, error) {
+	query, err := url.QueryUnescape(rawQuery)
+	if err != nil {
+		return nil, errors.New("Error when unescaping query")
+	}
+
+	filters := make(map[string]string)
+
+	for _, filter := range strings.Split(query, "&") {
+		parts := strings.Split(filter, "=")
+		if len(parts) != 2 {
+			return nil, errors.New("Invalid key value pair in query")
+		}
+
+		key, err := url.QueryUnescape(parts[0])
+		if err != nil {
+			return nil, errors.New("Error when unescaping key")
+		}
+
+		value, err := url.QueryUnescape(parts[1])
+		if err != nil {
+			return nil, errors.New("Error when unescaping value")
+		}
+
+		filters[key] = value
+	}
+
+	return filters, nil
+}
@@ -0,0 +1,482 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/secrets"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func teamSecretToAPI(secret *models.TeamSecret) api.TeamSecret {
+	return api.TeamSecret{
+		Name:          secret.Name,
+		LatestVersion: secret.LatestVersion,
+		CreatedAt:     secret.CreatedAt,
+		UpdatedAt:     secret.UpdatedAt,
+	}
+}
+
+// checkSecretsScope sends the error if the API key doesn't have the scope for the secrets.
+func (a *APIStore) checkSecretsScope(c *gin.Context, teamInfo authcache.AuthTeamInfo, scope string) bool {
+	if teamInfo.HasScope(scope) {
+		return true
+	}
+
+	a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("The API key doesn't have the '%s' scope", scope))
+
+	telemetry.ReportError(c.Request.Context(), fmt.Errorf("api key of team '%s' is missing the '%s' scope", teamInfo.Team.ID, scope))
+
+	return false
+}
+
+func (a *APIStore) GetSecrets(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsRead) {
+		return
+	}
+
+	teamSecrets, err := a.db.GetTeamSecrets(ctx, teamInfo.Team.ID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting secrets")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	result := make([]api.TeamSecret, 0, len(teamSecrets))
+	for _, secret := range teamSecrets {
+		result = append(result, teamSecretToAPI(secret))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostSecrets(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsWrite) {
+		return
+	}
+
+	body, err := utils.ParseBody[api.PostSecretsJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("team.id", teamInfo.Team.ID.String()),
+		attribute.String("secret.name", body.Name),
+	)
+
+	secret, err := a.db.AddTeamSecretVersion(ctx, teamInfo.Team.ID, body.Name, nil, func(version int32) (*db.SealedSecret, error) {
+		return a.secretsVault.Seal(ctx, teamInfo.Team.ID, body.Name, version, body.Value)
+	})
+	if err != nil {
+		if errors.Is(err, secrets.ErrEncryptionNotConfigured) {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Secrets are not available, their encryption is not configured")
+		} else {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting secret")
+		}
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	a.logger.Infof("Set version %d of secret '%s' of team '%s'", secret.LatestVersion, body.Name, teamInfo.Team.ID)
+
+	c.JSON(http.StatusCreated, teamSecretToAPI(secret))
+}
+
+func (a *APIStore) GetSecretsSecretName(c *gin.Context, secretName api.SecretName) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsRead) {
+		return
+	}
+
+	secret, err := a.db.GetTeamSecret(ctx, teamInfo.Team.ID, secretName)
+	if err != nil {
+		if errors.Is(err, db.ErrTeamSecretNotFound) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Secret '%s' not found", secretName))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting secret")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	c.Header(ETagHeader, secretETag(secret.LatestVersion))
+	c.JSON(http.StatusOK, teamSecretToAPI(secret))
+}
+
+// PutSecretsSecretName sets the secret to the value, the new version is added only if the value is different from the latest version,
+// so the declarative clients can send the request every time they apply the configuration.
+func (a *APIStore) PutSecretsSecretName(c *gin.Context, secretName api.SecretName, params api.PutSecretsSecretNameParams) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsWrite) {
+		return
+	}
+
+	body, err := utils.ParseBody[api.PutSecretsSecretNameJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("team.id", teamInfo.Team.ID.String()),
+		attribute.String("secret.name", secretName),
+	)
+
+	// The latest version is 0 if the secret doesn't exist yet
+	var currentVersion int32
+	currentETag := ""
+
+	current, err := a.db.GetTeamSecret(ctx, teamInfo.Team.ID, secretName)
+	if err == nil {
+		currentVersion = current.LatestVersion
+		currentETag = secretETag(currentVersion)
+	} else if !errors.Is(err, db.ErrTeamSecretNotFound) {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting secret")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	if !a.checkIfMatch(c, params.IfMatch, currentETag) {
+		return
+	}
+
+	if current != nil {
+		values, _, ok := a.openTeamSecrets(ctx, c, teamInfo.Team.ID, secrets.References{secretName: currentVersion})
+		if !ok {
+			return
+		}
+
+		if values[secretName] == body.Value {
+			c.Header(ETagHeader, currentETag)
+			c.JSON(http.StatusOK, teamSecretToAPI(current))
+
+			return
+		}
+	}
+
+	secret, err := a.db.AddTeamSecretVersion(ctx, teamInfo.Team.ID, secretName, &currentVersion, func(version int32) (*db.SealedSecret, error) {
+		return a.secretsVault.Seal(ctx, teamInfo.Team.ID, secretName, version, body.Value)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrTeamSecretVersionMismatch):
+			a.sendAPIStoreErrorCode(c, http.StatusPreconditionFailed, errcode.PreconditionFailed, fmt.Sprintf("Secret '%s' was changed concurrently, retry the request", secretName))
+		case errors.Is(err, secrets.ErrEncryptionNotConfigured):
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Secrets are not available, their encryption is not configured")
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting secret")
+		}
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	a.logger.Infof("Set version %d of secret '%s' of team '%s'", secret.LatestVersion, secretName, teamInfo.Team.ID)
+
+	status := http.StatusOK
+	if current == nil {
+		status = http.StatusCreated
+	}
+
+	c.Header(ETagHeader, secretETag(secret.LatestVersion))
+	c.JSON(status, teamSecretToAPI(secret))
+}
+
+func (a *APIStore) DeleteSecretsSecretName(c *gin.Context, secretName api.SecretName, params api.DeleteSecretsSecretNameParams) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsWrite) {
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("team.id", teamInfo.Team.ID.String()),
+		attribute.String("secret.name", secretName),
+	)
+
+	if params.IfMatch != nil {
+		current, err := a.db.GetTeamSecret(ctx, teamInfo.Team.ID, secretName)
+		if err != nil && !errors.Is(err, db.ErrTeamSecretNotFound) {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting secret")
+
+			telemetry.ReportCriticalError(ctx, err)
+
+			return
+		}
+
+		currentETag := ""
+		if current != nil {
+			currentETag = secretETag(current.LatestVersion)
+		}
+
+		if !a.checkIfMatch(c, params.IfMatch, currentETag) {
+			return
+		}
+	}
+
+	err := a.db.DeleteTeamSecret(ctx, teamInfo.Team.ID, secretName)
+	if err != nil {
+		if errors.Is(err, db.ErrTeamSecretNotFound) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Secret '%s' not found", secretName))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting secret")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	a.logger.Infof("Deleted secret '%s' of team '%s'", secretName, teamInfo.Team.ID)
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a *APIStore) GetSecretsSecretNameVersions(c *gin.Context, secretName api.SecretName) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsRead) {
+		return
+	}
+
+	versions, err := a.db.GetTeamSecretVersions(ctx, teamInfo.Team.ID, secretName)
+	if err != nil {
+		if errors.Is(err, db.ErrTeamSecretNotFound) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Secret '%s' not found", secretName))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting secret versions")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	result := make([]api.TeamSecretVersion, 0, len(versions))
+	for _, version := range versions {
+		result = append(result, api.TeamSecretVersion{
+			Version:   version.Version,
+			CreatedAt: version.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// sandboxSecrets are the team secrets resolved for the sandbox.
+type sandboxSecrets struct {
+	// values are the attached secrets by their names.
+	values map[string]string
+	// refs are the references of the attached secrets, they are kept with the sandbox, so the secrets can be attached on the resume.
+	refs []string
+	// envVars are the env vars with the secret references replaced by the values.
+	envVars map[string]string
+	// secretEnvVars are the names of the env vars with the secret references.
+	secretEnvVars []string
+}
+
+// resolveSandboxSecrets returns the team secrets referenced by the build, the request and the values of the env vars,
+// the error is sent to the client if the secrets can't be resolved. Only the references are logged, so the access to the secrets can be audited.
+func (a *APIStore) resolveSandboxSecrets(
+	ctx context.Context,
+	c *gin.Context,
+	sandboxID string,
+	refs []string,
+	envVars map[string]string,
+) (*sandboxSecrets, bool) {
+	attached, err := secrets.ParseReferences(refs)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid secrets: %s", err))
+
+		return nil, false
+	}
+
+	envVarRefs, secretEnvVars, err := secrets.EnvVarReferences(envVars)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid secrets in env vars: %s", err))
+
+		return nil, false
+	}
+
+	result := &sandboxSecrets{
+		envVars: envVars,
+	}
+
+	if len(attached) == 0 && len(envVarRefs) == 0 {
+		return result, true
+	}
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	if !a.checkSecretsScope(c, teamInfo, authcache.ScopeSecretsUse) {
+		return nil, false
+	}
+
+	for name := range attached {
+		if _, ok := envVars[name]; ok {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Secret '%s' is also in the env vars", name))
+
+			return nil, false
+		}
+	}
+
+	all := make(secrets.References, len(attached)+len(envVarRefs))
+	for _, references := range []secrets.References{attached, envVarRefs} {
+		err = all.Merge(references)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid secrets: %s", err))
+
+			return nil, false
+		}
+	}
+
+	values, versions, ok := a.openTeamSecrets(ctx, c, teamInfo.Team.ID, all)
+	if !ok {
+		return nil, false
+	}
+
+	if len(attached) > 0 {
+		result.values = make(map[string]string, len(attached))
+		for name := range attached {
+			result.values[name] = values[name]
+			result.refs = append(result.refs, attached.String(name))
+		}
+
+		slices.Sort(result.refs)
+
+		telemetry.SetAttributes(ctx, attribute.StringSlice("sandbox.secrets", result.refs))
+
+		a.logger.Infof("Attaching secrets [%s] of team '%s' to sandbox '%s'", versions.join(attached), teamInfo.Team.ID, sandboxID)
+	}
+
+	if len(envVarRefs) > 0 {
+		result.envVars = maps.Clone(envVars)
+		for _, name := range secretEnvVars {
+			result.envVars[name] = secrets.ExpandEnvVar(envVars[name], values)
+		}
+
+		slices.Sort(secretEnvVars)
+		result.secretEnvVars = secretEnvVars
+
+		a.logger.Infof("Expanding secrets [%s] of team '%s' in env vars [%s] of sandbox '%s'", versions.join(envVarRefs), teamInfo.Team.ID, strings.Join(secretEnvVars, ", "), sandboxID)
+	}
+
+	return result, true
+}
+
+// resolvedVersions are the versions of the secrets the references were resolved to.
+type resolvedVersions map[string]int32
+
+// join returns the referenced secrets with their resolved versions for the audit log.
+func (v resolvedVersions) join(refs secrets.References) string {
+	names := slices.Sorted(maps.Keys(refs))
+	for i, name := range names {
+		names[i] = fmt.Sprintf("%s@%d", name, v[name])
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// openTeamSecrets returns the decrypted values of the referenced secrets and their resolved versions by their names, the error is sent to the client.
+func (a *APIStore) openTeamSecrets(ctx context.Context, c *gin.Context, teamID uuid.UUID, refs secrets.References) (map[string]string, resolvedVersions, bool) {
+	sealed, err := a.db.GetTeamSecretValues(ctx, teamID, refs)
+	if err != nil {
+		if errors.Is(err, db.ErrTeamSecretNotFound) {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error attaching secrets: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return nil, nil, false
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting secrets")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, nil, false
+	}
+
+	values := make(map[string]string, len(sealed))
+	versions := make(resolvedVersions, len(sealed))
+
+	for _, secret := range sealed {
+		value, err := a.secretsVault.Open(ctx, teamID, secret.Name, secret.Version, secret.Sealed)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when decrypting secrets")
+
+			telemetry.ReportCriticalError(ctx, fmt.Errorf("failed to decrypt version %d of secret '%s': %w", secret.Version, secret.Name, err))
+
+			return nil, nil, false
+		}
+
+		values[secret.Name] = value
+		versions[secret.Name] = secret.Version
+	}
+
+	return values, versions, true
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// GetState lists the resources of the team with their entity tags, so the declarative clients can find the resources
+// that were changed or deleted outside of their configuration without reading each resource.
+func (a *APIStore) GetState(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("team.id", teamID.String()),
+	)
+
+	envs, err := a.db.ReadOnly().GetEnvs(ctx, teamID, db.ListEnvsOptions{})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting sandbox templates")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting envs: %w", err))
+
+		return
+	}
+
+	teamSecrets, err := a.db.ReadOnly().GetTeamSecrets(ctx, teamID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting secrets")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting secrets: %w", err))
+
+		return
+	}
+
+	state := api.TeamState{
+		Templates: make([]api.ResourceState, 0, len(envs)),
+		Secrets:   make([]api.ResourceState, 0, len(teamSecrets)),
+		Sandboxes: make([]api.ResourceState, 0),
+	}
+
+	for _, template := range envs {
+		tag, err := templateETag(template)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting sandbox templates")
+
+			telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting etag of env '%s': %w", template.TemplateID, err))
+
+			return
+		}
+
+		state.Templates = append(state.Templates, api.ResourceState{
+			Id:      template.TemplateID,
+			Aliases: template.Aliases,
+			Etag:    tag,
+		})
+	}
+
+	for _, secret := range teamSecrets {
+		state.Secrets = append(state.Secrets, api.ResourceState{
+			Id:   secret.Name,
+			Etag: secretETag(secret.LatestVersion),
+		})
+	}
+
+	for _, info := range a.orchestrator.GetSandboxes(ctx, &teamID) {
+		if info.TeamID == nil || *info.TeamID != teamID {
+			continue
+		}
+
+		tag, err := sandboxETag(info.Instance.TemplateID, info.Metadata, info.Labels)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting sandboxes")
+
+			telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting etag of sandbox '%s': %w", info.Instance.SandboxID, err))
+
+			return
+		}
+
+		state.Sandboxes = append(state.Sandboxes, api.ResourceState{
+			Id:   info.Instance.SandboxID,
+			Etag: tag,
+		})
+	}
+
+	c.JSON(http.StatusOK, state)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -20,19 +21,37 @@ import (
 
 	analyticscollector "github.com/e2b-dev/infra/packages/api/internal/analytics_collector"
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/budget"
+	"github.com/e2b-dev/infra/packages/api/internal/buildlogs"
+	"github.com/e2b-dev/infra/packages/api/internal/buildstate"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/builds"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/invalidation"
 	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/rebuild"
+	"github.com/e2b-dev/infra/packages/api/internal/replication"
+	"github.com/e2b-dev/infra/packages/api/internal/secrets"
+	"github.com/e2b-dev/infra/packages/api/internal/status"
 	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
+	"github.com/e2b-dev/infra/packages/api/internal/usage"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/gin_utils/middleware"
+	"github.com/e2b-dev/infra/packages/shared/pkg/health"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logging"
 )
 
 const (
 	defaultRequestLimit = 16
+
+	healthProbeTimeout = 3 * time.Second
+	// healthCacheDuration is how long the result of the deep health probes is reused, the endpoint is public
+	// and every request would probe the database and all the nodes otherwise.
+	healthCacheDuration = 5 * time.Second
 )
 
 var sandboxStartRequestLimit = semaphore.NewWeighted(defaultRequestLimit)
@@ -43,12 +62,27 @@ type APIStore struct {
 	orchestrator         *orchestrator.Orchestrator
 	templateManager      *template_manager.TemplateManager
 	buildCache           *builds.BuildCache
+	buildReconciler      *buildstate.Reconciler
 	db                   *db.DB
 	lokiClient           *loki.DefaultClient
 	logger               *zap.SugaredLogger
 	templateCache        *templatecache.TemplateCache
 	authCache            *authcache.TeamAuthCache
 	templateSpawnCounter *utils.TemplateSpawnCounter
+	secretsVault         *secrets.Vault
+	replication          *replication.Controller
+	budgets              *budget.Controller
+	status               *status.Checker
+	redis                *redis.Client
+
+	deepHealthMu   sync.Mutex
+	lastDeepHealth *deepHealthResult
+}
+
+type deepHealthResult struct {
+	httpStatus int
+	response   api.DeepHealth
+	checkedAt  time.Time
 }
 
 func NewAPIStore(ctx context.Context) *APIStore {
@@ -73,14 +107,19 @@ func NewAPIStore(ctx context.Context) *APIStore {
 		logger.Panic("initializing Posthog client", zap.Error(posthogErr))
 	}
 
-	nomadConfig := &nomadapi.Config{
-		Address:  env.GetEnv("NOMAD_ADDRESS", "http://localhost:4646"),
-		SecretID: os.Getenv("NOMAD_TOKEN"),
-	}
+	var nomadClient *nomadapi.Client
+	if env.IsKubernetes() {
+		logger.Info("Running in Kubernetes, the nodes register with the API instead of being discovered via Nomad")
+	} else {
+		nomadConfig := &nomadapi.Config{
+			Address:  env.GetEnv("NOMAD_ADDRESS", "http://localhost:4646"),
+			SecretID: os.Getenv("NOMAD_TOKEN"),
+		}
 
-	nomadClient, err := nomadapi.NewClient(nomadConfig)
-	if err != nil {
-		logger.Panic("initializing Nomad client", zap.Error(err))
+		nomadClient, err = nomadapi.NewClient(nomadConfig)
+		if err != nil {
+			logger.Panic("initializing Nomad client", zap.Error(err))
+		}
 	}
 
 	var redisClient *redis.Client
@@ -95,7 +134,7 @@ func NewAPIStore(ctx context.Context) *APIStore {
 		logger.Warn("REDIS_URL not set, using local caches")
 	}
 
-	orch, err := orchestrator.New(ctx, tracer, nomadClient, logger, posthogClient, redisClient)
+	orch, err := orchestrator.New(ctx, tracer, nomadClient, logger, posthogClient, redisClient, dbClient)
 	if err != nil {
 		logger.Panic("initializing Orchestrator client", zap.Error(err))
 	}
@@ -105,6 +144,11 @@ func NewAPIStore(ctx context.Context) *APIStore {
 		logger.Panic("initializing Template manager client", zap.Error(err))
 	}
 
+	secretsKeyManager, err := secrets.NewKeyManager(ctx)
+	if err != nil {
+		logger.Panic("initializing secrets key manager", zap.Error(err))
+	}
+
 	var lokiClient *loki.DefaultClient
 	if laddr := os.Getenv("LOKI_ADDRESS"); laddr != "" {
 		lokiClient = &loki.DefaultClient{
@@ -114,25 +158,60 @@ func NewAPIStore(ctx context.Context) *APIStore {
 		logger.Warn("LOKI_ADDRESS not set, disabling Loki client")
 	}
 
-	buildCache := builds.NewBuildCache()
+	buildCache := builds.NewBuildCache(dbClient)
+	go buildlogs.StartRetention(ctx, dbClient, logger)
+
+	buildReconciler := buildstate.NewReconciler(dbClient, logger)
+	go buildReconciler.Start(ctx)
 
 	templateCache := templatecache.NewTemplateCache(dbClient)
 	authCache := authcache.NewTeamAuthCache(dbClient)
+
+	// Keep the caches coherent with the changes made by the other API instances
+	go invalidation.NewListener(authCache, templateCache, logger).Start(ctx)
+
 	templateSpawnCounter := utils.NewTemplateSpawnCounter(time.Minute, dbClient)
 
-	return &APIStore{
+	replicationController := replication.NewController(dbClient, redisClient, logger)
+	go replicationController.Start(ctx)
+
+	go usage.NewRollup(dbClient, redisClient, logger).Start(ctx)
+
+	budgetController := budget.NewController(dbClient, orch, posthogClient, logger)
+	go budgetController.Start(ctx)
+
+	statusChecker := status.NewChecker(dbClient, redisClient, orch, logger)
+	go statusChecker.Start(ctx)
+
+	store := &APIStore{
 		orchestrator:         orch,
 		templateManager:      templateManager,
 		db:                   dbClient,
 		Tracer:               tracer,
 		posthog:              posthogClient,
 		buildCache:           buildCache,
+		buildReconciler:      buildReconciler,
 		logger:               logger,
 		lokiClient:           lokiClient,
 		templateCache:        templateCache,
 		authCache:            authCache,
 		templateSpawnCounter: templateSpawnCounter,
+		secretsVault:         secrets.NewVault(secretsKeyManager),
+		replication:          replicationController,
+		budgets:              budgetController,
+		status:               statusChecker,
+		redis:                redisClient,
 	}
+
+	// The scheduled rebuilds are built like the builds requested by the user
+	go rebuild.NewController(dbClient, logger, store.rebuildTemplate).Start(ctx)
+
+	return store
+}
+
+// NodeRegistry returns the registry the orchestrators register with in Kubernetes.
+func (a *APIStore) NodeRegistry() *node.Registry {
+	return a.orchestrator.Registry()
 }
 
 func (a *APIStore) Close() error {
@@ -161,21 +240,123 @@ func (a *APIStore) Close() error {
 // This function wraps sending of an error in the Error format, and
 // handling the failure to marshal that.
 func (a *APIStore) sendAPIStoreError(c *gin.Context, code int, message string) {
+	a.sendAPIStoreErrorCode(c, code, errcode.FromHTTPStatus(code), message)
+}
+
+// sendAPIStoreErrorCode sends the error with a more specific error code than the one derived from the HTTP status.
+func (a *APIStore) sendAPIStoreErrorCode(c *gin.Context, code int, errorCode errcode.Code, message string) {
 	apiErr := api.Error{
-		Code:    int32(code),
-		Message: message,
+		Code:      int32(code),
+		Message:   message,
+		ErrorCode: string(errorCode),
+		RequestID: middleware.GetRequestID(c),
 	}
 
 	c.Error(fmt.Errorf(message))
 	c.JSON(code, apiErr)
 }
 
+// ActiveSandboxes returns the sandboxes in the API cache, it is used by the diagnostics server.
+func (a *APIStore) ActiveSandboxes(ctx context.Context) any {
+	return a.orchestrator.GetSandboxes(ctx, nil)
+}
+
 func (a *APIStore) GetHealth(c *gin.Context) {
 	c.String(http.StatusOK, "Health check successful")
 }
 
+// GetHealthDeep probes the dependencies of the API, the errors of the probes are only logged, the endpoint is public.
+func (a *APIStore) GetHealthDeep(c *gin.Context) {
+	result := a.probeDeepHealth(c.Request.Context())
+
+	c.JSON(result.httpStatus, result.response)
+}
+
+// probeDeepHealth returns the result of the probes of the dependencies, it's reused for the healthCacheDuration
+// and the concurrent requests wait for the same probes, so the requests to the endpoint don't multiply the load of the dependencies.
+func (a *APIStore) probeDeepHealth(ctx context.Context) *deepHealthResult {
+	a.deepHealthMu.Lock()
+	defer a.deepHealthMu.Unlock()
+
+	if a.lastDeepHealth != nil && time.Since(a.lastDeepHealth.checkedAt) < healthCacheDuration {
+		return a.lastDeepHealth
+	}
+
+	probes := []health.Probe{
+		{Name: "database", Check: a.db.Ping},
+		{Name: "orchestrators", Check: a.checkOrchestrators},
+	}
+
+	if a.redis != nil {
+		probes = append(probes, health.Probe{
+			Name: "redis",
+			Check: func(ctx context.Context) error {
+				// The caches fall back to the database and the locks are skipped without Redis
+				err := a.redis.Ping(ctx).Err()
+				if err != nil {
+					return health.DegradedError(err)
+				}
+
+				return nil
+			},
+		})
+	}
+
+	// The result is shared with the other requests, so the probes don't fail when the request is canceled
+	result := health.Check(context.WithoutCancel(ctx), healthProbeTimeout, probes)
+
+	response := api.DeepHealth{
+		Status: api.ComponentHealth(result.Status),
+		Probes: make([]api.HealthProbe, len(result.Probes)),
+	}
+
+	for i, probe := range result.Probes {
+		if probe.Error != "" {
+			a.logger.Warnf("Health probe '%s' is %s: %s", probe.Name, probe.Status, probe.Error)
+		}
+
+		response.Probes[i] = api.HealthProbe{
+			Name:       probe.Name,
+			Status:     api.ComponentHealth(probe.Status),
+			DurationMs: probe.DurationMs,
+		}
+	}
+
+	a.lastDeepHealth = &deepHealthResult{
+		httpStatus: result.HTTPStatus(),
+		response:   response,
+		checkedAt:  time.Now(),
+	}
+
+	return a.lastDeepHealth
+}
+
+// checkOrchestrators fails if no node is reachable and degrades the API if some nodes aren't reachable.
+func (a *APIStore) checkOrchestrators(ctx context.Context) error {
+	nodes := a.orchestrator.CheckNodes(ctx)
+	if len(nodes) == 0 {
+		return fmt.Errorf("no orchestrator nodes are connected")
+	}
+
+	var errs []error
+	for _, node := range nodes {
+		if node.Err != nil {
+			errs = append(errs, node.Err)
+		}
+	}
+
+	switch {
+	case len(errs) == len(nodes):
+		return errors.Join(errs...)
+	case len(errs) > 0:
+		return health.DegradedError(errors.Join(errs...))
+	default:
+		return nil
+	}
+}
+
 func (a *APIStore) GetTeamFromAPIKey(ctx context.Context, apiKey string) (authcache.AuthTeamInfo, *api.APIError) {
-	team, tier, err := a.authCache.Get(ctx, apiKey)
+	team, tier, scopes, err := a.authCache.Get(ctx, apiKey)
 	if err != nil {
 		return authcache.AuthTeamInfo{}, &api.APIError{
 			Err:       fmt.Errorf("failed to get the team from db for an api key: %w", err),
@@ -185,8 +366,9 @@ func (a *APIStore) GetTeamFromAPIKey(ctx context.Context, apiKey string) (authca
 	}
 
 	return authcache.AuthTeamInfo{
-		Team: team,
-		Tier: tier,
+		Team:   team,
+		Tier:   tier,
+		Scopes: scopes,
 	}, nil
 }
 
@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const defaultBudgetWarningPercent = 80
+
+// GetBudget returns the monthly budget of the team with the cost accrued in the current period
+func (a *APIStore) GetBudget(c *gin.Context, params api.GetBudgetParams) {
+	ctx := c.Request.Context()
+
+	team, ok := a.getRequestedTeam(c, params.TeamID)
+	if !ok {
+		return
+	}
+
+	budget, err := a.db.GetTeamBudget(ctx, team.ID)
+	if errors.Is(err, db.ErrTeamBudgetNotFound) {
+		a.sendAPIStoreError(c, http.StatusNotFound, "The team has no budget")
+
+		return
+	}
+
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting budget")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting budget: %w", err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, teamBudgetResponse(budget))
+}
+
+// PutBudget sets the monthly budget of the team
+func (a *APIStore) PutBudget(c *gin.Context, params api.PutBudgetParams) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.PutBudgetJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when parsing request: %w", err))
+
+		return
+	}
+
+	if body.MonthlyLimitUsd <= 0 {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "The monthly limit must be positive")
+
+		return
+	}
+
+	warningPercent := int32(defaultBudgetWarningPercent)
+	if body.WarningPercent != nil {
+		warningPercent = *body.WarningPercent
+	}
+
+	if warningPercent < 1 || warningPercent > 100 {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "The warning percent must be between 1 and 100")
+
+		return
+	}
+
+	pauseSandboxes := false
+	if body.PauseSandboxes != nil {
+		pauseSandboxes = *body.PauseSandboxes
+	}
+
+	team, ok := a.getRequestedTeam(c, params.TeamID)
+	if !ok {
+		return
+	}
+
+	budget, err := a.db.SetTeamBudget(ctx, team.ID, body.MonthlyLimitUsd, warningPercent, pauseSandboxes)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting budget")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when setting budget: %w", err))
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "set team budget",
+		attribute.Float64("budget.monthly_limit_usd", body.MonthlyLimitUsd),
+		attribute.Int("budget.warning_percent", int(warningPercent)),
+		attribute.Bool("budget.pause_sandboxes", pauseSandboxes),
+	)
+
+	c.JSON(http.StatusOK, teamBudgetResponse(budget))
+}
+
+// DeleteBudget deletes the monthly budget of the team, the team isn't limited anymore
+func (a *APIStore) DeleteBudget(c *gin.Context, params api.DeleteBudgetParams) {
+	ctx := c.Request.Context()
+
+	team, ok := a.getRequestedTeam(c, params.TeamID)
+	if !ok {
+		return
+	}
+
+	err := a.db.DeleteTeamBudget(ctx, team.ID)
+	if errors.Is(err, db.ErrTeamBudgetNotFound) {
+		a.sendAPIStoreError(c, http.StatusNotFound, "The team has no budget")
+
+		return
+	}
+
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting budget")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when deleting budget: %w", err))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PutTeamsTeamIDBudgetOverride suspends the enforcement of the team's budget until the time
+func (a *APIStore) PutTeamsTeamIDBudgetOverride(c *gin.Context, teamID api.TeamID) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.PutTeamsTeamIDBudgetOverrideJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when parsing request: %w", err))
+
+		return
+	}
+
+	if !body.Until.After(time.Now()) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "The override must end in the future")
+
+		return
+	}
+
+	a.setTeamBudgetOverride(c, teamID, &body.Until, body.Reason)
+}
+
+// DeleteTeamsTeamIDBudgetOverride removes the override of the team's budget, the budget is enforced again
+func (a *APIStore) DeleteTeamsTeamIDBudgetOverride(c *gin.Context, teamID api.TeamID) {
+	a.setTeamBudgetOverride(c, teamID, nil, nil)
+}
+
+func (a *APIStore) setTeamBudgetOverride(c *gin.Context, teamID api.TeamID, until *time.Time, reason *string) {
+	ctx := c.Request.Context()
+
+	teamUUID, err := uuid.Parse(teamID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid team ID")
+
+		telemetry.ReportError(ctx, err)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx, attribute.String("team.id", teamUUID.String()))
+
+	budget, err := a.db.SetTeamBudgetOverride(ctx, teamUUID, until, reason)
+	if errors.Is(err, db.ErrTeamBudgetNotFound) {
+		a.sendAPIStoreError(c, http.StatusNotFound, "The team has no budget")
+
+		return
+	}
+
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when overriding budget")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when overriding budget: %w", err))
+
+		return
+	}
+
+	if until == nil {
+		c.Status(http.StatusNoContent)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, teamBudgetResponse(budget))
+}
+
+func teamBudgetResponse(budget *models.TeamBudget) api.TeamBudget {
+	result := api.TeamBudget{
+		MonthlyLimitUsd: budget.MonthlyLimitUsd,
+		WarningPercent:  budget.WarningPercent,
+		PauseSandboxes:  budget.PauseSandboxes,
+		AccruedUsd:      budget.AccruedUsd,
+		PeriodStart:     budget.PeriodStart,
+		Status:          api.Ok,
+		OverrideUntil:   budget.OverrideUntil,
+		OverrideReason:  budget.OverrideReason,
+	}
+
+	// The budget wasn't metered in the current period yet, nothing is accrued in it
+	if period := db.BudgetPeriodStart(time.Now()); budget.PeriodStart.Before(period) {
+		result.AccruedUsd = 0
+		result.PeriodStart = period
+
+		return result
+	}
+
+	switch {
+	case budget.AccruedUsd >= budget.MonthlyLimitUsd:
+		result.Status = api.Exceeded
+	case budget.AccruedUsd >= budget.MonthlyLimitUsd*float64(budget.WarningPercent)/100:
+		result.Status = api.Warning
+	}
+
+	return result
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	defaultUsageDays = 30
+	maxUsageDays     = 366
+)
+
+// GetUsageDaily returns the rolled up daily usage of the team
+func (a *APIStore) GetUsageDaily(c *gin.Context, params api.GetUsageDailyParams) {
+	ctx := c.Request.Context()
+
+	end := time.Now()
+	if params.End != nil {
+		end = *params.End
+	}
+
+	start := end.AddDate(0, 0, -defaultUsageDays)
+	if params.Start != nil {
+		start = *params.Start
+	}
+
+	if start.After(end) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "The start must be before the end")
+
+		return
+	}
+
+	if end.Sub(start) > maxUsageDays*24*time.Hour {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("The usage can be returned for at most %d days", maxUsageDays))
+
+		return
+	}
+
+	team, ok := a.getRequestedTeam(c, params.TeamID)
+	if !ok {
+		return
+	}
+
+	// The days are rolled up by their start, the day the start is in is returned whole
+	usage, err := a.db.GetTeamUsage(ctx, team.ID, start.UTC().Truncate(24*time.Hour), end)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting usage")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting usage: %w", err))
+
+		return
+	}
+
+	result := make([]api.TeamUsageDay, len(usage))
+	for i, u := range usage {
+		result[i] = api.TeamUsageDay{
+			Day:                u.Day,
+			SandboxesStarted:   u.SandboxesStarted,
+			SandboxesResumed:   u.SandboxesResumed,
+			ResumeLatencyP95Ms: u.ResumeP95Ms,
+			Builds:             u.Builds,
+			BuildMinutes:       float64(u.BuildSeconds) / 60,
+		}
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetUsageStorage returns the measured storage of the team's templates
+func (a *APIStore) GetUsageStorage(c *gin.Context, params api.GetUsageStorageParams) {
+	ctx := c.Request.Context()
+
+	team, ok := a.getRequestedTeam(c, params.TeamID)
+	if !ok {
+		return
+	}
+
+	storage, err := a.db.GetTeamTemplateStorage(ctx, team.ID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting storage usage")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting storage usage: %w", err))
+
+		return
+	}
+
+	result := make([]api.TemplateStorageUsage, len(storage))
+	for i, s := range storage {
+		result[i] = api.TemplateStorageUsage{
+			TemplateID:    s.EnvID,
+			TemplateBytes: s.TemplateBytes,
+			SnapshotBytes: s.SnapshotBytes,
+			UpdatedAt:     s.UpdatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// getRequestedTeam returns the team of the user with the ID, the default team of the user if the ID isn't set.
+// The error response is sent if false is returned.
+func (a *APIStore) getRequestedTeam(c *gin.Context, teamID *string) (*models.Team, bool) {
+	ctx := c.Request.Context()
+
+	userID, teams, err := a.GetUserAndTeams(c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting teams")
+
+		err = fmt.Errorf("error when getting teams: %w", err)
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, false
+	}
+
+	var team *models.Team
+	if teamID != nil {
+		teamUUID, err := uuid.Parse(*teamID)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid team ID")
+
+			telemetry.ReportError(ctx, err)
+
+			return nil, false
+		}
+
+		for _, t := range teams {
+			if t.ID == teamUUID {
+				team = t
+				break
+			}
+		}
+
+		if team == nil {
+			a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.TeamNotFound, "Team not found")
+
+			telemetry.ReportError(ctx, fmt.Errorf("team not found"))
+
+			return nil, false
+		}
+	} else {
+		for _, t := range teams {
+			if t.Edges.UsersTeams[0].IsDefault {
+				team = t
+				break
+			}
+		}
+
+		if team == nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Default team not found")
+
+			telemetry.ReportError(ctx, fmt.Errorf("default team not found"))
+
+			return nil, false
+		}
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("user.id", userID.String()),
+		attribute.String("team.id", team.ID.String()),
+	)
+
+	return team, true
+}
@@ -3,10 +3,13 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -40,10 +43,8 @@ func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context, tem
 
 	dockerBuild, err := a.buildCache.Get(templateID, buildUUID)
 	if err != nil {
-		msg := fmt.Errorf("error finding cache for env %s and build %s", templateID, buildID)
-		telemetry.ReportError(ctx, msg)
-
-		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build (%s) not found", buildID))
+		// The finished builds expire from the cache, their logs are read from the database
+		a.getStoredBuildStatus(c, teams, templateID, buildUUID, *params.LogsOffset)
 
 		return
 	}
@@ -79,3 +80,56 @@ func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context, tem
 
 	c.JSON(http.StatusOK, result)
 }
+
+// getStoredBuildStatus sends the status of the build from the database with its stored logs.
+func (a *APIStore) getStoredBuildStatus(c *gin.Context, teams []*models.Team, templateID string, buildID uuid.UUID, logsOffset int32) {
+	ctx := c.Request.Context()
+
+	build, err := a.db.Client.EnvBuild.Query().Where(envbuild.ID(buildID), envbuild.EnvID(templateID)).WithEnv().Only(ctx)
+	if err != nil {
+		if !models.IsNotFound(err) {
+			telemetry.ReportError(ctx, fmt.Errorf("error when getting build %s of env %s: %w", buildID, templateID, err))
+		}
+
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.BuildNotFound, fmt.Sprintf("Build (%s) not found", buildID))
+
+		return
+	}
+
+	if !slices.ContainsFunc(teams, func(t *models.Team) bool { return t.ID == build.Edges.Env.TeamID }) {
+		telemetry.ReportError(ctx, fmt.Errorf("user doesn't have access to env '%s'", templateID))
+
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to this sandbox template (%s)", templateID))
+
+		return
+	}
+
+	storedLogs, err := a.db.GetEnvBuildLogs(ctx, buildID, logsOffset)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting build logs: %w", err))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting build logs")
+
+		return
+	}
+
+	logs := make([]string, len(storedLogs))
+	for i, log := range storedLogs {
+		logs[i] = log.Message
+	}
+
+	status := api.TemplateBuildStatusBuilding
+	switch build.Status {
+	case envbuild.StatusSuccess, envbuild.StatusUploaded:
+		status = api.TemplateBuildStatusReady
+	case envbuild.StatusFailed:
+		status = api.TemplateBuildStatusError
+	}
+
+	c.JSON(http.StatusOK, api.TemplateBuild{
+		Logs:       logs,
+		TemplateID: templateID,
+		BuildID:    buildID.String(),
+		Status:     status,
+	})
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// PostTemplatesTemplateIDBuildsBuildIDCancel cancels the running build. The build running in this API instance is stopped right away,
+// the build running in the other instance is stopped by its heartbeat. Stopping the build stops the build in the template manager,
+// which kills the build VM with its processes and removes the build files.
+func (a *APIStore) PostTemplatesTemplateIDBuildsBuildIDCancel(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid build ID: %s", buildID))
+
+		telemetry.ReportError(ctx, fmt.Errorf("error when parsing build id: %w", err))
+
+		return
+	}
+
+	userID, teams, err := a.GetUserAndTeams(c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting teams")
+
+		err = fmt.Errorf("error when getting teams: %w", err)
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	build, err := a.db.Client.EnvBuild.Query().Where(envbuild.ID(buildUUID), envbuild.EnvID(templateID)).WithEnv().Only(ctx)
+	if models.IsNotFound(err) {
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.BuildNotFound, fmt.Sprintf("Build (%s) not found", buildID))
+
+		return
+	} else if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting build")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting build: %w", err))
+
+		return
+	}
+
+	var team *models.Team
+	for _, t := range teams {
+		if t.ID == build.Edges.Env.TeamID {
+			team = t
+			break
+		}
+	}
+
+	if team == nil {
+		telemetry.ReportError(ctx, fmt.Errorf("user '%s' doesn't have access to env '%s'", userID, templateID))
+
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to this sandbox template (%s)", templateID))
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("user.id", userID.String()),
+		attribute.String("team.id", team.ID.String()),
+		attribute.String("env.id", templateID),
+		attribute.String("build.id", buildID),
+	)
+
+	// The variants are built with their build, the build is canceled with all its variants
+	if build.VariantOf != nil {
+		buildUUID = *build.VariantOf
+	}
+
+	canceled, err := a.db.CancelEnvBuild(ctx, templateID, buildUUID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when canceling build")
+
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when canceling build: %w", err))
+
+		return
+	}
+
+	if !canceled {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Build (%s) isn't running", buildID))
+
+		return
+	}
+
+	if a.buildCache.Cancel(templateID, buildUUID) {
+		telemetry.ReportEvent(ctx, "stopped the build running in this instance")
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -8,6 +8,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
@@ -16,7 +17,7 @@ import (
 )
 
 // DeleteTemplatesTemplateID serves to delete an env (e.g. in CLI)
-func (a *APIStore) DeleteTemplatesTemplateID(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+func (a *APIStore) DeleteTemplatesTemplateID(c *gin.Context, aliasOrTemplateID api.TemplateID, params api.DeleteTemplatesTemplateIDParams) {
 	ctx := c.Request.Context()
 
 	cleanedAliasOrEnvID, err := id.CleanEnvID(aliasOrTemplateID)
@@ -54,7 +55,7 @@ func (a *APIStore) DeleteTemplatesTemplateID(c *gin.Context, aliasOrTemplateID a
 	notFound := models.IsNotFound(err)
 	if notFound {
 		telemetry.ReportError(ctx, fmt.Errorf("template '%s' not found", aliasOrTemplateID))
-		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("the sandbox template '%s' wasn't found", cleanedAliasOrEnvID))
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.TemplateNotFound, fmt.Sprintf("the sandbox template '%s' wasn't found", cleanedAliasOrEnvID))
 
 		return
 	} else if err != nil {
@@ -81,6 +82,20 @@ func (a *APIStore) DeleteTemplatesTemplateID(c *gin.Context, aliasOrTemplateID a
 		return
 	}
 
+	if params.IfMatch != nil {
+		currentETag, err := a.getTemplateETag(ctx, template.ID)
+		if err != nil {
+			telemetry.ReportError(ctx, fmt.Errorf("failed to get etag of env '%s': %w", template.ID, err))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting env")
+
+			return
+		}
+
+		if !a.checkIfMatch(c, params.IfMatch, currentETag) {
+			return
+		}
+	}
+
 	telemetry.SetAttributes(ctx,
 		attribute.String("user.id", userID.String()),
 		attribute.String("env.team.id", team.ID.String()),
@@ -88,6 +103,21 @@ func (a *APIStore) DeleteTemplatesTemplateID(c *gin.Context, aliasOrTemplateID a
 		attribute.String("env.id", template.ID),
 	)
 
+	// The upload would write the files back to the storage or fail on the deleted layers of the template
+	pendingUploads, err := a.db.HasPendingSnapshotUploads(ctx, template.ID)
+	if err != nil {
+		telemetry.ReportError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when checking the snapshot uploads")
+
+		return
+	}
+
+	if pendingUploads {
+		a.sendAPIStoreErrorCode(c, http.StatusConflict, errcode.Conflict, fmt.Sprintf("the sandbox template '%s' has snapshots that are still being uploaded, try again later", cleanedAliasOrEnvID))
+
+		return
+	}
+
 	deleteJobErr := a.templateManager.DeleteInstance(ctx, template.ID)
 	if deleteJobErr != nil {
 		errMsg := fmt.Errorf("error when deleting env files from storage: %w", deleteJobErr)
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// GetTemplatesTemplateID serves to get a template by its ID or alias (e.g. to import the existing template)
+func (a *APIStore) GetTemplatesTemplateID(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	cleanedAliasOrEnvID, err := id.CleanEnvID(aliasOrTemplateID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid env ID: %s", aliasOrTemplateID))
+
+		err = fmt.Errorf("invalid env ID: %w", err)
+		telemetry.ReportError(ctx, err)
+
+		return
+	}
+
+	userID, teams, err := a.GetUserAndTeams(c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when getting default team: %s", err))
+
+		err = fmt.Errorf("error when getting default team: %w", err)
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
+
+	template, _, err := a.db.ReadOnly().GetEnv(ctx, cleanedAliasOrEnvID)
+	if models.IsNotFound(err) {
+		telemetry.ReportError(ctx, fmt.Errorf("template '%s' not found", cleanedAliasOrEnvID))
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.TemplateNotFound, fmt.Sprintf("the sandbox template '%s' wasn't found", cleanedAliasOrEnvID))
+
+		return
+	} else if err != nil {
+		telemetry.ReportError(ctx, fmt.Errorf("failed to get env '%s': %w", cleanedAliasOrEnvID, err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting env")
+
+		return
+	}
+
+	var team *models.Team
+	for _, t := range teams {
+		if t.ID == template.TeamID {
+			team = t
+			break
+		}
+	}
+
+	if team == nil {
+		errMsg := fmt.Errorf("user '%s' doesn't have access to the sandbox template '%s'", userID, cleanedAliasOrEnvID)
+		telemetry.ReportError(ctx, errMsg)
+
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You (%s) don't have access to sandbox template '%s'", userID, cleanedAliasOrEnvID))
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("user.id", userID.String()),
+		attribute.String("env.team.id", team.ID.String()),
+		attribute.String("env.id", template.TemplateID),
+	)
+
+	currentETag, err := templateETag(template)
+	if err != nil {
+		telemetry.ReportError(ctx, fmt.Errorf("failed to get etag of env '%s': %w", template.TemplateID, err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting env")
+
+		return
+	}
+
+	c.Header(ETagHeader, currentETag)
+	c.JSON(http.StatusOK, &api.Template{
+		TemplateID:     template.TemplateID,
+		BuildID:        template.BuildID,
+		CpuCount:       int32(template.VCPU),
+		MemoryMB:       int32(template.RAMMB),
+		Public:         template.Public,
+		Aliases:        template.Aliases,
+		CreatedAt:      template.CreatedAt,
+		UpdatedAt:      template.UpdatedAt,
+		LastSpawnedAt:  template.LastSpawnedAt,
+		SpawnCount:     template.SpawnCount,
+		BuildCount:     template.BuildCount,
+		AllowedRegions: allowedRegions(template.AllowedRegions),
+	})
+}
+
+// allowedRegions returns the allowed regions of the template for the response, nil if any region is allowed.
+func allowedRegions(regions []string) *api.Regions {
+	if len(regions) == 0 {
+		return nil
+	}
+
+	return &regions
+}
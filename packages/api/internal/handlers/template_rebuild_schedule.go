@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/rebuild"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const defaultRebuildIntervalHours = 24
+
+// GetTemplatesTemplateIDRebuildSchedule returns the schedule of the automatic rebuilds of the template
+func (a *APIStore) GetTemplatesTemplateIDRebuildSchedule(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	template, ok := a.getRebuiltTemplate(c, aliasOrTemplateID)
+	if !ok {
+		return
+	}
+
+	schedule, err := a.db.GetEnvRebuildSchedule(ctx, template.ID)
+	if errors.Is(err, db.ErrEnvRebuildScheduleNotFound) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("The template '%s' isn't rebuilt automatically", template.ID))
+
+		return
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when getting rebuild schedule: %w", err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting rebuild schedule")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, rebuildScheduleToAPI(schedule))
+}
+
+// PutTemplatesTemplateIDRebuildSchedule schedules the automatic rebuilds of the template, the existing schedule is replaced
+func (a *APIStore) PutTemplatesTemplateIDRebuildSchedule(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.TemplateRebuildScheduleRequest](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+
+		return
+	}
+
+	intervalHours := int32(defaultRebuildIntervalHours)
+	if body.IntervalHours != nil {
+		intervalHours = *body.IntervalHours
+	}
+
+	upgradePackages := true
+	if body.UpgradePackages != nil {
+		upgradePackages = *body.UpgradePackages
+	}
+
+	var webhookSecret *string
+	if body.WebhookUrl != nil {
+		err = rebuild.ValidateWebhookURL(*body.WebhookUrl)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid webhook URL: %s", *body.WebhookUrl))
+
+			return
+		}
+
+		secret, err := rebuild.NewWebhookSecret()
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting rebuild schedule")
+
+			return
+		}
+
+		webhookSecret = &secret
+	}
+
+	template, ok := a.getRebuiltTemplate(c, aliasOrTemplateID)
+	if !ok {
+		return
+	}
+
+	schedule, err := a.db.SetEnvRebuildSchedule(ctx, template.ID, intervalHours, upgradePackages, body.WebhookUrl, webhookSecret)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when setting rebuild schedule: %w", err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting rebuild schedule")
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "set template rebuild schedule", attribute.Int("env.rebuild.interval_hours", int(intervalHours)))
+
+	a.logger.Infof("Scheduled rebuilds of template '%s' every %d hours", template.ID, intervalHours)
+
+	c.JSON(http.StatusOK, rebuildScheduleToAPI(schedule))
+}
+
+// DeleteTemplatesTemplateIDRebuildSchedule stops the automatic rebuilds of the template
+func (a *APIStore) DeleteTemplatesTemplateIDRebuildSchedule(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	template, ok := a.getRebuiltTemplate(c, aliasOrTemplateID)
+	if !ok {
+		return
+	}
+
+	err := a.db.DeleteEnvRebuildSchedule(ctx, template.ID)
+	if errors.Is(err, db.ErrEnvRebuildScheduleNotFound) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("The template '%s' isn't rebuilt automatically", template.ID))
+
+		return
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("error when deleting rebuild schedule: %w", err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting rebuild schedule")
+
+		return
+	}
+
+	a.logger.Infof("Stopped rebuilds of template '%s'", template.ID)
+
+	c.Status(http.StatusNoContent)
+}
+
+// getRebuiltTemplate returns the template the user has access to, the snapshots of the sandboxes can't be rebuilt.
+// The error response is sent if false is returned.
+func (a *APIStore) getRebuiltTemplate(c *gin.Context, aliasOrTemplateID api.TemplateID) (*models.Env, bool) {
+	ctx := c.Request.Context()
+
+	cleanedAliasOrEnvID, err := id.CleanEnvID(aliasOrTemplateID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid env ID: %s", aliasOrTemplateID))
+
+		err = fmt.Errorf("invalid env ID: %w", err)
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, false
+	}
+
+	userID, teams, err := a.GetUserAndTeams(c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when getting default team: %s", err))
+
+		err = fmt.Errorf("error when getting default team: %w", err)
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, false
+	}
+
+	template, err := a.db.
+		Client.
+		Env.
+		Query().
+		Where(
+			env.Or(
+				env.HasEnvAliasesWith(envalias.ID(cleanedAliasOrEnvID)),
+				env.ID(cleanedAliasOrEnvID),
+			),
+			env.HasBuildsWith(envbuild.StatusEQ(envbuild.StatusUploaded)),
+			env.Not(env.HasSnapshots()),
+		).Only(ctx)
+	if models.IsNotFound(err) {
+		telemetry.ReportError(ctx, fmt.Errorf("template '%s' not found", cleanedAliasOrEnvID))
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.TemplateNotFound, fmt.Sprintf("the sandbox template '%s' wasn't found", cleanedAliasOrEnvID))
+
+		return nil, false
+	} else if err != nil {
+		telemetry.ReportError(ctx, fmt.Errorf("failed to get env '%s': %w", cleanedAliasOrEnvID, err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting env")
+
+		return nil, false
+	}
+
+	for _, team := range teams {
+		if team.ID == template.TeamID {
+			telemetry.SetAttributes(ctx,
+				attribute.String("user.id", userID.String()),
+				attribute.String("team.id", team.ID.String()),
+				attribute.String("env.id", template.ID),
+			)
+
+			return template, true
+		}
+	}
+
+	telemetry.ReportError(ctx, fmt.Errorf("user '%s' doesn't have access to the sandbox template '%s'", userID, cleanedAliasOrEnvID))
+	a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You (%s) don't have access to sandbox template '%s'", userID, cleanedAliasOrEnvID))
+
+	return nil, false
+}
+
+// rebuildTemplate builds the scheduled rebuild of the template, the logs of the rebuild are available like the logs of the other builds.
+func (a *APIStore) rebuildTemplate(ctx context.Context, build *models.EnvBuild, upgradePackages bool) error {
+	templateID := *build.EnvID
+
+	template, err := a.db.Client.Env.Get(ctx, templateID)
+	if err != nil {
+		err = fmt.Errorf("error when getting env: %w", err)
+	} else {
+		err = a.buildCache.Create(templateID, build.ID, template.TeamID)
+	}
+
+	if err != nil {
+		dbErr := a.db.EnvBuildSetStatus(ctx, templateID, build.ID, envbuild.StatusFailed)
+		if dbErr != nil {
+			telemetry.ReportCriticalError(ctx, fmt.Errorf("error when setting build status: %w", dbErr))
+		}
+
+		return err
+	}
+
+	return a.buildTemplate(ctx, templateID, build, upgradePackages)
+}
+
+func rebuildScheduleToAPI(schedule *models.EnvRebuildSchedule) *api.TemplateRebuildSchedule {
+	result := &api.TemplateRebuildSchedule{
+		TemplateID:      schedule.EnvID,
+		IntervalHours:   schedule.IntervalHours,
+		UpgradePackages: schedule.UpgradePackages,
+		WebhookUrl:      schedule.WebhookURL,
+		WebhookSecret:   schedule.WebhookSecret,
+		NextRunAt:       schedule.NextRunAt,
+	}
+
+	if schedule.LastBuildID != nil {
+		lastBuildID := schedule.LastBuildID.String()
+		result.LastBuildID = &lastBuildID
+	}
+
+	if schedule.LastBuildStatus != nil {
+		lastBuildStatus := api.TemplateRebuildScheduleLastBuildStatus(*schedule.LastBuildStatus)
+		result.LastBuildStatus = &lastBuildStatus
+	}
+
+	return result
+}
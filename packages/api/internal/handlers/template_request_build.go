@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -11,18 +13,32 @@ import (
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/constants"
+	"github.com/e2b-dev/infra/packages/api/internal/secrets"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/firecracker"
+	"github.com/e2b-dev/infra/packages/shared/pkg/hardening"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/kernel"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
-func (a *APIStore) PostTemplates(c *gin.Context) {
+func (a *APIStore) PostTemplates(c *gin.Context, params api.PostTemplatesParams) {
 	ctx := c.Request.Context()
+
+	done, ok := a.startIdempotentRequest(c, a.GetUserID(c), params.IdempotencyKey)
+	if !ok {
+		return
+	}
+	defer done()
+
 	envID := id.Generate()
 
 	telemetry.ReportEvent(ctx, "started creating new environment")
@@ -33,7 +49,13 @@ func (a *APIStore) PostTemplates(c *gin.Context) {
 	}
 }
 
-func (a *APIStore) PostTemplatesTemplateID(c *gin.Context, templateID api.TemplateID) {
+func (a *APIStore) PostTemplatesTemplateID(c *gin.Context, templateID api.TemplateID, params api.PostTemplatesTemplateIDParams) {
+	done, ok := a.startIdempotentRequest(c, a.GetUserID(c), params.IdempotencyKey)
+	if !ok {
+		return
+	}
+	defer done()
+
 	cleanedTemplateID, err := id.CleanEnvID(templateID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid template ID: %s", cleanedTemplateID))
@@ -89,7 +111,7 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 		}
 
 		if team == nil {
-			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Team '%s' not found", *body.TeamID))
+			a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.TeamNotFound, fmt.Sprintf("Team '%s' not found", *body.TeamID))
 
 			err = fmt.Errorf("team not found: %w", err)
 			telemetry.ReportCriticalError(ctx, err)
@@ -156,6 +178,165 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 		telemetry.SetAttributes(ctx, attribute.String("env.start_cmd", *body.StartCmd))
 	}
 
+	var readinessProbe *schema.ReadinessProbe
+	if body.ReadinessProbe != nil {
+		if body.ReadinessProbe.Command == nil && body.ReadinessProbe.HttpPort == nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Readiness probe requires the command or the HTTP port")
+
+			telemetry.ReportError(ctx, fmt.Errorf("readiness probe without the command or the HTTP port"))
+
+			return nil
+		}
+
+		readinessProbe = &schema.ReadinessProbe{
+			Command:        body.ReadinessProbe.Command,
+			HTTPPort:       body.ReadinessProbe.HttpPort,
+			HTTPPath:       body.ReadinessProbe.HttpPath,
+			TimeoutSeconds: body.ReadinessProbe.Timeout,
+		}
+	}
+
+	kernelVersion := schema.DefaultKernelVersion
+	if body.KernelVersion != nil {
+		kernelVersion = *body.KernelVersion
+
+		// The kernels registered via the admin API are allowed in addition to the configured versions
+		err = kernel.ValidateVersion(kernelVersion)
+		if err != nil {
+			if _, kernelErr := a.db.GetKernel(ctx, kernelVersion); kernelErr == nil {
+				err = nil
+			}
+		}
+
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid kernel version: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return nil
+		}
+	}
+
+	var rootfsBlockSize *int64
+	if body.RootfsBlockSize != nil {
+		size := int64(*body.RootfsBlockSize)
+		if !header.ValidRootfsBlockSize(size) {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid rootfs block size %d, the size must be a power of two between %d and %d", size, header.RootfsBlockSize, header.MaxRootfsBlockSize))
+
+			return nil
+		}
+
+		rootfsBlockSize = &size
+	}
+
+	var kernelArgs []string
+	if body.KernelArgs != nil {
+		kernelArgs = *body.KernelArgs
+
+		err = kernel.ValidateArgs(kernelArgs)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid kernel args: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return nil
+		}
+	}
+
+	firecrackerVersion, canary := firecracker.SelectVersion(templateID)
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("env.kernel.version", kernelVersion),
+		attribute.StringSlice("env.kernel.args", kernelArgs),
+		attribute.String("env.firecracker.version", firecrackerVersion),
+		attribute.Bool("env.firecracker.canary", canary),
+	)
+
+	var hooks *schema.LifecycleHooks
+	if body.Hooks != nil {
+		hooks = &schema.LifecycleHooks{
+			OnResume: lifecycleHookFromAPI(body.Hooks.OnResume),
+			OnPause:  lifecycleHookFromAPI(body.Hooks.OnPause),
+		}
+	}
+
+	// Only the references are stored with the build, the secrets are resolved when the sandbox is created from the template
+	var secretRefs []string
+	if body.Secrets != nil {
+		refs, err := secrets.ParseReferences(*body.Secrets)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid secrets: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return nil
+		}
+
+		_, err = a.db.GetTeamSecretValues(ctx, team.ID, refs)
+		if err != nil {
+			if errors.Is(err, db.ErrTeamSecretNotFound) {
+				a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid secrets: %s", err))
+
+				telemetry.ReportError(ctx, err)
+
+				return nil
+			}
+
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting secrets")
+
+			telemetry.ReportCriticalError(ctx, err)
+
+			return nil
+		}
+
+		for name := range refs {
+			secretRefs = append(secretRefs, refs.String(name))
+		}
+
+		slices.Sort(secretRefs)
+
+		telemetry.SetAttributes(ctx, attribute.StringSlice("env.secrets", secretRefs))
+	}
+
+	// The level is resolved into the policy when the template is built, so the sandboxes are hardened the same way even if the levels change
+	var hardeningPolicy *schema.HardeningPolicy
+	if body.Hardening != nil {
+		var readOnlyPaths, deniedSyscalls []string
+		if body.Hardening.ReadOnlyPaths != nil {
+			readOnlyPaths = *body.Hardening.ReadOnlyPaths
+		}
+
+		if body.Hardening.DeniedSyscalls != nil {
+			deniedSyscalls = *body.Hardening.DeniedSyscalls
+		}
+
+		hardeningPolicy, err = hardening.Resolve(string(body.Hardening.Level), body.Hardening.NoNewPrivileges, readOnlyPaths, deniedSyscalls)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid hardening: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return nil
+		}
+
+		telemetry.SetAttributes(ctx, attribute.String("env.hardening.level", string(body.Hardening.Level)))
+	}
+
+	// The preset is resolved into the policy when the template is built too, so the presets can change without affecting the built templates
+	var egressPolicy *schema.EgressPolicy
+	if body.Egress != nil {
+		egressPolicy, err = resolveEgress(body.Egress)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid egress: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return nil
+		}
+
+		telemetry.SetAttributes(ctx, attribute.String("env.egress.preset", string(body.Egress.Preset)))
+	}
+
 	if body.CpuCount != nil {
 		telemetry.SetAttributes(ctx, attribute.Int("env.cpu", int(*body.CpuCount)))
 	}
@@ -172,6 +353,46 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 		return nil
 	}
 
+	// The variants are built with the build from the same provisioned rootfs, only their resources differ
+	var variants []api.TemplateVariant
+	var variantBuildIDs []uuid.UUID
+	if body.Variants != nil {
+		for _, variant := range *body.Variants {
+			if slices.ContainsFunc(variants, func(v api.TemplateVariant) bool { return v.Name == variant.Name }) {
+				a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Variant '%s' is defined more than once", variant.Name))
+
+				return nil
+			}
+
+			variantCPU, variantRAMMB, apiError := getCPUAndRAM(team.Tier, &variant.CpuCount, &variant.MemoryMB)
+			if apiError != nil {
+				a.sendAPIStoreError(c, apiError.Code, fmt.Sprintf("Invalid variant '%s': %s", variant.Name, apiError.ClientMsg))
+
+				return nil
+			}
+
+			variantBuildID, err := uuid.NewRandom()
+			if err != nil {
+				err = fmt.Errorf("error when generating build id: %w", err)
+				telemetry.ReportCriticalError(ctx, err)
+
+				a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to generate build id")
+
+				return nil
+			}
+
+			variantBuildIDs = append(variantBuildIDs, variantBuildID)
+			variants = append(variants, api.TemplateVariant{
+				Name:     variant.Name,
+				BuildID:  variantBuildID.String(),
+				CpuCount: int32(variantCPU),
+				MemoryMB: int32(variantRAMMB),
+			})
+		}
+
+		telemetry.SetAttributes(ctx, attribute.Int("env.variants", len(variants)))
+	}
+
 	var alias string
 	if body.Alias != nil {
 		alias, err = id.CleanEnvID(*body.Alias)
@@ -241,13 +462,52 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 		SetStatus(envbuild.StatusWaiting).
 		SetRAMMB(ramMB).
 		SetVcpu(cpuCount).
-		SetKernelVersion(schema.DefaultKernelVersion).
-		SetFirecrackerVersion(schema.DefaultFirecrackerVersion).
+		SetKernelVersion(kernelVersion).
+		SetKernelArgs(kernelArgs).
+		SetNillableRootfsBlockSize(rootfsBlockSize).
+		SetFirecrackerVersion(firecrackerVersion).
 		SetFreeDiskSizeMB(team.Edges.TeamTier.DiskMB).
 		SetNillableStartCmd(body.StartCmd).
+		SetReadinessProbe(readinessProbe).
+		SetHooks(hooks).
+		SetHardening(hardeningPolicy).
+		SetEgress(egressPolicy).
+		SetSecrets(secretRefs).
 		SetDockerfile(body.Dockerfile).
 		Exec(ctx)
 
+	for i, variant := range variants {
+		err = tx.EnvBuild.Create().
+			SetID(variantBuildIDs[i]).
+			SetEnvID(templateID).
+			SetStatus(envbuild.StatusWaiting).
+			SetRAMMB(int64(variant.MemoryMB)).
+			SetVcpu(int64(variant.CpuCount)).
+			SetKernelVersion(kernelVersion).
+			SetKernelArgs(kernelArgs).
+			SetNillableRootfsBlockSize(rootfsBlockSize).
+			SetFirecrackerVersion(firecrackerVersion).
+			SetFreeDiskSizeMB(team.Edges.TeamTier.DiskMB).
+			SetNillableStartCmd(body.StartCmd).
+			SetReadinessProbe(readinessProbe).
+			SetHooks(hooks).
+			SetHardening(hardeningPolicy).
+			SetEgress(egressPolicy).
+			SetSecrets(secretRefs).
+			SetDockerfile(body.Dockerfile).
+			SetVariant(variant.Name).
+			SetVariantOf(buildID).
+			Exec(ctx)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when inserting variant '%s': %s", variant.Name, err))
+
+			err = fmt.Errorf("error when inserting variant '%s': %w", variant.Name, err)
+			telemetry.ReportCriticalError(ctx, err)
+
+			return nil
+		}
+	}
+
 	// Check if the alias is available and claim it
 	if alias != "" {
 		envs, err := tx.
@@ -359,12 +619,18 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 
 	a.logger.Infof("Built template %s with build id %s", templateID, buildID.String())
 
-	return &api.Template{
+	template := &api.Template{
 		TemplateID: templateID,
 		BuildID:    buildID.String(),
 		Public:     false,
 		Aliases:    &aliases,
 	}
+
+	if len(variants) > 0 {
+		template.Variants = &variants
+	}
+
+	return template
 }
 
 func getCPUAndRAM(tierID string, cpuCount, memoryMB *int32) (int64, int64, *api.APIError) {
@@ -414,3 +680,21 @@ func getCPUAndRAM(tierID string, cpuCount, memoryMB *int32) (int64, int64, *api.
 
 	return int64(cpu), int64(ramMB), nil
 }
+
+func lifecycleHookFromAPI(hook *api.LifecycleHook) *schema.LifecycleHook {
+	if hook == nil {
+		return nil
+	}
+
+	result := &schema.LifecycleHook{
+		Command:        hook.Command,
+		TimeoutSeconds: hook.Timeout,
+	}
+
+	if hook.FailurePolicy != nil {
+		policy := string(*hook.FailurePolicy)
+		result.FailurePolicy = &policy
+	}
+
+	return result
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -13,17 +14,27 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/buildstate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/firecracker"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
 // PostTemplatesTemplateIDBuildsBuildID triggers a new build after the user pushes the Docker image to the registry
-func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
+func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, templateID api.TemplateID, buildID api.BuildID, params api.PostTemplatesTemplateIDBuildsBuildIDParams) {
 	ctx := c.Request.Context()
 	span := trace.SpanFromContext(ctx)
 
+	done, ok := a.startIdempotentRequest(c, a.GetUserID(c), params.IdempotencyKey)
+	if !ok {
+		return
+	}
+	defer done()
+
 	buildUUID, err := uuid.Parse(buildID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid build ID: %s", buildID))
@@ -55,7 +66,7 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		},
 	).Only(ctx)
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error when getting template: %s", err))
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.TemplateNotFound, fmt.Sprintf("Error when getting template: %s", err))
 
 		err = fmt.Errorf("error when getting env: %w", err)
 		telemetry.ReportCriticalError(ctx, err)
@@ -87,6 +98,12 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		attribute.String("template.id", templateID),
 	)
 
+	if variantOf := envDB.Edges.Builds[0].VariantOf; variantOf != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Build (%s) is a variant, it's built with the build (%s)", buildID, variantOf.String()))
+
+		return
+	}
+
 	// Create a new build cache for storing logs
 	err = a.buildCache.Create(templateID, buildUUID, team.ID)
 	if err != nil {
@@ -118,61 +135,148 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		defer childSpan.End()
 
 		startTime := time.Now()
-		build := envDB.Edges.Builds[0]
-		startCmd := ""
-		if build.StartCmd != nil {
-			startCmd = *build.StartCmd
+
+		buildErr := a.buildTemplate(buildContext, templateID, envDB.Edges.Builds[0], false)
+		if buildErr != nil {
+			return
 		}
 
-		// Call the Template Manager to build the environment
-		buildErr := a.templateManager.CreateTemplate(
+		a.posthog.CreateAnalyticsUserEvent(userID.String(), team.ID.String(), "built environment", posthog.NewProperties().
+			Set("user_id", userID).
+			Set("environment", templateID).
+			Set("build_id", buildID).
+			Set("duration", time.Since(startTime).String()).
+			Set("success", err != nil),
+		)
+	}()
+
+	c.Status(http.StatusAccepted)
+}
+
+// buildTemplate builds the template in the template manager and records the result with the build, the build cache entry
+// has to be created before. The packages of the image are upgraded when provisioning if requested, the scheduled rebuilds use it.
+func (a *APIStore) buildTemplate(ctx context.Context, templateID string, build *models.EnvBuild, upgradePackages bool) error {
+	startCmd := ""
+	if build.StartCmd != nil {
+		startCmd = *build.StartCmd
+	}
+
+	var rootfsBlockSize int64
+	if build.RootfsBlockSize != nil {
+		rootfsBlockSize = *build.RootfsBlockSize
+	}
+
+	var sourceBuildID string
+	if build.SourceBuildID != nil {
+		sourceBuildID = build.SourceBuildID.String()
+	}
+
+	// The canceled build stops the build in the template manager, the records of the build are updated with the parent context
+	buildCtx, cancelBuild := context.WithCancel(ctx)
+	defer cancelBuild()
+
+	cancelErr := a.buildCache.SetCancel(templateID, build.ID, cancelBuild)
+	if cancelErr != nil {
+		telemetry.ReportError(ctx, fmt.Errorf("error when registering build cancellation: %w", cancelErr))
+	}
+
+	// The build is failed by the reconciler if the heartbeat stops, e.g. when the API is restarted during the build
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	defer stopHeartbeat()
+
+	go buildstate.Heartbeat(heartbeatCtx, a.db, a.buildCache, a.logger, templateID, build.ID, cancelBuild)
+
+	// The variants of the build are built with it from the same provisioned rootfs
+	variants, variantsErr := a.db.GetEnvBuildVariants(ctx, build.ID)
+
+	// Call the Template Manager to build the environment
+	createTemplate := func(firecrackerVersion string) error {
+		return a.templateManager.CreateTemplate(
 			a.Tracer,
-			buildContext,
+			buildCtx,
 			a.db,
 			a.buildCache,
 			templateID,
-			buildUUID,
+			build.ID,
 			build.KernelVersion,
-			build.FirecrackerVersion,
+			build.KernelArgs,
+			firecrackerVersion,
 			startCmd,
 			build.Vcpu,
 			build.FreeDiskSizeMB,
 			build.RAMMB,
+			rootfsBlockSize,
+			sourceBuildID,
+			upgradePackages,
+			variants,
 		)
-		if buildErr != nil {
-			buildErr = fmt.Errorf("error when building env: %w", buildErr)
-			telemetry.ReportCriticalError(buildContext, buildErr)
+	}
 
-			dbErr := a.db.EnvBuildSetStatus(buildContext, templateID, buildUUID, envbuild.StatusFailed)
-			if dbErr != nil {
-				telemetry.ReportCriticalError(buildContext, fmt.Errorf("error when setting build status: %w", dbErr))
-			}
+	buildErr := variantsErr
+	if buildErr == nil {
+		buildErr = createTemplate(build.FirecrackerVersion)
+		if buildErr != nil && buildCtx.Err() == nil && firecracker.IsCanary(build.FirecrackerVersion) {
+			buildErr = a.fallbackToStableFirecracker(ctx, templateID, build.ID, build.FirecrackerVersion, buildErr, createTemplate)
+		}
+	}
 
-			// Save the error in the logs
-			buildCacheErr := a.buildCache.Append(templateID, buildUUID, fmt.Sprintf("Build failed: %s\n", buildErr))
-			if buildCacheErr != nil {
-				telemetry.ReportCriticalError(buildContext, fmt.Errorf("error when appending build logs: %w", buildCacheErr))
-			}
+	if buildErr != nil {
+		buildErr = fmt.Errorf("error when building env: %w", buildErr)
+		telemetry.ReportCriticalError(ctx, buildErr)
 
-			cacheErr := a.buildCache.SetDone(templateID, buildUUID, api.TemplateBuildStatusError)
-			if cacheErr != nil {
-				telemetry.ReportCriticalError(buildContext, fmt.Errorf("error when setting build done in logs: %w", cacheErr))
-			}
+		dbErr := a.db.EnvBuildSetStatus(ctx, templateID, build.ID, envbuild.StatusFailed)
+		if dbErr != nil {
+			telemetry.ReportCriticalError(ctx, fmt.Errorf("error when setting build status: %w", dbErr))
+		}
 
-			return
+		logLine := fmt.Sprintf("Build failed: %s\n", buildErr)
+		if buildCtx.Err() != nil && ctx.Err() == nil {
+			logLine = "Build canceled\n"
 		}
 
-		// Invalidate the cache
-		a.templateCache.Invalidate(templateID)
+		// Save the error in the logs
+		buildCacheErr := a.buildCache.Append(templateID, build.ID, logLine)
+		if buildCacheErr != nil {
+			telemetry.ReportCriticalError(ctx, fmt.Errorf("error when appending build logs: %w", buildCacheErr))
+		}
 
-		a.posthog.CreateAnalyticsUserEvent(userID.String(), team.ID.String(), "built environment", posthog.NewProperties().
-			Set("user_id", userID).
-			Set("environment", templateID).
-			Set("build_id", buildID).
-			Set("duration", time.Since(startTime).String()).
-			Set("success", err != nil),
-		)
-	}()
+		cacheErr := a.buildCache.SetDone(templateID, build.ID, api.TemplateBuildStatusError)
+		if cacheErr != nil {
+			telemetry.ReportCriticalError(ctx, fmt.Errorf("error when setting build done in logs: %w", cacheErr))
+		}
 
-	c.Status(http.StatusAccepted)
+		return buildErr
+	}
+
+	// Invalidate the cache
+	a.templateCache.Invalidate(templateID)
+
+	// Replicate the new build to the regions of the team
+	a.replication.Trigger()
+
+	return nil
+}
+
+// fallbackToStableFirecracker retries the failed build with the stable firecracker version, so the templates assigned to the canary version aren't broken by it.
+func (a *APIStore) fallbackToStableFirecracker(
+	ctx context.Context,
+	templateID string,
+	buildID uuid.UUID,
+	canaryVersion string,
+	canaryErr error,
+	createTemplate func(firecrackerVersion string) error,
+) error {
+	telemetry.ReportError(ctx, fmt.Errorf("build with the canary firecracker version '%s' failed, falling back to the stable version: %w", canaryVersion, canaryErr))
+
+	logErr := a.buildCache.Append(templateID, buildID, fmt.Sprintf("Build with firecracker %s failed, retrying with %s\n", canaryVersion, schema.DefaultFirecrackerVersion))
+	if logErr != nil {
+		telemetry.ReportError(ctx, fmt.Errorf("error when appending build logs: %w", logErr))
+	}
+
+	err := a.db.EnvBuildSetFirecrackerVersion(ctx, templateID, buildID, schema.DefaultFirecrackerVersion)
+	if err != nil {
+		return fmt.Errorf("error when falling back to the stable firecracker version: %w", errors.Join(err, canaryErr))
+	}
+
+	return createTemplate(schema.DefaultFirecrackerVersion)
 }
@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel/attribute"
@@ -10,6 +12,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
@@ -18,7 +21,7 @@ import (
 )
 
 // PatchTemplatesTemplateID serves to update a template
-func (a *APIStore) PatchTemplatesTemplateID(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+func (a *APIStore) PatchTemplatesTemplateID(c *gin.Context, aliasOrTemplateID api.TemplateID, params api.PatchTemplatesTemplateIDParams) {
 	ctx := c.Request.Context()
 
 	body, err := utils.ParseBody[api.TemplateUpdateRequest](ctx, c)
@@ -63,7 +66,7 @@ func (a *APIStore) PatchTemplatesTemplateID(c *gin.Context, aliasOrTemplateID ap
 	notFound := models.IsNotFound(err)
 	if notFound {
 		telemetry.ReportError(ctx, fmt.Errorf("template '%s' not found", aliasOrTemplateID))
-		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("the sandbox template '%s' wasn't found", cleanedAliasOrEnvID))
+		a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.TemplateNotFound, fmt.Sprintf("the sandbox template '%s' wasn't found", cleanedAliasOrEnvID))
 
 		return
 	} else if err != nil {
@@ -90,12 +93,56 @@ func (a *APIStore) PatchTemplatesTemplateID(c *gin.Context, aliasOrTemplateID ap
 		return
 	}
 
+	// The version the tag was computed from is compared again in the update, so the concurrent update with the same tag isn't lost
+	var ifUnchanged *db.EnvVersion
+	if params.IfMatch != nil {
+		current, build, err := a.db.GetEnv(ctx, template.ID)
+		if err != nil && !models.IsNotFound(err) {
+			telemetry.ReportError(ctx, fmt.Errorf("failed to get env '%s': %w", template.ID, err))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting env")
+
+			return
+		}
+
+		currentETag := ""
+		if current != nil {
+			currentETag, err = templateETag(current)
+			if err != nil {
+				telemetry.ReportError(ctx, fmt.Errorf("failed to get etag of env '%s': %w", template.ID, err))
+				a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting env")
+
+				return
+			}
+
+			ifUnchanged = &db.EnvVersion{UpdatedAt: current.UpdatedAt}
+			if build.FinishedAt != nil {
+				ifUnchanged.BuildFinishedAt = *build.FinishedAt
+			}
+		}
+
+		if !a.checkIfMatch(c, params.IfMatch, currentETag) {
+			return
+		}
+	}
+
+	if body.AllowedRegions != nil && slices.Contains(*body.AllowedRegions, "") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Allowed regions can't be empty strings")
+
+		return
+	}
+
 	// Update env
 	dbErr := a.db.UpdateEnv(ctx, template.ID, db.UpdateEnvInput{
-		Public: *body.Public,
+		Public:         body.Public,
+		AllowedRegions: body.AllowedRegions,
+		IfUnchanged:    ifUnchanged,
 	})
 
-	if dbErr != nil {
+	if errors.Is(dbErr, db.ErrEnvChanged) {
+		a.sendPreconditionFailed(c)
+
+		return
+	} else if dbErr != nil {
 		errMsg := fmt.Errorf("error when updating env: %w", dbErr)
 		telemetry.ReportError(ctx, errMsg)
 
@@ -120,5 +167,12 @@ func (a *APIStore) PatchTemplatesTemplateID(c *gin.Context, aliasOrTemplateID ap
 
 	a.logger.Infof("Updated env '%s' from team '%s'", template.ID, team.ID)
 
+	currentETag, err := a.getTemplateETag(ctx, template.ID)
+	if err != nil {
+		telemetry.ReportError(ctx, fmt.Errorf("failed to get etag of env '%s': %w", template.ID, err))
+	} else if currentETag != "" {
+		c.Header(ETagHeader, currentETag)
+	}
+
 	c.JSON(http.StatusOK, nil)
 }
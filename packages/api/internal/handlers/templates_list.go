@@ -10,7 +10,11 @@ import (
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -21,7 +25,7 @@ func (a *APIStore) GetTemplates(c *gin.Context, params api.GetTemplatesParams) {
 	userID := c.Value(auth.UserIDContextKey).(uuid.UUID)
 
 	var team *models.Team
-	teams, err := a.db.GetTeams(ctx, userID)
+	teams, err := a.db.ReadOnly().GetTeams(ctx, userID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when getting teams"))
 
@@ -49,7 +53,7 @@ func (a *APIStore) GetTemplates(c *gin.Context, params api.GetTemplatesParams) {
 		}
 
 		if team == nil {
-			a.sendAPIStoreError(c, http.StatusNotFound, "Team not found")
+			a.sendAPIStoreErrorCode(c, http.StatusNotFound, errcode.TeamNotFound, "Team not found")
 
 			telemetry.ReportError(ctx, fmt.Errorf("team not found"))
 
@@ -77,7 +81,33 @@ func (a *APIStore) GetTemplates(c *gin.Context, params api.GetTemplatesParams) {
 		attribute.String("team.id", team.ID.String()),
 	)
 
-	envs, err := a.db.GetEnvs(ctx, team.ID)
+	opts := db.ListEnvsOptions{
+		Public:       params.Public,
+		CreatedAfter: params.CreatedAfter,
+		Desc:         params.Order != nil && *params.Order == api.Desc,
+	}
+
+	if params.SortBy != nil && *params.SortBy == api.UpdatedAt {
+		opts.SortBy = env.FieldUpdatedAt
+	}
+
+	if params.NextToken != nil {
+		opts.After, err = utils.DecodeNextToken(*params.NextToken)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid next token: %s", err))
+
+			telemetry.ReportError(ctx, err)
+
+			return
+		}
+	}
+
+	if params.Limit != nil {
+		// Get one more env to know if there is a next page
+		opts.Limit = int(*params.Limit) + 1
+	}
+
+	envs, err := a.db.ReadOnly().GetEnvs(ctx, team.ID, opts)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting sandbox templates")
 
@@ -89,6 +119,18 @@ func (a *APIStore) GetTemplates(c *gin.Context, params api.GetTemplatesParams) {
 
 	telemetry.ReportEvent(ctx, "listed environments")
 
+	if params.Limit != nil && len(envs) > int(*params.Limit) {
+		envs = envs[:*params.Limit]
+
+		last := envs[len(envs)-1]
+		cursor := db.PageCursor{Time: last.CreatedAt, ID: last.TemplateID}
+		if opts.SortBy == env.FieldUpdatedAt {
+			cursor.Time = last.UpdatedAt
+		}
+
+		c.Header(utils.NextTokenHeader, utils.EncodeNextToken(cursor))
+	}
+
 	a.posthog.IdentifyAnalyticsTeam(team.ID.String(), team.Name)
 	properties := a.posthog.GetPackageToPosthogProperties(&c.Request.Header)
 	a.posthog.CreateAnalyticsUserEvent(userID.String(), team.ID.String(), "listed environments", properties)
@@ -104,18 +146,19 @@ func (a *APIStore) GetTemplates(c *gin.Context, params api.GetTemplatesParams) {
 		}
 
 		templates = append(templates, &api.Template{
-			TemplateID:    item.TemplateID,
-			BuildID:       item.BuildID,
-			CpuCount:      int32(item.VCPU),
-			MemoryMB:      int32(item.RAMMB),
-			Public:        item.Public,
-			Aliases:       item.Aliases,
-			CreatedAt:     item.CreatedAt,
-			UpdatedAt:     item.UpdatedAt,
-			LastSpawnedAt: item.LastSpawnedAt,
-			SpawnCount:    item.SpawnCount,
-			BuildCount:    item.BuildCount,
-			CreatedBy:     createdBy,
+			TemplateID:     item.TemplateID,
+			BuildID:        item.BuildID,
+			CpuCount:       int32(item.VCPU),
+			MemoryMB:       int32(item.RAMMB),
+			Public:         item.Public,
+			Aliases:        item.Aliases,
+			CreatedAt:      item.CreatedAt,
+			UpdatedAt:      item.UpdatedAt,
+			LastSpawnedAt:  item.LastSpawnedAt,
+			SpawnCount:     item.SpawnCount,
+			BuildCount:     item.BuildCount,
+			CreatedBy:      createdBy,
+			AllowedRegions: allowedRegions(item.AllowedRegions),
 		})
 	}
 
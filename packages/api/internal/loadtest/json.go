@@ -0,0 +1,137 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// The JSON report has the durations in milliseconds and the stable field names, so the reports of different commits
+// can be diffed by the CI jobs.
+type jsonReport struct {
+	Run            string    `json:"run"`
+	TemplateID     string    `json:"templateID"`
+	Command        string    `json:"command"`
+	SandboxTimeout int32     `json:"sandboxTimeout"`
+	Concurrency    int       `json:"concurrency"`
+	Rate           float64   `json:"rate"`
+	ElapsedMs      int64     `json:"elapsedMs"`
+	GeneratedAt    time.Time `json:"generatedAt"`
+
+	Total      int     `json:"total"`
+	Succeeded  int     `json:"succeeded"`
+	Throughput float64 `json:"throughput"`
+
+	Stages    []jsonStageStats `json:"stages"`
+	Errors    []jsonErrorStats `json:"errors"`
+	Sandboxes []jsonResult     `json:"sandboxes"`
+}
+
+type jsonStageStats struct {
+	Stage Stage `json:"stage"`
+	Count int   `json:"count"`
+	MinMs int64 `json:"minMs"`
+	P50Ms int64 `json:"p50Ms"`
+	P90Ms int64 `json:"p90Ms"`
+	P99Ms int64 `json:"p99Ms"`
+	MaxMs int64 `json:"maxMs"`
+}
+
+type jsonErrorStats struct {
+	Stage   Stage     `json:"stage"`
+	Kind    ErrorKind `json:"kind"`
+	Count   int       `json:"count"`
+	Example string    `json:"example"`
+}
+
+type jsonResult struct {
+	SandboxID   string          `json:"sandboxID,omitempty"`
+	StartedAt   time.Time       `json:"startedAt"`
+	DurationsMs map[Stage]int64 `json:"durationsMs"`
+	TotalMs     int64           `json:"totalMs"`
+	FailedStage Stage           `json:"failedStage,omitempty"`
+	ErrorKind   ErrorKind       `json:"errorKind,omitempty"`
+	Error       string          `json:"error,omitempty"`
+}
+
+func (r *Report) toJSON() jsonReport {
+	report := jsonReport{
+		Run:            r.Config.Run,
+		TemplateID:     r.Config.TemplateID,
+		Command:        r.Config.Command,
+		SandboxTimeout: r.Config.SandboxTimeout,
+		Concurrency:    r.Concurrency,
+		Rate:           r.Rate,
+		ElapsedMs:      r.Elapsed.Milliseconds(),
+		GeneratedAt:    r.GeneratedAt,
+		Total:          r.Total,
+		Succeeded:      r.Succeeded,
+		Throughput:     r.Throughput,
+		Stages:         make([]jsonStageStats, 0, len(r.Stages)),
+		Errors:         make([]jsonErrorStats, 0, len(r.Errors)),
+		Sandboxes:      make([]jsonResult, 0, len(r.Results)),
+	}
+
+	for _, s := range r.Stages {
+		report.Stages = append(report.Stages, jsonStageStats{
+			Stage: s.Stage,
+			Count: s.Count,
+			MinMs: s.Min.Milliseconds(),
+			P50Ms: s.P50.Milliseconds(),
+			P90Ms: s.P90.Milliseconds(),
+			P99Ms: s.P99.Milliseconds(),
+			MaxMs: s.Max.Milliseconds(),
+		})
+	}
+
+	for _, e := range r.Errors {
+		report.Errors = append(report.Errors, jsonErrorStats{
+			Stage:   e.Stage,
+			Kind:    e.Kind,
+			Count:   e.Count,
+			Example: e.Example,
+		})
+	}
+
+	for _, res := range r.Results {
+		result := jsonResult{
+			SandboxID:   res.SandboxID,
+			StartedAt:   res.StartedAt,
+			DurationsMs: make(map[Stage]int64, len(res.Durations)),
+			TotalMs:     res.Total.Milliseconds(),
+			FailedStage: res.FailedStage,
+			ErrorKind:   res.ErrorKind,
+		}
+
+		for s, d := range res.Durations {
+			result.DurationsMs[s] = d.Milliseconds()
+		}
+
+		if res.Error != nil {
+			result.Error = res.Error.Error()
+		}
+
+		report.Sandboxes = append(report.Sandboxes, result)
+	}
+
+	return report
+}
+
+func (r *Report) WriteJSON(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create JSON report: %w", err)
+	}
+	defer f.Close()
+
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+
+	err = encoder.Encode(r.toJSON())
+	if err != nil {
+		return fmt.Errorf("failed to write JSON report: %w", err)
+	}
+
+	return nil
+}
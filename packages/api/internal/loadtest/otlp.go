@@ -0,0 +1,92 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const serviceName = "load-sandboxes"
+
+// ExportOTLP exports the report as one trace to the OTLP collector, the run is the root span with a child span
+// per sandbox and the stages of the sandbox are its children.
+func (r *Report) ExportOTLP(ctx context.Context, endpoint string) error {
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(endpoint), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+		sdktrace.WithBatcher(exporter),
+	)
+
+	tracer := provider.Tracer(serviceName)
+
+	runStart := r.GeneratedAt.Add(-r.Elapsed)
+	runCtx, runSpan := tracer.Start(ctx, "load-test",
+		trace.WithTimestamp(runStart),
+		trace.WithAttributes(
+			attribute.String("template.id", r.Config.TemplateID),
+			attribute.String("command", r.Config.Command),
+			attribute.Int("concurrency", r.Concurrency),
+			attribute.Float64("rate", r.Rate),
+			attribute.Int("sandboxes.total", r.Total),
+			attribute.Int("sandboxes.succeeded", r.Succeeded),
+			attribute.Float64("throughput", r.Throughput),
+		),
+	)
+
+	for _, res := range r.Results {
+		exportResult(runCtx, tracer, res)
+	}
+
+	runSpan.End(trace.WithTimestamp(runStart.Add(r.Elapsed)))
+
+	err = provider.Shutdown(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export OTLP spans: %w", err)
+	}
+
+	return nil
+}
+
+// exportResult creates the spans of the sandbox. The stages run one after another, so each stage starts
+// when the previous one ended.
+func exportResult(ctx context.Context, tracer trace.Tracer, res *Result) {
+	sandboxCtx, sandboxSpan := tracer.Start(ctx, "sandbox",
+		trace.WithTimestamp(res.StartedAt),
+		trace.WithAttributes(attribute.String("sandbox.id", res.SandboxID)),
+	)
+
+	stageStart := res.StartedAt
+	for _, s := range Stages {
+		d, ok := res.Durations[s]
+		if !ok {
+			continue
+		}
+
+		_, stageSpan := tracer.Start(sandboxCtx, string(s), trace.WithTimestamp(stageStart))
+		if res.Error != nil && res.FailedStage == s {
+			stageSpan.SetStatus(codes.Error, res.Error.Error())
+			stageSpan.SetAttributes(attribute.String("error.kind", string(res.ErrorKind)))
+		}
+
+		stageStart = stageStart.Add(d)
+		stageSpan.End(trace.WithTimestamp(stageStart))
+	}
+
+	if res.Error != nil {
+		sandboxSpan.SetStatus(codes.Error, res.Error.Error())
+	}
+
+	sandboxSpan.End(trace.WithTimestamp(res.StartedAt.Add(res.Total)))
+}
@@ -0,0 +1,320 @@
+// Package loadtest aggregates the results of the sandbox load tests into reports, the reports are printed, written
+// as CSV, HTML or JSON, or exported as OTLP spans, so the results of different commits can be compared.
+package loadtest
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/benchmark"
+	"github.com/e2b-dev/infra/packages/shared/pkg/client"
+)
+
+type ErrorKind string
+
+const (
+	errorTimeout     ErrorKind = "timeout"
+	errorCanceled    ErrorKind = "canceled"
+	errorRateLimited ErrorKind = "rate_limited"
+	errorExec        ErrorKind = "exec_failed"
+	errorNetwork     ErrorKind = "network"
+	errorOther       ErrorKind = "other"
+)
+
+// ClassifyError groups the errors by their cause, the API errors are grouped by the error code or the status code.
+func ClassifyError(err error) ErrorKind {
+	var apiErr *client.APIError
+	var execErr *client.ExecError
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorTimeout
+	case errors.Is(err, context.Canceled):
+		return errorCanceled
+	case errors.As(err, &apiErr):
+		if apiErr.StatusCode == http.StatusTooManyRequests {
+			return errorRateLimited
+		}
+
+		if apiErr.ErrorCode != "" {
+			return ErrorKind(apiErr.ErrorCode)
+		}
+
+		return ErrorKind(fmt.Sprintf("http_%d", apiErr.StatusCode))
+	case errors.As(err, &execErr):
+		return errorExec
+	case errors.As(err, &netErr):
+		return errorNetwork
+	default:
+		return errorOther
+	}
+}
+
+type StageStats struct {
+	Stage Stage
+	benchmark.Distribution
+}
+
+type ErrorStats struct {
+	Stage Stage
+	Kind  ErrorKind
+	Count int
+	// Example is the first error of the kind, the errors of the same kind usually differ only by the sandbox ID.
+	Example string
+}
+
+type Report struct {
+	Config      Config
+	Concurrency int
+	Rate        float64
+	Elapsed     time.Duration
+	GeneratedAt time.Time
+
+	Total     int
+	Succeeded int
+	// Throughput of the completed sandboxes per second.
+	Throughput float64
+
+	Stages  []StageStats
+	Errors  []ErrorStats
+	Results []*Result
+}
+
+func computeStageStats(s Stage, durations []time.Duration) StageStats {
+	return StageStats{Stage: s, Distribution: benchmark.Distribute(durations)}
+}
+
+func New(config Config, results []*Result, concurrency int, rate float64, elapsed time.Duration) *Report {
+	slices.SortFunc(results, func(a, b *Result) int {
+		return a.StartedAt.Compare(b.StartedAt)
+	})
+
+	r := &Report{
+		Config:      config,
+		Concurrency: concurrency,
+		Rate:        rate,
+		Elapsed:     elapsed,
+		GeneratedAt: time.Now(),
+		Total:       len(results),
+		Results:     results,
+	}
+
+	if elapsed > 0 {
+		r.Throughput = float64(len(results)) / elapsed.Seconds()
+	}
+
+	// Only the successful stages are included in the latencies, the failed ones are in the errors
+	durations := make(map[Stage][]time.Duration, len(Stages))
+	var totals []time.Duration
+
+	errorsByKind := make(map[string]*ErrorStats)
+
+	for _, res := range results {
+		for s, d := range res.Durations {
+			if res.Error != nil && res.FailedStage == s {
+				continue
+			}
+
+			durations[s] = append(durations[s], d)
+		}
+
+		if res.Error == nil {
+			r.Succeeded++
+			totals = append(totals, res.Total)
+
+			continue
+		}
+
+		key := string(res.FailedStage) + "/" + string(res.ErrorKind)
+		stats, ok := errorsByKind[key]
+		if !ok {
+			stats = &ErrorStats{Stage: res.FailedStage, Kind: res.ErrorKind, Example: res.Error.Error()}
+			errorsByKind[key] = stats
+		}
+
+		stats.Count++
+	}
+
+	for _, s := range Stages {
+		if len(durations[s]) == 0 {
+			continue
+		}
+
+		r.Stages = append(r.Stages, computeStageStats(s, durations[s]))
+	}
+
+	r.Stages = append(r.Stages, computeStageStats(StageTotal, totals))
+
+	for _, stats := range errorsByKind {
+		r.Errors = append(r.Errors, *stats)
+	}
+
+	slices.SortFunc(r.Errors, func(a, b ErrorStats) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	return r
+}
+
+func (r *Report) Print(w io.Writer) {
+	fmt.Fprintf(w, "\nsandboxes: %d, succeeded: %d, failed: %d, elapsed: %s, throughput: %.2f sandboxes/s\n\n",
+		r.Total, r.Succeeded, r.Total-r.Succeeded, r.Elapsed.Round(time.Millisecond), r.Throughput)
+
+	fmt.Fprintf(w, "%-8s %8s %10s %10s %10s %10s %10s\n", "stage", "count", "min", "p50", "p90", "p99", "max")
+	for _, s := range r.Stages {
+		fmt.Fprintf(w, "%-8s %8d %10s %10s %10s %10s %10s\n", s.Stage, s.Count,
+			s.Min.Round(time.Millisecond), s.P50.Round(time.Millisecond), s.P90.Round(time.Millisecond),
+			s.P99.Round(time.Millisecond), s.Max.Round(time.Millisecond))
+	}
+
+	if len(r.Errors) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n%-8s %-32s %8s  %s\n", "stage", "error", "count", "example")
+	for _, e := range r.Errors {
+		fmt.Fprintf(w, "%-8s %-32s %8d  %s\n", e.Stage, e.Kind, e.Count, e.Example)
+	}
+}
+
+// Records converts the results to the benchmark records, one record per stage of the sandbox and one for its total.
+// The record of the failed stage has the error and so has the total of the failed sandbox.
+func (r *Report) Records(host string) []benchmark.Record {
+	records := make([]benchmark.Record, 0, len(r.Results)*(len(Stages)+1))
+
+	for _, res := range r.Results {
+		var errMsg string
+		if res.Error != nil {
+			errMsg = res.Error.Error()
+		}
+
+		stageStart := res.StartedAt
+		for _, s := range Stages {
+			d, ok := res.Durations[s]
+			if !ok {
+				continue
+			}
+
+			record := benchmark.Record{
+				Run:       r.Config.Run,
+				Host:      host,
+				Operation: string(s),
+				ID:        res.SandboxID,
+				StartedAt: stageStart,
+				Duration:  d,
+			}
+
+			if res.FailedStage == s {
+				record.Error = errMsg
+			}
+
+			records = append(records, record)
+			stageStart = stageStart.Add(d)
+		}
+
+		records = append(records, benchmark.Record{
+			Run:       r.Config.Run,
+			Host:      host,
+			Operation: string(StageTotal),
+			ID:        res.SandboxID,
+			StartedAt: res.StartedAt,
+			Duration:  res.Total,
+			Error:     errMsg,
+		})
+	}
+
+	return records
+}
+
+// WriteCSV writes the results in the benchmark CSV schema, so the reports of the runs on different machines
+// can be merged by aggregate-benchmarks.
+func (r *Report) WriteCSV(path, host string) error {
+	err := benchmark.WriteCSVFile(path, r.Records(host))
+	if err != nil {
+		return fmt.Errorf("failed to write CSV report: %w", err)
+	}
+
+	return nil
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"ms": func(d time.Duration) string {
+		return strconv.FormatInt(d.Milliseconds(), 10)
+	},
+	"stageMs": func(r *Result, s string) string {
+		d, ok := r.Durations[Stage(s)]
+		if !ok {
+			return ""
+		}
+
+		return strconv.FormatInt(d.Milliseconds(), 10)
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Sandbox load test</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: right; }
+th:first-child, td:first-child, td.text { text-align: left; }
+.failed { color: #b00; }
+</style>
+</head>
+<body>
+<h1>Sandbox load test</h1>
+<p>
+Template <b>{{ .Config.TemplateID }}</b>, command <code>{{ .Config.Command }}</code>,
+concurrency {{ .Concurrency }}, rate {{ if .Rate }}{{ printf "%.2f" .Rate }}/s{{ else }}unlimited{{ end }}.<br>
+{{ .Total }} sandboxes, {{ .Succeeded }} succeeded, elapsed {{ .Elapsed }}, throughput {{ printf "%.2f" .Throughput }} sandboxes/s.<br>
+Generated at {{ .GeneratedAt.Format "2006-01-02 15:04:05 MST" }}.
+</p>
+
+<h2>Latencies (ms)</h2>
+<table>
+<tr><th>Stage</th><th>Count</th><th>Min</th><th>P50</th><th>P90</th><th>P99</th><th>Max</th></tr>
+{{ range .Stages }}<tr><td>{{ .Stage }}</td><td>{{ .Count }}</td><td>{{ ms .Min }}</td><td>{{ ms .P50 }}</td><td>{{ ms .P90 }}</td><td>{{ ms .P99 }}</td><td>{{ ms .Max }}</td></tr>
+{{ end }}</table>
+
+{{ if .Errors }}<h2>Errors</h2>
+<table>
+<tr><th>Stage</th><th>Kind</th><th>Count</th><th>Example</th></tr>
+{{ range .Errors }}<tr><td>{{ .Stage }}</td><td class="text">{{ .Kind }}</td><td>{{ .Count }}</td><td class="text">{{ .Example }}</td></tr>
+{{ end }}</table>
+{{ end }}
+
+<h2>Sandboxes</h2>
+<table>
+<tr><th>Sandbox</th><th>Started at</th><th>Create</th><th>Exec</th><th>Kill</th><th>Total</th><th>Error</th></tr>
+{{ range .Results }}<tr{{ if .Error }} class="failed"{{ end }}><td>{{ .SandboxID }}</td><td>{{ .StartedAt.Format "15:04:05.000" }}</td><td>{{ stageMs . "create" }}</td><td>{{ stageMs . "exec" }}</td><td>{{ stageMs . "kill" }}</td><td>{{ ms .Total }}</td><td class="text">{{ if .Error }}{{ .Error }}{{ end }}</td></tr>
+{{ end }}</table>
+</body>
+</html>
+`))
+
+func (r *Report) WriteHTML(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create HTML report: %w", err)
+	}
+	defer f.Close()
+
+	err = htmlTemplate.Execute(f, r)
+	if err != nil {
+		return fmt.Errorf("failed to write HTML report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package loadtest
+
+import (
+	"fmt"
+	"time"
+)
+
+type Stage string
+
+const (
+	StageCreate Stage = "create"
+	StageExec   Stage = "exec"
+	StageKill   Stage = "kill"
+	// StageTotal is the whole lifecycle of the sandbox, only the successful sandboxes are included in its latencies.
+	StageTotal Stage = "total"
+)
+
+// Stages are the stages of the sandbox lifecycle in the order they run.
+var Stages = []Stage{StageCreate, StageExec, StageKill}
+
+type Config struct {
+	// Run identifies the run in the CSV report, e.g. the commit the API was built from.
+	Run            string
+	TemplateID     string
+	Command        string
+	SandboxTimeout int32
+}
+
+// Result is the result of one sandbox, the durations contain only the stages that ran.
+type Result struct {
+	SandboxID string
+	StartedAt time.Time
+	Durations map[Stage]time.Duration
+	Total     time.Duration
+
+	FailedStage Stage
+	ErrorKind   ErrorKind
+	Error       error
+}
+
+func NewResult() *Result {
+	return &Result{
+		StartedAt: time.Now(),
+		Durations: make(map[Stage]time.Duration, len(Stages)),
+	}
+}
+
+// Fail records the error of the stage, the first failed stage is kept.
+func (r *Result) Fail(s Stage, err error) {
+	if r.Error != nil {
+		return
+	}
+
+	r.FailedStage = s
+	r.ErrorKind = ClassifyError(err)
+	r.Error = fmt.Errorf("%s: %w", s, err)
+}
@@ -0,0 +1,148 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+)
+
+const (
+	orchestratorServiceName = "orchestrator"
+	// nodeIDMetaKey is the key of the service meta with the Nomad ID of the node, the Consul node ID is different.
+	nodeIDMetaKey = "node_id"
+
+	// consulWaitTime is how long the blocking query waits for the change of the service.
+	consulWaitTime = 5 * time.Minute
+	// consulRetryInterval is how long to wait before the failed query is retried.
+	consulRetryInterval = time.Second
+)
+
+// ConsulDiscovery discovers the orchestrators from the Consul catalog, the instances with a critical health check
+// (including the maintenance mode) aren't listed. The catalog is watched by the blocking queries,
+// so the nodes are listed from the last result.
+type ConsulDiscovery struct {
+	client *consulapi.Client
+	logger *zap.SugaredLogger
+
+	mu    sync.RWMutex
+	nodes []*NodeInfo
+}
+
+// NewConsulDiscovery lists the orchestrator instances and starts watching them, the watch stops when the context is done.
+func NewConsulDiscovery(ctx context.Context, logger *zap.SugaredLogger, token string) (*ConsulDiscovery, error) {
+	config := consulapi.DefaultConfig()
+	config.Token = token
+
+	client, err := consulapi.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Consul client: %w", err)
+	}
+
+	d := &ConsulDiscovery{
+		client: client,
+		logger: logger,
+	}
+
+	index, err := d.sync(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	go d.watch(ctx, index)
+
+	return d, nil
+}
+
+// List returns the healthy orchestrator nodes from the last result of the query.
+func (d *ConsulDiscovery) List() []*NodeInfo {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.nodes
+}
+
+func (d *ConsulDiscovery) watch(ctx context.Context, index uint64) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		next, err := d.sync(ctx, index)
+		if err != nil {
+			d.logger.Warnf("Error watching the orchestrators in Consul: %v", err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(consulRetryInterval):
+			}
+
+			continue
+		}
+
+		// The index must be reset if it went backwards, e.g. after the Consul servers were restored from the snapshot
+		if next < index {
+			next = 0
+		}
+
+		index = next
+	}
+}
+
+// sync waits until the instances change after the index (the zero index doesn't wait) and stores them, it returns the index of the result.
+func (d *ConsulDiscovery) sync(ctx context.Context, index uint64) (uint64, error) {
+	opts := &consulapi.QueryOptions{
+		WaitIndex: index,
+		WaitTime:  consulWaitTime,
+	}
+
+	entries, meta, err := d.client.Health().Service(orchestratorServiceName, "", false, opts.WithContext(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to query service '%s': %w", orchestratorServiceName, err)
+	}
+
+	nodes := make([]*NodeInfo, 0, len(entries))
+
+	for _, entry := range entries {
+		// The warning is the degraded node, it's still used, the API checks the health of the nodes itself
+		if entry.Checks.AggregatedStatus() == consulapi.HealthCritical {
+			continue
+		}
+
+		id := entry.Service.Meta[nodeIDMetaKey]
+		if len(id) < consts.NodeIDLength {
+			d.logger.Warnf("Orchestrator instance '%s' in Consul doesn't have the node ID in the meta, skipping", entry.Service.ID)
+
+			continue
+		}
+
+		address := entry.Service.Address
+		if address == "" {
+			address = entry.Node.Address
+		}
+
+		nodes = append(nodes, &NodeInfo{
+			ID:                  id[:consts.NodeIDLength],
+			OrchestratorAddress: net.JoinHostPort(address, strconv.Itoa(entry.Service.Port)),
+			IPAddress:           address,
+			ClusterID:           LocalClusterID,
+			Region:              entry.Node.Datacenter,
+		})
+	}
+
+	d.mu.Lock()
+	d.nodes = nodes
+	d.mu.Unlock()
+
+	return meta.LastIndex, nil
+}
@@ -0,0 +1,136 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+const (
+	// endpointsResync is how often the informer lists all the slices again, the changes are watched in between.
+	endpointsResync      = 5 * time.Minute
+	endpointsSyncTimeout = 30 * time.Second
+
+	// orchestratorPortName is the name of the port of the orchestrator service, the orchestrator port is used if the slice has no such port.
+	orchestratorPortName = "grpc"
+)
+
+// EndpointsDiscovery discovers the orchestrators from the EndpointSlices of their service in Kubernetes.
+// The slices are watched by the informer, so the nodes are listed from the local cache.
+type EndpointsDiscovery struct {
+	lister    discoverylisters.EndpointSliceNamespaceLister
+	namespace string
+	selector  string
+}
+
+// NewEndpointsDiscovery starts watching the EndpointSlices matching the label selector in the namespace,
+// it returns after the slices were listed for the first time. The watch stops when the context is done.
+func NewEndpointsDiscovery(ctx context.Context, namespace, selector string) (*EndpointsDiscovery, error) {
+	_, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoints label selector '%s': %w", selector, err)
+	}
+
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(
+		client,
+		endpointsResync,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = selector
+		}),
+	)
+
+	slices := factory.Discovery().V1().EndpointSlices()
+	// The informer must be requested before the factory is started, otherwise it isn't started.
+	slices.Informer()
+
+	factory.Start(ctx.Done())
+
+	syncCtx, cancel := context.WithTimeout(ctx, endpointsSyncTimeout)
+	defer cancel()
+
+	for typ, synced := range factory.WaitForCacheSync(syncCtx.Done()) {
+		if !synced {
+			return nil, fmt.Errorf("failed to sync %s informer in namespace '%s'", typ, namespace)
+		}
+	}
+
+	return &EndpointsDiscovery{
+		lister:    slices.Lister().EndpointSlices(namespace),
+		namespace: namespace,
+		selector:  selector,
+	}, nil
+}
+
+// List returns the nodes of the ready endpoints, the endpoints without the node name are skipped,
+// because the ID of the node is derived from it the same way the orchestrator derives it.
+func (d *EndpointsDiscovery) List() ([]*NodeInfo, error) {
+	slices, err := d.lister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoint slices matching '%s' in namespace '%s': %w", d.selector, d.namespace, err)
+	}
+
+	nodes := make(map[string]*NodeInfo)
+
+	for _, slice := range slices {
+		if slice.AddressType != discoveryv1.AddressTypeIPv4 {
+			continue
+		}
+
+		port := consts.OrchestratorPort
+		for _, p := range slice.Ports {
+			if p.Name != nil && *p.Name == orchestratorPortName && p.Port != nil {
+				port = strconv.Itoa(int(*p.Port))
+			}
+		}
+
+		for _, endpoint := range slice.Endpoints {
+			// The endpoints of the terminating pods aren't ready, the nodes are not used for the new sandboxes then
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+
+			if endpoint.NodeName == nil || len(endpoint.Addresses) == 0 {
+				continue
+			}
+
+			id := env.KubernetesNodeID(*endpoint.NodeName)
+			nodes[id] = &NodeInfo{
+				ID:                  id,
+				OrchestratorAddress: net.JoinHostPort(endpoint.Addresses[0], port),
+				IPAddress:           endpoint.Addresses[0],
+				ClusterID:           LocalClusterID,
+			}
+		}
+	}
+
+	result := make([]*NodeInfo, 0, len(nodes))
+	for _, info := range nodes {
+		result = append(result, info)
+	}
+
+	return result, nil
+}
@@ -1,7 +1,46 @@
 package node
 
+import (
+	"os"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+// Token authenticates the calls between the API and the orchestrators, it's required in Kubernetes. In Nomad the calls
+// aren't authenticated if it's empty.
+var Token = os.Getenv("ORCHESTRATOR_TOKEN")
+
+// LocalClusterID is the ID of the cluster the API runs in, the nodes that don't report their cluster are in it.
+var LocalClusterID = env.GetEnv("CLUSTER_ID", "default")
+
 type NodeInfo struct {
 	ID                  string
 	OrchestratorAddress string
 	IPAddress           string
+	ClusterID           string
+	Region              string
+
+	// The fields below are set only for the registered nodes.
+
+	Labels map[string]string `json:",omitempty"`
+	// CPUCount and MemoryMB are the capacity of the node for the sandboxes, zero if not limited.
+	CPUCount int64 `json:",omitempty"`
+	MemoryMB int64 `json:",omitempty"`
+	// Draining is set when the node is shutting down, no new sandboxes are placed on it.
+	Draining bool `json:",omitempty"`
+	// ExpiresAt is when the registration expires if it's not renewed.
+	ExpiresAt time.Time `json:",omitempty"`
+	// ClusterProxyIP is the IP of the proxy of the node's cluster, the traffic from the other clusters is routed through it.
+	ClusterProxyIP string `json:",omitempty"`
+}
+
+// RoutingIP returns the IP the sandbox traffic for the node is routed to, the nodes in the other clusters
+// are reached through the proxy of their cluster.
+func (n *NodeInfo) RoutingIP() string {
+	if n.ClusterID != LocalClusterID && n.ClusterProxyIP != "" {
+		return n.ClusterProxyIP
+	}
+
+	return n.IPAddress
 }
@@ -0,0 +1,156 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+const (
+	registryKey     = "nodes:registered"
+	registryTimeout = 2 * time.Second
+
+	// registrationLease is how long the node is listed without renewing its registration.
+	registrationLease = 30 * time.Second
+)
+
+// Registry keeps the nodes that registered with the API instead of being discovered via Nomad, e.g. in Kubernetes.
+// The nodes are kept in Redis (if configured), so every API replica lists the node registered with any of them.
+// Without Redis the nodes are kept locally, which is correct only with a single API replica.
+type Registry struct {
+	orchestrator.UnimplementedNodeServiceServer
+
+	redis *redis.Client
+
+	mu    sync.Mutex
+	local map[string]*NodeInfo
+}
+
+func NewRegistry(rc *redis.Client) *Registry {
+	return &Registry{
+		redis: rc,
+		local: make(map[string]*NodeInfo),
+	}
+}
+
+// Register registers the node or renews its registration.
+func (r *Registry) Register(ctx context.Context, req *orchestrator.NodeRegisterRequest) (*orchestrator.NodeRegisterResponse, error) {
+	if len(req.NodeId) != consts.NodeIDLength {
+		return nil, status.Errorf(codes.InvalidArgument, "node ID must have %d characters", consts.NodeIDLength)
+	}
+
+	if req.OrchestratorAddress == "" || req.IpAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "orchestrator address and IP address are required")
+	}
+
+	info := &NodeInfo{
+		ID:                  req.NodeId,
+		OrchestratorAddress: req.OrchestratorAddress,
+		IPAddress:           req.IpAddress,
+		Labels:              req.Labels,
+		CPUCount:            req.CpuCount,
+		MemoryMB:            req.MemoryMb,
+		Draining:            req.Draining,
+		ExpiresAt:           time.Now().Add(registrationLease),
+		ClusterID:           req.ClusterId,
+		Region:              req.Region,
+		ClusterProxyIP:      req.ClusterProxyIp,
+	}
+
+	if info.ClusterID == "" {
+		info.ClusterID = LocalClusterID
+	}
+
+	err := r.set(ctx, info)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to register node '%s': %s", req.NodeId, err)
+	}
+
+	return &orchestrator.NodeRegisterResponse{LeaseSeconds: int64(registrationLease.Seconds())}, nil
+}
+
+// List returns the nodes with the registration that hasn't expired, the expired registrations are removed.
+func (r *Registry) List(ctx context.Context) ([]*NodeInfo, error) {
+	now := time.Now()
+
+	if r.redis == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		nodes := make([]*NodeInfo, 0, len(r.local))
+		for id, info := range r.local {
+			if !info.ExpiresAt.After(now) {
+				delete(r.local, id)
+
+				continue
+			}
+
+			nodes = append(nodes, info)
+		}
+
+		return nodes, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, registryTimeout)
+	defer cancel()
+
+	values, err := r.redis.HGetAll(ctx, registryKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registered nodes: %w", err)
+	}
+
+	nodes := make([]*NodeInfo, 0, len(values))
+	expired := make([]string, 0)
+
+	for id, value := range values {
+		var info NodeInfo
+
+		err = json.Unmarshal([]byte(value), &info)
+		if err != nil || !info.ExpiresAt.After(now) {
+			expired = append(expired, id)
+
+			continue
+		}
+
+		nodes = append(nodes, &info)
+	}
+
+	if len(expired) > 0 {
+		err = r.redis.HDel(ctx, registryKey, expired...).Err()
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove expired nodes: %w", err)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (r *Registry) set(ctx context.Context, info *NodeInfo) error {
+	if r.redis == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.local[info.ID] = info
+
+		return nil
+	}
+
+	value, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, registryTimeout)
+	defer cancel()
+
+	return r.redis.HSet(ctx, registryKey, info.ID, value).Err()
+}
@@ -0,0 +1,53 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ErrArtifactPath is returned when the path in the sandbox can't be persisted, e.g. it doesn't exist.
+type ErrArtifactPath struct {
+	Message string
+}
+
+func (e ErrArtifactPath) Error() string {
+	return e.Message
+}
+
+// SaveArtifact stores the file or the directory of the sandbox in the artifacts of the team, it returns once the artifact is stored.
+func (o *Orchestrator) SaveArtifact(ctx context.Context, sbx *instance.InstanceInfo, name, path string, labels map[string]string) (*orchestrator.SandboxSaveArtifactResponse, error) {
+	childCtx, childSpan := o.tracer.Start(ctx, "save-artifact")
+	defer childSpan.End()
+
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+	}
+
+	res, err := client.Sandbox.SaveArtifact(childCtx, &orchestrator.SandboxSaveArtifactRequest{
+		SandboxId: sbx.Instance.SandboxID,
+		Name:      name,
+		Path:      path,
+		Labels:    labels,
+	})
+	if status.Code(err) == codes.InvalidArgument {
+		return nil, ErrArtifactPath{Message: status.Convert(err).Message()}
+	}
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save artifact '%s' of sandbox '%s': %w", name, sbx.Instance.SandboxID, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "Saved artifact")
+
+	return res, nil
+}
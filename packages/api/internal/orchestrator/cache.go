@@ -7,15 +7,19 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/posthog/posthog-go"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	analyticscollector "github.com/e2b-dev/infra/packages/api/internal/analytics_collector"
+	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -34,7 +38,7 @@ func (o *Orchestrator) GetSandbox(sandboxID string) (*instance.InstanceInfo, err
 func (o *Orchestrator) keepInSync(instanceCache *instance.InstanceCache) {
 	for {
 		ctx, span := o.tracer.Start(context.Background(), "keep-in-sync")
-		nodes, err := o.listNomadNodes(ctx)
+		nodes, err := o.listNodes(ctx)
 		if err != nil {
 			o.logger.Errorf("Error listing nodes: %v", err)
 			span.End()
@@ -58,22 +62,29 @@ func (o *Orchestrator) keepInSync(instanceCache *instance.InstanceCache) {
 		}
 		wg.Wait()
 
+		syncedNodes := smap.New[bool]()
 		for _, n := range o.nodes.Items() {
 			wg.Add(1)
 			go func(n *Node) {
 				defer wg.Done()
-				o.syncNode(ctx, n, nodes, instanceCache)
+				if o.syncNode(ctx, n, nodes, instanceCache) {
+					syncedNodes.Insert(n.Info.ID, true)
+				}
 			}(n)
 		}
 		wg.Wait()
 
+		o.reconcileSandboxes(ctx, nodes, syncedNodes)
+		o.synced.Store(true)
+
 		span.End()
 		// Sleep for a while before syncing again
 		time.Sleep(instance.CacheSyncTime)
 	}
 }
 
-func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.NodeInfo, instanceCache *instance.InstanceCache) {
+// syncNode returns true if the sandboxes in the cache were synced with the node.
+func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.NodeInfo, instanceCache *instance.InstanceCache) bool {
 	ctx, childSpan := o.tracer.Start(ctx, "sync-node")
 	telemetry.SetAttributes(ctx, attribute.String("node.id", node.Info.ID))
 	defer childSpan.End()
@@ -82,6 +93,13 @@ func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.N
 	for _, activeNode := range nodes {
 		if node.Info.ID == activeNode.ID {
 			found = true
+
+			// The registered node is draining before it shuts down
+			if activeNode.Draining && node.Status() != api.NodeStatusDraining {
+				o.logger.Infof("Node %s is draining", node.Info.ID)
+				node.SetStatus(api.NodeStatusDraining)
+			}
+
 			break
 		}
 	}
@@ -97,24 +115,48 @@ func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.N
 
 		o.nodes.Remove(node.Info.ID)
 
-		return
+		return false
 	}
 
-	activeInstances, instancesErr := o.getSandboxes(ctx, node.Info)
+	listedAt := time.Now()
+	activeInstances, instancesErr := o.getSandboxes(ctx, node)
 	if instancesErr != nil {
 		o.logger.Errorf("Error getting instances: %v", instancesErr)
-		return
+		return false
 	}
 
 	instanceCache.Sync(activeInstances, node.Info.ID)
 
+	// The counts shared by the API replicas are reconciled with the sandboxes actually running on the node
+	teamSandboxes := make(map[uuid.UUID][]string)
+	for _, sbx := range activeInstances {
+		teamSandboxes[*sbx.TeamID] = append(teamSandboxes[*sbx.TeamID], sbx.Instance.SandboxID)
+	}
+
+	countErr := o.teamCounter.Refresh(ctx, teamSandboxes, listedAt)
+	if countErr != nil {
+		o.logger.Errorf("Error refreshing sandbox counts: %v", countErr)
+	}
+
 	builds, buildsErr := o.listCachedBuilds(ctx, node.Info.ID)
 	if buildsErr != nil {
 		o.logger.Errorf("Error listing cached builds: %v", buildsErr)
-		return
+		return true
 	}
 
 	node.SyncBuilds(builds)
+
+	pinnedErr := o.syncPinnedBuilds(ctx, node)
+	if pinnedErr != nil {
+		o.logger.Errorf("Error syncing pinned builds: %v", pinnedErr)
+	}
+
+	uploadsErr := o.syncSnapshotUploads(ctx, node)
+	if uploadsErr != nil {
+		o.logger.Errorf("Error syncing snapshot uploads: %v", uploadsErr)
+	}
+
+	return true
 }
 
 func (o *Orchestrator) getDeleteInstanceFunction(ctx context.Context, posthogClient *analyticscollector.PosthogClient, logger *zap.SugaredLogger) func(info instance.InstanceInfo) error {
@@ -132,6 +174,17 @@ func (o *Orchestrator) getDeleteInstanceFunction(ctx context.Context, posthogCli
 			logger.Errorf("error sending Analytics event: %v", err)
 		}
 
+		// The cost since the last metering of the team budget is accrued with the end of the sandbox
+		err = o.db.EndSandbox(ctx, info.Instance.SandboxID, time.Now())
+		if err != nil {
+			logger.Errorf("error ending persisted sandbox: %v", err)
+		}
+
+		err = o.teamCounter.Release(ctx, *info.TeamID, info.Instance.SandboxID)
+		if err != nil {
+			logger.Errorf("error releasing sandbox count: %v", err)
+		}
+
 		posthogClient.CreateAnalyticsTeamEvent(
 			info.TeamID.String(),
 			"closed_instance", posthog.NewProperties().
@@ -147,7 +200,7 @@ func (o *Orchestrator) getDeleteInstanceFunction(ctx context.Context, posthogCli
 			node.CPUUsage.Add(-info.VCpu)
 			node.RamUsage.Add(-info.RamMB)
 
-			o.dns.Remove(ctx, info.Instance.SandboxID, node.Info.IPAddress)
+			o.dns.Remove(ctx, info.Instance.SandboxID, node.Info.RoutingIP())
 		}
 
 		req := &orchestrator.SandboxDeleteRequest{SandboxId: info.Instance.SandboxID}
@@ -179,10 +232,21 @@ func (o *Orchestrator) getInsertInstanceFunction(ctx context.Context, logger *za
 			node.CPUUsage.Add(info.VCpu)
 			node.RamUsage.Add(info.RamMB)
 
-			o.dns.Add(ctx, info.Instance.SandboxID, node.Info.IPAddress)
+			o.dns.Add(ctx, info.Instance.SandboxID, node.Info.RoutingIP())
+		}
+
+		err := o.db.UpsertSandbox(ctx, recordFromInstance(info), sandbox.StateRunning)
+		if err != nil {
+			logger.Errorf("Error persisting sandbox: %v", err)
+		}
+
+		// The reservation made during the creation is replaced by the lease of the running sandbox
+		err = o.teamCounter.Refresh(ctx, map[uuid.UUID][]string{*info.TeamID: {info.Instance.SandboxID}}, time.Now())
+		if err != nil {
+			logger.Errorf("Error counting sandbox: %v", err)
 		}
 
-		_, err := o.analytics.Client.InstanceStarted(ctx, &analyticscollector.InstanceStartedEvent{
+		_, err = o.analytics.Client.InstanceStarted(ctx, &analyticscollector.InstanceStartedEvent{
 			InstanceId:    info.Instance.SandboxID,
 			EnvironmentId: info.Instance.TemplateID,
 			BuildId:       info.BuildID.String(),
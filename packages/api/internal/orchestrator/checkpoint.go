@@ -0,0 +1,81 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+type ErrCheckpointNotFound struct{}
+
+func (ErrCheckpointNotFound) Error() string {
+	return "The checkpoint was not found"
+}
+
+type SandboxCheckpoint struct {
+	ID        string
+	CreatedAt time.Time
+}
+
+func (o *Orchestrator) CreateCheckpoint(ctx context.Context, sbx *instance.InstanceInfo) (*SandboxCheckpoint, error) {
+	childCtx, childSpan := o.tracer.Start(ctx, "create-checkpoint")
+	defer childSpan.End()
+
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+	}
+
+	res, err := client.Sandbox.Checkpoint(childCtx, &orchestrator.SandboxCheckpointRequest{
+		SandboxId: sbx.Instance.SandboxID,
+	})
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint for sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "Created checkpoint")
+
+	return &SandboxCheckpoint{
+		ID:        res.CheckpointId,
+		CreatedAt: res.CreatedAt.AsTime(),
+	}, nil
+}
+
+// ChangedFiles lists the files changed in the sandbox between the checkpoints, empty checkpoint IDs mean the sandbox start and the current state.
+func (o *Orchestrator) ChangedFiles(ctx context.Context, sbx *instance.InstanceInfo, fromCheckpointID, toCheckpointID *string) ([]*orchestrator.ChangedFile, error) {
+	childCtx, childSpan := o.tracer.Start(ctx, "list-changed-files")
+	defer childSpan.End()
+
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+	}
+
+	res, err := client.Sandbox.ChangedFiles(childCtx, &orchestrator.SandboxChangedFilesRequest{
+		SandboxId:        sbx.Instance.SandboxID,
+		FromCheckpointId: fromCheckpointID,
+		ToCheckpointId:   toCheckpointID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrCheckpointNotFound{}
+	}
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changed files for sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "Listed changed files")
+
+	return res.Files, nil
+}
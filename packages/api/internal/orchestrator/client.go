@@ -14,6 +14,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/node"
 	e2bgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/requestid"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 )
 
@@ -23,7 +24,16 @@ type GRPCClient struct {
 }
 
 func NewClient(host string) (*GRPCClient, error) {
-	conn, err := e2bgrpc.GetConnection(host, false, grpc.WithStatsHandler(otelgrpc.NewClientHandler()), grpc.WithBlock(), grpc.WithTimeout(time.Second))
+	conn, err := e2bgrpc.GetConnection(
+		host,
+		false,
+		e2bgrpc.WithToken(node.Token),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(requestid.StreamClientInterceptor()),
+		grpc.WithBlock(),
+		grpc.WithTimeout(time.Second),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to establish GRPC connection: %w", err)
 	}
@@ -59,6 +69,7 @@ func (o *Orchestrator) connectToNode(ctx context.Context, node *node.NodeInfo) e
 	n := &Node{
 		Client:         client,
 		buildCache:     buildCache,
+		warming:        smap.New[struct{}](),
 		sbxsInProgress: smap.New[*sbxInProgress](),
 		status:         api.NodeStatusReady,
 		Info:           node,
@@ -66,6 +77,8 @@ func (o *Orchestrator) connectToNode(ctx context.Context, node *node.NodeInfo) e
 
 	o.nodes.Insert(n.Info.ID, n)
 
+	go o.watchNode(n)
+
 	return nil
 }
 
@@ -0,0 +1,55 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// Console is the serial console of the sandbox attached via the orchestrator.
+type Console struct {
+	stream orchestrator.SandboxService_ConsoleClient
+}
+
+// Read returns the next chunk of the console output, io.EOF is returned when the console is detached.
+func (c *Console) Read() ([]byte, error) {
+	res, err := c.stream.Recv()
+	if err != nil {
+		return nil, utils.UnwrapGRPCError(err)
+	}
+
+	return res.Output, nil
+}
+
+// Write sends the input to the console, the console must be attached as writable.
+func (c *Console) Write(input []byte) error {
+	return c.stream.Send(&orchestrator.SandboxConsoleRequest{
+		Input: input,
+	})
+}
+
+// AttachConsole attaches to the serial console of the sandbox, the console is detached when the context is canceled.
+func (o *Orchestrator) AttachConsole(ctx context.Context, sbx *instance.InstanceInfo, writable bool) (*Console, error) {
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+	}
+
+	stream, err := client.Sandbox.Console(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open console stream for sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	err = stream.Send(&orchestrator.SandboxConsoleRequest{
+		SandboxId: sbx.Instance.SandboxID,
+		Writable:  writable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach console for sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	return &Console{stream: stream}, nil
+}
@@ -3,6 +3,7 @@ package orchestrator
 import (
 	"context"
 	_ "embed"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -16,9 +17,13 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	dbsandbox "github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -29,7 +34,11 @@ func (o *Orchestrator) CreateSandbox(
 	team authcache.AuthTeamInfo,
 	build *models.EnvBuild,
 	metadata,
+	labels,
 	envVars map[string]string,
+	secretEnvVars []string,
+	secrets map[string]string,
+	secretRefs []string,
 	startTime time.Time,
 	endTime time.Time,
 	timeout time.Duration,
@@ -37,23 +46,41 @@ func (o *Orchestrator) CreateSandbox(
 	isResume bool,
 	clientID *string,
 	baseTemplateID string,
+	priority *api.SandboxPriority,
+	placement *Placement,
+	image *Image,
+	allowWarming bool,
+	egress *schema.EgressPolicy,
 ) (*api.Sandbox, error) {
 	childCtx, childSpan := o.tracer.Start(ctx, "create-sandbox")
 	defer childSpan.End()
 
-	// // Check if team has reached max instances
-	// err, releaseTeamSandboxReservation := o.instanceCache.Reserve(sandboxID, teamID, maxInstancesPerTeam)
-	// if err != nil {
-	// 	errMsg := fmt.Errorf("team '%s' has reached the maximum number of instances (%d)", teamID, maxInstancesPerTeam)
-	// 	telemetry.ReportCriticalError(ctx, fmt.Errorf("%w (error: %w)", errMsg, err))
-	//
-	// 	return nil, fmt.Errorf(
-	// 		"you have reached the maximum number of concurrent E2B sandboxes (%d). If you need more, "+
-	// 			"please contact us at 'https://e2b.dev/docs/getting-help'", maxInstancesPerTeam)
-	// }
-	//
-	// telemetry.ReportEvent(childCtx, "Reserved sandbox for team")
-	// defer releaseTeamSandboxReservation()
+	created := false
+
+	// The sandboxes are counted in the store shared by the API replicas, so the limit of the team holds across them
+	err := o.teamCounter.Reserve(childCtx, team.Team.ID, sandboxID, team.Tier.ConcurrentInstances)
+	var limitErr sandbox.ErrLimitReached
+	if errors.As(err, &limitErr) {
+		telemetry.ReportCriticalError(ctx, fmt.Errorf("team '%s' has reached the maximum number of instances (%d)", team.Team.ID, limitErr.Limit))
+
+		return nil, errcode.Wrap(errcode.RateLimited, fmt.Errorf("%w. If you need more, please contact us at 'https://e2b.dev/docs/getting-help'", err))
+	} else if err != nil {
+		// The limit is not enforced when the store is not available, the sandboxes are still counted by the sync with the nodes
+		telemetry.ReportError(childCtx, err)
+	} else {
+		telemetry.ReportEvent(childCtx, "Reserved sandbox for team")
+
+		defer func() {
+			if created {
+				return
+			}
+
+			releaseErr := o.teamCounter.Release(context.WithoutCancel(childCtx), team.Team.ID, sandboxID)
+			if releaseErr != nil {
+				telemetry.ReportError(childCtx, releaseErr)
+			}
+		}()
+	}
 
 	features, err := sandbox.NewVersionInfo(build.FirecrackerVersion)
 	if err != nil {
@@ -64,6 +91,19 @@ func (o *Orchestrator) CreateSandbox(
 
 	telemetry.ReportEvent(childCtx, "Got FC version info")
 
+	var onResumeHook, onPauseHook *orchestrator.LifecycleHook
+	if build.Hooks != nil {
+		onResumeHook = lifecycleHookToProto(build.Hooks.OnResume)
+		onPauseHook = lifecycleHookToProto(build.Hooks.OnPause)
+	}
+
+	imageRef, imageAuth := imageToProto(image)
+
+	// The policy of the sandbox overrides the policy of the template, the resumed sandbox keeps the policy of the snapshot
+	if egress == nil {
+		egress = build.Egress
+	}
+
 	sbxRequest := &orchestrator.SandboxCreateRequest{
 		Sandbox: &orchestrator.SandboxConfig{
 			BaseTemplateId:     baseTemplateID,
@@ -73,42 +113,114 @@ func (o *Orchestrator) CreateSandbox(
 			BuildId:            build.ID.String(),
 			SandboxId:          sandboxID,
 			KernelVersion:      build.KernelVersion,
+			KernelChecksum:     o.getKernelChecksum(childCtx, build.KernelVersion),
 			FirecrackerVersion: build.FirecrackerVersion,
 			EnvdVersion:        *build.EnvdVersion,
 			Metadata:           metadata,
+			Labels:             labels,
 			EnvVars:            envVars,
+			SecretEnvVars:      secretEnvVars,
+			Secrets:            secrets,
+			SecretRefs:         secretRefs,
+			EncryptSnapshots:   team.Team.EncryptSnapshots,
 			MaxSandboxLength:   team.Tier.MaxLengthHours,
 			HugePages:          features.HasHugePages(),
 			RamMb:              build.RAMMB,
 			Vcpu:               build.Vcpu,
 			Snapshot:           isResume,
+			ReadinessProbe:     readinessProbeToProto(build.ReadinessProbe),
+			OnResumeHook:       onResumeHook,
+			OnPauseHook:        onPauseHook,
+			Hardening:          hardeningPolicyToProto(build.Hardening),
+			Egress:             egressPolicyToProto(egress),
+			Priority:           priorityToProto(priority),
+			Image:              imageRef,
+			ImageAuth:          imageAuth,
 		},
 		StartTime: timestamppb.New(startTime),
 		EndTime:   timestamppb.New(endTime),
 	}
 
+	// Persist the intent to create the sandbox, so it isn't lost if the API restarts during the creation
+	err = o.db.UpsertSandbox(childCtx, &db.SandboxRecord{
+		SandboxID: sandboxID,
+		TeamID:    team.Team.ID,
+		EnvID:     *build.EnvID,
+		BuildID:   build.ID,
+		Alias:     &alias,
+		StartedAt: startTime,
+		EndAt:     endTime,
+		VCPU:      build.Vcpu,
+		RAMMB:     build.RAMMB,
+		Metadata:  metadata,
+		Labels:    labels,
+	}, dbsandbox.StateCreating)
+	if err != nil {
+		telemetry.ReportError(childCtx, err)
+	} else {
+		defer func() {
+			if created {
+				return
+			}
+
+			deleteErr := o.db.DeleteSandbox(context.WithoutCancel(childCtx), sandboxID)
+			if deleteErr != nil {
+				telemetry.ReportError(childCtx, deleteErr)
+			}
+		}()
+	}
+
+	// The snapshot that isn't uploaded to the storage yet exists only on the node it was taken on
+	snapshotNodeID, nodeOnly := "", false
+	if isResume {
+		snapshotNodeID, nodeOnly = db.SnapshotNodeOnly(build)
+	}
+
 	var node *Node
 
-	if isResume && clientID != nil {
+	// The high priority sandbox can preempt one low priority sandbox when there is no capacity for it
+	canPreempt := sbxRequest.Sandbox.Priority == orchestrator.SandboxPriority_PRIORITY_HIGH && !nodeOnly
+
+	if nodeOnly {
+		telemetry.ReportEvent(childCtx, "Placing sandbox on the node with the snapshot that isn't uploaded yet")
+
+		node, _ = o.nodes.Get(snapshotNodeID)
+		if node == nil || node.Status() != api.NodeStatusReady {
+			return nil, errcode.Wrap(errcode.Unavailable, fmt.Errorf("the snapshot is still being uploaded and the node it was taken on is not available, try again later"))
+		}
+	} else if isResume && clientID != nil {
 		telemetry.ReportEvent(childCtx, "Placing sandbox on the node where the snapshot was taken")
 
 		node, _ = o.nodes.Get(*clientID)
-		if node != nil && node.Status() != api.NodeStatusReady {
+		if node != nil && (node.Status() != api.NodeStatusReady || node.isSaturated() || !placement.allows(node.Info)) {
 			node = nil
 		}
 	}
 
 	for {
 		if node == nil {
-			node, err = o.getLeastBusyNode(childCtx)
+			var preempted bool
+
+			node, preempted, err = o.getLeastBusyNode(childCtx, placement, canPreempt, sandboxID, sbxRequest.Sandbox.BuildId, build.Vcpu, build.RAMMB)
+			if preempted {
+				canPreempt = false
+			}
+
 			if err != nil {
 				errMsg := fmt.Errorf("failed to get least busy node: %w", err)
 				telemetry.ReportError(childCtx, errMsg)
 
-				return nil, errMsg
+				return nil, errcode.Wrap(errcode.NodeCapacity, errMsg)
 			}
 		}
 
+		// The sandbox would wait for the fetch of the build on the node, it's delayed instead if the client allows it
+		if allowWarming && !nodeOnly && !node.warmBuild(sbxRequest.Sandbox) {
+			telemetry.ReportEvent(childCtx, "Delayed sandbox until the build is fetched to the node", attribute.String("node.id", node.Info.ID))
+
+			return nil, ErrBuildWarming
+		}
+
 		// To creating a lot of sandboxes at once on the same node
 		node.sbxsInProgress.Insert(sandboxID, &sbxInProgress{
 			MiBMemory: build.RAMMB,
@@ -129,6 +241,22 @@ func (o *Orchestrator) CreateSandbox(
 			if node.Client.connection.GetState() != connectivity.Ready {
 				// If the connection is not ready, we should remove the node from the list
 				o.nodes.Remove(node.Info.ID)
+			} else if code, ok := errcode.Of(err); ok && code == errcode.NodeCapacity {
+				// The node has no capacity left (e.g. no free nbd devices), the sandbox is placed on another node
+				log.Printf("node '%s' is saturated: %v", node.Info.ID, err)
+				telemetry.ReportEvent(childCtx, "node is saturated", attribute.String("node.id", node.Info.ID))
+
+				if canPreempt && o.preempt(childCtx, node, placement, sandboxID, build.Vcpu, build.RAMMB) != nil {
+					// The sandbox is placed on the same node again, with the resources of the preempted sandbox
+					canPreempt = false
+
+					continue
+				}
+
+				node.markSaturated()
+			} else if code, ok := errcode.Of(err); ok && code == errcode.RateLimited {
+				// The team has reached its quota on the node
+				return nil, errcode.Wrap(errcode.RateLimited, err)
 			} else {
 				log.Printf("failed to create sandbox on node '%s': %v", node.Info.ID, err)
 
@@ -136,6 +264,10 @@ func (o *Orchestrator) CreateSandbox(
 			}
 		}
 
+		if nodeOnly {
+			return nil, errcode.Wrap(errcode.Unavailable, fmt.Errorf("the snapshot is still being uploaded and the node it was taken on is not available, try again later"))
+		}
+
 		// The node is not available, try again with another node
 		node = nil
 	}
@@ -157,6 +289,10 @@ func (o *Orchestrator) CreateSandbox(
 		EnvdVersion: *build.EnvdVersion,
 	}
 
+	if node.Info.Region != "" {
+		sbx.Region = &node.Info.Region
+	}
+
 	// This is to compensate for the time it takes to start the instance
 	// Otherwise it could cause the instance to expire before user has a chance to use it
 	startTime = time.Now()
@@ -170,12 +306,19 @@ func (o *Orchestrator) CreateSandbox(
 		BuildID:            &build.ID,
 		TeamID:             &team.Team.ID,
 		Metadata:           metadata,
+		Labels:             labels,
 		VCpu:               build.Vcpu,
 		RamMB:              build.RAMMB,
 		TotalDiskSizeMB:    *build.TotalDiskSizeMB,
 		KernelVersion:      build.KernelVersion,
 		FirecrackerVersion: build.FirecrackerVersion,
 		EnvdVersion:        *build.EnvdVersion,
+		ReadinessProbe:     build.ReadinessProbe,
+		Hooks:              build.Hooks,
+		Hardening:          build.Hardening,
+		Egress:             egress,
+		SecretRefs:         secretRefs,
+		Priority:           priorityFromProto(sbxRequest.Sandbox.Priority),
 		MaxInstanceLength:  time.Duration(team.Tier.MaxLengthHours) * time.Hour,
 		Node:               node.Info,
 	}
@@ -193,22 +336,42 @@ func (o *Orchestrator) CreateSandbox(
 		return nil, errMsg
 	}
 
+	created = true
+
 	return &sbx, nil
 }
 
-func (o *Orchestrator) getLeastBusyNode(ctx context.Context) (leastBusyNode *Node, err error) {
+// getLeastBusyNode waits for a node with free capacity allowed by the placement, the nodes in the more preferred regions are used first.
+// In the same region, the nodes that have the build cached are preferred, the sandbox doesn't wait for the fetch of the build there.
+// If canPreempt is set and no node is free for the preemptionWait, a low priority sandbox is preempted to make room for the sandbox
+// and its node is returned with preempted set.
+func (o *Orchestrator) getLeastBusyNode(ctx context.Context, placement *Placement, canPreempt bool, sandboxID, buildID string, vcpu, ramMB int64) (leastBusyNode *Node, preempted bool, err error) {
 	childCtx, childSpan := o.tracer.Start(ctx, "get-least-busy-node")
 	defer childSpan.End()
 
+	start := time.Now()
+
 	for {
 		if childCtx.Err() != nil {
-			return nil, fmt.Errorf("context was canceled")
+			return nil, false, fmt.Errorf("context was canceled")
 		}
 
-		// TODO: Incorporate the node's cached builds and total resources into the decision
-		for _, node := range o.nodes.Items() {
+		nodes := o.nodes.Items()
+		leastBusyTier := 0
+		leastBusyCached := false
+		allowed := false
+
+		// TODO: Incorporate the node's total resources into the decision
+		for _, node := range nodes {
+			tier, ok := placement.rank(node.Info)
+			if !ok {
+				continue
+			}
+
+			allowed = true
+
 			// To prevent overloading the node
-			if len(node.sbxsInProgress.Items()) > 3 || node.Status() != api.NodeStatusReady {
+			if len(node.sbxsInProgress.Items()) > 3 || node.Status() != api.NodeStatusReady || node.isSaturated() || node.isFull() {
 				continue
 			}
 
@@ -217,13 +380,34 @@ func (o *Orchestrator) getLeastBusyNode(ctx context.Context) (leastBusyNode *Nod
 				cpuUsage += sbx.CPUs
 			}
 
-			if leastBusyNode == nil || (node.CPUUsage.Load()+cpuUsage) < leastBusyNode.CPUUsage.Load() {
+			cached := node.hasBuild(buildID)
+
+			if leastBusyNode == nil || tier < leastBusyTier ||
+				(tier == leastBusyTier && cached && !leastBusyCached) ||
+				(tier == leastBusyTier && cached == leastBusyCached && (node.CPUUsage.Load()+cpuUsage) < leastBusyNode.CPUUsage.Load()) {
 				leastBusyNode = node
+				leastBusyTier = tier
+				leastBusyCached = cached
 			}
 		}
 
 		if leastBusyNode != nil {
-			return leastBusyNode, nil
+			return leastBusyNode, false, nil
+		}
+
+		if !allowed && len(nodes) > 0 {
+			// Waiting doesn't help when there is no node in the regions of the sandbox at all
+			return nil, false, fmt.Errorf("no node in the regions allowed for the sandbox")
+		}
+
+		if canPreempt && time.Since(start) > preemptionWait {
+			// Preempting is attempted only once, the sandbox waits for the capacity afterwards
+			canPreempt = false
+
+			node := o.preempt(childCtx, nil, placement, sandboxID, vcpu, ramMB)
+			if node != nil {
+				return node, true, nil
+			}
 		}
 
 		// If no node is available, wait for a bit
@@ -0,0 +1,48 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+type ErrDiagnosticsNotFound struct{}
+
+func (ErrDiagnosticsNotFound) Error() string {
+	return "The sandbox diagnostics were not found"
+}
+
+// GetDiagnostics returns the stored forensic bundle of the sandbox with the ID of the team that owned the sandbox.
+// The bundles are kept in the shared storage, so any ready node can return them even after the sandbox is gone.
+func (o *Orchestrator) GetDiagnostics(ctx context.Context, sandboxID string) ([]byte, string, error) {
+	childCtx, childSpan := o.tracer.Start(ctx, "get-diagnostics")
+	defer childSpan.End()
+
+	for _, node := range o.nodes.Items() {
+		if node.Status() != api.NodeStatusReady {
+			continue
+		}
+
+		res, err := node.Client.Sandbox.Diagnostics(childCtx, &orchestrator.SandboxDiagnosticsRequest{
+			SandboxId: sandboxID,
+		})
+		if status.Code(err) == codes.NotFound {
+			return nil, "", ErrDiagnosticsNotFound{}
+		}
+
+		err = utils.UnwrapGRPCError(err)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get diagnostics for sandbox '%s': %w", sandboxID, err)
+		}
+
+		return res.Bundle, res.TeamId, nil
+	}
+
+	return nil, "", fmt.Errorf("no ready node to get diagnostics for sandbox '%s'", sandboxID)
+}
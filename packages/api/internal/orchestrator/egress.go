@@ -0,0 +1,30 @@
+package orchestrator
+
+import (
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+func egressPolicyToProto(policy *schema.EgressPolicy) *orchestrator.EgressPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &orchestrator.EgressPolicy{
+		Preset:       policy.Preset,
+		AllowAll:     policy.AllowAll,
+		AllowedHosts: policy.AllowedHosts,
+	}
+}
+
+func egressPolicyFromProto(policy *orchestrator.EgressPolicy) *schema.EgressPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &schema.EgressPolicy{
+		Preset:       policy.Preset,
+		AllowAll:     policy.AllowAll,
+		AllowedHosts: policy.AllowedHosts,
+	}
+}
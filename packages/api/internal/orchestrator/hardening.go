@@ -0,0 +1,32 @@
+package orchestrator
+
+import (
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+func hardeningPolicyToProto(policy *schema.HardeningPolicy) *orchestrator.HardeningPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &orchestrator.HardeningPolicy{
+		Level:           policy.Level,
+		NoNewPrivileges: policy.NoNewPrivileges,
+		ReadOnlyPaths:   policy.ReadOnlyPaths,
+		DeniedSyscalls:  policy.DeniedSyscalls,
+	}
+}
+
+func hardeningPolicyFromProto(policy *orchestrator.HardeningPolicy) *schema.HardeningPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &schema.HardeningPolicy{
+		Level:           policy.Level,
+		NoNewPrivileges: policy.NoNewPrivileges,
+		ReadOnlyPaths:   policy.ReadOnlyPaths,
+		DeniedSyscalls:  policy.DeniedSyscalls,
+	}
+}
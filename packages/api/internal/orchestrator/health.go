@@ -0,0 +1,78 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+)
+
+const nodeHealthTimeout = 3 * time.Second
+
+// NodeHealth is the result of the health check of the node.
+type NodeHealth struct {
+	NodeID    string
+	ClusterID string
+	Status    api.NodeStatus
+	Latency   time.Duration
+	CheckedAt time.Time
+	Err       error
+}
+
+// CheckNodes checks the health of all connected nodes in parallel.
+func (o *Orchestrator) CheckNodes(ctx context.Context) []NodeHealth {
+	nodes := o.nodes.Items()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	result := make([]NodeHealth, 0, len(nodes))
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+
+			health := n.check(ctx)
+
+			mu.Lock()
+			result = append(result, health)
+			mu.Unlock()
+		}(n)
+	}
+
+	wg.Wait()
+
+	return result
+}
+
+func (n *Node) check(ctx context.Context) NodeHealth {
+	ctx, cancel := context.WithTimeout(ctx, nodeHealthTimeout)
+	defer cancel()
+
+	start := time.Now()
+
+	health := NodeHealth{
+		NodeID:    n.Info.ID,
+		ClusterID: n.Info.ClusterID,
+		Status:    n.Status(),
+		CheckedAt: start,
+	}
+
+	resp, err := grpc_health_v1.NewHealthClient(n.Client.connection).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	health.Latency = time.Since(start)
+
+	switch {
+	case err != nil:
+		health.Err = fmt.Errorf("failed to check health of node '%s': %w", n.Info.ID, err)
+	case resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING:
+		health.Err = fmt.Errorf("node '%s' is %s", n.Info.ID, resp.GetStatus())
+	}
+
+	n.lastHealth.Store(&health)
+
+	return health
+}
@@ -0,0 +1,61 @@
+package orchestrator
+
+import (
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+func lifecycleHookToProto(hook *schema.LifecycleHook) *orchestrator.LifecycleHook {
+	if hook == nil {
+		return nil
+	}
+
+	result := &orchestrator.LifecycleHook{
+		Command:       hook.Command,
+		FailurePolicy: orchestrator.HookFailurePolicy_IGNORE,
+	}
+
+	if hook.TimeoutSeconds != nil {
+		result.TimeoutSeconds = *hook.TimeoutSeconds
+	}
+
+	if hook.FailurePolicy != nil && *hook.FailurePolicy == string(api.Fail) {
+		result.FailurePolicy = orchestrator.HookFailurePolicy_FAIL
+	}
+
+	return result
+}
+
+func lifecycleHookFromProto(hook *orchestrator.LifecycleHook) *schema.LifecycleHook {
+	if hook == nil {
+		return nil
+	}
+
+	result := &schema.LifecycleHook{
+		Command: hook.Command,
+	}
+
+	if hook.TimeoutSeconds > 0 {
+		timeout := hook.TimeoutSeconds
+		result.TimeoutSeconds = &timeout
+	}
+
+	if hook.FailurePolicy == orchestrator.HookFailurePolicy_FAIL {
+		policy := string(api.Fail)
+		result.FailurePolicy = &policy
+	}
+
+	return result
+}
+
+func lifecycleHooksFromProto(config *orchestrator.SandboxConfig) *schema.LifecycleHooks {
+	if config.OnResumeHook == nil && config.OnPauseHook == nil {
+		return nil
+	}
+
+	return &schema.LifecycleHooks{
+		OnResume: lifecycleHookFromProto(config.OnResumeHook),
+		OnPause:  lifecycleHookFromProto(config.OnPauseHook),
+	}
+}
@@ -0,0 +1,28 @@
+package orchestrator
+
+import (
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// Image is the OCI image the sandbox boots from, the credential is set for the images in the private registries of the team.
+type Image struct {
+	Ref      string
+	Username string
+	Password string
+}
+
+// imageToProto returns the reference of the image and the credential for its registry, nil if the sandbox isn't booted from the image.
+func imageToProto(image *Image) (*string, *orchestrator.RegistryAuth) {
+	if image == nil {
+		return nil, nil
+	}
+
+	if image.Username == "" && image.Password == "" {
+		return &image.Ref, nil
+	}
+
+	return &image.Ref, &orchestrator.RegistryAuth{
+		Username: image.Username,
+		Password: image.Password,
+	}
+}
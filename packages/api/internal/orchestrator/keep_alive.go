@@ -1,8 +1,24 @@
 package orchestrator
 
-import "time"
+import (
+	"context"
+	"time"
 
-func (o *Orchestrator) KeepAliveFor(sandboxID string, duration time.Duration, allowShorter bool) error {
-	_, err := o.instanceCache.KeepAliveFor(sandboxID, duration, allowShorter)
-	return err
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// KeepAliveFor extends the sandbox and persists its new end time, so the sandbox records show when the sandbox will end.
+func (o *Orchestrator) KeepAliveFor(ctx context.Context, sandboxID string, duration time.Duration, allowShorter bool) error {
+	sbx, err := o.instanceCache.KeepAliveFor(sandboxID, duration, allowShorter)
+	if err != nil {
+		return err
+	}
+
+	// The sandbox is extended even if its record isn't updated, the end time of the record is only informative
+	err = o.db.UpdateSandboxEndAt(ctx, sandboxID, sbx.EndTime)
+	if err != nil {
+		telemetry.ReportError(ctx, err)
+	}
+
+	return nil
 }
@@ -0,0 +1,38 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const kernelChecksumExpiration = time.Minute
+
+// getKernelChecksum returns the checksum of the registered kernel the nodes verify the downloaded kernel against.
+// The kernels that are not registered are not verified.
+func (o *Orchestrator) getKernelChecksum(ctx context.Context, version string) string {
+	if item := o.kernelChecksums.Get(version); item != nil {
+		return item.Value()
+	}
+
+	k, err := o.db.GetKernel(ctx, version)
+	if err != nil {
+		if !models.IsNotFound(err) {
+			telemetry.ReportError(ctx, err)
+
+			return ""
+		}
+
+		o.kernelChecksums.Set(version, "", ttlcache.DefaultTTL)
+
+		return ""
+	}
+
+	o.kernelChecksums.Set(version, k.Checksum, ttlcache.DefaultTTL)
+
+	return k.Checksum
+}
@@ -6,34 +6,75 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 )
 
-func (o *Orchestrator) getSandboxes(ctx context.Context, node *node.NodeInfo) ([]*instance.InstanceInfo, error) {
+// listSandboxesPageSize limits the size of a single list response, the nodes can run thousands of sandboxes.
+const listSandboxesPageSize = 500
+
+// getSandboxes returns the sandboxes running on the node. If they didn't change since the last call, the node
+// returns only its revision and the sandboxes from the last call are returned.
+func (o *Orchestrator) getSandboxes(ctx context.Context, n *Node) ([]*instance.InstanceInfo, error) {
 	childCtx, childSpan := o.tracer.Start(ctx, "get-sandboxes-from-orchestrator")
 	defer childSpan.End()
 
-	client, err := o.GetClient(node.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get GRPC client: %w", err)
-	}
+	n.listMu.Lock()
+	defer n.listMu.Unlock()
+
+	var sandboxes []*orchestrator.RunningSandbox
+	var revision uint64
+
+	pageToken := ""
+	for {
+		res, err := n.Client.Sandbox.List(childCtx, &orchestrator.SandboxListRequest{
+			SinceRevision: n.listRevision,
+			PageSize:      listSandboxesPageSize,
+			PageToken:     pageToken,
+		})
+
+		err = utils.UnwrapGRPCError(err)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sandboxes: %w", err)
+		}
+
+		if res.GetUnchanged() {
+			return n.listed, nil
+		}
 
-	res, err := client.Sandbox.List(childCtx, &empty.Empty{})
+		// The sandboxes changed between the pages, the next call lists them all again
+		if revision != 0 && res.GetRevision() != revision {
+			revision = 0
+		} else if pageToken == "" {
+			revision = res.GetRevision()
+		}
+
+		sandboxes = append(sandboxes, res.GetSandboxes()...)
+
+		pageToken = res.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
 
-	err = utils.UnwrapGRPCError(err)
+	sandboxesInfo, err := sandboxesFromProto(sandboxes, n.Info)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list sandboxes: %w", err)
+		return nil, err
 	}
 
-	sandboxes := res.GetSandboxes()
+	n.listRevision = revision
+	n.listed = sandboxesInfo
 
+	return sandboxesInfo, nil
+}
+
+func sandboxesFromProto(sandboxes []*orchestrator.RunningSandbox, node *node.NodeInfo) ([]*instance.InstanceInfo, error) {
 	sandboxesInfo := make([]*instance.InstanceInfo, 0, len(sandboxes))
 
 	for _, sbx := range sandboxes {
@@ -50,7 +91,7 @@ func (o *Orchestrator) getSandboxes(ctx context.Context, node *node.NodeInfo) ([
 
 		buildID, parseErr := uuid.Parse(config.BuildId)
 		if parseErr != nil {
-			return nil, fmt.Errorf("failed to parse build ID '%s' for job: %w", config.BuildId, err)
+			return nil, fmt.Errorf("failed to parse build ID '%s' for job: %w", config.BuildId, parseErr)
 		}
 
 		sandboxesInfo = append(sandboxesInfo, &instance.InstanceInfo{
@@ -68,10 +109,17 @@ func (o *Orchestrator) getSandboxes(ctx context.Context, node *node.NodeInfo) ([
 			BuildID:            &buildID,
 			TeamID:             &teamID,
 			Metadata:           config.Metadata,
+			Labels:             config.Labels,
 			KernelVersion:      config.KernelVersion,
 			FirecrackerVersion: config.FirecrackerVersion,
 			EnvdVersion:        config.EnvdVersion,
 			TotalDiskSizeMB:    config.TotalDiskSizeMb,
+			ReadinessProbe:     readinessProbeFromProto(config.ReadinessProbe),
+			Hooks:              lifecycleHooksFromProto(config),
+			Hardening:          hardeningPolicyFromProto(config.Hardening),
+			Egress:             egressPolicyFromProto(config.Egress),
+			SecretRefs:         config.SecretRefs,
+			Priority:           priorityFromProto(config.Priority),
 			MaxInstanceLength:  time.Duration(config.MaxSandboxLength) * time.Hour,
 			Node:               node,
 		})
@@ -82,10 +130,15 @@ func (o *Orchestrator) getSandboxes(ctx context.Context, node *node.NodeInfo) ([
 
 // GetSandboxes returns all instances for a given node.
 func (o *Orchestrator) GetSandboxes(ctx context.Context, teamID *uuid.UUID) []instance.InstanceInfo {
-	_, childSpan := o.tracer.Start(ctx, "get-sandboxes")
+	ctx, childSpan := o.tracer.Start(ctx, "get-sandboxes")
 	defer childSpan.End()
 
-	return o.instanceCache.GetInstances(teamID)
+	instances := o.instanceCache.GetInstances(teamID)
+	if !o.synced.Load() {
+		instances = append(instances, o.getPersistedSandboxes(ctx, teamID)...)
+	}
+
+	return instances
 }
 
 func (o *Orchestrator) GetInstance(ctx context.Context, id string) (instance.InstanceInfo, error) {
@@ -0,0 +1,35 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// NetworkActivity returns the summary of the outbound connections of the sandbox by the destination.
+func (o *Orchestrator) NetworkActivity(ctx context.Context, sbx *instance.InstanceInfo) ([]*orchestrator.NetworkDestination, error) {
+	childCtx, childSpan := o.tracer.Start(ctx, "get-network-activity")
+	defer childSpan.End()
+
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+	}
+
+	res, err := client.Sandbox.NetworkActivity(childCtx, &orchestrator.SandboxNetworkActivityRequest{
+		SandboxId: sbx.Instance.SandboxID,
+	})
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network activity for sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "Got network activity")
+
+	return res.Destinations, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -12,12 +13,17 @@ import (
 	"github.com/jellydator/ttlcache/v3"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 )
 
+// nodeSaturationCooldown is how long the node isn't used for the new sandboxes after it reported it has no capacity.
+const nodeSaturationCooldown = 10 * time.Second
+
 type sbxInProgress struct {
 	MiBMemory int64
 	CPUs      int64
@@ -33,9 +39,22 @@ type Node struct {
 	status   api.NodeStatus
 	statusMu sync.RWMutex
 
+	// lastHealth is the result of the last health check of the node, nil if the node wasn't checked yet.
+	lastHealth atomic.Pointer[NodeHealth]
+
+	// saturatedUntil is the unix time in nanoseconds until which the node isn't used for the new sandboxes.
+	saturatedUntil atomic.Int64
+
 	sbxsInProgress *smap.Map[*sbxInProgress]
 
 	buildCache *ttlcache.Cache[string, interface{}]
+	// warming are the builds the node is fetching for the delayed sandboxes.
+	warming *smap.Map[struct{}]
+
+	// listRevision is the revision of the sandboxes on the node when they were listed, zero if they weren't listed yet.
+	listRevision uint64
+	listed       []*instance.InstanceInfo
+	listMu       sync.Mutex
 }
 
 func (n *Node) Status() api.NodeStatus {
@@ -52,6 +71,66 @@ func (n *Node) SetStatus(status api.NodeStatus) {
 	n.status = status
 }
 
+// markSaturated excludes the node from the placement for the cooldown, e.g. when it has no free nbd devices.
+func (n *Node) markSaturated() {
+	n.saturatedUntil.Store(time.Now().Add(nodeSaturationCooldown).UnixNano())
+}
+
+// clearSaturated includes the node in the placement again, e.g. when a sandbox was preempted on it.
+func (n *Node) clearSaturated() {
+	n.saturatedUntil.Store(0)
+}
+
+func (n *Node) isSaturated() bool {
+	return time.Now().UnixNano() < n.saturatedUntil.Load()
+}
+
+// isFull returns true if the memory of the sandboxes on the node reached the capacity the node registered with.
+func (n *Node) isFull() bool {
+	return n.Info.MemoryMB > 0 && n.RamUsage.Load() >= n.Info.MemoryMB
+}
+
+// listNodes returns the active nodes, in Kubernetes the nodes register with the API, they are discovered via Nomad
+// or the Consul catalog otherwise.
+func (o *Orchestrator) listNodes(ctx context.Context) ([]*node.NodeInfo, error) {
+	if env.IsKubernetes() {
+		return o.listKubernetesNodes(ctx)
+	}
+
+	if o.consul != nil {
+		return o.consul.List(), nil
+	}
+
+	return o.listNomadNodes(ctx)
+}
+
+// listKubernetesNodes returns the registered nodes and the nodes of the orchestrator endpoints if the endpoints are discovered.
+// The registration has the capacity and the labels of the node, so it's preferred for the node that's in both.
+func (o *Orchestrator) listKubernetesNodes(ctx context.Context) ([]*node.NodeInfo, error) {
+	registered, err := o.registry.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.endpoints == nil {
+		return registered, nil
+	}
+
+	discovered, err := o.endpoints.List()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := registered
+	for _, info := range discovered {
+		if !slices.ContainsFunc(registered, func(r *node.NodeInfo) bool { return r.ID == info.ID }) {
+			nodes = append(nodes, info)
+		}
+	}
+
+	return nodes, nil
+}
+
 func (o *Orchestrator) listNomadNodes(ctx context.Context) ([]*node.NodeInfo, error) {
 	_, listSpan := o.tracer.Start(ctx, "list-nomad-nodes")
 	defer listSpan.End()
@@ -68,6 +147,8 @@ func (o *Orchestrator) listNomadNodes(ctx context.Context) ([]*node.NodeInfo, er
 			ID:                  n.ID[:consts.NodeIDLength],
 			OrchestratorAddress: fmt.Sprintf("%s:%s", n.Address, consts.OrchestratorPort),
 			IPAddress:           n.Address,
+			ClusterID:           node.LocalClusterID,
+			Region:              n.Datacenter,
 		})
 	}
 
@@ -82,7 +163,10 @@ func (o *Orchestrator) GetNode(nodeID string) *Node {
 func (o *Orchestrator) GetNodes() []*api.Node {
 	nodes := make(map[string]*api.Node)
 	for key, n := range o.nodes.Items() {
-		nodes[key] = &api.Node{NodeID: key, Status: n.Status()}
+		nodes[key] = &api.Node{NodeID: key, Status: n.Status(), ClusterID: &n.Info.ClusterID}
+		if n.Info.Region != "" {
+			nodes[key].Region = &n.Info.Region
+		}
 	}
 
 	for _, sbx := range o.instanceCache.Items() {
@@ -144,6 +228,13 @@ func (o *Orchestrator) GetNodeDetail(nodeId string) *api.NodeDetail {
 
 func (n *Node) SyncBuilds(builds []*orchestrator.CachedBuildInfo) {
 	for _, build := range builds {
+		if build.Pinned {
+			// The pinned builds are never evicted from the node
+			n.buildCache.Set(build.BuildId, struct{}{}, ttlcache.NoTTL)
+
+			continue
+		}
+
 		n.buildCache.Set(build.BuildId, struct{}{}, build.ExpirationTime.AsTime().Sub(time.Now()))
 	}
 }
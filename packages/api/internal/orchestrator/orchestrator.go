@@ -3,27 +3,52 @@ package orchestrator
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
 
 	"github.com/go-redis/redis/v8"
 	nomadapi "github.com/hashicorp/nomad/api"
+	"github.com/jellydator/ttlcache/v3"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	analyticscollector "github.com/e2b-dev/infra/packages/api/internal/analytics_collector"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/dns"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 )
 
 type Orchestrator struct {
-	nomadClient   *nomadapi.Client
+	nomadClient *nomadapi.Client
+	// registry keeps the nodes that register with the API, it's used instead of Nomad in Kubernetes.
+	registry *node.Registry
+	// endpoints discovers the nodes from the EndpointSlices of the orchestrator service in Kubernetes, nil if not configured.
+	endpoints *node.EndpointsDiscovery
+	// consul discovers the nodes from the Consul catalog instead of Nomad, nil if not configured.
+	consul        *node.ConsulDiscovery
 	instanceCache *instance.InstanceCache
 	nodes         *smap.Map[*Node]
 	tracer        trace.Tracer
 	logger        *zap.SugaredLogger
 	analytics     *analyticscollector.Analytics
+	posthog       *analyticscollector.PosthogClient
 	dns           *dns.DNS
+	db            *db.DB
+	// teamCounter counts the concurrent sandboxes of the teams across the API replicas.
+	teamCounter *sandbox.TeamCounter
+	// kernelChecksums caches the checksums of the registered kernels, empty string if the kernel is not registered.
+	kernelChecksums *ttlcache.Cache[string, string]
+	// synced is set after the first sync with the nodes, before that the sandbox list is completed from the database.
+	synced atomic.Bool
+
+	preemptedCounter metric.Int64Counter
 }
 
 func New(
@@ -33,6 +58,7 @@ func New(
 	logger *zap.SugaredLogger,
 	posthogClient *analyticscollector.PosthogClient,
 	redisClient *redis.Client,
+	dbClient *db.DB,
 ) (*Orchestrator, error) {
 	analyticsInstance, err := analyticscollector.NewAnalytics()
 	if err != nil {
@@ -41,6 +67,11 @@ func New(
 
 	dnsServer := dns.New(redisClient)
 
+	preemptedCounter, err := meters.GetCounter(meters.SandboxPreemptedMeterName)
+	if err != nil {
+		logger.Errorw("error getting counter", "error", err)
+	}
+
 	if env.IsLocal() {
 		logger.Info("Running locally, skipping starting DNS server")
 	} else {
@@ -55,11 +86,37 @@ func New(
 
 	o := Orchestrator{
 		analytics:   analyticsInstance,
+		posthog:     posthogClient,
 		nomadClient: nomadClient,
+		registry:    node.NewRegistry(redisClient),
 		logger:      logger,
 		tracer:      tracer,
 		nodes:       smap.New[*Node](),
 		dns:         dnsServer,
+		db:          dbClient,
+		teamCounter: sandbox.NewTeamCounter(redisClient),
+		kernelChecksums: ttlcache.New(
+			ttlcache.WithTTL[string, string](kernelChecksumExpiration),
+		),
+		preemptedCounter: preemptedCounter,
+	}
+
+	if selector := os.Getenv("ORCHESTRATOR_ENDPOINTS_SELECTOR"); env.IsKubernetes() && selector != "" {
+		o.endpoints, err = node.NewEndpointsDiscovery(ctx, env.GetEnv("POD_NAMESPACE", "default"), selector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start endpoints discovery: %w", err)
+		}
+
+		logger.Infof("Discovering the nodes from the endpoint slices matching '%s'", selector)
+	}
+
+	if env.GetEnv("ORCHESTRATOR_DISCOVERY", "nomad") == "consul" && !env.IsKubernetes() {
+		o.consul, err = node.NewConsulDiscovery(ctx, logger, os.Getenv("CONSUL_TOKEN"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to start Consul discovery: %w", err)
+		}
+
+		logger.Info("Discovering the nodes from the Consul catalog")
 	}
 
 	cache := instance.NewCache(
@@ -73,6 +130,7 @@ func New(
 
 	if env.IsLocal() {
 		logger.Info("Skipping syncing sandboxes, running locally")
+		o.synced.Store(true)
 	} else {
 		go o.keepInSync(cache)
 	}
@@ -80,6 +138,11 @@ func New(
 	return &o, nil
 }
 
+// Registry returns the registry the nodes register with in Kubernetes.
+func (o *Orchestrator) Registry() *node.Registry {
+	return o.registry
+}
+
 func (o *Orchestrator) Close() error {
 	var err error
 	for _, node := range o.nodes.Items() {
@@ -2,13 +2,18 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/gogo/status"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -50,3 +55,101 @@ func (o *Orchestrator) PauseInstance(ctx context.Context, sbx *instance.Instance
 
 	return fmt.Errorf("failed to pause sandbox '%s': %w", sbx.Instance.SandboxID, err)
 }
+
+// SnapshotInfo is the configuration of the sandbox kept in its snapshot, so the sandbox is resumed with the same configuration.
+func SnapshotInfo(sbx *instance.InstanceInfo) *db.SnapshotInfo {
+	return &db.SnapshotInfo{
+		BaseTemplateID:     sbx.Instance.TemplateID,
+		SandboxID:          sbx.Instance.SandboxID,
+		VCPU:               sbx.VCpu,
+		RAMMB:              sbx.RamMB,
+		TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
+		Metadata:           sbx.Metadata,
+		KernelVersion:      sbx.KernelVersion,
+		FirecrackerVersion: sbx.FirecrackerVersion,
+		EnvdVersion:        sbx.Instance.EnvdVersion,
+		ReadinessProbe:     sbx.ReadinessProbe,
+		Hooks:              sbx.Hooks,
+		Hardening:          sbx.Hardening,
+		Egress:             sbx.Egress,
+		SecretRefs:         sbx.SecretRefs,
+	}
+}
+
+// pauseRequested pauses the sandbox evicted by the node because the node is over capacity,
+// the sandbox can be resumed by its team the same as if it was paused by them.
+func (o *Orchestrator) pauseRequested(ctx context.Context, sandboxID string, nodeID string, eviction *orchestrator.SandboxEviction) {
+	ctx, childSpan := o.tracer.Start(ctx, "pause-requested-instance")
+	defer childSpan.End()
+
+	sbx, err := o.GetSandbox(sandboxID)
+	if err != nil || sbx.Instance.ClientID != nodeID {
+		return
+	}
+
+	err = o.pauseEvicted(ctx, sbx)
+	if err != nil {
+		o.logger.Errorf("Error pausing sandbox %s evicted from node %s: %v", sandboxID, nodeID, err)
+
+		return
+	}
+
+	o.logger.Infof("Sandbox %s evicted from node %s by the %s policy (%s) was paused", sandboxID, nodeID, eviction.GetPolicy(), eviction.GetReason())
+}
+
+// pauseEvicted pauses the sandbox removed from its node without the request of its team, so the team can resume it later.
+func (o *Orchestrator) pauseEvicted(ctx context.Context, sbx *instance.InstanceInfo) error {
+	envBuild, err := o.db.NewSnapshotBuild(ctx, SnapshotInfo(sbx), *sbx.TeamID)
+	if err != nil {
+		return fmt.Errorf("error creating snapshot: %w", err)
+	}
+
+	err = o.PauseInstance(ctx, sbx, *envBuild.EnvID, envBuild.ID.String())
+	if err != nil {
+		// The sandbox keeps running when it wasn't paused, e.g. when its on-pause hook failed or the pause queue is exhausted
+		if code, ok := errcode.Of(err); (ok && code == errcode.HookFailed) || errors.Is(err, ErrPauseQueueExhausted{}) {
+			statusErr := o.db.EnvBuildSetStatus(ctx, *envBuild.EnvID, envBuild.ID, envbuild.StatusFailed)
+			if statusErr != nil {
+				telemetry.ReportError(ctx, fmt.Errorf("error when setting the snapshot build status: %w", statusErr))
+			}
+
+			return err
+		}
+	}
+
+	defer o.DeleteInstance(ctx, sbx.Instance.SandboxID)
+
+	if err != nil {
+		return err
+	}
+
+	err = o.db.SnapshotBuildSetPaused(ctx, *envBuild.EnvID, envBuild.ID, sbx.Instance.ClientID)
+	if err != nil {
+		return fmt.Errorf("error setting snapshot as paused: %w", err)
+	}
+
+	return nil
+}
+
+// PauseTeamSandboxes pauses the running sandboxes of the team without the request of the team, e.g. when the team is over its budget.
+// The sandboxes can be resumed by the team the same as if they were paused by them. It returns the number of the paused sandboxes.
+func (o *Orchestrator) PauseTeamSandboxes(ctx context.Context, teamID uuid.UUID, reason string) int {
+	ctx, childSpan := o.tracer.Start(ctx, "pause-team-instances")
+	defer childSpan.End()
+
+	var paused int
+	for _, sbx := range o.instanceCache.GetInstances(&teamID) {
+		err := o.pauseEvicted(ctx, &sbx)
+		if err != nil {
+			o.logger.Errorf("Error pausing sandbox %s of team %s: %v", sbx.Instance.SandboxID, teamID, err)
+
+			continue
+		}
+
+		sbx.Logger.Warnf("Sandbox was paused because %s, it can be resumed", reason)
+
+		paused++
+	}
+
+	return paused
+}
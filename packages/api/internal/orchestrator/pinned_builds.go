@@ -0,0 +1,65 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// syncPinnedBuilds sends the builds pinned on the node to the node, so their files are kept in the node cache.
+func (o *Orchestrator) syncPinnedBuilds(ctx context.Context, node *Node) error {
+	childCtx, childSpan := o.tracer.Start(ctx, "sync-pinned-builds")
+	defer childSpan.End()
+
+	builds, err := o.db.GetPinnedEnvBuilds(childCtx, node.Info.ID)
+	if err != nil {
+		return err
+	}
+
+	pinned := make([]*orchestrator.PinnedBuild, 0, len(builds))
+
+	for _, build := range builds {
+		if build.EnvID == nil {
+			continue
+		}
+
+		features, err := sandbox.NewVersionInfo(build.FirecrackerVersion)
+		if err != nil {
+			o.logger.Warnf("failed to get features for firecracker version '%s' of pinned build '%s': %v", build.FirecrackerVersion, build.ID, err)
+
+			continue
+		}
+
+		pinned = append(pinned, &orchestrator.PinnedBuild{
+			TemplateId:         *build.EnvID,
+			BuildId:            build.ID.String(),
+			KernelVersion:      build.KernelVersion,
+			FirecrackerVersion: build.FirecrackerVersion,
+			HugePages:          features.HasHugePages(),
+		})
+	}
+
+	childSpan.SetAttributes(attribute.Int("builds", len(pinned)))
+
+	_, err = node.Client.Sandbox.SetPinnedBuilds(childCtx, &orchestrator.SandboxSetPinnedBuildsRequest{
+		Builds: pinned,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		// The node doesn't support pinning yet
+		return nil
+	}
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to set pinned builds: %w", err)
+	}
+
+	return nil
+}
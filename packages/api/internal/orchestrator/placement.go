@@ -0,0 +1,80 @@
+package orchestrator
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+// Placement restricts the nodes the sandbox is placed on by their region, the nil placement allows any node.
+type Placement struct {
+	// Allowed are the regions allowed by the template, any region is allowed if empty.
+	Allowed []string
+	// Preferred are the regions tried in the order of preference, any allowed region is used if empty.
+	Preferred []string
+	// Failover allows the other allowed regions when the preferred regions have no capacity.
+	Failover bool
+	// Replicated are the regions the build was replicated to. The regions with a bucket replica the build wasn't replicated to
+	// can't be used, the nodes there read the builds only from the replica, so they never read the storage cross-region.
+	Replicated []string
+}
+
+// NewPlacement returns the placement of the sandbox, the preferred regions must be allowed by the template.
+func NewPlacement(allowed, preferred []string, failover bool, replicated []string) (*Placement, error) {
+	if len(allowed) == 0 && len(preferred) == 0 && len(gcs.ReplicaRegions()) == 0 {
+		return nil, nil
+	}
+
+	if len(allowed) > 0 {
+		for _, region := range preferred {
+			if !slices.Contains(allowed, region) {
+				return nil, fmt.Errorf("region '%s' is not allowed by the template, allowed regions are %v", region, allowed)
+			}
+		}
+	}
+
+	return &Placement{
+		Allowed:    allowed,
+		Preferred:  preferred,
+		Failover:   failover,
+		Replicated: replicated,
+	}, nil
+}
+
+// rank returns the tier of the node, the nodes in the lower tier are used first. It returns false if the node can't be used.
+func (p *Placement) rank(info *node.NodeInfo) (int, bool) {
+	if p == nil {
+		return 0, true
+	}
+
+	if len(p.Allowed) > 0 && !slices.Contains(p.Allowed, info.Region) {
+		return 0, false
+	}
+
+	if _, ok := gcs.ReplicaBucket(info.Region); ok && !slices.Contains(p.Replicated, info.Region) {
+		return 0, false
+	}
+
+	if len(p.Preferred) == 0 {
+		return 0, true
+	}
+
+	if tier := slices.Index(p.Preferred, info.Region); tier >= 0 {
+		return tier, true
+	}
+
+	if !p.Failover {
+		return 0, false
+	}
+
+	return len(p.Preferred), true
+}
+
+// allows returns true if the sandbox can be placed on the node.
+func (p *Placement) allows(info *node.NodeInfo) bool {
+	_, ok := p.rank(info)
+
+	return ok
+}
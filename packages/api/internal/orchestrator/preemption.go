@@ -0,0 +1,84 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"github.com/posthog/posthog-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// preemptionWait is how long the high priority sandbox waits for a free node before it preempts a low priority sandbox.
+const preemptionWait = time.Second
+
+// preempt pauses a low priority sandbox to make room for the high priority sandbox, on the node if set or on any ready node allowed by the placement.
+// Only the sandboxes with at least the vCPUs and the RAM the high priority sandbox requests are preempted, a smaller one wouldn't free enough room for it.
+// The most recently started sandbox is preempted, so the least work is interrupted. It returns the node of the preempted sandbox,
+// nil if there was no low priority sandbox to preempt. The preempted sandbox can be resumed by its team the same as if it was paused by them.
+func (o *Orchestrator) preempt(ctx context.Context, node *Node, placement *Placement, preemptedBy string, vcpu, ramMB int64) *Node {
+	ctx, childSpan := o.tracer.Start(ctx, "preempt-instance")
+	defer childSpan.End()
+
+	var victim *instance.InstanceInfo
+
+	for _, sbx := range o.instanceCache.Items() {
+		if sbx.Priority != api.Low {
+			continue
+		}
+
+		if sbx.VCpu < vcpu || sbx.RamMB < ramMB {
+			continue
+		}
+
+		if node != nil && sbx.Instance.ClientID != node.Info.ID {
+			continue
+		}
+
+		sbxNode := o.GetNode(sbx.Instance.ClientID)
+		if sbxNode == nil || sbxNode.Status() != api.NodeStatusReady || !placement.allows(sbxNode.Info) {
+			continue
+		}
+
+		if victim == nil || sbx.StartTime.After(victim.StartTime) {
+			victim = &sbx
+		}
+	}
+
+	if victim == nil {
+		return nil
+	}
+
+	telemetry.ReportEvent(ctx, "preempting sandbox", attribute.String("preempted.instance.id", victim.Instance.SandboxID))
+
+	err := o.pauseEvicted(ctx, victim)
+	if err != nil {
+		o.logger.Errorf("Error pausing sandbox %s preempted by sandbox %s: %v", victim.Instance.SandboxID, preemptedBy, err)
+
+		return nil
+	}
+
+	victim.Logger.Warnf("Sandbox was paused to make room for a high priority sandbox, it can be resumed")
+	o.logger.Infof("Sandbox %s on node %s was preempted by sandbox %s", victim.Instance.SandboxID, victim.Instance.ClientID, preemptedBy)
+
+	o.preemptedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("node.id", victim.Instance.ClientID)))
+	o.posthog.CreateAnalyticsTeamEvent(
+		victim.TeamID.String(),
+		"preempted_instance", posthog.NewProperties().
+			Set("instance_id", victim.Instance.SandboxID).
+			Set("environment", victim.Instance.TemplateID).
+			Set("preempted_by", preemptedBy),
+	)
+
+	preemptedNode := o.GetNode(victim.Instance.ClientID)
+	if preemptedNode != nil {
+		// The node has the capacity of the preempted sandbox now
+		preemptedNode.clearSaturated()
+	}
+
+	return preemptedNode
+}
@@ -0,0 +1,33 @@
+package orchestrator
+
+import (
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// priorityToProto returns the priority class of the sandbox, it's normal if not set.
+func priorityToProto(priority *api.SandboxPriority) orchestrator.SandboxPriority {
+	if priority == nil {
+		return orchestrator.SandboxPriority_PRIORITY_NORMAL
+	}
+
+	switch *priority {
+	case api.Low:
+		return orchestrator.SandboxPriority_PRIORITY_LOW
+	case api.High:
+		return orchestrator.SandboxPriority_PRIORITY_HIGH
+	default:
+		return orchestrator.SandboxPriority_PRIORITY_NORMAL
+	}
+}
+
+func priorityFromProto(priority orchestrator.SandboxPriority) api.SandboxPriority {
+	switch priority {
+	case orchestrator.SandboxPriority_PRIORITY_LOW:
+		return api.Low
+	case orchestrator.SandboxPriority_PRIORITY_HIGH:
+		return api.High
+	default:
+		return api.Normal
+	}
+}
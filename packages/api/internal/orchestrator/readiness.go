@@ -0,0 +1,43 @@
+package orchestrator
+
+import (
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+func readinessProbeToProto(probe *schema.ReadinessProbe) *orchestrator.ReadinessProbe {
+	if probe == nil {
+		return nil
+	}
+
+	result := &orchestrator.ReadinessProbe{
+		Command:  probe.Command,
+		HttpPort: probe.HTTPPort,
+		HttpPath: probe.HTTPPath,
+	}
+
+	if probe.TimeoutSeconds != nil {
+		result.TimeoutSeconds = *probe.TimeoutSeconds
+	}
+
+	return result
+}
+
+func readinessProbeFromProto(probe *orchestrator.ReadinessProbe) *schema.ReadinessProbe {
+	if probe == nil {
+		return nil
+	}
+
+	result := &schema.ReadinessProbe{
+		Command:  probe.Command,
+		HTTPPort: probe.HttpPort,
+		HTTPPath: probe.HttpPath,
+	}
+
+	if probe.TimeoutSeconds > 0 {
+		timeout := probe.TimeoutSeconds
+		result.TimeoutSeconds = &timeout
+	}
+
+	return result
+}
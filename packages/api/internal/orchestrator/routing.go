@@ -0,0 +1,46 @@
+package orchestrator
+
+import (
+	"context"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+)
+
+// SandboxRouting is where the traffic of the sandbox is routed to.
+type SandboxRouting struct {
+	// Sandbox is the sandbox in the cache, nil if the sandbox isn't running.
+	Sandbox *instance.InstanceInfo
+	// Node is the node the traffic is routed to, nil if the node isn't connected.
+	Node *Node
+	// Health is the last health check of the node, the node is checked now if it wasn't checked yet.
+	Health *NodeHealth
+}
+
+// GetSandboxRouting returns the sandbox and the node its traffic is routed to. The node of the sandbox that isn't running
+// is the node of the client ID, the proxies route the traffic by the client ID in the hostname.
+func (o *Orchestrator) GetSandboxRouting(ctx context.Context, sandboxID, clientID string) SandboxRouting {
+	var routing SandboxRouting
+
+	sbx, err := o.GetSandbox(sandboxID)
+	if err == nil {
+		routing.Sandbox = sbx
+		clientID = sbx.Instance.ClientID
+	}
+
+	if clientID == "" {
+		return routing
+	}
+
+	routing.Node = o.GetNode(clientID)
+	if routing.Node == nil {
+		return routing
+	}
+
+	routing.Health = routing.Node.lastHealth.Load()
+	if routing.Health == nil {
+		health := routing.Node.check(ctx)
+		routing.Health = &health
+	}
+
+	return routing
+}
@@ -0,0 +1,154 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+// sandboxCreatingTimeout is how long the sandbox can stay in the creating state before the record is considered stale.
+const sandboxCreatingTimeout = 5 * time.Minute
+
+func recordFromInstance(info instance.InstanceInfo) *db.SandboxRecord {
+	var clusterID string
+	if info.Node != nil {
+		clusterID = info.Node.ClusterID
+	}
+
+	return &db.SandboxRecord{
+		SandboxID: info.Instance.SandboxID,
+		TeamID:    *info.TeamID,
+		EnvID:     info.Instance.TemplateID,
+		BuildID:   *info.BuildID,
+		Alias:     info.Instance.Alias,
+		NodeID:    info.Instance.ClientID,
+		ClusterID: clusterID,
+		StartedAt: info.StartTime,
+		EndAt:     info.EndTime,
+		VCPU:      info.VCpu,
+		RAMMB:     info.RamMB,
+		Metadata:  info.Metadata,
+		Labels:    info.Labels,
+	}
+}
+
+func instanceFromRecord(record *models.Sandbox) instance.InstanceInfo {
+	teamID := record.TeamID
+	buildID := record.BuildID
+
+	return instance.InstanceInfo{
+		Logger: logs.NewSandboxLogger(record.ID, record.EnvID, teamID.String(), record.Vcpu, record.RAMMB, false),
+		Instance: &api.Sandbox{
+			SandboxID:  record.ID,
+			TemplateID: record.EnvID,
+			Alias:      record.Alias,
+			ClientID:   record.NodeID,
+		},
+		TeamID:    &teamID,
+		BuildID:   &buildID,
+		Metadata:  record.Metadata,
+		Labels:    record.Labels,
+		StartTime: record.StartedAt,
+		EndTime:   record.EndAt,
+		VCpu:      record.Vcpu,
+		RamMB:     record.RAMMB,
+	}
+}
+
+// getPersistedSandboxes returns the running sandboxes from the database that are not in the cache.
+// They are used only before the first sync with the nodes, so the sandbox list is complete after the API restart.
+func (o *Orchestrator) getPersistedSandboxes(ctx context.Context, teamID *uuid.UUID) []instance.InstanceInfo {
+	records, err := o.db.ReadOnly().GetSandboxes(ctx, teamID)
+	if err != nil {
+		o.logger.Errorf("Error getting persisted sandboxes: %v", err)
+
+		return nil
+	}
+
+	var instances []instance.InstanceInfo
+	for _, record := range records {
+		if record.State != sandbox.StateRunning || o.instanceCache.Exists(record.ID) {
+			continue
+		}
+
+		instances = append(instances, instanceFromRecord(record))
+	}
+
+	return instances
+}
+
+// reconcileSandboxes compares the persisted sandboxes with the sandboxes synced from the nodes.
+// Sandboxes on the nodes that are not active anymore are marked as lost, the expired and stale records are deleted.
+func (o *Orchestrator) reconcileSandboxes(ctx context.Context, nodes []*node.NodeInfo, syncedNodes *smap.Map[bool]) {
+	ctx, childSpan := o.tracer.Start(ctx, "reconcile-sandboxes")
+	defer childSpan.End()
+
+	records, err := o.db.GetSandboxes(ctx, nil)
+	if err != nil {
+		o.logger.Errorf("Error getting persisted sandboxes: %v", err)
+
+		return
+	}
+
+	activeNodes := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		activeNodes[n.ID] = true
+	}
+
+	now := time.Now()
+	for _, record := range records {
+		info, cacheErr := o.instanceCache.GetInstance(record.ID)
+		if cacheErr == nil {
+			if record.State != sandbox.StateRunning || record.NodeID != info.Instance.ClientID || (info.Node != nil && record.ClusterID != info.Node.ClusterID) || !record.EndAt.Equal(info.EndTime) {
+				err = o.db.UpsertSandbox(ctx, recordFromInstance(info), sandbox.StateRunning)
+				if err != nil {
+					o.logger.Errorf("Error updating persisted sandbox: %v", err)
+				}
+			}
+
+			continue
+		}
+
+		var reason string
+		switch {
+		case now.After(record.EndAt):
+			reason = "expired"
+		case record.State == sandbox.StateCreating:
+			if now.Sub(record.UpdatedAt) > sandboxCreatingTimeout {
+				reason = "stale"
+			}
+		case record.State == sandbox.StateRunning:
+			if synced, _ := syncedNodes.Get(record.NodeID); synced {
+				reason = "not running on the node"
+			} else if !activeNodes[record.NodeID] {
+				o.logger.Warnf("Sandbox %s is lost, node %s is not active anymore", record.ID, record.NodeID)
+
+				err = o.db.MarkSandboxLost(ctx, record.ID)
+				if err != nil {
+					o.logger.Errorf("Error marking persisted sandbox as lost: %v", err)
+				}
+			}
+		}
+
+		if reason == "" {
+			continue
+		}
+
+		o.logger.Infof("Deleting persisted sandbox %s (%s)", record.ID, reason)
+
+		err = o.db.DeleteSandbox(ctx, record.ID)
+		if err != nil {
+			o.logger.Errorf("Error deleting persisted sandbox: %v", err)
+		}
+	}
+}
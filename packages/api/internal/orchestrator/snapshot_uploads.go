@@ -0,0 +1,88 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+// syncSnapshotUploads records the finished uploads of the snapshots taken on the node.
+func (o *Orchestrator) syncSnapshotUploads(ctx context.Context, node *Node) error {
+	childCtx, childSpan := o.tracer.Start(ctx, "sync-snapshot-uploads")
+	defer childSpan.End()
+
+	builds, err := o.db.GetPendingSnapshotUploads(childCtx, node.Info.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(builds) == 0 {
+		return nil
+	}
+
+	childSpan.SetAttributes(attribute.Int("builds", len(builds)))
+
+	buildIDs := make([]string, 0, len(builds))
+	for _, build := range builds {
+		buildIDs = append(buildIDs, build.ID.String())
+	}
+
+	res, err := node.Client.Sandbox.SnapshotUploads(childCtx, &orchestrator.SandboxSnapshotUploadsRequest{
+		BuildIds: buildIDs,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		// The node doesn't track the uploads, the snapshots are considered uploaded as before
+		for _, build := range builds {
+			err = o.db.SnapshotBuildSetUploadStatus(childCtx, build.ID, envbuild.UploadStatusUploaded)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to get snapshot uploads: %w", err)
+	}
+
+	for _, build := range builds {
+		var uploadStatus envbuild.UploadStatus
+
+		switch res.GetStates()[build.ID.String()] {
+		case orchestrator.SnapshotUploadState_SNAPSHOT_UPLOADING:
+			continue
+		case orchestrator.SnapshotUploadState_SNAPSHOT_UPLOADED:
+			uploadStatus = envbuild.UploadStatusUploaded
+		default:
+			// The upload failed or the node lost the snapshot, it's resumable only if it's still cached on the node
+			o.logger.Warnf("Snapshot build '%s' wasn't uploaded from node '%s'", build.ID, node.Info.ID)
+
+			uploadStatus = envbuild.UploadStatusFailed
+		}
+
+		err = o.db.SnapshotBuildSetUploadStatus(childCtx, build.ID, uploadStatus)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := gcs.ReplicaBucket(node.Info.Region); ok && uploadStatus == envbuild.UploadStatusUploaded {
+			// The node replicates the uploaded snapshot to the bucket replica in its region itself
+			err = o.db.SetBuildReplicatedRegions(childCtx, build.ID, []string{node.Info.Region})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
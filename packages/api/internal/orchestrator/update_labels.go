@@ -0,0 +1,53 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// UpdateSandboxLabels replaces the labels of the sandbox in the cache, on the node and in the database.
+// The precondition is checked atomically with the update in the cache, see instance.InstanceCache.UpdateLabels.
+func (o *Orchestrator) UpdateSandboxLabels(ctx context.Context, sandboxID string, labels map[string]string, precondition func(instance.InstanceInfo) (bool, error)) (*instance.InstanceInfo, error) {
+	childCtx, childSpan := o.tracer.Start(ctx, "update-sandbox-labels")
+	defer childSpan.End()
+
+	telemetry.SetAttributes(childCtx, attribute.String("instance.id", sandboxID))
+
+	info, err := o.instanceCache.UpdateLabels(sandboxID, labels, precondition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update labels of sandbox '%s': %w", sandboxID, err)
+	}
+
+	client, err := o.GetClient(info.Instance.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client '%s': %w", info.Instance.ClientID, err)
+	}
+
+	// The labels are kept on the node, so they are restored when the API restarts
+	_, err = client.Sandbox.Update(childCtx, &orchestrator.SandboxUpdateRequest{
+		SandboxId: sandboxID,
+		Labels:    &orchestrator.SandboxLabels{Labels: labels},
+	})
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update labels of sandbox '%s' on the node: %w", sandboxID, err)
+	}
+
+	err = o.db.UpsertSandbox(childCtx, recordFromInstance(*info), sandbox.StateRunning)
+	if err != nil {
+		telemetry.ReportError(childCtx, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "Updated sandbox labels")
+
+	return info, nil
+}
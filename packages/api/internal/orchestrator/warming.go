@@ -0,0 +1,70 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// WarmingRetryAfter is how long the client should wait before retrying the start of the sandbox whose build is warming.
+const WarmingRetryAfter = 5 * time.Second
+
+// buildWarmingTimeout limits the prefetch of the build on the node.
+const buildWarmingTimeout = 5 * time.Minute
+
+// ErrBuildWarming is returned when the start of the sandbox was delayed, because its build isn't cached on the node.
+var ErrBuildWarming = errors.New("the template of the sandbox is being fetched to the node, retry the request later")
+
+// hasBuild returns true if the build is cached on the node or the node is fetching it.
+func (n *Node) hasBuild(buildID string) bool {
+	if n.buildCache.Has(buildID) {
+		return true
+	}
+
+	_, warming := n.warming.Get(buildID)
+
+	return warming
+}
+
+// warmBuild returns true if the build is cached on the node. Otherwise the node starts fetching the build in the background,
+// the sandboxes of the build are started without waiting for the storage once it's fetched.
+func (n *Node) warmBuild(config *orchestrator.SandboxConfig) bool {
+	if n.buildCache.Has(config.BuildId) {
+		return true
+	}
+
+	if !n.warming.InsertIfAbsent(config.BuildId, struct{}{}) {
+		// The build is already being fetched
+		return false
+	}
+
+	go func() {
+		defer n.warming.Remove(config.BuildId)
+
+		ctx, cancel := context.WithTimeout(context.Background(), buildWarmingTimeout)
+		defer cancel()
+
+		_, err := n.Client.Sandbox.Prefetch(ctx, &orchestrator.SandboxPrefetchRequest{
+			TemplateId:         config.TemplateId,
+			BuildId:            config.BuildId,
+			KernelVersion:      config.KernelVersion,
+			FirecrackerVersion: config.FirecrackerVersion,
+			HugePages:          config.HugePages,
+			Snapshot:           config.Snapshot,
+		})
+
+		err = utils.UnwrapGRPCError(err)
+		if err != nil {
+			log.Printf("failed to prefetch build '%s' on node '%s': %v", config.BuildId, n.Info.ID, err)
+		}
+
+		// The retried sandbox isn't delayed again even if the prefetch failed, the create reports the error of the build then
+		n.InsertBuild(config.BuildId)
+	}()
+
+	return false
+}
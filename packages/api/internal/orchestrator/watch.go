@@ -0,0 +1,73 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+const watchRetryInterval = 5 * time.Second
+
+// watchNode applies the sandbox changes pushed by the node, so the sandboxes that exited are removed without waiting
+// for the periodic sync. The periodic sync is still used to recover from the missed events.
+func (o *Orchestrator) watchNode(n *Node) {
+	// The node is removed when it's not active anymore
+	for o.GetNode(n.Info.ID) == n {
+		err := o.watchNodeEvents(context.Background(), n)
+		if status.Code(err) == codes.Unimplemented {
+			o.logger.Infof("Node %s doesn't support watching sandboxes, relying on the periodic sync", n.Info.ID)
+
+			return
+		}
+
+		if o.GetNode(n.Info.ID) != n {
+			return
+		}
+
+		o.logger.Warnf("Error watching sandboxes on node %s: %v", n.Info.ID, err)
+
+		time.Sleep(watchRetryInterval)
+	}
+}
+
+func (o *Orchestrator) watchNodeEvents(ctx context.Context, n *Node) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := n.Client.Sandbox.Watch(ctx, &orchestrator.SandboxWatchRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch event.GetType() {
+		case orchestrator.SandboxEventType_CREATED:
+			sandboxes, err := sandboxesFromProto([]*orchestrator.RunningSandbox{event.GetSandbox()}, n.Info)
+			if err != nil {
+				o.logger.Errorf("Error parsing sandbox created on node %s: %v", n.Info.ID, err)
+
+				continue
+			}
+
+			err = o.instanceCache.SyncAdded(sandboxes[0])
+			if err != nil {
+				o.logger.Errorf("Error adding sandbox created on node %s: %v", n.Info.ID, err)
+			}
+		case orchestrator.SandboxEventType_EXITED:
+			o.instanceCache.SyncRemoved(event.GetSandbox().GetConfig().GetSandboxId(), n.Info.ID)
+		case orchestrator.SandboxEventType_PAUSE_REQUESTED:
+			go o.pauseRequested(context.Background(), event.GetSandbox().GetConfig().GetSandboxId(), n.Info.ID, event.GetEviction())
+		default:
+			// The other changes are made by the API, the cache is already updated
+		}
+	}
+}
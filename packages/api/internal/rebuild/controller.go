@@ -0,0 +1,271 @@
+// Package rebuild rebuilds the templates with the rebuild schedule from the images of their last builds, so the templates pick up
+// the security updates without the rebuilds requested by the user. The result of every rebuild is posted to the webhook of the schedule.
+package rebuild
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+)
+
+const (
+	scheduleInterval = time.Minute
+	// scheduleBatchSize is the maximum number of rebuilds started in one run.
+	scheduleBatchSize = 10
+
+	webhookTimeout = 10 * time.Second
+
+	// SignatureHeader is the header with the HMAC-SHA256 of the notification body signed by the webhook secret of the schedule.
+	SignatureHeader = "X-E2B-Signature"
+
+	webhookSecretSize = 32
+
+	// rebuildFailedMessage is sent instead of the build error, the error can contain the details of the infrastructure.
+	rebuildFailedMessage = "The rebuild failed, the details are in the logs of the build"
+)
+
+var ErrWebhookAddressNotAllowed = errors.New("webhook address is not allowed")
+
+// sharedAddressSpace is the carrier-grade NAT range, it isn't reachable from the internet like the private ranges.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// BuildFunc builds the template build created for the rebuild, it returns the error if the build failed.
+type BuildFunc func(ctx context.Context, build *models.EnvBuild, upgradePackages bool) error
+
+// Notification is the result of the rebuild posted to the webhook of the schedule.
+type Notification struct {
+	TemplateID string                             `json:"templateID"`
+	BuildID    uuid.UUID                          `json:"buildID"`
+	Status     envrebuildschedule.LastBuildStatus `json:"status"`
+	Error      string                             `json:"error,omitempty"`
+	FinishedAt time.Time                          `json:"finishedAt"`
+}
+
+// Controller starts the due rebuilds of the templates, the schedules are claimed in the database,
+// so every rebuild is started by one API instance only.
+type Controller struct {
+	db     *db.DB
+	logger *zap.SugaredLogger
+	build  BuildFunc
+
+	httpClient *http.Client
+}
+
+func NewController(dbClient *db.DB, logger *zap.SugaredLogger, build BuildFunc) *Controller {
+	// The addresses are checked when dialing, so the hosts resolving to the internal addresses and the redirects to them are refused too
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: refuseInternalAddress,
+	}
+
+	return &Controller{
+		db:     dbClient,
+		logger: logger,
+		build:  build,
+		httpClient: &http.Client{
+			Timeout: webhookTimeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: webhookTimeout,
+			},
+		},
+	}
+}
+
+// ValidateWebhookURL checks the webhook URL when the schedule is set, the addresses the host resolves to are checked when the notification is sent.
+func ValidateWebhookURL(rawURL string) error {
+	webhookURL, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	if webhookURL.Scheme != "https" && webhookURL.Scheme != "http" {
+		return fmt.Errorf("unsupported scheme '%s'", webhookURL.Scheme)
+	}
+
+	if webhookURL.Hostname() == "" {
+		return errors.New("missing host")
+	}
+
+	if webhookURL.Hostname() == "localhost" {
+		return ErrWebhookAddressNotAllowed
+	}
+
+	if ip, err := netip.ParseAddr(webhookURL.Hostname()); err == nil && !isPublicAddress(ip) {
+		return ErrWebhookAddressNotAllowed
+	}
+
+	return nil
+}
+
+// NewWebhookSecret returns the random secret the notifications of the schedule are signed with.
+func NewWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretSize)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+// refuseInternalAddress refuses the connections to the addresses that aren't reachable from the internet,
+// so the webhooks can't be used to reach the services of the cluster or the metadata server.
+func refuseInternalAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid webhook address '%s': %w", address, err)
+	}
+
+	if !isPublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrWebhookAddressNotAllowed, addrPort.Addr())
+	}
+
+	return nil
+}
+
+func isPublicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// Start starts the due rebuilds periodically until the context is canceled.
+func (c *Controller) Start(ctx context.Context) {
+	ticker := time.NewTicker(scheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		c.schedule(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Controller) schedule(ctx context.Context) {
+	schedules, err := c.db.ClaimDueEnvRebuildSchedules(ctx, scheduleBatchSize)
+	if err != nil {
+		c.logger.Errorf("Error claiming due template rebuilds: %v", err)
+
+		return
+	}
+
+	for _, schedule := range schedules {
+		go c.rebuild(ctx, schedule)
+	}
+}
+
+func (c *Controller) rebuild(ctx context.Context, schedule *models.EnvRebuildSchedule) {
+	build, err := c.db.CreateEnvRebuild(ctx, schedule.EnvID)
+	if errors.Is(err, db.ErrEnvBuildInProgress) {
+		c.logger.Infof("Skipping rebuild of template '%s', the template is being built", schedule.EnvID)
+
+		return
+	}
+
+	if err != nil {
+		c.logger.Errorf("Error creating rebuild of template '%s': %v", schedule.EnvID, err)
+
+		return
+	}
+
+	c.logger.Infof("Rebuilding template '%s' with build id %s", schedule.EnvID, build.ID)
+
+	// The rebuild isn't canceled with the controller, like the builds requested by the user aren't
+	ctx = context.WithoutCancel(ctx)
+
+	status := envrebuildschedule.LastBuildStatusSuccess
+
+	buildErr := c.build(ctx, build, schedule.UpgradePackages)
+	if buildErr != nil {
+		c.logger.Errorf("Error rebuilding template '%s' with build id %s: %v", schedule.EnvID, build.ID, buildErr)
+
+		status = envrebuildschedule.LastBuildStatusFailed
+	}
+
+	err = c.db.SetEnvRebuildStatus(ctx, schedule.EnvID, build.ID, status)
+	if err != nil {
+		c.logger.Errorf("Error recording rebuild of template '%s': %v", schedule.EnvID, err)
+	}
+
+	if schedule.WebhookURL == nil {
+		return
+	}
+
+	notification := Notification{
+		TemplateID: schedule.EnvID,
+		BuildID:    build.ID,
+		Status:     status,
+		FinishedAt: time.Now(),
+	}
+
+	if buildErr != nil {
+		notification.Error = rebuildFailedMessage
+	}
+
+	if schedule.WebhookSecret == nil {
+		c.logger.Errorf("Error notifying about rebuild of template '%s': the schedule has no webhook secret", schedule.EnvID)
+
+		return
+	}
+
+	err = c.notify(ctx, *schedule.WebhookURL, *schedule.WebhookSecret, notification)
+	if err != nil {
+		c.logger.Errorf("Error notifying about rebuild of template '%s': %v", schedule.EnvID, err)
+	}
+}
+
+// notify posts the notification signed by the webhook secret to the webhook.
+func (c *Controller) notify(ctx context.Context, webhookURL, secret string, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
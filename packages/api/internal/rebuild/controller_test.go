@@ -0,0 +1,56 @@
+package rebuild
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://example.com/hook", valid: true},
+		{url: "http://203.0.113.10:8080/hook", valid: true},
+		{url: "ftp://example.com/hook", valid: false},
+		{url: "https:///hook", valid: false},
+		{url: "http://localhost/hook", valid: false},
+		{url: "http://127.0.0.1/hook", valid: false},
+		{url: "http://10.0.0.1/hook", valid: false},
+		{url: "http://169.254.169.254/computeMetadata/v1/", valid: false},
+		{url: "http://[::1]/hook", valid: false},
+		{url: "http://[::ffff:192.168.0.1]/hook", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := ValidateWebhookURL(tt.url)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid webhook URL, got %v", err)
+			}
+
+			if !tt.valid && err == nil {
+				t.Fatal("expected invalid webhook URL")
+			}
+		})
+	}
+}
+
+func TestRefuseInternalAddress(t *testing.T) {
+	err := refuseInternalAddress("tcp4", "203.0.113.10:443", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, address := range []string{"127.0.0.1:80", "10.1.2.3:443", "172.16.0.1:443", "192.168.1.1:80", "169.254.169.254:80", "100.64.0.1:80", "[fd00::1]:443", "0.0.0.0:80"} {
+		err = refuseInternalAddress("tcp", address, nil)
+		if !errors.Is(err, ErrWebhookAddressNotAllowed) {
+			t.Fatalf("expected %s to be refused, got %v", address, err)
+		}
+	}
+
+	if isPublicAddress(netip.MustParseAddr("::ffff:10.0.0.1")) {
+		t.Fatal("expected the mapped private address to be internal")
+	}
+}
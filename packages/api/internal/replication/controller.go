@@ -0,0 +1,194 @@
+// Package replication replicates the finished builds from the template bucket to the bucket replicas in the other regions,
+// so the nodes in the regions read the templates and snapshots from their region only.
+package replication
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+const (
+	replicationInterval = time.Minute
+	// replicationBatchSize is the maximum number of builds of a team replicated in one run.
+	replicationBatchSize = 20
+
+	lockKeyPrefix = "replication:build:"
+	// lockTimeout is how long the build is locked by the API instance replicating it, it must be longer than the replication.
+	lockTimeout  = 30 * time.Minute
+	redisTimeout = 2 * time.Second
+)
+
+// Controller replicates the builds of the teams with the replication regions to the bucket replicas in the regions.
+// The replicated regions are recorded with the build, the sandboxes of the build are placed only in the regions it's readable in.
+type Controller struct {
+	db     *db.DB
+	redis  *redis.Client
+	logger *zap.SugaredLogger
+
+	trigger chan struct{}
+}
+
+// NewController returns the replication controller, the builds are locked in Redis (if configured),
+// so the API instances don't replicate the same build at once.
+func NewController(dbClient *db.DB, redisClient *redis.Client, logger *zap.SugaredLogger) *Controller {
+	return &Controller{
+		db:      dbClient,
+		redis:   redisClient,
+		logger:  logger,
+		trigger: make(chan struct{}, 1),
+	}
+}
+
+// Start replicates the builds periodically until the context is canceled, it does nothing if there are no bucket replicas.
+func (c *Controller) Start(ctx context.Context) {
+	if len(gcs.ReplicaRegions()) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(replicationInterval)
+	defer ticker.Stop()
+
+	for {
+		c.replicate(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-c.trigger:
+		}
+	}
+}
+
+// Trigger starts the replication right away, e.g. when a build finished, so the build is readable in the other regions sooner.
+func (c *Controller) Trigger() {
+	select {
+	case c.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (c *Controller) replicate(ctx context.Context) {
+	policies, err := c.db.GetReplicationPolicies(ctx)
+	if err != nil {
+		c.logger.Errorf("Error getting replication policies: %v", err)
+
+		return
+	}
+
+	for teamID, regions := range policies {
+		// Only the regions with a bucket replica are replicated to, the other regions read from the template bucket
+		targets := make([]string, 0, len(regions))
+		for _, region := range regions {
+			if _, ok := gcs.ReplicaBucket(region); ok {
+				targets = append(targets, region)
+			}
+		}
+
+		if len(targets) == 0 {
+			continue
+		}
+
+		builds, err := c.db.GetBuildsToReplicate(ctx, teamID, targets, replicationBatchSize)
+		if err != nil {
+			c.logger.Errorf("Error getting builds to replicate: %v", err)
+
+			continue
+		}
+
+		for _, build := range builds {
+			if ctx.Err() != nil {
+				return
+			}
+
+			c.replicateBuild(ctx, build, targets)
+		}
+	}
+}
+
+func (c *Controller) replicateBuild(ctx context.Context, build *models.EnvBuild, targets []string) {
+	locked, err := c.lock(ctx, build.ID)
+	if err != nil {
+		c.logger.Errorf("Error locking build '%s' for replication: %v", build.ID, err)
+
+		return
+	}
+
+	if !locked {
+		return
+	}
+
+	defer c.unlock(context.WithoutCancel(ctx), build.ID)
+
+	replicated := slices.Clone(build.ReplicatedRegions)
+	for _, region := range targets {
+		if slices.Contains(replicated, region) {
+			continue
+		}
+
+		bucket, _ := gcs.ReplicaBucket(region)
+
+		err = storage.ReplicateBuild(ctx, gcs.TemplateBucket, bucket, build.ID.String())
+		if err != nil {
+			c.logger.Errorf("Error replicating build '%s' to region '%s': %v", build.ID, region, err)
+
+			continue
+		}
+
+		replicated = append(replicated, region)
+	}
+
+	if len(replicated) == len(build.ReplicatedRegions) {
+		return
+	}
+
+	err = c.db.SetBuildReplicatedRegions(ctx, build.ID, replicated)
+	if err != nil {
+		c.logger.Errorf("Error recording replication of build '%s': %v", build.ID, err)
+
+		return
+	}
+
+	c.logger.Infof("Replicated build '%s' to regions %v", build.ID, replicated)
+}
+
+func (c *Controller) lock(ctx context.Context, buildID uuid.UUID) (bool, error) {
+	if c.redis == nil {
+		return true, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	locked, err := c.redis.SetNX(ctx, lockKeyPrefix+buildID.String(), 1, lockTimeout).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to lock build: %w", err)
+	}
+
+	return locked, nil
+}
+
+func (c *Controller) unlock(ctx context.Context, buildID uuid.UUID) {
+	if c.redis == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	err := c.redis.Del(ctx, lockKeyPrefix+buildID.String()).Err()
+	if err != nil {
+		c.logger.Errorf("Error unlocking build '%s': %v", buildID, err)
+	}
+}
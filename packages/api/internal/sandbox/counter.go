@@ -0,0 +1,232 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const (
+	counterKeyPrefix  = "sandboxes:team:"
+	releasedKeySuffix = ":released"
+	counterTimeout   = 500 * time.Millisecond
+
+	// reservationLease is how long the sandbox is counted while it's being created, the creation can't take longer.
+	reservationLease = 2 * time.Minute
+	// runningLease is how long the running sandbox is counted without being refreshed, it's refreshed on every sync with the nodes,
+	// so the sandboxes lost with the API replica that created them stop being counted.
+	runningLease = 5 * time.Minute
+)
+
+// reserveScript drops the expired sandboxes of the team and counts the sandbox if the team is below the limit.
+// The sandbox already counted is only extended, e.g. when the creation is retried.
+var reserveScript = redis.NewScript(`
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", ARGV[1])
+if not redis.call("ZSCORE", KEYS[1], ARGV[3]) and redis.call("ZCARD", KEYS[1]) >= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call("ZADD", KEYS[1], ARGV[4], ARGV[3])
+redis.call("PEXPIREAT", KEYS[1], ARGV[5])
+return 1
+`)
+
+// refreshScript extends the leases of the sandboxes of the team, the sandboxes released since they were listed are skipped,
+// so the refresh built from the older list doesn't count them again.
+var refreshScript = redis.NewScript(`
+for i = 3, #ARGV do
+	local released = redis.call("ZSCORE", KEYS[2], ARGV[i])
+	if not released or tonumber(released) < tonumber(ARGV[1]) then
+		redis.call("ZADD", KEYS[1], ARGV[2], ARGV[i])
+	end
+end
+redis.call("PEXPIREAT", KEYS[1], ARGV[2])
+return 1
+`)
+
+// TeamCounter counts the concurrent sandboxes of the teams, so the limits are enforced consistently across the API replicas.
+// The sandboxes are counted in Redis (if configured) with a lease, the leases are refreshed from the state of the nodes.
+// Without Redis each replica counts only the sandboxes it knows about, so a team can run more sandboxes than its limit across the replicas.
+// The released sandboxes are remembered for the running lease, so they aren't counted again by a refresh listed before the release.
+type TeamCounter struct {
+	redis *redis.Client
+
+	mu       sync.Mutex
+	local    map[uuid.UUID]map[string]time.Time
+	released map[string]time.Time
+}
+
+func NewTeamCounter(rc *redis.Client) *TeamCounter {
+	return &TeamCounter{
+		redis:    rc,
+		local:    make(map[uuid.UUID]map[string]time.Time),
+		released: make(map[string]time.Time),
+	}
+}
+
+// ErrLimitReached is returned when the team already runs the maximum number of sandboxes.
+type ErrLimitReached struct {
+	Limit int64
+}
+
+func (e ErrLimitReached) Error() string {
+	return fmt.Sprintf("you have reached the maximum number of concurrent sandboxes (%d)", e.Limit)
+}
+
+// Reserve counts the sandbox being created if the team is below the limit, the reservation is released if the creation fails.
+func (c *TeamCounter) Reserve(ctx context.Context, teamID uuid.UUID, sandboxID string, limit int64) error {
+	now := time.Now()
+	expiresAt := now.Add(reservationLease)
+
+	if c.redis == nil {
+		return c.reserveLocal(teamID, sandboxID, limit, now, expiresAt)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, counterTimeout)
+	defer cancel()
+
+	reserved, err := reserveScript.Run(ctx, c.redis, []string{c.key(teamID)},
+		now.UnixMilli(),
+		limit,
+		sandboxID,
+		expiresAt.UnixMilli(),
+		now.Add(runningLease).UnixMilli(),
+	).Int()
+	if err != nil {
+		return fmt.Errorf("failed to reserve sandbox '%s': %w", sandboxID, err)
+	}
+
+	if reserved == 0 {
+		return ErrLimitReached{Limit: limit}
+	}
+
+	return nil
+}
+
+// Release stops counting the sandbox, e.g. when it failed to start or it was removed.
+func (c *TeamCounter) Release(ctx context.Context, teamID uuid.UUID, sandboxID string) error {
+	now := time.Now()
+
+	if c.redis == nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.local[teamID], sandboxID)
+
+		for id, releasedAt := range c.released {
+			if now.Sub(releasedAt) > runningLease {
+				delete(c.released, id)
+			}
+		}
+
+		c.released[sandboxID] = now
+
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, counterTimeout)
+	defer cancel()
+
+	releasedKey := c.releasedKey(teamID)
+
+	pipe := c.redis.Pipeline()
+	pipe.ZRem(ctx, c.key(teamID), sandboxID)
+	pipe.ZRemRangeByScore(ctx, releasedKey, "-inf", strconv.FormatInt(now.Add(-runningLease).UnixMilli(), 10))
+	pipe.ZAdd(ctx, releasedKey, &redis.Z{Score: float64(now.UnixMilli()), Member: sandboxID})
+	pipe.PExpireAt(ctx, releasedKey, now.Add(runningLease))
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release sandbox '%s': %w", sandboxID, err)
+	}
+
+	return nil
+}
+
+// Refresh extends the leases of the running sandboxes by the team, the sandboxes not counted yet are added.
+// The sandboxes released since listedAt, when the running sandboxes were listed, are skipped.
+func (c *TeamCounter) Refresh(ctx context.Context, sandboxes map[uuid.UUID][]string, listedAt time.Time) error {
+	expiresAt := time.Now().Add(runningLease)
+
+	if c.redis == nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		for teamID, sandboxIDs := range sandboxes {
+			for _, sandboxID := range sandboxIDs {
+				if releasedAt, ok := c.released[sandboxID]; ok && !releasedAt.Before(listedAt) {
+					continue
+				}
+
+				c.localTeam(teamID)[sandboxID] = expiresAt
+			}
+		}
+
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, counterTimeout)
+	defer cancel()
+
+	pipe := c.redis.Pipeline()
+	for teamID, sandboxIDs := range sandboxes {
+		args := make([]any, 0, len(sandboxIDs)+2)
+		args = append(args, listedAt.UnixMilli(), expiresAt.UnixMilli())
+		for _, sandboxID := range sandboxIDs {
+			args = append(args, sandboxID)
+		}
+
+		// The scripts aren't loaded in the pipeline, so the script is sent whole
+		refreshScript.Eval(ctx, pipe, []string{c.key(teamID), c.releasedKey(teamID)}, args...)
+	}
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to refresh sandbox counts: %w", err)
+	}
+
+	return nil
+}
+
+func (c *TeamCounter) reserveLocal(teamID uuid.UUID, sandboxID string, limit int64, now, expiresAt time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	team := c.localTeam(teamID)
+	for id, expiration := range team {
+		if !expiration.After(now) {
+			delete(team, id)
+		}
+	}
+
+	if _, ok := team[sandboxID]; !ok && int64(len(team)) >= limit {
+		return ErrLimitReached{Limit: limit}
+	}
+
+	team[sandboxID] = expiresAt
+
+	return nil
+}
+
+// localTeam returns the local counts of the team, the caller must hold the lock.
+func (c *TeamCounter) localTeam(teamID uuid.UUID) map[string]time.Time {
+	team, ok := c.local[teamID]
+	if !ok {
+		team = make(map[string]time.Time)
+		c.local[teamID] = team
+	}
+
+	return team
+}
+
+func (c *TeamCounter) key(teamID uuid.UUID) string {
+	return counterKeyPrefix + teamID.String()
+}
+
+func (c *TeamCounter) releasedKey(teamID uuid.UUID) string {
+	return c.key(teamID) + releasedKeySuffix
+}
@@ -0,0 +1,63 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRefreshSkipsReleasedSandbox(t *testing.T) {
+	ctx := context.Background()
+	c := NewTeamCounter(nil)
+	teamID := uuid.New()
+
+	err := c.Reserve(ctx, teamID, "sbx-1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The sandbox is released after the running sandboxes were listed
+	listedAt := time.Now()
+
+	err = c.Release(ctx, teamID, "sbx-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = c.Refresh(ctx, map[uuid.UUID][]string{teamID: {"sbx-1"}}, listedAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = c.Reserve(ctx, teamID, "sbx-2", 1)
+	if err != nil {
+		t.Fatalf("expected the released sandbox not to be counted, got: %v", err)
+	}
+}
+
+func TestRefreshCountsSandboxListedAfterRelease(t *testing.T) {
+	ctx := context.Background()
+	c := NewTeamCounter(nil)
+	teamID := uuid.New()
+
+	err := c.Release(ctx, teamID, "sbx-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The sandbox was resumed and listed again after it was released
+	err = c.Refresh(ctx, map[uuid.UUID][]string{teamID: {"sbx-1"}}, time.Now().Add(time.Millisecond))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var limitErr ErrLimitReached
+
+	err = c.Reserve(ctx, teamID, "sbx-2", 1)
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("expected the limit to be reached, got: %v", err)
+	}
+}
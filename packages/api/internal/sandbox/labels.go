@@ -0,0 +1,139 @@
+package sandbox
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxLabels           = 64
+	maxLabelNameLength  = 63
+	maxLabelValueLength = 63
+	maxLabelPrefixLen   = 253
+)
+
+var (
+	labelNamePattern   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	labelPrefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+)
+
+// ValidateLabels checks the labels have the Kubernetes-like format, the key is an optional DNS prefix and a name ("example.com/name").
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > maxLabels {
+		return fmt.Errorf("too many labels (%d), the maximum is %d", len(labels), maxLabels)
+	}
+
+	for key, value := range labels {
+		if err := validateLabelKey(key); err != nil {
+			return err
+		}
+
+		if len(value) > maxLabelValueLength {
+			return fmt.Errorf("value of the label '%s' is longer than %d characters", key, maxLabelValueLength)
+		}
+
+		if value != "" && !labelNamePattern.MatchString(value) {
+			return fmt.Errorf("value of the label '%s' contains invalid characters", key)
+		}
+	}
+
+	return nil
+}
+
+func validateLabelKey(key string) error {
+	name := key
+	if prefix, suffix, found := strings.Cut(key, "/"); found {
+		if len(prefix) > maxLabelPrefixLen || !labelPrefixPattern.MatchString(prefix) {
+			return fmt.Errorf("prefix of the label '%s' is not a valid DNS subdomain", key)
+		}
+
+		name = suffix
+	}
+
+	if len(name) > maxLabelNameLength {
+		return fmt.Errorf("name of the label '%s' is longer than %d characters", key, maxLabelNameLength)
+	}
+
+	if !labelNamePattern.MatchString(name) {
+		return fmt.Errorf("name of the label '%s' is empty or contains invalid characters", key)
+	}
+
+	return nil
+}
+
+type labelOperator int
+
+const (
+	labelEquals labelOperator = iota
+	labelNotEquals
+	labelExists
+	labelNotExists
+)
+
+type labelRequirement struct {
+	key      string
+	operator labelOperator
+	value    string
+}
+
+// LabelSelector matches the labels if all the requirements are satisfied.
+type LabelSelector []labelRequirement
+
+// ParseLabelSelector parses the selectors in the "key=value", "key!=value", "key" and "!key" format.
+func ParseLabelSelector(selectors []string) (LabelSelector, error) {
+	selector := make(LabelSelector, 0, len(selectors))
+
+	for _, s := range selectors {
+		s = strings.TrimSpace(s)
+
+		var requirement labelRequirement
+		switch {
+		case strings.Contains(s, "!="):
+			key, value, _ := strings.Cut(s, "!=")
+			requirement = labelRequirement{key: key, operator: labelNotEquals, value: value}
+		case strings.Contains(s, "="):
+			key, value, _ := strings.Cut(s, "=")
+			requirement = labelRequirement{key: key, operator: labelEquals, value: value}
+		case strings.HasPrefix(s, "!"):
+			requirement = labelRequirement{key: strings.TrimPrefix(s, "!"), operator: labelNotExists}
+		default:
+			requirement = labelRequirement{key: s, operator: labelExists}
+		}
+
+		if err := validateLabelKey(requirement.key); err != nil {
+			return nil, fmt.Errorf("invalid label selector '%s': %w", s, err)
+		}
+
+		selector = append(selector, requirement)
+	}
+
+	return selector, nil
+}
+
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		value, exists := labels[requirement.key]
+
+		switch requirement.operator {
+		case labelEquals:
+			if !exists || value != requirement.value {
+				return false
+			}
+		case labelNotEquals:
+			if exists && value == requirement.value {
+				return false
+			}
+		case labelExists:
+			if !exists {
+				return false
+			}
+		case labelNotExists:
+			if exists {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,84 @@
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/kms"
+)
+
+const (
+	keyManagerEnv = "SECRETS_KEY_MANAGER"
+	// keyEnv is the key of the KMS, the resource name of the GCP KMS key, the ARN or alias of the AWS KMS key or the name of the Vault transit key.
+	keyEnv = "SECRETS_ENCRYPTION_KEY"
+	// localKeysEnv has the keys of the local key manager in the ID:BASE64_KEY format separated by commas,
+	// the first key encrypts the new data keys and the others are kept for the decryption after the rotation.
+	localKeysEnv = "SECRETS_ENCRYPTION_KEYS"
+)
+
+// ErrEncryptionNotConfigured is returned by the local key manager without the keys.
+var ErrEncryptionNotConfigured = kms.ErrNotConfigured
+
+// NewKeyManager returns the KMS selected by the SECRETS_KEY_MANAGER env var, the local key manager is used by default.
+// The data keys of the secrets are wrapped by the same key managers as the data keys of the encrypted snapshots.
+func NewKeyManager(ctx context.Context) (kms.KeyManager, error) {
+	name := os.Getenv(keyManagerEnv)
+	if name == "" {
+		name = kms.Local
+	}
+
+	key := os.Getenv(keyEnv)
+	if name == kms.Local {
+		key = os.Getenv(localKeysEnv)
+	} else if key == "" {
+		return nil, fmt.Errorf("%s is required for the secrets encryption with %s", keyEnv, name)
+	}
+
+	manager, err := kms.New(ctx, name, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure secrets encryption: %w", err)
+	}
+
+	return manager, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext with the random nonce prepended to the ciphertext.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, data := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, data, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+
+	return plaintext, nil
+}
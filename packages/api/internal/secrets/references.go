@@ -0,0 +1,131 @@
+package secrets
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	namePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	// envVarReferencePattern matches the ${secrets.NAME} and ${secrets.NAME@VERSION} references in the values of the env vars.
+	envVarReferencePattern = regexp.MustCompile(`\$\{secrets\.([A-Za-z_][A-Za-z0-9_]*)(?:@([0-9]+))?\}`)
+)
+
+// References are the versions of the referenced secrets by their names, the zero version is the latest one.
+type References map[string]int32
+
+// ParseReferences parses the references in the NAME or NAME@VERSION format.
+func ParseReferences(refs []string) (References, error) {
+	references := make(References, len(refs))
+
+	for _, ref := range refs {
+		name, version, err := parseReference(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		err = references.add(name, version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return references, nil
+}
+
+func parseReference(ref string) (string, int32, error) {
+	name, versionStr, hasVersion := strings.Cut(ref, "@")
+	if !namePattern.MatchString(name) {
+		return "", 0, fmt.Errorf("invalid secret reference '%s', expected NAME or NAME@VERSION", ref)
+	}
+
+	if !hasVersion {
+		return name, 0, nil
+	}
+
+	version, err := strconv.ParseInt(versionStr, 10, 32)
+	if err != nil || version < 1 {
+		return "", 0, fmt.Errorf("invalid version of secret reference '%s'", ref)
+	}
+
+	return name, int32(version), nil
+}
+
+func (r References) add(name string, version int32) error {
+	existing, ok := r[name]
+	if ok && existing != version {
+		return fmt.Errorf("secret '%s' is referenced in different versions", name)
+	}
+
+	r[name] = version
+
+	return nil
+}
+
+// Merge adds the other references, the same secret can't be referenced in different versions.
+func (r References) Merge(other References) error {
+	for name, version := range other {
+		err := r.add(name, version)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns the reference of the secret in the NAME or NAME@VERSION format.
+func (r References) String(name string) string {
+	if r[name] == 0 {
+		return name
+	}
+
+	return fmt.Sprintf("%s@%d", name, r[name])
+}
+
+// EnvVarReferences returns the secrets referenced in the values of the env vars and the names of the env vars with the references.
+func EnvVarReferences(envVars map[string]string) (References, []string, error) {
+	references := make(References)
+
+	var names []string
+
+	for key, value := range envVars {
+		matches := envVarReferencePattern.FindAllStringSubmatch(value, -1)
+		if len(matches) == 0 {
+			continue
+		}
+
+		names = append(names, key)
+
+		for _, match := range matches {
+			ref := match[1]
+			if match[2] != "" {
+				ref += "@" + match[2]
+			}
+
+			name, version, err := parseReference(ref)
+			if err != nil {
+				return nil, nil, fmt.Errorf("env var '%s': %w", key, err)
+			}
+
+			err = references.add(name, version)
+			if err != nil {
+				return nil, nil, fmt.Errorf("env var '%s': %w", key, err)
+			}
+		}
+	}
+
+	return references, names, nil
+}
+
+// ExpandEnvVar replaces the references in the value of the env var with the values of the secrets.
+func ExpandEnvVar(value string, secrets map[string]string) string {
+	return envVarReferencePattern.ReplaceAllStringFunc(value, func(match string) string {
+		name := envVarReferencePattern.FindStringSubmatch(match)[1]
+
+		return secrets[name]
+	})
+}
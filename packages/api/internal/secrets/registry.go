@@ -0,0 +1,49 @@
+package secrets
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const dockerHub = "docker.io"
+
+// registryPattern matches the host of the registry with an optional port.
+var registryPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*(:[0-9]+)?$`)
+
+// dockerHubAliases are the hosts of Docker Hub, the credentials for them are stored for docker.io.
+var dockerHubAliases = map[string]struct{}{
+	dockerHub:                 {},
+	"index.docker.io":         {},
+	"registry-1.docker.io":    {},
+	"registry.hub.docker.com": {},
+}
+
+// NormalizeRegistry returns the host the credential for the registry is stored for.
+func NormalizeRegistry(registry string) (string, error) {
+	host := strings.ToLower(strings.TrimSpace(registry))
+	if !registryPattern.MatchString(host) {
+		return "", fmt.Errorf("invalid registry '%s', expected the host of the registry like ghcr.io", registry)
+	}
+
+	if _, ok := dockerHubAliases[host]; ok {
+		return dockerHub, nil
+	}
+
+	return host, nil
+}
+
+// ImageRegistry returns the host of the registry of the image reference, the images without the registry are on Docker Hub.
+func ImageRegistry(imageRef string) string {
+	first, _, ok := strings.Cut(imageRef, "/")
+	if !ok || !(strings.ContainsAny(first, ".:") || first == "localhost") {
+		return dockerHub
+	}
+
+	registry, err := NormalizeRegistry(first)
+	if err != nil {
+		return first
+	}
+
+	return registry
+}
@@ -0,0 +1,83 @@
+package secrets
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/kms"
+)
+
+const dataKeySize = 32
+
+// Vault encrypts the values of the secrets with the envelope encryption, each version has its own data key
+// wrapped by the KMS. The team, the name and the version of the secret are authenticated with the value,
+// so the stored value can't be moved to the other secret.
+type Vault struct {
+	keys kms.KeyManager
+}
+
+func NewVault(keys kms.KeyManager) *Vault {
+	return &Vault{keys: keys}
+}
+
+func additionalData(teamID uuid.UUID, name string, version int32) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", teamID, name, version))
+}
+
+func (v *Vault) Seal(ctx context.Context, teamID uuid.UUID, name string, version int32, value string) (*db.SealedSecret, error) {
+	dataKey := make([]byte, dataKeySize)
+
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	defer clear(dataKey)
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := seal(aead, []byte(value), additionalData(teamID, name, version))
+	if err != nil {
+		return nil, err
+	}
+
+	// The data key is wrapped for the team, so it can't be unwrapped for the secrets of another team
+	encryptedKey, keyID, err := v.keys.Wrap(ctx, teamID.String(), dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt data key: %w", err)
+	}
+
+	return &db.SealedSecret{
+		KeyID:        keyID,
+		EncryptedKey: encryptedKey,
+		Ciphertext:   ciphertext,
+	}, nil
+}
+
+func (v *Vault) Open(ctx context.Context, teamID uuid.UUID, name string, version int32, sealed db.SealedSecret) (string, error) {
+	dataKey, err := v.keys.Unwrap(ctx, teamID.String(), sealed.KeyID, sealed.EncryptedKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+
+	defer clear(dataKey)
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := open(aead, sealed.Ciphertext, additionalData(teamID, name, version))
+	if err != nil {
+		return "", err
+	}
+
+	return string(value), nil
+}
@@ -0,0 +1,353 @@
+// Package status checks the health of the components of the cluster the API depends on, so the operators see
+// the state of the whole cluster with the latencies and the last errors of the components in one place.
+package status
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+const (
+	checkInterval = 15 * time.Second
+	checkTimeout  = 5 * time.Second
+)
+
+type Kind string
+
+const (
+	KindNode     Kind = "node"
+	KindProxy    Kind = "proxy"
+	KindStorage  Kind = "storage"
+	KindDatabase Kind = "database"
+	KindRedis    Kind = "redis"
+)
+
+type Health string
+
+const (
+	Healthy   Health = "healthy"
+	Degraded  Health = "degraded"
+	Unhealthy Health = "unhealthy"
+)
+
+// Component is the result of the last health check of the component, the last error is kept after the component recovers.
+type Component struct {
+	Name      string
+	Kind      Kind
+	Health    Health
+	Latency   time.Duration
+	CheckedAt time.Time
+	// Message describes why the healthy component is degraded.
+	Message     string
+	LastError   string
+	LastErrorAt *time.Time
+}
+
+// Status is the health of the cluster derived from the health of its components.
+type Status struct {
+	Health     Health
+	CheckedAt  time.Time
+	Components []Component
+}
+
+// Checker checks the components periodically, the status is served from the last check, so the status requests
+// don't load the components.
+type Checker struct {
+	db           *db.DB
+	redis        *redis.Client
+	orchestrator *orchestrator.Orchestrator
+	// proxies are the health check URLs of the proxies by their name.
+	proxies    map[string]string
+	httpClient *http.Client
+	logger     *zap.SugaredLogger
+
+	mu     sync.RWMutex
+	status *Status
+	// lastErrors are the last errors of the components by their kind and name, the components that disappeared are removed.
+	lastErrors map[string]Component
+}
+
+func NewChecker(dbClient *db.DB, redisClient *redis.Client, orch *orchestrator.Orchestrator, logger *zap.SugaredLogger) *Checker {
+	return &Checker{
+		db:           dbClient,
+		redis:        redisClient,
+		orchestrator: orch,
+		proxies:      parseProxies(os.Getenv("PROXY_HEALTH_URLS"), logger),
+		httpClient:   &http.Client{Timeout: checkTimeout},
+		logger:       logger,
+		lastErrors:   make(map[string]Component),
+	}
+}
+
+// parseProxies parses the health check URLs of the proxies in the name=url,name=url format.
+func parseProxies(value string, logger *zap.SugaredLogger) map[string]string {
+	proxies := make(map[string]string)
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		name, url, ok := strings.Cut(pair, "=")
+		if !ok || name == "" || url == "" {
+			logger.Warnf("Ignoring invalid proxy health check '%s', the proxies must be in the name=url format", pair)
+
+			continue
+		}
+
+		proxies[name] = url
+	}
+
+	return proxies
+}
+
+// Start checks the components periodically until the context is canceled.
+func (c *Checker) Start(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		c.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Status returns the status from the last check, nil if the components weren't checked yet.
+func (c *Checker) Status() *Status {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.status
+}
+
+// Check checks all components in parallel and stores the status.
+func (c *Checker) Check(ctx context.Context) *Status {
+	checkedAt := time.Now()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	components := make([]Component, 0)
+	add := func(component Component) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		components = append(components, component)
+	}
+
+	run := func(name string, kind Kind, check func(ctx context.Context) (string, error)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+
+			start := time.Now()
+			message, err := check(ctx)
+
+			add(newComponent(name, kind, time.Since(start), checkedAt, message, err))
+		}()
+	}
+
+	run("postgres", KindDatabase, c.checkDatabase)
+	if c.db.HasReplica() {
+		run("postgres-read-replica", KindDatabase, c.checkReplica)
+	}
+
+	if c.redis != nil {
+		run("redis", KindRedis, func(ctx context.Context) (string, error) {
+			return "", c.redis.Ping(ctx).Err()
+		})
+	}
+
+	run("template-bucket", KindStorage, func(ctx context.Context) (string, error) {
+		return "", gcs.Ping(ctx, gcs.TemplateBucket)
+	})
+
+	for _, region := range gcs.ReplicaRegions() {
+		bucket, _ := gcs.ReplicaBucket(region)
+
+		run(fmt.Sprintf("template-bucket-%s", region), KindStorage, func(ctx context.Context) (string, error) {
+			return "", gcs.Ping(ctx, bucket)
+		})
+	}
+
+	for name, url := range c.proxies {
+		run(name, KindProxy, func(ctx context.Context) (string, error) {
+			return "", c.checkProxy(ctx, url)
+		})
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for _, node := range c.orchestrator.CheckNodes(ctx) {
+			var message string
+			if node.Status != api.NodeStatusReady {
+				message = fmt.Sprintf("The node is %s", node.Status)
+			}
+
+			add(newComponent(node.NodeID, KindNode, node.Latency, checkedAt, message, node.Err))
+		}
+	}()
+
+	wg.Wait()
+
+	return c.store(checkedAt, components)
+}
+
+func (c *Checker) checkDatabase(ctx context.Context) (string, error) {
+	err := c.db.Ping(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return "", nil
+}
+
+func (c *Checker) checkReplica(ctx context.Context) (string, error) {
+	lag, err := c.db.ReplicaLag(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get read replica lag: %w", err)
+	}
+
+	if lag > c.db.ReplicaMaxLag() {
+		return fmt.Sprintf("The read replica lags %s behind the primary, the queries are routed to the primary", lag.Round(time.Millisecond)), nil
+	}
+
+	return "", nil
+}
+
+func (c *Checker) checkProxy(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create health check request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to check health of proxy: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("health check of proxy returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func newComponent(name string, kind Kind, latency time.Duration, checkedAt time.Time, message string, err error) Component {
+	component := Component{
+		Name:      name,
+		Kind:      kind,
+		Health:    Healthy,
+		Latency:   latency,
+		CheckedAt: checkedAt,
+		Message:   message,
+	}
+
+	if message != "" {
+		component.Health = Degraded
+	}
+
+	if err != nil {
+		component.Health = Unhealthy
+		component.LastError = err.Error()
+		component.LastErrorAt = &checkedAt
+	}
+
+	return component
+}
+
+// store keeps the last errors of the components that are healthy now and stores the status of the check.
+func (c *Checker) store(checkedAt time.Time, components []Component) *Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lastErrors := make(map[string]Component, len(components))
+	for i, component := range components {
+		key := string(component.Kind) + "/" + component.Name
+
+		if component.LastErrorAt == nil {
+			previous, ok := c.lastErrors[key]
+			if !ok {
+				continue
+			}
+
+			components[i].LastError = previous.LastError
+			components[i].LastErrorAt = previous.LastErrorAt
+			component = components[i]
+		} else if component.Health == Unhealthy {
+			c.logger.Warnf("Component '%s' (%s) is unhealthy: %s", component.Name, component.Kind, component.LastError)
+		}
+
+		lastErrors[key] = component
+	}
+
+	c.lastErrors = lastErrors
+
+	slices.SortFunc(components, func(a, b Component) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Name, b.Name))
+	})
+
+	c.status = &Status{
+		Health:     clusterHealth(components),
+		CheckedAt:  checkedAt,
+		Components: components,
+	}
+
+	return c.status
+}
+
+// clusterHealth returns unhealthy if the sandboxes can't be started, i.e. the database or the template bucket
+// is unhealthy or there is no healthy node, and degraded if any other component isn't healthy.
+func clusterHealth(components []Component) Health {
+	health := Healthy
+	healthyNodes := 0
+
+	for _, component := range components {
+		if component.Kind == KindNode && component.Health != Unhealthy {
+			healthyNodes++
+		}
+
+		if component.Health == Healthy {
+			continue
+		}
+
+		if component.Health == Unhealthy && (component.Name == "postgres" || component.Name == "template-bucket") {
+			return Unhealthy
+		}
+
+		health = Degraded
+	}
+
+	if healthyNodes == 0 {
+		return Unhealthy
+	}
+
+	return health
+}
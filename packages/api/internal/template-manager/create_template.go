@@ -16,11 +16,35 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
+const (
+	defaultBuildStepTimeoutSeconds = 30 * 60
+	defaultBuildTimeoutSeconds     = 60 * 60
+)
+
+var (
+	// buildStepTimeoutSeconds limits each step of the build, so the runaway provisioning doesn't hold the resources of the node.
+	buildStepTimeoutSeconds = timeoutSeconds("TEMPLATE_BUILD_STEP_TIMEOUT_SECONDS", defaultBuildStepTimeoutSeconds)
+	// buildTimeoutSeconds limits the whole build including the upload.
+	buildTimeoutSeconds = timeoutSeconds("TEMPLATE_BUILD_TIMEOUT_SECONDS", defaultBuildTimeoutSeconds)
+)
+
+// timeoutSeconds returns the timeout from the environment variable, zero disables the timeout.
+func timeoutSeconds(key string, defaultSeconds int32) int32 {
+	seconds, err := strconv.ParseInt(env.GetEnv(key, strconv.Itoa(int(defaultSeconds))), 10, 32)
+	if err != nil || seconds < 0 {
+		return defaultSeconds
+	}
+
+	return int32(seconds)
+}
+
 func (tm *TemplateManager) CreateTemplate(
 	t trace.Tracer,
 	ctx context.Context,
@@ -28,12 +52,17 @@ func (tm *TemplateManager) CreateTemplate(
 	buildCache *builds.BuildCache,
 	templateID string,
 	buildID uuid.UUID,
-	kernelVersion,
+	kernelVersion string,
+	kernelArgs []string,
 	firecrackerVersion,
 	startCommand string,
 	vCpuCount,
 	diskSizeMB,
-	memoryMB int64,
+	memoryMB,
+	rootfsBlockSize int64,
+	sourceBuildID string,
+	upgradePackages bool,
+	variants []*models.EnvBuild,
 ) error {
 	childCtx, childSpan := t.Start(ctx, "create-template",
 		trace.WithAttributes(
@@ -51,17 +80,33 @@ func (tm *TemplateManager) CreateTemplate(
 
 	telemetry.ReportEvent(childCtx, "Got FC version info")
 
+	templateVariants := make([]*template_manager.TemplateVariant, len(variants))
+	for i, variant := range variants {
+		templateVariants[i] = &template_manager.TemplateVariant{
+			BuildID:   variant.ID.String(),
+			MemoryMB:  int32(variant.RAMMB),
+			VCpuCount: int32(variant.Vcpu),
+		}
+	}
+
 	logs, err := tm.grpc.Client.TemplateCreate(ctx, &template_manager.TemplateCreateRequest{
 		Template: &template_manager.TemplateConfig{
-			TemplateID:         templateID,
-			BuildID:            buildID.String(),
-			VCpuCount:          int32(vCpuCount),
-			MemoryMB:           int32(memoryMB),
-			DiskSizeMB:         int32(diskSizeMB),
-			KernelVersion:      kernelVersion,
-			FirecrackerVersion: firecrackerVersion,
-			HugePages:          features.HasHugePages(),
-			StartCommand:       startCommand,
+			TemplateID:          templateID,
+			BuildID:             buildID.String(),
+			VCpuCount:           int32(vCpuCount),
+			MemoryMB:            int32(memoryMB),
+			DiskSizeMB:          int32(diskSizeMB),
+			KernelVersion:       kernelVersion,
+			KernelArgs:          kernelArgs,
+			FirecrackerVersion:  firecrackerVersion,
+			HugePages:           features.HasHugePages(),
+			StartCommand:        startCommand,
+			RootfsBlockSize:     int32(rootfsBlockSize),
+			SourceBuildID:       sourceBuildID,
+			UpgradePackages:     upgradePackages,
+			StepTimeoutSeconds:  buildStepTimeoutSeconds,
+			BuildTimeoutSeconds: buildTimeoutSeconds,
+			Variants:            templateVariants,
 		},
 	})
 	err = utils.UnwrapGRPCError(err)
@@ -102,6 +147,14 @@ func (tm *TemplateManager) CreateTemplate(
 		return fmt.Errorf("envd version not found in trailer")
 	}
 
+	// The variants are finished before the build, so the build is never selected without its variants
+	for _, variant := range variants {
+		err = db.FinishEnvBuild(childCtx, templateID, variant.ID, diskSize, envdVersion[0])
+		if err != nil {
+			return fmt.Errorf("error when finishing variant build '%s': %w", variant.ID, err)
+		}
+	}
+
 	err = db.FinishEnvBuild(childCtx, templateID, buildID, diskSize, envdVersion[0])
 	if err != nil {
 		return fmt.Errorf("error when finishing build: %w", err)
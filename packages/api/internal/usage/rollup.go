@@ -0,0 +1,184 @@
+// Package usage rolls up the usage of the teams for the dashboards, so the dashboards read the small rollup tables
+// instead of aggregating the raw sandbox starts and builds on every request.
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+const (
+	usageInterval = 10 * time.Minute
+	// usageDays is the number of the last days rolled up in every run, the previous day is rolled up again after it ends,
+	// so the sandbox starts and the builds recorded late are counted.
+	usageDays = 2
+	// sandboxStartsRetention is how long the sandbox starts are kept after their day was rolled up for the last time.
+	sandboxStartsRetention = 7 * 24 * time.Hour
+
+	storageInterval = 6 * time.Hour
+
+	usageLockKey   = "usage:rollup:daily"
+	storageLockKey = "usage:rollup:storage"
+	redisTimeout   = 2 * time.Second
+)
+
+// Rollup rolls up the daily usage and the template storage of the teams periodically. The rollups are locked in Redis (if configured),
+// so only one API instance rolls up in every interval, the rollups are idempotent otherwise.
+type Rollup struct {
+	db     *db.DB
+	redis  *redis.Client
+	logger *zap.SugaredLogger
+}
+
+func NewRollup(dbClient *db.DB, redisClient *redis.Client, logger *zap.SugaredLogger) *Rollup {
+	return &Rollup{
+		db:     dbClient,
+		redis:  redisClient,
+		logger: logger,
+	}
+}
+
+// Start rolls up the usage periodically until the context is canceled.
+func (r *Rollup) Start(ctx context.Context) {
+	usageTicker := time.NewTicker(usageInterval)
+	defer usageTicker.Stop()
+
+	storageTicker := time.NewTicker(storageInterval)
+	defer storageTicker.Stop()
+
+	r.run(ctx, usageLockKey, usageInterval, r.rollupUsage)
+	r.run(ctx, storageLockKey, storageInterval, r.rollupStorage)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-usageTicker.C:
+			r.run(ctx, usageLockKey, usageInterval, r.rollupUsage)
+		case <-storageTicker.C:
+			r.run(ctx, storageLockKey, storageInterval, r.rollupStorage)
+		}
+	}
+}
+
+func (r *Rollup) run(ctx context.Context, lockKey string, interval time.Duration, rollup func(ctx context.Context) error) {
+	locked, err := r.lock(ctx, lockKey, interval)
+	if err != nil {
+		r.logger.Errorf("Error locking usage rollup '%s': %v", lockKey, err)
+
+		return
+	}
+
+	if !locked {
+		return
+	}
+
+	err = rollup(ctx)
+	if err != nil {
+		r.logger.Errorf("Error rolling up usage '%s': %v", lockKey, err)
+	}
+}
+
+func (r *Rollup) rollupUsage(ctx context.Context) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	for i := range usageDays {
+		day := today.AddDate(0, 0, -i)
+
+		usage, err := r.db.GetTeamsUsage(ctx, day)
+		if err != nil {
+			return fmt.Errorf("failed to get usage of %s: %w", day.Format(time.DateOnly), err)
+		}
+
+		for teamID, u := range usage {
+			err = r.db.SetTeamUsage(ctx, teamID, day, u)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	oldest := today.AddDate(0, 0, -(usageDays - 1))
+
+	deleted, err := r.db.DeleteSandboxStarts(ctx, oldest.Add(-sandboxStartsRetention))
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		r.logger.Infof("Deleted %d rolled up sandbox starts", deleted)
+	}
+
+	return nil
+}
+
+type templateStorage struct {
+	teamID        uuid.UUID
+	templateBytes int64
+	snapshotBytes int64
+}
+
+func (r *Rollup) rollupStorage(ctx context.Context) error {
+	started := time.Now()
+
+	builds, err := r.db.GetStoredBuilds(ctx)
+	if err != nil {
+		return err
+	}
+
+	storage := make(map[string]*templateStorage)
+	for _, build := range builds {
+		size, err := gcs.DirSize(ctx, gcs.TemplateBucket, build.BuildID.String())
+		if err != nil {
+			return fmt.Errorf("failed to get size of build '%s': %w", build.BuildID, err)
+		}
+
+		s, ok := storage[build.EnvID]
+		if !ok {
+			s = &templateStorage{teamID: build.TeamID}
+			storage[build.EnvID] = s
+		}
+
+		if build.Snapshot {
+			s.snapshotBytes += size
+		} else {
+			s.templateBytes += size
+		}
+	}
+
+	for envID, s := range storage {
+		err = r.db.SetTeamTemplateStorage(ctx, s.teamID, envID, s.templateBytes, s.snapshotBytes)
+		if err != nil {
+			// The template the snapshots were started from may have been deleted
+			r.logger.Warnf("Error setting storage of template '%s': %v", envID, err)
+		}
+	}
+
+	return r.db.DeleteStaleTeamTemplateStorage(ctx, started)
+}
+
+// lock locks the rollup for the interval, the lock isn't released, so the rollup runs once per interval in all API instances.
+func (r *Rollup) lock(ctx context.Context, key string, interval time.Duration) (bool, error) {
+	if r.redis == nil {
+		return true, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	// The lock expires a bit before the next run, so the instance that locked it can lock it again despite the ticker drift
+	locked, err := r.redis.SetNX(ctx, key, 1, interval-redisTimeout).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to lock rollup: %w", err)
+	}
+
+	return locked, nil
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/gin_utils/middleware"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -36,10 +39,20 @@ func ErrorHandler(c *gin.Context, message string, statusCode int) {
 	c.Error(errMsg)
 
 	if strings.HasPrefix(message, "error in openapi3filter.SecurityRequirementsError: security requirements failed: ") {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"code": http.StatusUnauthorized, "message": strings.TrimPrefix(message, "error in openapi3filter.SecurityRequirementsError: security requirements failed: ")})
+		c.AbortWithStatusJSON(http.StatusUnauthorized, api.Error{
+			Code:      http.StatusUnauthorized,
+			Message:   strings.TrimPrefix(message, "error in openapi3filter.SecurityRequirementsError: security requirements failed: "),
+			ErrorCode: string(errcode.Unauthorized),
+			RequestID: middleware.GetRequestID(c),
+		})
 
 		return
 	}
 
-	c.AbortWithStatusJSON(statusCode, gin.H{"code": statusCode, "message": fmt.Errorf("validation error: %s", message).Error()})
+	c.AbortWithStatusJSON(statusCode, api.Error{
+		Code:      int32(statusCode),
+		Message:   fmt.Errorf("validation error: %s", message).Error(),
+		ErrorCode: string(errcode.Validation),
+		RequestID: middleware.GetRequestID(c),
+	})
 }
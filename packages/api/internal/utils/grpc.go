@@ -4,8 +4,11 @@ import (
 	"fmt"
 
 	"github.com/gogo/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 )
 
+// UnwrapGRPCError converts the gRPC status error to a plain error, the error code from the status is kept.
 func UnwrapGRPCError(err error) error {
 	if err == nil {
 		return nil
@@ -16,5 +19,11 @@ func UnwrapGRPCError(err error) error {
 		return err
 	}
 
-	return fmt.Errorf("[%s] %s", st.Code(), st.Message())
+	unwrapped := fmt.Errorf("[%s] %s", st.Code(), st.Message())
+
+	if code, ok := errcode.Of(err); ok {
+		return errcode.Wrap(code, unwrapped)
+	}
+
+	return unwrapped
 }
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+)
+
+// NextTokenHeader is the response header with the cursor of the next page.
+const NextTokenHeader = "X-Next-Token"
+
+func EncodeNextToken(cursor db.PageCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", cursor.Time.UnixNano(), cursor.ID)))
+}
+
+func DecodeNextToken(token string) (*db.PageCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid next token: %w", err)
+	}
+
+	timestamp, id, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return nil, fmt.Errorf("invalid next token format")
+	}
+
+	nanos, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid next token time: %w", err)
+	}
+
+	return &db.PageCursor{Time: time.Unix(0, nanos), ID: id}, nil
+}
@@ -21,12 +21,19 @@ import (
 	limits "github.com/gin-contrib/size"
 	"github.com/gin-gonic/gin"
 	middleware "github.com/oapi-codegen/gin-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/handlers"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/diagnostics"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	e2bgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 
 	customMiddleware "github.com/e2b-dev/infra/packages/shared/pkg/gin_utils/middleware"
@@ -40,6 +47,10 @@ const (
 	maxUploadLimit       = 1 << 28 // 256 MiB
 	maxReadHeaderTimeout = 60 * time.Second
 	defaultPort          = 80
+	// The diagnostics server is started only when ADMIN_TOKEN is set.
+	defaultDiagnosticsPort = 8081
+	// The gRPC server the orchestrators register with is started only in Kubernetes.
+	defaultGRPCPort = 5011
 )
 
 func NewGinServer(ctx context.Context, apiStore *handlers.APIStore, swagger *openapi3.T, port int) *http.Server {
@@ -50,16 +61,19 @@ func NewGinServer(ctx context.Context, apiStore *handlers.APIStore, swagger *ope
 	r := gin.New()
 
 	r.Use(
+		customMiddleware.RequestID(),
 		// We use custom otel gin middleware because we want to log 4xx errors in the otel
 		customMiddleware.ExcludeRoutes(tracingMiddleware.Middleware(serviceName),
 			"/health",
+			"/health/deep",
 			"/sandboxes/:sandboxID/refreshes",
 			"/templates/:templateID/builds/:buildID/logs",
 			"/templates/:templateID/builds/:buildID/status",
 		),
 		customMiddleware.IncludeRoutes(metricsMiddleware.Middleware(serviceName), "/sandboxes"),
-		customMiddleware.ExcludeRoutes(gin.LoggerWithWriter(gin.DefaultWriter),
+		customMiddleware.ExcludeRoutes(gin.LoggerWithConfig(gin.LoggerConfig{Output: gin.DefaultWriter, Formatter: customMiddleware.LogFormatter}),
 			"/health",
+			"/health/deep",
 			"/sandboxes/:sandboxID/refreshes",
 			"/templates/:templateID/builds/:buildID/logs",
 			"/templates/:templateID/builds/:buildID/status",
@@ -78,6 +92,12 @@ func NewGinServer(ctx context.Context, apiStore *handlers.APIStore, swagger *ope
 		// API Key header
 		"Authorization",
 		"X-API-Key",
+		// Request ID propagated to the error responses
+		customMiddleware.RequestIDHeader,
+		// Key of the request the client can safely retry
+		handlers.IdempotencyKeyHeader,
+		// Entity tag of the resource the request expects
+		"If-Match",
 		// Custom headers sent from SDK
 		"browser",
 		"lang",
@@ -91,6 +111,7 @@ func NewGinServer(ctx context.Context, apiStore *handlers.APIStore, swagger *ope
 		"sdk_runtime",
 		"system",
 	}
+	config.ExposeHeaders = []string{customMiddleware.RequestIDHeader, utils.NextTokenHeader, handlers.IdempotentReplayedHeader, handlers.ETagHeader}
 	r.Use(cors.New(config))
 
 	// Create a team API Key auth validator
@@ -128,6 +149,38 @@ func NewGinServer(ctx context.Context, apiStore *handlers.APIStore, swagger *ope
 	return s
 }
 
+// startNodeRegistry starts the gRPC server the orchestrators register their nodes with, it's used instead of Nomad in Kubernetes.
+func startNodeRegistry(registry *node.Registry, port int) (*grpc.Server, error) {
+	// Any pod could register a node and receive the sandboxes of the teams without the token
+	if node.Token == "" {
+		return nil, fmt.Errorf("ORCHESTRATOR_TOKEN is required in Kubernetes")
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on port %d: %w", port, err)
+	}
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(e2bgrpc.UnaryServerTokenInterceptor(node.Token)),
+		grpc.ChainStreamInterceptor(e2bgrpc.StreamServerTokenInterceptor(node.Token)),
+	)
+
+	orchestrator.RegisterNodeServiceServer(s, registry)
+	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
+
+	go func() {
+		log.Printf("node registry grpc service (%d) starting", port)
+
+		err := s.Serve(lis)
+		if err != nil {
+			log.Printf("node registry grpc service (%d) encountered error: %v", port, err)
+		}
+	}()
+
+	return s, nil
+}
+
 func main() {
 	ctx, cancel := context.WithCancel(context.Background()) // root context
 	defer cancel()
@@ -141,10 +194,14 @@ func main() {
 	//     exiting early.
 
 	var (
-		port  int
-		debug string
+		port            int
+		diagnosticsPort int
+		grpcPort        int
+		debug           string
 	)
 	flag.IntVar(&port, "port", defaultPort, "Port for test HTTP server")
+	flag.IntVar(&diagnosticsPort, "diagnostics-port", defaultDiagnosticsPort, "Port for the diagnostics HTTP server")
+	flag.IntVar(&grpcPort, "grpc-port", defaultGRPCPort, "Port for the gRPC server the orchestrators register with in Kubernetes")
 	flag.StringVar(&debug, "true", "false", "is debug")
 	flag.Parse()
 
@@ -215,6 +272,30 @@ func main() {
 	apiStore := handlers.NewAPIStore(ctx)
 	cleanupFns = append(cleanupFns, apiStore.Close)
 
+	diagnosticsServer := diagnostics.NewServer(diagnosticsPort, os.Getenv("ADMIN_TOKEN"), apiStore.ActiveSandboxes)
+	if diagnosticsServer != nil {
+		diagnostics.Start(diagnosticsServer)
+		cleanupFns = append(cleanupFns, func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			return diagnosticsServer.Shutdown(ctx)
+		})
+	}
+
+	if env.IsKubernetes() {
+		grpcServer, err := startNodeRegistry(apiStore.NodeRegistry(), grpcPort)
+		if err != nil {
+			log.Fatalf("Error starting node registry server: %v", err)
+		}
+
+		cleanupFns = append(cleanupFns, func() error {
+			grpcServer.GracefulStop()
+
+			return nil
+		})
+	}
+
 	// pass the signal context so that handlers know when shutdown is happening.
 	s := NewGinServer(ctx, apiStore, swagger, port)
 
@@ -45,6 +45,23 @@ resource "google_secret_manager_secret" "postgres_connection_string" {
   }
 }
 
+resource "google_secret_manager_secret" "postgres_read_replica_connection_string" {
+  secret_id = "${var.prefix}postgres-read-replica-connection-string"
+
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "postgres_read_replica_connection_string" {
+  secret      = google_secret_manager_secret.postgres_read_replica_connection_string.name
+  secret_data = " "
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
 resource "google_secret_manager_secret" "posthog_api_key" {
   secret_id = "${var.prefix}posthog-api-key"
 
@@ -98,3 +115,24 @@ resource "google_secret_manager_secret_version" "api_admin_token_value" {
   secret      = google_secret_manager_secret.api_admin_token.id
   secret_data = random_password.api_admin_secret.result
 }
+
+resource "random_id" "secrets_encryption_key" {
+  byte_length = 32
+}
+
+# The keys are in the ID:BASE64_KEY format separated by commas, the first key encrypts the new team secrets
+resource "google_secret_manager_secret" "secrets_encryption_keys" {
+  secret_id = "${var.prefix}secrets-encryption-keys"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "secrets_encryption_keys_value" {
+  secret      = google_secret_manager_secret.secrets_encryption_keys.id
+  secret_data = "v1:${random_id.secrets_encryption_key.b64_std}"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
@@ -10,6 +10,10 @@ output "postgres_connection_string_secret_name" {
   value = google_secret_manager_secret.postgres_connection_string.name
 }
 
+output "postgres_read_replica_connection_string_secret_name" {
+  value = google_secret_manager_secret.postgres_read_replica_connection_string.name
+}
+
 output "posthog_api_key_secret_name" {
   value = google_secret_manager_secret.posthog_api_key.name
 }
@@ -20,4 +24,8 @@ output "custom_envs_repository_name" {
 
 output "api_admin_token_name" {
   value = google_secret_manager_secret.api_admin_token.name
+}
+
+output "secrets_encryption_keys_secret_name" {
+  value = google_secret_manager_secret.secrets_encryption_keys.name
 }
\ No newline at end of file
@@ -12,9 +12,10 @@ resource "google_storage_bucket" "loki_storage_bucket" {
     retention_duration_seconds = 0
   }
 
+  # The objects are deleted after MORE than the longest Loki retention, so Loki deletes the expired logs itself
   lifecycle_rule {
     condition {
-      age = 8
+      age = max(8, var.loki_retention_days + 1)
     }
 
     action {
@@ -96,6 +97,17 @@ resource "google_storage_bucket" "fc_template_bucket" {
     retention_duration_seconds = 604800
   }
 
+  # The crash diagnostics of the sandboxes contain the guest logs and the process state, they aren't kept with the templates
+  lifecycle_rule {
+    condition {
+      age            = var.diagnostics_retention_days
+      matches_prefix = ["diagnostics/"]
+    }
+
+    action {
+      type = "Delete"
+    }
+  }
 
   labels = var.labels
 }
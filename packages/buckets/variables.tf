@@ -27,3 +27,13 @@ variable "fc_template_bucket_name" {
   type        = string
   description = "The name of the FC template bucket"
 }
+
+variable "loki_retention_days" {
+  type        = number
+  description = "The longest retention of the logs in Loki, the objects of the Loki bucket are deleted after it"
+}
+
+variable "diagnostics_retention_days" {
+  type        = number
+  description = "The retention of the crash diagnostics of the sandboxes, the bundles in the template bucket are deleted after it"
+}
@@ -1,3 +1,7 @@
 output "logs_proxy_ip" {
   value = module.gce_lb_http_logs.external_ip
 }
+
+output "https_lb_ip" {
+  value = google_compute_global_forwarding_rule.https.ip_address
+}
@@ -1,3 +1,7 @@
 output "logs_proxy_ip" {
   value = module.network.logs_proxy_ip
 }
+
+output "https_lb_ip" {
+  value = module.network.https_lb_ip
+}
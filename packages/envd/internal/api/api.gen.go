@@ -6,17 +6,63 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ArchiveFormat.
+const (
+	TarGz ArchiveFormat = "tar.gz"
+	Zip   ArchiveFormat = "zip"
+)
+
+// Defines values for ChangedEntryType.
+const (
+	ChangedEntryTypeDirectory ChangedEntryType = "directory"
+	ChangedEntryTypeFile      ChangedEntryType = "file"
+)
+
 // Defines values for EntryInfoType.
 const (
-	File EntryInfoType = "file"
+	EntryInfoTypeFile EntryInfoType = "file"
 )
 
+// ArchiveFormat Format of the archive
+type ArchiveFormat string
+
+// BlockRange defines model for BlockRange.
+type BlockRange struct {
+	// Count Number of blocks in the range
+	Count int64 `json:"count"`
+
+	// Start Index of the first block in the range
+	Start int64 `json:"start"`
+}
+
+// ChangedBlocks defines model for ChangedBlocks.
+type ChangedBlocks struct {
+	// BlockSize Size of the block in bytes
+	BlockSize int64 `json:"blockSize"`
+
+	// Ranges Sorted, non-overlapping ranges of changed blocks
+	Ranges []BlockRange `json:"ranges"`
+}
+
+// ChangedEntry defines model for ChangedEntry.
+type ChangedEntry struct {
+	// Path Absolute path to the file or directory
+	Path string `json:"path"`
+
+	// Type Type of the entry
+	Type ChangedEntryType `json:"type"`
+}
+
+// ChangedEntryType Type of the entry
+type ChangedEntryType string
+
 // EntryInfo defines model for EntryInfo.
 type EntryInfo struct {
 	// Name Name of the file
@@ -44,6 +90,55 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// HardeningPolicy Hardening of the processes started by envd, the processes started before the init are not hardened
+type HardeningPolicy struct {
+	// DeniedSyscalls Syscalls denied by the seccomp profile of the processes, they fail with EPERM
+	DeniedSyscalls *[]string `json:"deniedSyscalls,omitempty"`
+
+	// NoNewPrivileges The processes can't gain privileges via setuid binaries or file capabilities
+	NoNewPrivileges *bool `json:"noNewPrivileges,omitempty"`
+
+	// ReadOnlyPaths Paths mounted read-only, the paths stay read-only until the sandbox is restarted
+	ReadOnlyPaths *[]string `json:"readOnlyPaths,omitempty"`
+}
+
+// Metrics Resource usage metrics
+type Metrics struct {
+	// CpuUsedPct CPU usage percentage
+	CpuUsedPct *float32 `json:"cpu_used_pct,omitempty"`
+
+	// DiskTotalMib Total space of the root filesystem in MiB
+	DiskTotalMib *int `json:"disk_total_mib,omitempty"`
+
+	// DiskUsedMib Used space of the root filesystem in MiB
+	DiskUsedMib *int `json:"disk_used_mib,omitempty"`
+
+	// MemBytes Total virtual memory usage in bytes
+	MemBytes *int `json:"mem_bytes,omitempty"`
+
+	// OpenFds Open file descriptors of all processes
+	OpenFds *int `json:"open_fds,omitempty"`
+
+	// Processes The processes using the most CPU and memory
+	Processes *[]ProcessMetrics `json:"processes,omitempty"`
+}
+
+// ProcessMetrics Resource usage of a process
+type ProcessMetrics struct {
+	// Cmd Command line of the process
+	Cmd *string `json:"cmd,omitempty"`
+
+	// CpuUsedPct CPU usage of the process since the last call in percent of one core
+	CpuUsedPct *float32 `json:"cpu_used_pct,omitempty"`
+
+	// MemRssMib Resident memory of the process in MiB
+	MemRssMib *int `json:"mem_rss_mib,omitempty"`
+
+	// OpenFds Open file descriptors of the process
+	OpenFds *int `json:"open_fds,omitempty"`
+	Pid     *int `json:"pid,omitempty"`
+}
+
 // FilePath defines model for FilePath.
 type FilePath = string
 
@@ -91,18 +186,66 @@ type PostFilesParams struct {
 	Username User `form:"username" json:"username"`
 }
 
+// GetFilesArchiveParams defines parameters for GetFilesArchive.
+type GetFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Format Format of the archive, tar.gz is used by default.
+	Format *ArchiveFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PostFilesArchiveParams defines parameters for PostFilesArchive.
+type PostFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Format Format of the archive, tar.gz is used by default.
+	Format *ArchiveFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
 // PostInitJSONBody defines parameters for PostInit.
 type PostInitJSONBody struct {
+	// Entropy Random bytes from the host used to reseed the RNG of the sandbox
+	Entropy *[]byte `json:"entropy,omitempty"`
+
 	// EnvVars Environment variables to set
 	EnvVars *EnvVars `json:"envVars,omitempty"`
+
+	// Hardening Hardening of the processes started by envd, the processes started before the init are not hardened
+	Hardening *HardeningPolicy `json:"hardening,omitempty"`
+
+	// Secrets Secrets exposed as the env vars of the new processes and as the files in tmpfs, they are never written to the disk
+	Secrets *map[string]string `json:"secrets,omitempty"`
+
+	// Timestamp Time of the host, used to sync the clock if the PTP device is not available
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// PostScrubJSONBody defines parameters for PostScrub.
+type PostScrubJSONBody struct {
+	// EnvVars Names of the env vars to remove
+	EnvVars *[]string `json:"envVars,omitempty"`
 }
 
 // PostFilesMultipartRequestBody defines body for PostFiles for multipart/form-data ContentType.
 type PostFilesMultipartRequestBody PostFilesMultipartBody
 
+// PostFilesChangesJSONRequestBody defines body for PostFilesChanges for application/json ContentType.
+type PostFilesChangesJSONRequestBody = ChangedBlocks
+
 // PostInitJSONRequestBody defines body for PostInit for application/json ContentType.
 type PostInitJSONRequestBody PostInitJSONBody
 
+// PostScrubJSONRequestBody defines body for PostScrub for application/json ContentType.
+type PostScrubJSONRequestBody PostScrubJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get the environment variables
@@ -114,6 +257,18 @@ type ServerInterface interface {
 	// Upload a file and ensure the parent directories exist. If the file exists, it will be overwritten.
 	// (POST /files)
 	PostFiles(w http.ResponseWriter, r *http.Request, params PostFilesParams)
+	// Download a directory or a file as an archive streamed while the directory is read
+	// (GET /files/archive)
+	GetFilesArchive(w http.ResponseWriter, r *http.Request, params GetFilesArchiveParams)
+	// Upload an archive and extract it to the directory, the directory and its parents are created if they don't exist. The existing files are overwritten.
+	// (POST /files/archive)
+	PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams)
+	// Resolve changed block ranges of the root block device to the files and directories they belong to
+	// (POST /files/changes)
+	PostFilesChanges(w http.ResponseWriter, r *http.Request)
+	// Flush the filesystem buffers so all pending writes reach the block device
+	// (POST /files/sync)
+	PostFilesSync(w http.ResponseWriter, r *http.Request)
 	// Check the health of the service
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
@@ -123,6 +278,12 @@ type ServerInterface interface {
 	// Get the stats of the service
 	// (GET /metrics)
 	GetMetrics(w http.ResponseWriter, r *http.Request)
+	// Remove the secret env vars and the secrets and return the freed memory before the sandbox is paused
+	// (POST /scrub)
+	PostScrub(w http.ResponseWriter, r *http.Request)
+	// Get the spans recorded since the last call, in the OTLP/JSON trace export format
+	// (GET /spans)
+	GetSpans(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -147,6 +308,30 @@ func (_ Unimplemented) PostFiles(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Download a directory or a file as an archive streamed while the directory is read
+// (GET /files/archive)
+func (_ Unimplemented) GetFilesArchive(w http.ResponseWriter, r *http.Request, params GetFilesArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload an archive and extract it to the directory, the directory and its parents are created if they don't exist. The existing files are overwritten.
+// (POST /files/archive)
+func (_ Unimplemented) PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resolve changed block ranges of the root block device to the files and directories they belong to
+// (POST /files/changes)
+func (_ Unimplemented) PostFilesChanges(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Flush the filesystem buffers so all pending writes reach the block device
+// (POST /files/sync)
+func (_ Unimplemented) PostFilesSync(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Check the health of the service
 // (GET /health)
 func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
@@ -165,6 +350,18 @@ func (_ Unimplemented) GetMetrics(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Remove the secret env vars and the secrets and return the freed memory before the sandbox is paused
+// (POST /scrub)
+func (_ Unimplemented) PostScrub(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the spans recorded since the last call, in the OTLP/JSON trace export format
+// (GET /spans)
+func (_ Unimplemented) GetSpans(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -272,6 +469,134 @@ func (siw *ServerInterfaceWrapper) PostFiles(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// GetFilesArchive operation middleware
+func (siw *ServerInterfaceWrapper) GetFilesArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFilesArchiveParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFilesArchive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostFilesArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostFilesArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFilesArchiveParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostFilesArchive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostFilesChanges operation middleware
+func (siw *ServerInterfaceWrapper) PostFilesChanges(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostFilesChanges(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostFilesSync operation middleware
+func (siw *ServerInterfaceWrapper) PostFilesSync(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostFilesSync(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetHealth operation middleware
 func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
 
@@ -314,6 +639,34 @@ func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// PostScrub operation middleware
+func (siw *ServerInterfaceWrapper) PostScrub(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostScrub(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpans operation middleware
+func (siw *ServerInterfaceWrapper) GetSpans(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpans(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -436,6 +789,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/files", wrapper.PostFiles)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/files/archive", wrapper.GetFilesArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/files/archive", wrapper.PostFilesArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/files/changes", wrapper.PostFilesChanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/files/sync", wrapper.PostFilesSync)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
 	})
@@ -445,6 +810,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/metrics", wrapper.GetMetrics)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/scrub", wrapper.PostScrub)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spans", wrapper.GetSpans)
+	})
 
 	return r
 }
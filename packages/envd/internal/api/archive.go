@@ -0,0 +1,437 @@
+package api
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+)
+
+func archiveFormat(format *ArchiveFormat) (ArchiveFormat, error) {
+	if format == nil {
+		return TarGz, nil
+	}
+
+	switch *format {
+	case TarGz, Zip:
+		return *format, nil
+	default:
+		return "", fmt.Errorf("unsupported archive format '%s'", *format)
+	}
+}
+
+func (a *API) GetFilesArchive(w http.ResponseWriter, r *http.Request, params GetFilesArchiveParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), logs.AssignOperationID()).
+			Str("path", path).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Archive read")
+	}()
+
+	format, err := archiveFormat(params.Format)
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	u, err := user.Lookup(params.Username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", params.Username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	stat, err := os.Stat(resolvedPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			errMsg = fmt.Errorf("path '%s' does not exist", resolvedPath)
+			errorCode = http.StatusNotFound
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+
+		errMsg = fmt.Errorf("error checking if path exists '%s': %w", resolvedPath, err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if !stat.IsDir() && !stat.Mode().IsRegular() {
+		errMsg = fmt.Errorf("path '%s' is not a directory or a regular file", resolvedPath)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	name := filepath.Base(resolvedPath) + "." + string(format)
+
+	contentType := "application/gzip"
+	if format == Zip {
+		contentType = "application/zip"
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+
+	w.WriteHeader(http.StatusOK)
+
+	// The archive is streamed, the errors after the first write can only abort the response, so the client gets an invalid archive.
+	if format == Zip {
+		err = writeZip(w, resolvedPath, stat.IsDir())
+	} else {
+		err = writeTarGz(w, resolvedPath, stat.IsDir())
+	}
+
+	if err != nil {
+		errMsg = fmt.Errorf("error writing archive of '%s': %w", resolvedPath, err)
+		errorCode = http.StatusInternalServerError
+
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func (a *API) PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), logs.AssignOperationID()).
+			Str("path", path).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Archive write")
+	}()
+
+	format, err := archiveFormat(params.Format)
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	u, err := user.Lookup(params.Username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", params.Username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	uid, gid, err := permissions.GetUserIds(u)
+	if err != nil {
+		errMsg = fmt.Errorf("error getting user ids: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = permissions.EnsureDirs(resolvedPath, int(uid), int(gid))
+	if err != nil {
+		errMsg = fmt.Errorf("error ensuring directories: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	freeSpace, err := freeDiskSpace(resolvedPath)
+	if err != nil {
+		errMsg = fmt.Errorf("error checking free disk space: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	// The extracted files are usually larger than the archive, this only rejects the archives that can't fit at all.
+	if int64(freeSpace) < r.ContentLength {
+		errMsg = fmt.Errorf("not enough disk space on '%s': %d bytes required, %d bytes free", resolvedPath, r.ContentLength, freeSpace)
+		errorCode = http.StatusInsufficientStorage
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	ex, err := newExtractor(resolvedPath, int(uid), int(gid))
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if format == Zip {
+		err = ex.extractZip(r.Body)
+	} else {
+		err = ex.extractTarGz(r.Body)
+	}
+
+	if err != nil {
+		errMsg = fmt.Errorf("error extracting archive to '%s': %w", resolvedPath, err)
+		errorCode = http.StatusInternalServerError
+
+		var invalidErr *invalidArchiveError
+		if errors.As(err, &invalidErr) {
+			errorCode = http.StatusBadRequest
+		}
+
+		if errors.Is(err, errNoSpace) {
+			errorCode = http.StatusInsufficientStorage
+		}
+
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	data, err := json.Marshal(ex.files)
+	if err != nil {
+		errMsg = fmt.Errorf("error marshaling response: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// archiveRoot returns the directory the names in the archive are relative to, the archived file is at the root of the archive.
+func archiveRoot(path string, isDir bool) string {
+	if isDir {
+		return path
+	}
+
+	return filepath.Dir(path)
+}
+
+// writeTarGz writes the directory with all its files, directories and symlinks as tar.gz, or the single file.
+func writeTarGz(w io.Writer, dir string, isDir bool) error {
+	root := archiveRoot(dir, isDir)
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == root {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			// The sockets and other special files can't be archived
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		header.Name = filepath.ToSlash(rel)
+		if d.IsDir() {
+			header.Name += "/"
+		}
+
+		err = tw.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		return copyFile(tw, path)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// writeZip writes the directory as zip, or the single file. The symlinks are stored with their target as the content.
+func writeZip(w io.Writer, dir string, isDir bool) error {
+	root := archiveRoot(dir, isDir)
+
+	zw := zip.NewWriter(w)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == root {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		mode := info.Mode()
+		if !mode.IsRegular() && !mode.IsDir() && mode&os.ModeSymlink == 0 {
+			return nil
+		}
+
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		header.Name = filepath.ToSlash(rel)
+		if d.IsDir() {
+			header.Name += "/"
+			header.Method = zip.Store
+		} else {
+			header.Method = zip.Deflate
+		}
+
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case mode&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+
+			_, err = io.WriteString(fw, link)
+
+			return err
+		case mode.IsRegular():
+			return copyFile(fw, path)
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func copyFile(w io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(w, file)
+
+	return err
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/host"
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+)
+
+func (a *API) PostFilesSync(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	operationID := logs.AssignOperationID()
+	logger := logs.RequestLogger(a.logger, r.Header)
+
+	logger.Debug().Str(string(logs.OperationIDKey), operationID).Msg("Flushing filesystem")
+
+	host.FlushFilesystem()
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *API) PostFilesChanges(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	operationID := logs.AssignOperationID()
+	logger := logs.RequestLogger(a.logger, r.Header)
+
+	var body PostFilesChangesJSONRequestBody
+
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to decode request: %v", err)
+		jsonError(w, http.StatusBadRequest, fmt.Errorf("error decoding request: %w", err))
+
+		return
+	}
+
+	ranges := make([]host.BlockRange, 0, len(body.Ranges))
+	for _, r := range body.Ranges {
+		ranges = append(ranges, host.BlockRange{
+			Start: r.Start,
+			Count: r.Count,
+		})
+	}
+
+	logger.Debug().Str(string(logs.OperationIDKey), operationID).Msgf("Resolving %d changed block ranges", len(ranges))
+
+	changed, err := host.ResolveChangedBlocks(r.Context(), body.BlockSize, ranges)
+	if err != nil {
+		logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to resolve changed blocks: %v", err)
+		jsonError(w, http.StatusInternalServerError, fmt.Errorf("error resolving changed blocks: %w", err))
+
+		return
+	}
+
+	entries := make([]ChangedEntry, 0, len(changed))
+	for _, c := range changed {
+		entryType := ChangedEntryTypeFile
+		if c.IsDir {
+			entryType = ChangedEntryTypeDirectory
+		}
+
+		entries = append(entries, ChangedEntry{
+			Path: c.Path,
+			Type: entryType,
+		})
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(entries)
+}
@@ -0,0 +1,344 @@
+package api
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+)
+
+var errNoSpace = errors.New("not enough disk space")
+
+type invalidArchiveError struct {
+	err error
+}
+
+func (e *invalidArchiveError) Error() string {
+	return fmt.Sprintf("invalid archive: %s", e.err)
+}
+
+func (e *invalidArchiveError) Unwrap() error {
+	return e.err
+}
+
+// extractor writes the entries of the archive to the directory, owned by the user.
+// The entries can't be written outside of the directory, neither by their names nor through the symlinks.
+type extractor struct {
+	dir   string
+	uid   int
+	gid   int
+	files UploadSuccess
+}
+
+func newExtractor(dir string, uid, gid int) (*extractor, error) {
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving directory '%s': %w", dir, err)
+	}
+
+	return &extractor{
+		dir:   realDir,
+		uid:   uid,
+		gid:   gid,
+		files: UploadSuccess{},
+	}, nil
+}
+
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolve returns the path of the entry in the directory.
+func (e *extractor) resolve(name string) (string, error) {
+	if filepath.IsAbs(name) {
+		return "", &invalidArchiveError{fmt.Errorf("entry '%s' has absolute path", name)}
+	}
+
+	path := filepath.Join(e.dir, filepath.FromSlash(name))
+	if !within(e.dir, path) {
+		return "", &invalidArchiveError{fmt.Errorf("entry '%s' is outside of the directory", name)}
+	}
+
+	// The deepest existing parent could be a symlink from a previous entry
+	existing := filepath.Dir(path)
+	for {
+		_, err := os.Lstat(existing)
+		if err == nil {
+			break
+		}
+
+		if !os.IsNotExist(err) {
+			return "", fmt.Errorf("error checking path '%s': %w", existing, err)
+		}
+
+		existing = filepath.Dir(existing)
+	}
+
+	realParent, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", fmt.Errorf("error resolving path '%s': %w", existing, err)
+	}
+
+	if !within(e.dir, realParent) {
+		return "", &invalidArchiveError{fmt.Errorf("entry '%s' is outside of the directory", name)}
+	}
+
+	return path, nil
+}
+
+// prepare creates the parent directories of the entry and removes the existing file or symlink at its path.
+func (e *extractor) prepare(name string) (string, error) {
+	path, err := e.resolve(name)
+	if err != nil {
+		return "", err
+	}
+
+	err = permissions.EnsureDirs(filepath.Dir(path), e.uid, e.gid)
+	if err != nil {
+		return "", fmt.Errorf("error ensuring directories: %w", err)
+	}
+
+	stat, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return path, nil
+		}
+
+		return "", fmt.Errorf("error getting file info: %w", err)
+	}
+
+	if stat.IsDir() {
+		return "", &invalidArchiveError{fmt.Errorf("entry '%s' is a directory in the sandbox", name)}
+	}
+
+	err = os.Remove(path)
+	if err != nil {
+		return "", fmt.Errorf("error removing existing file '%s': %w", path, err)
+	}
+
+	return path, nil
+}
+
+func (e *extractor) directory(name string, mode os.FileMode) error {
+	path, err := e.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	if path == e.dir {
+		return nil
+	}
+
+	// The existing directory could be a symlink
+	realPath, err := filepath.EvalSymlinks(path)
+	if err == nil && !within(e.dir, realPath) {
+		return &invalidArchiveError{fmt.Errorf("entry '%s' is outside of the directory", name)}
+	}
+
+	err = permissions.EnsureDirs(path, e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error ensuring directories: %w", err)
+	}
+
+	return os.Chmod(path, mode.Perm())
+}
+
+func (e *extractor) file(name string, mode os.FileMode, modTime time.Time, r io.Reader) error {
+	path, err := e.prepare(name)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL|syscall.O_NOFOLLOW, mode.Perm())
+	if err != nil {
+		return fmt.Errorf("error creating file '%s': %w", path, err)
+	}
+	defer file.Close()
+
+	err = file.Chown(e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error changing file ownership: %w", err)
+	}
+
+	// The mode passed to the open is masked by the umask
+	err = file.Chmod(mode.Perm())
+	if err != nil {
+		return fmt.Errorf("error changing file mode: %w", err)
+	}
+
+	_, err = io.Copy(file, r)
+	if err != nil {
+		if errors.Is(err, syscall.ENOSPC) {
+			return fmt.Errorf("error writing file '%s': %w", path, errNoSpace)
+		}
+
+		return fmt.Errorf("error writing file '%s': %w", path, err)
+	}
+
+	if !modTime.IsZero() {
+		err = os.Chtimes(path, modTime, modTime)
+		if err != nil {
+			return fmt.Errorf("error setting file times: %w", err)
+		}
+	}
+
+	e.files = append(e.files, EntryInfo{
+		Path: path,
+		Name: filepath.Base(path),
+		Type: EntryInfoTypeFile,
+	})
+
+	return nil
+}
+
+func (e *extractor) symlink(name, target string) error {
+	path, err := e.prepare(name)
+	if err != nil {
+		return err
+	}
+
+	err = os.Symlink(target, path)
+	if err != nil {
+		return fmt.Errorf("error creating symlink '%s': %w", path, err)
+	}
+
+	err = os.Lchown(path, e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error changing symlink ownership: %w", err)
+	}
+
+	return nil
+}
+
+func (e *extractor) link(name, target string) error {
+	targetPath, err := e.resolve(target)
+	if err != nil {
+		return err
+	}
+
+	path, err := e.prepare(name)
+	if err != nil {
+		return err
+	}
+
+	err = os.Link(targetPath, path)
+	if err != nil {
+		return fmt.Errorf("error creating hard link '%s': %w", path, err)
+	}
+
+	return nil
+}
+
+func (e *extractor) extractTarGz(r io.Reader) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return &invalidArchiveError{err}
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return &invalidArchiveError{err}
+		}
+
+		mode := os.FileMode(header.Mode)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = e.directory(header.Name, mode)
+		case tar.TypeReg:
+			err = e.file(header.Name, mode, header.ModTime, tr)
+		case tar.TypeSymlink:
+			err = e.symlink(header.Name, header.Linkname)
+		case tar.TypeLink:
+			err = e.link(header.Name, header.Linkname)
+		default:
+			// The devices, fifos and the metadata entries are skipped
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// extractZip needs the whole archive for reading its central directory, so the archive is saved to a temporary file first.
+func (e *extractor) extractZip(r io.Reader) error {
+	tmp, err := os.CreateTemp("", "envd-archive-*.zip")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		if errors.Is(err, syscall.ENOSPC) {
+			return fmt.Errorf("error saving archive: %w", errNoSpace)
+		}
+
+		return fmt.Errorf("error saving archive: %w", err)
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return &invalidArchiveError{err}
+	}
+
+	for _, f := range zr.File {
+		err = e.zipEntry(f)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *extractor) zipEntry(f *zip.File) error {
+	mode := f.Mode()
+
+	if mode.IsDir() {
+		return e.directory(f.Name, mode)
+	}
+
+	if !mode.IsRegular() && mode&os.ModeSymlink == 0 {
+		return nil
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return &invalidArchiveError{err}
+	}
+	defer rc.Close()
+
+	if mode&os.ModeSymlink != 0 {
+		target, err := io.ReadAll(io.LimitReader(rc, 4096))
+		if err != nil {
+			return &invalidArchiveError{err}
+		}
+
+		return e.symlink(f.Name, string(target))
+	}
+
+	return e.file(f.Name, mode, f.Modified, rc)
+}
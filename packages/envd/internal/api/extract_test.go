@@ -0,0 +1,87 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithin(t *testing.T) {
+	tests := []struct {
+		name     string
+		dir      string
+		path     string
+		expected bool
+	}{
+		{name: "same directory", dir: "/dir", path: "/dir", expected: true},
+		{name: "child", dir: "/dir", path: "/dir/file", expected: true},
+		{name: "nested child", dir: "/dir", path: "/dir/a/b/file", expected: true},
+		{name: "child named with dots", dir: "/dir", path: "/dir/..file", expected: true},
+		{name: "parent", dir: "/dir", path: "/", expected: false},
+		{name: "parent by dots", dir: "/dir", path: "/dir/..", expected: false},
+		{name: "outside by dots", dir: "/dir", path: "/dir/../etc/passwd", expected: false},
+		{name: "inside by dots", dir: "/dir", path: "/dir/a/../file", expected: true},
+		{name: "absolute outside", dir: "/dir", path: "/etc/passwd", expected: false},
+		{name: "sibling prefix", dir: "/dir", path: "/dir2", expected: false},
+		{name: "sibling prefix child", dir: "/dir", path: "/dir2/file", expected: false},
+		{name: "relative to absolute", dir: "/dir", path: "dir/file", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, within(tt.dir, tt.path))
+		})
+	}
+}
+
+func TestExtractorResolve(t *testing.T) {
+	root := t.TempDir()
+
+	dir := filepath.Join(root, "dir")
+	require.NoError(t, os.Mkdir(dir, 0o755))
+	require.NoError(t, os.Mkdir(filepath.Join(root, "dir2"), 0o755))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o755))
+
+	// The symlinks an earlier entry of the archive could create
+	require.NoError(t, os.Symlink(root, filepath.Join(dir, "out")))
+	require.NoError(t, os.Symlink(filepath.Join(root, "dir2"), filepath.Join(dir, "sibling")))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "sub"), filepath.Join(dir, "in")))
+
+	e, err := newExtractor(dir, os.Getuid(), os.Getgid())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		entry string
+		valid bool
+	}{
+		{name: "file", entry: "file", valid: true},
+		{name: "nested file in new directory", entry: "a/b/file", valid: true},
+		{name: "dots inside", entry: "sub/../file", valid: true},
+		{name: "dots outside", entry: "../file", valid: false},
+		{name: "dots to sibling", entry: "../dir2/file", valid: false},
+		{name: "nested dots outside", entry: "sub/../../file", valid: false},
+		{name: "absolute", entry: "/etc/passwd", valid: false},
+		{name: "symlinked parent outside", entry: "out/file", valid: false},
+		{name: "symlinked parent outside in new directory", entry: "out/a/file", valid: false},
+		{name: "symlinked parent to sibling", entry: "sibling/file", valid: false},
+		{name: "symlinked parent inside", entry: "in/file", valid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := e.resolve(tt.entry)
+			if !tt.valid {
+				var invalid *invalidArchiveError
+				require.ErrorAs(t, err, &invalid)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, filepath.Join(e.dir, filepath.FromSlash(tt.entry)), path)
+		})
+	}
+}
@@ -5,51 +5,116 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
+	"github.com/e2b-dev/infra/packages/envd/internal/hardening"
 	"github.com/e2b-dev/infra/packages/envd/internal/host"
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/telemetry"
 )
 
 func (a *API) PostInit(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
 	operationID := logs.AssignOperationID()
+	logger := logs.RequestLogger(a.logger, r.Header)
 
-	if r.Body != nil {
-		var initRequest PostInitJSONBody
+	// Init is called by the orchestrator on each sandbox start and resume.
+	telemetry.SetExecution(r.Header)
+
+	ctx, span := telemetry.Start(r.Context(), "init", r.Header)
+	defer span.End(nil)
 
+	var initRequest PostInitJSONBody
+
+	if r.Body != nil {
 		err := json.NewDecoder(r.Body).Decode(&initRequest)
 		if err != nil && err != io.EOF {
-			a.logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to decode request: %v", err)
+			logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to decode request: %v", err)
 			w.WriteHeader(http.StatusBadRequest)
 
 			return
 		}
 
 		if initRequest.EnvVars != nil {
-			a.logger.Debug().Str(string(logs.OperationIDKey), operationID).Msg(fmt.Sprintf("Setting %d env vars", len(*initRequest.EnvVars)))
+			logger.Debug().Str(string(logs.OperationIDKey), operationID).Msg(fmt.Sprintf("Setting %d env vars", len(*initRequest.EnvVars)))
 
 			for key, value := range *initRequest.EnvVars {
-				a.logger.Debug().Str(string(logs.OperationIDKey), operationID).Msgf("Setting env var for %s", key)
+				logger.Debug().Str(string(logs.OperationIDKey), operationID).Msgf("Setting env var for %s", key)
 
 				a.envVars.Store(key, value)
 			}
 		}
-	}
 
-	a.logger.Debug().Str(string(logs.OperationIDKey), operationID).Msg("Syncing host")
+		if initRequest.Secrets != nil && len(*initRequest.Secrets) > 0 {
+			err = a.secrets.Set(*initRequest.Secrets)
+			if err != nil {
+				// The sandbox must not start without the secrets it was created with
+				logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to set secrets: %v", err)
+				w.WriteHeader(http.StatusInternalServerError)
+
+				return
+			}
 
-	go func() {
-		err := host.Sync()
+			logger.Debug().Str(string(logs.OperationIDKey), operationID).Msgf("Set %d secrets", len(*initRequest.Secrets))
+		}
+
+		if initRequest.Hardening != nil {
+			err = a.hardening.Set(hardeningPolicy(initRequest.Hardening))
+			if err != nil {
+				// The sandbox must not start with the processes less restricted than the template requires
+				logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to set hardening policy: %v", err)
+				w.WriteHeader(http.StatusInternalServerError)
+
+				return
+			}
+		}
+	}
+
+	if initRequest.Entropy != nil {
+		err := host.AddEntropy(*initRequest.Entropy)
 		if err != nil {
-			a.logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to sync clock: %v", err)
+			logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to reseed RNG: %v", err)
 		} else {
-			a.logger.Trace().Str(string(logs.OperationIDKey), operationID).Msg("Clock synced")
+			logger.Debug().Str(string(logs.OperationIDKey), operationID).Msgf("Reseeded RNG with %d bytes", len(*initRequest.Entropy))
 		}
-	}()
+	}
+
+	logger.Debug().Str(string(logs.OperationIDKey), operationID).Msg("Syncing host")
+
+	// The clock is synced before responding, so nothing in the sandbox sees the stale time after the restore (e.g. TLS validation)
+	_, syncSpan := telemetry.Start(ctx, "clock-sync", nil)
+
+	drift, err := host.Sync(initRequest.Timestamp)
+	syncSpan.End(err)
+	if err != nil {
+		logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to sync clock: %v", err)
+	} else {
+		logger.Trace().Str(string(logs.OperationIDKey), operationID).Dur("drift", drift).Msg("Clock synced")
+
+		w.Header().Set("X-Clock-Drift", strconv.FormatInt(drift.Milliseconds(), 10))
+	}
 
 	w.Header().Set("Cache-Control", "no-store")
 	w.Header().Set("Content-Type", "")
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func hardeningPolicy(policy *HardeningPolicy) hardening.Policy {
+	result := hardening.Policy{}
+
+	if policy.NoNewPrivileges != nil {
+		result.NoNewPrivileges = *policy.NoNewPrivileges
+	}
+
+	if policy.ReadOnlyPaths != nil {
+		result.ReadOnlyPaths = *policy.ReadOnlyPaths
+	}
+
+	if policy.DeniedSyscalls != nil {
+		result.DeniedSyscalls = *policy.DeniedSyscalls
+	}
+
+	return result
+}
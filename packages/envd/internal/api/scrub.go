@@ -0,0 +1,52 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/host"
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+)
+
+// PostScrub is called by the orchestrator right before the sandbox is paused,
+// the secret env vars and the secrets are removed so they don't end up in the snapshot.
+func (a *API) PostScrub(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	operationID := logs.AssignOperationID()
+	logger := logs.RequestLogger(a.logger, r.Header)
+
+	var scrubRequest PostScrubJSONBody
+
+	err := json.NewDecoder(r.Body).Decode(&scrubRequest)
+	if err != nil && err != io.EOF {
+		logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to decode request: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	if scrubRequest.EnvVars != nil {
+		for _, key := range *scrubRequest.EnvVars {
+			a.envVars.Delete(key)
+		}
+
+		logger.Debug().Str(string(logs.OperationIDKey), operationID).Msgf("Removed %d secret env vars", len(*scrubRequest.EnvVars))
+	}
+
+	err = a.secrets.Clear()
+	if err != nil {
+		logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to clear secrets: %v", err)
+	}
+
+	err = host.ReleaseMemory()
+	if err != nil {
+		logger.Error().Str(string(logs.OperationIDKey), operationID).Msgf("Failed to release memory: %v", err)
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "")
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -6,17 +6,22 @@ import (
 
 	"github.com/rs/zerolog"
 
+	"github.com/e2b-dev/infra/packages/envd/internal/hardening"
 	"github.com/e2b-dev/infra/packages/envd/internal/host"
+	"github.com/e2b-dev/infra/packages/envd/internal/secrets"
+	"github.com/e2b-dev/infra/packages/envd/internal/telemetry"
 	"github.com/e2b-dev/infra/packages/envd/internal/utils"
 )
 
 type API struct {
-	logger  *zerolog.Logger
-	envVars *utils.Map[string, string]
+	logger    *zerolog.Logger
+	envVars   *utils.Map[string, string]
+	secrets   *secrets.Secrets
+	hardening *hardening.Hardening
 }
 
-func New(l *zerolog.Logger, envVars *utils.Map[string, string]) *API {
-	return &API{logger: l, envVars: envVars}
+func New(l *zerolog.Logger, envVars *utils.Map[string, string], secrets *secrets.Secrets, hardening *hardening.Hardening) *API {
+	return &API{logger: l, envVars: envVars, secrets: secrets, hardening: hardening}
 }
 
 func (a *API) GetHealth(w http.ResponseWriter, r *http.Request) {
@@ -48,3 +53,15 @@ func (a *API) GetMetrics(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(metrics)
 }
+
+func (a *API) GetSpans(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	a.logger.Trace().Msg("Get spans")
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(telemetry.Flush())
+}
@@ -235,7 +235,7 @@ func (a *API) PostFiles(w http.ResponseWriter, r *http.Request, params PostFiles
 			paths = append(paths, EntryInfo{
 				Path: filePath,
 				Name: filepath.Base(filePath),
-				Type: File,
+				Type: EntryInfoTypeFile,
 			})
 		}
 
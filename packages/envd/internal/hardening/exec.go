@@ -0,0 +1,93 @@
+package hardening
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// ExecCommand is the first arg of envd started in the exec mode.
+	ExecCommand = "hardened-exec"
+
+	// cannotExecuteCode is the exit code of the command that couldn't be executed, same as in the shells.
+	cannotExecuteCode = 126
+)
+
+// IsExec returns true if envd was started in the exec mode by the hardening.
+func IsExec() bool {
+	return len(os.Args) > 1 && os.Args[1] == ExecCommand
+}
+
+// Exec applies the restrictions from the args and executes the command, it returns only if the command couldn't be executed.
+func Exec() {
+	err := execHardened(os.Args[2:])
+
+	fmt.Fprintf(os.Stderr, "error executing hardened command: %v\n", err)
+
+	os.Exit(cannotExecuteCode)
+}
+
+func execHardened(args []string) error {
+	flags := flag.NewFlagSet(ExecCommand, flag.ContinueOnError)
+
+	uid := flags.Int("uid", -1, "user of the command")
+	gid := flags.Int("gid", -1, "group of the command")
+	noNewPrivileges := flags.Bool("no-new-privileges", false, "the command can't gain privileges")
+	deny := flags.String("deny", "", "comma-separated syscalls denied to the command")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	// The path is followed by the args of the command, including its name
+	if flags.NArg() < 2 {
+		return errors.New("missing command")
+	}
+
+	path, argv := flags.Arg(0), flags.Args()[1:]
+
+	// no_new_privs and the seccomp filter are set on the thread, the command is executed from the same thread
+	runtime.LockOSThread()
+
+	if *noNewPrivileges {
+		err = unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0)
+		if err != nil {
+			return fmt.Errorf("error setting no_new_privs: %w", err)
+		}
+	}
+
+	if *deny != "" {
+		filter, err := newFilter(strings.Split(*deny, ","))
+		if err != nil {
+			return err
+		}
+
+		err = installFilter(filter)
+		if err != nil {
+			return fmt.Errorf("error installing seccomp filter: %w", err)
+		}
+	}
+
+	if *gid >= 0 {
+		err = unix.Setresgid(*gid, *gid, *gid)
+		if err != nil {
+			return fmt.Errorf("error setting group %d: %w", *gid, err)
+		}
+	}
+
+	if *uid >= 0 {
+		err = unix.Setresuid(*uid, *uid, *uid)
+		if err != nil {
+			return fmt.Errorf("error setting user %d: %w", *uid, err)
+		}
+	}
+
+	return unix.Exec(path, argv, os.Environ())
+}
@@ -0,0 +1,148 @@
+// Package hardening enforces the hardening policy of the template on the processes started by envd.
+// The processes are started through the envd binary in the exec mode, it sets no_new_privs and installs the seccomp filter
+// on itself, drops the privileges to the user of the process and executes the command, which inherits the restrictions.
+package hardening
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/sys/unix"
+)
+
+// self is the envd binary, the path is resolved in the forked child, so it's envd even if the binary was replaced.
+const self = "/proc/self/exe"
+
+// Policy is the hardening of the processes started after it's set.
+type Policy struct {
+	NoNewPrivileges bool
+	ReadOnlyPaths   []string
+	DeniedSyscalls  []string
+}
+
+type Hardening struct {
+	logger *zerolog.Logger
+
+	mu              sync.RWMutex
+	noNewPrivileges bool
+	deniedSyscalls  []string
+	readOnly        map[string]bool
+}
+
+func New(l *zerolog.Logger) *Hardening {
+	return &Hardening{
+		logger:   l,
+		readOnly: make(map[string]bool),
+	}
+}
+
+// Set replaces the policy of the new processes, the paths already mounted read-only stay read-only.
+func (h *Hardening) Set(policy Policy) error {
+	// The filter is built only to validate the syscalls, the exec mode builds it again for each process
+	_, err := newFilter(policy.DeniedSyscalls)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, path := range policy.ReadOnlyPaths {
+		if h.readOnly[path] {
+			continue
+		}
+
+		err = remountReadOnly(path)
+		if err != nil {
+			return fmt.Errorf("error mounting '%s' read-only: %w", path, err)
+		}
+
+		h.readOnly[path] = true
+	}
+
+	h.noNewPrivileges = policy.NoNewPrivileges
+	h.deniedSyscalls = policy.DeniedSyscalls
+
+	h.logger.Info().
+		Bool("no_new_privileges", policy.NoNewPrivileges).
+		Strs("read_only_paths", policy.ReadOnlyPaths).
+		Strs("denied_syscalls", policy.DeniedSyscalls).
+		Msg("Hardening policy set")
+
+	return nil
+}
+
+// Apply changes the command to be started through envd in the exec mode, the command is unchanged without the policy.
+// It must be called after the credentials of the command are set, they are applied by envd after the restrictions.
+func (h *Hardening) Apply(cmd *exec.Cmd) {
+	if h == nil {
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if !h.noNewPrivileges && len(h.deniedSyscalls) == 0 {
+		return
+	}
+
+	args := []string{self, ExecCommand}
+
+	// The seccomp filter can be installed without no_new_privs only with CAP_SYS_ADMIN, so envd drops the privileges itself
+	if cmd.SysProcAttr != nil && cmd.SysProcAttr.Credential != nil {
+		args = append(args,
+			"-uid", strconv.FormatUint(uint64(cmd.SysProcAttr.Credential.Uid), 10),
+			"-gid", strconv.FormatUint(uint64(cmd.SysProcAttr.Credential.Gid), 10),
+		)
+
+		cmd.SysProcAttr.Credential = nil
+	}
+
+	if h.noNewPrivileges {
+		args = append(args, "-no-new-privileges")
+	}
+
+	if len(h.deniedSyscalls) > 0 {
+		args = append(args, "-deny", strings.Join(h.deniedSyscalls, ","))
+	}
+
+	args = append(args, "--", cmd.Path)
+	args = append(args, cmd.Args...)
+
+	cmd.Path = self
+	cmd.Args = args
+}
+
+// remountReadOnly bind mounts the path on itself and remounts the bind mount read-only, the missing paths are skipped.
+func remountReadOnly(path string) error {
+	var stat unix.Statfs_t
+
+	err := unix.Statfs(path, &stat)
+	if err != nil {
+		if err == unix.ENOENT {
+			return nil
+		}
+
+		return err
+	}
+
+	if stat.Flags&unix.ST_RDONLY != 0 {
+		return nil
+	}
+
+	err = unix.Mount(path, path, "", unix.MS_BIND|unix.MS_REC, "")
+	if err != nil {
+		return fmt.Errorf("error bind mounting: %w", err)
+	}
+
+	err = unix.Mount("", path, "", unix.MS_BIND|unix.MS_REMOUNT|unix.MS_RDONLY, "")
+	if err != nil {
+		return fmt.Errorf("error remounting read-only: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package hardening
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// The offsets of the fields in the seccomp_data passed to the filter.
+	seccompDataNr   = 0
+	seccompDataArch = 4
+
+	// noSyscall marks the syscalls that don't exist on the architecture, there is nothing to deny.
+	noSyscall = -1
+)
+
+// syscalls are the numbers of the syscalls that can be denied on all the architectures.
+var syscalls = map[string]int{
+	"acct":              unix.SYS_ACCT,
+	"add_key":           unix.SYS_ADD_KEY,
+	"adjtimex":          unix.SYS_ADJTIMEX,
+	"bpf":               unix.SYS_BPF,
+	"chroot":            unix.SYS_CHROOT,
+	"clock_adjtime":     unix.SYS_CLOCK_ADJTIME,
+	"clock_settime":     unix.SYS_CLOCK_SETTIME,
+	"delete_module":     unix.SYS_DELETE_MODULE,
+	"fanotify_init":     unix.SYS_FANOTIFY_INIT,
+	"finit_module":      unix.SYS_FINIT_MODULE,
+	"init_module":       unix.SYS_INIT_MODULE,
+	"io_uring_enter":    unix.SYS_IO_URING_ENTER,
+	"io_uring_register": unix.SYS_IO_URING_REGISTER,
+	"io_uring_setup":    unix.SYS_IO_URING_SETUP,
+	"kexec_file_load":   unix.SYS_KEXEC_FILE_LOAD,
+	"kexec_load":        unix.SYS_KEXEC_LOAD,
+	"keyctl":            unix.SYS_KEYCTL,
+	"lookup_dcookie":    unix.SYS_LOOKUP_DCOOKIE,
+	"memfd_create":      unix.SYS_MEMFD_CREATE,
+	"mknodat":           unix.SYS_MKNODAT,
+	"mount":             unix.SYS_MOUNT,
+	"move_pages":        unix.SYS_MOVE_PAGES,
+	"name_to_handle_at": unix.SYS_NAME_TO_HANDLE_AT,
+	"nfsservctl":        unix.SYS_NFSSERVCTL,
+	"open_by_handle_at": unix.SYS_OPEN_BY_HANDLE_AT,
+	"perf_event_open":   unix.SYS_PERF_EVENT_OPEN,
+	"personality":       unix.SYS_PERSONALITY,
+	"pivot_root":        unix.SYS_PIVOT_ROOT,
+	"process_vm_readv":  unix.SYS_PROCESS_VM_READV,
+	"process_vm_writev": unix.SYS_PROCESS_VM_WRITEV,
+	"ptrace":            unix.SYS_PTRACE,
+	"quotactl":          unix.SYS_QUOTACTL,
+	"reboot":            unix.SYS_REBOOT,
+	"request_key":       unix.SYS_REQUEST_KEY,
+	"setdomainname":     unix.SYS_SETDOMAINNAME,
+	"sethostname":       unix.SYS_SETHOSTNAME,
+	"setns":             unix.SYS_SETNS,
+	"settimeofday":      unix.SYS_SETTIMEOFDAY,
+	"swapoff":           unix.SYS_SWAPOFF,
+	"swapon":            unix.SYS_SWAPON,
+	"syslog":            unix.SYS_SYSLOG,
+	"umount2":           unix.SYS_UMOUNT2,
+	"unshare":           unix.SYS_UNSHARE,
+	"userfaultfd":       unix.SYS_USERFAULTFD,
+	"vhangup":           unix.SYS_VHANGUP,
+}
+
+func syscallNumber(name string) (int, error) {
+	if nr, ok := syscalls[name]; ok {
+		return nr, nil
+	}
+
+	if nr, ok := archSyscalls[name]; ok {
+		return nr, nil
+	}
+
+	return 0, fmt.Errorf("syscall '%s' can't be denied", name)
+}
+
+// newFilter returns the seccomp filter denying the syscalls with EPERM, the syscalls of the other ABIs are denied too,
+// so the filter can't be bypassed by calling the same syscall with a different number.
+func newFilter(denied []string) ([]unix.SockFilter, error) {
+	var numbers []uint32
+
+	for _, name := range denied {
+		nr, err := syscallNumber(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if nr != noSyscall {
+			numbers = append(numbers, uint32(nr))
+		}
+	}
+
+	// The jumps to the deny instruction can't be longer than 255 instructions
+	if len(numbers) > 250 {
+		return nil, fmt.Errorf("too many denied syscalls (%d)", len(numbers))
+	}
+
+	deny := bpfStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ERRNO|(uint32(unix.EPERM)&unix.SECCOMP_RET_DATA))
+
+	filter := []unix.SockFilter{
+		bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArch),
+		bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, auditArch, 1, 0),
+		deny,
+		bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataNr),
+	}
+
+	if abiMask != 0 {
+		filter = append(filter, bpfJump(unix.BPF_JMP|unix.BPF_JGE|unix.BPF_K, abiMask, uint8(len(numbers)+1), 0))
+	}
+
+	for i, nr := range numbers {
+		filter = append(filter, bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, nr, uint8(len(numbers)-i), 0))
+	}
+
+	filter = append(filter,
+		bpfStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW),
+		deny,
+	)
+
+	return filter, nil
+}
+
+func installFilter(filter []unix.SockFilter) error {
+	program := unix.SockFprog{
+		Len:    uint16(len(filter)),
+		Filter: &filter[0],
+	}
+
+	return unix.Prctl(unix.PR_SET_SECCOMP, unix.SECCOMP_MODE_FILTER, uintptr(unsafe.Pointer(&program)), 0, 0)
+}
+
+func bpfStmt(code uint16, k uint32) unix.SockFilter {
+	return unix.SockFilter{Code: code, K: k}
+}
+
+func bpfJump(code uint16, k uint32, jt, jf uint8) unix.SockFilter {
+	return unix.SockFilter{Code: code, Jt: jt, Jf: jf, K: k}
+}
@@ -0,0 +1,18 @@
+package hardening
+
+import "golang.org/x/sys/unix"
+
+const (
+	auditArch = unix.AUDIT_ARCH_X86_64
+	// abiMask is set in the numbers of the x32 syscalls, they are denied as the other ABIs.
+	abiMask = 0x40000000
+)
+
+// archSyscalls are the syscalls that can be denied and exist only on some architectures.
+var archSyscalls = map[string]int{
+	"ioperm":     unix.SYS_IOPERM,
+	"iopl":       unix.SYS_IOPL,
+	"mknod":      unix.SYS_MKNOD,
+	"modify_ldt": unix.SYS_MODIFY_LDT,
+	"uselib":     unix.SYS_USELIB,
+}
@@ -0,0 +1,17 @@
+package hardening
+
+import "golang.org/x/sys/unix"
+
+const (
+	auditArch = unix.AUDIT_ARCH_AARCH64
+	abiMask   = 0
+)
+
+// archSyscalls are the syscalls that can be denied and exist only on some architectures.
+var archSyscalls = map[string]int{
+	"ioperm":     noSyscall,
+	"iopl":       noSyscall,
+	"mknod":      noSyscall,
+	"modify_ldt": noSyscall,
+	"uselib":     noSyscall,
+}
@@ -0,0 +1,112 @@
+package hardening
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	denyResult  = unix.SECCOMP_RET_ERRNO | (uint32(unix.EPERM) & unix.SECCOMP_RET_DATA)
+	allowResult = uint32(unix.SECCOMP_RET_ALLOW)
+)
+
+// runFilter runs the filter for the syscall like the kernel and returns the result and the index of the return instruction,
+// only the instructions used by newFilter are supported.
+func runFilter(t *testing.T, filter []unix.SockFilter, arch, nr uint32) (uint32, int) {
+	var acc uint32
+
+	for pc := 0; pc < len(filter); pc++ {
+		ins := filter[pc]
+
+		switch ins.Code {
+		case unix.BPF_LD | unix.BPF_W | unix.BPF_ABS:
+			switch ins.K {
+			case seccompDataArch:
+				acc = arch
+			case seccompDataNr:
+				acc = nr
+			default:
+				t.Fatalf("unexpected load of offset %d at %d", ins.K, pc)
+			}
+		case unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K:
+			matched := acc == ins.K
+			if ins.Code&unix.BPF_JGE == unix.BPF_JGE {
+				matched = acc >= ins.K
+			}
+
+			if matched {
+				pc += int(ins.Jt)
+			} else {
+				pc += int(ins.Jf)
+			}
+
+			require.Less(t, pc+1, len(filter), "jump at %d is out of the filter", pc)
+		case unix.BPF_RET | unix.BPF_K:
+			return ins.K, pc
+		default:
+			t.Fatalf("unexpected instruction %#x at %d", ins.Code, pc)
+		}
+	}
+
+	t.Fatal("filter ended without return")
+
+	return 0, 0
+}
+
+func TestNewFilterJumps(t *testing.T) {
+	tests := []struct {
+		name   string
+		denied []string
+	}{
+		{name: "no syscalls", denied: nil},
+		{name: "one syscall", denied: []string{"ptrace"}},
+		{name: "many syscalls", denied: []string{"ptrace", "mount", "bpf", "keyctl", "unshare", "setns", "reboot"}},
+	}
+
+	allowed := []uint32{unix.SYS_READ, unix.SYS_WRITE, unix.SYS_GETPID}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := newFilter(tt.denied)
+			require.NoError(t, err)
+
+			allowIndex, denyIndex := len(filter)-2, len(filter)-1
+
+			require.Equal(t, allowResult, filter[allowIndex].K)
+			require.Equal(t, denyResult, filter[denyIndex].K)
+
+			for _, name := range tt.denied {
+				nr, err := syscallNumber(name)
+				require.NoError(t, err)
+
+				result, index := runFilter(t, filter, auditArch, uint32(nr))
+				require.Equal(t, denyResult, result, "syscall %s", name)
+				require.Equal(t, denyIndex, index, "syscall %s", name)
+			}
+
+			for _, nr := range allowed {
+				result, index := runFilter(t, filter, auditArch, nr)
+				require.Equal(t, allowResult, result, "syscall %d", nr)
+				require.Equal(t, allowIndex, index, "syscall %d", nr)
+			}
+
+			// The syscalls of the other architectures are denied before the syscall number is checked
+			result, index := runFilter(t, filter, auditArch+1, unix.SYS_READ)
+			require.Equal(t, denyResult, result)
+			require.Equal(t, 2, index)
+
+			if abiMask != 0 {
+				result, index := runFilter(t, filter, auditArch, abiMask|unix.SYS_READ)
+				require.Equal(t, denyResult, result)
+				require.Equal(t, denyIndex, index)
+			}
+		})
+	}
+}
+
+func TestNewFilterUnknownSyscall(t *testing.T) {
+	_, err := newFilter([]string{"read"})
+	require.Error(t, err)
+}
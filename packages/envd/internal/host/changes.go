@@ -0,0 +1,148 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+const rootPath = "/"
+
+type BlockRange struct {
+	Start int64
+	Count int64
+}
+
+type ChangedEntry struct {
+	Path  string
+	IsDir bool
+}
+
+// FlushFilesystem writes all the pending filesystem changes to the block devices,
+// so the host sees every change made in the sandbox up to this point.
+func FlushFilesystem() {
+	unix.Sync()
+}
+
+// ResolveChangedBlocks walks the root filesystem and returns the files and directories
+// that have any of their data in the changed block ranges of the root block device.
+//
+// Only the data extents are checked, so entries that were changed only in their metadata (e.g. permissions)
+// or that were already removed are not returned.
+func ResolveChangedBlocks(ctx context.Context, blockSize int64, ranges []BlockRange) ([]ChangedEntry, error) {
+	if blockSize <= 0 {
+		return nil, fmt.Errorf("invalid block size %d", blockSize)
+	}
+
+	changed := make([]Extent, 0, len(ranges))
+	for _, r := range ranges {
+		changed = append(changed, Extent{
+			Offset: r.Start * blockSize,
+			Length: r.Count * blockSize,
+		})
+	}
+
+	sort.Slice(changed, func(i, j int) bool {
+		return changed[i].Offset < changed[j].Offset
+	})
+
+	entries := make([]ChangedEntry, 0)
+
+	if len(changed) == 0 {
+		return entries, nil
+	}
+
+	rootDevice, err := deviceID(rootPath)
+	if err != nil {
+		return nil, fmt.Errorf("error getting root device: %w", err)
+	}
+
+	err = filepath.WalkDir(rootPath, func(path string, d fs.DirEntry, err error) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// Skip the entries we cannot read, the rest of the tree is still useful.
+		if err != nil {
+			if d != nil && d.IsDir() {
+				return fs.SkipDir
+			}
+
+			return nil
+		}
+
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+
+		if d.IsDir() {
+			device, err := deviceID(path)
+			if err != nil {
+				return fs.SkipDir
+			}
+
+			// Only the root filesystem is backed by the root block device.
+			if device != rootDevice {
+				return fs.SkipDir
+			}
+		}
+
+		f, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NOATIME|syscall.O_NOFOLLOW, 0)
+		if err != nil {
+			return nil
+		}
+		defer f.Close()
+
+		extents, err := fileExtents(f)
+		if err != nil {
+			return nil
+		}
+
+		if overlaps(changed, extents) {
+			entries = append(entries, ChangedEntry{
+				Path:  path,
+				IsDir: d.IsDir(),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking filesystem: %w", err)
+	}
+
+	return entries, nil
+}
+
+// overlaps checks if any of the extents overlaps with the sorted changed extents.
+func overlaps(changed []Extent, extents []Extent) bool {
+	for _, e := range extents {
+		// Find the first changed extent that ends after the start of the extent.
+		i := sort.Search(len(changed), func(i int) bool {
+			return changed[i].Offset+changed[i].Length > e.Offset
+		})
+
+		if i < len(changed) && changed[i].Offset < e.Offset+e.Length {
+			return true
+		}
+	}
+
+	return false
+}
+
+func deviceID(path string) (uint64, error) {
+	var stat unix.Stat_t
+
+	err := unix.Lstat(path, &stat)
+	if err != nil {
+		return 0, err
+	}
+
+	return stat.Dev, nil
+}
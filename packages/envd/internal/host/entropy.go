@@ -0,0 +1,40 @@
+package host
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const randomDevicePath = "/dev/urandom"
+
+// AddEntropy mixes the entropy from the host into the kernel entropy pool and reseeds the kernel RNG.
+// All sandboxes restored from the same snapshot start with the identical RNG state, so it has to be reseeded on each restore.
+func AddEntropy(entropy []byte) error {
+	device, err := os.OpenFile(randomDevicePath, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open random device: %w", err)
+	}
+	defer device.Close()
+
+	// struct rand_pool_info { int entropy_count; int buf_size; __u32 buf[]; }
+	info := make([]byte, 8+len(entropy))
+	binary.NativeEndian.PutUint32(info[0:4], uint32(len(entropy)*8))
+	binary.NativeEndian.PutUint32(info[4:8], uint32(len(entropy)))
+	copy(info[8:], entropy)
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, device.Fd(), unix.RNDADDENTROPY, uintptr(unsafe.Pointer(&info[0])))
+	if errno != 0 {
+		return fmt.Errorf("failed to add entropy: %w", errno)
+	}
+
+	_, _, errno = unix.Syscall(unix.SYS_IOCTL, device.Fd(), unix.RNDRESEEDCRNG, 0)
+	if errno != 0 {
+		return fmt.Errorf("failed to reseed the RNG: %w", errno)
+	}
+
+	return nil
+}
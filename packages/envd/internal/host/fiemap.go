@@ -0,0 +1,86 @@
+package host
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// https://www.kernel.org/doc/html/latest/filesystems/fiemap.html
+const (
+	fsIocFiemap = 0xC020660B
+
+	fiemapExtentLast     = 0x00000001
+	fiemapExtentUnknown  = 0x00000002
+	fiemapExtentDelalloc = 0x00000004
+
+	fiemapExtentsPerCall = 64
+)
+
+type fiemapExtent struct {
+	Logical    uint64
+	Physical   uint64
+	Length     uint64
+	reserved64 [2]uint64
+	Flags      uint32
+	reserved   [3]uint32
+}
+
+type fiemap struct {
+	Start         uint64
+	Length        uint64
+	Flags         uint32
+	MappedExtents uint32
+	ExtentCount   uint32
+	reserved      uint32
+	Extents       [fiemapExtentsPerCall]fiemapExtent
+}
+
+// Extent is a range of bytes on the underlying block device.
+type Extent struct {
+	Offset int64
+	Length int64
+}
+
+// fileExtents returns the physical extents of the file on the block device.
+// Extents that don't have a known location on the device yet (e.g. delayed allocation) are skipped.
+func fileExtents(f *os.File) ([]Extent, error) {
+	var extents []Extent
+
+	m := &fiemap{}
+
+	var start uint64
+	for {
+		m.Start = start
+		m.Length = math.MaxUint64
+		m.ExtentCount = fiemapExtentsPerCall
+		m.MappedExtents = 0
+
+		_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFiemap, uintptr(unsafe.Pointer(m)))
+		if errno != 0 {
+			return nil, fmt.Errorf("fiemap ioctl failed: %w", errno)
+		}
+
+		if m.MappedExtents == 0 {
+			return extents, nil
+		}
+
+		for _, e := range m.Extents[:m.MappedExtents] {
+			if e.Flags&(fiemapExtentUnknown|fiemapExtentDelalloc) == 0 {
+				extents = append(extents, Extent{
+					Offset: int64(e.Physical),
+					Length: int64(e.Length),
+				})
+			}
+
+			if e.Flags&fiemapExtentLast != 0 {
+				return extents, nil
+			}
+
+			start = e.Logical + e.Length
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package host
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+)
+
+const dropCachesPath = "/proc/sys/vm/drop_caches"
+
+// ReleaseMemory returns the memory freed by envd to the kernel (via madvise) and drops the page cache,
+// so less of the stale data is in the memory when the sandbox is snapshotted.
+func ReleaseMemory() error {
+	debug.FreeOSMemory()
+
+	err := os.WriteFile(dropCachesPath, []byte("3"), 0)
+	if err != nil {
+		return fmt.Errorf("failed to drop caches: %w", err)
+	}
+
+	return nil
+}
@@ -1,19 +1,52 @@
 package host
 
 import (
+	"cmp"
 	"math"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
 	"github.com/shirou/gopsutil/v4/mem"
+	"github.com/shirou/gopsutil/v4/process"
 )
 
+const (
+	// maxReportedProcesses is the number of the processes using the most resources included in the metrics.
+	maxReportedProcesses = 10
+
+	maxReportedCmdLength = 256
+
+	diskPath = "/"
+)
+
+var (
+	// The processes are kept between the calls, the CPU usage of a process is computed since the last call.
+	processesMu sync.Mutex
+	processes   = make(map[int32]*process.Process)
+)
+
+type ProcessMetrics struct {
+	PID            int32   `json:"pid"`
+	Cmd            string  `json:"cmd"`
+	CPUUsedPercent float32 `json:"cpu_used_pct"` // Percent of one CPU core rounded to 2 decimal places
+	MemRSSMiB      uint64  `json:"mem_rss_mib"`  // Resident memory in MiB
+	OpenFDs        int32   `json:"open_fds"`
+}
+
 type Metrics struct {
 	Timestamp      int64   `json:"ts"`            // Unix Timestamp in UTC
 	CPUCount       uint32  `json:"cpu_count"`     // Total CPU cores
 	CPUUsedPercent float32 `json:"cpu_used_pct"`  // Percent rounded to 2 decimal places
 	MemTotalMiB    uint64  `json:"mem_total_mib"` // Total virtual memory in MiB
 	MemUsedMiB     uint64  `json:"mem_used_mib"`  // Used virtual memory in MiB
+	DiskTotalMiB   uint64  `json:"disk_total_mib"`
+	DiskUsedMiB    uint64  `json:"disk_used_mib"`
+	OpenFDs        int64   `json:"open_fds"` // Open file descriptors of all processes
+	// The processes using the most CPU and memory
+	Processes []ProcessMetrics `json:"processes,omitempty"`
 }
 
 func GetMetrics() (*Metrics, error) {
@@ -35,17 +68,109 @@ func GetMetrics() (*Metrics, error) {
 		return nil, err
 	}
 
-	cpuUsedPct := cpuUsedPcts[0]
-	cpuUsedPctRounded := float32(cpuUsedPct)
-	if cpuUsedPct > 0 {
-		cpuUsedPctRounded = float32(math.Round(cpuUsedPct*100) / 100)
+	d, err := disk.Usage(diskPath)
+	if err != nil {
+		return nil, err
 	}
 
+	procs, openFDs := getProcessMetrics()
+
 	return &Metrics{
 		Timestamp:      time.Now().UTC().Unix(),
 		CPUCount:       uint32(cpuTotal),
-		CPUUsedPercent: cpuUsedPctRounded,
+		CPUUsedPercent: roundPercent(cpuUsedPcts[0]),
 		MemUsedMiB:     memUsedMiB,
 		MemTotalMiB:    memTotalMiB,
+		DiskTotalMiB:   d.Total / 1024 / 1024,
+		DiskUsedMiB:    d.Used / 1024 / 1024,
+		OpenFDs:        openFDs,
+		Processes:      procs,
 	}, nil
 }
+
+func roundPercent(pct float64) float32 {
+	if pct <= 0 {
+		return float32(pct)
+	}
+
+	return float32(math.Round(pct*100) / 100)
+}
+
+// getProcessMetrics returns the processes using the most resources and the open file descriptors of all processes.
+// The processes that exit while they are read are skipped.
+func getProcessMetrics() ([]ProcessMetrics, int64) {
+	pids, err := process.Pids()
+	if err != nil {
+		return nil, 0
+	}
+
+	processesMu.Lock()
+	defer processesMu.Unlock()
+
+	running := make(map[int32]*process.Process, len(pids))
+	result := make([]ProcessMetrics, 0, len(pids))
+
+	var openFDs int64
+
+	for _, pid := range pids {
+		p, ok := processes[pid]
+		if !ok {
+			p, err = process.NewProcess(pid)
+			if err != nil {
+				continue
+			}
+		}
+
+		running[pid] = p
+
+		// The first call for the process returns zero, the usage is since the last call
+		cpuPct, err := p.Percent(0)
+		if err != nil {
+			continue
+		}
+
+		memInfo, err := p.MemoryInfo()
+		if err != nil {
+			continue
+		}
+
+		fds, err := p.NumFDs()
+		if err == nil {
+			openFDs += int64(fds)
+		}
+
+		cmd, err := p.Cmdline()
+		if err != nil || cmd == "" {
+			// The kernel threads have no command line
+			cmd, _ = p.Name()
+		}
+
+		if len(cmd) > maxReportedCmdLength {
+			cmd = cmd[:maxReportedCmdLength]
+		}
+
+		result = append(result, ProcessMetrics{
+			PID:            pid,
+			Cmd:            cmd,
+			CPUUsedPercent: roundPercent(cpuPct),
+			MemRSSMiB:      memInfo.RSS / 1024 / 1024,
+			OpenFDs:        fds,
+		})
+	}
+
+	// The exited processes are forgotten
+	processes = running
+
+	slices.SortFunc(result, func(a, b ProcessMetrics) int {
+		return cmp.Or(
+			cmp.Compare(b.CPUUsedPercent, a.CPUUsedPercent),
+			cmp.Compare(b.MemRSSMiB, a.MemRSSMiB),
+		)
+	})
+
+	if len(result) > maxReportedProcesses {
+		result = result[:maxReportedProcesses]
+	}
+
+	return result, openFDs
+}
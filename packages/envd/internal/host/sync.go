@@ -1,40 +1,63 @@
 package host
 
 import (
-	"context"
 	"fmt"
-	"os/exec"
+	"os"
 	"sync"
 	"time"
+
+	"golang.org/x/sys/unix"
 )
 
 var syncingLock sync.RWMutex
 
-const syncTimeout = 2 * time.Second
+// ptpDevicePath is the PTP device backed by the KVM clock of the host.
+const ptpDevicePath = "/dev/ptp0"
 
-func updateClock() error {
-	ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
-	defer cancel()
+// readPTPClock reads the time of the host from the PTP device.
+func readPTPClock() (unix.Timespec, error) {
+	device, err := os.Open(ptpDevicePath)
+	if err != nil {
+		return unix.Timespec{}, fmt.Errorf("failed to open PTP device: %w", err)
+	}
+	defer device.Close()
 
-	// The chronyc -a makestep is not immediately stepping the clock
-	err := exec.CommandContext(ctx, "/usr/bin/bash", "-c", "/usr/bin/date -s @$(/usr/sbin/phc_ctl /dev/ptp0 get | cut -d' ' -f5)").Run()
+	// The dynamic POSIX clock ID of the open character device (FD_TO_CLOCKID in the kernel)
+	clockID := int32((^device.Fd())<<3 | 3)
+
+	var ts unix.Timespec
+	err = unix.ClockGettime(clockID, &ts)
 	if err != nil {
-		return fmt.Errorf("failed to update clock: %w", err)
+		return unix.Timespec{}, fmt.Errorf("failed to read PTP clock: %w", err)
 	}
 
-	return nil
+	return ts, nil
 }
 
-func Sync() error {
+// Sync sets the wall clock to the time of the host, the clock is stale after the sandbox is restored from the snapshot.
+// The time is read from the PTP device and the hostTime (if not nil) is used only if the device is not available.
+// It returns the correction applied to the clock.
+func Sync(hostTime *time.Time) (time.Duration, error) {
 	syncingLock.Lock()
 	defer syncingLock.Unlock()
 
-	err := updateClock()
+	ts, err := readPTPClock()
+	if err != nil {
+		if hostTime == nil {
+			return 0, fmt.Errorf("failed to sync clock: %w", err)
+		}
+
+		ts = unix.NsecToTimespec(hostTime.UnixNano())
+	}
+
+	drift := time.Unix(ts.Unix()).Sub(time.Now())
+
+	err = unix.ClockSettime(unix.CLOCK_REALTIME, &ts)
 	if err != nil {
-		return fmt.Errorf("failed to sync clock: %w", err)
+		return 0, fmt.Errorf("failed to set clock: %w", err)
 	}
 
-	return nil
+	return drift, nil
 }
 
 func WaitForSync() {
@@ -65,6 +65,8 @@ func NewUnaryLogInterceptor(logger *zerolog.Logger) connect.UnaryInterceptorFunc
 				Str("method", DefaultHTTPMethod+" "+req.Spec().Procedure).
 				Str(string(OperationIDKey), ctx.Value(OperationIDKey).(string))
 
+			l = WithRequestFields(l, req.Header())
+
 			if err != nil {
 				l = l.Int("error_code", int(connect.CodeOf(err)))
 			}
@@ -103,6 +105,8 @@ func LogServerStreamWithoutEvents[T any, R any](
 		Str("method", DefaultHTTPMethod+" "+req.Spec().Procedure).
 		Str(string(OperationIDKey), ctx.Value(OperationIDKey).(string))
 
+	l = WithRequestFields(l, req.Header())
+
 	if req != nil {
 		l = l.Interface("request", req.Any())
 	}
@@ -115,6 +119,8 @@ func LogServerStreamWithoutEvents[T any, R any](
 		Str("method", DefaultHTTPMethod+" "+req.Spec().Procedure).
 		Str(string(OperationIDKey), ctx.Value(OperationIDKey).(string))
 
+	logEvent = WithRequestFields(logEvent, req.Header())
+
 	if err != nil {
 		logEvent = logEvent.Int("error_code", int(connect.CodeOf(err)))
 	} else {
@@ -134,7 +140,7 @@ func LogClientStreamWithoutEvents[T any, R any](
 ) (*connect.Response[R], error) {
 	ctx = AddRequestIDToContext(ctx)
 
-	logger.Debug().
+	WithRequestFields(logger.Debug(), stream.RequestHeader()).
 		Str("method", DefaultHTTPMethod+" "+stream.Spec().Procedure).
 		Str(string(OperationIDKey), ctx.Value(OperationIDKey).(string)).
 		Msg(fmt.Sprintf("%s (client stream start)", formatMethod(stream.Spec().Procedure)))
@@ -145,6 +151,8 @@ func LogClientStreamWithoutEvents[T any, R any](
 		Str("method", DefaultHTTPMethod+" "+stream.Spec().Procedure).
 		Str(string(OperationIDKey), ctx.Value(OperationIDKey).(string))
 
+	logEvent = WithRequestFields(logEvent, stream.RequestHeader())
+
 	if err != nil {
 		logEvent = logEvent.Int("error_code", int(connect.CodeOf(err)))
 	}
@@ -162,6 +170,38 @@ func LogClientStreamWithoutEvents[T any, R any](
 	return res, err
 }
 
+func LogBidiStreamWithoutEvents[T any, R any](
+	ctx context.Context,
+	logger *zerolog.Logger,
+	stream *connect.BidiStream[T, R],
+	handler func(ctx context.Context, stream *connect.BidiStream[T, R]) error,
+) error {
+	ctx = AddRequestIDToContext(ctx)
+
+	WithRequestFields(logger.Debug(), stream.RequestHeader()).
+		Str("method", DefaultHTTPMethod+" "+stream.Spec().Procedure).
+		Str(string(OperationIDKey), ctx.Value(OperationIDKey).(string)).
+		Msg(fmt.Sprintf("%s (bidi stream start)", formatMethod(stream.Spec().Procedure)))
+
+	err := handler(ctx, stream)
+
+	logEvent := getErrDebugLogEvent(logger, err).
+		Str("method", DefaultHTTPMethod+" "+stream.Spec().Procedure).
+		Str(string(OperationIDKey), ctx.Value(OperationIDKey).(string))
+
+	logEvent = WithRequestFields(logEvent, stream.RequestHeader())
+
+	if err != nil {
+		logEvent = logEvent.Int("error_code", int(connect.CodeOf(err)))
+	} else {
+		logEvent = logEvent.Interface("response", nil)
+	}
+
+	logEvent.Msg(fmt.Sprintf("%s (bidi stream end)", formatMethod(stream.Spec().Procedure)))
+
+	return err
+}
+
 // Return logger with error level if err is not nil, otherwise return logger with debug level
 func getErrDebugLogEvent(logger *zerolog.Logger, err error) *zerolog.Event {
 	if err != nil {
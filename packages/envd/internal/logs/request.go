@@ -0,0 +1,57 @@
+package logs
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// RequestIDHeader is set by the orchestrator to the ID of the API request that caused the call.
+	RequestIDHeader = "X-Request-ID"
+	RequestIDKey    = "request_id"
+	TraceIDKey      = "trace_id"
+
+	traceParentHeader = "traceparent"
+)
+
+// traceID returns the trace ID from the W3C traceparent header (version-traceid-spanid-flags).
+func traceID(header http.Header) string {
+	parts := strings.Split(header.Get(traceParentHeader), "-")
+	if len(parts) != 4 {
+		return ""
+	}
+
+	return parts[1]
+}
+
+// WithRequestFields adds the request and trace IDs propagated from the caller to the log event.
+func WithRequestFields(e *zerolog.Event, header http.Header) *zerolog.Event {
+	if requestID := header.Get(RequestIDHeader); requestID != "" {
+		e = e.Str(RequestIDKey, requestID)
+	}
+
+	if id := traceID(header); id != "" {
+		e = e.Str(TraceIDKey, id)
+	}
+
+	return e
+}
+
+// RequestLogger returns a logger with the request and trace IDs propagated from the caller.
+func RequestLogger(logger *zerolog.Logger, header http.Header) *zerolog.Logger {
+	c := logger.With()
+
+	if requestID := header.Get(RequestIDHeader); requestID != "" {
+		c = c.Str(RequestIDKey, requestID)
+	}
+
+	if id := traceID(header); id != "" {
+		c = c.Str(TraceIDKey, id)
+	}
+
+	l := c.Logger()
+
+	return &l
+}
@@ -1,6 +1,7 @@
 package permissions
 
 import (
+	"net/http"
 	"strconv"
 	"time"
 
@@ -10,7 +11,11 @@ import (
 const defaultKeepAliveInterval = 90 * time.Second
 
 func GetKeepAliveTicker[T any](req *connect.Request[T]) (*time.Ticker, func()) {
-	keepAliveIntervalHeader := req.Header().Get("Keepalive-Ping-Interval")
+	return GetKeepAliveTickerFromHeader(req.Header())
+}
+
+func GetKeepAliveTickerFromHeader(header http.Header) (*time.Ticker, func()) {
+	keepAliveIntervalHeader := header.Get("Keepalive-Ping-Interval")
 
 	var interval time.Duration
 
@@ -0,0 +1,241 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Dir is the tmpfs with the secret files, the name of the file is the name of the secret.
+	Dir = "/run/e2b/secrets"
+
+	tmpfsSize = "4m"
+)
+
+var namePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Secrets are the secrets of the sandbox set by the orchestrator on the init.
+// They are exposed as the env vars of the new processes and as the files in the tmpfs, so they are never written to the disk,
+// and they are removed before the sandbox is paused. The access to the secrets is logged.
+type Secrets struct {
+	logger *zerolog.Logger
+
+	mu      sync.RWMutex
+	values  map[string]string
+	mounted bool
+	watcher *os.File
+}
+
+func New(l *zerolog.Logger) *Secrets {
+	return &Secrets{
+		logger: l,
+	}
+}
+
+// Set replaces the secrets, the files of the removed secrets are deleted.
+func (s *Secrets) Set(values map[string]string) error {
+	for name := range values {
+		if !namePattern.MatchString(name) {
+			return fmt.Errorf("invalid secret name '%s'", name)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopWatcherLocked()
+
+	err := s.mountLocked()
+	if err != nil {
+		return err
+	}
+
+	for name := range s.values {
+		if _, ok := values[name]; ok {
+			continue
+		}
+
+		err = os.Remove(filepath.Join(Dir, name))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("error removing secret file '%s': %w", name, err)
+		}
+	}
+
+	for name, value := range values {
+		// The secrets are readable by all users same as the env vars of the processes
+		err = os.WriteFile(filepath.Join(Dir, name), []byte(value), 0o444)
+		if err != nil {
+			return fmt.Errorf("error writing secret file '%s': %w", name, err)
+		}
+	}
+
+	s.values = values
+
+	// The files are watched only after they are written, so the writes of envd are not logged as the access
+	err = s.startWatcherLocked()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to watch secret files, the access to them is not logged")
+	}
+
+	return nil
+}
+
+// Clear removes the secrets with their tmpfs, so the memory of the files is freed before the sandbox is paused.
+func (s *Secrets) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopWatcherLocked()
+
+	// The files are removed first, so their memory is freed even if the lazily unmounted tmpfs is still used by a process
+	for name := range s.values {
+		err := os.Remove(filepath.Join(Dir, name))
+		if err != nil && !os.IsNotExist(err) {
+			s.logger.Error().Err(err).Str("secret", name).Msg("Failed to remove secret file")
+		}
+	}
+
+	s.values = nil
+
+	if !s.mounted {
+		return nil
+	}
+
+	err := unix.Unmount(Dir, unix.MNT_DETACH)
+	if err != nil {
+		return fmt.Errorf("error unmounting secrets: %w", err)
+	}
+
+	s.mounted = false
+
+	return nil
+}
+
+// Env returns the secrets as the env vars of the process started by the user, the access is logged with the names of the secrets.
+func (s *Secrets) Env(consumer, username string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.values) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(s.values))
+	env := make([]string, 0, len(s.values))
+
+	for name, value := range s.values {
+		names = append(names, name)
+		env = append(env, name+"="+value)
+	}
+
+	slices.Sort(names)
+
+	s.logger.Info().
+		Str("event_type", "secret_access").
+		Str("consumer", consumer).
+		Str("username", username).
+		Strs("secrets", names).
+		Msg("Secrets exposed as env vars")
+
+	return env
+}
+
+// mountLocked mounts the tmpfs for the secret files, the secrets set by the previous init are in the already mounted tmpfs.
+func (s *Secrets) mountLocked() error {
+	if s.mounted {
+		return nil
+	}
+
+	err := os.MkdirAll(Dir, 0o755)
+	if err != nil {
+		return fmt.Errorf("error creating secrets directory: %w", err)
+	}
+
+	err = unix.Mount("tmpfs", Dir, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, "mode=0755,size="+tmpfsSize)
+	if err != nil {
+		return fmt.Errorf("error mounting tmpfs for secrets: %w", err)
+	}
+
+	s.mounted = true
+
+	return nil
+}
+
+// startWatcherLocked logs the opening of the secret files, the process opening the file is not known to inotify.
+func (s *Secrets) startWatcherLocked() error {
+	if len(s.values) == 0 {
+		return nil
+	}
+
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return fmt.Errorf("error creating inotify: %w", err)
+	}
+
+	_, err = unix.InotifyAddWatch(fd, Dir, unix.IN_OPEN)
+	if err != nil {
+		unix.Close(fd)
+
+		return fmt.Errorf("error watching secrets directory: %w", err)
+	}
+
+	// The non-blocking file is read through the runtime poller, so the read is interrupted when the watcher is closed
+	watcher := os.NewFile(uintptr(fd), "secrets-inotify")
+	s.watcher = watcher
+
+	go s.watch(watcher)
+
+	return nil
+}
+
+func (s *Secrets) stopWatcherLocked() {
+	if s.watcher == nil {
+		return
+	}
+
+	s.watcher.Close()
+	s.watcher = nil
+}
+
+func (s *Secrets) watch(watcher *os.File) {
+	buf := make([]byte, 4096)
+
+	for {
+		n, err := watcher.Read(buf)
+		if err != nil {
+			if !errors.Is(err, os.ErrClosed) {
+				s.logger.Error().Err(err).Msg("Failed to read secret files events")
+			}
+
+			return
+		}
+
+		for offset := 0; offset+unix.SizeofInotifyEvent <= n; {
+			event := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameBytes := buf[offset+unix.SizeofInotifyEvent : offset+unix.SizeofInotifyEvent+int(event.Len)]
+			offset += unix.SizeofInotifyEvent + int(event.Len)
+
+			// The name is padded with the null bytes, the events without the name are for the directory itself
+			name := strings.TrimRight(string(nameBytes), "\x00")
+			if name == "" {
+				continue
+			}
+
+			s.logger.Info().
+				Str("event_type", "secret_access").
+				Str("consumer", "file").
+				Strs("secrets", []string{name}).
+				Msg("Secret file opened")
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
 	spec "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem/filesystemconnect"
+	"github.com/e2b-dev/infra/packages/envd/internal/telemetry"
 	"github.com/e2b-dev/infra/packages/envd/internal/utils"
 )
 
@@ -21,7 +22,7 @@ func Handle(server *chi.Mux, l *zerolog.Logger) {
 		watchers: utils.NewMap[string, *FileWatcher](),
 	}
 
-	interceptors := connect.WithInterceptors(logs.NewUnaryLogInterceptor(l))
+	interceptors := connect.WithInterceptors(logs.NewUnaryLogInterceptor(l), telemetry.NewUnaryTraceInterceptor())
 
 	path, handler := spec.NewFilesystemHandler(service, interceptors)
 
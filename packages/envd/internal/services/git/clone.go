@@ -0,0 +1,96 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/git"
+)
+
+// splitCredentials removes the credentials from the HTTP URL, so they are not saved in the remote of the repository.
+func splitCredentials(rawURL string) (string, *rpc.Credentials) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return rawURL, nil
+	}
+
+	password, _ := u.User.Password()
+	credentials := &rpc.Credentials{
+		Username: u.User.Username(),
+		Password: password,
+	}
+
+	u.User = nil
+
+	return u.String(), credentials
+}
+
+func (s Service) Clone(ctx context.Context, req *connect.Request[rpc.CloneRequest]) (*connect.Response[rpc.CloneResponse], error) {
+	if req.Msg.GetUrl() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("url is required"))
+	}
+
+	cmd, err := s.newCommand(ctx, req.Msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	repoURL, urlCredentials := splitCredentials(req.Msg.GetUrl())
+
+	cmd.credentials = req.Msg.GetCredentials()
+	if cmd.credentials == nil {
+		cmd.credentials = urlCredentials
+	}
+
+	uid, gid, err := permissions.GetUserIds(cmd.user)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	path := cmd.dir
+
+	err = permissions.EnsureDirs(filepath.Dir(path), int(uid), int(gid))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error ensuring directories: %w", err))
+	}
+
+	args := []string{"clone"}
+	if req.Msg.Branch != nil {
+		args = append(args, "--branch", req.Msg.GetBranch())
+	}
+
+	if req.Msg.Depth != nil {
+		args = append(args, "--depth", strconv.FormatUint(uint64(req.Msg.GetDepth()), 10))
+	}
+
+	args = append(args, "--", repoURL, path)
+
+	// The repository directory doesn't exist yet
+	cmd.dir = filepath.Dir(path)
+
+	_, err = cmd.run(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.dir = path
+	cmd.credentials = nil
+
+	branch, err := cmd.run(ctx, "branch", "--show-current")
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rpc.CloneResponse{
+		Path:   path,
+		Branch: strings.TrimSpace(branch),
+		Commit: cmd.head(ctx),
+	}), nil
+}
@@ -0,0 +1,166 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"strings"
+	"syscall"
+
+	"connectrpc.com/connect"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/hardening"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/git"
+)
+
+const (
+	usernameEnv = "E2B_GIT_USERNAME"
+	passwordEnv = "E2B_GIT_PASSWORD"
+
+	// The helper reads the credentials from the env of the git process, so they are never written to the disk.
+	credentialHelper = `!f() { test "$1" = get || return 0; echo "username=${` + usernameEnv + `}"; echo "password=${` + passwordEnv + `}"; }; f`
+)
+
+// command runs git as the user in the directory.
+type command struct {
+	user        *user.User
+	dir         string
+	credentials *rpc.Credentials
+	env         []string
+	envs        map[string]string
+	hardening   *hardening.Hardening
+}
+
+func (s Service) newCommand(ctx context.Context, path string) (*command, error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	envs := make(map[string]string)
+	if s.envs != nil {
+		s.envs.Range(func(key string, value string) bool {
+			envs[key] = value
+
+			return true
+		})
+	}
+
+	return &command{
+		user:      u,
+		dir:       dir,
+		envs:      envs,
+		hardening: s.hardening,
+	}, nil
+}
+
+// run returns the stdout of the git command, the error contains its stderr.
+func (c *command) run(ctx context.Context, args ...string) (string, error) {
+	var fullArgs []string
+	if c.credentials != nil {
+		// The empty helper removes the configured helpers, so the credentials are not stored by them
+		fullArgs = append(fullArgs, "-c", "credential.helper=", "-c", "credential.helper="+credentialHelper)
+	}
+
+	fullArgs = append(fullArgs, args...)
+
+	cmd := exec.CommandContext(ctx, "git", fullArgs...)
+	cmd.Dir = c.dir
+
+	uid, gid, err := permissions.GetUserIds(c.user)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: &syscall.Credential{
+			Uid:         uid,
+			Gid:         gid,
+			Groups:      []uint32{gid},
+			NoSetGroups: true,
+		},
+	}
+
+	c.hardening.Apply(cmd)
+
+	env := []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + c.user.HomeDir,
+		"USER=" + c.user.Username,
+		"LOGNAME=" + c.user.Username,
+	}
+
+	for key, value := range c.envs {
+		env = append(env, key+"="+value)
+	}
+
+	// The messages are parsed, so they must not be translated, and git must not wait for the input
+	env = append(env, "LC_ALL=C", "GIT_TERMINAL_PROMPT=0")
+	env = append(env, c.env...)
+
+	if c.credentials != nil {
+		env = append(env, usernameEnv+"="+c.credentials.GetUsername(), passwordEnv+"="+c.credentials.GetPassword())
+	}
+
+	cmd.Env = env
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	if err != nil {
+		return "", commandError(args[0], stdout.String(), stderr.String(), err)
+	}
+
+	return stdout.String(), nil
+}
+
+func commandError(name, stdout, stderr string, err error) error {
+	if errors.Is(err, exec.ErrNotFound) {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("git is not installed in the sandbox"))
+	}
+
+	// Some commands like the commit print the reason of the failure to the stdout
+	msg := strings.TrimSpace(stderr)
+	if msg == "" {
+		msg = strings.TrimSpace(stdout)
+	}
+
+	if msg == "" {
+		msg = err.Error()
+	}
+
+	code := connect.CodeInternal
+
+	switch {
+	case strings.Contains(msg, "Authentication failed"),
+		strings.Contains(msg, "could not read Username"),
+		strings.Contains(msg, "could not read Password"),
+		strings.Contains(msg, "Permission denied (publickey)"):
+		code = connect.CodeUnauthenticated
+	case strings.Contains(msg, "not a git repository"),
+		strings.Contains(msg, "Please tell me who you are"),
+		strings.Contains(msg, "nothing to commit"):
+		code = connect.CodeFailedPrecondition
+	case strings.Contains(msg, "already exists and is not an empty directory"):
+		code = connect.CodeAlreadyExists
+	case strings.Contains(msg, "Repository not found"),
+		strings.Contains(msg, "does not appear to be a git repository"):
+		code = connect.CodeNotFound
+	case strings.Contains(msg, "[rejected]"):
+		code = connect.CodeAborted
+	}
+
+	return connect.NewError(code, fmt.Errorf("git %s failed: %s", name, msg))
+}
@@ -0,0 +1,91 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/git"
+)
+
+func (s Service) Commit(ctx context.Context, req *connect.Request[rpc.CommitRequest]) (*connect.Response[rpc.CommitResponse], error) {
+	if req.Msg.GetMessage() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("commit message is required"))
+	}
+
+	cmd, err := s.newCommand(ctx, req.Msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = cmd.run(ctx, append([]string{"add", "--all", "--"}, req.Msg.GetPaths()...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if author := req.Msg.GetAuthor(); author != nil {
+		cmd.env = append(cmd.env,
+			"GIT_AUTHOR_NAME="+author.GetName(),
+			"GIT_AUTHOR_EMAIL="+author.GetEmail(),
+			"GIT_COMMITTER_NAME="+author.GetName(),
+			"GIT_COMMITTER_EMAIL="+author.GetEmail(),
+		)
+	}
+
+	args := []string{"commit", "--message", req.Msg.GetMessage()}
+	if req.Msg.GetAllowEmpty() {
+		args = append(args, "--allow-empty")
+	}
+
+	_, err = cmd.run(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rpc.CommitResponse{
+		Commit: cmd.head(ctx),
+	}), nil
+}
+
+func (s Service) Push(ctx context.Context, req *connect.Request[rpc.PushRequest]) (*connect.Response[rpc.PushResponse], error) {
+	cmd, err := s.newCommand(ctx, req.Msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	remote := "origin"
+	if req.Msg.Remote != nil {
+		remote = req.Msg.GetRemote()
+	}
+
+	branch := req.Msg.GetBranch()
+	if req.Msg.Branch == nil {
+		current, err := cmd.run(ctx, "branch", "--show-current")
+		if err != nil {
+			return nil, err
+		}
+
+		branch = strings.TrimSpace(current)
+		if branch == "" {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("HEAD is detached, the branch must be specified"))
+		}
+	}
+
+	args := []string{"push"}
+	if req.Msg.GetSetUpstream() {
+		args = append(args, "--set-upstream")
+	}
+
+	args = append(args, "--", remote, branch)
+
+	cmd.credentials = req.Msg.GetCredentials()
+
+	_, err = cmd.run(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rpc.PushResponse{}), nil
+}
@@ -0,0 +1,80 @@
+package git
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/git"
+)
+
+// parseNumstat parses the output of the 'git diff --numstat -z'.
+func parseNumstat(output string) []*rpc.FileDiffStat {
+	var stats []*rpc.FileDiffStat
+
+	records := strings.Split(output, "\x00")
+
+	for i := 0; i < len(records); i++ {
+		// <added>\t<deleted>\t<path>, the path is empty for the renames and the old and new paths are the next records
+		fields := strings.SplitN(records[i], "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		path := fields[2]
+		if path == "" && i+2 < len(records) {
+			path = records[i+2]
+			i += 2
+		}
+
+		stat := &rpc.FileDiffStat{
+			Path: path,
+		}
+
+		if fields[0] == "-" && fields[1] == "-" {
+			stat.Binary = true
+		} else {
+			added, _ := strconv.ParseUint(fields[0], 10, 32)
+			deleted, _ := strconv.ParseUint(fields[1], 10, 32)
+
+			stat.AddedLines = uint32(added)
+			stat.DeletedLines = uint32(deleted)
+		}
+
+		stats = append(stats, stat)
+	}
+
+	return stats
+}
+
+func (s Service) Diff(ctx context.Context, req *connect.Request[rpc.DiffRequest]) (*connect.Response[rpc.DiffResponse], error) {
+	cmd, err := s.newCommand(ctx, req.Msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"diff", "--no-color", "--no-ext-diff"}
+	if req.Msg.GetStaged() {
+		args = append(args, "--cached")
+	}
+
+	paths := append([]string{"--"}, req.Msg.GetPaths()...)
+
+	numstat, err := cmd.run(ctx, slices.Concat(args, []string{"--numstat", "-z"}, paths)...)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := cmd.run(ctx, slices.Concat(args, paths)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rpc.DiffResponse{
+		Diff:  diff,
+		Files: parseNumstat(numstat),
+	}), nil
+}
@@ -0,0 +1,68 @@
+package git
+
+import (
+	"context"
+	"net/url"
+
+	"connectrpc.com/connect"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/hardening"
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/git"
+	spec "github.com/e2b-dev/infra/packages/envd/internal/services/spec/git/gitconnect"
+	"github.com/e2b-dev/infra/packages/envd/internal/telemetry"
+	"github.com/e2b-dev/infra/packages/envd/internal/utils"
+)
+
+type Service struct {
+	logger    *zerolog.Logger
+	envs      *utils.Map[string, string]
+	hardening *hardening.Hardening
+}
+
+func Handle(server *chi.Mux, l *zerolog.Logger, envs *utils.Map[string, string], hardening *hardening.Hardening) {
+	service := Service{
+		logger:    l,
+		envs:      envs,
+		hardening: hardening,
+	}
+
+	// The credentials are removed from the requests before the log interceptor logs them
+	interceptors := connect.WithInterceptors(logs.NewUnaryLogInterceptor(l), newRedactInterceptor(), telemetry.NewUnaryTraceInterceptor())
+
+	path, handler := spec.NewGitHandler(service, interceptors)
+
+	server.Mount(path, handler)
+}
+
+// newRedactInterceptor removes the credentials from the request after it is handled.
+func newRedactInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			res, err := next(ctx, req)
+
+			switch msg := req.Any().(type) {
+			case *rpc.CloneRequest:
+				msg.Credentials = nil
+				msg.Url = redactURL(msg.GetUrl())
+			case *rpc.PushRequest:
+				msg.Credentials = nil
+			}
+
+			return res, err
+		}
+	}
+}
+
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+
+	u.User = nil
+
+	return u.String()
+}
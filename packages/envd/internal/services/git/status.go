@@ -0,0 +1,137 @@
+package git
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/git"
+)
+
+// head returns the commit of the HEAD, it is empty if the repository has no commits yet.
+func (c *command) head(ctx context.Context) string {
+	commit, err := c.run(ctx, "rev-parse", "--verify", "--quiet", "HEAD")
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(commit)
+}
+
+func fileState(c byte) rpc.FileState {
+	switch c {
+	case '.':
+		return rpc.FileState_FILE_STATE_UNMODIFIED
+	case 'M':
+		return rpc.FileState_FILE_STATE_MODIFIED
+	case 'T':
+		return rpc.FileState_FILE_STATE_TYPE_CHANGED
+	case 'A':
+		return rpc.FileState_FILE_STATE_ADDED
+	case 'D':
+		return rpc.FileState_FILE_STATE_DELETED
+	case 'R':
+		return rpc.FileState_FILE_STATE_RENAMED
+	case 'C':
+		return rpc.FileState_FILE_STATE_COPIED
+	case 'U':
+		return rpc.FileState_FILE_STATE_UNMERGED
+	default:
+		return rpc.FileState_FILE_STATE_UNSPECIFIED
+	}
+}
+
+// parseStatus parses the output of the 'git status --porcelain=v2 --branch -z'.
+func parseStatus(output string) *rpc.StatusResponse {
+	status := &rpc.StatusResponse{}
+
+	records := strings.Split(output, "\x00")
+
+	for i := 0; i < len(records); i++ {
+		record := records[i]
+
+		switch {
+		case strings.HasPrefix(record, "# branch.oid "):
+			commit := strings.TrimPrefix(record, "# branch.oid ")
+			if commit != "(initial)" {
+				status.Commit = commit
+			}
+		case strings.HasPrefix(record, "# branch.head "):
+			branch := strings.TrimPrefix(record, "# branch.head ")
+			if branch != "(detached)" {
+				status.Branch = branch
+			}
+		case strings.HasPrefix(record, "# branch.upstream "):
+			upstream := strings.TrimPrefix(record, "# branch.upstream ")
+			status.Upstream = &upstream
+		case strings.HasPrefix(record, "# branch.ab "):
+			fields := strings.Fields(strings.TrimPrefix(record, "# branch.ab "))
+			if len(fields) == 2 {
+				ahead, _ := strconv.ParseUint(strings.TrimPrefix(fields[0], "+"), 10, 32)
+				behind, _ := strconv.ParseUint(strings.TrimPrefix(fields[1], "-"), 10, 32)
+
+				status.Ahead = uint32(ahead)
+				status.Behind = uint32(behind)
+			}
+		case strings.HasPrefix(record, "1 "):
+			// 1 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <path>
+			fields := strings.SplitN(record, " ", 9)
+			if len(fields) == 9 {
+				status.Files = append(status.Files, &rpc.FileStatus{
+					Path:     fields[8],
+					Staged:   fileState(fields[1][0]),
+					Unstaged: fileState(fields[1][1]),
+				})
+			}
+		case strings.HasPrefix(record, "2 "):
+			// 2 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <X><score> <path>, the original path is the next record
+			fields := strings.SplitN(record, " ", 10)
+			if len(fields) == 10 && i+1 < len(records) {
+				i++
+
+				original := records[i]
+
+				status.Files = append(status.Files, &rpc.FileStatus{
+					Path:         fields[9],
+					OriginalPath: &original,
+					Staged:       fileState(fields[1][0]),
+					Unstaged:     fileState(fields[1][1]),
+				})
+			}
+		case strings.HasPrefix(record, "u "):
+			// u <XY> <sub> <m1> <m2> <m3> <mW> <h1> <h2> <h3> <path>
+			fields := strings.SplitN(record, " ", 11)
+			if len(fields) == 11 {
+				status.Files = append(status.Files, &rpc.FileStatus{
+					Path:     fields[10],
+					Staged:   rpc.FileState_FILE_STATE_UNMERGED,
+					Unstaged: rpc.FileState_FILE_STATE_UNMERGED,
+				})
+			}
+		case strings.HasPrefix(record, "? "):
+			status.Files = append(status.Files, &rpc.FileStatus{
+				Path:     strings.TrimPrefix(record, "? "),
+				Staged:   rpc.FileState_FILE_STATE_UNMODIFIED,
+				Unstaged: rpc.FileState_FILE_STATE_UNTRACKED,
+			})
+		}
+	}
+
+	return status
+}
+
+func (s Service) Status(ctx context.Context, req *connect.Request[rpc.StatusRequest]) (*connect.Response[rpc.StatusResponse], error) {
+	cmd, err := s.newCommand(ctx, req.Msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := cmd.run(ctx, "status", "--porcelain=v2", "--branch", "-z")
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(parseStatus(output)), nil
+}
@@ -0,0 +1,96 @@
+// Kernel driver, the cells are read from the fd 3 and the events are written to the fd 4 as JSON lines.
+const fs = require('fs')
+const readline = require('readline')
+const util = require('util')
+const vm = require('vm')
+const { createRequire } = require('module')
+
+function emit(event) {
+  fs.writeSync(4, JSON.stringify(event) + '\n')
+}
+
+function format(args) {
+  return util.formatWithOptions({ colors: false }, ...args) + '\n'
+}
+
+function mimeBundle(value) {
+  return { 'text/plain': util.inspect(value, { colors: false }) }
+}
+
+// display(value) shows the value, display(bundle, { raw: true }) shows the MIME bundle like { 'text/html': '<b>x</b>' }.
+globalThis.display = (value, options = {}) => {
+  const data = options.raw ? value : mimeBundle(value)
+  emit({ type: 'display', data, result: false })
+}
+
+globalThis.console = new console.Console({
+  stdout: { write: (text) => emit({ type: 'stdout', text }) },
+  stderr: { write: (text) => emit({ type: 'stderr', text }) },
+})
+for (const name of ['log', 'info', 'debug']) {
+  globalThis.console[name] = (...args) => emit({ type: 'stdout', text: format(args) })
+}
+for (const name of ['warn', 'error', 'trace']) {
+  globalThis.console[name] = (...args) => emit({ type: 'stderr', text: format(args) })
+}
+
+globalThis.require = createRequire(process.cwd() + '/')
+
+// The interrupt of the idle kernel is ignored
+process.on('SIGINT', () => {})
+
+function compile(code) {
+  try {
+    return new vm.Script(code, { filename: '<cell>' })
+  } catch (e) {
+    if (!(e instanceof SyntaxError) || !/await/.test(e.message)) {
+      throw e
+    }
+
+    // The cells with the top-level await run as async functions, their declarations are local to the cell
+    return new vm.Script(`(async () => {\n${code}\n})()`, { filename: '<cell>' })
+  }
+}
+
+async function execute(code) {
+  let result = compile(code).runInThisContext({ breakOnSigint: true, displayErrors: false })
+
+  if (result instanceof Promise) {
+    result = await result
+  }
+
+  if (result !== undefined) {
+    globalThis._ = result
+    emit({ type: 'display', data: mimeBundle(result), result: true })
+  }
+}
+
+async function main() {
+  const requests = readline.createInterface({ input: fs.createReadStream(null, { fd: 3 }) })
+
+  emit({ type: 'ready' })
+
+  for await (const line of requests) {
+    const request = JSON.parse(line)
+    let status = 'ok'
+
+    try {
+      await execute(request.code)
+    } catch (e) {
+      const interrupted = e && e.code === 'ERR_SCRIPT_EXECUTION_INTERRUPTED'
+      status = interrupted ? 'interrupted' : 'error'
+
+      emit({
+        type: 'error',
+        name: (e && e.name) || 'Error',
+        value: e && e.message !== undefined ? e.message : String(e),
+        // The frames of the driver are not shown
+        traceback: ((e && e.stack) || '').split('\n').filter((l) => !/\(node:|\[eval\]/.test(l)).join('\n'),
+      })
+    }
+
+    emit({ type: 'done', status })
+  }
+}
+
+main()
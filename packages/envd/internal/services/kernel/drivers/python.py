@@ -0,0 +1,149 @@
+# Kernel driver, the cells are read from the fd 3 and the events are written to the fd 4 as JSON lines.
+import ast
+import asyncio
+import base64
+import inspect
+import json
+import os
+import signal
+import sys
+import traceback
+
+_requests = os.fdopen(3, "r", encoding="utf-8")
+_events = os.fdopen(4, "w", encoding="utf-8", buffering=1)
+
+
+def _emit(event):
+    _events.write(json.dumps(event) + "\n")
+    _events.flush()
+
+
+class _Stream:
+    def __init__(self, name):
+        self.name = name
+
+    def write(self, text):
+        if text:
+            _emit({"type": self.name, "text": text})
+        return len(text)
+
+    def flush(self):
+        pass
+
+    def isatty(self):
+        return False
+
+
+_REPR_METHODS = {
+    "text/html": "_repr_html_",
+    "text/markdown": "_repr_markdown_",
+    "text/latex": "_repr_latex_",
+    "image/svg+xml": "_repr_svg_",
+    "image/png": "_repr_png_",
+    "image/jpeg": "_repr_jpeg_",
+    "application/json": "_repr_json_",
+}
+
+
+def _mime_bundle(obj):
+    data = {"text/plain": repr(obj)}
+    for mime, method in _REPR_METHODS.items():
+        fn = getattr(obj, method, None)
+        if fn is None:
+            continue
+        try:
+            value = fn()
+        except Exception:
+            continue
+        if value is None:
+            continue
+        if isinstance(value, tuple):
+            value = value[0]
+        if isinstance(value, bytes):
+            value = base64.b64encode(value).decode("ascii")
+        elif not isinstance(value, str):
+            value = json.dumps(value)
+        data[mime] = value
+    if "_repr_mimebundle_" in dir(obj):
+        try:
+            bundle = obj._repr_mimebundle_()
+            if isinstance(bundle, tuple):
+                bundle = bundle[0]
+            for mime, value in (bundle or {}).items():
+                if isinstance(value, bytes):
+                    value = base64.b64encode(value).decode("ascii")
+                elif not isinstance(value, str):
+                    value = json.dumps(value)
+                data[mime] = value
+        except Exception:
+            pass
+    return data
+
+
+def display(*objs):
+    for obj in objs:
+        _emit({"type": "display", "data": _mime_bundle(obj), "result": False})
+
+
+_globals = {"__name__": "__main__", "__builtins__": __builtins__, "display": display}
+# The cells with the top-level await run in the same loop, so the async objects can be used in the next cells
+_loop = asyncio.new_event_loop()
+asyncio.set_event_loop(_loop)
+
+
+def _execute(code):
+    tree = ast.parse(code, "<cell>", "exec")
+    last = None
+    if tree.body and isinstance(tree.body[-1], ast.Expr):
+        last = ast.Expression(tree.body.pop().value)
+    flags = ast.PyCF_ALLOW_TOP_LEVEL_AWAIT
+    for node, mode in ((ast.Module(tree.body, type_ignores=[]), "exec"), (last, "eval")):
+        if node is None:
+            continue
+        compiled = compile(node, "<cell>", mode, flags=flags)
+        result = eval(compiled, _globals)
+        if compiled.co_flags & inspect.CO_COROUTINE:
+            result = _loop.run_until_complete(result)
+        if mode == "eval" and result is not None:
+            _globals["_"] = result
+            _emit({"type": "display", "data": _mime_bundle(result), "result": True})
+
+
+def _main():
+    sys.stdout = _Stream("stdout")
+    sys.stderr = _Stream("stderr")
+    signal.signal(signal.SIGINT, signal.default_int_handler)
+    _emit({"type": "ready"})
+
+    while True:
+        try:
+            line = _requests.readline()
+        except KeyboardInterrupt:
+            continue
+        if not line:
+            return
+        request = json.loads(line)
+        status = "ok"
+        try:
+            _execute(request["code"])
+        except KeyboardInterrupt:
+            status = "interrupted"
+            _emit({"type": "error", "name": "KeyboardInterrupt", "value": "", "traceback": ""})
+        except BaseException as e:
+            status = "error"
+            tb = e.__traceback__
+            # The frames of the driver are not shown
+            while tb is not None and tb.tb_frame.f_code.co_filename != "<cell>":
+                tb = tb.tb_next
+            _emit({
+                "type": "error",
+                "name": type(e).__name__,
+                "value": str(e),
+                "traceback": "".join(traceback.format_exception(type(e), e, tb)),
+            })
+        sys.stdout.flush()
+        sys.stderr.flush()
+        _emit({"type": "done", "status": status})
+
+
+_main()
@@ -0,0 +1,119 @@
+package kernel
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/kernel"
+)
+
+func executionStatus(status string) rpc.ExecutionStatus {
+	switch status {
+	case "ok":
+		return rpc.ExecutionStatus_EXECUTION_STATUS_OK
+	case "interrupted":
+		return rpc.ExecutionStatus_EXECUTION_STATUS_INTERRUPTED
+	default:
+		return rpc.ExecutionStatus_EXECUTION_STATUS_ERROR
+	}
+}
+
+func eventResponse(e event) *rpc.ExecuteResponse {
+	switch e.Type {
+	case "stdout":
+		return &rpc.ExecuteResponse{Event: &rpc.ExecuteResponse_Stdout{Stdout: &rpc.ExecuteResponse_StreamEvent{Text: e.Text}}}
+	case "stderr":
+		return &rpc.ExecuteResponse{Event: &rpc.ExecuteResponse_Stderr{Stderr: &rpc.ExecuteResponse_StreamEvent{Text: e.Text}}}
+	case "display":
+		return &rpc.ExecuteResponse{Event: &rpc.ExecuteResponse_Display{Display: &rpc.ExecuteResponse_DisplayEvent{Data: e.Data, IsResult: e.Result}}}
+	case "error":
+		return &rpc.ExecuteResponse{Event: &rpc.ExecuteResponse_Error{Error: &rpc.ExecuteResponse_ErrorEvent{Name: e.Name, Value: e.Value, Traceback: e.Traceback}}}
+	case "done":
+		return &rpc.ExecuteResponse{Event: &rpc.ExecuteResponse_End{End: &rpc.ExecuteResponse_EndEvent{Status: executionStatus(e.Status)}}}
+	case "exit":
+		return &rpc.ExecuteResponse{Event: &rpc.ExecuteResponse_End{End: &rpc.ExecuteResponse_EndEvent{Status: rpc.ExecutionStatus_EXECUTION_STATUS_KERNEL_DIED}}}
+	default:
+		return nil
+	}
+}
+
+func (s *Service) Execute(ctx context.Context, req *connect.Request[rpc.ExecuteRequest], stream *connect.ServerStream[rpc.ExecuteResponse]) error {
+	return logs.LogServerStreamWithoutEvents(ctx, s.logger, req, stream, s.handleExecute)
+}
+
+func (s *Service) handleExecute(ctx context.Context, req *connect.Request[rpc.ExecuteRequest], stream *connect.ServerStream[rpc.ExecuteResponse]) error {
+	k, err := s.getKernel(req.Msg.GetKernelId())
+	if err != nil {
+		return err
+	}
+
+	// The cells wait for the previous cells of the kernel
+	k.execMu.Lock()
+	defer k.execMu.Unlock()
+
+	events, count, err := k.begin(req.Msg.GetCode())
+	if err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	defer k.end()
+
+	streamErr := stream.Send(&rpc.ExecuteResponse{
+		Event: &rpc.ExecuteResponse_Start{
+			Start: &rpc.ExecuteResponse_StartEvent{
+				ExecutionCount: count,
+			},
+		},
+	})
+	if streamErr != nil {
+		return connect.NewError(connect.CodeUnknown, streamErr)
+	}
+
+	keepaliveTicker, resetKeepalive := permissions.GetKeepAliveTicker(req)
+	defer keepaliveTicker.Stop()
+
+	done := ctx.Done()
+
+	var sendErr error
+
+	for {
+		select {
+		case <-done:
+			// The cell is interrupted when the client disconnects, the next cell can run only after it ends
+			done = nil
+			sendErr = ctx.Err()
+
+			_ = k.Interrupt()
+		case <-keepaliveTicker.C:
+			if sendErr != nil {
+				continue
+			}
+
+			sendErr = stream.Send(&rpc.ExecuteResponse{
+				Event: &rpc.ExecuteResponse_Keepalive{
+					Keepalive: &rpc.ExecuteResponse_KeepAlive{},
+				},
+			})
+		case e := <-events:
+			res := eventResponse(e)
+			if res != nil && sendErr == nil {
+				sendErr = stream.Send(res)
+				if sendErr != nil {
+					_ = k.Interrupt()
+				}
+
+				resetKeepalive()
+			}
+
+			if e.Type == "done" || e.Type == "exit" {
+				if sendErr != nil {
+					return connect.NewError(connect.CodeUnknown, sendErr)
+				}
+
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,390 @@
+package kernel
+
+import (
+	"bufio"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/user"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/hardening"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/kernel"
+)
+
+const (
+	kernelStartTimeout = 30 * time.Second
+	eventsBufferSize   = 64
+	outputChunkSize    = 2 << 13
+)
+
+var (
+	//go:embed drivers/python.py
+	pythonDriver string
+	//go:embed drivers/node.js
+	nodeDriver string
+
+	errKernelNotRunning = errors.New("kernel is not running, it must be restarted")
+)
+
+// event is written by the driver of the kernel, the exit event is sent when the kernel process exits.
+type event struct {
+	Type      string            `json:"type"`
+	Text      string            `json:"text,omitempty"`
+	Data      map[string]string `json:"data,omitempty"`
+	Result    bool              `json:"result,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Value     string            `json:"value,omitempty"`
+	Traceback string            `json:"traceback,omitempty"`
+	Status    string            `json:"status,omitempty"`
+}
+
+// Kernel is the interpreter process running the cells with the driver, the driver reads the cells from the fd 3
+// and writes the events to the fd 4, so the output of the cells is separated from the protocol.
+type Kernel struct {
+	ID       string
+	Language rpc.Language
+	Cwd      string
+
+	user      *user.User
+	env       []string
+	hardening *hardening.Hardening
+
+	// execMu orders the executions of the cells
+	execMu sync.Mutex
+
+	mu             sync.Mutex
+	cmd            *exec.Cmd
+	requests       *os.File
+	exited         chan struct{}
+	current        *execution
+	executionCount uint32
+}
+
+// execution receives the events of the running cell.
+type execution struct {
+	events chan event
+	ended  chan struct{}
+}
+
+func newKernel(id string, language rpc.Language, u *user.User, cwd string, env []string, hardening *hardening.Hardening) (*Kernel, error) {
+	k := &Kernel{
+		ID:        id,
+		Language:  language,
+		Cwd:       cwd,
+		user:      u,
+		env:       env,
+		hardening: hardening,
+	}
+
+	err := k.start()
+	if err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+func (k *Kernel) command() (*exec.Cmd, error) {
+	switch k.Language {
+	case rpc.Language_LANGUAGE_PYTHON:
+		return exec.Command("python3", "-u", "-c", pythonDriver), nil
+	case rpc.Language_LANGUAGE_NODE:
+		return exec.Command("node", "-e", nodeDriver), nil
+	default:
+		return nil, fmt.Errorf("unsupported language %s", k.Language)
+	}
+}
+
+// start starts the kernel process and waits until the driver is ready.
+func (k *Kernel) start() error {
+	cmd, err := k.command()
+	if err != nil {
+		return err
+	}
+
+	uid, gid, err := permissions.GetUserIds(k.user)
+	if err != nil {
+		return err
+	}
+
+	cmd.Dir = k.Cwd
+	cmd.Env = k.env
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		// The processes started by the cells are killed with the kernel
+		Setpgid: true,
+		Credential: &syscall.Credential{
+			Uid:         uid,
+			Gid:         gid,
+			Groups:      []uint32{gid},
+			NoSetGroups: true,
+		},
+	}
+
+	k.hardening.Apply(cmd)
+
+	requestsReader, requestsWriter, err := os.Pipe()
+	if err != nil {
+		return fmt.Errorf("error creating requests pipe: %w", err)
+	}
+	defer requestsReader.Close()
+
+	eventsReader, eventsWriter, err := os.Pipe()
+	if err != nil {
+		requestsWriter.Close()
+
+		return fmt.Errorf("error creating events pipe: %w", err)
+	}
+	defer eventsWriter.Close()
+
+	cmd.ExtraFiles = []*os.File{requestsReader, eventsWriter}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		requestsWriter.Close()
+		eventsReader.Close()
+
+		return fmt.Errorf("error creating stdout pipe: %w", err)
+	}
+
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		requestsWriter.Close()
+		eventsReader.Close()
+
+		return fmt.Errorf("error creating stderr pipe: %w", err)
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		requestsWriter.Close()
+		eventsReader.Close()
+
+		return fmt.Errorf("error starting kernel: %w", err)
+	}
+
+	ready := make(chan struct{})
+	exited := make(chan struct{})
+
+	k.mu.Lock()
+	k.cmd = cmd
+	k.requests = requestsWriter
+	k.exited = exited
+	k.executionCount = 0
+	k.mu.Unlock()
+
+	var readers sync.WaitGroup
+
+	readers.Add(3)
+
+	go func() {
+		defer readers.Done()
+		defer eventsReader.Close()
+
+		k.readEvents(eventsReader, ready)
+	}()
+
+	// The output written directly to the stdout and stderr, e.g. by the subprocesses, is sent to the current cell too
+	go func() {
+		defer readers.Done()
+
+		k.readOutput(stdout, "stdout")
+	}()
+
+	go func() {
+		defer readers.Done()
+
+		k.readOutput(stderr, "stderr")
+	}()
+
+	go func() {
+		readers.Wait()
+
+		waitErr := cmd.Wait()
+		if waitErr != nil {
+			fmt.Fprintf(os.Stderr, "kernel '%s' exited: %s\n", k.ID, waitErr)
+		}
+
+		requestsWriter.Close()
+
+		k.dispatch(event{Type: "exit"})
+
+		close(exited)
+	}()
+
+	select {
+	case <-ready:
+		return nil
+	case <-exited:
+		return fmt.Errorf("kernel exited before it was ready")
+	case <-time.After(kernelStartTimeout):
+		k.kill()
+
+		return fmt.Errorf("kernel was not ready in %s", kernelStartTimeout)
+	}
+}
+
+func (k *Kernel) readEvents(r io.Reader, ready chan struct{}) {
+	reader := bufio.NewReader(r)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			var e event
+
+			jsonErr := json.Unmarshal(line, &e)
+			if jsonErr != nil {
+				fmt.Fprintf(os.Stderr, "error parsing event of kernel '%s': %s\n", k.ID, jsonErr)
+			} else if e.Type == "ready" {
+				select {
+				case <-ready:
+				default:
+					close(ready)
+				}
+			} else {
+				k.dispatch(e)
+			}
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (k *Kernel) readOutput(r io.Reader, eventType string) {
+	buf := make([]byte, outputChunkSize)
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			k.dispatch(event{Type: eventType, Text: string(buf[:n])})
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// dispatch sends the event to the running cell, the events outside of the cells are dropped.
+func (k *Kernel) dispatch(e event) {
+	k.mu.Lock()
+	current := k.current
+	k.mu.Unlock()
+
+	if current == nil {
+		return
+	}
+
+	select {
+	case current.events <- e:
+	case <-current.ended:
+	}
+}
+
+// begin sends the cell to the kernel, the events of the cell are received from the returned channel until the done or exit event.
+func (k *Kernel) begin(code string) (<-chan event, uint32, error) {
+	request, err := json.Marshal(map[string]string{"code": code})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	current := &execution{
+		events: make(chan event, eventsBufferSize),
+		ended:  make(chan struct{}),
+	}
+
+	k.mu.Lock()
+
+	select {
+	case <-k.exited:
+		k.mu.Unlock()
+
+		return nil, 0, errKernelNotRunning
+	default:
+	}
+
+	k.current = current
+	k.executionCount++
+
+	count := k.executionCount
+	requests := k.requests
+
+	k.mu.Unlock()
+
+	// The large cells could block until the driver reads them, the events must not be blocked meanwhile
+	_, err = requests.Write(append(request, '\n'))
+	if err != nil {
+		k.end()
+
+		return nil, 0, fmt.Errorf("error sending cell to kernel: %w", err)
+	}
+
+	return current.events, count, nil
+}
+
+func (k *Kernel) end() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.current != nil {
+		close(k.current.ended)
+		k.current = nil
+	}
+}
+
+func (k *Kernel) Interrupt() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	select {
+	case <-k.exited:
+		return errKernelNotRunning
+	default:
+	}
+
+	return k.cmd.Process.Signal(syscall.SIGINT)
+}
+
+// kill kills the kernel with all processes started by the cells and waits until it exits.
+func (k *Kernel) kill() {
+	k.mu.Lock()
+	cmd := k.cmd
+	exited := k.exited
+	k.mu.Unlock()
+
+	err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	if err != nil && !errors.Is(err, syscall.ESRCH) {
+		fmt.Fprintf(os.Stderr, "error killing kernel '%s': %s\n", k.ID, err)
+	}
+
+	<-exited
+}
+
+func (k *Kernel) Restart() error {
+	k.kill()
+
+	return k.start()
+}
+
+func (k *Kernel) Info() *rpc.KernelInfo {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return &rpc.KernelInfo{
+		Id:             k.ID,
+		Language:       k.Language,
+		Cwd:            k.Cwd,
+		Pid:            uint32(k.cmd.Process.Pid),
+		ExecutionCount: k.executionCount,
+		Busy:           k.current != nil,
+	}
+}
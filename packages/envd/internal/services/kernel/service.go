@@ -0,0 +1,181 @@
+package kernel
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"connectrpc.com/connect"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/hardening"
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	"github.com/e2b-dev/infra/packages/envd/internal/secrets"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/kernel"
+	spec "github.com/e2b-dev/infra/packages/envd/internal/services/spec/kernel/kernelconnect"
+	"github.com/e2b-dev/infra/packages/envd/internal/telemetry"
+	"github.com/e2b-dev/infra/packages/envd/internal/utils"
+)
+
+type Service struct {
+	logger    *zerolog.Logger
+	envs      *utils.Map[string, string]
+	secrets   *secrets.Secrets
+	hardening *hardening.Hardening
+	kernels   *utils.Map[string, *Kernel]
+}
+
+func Handle(server *chi.Mux, l *zerolog.Logger, envs *utils.Map[string, string], secrets *secrets.Secrets, hardening *hardening.Hardening) {
+	service := &Service{
+		logger:    l,
+		envs:      envs,
+		secrets:   secrets,
+		hardening: hardening,
+		kernels:   utils.NewMap[string, *Kernel](),
+	}
+
+	interceptors := connect.WithInterceptors(logs.NewUnaryLogInterceptor(l), telemetry.NewUnaryTraceInterceptor())
+
+	path, handler := spec.NewKernelHandler(service, interceptors)
+
+	server.Mount(path, handler)
+}
+
+func (s *Service) getKernel(id string) (*Kernel, error) {
+	k, ok := s.kernels.Load(id)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("kernel '%s' not found", id))
+	}
+
+	return k, nil
+}
+
+func newKernelID() (string, error) {
+	b := make([]byte, 8)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func (s *Service) Create(ctx context.Context, req *connect.Request[rpc.CreateRequest]) (*connect.Response[rpc.CreateResponse], error) {
+	if req.Msg.GetLanguage() == rpc.Language_LANGUAGE_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("language is required"))
+	}
+
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cwd, err := permissions.ExpandAndResolve(req.Msg.GetCwd(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	env := []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + u.HomeDir,
+		"USER=" + u.Username,
+		"LOGNAME=" + u.Username,
+	}
+
+	if s.envs != nil {
+		s.envs.Range(func(key string, value string) bool {
+			env = append(env, key+"="+value)
+
+			return true
+		})
+	}
+
+	env = append(env, s.secrets.Env(fmt.Sprintf("kernel '%s'", req.Msg.GetLanguage()), u.Username)...)
+
+	// Only the last values of the env vars are used - this allows for overwriting defaults
+	for key, value := range req.Msg.GetEnvs() {
+		env = append(env, key+"="+value)
+	}
+
+	id, err := newKernelID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error generating kernel id: %w", err))
+	}
+
+	k, err := newKernel(id, req.Msg.GetLanguage(), u, cwd, env, s.hardening)
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("interpreter of %s is not installed in the sandbox: %w", req.Msg.GetLanguage(), err))
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	s.kernels.Store(id, k)
+
+	return connect.NewResponse(&rpc.CreateResponse{
+		Kernel: k.Info(),
+	}), nil
+}
+
+func (s *Service) List(context.Context, *connect.Request[rpc.ListRequest]) (*connect.Response[rpc.ListResponse], error) {
+	kernels := make([]*rpc.KernelInfo, 0)
+
+	s.kernels.Range(func(_ string, k *Kernel) bool {
+		kernels = append(kernels, k.Info())
+
+		return true
+	})
+
+	return connect.NewResponse(&rpc.ListResponse{
+		Kernels: kernels,
+	}), nil
+}
+
+func (s *Service) Delete(_ context.Context, req *connect.Request[rpc.DeleteRequest]) (*connect.Response[rpc.DeleteResponse], error) {
+	k, ok := s.kernels.LoadAndDelete(req.Msg.GetKernelId())
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("kernel '%s' not found", req.Msg.GetKernelId()))
+	}
+
+	k.kill()
+
+	return connect.NewResponse(&rpc.DeleteResponse{}), nil
+}
+
+func (s *Service) Interrupt(_ context.Context, req *connect.Request[rpc.InterruptRequest]) (*connect.Response[rpc.InterruptResponse], error) {
+	k, err := s.getKernel(req.Msg.GetKernelId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Interrupt()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewResponse(&rpc.InterruptResponse{}), nil
+}
+
+func (s *Service) Restart(_ context.Context, req *connect.Request[rpc.RestartRequest]) (*connect.Response[rpc.RestartResponse], error) {
+	k, err := s.getKernel(req.Msg.GetKernelId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Restart()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error restarting kernel: %w", err))
+	}
+
+	return connect.NewResponse(&rpc.RestartResponse{
+		Kernel: k.Info(),
+	}), nil
+}
@@ -0,0 +1,336 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+
+	"connectrpc.com/connect"
+)
+
+// outputWindow limits the output sent to the client to the bytes the client allowed.
+type outputWindow struct {
+	enabled   bool
+	mu        sync.Mutex
+	available int64
+	updated   chan struct{}
+}
+
+func newOutputWindow(size uint32) *outputWindow {
+	return &outputWindow{
+		enabled:   size > 0,
+		available: int64(size),
+		updated:   make(chan struct{}, 1),
+	}
+}
+
+func (w *outputWindow) add(increment uint32) {
+	w.mu.Lock()
+	w.available += int64(increment)
+	w.mu.Unlock()
+
+	select {
+	case w.updated <- struct{}{}:
+	default:
+	}
+}
+
+// take reserves up to n bytes of the window, it returns 0 if the window is exhausted.
+func (w *outputWindow) take(n int) int {
+	if !w.enabled {
+		return n
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	taken := int(min(int64(n), w.available))
+	if taken <= 0 {
+		return 0
+	}
+
+	w.available -= int64(taken)
+
+	return taken
+}
+
+// attachStream serializes the responses from the input and output goroutines and stops them when the handler returns.
+type attachStream struct {
+	stream *connect.BidiStream[rpc.AttachRequest, rpc.AttachResponse]
+	mu     sync.Mutex
+	closed bool
+}
+
+func (a *attachStream) send(res *rpc.AttachResponse) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed {
+		return errors.New("stream closed")
+	}
+
+	return a.stream.Send(res)
+}
+
+func (a *attachStream) close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.closed = true
+}
+
+func (a *attachStream) sendEvent(event *rpc.ProcessEvent) error {
+	return a.send(&rpc.AttachResponse{
+		Event: &rpc.AttachResponse_Process{
+			Process: event,
+		},
+	})
+}
+
+func (s *Service) Attach(ctx context.Context, stream *connect.BidiStream[rpc.AttachRequest, rpc.AttachResponse]) error {
+	return logs.LogBidiStreamWithoutEvents(ctx, s.logger, stream, s.handleAttach)
+}
+
+func (s *Service) handleAttach(ctx context.Context, stream *connect.BidiStream[rpc.AttachRequest, rpc.AttachResponse]) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	req, err := stream.Receive()
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("error receiving start event: %w", err))
+	}
+
+	start := req.GetStart()
+	if start == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("first event must be the start event, got %T", req.GetEvent()))
+	}
+
+	proc, err := s.getProcess(start.GetProcess())
+	if err != nil {
+		return err
+	}
+
+	out := &attachStream{stream: stream}
+	defer out.close()
+
+	window := newOutputWindow(start.GetWindowSize())
+
+	data, dataCancel := proc.DataEvent.Fork()
+	defer dataCancel()
+
+	end, endCancel := proc.EndEvent.Fork()
+	defer endCancel()
+
+	streamErr := out.sendEvent(&rpc.ProcessEvent{
+		Event: &rpc.ProcessEvent_Start{
+			Start: &rpc.ProcessEvent_StartEvent{
+				Pid: proc.Pid(),
+			},
+		},
+	})
+	if streamErr != nil {
+		return connect.NewError(connect.CodeUnknown, streamErr)
+	}
+
+	go s.receiveAttachInput(ctx, cancel, proc, stream, out, window)
+
+	exitChan := make(chan struct{})
+
+	go func() {
+		defer close(exitChan)
+
+		keepaliveTicker, resetKeepalive := permissions.GetKeepAliveTickerFromHeader(stream.RequestHeader())
+		defer keepaliveTicker.Stop()
+
+		sendKeepalive := func() error {
+			return out.sendEvent(&rpc.ProcessEvent{
+				Event: &rpc.ProcessEvent_Keepalive{
+					Keepalive: &rpc.ProcessEvent_KeepAlive{},
+				},
+			})
+		}
+
+	dataLoop:
+		for {
+			select {
+			case <-keepaliveTicker.C:
+				streamErr := sendKeepalive()
+				if streamErr != nil {
+					cancel(connect.NewError(connect.CodeUnknown, streamErr))
+
+					return
+				}
+			case <-ctx.Done():
+				cancel(ctx.Err())
+
+				return
+			case event, ok := <-data:
+				if !ok {
+					break dataLoop
+				}
+
+				output, newEvent := splitOutput(event.Data)
+
+				// The output is split by the window, the process is blocked on the full pipe until the client allows more output.
+				for len(output) > 0 {
+					n := window.take(len(output))
+					if n == 0 {
+						select {
+						case <-ctx.Done():
+							cancel(ctx.Err())
+
+							return
+						case <-window.updated:
+						case <-keepaliveTicker.C:
+							streamErr := sendKeepalive()
+							if streamErr != nil {
+								cancel(connect.NewError(connect.CodeUnknown, streamErr))
+
+								return
+							}
+						}
+
+						continue
+					}
+
+					streamErr := out.sendEvent(&rpc.ProcessEvent{
+						Event: &rpc.ProcessEvent_Data{
+							Data: newEvent(output[:n]),
+						},
+					})
+					if streamErr != nil {
+						cancel(connect.NewError(connect.CodeUnknown, streamErr))
+
+						return
+					}
+
+					output = output[n:]
+				}
+
+				resetKeepalive()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			cancel(ctx.Err())
+
+			return
+		case event, ok := <-end:
+			if !ok {
+				cancel(connect.NewError(connect.CodeUnknown, errors.New("end event channel closed before sending end event")))
+
+				return
+			}
+
+			streamErr := out.sendEvent(&rpc.ProcessEvent{
+				Event: &event,
+			})
+			if streamErr != nil {
+				cancel(connect.NewError(connect.CodeUnknown, streamErr))
+
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	case <-exitChan:
+		return nil
+	}
+}
+
+// receiveAttachInput writes the input from the client to the process until the client closes its side of the stream.
+func (s *Service) receiveAttachInput(
+	ctx context.Context,
+	cancel context.CancelCauseFunc,
+	proc *handler.Handler,
+	stream *connect.BidiStream[rpc.AttachRequest, rpc.AttachResponse],
+	out *attachStream,
+	window *outputWindow,
+) {
+	var written uint64
+
+	for {
+		req, err := stream.Receive()
+		if errors.Is(err, io.EOF) {
+			// The output is still sent after the client stops sending the input
+			return
+		}
+
+		if err != nil {
+			cancel(connect.NewError(connect.CodeUnknown, err))
+
+			return
+		}
+
+		switch req.GetEvent().(type) {
+		case *rpc.AttachRequest_Data:
+			input := req.GetData().GetInput()
+
+			err := handleInput(ctx, proc, input, s.logger)
+			if err != nil {
+				cancel(err)
+
+				return
+			}
+
+			written += uint64(len(input.GetStdin()) + len(input.GetPty()))
+
+			err = out.send(&rpc.AttachResponse{
+				Event: &rpc.AttachResponse_InputAck{
+					InputAck: &rpc.AttachResponse_InputAckEvent{
+						WrittenBytes: written,
+					},
+				},
+			})
+			if err != nil {
+				cancel(connect.NewError(connect.CodeUnknown, err))
+
+				return
+			}
+		case *rpc.AttachRequest_CloseStdin:
+			err := proc.CloseStdin()
+			if err != nil {
+				cancel(connect.NewError(connect.CodeFailedPrecondition, err))
+
+				return
+			}
+		case *rpc.AttachRequest_WindowUpdate:
+			window.add(req.GetWindowUpdate().GetIncrement())
+		case *rpc.AttachRequest_Keepalive:
+			break
+		default:
+			cancel(connect.NewError(connect.CodeUnimplemented, fmt.Errorf("invalid event type %T", req.GetEvent())))
+
+			return
+		}
+	}
+}
+
+// splitOutput returns the output of the data event and the constructor of the events with the same output type.
+func splitOutput(event *rpc.ProcessEvent_DataEvent) ([]byte, func([]byte) *rpc.ProcessEvent_DataEvent) {
+	switch output := event.GetOutput().(type) {
+	case *rpc.ProcessEvent_DataEvent_Stdout:
+		return output.Stdout, func(b []byte) *rpc.ProcessEvent_DataEvent {
+			return &rpc.ProcessEvent_DataEvent{Output: &rpc.ProcessEvent_DataEvent_Stdout{Stdout: b}}
+		}
+	case *rpc.ProcessEvent_DataEvent_Stderr:
+		return output.Stderr, func(b []byte) *rpc.ProcessEvent_DataEvent {
+			return &rpc.ProcessEvent_DataEvent{Output: &rpc.ProcessEvent_DataEvent_Stderr{Stderr: b}}
+		}
+	default:
+		return event.GetPty(), func(b []byte) *rpc.ProcessEvent_DataEvent {
+			return &rpc.ProcessEvent_DataEvent{Output: &rpc.ProcessEvent_DataEvent_Pty{Pty: b}}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const (
+	defaultFlushInterval = 100 * time.Millisecond
+	maxBufferSize        = 1 << 20
+)
+
+func validateBuffering(buffering *rpc.OutputBuffering) error {
+	switch buffering.GetMode() {
+	case rpc.BufferingMode_BUFFERING_MODE_UNSPECIFIED, rpc.BufferingMode_BUFFERING_MODE_LINE, rpc.BufferingMode_BUFFERING_MODE_SIZE:
+	default:
+		return fmt.Errorf("invalid buffering mode %d", buffering.GetMode())
+	}
+
+	if buffering.Size != nil && (buffering.GetSize() == 0 || buffering.GetSize() > maxBufferSize) {
+		return fmt.Errorf("buffer size must be between 1 and %d", maxBufferSize)
+	}
+
+	return nil
+}
+
+// outputBuffer collects the output of the process and sends it by lines or by full buffers.
+// The incomplete output is sent after the flush interval, so the prompts of the interactive programs are not delayed indefinitely.
+type outputBuffer struct {
+	mode     rpc.BufferingMode
+	size     int
+	interval time.Duration
+	send     func([]byte)
+
+	mu     sync.Mutex
+	buf    []byte
+	timer  *time.Timer
+	closed bool
+}
+
+func newOutputBuffer(buffering *rpc.OutputBuffering, send func([]byte)) *outputBuffer {
+	b := &outputBuffer{
+		mode:     buffering.GetMode(),
+		size:     stdChunkSize,
+		interval: defaultFlushInterval,
+		send:     send,
+	}
+
+	if buffering.Size != nil {
+		b.size = int(buffering.GetSize())
+	}
+
+	if buffering.FlushIntervalMs != nil {
+		b.interval = time.Duration(buffering.GetFlushIntervalMs()) * time.Millisecond
+	}
+
+	return b
+}
+
+func (b *outputBuffer) Write(data []byte) {
+	if b.mode == rpc.BufferingMode_BUFFERING_MODE_UNSPECIFIED {
+		b.send(data)
+
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = append(b.buf, data...)
+
+	for len(b.buf) >= b.size {
+		b.sendLocked(b.size)
+	}
+
+	if b.mode == rpc.BufferingMode_BUFFERING_MODE_LINE {
+		i := bytes.LastIndexByte(b.buf, '\n')
+		if i >= 0 {
+			b.sendLocked(i + 1)
+		}
+	}
+
+	if len(b.buf) > 0 && b.timer == nil {
+		b.timer = time.AfterFunc(b.interval, b.flush)
+	}
+}
+
+// Close sends the rest of the output, nothing is sent after it returns.
+func (b *outputBuffer) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	if len(b.buf) > 0 {
+		b.sendLocked(len(b.buf))
+	}
+
+	b.closed = true
+}
+
+func (b *outputBuffer) flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.timer = nil
+
+	if b.closed || len(b.buf) == 0 {
+		return
+	}
+
+	b.sendLocked(len(b.buf))
+}
+
+// sendLocked sends the first n bytes of the buffer, the sent data must not be modified so the rest is copied.
+func (b *outputBuffer) sendLocked(n int) {
+	data := b.buf[:n]
+	b.buf = append([]byte(nil), b.buf[n:]...)
+
+	b.send(data)
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const (
+	cgroupRoot = "/sys/fs/cgroup"
+	// The cgroups of the processes are in the parent cgroup without processes, so the controllers can be enabled for them.
+	processesCgroupDir = cgroupRoot + "/envd-processes"
+
+	minCPUWeight = 1
+	maxCPUWeight = 10000
+	minNice      = -20
+	maxNice      = 19
+)
+
+var (
+	cgroupsOnce sync.Once
+	cgroupsErr  error
+
+	nextCgroupID atomic.Uint64
+)
+
+// initCgroups enables the cpu, memory and pids controllers for the cgroups of the processes.
+func initCgroups() error {
+	cgroupsOnce.Do(func() {
+		_, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers"))
+		if err != nil {
+			cgroupsErr = fmt.Errorf("cgroups v2 are not available in the sandbox: %w", err)
+
+			return
+		}
+
+		err = os.MkdirAll(processesCgroupDir, 0o755)
+		if err != nil {
+			cgroupsErr = fmt.Errorf("failed to create the cgroup for the processes: %w", err)
+
+			return
+		}
+
+		for _, dir := range []string{cgroupRoot, processesCgroupDir} {
+			err = os.WriteFile(filepath.Join(dir, "cgroup.subtree_control"), []byte("+cpu +memory +pids"), 0)
+			if err != nil {
+				cgroupsErr = fmt.Errorf("failed to enable the cgroup controllers in '%s': %w", dir, err)
+
+				return
+			}
+		}
+	})
+
+	return cgroupsErr
+}
+
+func validateLimits(limits *rpc.ProcessLimits) error {
+	if limits.CpuWeight != nil && (limits.GetCpuWeight() < minCPUWeight || limits.GetCpuWeight() > maxCPUWeight) {
+		return fmt.Errorf("cpu weight must be between %d and %d", minCPUWeight, maxCPUWeight)
+	}
+
+	if limits.MemoryMaxBytes != nil && limits.GetMemoryMaxBytes() == 0 {
+		return errors.New("memory max must be greater than 0")
+	}
+
+	if limits.PidsMax != nil && limits.GetPidsMax() == 0 {
+		return errors.New("pids max must be greater than 0")
+	}
+
+	if limits.Nice != nil && (limits.GetNice() < minNice || limits.GetNice() > maxNice) {
+		return fmt.Errorf("nice must be between %d and %d", minNice, maxNice)
+	}
+
+	return nil
+}
+
+// cgroup limits the resources of the process and all its children.
+type cgroup struct {
+	path string
+	fd   *os.File
+}
+
+// newCgroup creates the cgroup with the limits, the process is started directly in the cgroup via its file descriptor.
+func newCgroup(limits *rpc.ProcessLimits) (*cgroup, error) {
+	err := initCgroups()
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(processesCgroupDir, "process-"+strconv.FormatUint(nextCgroupID.Add(1), 10))
+
+	err = os.Mkdir(path, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cgroup: %w", err)
+	}
+
+	cg := &cgroup{path: path}
+
+	files := make(map[string]string)
+	if limits.CpuWeight != nil {
+		files["cpu.weight"] = strconv.FormatUint(uint64(limits.GetCpuWeight()), 10)
+	}
+
+	if limits.MemoryMaxBytes != nil {
+		files["memory.max"] = strconv.FormatUint(limits.GetMemoryMaxBytes(), 10)
+		// The process is killed instead of using the swap
+		files["memory.swap.max"] = "0"
+	}
+
+	if limits.PidsMax != nil {
+		files["pids.max"] = strconv.FormatUint(uint64(limits.GetPidsMax()), 10)
+	}
+
+	for name, value := range files {
+		err = os.WriteFile(filepath.Join(path, name), []byte(value), 0)
+		if err != nil && !(name == "memory.swap.max" && os.IsNotExist(err)) {
+			cg.remove()
+
+			return nil, fmt.Errorf("failed to set '%s' of cgroup: %w", name, err)
+		}
+	}
+
+	fd, err := os.Open(path)
+	if err != nil {
+		cg.remove()
+
+		return nil, fmt.Errorf("failed to open cgroup: %w", err)
+	}
+
+	cg.fd = fd
+
+	return cg, nil
+}
+
+// apply starts the process in the cgroup.
+func (c *cgroup) apply(attr *syscall.SysProcAttr) {
+	attr.UseCgroupFD = true
+	attr.CgroupFD = int(c.fd.Fd())
+}
+
+// started closes the file descriptor of the cgroup, it's needed only to start the process.
+func (c *cgroup) started() {
+	if c.fd != nil {
+		c.fd.Close()
+		c.fd = nil
+	}
+}
+
+// remove deletes the cgroup, it stays if the children of the process are still running.
+func (c *cgroup) remove() {
+	c.started()
+
+	err := os.Remove(c.path)
+	if err != nil && !errors.Is(err, syscall.EBUSY) && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "error removing cgroup '%s': %s\n", c.path, err)
+	}
+}
+
+func setNice(pid int, nice int32) error {
+	err := syscall.Setpriority(syscall.PRIO_PROCESS, pid, int(nice))
+	if err != nil {
+		return fmt.Errorf("failed to set nice of process '%d': %w", pid, err)
+	}
+
+	return nil
+}
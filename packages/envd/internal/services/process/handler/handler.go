@@ -10,8 +10,10 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/e2b-dev/infra/packages/envd/internal/hardening"
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
 	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	"github.com/e2b-dev/infra/packages/envd/internal/secrets"
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
 	"github.com/e2b-dev/infra/packages/envd/internal/utils"
 
@@ -48,6 +50,9 @@ type Handler struct {
 
 	DataEvent *MultiplexedChannel[rpc.ProcessEvent_Data]
 	EndEvent  *MultiplexedChannel[rpc.ProcessEvent_End]
+
+	cgroup *cgroup
+	nice   *int32
 }
 
 // This method must be called only after the process has been started
@@ -55,7 +60,7 @@ func (p *Handler) Pid() uint32 {
 	return uint32(p.cmd.Process.Pid)
 }
 
-func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars *utils.Map[string, string]) (*Handler, error) {
+func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars *utils.Map[string, string], secrets *secrets.Secrets, hardening *hardening.Hardening) (_ *Handler, err error) {
 	cmd := exec.Command(req.GetProcess().GetCmd(), req.GetProcess().GetArgs()...)
 
 	uid, gid, err := permissions.GetUserIds(user)
@@ -95,6 +100,10 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 		})
 	}
 
+	if secrets != nil {
+		formattedVars = append(formattedVars, secrets.Env(fmt.Sprintf("process '%s'", req.GetProcess().GetCmd()), user.Username)...)
+	}
+
 	// Only the last values of the env vars are used - this allows for overwriting defaults
 	for key, value := range req.GetProcess().GetEnvs() {
 		formattedVars = append(formattedVars, key+"="+value)
@@ -102,6 +111,42 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 
 	cmd.Env = formattedVars
 
+	var cg *cgroup
+	var nice *int32
+
+	limits := req.GetLimits()
+	if limits != nil {
+		err = validateLimits(limits)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		nice = limits.Nice
+	}
+
+	err = validateBuffering(req.GetBuffering())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// The niceness is set on the process directly, the cgroup is created only for the other limits
+	if limits != nil && (limits.CpuWeight != nil || limits.MemoryMaxBytes != nil || limits.PidsMax != nil) {
+		cg, err = newCgroup(limits)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+
+		cg.apply(cmd.SysProcAttr)
+
+		defer func() {
+			if err != nil {
+				cg.remove()
+			}
+		}()
+	}
+
+	hardening.Apply(cmd)
+
 	outMultiplex := NewMultiplexedChannel[rpc.ProcessEvent_Data](outputBufferSize)
 	var outWg sync.WaitGroup
 
@@ -157,6 +202,8 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 			outWg:     &outWg,
 			EndEvent:  NewMultiplexedChannel[rpc.ProcessEvent_End](0),
 			logger:    logger,
+			cgroup:    cg,
+			nice:      nice,
 		}, nil
 	}
 
@@ -181,19 +228,24 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 
 		go logs.LogBufferedDataEvents(stdoutLogs, &stdoutLogger, "data")
 
+		stdoutBuffer := newOutputBuffer(req.GetBuffering(), func(data []byte) {
+			outMultiplex.Source <- rpc.ProcessEvent_Data{
+				Data: &rpc.ProcessEvent_DataEvent{
+					Output: &rpc.ProcessEvent_DataEvent_Stdout{
+						Stdout: data,
+					},
+				},
+			}
+		})
+		defer stdoutBuffer.Close()
+
 		for {
 			buf := make([]byte, stdChunkSize)
 
 			n, readErr := stdout.Read(buf)
 
 			if n > 0 {
-				outMultiplex.Source <- rpc.ProcessEvent_Data{
-					Data: &rpc.ProcessEvent_DataEvent{
-						Output: &rpc.ProcessEvent_DataEvent_Stdout{
-							Stdout: buf[:n],
-						},
-					},
-				}
+				stdoutBuffer.Write(buf[:n])
 
 				stdoutLogs <- buf[:n]
 			}
@@ -226,19 +278,24 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 
 		go logs.LogBufferedDataEvents(stderrLogs, &stderrLogger, "data")
 
+		stderrBuffer := newOutputBuffer(req.GetBuffering(), func(data []byte) {
+			outMultiplex.Source <- rpc.ProcessEvent_Data{
+				Data: &rpc.ProcessEvent_DataEvent{
+					Output: &rpc.ProcessEvent_DataEvent_Stderr{
+						Stderr: data,
+					},
+				},
+			}
+		})
+		defer stderrBuffer.Close()
+
 		for {
 			buf := make([]byte, stdChunkSize)
 
 			n, readErr := stderr.Read(buf)
 
 			if n > 0 {
-				outMultiplex.Source <- rpc.ProcessEvent_Data{
-					Data: &rpc.ProcessEvent_DataEvent{
-						Output: &rpc.ProcessEvent_DataEvent_Stderr{
-							Stderr: buf[:n],
-						},
-					},
-				}
+				stderrBuffer.Write(buf[:n])
 
 				stderrLogs <- buf[:n]
 			}
@@ -264,6 +321,8 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 		outWg:     &outWg,
 		EndEvent:  NewMultiplexedChannel[rpc.ProcessEvent_End](0),
 		logger:    logger,
+		cgroup:    cg,
+		nice:      nice,
 	}, nil
 }
 
@@ -296,6 +355,20 @@ func (p *Handler) WriteStdin(data []byte) error {
 	return nil
 }
 
+// CloseStdin closes the stdin of the process, so the process reads EOF.
+func (p *Handler) CloseStdin() error {
+	if p.tty != nil {
+		return fmt.Errorf("tty assigned to process — stdin can't be closed")
+	}
+
+	err := p.stdin.Close()
+	if err != nil {
+		return fmt.Errorf("error closing stdin of process '%d': %w", p.cmd.Process.Pid, err)
+	}
+
+	return nil
+}
+
 func (p *Handler) WriteTty(data []byte) error {
 	if p.tty == nil {
 		return fmt.Errorf("tty not assigned to process — input should be written to the stdin, not the tty")
@@ -314,10 +387,25 @@ func (p *Handler) Start() (uint32, error) {
 	if p.tty == nil {
 		err := p.cmd.Start()
 		if err != nil {
+			if p.cgroup != nil {
+				p.cgroup.remove()
+			}
+
 			return 0, fmt.Errorf("error starting process '%s': %w", p.cmd, err)
 		}
 	}
 
+	if p.cgroup != nil {
+		p.cgroup.started()
+	}
+
+	if p.nice != nil {
+		niceErr := setNice(p.cmd.Process.Pid, *p.nice)
+		if niceErr != nil {
+			fmt.Fprintf(os.Stderr, "error setting nice for process '%s': %s\n", p.cmd, niceErr)
+		}
+	}
+
 	adjustErr := adjustOomScore(p.cmd.Process.Pid, defaultOomScore)
 	if adjustErr != nil {
 		fmt.Fprintf(os.Stderr, "error adjusting oom score for process '%s': %s\n", p.cmd, adjustErr)
@@ -342,6 +430,10 @@ func (p *Handler) Wait() {
 
 	err := p.cmd.Wait()
 
+	if p.cgroup != nil {
+		p.cgroup.remove()
+	}
+
 	var errMsg *string
 
 	if err != nil {
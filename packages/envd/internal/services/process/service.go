@@ -3,10 +3,13 @@ package process
 import (
 	"fmt"
 
+	"github.com/e2b-dev/infra/packages/envd/internal/hardening"
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/secrets"
 	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
 	spec "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process/processconnect"
+	"github.com/e2b-dev/infra/packages/envd/internal/telemetry"
 	"github.com/e2b-dev/infra/packages/envd/internal/utils"
 
 	"connectrpc.com/connect"
@@ -18,20 +21,24 @@ type Service struct {
 	processes *utils.Map[uint32, *handler.Handler]
 	logger    *zerolog.Logger
 	envs      *utils.Map[string, string]
+	secrets   *secrets.Secrets
+	hardening *hardening.Hardening
 }
 
-func newService(l *zerolog.Logger, envs *utils.Map[string, string]) *Service {
+func newService(l *zerolog.Logger, envs *utils.Map[string, string], secrets *secrets.Secrets, hardening *hardening.Hardening) *Service {
 	return &Service{
 		logger:    l,
 		processes: utils.NewMap[uint32, *handler.Handler](),
 		envs:      envs,
+		secrets:   secrets,
+		hardening: hardening,
 	}
 }
 
-func Handle(server *chi.Mux, l *zerolog.Logger, envs *utils.Map[string, string]) *Service {
-	service := newService(l, envs)
+func Handle(server *chi.Mux, l *zerolog.Logger, envs *utils.Map[string, string], secrets *secrets.Secrets, hardening *hardening.Hardening) *Service {
+	service := newService(l, envs, secrets, hardening)
 
-	interceptors := connect.WithInterceptors(logs.NewUnaryLogInterceptor(l))
+	interceptors := connect.WithInterceptors(logs.NewUnaryLogInterceptor(l), telemetry.NewUnaryTraceInterceptor())
 
 	path, h := spec.NewProcessHandler(service, interceptors)
 
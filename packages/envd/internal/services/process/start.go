@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"os/user"
+	"strconv"
 
 	"github.com/e2b-dev/infra/packages/envd/internal/host"
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
 	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
 	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+	"github.com/e2b-dev/infra/packages/envd/internal/telemetry"
 
 	"connectrpc.com/connect"
 )
@@ -27,7 +29,7 @@ func (s *Service) InitializeStartProcess(ctx context.Context, user *user.User, r
 
 	handlerL := s.logger.With().Str(string(logs.OperationIDKey), ctx.Value(logs.OperationIDKey).(string)).Logger()
 
-	proc, err := handler.New(user, req, &handlerL, nil)
+	proc, err := handler.New(user, req, &handlerL, nil, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -56,6 +58,10 @@ func (s *Service) handleStart(ctx context.Context, req *connect.Request[rpc.Star
 	ctx, cancel := context.WithCancelCause(ctx)
 	defer cancel(nil)
 
+	// The span ends when the process is started, not when it exits.
+	ctx, span := telemetry.Start(ctx, "process-start", req.Header())
+	span.SetAttribute("process.cmd", req.Msg.GetProcess().GetCmd())
+
 	s.logger.Trace().Str(string(logs.OperationIDKey), ctx.Value(logs.OperationIDKey).(string)).Msg("Process start: Waiting for clock to sync")
 	host.WaitForSync()
 	s.logger.Trace().Str(string(logs.OperationIDKey), ctx.Value(logs.OperationIDKey).(string)).Msg("Process start: Clock synced")
@@ -64,11 +70,15 @@ func (s *Service) handleStart(ctx context.Context, req *connect.Request[rpc.Star
 
 	u, err := permissions.GetAuthUser(ctx)
 	if err != nil {
+		span.End(err)
+
 		return err
 	}
 
-	proc, err := handler.New(u, req.Msg, &handlerL, s.envs)
+	proc, err := handler.New(u, req.Msg, &handlerL, s.envs, s.secrets, s.hardening)
 	if err != nil {
+		span.End(err)
+
 		return err
 	}
 
@@ -180,9 +190,14 @@ func (s *Service) handleStart(ctx context.Context, req *connect.Request[rpc.Star
 
 	pid, err := proc.Start()
 	if err != nil {
+		span.End(err)
+
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	span.SetAttribute("process.pid", strconv.FormatUint(uint64(pid), 10))
+	span.End(nil)
+
 	s.processes.Store(pid, proc)
 
 	start <- rpc.ProcessEvent_Start{
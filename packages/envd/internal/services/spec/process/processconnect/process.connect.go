@@ -47,6 +47,8 @@ const (
 	ProcessSendInputProcedure = "/process.Process/SendInput"
 	// ProcessSendSignalProcedure is the fully-qualified name of the Process's SendSignal RPC.
 	ProcessSendSignalProcedure = "/process.Process/SendSignal"
+	// ProcessAttachProcedure is the fully-qualified name of the Process's Attach RPC.
+	ProcessAttachProcedure = "/process.Process/Attach"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -59,6 +61,7 @@ var (
 	processStreamInputMethodDescriptor = processServiceDescriptor.Methods().ByName("StreamInput")
 	processSendInputMethodDescriptor   = processServiceDescriptor.Methods().ByName("SendInput")
 	processSendSignalMethodDescriptor  = processServiceDescriptor.Methods().ByName("SendSignal")
+	processAttachMethodDescriptor      = processServiceDescriptor.Methods().ByName("Attach")
 )
 
 // ProcessClient is a client for the process.Process service.
@@ -71,6 +74,8 @@ type ProcessClient interface {
 	StreamInput(context.Context) *connect.ClientStreamForClient[process.StreamInputRequest, process.StreamInputResponse]
 	SendInput(context.Context, *connect.Request[process.SendInputRequest]) (*connect.Response[process.SendInputResponse], error)
 	SendSignal(context.Context, *connect.Request[process.SendSignalRequest]) (*connect.Response[process.SendSignalResponse], error)
+	// Bidirectional stream of the process input and output with the flow control, it requires HTTP/2
+	Attach(context.Context) *connect.BidiStreamForClient[process.AttachRequest, process.AttachResponse]
 }
 
 // NewProcessClient constructs a client for the process.Process service. By default, it uses the
@@ -125,6 +130,12 @@ func NewProcessClient(httpClient connect.HTTPClient, baseURL string, opts ...con
 			connect.WithSchema(processSendSignalMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		attach: connect.NewClient[process.AttachRequest, process.AttachResponse](
+			httpClient,
+			baseURL+ProcessAttachProcedure,
+			connect.WithSchema(processAttachMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -137,6 +148,7 @@ type processClient struct {
 	streamInput *connect.Client[process.StreamInputRequest, process.StreamInputResponse]
 	sendInput   *connect.Client[process.SendInputRequest, process.SendInputResponse]
 	sendSignal  *connect.Client[process.SendSignalRequest, process.SendSignalResponse]
+	attach      *connect.Client[process.AttachRequest, process.AttachResponse]
 }
 
 // List calls process.Process.List.
@@ -174,6 +186,11 @@ func (c *processClient) SendSignal(ctx context.Context, req *connect.Request[pro
 	return c.sendSignal.CallUnary(ctx, req)
 }
 
+// Attach calls process.Process.Attach.
+func (c *processClient) Attach(ctx context.Context) *connect.BidiStreamForClient[process.AttachRequest, process.AttachResponse] {
+	return c.attach.CallBidiStream(ctx)
+}
+
 // ProcessHandler is an implementation of the process.Process service.
 type ProcessHandler interface {
 	List(context.Context, *connect.Request[process.ListRequest]) (*connect.Response[process.ListResponse], error)
@@ -184,6 +201,8 @@ type ProcessHandler interface {
 	StreamInput(context.Context, *connect.ClientStream[process.StreamInputRequest]) (*connect.Response[process.StreamInputResponse], error)
 	SendInput(context.Context, *connect.Request[process.SendInputRequest]) (*connect.Response[process.SendInputResponse], error)
 	SendSignal(context.Context, *connect.Request[process.SendSignalRequest]) (*connect.Response[process.SendSignalResponse], error)
+	// Bidirectional stream of the process input and output with the flow control, it requires HTTP/2
+	Attach(context.Context, *connect.BidiStream[process.AttachRequest, process.AttachResponse]) error
 }
 
 // NewProcessHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -234,6 +253,12 @@ func NewProcessHandler(svc ProcessHandler, opts ...connect.HandlerOption) (strin
 		connect.WithSchema(processSendSignalMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	processAttachHandler := connect.NewBidiStreamHandler(
+		ProcessAttachProcedure,
+		svc.Attach,
+		connect.WithSchema(processAttachMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/process.Process/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProcessListProcedure:
@@ -250,6 +275,8 @@ func NewProcessHandler(svc ProcessHandler, opts ...connect.HandlerOption) (strin
 			processSendInputHandler.ServeHTTP(w, r)
 		case ProcessSendSignalProcedure:
 			processSendSignalHandler.ServeHTTP(w, r)
+		case ProcessAttachProcedure:
+			processAttachHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -286,3 +313,7 @@ func (UnimplementedProcessHandler) SendInput(context.Context, *connect.Request[p
 func (UnimplementedProcessHandler) SendSignal(context.Context, *connect.Request[process.SendSignalRequest]) (*connect.Response[process.SendSignalResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("process.Process.SendSignal is not implemented"))
 }
+
+func (UnimplementedProcessHandler) Attach(context.Context, *connect.BidiStream[process.AttachRequest, process.AttachResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("process.Process.Attach is not implemented"))
+}
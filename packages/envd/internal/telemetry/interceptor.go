@@ -0,0 +1,27 @@
+package telemetry
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+// NewUnaryTraceInterceptor records a span for each unary call.
+func NewUnaryTraceInterceptor() connect.UnaryInterceptorFunc {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return connect.UnaryFunc(func(
+			ctx context.Context,
+			req connect.AnyRequest,
+		) (connect.AnyResponse, error) {
+			ctx, span := Start(ctx, req.Spec().Procedure, req.Header())
+
+			res, err := next(ctx, req)
+
+			span.End(err)
+
+			return res, err
+		})
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
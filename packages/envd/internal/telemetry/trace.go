@@ -0,0 +1,234 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	serviceName = "envd"
+
+	traceParentHeader = "traceparent"
+
+	// The spans are pulled by the orchestrator, when it doesn't pull them the oldest are dropped.
+	maxBufferedSpans = 1024
+
+	spanKindServer  = 2
+	statusCodeError = 2
+)
+
+type spanContextKey struct{}
+
+type Span struct {
+	traceID      string
+	spanID       string
+	parentSpanID string
+	name         string
+	start        time.Time
+	end          time.Time
+	attributes   map[string]string
+	err          error
+}
+
+type recorder struct {
+	mu sync.Mutex
+	// Trace ID of the sandbox execution, set by the orchestrator in the init call.
+	executionTraceID string
+	spans            []*Span
+}
+
+var spans = &recorder{}
+
+func randomID(size int) string {
+	b := make([]byte, size)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// parseTraceParent returns the trace and span IDs from the W3C traceparent header (version-traceid-spanid-flags).
+func parseTraceParent(header http.Header) (traceID, spanID string) {
+	parts := strings.Split(header.Get(traceParentHeader), "-")
+	if len(parts) != 4 || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return "", ""
+	}
+
+	return parts[1], parts[2]
+}
+
+// SetExecution ties the spans without the propagated trace context to the trace of the sandbox execution (create or resume).
+func SetExecution(header http.Header) {
+	traceID, _ := parseTraceParent(header)
+	if traceID == "" {
+		return
+	}
+
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	spans.executionTraceID = traceID
+}
+
+// Start starts a span that is a child of the span in the context, the span propagated in the headers or the sandbox execution trace.
+// If there is no trace to tie the span to, the span is not recorded.
+func Start(ctx context.Context, name string, header http.Header) (context.Context, *Span) {
+	span := &Span{
+		spanID:     randomID(8),
+		name:       name,
+		start:      time.Now(),
+		attributes: make(map[string]string),
+	}
+
+	if parent, ok := ctx.Value(spanContextKey{}).(*Span); ok && parent != nil {
+		span.traceID = parent.traceID
+		span.parentSpanID = parent.spanID
+	} else if traceID, spanID := parseTraceParent(header); traceID != "" {
+		span.traceID = traceID
+		span.parentSpanID = spanID
+	} else {
+		spans.mu.Lock()
+		span.traceID = spans.executionTraceID
+		spans.mu.Unlock()
+	}
+
+	if span.traceID == "" {
+		return ctx, nil
+	}
+
+	return context.WithValue(ctx, spanContextKey{}, span), span
+}
+
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil {
+		return
+	}
+
+	s.attributes[key] = value
+}
+
+// End records the span, the error is set as the span status.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+
+	s.end = time.Now()
+	s.err = err
+
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	if len(spans.spans) == maxBufferedSpans {
+		spans.spans = spans.spans[1:]
+	}
+
+	spans.spans = append(spans.spans, s)
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+// Export is the OTLP/JSON trace export request, it can be sent to the collector as is.
+type Export struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+func (s *Span) otlp() otlpSpan {
+	span := otlpSpan{
+		TraceID:           s.traceID,
+		SpanID:            s.spanID,
+		ParentSpanID:      s.parentSpanID,
+		Name:              s.name,
+		Kind:              spanKindServer,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+	}
+
+	for key, value := range s.attributes {
+		span.Attributes = append(span.Attributes, otlpAttribute{Key: key, Value: otlpValue{StringValue: value}})
+	}
+
+	if s.err != nil {
+		span.Status = otlpStatus{Code: statusCodeError, Message: s.err.Error()}
+	}
+
+	return span
+}
+
+// Flush returns the recorded spans in the OTLP format and removes them from the buffer.
+func Flush() Export {
+	spans.mu.Lock()
+	recorded := spans.spans
+	spans.spans = nil
+	spans.mu.Unlock()
+
+	exported := make([]otlpSpan, 0, len(recorded))
+	for _, span := range recorded {
+		exported = append(exported, span.otlp())
+	}
+
+	return Export{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpAttribute{
+						{Key: "service.name", Value: otlpValue{StringValue: serviceName}},
+					},
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: serviceName},
+						Spans: exported,
+					},
+				},
+			},
+		},
+	}
+}
@@ -9,9 +9,13 @@ import (
 	"time"
 
 	"github.com/e2b-dev/infra/packages/envd/internal/api"
+	"github.com/e2b-dev/infra/packages/envd/internal/hardening"
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
 	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	"github.com/e2b-dev/infra/packages/envd/internal/secrets"
 	filesystemRpc "github.com/e2b-dev/infra/packages/envd/internal/services/filesystem"
+	gitRpc "github.com/e2b-dev/infra/packages/envd/internal/services/git"
+	kernelRpc "github.com/e2b-dev/infra/packages/envd/internal/services/kernel"
 	processRpc "github.com/e2b-dev/infra/packages/envd/internal/services/process"
 	processSpec "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
 	"github.com/e2b-dev/infra/packages/envd/internal/utils"
@@ -20,6 +24,8 @@ import (
 	connectcors "connectrpc.com/cors"
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/cors"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 const (
@@ -32,7 +38,7 @@ const (
 
 var (
 	// These vars are automatically set by goreleaser.
-	Version = "0.1.5"
+	Version = "0.1.19"
 
 	debug bool
 	port  int64
@@ -89,6 +95,7 @@ func withCORS(h http.Handler) http.Handler {
 			"Cache-Control",
 			"X-Requested-With",
 			"X-Content-Type-Options",
+			logs.RequestIDHeader,
 			"Access-Control-Request-Method",
 			"Access-Control-Request-Headers",
 			"Access-Control-Request-Private-Network",
@@ -107,6 +114,11 @@ func withCORS(h http.Handler) http.Handler {
 }
 
 func main() {
+	// The processes are started through envd when the hardening policy is set, the flags of envd don't apply
+	if hardening.IsExec() {
+		hardening.Exec()
+	}
+
 	parseFlags()
 
 	if versionFlag {
@@ -130,15 +142,28 @@ func main() {
 
 	envVars.Store("E2B_SANDBOX", "true")
 
+	secretsLogger := l.With().Str("logger", "secrets").Logger()
+	sandboxSecrets := secrets.New(&secretsLogger)
+
+	hardeningLogger := l.With().Str("logger", "hardening").Logger()
+	processHardening := hardening.New(&hardeningLogger)
+
 	processLogger := l.With().Str("logger", "process").Logger()
-	processService := processRpc.Handle(m, &processLogger, envVars)
+	processService := processRpc.Handle(m, &processLogger, envVars, sandboxSecrets, processHardening)
+
+	gitLogger := l.With().Str("logger", "git").Logger()
+	gitRpc.Handle(m, &gitLogger, envVars, processHardening)
+
+	kernelLogger := l.With().Str("logger", "kernel").Logger()
+	kernelRpc.Handle(m, &kernelLogger, envVars, sandboxSecrets, processHardening)
 
-	handler := api.HandlerFromMux(api.New(&envLogger, envVars), m)
+	handler := api.HandlerFromMux(api.New(&envLogger, envVars, sandboxSecrets, processHardening), m)
 
 	middleware := authn.NewMiddleware(permissions.AuthenticateUsername)
 
 	s := &http.Server{
-		Handler:           withCORS(middleware.Wrap(handler)),
+		// The unencrypted HTTP/2 is needed for the bidirectional streams
+		Handler:           h2c.NewHandler(withCORS(middleware.Wrap(handler)), &http2.Server{}),
 		Addr:              fmt.Sprintf("0.0.0.0:%d", port),
 		ReadHeaderTimeout: 5 * time.Second,
 		ReadTimeout:       maxTimeout,
@@ -19,6 +19,7 @@ function build_version {
   echo "Copying finished build to builds directory"
   mkdir -p "../builds/${version_name}"
   cp build/cargo_target/x86_64-unknown-linux-musl/release/firecracker "../builds/${version_name}/firecracker"
+  cp build/cargo_target/x86_64-unknown-linux-musl/release/jailer "../builds/${version_name}/jailer"
 }
 
 echo "Cloning the Firecracker repository"
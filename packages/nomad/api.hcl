@@ -27,6 +27,19 @@ job "api" {
         timeout  = "5s"
         port     = "${port_number}"
       }
+
+      # The dependencies are probed less often and the API turns critical only after repeated failures,
+      # the degraded API is a warning that doesn't block the deployments
+      check {
+        type                     = "http"
+        name                     = "deep-health"
+        path                     = "/health/deep"
+        interval                 = "30s"
+        timeout                  = "10s"
+        port                     = "${port_number}"
+        failures_before_critical = 3
+        on_update                = "ignore_warnings"
+      }
     }
 
 %{ if update_stanza == "true" }
@@ -59,22 +72,34 @@ job "api" {
       }
 
       env {
-        ORCHESTRATOR_PORT             = "${orchestrator_port}"
-        TEMPLATE_MANAGER_ADDRESS      = "${template_manager_address}"
-        POSTGRES_CONNECTION_STRING    = "${postgres_connection_string}"
-        ENVIRONMENT                   = "${environment}"
-        POSTHOG_API_KEY               = "${posthog_api_key}"
-        ANALYTICS_COLLECTOR_HOST      = "${analytics_collector_host}"
-        ANALYTICS_COLLECTOR_API_TOKEN = "${analytics_collector_api_token}"
-        LOKI_ADDRESS                  = "${loki_address}"
-        OTEL_TRACING_PRINT            = "${otel_tracing_print}"
-        LOGS_COLLECTOR_ADDRESS        = "${logs_collector_address}"
-        NOMAD_TOKEN                   = "${nomad_acl_token}"
-        OTEL_COLLECTOR_GRPC_ENDPOINT  = "${otel_collector_grpc_endpoint}"
-        ADMIN_TOKEN                   = "${admin_token}"
-        REDIS_URL                     = "${redis_url}"
-        # This is here just because it is required in some part of our code which is transitively imported
-        TEMPLATE_BUCKET_NAME          = "skip"
+        ORCHESTRATOR_PORT                       = "${orchestrator_port}"
+        ORCHESTRATOR_DISCOVERY                  = "${orchestrator_discovery}"
+        CONSUL_TOKEN                            = "${consul_acl_token}"
+        TEMPLATE_MANAGER_ADDRESS                = "${template_manager_address}"
+        POSTGRES_CONNECTION_STRING              = "${postgres_connection_string}"
+        POSTGRES_READ_REPLICA_CONNECTION_STRING = "${postgres_read_replica_connection_string}"
+        ENVIRONMENT                             = "${environment}"
+        POSTHOG_API_KEY                         = "${posthog_api_key}"
+        ANALYTICS_COLLECTOR_HOST                = "${analytics_collector_host}"
+        ANALYTICS_COLLECTOR_API_TOKEN           = "${analytics_collector_api_token}"
+        LOKI_ADDRESS                            = "${loki_address}"
+        SANDBOX_LOGS_RETENTION_DAYS             = "${sandbox_logs_retention_days}"
+        OTEL_TRACING_PRINT                      = "${otel_tracing_print}"
+        LOGS_COLLECTOR_ADDRESS                  = "${logs_collector_address}"
+        NOMAD_TOKEN                             = "${nomad_acl_token}"
+        OTEL_COLLECTOR_GRPC_ENDPOINT            = "${otel_collector_grpc_endpoint}"
+        ADMIN_TOKEN                             = "${admin_token}"
+        SECRETS_ENCRYPTION_KEYS                 = "${secrets_encryption_keys}"
+        SECRETS_KEY_MANAGER                     = "${secrets_key_manager}"
+        SECRETS_ENCRYPTION_KEY                  = "${secrets_encryption_key}"
+        REDIS_URL                               = "${redis_url}"
+        ALLOWED_KERNEL_VERSIONS                 = "${allowed_kernel_versions}"
+        FIRECRACKER_CANARY_VERSION              = "${firecracker_canary_version}"
+        FIRECRACKER_CANARY_PERCENTAGE           = "${firecracker_canary_percentage}"
+        FIRECRACKER_CANARY_TEMPLATES            = "${firecracker_canary_templates}"
+        TEMPLATE_BUCKET_NAME                    = "${template_bucket_name}"
+        TEMPLATE_BUCKET_REPLICAS                = "${template_bucket_replicas}"
+        PROXY_HEALTH_URLS                       = "${proxy_health_urls}"
       }
 
       config {
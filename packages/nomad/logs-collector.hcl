@@ -80,6 +80,11 @@ job "logs-collector" {
           "health",
           "logs",
         ]
+
+        # The access logs of the client proxy on the API nodes
+        volumes = [
+          "/var/log/client-proxy:/var/log/client-proxy:ro",
+        ]
       }
 
       env {
@@ -124,6 +129,14 @@ del(."_path")
 if !exists(.envID) {
   .envID = "unknown"
 }
+if .event_type == "stdout" || .event_type == "stderr" {
+  .stream = .event_type
+} else {
+  .stream = "system"
+}
+if !exists(.level) {
+  .level = "info"
+}
 """
 
 [transforms.internal_routing]
@@ -140,6 +153,34 @@ source = '''
 del(.internal)
 '''
 
+# Only the server errors of the client proxy are shipped, the proxy logs every 5xx response with the sandbox ID
+[sources.client_proxy]
+type = "file"
+include = [ "/var/log/client-proxy/access.log" ]
+
+[transforms.parse_client_proxy]
+type = "remap"
+inputs = [ "client_proxy" ]
+drop_on_error = true
+source = """
+. = object!(parse_json!(string!(.message)))
+"""
+
+[transforms.client_proxy_server_errors]
+type = "filter"
+inputs = [ "parse_client_proxy" ]
+condition = 'is_integer(.status) && int!(.status) >= 500 && is_string(.sandbox_id) && .sandbox_id != ""'
+
+[sinks.client_proxy_loki_logs]
+type = "loki"
+inputs = [ "client_proxy_server_errors" ]
+endpoint = "http://loki.service.consul:${var.loki_service_port_number}"
+encoding.codec = "json"
+
+[sinks.client_proxy_loki_logs.labels]
+source = "client-proxy"
+sandboxID = "{{ sandbox_id }}"
+
 [sinks.local_loki_logs]
 type = "loki"
 inputs = [ "remove_internal" ]
@@ -153,6 +194,8 @@ teamID = "{{ teamID }}"
 envID = "{{ envID }}"
 sandboxID = "{{ sandboxID }}"
 category = "{{ category }}"
+stream = "{{ stream }}"
+level = "{{ level }}"
 
 %{ if var.grafana_logs_endpoint != " " }
 [sinks.grafana]
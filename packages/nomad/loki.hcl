@@ -14,6 +14,10 @@ variable "loki_bucket_name" {
   type = string
 }
 
+variable "sandbox_logs_retention_days" {
+  type = number
+}
+
 job "loki" {
   datacenters = [var.gcp_zone]
   type        = "service"
@@ -140,6 +144,11 @@ compactor:
 # The bucket lifecycle policy should be set to delete objects after MORE than the specified retention period
 limits_config:
   retention_period: 168h
+  # The logs of the sandboxes are kept longer, so they can be retrieved after the sandbox is killed
+  retention_stream:
+    - selector: '{source="logs-collector", service="envd"}'
+      priority: 1
+      period: ${var.sandbox_logs_retention_days * 24}h
   ingestion_rate_mb: 100
   ingestion_burst_size_mb: 500
   per_stream_rate_limit: "80MB"
@@ -3,6 +3,10 @@ data "google_secret_manager_secret_version" "postgres_connection_string" {
   secret = var.postgres_connection_string_secret_name
 }
 
+data "google_secret_manager_secret_version" "postgres_read_replica_connection_string" {
+  secret = var.postgres_read_replica_connection_string_secret_name
+}
+
 data "google_secret_manager_secret_version" "posthog_api_key" {
   secret = var.posthog_api_key_secret_name
 }
@@ -48,6 +52,10 @@ data "google_secret_manager_secret_version" "api_admin_token" {
   secret = var.api_admin_token_name
 }
 
+data "google_secret_manager_secret_version" "secrets_encryption_keys" {
+  secret = var.secrets_encryption_keys_secret_name
+}
+
 provider "nomad" {
   address      = "https://nomad.${var.domain_name}"
   secret_id    = var.nomad_acl_token_secret
@@ -56,25 +64,39 @@ provider "nomad" {
 
 resource "nomad_job" "api" {
   jobspec = templatefile("${path.module}/api.hcl", {
-    update_stanza                 = var.api_machine_count > 1
-    orchestrator_port             = var.orchestrator_port
-    template_manager_address      = "http://template-manager.service.consul:${var.template_manager_port}"
-    otel_collector_grpc_endpoint  = "localhost:4317"
-    loki_address                  = "http://localhost:${var.loki_service_port.port}"
-    logs_collector_address        = "http://localhost:${var.logs_proxy_port.port}"
-    gcp_zone                      = var.gcp_zone
-    port_name                     = var.api_port.name
-    port_number                   = var.api_port.port
-    api_docker_image              = var.api_docker_image_digest
-    postgres_connection_string    = data.google_secret_manager_secret_version.postgres_connection_string.secret_data
-    posthog_api_key               = data.google_secret_manager_secret_version.posthog_api_key.secret_data
-    environment                   = var.environment
-    analytics_collector_host      = data.google_secret_manager_secret_version.analytics_collector_host.secret_data
-    analytics_collector_api_token = data.google_secret_manager_secret_version.analytics_collector_api_token.secret_data
-    otel_tracing_print            = var.otel_tracing_print
-    nomad_acl_token               = var.nomad_acl_token_secret
-    admin_token                   = data.google_secret_manager_secret_version.api_admin_token.secret_data
-    redis_url                     = "redis://redis.service.consul:${var.redis_port.port}"
+    update_stanza                           = var.api_machine_count > 1
+    orchestrator_port                       = var.orchestrator_port
+    orchestrator_discovery                  = var.orchestrator_discovery
+    consul_acl_token                        = var.consul_acl_token_secret
+    template_manager_address                = "http://template-manager.service.consul:${var.template_manager_port}"
+    otel_collector_grpc_endpoint            = "localhost:4317"
+    loki_address                            = "http://localhost:${var.loki_service_port.port}"
+    sandbox_logs_retention_days             = var.sandbox_logs_retention_days
+    logs_collector_address                  = "http://localhost:${var.logs_proxy_port.port}"
+    gcp_zone                                = var.gcp_zone
+    port_name                               = var.api_port.name
+    port_number                             = var.api_port.port
+    api_docker_image                        = var.api_docker_image_digest
+    postgres_connection_string              = data.google_secret_manager_secret_version.postgres_connection_string.secret_data
+    postgres_read_replica_connection_string = data.google_secret_manager_secret_version.postgres_read_replica_connection_string.secret_data
+    posthog_api_key                         = data.google_secret_manager_secret_version.posthog_api_key.secret_data
+    environment                             = var.environment
+    analytics_collector_host                = data.google_secret_manager_secret_version.analytics_collector_host.secret_data
+    analytics_collector_api_token           = data.google_secret_manager_secret_version.analytics_collector_api_token.secret_data
+    otel_tracing_print                      = var.otel_tracing_print
+    nomad_acl_token                         = var.nomad_acl_token_secret
+    admin_token                             = data.google_secret_manager_secret_version.api_admin_token.secret_data
+    secrets_encryption_keys                 = data.google_secret_manager_secret_version.secrets_encryption_keys.secret_data
+    secrets_key_manager                     = var.secrets_encryption.kms
+    secrets_encryption_key                  = var.secrets_encryption.key
+    redis_url                               = "redis://redis.service.consul:${var.redis_port.port}"
+    allowed_kernel_versions                 = join(",", var.allowed_kernel_versions)
+    firecracker_canary_version              = var.firecracker_canary.version
+    firecracker_canary_percentage           = var.firecracker_canary.percentage
+    firecracker_canary_templates            = join(",", var.firecracker_canary.templates)
+    template_bucket_name                    = var.template_bucket_name
+    template_bucket_replicas                = join(",", [for region, bucket in var.template_bucket_replicas : "${region}=${bucket}"])
+    proxy_health_urls                       = "client-proxy=http://client-proxy.service.consul:${var.client_proxy_health_port.port}${var.client_proxy_health_port.path},session-proxy=http://${var.session_proxy_service_name}.service.consul:3004/health"
   })
 }
 
@@ -108,6 +130,38 @@ resource "nomad_job" "docker_reverse_proxy" {
   }
 }
 
+locals {
+  # The streams to envd are kept alive by the envd keepalive messages, the idle timeout must be longer than their interval
+  client_proxy_routes = [
+    {
+      server_name  = "~^49983-"
+      idle_timeout = var.client_proxy_timeouts.envd_idle_timeout_s
+      default      = false
+    },
+    {
+      server_name  = "_"
+      idle_timeout = var.client_proxy_timeouts.sandbox_idle_timeout_s
+      default      = true
+    },
+  ]
+
+  # The load balancer appends the client and its own address to X-Forwarded-For and connects from the Google front-end ranges
+  client_proxy_trusted_proxies = ["35.191.0.0/16", "130.211.0.0/22", "${var.load_balancer_ip}/32"]
+
+  client_proxy_conf = templatefile("${path.module}/proxies/client.conf", {
+    domain_name_escaped       = replace(var.domain_name, ".", "\\.")
+    max_body_size_mb          = var.client_proxy_limits.max_body_size_mb
+    max_response_rate_kb      = var.client_proxy_limits.max_response_rate_kb
+    max_streams_per_sandbox   = var.client_proxy_limits.max_streams_per_sandbox
+    max_requests_per_s_client = var.client_proxy_limits.max_requests_per_s_client
+    request_burst_per_client  = var.client_proxy_limits.request_burst_per_client
+    access_log_sample_percent = var.client_proxy_access_log_sample_percent
+    tcp_keepalive_idle_s      = var.client_proxy_timeouts.tcp_keepalive_idle_s
+    trusted_proxies           = local.client_proxy_trusted_proxies
+    routes                    = local.client_proxy_routes
+  })
+}
+
 resource "nomad_job" "client_proxy" {
   jobspec = file("${path.module}/client-proxy.hcl")
 
@@ -119,7 +173,7 @@ resource "nomad_job" "client_proxy" {
       client_proxy_health_port_number = var.client_proxy_health_port.port
       client_proxy_health_port_name   = var.client_proxy_health_port.name
       client_proxy_health_port_path   = var.client_proxy_health_port.path
-      load_balancer_conf              = templatefile("${path.module}/proxies/client.conf", { domain_name_escaped = replace(var.domain_name, ".", "\\.") })
+      load_balancer_conf              = local.client_proxy_conf
       nginx_conf                      = file("${path.module}/proxies/nginx.conf")
     }
   }
@@ -202,20 +256,47 @@ data "external" "orchestrator_checksum" {
   }
 }
 
+# Shared by the storage cache servers and their clients on the orchestrator nodes
+resource "random_password" "storage_cache_token" {
+  length  = 32
+  special = false
+}
+
+# Separate from the API admin token, so the token on the orchestrator nodes doesn't grant the admin access to the API
+resource "random_password" "orchestrator_diagnostics_token" {
+  length  = 32
+  special = false
+}
+
 resource "nomad_job" "orchestrator" {
   jobspec = templatefile("${path.module}/orchestrator.hcl", {
     gcp_zone         = var.gcp_zone
     port             = var.orchestrator_port
+    health_port      = var.orchestrator_health_port
+    diagnostics_port = var.orchestrator_diagnostics_port
     environment      = var.environment
     consul_acl_token = var.consul_acl_token_secret
 
-    bucket_name                  = var.fc_env_pipeline_bucket_name
-    orchestrator_checksum        = data.external.orchestrator_checksum.result.hex
-    logs_collector_address       = "http://localhost:${var.logs_proxy_port.port}"
-    logs_collector_public_ip     = var.logs_proxy_address
-    otel_tracing_print           = var.otel_tracing_print
-    template_bucket_name         = var.template_bucket_name
-    otel_collector_grpc_endpoint = "localhost:4317"
+    bucket_name                   = var.fc_env_pipeline_bucket_name
+    orchestrator_checksum         = data.external.orchestrator_checksum.result.hex
+    logs_collector_address        = "http://localhost:${var.logs_proxy_port.port}"
+    logs_collector_public_ip      = var.logs_proxy_address
+    otel_tracing_print            = var.otel_tracing_print
+    template_bucket_name          = var.template_bucket_name
+    template_bucket_replicas      = join(",", [for region, bucket in var.template_bucket_replicas : "${region}=${bucket}"])
+    kernels_bucket_name           = var.kernels_bucket_name
+    otel_collector_grpc_endpoint  = "localhost:4317"
+    otel_collector_http_endpoint  = "localhost:4318"
+    diagnostics_token             = random_password.orchestrator_diagnostics_token.result
+    storage_cache_enabled         = var.storage_cache.enabled
+    storage_cache_port            = var.storage_cache.port
+    storage_cache_max_size_gb     = var.storage_cache.max_size_gb
+    storage_cache_token           = random_password.storage_cache_token.result
+    snapshot_encryption_kms       = var.snapshot_encryption.kms
+    snapshot_encryption_key       = var.snapshot_encryption.key
+    firecracker_jailer_percentage = var.firecracker_jailer.percentage
+    firecracker_jailer_uid_base   = var.firecracker_jailer.uid_base
+    eviction_policy               = var.eviction_policy
   })
 }
 
@@ -263,7 +344,8 @@ resource "nomad_job" "loki" {
     vars = {
       gcp_zone = var.gcp_zone
 
-      loki_bucket_name = var.loki_bucket_name
+      loki_bucket_name            = var.loki_bucket_name
+      sandbox_logs_retention_days = var.sandbox_logs_retention_days
 
       loki_service_port_number = var.loki_service_port.port
       loki_service_port_name   = var.loki_service_port.name
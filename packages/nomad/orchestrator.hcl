@@ -9,12 +9,28 @@ job "orchestrator" {
       port "orchestrator" {
         static = "${port}"
       }
+      port "health" {
+        static = "${health_port}"
+      }
+      port "diagnostics" {
+        static = "${diagnostics_port}"
+      }
+%{ if storage_cache_enabled }
+      port "storage-cache" {
+        static = "${storage_cache_port}"
+      }
+%{ endif }
     }
 
     service {
       name = "orchestrator"
       port = "${port}"
 
+      # The API discovering the orchestrators from Consul reads the Nomad ID of the node from the meta
+      meta {
+        node_id = "$${node.unique.id}"
+      }
+
       check {
         type         = "grpc"
         name         = "health"
@@ -23,25 +39,66 @@ job "orchestrator" {
         grpc_use_tls = false
         port         = "${port}"
       }
+
+      # The node turns critical only after repeated failures of the dependencies, the degraded node is a warning
+      check {
+        type                     = "http"
+        name                     = "deep-health"
+        path                     = "/health/deep"
+        interval                 = "30s"
+        timeout                  = "10s"
+        port                     = "health"
+        failures_before_critical = 3
+        on_update                = "ignore_warnings"
+      }
+    }
+
+%{ if storage_cache_enabled }
+    service {
+      name = "storage-cache"
+      port = "${storage_cache_port}"
+
+      check {
+        type     = "tcp"
+        name     = "health"
+        interval = "20s"
+        timeout  = "5s"
+        port     = "${storage_cache_port}"
+      }
     }
+%{ endif }
 
     task "start" {
       driver = "raw_exec"
 
       env {
-        NODE_ID                      = "$${node.unique.id}"
-        CONSUL_TOKEN                 = "${consul_acl_token}"
-        OTEL_TRACING_PRINT           = "${otel_tracing_print}"
-        LOGS_COLLECTOR_ADDRESS       = "${logs_collector_address}"
-        LOGS_COLLECTOR_PUBLIC_IP     = "${logs_collector_public_ip}"
-        ENVIRONMENT                  = "${environment}"
-        TEMPLATE_BUCKET_NAME         = "${template_bucket_name}"
-        OTEL_COLLECTOR_GRPC_ENDPOINT = "${otel_collector_grpc_endpoint}"
+        NODE_ID                       = "$${node.unique.id}"
+        NODE_IP                       = "$${attr.unique.network.ip-address}"
+        REGION                        = "$${node.datacenter}"
+        CONSUL_TOKEN                  = "${consul_acl_token}"
+        OTEL_TRACING_PRINT            = "${otel_tracing_print}"
+        LOGS_COLLECTOR_ADDRESS        = "${logs_collector_address}"
+        LOGS_COLLECTOR_PUBLIC_IP      = "${logs_collector_public_ip}"
+        ENVIRONMENT                   = "${environment}"
+        TEMPLATE_BUCKET_NAME          = "${template_bucket_name}"
+        TEMPLATE_BUCKET_REPLICAS      = "${template_bucket_replicas}"
+        KERNELS_BUCKET_NAME           = "${kernels_bucket_name}"
+        OTEL_COLLECTOR_GRPC_ENDPOINT  = "${otel_collector_grpc_endpoint}"
+        OTEL_COLLECTOR_HTTP_ENDPOINT  = "${otel_collector_http_endpoint}"
+        DIAGNOSTICS_TOKEN             = "${diagnostics_token}"
+        STORAGE_CACHE_ENABLED         = "${storage_cache_enabled}"
+        STORAGE_CACHE_MAX_SIZE_GB     = "${storage_cache_max_size_gb}"
+        STORAGE_CACHE_TOKEN           = "${storage_cache_token}"
+        SNAPSHOT_ENCRYPTION_KMS       = "${snapshot_encryption_kms}"
+        SNAPSHOT_ENCRYPTION_KEY       = "${snapshot_encryption_key}"
+        FIRECRACKER_JAILER_PERCENTAGE = "${firecracker_jailer_percentage}"
+        FIRECRACKER_JAILER_UID_BASE   = "${firecracker_jailer_uid_base}"
+        EVICTION_POLICY               = "${eviction_policy}"
       }
 
       config {
         command = "/bin/bash"
-        args    = ["-c", " chmod +x local/orchestrator && local/orchestrator --port ${port}"]
+        args    = ["-c", " chmod +x local/orchestrator && local/orchestrator --port ${port} --storage-cache-port ${storage_cache_port} --health-port ${health_port} --diagnostics-port ${diagnostics_port}"]
       }
 
       artifact {
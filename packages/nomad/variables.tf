@@ -10,6 +10,14 @@ variable "template_bucket_name" {
   type = string
 }
 
+variable "template_bucket_replicas" {
+  type = map(string)
+}
+
+variable "kernels_bucket_name" {
+  type = string
+}
+
 variable "nomad_acl_token_secret" {
   type = string
 }
@@ -43,6 +51,29 @@ variable "api_admin_token_name" {
   type = string
 }
 
+variable "secrets_encryption_keys_secret_name" {
+  type = string
+}
+
+variable "secrets_encryption" {
+  type = object({
+    kms = string
+    key = string
+  })
+}
+
+variable "allowed_kernel_versions" {
+  type = list(string)
+}
+
+variable "firecracker_canary" {
+  type = object({
+    version    = string
+    percentage = number
+    templates  = list(string)
+  })
+}
+
 variable "logs_proxy_address" {
   type = string
 }
@@ -83,6 +114,10 @@ variable "postgres_connection_string_secret_name" {
   type = string
 }
 
+variable "postgres_read_replica_connection_string_secret_name" {
+  type = string
+}
+
 # Proxies
 variable "session_proxy_service_name" {
   type = string
@@ -110,6 +145,32 @@ variable "client_proxy_port" {
   })
 }
 
+variable "load_balancer_ip" {
+  type = string
+}
+
+variable "client_proxy_limits" {
+  type = object({
+    max_body_size_mb          = number
+    max_response_rate_kb      = number
+    max_streams_per_sandbox   = number
+    max_requests_per_s_client = number
+    request_burst_per_client  = number
+  })
+}
+
+variable "client_proxy_timeouts" {
+  type = object({
+    envd_idle_timeout_s    = number
+    sandbox_idle_timeout_s = number
+    tcp_keepalive_idle_s   = number
+  })
+}
+
+variable "client_proxy_access_log_sample_percent" {
+  type = number
+}
+
 variable "domain_name" {
   type = string
 }
@@ -177,6 +238,10 @@ variable "loki_service_port" {
   })
 }
 
+variable "sandbox_logs_retention_days" {
+  type = number
+}
+
 # Docker reverse proxy
 variable "docker_reverse_proxy_image_digest" {
   type = string
@@ -199,6 +264,44 @@ variable "orchestrator_port" {
   type = number
 }
 
+variable "orchestrator_health_port" {
+  type = number
+}
+
+variable "orchestrator_diagnostics_port" {
+  type = number
+}
+
+variable "orchestrator_discovery" {
+  type = string
+}
+
+variable "storage_cache" {
+  type = object({
+    enabled     = bool
+    port        = number
+    max_size_gb = number
+  })
+}
+
+variable "snapshot_encryption" {
+  type = object({
+    kms = string
+    key = string
+  })
+}
+
+variable "firecracker_jailer" {
+  type = object({
+    percentage = number
+    uid_base   = number
+  })
+}
+
+variable "eviction_policy" {
+  type = string
+}
+
 variable "fc_env_pipeline_bucket_name" {
   type = string
 }
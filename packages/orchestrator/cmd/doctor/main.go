@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/doctor"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+func main() {
+	fix := flag.Bool("fix", false, "fix the problems that can be fixed in place, the fixes of the sysctls last until the reboot")
+	kernelVersion := flag.String("kernel-version", schema.DefaultKernelVersion, "kernel version the host is checked with")
+	firecrackerVersion := flag.String("firecracker-version", schema.DefaultFirecrackerVersion, "firecracker version the host is checked with")
+	templateBucket := flag.String("template-bucket", os.Getenv("TEMPLATE_BUCKET_NAME"), "bucket the templates are read from")
+
+	flag.Parse()
+
+	checks := doctor.Checks(doctor.Config{
+		KernelVersion:      *kernelVersion,
+		FirecrackerVersion: *firecrackerVersion,
+		TemplateBucket:     *templateBucket,
+	})
+
+	results := doctor.Run(context.Background(), checks, *fix)
+
+	failed := doctor.Print(os.Stdout, results)
+	if failed > 0 {
+		fmt.Printf("\n%d of %d checks failed, the orchestrator won't run on this host\n", failed, len(results))
+
+		os.Exit(1)
+	}
+
+	fmt.Printf("\nThe host is ready to run the orchestrator\n")
+}
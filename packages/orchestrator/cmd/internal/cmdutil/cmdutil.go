@@ -0,0 +1,71 @@
+// Package cmdutil sets up the local environment the debugging commands run the sandboxes in.
+package cmdutil
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/dns"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+)
+
+const (
+	dnsAddress = "127.0.0.4"
+	dnsPort    = 53
+)
+
+// Env is the local environment of the sandboxes.
+type Env struct {
+	DNS           *dns.DNS
+	TemplateCache *template.Cache
+	NetworkPool   *network.Pool
+}
+
+// SignalContext returns a context that is canceled on interrupt.
+func SignalContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt)
+}
+
+// Setup starts the DNS server and creates the template cache and the network pool for the given number of sandboxes.
+func Setup(ctx context.Context, sandboxes int) (*Env, error) {
+	dnsServer := dns.New()
+	go func() {
+		log.Printf("Starting DNS server")
+
+		err := dnsServer.Start(dnsAddress, dnsPort)
+		if err != nil {
+			log.Fatalf("Failed running DNS server: %s\n", err.Error())
+		}
+	}()
+
+	templateCache, err := template.NewCache(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create template cache: %w", err)
+	}
+
+	networkPool, err := network.NewPool(ctx, sandboxes, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network pool: %w", err)
+	}
+
+	nbd.Pool, err = nbd.NewDevicePool()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create nbd device pool: %w", err)
+	}
+
+	return &Env{
+		DNS:           dnsServer,
+		TemplateCache: templateCache,
+		NetworkPool:   networkPool,
+	}, nil
+}
+
+// Close releases the network slots of the environment.
+func (e *Env) Close() error {
+	return e.NetworkPool.Close()
+}
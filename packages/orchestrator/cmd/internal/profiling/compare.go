@@ -0,0 +1,155 @@
+package profiling
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Delta is the change of the function between the baseline and the candidate profile. Flat is the time
+// spent in the function itself and Cum the time spent in the function and the functions it called.
+type Delta struct {
+	Function      string
+	BaselineFlat  int64
+	CandidateFlat int64
+	BaselineCum   int64
+	CandidateCum  int64
+}
+
+func (d Delta) FlatDelta() int64 {
+	return d.CandidateFlat - d.BaselineFlat
+}
+
+func (d Delta) CumDelta() int64 {
+	return d.CandidateCum - d.BaselineCum
+}
+
+type totals struct {
+	flat int64
+	cum  int64
+}
+
+// functionTotals sums the values of the functions, the recursive functions are counted once per stack in cum.
+func (p *Profile) functionTotals() (map[string]totals, int64) {
+	result := make(map[string]totals)
+	var total int64
+
+	for _, s := range p.Samples {
+		total += s.Value
+
+		seen := make(map[string]bool, len(s.Stack))
+		for i, fn := range s.Stack {
+			t := result[fn]
+			if !seen[fn] {
+				seen[fn] = true
+				t.cum += s.Value
+			}
+
+			if i == len(s.Stack)-1 {
+				t.flat += s.Value
+			}
+
+			result[fn] = t
+		}
+	}
+
+	return result, total
+}
+
+// Comparison is the difference between two runs of the same benchmark.
+type Comparison struct {
+	ValueType      string
+	BaselineTotal  int64
+	CandidateTotal int64
+	// Deltas are sorted by the absolute change of cum, the largest first.
+	Deltas []Delta
+}
+
+// Compare computes the per-function deltas between the baseline and the candidate profile.
+func Compare(baseline, candidate *Profile) (*Comparison, error) {
+	if baseline.ValueType != candidate.ValueType {
+		return nil, fmt.Errorf("profiles have different value types '%s' and '%s'", baseline.ValueType, candidate.ValueType)
+	}
+
+	baselineTotals, baselineTotal := baseline.functionTotals()
+	candidateTotals, candidateTotal := candidate.functionTotals()
+
+	deltas := make(map[string]*Delta)
+	get := func(fn string) *Delta {
+		d, ok := deltas[fn]
+		if !ok {
+			d = &Delta{Function: fn}
+			deltas[fn] = d
+		}
+
+		return d
+	}
+
+	for fn, t := range baselineTotals {
+		d := get(fn)
+		d.BaselineFlat = t.flat
+		d.BaselineCum = t.cum
+	}
+
+	for fn, t := range candidateTotals {
+		d := get(fn)
+		d.CandidateFlat = t.flat
+		d.CandidateCum = t.cum
+	}
+
+	c := &Comparison{
+		ValueType:      baseline.ValueType,
+		BaselineTotal:  baselineTotal,
+		CandidateTotal: candidateTotal,
+		Deltas:         make([]Delta, 0, len(deltas)),
+	}
+
+	for _, d := range deltas {
+		c.Deltas = append(c.Deltas, *d)
+	}
+
+	slices.SortFunc(c.Deltas, func(a, b Delta) int {
+		return cmp.Or(cmp.Compare(abs(b.CumDelta()), abs(a.CumDelta())), cmp.Compare(a.Function, b.Function))
+	})
+
+	return c, nil
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+// Print writes the totals and the top functions by the change of cum, all functions if top is 0.
+func (c *Comparison) Print(w io.Writer, top int) {
+	fmt.Fprintf(w, "total %s: baseline %d, candidate %d, delta %+d (%s)\n\n",
+		c.ValueType, c.BaselineTotal, c.CandidateTotal, c.CandidateTotal-c.BaselineTotal, percent(c.BaselineTotal, c.CandidateTotal))
+
+	fmt.Fprintf(w, "%14s %14s %14s %9s %14s  %s\n", "baseline cum", "candidate cum", "cum delta", "cum %", "flat delta", "function")
+
+	deltas := c.Deltas
+	if top > 0 && len(deltas) > top {
+		deltas = deltas[:top]
+	}
+
+	for _, d := range deltas {
+		fmt.Fprintf(w, "%14d %14d %+14d %9s %+14d  %s\n",
+			d.BaselineCum, d.CandidateCum, d.CumDelta(), percent(d.BaselineCum, d.CandidateCum), d.FlatDelta(), d.Function)
+	}
+}
+
+func percent(baseline, candidate int64) string {
+	if baseline == 0 {
+		if candidate == 0 {
+			return "0%"
+		}
+
+		return "new"
+	}
+
+	return fmt.Sprintf("%+.1f%%", float64(candidate-baseline)/float64(baseline)*100)
+}
@@ -0,0 +1,321 @@
+// Package profiling records the CPU profiles of the debugging commands and turns them into the folded stacks
+// for the flamegraphs and into the per-function deltas between two runs.
+//
+// The profiles are decoded here instead of with the pprof library, only the stacks and the values are needed.
+package profiling
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"runtime/pprof"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Fields of the pprof profile.proto messages the stacks are decoded from.
+const (
+	profileSampleType  = 1
+	profileSample      = 2
+	profileLocation    = 4
+	profileFunction    = 5
+	profileStringTable = 6
+
+	valueTypeType = 1
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+
+	functionID   = 1
+	functionName = 2
+)
+
+// Sample is one stack of the profile, the stack starts with the root function.
+type Sample struct {
+	Stack []string
+	Value int64
+}
+
+// Profile is the decoded CPU profile, the values are in the unit of the last sample type, i.e. CPU nanoseconds.
+type Profile struct {
+	ValueType string
+	Samples   []Sample
+}
+
+// StartCPUProfile starts profiling the CPU into the file, the returned function stops the profiling.
+func StartCPUProfile(path string) (func() error, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CPU profile: %w", err)
+	}
+
+	err = pprof.StartCPUProfile(f)
+	if err != nil {
+		f.Close()
+
+		return nil, fmt.Errorf("failed to start CPU profile: %w", err)
+	}
+
+	return func() error {
+		pprof.StopCPUProfile()
+
+		return f.Close()
+	}, nil
+}
+
+// ReadFile reads the profile in the pprof format, the profile can be gzipped.
+func ReadFile(path string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profile: %w", err)
+	}
+
+	if bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress profile: %w", err)
+		}
+
+		data, err = io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress profile: %w", err)
+		}
+	}
+
+	p, err := parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse profile '%s': %w", path, err)
+	}
+
+	return p, nil
+}
+
+type rawSample struct {
+	locations []uint64
+	values    []int64
+}
+
+func parse(data []byte) (*Profile, error) {
+	var strs []string
+	var valueTypes []uint64
+	var samples []rawSample
+	// locations are the function IDs of the location by its ID, the inlined functions are first.
+	locations := make(map[uint64][]uint64)
+	functions := make(map[uint64]uint64)
+
+	err := forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		switch num {
+		case profileStringTable:
+			strs = append(strs, string(value))
+		case profileSampleType:
+			return forEachField(value, func(num protowire.Number, _ protowire.Type, _ []byte, varint uint64) error {
+				if num == valueTypeType {
+					valueTypes = append(valueTypes, varint)
+				}
+
+				return nil
+			})
+		case profileSample:
+			var s rawSample
+			err := forEachField(value, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+				switch num {
+				case sampleLocationID:
+					return appendVarints(&s.locations, typ, value, varint)
+				case sampleValue:
+					var values []uint64
+					err := appendVarints(&values, typ, value, varint)
+					for _, v := range values {
+						s.values = append(s.values, int64(v))
+					}
+
+					return err
+				}
+
+				return nil
+			})
+			samples = append(samples, s)
+
+			return err
+		case profileLocation:
+			var id uint64
+			var funcs []uint64
+			err := forEachField(value, func(num protowire.Number, _ protowire.Type, value []byte, varint uint64) error {
+				switch num {
+				case locationID:
+					id = varint
+				case locationLine:
+					return forEachField(value, func(num protowire.Number, _ protowire.Type, _ []byte, varint uint64) error {
+						if num == lineFunctionID {
+							funcs = append(funcs, varint)
+						}
+
+						return nil
+					})
+				}
+
+				return nil
+			})
+			locations[id] = funcs
+
+			return err
+		case profileFunction:
+			var id, name uint64
+			err := forEachField(value, func(num protowire.Number, _ protowire.Type, _ []byte, varint uint64) error {
+				switch num {
+				case functionID:
+					id = varint
+				case functionName:
+					name = varint
+				}
+
+				return nil
+			})
+			functions[id] = name
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	str := func(idx uint64) string {
+		if idx >= uint64(len(strs)) {
+			return ""
+		}
+
+		return strs[idx]
+	}
+
+	if len(valueTypes) == 0 {
+		return nil, fmt.Errorf("profile has no sample types")
+	}
+
+	p := &Profile{ValueType: str(valueTypes[len(valueTypes)-1])}
+
+	for _, s := range samples {
+		if len(s.values) != len(valueTypes) {
+			return nil, fmt.Errorf("sample has %d values, expected %d", len(s.values), len(valueTypes))
+		}
+
+		// The first location is the leaf and the callers of the inlined functions are after them
+		var stack []string
+		for i := len(s.locations) - 1; i >= 0; i-- {
+			funcs := locations[s.locations[i]]
+			for j := len(funcs) - 1; j >= 0; j-- {
+				stack = append(stack, str(functions[funcs[j]]))
+			}
+		}
+
+		p.Samples = append(p.Samples, Sample{Stack: stack, Value: s.values[len(s.values)-1]})
+	}
+
+	return p, nil
+}
+
+// forEachField calls fn for every field of the message, the value is set for the length-delimited fields
+// and varint for the varint fields.
+func forEachField(data []byte, fn func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return fmt.Errorf("failed to decode field tag: %w", protowire.ParseError(n))
+		}
+		data = data[n:]
+
+		var value []byte
+		var varint uint64
+
+		switch typ {
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(data)
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+
+		if n < 0 {
+			return fmt.Errorf("failed to decode field %d: %w", num, protowire.ParseError(n))
+		}
+		data = data[n:]
+
+		err := fn(num, typ, value, varint)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appendVarints appends the repeated varint field, the field is either packed or one value per field.
+func appendVarints(values *[]uint64, typ protowire.Type, value []byte, varint uint64) error {
+	if typ == protowire.VarintType {
+		*values = append(*values, varint)
+
+		return nil
+	}
+
+	for len(value) > 0 {
+		v, n := protowire.ConsumeVarint(value)
+		if n < 0 {
+			return fmt.Errorf("failed to decode packed field: %w", protowire.ParseError(n))
+		}
+
+		*values = append(*values, v)
+		value = value[n:]
+	}
+
+	return nil
+}
+
+// WriteFolded writes the stacks in the folded format, one "root;...;leaf value" line per stack, the format
+// the flamegraph tools read.
+func (p *Profile) WriteFolded(w io.Writer) error {
+	folded := make(map[string]int64)
+	for _, s := range p.Samples {
+		folded[strings.Join(s.Stack, ";")] += s.Value
+	}
+
+	stacks := make([]string, 0, len(folded))
+	for stack := range folded {
+		stacks = append(stacks, stack)
+	}
+	slices.Sort(stacks)
+
+	bw := bufio.NewWriter(w)
+	for _, stack := range stacks {
+		fmt.Fprintf(bw, "%s %d\n", stack, folded[stack])
+	}
+
+	return bw.Flush()
+}
+
+// WriteFoldedFile writes the folded stacks of the profile into the file.
+func (p *Profile) WriteFoldedFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create folded stacks file: %w", err)
+	}
+	defer f.Close()
+
+	err = p.WriteFolded(f)
+	if err != nil {
+		return fmt.Errorf("failed to write folded stacks: %w", err)
+	}
+
+	return nil
+}
@@ -35,6 +35,15 @@ func (d *DeviceWithClose) Slice(offset, length int64) ([]byte, error) {
 }
 
 func main() {
+	var err error
+
+	nbd.Pool, err = nbd.NewDevicePool()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create nbd device pool: %v\n", err)
+
+		os.Exit(1)
+	}
+
 	data := make([]byte, blockSize*8)
 	rand.Read(data)
 
@@ -4,14 +4,13 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"log"
 	"os"
-	"os/signal"
 	"strconv"
 	"time"
 
 	"go.opentelemetry.io/otel"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/cmd/internal/cmdutil"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/dns"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
@@ -26,45 +25,21 @@ func main() {
 	sandboxId := flag.String("sandbox", "", "sandbox id")
 	keepAlive := flag.Int("alive", 0, "keep alive")
 	count := flag.Int("count", 1, "number of serially spawned sandboxes")
+	kernelVersion := flag.String("kernel", "vmlinux-5.10.186", "kernel version of the template")
+	firecrackerVersion := flag.String("firecracker", "v1.7.0-dev_8bb88311", "firecracker version of the template")
 
 	flag.Parse()
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := cmdutil.SignalContext()
 	defer cancel()
 
-	done := make(chan os.Signal, 1)
-	signal.Notify(done, os.Interrupt)
-
-	go func() {
-		<-done
-
-		cancel()
-	}()
-
-	dnsServer := dns.New()
-	go func() {
-		log.Printf("Starting DNS server")
-
-		err := dnsServer.Start("127.0.0.4", 53)
-		if err != nil {
-			log.Fatalf("Failed running DNS server: %s\n", err.Error())
-		}
-	}()
-
-	templateCache, err := template.NewCache(ctx)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "failed to create template cache: %v\n", err)
-
-		return
-	}
-
-	networkPool, err := network.NewPool(ctx, *count, 0)
+	env, err := cmdutil.Setup(ctx, *count)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "failed to create network pool: %v\n", err)
+		fmt.Fprintf(os.Stderr, "failed to set up environment: %v\n", err)
 
 		return
 	}
-	defer networkPool.Close()
+	defer env.Close()
 
 	for i := 0; i < *count; i++ {
 		fmt.Println("--------------------------------")
@@ -76,11 +51,13 @@ func main() {
 			ctx,
 			*templateId,
 			*buildId,
+			*kernelVersion,
+			*firecrackerVersion,
 			*sandboxId+"-"+strconv.Itoa(v),
-			dnsServer,
+			env.DNS,
 			time.Duration(*keepAlive)*time.Second,
-			networkPool,
-			templateCache,
+			env.NetworkPool,
+			env.TemplateCache,
 		)
 		if err != nil {
 			break
@@ -92,6 +69,8 @@ func mockSandbox(
 	ctx context.Context,
 	templateId,
 	buildId,
+	kernelVersion,
+	firecrackerVersion,
 	sandboxId string,
 	dns *dns.DNS,
 	keepAlive time.Duration,
@@ -111,11 +90,9 @@ func mockSandbox(
 		networkPool,
 		templateCache,
 		&orchestrator.SandboxConfig{
-			TemplateId: templateId,
-			// FirecrackerVersion: "v1.10.1_1fcdaec",
-			// KernelVersion:      "vmlinux-6.1.102",
-			FirecrackerVersion: "v1.7.0-dev_8bb88311",
-			KernelVersion:      "vmlinux-5.10.186",
+			TemplateId:         templateId,
+			FirecrackerVersion: firecrackerVersion,
+			KernelVersion:      kernelVersion,
 			TeamId:             "test-team",
 			BuildId:            buildId,
 			HugePages:          true,
@@ -4,15 +4,15 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"log"
 	"os"
-	"os/signal"
 	"strconv"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/cmd/internal/cmdutil"
+	"github.com/e2b-dev/infra/packages/orchestrator/cmd/internal/profiling"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/dns"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
@@ -28,45 +28,38 @@ func main() {
 	sandboxId := flag.String("sandbox", "", "sandbox id")
 	keepAlive := flag.Int("alive", 0, "keep alive")
 	count := flag.Int("count", 1, "number of serially spawned sandboxes")
+	kernelVersion := flag.String("kernel", "vmlinux-5.10.186", "kernel version of the template")
+	firecrackerVersion := flag.String("firecracker", "v1.7.0-dev_8bb88311", "firecracker version of the template")
+	cpuProfile := flag.String("cpuprofile", "", "path of the CPU profile of the sandboxes, the folded stacks for the flamegraphs are written next to it")
 
 	flag.Parse()
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := cmdutil.SignalContext()
 	defer cancel()
 
-	done := make(chan os.Signal, 1)
-	signal.Notify(done, os.Interrupt)
-
-	go func() {
-		<-done
-
-		cancel()
-	}()
-
-	dnsServer := dns.New()
-	go func() {
-		log.Printf("Starting DNS server")
-
-		err := dnsServer.Start("127.0.0.4", 53)
-		if err != nil {
-			log.Fatalf("Failed running DNS server: %s\n", err.Error())
-		}
-	}()
-
-	templateCache, err := template.NewCache(ctx)
+	env, err := cmdutil.Setup(ctx, *count)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "failed to create template cache: %v\n", err)
+		fmt.Fprintf(os.Stderr, "failed to set up environment: %v\n", err)
 
 		return
 	}
+	defer env.Close()
 
-	networkPool, err := network.NewPool(ctx, *count, 0)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "failed to create network pool: %v\n", err)
+	if *cpuProfile != "" {
+		stopProfile, err := profiling.StartCPUProfile(*cpuProfile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to start CPU profile: %v\n", err)
 
-		return
+			return
+		}
+
+		defer func() {
+			err := writeProfile(stopProfile, *cpuProfile)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "failed to write CPU profile: %v\n", err)
+			}
+		}()
 	}
-	defer networkPool.Close()
 
 	eg, ctx := errgroup.WithContext(ctx)
 
@@ -80,11 +73,13 @@ func main() {
 			ctx,
 			*templateId,
 			*buildId,
+			*kernelVersion,
+			*firecrackerVersion,
 			*sandboxId+"-"+strconv.Itoa(v),
-			dnsServer,
+			env.DNS,
 			time.Duration(*keepAlive)*time.Second,
-			networkPool,
-			templateCache,
+			env.NetworkPool,
+			env.TemplateCache,
 		)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "failed to start sandbox: %v\n", err)
@@ -98,10 +93,27 @@ func main() {
 	}
 }
 
+// writeProfile stops the CPU profile and writes its folded stacks into the file next to it.
+func writeProfile(stop func() error, path string) error {
+	err := stop()
+	if err != nil {
+		return err
+	}
+
+	profile, err := profiling.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return profile.WriteFoldedFile(path + ".folded")
+}
+
 func mockSnapshot(
 	ctx context.Context,
 	templateId,
 	buildId,
+	kernelVersion,
+	firecrackerVersion,
 	sandboxId string,
 	dns *dns.DNS,
 	keepAlive time.Duration,
@@ -123,8 +135,8 @@ func mockSnapshot(
 		templateCache,
 		&orchestrator.SandboxConfig{
 			TemplateId:         templateId,
-			FirecrackerVersion: "v1.7.0-dev_8bb88311",
-			KernelVersion:      "vmlinux-5.10.186",
+			FirecrackerVersion: firecrackerVersion,
+			KernelVersion:      kernelVersion,
 			TeamId:             "test-team",
 			BuildId:            buildId,
 			HugePages:          true,
@@ -207,6 +219,7 @@ func mockSnapshot(
 		snapshot.Snapfile,
 		snapshot.MemfileDiff,
 		snapshot.RootfsDiff,
+		snapshot.MemfilePrefetch,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to add snapshot to template cache: %w", err)
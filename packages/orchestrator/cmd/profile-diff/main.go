@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/cmd/internal/profiling"
+)
+
+func main() {
+	baselinePath := flag.String("baseline", "", "CPU profile of the baseline run")
+	candidatePath := flag.String("candidate", "", "CPU profile of the candidate run")
+	top := flag.Int("top", 30, "number of the functions with the largest change, 0 for all")
+
+	flag.Parse()
+
+	if *baselinePath == "" || *candidatePath == "" {
+		log.Fatal("both baseline and candidate profiles are required")
+	}
+
+	baseline, err := profiling.ReadFile(*baselinePath)
+	if err != nil {
+		log.Fatalf("failed to read baseline profile: %v", err)
+	}
+
+	candidate, err := profiling.ReadFile(*candidatePath)
+	if err != nil {
+		log.Fatalf("failed to read candidate profile: %v", err)
+	}
+
+	comparison, err := profiling.Compare(baseline, candidate)
+	if err != nil {
+		log.Fatalf("failed to compare profiles: %v", err)
+	}
+
+	comparison.Print(os.Stdout, *top)
+}
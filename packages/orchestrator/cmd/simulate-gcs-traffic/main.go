@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/benchmark"
+)
+
+// chunkSize is the size of the chunks the template cache reads from the bucket.
+const chunkSize = 4 * 1024 * 1024
+
+const (
+	experimentHTTP     = "http"
+	experimentGRPC     = "grpc"
+	experimentParallel = "parallel"
+	experimentPoolSize = "pool-sweep"
+)
+
+type config struct {
+	run         string
+	host        string
+	bucket      string
+	object      string
+	chunks      int
+	chunkSize   int64
+	concurrency int
+}
+
+func main() {
+	bucket := flag.String("bucket", os.Getenv("TEMPLATE_BUCKET_NAME"), "bucket the object is read from")
+	object := flag.String("object", "", "object read by the experiments, e.g. the memfile of a template build")
+	experiments := flag.String("experiments", "http,grpc,parallel,pool-sweep", "comma separated experiments to run")
+	chunks := flag.Int("chunks", 64, "number of chunks read by each experiment")
+	size := flag.Int64("chunk-size", chunkSize, "size of the chunks in bytes")
+	concurrency := flag.Int("concurrency", 16, "number of the parallel ranged reads in the parallel and pool-sweep experiments")
+	poolSizes := flag.String("pool-sizes", "1,2,4,8", "comma separated gRPC connection pool sizes of the pool-sweep experiment")
+	runName := flag.String("run", "", "name of the run in the results")
+	csvPath := flag.String("csv", "simulate-gcs-traffic.csv", "path of the CSV results, empty to skip")
+
+	flag.Parse()
+
+	if *bucket == "" || *object == "" {
+		log.Fatal("bucket and object are required")
+	}
+
+	if *chunks <= 0 || *size <= 0 || *concurrency <= 0 {
+		log.Fatal("chunks, chunk-size and concurrency must be positive")
+	}
+
+	pools, err := parseInts(*poolSizes)
+	if err != nil {
+		log.Fatalf("invalid pool sizes: %v", err)
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		log.Printf("failed to get the hostname: %v", err)
+	}
+
+	// The sandbox packages aren't imported, they panic on the hosts without the nbd module
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	cfg := config{
+		run:         *runName,
+		host:        host,
+		bucket:      *bucket,
+		object:      *object,
+		chunks:      *chunks,
+		chunkSize:   *size,
+		concurrency: *concurrency,
+	}
+
+	var records []benchmark.Record
+	for _, experiment := range strings.Split(*experiments, ",") {
+		experimentRecords, err := runExperiment(ctx, cfg, strings.TrimSpace(experiment), pools)
+		if err != nil {
+			log.Fatalf("experiment '%s' failed: %v", experiment, err)
+		}
+
+		records = append(records, experimentRecords...)
+	}
+
+	benchmark.PrintSummaries(os.Stdout, benchmark.Summarize(records, benchmark.DefaultBounds))
+
+	if *csvPath != "" {
+		err = benchmark.WriteCSVFile(*csvPath, records)
+		if err != nil {
+			log.Fatalf("failed to write the results: %v", err)
+		}
+	}
+}
+
+func parseInts(value string) ([]int, error) {
+	var ints []int
+
+	for _, part := range strings.Split(value, ",") {
+		v, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || v <= 0 {
+			return nil, fmt.Errorf("'%s' is not a positive number", part)
+		}
+
+		ints = append(ints, v)
+	}
+
+	return ints, nil
+}
+
+func runExperiment(ctx context.Context, cfg config, experiment string, pools []int) ([]benchmark.Record, error) {
+	switch experiment {
+	case experimentHTTP:
+		return withClient(ctx, storage.NewClient, func(client *storage.Client) ([]benchmark.Record, error) {
+			return readChunks(ctx, cfg, client, experimentHTTP, 1)
+		})
+	case experimentGRPC:
+		return withClient(ctx, storage.NewGRPCClient, func(client *storage.Client) ([]benchmark.Record, error) {
+			return readChunks(ctx, cfg, client, experimentGRPC, 1)
+		})
+	case experimentParallel:
+		clients := []struct {
+			name      string
+			newClient func(context.Context, ...option.ClientOption) (*storage.Client, error)
+		}{
+			{name: experimentHTTP, newClient: storage.NewClient},
+			{name: experimentGRPC, newClient: storage.NewGRPCClient},
+		}
+
+		var records []benchmark.Record
+		for _, c := range clients {
+			parallelRecords, err := withClient(ctx, c.newClient, func(client *storage.Client) ([]benchmark.Record, error) {
+				return readChunks(ctx, cfg, client, fmt.Sprintf("%s-%s", c.name, experimentParallel), cfg.concurrency)
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			records = append(records, parallelRecords...)
+		}
+
+		return records, nil
+	case experimentPoolSize:
+		var records []benchmark.Record
+		for _, pool := range pools {
+			newClient := func(ctx context.Context, opts ...option.ClientOption) (*storage.Client, error) {
+				return storage.NewGRPCClient(ctx, append(opts, option.WithGRPCConnectionPool(pool))...)
+			}
+
+			poolRecords, err := withClient(ctx, newClient, func(client *storage.Client) ([]benchmark.Record, error) {
+				return readChunks(ctx, cfg, client, fmt.Sprintf("%s-pool-%d", experimentGRPC, pool), cfg.concurrency)
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			records = append(records, poolRecords...)
+		}
+
+		return records, nil
+	default:
+		return nil, fmt.Errorf("unknown experiment")
+	}
+}
+
+func withClient(
+	ctx context.Context,
+	newClient func(context.Context, ...option.ClientOption) (*storage.Client, error),
+	fn func(client *storage.Client) ([]benchmark.Record, error),
+) ([]benchmark.Record, error) {
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+	defer client.Close()
+
+	return fn(client)
+}
+
+// readChunks reads the chunks of the object with the ranged reads, at most concurrency reads are in progress.
+// The chunks wrap around if the object is smaller than the chunks read by the experiment.
+func readChunks(ctx context.Context, cfg config, client *storage.Client, operation string, concurrency int) ([]benchmark.Record, error) {
+	object := client.Bucket(cfg.bucket).Object(cfg.object)
+
+	attrs, err := object.Attrs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object attributes: %w", err)
+	}
+
+	if attrs.Size == 0 {
+		return nil, fmt.Errorf("object is empty")
+	}
+
+	log.Printf("running %s, %d chunks of %d bytes, concurrency %d", operation, cfg.chunks, cfg.chunkSize, concurrency)
+
+	records := make([]benchmark.Record, 0, 2*cfg.chunks)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	slots := make(chan struct{}, concurrency)
+
+	for i := 0; i < cfg.chunks; i++ {
+		select {
+		case <-ctx.Done():
+		case slots <- struct{}{}:
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		offset := (int64(i) * cfg.chunkSize) % attrs.Size
+		length := min(cfg.chunkSize, attrs.Size-offset)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			start := time.Now()
+			firstByte, total, err := readChunk(ctx, object, offset, length)
+
+			var errMsg string
+			if err != nil {
+				errMsg = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			records = append(records,
+				benchmark.Record{
+					Run:       cfg.run,
+					Host:      cfg.host,
+					Operation: operation + "/first-byte",
+					ID:        strconv.FormatInt(offset, 10),
+					StartedAt: start,
+					Duration:  firstByte,
+					Error:     errMsg,
+				},
+				benchmark.Record{
+					Run:       cfg.run,
+					Host:      cfg.host,
+					Operation: operation + "/total",
+					ID:        strconv.FormatInt(offset, 10),
+					StartedAt: start,
+					Duration:  total,
+					Error:     errMsg,
+				},
+			)
+		}()
+	}
+
+	wg.Wait()
+
+	return records, ctx.Err()
+}
+
+// readChunk reads the range of the object and returns the time to the first byte and the time to read the whole range.
+func readChunk(ctx context.Context, object *storage.ObjectHandle, offset, length int64) (time.Duration, time.Duration, error) {
+	start := time.Now()
+
+	reader, err := object.NewRangeReader(ctx, offset, length)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create GCS reader: %w", err)
+	}
+	defer reader.Close()
+
+	var firstByte time.Duration
+
+	buf := make([]byte, length)
+	read := 0
+
+	for read < len(buf) {
+		n, err := reader.Read(buf[read:])
+		if n > 0 && read == 0 {
+			firstByte = time.Since(start)
+		}
+
+		read += n
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return firstByte, time.Since(start), fmt.Errorf("failed to read from GCS object: %w", err)
+		}
+	}
+
+	if int64(read) != length {
+		return firstByte, time.Since(start), fmt.Errorf("read %d bytes, expected %d", read, length)
+	}
+
+	return firstByte, time.Since(start), nil
+}
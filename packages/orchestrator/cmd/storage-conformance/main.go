@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/conformance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+func main() {
+	bucket := flag.String("bucket", os.Getenv("TEMPLATE_BUCKET_NAME"), "bucket the suite runs against, the suite creates and deletes its own objects")
+	objectSize := flag.Int64("object-size", 256*1024*1024, "size of the object the reads and the upload are measured with")
+	chunkSize := flag.Int64("chunk-size", 4*1024*1024, "size of the ranged reads")
+	reads := flag.Int("reads", 100, "number of the measured ranged reads")
+
+	flag.Parse()
+
+	if *bucket == "" {
+		log.Fatal("bucket is required")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	handle := gcs.NewBucket(*bucket)
+	newObject := func(ctx context.Context, path string) conformance.Object {
+		return gcs.NewObject(ctx, handle, path)
+	}
+
+	report, err := conformance.Run(ctx, newObject, conformance.Config{
+		Prefix:     fmt.Sprintf("storage-conformance/%s", uuid.New()),
+		ObjectSize: *objectSize,
+		ChunkSize:  *chunkSize,
+		Reads:      *reads,
+	})
+
+	for _, check := range report.Checks {
+		if check.Err != nil {
+			fmt.Printf("[FAIL ] %s: %v\n", check.Name, check.Err)
+		} else {
+			fmt.Printf("[ OK  ] %s\n", check.Name)
+		}
+	}
+
+	if err != nil {
+		log.Fatalf("failed to run the benchmarks: %v", err)
+	}
+
+	r := report.Reads
+	fmt.Printf("\nupload throughput: %.1f MB/s\n", report.UploadThroughput/1024/1024)
+	fmt.Printf("ranged reads of %d bytes: count %d, min %s, mean %s, p50 %s, p90 %s, p99 %s, max %s\n", *chunkSize, r.Count,
+		r.Min.Round(time.Microsecond), r.Mean.Round(time.Microsecond), r.P50.Round(time.Microsecond),
+		r.P90.Round(time.Microsecond), r.P99.Round(time.Microsecond), r.Max.Round(time.Microsecond))
+
+	if report.Failed() > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,220 @@
+// Package cacheusage accounts where the template files of the sandboxes were served from, per build and per team,
+// so the operators see which templates cause the bucket egress and the cold resumes on the node.
+package cacheusage
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+)
+
+// Source is where the chunks of the template files not cached on the node were fetched from.
+type Source string
+
+const (
+	SourceStorageCache Source = "storage-cache"
+	SourceBucket       Source = "bucket"
+)
+
+const (
+	// retention is the same as the expiration of the templates in the node cache.
+	retention = 25 * time.Hour
+	// maxBuilds limits the memory of the accounting, the builds not used for the longest time are dropped first.
+	maxBuilds = 10_000
+)
+
+type BuildUsage struct {
+	BuildID string `json:"buildID"`
+	// TeamID is the team of the last sandbox that requested the build.
+	TeamID string `json:"teamID,omitempty"`
+	// Hits are the requests of the template that was already cached on the node.
+	Hits int64 `json:"hits"`
+	// Misses are the requests that started the fetch of the template, the sandboxes resume cold.
+	Misses            int64     `json:"misses"`
+	StorageCacheBytes int64     `json:"storageCacheBytes"`
+	BucketBytes       int64     `json:"bucketBytes"`
+	LastUsedAt        time.Time `json:"lastUsedAt"`
+}
+
+type TeamUsage struct {
+	TeamID            string `json:"teamID"`
+	Builds            int    `json:"builds"`
+	Hits              int64  `json:"hits"`
+	Misses            int64  `json:"misses"`
+	StorageCacheBytes int64  `json:"storageCacheBytes"`
+	BucketBytes       int64  `json:"bucketBytes"`
+}
+
+type Usage struct {
+	Builds []BuildUsage `json:"builds"`
+	Teams  []TeamUsage  `json:"teams"`
+}
+
+var (
+	mu     sync.Mutex
+	builds = make(map[string]*BuildUsage)
+
+	metricsOnce sync.Once
+	requests    metric.Int64Counter
+	bytesRead   metric.Int64Counter
+)
+
+func initMetrics() {
+	metricsOnce.Do(func() {
+		var err error
+
+		requests, err = meters.GetCounter(meters.TemplateCacheRequestsMeterName)
+		if err != nil {
+			log.Printf("failed to create template cache requests counter: %v", err)
+		}
+
+		bytesRead, err = meters.GetCounter(meters.TemplateCacheBytesMeterName)
+		if err != nil {
+			log.Printf("failed to create template cache bytes counter: %v", err)
+		}
+	})
+}
+
+// get returns the usage of the build, the caller has to hold the lock.
+func get(buildID string) *BuildUsage {
+	usage, ok := builds[buildID]
+	if !ok {
+		if len(builds) >= maxBuilds {
+			evict()
+		}
+
+		usage = &BuildUsage{BuildID: buildID}
+		builds[buildID] = usage
+	}
+
+	usage.LastUsedAt = time.Now()
+
+	return usage
+}
+
+// evict drops the builds not used within the retention, or the least recently used build if all were used.
+func evict() {
+	var oldest *BuildUsage
+	for id, usage := range builds {
+		if time.Since(usage.LastUsedAt) > retention {
+			delete(builds, id)
+
+			continue
+		}
+
+		if oldest == nil || usage.LastUsedAt.Before(oldest.LastUsedAt) {
+			oldest = usage
+		}
+	}
+
+	if len(builds) >= maxBuilds && oldest != nil {
+		delete(builds, oldest.BuildID)
+	}
+}
+
+// RecordTemplate records the request of the template by the sandbox of the team, hit is true if the template
+// was already cached on the node.
+func RecordTemplate(buildID, teamID string, hit bool) {
+	initMetrics()
+
+	mu.Lock()
+	usage := get(buildID)
+	usage.TeamID = teamID
+	if hit {
+		usage.Hits++
+	} else {
+		usage.Misses++
+	}
+	mu.Unlock()
+
+	if requests != nil {
+		result := "miss"
+		if hit {
+			result = "hit"
+		}
+
+		requests.Add(context.Background(), 1, metric.WithAttributes(attribute.String("result", result)))
+	}
+}
+
+// RecordRead records the bytes of the template file fetched to the node from the source,
+// the path of the file in the bucket starts with the build ID.
+func RecordRead(path string, source Source, n int) {
+	if n <= 0 {
+		return
+	}
+
+	initMetrics()
+
+	buildID, _, _ := strings.Cut(path, "/")
+
+	mu.Lock()
+	usage := get(buildID)
+	switch source {
+	case SourceStorageCache:
+		usage.StorageCacheBytes += int64(n)
+	case SourceBucket:
+		usage.BucketBytes += int64(n)
+	}
+	mu.Unlock()
+
+	if bytesRead != nil {
+		bytesRead.Add(context.Background(), int64(n), metric.WithAttributes(attribute.String("source", string(source))))
+	}
+}
+
+// Get returns the usage of the builds used within the retention and their teams, the builds and the teams
+// are sorted by the bucket bytes, the largest egress first.
+func Get() Usage {
+	mu.Lock()
+	result := Usage{Builds: make([]BuildUsage, 0, len(builds))}
+	for id, usage := range builds {
+		if time.Since(usage.LastUsedAt) > retention {
+			delete(builds, id)
+
+			continue
+		}
+
+		result.Builds = append(result.Builds, *usage)
+	}
+	mu.Unlock()
+
+	teams := make(map[string]*TeamUsage)
+	for _, usage := range result.Builds {
+		team, ok := teams[usage.TeamID]
+		if !ok {
+			team = &TeamUsage{TeamID: usage.TeamID}
+			teams[usage.TeamID] = team
+		}
+
+		team.Builds++
+		team.Hits += usage.Hits
+		team.Misses += usage.Misses
+		team.StorageCacheBytes += usage.StorageCacheBytes
+		team.BucketBytes += usage.BucketBytes
+	}
+
+	result.Teams = make([]TeamUsage, 0, len(teams))
+	for _, team := range teams {
+		result.Teams = append(result.Teams, *team)
+	}
+
+	slices.SortFunc(result.Builds, func(a, b BuildUsage) int {
+		return cmp.Or(cmp.Compare(b.BucketBytes, a.BucketBytes), cmp.Compare(a.BuildID, b.BuildID))
+	})
+
+	slices.SortFunc(result.Teams, func(a, b TeamUsage) int {
+		return cmp.Or(cmp.Compare(b.BucketBytes, a.BucketBytes), cmp.Compare(a.TeamID, b.TeamID))
+	})
+
+	return result
+}
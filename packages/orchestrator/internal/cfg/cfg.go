@@ -0,0 +1,177 @@
+package cfg
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	defaultRegisterInterval = 10 * time.Second
+	defaultAPIAddress       = "api:5011"
+)
+
+// Config is the configuration of the node the orchestrator runs on. In Nomad the node is discovered by the API,
+// in Kubernetes the orchestrator registers the node with the API itself, the values are detected if not set.
+type Config struct {
+	// NodeID is the short ID of the node, it's the client ID in the IDs of the sandboxes on the node.
+	NodeID string
+	// NodeIP is the address of the node the API and the sandbox traffic reach the node on.
+	NodeIP string
+	// Labels of the node, e.g. the zone or the machine type.
+	Labels map[string]string
+	// CPUCount and MemoryMB are the capacity of the node for the sandboxes.
+	CPUCount int64
+	MemoryMB int64
+	// ClusterID and Region are where the node runs, the sandboxes are placed in the regions allowed by their template.
+	ClusterID string
+	Region    string
+	// ClusterProxyIP is the IP of the proxy of the cluster, the sandbox traffic from the other clusters is routed through it.
+	ClusterProxyIP string
+
+	// APIAddress is the address of the API gRPC server the node is registered with.
+	APIAddress string
+	// RegisterInterval is how often the registration is renewed.
+	RegisterInterval time.Duration
+	// Token authenticates the calls between the API and the orchestrator, it's required in Kubernetes. In Nomad the calls
+	// aren't authenticated if empty.
+	Token string
+	// TeamCreateQuota is how many sandboxes a team can create on the node per minute, the quota isn't enforced if zero.
+	TeamCreateQuota int64
+
+	labels string
+}
+
+// NodeID returns the short ID of the node. In Kubernetes the ID is derived from the node name from the downward API.
+func NodeID() string {
+	if env.IsKubernetes() {
+		return env.KubernetesNodeID(utils.RequiredEnv("NODE_NAME", "Kubernetes node name, set from spec.nodeName by the downward API"))
+	}
+
+	nodeID := utils.RequiredEnv("NODE_ID", "Nomad ID of the instance node")
+
+	return nodeID[:consts.NodeIDLength]
+}
+
+// RegisterFlags adds the flags of the node to the flag set, the flags default to the environment variables.
+func RegisterFlags(fs *flag.FlagSet) *Config {
+	c := &Config{}
+
+	fs.Int64Var(&c.CPUCount, "node-cpu-count", envInt("NODE_CPU_COUNT", 0), "CPUs of the node for the sandboxes, all CPUs of the machine if zero")
+	fs.Int64Var(&c.MemoryMB, "node-memory-mb", envInt("NODE_MEMORY_MB", 0), "memory of the node for the sandboxes in MiB, all memory of the machine if zero")
+	fs.StringVar(&c.labels, "node-labels", os.Getenv("NODE_LABELS"), "comma separated key=value labels of the node")
+	fs.StringVar(&c.ClusterID, "cluster-id", os.Getenv("CLUSTER_ID"), "ID of the cluster of the node, the cluster of the API if empty")
+	fs.StringVar(&c.Region, "region", os.Getenv("REGION"), "region of the node")
+	fs.StringVar(&c.ClusterProxyIP, "cluster-proxy-ip", os.Getenv("CLUSTER_PROXY_IP"), "IP of the proxy of the cluster the sandbox traffic from the other clusters is routed to")
+	fs.StringVar(&c.APIAddress, "api-address", env.GetEnv("API_GRPC_ADDRESS", defaultAPIAddress), "address of the API gRPC server the node registers with in Kubernetes")
+	fs.DurationVar(&c.RegisterInterval, "register-interval", defaultRegisterInterval, "how often the node registration is renewed in Kubernetes")
+	fs.Int64Var(&c.TeamCreateQuota, "team-create-quota", envInt("TEAM_CREATE_QUOTA", 0), "sandboxes a team can create on the node per minute, not enforced if zero")
+
+	return c
+}
+
+// Load fills the values of the node that aren't set by the flags, it must be called after the flags are parsed.
+func (c *Config) Load() error {
+	c.NodeID = NodeID()
+	c.Token = os.Getenv("ORCHESTRATOR_TOKEN")
+
+	labels, err := parseLabels(c.labels)
+	if err != nil {
+		return fmt.Errorf("invalid node labels: %w", err)
+	}
+
+	c.Labels = labels
+
+	if env.IsKubernetes() {
+		// The pods can reach the orchestrator, the sandboxes must not be created by the calls without the token
+		if c.Token == "" {
+			return fmt.Errorf("ORCHESTRATOR_TOKEN is required in Kubernetes")
+		}
+
+		// The orchestrator pod uses the host network, so the pod IP is the IP of the node
+		c.NodeIP = utils.RequiredEnv("POD_IP", "IP of the orchestrator pod, set from status.podIP by the downward API")
+	} else {
+		c.NodeIP = os.Getenv("NODE_IP")
+	}
+
+	if c.CPUCount == 0 {
+		c.CPUCount = int64(runtime.NumCPU())
+	}
+
+	if c.MemoryMB == 0 {
+		c.MemoryMB, err = totalMemoryMB()
+		if err != nil {
+			return fmt.Errorf("failed to detect the memory of the node: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func envInt(key string, defaultValue int64) int64 {
+	value, err := strconv.ParseInt(os.Getenv(key), 10, 64)
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+func parseLabels(value string) (map[string]string, error) {
+	labels := make(map[string]string)
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, labelValue, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("label '%s' is not in the key=value format", pair)
+		}
+
+		labels[key] = labelValue
+	}
+
+	return labels, nil
+}
+
+// totalMemoryMB returns the total memory of the machine from /proc/meminfo.
+func totalMemoryMB() (int64, error) {
+	file, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "MemTotal:" {
+			continue
+		}
+
+		kb, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid MemTotal: %w", err)
+		}
+
+		return kb / 1024, nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("MemTotal not found")
+}
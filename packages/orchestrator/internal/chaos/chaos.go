@@ -0,0 +1,118 @@
+// Package chaos injects faults into the orchestrator, so the resilience of the control plane can be tested without changing the code.
+// The faults are configured by the FAULT_INJECTION env var and are never injected in production.
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+type Point string
+
+const (
+	// Reading the template data from the storage.
+	StorageRead Point = "storage.read"
+	// Serving the reads and writes of the rootfs over NBD.
+	NBDRead  Point = "nbd.read"
+	NBDWrite Point = "nbd.write"
+	// Getting the network slot for a new sandbox.
+	NetworkSlot Point = "network.slot"
+	// Handling the gRPC requests.
+	GRPC Point = "grpc"
+)
+
+var points = []Point{StorageRead, NBDRead, NBDWrite, NetworkSlot, GRPC}
+
+var ErrInjected = errors.New("injected fault")
+
+// probabilities is nil when the fault injection is disabled.
+var probabilities atomic.Pointer[map[Point]float64]
+
+// parseProbabilities parses the probabilities in the "point=probability,point=probability" format.
+func parseProbabilities(value string) (map[Point]float64, error) {
+	result := make(map[Point]float64)
+
+	for _, item := range strings.Split(value, ",") {
+		point, probability, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid fault '%s', expected 'point=probability'", item)
+		}
+
+		if !slices.Contains(points, Point(point)) {
+			return nil, fmt.Errorf("unknown fault injection point '%s'", point)
+		}
+
+		p, err := strconv.ParseFloat(probability, 64)
+		if err != nil || p < 0 || p > 1 {
+			return nil, fmt.Errorf("invalid probability '%s' for point '%s', expected a number between 0 and 1", probability, point)
+		}
+
+		result[Point(point)] = p
+	}
+
+	return result, nil
+}
+
+// Init enables the fault injection if the FAULT_INJECTION env var is set, e.g. "storage.read=0.01,grpc=0.05".
+func Init() error {
+	value := os.Getenv("FAULT_INJECTION")
+	if value == "" {
+		return nil
+	}
+
+	if env.IsProduction() {
+		return fmt.Errorf("fault injection can't be enabled in production")
+	}
+
+	parsed, err := parseProbabilities(value)
+	if err != nil {
+		return fmt.Errorf("failed to parse fault injection config: %w", err)
+	}
+
+	probabilities.Store(&parsed)
+
+	log.Printf("fault injection is enabled: %s", value)
+
+	return nil
+}
+
+// Inject returns an error with the probability configured for the point, it always returns nil when the fault injection is disabled.
+func Inject(point Point) error {
+	p := probabilities.Load()
+	if p == nil {
+		return nil
+	}
+
+	probability, ok := (*p)[point]
+	if !ok || rand.Float64() >= probability {
+		return nil
+	}
+
+	return fmt.Errorf("%w at %s", ErrInjected, point)
+}
+
+// UnaryServerInterceptor fails the gRPC requests with the Unavailable code, the same as when the orchestrator is not reachable.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		err := Inject(GRPC)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, fmt.Sprintf("%s: %s", info.FullMethod, err))
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -5,17 +5,20 @@ import (
 
 	"github.com/hashicorp/consul/api"
 
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
-var (
-	consulToken = utils.RequiredEnv("CONSUL_TOKEN", "Consul token for authenticating requests to the Consul API")
-	Client      = utils.Must(newClient())
-)
+// Client is nil in Kubernetes, the nodes there don't have the Consul agent.
+var Client = utils.Must(newClient())
 
 func newClient() (*api.Client, error) {
+	if env.IsKubernetes() {
+		return nil, nil
+	}
+
 	config := api.DefaultConfig()
-	config.Token = consulToken
+	config.Token = utils.RequiredEnv("CONSUL_TOKEN", "Consul token for authenticating requests to the Consul API")
 
 	consulClient, err := api.NewClient(config)
 	if err != nil {
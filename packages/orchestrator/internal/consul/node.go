@@ -1,13 +1,8 @@
 package consul
 
 import (
-	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/cfg"
 )
 
-const shortNodeIDLength = 8
-
-var (
-	nodeID = utils.RequiredEnv("NODE_ID", "Nomad ID of the instance node")
-	// Node ID must be at least 8 characters long.
-	ClientID = nodeID[:shortNodeIDLength]
-)
+// ClientID is the short ID of the node, it's the client ID in the IDs of the sandboxes on the node.
+var ClientID = cfg.NodeID()
@@ -0,0 +1,412 @@
+package doctor
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"golang.org/x/sys/unix"
+	"google.golang.org/api/iterator"
+)
+
+// The paths and the limits match the ones used by the orchestrator and set up by the client start script.
+const (
+	kvmPath          = "/dev/kvm"
+	nbdMaxPath       = "/sys/module/nbd/parameters/nbds_max"
+	meminfoPath      = "/proc/meminfo"
+	hugepagesDir     = "/mnt/hugepages"
+	snapshotCacheDir = "/mnt/snapshot-cache"
+	cgroupRoot       = "/sys/fs/cgroup"
+	kernelsDir       = "/fc-kernels"
+	kernelName       = "vmlinux.bin"
+	fcVersionsDir    = "/fc-versions"
+	envdPath         = "/fc-envd/envd"
+
+	recommendedNBDDevices = 4096
+)
+
+var cgroupControllers = []string{"cpu", "memory", "pids"}
+
+type sysctl struct {
+	key string
+	min int64
+	// required sysctls fail the check, the others are only recommended for the performance.
+	required bool
+}
+
+var sysctls = []sysctl{
+	{key: "net.ipv4.ip_forward", min: 1, required: true},
+	{key: "net.core.somaxconn", min: 65535},
+	{key: "net.core.netdev_max_backlog", min: 65535},
+	{key: "net.ipv4.tcp_max_syn_backlog", min: 65535},
+	{key: "vm.max_map_count", min: 1048576},
+	{key: "net.netfilter.nf_conntrack_max", min: 2097152},
+}
+
+// Config selects the versions of the binaries and the bucket the host is checked with.
+type Config struct {
+	KernelVersion      string
+	FirecrackerVersion string
+	TemplateBucket     string
+}
+
+// Checks returns the checks of the host in the order they should run.
+func Checks(config Config) []Check {
+	checks := []Check{
+		{
+			Name:        "root",
+			Run:         checkRoot,
+			Remediation: "Run the orchestrator and the doctor as root, the sandboxes need to create network namespaces and mount devices",
+		},
+		{
+			Name:        "kvm",
+			Run:         checkKVM,
+			Remediation: "Enable the hardware virtualization (or the nested virtualization on a VM) and load the kvm_intel or kvm_amd module",
+		},
+		{
+			Name:        "nbd",
+			Run:         checkNBD,
+			Remediation: fmt.Sprintf("Load the nbd module with 'modprobe nbd nbds_max=%d'", recommendedNBDDevices),
+			Fix:         fixNBD,
+		},
+		{
+			Name:        "hugepages",
+			Run:         checkHugePages,
+			Remediation: fmt.Sprintf("Mount hugetlbfs at %s and allocate the hugepages with vm.nr_hugepages and vm.nr_overcommit_hugepages, see the client start script", hugepagesDir),
+		},
+		{
+			Name:        "cgroups",
+			Run:         checkCgroups,
+			Remediation: fmt.Sprintf("Boot with the unified cgroup v2 hierarchy and enable the %s controllers in %s/cgroup.subtree_control", strings.Join(cgroupControllers, ", "), cgroupRoot),
+			Fix:         fixCgroups,
+		},
+		{
+			Name:        "snapshot-cache",
+			Run:         checkSnapshotCache,
+			Remediation: fmt.Sprintf("Mount a tmpfs at %s, the snapshots are written to the disk otherwise", snapshotCacheDir),
+		},
+	}
+
+	for _, s := range sysctls {
+		checks = append(checks, Check{
+			Name:        "sysctl " + s.key,
+			Run:         s.check,
+			Remediation: fmt.Sprintf("Set '%s = %d' in /etc/sysctl.conf and run 'sysctl -p'", s.key, s.min),
+			Fix:         s.fix,
+		})
+	}
+
+	kernelPath := filepath.Join(kernelsDir, config.KernelVersion, kernelName)
+
+	checks = append(checks,
+		Check{
+			Name:        "storage",
+			Run:         func(ctx context.Context) error { return checkStorage(ctx, config.TemplateBucket) },
+			Remediation: "Set TEMPLATE_BUCKET_NAME and the credentials of a service account that can read the bucket (GOOGLE_APPLICATION_CREDENTIALS or the instance service account)",
+		},
+		Check{
+			Name:        "kernel " + config.KernelVersion,
+			Run:         func(ctx context.Context) error { return checkFile(kernelPath, false) },
+			Remediation: fmt.Sprintf("Mount the kernels bucket at %s, see the client start script", kernelsDir),
+		},
+		Check{
+			Name:        "firecracker " + config.FirecrackerVersion,
+			Run:         func(ctx context.Context) error { return checkFirecracker(ctx, config.FirecrackerVersion) },
+			Remediation: fmt.Sprintf("Mount the firecracker versions bucket at %s, see the client start script", fcVersionsDir),
+		},
+		Check{
+			Name:        "envd",
+			Run:         func(ctx context.Context) error { return checkFile(envdPath, true) },
+			Remediation: fmt.Sprintf("Mount the envd bucket at %s, see the client start script", filepath.Dir(envdPath)),
+		},
+	)
+
+	return checks
+}
+
+func checkRoot(_ context.Context) error {
+	if os.Geteuid() != 0 {
+		return fmt.Errorf("the doctor runs as uid %d, the checks of the devices and the fixes need root", os.Geteuid())
+	}
+
+	return nil
+}
+
+func checkKVM(_ context.Context) error {
+	f, err := os.OpenFile(kvmPath, os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("%s isn't accessible: %w", kvmPath, err)
+	}
+
+	return f.Close()
+}
+
+func checkNBD(_ context.Context) error {
+	data, err := os.ReadFile(nbdMaxPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("the nbd module isn't loaded")
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", nbdMaxPath, err)
+	}
+
+	devices, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", nbdMaxPath, err)
+	}
+
+	if devices == 0 {
+		return fmt.Errorf("the nbd module is loaded with no devices")
+	}
+
+	if devices < recommendedNBDDevices {
+		return WarningError(fmt.Errorf("the nbd module is loaded with %d devices, %d are recommended", devices, recommendedNBDDevices))
+	}
+
+	return nil
+}
+
+// fixNBD loads the nbd module, the module loaded with too few devices can't be reloaded while the devices are used.
+func fixNBD(ctx context.Context) error {
+	if _, err := os.Stat(nbdMaxPath); err == nil {
+		return fmt.Errorf("the nbd module is already loaded, unload it with 'modprobe -r nbd' when no sandboxes run")
+	}
+
+	out, err := exec.CommandContext(ctx, "modprobe", "nbd", fmt.Sprintf("nbds_max=%d", recommendedNBDDevices)).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to load nbd module: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+func checkHugePages(_ context.Context) error {
+	values, err := readMeminfo()
+	if err != nil {
+		return err
+	}
+
+	overcommit, err := readSysctl("vm.nr_overcommit_hugepages")
+	if err != nil {
+		return err
+	}
+
+	if values["HugePages_Total"] == 0 && overcommit == 0 {
+		return WarningError(fmt.Errorf("no hugepages are allocated, the templates with hugepages can't be started"))
+	}
+
+	var stat unix.Statfs_t
+
+	err = unix.Statfs(hugepagesDir, &stat)
+	if err != nil {
+		return WarningError(fmt.Errorf("failed to stat %s: %w", hugepagesDir, err))
+	}
+
+	if stat.Type != unix.HUGETLBFS_MAGIC {
+		return WarningError(fmt.Errorf("%s isn't a hugetlbfs mount", hugepagesDir))
+	}
+
+	return nil
+}
+
+func readMeminfo() (map[string]int64, error) {
+	f, err := os.Open(meminfoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", meminfoPath, err)
+	}
+	defer f.Close()
+
+	values := make(map[string]int64)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok || !strings.HasPrefix(key, "HugePages_") {
+			continue
+		}
+
+		parsed, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", key, err)
+		}
+
+		values[key] = parsed
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", meminfoPath, err)
+	}
+
+	return values, nil
+}
+
+func checkCgroups(_ context.Context) error {
+	data, err := os.ReadFile(filepath.Join(cgroupRoot, "cgroup.controllers"))
+	if err != nil {
+		return fmt.Errorf("cgroup v2 isn't mounted at %s: %w", cgroupRoot, err)
+	}
+
+	available := strings.Fields(string(data))
+
+	data, err = os.ReadFile(filepath.Join(cgroupRoot, "cgroup.subtree_control"))
+	if err != nil {
+		return fmt.Errorf("failed to read enabled cgroup controllers: %w", err)
+	}
+
+	enabled := strings.Fields(string(data))
+
+	for _, controller := range cgroupControllers {
+		if !slices.Contains(available, controller) {
+			return fmt.Errorf("the %s cgroup controller isn't available", controller)
+		}
+
+		// The controllers are needed only by the sandboxes started under the jailer
+		if !slices.Contains(enabled, controller) {
+			return WarningError(fmt.Errorf("the %s cgroup controller isn't enabled for the child cgroups", controller))
+		}
+	}
+
+	return nil
+}
+
+func fixCgroups(_ context.Context) error {
+	for _, controller := range cgroupControllers {
+		err := os.WriteFile(filepath.Join(cgroupRoot, "cgroup.subtree_control"), []byte("+"+controller), 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to enable cgroup controller '%s': %w", controller, err)
+		}
+	}
+
+	return nil
+}
+
+func checkSnapshotCache(_ context.Context) error {
+	var stat unix.Statfs_t
+
+	err := unix.Statfs(snapshotCacheDir, &stat)
+	if err != nil {
+		return WarningError(fmt.Errorf("failed to stat %s: %w", snapshotCacheDir, err))
+	}
+
+	if stat.Type != unix.TMPFS_MAGIC {
+		return WarningError(fmt.Errorf("%s isn't a tmpfs mount", snapshotCacheDir))
+	}
+
+	return nil
+}
+
+func sysctlPath(key string) string {
+	return filepath.Join("/proc/sys", strings.ReplaceAll(key, ".", "/"))
+}
+
+func readSysctl(key string) (int64, error) {
+	data, err := os.ReadFile(sysctlPath(key))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+
+	value, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", key, err)
+	}
+
+	return value, nil
+}
+
+func (s sysctl) check(_ context.Context) error {
+	value, err := readSysctl(s.key)
+	// The conntrack sysctls exist only when the module is loaded
+	if errors.Is(err, os.ErrNotExist) && !s.required {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if value < s.min {
+		err = fmt.Errorf("%s is %d, at least %d is needed", s.key, value, s.min)
+		if !s.required {
+			return WarningError(err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// fix sets the sysctl until the reboot, the remediation persists it.
+func (s sysctl) fix(_ context.Context) error {
+	err := os.WriteFile(sysctlPath(s.key), []byte(strconv.FormatInt(s.min, 10)), 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to set %s: %w", s.key, err)
+	}
+
+	return nil
+}
+
+func checkStorage(ctx context.Context, bucket string) error {
+	if bucket == "" {
+		return fmt.Errorf("the template bucket isn't set")
+	}
+
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create storage client: %w", err)
+	}
+	defer client.Close()
+
+	it := client.Bucket(bucket).Objects(ctx, nil)
+	it.PageInfo().MaxSize = 1
+
+	_, err = it.Next()
+	if err != nil && !errors.Is(err, iterator.Done) {
+		return fmt.Errorf("failed to list objects in bucket '%s': %w", bucket, err)
+	}
+
+	return nil
+}
+
+func checkFile(path string, executable bool) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("%s isn't accessible: %w", path, err)
+	}
+
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", path)
+	}
+
+	if executable && info.Mode().Perm()&0o111 == 0 {
+		return fmt.Errorf("%s isn't executable", path)
+	}
+
+	return nil
+}
+
+// checkFirecracker checks the firecracker binary runs, the binaries are built for the architecture of the hosts.
+func checkFirecracker(ctx context.Context, version string) error {
+	path := filepath.Join(fcVersionsDir, version, "firecracker")
+
+	err := checkFile(path, true)
+	if err != nil {
+		return err
+	}
+
+	out, err := exec.CommandContext(ctx, path, "--version").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to run %s: %w: %s", path, err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
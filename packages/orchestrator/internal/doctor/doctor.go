@@ -0,0 +1,135 @@
+// Package doctor validates that the host is set up to run the orchestrator, the checks print the remediation
+// of the found problems and some of the problems can be fixed in place.
+//
+// The package doesn't depend on the sandbox packages, they fail to initialize on the misconfigured hosts the doctor is meant for.
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// Check validates one part of the host setup.
+type Check struct {
+	Name string
+	// Run returns an error describing the problem, the error is wrapped by WarningError if the orchestrator runs with it,
+	// only with limitations.
+	Run func(ctx context.Context) error
+	// Remediation describes how to fix the problem by hand.
+	Remediation string
+	// Fix fixes the problem in place, nil if the problem can't be fixed by the doctor.
+	Fix func(ctx context.Context) error
+}
+
+type warningError struct {
+	err error
+}
+
+func (e warningError) Error() string {
+	return e.err.Error()
+}
+
+func (e warningError) Unwrap() error {
+	return e.err
+}
+
+// WarningError marks the problem as a warning, the orchestrator runs with it, only with limitations.
+func WarningError(err error) error {
+	return warningError{err: err}
+}
+
+type Result struct {
+	Name    string
+	Status  Status
+	Message string
+	// Fixed is true if the problem was fixed, the check passed after the fix.
+	Fixed bool
+	// FixError is the error of the failed fix.
+	FixError error
+	// Remediation is set if the problem wasn't fixed.
+	Remediation string
+}
+
+// Run runs the checks in order, the problems are fixed if fix is true and the check can fix them.
+func Run(ctx context.Context, checks []Check, fix bool) []Result {
+	results := make([]Result, 0, len(checks))
+
+	for _, check := range checks {
+		result := run(ctx, check)
+
+		if result.Status != StatusOK && fix && check.Fix != nil {
+			err := check.Fix(ctx)
+			if err != nil {
+				result.FixError = err
+			} else if fixed := run(ctx, check); fixed.Status == StatusOK {
+				result = fixed
+				result.Fixed = true
+			} else {
+				result = fixed
+				result.FixError = errors.New("the problem persists after the fix")
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func run(ctx context.Context, check Check) Result {
+	err := check.Run(ctx)
+	if err == nil {
+		return Result{Name: check.Name, Status: StatusOK}
+	}
+
+	status := StatusFail
+	if errors.As(err, &warningError{}) {
+		status = StatusWarn
+	}
+
+	return Result{
+		Name:        check.Name,
+		Status:      status,
+		Message:     err.Error(),
+		Remediation: check.Remediation,
+	}
+}
+
+// Print writes the results in a human readable form and returns the number of the failed checks.
+func Print(w io.Writer, results []Result) int {
+	failed := 0
+
+	for _, result := range results {
+		switch {
+		case result.Fixed:
+			fmt.Fprintf(w, "[FIXED] %s\n", result.Name)
+		case result.Status == StatusOK:
+			fmt.Fprintf(w, "[ OK  ] %s\n", result.Name)
+		case result.Status == StatusWarn:
+			fmt.Fprintf(w, "[WARN ] %s: %s\n", result.Name, result.Message)
+		default:
+			failed++
+			fmt.Fprintf(w, "[FAIL ] %s: %s\n", result.Name, result.Message)
+		}
+
+		if result.FixError != nil {
+			fmt.Fprintf(w, "        fix failed: %v\n", result.FixError)
+		}
+
+		if result.Status != StatusOK && result.Remediation != "" {
+			fmt.Fprintf(w, "        remediation: %s\n", result.Remediation)
+		}
+	}
+
+	return failed
+}
@@ -0,0 +1,478 @@
+// Package encryption encrypts the memfile and rootfs diffs of the snapshots before they are uploaded to the storage.
+// Each team has its own data key wrapped by the KMS, the keys of every diff are derived from the data key and the random salt.
+// The diffs are encrypted by AES-256-CTR, so the offsets of the data don't change and the chunks can be decrypted independently
+// when they are read. The wrapped data key and the salt are stored in the metadata of the object.
+//
+// Every chunk of the ciphertext is authenticated by HMAC-SHA256 of its index and its content. The tags of the chunks are appended
+// to the object after the ciphertext, followed by the tag of the whole table that also covers the size of the diff,
+// so the chunks can't be modified, reordered or truncated. The table is verified when the diff is opened
+// and each chunk is verified before it's decrypted.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/kms"
+)
+
+const (
+	// kmsEnv selects the KMS wrapping the data keys, the snapshots are not encrypted if it's not set.
+	kmsEnv = "SNAPSHOT_ENCRYPTION_KMS"
+	// keyEnv is the key of the KMS, the resource name of the GCP KMS key, the ARN or alias of the AWS KMS key or the name of the Vault transit key.
+	keyEnv = "SNAPSHOT_ENCRYPTION_KEY"
+
+	algorithm = "AES256-CTR-HMAC-SHA256"
+
+	metadataAlgorithm  = "e2b-encryption"
+	metadataKMS        = "e2b-encryption-kms"
+	metadataKeyID      = "e2b-encryption-key-id"
+	metadataWrappedKey = "e2b-encryption-wrapped-key"
+	metadataTeamID     = "e2b-encryption-team-id"
+	metadataSalt       = "e2b-encryption-salt"
+
+	keySize  = 32
+	saltSize = 32
+
+	// authChunkSize is the size of the authenticated chunks, it's the same as the size of the chunks the orchestrator
+	// fetches from the storage, so every fetch verifies exactly one tag.
+	authChunkSize = 4 * 1024 * 1024
+	tagSize       = sha256.Size
+
+	kmsTimeout = 10 * time.Second
+	// unwrappedKeyExpiration is how long the data keys are kept in memory, so the KMS is not called for every diff.
+	// The rotation and the revocation of the KMS keys take effect after it, the expiration isn't extended by the use of the key.
+	unwrappedKeyExpiration = time.Hour
+)
+
+var (
+	ErrNotConfigured = errors.New("snapshot encryption is not configured on the node")
+	ErrNotAuthentic  = errors.New("diff failed the authentication")
+)
+
+var (
+	keys kms.KeyManager
+
+	teamKeysMu sync.Mutex
+	teamKeys   = ttlcache.New(
+		ttlcache.WithTTL[string, *dataKey](unwrappedKeyExpiration),
+		ttlcache.WithDisableTouchOnHit[string, *dataKey](),
+	)
+
+	unwrapped = ttlcache.New(
+		ttlcache.WithTTL[string, []byte](unwrappedKeyExpiration),
+		ttlcache.WithDisableTouchOnHit[string, []byte](),
+	)
+	unwraps singleflight.Group
+)
+
+type dataKey struct {
+	key     []byte
+	wrapped []byte
+	keyID   string
+}
+
+// Init configures the KMS from the env, the snapshots can't be encrypted if the KMS is not configured.
+func Init(ctx context.Context) error {
+	name := os.Getenv(kmsEnv)
+	if name != "" {
+		key := os.Getenv(keyEnv)
+		if key == "" {
+			return fmt.Errorf("%s is required for the snapshot encryption with %s", keyEnv, name)
+		}
+
+		manager, err := kms.New(ctx, name, key)
+		if err != nil {
+			return fmt.Errorf("failed to configure snapshot encryption: %w", err)
+		}
+
+		keys = manager
+	}
+
+	go teamKeys.Start()
+	go unwrapped.Start()
+
+	return nil
+}
+
+// teamKey returns the data key of the team, the key is generated and wrapped by the KMS again after it expires.
+func teamKey(ctx context.Context, teamID string) (*dataKey, error) {
+	teamKeysMu.Lock()
+	defer teamKeysMu.Unlock()
+
+	if item := teamKeys.Get(teamID); item != nil {
+		return item.Value(), nil
+	}
+
+	key := make([]byte, keySize)
+
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, kmsTimeout)
+	defer cancel()
+
+	wrapped, keyID, err := keys.Wrap(ctx, teamID, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key of team '%s': %w", teamID, err)
+	}
+
+	teamDataKey := &dataKey{
+		key:     key,
+		wrapped: wrapped,
+		keyID:   keyID,
+	}
+
+	teamKeys.Set(teamID, teamDataKey, ttlcache.DefaultTTL)
+
+	return teamDataKey, nil
+}
+
+// diffKeys are the keys of one diff.
+type diffKeys struct {
+	block  cipher.Block
+	macKey []byte
+}
+
+// newDiffKeys derives the encryption and the authentication keys of the diff from the data key and the salt.
+func newDiffKeys(key, salt []byte) (*diffKeys, error) {
+	block, err := aes.NewCipher(deriveKey(key, salt, "encryption"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &diffKeys{
+		block:  block,
+		macKey: deriveKey(key, salt, "authentication"),
+	}, nil
+}
+
+func deriveKey(key, salt []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(algorithm))
+	mac.Write([]byte(purpose))
+	mac.Write(salt)
+
+	return mac.Sum(nil)
+}
+
+// chunkTag authenticates the ciphertext of the chunk at the index.
+func (k *diffKeys) chunkTag(idx int64, ciphertext []byte) []byte {
+	var header [8]byte
+	binary.BigEndian.PutUint64(header[:], uint64(idx))
+
+	mac := hmac.New(sha256.New, k.macKey)
+	mac.Write([]byte("chunk"))
+	mac.Write(header[:])
+	mac.Write(ciphertext)
+
+	return mac.Sum(nil)
+}
+
+// tableTag authenticates the tags of all the chunks and the size of the diff.
+func (k *diffKeys) tableTag(size int64, tags []byte) []byte {
+	var header [8]byte
+	binary.BigEndian.PutUint64(header[:], uint64(size))
+
+	mac := hmac.New(sha256.New, k.macKey)
+	mac.Write([]byte("table"))
+	mac.Write(header[:])
+	mac.Write(tags)
+
+	return mac.Sum(nil)
+}
+
+// keyStream returns the CTR stream positioned at the offset of the data.
+func keyStream(block cipher.Block, off int64) cipher.Stream {
+	var iv [aes.BlockSize]byte
+	binary.BigEndian.PutUint64(iv[8:], uint64(off/aes.BlockSize))
+
+	stream := cipher.NewCTR(block, iv[:])
+
+	if skip := off % aes.BlockSize; skip > 0 {
+		var discard [aes.BlockSize]byte
+		stream.XORKeyStream(discard[:skip], discard[:skip])
+	}
+
+	return stream
+}
+
+// Encryption encrypts the diffs of the team's snapshot.
+type Encryption struct {
+	key    *dataKey
+	teamID string
+}
+
+// NewEncryption returns the encryption of the diffs with the data key of the team.
+func NewEncryption(ctx context.Context, teamID string) (*Encryption, error) {
+	if keys == nil {
+		return nil, ErrNotConfigured
+	}
+
+	key, err := teamKey(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Encryption{
+		key:    key,
+		teamID: teamID,
+	}, nil
+}
+
+func (e *Encryption) Encrypt(src io.Reader) (io.Reader, map[string]string, error) {
+	salt := make([]byte, saltSize)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	diffKeys, err := newDiffKeys(e.key.key, salt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	metadata := map[string]string{
+		metadataAlgorithm:  algorithm,
+		metadataKMS:        keys.Name(),
+		metadataKeyID:      e.key.keyID,
+		metadataWrappedKey: base64.StdEncoding.EncodeToString(e.key.wrapped),
+		metadataTeamID:     e.teamID,
+		metadataSalt:       base64.StdEncoding.EncodeToString(salt),
+	}
+
+	return &encryptingReader{
+		src:    src,
+		keys:   diffKeys,
+		stream: keyStream(diffKeys.block, 0),
+		buf:    make([]byte, authChunkSize),
+	}, metadata, nil
+}
+
+// encryptingReader encrypts the diff chunk by chunk and appends the table of the tags after the last chunk.
+type encryptingReader struct {
+	src    io.Reader
+	keys   *diffKeys
+	stream cipher.Stream
+	buf    []byte
+
+	size    int64
+	tags    []byte
+	pending []byte
+	done    bool
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		err := r.next()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+
+	return n, nil
+}
+
+// next encrypts the next chunk of the source, or prepares the table of the tags at the end of the source.
+func (r *encryptingReader) next() error {
+	n, err := io.ReadFull(r.src, r.buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+
+	if n == 0 {
+		r.pending = append(r.tags, r.keys.tableTag(r.size, r.tags)...)
+		r.done = true
+
+		return nil
+	}
+
+	chunk := r.buf[:n]
+	r.stream.XORKeyStream(chunk, chunk)
+
+	r.tags = append(r.tags, r.keys.chunkTag(r.size/authChunkSize, chunk)...)
+	r.size += int64(n)
+	r.pending = chunk
+
+	return nil
+}
+
+// unwrap returns the data key from the metadata of the diff, the unwrapped keys are cached.
+func unwrap(ctx context.Context, metadata map[string]string) ([]byte, error) {
+	if keys == nil {
+		return nil, ErrNotConfigured
+	}
+
+	if metadata[metadataKMS] != keys.Name() {
+		return nil, fmt.Errorf("diff was encrypted with KMS '%s', but '%s' is configured", metadata[metadataKMS], keys.Name())
+	}
+
+	teamID, keyID := metadata[metadataTeamID], metadata[metadataKeyID]
+	cacheKey := teamID + "/" + keyID + "/" + metadata[metadataWrappedKey]
+
+	if item := unwrapped.Get(cacheKey); item != nil {
+		return item.Value(), nil
+	}
+
+	key, err, _ := unwraps.Do(cacheKey, func() (any, error) {
+		wrapped, err := base64.StdEncoding.DecodeString(metadata[metadataWrappedKey])
+		if err != nil {
+			return nil, fmt.Errorf("invalid wrapped data key: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), kmsTimeout)
+		defer cancel()
+
+		key, err := keys.Unwrap(ctx, teamID, keyID, wrapped)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unwrap data key of team '%s': %w", teamID, err)
+		}
+
+		unwrapped.Set(cacheKey, key, ttlcache.DefaultTTL)
+
+		return key, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return key.([]byte), nil
+}
+
+type decryptingReaderAt struct {
+	src  io.ReaderAt
+	keys *diffKeys
+	size int64
+	tags []byte
+}
+
+// NewReaderAt returns the reader decrypting the diff by the metadata of its object and the size of the diff without the tags.
+// The diffs without the encryption are returned unchanged.
+func NewReaderAt(ctx context.Context, src io.ReaderAt, objectSize int64, metadata map[string]string) (io.ReaderAt, int64, error) {
+	alg, ok := metadata[metadataAlgorithm]
+	if !ok {
+		return src, objectSize, nil
+	}
+
+	if alg != algorithm {
+		return nil, 0, fmt.Errorf("unknown diff encryption '%s'", alg)
+	}
+
+	key, err := unwrap(ctx, metadata)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(metadata[metadataSalt])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid salt: %w", err)
+	}
+
+	diffKeys, err := newDiffKeys(key, salt)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	// Every chunk but the last one is full, so the number of the chunks is determined by the size of the object
+	withoutTableTag := objectSize - tagSize
+	chunks := (withoutTableTag + authChunkSize + tagSize - 1) / (authChunkSize + tagSize)
+	size := withoutTableTag - chunks*tagSize
+
+	if withoutTableTag < 0 || size < 0 {
+		return nil, 0, fmt.Errorf("%w: object is too small for its tags", ErrNotAuthentic)
+	}
+
+	table := make([]byte, objectSize-size)
+
+	_, err = src.ReadAt(table, size)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, 0, fmt.Errorf("failed to read the tags: %w", err)
+	}
+
+	tags, tag := table[:len(table)-tagSize], table[len(table)-tagSize:]
+	if !hmac.Equal(tag, diffKeys.tableTag(size, tags)) {
+		return nil, 0, fmt.Errorf("%w: invalid table of the tags", ErrNotAuthentic)
+	}
+
+	return &decryptingReaderAt{
+		src:  src,
+		keys: diffKeys,
+		size: size,
+		tags: tags,
+	}, size, nil
+}
+
+// ReadAt reads and verifies the whole chunks the range is in before it decrypts them.
+func (r *decryptingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	end := min(off+int64(len(p)), r.size)
+
+	for idx := off / authChunkSize; idx*authChunkSize < end; idx++ {
+		chunkStart := idx * authChunkSize
+		chunkEnd := min(chunkStart+authChunkSize, r.size)
+
+		// The chunks read whole are decrypted in place, the others are decrypted in the separate buffer
+		var chunk []byte
+		if chunkStart >= off && chunkEnd <= end {
+			chunk = p[chunkStart-off : chunkEnd-off]
+		} else {
+			chunk = make([]byte, chunkEnd-chunkStart)
+		}
+
+		n, err := r.src.ReadAt(chunk, chunkStart)
+		if n < len(chunk) {
+			if err == nil {
+				err = io.ErrUnexpectedEOF
+			}
+
+			return 0, fmt.Errorf("failed to read chunk %d: %w", idx, err)
+		}
+
+		tag := r.tags[idx*tagSize : (idx+1)*tagSize]
+		if !hmac.Equal(tag, r.keys.chunkTag(idx, chunk)) {
+			return 0, fmt.Errorf("%w: invalid tag of chunk %d", ErrNotAuthentic, idx)
+		}
+
+		keyStream(r.keys.block, chunkStart).XORKeyStream(chunk, chunk)
+
+		if chunkStart < off || chunkEnd > end {
+			from, to := max(chunkStart, off), min(chunkEnd, end)
+			copy(p[from-off:to-off], chunk[from-chunkStart:to-chunkStart])
+		}
+	}
+
+	n := int(end - off)
+	if n < len(p) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
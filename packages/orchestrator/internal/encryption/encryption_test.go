@@ -0,0 +1,279 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"io"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testKeyManager returns the data keys unwrapped, the tests don't need the KMS.
+type testKeyManager struct{}
+
+func (testKeyManager) Name() string {
+	return "test"
+}
+
+func (testKeyManager) Wrap(_ context.Context, _ string, dataKey []byte) ([]byte, string, error) {
+	return bytes.Clone(dataKey), "test-key", nil
+}
+
+func (testKeyManager) Unwrap(_ context.Context, _, _ string, wrapped []byte) ([]byte, error) {
+	return bytes.Clone(wrapped), nil
+}
+
+const testDiffSize = 3*4096 + 7
+
+// testDiff is the diff encrypted with its own salt, it starts at the offset in the device like in the mappings of the header.
+type testDiff struct {
+	offset    int64
+	plaintext []byte
+	reader    io.ReaderAt
+}
+
+func newTestDiffs(t *testing.T, count int) []*testDiff {
+	keys = testKeyManager{}
+	t.Cleanup(func() {
+		keys = nil
+	})
+
+	e, err := NewEncryption(context.Background(), "test-team")
+	require.NoError(t, err)
+
+	r := rand.New(rand.NewSource(1))
+
+	diffs := make([]*testDiff, count)
+	for i := range diffs {
+		plaintext := make([]byte, testDiffSize)
+		r.Read(plaintext)
+
+		encrypted, metadata, err := e.Encrypt(bytes.NewReader(plaintext))
+		require.NoError(t, err)
+
+		ciphertext, err := io.ReadAll(encrypted)
+		require.NoError(t, err)
+		require.NotEqual(t, plaintext, ciphertext[:testDiffSize])
+
+		reader, size, err := NewReaderAt(context.Background(), bytes.NewReader(ciphertext), int64(len(ciphertext)), metadata)
+		require.NoError(t, err)
+		require.Equal(t, int64(testDiffSize), size)
+
+		diffs[i] = &testDiff{
+			offset:    int64(i) * testDiffSize,
+			plaintext: plaintext,
+			reader:    reader,
+		}
+	}
+
+	return diffs
+}
+
+// readDevice reads the range of the device by splitting it at the boundaries of the diffs.
+func readDevice(t *testing.T, diffs []*testDiff, off, length int64) []byte {
+	b := make([]byte, length)
+
+	for _, diff := range diffs {
+		start := max(off, diff.offset)
+		end := min(off+length, diff.offset+testDiffSize)
+		if start >= end {
+			continue
+		}
+
+		n, err := diff.reader.ReadAt(b[start-off:end-off], start-diff.offset)
+		require.NoError(t, err)
+		require.Equal(t, int(end-start), n)
+	}
+
+	return b
+}
+
+func TestReadAtRoundTrip(t *testing.T) {
+	diffs := newTestDiffs(t, 3)
+
+	device := make([]byte, 0, len(diffs)*testDiffSize)
+	for _, diff := range diffs {
+		device = append(device, diff.plaintext...)
+	}
+
+	tests := []struct {
+		name   string
+		off    int64
+		length int64
+	}{
+		{name: "whole device", off: 0, length: int64(len(device))},
+		{name: "single byte", off: 5, length: 1},
+		{name: "aligned block", off: aes.BlockSize, length: aes.BlockSize},
+		{name: "unaligned inside block", off: 3, length: 9},
+		{name: "unaligned across block", off: aes.BlockSize - 1, length: 2},
+		{name: "unaligned across blocks", off: 7, length: 5*aes.BlockSize + 3},
+		{name: "across page", off: 4096 - 5, length: 11},
+		{name: "end of diff", off: testDiffSize - 3, length: 3},
+		{name: "across diff", off: testDiffSize - 5, length: 10},
+		{name: "across diff aligned", off: testDiffSize - 7, length: 2 * aes.BlockSize},
+		{name: "across all diffs", off: 13, length: 2*testDiffSize + 17},
+		{name: "end of device", off: int64(len(device)) - 21, length: 21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := readDevice(t, diffs, tt.off, tt.length)
+
+			require.Equal(t, device[tt.off:tt.off+tt.length], b)
+		})
+	}
+}
+
+func TestReadAtPastEnd(t *testing.T) {
+	diff := newTestDiffs(t, 1)[0]
+
+	b := make([]byte, 2*aes.BlockSize)
+	off := int64(testDiffSize - 5)
+
+	n, err := diff.reader.ReadAt(b, off)
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, 5, n)
+	require.Equal(t, diff.plaintext[off:], b[:n])
+}
+
+func TestReadAtUnencrypted(t *testing.T) {
+	src := bytes.NewReader([]byte("plain diff"))
+
+	reader, size, err := NewReaderAt(context.Background(), src, src.Size(), map[string]string{})
+	require.NoError(t, err)
+	require.Equal(t, src, reader)
+	require.Equal(t, src.Size(), size)
+}
+
+// encryptTestDiff returns the encrypted object of the random diff of the size.
+func encryptTestDiff(t *testing.T, size int) (plaintext, object []byte, metadata map[string]string) {
+	keys = testKeyManager{}
+	t.Cleanup(func() {
+		keys = nil
+	})
+
+	e, err := NewEncryption(context.Background(), "test-team")
+	require.NoError(t, err)
+
+	plaintext = make([]byte, size)
+	rand.New(rand.NewSource(2)).Read(plaintext)
+
+	encrypted, metadata, err := e.Encrypt(bytes.NewReader(plaintext))
+	require.NoError(t, err)
+
+	object, err = io.ReadAll(encrypted)
+	require.NoError(t, err)
+
+	return plaintext, object, metadata
+}
+
+func TestReadAtChunks(t *testing.T) {
+	for _, size := range []int{0, 1, authChunkSize, 2*authChunkSize + 5} {
+		plaintext, object, metadata := encryptTestDiff(t, size)
+
+		reader, readerSize, err := NewReaderAt(context.Background(), bytes.NewReader(object), int64(len(object)), metadata)
+		require.NoError(t, err)
+		require.Equal(t, int64(size), readerSize)
+
+		// The reads of the whole chunks like the chunker does them
+		for off := 0; off < size; off += authChunkSize {
+			b := make([]byte, authChunkSize)
+
+			n, err := reader.ReadAt(b, int64(off))
+			if off+authChunkSize > size {
+				require.ErrorIs(t, err, io.EOF)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, plaintext[off:off+n], b[:n])
+		}
+
+		if size > authChunkSize {
+			b := make([]byte, 10)
+
+			_, err = reader.ReadAt(b, authChunkSize-5)
+			require.NoError(t, err)
+			require.Equal(t, plaintext[authChunkSize-5:authChunkSize+5], b)
+		}
+	}
+}
+
+func TestReadAtTampered(t *testing.T) {
+	_, object, metadata := encryptTestDiff(t, 2*authChunkSize+5)
+
+	open := func(object []byte) (io.ReaderAt, error) {
+		reader, _, err := NewReaderAt(context.Background(), bytes.NewReader(object), int64(len(object)), metadata)
+
+		return reader, err
+	}
+
+	t.Run("modified chunk", func(t *testing.T) {
+		tampered := bytes.Clone(object)
+		tampered[authChunkSize+3] ^= 1
+
+		reader, err := open(tampered)
+		require.NoError(t, err)
+
+		_, err = reader.ReadAt(make([]byte, 1), 0)
+		require.NoError(t, err)
+
+		_, err = reader.ReadAt(make([]byte, 1), authChunkSize)
+		require.ErrorIs(t, err, ErrNotAuthentic)
+	})
+
+	t.Run("swapped chunks", func(t *testing.T) {
+		tampered := bytes.Clone(object)
+		copy(tampered[:authChunkSize], object[authChunkSize:2*authChunkSize])
+		copy(tampered[authChunkSize:2*authChunkSize], object[:authChunkSize])
+
+		reader, err := open(tampered)
+		require.NoError(t, err)
+
+		_, err = reader.ReadAt(make([]byte, 1), 0)
+		require.ErrorIs(t, err, ErrNotAuthentic)
+	})
+
+	t.Run("truncated object", func(t *testing.T) {
+		_, err := open(object[:len(object)-1])
+		require.ErrorIs(t, err, ErrNotAuthentic)
+	})
+
+	t.Run("modified table", func(t *testing.T) {
+		tampered := bytes.Clone(object)
+		tampered[len(tampered)-2*tagSize] ^= 1
+
+		_, err := open(tampered)
+		require.ErrorIs(t, err, ErrNotAuthentic)
+	})
+}
+
+func TestTeamKeyExpires(t *testing.T) {
+	keys = testKeyManager{}
+	t.Cleanup(func() {
+		keys = nil
+		teamKeys.Delete("expiring-team")
+	})
+
+	first, err := teamKey(context.Background(), "expiring-team")
+	require.NoError(t, err)
+
+	cached, err := teamKey(context.Background(), "expiring-team")
+	require.NoError(t, err)
+	require.Same(t, first, cached)
+
+	// The key expires with the unwrapped keys, the new key is wrapped by the current KMS key
+	item := teamKeys.Get("expiring-team")
+	require.NotNil(t, item)
+	require.WithinDuration(t, time.Now().Add(unwrappedKeyExpiration), item.ExpiresAt(), time.Minute)
+
+	teamKeys.Delete("expiring-team")
+
+	renewed, err := teamKey(context.Background(), "expiring-team")
+	require.NoError(t, err)
+	require.NotEqual(t, first.key, renewed.key)
+}
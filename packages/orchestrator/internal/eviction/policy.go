@@ -0,0 +1,113 @@
+// Package eviction selects the sandboxes evicted from the node when it's over capacity.
+// The evicted sandboxes are paused by the API, so they can be resumed by their teams later.
+package eviction
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+type Policy string
+
+const (
+	// PolicyOldestIdleFirst evicts the idle sandboxes that were started first, the busy sandboxes are not evicted.
+	PolicyOldestIdleFirst Policy = "oldest-idle-first"
+	// PolicyLowestPriorityFirst evicts the sandboxes of the lowest priority class first, the idle ones before the busy ones.
+	PolicyLowestPriorityFirst Policy = "lowest-priority-first"
+	// PolicyNever doesn't evict any sandboxes, the node only stops accepting new sandboxes.
+	PolicyNever Policy = "never"
+
+	defaultPolicy = PolicyOldestIdleFirst
+)
+
+// idleCPUUsage is the number of CPUs below which the sandbox is considered idle.
+const idleCPUUsage = 0.05
+
+// Candidate is a sandbox that can be evicted.
+type Candidate struct {
+	SandboxID string
+	Priority  orchestrator.SandboxPriority
+	StartedAt time.Time
+	// CPUUsage is the number of CPUs used by the sandbox on average since it was last measured.
+	CPUUsage float64
+}
+
+func (c Candidate) idle() bool {
+	return c.CPUUsage < idleCPUUsage
+}
+
+// priorityRank orders the priority classes from the first evicted, the normal priority is the zero value of the enum.
+func priorityRank(priority orchestrator.SandboxPriority) int {
+	switch priority {
+	case orchestrator.SandboxPriority_PRIORITY_LOW:
+		return 0
+	case orchestrator.SandboxPriority_PRIORITY_HIGH:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func ParsePolicy(value string) (Policy, error) {
+	switch policy := Policy(value); policy {
+	case PolicyOldestIdleFirst, PolicyLowestPriorityFirst, PolicyNever:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown eviction policy '%s', expected one of %s, %s, %s", value, PolicyOldestIdleFirst, PolicyLowestPriorityFirst, PolicyNever)
+	}
+}
+
+// PolicyFromEnv returns the policy from the EVICTION_POLICY environment variable, it's oldest-idle-first if not set.
+func PolicyFromEnv() (Policy, error) {
+	value := os.Getenv("EVICTION_POLICY")
+	if value == "" {
+		return defaultPolicy, nil
+	}
+
+	return ParsePolicy(value)
+}
+
+// Select returns at most count of the candidates in the order they should be evicted.
+func (p Policy) Select(candidates []Candidate, count int) []Candidate {
+	var selected []Candidate
+
+	switch p {
+	case PolicyOldestIdleFirst:
+		for _, candidate := range candidates {
+			if candidate.idle() {
+				selected = append(selected, candidate)
+			}
+		}
+
+		slices.SortFunc(selected, func(a, b Candidate) int {
+			return a.StartedAt.Compare(b.StartedAt)
+		})
+	case PolicyLowestPriorityFirst:
+		selected = slices.Clone(candidates)
+
+		slices.SortFunc(selected, func(a, b Candidate) int {
+			if rank := cmp.Compare(priorityRank(a.Priority), priorityRank(b.Priority)); rank != 0 {
+				return rank
+			}
+
+			if a.idle() != b.idle() {
+				if a.idle() {
+					return -1
+				}
+
+				return 1
+			}
+
+			return a.StartedAt.Compare(b.StartedAt)
+		})
+	default:
+		return nil
+	}
+
+	return selected[:min(count, len(selected))]
+}
@@ -0,0 +1,118 @@
+// Package registration registers the node with the API when the nodes aren't discovered via Nomad, e.g. in Kubernetes,
+// where the orchestrator runs as a DaemonSet. The registration is renewed periodically and it expires when the node is gone.
+package registration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/cfg"
+	e2bgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+const registerTimeout = 5 * time.Second
+
+type Registrar struct {
+	config *cfg.Config
+	port   int
+	health *health.Server
+
+	client     orchestrator.NodeServiceClient
+	connection e2bgrpc.ClientConnInterface
+
+	draining atomic.Bool
+}
+
+// New returns the registrar of the node, the orchestrator server of the node listens on the port.
+// The health of the server is set to serving after the node is registered for the first time.
+func New(config *cfg.Config, port int, healthServer *health.Server) (*Registrar, error) {
+	conn, err := e2bgrpc.GetConnection(
+		config.APIAddress,
+		false,
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		e2bgrpc.WithToken(config.Token),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the API: %w", err)
+	}
+
+	return &Registrar{
+		config:     config,
+		port:       port,
+		health:     healthServer,
+		client:     orchestrator.NewNodeServiceClient(conn),
+		connection: conn,
+	}, nil
+}
+
+// Start renews the registration until the context is canceled.
+func (r *Registrar) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.config.RegisterInterval)
+	defer ticker.Stop()
+
+	registered := false
+
+	for {
+		err := r.register(ctx)
+		if err != nil {
+			log.Printf("failed to register node '%s' with the API: %v", r.config.NodeID, err)
+		} else if !registered {
+			registered = true
+
+			log.Printf("registered node '%s' with the API", r.config.NodeID)
+		}
+
+		if registered && !r.draining.Load() {
+			r.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Drain registers the node as draining, so the API doesn't place new sandboxes on it, and marks the node as not ready.
+func (r *Registrar) Drain(ctx context.Context) error {
+	r.draining.Store(true)
+	r.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	return r.register(ctx)
+}
+
+func (r *Registrar) Close() error {
+	return r.connection.Close()
+}
+
+func (r *Registrar) register(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, registerTimeout)
+	defer cancel()
+
+	_, err := r.client.Register(ctx, &orchestrator.NodeRegisterRequest{
+		NodeId:              r.config.NodeID,
+		OrchestratorAddress: net.JoinHostPort(r.config.NodeIP, strconv.Itoa(r.port)),
+		IpAddress:           r.config.NodeIP,
+		Labels:              r.config.Labels,
+		CpuCount:            r.config.CPUCount,
+		MemoryMb:            r.config.MemoryMB,
+		Draining:            r.draining.Load(),
+		ClusterId:           r.config.ClusterID,
+		Region:              r.config.Region,
+		ClusterProxyIp:      r.config.ClusterProxyIP,
+	})
+
+	return err
+}
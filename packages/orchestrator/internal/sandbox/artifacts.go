@@ -0,0 +1,69 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/requestid"
+)
+
+// minEnvdVersionForFileArtifacts is the first envd version that archives the single files, the older versions archive only the directories.
+const minEnvdVersionForFileArtifacts = "v0.1.19"
+
+// ErrArtifactPath is returned when envd can't archive the path, e.g. it doesn't exist.
+type ErrArtifactPath struct {
+	Path    string
+	Message string
+}
+
+func (e ErrArtifactPath) Error() string {
+	return fmt.Sprintf("path '%s' can't be archived: %s", e.Path, e.Message)
+}
+
+// ArchivePath streams the file or the directory from the sandbox as tar.gz, the archive is read while it's streamed.
+// The caller must close the returned reader.
+func (s *Sandbox) ArchivePath(ctx context.Context, path string) (io.ReadCloser, error) {
+	query := url.Values{}
+	query.Set("path", path)
+	query.Set("username", processUser)
+	query.Set("format", "tar.gz")
+
+	address := fmt.Sprintf("http://%s:%d/files/archive?%s", s.Slot.HostIP(), consts.DefaultEnvdServerPort, query.Encode())
+
+	request, err := http.NewRequestWithContext(ctx, "GET", address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	requestid.InjectHeaders(ctx, request.Header)
+
+	// The archive of the large directory can take longer than the default client timeout.
+	response, err := processClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusOK {
+		return response.Body, nil
+	}
+
+	defer response.Body.Close()
+
+	message, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+
+	switch response.StatusCode {
+	case http.StatusBadRequest, http.StatusNotFound:
+		artifactErr := ErrArtifactPath{Path: path, Message: string(message)}
+		if !isGTEVersion(s.Config.EnvdVersion, minEnvdVersionForFileArtifacts) {
+			artifactErr.Message += fmt.Sprintf(" (envd version %s can archive only directories, rebuild the template to persist single files)", s.Config.EnvdVersion)
+		}
+
+		return nil, artifactErr
+	default:
+		return nil, fmt.Errorf("unexpected status code %d: %s", response.StatusCode, message)
+	}
+}
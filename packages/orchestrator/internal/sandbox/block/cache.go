@@ -38,6 +38,9 @@ type Cache struct {
 	dirty     sync.Map
 	dirtyFile bool
 	closed    atomic.Bool
+
+	// generation is increased with every write, the dirty map stores the generation of the last write to each block.
+	generation atomic.Uint64
 }
 
 // When we are passing filePath that is a file that has content we want to server want to use dirtyFile = true.
@@ -106,6 +109,28 @@ func (m *Cache) Export(out io.Writer) (*bitset.BitSet, error) {
 	return tracked, nil
 }
 
+// ChangedSince returns the blocks that were written after the given generation and the current generation.
+func (m *Cache) ChangedSince(generation uint64) (*bitset.BitSet, uint64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.isClosed() {
+		return nil, 0, NewErrCacheClosed(m.filePath)
+	}
+
+	changed := bitset.New(uint(header.TotalBlocks(m.size, m.blockSize)))
+
+	m.dirty.Range(func(key, value any) bool {
+		if value.(uint64) > generation {
+			changed.Set(uint(header.BlockIdx(key.(int64), m.blockSize)))
+		}
+
+		return true
+	})
+
+	return changed, m.generation.Load(), nil
+}
+
 func (m *Cache) ReadAt(b []byte, off int64) (int, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -175,9 +200,10 @@ func (m *Cache) Slice(off, length int64) ([]byte, error) {
 	return nil, ErrBytesNotAvailable{}
 }
 
+// isCached returns true if all blocks the range overlaps are cached, the range doesn't have to be aligned to the blocks.
 func (m *Cache) isCached(off, length int64) bool {
-	for _, blockOff := range header.BlocksOffsets(length, m.blockSize) {
-		_, dirty := m.dirty.Load(off + blockOff)
+	for block := header.BlockIdx(off, m.blockSize); block*m.blockSize < off+length; block++ {
+		_, dirty := m.dirty.Load(header.BlockOffset(block, m.blockSize))
 		if !dirty {
 			return false
 		}
@@ -187,8 +213,10 @@ func (m *Cache) isCached(off, length int64) bool {
 }
 
 func (m *Cache) setIsCached(off, length int64) {
+	generation := m.generation.Add(1)
+
 	for _, blockOff := range header.BlocksOffsets(length, m.blockSize) {
-		m.dirty.Store(off+blockOff, struct{}{})
+		m.dirty.Store(off+blockOff, generation)
 	}
 }
 
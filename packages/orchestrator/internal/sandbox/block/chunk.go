@@ -8,6 +8,7 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/chaos"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
@@ -102,15 +103,26 @@ func (c *Chunker) Slice(off, length int64) ([]byte, error) {
 	return b, nil
 }
 
+// Prefetch fetches the chunks of the range to the cache, the chunks already fetched are not fetched again.
+func (c *Chunker) Prefetch(off, length int64) error {
+	length = min(length, c.size-off)
+	if length <= 0 {
+		return nil
+	}
+
+	return c.fetchToCache(off, length)
+}
+
 // fetchToCache ensures that the data at the given offset and length is available in the cache.
 func (c *Chunker) fetchToCache(off, length int64) error {
 	var eg errgroup.Group
 
-	chunks := header.BlocksOffsets(length, ChunkSize)
-
 	startingChunk := header.BlockIdx(off, ChunkSize)
 	startingChunkOffset := header.BlockOffset(startingChunk, ChunkSize)
 
+	// The range doesn't have to be aligned to the chunks, all chunks it overlaps are fetched
+	chunks := header.BlocksOffsets(off+length-startingChunkOffset, ChunkSize)
+
 	for _, chunkOff := range chunks {
 		// Ensure the closure captures the correct block offset.
 		fetchOff := startingChunkOffset + chunkOff
@@ -131,9 +143,14 @@ func (c *Chunker) fetchToCache(off, length int64) error {
 				default:
 				}
 
+				err := chaos.Inject(chaos.StorageRead)
+				if err != nil {
+					return fmt.Errorf("failed to read chunk from base %d: %w", fetchOff, err)
+				}
+
 				b := make([]byte, ChunkSize)
 
-				_, err := c.base.ReadAt(b, fetchOff)
+				_, err = c.base.ReadAt(b, fetchOff)
 				if err != nil && !errors.Is(err, io.EOF) {
 					return fmt.Errorf("failed to read chunk from base %d: %w", fetchOff, err)
 				}
@@ -3,8 +3,11 @@ package block
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 
+	"github.com/bits-and-blooms/bitset"
+
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
@@ -13,6 +16,9 @@ type Overlay struct {
 	cache        *Cache
 	blockSize    int64
 	cacheEjected atomic.Bool
+
+	// writeMu serializes the writes, the writes of the partial blocks read and write back the whole block.
+	writeMu sync.Mutex
 }
 
 func NewOverlay(device ReadonlyDevice, cache *Cache, blockSize int64) *Overlay {
@@ -23,23 +29,28 @@ func NewOverlay(device ReadonlyDevice, cache *Cache, blockSize int64) *Overlay {
 	}
 }
 
+// ReadAt reads the blocks from the cache if they were written, from the device otherwise.
+// The reads don't have to be aligned to the blocks, the device block size can be smaller than the block size of the overlay.
 func (o *Overlay) ReadAt(p []byte, off int64) (int, error) {
-	blocks := header.BlocksOffsets(int64(len(p)), o.blockSize)
+	for n := int64(0); n < int64(len(p)); {
+		blockOff := off + n - header.BlockOffset(header.BlockIdx(off+n, o.blockSize), o.blockSize)
+		length := min(o.blockSize-blockOff, int64(len(p))-n)
 
-	for _, blockOff := range blocks {
-		n, err := o.cache.ReadAt(p[blockOff:blockOff+o.blockSize], off+blockOff)
-		if err == nil {
-			continue
-		}
+		part := p[n : n+length]
 
-		if !errors.As(err, &ErrBytesNotAvailable{}) {
-			return n, fmt.Errorf("error reading from cache: %w", err)
-		}
-
-		n, err = o.device.ReadAt(p[blockOff:blockOff+o.blockSize], off+blockOff)
+		_, err := o.cache.ReadAt(part, off+n)
 		if err != nil {
-			return n, fmt.Errorf("error reading from device: %w", err)
+			if !errors.As(err, &ErrBytesNotAvailable{}) {
+				return int(n), fmt.Errorf("error reading from cache: %w", err)
+			}
+
+			_, err = o.device.ReadAt(part, off+n)
+			if err != nil {
+				return int(n), fmt.Errorf("error reading from device: %w", err)
+			}
 		}
+
+		n += length
 	}
 
 	return len(p), nil
@@ -53,6 +64,15 @@ func (o *Overlay) EjectCache() (*Cache, error) {
 	return o.cache, nil
 }
 
+// ChangedSince returns the blocks written to the overlay after the given cache generation.
+func (o *Overlay) ChangedSince(generation uint64) (*bitset.BitSet, uint64, error) {
+	if o.cacheEjected.Load() {
+		return nil, 0, fmt.Errorf("cache already ejected")
+	}
+
+	return o.cache.ChangedSince(generation)
+}
+
 // This method will not be very optimal if the length is not the same as the block size, because we cannot be just exposing the cache slice,
 // but creating and copying the bytes from the cache and device to the new slice.
 //
@@ -61,8 +81,55 @@ func (o *Overlay) Slice(off, length int64) ([]byte, error) {
 	return nil, fmt.Errorf("not implemented")
 }
 
+// WriteAt writes the whole blocks to the cache, the partial blocks are completed from the cache or the device first,
+// so the cache tracks only the whole blocks.
 func (o *Overlay) WriteAt(p []byte, off int64) (int, error) {
-	return o.cache.WriteAt(p, off)
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+
+	if off%o.blockSize == 0 && int64(len(p))%o.blockSize == 0 {
+		return o.cache.WriteAt(p, off)
+	}
+
+	size, err := o.cache.Size()
+	if err != nil {
+		return 0, fmt.Errorf("error getting cache size: %w", err)
+	}
+
+	for n := int64(0); n < int64(len(p)); {
+		blockStart := header.BlockOffset(header.BlockIdx(off+n, o.blockSize), o.blockSize)
+		blockOff := off + n - blockStart
+		length := min(o.blockSize-blockOff, int64(len(p))-n)
+
+		if blockOff == 0 && length == o.blockSize {
+			_, err := o.cache.WriteAt(p[n:n+length], off+n)
+			if err != nil {
+				return int(n), fmt.Errorf("error writing to cache: %w", err)
+			}
+
+			n += length
+
+			continue
+		}
+
+		block := make([]byte, min(o.blockSize, size-blockStart))
+
+		_, err := o.ReadAt(block, blockStart)
+		if err != nil {
+			return int(n), fmt.Errorf("error reading block for partial write: %w", err)
+		}
+
+		copy(block[blockOff:], p[n:n+length])
+
+		_, err = o.cache.WriteAt(block, blockStart)
+		if err != nil {
+			return int(n), fmt.Errorf("error writing to cache: %w", err)
+		}
+
+		n += length
+	}
+
+	return len(p), nil
 }
 
 func (o *Overlay) Size() (int64, error) {
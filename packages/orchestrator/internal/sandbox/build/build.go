@@ -95,6 +95,39 @@ func (b *File) ReadAt(p []byte, off int64) (n int, err error) {
 	return n, nil
 }
 
+// Prefetch fetches the range of the file from the diffs of the builds it's mapped to.
+func (b *File) Prefetch(off, length int64) error {
+	for fetched := int64(0); fetched < length; {
+		mappedOffset, mappedLength, buildID, err := b.header.GetShiftedMapping(off + fetched)
+		if err != nil {
+			return fmt.Errorf("failed to get mapping: %w", err)
+		}
+
+		fetchLength := min(mappedLength, length-fetched)
+		if fetchLength <= 0 {
+			return nil
+		}
+
+		fetched += fetchLength
+
+		if *buildID == uuid.Nil {
+			continue
+		}
+
+		mappedBuild, err := b.getBuild(buildID)
+		if err != nil {
+			return fmt.Errorf("failed to get build: %w", err)
+		}
+
+		err = mappedBuild.Prefetch(mappedOffset, fetchLength)
+		if err != nil {
+			return fmt.Errorf("failed to prefetch from source: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // The slice access must be in the predefined blocksize of the build.
 func (b *File) Slice(off, length int64) ([]byte, error) {
 	mappedOffset, _, buildID, err := b.header.GetShiftedMapping(off)
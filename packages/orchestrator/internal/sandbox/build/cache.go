@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/jellydator/ttlcache/v3"
@@ -19,6 +21,9 @@ type DiffStore struct {
 	bucket *gcs.BucketHandle
 	cache  *ttlcache.Cache[string, Diff]
 	ctx    context.Context
+
+	pinnedMu sync.RWMutex
+	pinned   map[string]struct{}
 }
 
 func NewDiffStore(bucket *gcs.BucketHandle, ctx context.Context) (*DiffStore, error) {
@@ -46,16 +51,45 @@ func NewDiffStore(bucket *gcs.BucketHandle, ctx context.Context) (*DiffStore, er
 		bucket: bucket,
 		cache:  cache,
 		ctx:    ctx,
+		pinned: make(map[string]struct{}),
 	}, nil
 }
 
+// ttl returns the expiration of the diffs of the build, the diffs of the pinned builds never expire.
+func (s *DiffStore) ttl(buildId string) time.Duration {
+	s.pinnedMu.RLock()
+	defer s.pinnedMu.RUnlock()
+
+	if _, ok := s.pinned[buildId]; ok {
+		return ttlcache.NoTTL
+	}
+
+	return buildExpiration
+}
+
+// SetPinned replaces the pinned builds, the diffs of the unpinned builds expire again.
+func (s *DiffStore) SetPinned(buildIds map[string]struct{}) {
+	s.pinnedMu.Lock()
+	s.pinned = buildIds
+	s.pinnedMu.Unlock()
+
+	for key, item := range s.cache.Items() {
+		buildId, _, _ := strings.Cut(key, "/")
+
+		ttl := s.ttl(buildId)
+		if item.TTL() != ttl {
+			s.cache.Set(key, item.Value(), ttl)
+		}
+	}
+}
+
 func (s *DiffStore) Get(buildId string, diffType DiffType, blockSize int64) (Diff, error) {
 	diff := newStorageDiff(buildId, diffType, blockSize)
 
 	source, found := s.cache.GetOrSet(
 		diff.CacheKey(),
 		diff,
-		ttlcache.WithTTL[string, Diff](buildExpiration),
+		ttlcache.WithTTL[string, Diff](s.ttl(buildId)),
 	)
 
 	value := source.Value()
@@ -76,5 +110,13 @@ func (s *DiffStore) Get(buildId string, diffType DiffType, blockSize int64) (Dif
 func (s *DiffStore) Add(buildId string, t DiffType, d Diff) {
 	storagePath := storagePath(buildId, t)
 
-	s.cache.Set(storagePath, d, buildExpiration)
+	s.cache.Set(storagePath, d, s.ttl(buildId))
+}
+
+// GetOrAdd returns the diff of the build if it's in the store, otherwise it adds the diff.
+// The diff is not added if it's returned false, the caller closes it then.
+func (s *DiffStore) GetOrAdd(buildId string, t DiffType, d Diff) (Diff, bool) {
+	item, found := s.cache.GetOrSet(storagePath(buildId, t), d, ttlcache.WithTTL[string, Diff](s.ttl(buildId)))
+
+	return item.Value(), !found
 }
@@ -23,6 +23,8 @@ type Diff interface {
 	io.Closer
 	io.ReaderAt
 	Slice(off, length int64) ([]byte, error)
+	// Prefetch fetches the range of the diff to the local cache ahead of the reads.
+	Prefetch(off, length int64) error
 	CachePath() (string, error)
 }
 
@@ -36,6 +38,10 @@ func (n *NoDiff) Slice(off, length int64) ([]byte, error) {
 	return nil, ErrNoDiff{}
 }
 
+func (n *NoDiff) Prefetch(off, length int64) error {
+	return nil
+}
+
 func (n *NoDiff) Close() error {
 	return nil
 }
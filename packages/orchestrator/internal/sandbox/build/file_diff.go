@@ -0,0 +1,49 @@
+package build
+
+import (
+	"fmt"
+	"os"
+)
+
+// fileDiff is the read-only diff of the file on the node that is owned by someone else, the file isn't removed when
+// the diff is closed. The file can be removed by its owner while the diff is open, the open file stays readable.
+type fileDiff struct {
+	f *os.File
+}
+
+func NewFileDiff(path string) (Diff, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return &fileDiff{f: f}, nil
+}
+
+func (d *fileDiff) CachePath() (string, error) {
+	return d.f.Name(), nil
+}
+
+func (d *fileDiff) Close() error {
+	return d.f.Close()
+}
+
+func (d *fileDiff) ReadAt(p []byte, off int64) (int, error) {
+	return d.f.ReadAt(p, off)
+}
+
+func (d *fileDiff) Slice(off, length int64) ([]byte, error) {
+	b := make([]byte, length)
+
+	_, err := d.f.ReadAt(b, off)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Prefetch does nothing, the file is already on the node.
+func (d *fileDiff) Prefetch(off, length int64) error {
+	return nil
+}
@@ -96,3 +96,8 @@ func (b *localDiff) ReadAt(p []byte, off int64) (int, error) {
 func (b *localDiff) Slice(off, length int64) ([]byte, error) {
 	return b.cache.Slice(off, length)
 }
+
+// Prefetch does nothing, the local diff is already on the node.
+func (b *localDiff) Prefetch(off, length int64) error {
+	return nil
+}
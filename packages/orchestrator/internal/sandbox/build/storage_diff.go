@@ -6,7 +6,9 @@ import (
 	"io"
 	"path/filepath"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/encryption"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/storagecache"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
@@ -47,18 +49,28 @@ func (b *StorageDiff) CacheKey() string {
 }
 
 func (b *StorageDiff) Init(ctx context.Context, bucket *gcs.BucketHandle) error {
-	obj := gcs.NewObject(ctx, bucket, b.storagePath)
+	obj := storagecache.NewObject(ctx, bucket, b.storagePath)
 
-	size, err := obj.Size()
+	attrs, err := obj.Attrs()
 	if err != nil {
-		errMsg := fmt.Errorf("failed to get object size: %w", err)
+		errMsg := fmt.Errorf("failed to get object attributes: %w", err)
 
 		b.chunker.SetError(errMsg)
 
 		return errMsg
 	}
 
-	chunker, err := block.NewChunker(ctx, size, b.blockSize, obj, b.cachePath)
+	// The encrypted diffs are decrypted before they are cached on the node
+	source, size, err := encryption.NewReaderAt(ctx, obj, attrs.Size, attrs.Metadata)
+	if err != nil {
+		errMsg := fmt.Errorf("failed to decrypt object: %w", err)
+
+		b.chunker.SetError(errMsg)
+
+		return errMsg
+	}
+
+	chunker, err := block.NewChunker(ctx, size, b.blockSize, source, b.cachePath)
 	if err != nil {
 		errMsg := fmt.Errorf("failed to create chunker: %w", err)
 
@@ -97,6 +109,15 @@ func (b *StorageDiff) Slice(off, length int64) ([]byte, error) {
 	return c.Slice(off, length)
 }
 
+func (b *StorageDiff) Prefetch(off, length int64) error {
+	c, err := b.chunker.Wait()
+	if err != nil {
+		return err
+	}
+
+	return c.Prefetch(off, length)
+}
+
 func (b *StorageDiff) WriteTo(w io.Writer) (int64, error) {
 	c, err := b.chunker.Wait()
 	if err != nil {
@@ -0,0 +1,311 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/requestid"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// When the limit is reached, the oldest checkpoint is merged into the next one.
+	maxCheckpoints = 32
+
+	changedFilesTimeout = 2 * time.Minute
+	// maxChangedFilesSize limits how much of the changed files list the guest can make the orchestrator read.
+	maxChangedFilesSize = 16 << 20
+
+	minEnvdVersionForChangedFiles = "v0.1.6"
+)
+
+// Walking the whole filesystem can take longer than the timeout of the default envd client.
+var changedFilesClient = http.Client{
+	Timeout: changedFilesTimeout,
+}
+
+type ErrCheckpointNotFound struct {
+	ID string
+}
+
+func (e ErrCheckpointNotFound) Error() string {
+	return fmt.Sprintf("checkpoint '%s' not found", e.ID)
+}
+
+type Checkpoint struct {
+	ID        string
+	CreatedAt time.Time
+
+	generation uint64
+	// Rootfs blocks written between the previous checkpoint (or the sandbox start) and this checkpoint.
+	changed *bitset.BitSet
+}
+
+type checkpoints struct {
+	mu    sync.Mutex
+	items []*Checkpoint
+}
+
+func (c *checkpoints) index(id string) (int, error) {
+	for i, item := range c.items {
+		if item.ID == id {
+			return i, nil
+		}
+	}
+
+	return 0, ErrCheckpointNotFound{ID: id}
+}
+
+type ChangedFile struct {
+	Path string `json:"path"`
+	// Type is either "file" or "directory".
+	Type string `json:"type"`
+}
+
+type changedBlockRange struct {
+	Start int64 `json:"start"`
+	Count int64 `json:"count"`
+}
+
+type changedBlocks struct {
+	BlockSize int64               `json:"blockSize"`
+	Ranges    []changedBlockRange `json:"ranges"`
+}
+
+// CreateCheckpoint marks the current state of the sandbox rootfs, so the changes between checkpoints can be listed later.
+func (s *Sandbox) CreateCheckpoint(ctx context.Context, tracer trace.Tracer) (*Checkpoint, error) {
+	childCtx, childSpan := tracer.Start(ctx, "create-checkpoint")
+	defer childSpan.End()
+
+	if !isGTEVersion(s.Config.EnvdVersion, minEnvdVersionForChangedFiles) {
+		return nil, fmt.Errorf("envd version '%s' doesn't support checkpoints", s.Config.EnvdVersion)
+	}
+
+	// Make sure the writes cached in the guest are part of this checkpoint.
+	err := s.syncEnvdFilesystem(childCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to flush sandbox filesystem: %w", err)
+	}
+
+	s.checkpoints.mu.Lock()
+	defer s.checkpoints.mu.Unlock()
+
+	var lastGeneration uint64
+	if len(s.checkpoints.items) > 0 {
+		lastGeneration = s.checkpoints.items[len(s.checkpoints.items)-1].generation
+	}
+
+	changed, generation, err := s.rootfs.ChangedSince(lastGeneration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changed blocks: %w", err)
+	}
+
+	checkpoint := &Checkpoint{
+		ID:         uuid.New().String(),
+		CreatedAt:  time.Now(),
+		generation: generation,
+		changed:    changed,
+	}
+
+	if len(s.checkpoints.items) == maxCheckpoints {
+		s.checkpoints.items[1].changed.InPlaceUnion(s.checkpoints.items[0].changed)
+		s.checkpoints.items = s.checkpoints.items[1:]
+	}
+
+	s.checkpoints.items = append(s.checkpoints.items, checkpoint)
+
+	telemetry.ReportEvent(childCtx, "created checkpoint", attribute.String("checkpoint.id", checkpoint.ID))
+
+	return checkpoint, nil
+}
+
+// ChangedBlocks returns the rootfs blocks changed between the two checkpoints.
+// Empty from means the sandbox start (or resume), empty to means the current state.
+func (s *Sandbox) ChangedBlocks(from, to string) (*bitset.BitSet, error) {
+	s.checkpoints.mu.Lock()
+	defer s.checkpoints.mu.Unlock()
+
+	// Index of the first checkpoint which changes are included.
+	start := 0
+	if from != "" {
+		i, err := s.checkpoints.index(from)
+		if err != nil {
+			return nil, err
+		}
+
+		start = i + 1
+	}
+
+	if to == "" {
+		var generation uint64
+		if start > 0 {
+			generation = s.checkpoints.items[start-1].generation
+		}
+
+		changed, _, err := s.rootfs.ChangedSince(generation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get changed blocks: %w", err)
+		}
+
+		return changed, nil
+	}
+
+	end, err := s.checkpoints.index(to)
+	if err != nil {
+		return nil, err
+	}
+
+	if end < start-1 {
+		return nil, fmt.Errorf("checkpoint '%s' was created before checkpoint '%s'", to, from)
+	}
+
+	changed := bitset.New(0)
+	for _, checkpoint := range s.checkpoints.items[start : end+1] {
+		changed.InPlaceUnion(checkpoint.changed)
+	}
+
+	return changed, nil
+}
+
+// ChangedFiles returns the files in the sandbox rootfs changed between the two checkpoints.
+// The changed blocks are resolved to the paths by envd, so files that were removed since are not listed.
+func (s *Sandbox) ChangedFiles(ctx context.Context, tracer trace.Tracer, from, to string) ([]ChangedFile, error) {
+	childCtx, childSpan := tracer.Start(ctx, "changed-files")
+	defer childSpan.End()
+
+	if !isGTEVersion(s.Config.EnvdVersion, minEnvdVersionForChangedFiles) {
+		return nil, fmt.Errorf("envd version '%s' doesn't support listing changed files", s.Config.EnvdVersion)
+	}
+
+	if to == "" {
+		err := s.syncEnvdFilesystem(childCtx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to flush sandbox filesystem: %w", err)
+		}
+	}
+
+	changed, err := s.ChangedBlocks(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.SetAttributes(childCtx, attribute.Int64("changed.blocks", int64(changed.Count())))
+
+	files, err := s.resolveChangedBlocks(childCtx, changedBlocks{
+		BlockSize: s.rootfs.BlockSize(),
+		Ranges:    blockRanges(changed),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve changed blocks: %w", err)
+	}
+
+	telemetry.ReportEvent(childCtx, "resolved changed files", attribute.Int("changed.files", len(files)))
+
+	return files, nil
+}
+
+func blockRanges(blocks *bitset.BitSet) []changedBlockRange {
+	ranges := make([]changedBlockRange, 0)
+
+	for i, ok := blocks.NextSet(0); ok; i, ok = blocks.NextSet(i + 1) {
+		if len(ranges) > 0 {
+			last := &ranges[len(ranges)-1]
+			if last.Start+last.Count == int64(i) {
+				last.Count++
+
+				continue
+			}
+		}
+
+		ranges = append(ranges, changedBlockRange{Start: int64(i), Count: 1})
+	}
+
+	return ranges
+}
+
+func (s *Sandbox) syncEnvdFilesystem(ctx context.Context) error {
+	address := fmt.Sprintf("http://%s:%d/files/sync", s.Slot.HostIP(), consts.DefaultEnvdServerPort)
+
+	request, err := http.NewRequestWithContext(ctx, "POST", address, nil)
+	if err != nil {
+		return err
+	}
+
+	requestid.InjectHeaders(ctx, request.Header)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	_, err = io.Copy(io.Discard, response.Body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Sandbox) resolveChangedBlocks(ctx context.Context, blocks changedBlocks) ([]ChangedFile, error) {
+	ctx, cancel := context.WithTimeout(ctx, changedFilesTimeout)
+	defer cancel()
+
+	address := fmt.Sprintf("http://%s:%d/files/changes", s.Slot.HostIP(), consts.DefaultEnvdServerPort)
+
+	body, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, "POST", address, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	requestid.InjectHeaders(ctx, request.Header)
+
+	response, err := changedFilesClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	filesBody, err := io.ReadAll(io.LimitReader(response.Body, maxChangedFilesSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filesBody) > maxChangedFilesSize {
+		return nil, fmt.Errorf("changed files exceed %d bytes", maxChangedFilesSize)
+	}
+
+	var files []ChangedFile
+	err = json.Unmarshal(filesBody, &files)
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
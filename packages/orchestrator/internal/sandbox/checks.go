@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"time"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 	"golang.org/x/mod/semver"
@@ -16,15 +18,20 @@ import (
 const (
 	healthCheckInterval      = 10 * time.Second
 	metricsCheckInterval     = 2 * time.Second
+	networkActivityInterval  = 10 * time.Second
 	minEnvdVersionForMetrcis = "0.1.5"
 )
 
 func (s *Sandbox) logHeathAndUsage(ctx *utils.LockableCancelableContext) {
 	healthTicker := time.NewTicker(healthCheckInterval)
 	metricsTicker := time.NewTicker(metricsCheckInterval)
+	spansTicker := time.NewTicker(spansExportInterval)
+	networkTicker := time.NewTicker(networkActivityInterval)
 	defer func() {
 		healthTicker.Stop()
 		metricsTicker.Stop()
+		spansTicker.Stop()
+		networkTicker.Stop()
 	}()
 
 	// Get metrics on sandbox startup
@@ -45,16 +52,41 @@ func (s *Sandbox) logHeathAndUsage(ctx *utils.LockableCancelableContext) {
 			cancel()
 		case <-metricsTicker.C:
 			s.LogMetrics(ctx)
+		case <-spansTicker.C:
+			err := s.ExportEnvdSpans(ctx)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "failed to export envd spans for sandbox '%s': %v\n", s.Config.SandboxId, err)
+			}
+		case <-networkTicker.C:
+			err := s.network.Sample()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "failed to sample network activity of sandbox '%s': %v\n", s.Config.SandboxId, err)
+			}
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// NetworkActivity returns the summary of the connections the sandbox made since it started.
+func (s *Sandbox) NetworkActivity() []network.Destination {
+	return s.network.Destinations()
+}
+
 func (s *Sandbox) Healthcheck(ctx context.Context, alwaysReport bool) {
 	var err error
 	defer func() {
 		s.Logger.Healthcheck(err == nil, alwaysReport)
+
+		health := &envdHealth{lastErr: err}
+		if err == nil {
+			now := time.Now()
+			health.lastHealthyAt = &now
+		} else if previous := s.envdHealth.Load(); previous != nil {
+			health.lastHealthyAt = previous.lastHealthyAt
+		}
+
+		s.envdHealth.Store(health)
 	}()
 
 	address := fmt.Sprintf("http://%s:%d/health", s.Slot.HostIP(), consts.DefaultEnvdServerPort)
@@ -116,7 +148,8 @@ func (s *Sandbox) LogMetrics(ctx context.Context) {
 			s.Logger.Warnf("failed to get metrics: %s", err)
 		} else {
 			s.Logger.Metrics(
-				metrics.MemTotalMiB, metrics.MemUsedMiB, metrics.CPUCount, metrics.CPUUsedPercent)
+				metrics.MemTotalMiB, metrics.MemUsedMiB, metrics.CPUCount, metrics.CPUUsedPercent,
+				metrics.DiskTotalMiB, metrics.DiskUsedMiB, metrics.OpenFDs, metrics.logProcesses())
 		}
 	}
 }
@@ -0,0 +1,203 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+// diagnosticsStorageDir is the prefix of the bundles in the template bucket, the lifecycle rule of the bucket deletes them after the retention.
+const diagnosticsStorageDir = "diagnostics"
+
+type TerminationReason string
+
+const (
+	// The FC process was killed by SIGKILL we didn't send, usually by the host OOM killer.
+	TerminationReasonOOMKilled TerminationReason = "oom_killed"
+	// The guest kernel panicked, the FC process exits after that.
+	TerminationReasonGuestPanic TerminationReason = "guest_panic"
+	// The FC process exited on its own (e.g. the guest rebooted).
+	TerminationReasonGuestExit TerminationReason = "guest_exit"
+	// The FC process failed.
+	TerminationReasonFirecrackerCrash TerminationReason = "firecracker_crash"
+	// The memory (UFFD) handler failed.
+	TerminationReasonUffdCrash TerminationReason = "uffd_crash"
+)
+
+type EnvdDiagnostics struct {
+	Version string `json:"version"`
+	// Time of the last successful health check, nil if there was none.
+	LastHealthyAt *time.Time `json:"lastHealthyAt,omitempty"`
+	// Error of the last failed health check.
+	LastHealthcheckError string `json:"lastHealthcheckError,omitempty"`
+}
+
+type MemoryDiagnostics struct {
+	PageSize int64 `json:"pageSize"`
+	// Number of the page faults served by the UFFD handler.
+	PageFaults uint64 `json:"pageFaults"`
+}
+
+type RootfsDiagnostics struct {
+	BlockSize int64 `json:"blockSize"`
+	// Number of the rootfs blocks written by the sandbox.
+	DirtyBlocks uint64 `json:"dirtyBlocks"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Diagnostics is the forensic bundle collected when the sandbox terminates unexpectedly.
+type Diagnostics struct {
+	SandboxID    string            `json:"sandboxID"`
+	TemplateID   string            `json:"templateID"`
+	TeamID       string            `json:"teamID"`
+	StartedAt    time.Time         `json:"startedAt"`
+	TerminatedAt time.Time         `json:"terminatedAt"`
+	Reason       TerminationReason `json:"reason"`
+	Error        string            `json:"error"`
+
+	KernelVersion      string `json:"kernelVersion"`
+	FirecrackerVersion string `json:"firecrackerVersion"`
+	VCPU               int64  `json:"vcpu"`
+	RamMB              int64  `json:"ramMB"`
+
+	// Last lines of the FC process output, including the guest serial console.
+	FirecrackerLogs []string          `json:"firecrackerLogs"`
+	Envd            EnvdDiagnostics   `json:"envd"`
+	Memory          MemoryDiagnostics `json:"memory"`
+	Rootfs          RootfsDiagnostics `json:"rootfs"`
+}
+
+type envdHealth struct {
+	lastHealthyAt *time.Time
+	lastErr       error
+}
+
+// collectDiagnostics must be called before the sandbox resources are cleaned up.
+func (s *Sandbox) collectDiagnostics(fcErr, uffdErr error) *Diagnostics {
+	logs := s.process.Logs()
+
+	var reason TerminationReason
+
+	switch {
+	case uffdErr != nil:
+		reason = TerminationReasonUffdCrash
+	case errors.Is(fcErr, fc.ErrKilled):
+		reason = TerminationReasonOOMKilled
+	case errors.Is(fcErr, fc.ErrUnexpectedExit) && containsKernelPanic(logs):
+		reason = TerminationReasonGuestPanic
+	case errors.Is(fcErr, fc.ErrUnexpectedExit):
+		reason = TerminationReasonGuestExit
+	default:
+		reason = TerminationReasonFirecrackerCrash
+	}
+
+	diagnostics := &Diagnostics{
+		SandboxID:          s.Config.SandboxId,
+		TemplateID:         s.Config.TemplateId,
+		TeamID:             s.Config.TeamId,
+		StartedAt:          s.StartedAt,
+		TerminatedAt:       time.Now(),
+		Reason:             reason,
+		Error:              errors.Join(fcErr, uffdErr).Error(),
+		KernelVersion:      s.Config.KernelVersion,
+		FirecrackerVersion: s.Config.FirecrackerVersion,
+		VCPU:               s.Config.Vcpu,
+		RamMB:              s.Config.RamMb,
+		FirecrackerLogs:    logs,
+		Envd: EnvdDiagnostics{
+			Version: s.Config.EnvdVersion,
+		},
+		Memory: MemoryDiagnostics{
+			PageSize: s.files.MemfilePageSize(),
+		},
+		Rootfs: RootfsDiagnostics{
+			BlockSize: s.rootfs.BlockSize(),
+		},
+	}
+
+	// The booted sandboxes have no uffd
+	if s.uffd != nil {
+		diagnostics.Memory.PageFaults = s.uffd.Faults()
+	}
+
+	if health := s.envdHealth.Load(); health != nil {
+		diagnostics.Envd.LastHealthyAt = health.lastHealthyAt
+
+		if health.lastErr != nil {
+			diagnostics.Envd.LastHealthcheckError = health.lastErr.Error()
+		}
+	}
+
+	dirty, _, err := s.rootfs.ChangedSince(0)
+	if err != nil {
+		diagnostics.Rootfs.Error = err.Error()
+	} else {
+		diagnostics.Rootfs.DirtyBlocks = uint64(dirty.Count())
+	}
+
+	return diagnostics
+}
+
+func containsKernelPanic(logs []string) bool {
+	for _, line := range logs {
+		if strings.Contains(line, "Kernel panic") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Diagnostics returns the forensic bundle if the sandbox terminated unexpectedly, nil otherwise.
+func (s *Sandbox) Diagnostics() *Diagnostics {
+	return s.diagnostics.Load()
+}
+
+func diagnosticsStoragePath(sandboxID string) string {
+	return fmt.Sprintf("%s/%s.json", diagnosticsStorageDir, sandboxID)
+}
+
+// UploadDiagnostics stores the bundle, replacing the previous bundle of the same sandbox.
+func UploadDiagnostics(ctx context.Context, bucket *gcs.BucketHandle, diagnostics *Diagnostics) error {
+	data, err := json.Marshal(diagnostics)
+	if err != nil {
+		return fmt.Errorf("failed to marshal diagnostics: %w", err)
+	}
+
+	object := gcs.NewObject(ctx, bucket, diagnosticsStoragePath(diagnostics.SandboxID))
+
+	_, err = object.ReadFrom(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to upload diagnostics: %w", err)
+	}
+
+	return nil
+}
+
+// GetDiagnostics returns the stored bundle of the sandbox, the error wraps gcs.ErrObjectNotExist if there is none.
+func GetDiagnostics(ctx context.Context, bucket *gcs.BucketHandle, sandboxID string) (*Diagnostics, error) {
+	object := gcs.NewObject(ctx, bucket, diagnosticsStoragePath(sandboxID))
+
+	var data bytes.Buffer
+
+	_, err := object.WriteTo(&data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download diagnostics: %w", err)
+	}
+
+	var diagnostics Diagnostics
+
+	err = json.Unmarshal(data.Bytes(), &diagnostics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal diagnostics: %w", err)
+	}
+
+	return &diagnostics, nil
+}
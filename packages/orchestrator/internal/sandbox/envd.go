@@ -3,15 +3,23 @@ package sandbox
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+	"github.com/e2b-dev/infra/packages/shared/pkg/requestid"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
 const maxRetries = 120
@@ -55,27 +63,72 @@ func (s *Sandbox) syncOldEnvd(ctx context.Context) error {
 	return nil
 }
 
+const (
+	// clockDriftHeader is the header with the correction applied to the sandbox clock in milliseconds.
+	clockDriftHeader = "X-Clock-Drift"
+
+	// entropySize is the number of random bytes sent to envd to reseed the RNG of the sandbox.
+	entropySize = 64
+
+	// minEnvdVersionHardening is the first envd version that enforces the hardening policy.
+	minEnvdVersionHardening = "v0.1.18"
+)
+
+type hardeningPolicy struct {
+	NoNewPrivileges bool     `json:"noNewPrivileges"`
+	ReadOnlyPaths   []string `json:"readOnlyPaths,omitempty"`
+	DeniedSyscalls  []string `json:"deniedSyscalls,omitempty"`
+}
+
 type PostInitJSONBody struct {
-	EnvVars *map[string]string `json:"envVars"`
+	EnvVars   *map[string]string `json:"envVars"`
+	Secrets   map[string]string  `json:"secrets,omitempty"`
+	Hardening *hardeningPolicy   `json:"hardening,omitempty"`
+	Timestamp *time.Time         `json:"timestamp,omitempty"`
+	Entropy   []byte             `json:"entropy,omitempty"`
 }
 
-func (s *Sandbox) initEnvd(ctx context.Context, tracer trace.Tracer, envVars map[string]string) error {
+// initEnvd sends the env vars, the secrets and the hardening policy to envd, the init is called over the host network before the sandbox is routable,
+// so nothing else can reach envd at that point.
+func (s *Sandbox) initEnvd(ctx context.Context, tracer trace.Tracer, envVars, secrets map[string]string, hardening *orchestrator.HardeningPolicy) error {
 	childCtx, childSpan := tracer.Start(ctx, "envd-init")
 	defer childSpan.End()
 
 	address := fmt.Sprintf("http://%s:%d/init", s.Slot.HostIP(), consts.DefaultEnvdServerPort)
 
-	jsonBody := &PostInitJSONBody{
-		EnvVars: &envVars,
+	// The sandboxes restored from the same snapshot share the RNG state, so each one gets fresh entropy from the host
+	entropy := make([]byte, entropySize)
+	_, err := rand.Read(entropy)
+	if err != nil {
+		return fmt.Errorf("failed to generate entropy: %w", err)
 	}
 
-	envVarsJSON, err := json.Marshal(jsonBody)
-	if err != nil {
-		return err
+	var policy *hardeningPolicy
+	if hardening != nil {
+		policy = &hardeningPolicy{
+			NoNewPrivileges: hardening.NoNewPrivileges,
+			ReadOnlyPaths:   hardening.ReadOnlyPaths,
+			DeniedSyscalls:  hardening.DeniedSyscalls,
+		}
 	}
 
 	var response *http.Response
 	for i := 0; i < maxRetries; i++ {
+		// The host time is set on each attempt, envd uses it to sync the clock when the PTP device is not available
+		now := time.Now()
+		jsonBody := &PostInitJSONBody{
+			EnvVars:   &envVars,
+			Secrets:   secrets,
+			Hardening: policy,
+			Timestamp: &now,
+			Entropy:   entropy,
+		}
+
+		envVarsJSON, err := json.Marshal(jsonBody)
+		if err != nil {
+			return err
+		}
+
 		reqCtx, cancel := context.WithTimeout(childCtx, 50*time.Millisecond)
 		request, err := http.NewRequestWithContext(reqCtx, "POST", address, bytes.NewReader(envVarsJSON))
 		if err != nil {
@@ -83,6 +136,8 @@ func (s *Sandbox) initEnvd(ctx context.Context, tracer trace.Tracer, envVars map
 			return err
 		}
 
+		requestid.InjectHeaders(reqCtx, request.Header)
+
 		response, err = httpClient.Do(request)
 		if err == nil {
 			cancel()
@@ -107,5 +162,32 @@ func (s *Sandbox) initEnvd(ctx context.Context, tracer trace.Tracer, envVars map
 		return err
 	}
 
+	s.recordClockDrift(childCtx, response.Header.Get(clockDriftHeader))
+
 	return nil
 }
+
+// recordClockDrift records the correction of the sandbox clock reported by envd, older envd versions don't report it.
+func (s *Sandbox) recordClockDrift(ctx context.Context, value string) {
+	if value == "" {
+		return
+	}
+
+	driftMs, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		telemetry.ReportError(ctx, fmt.Errorf("invalid clock drift '%s': %w", value, err))
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx, attribute.Int64("clock.drift_ms", driftMs))
+
+	histogram, err := meters.GetHistogram(meters.ClockDriftMeterName)
+	if err != nil {
+		telemetry.ReportError(ctx, fmt.Errorf("failed to get clock drift histogram: %w", err))
+
+		return
+	}
+
+	histogram.Record(ctx, math.Abs(float64(driftMs)))
+}
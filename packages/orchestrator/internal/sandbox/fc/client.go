@@ -8,7 +8,6 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/socket"
-	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/e2b-dev/infra/packages/shared/pkg/fc/client"
 	"github.com/e2b-dev/infra/packages/shared/pkg/fc/client/operations"
 	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
@@ -32,8 +31,9 @@ func newApiClient(socketPath string) *apiClient {
 func (c *apiClient) loadSnapshot(
 	ctx context.Context,
 	uffdSocketPath string,
+	backendPath string,
 	uffdReady chan struct{},
-	snapfile template.File,
+	snapfilePath string,
 ) error {
 	err := socket.Wait(ctx, uffdSocketPath)
 	if err != nil {
@@ -42,11 +42,10 @@ func (c *apiClient) loadSnapshot(
 
 	backendType := models.MemoryBackendBackendTypeUffd
 	backend := &models.MemoryBackend{
-		BackendPath: &uffdSocketPath,
+		BackendPath: &backendPath,
 		BackendType: &backendType,
 	}
 
-	snapfilePath := snapfile.Path()
 	snapshotConfig := operations.LoadSnapshotParams{
 		Context: ctx,
 		Body: &models.SnapshotLoadParams{
@@ -140,3 +139,116 @@ func (c *apiClient) setMmds(ctx context.Context, metadata *MmdsMetadata) error {
 
 	return nil
 }
+
+// boot configures the VM booted from the kernel and the rootfs, instead of loading the snapshot, and starts it.
+func (c *apiClient) boot(
+	ctx context.Context,
+	bootArgs string,
+	kernelPath string,
+	rootfsPath string,
+	vcpu int64,
+	ramMB int64,
+	hugePages bool,
+) error {
+	_, err := c.client.Operations.PutGuestBootSource(&operations.PutGuestBootSourceParams{
+		Context: ctx,
+		Body: &models.BootSource{
+			BootArgs:        bootArgs,
+			KernelImagePath: &kernelPath,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting boot source: %w", err)
+	}
+
+	driveID := "rootfs"
+	ioEngine := "Async"
+	isRootDevice := true
+
+	_, err = c.client.Operations.PutGuestDriveByID(&operations.PutGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: driveID,
+		Body: &models.Drive{
+			DriveID:      &driveID,
+			PathOnHost:   rootfsPath,
+			IsRootDevice: &isRootDevice,
+			IoEngine:     &ioEngine,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting rootfs drive: %w", err)
+	}
+
+	ifaceID := bootIfaceID
+	hostDevName := bootTapName
+
+	_, err = c.client.Operations.PutGuestNetworkInterfaceByID(&operations.PutGuestNetworkInterfaceByIDParams{
+		Context: ctx,
+		IfaceID: ifaceID,
+		Body: &models.NetworkInterface{
+			IfaceID:     &ifaceID,
+			GuestMac:    bootMacAddress,
+			HostDevName: &hostDevName,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting network interface: %w", err)
+	}
+
+	smt := true
+	trackDirtyPages := false
+
+	machineConfig := &models.MachineConfiguration{
+		VcpuCount:       &vcpu,
+		MemSizeMib:      &ramMB,
+		Smt:             &smt,
+		TrackDirtyPages: &trackDirtyPages,
+	}
+
+	if hugePages {
+		machineConfig.HugePages = models.MachineConfigurationHugePagesNr2M
+	}
+
+	_, err = c.client.Operations.PutMachineConfiguration(&operations.PutMachineConfigurationParams{
+		Context: ctx,
+		Body:    machineConfig,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting machine config: %w", err)
+	}
+
+	mmdsVersion := "V2"
+
+	_, err = c.client.Operations.PutMmdsConfig(&operations.PutMmdsConfigParams{
+		Context: ctx,
+		Body: &models.MmdsConfig{
+			Version:           &mmdsVersion,
+			NetworkInterfaces: []string{bootIfaceID},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting mmds config: %w", err)
+	}
+
+	_, err = c.client.Operations.PutEntropyDevice(&operations.PutEntropyDeviceParams{
+		Context: ctx,
+		Body:    &models.EntropyDevice{},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting entropy device: %w", err)
+	}
+
+	start := models.InstanceActionInfoActionTypeInstanceStart
+
+	_, err = c.client.Operations.CreateSyncAction(&operations.CreateSyncActionParams{
+		Context: ctx,
+		Info: &models.InstanceActionInfo{
+			ActionType: &start,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error starting vm: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package fc
+
+import "sync"
+
+// Number of the output chunks buffered for each console subscriber, slow subscribers miss the output over this limit.
+const consoleSubscriberBuffer = 256
+
+// console broadcasts the FC process output (the guest serial console) to the attached subscribers.
+type console struct {
+	mu          sync.Mutex
+	subscribers map[chan []byte]struct{}
+	closed      bool
+}
+
+func newConsole() *console {
+	return &console{
+		subscribers: make(map[chan []byte]struct{}),
+	}
+}
+
+func (c *console) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.subscribers) == 0 {
+		return len(p), nil
+	}
+
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+
+	for subscriber := range c.subscribers {
+		select {
+		case subscriber <- chunk:
+		default:
+		}
+	}
+
+	return len(p), nil
+}
+
+// Subscribe returns the channel with the console output, the channel is closed when the FC process exits.
+func (c *console) Subscribe() (<-chan []byte, func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subscriber := make(chan []byte, consoleSubscriberBuffer)
+
+	if c.closed {
+		close(subscriber)
+
+		return subscriber, func() {}
+	}
+
+	c.subscribers[subscriber] = struct{}{}
+
+	return subscriber, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if _, ok := c.subscribers[subscriber]; ok {
+			delete(c.subscribers, subscriber)
+			close(subscriber)
+		}
+	}
+}
+
+func (c *console) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+
+	for subscriber := range c.subscribers {
+		delete(c.subscribers, subscriber)
+		close(subscriber)
+	}
+}
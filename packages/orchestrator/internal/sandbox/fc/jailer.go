@@ -0,0 +1,296 @@
+package fc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	txtTemplate "text/template"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	// jailerPercentageEnv is the percentage (0-100) of the sandboxes started under the jailer, the others are started in the unshared namespaces.
+	// The sandboxes are selected by the hash of their ID, so the node can be migrated gradually and rolled back by lowering the percentage,
+	// the running sandboxes keep the process model they were started with.
+	jailerPercentageEnv = "FIRECRACKER_JAILER_PERCENTAGE"
+	// jailerUIDBaseEnv is the first UID of the jailed firecracker processes, each network slot has its own UID and GID.
+	jailerUIDBaseEnv = "FIRECRACKER_JAILER_UID_BASE"
+
+	defaultJailerUIDBase = 200000
+
+	jailerChrootBaseDir = "/orchestrator/jailer"
+	// jailerCgroupParent is the cgroup v2 of the jailed firecracker processes, each process has its own child cgroup.
+	jailerCgroupParent = "firecracker-sandboxes"
+	cgroupRoot         = "/sys/fs/cgroup"
+	netnsDir           = "/var/run/netns"
+
+	// The paths inside the chroot.
+	jailedSocketPath     = "/firecracker.socket"
+	jailedUffdSocketPath = "/uffd.sock"
+	jailedSnapfilePath   = "/snapfile"
+	jailedRootfsPath     = "/rootfs"
+	jailedSnapshotDir    = "/snapshot"
+
+	// memoryOverheadMB is the memory of the firecracker process above the guest memory.
+	memoryOverheadMB = 128
+	cpuPeriodUs      = 100000
+	maxPids          = 64
+)
+
+const jailerStartScript = `mount --make-rprivate / &&
+mount --bind -o ro {{ .kernelPath }} {{ .chrootKernelPath }} &&
+mount --bind -o ro {{ .snapfilePath }} {{ .chrootSnapfilePath }} &&
+mount --bind {{ .uffdSocketPath }} {{ .chrootUffdSocketPath }} &&
+{{ .jailerPath }} --id {{ .id }} --exec-file {{ .firecrackerPath }} --uid {{ .uid }} --gid {{ .gid }} --chroot-base-dir {{ .chrootBaseDir }} --netns {{ .netnsPath }} --cgroup-version 2 --parent-cgroup {{ .cgroupParent }}{{ range .cgroups }} --cgroup "{{ . }}"{{ end }} -- --api-sock {{ .firecrackerSocket }}`
+
+var jailerStartScriptTemplate = txtTemplate.Must(txtTemplate.New("fc-jailer-start").Parse(jailerStartScript))
+
+var cgroupControllers = []string{"cpu", "memory", "pids"}
+
+// ErrNotJailed is returned for the cgroup stats of the firecracker process not started under the jailer.
+var ErrNotJailed = errors.New("fc process is not jailed")
+
+// UseJailer returns whether the sandbox is started under the jailer.
+func UseJailer(sandboxID string) bool {
+	percentage, err := strconv.ParseUint(os.Getenv(jailerPercentageEnv), 10, 32)
+	if err != nil || percentage == 0 {
+		return false
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(sandboxID))
+
+	return hash.Sum32()%100 < uint32(min(percentage, 100))
+}
+
+// InitJailer creates the chroot base dir and the parent cgroup with the controllers enabled for the cgroups of the sandboxes.
+func InitJailer() error {
+	percentage, _ := strconv.ParseUint(os.Getenv(jailerPercentageEnv), 10, 32)
+	if percentage == 0 {
+		return nil
+	}
+
+	err := os.MkdirAll(jailerChrootBaseDir, 0o755)
+	if err != nil {
+		return fmt.Errorf("error creating jailer chroot base dir: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Join(cgroupRoot, jailerCgroupParent), 0o755)
+	if err != nil {
+		return fmt.Errorf("error creating jailer parent cgroup: %w", err)
+	}
+
+	for _, dir := range []string{cgroupRoot, filepath.Join(cgroupRoot, jailerCgroupParent)} {
+		for _, controller := range cgroupControllers {
+			err = os.WriteFile(filepath.Join(dir, "cgroup.subtree_control"), []byte("+"+controller), 0o644)
+			if err != nil {
+				return fmt.Errorf("error enabling cgroup controller '%s' in '%s': %w", controller, dir, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func jailerUIDBase() int {
+	base, err := strconv.Atoi(os.Getenv(jailerUIDBaseEnv))
+	if err != nil || base <= 0 {
+		return defaultJailerUIDBase
+	}
+
+	return base
+}
+
+// jail is the chroot and the cgroup of the firecracker process started under the jailer.
+// The files firecracker needs are bind mounted into the chroot in the mount namespace of the process, so they are unmounted when it exits.
+type jail struct {
+	id  string
+	uid int
+	// dir is created by the jailer as <chroot base>/<exec file name>/<id>, the chroot is its root subdir.
+	dir string
+}
+
+func newJail(slot network.Slot, files *storage.SandboxFiles) *jail {
+	id := files.SandboxJailerID()
+
+	return &jail{
+		id:  id,
+		uid: jailerUIDBase() + slot.Idx,
+		dir: filepath.Join(jailerChrootBaseDir, storage.FirecrackerBinaryName, id),
+	}
+}
+
+// path returns the host path of the path inside the chroot.
+func (j *jail) path(jailedPath string) string {
+	return filepath.Join(j.dir, "root", jailedPath)
+}
+
+func (j *jail) cgroupDir() string {
+	return filepath.Join(cgroupRoot, jailerCgroupParent, j.id)
+}
+
+// prepare creates the chroot with the targets of the bind mounts, the rootfs baked in the snapshot is linked to the rootfs device created later.
+func (j *jail) prepare(files *storage.SandboxFiles, buildRootfsPath string) error {
+	err := os.MkdirAll(j.path(jailedSnapshotDir), 0o700)
+	if err != nil {
+		return fmt.Errorf("error creating jail: %w", err)
+	}
+
+	for _, dir := range []string{j.path("/"), j.path(jailedSnapshotDir)} {
+		err = os.Chown(dir, j.uid, j.uid)
+		if err != nil {
+			return fmt.Errorf("error changing owner of '%s': %w", dir, err)
+		}
+	}
+
+	err = os.MkdirAll(filepath.Dir(j.path(buildRootfsPath)), 0o755)
+	if err != nil {
+		return fmt.Errorf("error creating rootfs dir: %w", err)
+	}
+
+	err = os.Symlink(jailedRootfsPath, j.path(buildRootfsPath))
+	if err != nil {
+		return fmt.Errorf("error symlinking rootfs: %w", err)
+	}
+
+	for _, target := range []string{files.BuildKernelPath(), jailedSnapfilePath, jailedUffdSocketPath} {
+		err = os.MkdirAll(filepath.Dir(j.path(target)), 0o755)
+		if err != nil {
+			return fmt.Errorf("error creating dir of '%s': %w", target, err)
+		}
+
+		err = os.WriteFile(j.path(target), nil, 0o644)
+		if err != nil {
+			return fmt.Errorf("error creating mount target '%s': %w", target, err)
+		}
+	}
+
+	return nil
+}
+
+// cgroups are the limits of the firecracker process, one more CPU is allowed for the VMM and API threads.
+func (j *jail) cgroups(vcpu, ramMB int64) []string {
+	return []string{
+		fmt.Sprintf("cpu.max=%d %d", (vcpu+1)*cpuPeriodUs, cpuPeriodUs),
+		fmt.Sprintf("memory.max=%d", (ramMB+memoryOverheadMB)<<20),
+		fmt.Sprintf("pids.max=%d", maxPids),
+	}
+}
+
+// linkRootfs creates the node of the rootfs device in the chroot, the device is not accessible from the chroot otherwise.
+func (j *jail) linkRootfs(device string) error {
+	var stat unix.Stat_t
+
+	err := unix.Stat(device, &stat)
+	if err != nil {
+		return fmt.Errorf("error getting rootfs device: %w", err)
+	}
+
+	err = unix.Mknod(j.path(jailedRootfsPath), unix.S_IFBLK|0o600, int(stat.Rdev))
+	if err != nil {
+		return fmt.Errorf("error creating rootfs device: %w", err)
+	}
+
+	return os.Chown(j.path(jailedRootfsPath), j.uid, j.uid)
+}
+
+// moveOut moves the file written by firecracker in the chroot to the path on the host.
+func (j *jail) moveOut(jailedPath, path string) error {
+	err := os.Rename(j.path(jailedPath), path)
+	if !errors.Is(err, unix.EXDEV) {
+		return err
+	}
+
+	src, err := os.Open(j.path(jailedPath))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		return err
+	}
+
+	return os.Remove(j.path(jailedPath))
+}
+
+// cleanup removes the chroot and the cgroup after the firecracker process exited.
+func (j *jail) cleanup() error {
+	var errs []error
+
+	err := os.RemoveAll(j.dir)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error removing jail: %w", err))
+	}
+
+	err = os.Remove(j.cgroupDir())
+	if err != nil && !os.IsNotExist(err) {
+		errs = append(errs, fmt.Errorf("error removing cgroup: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// CgroupStats is the resource usage of the jailed firecracker process.
+type CgroupStats struct {
+	CPUUsage     time.Duration
+	CPUThrottled time.Duration
+	MemoryBytes  uint64
+}
+
+func (j *jail) stats() (*CgroupStats, error) {
+	stats := &CgroupStats{}
+
+	cpuStat, err := os.Open(filepath.Join(j.cgroupDir(), "cpu.stat"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading cpu stats: %w", err)
+	}
+	defer cpuStat.Close()
+
+	scanner := bufio.NewScanner(cpuStat)
+	for scanner.Scan() {
+		key, value, _ := strings.Cut(scanner.Text(), " ")
+
+		usec, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		switch key {
+		case "usage_usec":
+			stats.CPUUsage = time.Duration(usec) * time.Microsecond
+		case "throttled_usec":
+			stats.CPUThrottled = time.Duration(usec) * time.Microsecond
+		}
+	}
+
+	memory, err := os.ReadFile(filepath.Join(j.cgroupDir(), "memory.current"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading memory stats: %w", err)
+	}
+
+	stats.MemoryBytes, err = strconv.ParseUint(strings.TrimSpace(string(memory)), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid memory stats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,44 @@
+package fc
+
+import "sync"
+
+// Number of the last Firecracker output lines kept for the diagnostics.
+const logTailSize = 256
+
+// logTail keeps the last lines of the Firecracker process output.
+// The guest serial console is part of the output, so it also contains the last kernel messages (e.g. panics).
+type logTail struct {
+	mu    sync.Mutex
+	lines []string
+	next  int
+	full  bool
+}
+
+func newLogTail(size int) *logTail {
+	return &logTail{
+		lines: make([]string, size),
+	}
+}
+
+func (t *logTail) Add(line string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lines[t.next] = line
+	t.next = (t.next + 1) % len(t.lines)
+
+	if t.next == 0 {
+		t.full = true
+	}
+}
+
+func (t *logTail) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.full {
+		return append([]string{}, t.lines[:t.next]...)
+	}
+
+	return append(append([]string{}, t.lines[t.next:]...), t.lines[:t.next]...)
+}
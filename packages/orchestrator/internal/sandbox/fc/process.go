@@ -9,8 +9,11 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sync/atomic"
 	"syscall"
 	txtTemplate "text/template"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -19,6 +22,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/rootfs"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/socket"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/slo"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -31,17 +35,49 @@ ln -s {{ .rootfsPath }} {{ .buildRootfsPath }} &&
 ln -s {{ .kernelPath }} {{ .buildKernelPath }} &&
 ip netns exec {{ .namespaceID }} {{ .firecrackerPath }} --api-sock {{ .firecrackerSocket }}`
 
+// The network of the booted VM is configured by the kernel, the address of the VM and of the tap device are the same in all the namespaces.
+const (
+	bootIfaceID    = "eth0"
+	bootTapName    = "tap0"
+	bootMacAddress = "02:FC:00:00:00:05"
+	bootAddress    = "169.254.0.21"
+	bootTapAddress = "169.254.0.22"
+	bootMaskLong   = "255.255.255.252"
+)
+
 var startScriptTemplate = txtTemplate.Must(txtTemplate.New("fc-start").Parse(startScript))
 
+var (
+	// ErrKilled is returned when the FC process was killed by SIGKILL that wasn't sent by us, usually by the host OOM killer.
+	ErrKilled = errors.New("fc process was killed")
+	// ErrUnexpectedExit is returned when the FC process exited on its own, e.g. after a guest kernel panic.
+	ErrUnexpectedExit = errors.New("fc process exited unexpectedly")
+)
+
 type Process struct {
 	uffdReady chan struct{}
-	snapfile  template.File
+	// snapfile is nil for the VM booted from the rootfs instead of loading the snapshot.
+	snapfile template.File
+	// initPath is the init the booted VM runs.
+	initPath string
+
+	vcpu      int64
+	ramMB     int64
+	hugePages bool
+	// rootfsPath is the path of the rootfs the FC process sees.
+	rootfsPath string
+
+	// jail is set for the process started under the jailer.
+	jail *jail
 
 	cmd *exec.Cmd
 
 	stdout *io.PipeReader
 	stderr *io.PipeReader
 
+	stdinReader *os.File
+	stdinWriter *os.File
+
 	metadata *MmdsMetadata
 
 	uffdSocketPath        string
@@ -53,6 +89,10 @@ type Process struct {
 	Exit chan error
 
 	client *apiClient
+
+	logs    *logTail
+	console *console
+	stopped atomic.Bool
 }
 
 func NewProcess(
@@ -61,10 +101,14 @@ func NewProcess(
 	slot network.Slot,
 	files *storage.SandboxFiles,
 	mmdsMetadata *MmdsMetadata,
+	kernelPath string,
 	snapfile template.File,
 	rootfs *rootfs.CowDevice,
 	uffdReady chan struct{},
 	baseTemplateID string,
+	vcpu int64,
+	ramMB int64,
+	initPath string,
 ) (*Process, error) {
 	childCtx, childSpan := tracer.Start(ctx, "initialize-fc", trace.WithAttributes(
 		attribute.String("sandbox.id", mmdsMetadata.SandboxId),
@@ -82,23 +126,62 @@ func NewProcess(
 		files.Hugepages(),
 	)
 
-	err := startScriptTemplate.Execute(&fcStartScript, map[string]interface{}{
-		"rootfsPath":        files.SandboxCacheRootfsLinkPath(),
-		"kernelPath":        files.CacheKernelPath(),
-		"buildDir":          baseBuild.BuildDir(),
-		"buildRootfsPath":   baseBuild.BuildRootfsPath(),
-		"buildKernelPath":   files.BuildKernelPath(),
-		"buildKernelDir":    files.BuildKernelDir(),
-		"namespaceID":       slot.NamespaceID(),
-		"firecrackerPath":   files.FirecrackerPath(),
-		"firecrackerSocket": files.SandboxFirecrackerSocketPath(),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("error executing fc start script template: %w", err)
+	var fcJail *jail
+
+	firecrackerSocketPath := files.SandboxFirecrackerSocketPath()
+
+	// The booted VMs are not jailed, the jail is prepared for the snapshot files
+	if snapfile != nil && UseJailer(mmdsMetadata.SandboxId) {
+		fcJail = newJail(slot, files)
+
+		err := fcJail.prepare(files, baseBuild.BuildRootfsPath())
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("error preparing jail: %w", err), fcJail.cleanup())
+		}
+
+		firecrackerSocketPath = fcJail.path(jailedSocketPath)
+
+		err = jailerStartScriptTemplate.Execute(&fcStartScript, map[string]interface{}{
+			"kernelPath":           kernelPath,
+			"chrootKernelPath":     fcJail.path(files.BuildKernelPath()),
+			"snapfilePath":         snapfile.Path(),
+			"chrootSnapfilePath":   fcJail.path(jailedSnapfilePath),
+			"uffdSocketPath":       files.SandboxUffdSocketPath(),
+			"chrootUffdSocketPath": fcJail.path(jailedUffdSocketPath),
+			"jailerPath":           files.JailerPath(),
+			"id":                   fcJail.id,
+			"firecrackerPath":      files.FirecrackerPath(),
+			"uid":                  fcJail.uid,
+			"gid":                  fcJail.uid,
+			"chrootBaseDir":        jailerChrootBaseDir,
+			"netnsPath":            filepath.Join(netnsDir, slot.NamespaceID()),
+			"cgroupParent":         jailerCgroupParent,
+			"cgroups":              fcJail.cgroups(vcpu, ramMB),
+			"firecrackerSocket":    jailedSocketPath,
+		})
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("error executing fc jailer start script template: %w", err), fcJail.cleanup())
+		}
+	} else {
+		err := startScriptTemplate.Execute(&fcStartScript, map[string]interface{}{
+			"rootfsPath":        files.SandboxCacheRootfsLinkPath(),
+			"kernelPath":        kernelPath,
+			"buildDir":          baseBuild.BuildDir(),
+			"buildRootfsPath":   baseBuild.BuildRootfsPath(),
+			"buildKernelPath":   files.BuildKernelPath(),
+			"buildKernelDir":    files.BuildKernelDir(),
+			"namespaceID":       slot.NamespaceID(),
+			"firecrackerPath":   files.FirecrackerPath(),
+			"firecrackerSocket": firecrackerSocketPath,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error executing fc start script template: %w", err)
+		}
 	}
 
 	telemetry.SetAttributes(childCtx,
 		attribute.String("sandbox.cmd", fcStartScript.String()),
+		attribute.Bool("sandbox.jailed", fcJail != nil),
 	)
 
 	cmd := exec.Command(
@@ -121,19 +204,41 @@ func NewProcess(
 	cmdStderrReader, cmdStderrWriter := io.Pipe()
 	cmd.Stderr = cmdStderrWriter
 
+	// The guest serial console input.
+	cmdStdinReader, cmdStdinWriter, err := os.Pipe()
+	if err != nil {
+		if fcJail != nil {
+			err = errors.Join(err, fcJail.cleanup())
+		}
+
+		return nil, fmt.Errorf("error creating fc stdin pipe: %w", err)
+	}
+
+	cmd.Stdin = cmdStdinReader
+
 	return &Process{
 		Exit:                  make(chan error, 1),
 		uffdReady:             uffdReady,
+		jail:                  fcJail,
 		cmd:                   cmd,
 		stdout:                cmdStdoutReader,
 		stderr:                cmdStderrReader,
-		firecrackerSocketPath: files.SandboxFirecrackerSocketPath(),
+		stdinReader:           cmdStdinReader,
+		stdinWriter:           cmdStdinWriter,
+		firecrackerSocketPath: firecrackerSocketPath,
 		metadata:              mmdsMetadata,
 		uffdSocketPath:        files.SandboxUffdSocketPath(),
 		snapfile:              snapfile,
-		client:                newApiClient(files.SandboxFirecrackerSocketPath()),
+		initPath:              initPath,
+		vcpu:                  vcpu,
+		ramMB:                 ramMB,
+		hugePages:             files.Hugepages(),
+		rootfsPath:            baseBuild.BuildRootfsPath(),
+		client:                newApiClient(firecrackerSocketPath),
 		rootfs:                rootfs,
 		files:                 files,
+		logs:                  newLogTail(logTailSize),
+		console:               newConsole(),
 	}, nil
 }
 
@@ -141,6 +246,7 @@ func (p *Process) Start(
 	ctx context.Context,
 	tracer trace.Tracer,
 	logger *logs.SandboxLogger,
+	timings *slo.Timings,
 ) error {
 	childCtx, childSpan := tracer.Start(ctx, "start-fc")
 	defer childSpan.End()
@@ -153,10 +259,11 @@ func (p *Process) Start(
 			}
 		}()
 
-		scanner := bufio.NewScanner(p.stdout)
+		scanner := bufio.NewScanner(io.TeeReader(p.stdout, p.console))
 
 		for scanner.Scan() {
 			line := scanner.Text()
+			p.logs.Add(line)
 
 			logger.Infof("[sandbox %s]: stdout: %s\n", p.metadata.SandboxId, line)
 		}
@@ -179,6 +286,7 @@ func (p *Process) Start(
 
 		for scanner.Scan() {
 			line := scanner.Text()
+			p.logs.Add(line)
 
 			logger.Warnf("[sandbox %s]: stderr: %s\n", p.metadata.SandboxId, line)
 		}
@@ -189,27 +297,65 @@ func (p *Process) Start(
 		}
 	}()
 
-	err := os.Symlink("/dev/null", p.files.SandboxCacheRootfsLinkPath())
-	if err != nil {
-		return fmt.Errorf("error symlinking rootfs: %w", err)
+	// The jailed process has the rootfs device created in the chroot after it's started
+	if p.jail == nil {
+		err := os.Symlink("/dev/null", p.files.SandboxCacheRootfsLinkPath())
+		if err != nil {
+			return fmt.Errorf("error symlinking rootfs: %w", err)
+		}
 	}
 
-	err = p.cmd.Start()
+	restoreStart := time.Now()
+
+	err := p.cmd.Start()
 	if err != nil {
+		p.stdinReader.Close()
+		p.stdinWriter.Close()
+
+		if p.jail != nil {
+			err = errors.Join(err, p.jail.cleanup())
+		}
+
 		return fmt.Errorf("error starting fc process: %w", err)
 	}
 
+	// The FC process has its own copy of the stdin reader now.
+	err = p.stdinReader.Close()
+	if err != nil {
+		logger.Warnf("[sandbox %s]: error closing fc stdin reader: %v\n", p.metadata.SandboxId, err)
+	}
+
 	startCtx, cancelStart := context.WithCancelCause(childCtx)
 	defer cancelStart(fmt.Errorf("fc finished starting"))
 
 	go func() {
 		waitErr := p.cmd.Wait()
+
+		p.console.Close()
+		p.stdinWriter.Close()
+
+		if p.jail != nil {
+			cleanupErr := p.jail.cleanup()
+			if cleanupErr != nil {
+				logger.Errorf("[sandbox %s]: error cleaning up fc jail: %v\n", p.metadata.SandboxId, cleanupErr)
+			}
+		}
+
 		if waitErr != nil {
 			var exitErr *exec.ExitError
 			if errors.As(waitErr, &exitErr) {
 				// Check if the process was killed by a signal
-				if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() && status.Signal() == syscall.SIGKILL {
-					p.Exit <- nil
+				// The shell running FC reports the killed FC process as exit code 128 + signal.
+				if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && (status.Signaled() && status.Signal() == syscall.SIGKILL || status.ExitStatus() == 128+int(syscall.SIGKILL)) {
+					if p.stopped.Load() {
+						p.Exit <- nil
+
+						return
+					}
+
+					p.Exit <- ErrKilled
+
+					cancelStart(ErrKilled)
 
 					return
 				}
@@ -224,6 +370,14 @@ func (p *Process) Start(
 			return
 		}
 
+		if !p.stopped.Load() {
+			p.Exit <- ErrUnexpectedExit
+
+			cancelStart(ErrUnexpectedExit)
+
+			return
+		}
+
 		p.Exit <- nil
 	}()
 
@@ -242,21 +396,89 @@ func (p *Process) Start(
 		return fmt.Errorf("error getting rootfs path: %w", err)
 	}
 
-	err = os.Remove(p.files.SandboxCacheRootfsLinkPath())
-	if err != nil {
-		return fmt.Errorf("error removing rootfs symlink: %w", err)
+	if p.snapfile == nil {
+		err = os.Remove(p.files.SandboxCacheRootfsLinkPath())
+		if err != nil {
+			return fmt.Errorf("error removing rootfs symlink: %w", err)
+		}
+
+		err = os.Symlink(device, p.files.SandboxCacheRootfsLinkPath())
+		if err != nil {
+			return fmt.Errorf("error symlinking rootfs: %w", err)
+		}
+
+		ip := fmt.Sprintf("%s::%s:%s:instance:%s:off:%s", bootAddress, bootTapAddress, bootMaskLong, bootIfaceID, network.SandboxNameserver)
+		bootArgs := fmt.Sprintf("console=ttyS0 quiet loglevel=1 ip=%s reboot=k panic=1 pci=off nomodules i8042.nokbd i8042.noaux ipv6.disable=1 random.trust_cpu=on init=%s", ip, p.initPath)
+
+		err = p.client.boot(startCtx, bootArgs, p.files.BuildKernelPath(), p.rootfsPath, p.vcpu, p.ramMB, p.hugePages)
+		if err != nil {
+			fcStopErr := p.Stop()
+
+			return errors.Join(fmt.Errorf("error booting vm: %w", err), fcStopErr)
+		}
+
+		timings.Since(slo.StageFCRestore, restoreStart)
+	} else {
+		err = p.restore(startCtx, device, restoreStart, timings)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = os.Symlink(device, p.files.SandboxCacheRootfsLinkPath())
+	err = p.client.setMmds(startCtx, p.metadata)
 	if err != nil {
-		return fmt.Errorf("error symlinking rootfs: %w", err)
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error setting mmds: %w", err), fcStopErr)
 	}
 
+	telemetry.SetAttributes(
+		childCtx,
+		attribute.String("sandbox.cmd.dir", p.cmd.Dir),
+		attribute.String("sandbox.cmd.path", p.cmd.Path),
+	)
+
+	return nil
+}
+
+// restore loads the snapshot of the VM with the memory served by the uffd and resumes it.
+func (p *Process) restore(ctx context.Context, device string, restoreStart time.Time, timings *slo.Timings) error {
+	// The paths in the API calls are resolved by the FC process, so they are the paths inside the chroot for the jailed process
+	uffdSocketPath := p.uffdSocketPath
+	snapfilePath := p.snapfile.Path()
+
+	var err error
+
+	if p.jail != nil {
+		err = p.jail.linkRootfs(device)
+		if err != nil {
+			fcStopErr := p.Stop()
+
+			return errors.Join(fmt.Errorf("error linking rootfs to jail: %w", err), fcStopErr)
+		}
+
+		uffdSocketPath = jailedUffdSocketPath
+		snapfilePath = jailedSnapfilePath
+	} else {
+		err = os.Remove(p.files.SandboxCacheRootfsLinkPath())
+		if err != nil {
+			return fmt.Errorf("error removing rootfs symlink: %w", err)
+		}
+
+		err = os.Symlink(device, p.files.SandboxCacheRootfsLinkPath())
+		if err != nil {
+			return fmt.Errorf("error symlinking rootfs: %w", err)
+		}
+	}
+
+	loadStart := time.Now()
+
 	err = p.client.loadSnapshot(
-		startCtx,
+		ctx,
 		p.uffdSocketPath,
+		uffdSocketPath,
 		p.uffdReady,
-		p.snapfile,
+		snapfilePath,
 	)
 	if err != nil {
 		fcStopErr := p.Stop()
@@ -264,25 +486,16 @@ func (p *Process) Start(
 		return errors.Join(fmt.Errorf("error loading snapshot: %w", err), fcStopErr)
 	}
 
-	err = p.client.resumeVM(startCtx)
+	timings.Since(slo.StageUFFDReady, loadStart)
+
+	err = p.client.resumeVM(ctx)
 	if err != nil {
 		fcStopErr := p.Stop()
 
 		return errors.Join(fmt.Errorf("error resuming vm: %w", err), fcStopErr)
 	}
 
-	err = p.client.setMmds(startCtx, p.metadata)
-	if err != nil {
-		fcStopErr := p.Stop()
-
-		return errors.Join(fmt.Errorf("error setting mmds: %w", err), fcStopErr)
-	}
-
-	telemetry.SetAttributes(
-		childCtx,
-		attribute.String("sandbox.cmd.dir", p.cmd.Dir),
-		attribute.String("sandbox.cmd.path", p.cmd.Path),
-	)
+	timings.Since(slo.StageFCRestore, restoreStart)
 
 	return nil
 }
@@ -300,6 +513,8 @@ func (p *Process) Stop() error {
 		return fmt.Errorf("fc process not started")
 	}
 
+	p.stopped.Store(true)
+
 	err := p.cmd.Process.Kill()
 	if err != nil {
 		return fmt.Errorf("failed to send KILL to FC process: %w", err)
@@ -320,5 +535,68 @@ func (p *Process) CreateSnapshot(ctx context.Context, tracer trace.Tracer, snapf
 	ctx, childSpan := tracer.Start(ctx, "create-snapshot-fc")
 	defer childSpan.End()
 
-	return p.client.createSnapshot(ctx, snapfilePath, memfilePath)
+	if p.jail == nil {
+		return p.client.createSnapshot(ctx, snapfilePath, memfilePath)
+	}
+
+	// The jailed process can write only into the chroot, the snapshot is moved out after it's created
+	jailedSnapfile := filepath.Join(jailedSnapshotDir, filepath.Base(snapfilePath))
+	jailedMemfile := filepath.Join(jailedSnapshotDir, filepath.Base(memfilePath))
+
+	err := p.client.createSnapshot(ctx, jailedSnapfile, jailedMemfile)
+	if err != nil {
+		return err
+	}
+
+	err = p.jail.moveOut(jailedSnapfile, snapfilePath)
+	if err != nil {
+		return fmt.Errorf("error moving snapfile out of jail: %w", err)
+	}
+
+	err = p.jail.moveOut(jailedMemfile, memfilePath)
+	if err != nil {
+		return fmt.Errorf("error moving memfile out of jail: %w", err)
+	}
+
+	return nil
+}
+
+// Jailed reports whether the FC process was started under the jailer.
+func (p *Process) Jailed() bool {
+	return p.jail != nil
+}
+
+// CgroupStats returns the resource usage of the cgroup of the FC process started under the jailer.
+func (p *Process) CgroupStats() (*CgroupStats, error) {
+	if p.jail == nil {
+		return nil, ErrNotJailed
+	}
+
+	return p.jail.stats()
+}
+
+// Stopped reports whether the FC process was stopped by us.
+func (p *Process) Stopped() bool {
+	return p.stopped.Load()
+}
+
+// Logs returns the last lines of the FC process output, including the guest serial console.
+func (p *Process) Logs() []string {
+	return p.logs.Lines()
+}
+
+// AttachConsole returns the guest serial console output from now on, the channel is closed when the FC process exits.
+// Call the returned function to detach.
+func (p *Process) AttachConsole() (<-chan []byte, func()) {
+	return p.console.Subscribe()
+}
+
+// WriteConsole writes the input to the guest serial console.
+func (p *Process) WriteConsole(input []byte) error {
+	_, err := p.stdinWriter.Write(input)
+	if err != nil {
+		return fmt.Errorf("error writing to fc stdin: %w", err)
+	}
+
+	return nil
 }
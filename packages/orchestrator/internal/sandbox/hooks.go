@@ -0,0 +1,66 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	defaultHookTimeout = 30 * time.Second
+
+	onResumeHookName = "on-resume"
+	onPauseHookName  = "on-pause"
+)
+
+// RunPauseHook runs the on-pause hook of the template right before the sandbox is paused.
+// The error is returned only if the hook failed and its failure policy is to fail the pause.
+func (s *Sandbox) RunPauseHook(ctx context.Context, tracer trace.Tracer) error {
+	return s.runHook(ctx, tracer, onPauseHookName, s.Config.OnPauseHook)
+}
+
+// runHook executes the hook in the sandbox and logs the result to the sandbox logs.
+func (s *Sandbox) runHook(ctx context.Context, tracer trace.Tracer, name string, hook *orchestrator.LifecycleHook) error {
+	if hook == nil {
+		return nil
+	}
+
+	childCtx, childSpan := tracer.Start(ctx, fmt.Sprintf("run-%s-hook", name))
+	defer childSpan.End()
+
+	timeout := defaultHookTimeout
+	if hook.TimeoutSeconds > 0 {
+		timeout = time.Duration(hook.TimeoutSeconds) * time.Second
+	}
+
+	hookCtx, cancel := context.WithTimeout(childCtx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := s.runCommand(hookCtx, hook.Command)
+	if err != nil && hookCtx.Err() != nil {
+		err = fmt.Errorf("hook timed out after %s: %w", timeout, err)
+	}
+
+	s.Logger.Hook(name, time.Since(start), err)
+
+	if err == nil {
+		telemetry.ReportEvent(childCtx, fmt.Sprintf("%s hook succeeded", name))
+
+		return nil
+	}
+
+	telemetry.ReportError(childCtx, fmt.Errorf("%s hook failed: %w", name, err), attribute.String("failure_policy", hook.FailurePolicy.String()))
+
+	if hook.FailurePolicy == orchestrator.HookFailurePolicy_FAIL {
+		return fmt.Errorf("%s hook failed: %w", name, err)
+	}
+
+	return nil
+}
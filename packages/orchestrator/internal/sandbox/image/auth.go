@@ -0,0 +1,40 @@
+package image
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// Auth is the credential for the private registry of the image.
+type Auth struct {
+	Username string
+	Password string
+}
+
+// key identifies the credential in the caches, so the images resolved with the credential of one team are not used by the others.
+// It's empty for the anonymous pulls.
+func (a *Auth) key() string {
+	if a == nil {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(a.Username + ":" + a.Password))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (a *Auth) basic() string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(a.Username+":"+a.Password))
+}
+
+// mask replaces the password in the error, the errors are returned to the users and logged.
+func (a *Auth) mask(err error) error {
+	if a == nil || a.Password == "" || err == nil || !strings.Contains(err.Error(), a.Password) {
+		return err
+	}
+
+	return errors.New(strings.ReplaceAll(err.Error(), a.Password, "*****"))
+}
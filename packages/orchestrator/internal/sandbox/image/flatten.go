@@ -0,0 +1,205 @@
+package image
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	whiteoutPrefix = ".wh."
+	opaqueWhiteout = ".wh..wh..opq"
+)
+
+// openLayer returns the tar reader of the layer blob, the gzip compressed layers are decompressed.
+func openLayer(layerPath string) (*tar.Reader, func() error, error) {
+	f, err := os.Open(layerPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open layer: %w", err)
+	}
+
+	buffered := bufio.NewReader(f)
+
+	magic, err := buffered.Peek(2)
+	if err != nil && !errors.Is(err, io.EOF) {
+		f.Close()
+
+		return nil, nil, fmt.Errorf("failed to read layer: %w", err)
+	}
+
+	if !bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		return tar.NewReader(buffered), f.Close, nil
+	}
+
+	gz, err := gzip.NewReader(buffered)
+	if err != nil {
+		f.Close()
+
+		return nil, nil, fmt.Errorf("failed to decompress layer: %w", err)
+	}
+
+	return tar.NewReader(gz), func() error {
+		return errors.Join(gz.Close(), f.Close())
+	}, nil
+}
+
+// walkLayer calls the fn for the entries of the layer with the clean absolute paths.
+func walkLayer(layerPath string, fn func(name string, hdr *tar.Header, r io.Reader) error) error {
+	tr, closeLayer, err := openLayer(layerPath)
+	if err != nil {
+		return err
+	}
+	defer closeLayer()
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read layer entry: %w", err)
+		}
+
+		name := path.Clean("/" + hdr.Name)
+		if name == "/" {
+			continue
+		}
+
+		err = fn(name, hdr, tr)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// lowerHidden tracks the paths of the lower layers hidden by the upper layers.
+type lowerHidden struct {
+	// deleted are the paths removed by the whiteouts, with their subtrees.
+	deleted map[string]struct{}
+	// opaque are the directories whose content in the lower layers is hidden.
+	opaque map[string]struct{}
+	// files are the paths of the non-directory entries, the lower entries under them are hidden.
+	files map[string]struct{}
+}
+
+func (h *lowerHidden) hides(name string) bool {
+	if _, ok := h.deleted[name]; ok {
+		return true
+	}
+
+	for dir := path.Dir(name); dir != "/"; dir = path.Dir(dir) {
+		_, deleted := h.deleted[dir]
+		_, opaque := h.opaque[dir]
+		_, file := h.files[dir]
+
+		if deleted || opaque || file {
+			return true
+		}
+	}
+
+	_, opaque := h.opaque["/"]
+
+	return opaque
+}
+
+// flatten writes the filesystem of the layers, ordered from the base layer, as one tar stream with the whiteouts applied.
+// The layers are read twice, the entries visible in the image are found from the top layer first, then they are written
+// from the base layer, so the parent directories and the targets of the hard links are written before the entries.
+func flatten(layers []string, w *tar.Writer) error {
+	hidden := &lowerHidden{
+		deleted: make(map[string]struct{}),
+		opaque:  make(map[string]struct{}),
+		files:   make(map[string]struct{}),
+	}
+
+	// owner is the layer of the visible entry of the path
+	owner := make(map[string]int)
+
+	for i := len(layers) - 1; i >= 0; i-- {
+		var deleted, opaque, files []string
+
+		err := walkLayer(layers[i], func(name string, hdr *tar.Header, _ io.Reader) error {
+			base := path.Base(name)
+
+			if base == opaqueWhiteout {
+				opaque = append(opaque, path.Dir(name))
+
+				return nil
+			}
+
+			if strings.HasPrefix(base, whiteoutPrefix) {
+				deleted = append(deleted, path.Join(path.Dir(name), strings.TrimPrefix(base, whiteoutPrefix)))
+
+				return nil
+			}
+
+			if _, ok := owner[name]; ok || hidden.hides(name) {
+				return nil
+			}
+
+			owner[name] = i
+
+			if hdr.Typeflag != tar.TypeDir {
+				files = append(files, name)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to index layer %d: %w", i, err)
+		}
+
+		// The whiteouts hide only the entries of the layers below
+		for _, name := range deleted {
+			hidden.deleted[name] = struct{}{}
+		}
+
+		for _, name := range opaque {
+			hidden.opaque[name] = struct{}{}
+		}
+
+		for _, name := range files {
+			hidden.files[name] = struct{}{}
+		}
+	}
+
+	for i, layer := range layers {
+		err := walkLayer(layer, func(name string, hdr *tar.Header, r io.Reader) error {
+			if layerIdx, ok := owner[name]; !ok || layerIdx != i {
+				return nil
+			}
+
+			hdr.Name = strings.TrimPrefix(name, "/")
+			if hdr.Typeflag == tar.TypeLink {
+				hdr.Linkname = strings.TrimPrefix(path.Clean("/"+hdr.Linkname), "/")
+			}
+
+			err := w.WriteHeader(hdr)
+			if err != nil {
+				return fmt.Errorf("failed to write entry '%s': %w", name, err)
+			}
+
+			if hdr.Typeflag == tar.TypeReg {
+				_, err = io.Copy(w, r)
+				if err != nil {
+					return fmt.Errorf("failed to write entry '%s': %w", name, err)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to flatten layer %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
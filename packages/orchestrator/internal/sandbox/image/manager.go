@@ -0,0 +1,467 @@
+// Package image builds the rootfs of the sandboxes booted from the OCI images on the node. The layers of the images
+// are downloaded once and shared by the images, the rootfs of an image is converted once and shared by its sandboxes.
+package image
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Microsoft/hcsshim/ext4/tar2ext4"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	cacheDir  = "/orchestrator/images"
+	layersDir = cacheDir + "/layers"
+	rootfsDir = cacheDir + "/rootfs"
+
+	// How long to keep the rootfs and the layers on the node since they were last used.
+	imageExpiration = 6 * time.Hour
+	gcInterval      = time.Hour
+	// How long the digest the tag of the image resolved to is used before the tag is resolved again.
+	tagExpiration = 5 * time.Minute
+
+	// The timeout of the conversion shared by the sandboxes started from the image at once.
+	convertTimeout = 15 * time.Minute
+
+	maxRootfsSize = 15000 << 20
+	// maxLayersSize limits the layers of one image downloaded to the node, the compressed layers are smaller than the rootfs.
+	maxLayersSize = maxRootfsSize
+	// rootfsFreeSpace is the free space of the filesystem for the files written by the sandbox.
+	rootfsFreeSpace = 2048 << 20
+
+	// InitPath is the init of the sandbox booted from the image, it's added to the rootfs of the image.
+	InitPath = "/.e2b/init"
+)
+
+// initScript prepares the system for envd, the images don't have an init system.
+const initScript = `#!/bin/sh
+mount -t proc proc /proc
+mount -t sysfs sysfs /sys
+mount -t devtmpfs devtmpfs /dev
+mkdir -p /dev/pts /dev/shm
+mount -t devpts devpts /dev/pts
+mount -t tmpfs tmpfs /dev/shm
+mount -t tmpfs tmpfs /run
+mount -t tmpfs tmpfs /tmp
+hostname e2b
+exec ` + storage.GuestEnvdPath + `
+`
+
+const resolvConf = "nameserver " + network.SandboxNameserver + "\n"
+
+// Image is the rootfs of the image on the node.
+type Image struct {
+	Path   string
+	Digest string
+}
+
+type rootfs struct {
+	path     string
+	refs     int
+	lastUsed time.Time
+}
+
+type resolved struct {
+	digest     string
+	layers     []descriptor
+	resolvedAt time.Time
+}
+
+type Manager struct {
+	registry *registry
+
+	mu     sync.Mutex
+	images map[string]*rootfs
+	tags   map[string]*resolved
+	// layers are the last uses of the downloaded layers by the digest.
+	layers map[string]time.Time
+
+	conversions singleflight.Group
+	downloads   singleflight.Group
+}
+
+// NewManager creates the image manager, the images converted by the previous run of the orchestrator are removed,
+// they are converted again when needed.
+func NewManager(ctx context.Context) (*Manager, error) {
+	err := os.RemoveAll(cacheDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clean images dir: %w", err)
+	}
+
+	for _, dir := range []string{layersDir, rootfsDir} {
+		err = os.MkdirAll(dir, 0o755)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create images dir: %w", err)
+		}
+	}
+
+	m := &Manager{
+		registry: newRegistry(),
+		images:   make(map[string]*rootfs),
+		tags:     make(map[string]*resolved),
+		layers:   make(map[string]time.Time),
+	}
+
+	go m.startGC(ctx)
+
+	return m, nil
+}
+
+// Acquire returns the rootfs of the image and converts it if it isn't on the node yet, the image is pulled with the credential if it's set.
+// The release has to be called when the rootfs is no longer used.
+func (m *Manager) Acquire(ctx context.Context, imageRef string, auth *Auth) (*Image, func(), error) {
+	img, release, err := m.acquire(ctx, imageRef, auth)
+
+	return img, release, auth.mask(err)
+}
+
+func (m *Manager) acquire(ctx context.Context, imageRef string, auth *Auth) (*Image, func(), error) {
+	ref, err := parseReference(imageRef)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The image is resolved with the credential before its rootfs is used,
+	// so the rootfs of the private image on the node is used only by the sandboxes with the access to it
+	r, err := m.resolve(ctx, ref, auth)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.mu.Lock()
+	img, ok := m.images[r.digest]
+	if ok {
+		img.refs++
+		m.mu.Unlock()
+
+		return &Image{Path: img.path, Digest: r.digest}, m.releaseFunc(r.digest), nil
+	}
+	m.mu.Unlock()
+
+	result, err, _ := m.conversions.Do(r.digest, func() (any, error) {
+		convertCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), convertTimeout)
+		defer cancel()
+
+		return m.convert(convertCtx, ref, auth, r)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.mu.Lock()
+	img, ok = m.images[r.digest]
+	if !ok {
+		img = result.(*rootfs)
+		m.images[r.digest] = img
+	}
+	img.refs++
+	m.mu.Unlock()
+
+	return &Image{Path: img.path, Digest: r.digest}, m.releaseFunc(r.digest), nil
+}
+
+func (m *Manager) releaseFunc(digest string) func() {
+	return sync.OnceFunc(func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if img, ok := m.images[digest]; ok {
+			img.refs--
+			img.lastUsed = time.Now()
+		}
+	})
+}
+
+// resolve returns the digest and the layers of the image, the tags are resolved again after the tag expiration.
+// The images are resolved for each credential separately.
+func (m *Manager) resolve(ctx context.Context, ref *reference, auth *Auth) (*resolved, error) {
+	key := ref.String() + "/" + auth.key()
+
+	m.mu.Lock()
+	r, ok := m.tags[key]
+	m.mu.Unlock()
+
+	if ok && (ref.digest != "" || time.Since(r.resolvedAt) < tagExpiration) {
+		return r, nil
+	}
+
+	digest, layers, err := m.registry.resolve(ctx, ref, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	r = &resolved{
+		digest:     digest,
+		layers:     layers,
+		resolvedAt: time.Now(),
+	}
+
+	m.mu.Lock()
+	m.tags[key] = r
+	m.mu.Unlock()
+
+	return r, nil
+}
+
+// convert downloads the layers of the image and converts them to the ext4 filesystem with envd and the init added.
+func (m *Manager) convert(ctx context.Context, ref *reference, auth *Auth, r *resolved) (*rootfs, error) {
+	layerPaths := make([]string, 0, len(r.layers))
+
+	layersSize := int64(0)
+	for _, layer := range r.layers {
+		if layer.Size <= 0 {
+			return nil, fmt.Errorf("layer '%s' of image '%s' has invalid size %d", layer.Digest, ref, layer.Size)
+		}
+
+		layersSize += layer.Size
+		if layersSize > maxLayersSize {
+			return nil, fmt.Errorf("layers of image '%s' exceed %d bytes", ref, maxLayersSize)
+		}
+	}
+
+	for _, layer := range r.layers {
+		result, err, _ := m.downloads.Do(layer.Digest, func() (any, error) {
+			return m.downloadLayer(ctx, ref, auth, layer)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		layerPaths = append(layerPaths, result.(string))
+	}
+
+	tmp, err := os.CreateTemp(rootfsDir, "rootfs.*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rootfs file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		tw := tar.NewWriter(pw)
+
+		flattenErr := flatten(layerPaths, tw)
+		if flattenErr == nil {
+			flattenErr = addSandboxFiles(tw)
+		}
+
+		pw.CloseWithError(errors.Join(flattenErr, tw.Close()))
+	}()
+
+	// The package creates a read-only ext4 filesystem, it's made writable and resized after the conversion
+	err = tar2ext4.ConvertTarToExt4(pr, tmp, tar2ext4.MaximumDiskSize(maxRootfsSize))
+	pr.CloseWithError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert image '%s' to ext4: %w", ref, err)
+	}
+
+	stat, err := tmp.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat rootfs file: %w", err)
+	}
+
+	err = tmp.Truncate(stat.Size() + rootfsFreeSpace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extend rootfs file: %w", err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to close rootfs file: %w", err)
+	}
+
+	for _, args := range [][]string{{"tune2fs", "-O", "^read-only", tmp.Name()}, {"resize2fs", tmp.Name()}} {
+		out, cmdErr := exec.CommandContext(ctx, args[0], args[1:]...).CombinedOutput()
+		if cmdErr != nil {
+			return nil, fmt.Errorf("failed to run %s on rootfs of image '%s': %w: %s", args[0], ref, cmdErr, strings.TrimSpace(string(out)))
+		}
+	}
+
+	path := filepath.Join(rootfsDir, strings.TrimPrefix(r.digest, "sha256:")+".ext4")
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move rootfs file: %w", err)
+	}
+
+	log.Printf("converted image '%s' (%s) to rootfs", ref, r.digest)
+
+	return &rootfs{
+		path:     path,
+		lastUsed: time.Now(),
+	}, nil
+}
+
+// downloadLayer downloads the layer blob to the node if it isn't there yet and verifies its digest.
+// No more than the size from the manifest is read from the registry.
+func (m *Manager) downloadLayer(ctx context.Context, ref *reference, auth *Auth, layer descriptor) (string, error) {
+	digest := layer.Digest
+
+	hexDigest, ok := strings.CutPrefix(digest, "sha256:")
+	if !ok || len(hexDigest) != 64 || strings.ContainsAny(hexDigest, "/.") {
+		return "", fmt.Errorf("unsupported layer digest '%s'", digest)
+	}
+
+	path := filepath.Join(layersDir, hexDigest)
+
+	defer func() {
+		m.mu.Lock()
+		m.layers[digest] = time.Now()
+		m.mu.Unlock()
+	}()
+
+	_, err := os.Stat(path)
+	if err == nil {
+		return path, nil
+	}
+
+	blob, err := m.registry.blob(ctx, ref, auth, digest)
+	if err != nil {
+		return "", err
+	}
+	defer blob.Close()
+
+	tmp, err := os.CreateTemp(layersDir, hexDigest+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create layer file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+
+	written, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(blob, layer.Size))
+	closeErr := tmp.Close()
+	if err = errors.Join(err, closeErr); err != nil {
+		return "", fmt.Errorf("failed to download layer '%s': %w", digest, err)
+	}
+
+	if written != layer.Size {
+		return "", fmt.Errorf("layer '%s' has %d bytes, the manifest says %d", digest, written, layer.Size)
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != hexDigest {
+		return "", fmt.Errorf("layer '%s' doesn't match its digest", digest)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return "", fmt.Errorf("failed to move layer file: %w", err)
+	}
+
+	return path, nil
+}
+
+// addSandboxFiles adds envd, the init and the DNS config to the rootfs, they replace the files of the image.
+func addSandboxFiles(tw *tar.Writer) error {
+	envd, err := os.ReadFile(storage.HostEnvdPath)
+	if err != nil {
+		return fmt.Errorf("failed to read envd: %w", err)
+	}
+
+	files := []struct {
+		name    string
+		mode    int64
+		content []byte
+	}{
+		{name: storage.GuestEnvdPath, mode: 0o755, content: envd},
+		{name: InitPath, mode: 0o755, content: []byte(initScript)},
+		{name: "/etc/resolv.conf", mode: 0o644, content: []byte(resolvConf)},
+	}
+
+	for _, file := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     strings.TrimPrefix(file.name, "/"),
+			Mode:     file.mode,
+			Size:     int64(len(file.content)),
+			ModTime:  time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add '%s' to rootfs: %w", file.name, err)
+		}
+
+		_, err = tw.Write(file.content)
+		if err != nil {
+			return fmt.Errorf("failed to add '%s' to rootfs: %w", file.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) startGC(ctx context.Context) {
+	ticker := time.NewTicker(gcInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.gc()
+		}
+	}
+}
+
+// gc removes the rootfs of the images that are not used by any sandbox and the layers that were not used
+// for the expiration period.
+func (m *Manager) gc() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for digest, img := range m.images {
+		if img.refs > 0 || time.Since(img.lastUsed) < imageExpiration {
+			continue
+		}
+
+		err := os.Remove(img.path)
+		if err != nil {
+			log.Printf("failed to remove unused rootfs of image '%s': %v", digest, err)
+
+			continue
+		}
+
+		delete(m.images, digest)
+
+		log.Printf("removed unused rootfs of image '%s'", digest)
+	}
+
+	for digest, lastUsed := range m.layers {
+		if time.Since(lastUsed) < imageExpiration {
+			continue
+		}
+
+		err := os.Remove(filepath.Join(layersDir, strings.TrimPrefix(digest, "sha256:")))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Printf("failed to remove unused layer '%s': %v", digest, err)
+
+			continue
+		}
+
+		delete(m.layers, digest)
+	}
+
+	for key, r := range m.tags {
+		if time.Since(r.resolvedAt) >= imageExpiration {
+			delete(m.tags, key)
+		}
+	}
+}
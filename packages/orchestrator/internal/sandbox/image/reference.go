@@ -0,0 +1,93 @@
+package image
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	dockerHub         = "docker.io"
+	dockerHubRegistry = "registry-1.docker.io"
+	defaultTag        = "latest"
+)
+
+// reference is the parsed reference of the image in the [registry/]repository[:tag][@digest] format.
+type reference struct {
+	registry   string
+	repository string
+	tag        string
+	digest     string
+}
+
+func parseReference(ref string) (*reference, error) {
+	if ref == "" || strings.ContainsAny(ref, " \t\n") {
+		return nil, fmt.Errorf("invalid image reference '%s'", ref)
+	}
+
+	r := &reference{}
+
+	name, digest, ok := strings.Cut(ref, "@")
+	if ok {
+		if !strings.HasPrefix(digest, "sha256:") || len(digest) != len("sha256:")+64 {
+			return nil, fmt.Errorf("invalid digest of image reference '%s'", ref)
+		}
+
+		r.digest = digest
+	}
+
+	// The tag is after the last colon that is not a part of the registry host
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		r.tag = name[i+1:]
+		name = name[:i]
+	}
+
+	if r.tag == "" && r.digest == "" {
+		r.tag = defaultTag
+	}
+
+	// The first component is the registry if it looks like a host, otherwise the image is on Docker Hub
+	first, rest, ok := strings.Cut(name, "/")
+	if ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		r.registry = first
+		r.repository = rest
+	} else {
+		r.registry = dockerHub
+		r.repository = name
+	}
+
+	if r.registry == dockerHub {
+		r.registry = dockerHubRegistry
+
+		if !strings.Contains(r.repository, "/") {
+			r.repository = "library/" + r.repository
+		}
+	}
+
+	if r.repository == "" || r.repository != strings.ToLower(r.repository) {
+		return nil, fmt.Errorf("invalid repository of image reference '%s'", ref)
+	}
+
+	return r, nil
+}
+
+// manifestRef is the digest of the image if it's pinned, the tag otherwise.
+func (r *reference) manifestRef() string {
+	if r.digest != "" {
+		return r.digest
+	}
+
+	return r.tag
+}
+
+func (r *reference) String() string {
+	s := r.registry + "/" + r.repository
+	if r.tag != "" {
+		s += ":" + r.tag
+	}
+
+	if r.digest != "" {
+		s += "@" + r.digest
+	}
+
+	return s
+}
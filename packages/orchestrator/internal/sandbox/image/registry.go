@@ -0,0 +1,269 @@
+package image
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	mediaTypeOCIIndex      = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIManifest   = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerList    = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerSchema2 = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// maxManifestSize is the limit of the manifests read from the registry, the manifests are small JSON documents.
+	maxManifestSize = 4 << 20
+
+	registryTimeout = 30 * time.Second
+)
+
+var manifestMediaTypes = []string{mediaTypeOCIIndex, mediaTypeOCIManifest, mediaTypeDockerList, mediaTypeDockerSchema2}
+
+type descriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+	Platform  *struct {
+		Architecture string `json:"architecture"`
+		OS           string `json:"os"`
+	} `json:"platform,omitempty"`
+}
+
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Manifests []descriptor `json:"manifests"`
+	Layers    []descriptor `json:"layers"`
+}
+
+// registry pulls the public images by the distribution API of the registries, the anonymous token is requested
+// when the registry requires one.
+type registry struct {
+	client *http.Client
+
+	mu sync.Mutex
+	// authorizations are the authorization headers by the registry, the repository and the credential.
+	authorizations map[string]string
+}
+
+func newRegistry() *registry {
+	return &registry{
+		client:         &http.Client{},
+		authorizations: make(map[string]string),
+	}
+}
+
+// resolve returns the digest and the layers of the image manifest for the platform of the node.
+func (r *registry) resolve(ctx context.Context, ref *reference, auth *Auth) (string, []descriptor, error) {
+	m, digest, err := r.manifest(ctx, ref, auth, ref.manifestRef())
+	if err != nil {
+		return "", nil, err
+	}
+
+	if m.MediaType == mediaTypeOCIIndex || m.MediaType == mediaTypeDockerList || len(m.Manifests) > 0 {
+		platformDigest := ""
+
+		for _, d := range m.Manifests {
+			if d.Platform != nil && d.Platform.OS == "linux" && d.Platform.Architecture == runtime.GOARCH {
+				platformDigest = d.Digest
+
+				break
+			}
+		}
+
+		if platformDigest == "" {
+			return "", nil, fmt.Errorf("image '%s' has no manifest for linux/%s", ref, runtime.GOARCH)
+		}
+
+		m, digest, err = r.manifest(ctx, ref, auth, platformDigest)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(m.Layers) == 0 {
+		return "", nil, fmt.Errorf("image '%s' has no layers", ref)
+	}
+
+	return digest, m.Layers, nil
+}
+
+func (r *registry) manifest(ctx context.Context, ref *reference, auth *Auth, manifestRef string) (*manifest, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, registryTimeout)
+	defer cancel()
+
+	resp, err := r.get(ctx, ref, auth, "manifests/"+manifestRef, strings.Join(manifestMediaTypes, ", "))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get manifest of image '%s': %w", ref, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read manifest of image '%s': %w", ref, err)
+	}
+
+	sum := sha256.Sum256(body)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+
+	if strings.HasPrefix(manifestRef, "sha256:") && manifestRef != digest {
+		return nil, "", fmt.Errorf("manifest of image '%s' doesn't match the digest %s", ref, manifestRef)
+	}
+
+	var m manifest
+
+	err = json.Unmarshal(body, &m)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse manifest of image '%s': %w", ref, err)
+	}
+
+	if m.MediaType == "" {
+		m.MediaType = resp.Header.Get("Content-Type")
+	}
+
+	return &m, digest, nil
+}
+
+// blob returns the reader of the blob, the caller verifies the digest of the content.
+func (r *registry) blob(ctx context.Context, ref *reference, auth *Auth, digest string) (io.ReadCloser, error) {
+	resp, err := r.get(ctx, ref, auth, "blobs/"+digest, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blob '%s' of image '%s': %w", digest, ref, err)
+	}
+
+	return resp.Body, nil
+}
+
+func (r *registry) get(ctx context.Context, ref *reference, auth *Auth, path, accept string) (*http.Response, error) {
+	authorizationKey := ref.registry + "/" + ref.repository + "/" + auth.key()
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s/v2/%s/%s", ref.registry, ref.repository, path), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+
+		r.mu.Lock()
+		authorization := r.authorizations[authorizationKey]
+		r.mu.Unlock()
+
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		resp, err := r.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusOK {
+			return resp, nil
+		}
+
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusUnauthorized || attempt > 0 {
+			return nil, fmt.Errorf("unexpected status %s", resp.Status)
+		}
+
+		authorization, err = r.authorize(ctx, resp.Header.Get("WWW-Authenticate"), ref, auth)
+		if err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		r.authorizations[authorizationKey] = authorization
+		r.mu.Unlock()
+	}
+}
+
+// authorize returns the authorization header by the challenge of the registry. The pull token is requested for the bearer challenge,
+// with the credential if it's set. The credential is sent directly for the basic challenge, e.g. by ECR.
+func (r *registry) authorize(ctx context.Context, challenge string, ref *reference, auth *Auth) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+
+	if strings.EqualFold(scheme, "Basic") {
+		if auth == nil {
+			return "", fmt.Errorf("registry '%s' requires a credential", ref.registry)
+		}
+
+		return auth.basic(), nil
+	}
+
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", fmt.Errorf("unsupported registry authentication '%s'", scheme)
+	}
+
+	values := make(map[string]string)
+	for _, param := range strings.Split(params, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if ok {
+			values[key] = strings.Trim(value, `"`)
+		}
+	}
+
+	realm, err := url.Parse(values["realm"])
+	if err != nil || realm.Scheme != "https" {
+		return "", fmt.Errorf("invalid registry authentication realm '%s'", values["realm"])
+	}
+
+	query := realm.Query()
+	if service := values["service"]; service != "" {
+		query.Set("service", service)
+	}
+
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", ref.repository))
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	if auth != nil {
+		req.SetBasicAuth(auth.Username, auth.Password)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get registry token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get registry token: unexpected status %s", resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	err = json.NewDecoder(io.LimitReader(resp.Body, maxManifestSize)).Decode(&body)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse registry token: %w", err)
+	}
+
+	if body.Token != "" {
+		return "Bearer " + body.Token, nil
+	}
+
+	if body.AccessToken != "" {
+		return "Bearer " + body.AccessToken, nil
+	}
+
+	return "", fmt.Errorf("registry returned an empty token")
+}
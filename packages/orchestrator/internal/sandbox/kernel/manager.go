@@ -0,0 +1,220 @@
+// Package kernel manages the kernel binaries on the node, they are downloaded on demand, verified and removed when unused.
+package kernel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+const (
+	cacheDir = "/orchestrator/kernels"
+
+	// How long to keep the kernel on the node since it was last used by a sandbox.
+	// Should be longer than the maximum possible sandbox lifetime, the same as for the templates.
+	kernelExpiration = 25 * time.Hour
+	gcInterval       = time.Hour
+)
+
+type kernel struct {
+	path     string
+	checksum string
+	refs     int
+	lastUsed time.Time
+}
+
+type Manager struct {
+	// The bucket the kernels are downloaded from, if nil the kernels are copied from the mounted kernels dir.
+	bucket *gcs.BucketHandle
+
+	mu      sync.Mutex
+	kernels map[string]*kernel
+
+	downloads singleflight.Group
+}
+
+// NewManager creates the kernel manager, the kernels are downloaded from the KERNELS_BUCKET_NAME bucket if it is set.
+// The kernels downloaded by the previous run of the orchestrator are removed, they are downloaded again when needed.
+func NewManager(ctx context.Context) (*Manager, error) {
+	err := os.RemoveAll(cacheDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clean kernels dir: %w", err)
+	}
+
+	err = os.MkdirAll(cacheDir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kernels dir: %w", err)
+	}
+
+	m := &Manager{
+		kernels: make(map[string]*kernel),
+	}
+
+	if bucketName := os.Getenv("KERNELS_BUCKET_NAME"); bucketName != "" {
+		m.bucket = gcs.NewBucket(bucketName)
+	}
+
+	go m.startGC(ctx)
+
+	return m, nil
+}
+
+// Acquire returns the path of the kernel binary and downloads it if it isn't on the node yet.
+// If the checksum is not empty, the kernel is verified against it. The release has to be called when the kernel is no longer used.
+func (m *Manager) Acquire(ctx context.Context, version, checksum string) (string, func(), error) {
+	m.mu.Lock()
+	k, ok := m.kernels[version]
+	if ok {
+		if checksum != "" && k.checksum != checksum {
+			m.mu.Unlock()
+
+			return "", nil, fmt.Errorf("kernel '%s' checksum mismatch, expected %s, got %s", version, checksum, k.checksum)
+		}
+
+		k.refs++
+		m.mu.Unlock()
+
+		return k.path, m.releaseFunc(version), nil
+	}
+	m.mu.Unlock()
+
+	result, err, _ := m.downloads.Do(version, func() (any, error) {
+		return m.download(ctx, version)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	downloaded := result.(*kernel)
+	if checksum != "" && downloaded.checksum != checksum {
+		return "", nil, fmt.Errorf("kernel '%s' checksum mismatch, expected %s, got %s", version, checksum, downloaded.checksum)
+	}
+
+	m.mu.Lock()
+	k, ok = m.kernels[version]
+	if !ok {
+		k = downloaded
+		m.kernels[version] = k
+	}
+	k.refs++
+	m.mu.Unlock()
+
+	return k.path, m.releaseFunc(version), nil
+}
+
+func (m *Manager) releaseFunc(version string) func() {
+	return sync.OnceFunc(func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if k, ok := m.kernels[version]; ok {
+			k.refs--
+			k.lastUsed = time.Now()
+		}
+	})
+}
+
+func (m *Manager) openSource(ctx context.Context, version string) (io.ReadCloser, error) {
+	if m.bucket == nil {
+		return os.Open(filepath.Join(storage.KernelsDir, version, storage.KernelName))
+	}
+
+	return m.bucket.Object(fmt.Sprintf("%s/%s", version, storage.KernelName)).NewReader(ctx)
+}
+
+// download copies the kernel to the node, the checksum is computed while copying.
+func (m *Manager) download(ctx context.Context, version string) (*kernel, error) {
+	if version == "" || filepath.Base(version) != version {
+		return nil, fmt.Errorf("invalid kernel version '%s'", version)
+	}
+
+	source, err := m.openSource(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open kernel '%s': %w", version, err)
+	}
+	defer source.Close()
+
+	dir := filepath.Join(cacheDir, version)
+
+	err = os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kernel dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, storage.KernelName+".*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kernel file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+
+	_, err = io.Copy(io.MultiWriter(tmp, hash), source)
+	closeErr := tmp.Close()
+	if err = errors.Join(err, closeErr); err != nil {
+		return nil, fmt.Errorf("failed to download kernel '%s': %w", version, err)
+	}
+
+	path := filepath.Join(dir, storage.KernelName)
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move kernel file: %w", err)
+	}
+
+	return &kernel{
+		path:     path,
+		checksum: hex.EncodeToString(hash.Sum(nil)),
+		lastUsed: time.Now(),
+	}, nil
+}
+
+func (m *Manager) startGC(ctx context.Context) {
+	ticker := time.NewTicker(gcInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.gc()
+		}
+	}
+}
+
+// gc removes the kernels that are not used by any sandbox and were not used for the expiration period.
+func (m *Manager) gc() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for version, k := range m.kernels {
+		if k.refs > 0 || time.Since(k.lastUsed) < kernelExpiration {
+			continue
+		}
+
+		err := os.RemoveAll(filepath.Dir(k.path))
+		if err != nil {
+			log.Printf("failed to remove unused kernel '%s': %v", version, err)
+
+			continue
+		}
+
+		delete(m.kernels, version)
+
+		log.Printf("removed unused kernel '%s'", version)
+	}
+}
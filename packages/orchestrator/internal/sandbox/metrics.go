@@ -1,9 +1,38 @@
 package sandbox
 
+import "github.com/e2b-dev/infra/packages/shared/pkg/logs"
+
 type SandboxMetrics struct {
-	Timestamp      int64   `json:"ts"`            // Unix Timestamp in UTC
-	CPUCount       uint32  `json:"cpu_count"`     // Total CPU cores
-	CPUUsedPercent float32 `json:"cpu_used_pct"`  // Percent rounded to 2 decimal places
-	MemTotalMiB    uint64  `json:"mem_total_mib"` // Total virtual memory in MiB
-	MemUsedMiB     uint64  `json:"mem_used_mib"`  // Used virtual memory in MiB
+	Timestamp      int64                   `json:"ts"`             // Unix Timestamp in UTC
+	CPUCount       uint32                  `json:"cpu_count"`      // Total CPU cores
+	CPUUsedPercent float32                 `json:"cpu_used_pct"`   // Percent rounded to 2 decimal places
+	MemTotalMiB    uint64                  `json:"mem_total_mib"`  // Total virtual memory in MiB
+	MemUsedMiB     uint64                  `json:"mem_used_mib"`   // Used virtual memory in MiB
+	DiskTotalMiB   uint64                  `json:"disk_total_mib"` // Total space of the root filesystem in MiB
+	DiskUsedMiB    uint64                  `json:"disk_used_mib"`  // Used space of the root filesystem in MiB
+	OpenFDs        int64                   `json:"open_fds"`       // Open file descriptors of all processes
+	Processes      []SandboxProcessMetrics `json:"processes"`      // The processes using the most resources
+}
+
+type SandboxProcessMetrics struct {
+	PID            int32   `json:"pid"`
+	Cmd            string  `json:"cmd"`
+	CPUUsedPercent float32 `json:"cpu_used_pct"` // Percent of one CPU core
+	MemRSSMiB      uint64  `json:"mem_rss_mib"`
+	OpenFDs        int32   `json:"open_fds"`
+}
+
+func (m SandboxMetrics) logProcesses() []logs.ProcessMetrics {
+	processes := make([]logs.ProcessMetrics, 0, len(m.Processes))
+	for _, p := range m.Processes {
+		processes = append(processes, logs.ProcessMetrics{
+			PID:        p.PID,
+			Cmd:        p.Cmd,
+			CPUUsedPct: p.CPUUsedPercent,
+			MemRSSMiB:  p.MemRSSMiB,
+			OpenFDs:    p.OpenFDs,
+		})
+	}
+
+	return processes
 }
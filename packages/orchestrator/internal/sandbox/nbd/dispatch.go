@@ -6,6 +6,10 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/chaos"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/watchdog"
 )
 
 type Provider interface {
@@ -196,6 +200,8 @@ func (d *Dispatch) Handle() error {
 }
 
 func (d *Dispatch) cmdRead(cmdHandle uint64, cmdFrom uint64, cmdLength uint32) error {
+	start := time.Now()
+
 	d.pendingMu.Lock()
 	d.pendingResponses.Add(1)
 	d.pendingMu.Unlock()
@@ -205,7 +211,10 @@ func (d *Dispatch) cmdRead(cmdHandle uint64, cmdFrom uint64, cmdLength uint32) e
 		data := make([]byte, length)
 
 		go func() {
-			_, e := d.prov.ReadAt(data, int64(from))
+			e := chaos.Inject(chaos.NBDRead)
+			if e == nil {
+				_, e = d.prov.ReadAt(data, int64(from))
+			}
 			errchan <- e
 		}()
 
@@ -217,6 +226,8 @@ func (d *Dispatch) cmdRead(cmdHandle uint64, cmdFrom uint64, cmdLength uint32) e
 		case e = <-errchan:
 		}
 
+		watchdog.NBD.Since(start)
+
 		errorValue := uint32(0)
 		if e != nil {
 			errorValue = 1
@@ -238,6 +249,8 @@ func (d *Dispatch) cmdRead(cmdHandle uint64, cmdFrom uint64, cmdLength uint32) e
 }
 
 func (d *Dispatch) cmdWrite(cmdHandle uint64, cmdFrom uint64, cmdData []byte) error {
+	start := time.Now()
+
 	d.pendingMu.Lock()
 	d.pendingResponses.Add(1)
 	d.pendingMu.Unlock()
@@ -245,7 +258,10 @@ func (d *Dispatch) cmdWrite(cmdHandle uint64, cmdFrom uint64, cmdData []byte) er
 	go func() {
 		errchan := make(chan error)
 		go func() {
-			_, e := d.prov.WriteAt(cmdData, int64(cmdFrom))
+			e := chaos.Inject(chaos.NBDWrite)
+			if e == nil {
+				_, e = d.prov.WriteAt(cmdData, int64(cmdFrom))
+			}
 			errchan <- e
 		}()
 
@@ -257,6 +273,8 @@ func (d *Dispatch) cmdWrite(cmdHandle uint64, cmdFrom uint64, cmdData []byte) er
 		case e = <-errchan:
 		}
 
+		watchdog.NBD.Since(start)
+
 		errorValue := uint32(0)
 		if e != nil {
 			errorValue = 1
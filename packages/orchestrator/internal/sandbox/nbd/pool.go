@@ -10,6 +10,8 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/bits-and-blooms/bitset"
 	"go.opentelemetry.io/otel/metric"
@@ -17,8 +19,19 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
 )
 
-// maxSlotsReady is the number of slots that are ready to be used.
-const maxSlotsReady = 64
+const (
+	// maxSlotsReady is the number of slots that are ready to be used.
+	maxSlotsReady = 64
+
+	// initialDevices is the number of devices the pool starts with, it grows up to the host limit when all of them are used.
+	initialDevices = 256
+
+	// deviceWaitTimeout is how long the request waits in the queue for a free device when all devices are used.
+	deviceWaitTimeout = 30 * time.Second
+
+	// exhaustedRetryInterval is how often the pool looks for a free device when all devices are used and none was released.
+	exhaustedRetryInterval = time.Second
+)
 
 // ErrNoFreeSlots is returned when there are no free slots.
 // You can retry the request after some time.
@@ -52,43 +65,90 @@ type DevicePool struct {
 	usedSlots *bitset.BitSet
 	mu        sync.Mutex
 
+	// limit is the number of devices the pool currently uses, it is doubled when all of them are used, up to maxDevices.
+	limit      uint
+	maxDevices uint
+
+	// exhausted is set when all devices up to the host limit are used.
+	exhausted atomic.Bool
+	// released is signaled when a device is released, so the pool doesn't have to poll for a free device.
+	released chan struct{}
+
 	slots chan DeviceSlot
 
-	slotCounter metric.Int64UpDownCounter
+	slotCounter      metric.Int64UpDownCounter
+	waitingCounter   metric.Int64UpDownCounter
+	exhaustedCounter metric.Int64Counter
 }
 
+// Pool is the device pool of the orchestrator, it is created by NewDevicePool when the orchestrator starts.
 var Pool *DevicePool
 
-func init() {
+// NewDevicePool creates the pool of the devices up to the host limit and starts populating it.
+func NewDevicePool() (*DevicePool, error) {
 	maxDevices, err := getMaxDevices()
 	if err != nil {
-		panic(fmt.Errorf("failed to get current max devices: %w", err))
+		return nil, fmt.Errorf("failed to get current max devices: %w", err)
 	}
 
 	if maxDevices == 0 {
-		panic(fmt.Errorf("nbd module is not loaded or max devices is set to 0"))
+		return nil, errors.New("nbd module is not loaded or max devices is set to 0")
+	}
+
+	// The host limit can be lowered, e.g. to keep some devices for other uses
+	if value := os.Getenv("NBD_MAX_DEVICES"); value != "" {
+		limit, parseErr := strconv.ParseUint(value, 10, 0)
+		if parseErr != nil || limit == 0 {
+			return nil, fmt.Errorf("invalid NBD_MAX_DEVICES '%s'", value)
+		}
+
+		maxDevices = min(maxDevices, uint(limit))
 	}
 
 	counter, err := meters.GetUpDownCounter(meters.NBDkSlotSReadyPoolCounterMeterName)
 	if err != nil {
-		panic(fmt.Errorf("failed to get nbd slot pool counter: %w", err))
+		return nil, fmt.Errorf("failed to get nbd slot pool counter: %w", err)
+	}
+
+	waitingCounter, err := meters.GetUpDownCounter(meters.NBDSlotsWaitingCounterMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nbd slot waiting counter: %w", err)
+	}
+
+	exhaustedCounter, err := meters.GetCounter(meters.NBDSlotsExhaustedMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nbd slot exhausted counter: %w", err)
 	}
 
 	pool := &DevicePool{
-		ctx:         context.Background(),
-		usedSlots:   bitset.New(maxDevices),
-		slots:       make(chan DeviceSlot, maxSlotsReady),
-		slotCounter: counter,
+		ctx:              context.Background(),
+		usedSlots:        bitset.New(maxDevices),
+		limit:            min(initialDevices, maxDevices),
+		maxDevices:       maxDevices,
+		released:         make(chan struct{}, 1),
+		slots:            make(chan DeviceSlot, maxSlotsReady),
+		slotCounter:      counter,
+		waitingCounter:   waitingCounter,
+		exhaustedCounter: exhaustedCounter,
+	}
+
+	_, err = meters.GetGaugeFloat(meters.NBDPoolUtilizationMeterName, func(ctx context.Context, observer metric.Float64Observer) error {
+		observer.Observe(pool.utilization())
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nbd pool utilization gauge: %w", err)
 	}
 
 	go func() {
-		err = pool.Populate()
+		err := pool.Populate()
 		if err != nil {
 			log.Fatalf("failed during populating device pool: %v\n", err)
 		}
 	}()
 
-	Pool = pool
+	return pool, nil
 }
 
 func getMaxDevices() (uint, error) {
@@ -120,17 +180,43 @@ func (d *DevicePool) Populate() error {
 		default:
 			device, err := d.getFreeDeviceSlot()
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "[nbd pool]: failed to create network: %v\n", err)
+				if errors.Is(err, ErrNoFreeSlots{}) {
+					d.exhausted.Store(true)
+				} else {
+					fmt.Fprintf(os.Stderr, "[nbd pool]: failed to get free device: %v\n", err)
+				}
+
+				// Wait for a device to be released instead of busy looping
+				select {
+				case <-d.ctx.Done():
+				case <-d.released:
+				case <-time.After(exhaustedRetryInterval):
+				}
 
 				continue
 			}
 
+			d.exhausted.Store(false)
+
 			d.slotCounter.Add(d.ctx, 1)
 			d.slots <- *device
 		}
 	}
 }
 
+// Saturated returns true if all devices up to the host limit are used and there is no device ready,
+// the new sandboxes would have to wait for a device to be released.
+func (d *DevicePool) Saturated() bool {
+	return d.exhausted.Load() && len(d.slots) == 0
+}
+
+func (d *DevicePool) utilization() float64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return float64(d.usedSlots.Count()) / float64(d.maxDevices)
+}
+
 // The following files and resources are useful for checking if the device is free:
 // /sys/devices/virtual/block/nbdX/pid
 // /sys/block/nbdX/pid
@@ -177,8 +263,17 @@ func (d *DevicePool) getMaybeEmptySlot(start DeviceSlot) (DeviceSlot, func(), bo
 
 	slot, ok := d.usedSlots.NextClear(uint(start))
 
-	if !ok {
-		return 0, func() {}, false
+	// All devices in the pool are used, the pool grows up to the host limit
+	for !ok || slot >= d.limit {
+		if d.limit >= d.maxDevices {
+			return 0, func() {}, false
+		}
+
+		d.limit = min(d.limit*2, d.maxDevices)
+
+		log.Printf("[nbd pool]: all devices are used, growing the pool to %d devices", d.limit)
+
+		slot, ok = d.usedSlots.NextClear(uint(start))
 	}
 
 	d.usedSlots.Set(slot)
@@ -225,16 +320,33 @@ func (d *DevicePool) getFreeDeviceSlot() (*DeviceSlot, error) {
 	}
 }
 
-// Get device slot if there is one available.
+// GetDevice returns a free device slot. If there is no device ready, the request waits in the queue
+// until a device is released, the waiting is limited by the deviceWaitTimeout and ErrNoFreeSlots is returned after it.
 func (d *DevicePool) GetDevice(ctx context.Context) (DeviceSlot, error) {
-	for {
-		select {
-		case <-ctx.Done():
-			return 0, ctx.Err()
-		default:
-		}
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case slot := <-d.slots:
+		d.slotCounter.Add(d.ctx, -1)
+
+		return slot, nil
+	default:
+	}
 
-		slot := <-d.slots
+	d.waitingCounter.Add(d.ctx, 1)
+	defer d.waitingCounter.Add(d.ctx, -1)
+
+	timer := time.NewTimer(deviceWaitTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-timer.C:
+		d.exhaustedCounter.Add(d.ctx, 1)
+
+		return 0, ErrNoFreeSlots{}
+	case slot := <-d.slots:
 		d.slotCounter.Add(d.ctx, -1)
 
 		return slot, nil
@@ -256,6 +368,11 @@ func (d *DevicePool) ReleaseDevice(idx DeviceSlot) error {
 	d.usedSlots.Clear(uint(idx))
 	d.mu.Unlock()
 
+	select {
+	case d.released <- struct{}{}:
+	default:
+	}
+
 	return nil
 }
 
@@ -0,0 +1,208 @@
+package network
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+)
+
+// conntrackAcctPath enables counting the bytes of the connections in the namespace it's written in.
+const conntrackAcctPath = "/proc/sys/net/netfilter/nf_conntrack_acct"
+
+// maxActivityDestinations bounds the summary of the sandbox that connects to many addresses, the new addresses aren't added over it.
+const maxActivityDestinations = 1000
+
+// Destination is the summary of the connections of the sandbox to one address.
+type Destination struct {
+	IP       string
+	Port     uint16
+	Protocol string
+	// Connections is the number of the connections seen, the connections shorter than the sampling interval can be missed.
+	Connections   int64
+	BytesSent     uint64
+	BytesReceived uint64
+	LastSeen      time.Time
+}
+
+type destinationKey struct {
+	protocol uint8
+	ip       string
+	port     uint16
+}
+
+type flowKey struct {
+	destinationKey
+
+	srcPort uint16
+}
+
+type flowBytes struct {
+	sent     uint64
+	received uint64
+}
+
+// Activity summarizes the connections the sandbox made by the destination. The connections are sampled from the conntrack table
+// of the sandbox namespace, so the summary includes only the connections open at the time of a sample.
+type Activity struct {
+	slot *Slot
+
+	mu           sync.Mutex
+	destinations map[destinationKey]*Destination
+	// flows are the bytes of the connections open at the last sample, only the difference is added in the next sample.
+	flows map[flowKey]flowBytes
+	// ignored are the connections that were open before the sandbox started, e.g. of the previous sandbox in the reused slot.
+	ignored map[flowKey]struct{}
+}
+
+// NewActivity starts the summary of the connections of the sandbox in the slot, the connections open now are ignored.
+func NewActivity(slot *Slot) *Activity {
+	a := &Activity{
+		slot:         slot,
+		destinations: make(map[destinationKey]*Destination),
+		flows:        make(map[flowKey]flowBytes),
+		ignored:      make(map[flowKey]struct{}),
+	}
+
+	flows, err := slot.conntrackFlows()
+	if err == nil {
+		for _, flow := range flows {
+			a.ignored[a.key(flow)] = struct{}{}
+		}
+	}
+
+	return a
+}
+
+func (a *Activity) key(flow *netlink.ConntrackFlow) flowKey {
+	return flowKey{
+		destinationKey: destinationKey{
+			protocol: flow.Forward.Protocol,
+			ip:       flow.Forward.DstIP.String(),
+			port:     flow.Forward.DstPort,
+		},
+		srcPort: flow.Forward.SrcPort,
+	}
+}
+
+// Sample adds the connections open now to the summary.
+func (a *Activity) Sample() error {
+	flows, err := a.slot.conntrackFlows()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	current := make(map[flowKey]flowBytes, len(flows))
+	open := make(map[flowKey]struct{}, len(flows))
+
+	for _, flow := range flows {
+		key := a.key(flow)
+		open[key] = struct{}{}
+
+		// Only the connections made by the sandbox are summarized, not the incoming traffic
+		if _, ok := a.ignored[key]; ok || flow.Forward.SrcIP.String() != a.slot.NamespaceIP() {
+			continue
+		}
+
+		destination, ok := a.destinations[key.destinationKey]
+		if !ok {
+			if len(a.destinations) >= maxActivityDestinations {
+				continue
+			}
+
+			destination = &Destination{
+				IP:       key.ip,
+				Port:     key.port,
+				Protocol: protocolName(key.protocol),
+			}
+			a.destinations[key.destinationKey] = destination
+		}
+
+		bytes := flowBytes{sent: flow.Forward.Bytes, received: flow.Reverse.Bytes}
+
+		previous, seen := a.flows[key]
+		if !seen {
+			destination.Connections++
+		}
+
+		// The counters restart if the connection was closed and opened again with the same ports between the samples
+		if bytes.sent >= previous.sent && bytes.received >= previous.received {
+			destination.BytesSent += bytes.sent - previous.sent
+			destination.BytesReceived += bytes.received - previous.received
+		} else {
+			destination.BytesSent += bytes.sent
+			destination.BytesReceived += bytes.received
+		}
+
+		destination.LastSeen = now
+		current[key] = bytes
+	}
+
+	a.flows = current
+
+	// The connection with the ports of the ignored one is new once the ignored one is closed
+	for key := range a.ignored {
+		if _, ok := open[key]; !ok {
+			delete(a.ignored, key)
+		}
+	}
+
+	return nil
+}
+
+// Destinations returns the summary of the connections by the destination.
+func (a *Activity) Destinations() []Destination {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	destinations := make([]Destination, 0, len(a.destinations))
+	for _, destination := range a.destinations {
+		destinations = append(destinations, *destination)
+	}
+
+	return destinations
+}
+
+// conntrackFlows lists the connections tracked in the namespace of the slot.
+func (s *Slot) conntrackFlows() ([]*netlink.ConntrackFlow, error) {
+	ns, err := netns.GetFromName(s.NamespaceID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %s: %w", s.NamespaceID(), err)
+	}
+	defer ns.Close()
+
+	handle, err := netlink.NewHandleAt(ns, unix.NETLINK_NETFILTER)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create netlink handle in namespace %s: %w", s.NamespaceID(), err)
+	}
+	defer handle.Close()
+
+	flows, err := handle.ConntrackTableList(netlink.ConntrackTable, unix.AF_INET)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connections in namespace %s: %w", s.NamespaceID(), err)
+	}
+
+	return flows, nil
+}
+
+func protocolName(protocol uint8) string {
+	switch protocol {
+	case unix.IPPROTO_TCP:
+		return "tcp"
+	case unix.IPPROTO_UDP:
+		return "udp"
+	case unix.IPPROTO_ICMP:
+		return "icmp"
+	default:
+		return strconv.Itoa(int(protocol))
+	}
+}
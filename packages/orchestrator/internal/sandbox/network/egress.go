@@ -0,0 +1,157 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/vishvananda/netns"
+)
+
+// egressChain filters the outbound traffic of the sandbox in its namespace, it exists only while the sandbox has the egress policy.
+const egressChain = "EGRESS"
+
+// egressResolveTimeout limits the resolution of the allowed hosts when the sandbox starts.
+const egressResolveTimeout = 5 * time.Second
+
+// SandboxNameserver is the DNS resolver configured in the sandboxes, it's the only DNS server the egress policy allows.
+const SandboxNameserver = "8.8.8.8"
+
+func getEgressJumpRule(slot *Slot) []string {
+	return []string{"-i", slot.TapName(), "-j", egressChain}
+}
+
+// SetEgressPolicy allows the outbound traffic of the sandbox only to the hosts and to DNS queries to the sandbox nameserver.
+// The hosts are resolved once, when the policy is set, and only the addresses returned then are allowed. The hosts that
+// move to other addresses later, e.g. the hosts behind CDNs that return different addresses for each query,
+// are reachable only at the addresses from the resolution.
+func (s *Slot) SetEgressPolicy(ctx context.Context, allowedHosts []string) error {
+	ips, err := resolveHosts(ctx, allowedHosts)
+	if err != nil {
+		return err
+	}
+
+	return s.inNamespace(func(tables *iptables.IPTables) error {
+		err := tables.NewChain("filter", egressChain)
+		if err != nil {
+			return fmt.Errorf("error creating egress chain: %w", err)
+		}
+
+		rules := [][]string{
+			{"-d", SandboxNameserver, "-p", "udp", "--dport", "53", "-j", "ACCEPT"},
+			{"-d", SandboxNameserver, "-p", "tcp", "--dport", "53", "-j", "ACCEPT"},
+		}
+
+		for _, ip := range ips {
+			rules = append(rules, []string{"-d", ip, "-j", "ACCEPT"})
+		}
+
+		rules = append(rules, []string{"-j", "DROP"})
+
+		for _, rule := range rules {
+			err = tables.Append("filter", egressChain, rule...)
+			if err != nil {
+				return fmt.Errorf("error adding egress rule: %w", err)
+			}
+		}
+
+		// The jump is after the blocking rules, the responses to the inbound connections are accepted before it
+		err = tables.Append("filter", "FORWARD", getEgressJumpRule(s)...)
+		if err != nil {
+			return fmt.Errorf("error adding egress jump rule: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// RemoveEgressPolicy allows all the outbound traffic again, so the slot can be reused by the sandbox without the policy.
+func (s *Slot) RemoveEgressPolicy() error {
+	return s.inNamespace(func(tables *iptables.IPTables) error {
+		var errs []error
+
+		err := tables.DeleteIfExists("filter", "FORWARD", getEgressJumpRule(s)...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error deleting egress jump rule: %w", err))
+		}
+
+		err = tables.ClearAndDeleteChain("filter", egressChain)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error deleting egress chain: %w", err))
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+// inNamespace runs the function with the iptables of the slot namespace.
+func (s *Slot) inNamespace(fn func(tables *iptables.IPTables) error) error {
+	// Prevent thread changes so the iptables commands are started in the namespace
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	hostNS, err := netns.Get()
+	if err != nil {
+		return fmt.Errorf("cannot get current (host) namespace: %w", err)
+	}
+
+	defer func() {
+		err = netns.Set(hostNS)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error resetting network namespace back to the host namespace: %v", err)
+		}
+
+		err = hostNS.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error closing host network namespace: %v", err)
+		}
+	}()
+
+	ns, err := netns.GetFromName(s.NamespaceID())
+	if err != nil {
+		return fmt.Errorf("failed to get namespace %s: %w", s.NamespaceID(), err)
+	}
+	defer ns.Close()
+
+	err = netns.Set(ns)
+	if err != nil {
+		return fmt.Errorf("error setting network namespace to %s: %w", s.NamespaceID(), err)
+	}
+
+	tables, err := iptables.New()
+	if err != nil {
+		return fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	return fn(tables)
+}
+
+// resolveHosts returns the IPv4 addresses of the hosts, the host that can't be resolved fails the policy,
+// so the typo in the policy isn't silently ignored.
+func resolveHosts(ctx context.Context, hosts []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, egressResolveTimeout)
+	defer cancel()
+
+	var ips []string
+
+	for _, host := range hosts {
+		addrs, err := net.DefaultResolver.LookupIP(ctx, "ip4", host)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve allowed host '%s': %w", host, err)
+		}
+
+		for _, addr := range addrs {
+			ips = append(ips, addr.String())
+		}
+	}
+
+	slices.Sort(ips)
+
+	return slices.Compact(ips), nil
+}
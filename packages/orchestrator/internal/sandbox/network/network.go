@@ -167,6 +167,12 @@ func (s *Slot) CreateNetwork() error {
 		return fmt.Errorf("error setting lo device up: %w", err)
 	}
 
+	// Count the bytes of the connections for the network activity summary, the summary only lacks the bytes if it fails
+	err = os.WriteFile(conntrackAcctPath, []byte("1"), 0o644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error enabling connection accounting in namespace %s: %v\n", s.NamespaceID(), err)
+	}
+
 	// Add NS default route
 	err = netlink.RouteAdd(&netlink.Route{
 		Scope: netlink.SCOPE_UNIVERSE,
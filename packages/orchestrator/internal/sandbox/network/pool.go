@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/chaos"
 	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -16,6 +20,12 @@ import (
 const (
 	NewSlotsPoolSize    = 32
 	ReusedSlotsPoolSize = 100
+
+	// populateShards is the number of the namespaces created in parallel, the creation of a single namespace takes tens of milliseconds.
+	populateShards = 8
+
+	// populateRetryInterval is the delay after the slot creation failed, so the failing shard doesn't busy loop.
+	populateRetryInterval = 100 * time.Millisecond
 )
 
 type Pool struct {
@@ -26,6 +36,9 @@ type Pool struct {
 	reusedSlots       chan Slot
 	newSlotCounter    metric.Int64UpDownCounter
 	reusedSlotCounter metric.Int64UpDownCounter
+	waitHistogram     metric.Float64Histogram
+
+	populating sync.WaitGroup
 }
 
 func NewPool(ctx context.Context, newSlotsPoolSize, reusedSlotsPoolSize int) (*Pool, error) {
@@ -42,21 +55,40 @@ func NewPool(ctx context.Context, newSlotsPoolSize, reusedSlotsPoolSize int) (*P
 		return nil, fmt.Errorf("failed to create reused slot counter: %w", err)
 	}
 
+	waitHistogram, err := meters.GetHistogram(meters.NetworkSlotWaitMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create slot wait histogram: %w", err)
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	pool := &Pool{
 		newSlots:          newSlots,
 		reusedSlots:       reusedSlots,
 		newSlotCounter:    newSlotCounter,
 		reusedSlotCounter: reusedSlotsCounter,
+		waitHistogram:     waitHistogram,
 		ctx:               ctx,
 		cancel:            cancel,
 	}
 
+	// The new slots are created in parallel shards, the pool would be a bottleneck under churn otherwise
+	for shard := 0; shard < min(populateShards, max(newSlotsPoolSize, 1)); shard++ {
+		pool.populating.Add(1)
+
+		go func() {
+			defer pool.populating.Done()
+
+			err := pool.populate(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Fatalf("error when populating network slot pool: %v\n", err)
+			}
+		}()
+	}
+
 	go func() {
-		err := pool.populate(ctx)
-		if err != nil {
-			log.Fatalf("error when populating network slot pool: %v\n", err)
-		}
+		pool.populating.Wait()
+
+		close(newSlots)
 	}()
 
 	return pool, nil
@@ -89,36 +121,73 @@ func (p *Pool) populate(ctx context.Context) error {
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "[network slot pool]: failed to create network: %v\n", err)
 
+				time.Sleep(populateRetryInterval)
+
 				continue
 			}
 
-			p.newSlotCounter.Add(ctx, 1)
-			p.newSlots <- *slot
+			select {
+			case <-ctx.Done():
+				cleanupErr := cleanup(*slot)
+				if cleanupErr != nil {
+					fmt.Fprintf(os.Stderr, "[network slot pool]: %v\n", cleanupErr)
+				}
+
+				return ctx.Err()
+			case p.newSlots <- *slot:
+				p.newSlotCounter.Add(ctx, 1)
+			}
 		}
 	}
 }
 
 func (p *Pool) Get(ctx context.Context) (Slot, error) {
+	err := chaos.Inject(chaos.NetworkSlot)
+	if err != nil {
+		return Slot{}, err
+	}
+
+	start := time.Now()
+
+	// The reused slots are preferred, their namespace and iptables rules were already set up
 	select {
 	case slot := <-p.reusedSlots:
 		p.reusedSlotCounter.Add(ctx, -1)
+		p.recordWait(ctx, start, true)
 		telemetry.ReportEvent(ctx, "reused network slot")
 
 		return slot, nil
 	default:
-		select {
-		case <-ctx.Done():
-			return Slot{}, ctx.Err()
-		case slot := <-p.newSlots:
-			p.newSlotCounter.Add(ctx, -1)
-			telemetry.ReportEvent(ctx, "new network slot")
+	}
 
-			return slot, nil
+	select {
+	case <-ctx.Done():
+		return Slot{}, ctx.Err()
+	case slot := <-p.reusedSlots:
+		p.reusedSlotCounter.Add(ctx, -1)
+		p.recordWait(ctx, start, true)
+		telemetry.ReportEvent(ctx, "reused network slot")
+
+		return slot, nil
+	case slot, ok := <-p.newSlots:
+		if !ok {
+			return Slot{}, fmt.Errorf("network slot pool is closed")
 		}
+
+		p.newSlotCounter.Add(ctx, -1)
+		p.recordWait(ctx, start, false)
+		telemetry.ReportEvent(ctx, "new network slot")
+
+		return slot, nil
 	}
 }
 
+func (p *Pool) recordWait(ctx context.Context, start time.Time, reused bool) {
+	p.waitHistogram.Record(ctx, float64(time.Since(start).Microseconds())/1000, metric.WithAttributes(attribute.Bool("reused", reused)))
+}
+
 func (p *Pool) Return(slot Slot) error {
+	// The slot is reused as is, the namespace, veth and iptables rules are the same for every sandbox
 	select {
 	case p.reusedSlots <- slot:
 		p.reusedSlotCounter.Add(context.Background(), 1)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"slices"
+	"sync"
 
 	consulApi "github.com/hashicorp/consul/api"
 
@@ -22,6 +23,12 @@ const (
 	tapMask  = 30
 )
 
+// localSlots are the slots reserved on the node without Consul, there is only one orchestrator on the node then.
+var localSlots = struct {
+	sync.Mutex
+	reserved map[int]bool
+}{reserved: make(map[int]bool)}
+
 type Slot struct {
 	Key string
 	Idx int
@@ -107,6 +114,10 @@ func (s *Slot) TapCIDR() string {
 }
 
 func NewSlot() (*Slot, error) {
+	if consul.Client == nil {
+		return newLocalSlot()
+	}
+
 	kv := consul.Client.KV()
 
 	var slot *Slot
@@ -183,6 +194,10 @@ func NewSlot() (*Slot, error) {
 }
 
 func (ips *Slot) Release() error {
+	if consul.Client == nil {
+		return ips.releaseLocal()
+	}
+
 	kv := consul.Client.KV()
 
 	pair, _, err := kv.Get(ips.Key, nil)
@@ -212,3 +227,39 @@ func (ips *Slot) Release() error {
 func getKVKey(slotIdx int) string {
 	return fmt.Sprintf("%s/%d", consul.ClientID, slotIdx)
 }
+
+func newLocalSlot() (*Slot, error) {
+	localSlots.Lock()
+	defer localSlots.Unlock()
+
+	// Random start, so the slots of the removed sandboxes aren't reused right away
+	start := rand.Intn(slotsSize)
+	for i := 0; i < slotsSize; i++ {
+		slotIdx := (start + i) % slotsSize
+		if localSlots.reserved[slotIdx] {
+			continue
+		}
+
+		localSlots.reserved[slotIdx] = true
+
+		return &Slot{
+			Idx: slotIdx,
+			Key: getKVKey(slotIdx),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("failed to acquire IP slot: no empty slots found")
+}
+
+func (ips *Slot) releaseLocal() error {
+	localSlots.Lock()
+	defer localSlots.Unlock()
+
+	if !localSlots.reserved[ips.Idx] {
+		return fmt.Errorf("IP slot %d was already released", ips.Idx)
+	}
+
+	delete(localSlots.reserved, ips.Idx)
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package sandbox
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bits-and-blooms/bitset"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/time/rate"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/uffd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/watchdog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/prefetch"
+)
+
+const (
+	defaultPrefetchRateMB = 64
+
+	// prefetchManifestWait is how long the prefetch waits for the manifest of the snapshot to load.
+	prefetchManifestWait = 5 * time.Second
+
+	// prefetchPressure is the memory pressure the prefetch is deferred at, it's below the pressure the node is degraded at,
+	// so the prefetch stops evicting the page cache before the node stops accepting the sandboxes.
+	prefetchPressure      = 5
+	prefetchPressureCheck = time.Second
+	prefetchDeferDelay    = 5 * time.Second
+)
+
+// prefetchRate is the limit of the memfile prefetch of a sandbox in bytes per second.
+var prefetchRate = func() int64 {
+	if value := os.Getenv("MEMFILE_PREFETCH_RATE_MB"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err == nil && parsed >= 0 {
+			return parsed << 20
+		}
+
+		log.Printf("invalid MEMFILE_PREFETCH_RATE_MB '%s', using %d", value, defaultPrefetchRateMB)
+	}
+
+	return defaultPrefetchRateMB << 20
+}()
+
+type prefetchMeters struct {
+	blocks   metric.Int64Counter
+	deferred metric.Int64Counter
+}
+
+var (
+	prefetchMetersOnce sync.Once
+	prefetchMetrics    *prefetchMeters
+)
+
+// getPrefetchMeters returns the prefetch meters, nil if the meters can't be created, the prefetch isn't measured then.
+func getPrefetchMeters() *prefetchMeters {
+	prefetchMetersOnce.Do(func() {
+		blocks, err := meters.GetCounter(meters.MemfilePrefetchBlocksMeterName)
+		if err != nil {
+			log.Printf("failed to create memfile prefetch blocks counter: %v", err)
+
+			return
+		}
+
+		deferred, err := meters.GetCounter(meters.MemfilePrefetchDeferMeterName)
+		if err != nil {
+			log.Printf("failed to create memfile prefetch deferred counter: %v", err)
+
+			return
+		}
+
+		prefetchMetrics = &prefetchMeters{
+			blocks:   blocks,
+			deferred: deferred,
+		}
+	})
+
+	return prefetchMetrics
+}
+
+// memfilePrefetcher prefetches the blocks of the memfile of the resumed snapshot to the cache in the order of the rank
+// from the prefetch manifest, the blocks the previous resumes faulted most often first. The prefetch is rate limited
+// per sandbox and deferred under the host memory pressure, so it doesn't evict the page cache the running sandboxes use.
+type memfilePrefetcher struct {
+	memfile *template.Storage
+	uffd    *uffd.Uffd
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	stopOnce sync.Once
+
+	mu sync.Mutex
+	// prefetched are the blocks prefetched before the sandbox faulted them.
+	prefetched *bitset.BitSet
+	blockSize  int64
+}
+
+func startMemfilePrefetch(memfile *template.Storage, fcUffd *uffd.Uffd) *memfilePrefetcher {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p := &memfilePrefetcher{
+		memfile:    memfile,
+		uffd:       fcUffd,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		prefetched: bitset.New(0),
+	}
+
+	go func() {
+		defer close(p.done)
+
+		p.run(ctx)
+	}()
+
+	return p
+}
+
+func (p *memfilePrefetcher) run(ctx context.Context) {
+	if prefetchRate == 0 {
+		return
+	}
+
+	m := p.waitForManifest(ctx)
+	if m == nil {
+		return
+	}
+
+	blockSize := int64(m.BlockSize)
+
+	size, err := p.memfile.Size()
+	if err != nil || blockSize != int64(p.memfile.Header().Metadata.BlockSize) {
+		return
+	}
+
+	p.mu.Lock()
+	p.blockSize = blockSize
+	p.mu.Unlock()
+
+	limiter := rate.NewLimiter(rate.Limit(prefetchRate), int(max(prefetchRate, blockSize)))
+
+	var lastCheck time.Time
+
+	for _, b := range m.Blocks {
+		off := int64(b.Index) * blockSize
+		if off >= size || p.uffd.IsFaulted(off) {
+			continue
+		}
+
+		if time.Since(lastCheck) >= prefetchPressureCheck {
+			if !p.waitForPressure(ctx) {
+				return
+			}
+
+			lastCheck = time.Now()
+		}
+
+		err := limiter.WaitN(ctx, int(blockSize))
+		if err != nil {
+			return
+		}
+
+		err = p.memfile.Prefetch(off, blockSize)
+		if err != nil {
+			log.Printf("failed to prefetch memfile at %d-%d: %v", off, off+blockSize, err)
+
+			return
+		}
+
+		p.mu.Lock()
+		p.prefetched.Set(uint(b.Index))
+		p.mu.Unlock()
+	}
+}
+
+// waitForManifest returns the manifest of the snapshot, nil if the snapshot has none or the manifest isn't loaded in time.
+func (p *memfilePrefetcher) waitForManifest(ctx context.Context) *prefetch.Manifest {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	deadline := time.After(prefetchManifestWait)
+
+	for {
+		if m := p.memfile.PrefetchManifest(); m != nil {
+			return m
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-deadline:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForPressure waits until the memory pressure of the host is below the prefetch pressure, false if the context is canceled.
+func (p *memfilePrefetcher) waitForPressure(ctx context.Context) bool {
+	for {
+		pressure, err := watchdog.MemoryPressure()
+		if err != nil || pressure < prefetchPressure {
+			return true
+		}
+
+		if m := getPrefetchMeters(); m != nil {
+			m.deferred.Add(ctx, 1)
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(prefetchDeferDelay):
+		}
+	}
+}
+
+// stop stops the prefetch and records the prefetched blocks the sandbox faulted as useful and the rest as wasted.
+// It must be called before the snapshot of the memory is taken, the snapshot faults all the blocks.
+func (p *memfilePrefetcher) stop(ctx context.Context) {
+	p.stopOnce.Do(func() {
+		p.cancel()
+		<-p.done
+
+		m := getPrefetchMeters()
+		if m == nil {
+			return
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		var useful, wasted int64
+		for i, ok := p.prefetched.NextSet(0); ok; i, ok = p.prefetched.NextSet(i + 1) {
+			if p.uffd.IsFaulted(int64(i) * p.blockSize) {
+				useful++
+			} else {
+				wasted++
+			}
+		}
+
+		m.blocks.Add(ctx, useful, metric.WithAttributes(attribute.String("outcome", "useful")))
+		m.blocks.Add(ctx, wasted, metric.WithAttributes(attribute.String("outcome", "wasted")))
+	})
+}
@@ -0,0 +1,169 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+)
+
+const (
+	// processUser is the user the commands are executed as, the same as the default user in the SDK.
+	processUser = "user"
+
+	// maxProcessOutput is the maximum length of the process output included in the error.
+	maxProcessOutput = 1024
+
+	// Envelope flags of the Connect streaming protocol.
+	connectFlagEndStream = 0x02
+
+	// maxProcessEventSize is the largest envelope accepted from envd, the length is set by the guest.
+	maxProcessEventSize = 1 << 20
+)
+
+// processClient has no timeout, the commands can run for longer than the other envd requests, the context is used instead.
+var processClient = http.Client{}
+
+type processStartRequest struct {
+	Process processConfig `json:"process"`
+}
+
+type processConfig struct {
+	Cmd  string   `json:"cmd"`
+	Args []string `json:"args"`
+}
+
+type processStartResponse struct {
+	Event struct {
+		Data *struct {
+			Stdout []byte `json:"stdout"`
+			Stderr []byte `json:"stderr"`
+		} `json:"data"`
+		End *struct {
+			ExitCode int32   `json:"exitCode"`
+			Exited   bool    `json:"exited"`
+			Status   string  `json:"status"`
+			Error    *string `json:"error"`
+		} `json:"end"`
+	} `json:"event"`
+}
+
+type connectEndStream struct {
+	Error *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// runCommand starts the command in the sandbox via the envd process service and waits for its exit code.
+// The request uses the Connect streaming protocol with JSON encoding, so the orchestrator doesn't need the envd client.
+func (s *Sandbox) runCommand(ctx context.Context, command string) error {
+	address := fmt.Sprintf("http://%s:%d/process.Process/Start", s.Slot.HostIP(), consts.DefaultEnvdServerPort)
+
+	message, err := json.Marshal(processStartRequest{
+		Process: processConfig{
+			Cmd:  "/bin/bash",
+			Args: []string{"-l", "-c", command},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	body.WriteByte(0)
+	binary.Write(&body, binary.BigEndian, uint32(len(message)))
+	body.Write(message)
+
+	request, err := http.NewRequestWithContext(ctx, "POST", address, &body)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/connect+json")
+	request.Header.Set("Connect-Protocol-Version", "1")
+	request.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(processUser+":")))
+
+	response, err := processClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	// Only the end of the output is kept, it usually contains the reason of the failure
+	var output []byte
+
+	header := make([]byte, 5)
+	for {
+		_, err = io.ReadFull(response.Body, header)
+		if err != nil {
+			return fmt.Errorf("failed to read the process event: %w", err)
+		}
+
+		size := binary.BigEndian.Uint32(header[1:])
+		if size > maxProcessEventSize {
+			return fmt.Errorf("process event of %d bytes exceeds the limit of %d bytes", size, maxProcessEventSize)
+		}
+
+		payload := make([]byte, size)
+		_, err = io.ReadFull(response.Body, payload)
+		if err != nil {
+			return fmt.Errorf("failed to read the process event: %w", err)
+		}
+
+		if header[0]&connectFlagEndStream != 0 {
+			var end connectEndStream
+			err = json.Unmarshal(payload, &end)
+			if err != nil {
+				return fmt.Errorf("failed to parse the end of the stream: %w", err)
+			}
+
+			if end.Error != nil {
+				return fmt.Errorf("envd error [%s]: %s", end.Error.Code, end.Error.Message)
+			}
+
+			return errors.New("process stream ended without the exit code")
+		}
+
+		var event processStartResponse
+		err = json.Unmarshal(payload, &event)
+		if err != nil {
+			return fmt.Errorf("failed to parse the process event: %w", err)
+		}
+
+		if data := event.Event.Data; data != nil {
+			output = append(output, data.Stdout...)
+			output = append(output, data.Stderr...)
+
+			if len(output) > maxProcessOutput {
+				output = output[len(output)-maxProcessOutput:]
+			}
+		}
+
+		end := event.Event.End
+		if end == nil {
+			continue
+		}
+
+		if end.Error != nil {
+			return fmt.Errorf("process error: %s", *end.Error)
+		}
+
+		if end.ExitCode != 0 {
+			return fmt.Errorf("process exited with code %d: %s", end.ExitCode, bytes.TrimSpace(output))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,104 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	defaultReadinessTimeout = 60 * time.Second
+	readinessProbeInterval  = 500 * time.Millisecond
+	readinessAttemptTimeout = 5 * time.Second
+)
+
+// waitForReadiness runs the readiness probe until it succeeds or the probe timeout is reached.
+func (s *Sandbox) waitForReadiness(ctx context.Context, tracer trace.Tracer, probe *orchestrator.ReadinessProbe) error {
+	childCtx, childSpan := tracer.Start(ctx, "wait-for-readiness")
+	defer childSpan.End()
+
+	timeout := defaultReadinessTimeout
+	if probe.TimeoutSeconds > 0 {
+		timeout = time.Duration(probe.TimeoutSeconds) * time.Second
+	}
+
+	probeCtx, cancel := context.WithTimeout(childCtx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(readinessProbeInterval)
+	defer ticker.Stop()
+
+	attempts := 0
+	for {
+		attempts++
+
+		err := s.probeReadiness(probeCtx, probe)
+		if err == nil {
+			telemetry.ReportEvent(childCtx, "sandbox is ready", attribute.Int("attempts", attempts))
+
+			return nil
+		}
+
+		select {
+		case <-probeCtx.Done():
+			return fmt.Errorf("sandbox is not ready after %s (%d attempts): %w", timeout, attempts, err)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Sandbox) probeReadiness(ctx context.Context, probe *orchestrator.ReadinessProbe) error {
+	attemptCtx, cancel := context.WithTimeout(ctx, readinessAttemptTimeout)
+	defer cancel()
+
+	if probe.Command != nil {
+		err := s.runCommand(attemptCtx, probe.GetCommand())
+		if err != nil {
+			return fmt.Errorf("readiness command failed: %w", err)
+		}
+	}
+
+	if probe.HttpPort != nil {
+		err := s.probeHTTP(attemptCtx, probe.GetHttpPort(), probe.GetHttpPath())
+		if err != nil {
+			return fmt.Errorf("readiness HTTP probe failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Sandbox) probeHTTP(ctx context.Context, port int32, path string) error {
+	address := fmt.Sprintf("http://%s:%d/%s", s.Slot.HostIP(), port, strings.TrimPrefix(path, "/"))
+
+	request, err := http.NewRequestWithContext(ctx, "GET", address, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	_, err = io.Copy(io.Discard, response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	return nil
+}
@@ -39,7 +39,7 @@ func NewCowDevice(rootfs *template.Storage, cachePath string, blockSize int64) (
 		return nil, fmt.Errorf("error creating cache: %w", err)
 	}
 
-	overlay := block.NewOverlay(rootfs, cache, blockSize)
+	overlay := block.NewOverlay(newReadAhead(rootfs, size), cache, blockSize)
 
 	mnt := nbd.NewDirectPathMount(overlay)
 
@@ -91,6 +91,15 @@ func (o *CowDevice) Export(ctx context.Context, out io.Writer, stopSandbox func(
 	return dirty, nil
 }
 
+// ChangedSince returns the rootfs blocks written after the given generation and the current generation.
+func (o *CowDevice) ChangedSince(generation uint64) (*bitset.BitSet, uint64, error) {
+	return o.overlay.ChangedSince(generation)
+}
+
+func (o *CowDevice) BlockSize() int64 {
+	return o.blockSize
+}
+
 func (o *CowDevice) Close() error {
 	var errs []error
 
@@ -0,0 +1,124 @@
+package rootfs
+
+import (
+	"log"
+	"sync"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/layout"
+)
+
+const (
+	// readAheadLimit is the most of the file data fetched ahead of the read of the file.
+	readAheadLimit = 32 * 1024 * 1024
+	// maxReadAheads is the number of the concurrent read aheads of the device, the read aheads over it are dropped.
+	maxReadAheads = 4
+)
+
+// readAhead reads the rootfs of the template and fetches the rest of the file the guest reads from the storage
+// in the background, so the sequential reads of the file don't wait for the storage one chunk at a time.
+// The files are found by the layout of the rootfs parsed when the template was built.
+type readAhead struct {
+	*template.Storage
+
+	size int64
+
+	mu sync.Mutex
+	// fetched are the chunks of the device the read ahead was already started for.
+	fetched *bitset.BitSet
+
+	running chan struct{}
+}
+
+func newReadAhead(storage *template.Storage, size int64) *readAhead {
+	return &readAhead{
+		Storage: storage,
+		size:    size,
+		fetched: bitset.New(uint(header.TotalBlocks(size, block.ChunkSize))),
+		running: make(chan struct{}, maxReadAheads),
+	}
+}
+
+func (r *readAhead) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.Storage.ReadAt(p, off)
+	if err != nil {
+		return n, err
+	}
+
+	r.start(off)
+
+	return n, nil
+}
+
+func (r *readAhead) start(off int64) {
+	l := r.Layout()
+	if l == nil {
+		return
+	}
+
+	extents := l.ReadAhead(off, readAheadLimit)
+	if len(extents) == 0 {
+		return
+	}
+
+	// Only the chunks the read ahead wasn't started for are fetched, the adjacent chunks are fetched at once
+	r.mu.Lock()
+	var pending []layout.Extent
+	for _, e := range extents {
+		for chunk := header.BlockIdx(e.Offset, block.ChunkSize); chunk*block.ChunkSize < e.Offset+e.Length; chunk++ {
+			if r.fetched.Test(uint(chunk)) {
+				continue
+			}
+
+			r.fetched.Set(uint(chunk))
+
+			off := header.BlockOffset(chunk, block.ChunkSize)
+			if last := len(pending) - 1; last >= 0 && pending[last].Offset+pending[last].Length == off {
+				pending[last].Length += block.ChunkSize
+
+				continue
+			}
+
+			pending = append(pending, layout.Extent{Offset: off, Length: block.ChunkSize})
+		}
+	}
+	r.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	select {
+	case r.running <- struct{}{}:
+	default:
+		// The chunks are fetched by the reads themselves
+		r.mu.Lock()
+		for _, e := range pending {
+			for chunk := header.BlockIdx(e.Offset, block.ChunkSize); chunk*block.ChunkSize < e.Offset+e.Length; chunk++ {
+				r.fetched.Clear(uint(chunk))
+			}
+		}
+		r.mu.Unlock()
+
+		return
+	}
+
+	go func() {
+		defer func() { <-r.running }()
+
+		for _, e := range pending {
+			length := min(e.Length, r.size-e.Offset)
+
+			err := r.Prefetch(e.Offset, length)
+			if err != nil {
+				log.Printf("failed to read ahead rootfs at %d-%d: %v", e.Offset, e.Offset+length, err)
+
+				return
+			}
+		}
+	}()
+}
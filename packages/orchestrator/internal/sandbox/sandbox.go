@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -18,19 +19,26 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/dns"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/image"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/rootfs"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/scrub"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/stats"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/uffd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/slo"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/prefetch"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
+// ErrNoSnapshot is returned when the snapshot of the sandbox booted from the image is requested, it has no memory to resume from.
+var ErrNoSnapshot = errors.New("sandbox started from an image can't be snapshotted")
+
 var httpClient = http.Client{
 	Timeout: 10 * time.Second,
 }
@@ -43,9 +51,13 @@ type Sandbox struct {
 	uffd    *uffd.Uffd
 	rootfs  *rootfs.CowDevice
 
+	prefetcher *memfilePrefetcher
+
 	Config    *orchestrator.SandboxConfig
 	StartedAt time.Time
 	EndAt     time.Time
+	// Durations of the resume stages, nil when the sandbox was not resumed from a snapshot.
+	StartTimings *slo.Timings
 
 	Slot   network.Slot
 	Logger *logs.SandboxLogger
@@ -56,6 +68,13 @@ type Sandbox struct {
 	template template.Template
 
 	healthcheckCtx *utils.LockableCancelableContext
+
+	checkpoints *checkpoints
+
+	envdHealth  atomic.Pointer[envdHealth]
+	diagnostics atomic.Pointer[Diagnostics]
+
+	network *network.Activity
 }
 
 // Run cleanup functions for the already initialized resources if there is any error or after you are done with the started sandbox.
@@ -78,18 +97,81 @@ func NewSandbox(
 
 	cleanup := NewCleanup()
 
-	t, err := templateCache.GetTemplate(
-		config.TemplateId,
-		config.BuildId,
-		config.KernelVersion,
-		config.FirecrackerVersion,
-		config.HugePages,
-		isSnapshot,
+	// Only the resumes are measured, the starts from the templates don't have the same latency expectations.
+	var timings *slo.Timings
+	if isSnapshot {
+		timings = slo.NewTimings()
+	}
+
+	templateStart := time.Now()
+
+	var (
+		// t is nil for the sandboxes booted from the images, they have only the rootfs.
+		t              template.Template
+		templateFiles  *storage.TemplateCacheFiles
+		readonlyRootfs *template.Storage
+		err            error
 	)
+
+	if config.Image != nil {
+		var imageAuth *image.Auth
+		if auth := config.GetImageAuth(); auth != nil {
+			imageAuth = &image.Auth{Username: auth.GetUsername(), Password: auth.GetPassword()}
+		}
+
+		imageRootfs, imageBuildId, releaseImage, imageErr := templateCache.ImageRootfs(childCtx, config.GetImage(), imageAuth)
+		if imageErr != nil {
+			return nil, cleanup, fmt.Errorf("failed to get image rootfs: %w", imageErr)
+		}
+
+		cleanup.Add(func() error {
+			releaseImage()
+
+			return nil
+		})
+
+		readonlyRootfs = imageRootfs
+
+		templateFiles, err = storage.NewTemplateFiles(
+			config.TemplateId,
+			imageBuildId,
+			config.KernelVersion,
+			config.FirecrackerVersion,
+			config.HugePages,
+		).NewTemplateCacheFiles()
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to create image template files: %w", err)
+		}
+	} else {
+		t, err = templateCache.GetTemplate(
+			config.TemplateId,
+			config.BuildId,
+			config.KernelVersion,
+			config.FirecrackerVersion,
+			config.HugePages,
+			isSnapshot,
+			config.TeamId,
+		)
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to get template snapshot data: %w", err)
+		}
+
+		templateFiles = t.Files()
+	}
+
+	timings.Since(slo.StageTemplateFetch, templateStart)
+
+	kernelPath, releaseKernel, err := templateCache.Kernel(childCtx, config.KernelVersion, config.KernelChecksum)
 	if err != nil {
-		return nil, cleanup, fmt.Errorf("failed to get template snapshot data: %w", err)
+		return nil, cleanup, fmt.Errorf("failed to get kernel: %w", err)
 	}
 
+	cleanup.Add(func() error {
+		releaseKernel()
+
+		return nil
+	})
+
 	networkCtx, networkSpan := tracer.Start(childCtx, "get-network-slot")
 
 	ips, err := networkPool.Get(networkCtx)
@@ -106,9 +188,29 @@ func NewSandbox(
 		return nil
 	})
 
+	// The policy is set before the sandbox starts, so no outbound traffic leaves the sandbox unfiltered
+	if config.Egress != nil && !config.Egress.AllowAll {
+		egressErr := ips.SetEgressPolicy(networkCtx, config.Egress.AllowedHosts)
+
+		cleanup.Add(func() error {
+			removeErr := ips.RemoveEgressPolicy()
+			if removeErr != nil {
+				return fmt.Errorf("failed to remove egress policy: %w", removeErr)
+			}
+
+			return nil
+		})
+
+		if egressErr != nil {
+			return nil, cleanup, fmt.Errorf("failed to set egress policy: %w", egressErr)
+		}
+
+		telemetry.ReportEvent(networkCtx, "set egress policy", attribute.String("egress.preset", config.Egress.Preset))
+	}
+
 	networkSpan.End()
 
-	sandboxFiles := t.Files().NewSandboxFiles(config.SandboxId)
+	sandboxFiles := templateFiles.NewSandboxFiles(config.SandboxId)
 
 	cleanup.Add(func() error {
 		filesErr := cleanupFiles(sandboxFiles)
@@ -121,15 +223,21 @@ func NewSandbox(
 
 	_, overlaySpan := tracer.Start(childCtx, "create-rootfs-overlay")
 
-	readonlyRootfs, err := t.Rootfs()
-	if err != nil {
-		return nil, cleanup, fmt.Errorf("failed to get rootfs: %w", err)
+	rootfsStart := time.Now()
+
+	if t != nil {
+		readonlyRootfs, err = t.Rootfs()
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to get rootfs: %w", err)
+		}
 	}
 
+	timings.Since(slo.StageTemplateFetch, rootfsStart)
+
 	rootfsOverlay, err := rootfs.NewCowDevice(
 		readonlyRootfs,
 		sandboxFiles.SandboxCacheRootfsPath(),
-		sandboxFiles.RootfsBlockSize(),
+		int64(readonlyRootfs.Header().Metadata.BlockSize),
 	)
 	if err != nil {
 		return nil, cleanup, fmt.Errorf("failed to create overlay file: %w", err)
@@ -142,57 +250,95 @@ func NewSandbox(
 	})
 
 	go func() {
+		nbdStart := time.Now()
+
 		runErr := rootfsOverlay.Start(childCtx)
 		if runErr != nil {
 			fmt.Fprintf(os.Stderr, "[sandbox %s]: rootfs overlay error: %v\n", config.SandboxId, runErr)
+
+			return
 		}
+
+		timings.Since(slo.StageNBDAttach, nbdStart)
 	}()
 
-	memfile, err := t.Memfile()
-	if err != nil {
-		return nil, cleanup, fmt.Errorf("failed to get memfile: %w", err)
-	}
-	overlaySpan.End()
+	var (
+		memfile  *template.Storage
+		fcUffd   *uffd.Uffd
+		uffdExit chan error
+		snapfile template.File
+		// uffdReady is nil for the booted sandboxes, FC doesn't wait for it then.
+		uffdReady chan struct{}
+		initPath  string
+	)
 
-	fcUffd, uffdErr := uffd.New(memfile, sandboxFiles.SandboxUffdSocketPath(), sandboxFiles.MemfilePageSize())
-	if uffdErr != nil {
-		return nil, cleanup, fmt.Errorf("failed to create uffd: %w", uffdErr)
-	}
+	// The context is canceled if the uffd exits before FC is started
+	startCtx := childCtx
 
-	uffdStartErr := fcUffd.Start(config.SandboxId)
-	if uffdStartErr != nil {
-		return nil, cleanup, fmt.Errorf("failed to start uffd: %w", uffdStartErr)
-	}
+	if t == nil {
+		overlaySpan.End()
 
-	cleanup.Add(func() error {
-		stopErr := fcUffd.Stop()
-		if stopErr != nil {
-			return fmt.Errorf("failed to stop uffd: %w", stopErr)
+		initPath = image.InitPath
+	} else {
+		memfileStart := time.Now()
+
+		memfile, err = t.Memfile()
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to get memfile: %w", err)
 		}
 
-		return nil
-	})
+		timings.Since(slo.StageTemplateFetch, memfileStart)
+		overlaySpan.End()
 
-	uffdExit := make(chan error, 1)
+		var uffdErr error
 
-	uffdStartCtx, cancelUffdStartCtx := context.WithCancelCause(childCtx)
-	defer cancelUffdStartCtx(fmt.Errorf("uffd finished starting"))
+		fcUffd, uffdErr = uffd.New(memfile, sandboxFiles.SandboxUffdSocketPath(), sandboxFiles.MemfilePageSize())
+		if uffdErr != nil {
+			return nil, cleanup, fmt.Errorf("failed to create uffd: %w", uffdErr)
+		}
 
-	go func() {
-		uffdWaitErr := <-fcUffd.Exit
-		uffdExit <- uffdWaitErr
+		uffdStartErr := fcUffd.Start(config.SandboxId)
+		if uffdStartErr != nil {
+			return nil, cleanup, fmt.Errorf("failed to start uffd: %w", uffdStartErr)
+		}
 
-		cancelUffdStartCtx(fmt.Errorf("uffd process exited: %w", errors.Join(uffdWaitErr, context.Cause(uffdStartCtx))))
-	}()
+		cleanup.Add(func() error {
+			stopErr := fcUffd.Stop()
+			if stopErr != nil {
+				return fmt.Errorf("failed to stop uffd: %w", stopErr)
+			}
 
-	// todo: check if kernel, firecracker, and envd versions exist
-	snapfile, err := t.Snapfile()
-	if err != nil {
-		return nil, cleanup, fmt.Errorf("failed to get snapfile: %w", err)
+			return nil
+		})
+
+		uffdExit = make(chan error, 1)
+
+		uffdStartCtx, cancelUffdStartCtx := context.WithCancelCause(childCtx)
+		startCtx = uffdStartCtx
+		defer cancelUffdStartCtx(fmt.Errorf("uffd finished starting"))
+
+		go func() {
+			uffdWaitErr := <-fcUffd.Exit
+			uffdExit <- uffdWaitErr
+
+			cancelUffdStartCtx(fmt.Errorf("uffd process exited: %w", errors.Join(uffdWaitErr, context.Cause(uffdStartCtx))))
+		}()
+
+		// todo: check if kernel, firecracker, and envd versions exist
+		snapfileStart := time.Now()
+
+		snapfile, err = t.Snapfile()
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to get snapfile: %w", err)
+		}
+
+		timings.Since(slo.StageTemplateFetch, snapfileStart)
+
+		uffdReady = fcUffd.Ready
 	}
 
 	fcHandle, fcErr := fc.NewProcess(
-		uffdStartCtx,
+		startCtx,
 		tracer,
 		ips,
 		sandboxFiles,
@@ -203,17 +349,21 @@ func NewSandbox(
 			TraceId:              traceID,
 			TeamId:               config.TeamId,
 		},
+		kernelPath,
 		snapfile,
 		rootfsOverlay,
-		fcUffd.Ready,
+		uffdReady,
 		baseTemplateID,
+		config.Vcpu,
+		config.RamMb,
+		initPath,
 	)
 	if fcErr != nil {
 		return nil, cleanup, fmt.Errorf("failed to create FC: %w", fcErr)
 	}
 
 	internalLogger := logger.GetInternalLogger()
-	fcStartErr := fcHandle.Start(uffdStartCtx, tracer, internalLogger)
+	fcStartErr := fcHandle.Start(startCtx, tracer, internalLogger, timings)
 	if fcStartErr != nil {
 		return nil, cleanup, fmt.Errorf("failed to start FC: %w", fcStartErr)
 	}
@@ -244,6 +394,19 @@ func NewSandbox(
 		Logger:         logger,
 		cleanup:        cleanup,
 		healthcheckCtx: healthcheckCtx,
+		checkpoints:    &checkpoints{},
+		StartTimings:   timings,
+		network:        network.NewActivity(&ips),
+	}
+
+	if fcUffd != nil {
+		sbx.prefetcher = startMemfilePrefetch(memfile, fcUffd)
+
+		cleanup.Add(func() error {
+			sbx.prefetcher.stop(context.Background())
+
+			return nil
+		})
 	}
 
 	cleanup.AddPriority(func() error {
@@ -254,9 +417,11 @@ func NewSandbox(
 			errs = append(errs, fmt.Errorf("failed to stop FC: %w", fcStopErr))
 		}
 
-		uffdStopErr := fcUffd.Stop()
-		if uffdStopErr != nil {
-			errs = append(errs, fmt.Errorf("failed to stop uffd: %w", uffdStopErr))
+		if fcUffd != nil {
+			uffdStopErr := fcUffd.Stop()
+			if uffdStopErr != nil {
+				errs = append(errs, fmt.Errorf("failed to stop uffd: %w", uffdStopErr))
+			}
 		}
 
 		healthcheckCtx.Lock()
@@ -272,12 +437,42 @@ func NewSandbox(
 
 	// Sync envds.
 	if semver.Compare(fmt.Sprintf("v%s", config.EnvdVersion), "v0.1.1") >= 0 {
-		initErr := sbx.initEnvd(syncCtx, tracer, config.EnvVars)
+		envdStart := time.Now()
+
+		// The older envd versions would ignore the secrets
+		if len(config.Secrets) > 0 && semver.Compare(fmt.Sprintf("v%s", config.EnvdVersion), minEnvdVersionSecrets) < 0 {
+			return nil, cleanup, fmt.Errorf("envd version %s doesn't support secrets, rebuild the template to use them", config.EnvdVersion)
+		}
+
+		// The older envd versions would start the processes without the hardening
+		if config.Hardening != nil && semver.Compare(fmt.Sprintf("v%s", config.EnvdVersion), minEnvdVersionHardening) < 0 {
+			return nil, cleanup, fmt.Errorf("envd version %s doesn't support hardening, rebuild the template to use it", config.EnvdVersion)
+		}
+
+		initErr := sbx.initEnvd(syncCtx, tracer, config.EnvVars, config.Secrets, config.Hardening)
 		if initErr != nil {
 			return nil, cleanup, fmt.Errorf("failed to init new envd: %w", initErr)
 		} else {
+			timings.Since(slo.StageEnvdHealthy, envdStart)
+
 			telemetry.ReportEvent(childCtx, fmt.Sprintf("[sandbox %s]: initialized new envd", config.SandboxId))
 		}
+
+		// The runtimes in the sandbox may need to recover from the clock jump after the restore before they are ready.
+		hookErr := sbx.runHook(childCtx, tracer, onResumeHookName, config.OnResumeHook)
+		if hookErr != nil {
+			return nil, cleanup, hookErr
+		}
+
+		// The sandbox is added to DNS and returned only after the application is ready, so it doesn't receive traffic prematurely.
+		if config.ReadinessProbe != nil {
+			readyErr := sbx.waitForReadiness(childCtx, tracer, config.ReadinessProbe)
+			if readyErr != nil {
+				return nil, cleanup, fmt.Errorf("failed to wait for sandbox readiness: %w", readyErr)
+			}
+
+			telemetry.ReportEvent(childCtx, fmt.Sprintf("[sandbox %s]: readiness probe succeeded", config.SandboxId))
+		}
 	} else {
 		syncErr := sbx.syncOldEnvd(syncCtx)
 		if syncErr != nil {
@@ -307,11 +502,25 @@ func NewSandbox(
 func (s *Sandbox) Wait() error {
 	select {
 	case fcErr := <-s.process.Exit:
+		if fcErr != nil && !s.process.Stopped() {
+			s.diagnostics.Store(s.collectDiagnostics(fcErr, nil))
+		}
+
 		stopErr := s.Stop()
+
+		// The booted sandboxes have no uffd, the nil exit channel would block
+		if s.uffd == nil {
+			return errors.Join(fcErr, stopErr)
+		}
+
 		uffdErr := <-s.uffdExit
 
 		return errors.Join(fcErr, stopErr, uffdErr)
 	case uffdErr := <-s.uffdExit:
+		if uffdErr != nil && !s.process.Stopped() {
+			s.diagnostics.Store(s.collectDiagnostics(nil, uffdErr))
+		}
+
 		stopErr := s.Stop()
 		fcErr := <-s.process.Exit
 
@@ -337,6 +546,10 @@ func (s *Sandbox) Snapshot(
 	ctx, childSpan := tracer.Start(ctx, "sandbox-snapshot")
 	defer childSpan.End()
 
+	if s.template == nil {
+		return nil, ErrNoSnapshot
+	}
+
 	buildId, err := uuid.Parse(snapshotTemplateFiles.BuildId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse build id: %w", err)
@@ -357,15 +570,29 @@ func (s *Sandbox) Snapshot(
 		BaseBuildId: originalMemfile.Header().Metadata.BaseBuildId,
 	}
 
+	secrets := s.secretValues()
+	if len(s.Config.SecretEnvVars) > 0 || len(s.Config.Secrets) > 0 {
+		err = s.scrubEnvd(ctx, tracer)
+		if err != nil {
+			// The secrets are still zeroed in the memfile
+			telemetry.ReportError(ctx, fmt.Errorf("failed to scrub envd: %w", err))
+		}
+	}
+
 	s.healthcheckCtx.Lock()
 	s.healthcheckCtx.Cancel()
 	s.healthcheckCtx.Unlock()
 
+	s.prefetcher.stop(ctx)
+
 	err = s.process.Pause(ctx, tracer)
 	if err != nil {
 		return nil, fmt.Errorf("error pausing vm: %w", err)
 	}
 
+	// The faults are recorded before the snapshot of the memory is taken, taking the snapshot faults all the blocks
+	memfilePrefetch := prefetch.New(int64(memfileMetadata.BlockSize), originalMemfile.PrefetchManifest(), s.uffd.Faulted())
+
 	err = s.uffd.Disable()
 	if err != nil {
 		return nil, fmt.Errorf("failed to disable uffd: %w", err)
@@ -385,6 +612,11 @@ func (s *Sandbox) Snapshot(
 
 	memfileDirtyPages := s.uffd.Dirty()
 
+	_, err = scrub.Memfile(ctx, snapshotTemplateFiles.CacheMemfileFullSnapshotPath(), s.files.MemfilePageSize(), memfileDirtyPages, secrets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrub memfile: %w", err)
+	}
+
 	sourceFile, err := os.Open(snapshotTemplateFiles.CacheMemfileFullSnapshotPath())
 	if err != nil {
 		return nil, fmt.Errorf("failed to open memfile: %w", err)
@@ -533,13 +765,41 @@ func (s *Sandbox) Snapshot(
 		MemfileDiffHeader: header.NewHeader(memfileMetadata, memfileMappings),
 		RootfsDiff:        rootfsDiff,
 		RootfsDiffHeader:  header.NewHeader(rootfsMetadata, rootfsMappings),
+		MemfilePrefetch:   memfilePrefetch,
 	}, nil
 }
 
 type Snapshot struct {
 	MemfileDiff       build.Diff
 	MemfileDiffHeader *header.Header
+	MemfilePrefetch   *prefetch.Manifest
 	RootfsDiff        build.Diff
 	RootfsDiffHeader  *header.Header
 	Snapfile          *template.LocalFile
 }
+
+// AttachConsole returns the guest serial console output, the channel is closed when the sandbox stops.
+// Call the returned function to detach.
+func (s *Sandbox) AttachConsole() (<-chan []byte, func()) {
+	return s.process.AttachConsole()
+}
+
+// WriteConsole writes the input to the guest serial console.
+func (s *Sandbox) WriteConsole(input []byte) error {
+	return s.process.WriteConsole(input)
+}
+
+// CgroupStats returns the resource usage of the sandbox FC process, it's available only for the process started under the jailer.
+func (s *Sandbox) CgroupStats() (*fc.CgroupStats, error) {
+	return s.process.CgroupStats()
+}
+
+// CPUUsage returns the number of CPUs used by the sandbox FC process on average since the usage was last measured.
+func (s *Sandbox) CPUUsage() (float64, error) {
+	stats, err := s.stats.Stats()
+	if err != nil {
+		return 0, err
+	}
+
+	return stats.CPUCount, nil
+}
@@ -0,0 +1,110 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/requestid"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// The shorter secrets are not scrubbed, zeroing their matches could corrupt the unrelated memory.
+	minScrubbedSecretLength = 8
+
+	envdScrubTimeout = 5 * time.Second
+
+	// minEnvdVersionSecrets is the first envd version that exposes the secrets and removes them on the scrub.
+	minEnvdVersionSecrets = "v0.1.17"
+)
+
+type postScrubJSONBody struct {
+	EnvVars []string `json:"envVars"`
+}
+
+// secretValues returns the unique values of the secret env vars and the secrets of the sandbox.
+func (s *Sandbox) secretValues() [][]byte {
+	seen := make(map[string]struct{})
+
+	var secrets [][]byte
+
+	add := func(value string) {
+		if len(value) < minScrubbedSecretLength {
+			return
+		}
+
+		if _, ok := seen[value]; ok {
+			return
+		}
+
+		seen[value] = struct{}{}
+		secrets = append(secrets, []byte(value))
+	}
+
+	for _, name := range s.Config.SecretEnvVars {
+		add(s.Config.EnvVars[name])
+	}
+
+	for _, value := range s.Config.Secrets {
+		add(value)
+	}
+
+	return secrets
+}
+
+// scrubEnvd asks envd to drop the secret env vars and the secrets and to return its freed memory to the guest kernel,
+// so most of the secrets are not in the memory when the sandbox is paused.
+func (s *Sandbox) scrubEnvd(ctx context.Context, tracer trace.Tracer) error {
+	childCtx, childSpan := tracer.Start(ctx, "envd-scrub")
+	defer childSpan.End()
+
+	childCtx, cancel := context.WithTimeout(childCtx, envdScrubTimeout)
+	defer cancel()
+
+	address := fmt.Sprintf("http://%s:%d/scrub", s.Slot.HostIP(), consts.DefaultEnvdServerPort)
+
+	body, err := json.Marshal(&postScrubJSONBody{EnvVars: s.Config.SecretEnvVars})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(childCtx, "POST", address, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	requestid.InjectHeaders(childCtx, request.Header)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	_, err = io.Copy(io.Discard, response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		// The older envd versions can't scrub, the secrets are still zeroed in the memfile
+		telemetry.ReportEvent(childCtx, "envd doesn't support scrubbing")
+
+		return nil
+	}
+
+	if response.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	return nil
+}
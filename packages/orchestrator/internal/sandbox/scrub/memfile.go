@@ -0,0 +1,103 @@
+// Package scrub zeroes the secrets of the sandbox in its memory snapshot.
+package scrub
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/bits-and-blooms/bitset"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// windowSize is the size of the part of the memfile scanned at once, it doesn't depend on the page size,
+// so the buffer stays small with the hugepages.
+const windowSize = 4 * 1024 * 1024
+
+// Memfile zeroes the secrets in the dirty pages of the memfile before the diff is created from them.
+// Only the dirty pages are scanned, the other pages are from the template and can't contain the secrets of the sandbox.
+// Only the secrets fully inside a run of the dirty pages are zeroed, the windows of the run overlap by the longest secret.
+func Memfile(ctx context.Context, path string, pageSize int64, dirty *bitset.BitSet, secrets [][]byte) (int, error) {
+	if len(secrets) == 0 {
+		return 0, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open memfile: %w", err)
+	}
+	defer file.Close()
+
+	overlap := int64(0)
+	for _, secret := range secrets {
+		overlap = max(overlap, int64(len(secret)-1))
+	}
+
+	window := make([]byte, windowSize+overlap)
+	scrubbed := 0
+
+	for start, ok := dirty.NextSet(0); ok; {
+		// The run of the consecutive dirty pages
+		end, found := dirty.NextClear(start)
+		if !found {
+			end = dirty.Len()
+		}
+
+		runStart := int64(start) * pageSize
+		runEnd := int64(end) * pageSize
+
+		for offset := runStart; offset < runEnd; offset += windowSize {
+			b := window[:min(int64(len(window)), runEnd-offset)]
+
+			n, err := file.ReadAt(b, offset)
+			if err != nil && err != io.EOF {
+				return scrubbed, fmt.Errorf("failed to read memfile: %w", err)
+			}
+
+			b = b[:n]
+
+			count := zeroSecrets(b, secrets)
+			if count == 0 {
+				continue
+			}
+
+			_, err = file.WriteAt(b, offset)
+			if err != nil {
+				return scrubbed, fmt.Errorf("failed to write memfile: %w", err)
+			}
+
+			scrubbed += count
+		}
+
+		start, ok = dirty.NextSet(end)
+	}
+
+	telemetry.ReportEvent(ctx, "scrubbed secrets from memfile", attribute.Int("secrets.occurrences", scrubbed))
+
+	return scrubbed, file.Sync()
+}
+
+// zeroSecrets zeroes all occurrences of the secrets in the buffer and returns their number.
+func zeroSecrets(b []byte, secrets [][]byte) int {
+	count := 0
+
+	for _, secret := range secrets {
+		for i := 0; i < len(b); {
+			j := bytes.Index(b[i:], secret)
+			if j < 0 {
+				break
+			}
+
+			clear(b[i+j : i+j+len(secret)])
+
+			count++
+			i += j + len(secret)
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,134 @@
+package scrub
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/require"
+)
+
+const testPageSize = 4096
+
+var testSecret = []byte("secret-value-1234")
+
+func writeMemfile(t *testing.T, data []byte) string {
+	path := filepath.Join(t.TempDir(), "memfile")
+
+	err := os.WriteFile(path, data, 0o644)
+	require.NoError(t, err)
+
+	return path
+}
+
+func dirtyPages(pages uint, runs ...[2]uint) *bitset.BitSet {
+	dirty := bitset.New(pages)
+
+	for _, run := range runs {
+		for i := run[0]; i < run[1]; i++ {
+			dirty.Set(i)
+		}
+	}
+
+	return dirty
+}
+
+func TestZeroSecrets(t *testing.T) {
+	other := []byte("another-secret")
+
+	tests := []struct {
+		name     string
+		data     string
+		secrets  [][]byte
+		expected string
+		count    int
+	}{
+		{
+			name:     "no secrets",
+			data:     "plain memory",
+			secrets:  [][]byte{testSecret},
+			expected: "plain memory",
+			count:    0,
+		},
+		{
+			name:     "repeated secret",
+			data:     "a" + string(testSecret) + "b" + string(testSecret),
+			secrets:  [][]byte{testSecret},
+			expected: "a" + string(make([]byte, len(testSecret))) + "b" + string(make([]byte, len(testSecret))),
+			count:    2,
+		},
+		{
+			name:     "multiple secrets",
+			data:     string(other) + "|" + string(testSecret),
+			secrets:  [][]byte{testSecret, other},
+			expected: string(make([]byte, len(other))) + "|" + string(make([]byte, len(testSecret))),
+			count:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := []byte(tt.data)
+
+			count := zeroSecrets(b, tt.secrets)
+
+			require.Equal(t, tt.count, count)
+			require.Equal(t, []byte(tt.expected), b)
+		})
+	}
+}
+
+func TestMemfileWindowBoundary(t *testing.T) {
+	pages := uint(3 * windowSize / testPageSize)
+	data := make([]byte, int64(pages)*testPageSize)
+
+	// The secret starts before the end of the first window and ends in the second one
+	offset := windowSize - len(testSecret)/2
+	copy(data[offset:], testSecret)
+
+	path := writeMemfile(t, data)
+
+	scrubbed, err := Memfile(context.Background(), path, testPageSize, dirtyPages(pages, [2]uint{0, pages}), [][]byte{testSecret})
+	require.NoError(t, err)
+	require.Equal(t, 1, scrubbed)
+
+	result, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, len(data)), result)
+}
+
+func TestMemfileDirtyRuns(t *testing.T) {
+	pages := uint(16)
+	data := make([]byte, int64(pages)*testPageSize)
+
+	// Secrets in the separate runs of the dirty pages, in a clean page between them and at the end of the last run
+	dirtyOffsets := []int{1*testPageSize + 10, 7*testPageSize + 100, 12*testPageSize - len(testSecret)}
+	cleanOffset := 4*testPageSize + 50
+
+	for _, offset := range dirtyOffsets {
+		copy(data[offset:], testSecret)
+	}
+
+	copy(data[cleanOffset:], testSecret)
+
+	path := writeMemfile(t, data)
+
+	dirty := dirtyPages(pages, [2]uint{1, 3}, [2]uint{6, 8}, [2]uint{11, 12})
+
+	scrubbed, err := Memfile(context.Background(), path, testPageSize, dirty, [][]byte{testSecret})
+	require.NoError(t, err)
+	require.Equal(t, len(dirtyOffsets), scrubbed)
+
+	result, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	for _, offset := range dirtyOffsets {
+		require.Equal(t, make([]byte, len(testSecret)), result[offset:offset+len(testSecret)])
+	}
+
+	require.Equal(t, testSecret, result[cleanOffset:cleanOffset+len(testSecret)])
+	require.Equal(t, 1, bytes.Count(result, testSecret))
+}
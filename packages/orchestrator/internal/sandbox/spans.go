@@ -0,0 +1,134 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+)
+
+const (
+	spansExportInterval = 5 * time.Second
+
+	minEnvdVersionForSpans = "v0.1.7"
+
+	// maxEnvdSpansSize limits how much of the spans the guest can make the orchestrator read in one export.
+	maxEnvdSpansSize = 4 << 20
+
+	envdServiceName = "envd"
+)
+
+var otelCollectorHTTPEndpoint = os.Getenv("OTEL_COLLECTOR_HTTP_ENDPOINT")
+
+// ExportEnvdSpans pulls the spans recorded by envd and sends them to the otel collector.
+// The envd spans are children of the spans propagated in the requests to envd, so they are part of the same trace as the orchestrator spans.
+func (s *Sandbox) ExportEnvdSpans(ctx context.Context) error {
+	if otelCollectorHTTPEndpoint == "" || !isGTEVersion(s.Config.EnvdVersion, minEnvdVersionForSpans) {
+		return nil
+	}
+
+	address := fmt.Sprintf("http://%s:%d/spans", s.Slot.HostIP(), consts.DefaultEnvdServerPort)
+
+	request, err := http.NewRequestWithContext(ctx, "GET", address, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxEnvdSpansSize+1))
+	if err != nil {
+		return err
+	}
+
+	if len(body) > maxEnvdSpansSize {
+		return fmt.Errorf("envd spans exceed %d bytes", maxEnvdSpansSize)
+	}
+
+	spans, err := s.sanitizeEnvdSpans(body)
+	if err != nil {
+		return fmt.Errorf("failed to parse envd spans: %w", err)
+	}
+
+	if spans == nil {
+		return nil
+	}
+
+	exportRequest, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("http://%s/v1/traces", otelCollectorHTTPEndpoint), bytes.NewReader(spans))
+	if err != nil {
+		return err
+	}
+
+	exportRequest.Header.Set("Content-Type", "application/json")
+
+	exportResponse, err := httpClient.Do(exportRequest)
+	if err != nil {
+		return err
+	}
+	defer exportResponse.Body.Close()
+
+	if exportResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from otel collector: %d", exportResponse.StatusCode)
+	}
+
+	_, err = io.Copy(io.Discard, exportResponse.Body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// sanitizeEnvdSpans decodes the OTLP/JSON spans from envd and replaces the resource the guest reported
+// with the one the orchestrator knows, so the guest can't attribute its spans to another service, sandbox or team.
+// It returns nil when there are no spans to export.
+func (s *Sandbox) sanitizeEnvdSpans(body []byte) ([]byte, error) {
+	var spans coltracepb.ExportTraceServiceRequest
+
+	err := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, &spans)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(spans.GetResourceSpans()) == 0 {
+		return nil, nil
+	}
+
+	for _, resourceSpans := range spans.GetResourceSpans() {
+		resourceSpans.Resource = &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{
+				stringAttribute("service.name", envdServiceName),
+				stringAttribute("sandbox.id", s.Config.SandboxId),
+				stringAttribute("team.id", s.Config.TeamId),
+			},
+		}
+		resourceSpans.SchemaUrl = ""
+	}
+
+	return protojson.Marshal(&spans)
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
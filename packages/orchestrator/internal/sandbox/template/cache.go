@@ -3,13 +3,22 @@ package template
 import (
 	"context"
 	"fmt"
+	"os"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jellydator/ttlcache/v3"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/cacheusage"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/image"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/kernel"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/prefetch"
 )
 
 // How long to keep the template in the cache since the last access.
@@ -21,6 +30,14 @@ type Cache struct {
 	bucket     *gcs.BucketHandle
 	ctx        context.Context
 	buildStore *build.DiffStore
+	kernels    *kernel.Manager
+	images     *image.Manager
+
+	pinnedMu sync.RWMutex
+	// The cache keys of the pinned templates
+	pinned map[string]struct{}
+	// Serializes the pinning of the layers, so the older pinned builds don't override the newer ones
+	pinLayersMu sync.Mutex
 }
 
 func NewCache(ctx context.Context) (*Cache, error) {
@@ -39,16 +56,30 @@ func NewCache(ctx context.Context) (*Cache, error) {
 
 	go cache.Start()
 
-	buildStore, err := build.NewDiffStore(gcs.TemplateBucket, ctx)
+	// The templates are read from the replica in the region of the node, if there is one
+	buildStore, err := build.NewDiffStore(gcs.LocalTemplateBucket, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create build store: %w", err)
 	}
 
+	kernels, err := kernel.NewManager(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kernel manager: %w", err)
+	}
+
+	images, err := image.NewManager(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image manager: %w", err)
+	}
+
 	return &Cache{
-		bucket:     gcs.TemplateBucket,
+		bucket:     gcs.LocalTemplateBucket,
 		buildStore: buildStore,
+		kernels:    kernels,
+		images:     images,
 		cache:      cache,
 		ctx:        ctx,
+		pinned:     make(map[string]struct{}),
 	}, nil
 }
 
@@ -56,6 +87,61 @@ func (c *Cache) Items() map[string]*ttlcache.Item[string, Template] {
 	return c.cache.Items()
 }
 
+// Kernel returns the path of the kernel binary on the node, the release has to be called when the kernel is no longer used.
+func (c *Cache) Kernel(ctx context.Context, version, checksum string) (string, func(), error) {
+	return c.kernels.Acquire(ctx, version, checksum)
+}
+
+// ImageRootfs returns the rootfs of the OCI image and the id of the build the rootfs is stored as on the node,
+// the release has to be called when the rootfs is no longer used. The rootfs of an image is shared by its sandboxes.
+func (c *Cache) ImageRootfs(ctx context.Context, imageRef string, auth *image.Auth) (*Storage, string, func(), error) {
+	img, release, err := c.images.Acquire(ctx, imageRef, auth)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("failed to get image: %w", err)
+	}
+
+	// The build id is derived from the digest, so the sandboxes of the image share the diff
+	buildId := uuid.NewSHA1(uuid.NameSpaceURL, []byte(img.Digest))
+
+	stat, err := os.Stat(img.Path)
+	if err != nil {
+		release()
+
+		return nil, "", nil, fmt.Errorf("failed to stat image rootfs: %w", err)
+	}
+
+	diff, err := build.NewFileDiff(img.Path)
+	if err != nil {
+		release()
+
+		return nil, "", nil, fmt.Errorf("failed to open image rootfs: %w", err)
+	}
+
+	if _, added := c.buildStore.GetOrAdd(buildId.String(), build.Rootfs, diff); !added {
+		diff.Close()
+	}
+
+	h := header.NewHeader(&header.Metadata{
+		BuildId:     buildId,
+		BaseBuildId: buildId,
+		Size:        uint64(stat.Size()),
+		Version:     1,
+		BlockSize:   header.RootfsBlockSize,
+		Generation:  1,
+	}, nil)
+
+	s, err := NewStorage(ctx, c.buildStore, buildId.String(), build.Rootfs, header.RootfsBlockSize, false, h, c.bucket)
+	if err != nil {
+		release()
+
+		return nil, "", nil, fmt.Errorf("failed to create image rootfs storage: %w", err)
+	}
+
+	return s, buildId.String(), release, nil
+}
+
+// GetTemplate returns the template from the cache and starts its fetch if it's not cached. The request is accounted
+// to the team, the requests without the team, e.g. of the pinned templates, are not accounted.
 func (c *Cache) GetTemplate(
 	templateId,
 	buildId,
@@ -63,6 +149,7 @@ func (c *Cache) GetTemplate(
 	firecrackerVersion string,
 	hugePages bool,
 	isSnapshot bool,
+	teamId string,
 ) (Template, error) {
 	storageTemplate, err := newTemplateFromStorage(
 		templateId,
@@ -83,13 +170,17 @@ func (c *Cache) GetTemplate(
 	t, found := c.cache.GetOrSet(
 		storageTemplate.Files().CacheKey(),
 		storageTemplate,
-		ttlcache.WithTTL[string, Template](templateExpiration),
+		ttlcache.WithTTL[string, Template](c.ttl(storageTemplate.Files().CacheKey())),
 	)
 
 	if !found {
 		go storageTemplate.Fetch(c.ctx, c.buildStore)
 	}
 
+	if teamId != "" {
+		cacheusage.RecordTemplate(buildId, teamId, found)
+	}
+
 	return t.Value(), nil
 }
 
@@ -104,6 +195,7 @@ func (c *Cache) AddSnapshot(
 	localSnapfile *LocalFile,
 	memfileDiff build.Diff,
 	rootfsDiff build.Diff,
+	memfilePrefetch *prefetch.Manifest,
 ) error {
 	switch memfileDiff.(type) {
 	case *build.NoDiff:
@@ -135,10 +227,12 @@ func (c *Cache) AddSnapshot(
 		return fmt.Errorf("failed to create template cache from storage: %w", err)
 	}
 
+	storageTemplate.memfilePrefetch = memfilePrefetch
+
 	_, found := c.cache.GetOrSet(
 		storageTemplate.Files().CacheKey(),
 		storageTemplate,
-		ttlcache.WithTTL[string, Template](templateExpiration),
+		ttlcache.WithTTL[string, Template](c.ttl(storageTemplate.Files().CacheKey())),
 	)
 
 	if !found {
@@ -147,3 +241,81 @@ func (c *Cache) AddSnapshot(
 
 	return nil
 }
+
+// ttl returns the expiration of the template, the pinned templates never expire.
+func (c *Cache) ttl(cacheKey string) time.Duration {
+	if c.IsPinned(cacheKey) {
+		return ttlcache.NoTTL
+	}
+
+	return templateExpiration
+}
+
+// IsPinned returns true if the template with the cache key is pinned on the node.
+func (c *Cache) IsPinned(cacheKey string) bool {
+	c.pinnedMu.RLock()
+	defer c.pinnedMu.RUnlock()
+
+	_, ok := c.pinned[cacheKey]
+
+	return ok
+}
+
+// SetPinned replaces the pinned builds. The pinned templates are fetched if they are not in the cache
+// and they are never evicted together with the diffs of all their layers, the unpinned templates expire again.
+func (c *Cache) SetPinned(builds []*orchestrator.PinnedBuild) {
+	pinned := make(map[string]struct{}, len(builds))
+	for _, b := range builds {
+		files := storage.NewTemplateFiles(b.TemplateId, b.BuildId, b.KernelVersion, b.FirecrackerVersion, b.HugePages)
+
+		pinned[files.CacheKey()] = struct{}{}
+	}
+
+	c.pinnedMu.Lock()
+	c.pinned = pinned
+	c.pinnedMu.Unlock()
+
+	for key, item := range c.cache.Items() {
+		ttl := c.ttl(key)
+		if item.TTL() != ttl {
+			c.cache.Set(key, item.Value(), ttl)
+		}
+	}
+
+	go c.pinLayers(builds)
+}
+
+// pinLayers fetches the pinned templates and pins the diffs of all the builds their files are made of.
+func (c *Cache) pinLayers(builds []*orchestrator.PinnedBuild) {
+	c.pinLayersMu.Lock()
+	defer c.pinLayersMu.Unlock()
+
+	buildIds := make(map[string]struct{})
+
+	for _, b := range builds {
+		// The own diffs are pinned even if the template can't be fetched now, so they are kept once it's fetched
+		buildIds[b.BuildId] = struct{}{}
+
+		t, err := c.GetTemplate(b.TemplateId, b.BuildId, b.KernelVersion, b.FirecrackerVersion, b.HugePages, false, "")
+		if err != nil {
+			fmt.Printf("[template data cache]: failed to get pinned template %s/%s: %v\n", b.TemplateId, b.BuildId, err)
+
+			continue
+		}
+
+		for _, getStorage := range []func() (*Storage, error){t.Memfile, t.Rootfs} {
+			s, err := getStorage()
+			if err != nil {
+				fmt.Printf("[template data cache]: failed to fetch pinned template %s/%s: %v\n", b.TemplateId, b.BuildId, err)
+
+				continue
+			}
+
+			for _, mapping := range s.Header().Mapping {
+				buildIds[mapping.BuildId.String()] = struct{}{}
+			}
+		}
+	}
+
+	c.buildStore.SetPinned(buildIds)
+}
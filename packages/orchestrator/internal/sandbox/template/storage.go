@@ -2,7 +2,10 @@ package template
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 
@@ -10,11 +13,18 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/layout"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/prefetch"
 )
 
 type Storage struct {
 	header *header.Header
 	source *build.File
+
+	// layout of the files in the rootfs, nil until it's loaded or if the template has no layout.
+	layout atomic.Pointer[layout.Layout]
+	// manifest is the prefetch manifest of the memfile of the snapshot, nil until it's loaded or if the snapshot has none.
+	manifest atomic.Pointer[prefetch.Manifest]
 }
 
 func NewStorage(
@@ -27,16 +37,20 @@ func NewStorage(
 	h *header.Header,
 	bucket *gcs.BucketHandle,
 ) (*Storage, error) {
-	if isSnapshot && h == nil {
+	// The rootfs of the template builds has the header with the block size selected for the template,
+	// the older template builds have no header
+	if h == nil && (isSnapshot || fileType == build.Rootfs) {
 		headerObject := gcs.NewObject(ctx, bucket, buildId+"/"+string(fileType)+storage.HeaderSuffix)
 
 		diffHeader, err := header.Deserialize(headerObject)
-		if err != nil {
+		if err == nil {
+			h = diffHeader
+		} else if isSnapshot || !errors.Is(err, gcs.ErrObjectNotExist) {
 			return nil, fmt.Errorf("failed to deserialize header: %w", err)
 		}
+	}
 
-		h = diffHeader
-	} else if h == nil {
+	if h == nil {
 		object := gcs.NewObject(ctx, bucket, buildId+"/"+string(fileType))
 
 		size, err := object.Size()
@@ -61,10 +75,59 @@ func NewStorage(
 
 	b := build.NewFile(h, store, fileType)
 
-	return &Storage{
+	s := &Storage{
 		source: b,
 		header: h,
-	}, nil
+	}
+
+	if fileType == build.Rootfs {
+		// The layout only speeds up the reads, the storage is used before it's loaded
+		go s.loadLayout(ctx, bucket)
+	}
+
+	if fileType == build.Memfile && isSnapshot {
+		// The manifest only orders the prefetch of the memfile, the sandbox is resumed before it's loaded
+		go s.loadManifest(ctx, bucket, buildId)
+	}
+
+	return s, nil
+}
+
+// loadLayout loads the layout of the rootfs of the template build the storage is based on,
+// the snapshots share the layout of their template.
+func (d *Storage) loadLayout(ctx context.Context, bucket *gcs.BucketHandle) {
+	files := &storage.TemplateFiles{BuildId: d.header.Metadata.BaseBuildId.String()}
+
+	l, err := layout.Deserialize(gcs.NewObject(ctx, bucket, files.StorageRootfsLayoutPath()))
+	if errors.Is(err, gcs.ErrObjectNotExist) {
+		return
+	}
+
+	if err != nil {
+		log.Printf("failed to load rootfs layout of build '%s': %v", files.BuildId, err)
+
+		return
+	}
+
+	d.layout.Store(l)
+}
+
+// loadManifest loads the prefetch manifest of the memfile of the snapshot, the manifest is stored with each snapshot.
+func (d *Storage) loadManifest(ctx context.Context, bucket *gcs.BucketHandle, buildId string) {
+	files := &storage.TemplateFiles{BuildId: buildId}
+
+	m, err := prefetch.Deserialize(gcs.NewObject(ctx, bucket, files.StorageMemfilePrefetchPath()))
+	if errors.Is(err, gcs.ErrObjectNotExist) {
+		return
+	}
+
+	if err != nil {
+		log.Printf("failed to load memfile prefetch manifest of build '%s': %v", buildId, err)
+
+		return
+	}
+
+	d.manifest.CompareAndSwap(nil, m)
 }
 
 func (d *Storage) ReadAt(p []byte, off int64) (int, error) {
@@ -79,6 +142,25 @@ func (d *Storage) Slice(off, length int64) ([]byte, error) {
 	return d.source.Slice(off, length)
 }
 
+func (d *Storage) Prefetch(off, length int64) error {
+	return d.source.Prefetch(off, length)
+}
+
+// Layout returns the layout of the files in the rootfs, nil if it's not loaded.
+func (d *Storage) Layout() *layout.Layout {
+	return d.layout.Load()
+}
+
 func (d *Storage) Header() *header.Header {
 	return d.header
 }
+
+// PrefetchManifest returns the prefetch manifest of the memfile, nil if it's not loaded.
+func (d *Storage) PrefetchManifest() *prefetch.Manifest {
+	return d.manifest.Load()
+}
+
+// SetPrefetchManifest sets the prefetch manifest of the snapshot created on the node, before it's uploaded.
+func (d *Storage) SetPrefetchManifest(m *prefetch.Manifest) {
+	d.manifest.Store(m)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/prefetch"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
@@ -22,9 +23,10 @@ type storageTemplate struct {
 
 	isSnapshot bool
 
-	memfileHeader *header.Header
-	rootfsHeader  *header.Header
-	localSnapfile *LocalFile
+	memfileHeader   *header.Header
+	rootfsHeader    *header.Header
+	localSnapfile   *LocalFile
+	memfilePrefetch *prefetch.Manifest
 
 	bucket *gcs.BucketHandle
 }
@@ -121,6 +123,10 @@ func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore
 			return t.memfile.SetError(errMsg)
 		}
 
+		if t.memfilePrefetch != nil {
+			memfileStorage.SetPrefetchManifest(t.memfilePrefetch)
+		}
+
 		return t.memfile.SetValue(memfileStorage)
 	}()
 
@@ -7,10 +7,12 @@ import (
 	"net"
 	"os"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 
 	"github.com/bits-and-blooms/bitset"
 )
@@ -43,12 +45,48 @@ type Uffd struct {
 
 	memfile    *block.TrackedSliceDevice
 	socketPath string
+	blockSize  int64
+
+	faults atomic.Uint64
+
+	faultedMu sync.Mutex
+	// faulted are the blocks of the memfile faulted since the start.
+	faulted *bitset.BitSet
 }
 
 func (u *Uffd) Disable() error {
 	return u.memfile.Disable()
 }
 
+// Faults returns the number of the page faults served since the start.
+func (u *Uffd) Faults() uint64 {
+	return u.faults.Load()
+}
+
+// Faulted returns the blocks of the memfile faulted since the start.
+func (u *Uffd) Faulted() *bitset.BitSet {
+	u.faultedMu.Lock()
+	defer u.faultedMu.Unlock()
+
+	return u.faulted.Clone()
+}
+
+// IsFaulted reports whether the block of the memfile at the offset was faulted since the start.
+func (u *Uffd) IsFaulted(off int64) bool {
+	u.faultedMu.Lock()
+	defer u.faultedMu.Unlock()
+
+	return u.faulted.Test(uint(header.BlockIdx(off, u.blockSize)))
+}
+
+func (u *Uffd) fault(off int64) {
+	u.faults.Add(1)
+
+	u.faultedMu.Lock()
+	u.faulted.Set(uint(header.BlockIdx(off, u.blockSize)))
+	u.faultedMu.Unlock()
+}
+
 func (u *Uffd) Dirty() *bitset.BitSet {
 	return u.memfile.Dirty()
 }
@@ -71,6 +109,8 @@ func New(memfile block.ReadonlyDevice, socketPath string, blockSize int64) (*Uff
 		exitWriter: pWrite,
 		memfile:    trackedMemfile,
 		socketPath: socketPath,
+		blockSize:  blockSize,
+		faulted:    bitset.New(0),
 		Stop: sync.OnceValue(func() error {
 			_, writeErr := pWrite.Write([]byte{0})
 			if writeErr != nil {
@@ -180,7 +220,7 @@ func (u *Uffd) handle(sandboxId string) (err error) {
 
 	u.Ready <- struct{}{}
 
-	err = Serve(int(uffd), setup.Mappings, u.memfile, u.exitReader.Fd(), u.Stop, sandboxId)
+	err = Serve(int(uffd), setup.Mappings, u.memfile, u.exitReader.Fd(), u.Stop, sandboxId, u.fault)
 	if err != nil {
 		return fmt.Errorf("failed handling uffd: %w", err)
 	}
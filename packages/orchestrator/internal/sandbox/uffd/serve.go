@@ -12,6 +12,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/watchdog"
 )
 
 const (
@@ -40,7 +41,7 @@ func getMapping(addr uintptr, mappings []GuestRegionUffdMapping) (*GuestRegionUf
 	return nil, fmt.Errorf("address %d not found in any mapping", addr)
 }
 
-func Serve(uffd int, mappings []GuestRegionUffdMapping, src *block.TrackedSliceDevice, fd uintptr, stop func() error, sandboxId string) error {
+func Serve(uffd int, mappings []GuestRegionUffdMapping, src *block.TrackedSliceDevice, fd uintptr, stop func() error, sandboxId string, fault func(off int64)) error {
 	pollFds := []unix.PollFd{
 		{Fd: int32(uffd), Events: unix.POLLIN},
 		{Fd: int32(fd), Events: unix.POLLIN},
@@ -117,6 +118,8 @@ func Serve(uffd int, mappings []GuestRegionUffdMapping, src *block.TrackedSliceD
 		offset := int64(mapping.Offset + uintptr(addr) - mapping.BaseHostVirtAddr)
 		pagesize := int64(mapping.PageSize)
 
+		start := time.Now()
+
 		eg.Go(func() error {
 			defer func() {
 				if r := recover(); r != nil {
@@ -153,6 +156,9 @@ func Serve(uffd int, mappings []GuestRegionUffdMapping, src *block.TrackedSliceD
 				return fmt.Errorf("failed uffdio copy %w", errno)
 			}
 
+			fault(offset)
+			watchdog.UFFD.Since(start)
+
 			return nil
 		})
 	}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/consul"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/artifacts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) SaveArtifact(ctx context.Context, in *orchestrator.SandboxSaveArtifactRequest) (*orchestrator.SandboxSaveArtifactResponse, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-save-artifact")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", in.SandboxId),
+		attribute.String("client.id", consul.ClientID),
+		attribute.String("artifact.name", in.Name),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		errMsg := fmt.Errorf("sandbox '%s' not found", in.SandboxId)
+		telemetry.ReportError(ctx, errMsg)
+
+		return nil, errcode.GRPCError(codes.NotFound, errcode.SandboxNotFound, errMsg.Error())
+	}
+
+	err := artifacts.Validate(in.Name, in.Labels)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	archive, err := sbx.ArchivePath(ctx, in.Path)
+	if errors.As(err, &sandbox.ErrArtifactPath{}) {
+		telemetry.ReportError(ctx, err)
+
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	if err != nil {
+		errMsg := fmt.Errorf("error archiving path '%s' of sandbox '%s': %w", in.Path, in.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+	defer archive.Close()
+
+	artifact, err := artifacts.Upload(ctx, gcs.TemplateBucket, sbx.Config.TeamId, in.SandboxId, in.Name, in.Path, in.Labels, archive)
+	if err != nil {
+		errMsg := fmt.Errorf("error storing artifact '%s' of sandbox '%s': %w", in.Name, in.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	return &orchestrator.SandboxSaveArtifactResponse{
+		SizeBytes: artifact.SizeBytes,
+		CreatedAt: timestamppb.New(artifact.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/consul"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) Checkpoint(ctx context.Context, in *orchestrator.SandboxCheckpointRequest) (*orchestrator.SandboxCheckpointResponse, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-checkpoint")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", in.SandboxId),
+		attribute.String("client.id", consul.ClientID),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		errMsg := fmt.Errorf("sandbox '%s' not found", in.SandboxId)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, errcode.GRPCError(codes.NotFound, errcode.SandboxNotFound, errMsg.Error())
+	}
+
+	checkpoint, err := sbx.CreateCheckpoint(ctx, s.tracer)
+	if err != nil {
+		errMsg := fmt.Errorf("error creating checkpoint for sandbox '%s': %w", in.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	return &orchestrator.SandboxCheckpointResponse{
+		CheckpointId: checkpoint.ID,
+		CreatedAt:    timestamppb.New(checkpoint.CreatedAt),
+	}, nil
+}
+
+func (s *server) ChangedFiles(ctx context.Context, in *orchestrator.SandboxChangedFilesRequest) (*orchestrator.SandboxChangedFilesResponse, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-changed-files")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", in.SandboxId),
+		attribute.String("client.id", consul.ClientID),
+		attribute.String("checkpoint.from", in.GetFromCheckpointId()),
+		attribute.String("checkpoint.to", in.GetToCheckpointId()),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		errMsg := fmt.Errorf("sandbox '%s' not found", in.SandboxId)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, errcode.GRPCError(codes.NotFound, errcode.SandboxNotFound, errMsg.Error())
+	}
+
+	files, err := sbx.ChangedFiles(ctx, s.tracer, in.GetFromCheckpointId(), in.GetToCheckpointId())
+	if errors.As(err, &sandbox.ErrCheckpointNotFound{}) {
+		telemetry.ReportError(ctx, err)
+
+		return nil, errcode.GRPCError(codes.NotFound, errcode.CheckpointNotFound, err.Error())
+	}
+
+	if err != nil {
+		errMsg := fmt.Errorf("error listing changed files for sandbox '%s': %w", in.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	changed := make([]*orchestrator.ChangedFile, 0, len(files))
+	for _, file := range files {
+		changed = append(changed, &orchestrator.ChangedFile{
+			Path:  file.Path,
+			IsDir: file.Type == "directory",
+		})
+	}
+
+	return &orchestrator.SandboxChangedFilesResponse{
+		Files: changed,
+	}, nil
+}
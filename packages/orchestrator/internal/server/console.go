@@ -0,0 +1,103 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/consul"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) Console(stream orchestrator.SandboxService_ConsoleServer) error {
+	ctx, childSpan := s.tracer.Start(stream.Context(), "sandbox-console")
+	defer childSpan.End()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return status.New(codes.InvalidArgument, fmt.Sprintf("error receiving console request: %v", err)).Err()
+	}
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", req.SandboxId),
+		attribute.String("client.id", consul.ClientID),
+		attribute.Bool("console.writable", req.Writable),
+	)
+
+	sbx, ok := s.sandboxes.Get(req.SandboxId)
+	if !ok {
+		errMsg := fmt.Errorf("sandbox '%s' not found", req.SandboxId)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return errcode.GRPCError(codes.NotFound, errcode.SandboxNotFound, errMsg.Error())
+	}
+
+	writable := req.Writable
+
+	output, detach := sbx.AttachConsole()
+	defer detach()
+
+	sbx.Logger.Infof("Console attached (writable: %t)", writable)
+	defer sbx.Logger.Infof("Console detached")
+
+	inputErr := make(chan error, 1)
+
+	go func() {
+		for {
+			in, recvErr := stream.Recv()
+			if recvErr != nil {
+				inputErr <- recvErr
+
+				return
+			}
+
+			if len(in.Input) == 0 {
+				continue
+			}
+
+			if !writable {
+				inputErr <- status.New(codes.PermissionDenied, "console is attached as read-only").Err()
+
+				return
+			}
+
+			writeErr := sbx.WriteConsole(in.Input)
+			if writeErr != nil {
+				inputErr <- status.New(codes.Internal, writeErr.Error()).Err()
+
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-inputErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		case chunk, ok := <-output:
+			if !ok {
+				// The sandbox stopped.
+				return nil
+			}
+
+			err := stream.Send(&orchestrator.SandboxConsoleResponse{
+				Output: chunk,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/consul"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) Diagnostics(ctx context.Context, in *orchestrator.SandboxDiagnosticsRequest) (*orchestrator.SandboxDiagnosticsResponse, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-diagnostics")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", in.SandboxId),
+		attribute.String("client.id", consul.ClientID),
+	)
+
+	diagnostics, err := sandbox.GetDiagnostics(ctx, gcs.TemplateBucket, in.SandboxId)
+	if errors.Is(err, gcs.ErrObjectNotExist) {
+		return nil, status.New(codes.NotFound, fmt.Sprintf("diagnostics for sandbox '%s' not found", in.SandboxId)).Err()
+	}
+
+	if err != nil {
+		errMsg := fmt.Errorf("error getting diagnostics for sandbox '%s': %w", in.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	bundle, err := json.Marshal(diagnostics)
+	if err != nil {
+		errMsg := fmt.Errorf("error marshaling diagnostics for sandbox '%s': %w", in.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	return &orchestrator.SandboxDiagnosticsResponse{
+		TeamId: diagnostics.TeamID,
+		Bundle: bundle,
+	}, nil
+}
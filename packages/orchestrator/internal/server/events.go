@@ -0,0 +1,113 @@
+package server
+
+import (
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/consul"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// watcherBufferSize is the number of the events a watcher can be behind before it is disconnected.
+const watcherBufferSize = 1024
+
+type watchers struct {
+	mu          sync.Mutex
+	subscribers map[chan *orchestrator.SandboxEvent]struct{}
+}
+
+func newWatchers() *watchers {
+	return &watchers{
+		subscribers: make(map[chan *orchestrator.SandboxEvent]struct{}),
+	}
+}
+
+func (w *watchers) subscribe() chan *orchestrator.SandboxEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	events := make(chan *orchestrator.SandboxEvent, watcherBufferSize)
+	w.subscribers[events] = struct{}{}
+
+	return events
+}
+
+func (w *watchers) unsubscribe(events chan *orchestrator.SandboxEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, ok := w.subscribers[events]
+	if ok {
+		delete(w.subscribers, events)
+		close(events)
+	}
+}
+
+// broadcastLocked never blocks, the watchers that can't keep up are disconnected and have to list the sandboxes again.
+// The caller must hold the lock.
+func (w *watchers) broadcastLocked(event *orchestrator.SandboxEvent) {
+	for events := range w.subscribers {
+		select {
+		case events <- event:
+		default:
+			delete(w.subscribers, events)
+			close(events)
+		}
+	}
+}
+
+// publish changes the revision of the sandboxes and sends the event to the watchers.
+func (s *server) publish(eventType orchestrator.SandboxEventType, sbx *sandbox.Sandbox) {
+	s.publishEvent(eventType, sbx, nil)
+}
+
+// publishEviction requests the watchers to pause the sandbox evicted from the node.
+func (s *server) publishEviction(sbx *sandbox.Sandbox, eviction *orchestrator.SandboxEviction) {
+	s.publishEvent(orchestrator.SandboxEventType_PAUSE_REQUESTED, sbx, eviction)
+}
+
+// The lock makes sure the events are sent in the order of the revisions.
+func (s *server) publishEvent(eventType orchestrator.SandboxEventType, sbx *sandbox.Sandbox, eviction *orchestrator.SandboxEviction) {
+	s.watchers.mu.Lock()
+	defer s.watchers.mu.Unlock()
+
+	revision := s.revision.Add(1)
+
+	s.watchers.broadcastLocked(&orchestrator.SandboxEvent{
+		Type: eventType,
+		Sandbox: &orchestrator.RunningSandbox{
+			Config:    withoutSecrets(sbx.Config),
+			ClientId:  consul.ClientID,
+			StartTime: timestamppb.New(sbx.StartedAt),
+			EndTime:   timestamppb.New(sbx.EndAt),
+		},
+		Revision:  revision,
+		Timestamp: timestamppb.Now(),
+		Eviction:  eviction,
+	})
+}
+
+func (s *server) Watch(_ *orchestrator.SandboxWatchRequest, stream orchestrator.SandboxService_WatchServer) error {
+	events := s.watchers.subscribe()
+	defer s.watchers.unsubscribe(events)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "the watcher can't keep up with the sandbox events")
+			}
+
+			err := stream.Send(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
+	e2bhealth "github.com/e2b-dev/infra/packages/shared/pkg/health"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+const meminfoPath = "/proc/meminfo"
+
+// HealthProbes returns the probes of the deep health check of the node.
+func (s *Server) HealthProbes() []e2bhealth.Probe {
+	return []e2bhealth.Probe{
+		{Name: "nbd-pool", Check: checkNBDPool},
+		{Name: "hugepages", Check: checkHugePages},
+		{Name: "template-storage", Check: checkTemplateStorage},
+	}
+}
+
+// checkNBDPool degrades the node when all devices are used, the new sandboxes wait for a device to be released.
+func checkNBDPool(_ context.Context) error {
+	if nbd.Pool.Saturated() {
+		return e2bhealth.DegradedError(fmt.Errorf("all nbd devices are used"))
+	}
+
+	return nil
+}
+
+// checkHugePages degrades the node when it has no free hugepages, the sandboxes with hugepages can't be started.
+func checkHugePages(_ context.Context) error {
+	f, err := os.Open(meminfoPath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", meminfoPath, err)
+	}
+	defer f.Close()
+
+	values := make(map[string]int64)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok || !strings.HasPrefix(key, "HugePages_") {
+			continue
+		}
+
+		parsed, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", key, err)
+		}
+
+		values[key] = parsed
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", meminfoPath, err)
+	}
+
+	if values["HugePages_Total"] == 0 {
+		return e2bhealth.DegradedError(fmt.Errorf("no hugepages are allocated"))
+	}
+
+	if values["HugePages_Free"] == 0 {
+		return e2bhealth.DegradedError(fmt.Errorf("all %d hugepages are used", values["HugePages_Total"]))
+	}
+
+	return nil
+}
+
+// checkTemplateStorage checks the templates can be read from the bucket the node reads them from.
+func checkTemplateStorage(ctx context.Context) error {
+	return gcs.Ping(ctx, gcs.LocalTemplateBucket)
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+)
+
+// teamQuotaTTL is how long the quota of the team that doesn't create sandboxes on the node is kept.
+const teamQuotaTTL = 10 * time.Minute
+
+// recoverPanic turns the panic in the handler into the internal error, so the panic doesn't crash the node with all its sandboxes.
+func recoverPanic(_ context.Context, p any) error {
+	log.Printf("panic in gRPC handler: %v\n%s", p, debug.Stack())
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+// rpcMetrics record the latency and the status code of the requests by the method.
+type rpcMetrics struct {
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+func newRPCMetrics() (*rpcMetrics, error) {
+	requests, err := meters.GetCounter(meters.GRPCRequestsMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC requests counter: %w", err)
+	}
+
+	duration, err := meters.GetHistogram(meters.GRPCDurationMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC duration histogram: %w", err)
+	}
+
+	return &rpcMetrics{
+		requests: requests,
+		duration: duration,
+	}, nil
+}
+
+func (m *rpcMetrics) record(ctx context.Context, method string, start time.Time, err error) {
+	attributes := metric.WithAttributes(
+		attribute.String("rpc.method", method),
+		attribute.String("rpc.code", status.Code(err).String()),
+	)
+
+	m.requests.Add(ctx, 1, attributes)
+	m.duration.Record(ctx, float64(time.Since(start).Milliseconds()), attributes)
+}
+
+func (m *rpcMetrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+		m.record(ctx, info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+func (m *rpcMetrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+		m.record(ss.Context(), info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+// teamRequest is the request made on behalf of the team, e.g. the create of the sandbox.
+type teamRequest interface {
+	GetSandbox() *orchestrator.SandboxConfig
+}
+
+// teamQuotas limit how many sandboxes each team can create on the node, so the node can't be flooded by one team
+// even if the limits of the API are bypassed.
+type teamQuotas struct {
+	perMinute int64
+	limiters  *ttlcache.Cache[string, *rate.Limiter]
+}
+
+func newTeamQuotas(perMinute int64) *teamQuotas {
+	limiters := ttlcache.New(ttlcache.WithTTL[string, *rate.Limiter](teamQuotaTTL))
+	go limiters.Start()
+
+	return &teamQuotas{
+		perMinute: perMinute,
+		limiters:  limiters,
+	}
+}
+
+func (q *teamQuotas) allow(teamID string) bool {
+	limiter := rate.NewLimiter(rate.Every(time.Minute/time.Duration(q.perMinute)), int(q.perMinute))
+
+	item, _ := q.limiters.GetOrSet(teamID, limiter)
+
+	return item.Value().Allow()
+}
+
+// UnaryServerInterceptor rejects the requests of the teams over their quota, the quota isn't enforced if perMinute is zero.
+func (q *teamQuotas) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		r, ok := req.(teamRequest)
+		if !ok || q.perMinute <= 0 || r.GetSandbox() == nil || r.GetSandbox().TeamId == "" {
+			return handler(ctx, req)
+		}
+
+		if !q.allow(r.GetSandbox().TeamId) {
+			return nil, errcode.GRPCError(codes.ResourceExhausted, errcode.RateLimited, fmt.Sprintf("team '%s' has reached the quota of %d sandboxes per minute on the node", r.GetSandbox().TeamId, q.perMinute))
+		}
+
+		return handler(ctx, req)
+	}
+}
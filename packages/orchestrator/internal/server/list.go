@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/consul"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func (s *server) List(ctx context.Context, in *orchestrator.SandboxListRequest) (*orchestrator.SandboxListResponse, error) {
+	_, childSpan := s.tracer.Start(ctx, "sandbox-list")
+	defer childSpan.End()
+
+	// The revision is loaded before the sandboxes, so a change made in between is picked up by the next call
+	revision := s.revision.Load()
+
+	childSpan.SetAttributes(
+		attribute.Int64("revision", int64(revision)),
+		attribute.Int64("since_revision", int64(in.SinceRevision)),
+	)
+
+	if in.SinceRevision != 0 && in.SinceRevision == revision {
+		return &orchestrator.SandboxListResponse{
+			Revision:  revision,
+			Unchanged: true,
+		}, nil
+	}
+
+	mask := in.GetFieldMask()
+	if mask != nil && !mask.IsValid(&orchestrator.RunningSandbox{}) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid field mask: %v", mask.GetPaths())
+	}
+
+	items := slices.DeleteFunc(slices.Collect(maps.Values(s.sandboxes.Items())), func(sbx *sandbox.Sandbox) bool {
+		if sbx == nil || sbx.Config == nil {
+			return true
+		}
+
+		if in.TeamId != "" && sbx.Config.TeamId != in.TeamId {
+			return true
+		}
+
+		if in.TemplateId != "" && sbx.Config.TemplateId != in.TemplateId {
+			return true
+		}
+
+		// The page token is the ID of the last sandbox on the previous page
+		return in.PageToken != "" && sbx.Config.SandboxId <= in.PageToken
+	})
+
+	// The sandboxes are paginated by their ID, so the pages are stable when the sandboxes are added or removed
+	slices.SortFunc(items, func(a, b *sandbox.Sandbox) int {
+		return strings.Compare(a.Config.SandboxId, b.Config.SandboxId)
+	})
+
+	nextPageToken := ""
+	if in.PageSize > 0 && len(items) > int(in.PageSize) {
+		items = items[:in.PageSize]
+		nextPageToken = items[len(items)-1].Config.SandboxId
+	}
+
+	sandboxes := make([]*orchestrator.RunningSandbox, 0, len(items))
+
+	for _, sbx := range items {
+		running := &orchestrator.RunningSandbox{
+			Config:    withoutSecrets(sbx.Config),
+			ClientId:  consul.ClientID,
+			StartTime: timestamppb.New(sbx.StartedAt),
+			EndTime:   timestamppb.New(sbx.EndAt),
+		}
+
+		if mask != nil {
+			// The config is shared with the running sandbox, it can't be modified
+			running = proto.Clone(running).(*orchestrator.RunningSandbox)
+			applyFieldMask(running.ProtoReflect(), mask.GetPaths())
+		}
+
+		sandboxes = append(sandboxes, running)
+	}
+
+	return &orchestrator.SandboxListResponse{
+		Sandboxes:     sandboxes,
+		Revision:      revision,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// withoutSecrets returns the config without the secrets and the registry credential, the secrets are only passed to envd
+// and the credential is only used to pull the image, they never leave the node.
+func withoutSecrets(config *orchestrator.SandboxConfig) *orchestrator.SandboxConfig {
+	if len(config.Secrets) == 0 && config.ImageAuth == nil {
+		return config
+	}
+
+	// The config is shared with the running sandbox, it can't be modified
+	config = proto.Clone(config).(*orchestrator.SandboxConfig)
+	config.Secrets = nil
+	config.ImageAuth = nil
+
+	return config
+}
+
+// applyFieldMask clears the fields that are not in the paths, the fields of the nested messages are separated by a dot.
+func applyFieldMask(message protoreflect.Message, paths []string) {
+	kept := make(map[string]bool)
+	nested := make(map[string][]string)
+
+	for _, path := range paths {
+		field, rest, found := strings.Cut(path, ".")
+		if found {
+			nested[field] = append(nested[field], rest)
+		} else {
+			kept[field] = true
+		}
+	}
+
+	var cleared []protoreflect.FieldDescriptor
+
+	message.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := string(fd.Name())
+
+		switch {
+		case kept[name]:
+		case nested[name] != nil && fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			applyFieldMask(value.Message(), nested[name])
+		default:
+			cleared = append(cleared, fd)
+		}
+
+		return true
+	})
+
+	for _, fd := range cleared {
+		message.Clear(fd)
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"github.com/jellydator/ttlcache/v3"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -14,13 +17,25 @@ import (
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/cfg"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/chaos"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/dns"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/encryption"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/eviction"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/slo"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/watchdog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	e2bgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/requestid"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
 const ServiceName = "orchestrator"
@@ -32,11 +47,58 @@ type server struct {
 	tracer        trace.Tracer
 	networkPool   *network.Pool
 	templateCache *template.Cache
+	resumeSLO     *slo.Monitor
+	metrics       *versionMetrics
+	// revision is changed on every change of the sandboxes, so the API can skip the sync if nothing changed.
+	revision atomic.Uint64
+	watchers *watchers
+	// uploads are the states of the snapshot uploads by the build ID.
+	uploads *ttlcache.Cache[string, orchestrator.SnapshotUploadState]
+	// pauseRequests are the sandboxes evicted by the watchdog, the API is requested to pause them.
+	pauseRequests  *ttlcache.Cache[string, struct{}]
+	evictionPolicy eviction.Policy
+	watchdog       *watchdog.Watchdog
 
 	pauseMu sync.Mutex
 }
 
-func New() (*grpc.Server, error) {
+// Server is the gRPC server of the orchestrator with access to the sandboxes running on the node.
+type Server struct {
+	*grpc.Server
+	// Health is the readiness of the node, in Kubernetes the node is ready once it's registered with the API.
+	Health    *health.Server
+	sandboxes *smap.Map[*sandbox.Sandbox]
+}
+
+type ActiveSandbox struct {
+	SandboxID  string    `json:"sandboxID"`
+	TemplateID string    `json:"templateID"`
+	BuildID    string    `json:"buildID"`
+	TeamID     string    `json:"teamID"`
+	StartedAt  time.Time `json:"startedAt"`
+	EndAt      time.Time `json:"endAt"`
+}
+
+// ActiveSandboxes returns the sandboxes running on the node, it is used by the diagnostics server.
+func (s *Server) ActiveSandboxes(ctx context.Context) any {
+	items := s.sandboxes.Items()
+
+	active := make([]ActiveSandbox, 0, len(items))
+	for _, sbx := range items {
+		active = append(active, ActiveSandbox{
+			SandboxID:  sbx.Config.SandboxId,
+			TemplateID: sbx.Config.TemplateId,
+			BuildID:    sbx.Config.BuildId,
+			TeamID:     sbx.Config.TeamId,
+			StartedAt:  sbx.StartedAt,
+			EndAt:      sbx.EndAt,
+		})
+	}
+
+	return active
+}
+
+func New(config *cfg.Config) (*Server, error) {
 	ctx := context.Background()
 
 	dnsServer := dns.New()
@@ -59,22 +121,112 @@ func New() (*grpc.Server, error) {
 		return nil, fmt.Errorf("failed to create network pool: %w", err)
 	}
 
+	nbd.Pool, err = nbd.NewDevicePool()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create nbd device pool: %w", err)
+	}
+
+	resumeSLO, err := slo.NewMonitor()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resume SLO monitor: %w", err)
+	}
+
+	err = chaos.Init()
+	if err != nil {
+		return nil, fmt.Errorf("failed to init fault injection: %w", err)
+	}
+
+	err = encryption.Init(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init snapshot encryption: %w", err)
+	}
+
+	err = fc.InitJailer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to init firecracker jailer: %w", err)
+	}
+
+	metrics, err := newVersionMetrics()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create version metrics: %w", err)
+	}
+
+	rpcMetrics, err := newRPCMetrics()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC metrics: %w", err)
+	}
+
+	if config.Token == "" {
+		log.Printf("ORCHESTRATOR_TOKEN is not set, the gRPC calls aren't authenticated and any process that can reach the node can create sandboxes")
+	}
+
+	quotas := newTeamQuotas(config.TeamCreateQuota)
+
+	// The metrics are recorded first, so they include the rejected calls and the recovered panics
 	s := grpc.NewServer(
 		grpc.StatsHandler(e2bgrpc.NewStatsWrapper(otelgrpc.NewServerHandler())),
 		grpc.ChainUnaryInterceptor(
-			recovery.UnaryServerInterceptor(),
+			rpcMetrics.UnaryServerInterceptor(),
+			recovery.UnaryServerInterceptor(recovery.WithRecoveryHandlerContext(recoverPanic)),
+			e2bgrpc.UnaryServerTokenInterceptor(config.Token),
+			requestid.UnaryServerInterceptor(),
+			errcode.UnaryServerInterceptor(),
+			quotas.UnaryServerInterceptor(),
+			chaos.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			rpcMetrics.StreamServerInterceptor(),
+			recovery.StreamServerInterceptor(recovery.WithRecoveryHandlerContext(recoverPanic)),
+			e2bgrpc.StreamServerTokenInterceptor(config.Token),
+			requestid.StreamServerInterceptor(),
+			errcode.StreamServerInterceptor(),
 		),
 	)
 
-	orchestrator.RegisterSandboxServiceServer(s, &server{
-		tracer:        otel.Tracer(ServiceName),
-		dns:           dnsServer,
-		sandboxes:     smap.New[*sandbox.Sandbox](),
-		networkPool:   networkPool,
-		templateCache: templateCache,
-	})
+	sandboxes := smap.New[*sandbox.Sandbox]()
+
+	err = registerCgroupMetrics(sandboxes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sandbox cgroup metrics: %w", err)
+	}
+
+	evictionPolicy, err := eviction.PolicyFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get eviction policy: %w", err)
+	}
+
+	srv := &server{
+		tracer:         otel.Tracer(ServiceName),
+		dns:            dnsServer,
+		sandboxes:      sandboxes,
+		networkPool:    networkPool,
+		templateCache:  templateCache,
+		resumeSLO:      resumeSLO,
+		metrics:        metrics,
+		watchers:       newWatchers(),
+		uploads:        newSnapshotUploads(),
+		pauseRequests:  newPauseRequests(),
+		evictionPolicy: evictionPolicy,
+	}
+	// The revision starts at the current time, so it doesn't repeat after the orchestrator restarts
+	srv.revision.Store(uint64(time.Now().UnixNano()))
+
+	srv.watchdog, err = watchdog.New([]string{storage.SandboxCacheDir, storage.TemplateCacheDir}, srv.evict)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watchdog: %w", err)
+	}
+
+	go srv.watchdog.Start(ctx)
+
+	orchestrator.RegisterSandboxServiceServer(s, srv)
+
+	healthServer := health.NewServer()
+	if env.IsKubernetes() {
+		// The node isn't ready until it's registered with the API, so the rollout waits for the registration
+		healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	}
 
-	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
+	grpc_health_v1.RegisterHealthServer(s, healthServer)
 
-	return s, nil
+	return &Server{Server: s, Health: healthServer, sandboxes: sandboxes}, nil
 }
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+// versionMetrics are labeled by the firecracker version, so the canary version can be compared with the stable one
+// by the start latency and the crash rate (crashed / started).
+type versionMetrics struct {
+	started       metric.Int64Counter
+	startFailed   metric.Int64Counter
+	crashed       metric.Int64Counter
+	startDuration metric.Float64Histogram
+}
+
+func newVersionMetrics() (*versionMetrics, error) {
+	started, err := meters.GetCounter(meters.SandboxStartedMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sandbox started counter: %w", err)
+	}
+
+	startFailed, err := meters.GetCounter(meters.SandboxStartFailedMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sandbox start failed counter: %w", err)
+	}
+
+	crashed, err := meters.GetCounter(meters.SandboxCrashedMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sandbox crashed counter: %w", err)
+	}
+
+	startDuration, err := meters.GetHistogram(meters.SandboxStartDurationMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sandbox start duration histogram: %w", err)
+	}
+
+	return &versionMetrics{
+		started:       started,
+		startFailed:   startFailed,
+		crashed:       crashed,
+		startDuration: startDuration,
+	}, nil
+}
+
+func versionAttributes(config *orchestrator.SandboxConfig, extra ...attribute.KeyValue) metric.MeasurementOption {
+	return metric.WithAttributes(append([]attribute.KeyValue{
+		attribute.String("firecracker.version", config.FirecrackerVersion),
+		attribute.String("kernel.version", config.KernelVersion),
+		attribute.Bool("snapshot", config.Snapshot),
+		attribute.Bool("firecracker.jailer", fc.UseJailer(config.SandboxId)),
+	}, extra...)...)
+}
+
+func (m *versionMetrics) recordStart(ctx context.Context, config *orchestrator.SandboxConfig, duration time.Duration, err error) {
+	if err != nil {
+		m.startFailed.Add(ctx, 1, versionAttributes(config))
+
+		return
+	}
+
+	attributes := versionAttributes(config)
+
+	m.started.Add(ctx, 1, attributes)
+	m.startDuration.Record(ctx, float64(duration.Milliseconds()), attributes)
+}
+
+func (m *versionMetrics) recordCrash(ctx context.Context, config *orchestrator.SandboxConfig, reason sandbox.TerminationReason) {
+	m.crashed.Add(ctx, 1, versionAttributes(config, attribute.String("reason", string(reason))))
+}
+
+// registerCgroupMetrics registers the gauges of the resource usage of the sandboxes started under the jailer, the other sandboxes are skipped.
+func registerCgroupMetrics(sandboxes *smap.Map[*sandbox.Sandbox]) error {
+	gauges := map[meters.GaugeFloatType]func(stats *fc.CgroupStats) float64{
+		meters.SandboxCgroupCPUUsageMeterName: func(stats *fc.CgroupStats) float64 {
+			return stats.CPUUsage.Seconds()
+		},
+		meters.SandboxCgroupCPUThrottledMeterName: func(stats *fc.CgroupStats) float64 {
+			return stats.CPUThrottled.Seconds()
+		},
+		meters.SandboxCgroupMemoryUsageMeterName: func(stats *fc.CgroupStats) float64 {
+			return float64(stats.MemoryBytes)
+		},
+	}
+
+	for name, value := range gauges {
+		_, err := meters.GetGaugeFloat(name, func(ctx context.Context, observer metric.Float64Observer) error {
+			for _, sbx := range sandboxes.Items() {
+				stats, err := sbx.CgroupStats()
+				if err != nil {
+					continue
+				}
+
+				observer.Observe(value(stats), metric.WithAttributes(
+					attribute.String("sandbox.id", sbx.Config.SandboxId),
+					attribute.String("team.id", sbx.Config.TeamId),
+				))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create %s gauge: %w", name, err)
+		}
+	}
+
+	return nil
+}
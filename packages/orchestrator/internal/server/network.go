@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/consul"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) NetworkActivity(ctx context.Context, in *orchestrator.SandboxNetworkActivityRequest) (*orchestrator.SandboxNetworkActivityResponse, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-network-activity")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", in.SandboxId),
+		attribute.String("client.id", consul.ClientID),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		errMsg := fmt.Errorf("sandbox '%s' not found", in.SandboxId)
+		telemetry.ReportError(ctx, errMsg)
+
+		return nil, errcode.GRPCError(codes.NotFound, errcode.SandboxNotFound, errMsg.Error())
+	}
+
+	activity := sbx.NetworkActivity()
+
+	destinations := make([]*orchestrator.NetworkDestination, 0, len(activity))
+	for _, destination := range activity {
+		destinations = append(destinations, &orchestrator.NetworkDestination{
+			Ip:            destination.IP,
+			Port:          uint32(destination.Port),
+			Protocol:      destination.Protocol,
+			Connections:   destination.Connections,
+			BytesSent:     destination.BytesSent,
+			BytesReceived: destination.BytesReceived,
+			LastSeen:      timestamppb.New(destination.LastSeen),
+		})
+	}
+
+	return &orchestrator.SandboxNetworkActivityResponse{
+		Destinations: destinations,
+	}, nil
+}
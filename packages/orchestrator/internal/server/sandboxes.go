@@ -7,24 +7,33 @@ import (
 	"log"
 	"os"
 	"sync"
+	"time"
 
+	"github.com/jellydator/ttlcache/v3"
 	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/consul"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/encryption"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
-	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
+	"github.com/e2b-dev/infra/packages/shared/pkg/errcode"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/requestid"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
-const maxParalellSnapshotting = 8
+const (
+	maxParalellSnapshotting = 8
+
+	diagnosticsUploadTimeout = 30 * time.Second
+)
 
 func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequest) (*orchestrator.SandboxCreateResponse, error) {
 	childCtx, childSpan := s.tracer.Start(ctx, "sandbox-create")
@@ -33,11 +42,27 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 	childSpan.SetAttributes(
 		attribute.String("template.id", req.Sandbox.TemplateId),
 		attribute.String("kernel.version", req.Sandbox.KernelVersion),
+		attribute.String("firecracker.version", req.Sandbox.FirecrackerVersion),
 		attribute.String("sandbox.id", req.Sandbox.SandboxId),
 		attribute.String("client.id", consul.ClientID),
 		attribute.String("envd.version", req.Sandbox.EnvdVersion),
+		attribute.String("sandbox.priority", req.Sandbox.Priority.String()),
 	)
 
+	// The sandbox would wait for a free nbd device, it should be placed on another node instead
+	if nbd.Pool.Saturated() {
+		telemetry.ReportEvent(childCtx, "nbd device pool is saturated")
+
+		return nil, errcode.GRPCError(codes.ResourceExhausted, errcode.NodeCapacity, "no free nbd devices on the node")
+	}
+
+	// The new sandbox would add to the resource pressure, it should be placed on another node instead
+	if !s.watchdog.AcceptsSandboxes() {
+		telemetry.ReportEvent(childCtx, "node is under resource pressure", attribute.String("watchdog.level", s.watchdog.Level().String()))
+
+		return nil, errcode.GRPCError(codes.ResourceExhausted, errcode.NodeCapacity, fmt.Sprintf("the node is under resource pressure (%s)", s.watchdog.Level()))
+	}
+
 	logger := logs.NewSandboxLogger(
 		req.Sandbox.SandboxId,
 		req.Sandbox.TemplateId,
@@ -47,6 +72,8 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 		false,
 	)
 
+	start := time.Now()
+
 	sbx, cleanup, err := sandbox.NewSandbox(
 		childCtx,
 		s.tracer,
@@ -61,8 +88,9 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 		req.Sandbox.Snapshot,
 		req.Sandbox.BaseTemplateId,
 	)
+	s.metrics.recordStart(childCtx, req.Sandbox, time.Since(start), err)
 	if err != nil {
-		log.Printf("failed to create sandbox -> clean up: %v", err)
+		log.Printf("failed to create sandbox -> clean up (%s=%s): %v", requestid.LogField, requestid.FromContext(ctx), err)
 		cleanupErr := cleanup.Run()
 
 		errMsg := fmt.Errorf("failed to create sandbox: %w", errors.Join(err, context.Cause(ctx), cleanupErr))
@@ -72,6 +100,9 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 	}
 
 	s.sandboxes.Insert(req.Sandbox.SandboxId, sbx)
+	s.publish(orchestrator.SandboxEventType_CREATED, sbx)
+
+	s.resumeSLO.Observe(childCtx, req.Sandbox.SandboxId, childSpan.SpanContext().TraceID().String(), sbx.StartTimings)
 
 	go func() {
 		waitErr := sbx.Wait()
@@ -79,12 +110,32 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 			fmt.Fprintf(os.Stderr, "failed to wait for Sandbox: %v\n", waitErr)
 		}
 
+		if diagnostics := sbx.Diagnostics(); diagnostics != nil {
+			logger.Errorf("Sandbox terminated unexpectedly (%s): %s", diagnostics.Reason, diagnostics.Error)
+
+			s.metrics.recordCrash(context.Background(), req.Sandbox, diagnostics.Reason)
+
+			uploadCtx, cancel := context.WithTimeout(context.Background(), diagnosticsUploadTimeout)
+			uploadErr := sandbox.UploadDiagnostics(uploadCtx, gcs.TemplateBucket, diagnostics)
+			cancel()
+
+			if uploadErr != nil {
+				fmt.Fprintf(os.Stderr, "failed to upload diagnostics for Sandbox: %v\n", uploadErr)
+			}
+		}
+
 		cleanupErr := cleanup.Run()
 		if cleanupErr != nil {
 			fmt.Fprintf(os.Stderr, "failed to cleanup Sandbox: %v\n", cleanupErr)
 		}
 
-		s.sandboxes.Remove(req.Sandbox.SandboxId)
+		// The sandbox is already removed if it was killed or paused, the sandbox with the same ID could have been resumed since
+		removed := s.sandboxes.RemoveCb(req.Sandbox.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
+			return exists && v == sbx
+		})
+		if removed {
+			s.publish(orchestrator.SandboxEventType_EXITED, sbx)
+		}
 
 		logger.Infof("Sandbox killed")
 	}()
@@ -108,42 +159,20 @@ func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequ
 		errMsg := fmt.Errorf("sandbox not found")
 		telemetry.ReportCriticalError(ctx, errMsg)
 
-		return nil, status.New(codes.NotFound, errMsg.Error()).Err()
+		return nil, errcode.GRPCError(codes.NotFound, errcode.SandboxNotFound, errMsg.Error())
 	}
 
-	item.EndAt = req.EndTime.AsTime()
-
-	return &emptypb.Empty{}, nil
-}
-
-func (s *server) List(ctx context.Context, _ *emptypb.Empty) (*orchestrator.SandboxListResponse, error) {
-	_, childSpan := s.tracer.Start(ctx, "sandbox-list")
-	defer childSpan.End()
-
-	items := s.sandboxes.Items()
-
-	sandboxes := make([]*orchestrator.RunningSandbox, 0, len(items))
-
-	for _, sbx := range items {
-		if sbx == nil {
-			continue
-		}
-
-		if sbx.Config == nil {
-			continue
-		}
+	if req.EndTime != nil {
+		item.EndAt = req.EndTime.AsTime()
+	}
 
-		sandboxes = append(sandboxes, &orchestrator.RunningSandbox{
-			Config:    sbx.Config,
-			ClientId:  consul.ClientID,
-			StartTime: timestamppb.New(sbx.StartedAt),
-			EndTime:   timestamppb.New(sbx.EndAt),
-		})
+	if req.Labels != nil {
+		item.Config.Labels = req.Labels.Labels
 	}
 
-	return &orchestrator.SandboxListResponse{
-		Sandboxes: sandboxes,
-	}, nil
+	s.publish(orchestrator.SandboxEventType_UPDATED, item)
+
+	return &emptypb.Empty{}, nil
 }
 
 func (s *server) Delete(ctx context.Context, in *orchestrator.SandboxDeleteRequest) (*emptypb.Empty, error) {
@@ -160,7 +189,7 @@ func (s *server) Delete(ctx context.Context, in *orchestrator.SandboxDeleteReque
 		errMsg := fmt.Errorf("sandbox '%s' not found", in.SandboxId)
 		telemetry.ReportCriticalError(ctx, errMsg)
 
-		return nil, status.New(codes.NotFound, errMsg.Error()).Err()
+		return nil, errcode.GRPCError(codes.NotFound, errcode.SandboxNotFound, errMsg.Error())
 	}
 
 	// Don't allow connecting to the sandbox anymore.
@@ -171,6 +200,7 @@ func (s *server) Delete(ctx context.Context, in *orchestrator.SandboxDeleteReque
 	// 	Ensure the sandbox is removed from cache.
 	// 	Ideally we would rely only on the goroutine defer.
 	s.sandboxes.Remove(in.SandboxId)
+	s.publish(orchestrator.SandboxEventType_KILLED, sbx)
 
 	// Check health metrics before stopping the sandbox
 	sbx.Healthcheck(ctx, true)
@@ -178,7 +208,7 @@ func (s *server) Delete(ctx context.Context, in *orchestrator.SandboxDeleteReque
 
 	err := sbx.Stop()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error stopping sandbox '%s': %v\n", in.SandboxId, err)
+		fmt.Fprintf(os.Stderr, "error stopping sandbox '%s' (%s=%s): %v\n", in.SandboxId, requestid.LogField, requestid.FromContext(ctx), err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -194,7 +224,7 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 	if err != nil {
 		telemetry.ReportCriticalError(ctx, err)
 
-		return nil, status.New(codes.ResourceExhausted, err.Error()).Err()
+		return nil, errcode.GRPCError(codes.ResourceExhausted, errcode.NodeCapacity, err.Error())
 	}
 
 	releaseOnce := sync.OnceFunc(func() {
@@ -203,20 +233,57 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 
 	defer releaseOnce()
 
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		errMsg := fmt.Errorf("sandbox not found")
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, errcode.GRPCError(codes.NotFound, errcode.SandboxNotFound, errMsg.Error())
+	}
+
+	// The sandboxes booted from the images have no memory snapshot to resume from
+	if sbx.Config.Image != nil {
+		telemetry.ReportCriticalError(ctx, sandbox.ErrNoSnapshot)
+
+		return nil, errcode.GRPCError(codes.FailedPrecondition, errcode.PreconditionFailed, sandbox.ErrNoSnapshot.Error())
+	}
+
+	// The hook runs while the sandbox is still running and reachable, if it fails the sandbox is not paused.
+	err = sbx.RunPauseHook(ctx, s.tracer)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, errcode.GRPCError(codes.FailedPrecondition, errcode.HookFailed, err.Error())
+	}
+
+	// The data key is wrapped before the sandbox is paused, so the sandbox keeps running if its snapshot can't be encrypted
+	var snapshotEncryption storage.DiffEncryption
+	if sbx.Config.EncryptSnapshots {
+		snapshotEncryption, err = encryption.NewEncryption(ctx, sbx.Config.TeamId)
+		if err != nil {
+			errMsg := fmt.Errorf("error preparing snapshot encryption: %w", err)
+			telemetry.ReportCriticalError(ctx, errMsg)
+
+			return nil, status.New(codes.FailedPrecondition, errMsg.Error()).Err()
+		}
+	}
+
 	s.pauseMu.Lock()
 
-	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	// The sandbox could have been removed while the hook was running
+	sbx, ok = s.sandboxes.Get(in.SandboxId)
 	if !ok {
 		s.pauseMu.Unlock()
 
 		errMsg := fmt.Errorf("sandbox not found")
 		telemetry.ReportCriticalError(ctx, errMsg)
 
-		return nil, status.New(codes.NotFound, errMsg.Error()).Err()
+		return nil, errcode.GRPCError(codes.NotFound, errcode.SandboxNotFound, errMsg.Error())
 	}
 
 	s.dns.Remove(in.SandboxId, sbx.Slot.HostIP())
 	s.sandboxes.Remove(in.SandboxId)
+	s.publish(orchestrator.SandboxEventType_PAUSED, sbx)
 
 	s.pauseMu.Unlock()
 
@@ -272,6 +339,7 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 		snapshot.Snapfile,
 		snapshot.MemfileDiff,
 		snapshot.RootfsDiff,
+		snapshot.MemfilePrefetch,
 	)
 	if err != nil {
 		errMsg := fmt.Errorf("error adding snapshot to template cache: %w", err)
@@ -282,57 +350,10 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 
 	telemetry.ReportEvent(ctx, "added snapshot to template cache")
 
-	go func() {
-		var memfilePath *string
-
-		switch r := snapshot.MemfileDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			memfileLocalPath, err := r.CachePath()
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "error getting memfile diff path: %v\n", err)
-
-				return
-			}
-
-			memfilePath = &memfileLocalPath
-		}
-
-		var rootfsPath *string
-
-		switch r := snapshot.RootfsDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			rootfsLocalPath, err := r.CachePath()
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "error getting rootfs diff path: %v\n", err)
-
-				return
-			}
-
-			rootfsPath = &rootfsLocalPath
-		}
+	// The snapshot can be resumed from the node cache right away, the upload is tracked so the API knows when it's durable
+	s.uploads.Set(in.BuildId, orchestrator.SnapshotUploadState_SNAPSHOT_UPLOADING, ttlcache.NoTTL)
 
-		b := storage.NewTemplateBuild(
-			snapshot.MemfileDiffHeader,
-			snapshot.RootfsDiffHeader,
-			snapshotTemplateFiles.TemplateFiles,
-		)
-
-		err = <-b.Upload(
-			context.Background(),
-			snapshotTemplateFiles.CacheSnapfilePath(),
-			memfilePath,
-			rootfsPath,
-		)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "error uploading sandbox snapshot '%s': %v\n", in.SandboxId, err)
-
-			return
-		}
-	}()
+	go s.uploadSnapshot(in.SandboxId, in.BuildId, snapshotTemplateFiles, snapshot, snapshotEncryption)
 
 	return &emptypb.Empty{}, nil
 }
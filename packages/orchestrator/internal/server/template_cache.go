@@ -2,10 +2,15 @@ package server
 
 import (
 	"context"
+	"fmt"
 
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 )
 
@@ -19,6 +24,7 @@ func (s *server) ListCachedBuilds(ctx context.Context, _ *emptypb.Empty) (*orche
 		builds = append(builds, &orchestrator.CachedBuildInfo{
 			BuildId:        key,
 			ExpirationTime: timestamppb.New(item.ExpiresAt()),
+			Pinned:         s.templateCache.IsPinned(key),
 		})
 	}
 
@@ -26,3 +32,41 @@ func (s *server) ListCachedBuilds(ctx context.Context, _ *emptypb.Empty) (*orche
 		Builds: builds,
 	}, nil
 }
+
+func (s *server) SetPinnedBuilds(ctx context.Context, in *orchestrator.SandboxSetPinnedBuildsRequest) (*emptypb.Empty, error) {
+	_, childSpan := s.tracer.Start(ctx, "set-pinned-builds")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(attribute.Int("builds", len(in.Builds)))
+
+	s.templateCache.SetPinned(in.Builds)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) Prefetch(ctx context.Context, in *orchestrator.SandboxPrefetchRequest) (*emptypb.Empty, error) {
+	_, childSpan := s.tracer.Start(ctx, "prefetch-build")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(attribute.String("build.id", in.BuildId))
+
+	t, err := s.templateCache.GetTemplate(in.TemplateId, in.BuildId, in.KernelVersion, in.FirecrackerVersion, in.HugePages, in.Snapshot, "")
+	if err != nil {
+		return nil, status.New(codes.Internal, fmt.Sprintf("failed to get template: %s", err)).Err()
+	}
+
+	// The files are fetched in the background, they are ready once their headers are read from the storage
+	for _, getStorage := range []func() (*template.Storage, error){t.Memfile, t.Rootfs} {
+		_, err = getStorage()
+		if err != nil {
+			return nil, status.New(codes.Internal, fmt.Sprintf("failed to fetch build '%s': %s", in.BuildId, err)).Err()
+		}
+	}
+
+	_, err = t.Snapfile()
+	if err != nil {
+		return nil, status.New(codes.Internal, fmt.Sprintf("failed to fetch snapfile of build '%s': %s", in.BuildId, err)).Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+const (
+	snapshotUploadAttempts   = 3
+	snapshotUploadRetryDelay = 10 * time.Second
+	// How long the result of the upload is kept, the API has to pick it up in this time.
+	snapshotUploadStateExpiration = 24 * time.Hour
+)
+
+func newSnapshotUploads() *ttlcache.Cache[string, orchestrator.SnapshotUploadState] {
+	uploads := ttlcache.New(
+		ttlcache.WithTTL[string, orchestrator.SnapshotUploadState](snapshotUploadStateExpiration),
+		ttlcache.WithDisableTouchOnHit[string, orchestrator.SnapshotUploadState](),
+	)
+
+	go uploads.Start()
+
+	return uploads
+}
+
+// uploadSnapshot uploads the paused sandbox snapshot to the storage and records the result of the upload.
+func (s *server) uploadSnapshot(sandboxID, buildID string, files *storage.TemplateCacheFiles, snapshot *sandbox.Snapshot, encryption storage.DiffEncryption) {
+	var err error
+
+	for attempt := 1; attempt <= snapshotUploadAttempts; attempt++ {
+		err = uploadSnapshotFiles(files, snapshot, encryption)
+		if err == nil {
+			s.uploads.Set(buildID, orchestrator.SnapshotUploadState_SNAPSHOT_UPLOADED, ttlcache.DefaultTTL)
+
+			return
+		}
+
+		fmt.Fprintf(os.Stderr, "error uploading sandbox snapshot '%s' (attempt %d/%d): %v\n", sandboxID, attempt, snapshotUploadAttempts, err)
+
+		if attempt < snapshotUploadAttempts {
+			time.Sleep(snapshotUploadRetryDelay)
+		}
+	}
+
+	s.uploads.Set(buildID, orchestrator.SnapshotUploadState_SNAPSHOT_UPLOAD_FAILED, ttlcache.DefaultTTL)
+}
+
+func uploadSnapshotFiles(files *storage.TemplateCacheFiles, snapshot *sandbox.Snapshot, encryption storage.DiffEncryption) error {
+	var memfilePath *string
+
+	switch r := snapshot.MemfileDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		memfileLocalPath, err := r.CachePath()
+		if err != nil {
+			return fmt.Errorf("error getting memfile diff path: %w", err)
+		}
+
+		memfilePath = &memfileLocalPath
+	}
+
+	var rootfsPath *string
+
+	switch r := snapshot.RootfsDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		rootfsLocalPath, err := r.CachePath()
+		if err != nil {
+			return fmt.Errorf("error getting rootfs diff path: %w", err)
+		}
+
+		rootfsPath = &rootfsLocalPath
+	}
+
+	b := storage.NewTemplateBuild(
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		files.TemplateFiles,
+	)
+
+	if encryption != nil {
+		b.WithEncryption(encryption)
+	}
+
+	if snapshot.MemfilePrefetch != nil {
+		b.WithMemfilePrefetch(snapshot.MemfilePrefetch)
+	}
+
+	err := <-b.Upload(
+		context.Background(),
+		files.CacheSnapfilePath(),
+		memfilePath,
+		rootfsPath,
+	)
+	if err != nil {
+		return err
+	}
+
+	if gcs.LocalTemplateBucket != gcs.TemplateBucket {
+		// The node reads the snapshots from the replica in its region, so the snapshot can be resumed in the region right away
+		err = storage.ReplicateBuild(context.Background(), gcs.TemplateBucket, gcs.LocalTemplateBucket, files.BuildId)
+		if err != nil {
+			return fmt.Errorf("error replicating snapshot to the region of the node: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) SnapshotUploads(ctx context.Context, in *orchestrator.SandboxSnapshotUploadsRequest) (*orchestrator.SandboxSnapshotUploadsResponse, error) {
+	_, childSpan := s.tracer.Start(ctx, "snapshot-uploads")
+	defer childSpan.End()
+
+	states := make(map[string]orchestrator.SnapshotUploadState, len(in.BuildIds))
+
+	for _, buildID := range in.BuildIds {
+		item := s.uploads.Get(buildID)
+		if item == nil {
+			states[buildID] = orchestrator.SnapshotUploadState_SNAPSHOT_UPLOAD_UNKNOWN
+
+			continue
+		}
+
+		states[buildID] = item.Value()
+	}
+
+	return &orchestrator.SandboxSnapshotUploadsResponse{
+		States: states,
+	}, nil
+}
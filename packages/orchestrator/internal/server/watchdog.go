@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/eviction"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// pauseRequestExpiration is how long the sandbox isn't evicted again, the API pauses it in the meantime.
+const pauseRequestExpiration = time.Minute
+
+func newPauseRequests() *ttlcache.Cache[string, struct{}] {
+	requests := ttlcache.New(
+		ttlcache.WithTTL[string, struct{}](pauseRequestExpiration),
+		ttlcache.WithDisableTouchOnHit[string, struct{}](),
+	)
+
+	go requests.Start()
+
+	return requests
+}
+
+// evict requests the API to pause the sandboxes selected by the eviction policy, it's called by the watchdog under the memory pressure.
+func (s *server) evict(_ context.Context, count int, reason string) int {
+	var candidates []eviction.Candidate
+
+	for _, sbx := range s.sandboxes.Items() {
+		if s.pauseRequests.Has(sbx.Config.SandboxId) {
+			continue
+		}
+
+		usage, err := sbx.CPUUsage()
+		if err != nil {
+			continue
+		}
+
+		candidates = append(candidates, eviction.Candidate{
+			SandboxID: sbx.Config.SandboxId,
+			Priority:  sbx.Config.Priority,
+			StartedAt: sbx.StartedAt,
+			CPUUsage:  usage,
+		})
+	}
+
+	evicted := 0
+
+	for _, candidate := range s.evictionPolicy.Select(candidates, count) {
+		sbx, ok := s.sandboxes.Get(candidate.SandboxID)
+		if !ok {
+			continue
+		}
+
+		sbx.Logger.Warnf("Sandbox is evicted from the node by the %s policy because of %s, it will be paused", s.evictionPolicy, reason)
+
+		s.pauseRequests.Set(candidate.SandboxID, struct{}{}, ttlcache.DefaultTTL)
+		s.publishEviction(sbx, &orchestrator.SandboxEviction{
+			Policy: string(s.evictionPolicy),
+			Reason: reason,
+		})
+
+		evicted++
+	}
+
+	return evicted
+}
@@ -0,0 +1,237 @@
+package slo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+)
+
+const (
+	// The burn rate is computed over the window, it is split to buckets so the old resumes can be dropped.
+	burnRateWindow = time.Hour
+	bucketDuration = time.Minute
+
+	defaultObjective = 0.99
+)
+
+var defaultBudgets = map[Stage]time.Duration{
+	StageTemplateFetch: 500 * time.Millisecond,
+	StageNBDAttach:     100 * time.Millisecond,
+	StageFCRestore:     300 * time.Millisecond,
+	StageUFFDReady:     100 * time.Millisecond,
+	StageEnvdHealthy:   200 * time.Millisecond,
+}
+
+type bucket struct {
+	start      time.Time
+	total      int64
+	overBudget int64
+}
+
+// Monitor compares the stages of each resume against their latency budgets.
+// Objective is the ratio of resumes that should be within the budget of the stage.
+type Monitor struct {
+	budgets   map[Stage]time.Duration
+	objective float64
+
+	duration   metric.Float64Histogram
+	overBudget metric.Int64Counter
+
+	mu      sync.Mutex
+	buckets map[Stage][]bucket
+}
+
+// parseBudgets parses the budgets in the "stage=duration,stage=duration" format, the missing stages use the default budget.
+func parseBudgets(value string) (map[Stage]time.Duration, error) {
+	budgets := make(map[Stage]time.Duration, len(defaultBudgets))
+	for stage, budget := range defaultBudgets {
+		budgets[stage] = budget
+	}
+
+	if value == "" {
+		return budgets, nil
+	}
+
+	for _, item := range strings.Split(value, ",") {
+		stage, duration, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid budget '%s', expected 'stage=duration'", item)
+		}
+
+		if _, ok := defaultBudgets[Stage(stage)]; !ok {
+			return nil, fmt.Errorf("unknown stage '%s'", stage)
+		}
+
+		budget, err := time.ParseDuration(duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration for stage '%s': %w", stage, err)
+		}
+
+		budgets[Stage(stage)] = budget
+	}
+
+	return budgets, nil
+}
+
+// NewMonitor creates the monitor with the budgets from the RESUME_STAGE_BUDGETS and the objective from the RESUME_SLO_OBJECTIVE environment variables.
+func NewMonitor() (*Monitor, error) {
+	budgets, err := parseBudgets(os.Getenv("RESUME_STAGE_BUDGETS"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse resume stage budgets: %w", err)
+	}
+
+	objective := defaultObjective
+	if value := os.Getenv("RESUME_SLO_OBJECTIVE"); value != "" {
+		objective, err = strconv.ParseFloat(value, 64)
+		if err != nil || objective <= 0 || objective >= 1 {
+			return nil, fmt.Errorf("invalid resume SLO objective '%s', expected a number between 0 and 1", value)
+		}
+	}
+
+	duration, err := meters.GetHistogram(meters.ResumeStageDurationMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resume stage duration histogram: %w", err)
+	}
+
+	overBudget, err := meters.GetCounter(meters.ResumeStageOverBudgetMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resume stage over budget counter: %w", err)
+	}
+
+	m := &Monitor{
+		budgets:    budgets,
+		objective:  objective,
+		duration:   duration,
+		overBudget: overBudget,
+		buckets:    make(map[Stage][]bucket),
+	}
+
+	_, err = meters.GetGaugeFloat(meters.ResumeStageBurnRateMeterName, func(ctx context.Context, observer metric.Float64Observer) error {
+		for stage, burnRate := range m.burnRates(time.Now()) {
+			observer.Observe(burnRate, metric.WithAttributes(attribute.String("stage", string(stage))))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resume stage burn rate gauge: %w", err)
+	}
+
+	return m, nil
+}
+
+// Observe records the stages of the resume. The resumes with any stage over the budget are logged as exemplars of slow resumes.
+func (m *Monitor) Observe(ctx context.Context, sandboxID, traceID string, timings *Timings) {
+	durations := timings.Durations()
+	if len(durations) == 0 {
+		return
+	}
+
+	now := time.Now()
+
+	var slow []Stage
+
+	m.mu.Lock()
+	for _, stage := range stages {
+		duration, ok := durations[stage]
+		if !ok {
+			continue
+		}
+
+		attributes := metric.WithAttributes(attribute.String("stage", string(stage)))
+
+		m.duration.Record(ctx, float64(duration.Milliseconds()), attributes)
+
+		overBudget := duration > m.budgets[stage]
+		if overBudget {
+			m.overBudget.Add(ctx, 1, attributes)
+
+			slow = append(slow, stage)
+		}
+
+		m.record(stage, now, overBudget)
+	}
+	m.mu.Unlock()
+
+	if len(slow) == 0 {
+		return
+	}
+
+	stageDurations := make(map[Stage]string, len(durations))
+	for stage, duration := range durations {
+		stageDurations[stage] = duration.String()
+	}
+
+	exemplar, err := json.Marshal(map[string]any{
+		"sandbox_id": sandboxID,
+		"trace_id":   traceID,
+		"slow":       slow,
+		"stages":     stageDurations,
+	})
+	if err != nil {
+		return
+	}
+
+	log.Printf("slow sandbox resume: %s", exemplar)
+}
+
+// record adds the resume to the current bucket of the stage, the caller must hold the lock.
+func (m *Monitor) record(stage Stage, now time.Time, overBudget bool) {
+	buckets := m.buckets[stage]
+
+	if len(buckets) == 0 || now.Sub(buckets[len(buckets)-1].start) >= bucketDuration {
+		buckets = append(buckets, bucket{start: now})
+	}
+
+	// Drop the buckets outside of the window.
+	for len(buckets) > 0 && now.Sub(buckets[0].start) > burnRateWindow {
+		buckets = buckets[1:]
+	}
+
+	current := &buckets[len(buckets)-1]
+	current.total++
+	if overBudget {
+		current.overBudget++
+	}
+
+	m.buckets[stage] = buckets
+}
+
+// burnRates returns the ratio of the resumes over the budget in the window to the ratio allowed by the objective.
+// Burn rate 1 means the error budget is consumed exactly at the end of the window.
+func (m *Monitor) burnRates(now time.Time) map[Stage]float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	burnRates := make(map[Stage]float64, len(m.buckets))
+	for stage, buckets := range m.buckets {
+		var total, overBudget int64
+		for _, b := range buckets {
+			if now.Sub(b.start) > burnRateWindow {
+				continue
+			}
+
+			total += b.total
+			overBudget += b.overBudget
+		}
+
+		if total == 0 {
+			continue
+		}
+
+		burnRates[stage] = float64(overBudget) / float64(total) / (1 - m.objective)
+	}
+
+	return burnRates
+}
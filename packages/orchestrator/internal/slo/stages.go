@@ -0,0 +1,76 @@
+package slo
+
+import (
+	"sync"
+	"time"
+)
+
+type Stage string
+
+// The stages can overlap, e.g. the uffd is ready during the FC restore.
+const (
+	// Getting the template files, including waiting for their download.
+	StageTemplateFetch Stage = "template_fetch"
+	// Starting the rootfs overlay until the NBD device is ready.
+	StageNBDAttach Stage = "nbd_attach"
+	// Starting the FC process until the VM is resumed.
+	StageFCRestore Stage = "fc_restore"
+	// Loading the snapshot until the uffd starts serving the memory.
+	StageUFFDReady Stage = "uffd_ready"
+	// Initializing envd in the resumed VM.
+	StageEnvdHealthy Stage = "envd_healthy"
+)
+
+var stages = []Stage{
+	StageTemplateFetch,
+	StageNBDAttach,
+	StageFCRestore,
+	StageUFFDReady,
+	StageEnvdHealthy,
+}
+
+// Timings are the durations of the stages of a single sandbox start.
+// The methods are safe to call on nil Timings, so the stages don't have to be checked when the start is not measured.
+type Timings struct {
+	mu        sync.Mutex
+	durations map[Stage]time.Duration
+}
+
+func NewTimings() *Timings {
+	return &Timings{
+		durations: make(map[Stage]time.Duration),
+	}
+}
+
+// Add adds the duration to the stage, the stage can be measured in multiple parts.
+func (t *Timings) Add(stage Stage, duration time.Duration) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.durations[stage] += duration
+}
+
+// Since adds the time elapsed since the start to the stage.
+func (t *Timings) Since(stage Stage, start time.Time) {
+	t.Add(stage, time.Since(start))
+}
+
+func (t *Timings) Durations() map[Stage]time.Duration {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	durations := make(map[Stage]time.Duration, len(t.durations))
+	for stage, duration := range t.durations {
+		durations[stage] = duration
+	}
+
+	return durations
+}
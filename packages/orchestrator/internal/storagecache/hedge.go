@@ -0,0 +1,262 @@
+package storagecache
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+)
+
+const (
+	// latencyWindow is the number of the latest successful reads of the provider the latency quantiles are computed from.
+	latencyWindow = 512
+	// minLatencySamples is the number of the reads needed before the reads are hedged and the timeout is adapted,
+	// the quantiles of fewer reads are too noisy.
+	minLatencySamples = 32
+
+	hedgeQuantile = 0.99
+	// minHedgeDelay keeps the fast providers from duplicating the reads on the scheduling noise.
+	minHedgeDelay = 20 * time.Millisecond
+
+	// timeoutMultiplier of the P99 latency is the timeout of the read, the timeout is never above the default of the provider.
+	timeoutMultiplier = 8
+	minReadTimeout    = 2 * time.Second
+)
+
+var (
+	bucketReads = newProvider("bucket", 10*time.Second)
+	cacheReads  = newProvider("cache", peerRequestTimeout)
+)
+
+type readFunc func(ctx context.Context, b []byte) (int, error)
+
+type hedgeMeters struct {
+	hedged   metric.Int64Counter
+	hedgeWon metric.Int64Counter
+	timeouts metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+var (
+	hedgeMetersOnce sync.Once
+	hedgeMetrics    *hedgeMeters
+)
+
+// getHedgeMeters returns the storage read meters, nil if the meters can't be created, the reads aren't measured then.
+func getHedgeMeters() *hedgeMeters {
+	hedgeMetersOnce.Do(func() {
+		hedged, err := meters.GetCounter(meters.StorageReadHedgedMeterName)
+		if err != nil {
+			log.Printf("failed to create storage read hedged counter: %v", err)
+
+			return
+		}
+
+		hedgeWon, err := meters.GetCounter(meters.StorageReadHedgeWonMeterName)
+		if err != nil {
+			log.Printf("failed to create storage read hedge won counter: %v", err)
+
+			return
+		}
+
+		timeouts, err := meters.GetCounter(meters.StorageReadTimeoutMeterName)
+		if err != nil {
+			log.Printf("failed to create storage read timeout counter: %v", err)
+
+			return
+		}
+
+		duration, err := meters.GetHistogram(meters.StorageReadDurationMeterName)
+		if err != nil {
+			log.Printf("failed to create storage read duration histogram: %v", err)
+
+			return
+		}
+
+		hedgeMetrics = &hedgeMeters{
+			hedged:   hedged,
+			hedgeWon: hedgeWon,
+			timeouts: timeouts,
+			duration: duration,
+		}
+	})
+
+	return hedgeMetrics
+}
+
+// provider tracks the latencies of the reads from one storage provider. The reads slower than the P99 latency are hedged,
+// the duplicate read is started and the first read to finish is used, and the timeout of the reads follows the P99 latency,
+// so a stalled read fails over sooner than by the fixed timeout.
+type provider struct {
+	name           string
+	defaultTimeout time.Duration
+
+	mu        sync.Mutex
+	latencies []time.Duration
+	next      int
+}
+
+func newProvider(name string, defaultTimeout time.Duration) *provider {
+	return &provider{
+		name:           name,
+		defaultTimeout: defaultTimeout,
+		latencies:      make([]time.Duration, 0, latencyWindow),
+	}
+}
+
+func (p *provider) observe(latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.latencies) < latencyWindow {
+		p.latencies = append(p.latencies, latency)
+
+		return
+	}
+
+	p.latencies[p.next] = latency
+	p.next = (p.next + 1) % latencyWindow
+}
+
+// limits returns the timeout of the read and the delay after which the read is hedged, the read isn't hedged if false.
+func (p *provider) limits() (time.Duration, time.Duration, bool) {
+	p.mu.Lock()
+	if len(p.latencies) < minLatencySamples {
+		p.mu.Unlock()
+
+		return p.defaultTimeout, 0, false
+	}
+
+	sorted := slices.Clone(p.latencies)
+	p.mu.Unlock()
+
+	slices.Sort(sorted)
+	p99 := sorted[int(float64(len(sorted)-1)*hedgeQuantile)]
+
+	timeout := min(max(p99*timeoutMultiplier, minReadTimeout), p.defaultTimeout)
+
+	return timeout, max(p99, minHedgeDelay), true
+}
+
+type readResult struct {
+	n      int
+	err    error
+	hedged bool
+}
+
+// read reads from the provider with the adaptive timeout, the read is duplicated if it's slower than the P99 latency.
+// The duplicate reads into its own buffer, the first successful read is used and the other one is canceled.
+func (p *provider) read(ctx context.Context, b []byte, read readFunc) (int, error) {
+	start := time.Now()
+	timeout, delay, hedging := p.limits()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	results := make(chan readResult, 2)
+	attempt := func(ctx context.Context, buf []byte, hedged bool) {
+		attemptStart := time.Now()
+
+		n, err := read(ctx, buf)
+		if err == nil {
+			p.observe(time.Since(attemptStart))
+		}
+
+		results <- readResult{n: n, err: err, hedged: hedged}
+	}
+
+	primaryCtx, cancelPrimary := context.WithCancel(ctx)
+	defer cancelPrimary()
+
+	go attempt(primaryCtx, b, false)
+
+	result, hedgeWon := p.await(ctx, results, delay, hedging, attempt, cancelPrimary, b)
+
+	m := getHedgeMeters()
+	if m != nil {
+		attrs := metric.WithAttributes(attribute.String("provider", p.name))
+
+		m.duration.Record(ctx, float64(time.Since(start).Milliseconds()), attrs)
+
+		if hedgeWon {
+			m.hedgeWon.Add(ctx, 1, attrs)
+		}
+
+		if result.err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			m.timeouts.Add(ctx, 1, attrs)
+		}
+	}
+
+	return result.n, result.err
+}
+
+// await waits for the primary read and starts the hedged read after the delay, it returns the result of the read used
+// and true if the hedged read won. The primary read is stopped before the hedged result is copied to its buffer.
+func (p *provider) await(
+	ctx context.Context,
+	results chan readResult,
+	delay time.Duration,
+	hedging bool,
+	attempt func(ctx context.Context, buf []byte, hedged bool),
+	cancelPrimary context.CancelFunc,
+	b []byte,
+) (readResult, bool) {
+	if !hedging {
+		return <-results, false
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case result := <-results:
+		return result, false
+	case <-timer.C:
+	}
+
+	if m := getHedgeMeters(); m != nil {
+		m.hedged.Add(ctx, 1, metric.WithAttributes(attribute.String("provider", p.name)))
+	}
+
+	hedgeCtx, cancelHedge := context.WithCancel(ctx)
+	defer cancelHedge()
+
+	buf := make([]byte, len(b))
+	go attempt(hedgeCtx, buf, true)
+
+	pending := 2
+
+	result := <-results
+	pending--
+
+	if result.err != nil {
+		// The other read may still succeed
+		result = <-results
+		pending--
+	}
+
+	if !result.hedged {
+		return result, false
+	}
+
+	if pending > 0 {
+		// The primary read writes to the buffer until it returns
+		cancelPrimary()
+		<-results
+	}
+
+	if result.err != nil {
+		return result, false
+	}
+
+	copy(b, buf[:result.n])
+
+	return result, true
+}
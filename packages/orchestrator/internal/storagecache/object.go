@@ -0,0 +1,217 @@
+package storagecache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/storage"
+	consulapi "github.com/hashicorp/consul/api"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/cacheusage"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/consul"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+const (
+	peersRefreshInterval = 30 * time.Second
+	peerRequestTimeout   = 30 * time.Second
+)
+
+var (
+	peers      atomic.Pointer[[]string]
+	peersOnce  sync.Once
+	httpClient = &http.Client{Timeout: peerRequestTimeout}
+)
+
+// refreshPeers keeps the addresses of the healthy cache servers up to date.
+func refreshPeers() {
+	for {
+		entries, _, err := consul.Client.Health().Service(ServiceName, "", true, &consulapi.QueryOptions{AllowStale: true})
+		if err != nil {
+			log.Printf("failed to list storage cache servers: %v", err)
+		} else {
+			addresses := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				host := entry.Service.Address
+				if host == "" {
+					host = entry.Node.Address
+				}
+
+				addresses = append(addresses, net.JoinHostPort(host, strconv.Itoa(entry.Service.Port)))
+			}
+
+			peers.Store(&addresses)
+		}
+
+		time.Sleep(peersRefreshInterval)
+	}
+}
+
+// pickPeer returns the cache server of the chunk by the rendezvous hashing, so only the chunks of the removed
+// server move when the servers change.
+func pickPeer(object string, index int64) (string, bool) {
+	p := peers.Load()
+	if p == nil || len(*p) == 0 {
+		return "", false
+	}
+
+	key := object + "#" + strconv.FormatInt(index, 10)
+
+	var best string
+	var bestScore uint64
+
+	for _, peer := range *p {
+		h := fnv.New64a()
+		h.Write([]byte(key))
+		h.Write([]byte(peer))
+
+		score := h.Sum64()
+		if best == "" || score > bestScore {
+			best, bestScore = peer, score
+		}
+	}
+
+	return best, true
+}
+
+// Object reads the template file through the cache servers, it reads from the bucket directly if the cache is disabled
+// or the cache server fails.
+type Object struct {
+	ctx    context.Context
+	path   string
+	bucket *gcs.Object
+	cached bool
+}
+
+func NewObject(ctx context.Context, bucket *gcs.BucketHandle, path string) *Object {
+	// Only the memfile and rootfs of the builds in the template bucket of the region are served by the cache servers,
+	// the servers are discovered via Consul
+	cached := enabled && token != "" && bucket == gcs.LocalTemplateBucket && consul.Client != nil && validObject(path)
+	if cached {
+		peersOnce.Do(func() {
+			go refreshPeers()
+		})
+	}
+
+	return &Object{
+		ctx:    ctx,
+		path:   path,
+		bucket: gcs.NewObject(ctx, bucket, path),
+		cached: cached,
+	}
+}
+
+func (o *Object) Size() (int64, error) {
+	return o.bucket.Size()
+}
+
+// Attrs returns the attributes of the object from the bucket, the metadata of the object is not cached.
+func (o *Object) Attrs() (*storage.ObjectAttrs, error) {
+	return o.bucket.Attrs()
+}
+
+func (o *Object) ReadAt(b []byte, off int64) (int, error) {
+	if !o.cached {
+		return o.readBucket(b, off)
+	}
+
+	n := 0
+	for n < len(b) {
+		index := (off + int64(n)) / ChunkSize
+
+		data, err := o.readChunk(index)
+		if err != nil {
+			log.Printf("failed to read chunk %d of '%s' from the storage cache, reading from the bucket: %v", index, o.path, err)
+
+			m, err := o.readBucket(b[n:], off+int64(n))
+
+			return n + m, err
+		}
+
+		start := off + int64(n) - index*ChunkSize
+		if start >= int64(len(data)) {
+			// End of the object
+			break
+		}
+
+		n += copy(b[n:], data[start:])
+
+		if len(data) < ChunkSize {
+			break
+		}
+	}
+
+	return n, nil
+}
+
+// readBucket reads from the bucket, the read is hedged and timed out by the latencies of the bucket reads.
+func (o *Object) readBucket(b []byte, off int64) (int, error) {
+	n, err := bucketReads.read(o.ctx, b, func(ctx context.Context, buf []byte) (int, error) {
+		return o.bucket.ReadAtContext(ctx, buf, off)
+	})
+
+	cacheusage.RecordRead(o.path, cacheusage.SourceBucket, n)
+
+	return n, err
+}
+
+// readChunk reads the chunk from its cache server, the read is hedged and timed out by the latencies of the cache servers.
+func (o *Object) readChunk(index int64) ([]byte, error) {
+	peer, ok := pickPeer(o.path, index)
+	if !ok {
+		return nil, fmt.Errorf("no storage cache servers available")
+	}
+
+	data := make([]byte, ChunkSize)
+
+	n, err := cacheReads.read(o.ctx, data, func(ctx context.Context, buf []byte) (int, error) {
+		return o.fetchChunk(ctx, peer, index, buf)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cacheusage.RecordRead(o.path, cacheusage.SourceStorageCache, n)
+
+	return data[:n], nil
+}
+
+func (o *Object) fetchChunk(ctx context.Context, peer string, index int64, buf []byte) (int, error) {
+	address := fmt.Sprintf("http://%s%s?object=%s&index=%d", peer, chunkPath, url.QueryEscape(o.path), index)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set(TokenHeader, token)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("storage cache server %s returned status %d", peer, resp.StatusCode)
+	}
+
+	n, err := io.ReadFull(resp.Body, buf)
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		// The last chunk of the object is shorter
+		return n, nil
+	}
+
+	return n, err
+}
@@ -0,0 +1,282 @@
+// Package storagecache shares the template files fetched from the bucket between the nodes of the cluster.
+// Every node runs the cache server for a part of the chunks picked by the consistent hashing, so each chunk of
+// a template file is fetched from the bucket only once per cluster instead of once per node.
+package storagecache
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+const (
+	// ChunkSize is the same as the chunk size of the template files fetched by the nodes.
+	ChunkSize = 4 * 1024 * 1024
+
+	ServiceName = "storage-cache"
+
+	cacheDir = "/orchestrator/storage-cache"
+
+	defaultMaxSizeGB = 100
+
+	chunkPath = "/chunk"
+
+	// TokenHeader is the header with the shared token required by the cache servers.
+	TokenHeader = "X-Storage-Cache-Token"
+)
+
+var (
+	enabled = os.Getenv("STORAGE_CACHE_ENABLED") == "true"
+	token   = os.Getenv("STORAGE_CACHE_TOKEN")
+)
+
+// Enabled returns true if the nodes of the cluster share the template files.
+func Enabled() bool {
+	return enabled
+}
+
+type chunk struct {
+	size     int64
+	lastUsed time.Time
+}
+
+type Server struct {
+	host    string
+	bucket  *gcs.BucketHandle
+	maxSize int64
+
+	mu     sync.Mutex
+	chunks map[string]*chunk
+	size   int64
+
+	fetches singleflight.Group
+}
+
+// NewServer returns the cache server of the template bucket, the chunks cached before the restart are removed.
+// The server listens only on the internal IP of the node and serves only the requests with the shared token.
+func NewServer(nodeIP string) (*Server, error) {
+	if nodeIP == "" {
+		return nil, fmt.Errorf("node IP is required for the storage cache server")
+	}
+
+	if token == "" {
+		return nil, fmt.Errorf("STORAGE_CACHE_TOKEN is required for the storage cache server")
+	}
+
+	maxSizeGB := int64(defaultMaxSizeGB)
+	if value := os.Getenv("STORAGE_CACHE_MAX_SIZE_GB"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("invalid STORAGE_CACHE_MAX_SIZE_GB '%s'", value)
+		}
+
+		maxSizeGB = parsed
+	}
+
+	err := os.RemoveAll(cacheDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clean storage cache dir: %w", err)
+	}
+
+	err = os.MkdirAll(cacheDir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage cache dir: %w", err)
+	}
+
+	return &Server{
+		host:    nodeIP,
+		bucket:  gcs.LocalTemplateBucket,
+		maxSize: maxSizeGB << 30,
+		chunks:  make(map[string]*chunk),
+	}, nil
+}
+
+// validObject returns true if the object is the memfile or the rootfs of a build, the other objects of the bucket
+// aren't served.
+func validObject(object string) bool {
+	buildID, file, ok := strings.Cut(object, "/")
+	if !ok || (file != storage.MemfileName && file != storage.RootfsName) {
+		return false
+	}
+
+	_, err := uuid.Parse(buildID)
+
+	return err == nil
+}
+
+func chunkName(object string, index int64) string {
+	hash := sha256.Sum256([]byte(object))
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(hash[:]), index)
+}
+
+// Start serves the chunks on the port in the background.
+func (s *Server) Start(port int) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(chunkPath, s.handleChunk)
+
+	go func() {
+		address := net.JoinHostPort(s.host, strconv.Itoa(port))
+
+		log.Printf("starting storage cache server on %s", address)
+
+		err := http.ListenAndServe(address, mux)
+		if err != nil {
+			log.Printf("storage cache server failed: %v", err)
+		}
+	}()
+}
+
+func (s *Server) handleChunk(w http.ResponseWriter, r *http.Request) {
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(TokenHeader)), []byte(token)) != 1 {
+		http.Error(w, "invalid storage cache token", http.StatusUnauthorized)
+
+		return
+	}
+
+	object := r.URL.Query().Get("object")
+
+	index, err := strconv.ParseInt(r.URL.Query().Get("index"), 10, 64)
+	if !validObject(object) || err != nil || index < 0 {
+		http.Error(w, "memfile or rootfs object of a build and index are required", http.StatusBadRequest)
+
+		return
+	}
+
+	data, err := s.get(r.Context(), object, index)
+	if errors.Is(err, gcs.ErrObjectNotExist) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+
+	_, err = w.Write(data)
+	if err != nil {
+		log.Printf("failed to write chunk %d of '%s': %v", index, object, err)
+	}
+}
+
+func (s *Server) get(ctx context.Context, object string, index int64) ([]byte, error) {
+	name := chunkName(object, index)
+	path := filepath.Join(cacheDir, name)
+
+	s.mu.Lock()
+	c, ok := s.chunks[name]
+	if ok {
+		c.lastUsed = time.Now()
+	}
+	s.mu.Unlock()
+
+	if ok {
+		data, err := os.ReadFile(path)
+		if err == nil {
+			return data, nil
+		}
+
+		log.Printf("failed to read cached chunk '%s', fetching it again: %v", name, err)
+	}
+
+	data, err, _ := s.fetches.Do(name, func() (interface{}, error) {
+		return s.fetch(ctx, object, index, name, path)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return data.([]byte), nil
+}
+
+func (s *Server) fetch(ctx context.Context, object string, index int64, name, path string) ([]byte, error) {
+	// The fetch is shared by the concurrent requests, it can't be canceled by one of them
+	obj := gcs.NewObject(context.WithoutCancel(ctx), s.bucket, object)
+
+	data := make([]byte, ChunkSize)
+
+	n, err := bucketReads.read(context.WithoutCancel(ctx), data, func(ctx context.Context, buf []byte) (int, error) {
+		return obj.ReadAtContext(ctx, buf, index*ChunkSize)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chunk %d of '%s': %w", index, object, err)
+	}
+
+	data = data[:n]
+
+	tmpPath := path + ".tmp"
+
+	err = os.WriteFile(tmpPath, data, 0o644)
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+
+	if err != nil {
+		// The chunk is still returned, it's only not cached
+		log.Printf("failed to cache chunk '%s': %v", name, err)
+
+		os.Remove(tmpPath)
+
+		return data, nil
+	}
+
+	s.add(name, int64(n))
+
+	return data, nil
+}
+
+// add records the cached chunk and removes the least recently used chunks over the max size.
+func (s *Server) add(name string, size int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c, ok := s.chunks[name]; ok {
+		s.size -= c.size
+	}
+
+	s.chunks[name] = &chunk{size: size, lastUsed: time.Now()}
+	s.size += size
+
+	for s.size > s.maxSize {
+		var oldestName string
+		var oldest *chunk
+
+		for n, c := range s.chunks {
+			if oldest == nil || c.lastUsed.Before(oldest.lastUsed) {
+				oldestName, oldest = n, c
+			}
+		}
+
+		err := os.Remove(filepath.Join(cacheDir, oldestName))
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("failed to remove cached chunk '%s': %v", oldestName, err)
+		}
+
+		delete(s.chunks, oldestName)
+		s.size -= oldest.size
+	}
+}
@@ -0,0 +1,37 @@
+package watchdog
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// NBD is the latency of the rootfs reads and writes served over NBD.
+	NBD = &Latency{}
+	// UFFD is the latency of the guest memory page faults served by uffd.
+	UFFD = &Latency{}
+)
+
+// Latency is the mean latency of the requests served since it was last sampled.
+type Latency struct {
+	sum   atomic.Int64
+	count atomic.Int64
+}
+
+// Since records the latency of the request started at the start time.
+func (l *Latency) Since(start time.Time) {
+	l.sum.Add(int64(time.Since(start)))
+	l.count.Add(1)
+}
+
+// sample returns the mean latency since the last sample and resets it, it's zero if there were no requests.
+func (l *Latency) sample() time.Duration {
+	sum := l.sum.Swap(0)
+	count := l.count.Swap(0)
+
+	if count == 0 {
+		return 0
+	}
+
+	return time.Duration(sum / count)
+}
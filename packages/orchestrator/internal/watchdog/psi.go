@@ -0,0 +1,40 @@
+package watchdog
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// MemoryPressure returns the percentage of the time some tasks were stalled on memory in the last 10 seconds,
+// the line is in the "some avg10=0.00 avg60=0.00 avg300=0.00 total=0" format.
+func MemoryPressure() (float64, error) {
+	file, err := os.Open(memoryPressurePath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "some" {
+			continue
+		}
+
+		value, ok := strings.CutPrefix(fields[1], "avg10=")
+		if !ok {
+			return 0, fmt.Errorf("unexpected memory pressure format: %s", scanner.Text())
+		}
+
+		return strconv.ParseFloat(value, 64)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("memory pressure not found in %s", memoryPressurePath)
+}
@@ -0,0 +1,227 @@
+// Package watchdog monitors the host resources and degrades the node before the host OOM killer kills the FC processes indiscriminately.
+// The node stops accepting new sandboxes when any resource is under pressure. Under the critical memory pressure
+// it also evicts the sandboxes selected by the eviction policy of the node. The evicted sandboxes are paused and pausing
+// writes the snapshots to the disk, so the eviction is not used to relieve the disk or IO pressure.
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/meters"
+)
+
+type Level int32
+
+const (
+	// LevelNormal is the node without resource pressure.
+	LevelNormal Level = iota
+	// LevelDegraded is the node under pressure, it doesn't accept new sandboxes.
+	LevelDegraded
+	// LevelCritical is the node close to running out of memory, the sandboxes are evicted.
+	LevelCritical
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelNormal:
+		return "normal"
+	case LevelDegraded:
+		return "degraded"
+	case LevelCritical:
+		return "critical"
+	default:
+		return fmt.Sprintf("unknown (%d)", int32(l))
+	}
+}
+
+const (
+	checkInterval = 5 * time.Second
+	// recoveryChecks is the number of consecutive checks with a lower level before the level is lowered, so the node doesn't flap.
+	recoveryChecks = 6
+
+	memoryPressurePath = "/proc/pressure/memory"
+	// The percentage of the time some tasks were stalled on memory in the last 10 seconds.
+	memoryPressureDegraded = 10
+	memoryPressureCritical = 30
+
+	// diskFreeDegraded is the ratio of the free space of the cache dirs.
+	diskFreeDegraded = 0.1
+
+	nbdLatencyDegraded  = 100 * time.Millisecond
+	uffdLatencyDegraded = 50 * time.Millisecond
+
+	// maxShedPerCheck is the number of the sandboxes evicted at once, the memory is freed only after the snapshots are taken.
+	maxShedPerCheck = 2
+)
+
+// Shedder evicts at most count of the sandboxes because of the pressure described by the reason and returns how many were evicted.
+type Shedder func(ctx context.Context, count int, reason string) int
+
+type Watchdog struct {
+	dirs []string
+	shed Shedder
+
+	level atomic.Int32
+	// lower is the number of consecutive checks with a lower level than the current one.
+	lower int
+
+	shedCounter metric.Int64Counter
+}
+
+// New creates the watchdog of the disk space of the dirs, the shedder is called under the critical memory pressure.
+func New(dirs []string, shed Shedder) (*Watchdog, error) {
+	w := &Watchdog{
+		dirs: dirs,
+		shed: shed,
+	}
+
+	shedCounter, err := meters.GetCounter(meters.WatchdogShedMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watchdog shed counter: %w", err)
+	}
+
+	w.shedCounter = shedCounter
+
+	_, err = meters.GetGaugeFloat(meters.WatchdogLevelMeterName, func(ctx context.Context, observer metric.Float64Observer) error {
+		observer.Observe(float64(w.Level()))
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watchdog level gauge: %w", err)
+	}
+
+	return w, nil
+}
+
+// Start checks the resources periodically until the context is canceled.
+func (w *Watchdog) Start(ctx context.Context) {
+	_, err := MemoryPressure()
+	if err != nil {
+		log.Printf("Memory pressure is not available, the watchdog monitors only the disk space and the latencies: %v", err)
+	}
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.check(ctx)
+		}
+	}
+}
+
+func (w *Watchdog) Level() Level {
+	return Level(w.level.Load())
+}
+
+// AcceptsSandboxes reports whether the node has the resources for the new sandboxes.
+func (w *Watchdog) AcceptsSandboxes() bool {
+	return w.Level() == LevelNormal
+}
+
+func (w *Watchdog) check(ctx context.Context) {
+	level, reasons := w.evaluate()
+
+	current := w.Level()
+
+	switch {
+	case level > current:
+		w.lower = 0
+		w.level.Store(int32(level))
+
+		log.Printf("Watchdog level raised from %s to %s: %s", current, level, strings.Join(reasons, ", "))
+	case level < current:
+		w.lower++
+		if w.lower < recoveryChecks {
+			break
+		}
+
+		w.lower = 0
+		w.level.Store(int32(level))
+
+		log.Printf("Watchdog level lowered from %s to %s", current, level)
+	default:
+		w.lower = 0
+	}
+
+	if w.Level() != LevelCritical {
+		return
+	}
+
+	shed := w.shed(ctx, maxShedPerCheck, strings.Join(reasons, ", "))
+	if shed > 0 {
+		log.Printf("Watchdog evicted %d sandboxes: %s", shed, strings.Join(reasons, ", "))
+
+		w.shedCounter.Add(ctx, int64(shed))
+	}
+}
+
+// evaluate returns the level of the current resource usage with the reasons for a level other than normal.
+func (w *Watchdog) evaluate() (Level, []string) {
+	level := LevelNormal
+
+	var reasons []string
+
+	raise := func(to Level, reason string) {
+		level = max(level, to)
+		reasons = append(reasons, reason)
+	}
+
+	pressure, err := MemoryPressure()
+	if err == nil {
+		switch {
+		case pressure >= memoryPressureCritical:
+			raise(LevelCritical, fmt.Sprintf("memory pressure %.1f%%", pressure))
+		case pressure >= memoryPressureDegraded:
+			raise(LevelDegraded, fmt.Sprintf("memory pressure %.1f%%", pressure))
+		}
+	}
+
+	for _, dir := range w.dirs {
+		free, err := diskFree(dir)
+		if err != nil {
+			continue
+		}
+
+		if free < diskFreeDegraded {
+			raise(LevelDegraded, fmt.Sprintf("disk space of %s %.1f%% free", dir, free*100))
+		}
+	}
+
+	if latency := NBD.sample(); latency >= nbdLatencyDegraded {
+		raise(LevelDegraded, fmt.Sprintf("nbd latency %s", latency))
+	}
+
+	if latency := UFFD.sample(); latency >= uffdLatencyDegraded {
+		raise(LevelDegraded, fmt.Sprintf("uffd latency %s", latency))
+	}
+
+	return level, reasons
+}
+
+func diskFree(dir string) (float64, error) {
+	var stat unix.Statfs_t
+
+	err := unix.Statfs(dir, &stat)
+	if err != nil {
+		return 0, err
+	}
+
+	if stat.Blocks == 0 {
+		return 1, nil
+	}
+
+	return float64(stat.Bavail) / float64(stat.Blocks), nil
+}
@@ -6,22 +6,54 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/cacheusage"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/cfg"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/registration"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/server"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/storagecache"
+	"github.com/e2b-dev/infra/packages/shared/pkg/diagnostics"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/health"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
-const defaultPort = 5008
+const (
+	defaultPort             = 5008
+	defaultStorageCachePort = 5010
+	defaultHealthPort       = 5012
+	defaultDiagnosticsPort  = 5013
+
+	drainTimeout = 5 * time.Second
+
+	healthProbeTimeout = 3 * time.Second
+)
 
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	port := flag.Int("port", defaultPort, "orchestrator server port")
+	diagnosticsPort := flag.Int("diagnostics-port", defaultDiagnosticsPort, "orchestrator diagnostics server port, the server is started only when DIAGNOSTICS_TOKEN is set")
+
+	storageCachePort := flag.Int("storage-cache-port", defaultStorageCachePort, "storage cache server port, the server is started only when STORAGE_CACHE_ENABLED is true")
+
+	healthPort := flag.Int("health-port", defaultHealthPort, "deep health check server port")
+
+	config := cfg.RegisterFlags(flag.CommandLine)
 
 	flag.Parse()
 
+	err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load node config: %v", err)
+	}
+
 	if !env.IsLocal() {
 		shutdown := telemetry.InitOTLPExporter(ctx, server.ServiceName, "no")
 		defer shutdown(context.TODO())
@@ -32,11 +64,69 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	s, err := server.New()
+	s, err := server.New(config)
 	if err != nil {
 		log.Fatalf("failed to create server: %v", err)
 	}
 
+	if env.IsKubernetes() {
+		registrar, err := registration.New(config, *port, s.Health)
+		if err != nil {
+			log.Fatalf("failed to create node registrar: %v", err)
+		}
+		defer registrar.Close()
+
+		go registrar.Start(ctx)
+
+		go func() {
+			signalCtx, stop := signal.NotifyContext(ctx, syscall.SIGTERM)
+			defer stop()
+
+			<-signalCtx.Done()
+			if ctx.Err() != nil {
+				return
+			}
+
+			// The node stops receiving the new sandboxes, the running sandboxes are served until the pod is killed
+			// after its termination grace period
+			drainCtx, cancel := context.WithTimeout(ctx, drainTimeout)
+			defer cancel()
+
+			err := registrar.Drain(drainCtx)
+			if err != nil {
+				log.Printf("failed to drain node: %v", err)
+			}
+		}()
+	}
+
+	if storagecache.Enabled() {
+		storageCache, err := storagecache.NewServer(config.NodeIP)
+		if err != nil {
+			log.Fatalf("failed to create storage cache server: %v", err)
+		}
+
+		storageCache.Start(*storageCachePort)
+	}
+
+	go func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/health/deep", health.Handler(healthProbeTimeout, s.HealthProbes()))
+
+		log.Printf("starting deep health check server on port %d", *healthPort)
+
+		err := http.ListenAndServe(fmt.Sprintf(":%d", *healthPort), mux)
+		if err != nil {
+			log.Printf("deep health check server failed: %v", err)
+		}
+	}()
+
+	diagnostics.Start(diagnostics.NewServer(*diagnosticsPort, os.Getenv("DIAGNOSTICS_TOKEN"), s.ActiveSandboxes, diagnostics.Endpoint{
+		Path: "/debug/template-cache",
+		Handler: func(context.Context) any {
+			return cacheusage.Get()
+		},
+	}))
+
 	log.Printf("starting server on port %d", *port)
 
 	if err := s.Serve(lis); err != nil {
@@ -0,0 +1,22 @@
+-- Create "sandboxes" table
+CREATE TABLE "public"."sandboxes"
+(
+    id text not null,
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    updated_at timestamp with time zone not null,
+    team_id uuid not null,
+    env_id text not null,
+    build_id uuid not null,
+    alias text null,
+    node_id text null,
+    state character varying not null,
+    started_at timestamp with time zone not null,
+    end_at timestamp with time zone not null,
+    vcpu bigint not null,
+    ram_mb bigint not null,
+    metadata jsonb null,
+    constraint sandboxes_pkey primary key (id)
+);
+-- Create index "sandbox_team_id" to table: "sandboxes"
+CREATE INDEX "sandbox_team_id" ON "public"."sandboxes" ("team_id");
+ALTER TABLE "public"."sandboxes" ENABLE ROW LEVEL SECURITY;
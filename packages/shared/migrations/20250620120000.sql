@@ -0,0 +1,36 @@
+-- Notify the API instances about the changes of the cached tables, the payload contains the changed table and key
+CREATE OR REPLACE FUNCTION public.notify_cache_invalidation()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS $notify_cache_invalidation$
+DECLARE
+    row_data jsonb;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        row_data := to_jsonb(OLD);
+    ELSE
+        row_data := to_jsonb(NEW);
+    END IF;
+
+    PERFORM pg_notify('cache_invalidation', json_build_object(
+        'table', TG_TABLE_NAME,
+        'key', row_data ->> TG_ARGV[0],
+        'at', extract(epoch from clock_timestamp())
+    )::text);
+
+    RETURN NULL;
+END
+$notify_cache_invalidation$ SECURITY DEFINER SET search_path = public;
+
+CREATE TRIGGER teams_cache_invalidation AFTER INSERT OR UPDATE OR DELETE ON public.teams
+    FOR EACH ROW EXECUTE FUNCTION public.notify_cache_invalidation('id');
+CREATE TRIGGER tiers_cache_invalidation AFTER INSERT OR UPDATE OR DELETE ON public.tiers
+    FOR EACH ROW EXECUTE FUNCTION public.notify_cache_invalidation('id');
+CREATE TRIGGER team_api_keys_cache_invalidation AFTER INSERT OR UPDATE OR DELETE ON public.team_api_keys
+    FOR EACH ROW EXECUTE FUNCTION public.notify_cache_invalidation('team_id');
+CREATE TRIGGER envs_cache_invalidation AFTER INSERT OR UPDATE OR DELETE ON public.envs
+    FOR EACH ROW EXECUTE FUNCTION public.notify_cache_invalidation('id');
+CREATE TRIGGER env_aliases_cache_invalidation AFTER INSERT OR UPDATE OR DELETE ON public.env_aliases
+    FOR EACH ROW EXECUTE FUNCTION public.notify_cache_invalidation('env_id');
+CREATE TRIGGER env_builds_cache_invalidation AFTER INSERT OR UPDATE OR DELETE ON public.env_builds
+    FOR EACH ROW EXECUTE FUNCTION public.notify_cache_invalidation('env_id');
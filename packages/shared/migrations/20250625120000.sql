@@ -0,0 +1,4 @@
+-- Modify "sandboxes" table
+ALTER TABLE "public"."sandboxes" ADD COLUMN "labels" jsonb NULL;
+-- Create index "sandbox_labels" to table: "sandboxes"
+CREATE INDEX "sandbox_labels" ON "public"."sandboxes" USING GIN ("labels");
@@ -0,0 +1,2 @@
+-- Modify "env_builds" table
+ALTER TABLE "public"."env_builds" ADD COLUMN "kernel_args" jsonb NULL;
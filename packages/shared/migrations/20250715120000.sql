@@ -0,0 +1,10 @@
+-- Create "kernels" table
+CREATE TABLE "public"."kernels"
+(
+    id text not null,
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    checksum text not null,
+    description text null,
+    constraint kernels_pkey primary key (id)
+);
+ALTER TABLE "public"."kernels" ENABLE ROW LEVEL SECURITY;
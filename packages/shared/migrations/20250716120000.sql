@@ -0,0 +1,12 @@
+-- Create "pinned_builds" table
+CREATE TABLE "public"."pinned_builds"
+(
+    id uuid not null default gen_random_uuid(),
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    build_id uuid not null,
+    node_id text not null default '',
+    constraint pinned_builds_pkey primary key (id),
+    constraint pinned_builds_env_builds_builds foreign key (build_id) references "public"."env_builds" (id) on delete cascade
+);
+CREATE UNIQUE INDEX "pinnedbuild_build_id_node_id" ON "public"."pinned_builds" (build_id, node_id);
+ALTER TABLE "public"."pinned_builds" ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,2 @@
+-- Modify "env_builds" table
+ALTER TABLE "public"."env_builds" ADD COLUMN "upload_status" text NULL, ADD COLUMN "snapshot_node_id" text NULL;
@@ -0,0 +1,33 @@
+-- Create "team_secrets" table, the values are never stored in plaintext, they are sealed in "team_secret_versions"
+CREATE TABLE "public"."team_secrets"
+(
+    id uuid not null default gen_random_uuid(),
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    updated_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    team_id uuid not null,
+    name text not null,
+    latest_version integer not null default 1,
+    constraint team_secrets_pkey primary key (id),
+    constraint team_secrets_teams_secrets foreign key (team_id) references "public"."teams" (id) on delete cascade
+);
+CREATE UNIQUE INDEX "teamsecret_team_id_name" ON "public"."team_secrets" (team_id, name);
+ALTER TABLE "public"."team_secrets" ENABLE ROW LEVEL SECURITY;
+-- Create "team_secret_versions" table
+CREATE TABLE "public"."team_secret_versions"
+(
+    id uuid not null default gen_random_uuid(),
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    secret_id uuid not null,
+    version integer not null,
+    key_id text not null,
+    encrypted_key bytea not null,
+    ciphertext bytea not null,
+    constraint team_secret_versions_pkey primary key (id),
+    constraint team_secret_versions_team_secrets_versions foreign key (secret_id) references "public"."team_secrets" (id) on delete cascade
+);
+CREATE UNIQUE INDEX "teamsecretversion_secret_id_version" ON "public"."team_secret_versions" (secret_id, version);
+ALTER TABLE "public"."team_secret_versions" ENABLE ROW LEVEL SECURITY;
+-- Modify "team_api_keys" table
+ALTER TABLE "public"."team_api_keys" ADD COLUMN "scopes" jsonb NULL;
+-- Modify "env_builds" table
+ALTER TABLE "public"."env_builds" ADD COLUMN "secrets" jsonb NULL;
@@ -0,0 +1,2 @@
+-- Modify "teams" table
+ALTER TABLE "public"."teams" ADD COLUMN "encrypt_snapshots" boolean NOT NULL DEFAULT false;
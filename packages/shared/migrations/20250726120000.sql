@@ -0,0 +1,16 @@
+-- Create "idempotency_keys" table
+CREATE TABLE "public"."idempotency_keys"
+(
+    id uuid not null default gen_random_uuid(),
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    expires_at timestamp with time zone not null,
+    owner_id uuid not null,
+    key text not null,
+    request_hash text not null,
+    status_code bigint null,
+    response bytea null,
+    constraint idempotency_keys_pkey primary key (id)
+);
+CREATE UNIQUE INDEX "idempotencykey_owner_id_key" ON "public"."idempotency_keys" (owner_id, key);
+CREATE INDEX "idempotencykey_expires_at" ON "public"."idempotency_keys" (expires_at);
+ALTER TABLE "public"."idempotency_keys" ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,5 @@
+-- Modify "envs" table
+ALTER TABLE "public"."envs" ADD COLUMN "allowed_regions" jsonb NULL;
+COMMENT ON COLUMN "public"."envs"."allowed_regions" IS 'Regions the sandboxes of the env can run in, any region if empty';
+-- Modify "sandboxes" table
+ALTER TABLE "public"."sandboxes" ADD COLUMN "cluster_id" text NULL;
@@ -0,0 +1,4 @@
+-- Modify "env_builds" table
+ALTER TABLE "public"."env_builds" ADD COLUMN "replicated_regions" jsonb NULL;
+-- Modify "teams" table
+ALTER TABLE "public"."teams" ADD COLUMN "replication_regions" jsonb NULL;
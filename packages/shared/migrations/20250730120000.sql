@@ -0,0 +1,18 @@
+-- Create "team_registry_credentials" table
+CREATE TABLE "public"."team_registry_credentials"
+(
+    id uuid not null default gen_random_uuid(),
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    updated_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    team_id uuid not null,
+    registry text not null,
+    username text not null,
+    version integer not null default 1,
+    key_id text not null,
+    encrypted_key bytea not null,
+    ciphertext bytea not null,
+    constraint team_registry_credentials_pkey primary key (id),
+    constraint team_registry_credentials_teams_registry_credentials foreign key (team_id) references "public"."teams" (id) on delete cascade
+);
+CREATE UNIQUE INDEX "teamregistrycredential_team_id_registry" ON "public"."team_registry_credentials" (team_id, registry);
+ALTER TABLE "public"."team_registry_credentials" ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,22 @@
+-- Modify "env_builds" table
+ALTER TABLE "public"."env_builds" ADD COLUMN "source_build_id" uuid NULL;
+
+-- Create "env_rebuild_schedules" table
+CREATE TABLE "public"."env_rebuild_schedules"
+(
+    id uuid not null default gen_random_uuid(),
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    updated_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    env_id text not null,
+    interval_hours integer not null default 24,
+    next_run_at timestamp with time zone not null,
+    upgrade_packages boolean not null default true,
+    webhook_url text null,
+    last_build_id uuid null,
+    last_build_status text null,
+    constraint env_rebuild_schedules_pkey primary key (id),
+    constraint env_rebuild_schedules_envs_rebuild_schedule foreign key (env_id) references "public"."envs" (id) on delete cascade
+);
+CREATE UNIQUE INDEX "envrebuildschedule_env_id" ON "public"."env_rebuild_schedules" (env_id);
+CREATE INDEX "envrebuildschedule_next_run_at" ON "public"."env_rebuild_schedules" (next_run_at);
+ALTER TABLE "public"."env_rebuild_schedules" ENABLE ROW LEVEL SECURITY;
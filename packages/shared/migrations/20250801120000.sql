@@ -0,0 +1,24 @@
+-- Modify "teams" table
+ALTER TABLE "public"."teams" ADD COLUMN "build_log_retention_days" integer NOT NULL DEFAULT 30;
+
+-- Create "env_build_logs" table
+CREATE TABLE "public"."env_build_logs"
+(
+    id bigint generated by default as identity,
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    team_id uuid not null,
+    env_id text not null,
+    build_id uuid not null,
+    line integer not null,
+    message text not null,
+    constraint env_build_logs_pkey primary key (id),
+    constraint env_build_logs_teams_build_logs foreign key (team_id) references "public"."teams" (id) on delete cascade,
+    constraint env_build_logs_envs_build_logs foreign key (env_id) references "public"."envs" (id) on delete cascade
+);
+CREATE UNIQUE INDEX "envbuildlog_build_id_line" ON "public"."env_build_logs" (build_id, line);
+CREATE INDEX "envbuildlog_team_id_created_at" ON "public"."env_build_logs" (team_id, created_at);
+ALTER TABLE "public"."env_build_logs" ENABLE ROW LEVEL SECURITY;
+
+-- The build logs are searched by the substring of the message
+CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA "extensions";
+CREATE INDEX "envbuildlog_message_trgm" ON "public"."env_build_logs" USING gin (message "extensions".gin_trgm_ops);
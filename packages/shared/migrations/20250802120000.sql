@@ -0,0 +1,50 @@
+-- Create "sandbox_starts" table
+CREATE TABLE "public"."sandbox_starts"
+(
+    id bigint generated by default as identity,
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    team_id uuid not null,
+    env_id text not null,
+    sandbox_id text not null,
+    resumed boolean not null,
+    duration_ms bigint not null,
+    constraint sandbox_starts_pkey primary key (id),
+    constraint sandbox_starts_teams_sandbox_starts foreign key (team_id) references "public"."teams" (id) on delete cascade
+);
+CREATE INDEX "sandboxstart_created_at" ON "public"."sandbox_starts" (created_at);
+ALTER TABLE "public"."sandbox_starts" ENABLE ROW LEVEL SECURITY;
+
+-- Create "team_usage_daily" table
+CREATE TABLE "public"."team_usage_daily"
+(
+    id bigint generated by default as identity,
+    team_id uuid not null,
+    day timestamp with time zone not null,
+    sandboxes_started bigint not null default 0,
+    sandboxes_resumed bigint not null default 0,
+    resume_p95_ms bigint null,
+    builds bigint not null default 0,
+    build_seconds bigint not null default 0,
+    updated_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    constraint team_usage_daily_pkey primary key (id),
+    constraint team_usage_daily_teams_usage_daily foreign key (team_id) references "public"."teams" (id) on delete cascade
+);
+CREATE UNIQUE INDEX "teamusagedaily_team_id_day" ON "public"."team_usage_daily" (team_id, day);
+ALTER TABLE "public"."team_usage_daily" ENABLE ROW LEVEL SECURITY;
+
+-- Create "team_template_storage" table
+CREATE TABLE "public"."team_template_storage"
+(
+    id bigint generated by default as identity,
+    team_id uuid not null,
+    env_id text not null,
+    template_bytes bigint not null default 0,
+    snapshot_bytes bigint not null default 0,
+    updated_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    constraint team_template_storage_pkey primary key (id),
+    constraint team_template_storage_teams_template_storage foreign key (team_id) references "public"."teams" (id) on delete cascade,
+    constraint team_template_storage_envs_storage foreign key (env_id) references "public"."envs" (id) on delete cascade
+);
+CREATE UNIQUE INDEX "team_template_storage_env_id_key" ON "public"."team_template_storage" (env_id);
+CREATE INDEX "teamtemplatestorage_team_id" ON "public"."team_template_storage" (team_id);
+ALTER TABLE "public"."team_template_storage" ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,25 @@
+-- Modify "tiers" table
+ALTER TABLE "public"."tiers" ADD COLUMN "vcpu_hour_price_usd" double precision NOT NULL DEFAULT 0.0504, ADD COLUMN "ram_gib_hour_price_usd" double precision NOT NULL DEFAULT 0.0162;
+
+-- Create "team_budgets" table
+CREATE TABLE "public"."team_budgets"
+(
+    id uuid not null default gen_random_uuid(),
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    updated_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    team_id uuid not null,
+    monthly_limit_usd double precision not null,
+    warning_percent integer not null default 80,
+    pause_sandboxes boolean not null default false,
+    period_start timestamp with time zone not null,
+    accrued_usd double precision not null default 0,
+    metered_at timestamp with time zone not null,
+    warned_at timestamp with time zone null,
+    exceeded_at timestamp with time zone null,
+    override_until timestamp with time zone null,
+    override_reason text null,
+    constraint team_budgets_pkey primary key (id),
+    constraint team_budgets_teams_budget foreign key (team_id) references "public"."teams" (id) on delete cascade
+);
+CREATE UNIQUE INDEX "team_budgets_team_id_key" ON "public"."team_budgets" (team_id);
+ALTER TABLE "public"."team_budgets" ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,4 @@
+-- Modify "env_builds" table
+ALTER TABLE "public"."env_builds" ADD COLUMN "variant" text NULL, ADD COLUMN "variant_of" uuid NULL;
+-- Create index "envbuild_variant_of" to table: "env_builds"
+CREATE INDEX "envbuild_variant_of" ON "public"."env_builds" ("variant_of");
@@ -0,0 +1,29 @@
+-- Notify the API instances only about the changes the caches read, the columns updated with every use of the row,
+-- the heartbeats of the running builds and the builds the caches don't read don't invalidate the caches
+DROP TRIGGER IF EXISTS team_api_keys_cache_invalidation ON public.team_api_keys;
+DROP TRIGGER IF EXISTS envs_cache_invalidation ON public.envs;
+DROP TRIGGER IF EXISTS env_builds_cache_invalidation ON public.env_builds;
+
+CREATE TRIGGER team_api_keys_cache_invalidation AFTER INSERT OR DELETE ON public.team_api_keys
+    FOR EACH ROW EXECUTE FUNCTION public.notify_cache_invalidation('team_id');
+CREATE TRIGGER team_api_keys_update_cache_invalidation AFTER UPDATE ON public.team_api_keys
+    FOR EACH ROW
+    WHEN ((to_jsonb(OLD) - 'last_used' - 'updated_at') IS DISTINCT FROM (to_jsonb(NEW) - 'last_used' - 'updated_at'))
+    EXECUTE FUNCTION public.notify_cache_invalidation('team_id');
+
+CREATE TRIGGER envs_cache_invalidation AFTER INSERT OR DELETE ON public.envs
+    FOR EACH ROW EXECUTE FUNCTION public.notify_cache_invalidation('id');
+CREATE TRIGGER envs_update_cache_invalidation AFTER UPDATE ON public.envs
+    FOR EACH ROW
+    WHEN ((to_jsonb(OLD) - 'spawn_count' - 'last_spawned_at' - 'build_count' - 'updated_at') IS DISTINCT FROM
+          (to_jsonb(NEW) - 'spawn_count' - 'last_spawned_at' - 'build_count' - 'updated_at'))
+    EXECUTE FUNCTION public.notify_cache_invalidation('id');
+
+-- The caches read only the uploaded builds
+CREATE TRIGGER env_builds_cache_invalidation AFTER INSERT OR DELETE ON public.env_builds
+    FOR EACH ROW EXECUTE FUNCTION public.notify_cache_invalidation('env_id');
+CREATE TRIGGER env_builds_update_cache_invalidation AFTER UPDATE ON public.env_builds
+    FOR EACH ROW
+    WHEN ((OLD.status = 'uploaded' OR NEW.status = 'uploaded') AND
+          (to_jsonb(OLD) - 'heartbeat_at' - 'updated_at') IS DISTINCT FROM (to_jsonb(NEW) - 'heartbeat_at' - 'updated_at'))
+    EXECUTE FUNCTION public.notify_cache_invalidation('env_id');
@@ -0,0 +1,2 @@
+-- Modify "env_rebuild_schedules" table
+ALTER TABLE "public"."env_rebuild_schedules" ADD COLUMN "webhook_secret" text NULL;
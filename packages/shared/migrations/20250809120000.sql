@@ -0,0 +1,3 @@
+-- Modify "tiers" table
+ALTER TABLE "public"."tiers" ADD COLUMN "high_priority" boolean NOT NULL DEFAULT false;
+COMMENT ON COLUMN "public"."tiers"."high_priority" IS 'Whether the team can start the high priority sandboxes that preempt the low priority ones';
@@ -0,0 +1,204 @@
+// Package artifacts stores the files and directories persisted from the sandboxes, so they outlive the sandboxes.
+// The artifacts are stored as tar.gz archives in the template bucket, scoped by the team and the sandbox.
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+)
+
+const (
+	storageDir = "artifacts"
+	// Extension of the stored archives, the name of the artifact doesn't include it.
+	archiveExtension = ".tar.gz"
+
+	pathMetadataKey   = "path"
+	labelMetadataKey  = "label-"
+	maxLabels         = 16
+	maxLabelValueSize = 256
+)
+
+var nameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,127}$`)
+
+var labelKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,62}$`)
+
+// Artifact is the stored archive of the file or the directory of the sandbox.
+type Artifact struct {
+	SandboxID string
+	Name      string
+	// Path is the path of the file or the directory in the sandbox the artifact was persisted from.
+	Path      string
+	Labels    map[string]string
+	SizeBytes int64
+	CreatedAt time.Time
+}
+
+// Validate checks the name and the labels of the artifact can be stored.
+func Validate(name string, labels map[string]string) error {
+	if !nameRegex.MatchString(name) {
+		return fmt.Errorf("artifact name '%s' is invalid, it must have at most 128 letters, digits, '.', '_' or '-' and start with a letter or a digit", name)
+	}
+
+	if len(labels) > maxLabels {
+		return fmt.Errorf("too many labels (%d), the maximum is %d", len(labels), maxLabels)
+	}
+
+	for key, value := range labels {
+		if !labelKeyRegex.MatchString(key) {
+			return fmt.Errorf("label key '%s' is invalid, it must have at most 63 letters, digits, '.', '_' or '-' and start with a letter or a digit", key)
+		}
+
+		if len(value) > maxLabelValueSize {
+			return fmt.Errorf("value of label '%s' is too long, the maximum is %d bytes", key, maxLabelValueSize)
+		}
+	}
+
+	return nil
+}
+
+func teamDir(teamID string) string {
+	return fmt.Sprintf("%s/%s", storageDir, teamID)
+}
+
+func sandboxDir(teamID, sandboxID string) string {
+	return fmt.Sprintf("%s/%s", teamDir(teamID), sandboxID)
+}
+
+func storagePath(teamID, sandboxID, name string) string {
+	return fmt.Sprintf("%s/%s%s", sandboxDir(teamID, sandboxID), name, archiveExtension)
+}
+
+// Upload stores the archive as the artifact of the sandbox, the artifact with the same name is replaced.
+func Upload(ctx context.Context, bucket *gcs.BucketHandle, teamID, sandboxID, name, path string, labels map[string]string, archive io.Reader) (*Artifact, error) {
+	metadata := map[string]string{pathMetadataKey: path}
+	for key, value := range labels {
+		metadata[labelMetadataKey+key] = value
+	}
+
+	object := gcs.NewObject(ctx, bucket, storagePath(teamID, sandboxID, name))
+
+	size, err := object.ReadFromWithMetadata(archive, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload artifact '%s': %w", name, err)
+	}
+
+	return &Artifact{
+		SandboxID: sandboxID,
+		Name:      name,
+		Path:      path,
+		Labels:    labels,
+		SizeBytes: size,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// List returns the artifacts of the team that have all the labels, only the artifacts of the sandbox if the sandbox ID isn't empty.
+func List(ctx context.Context, bucket *gcs.BucketHandle, teamID, sandboxID string, labels map[string]string) ([]*Artifact, error) {
+	prefix := teamDir(teamID) + "/"
+	if sandboxID != "" {
+		prefix = sandboxDir(teamID, sandboxID) + "/"
+	}
+
+	objects := bucket.Objects(ctx, &storage.Query{
+		Prefix: prefix,
+	})
+
+	var result []*Artifact
+
+	for {
+		object, err := objects.Next()
+		if err == iterator.Done {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error when iterating over artifacts: %w", err)
+		}
+
+		artifact, ok := fromAttrs(teamID, object)
+		if !ok || !hasLabels(artifact, labels) {
+			continue
+		}
+
+		result = append(result, artifact)
+	}
+
+	return result, nil
+}
+
+// Download writes the archive of the artifact, the error wraps gcs.ErrObjectNotExist if there is no such artifact.
+func Download(ctx context.Context, bucket *gcs.BucketHandle, teamID, sandboxID, name string, w io.Writer) error {
+	// The archives can be large, so the download isn't limited by the read timeout of the storage objects
+	reader, err := bucket.Object(storagePath(teamID, sandboxID, name)).NewReader(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read artifact '%s': %w", name, err)
+	}
+	defer reader.Close()
+
+	_, err = io.Copy(w, reader)
+	if err != nil {
+		return fmt.Errorf("failed to download artifact '%s': %w", name, err)
+	}
+
+	return nil
+}
+
+// Get returns the stored artifact, the error wraps gcs.ErrObjectNotExist if there is no such artifact.
+func Get(ctx context.Context, bucket *gcs.BucketHandle, teamID, sandboxID, name string) (*Artifact, error) {
+	attrs, err := bucket.Object(storagePath(teamID, sandboxID, name)).Attrs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artifact '%s': %w", name, err)
+	}
+
+	artifact, ok := fromAttrs(teamID, attrs)
+	if !ok {
+		return nil, fmt.Errorf("object '%s' is not an artifact", attrs.Name)
+	}
+
+	return artifact, nil
+}
+
+func fromAttrs(teamID string, attrs *storage.ObjectAttrs) (*Artifact, bool) {
+	rel := strings.TrimPrefix(attrs.Name, teamDir(teamID)+"/")
+
+	sandboxID, file, ok := strings.Cut(rel, "/")
+	if !ok || !strings.HasSuffix(file, archiveExtension) {
+		return nil, false
+	}
+
+	artifact := &Artifact{
+		SandboxID: sandboxID,
+		Name:      strings.TrimSuffix(file, archiveExtension),
+		Path:      attrs.Metadata[pathMetadataKey],
+		Labels:    make(map[string]string),
+		SizeBytes: attrs.Size,
+		CreatedAt: attrs.Created,
+	}
+
+	for key, value := range attrs.Metadata {
+		if label, ok := strings.CutPrefix(key, labelMetadataKey); ok {
+			artifact.Labels[label] = value
+		}
+	}
+
+	return artifact, true
+}
+
+func hasLabels(artifact *Artifact, labels map[string]string) bool {
+	for key, value := range labels {
+		if artifact.Labels[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
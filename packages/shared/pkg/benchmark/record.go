@@ -0,0 +1,294 @@
+// Package benchmark is the common format of the benchmark results. The runs on different machines write their
+// results in the same CSV schema, so the results can be merged into one dataset and compared.
+package benchmark
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Record is the result of one operation of the benchmark.
+type Record struct {
+	// Run identifies the benchmark run, e.g. the commit the benchmark ran against.
+	Run  string
+	Host string
+	// Operation is the measured part of the benchmark, e.g. the sandbox create.
+	Operation string
+	// ID identifies the object of the operation, e.g. the sandbox.
+	ID        string
+	StartedAt time.Time
+	Duration  time.Duration
+	// Error is empty if the operation succeeded.
+	Error string
+}
+
+var csvHeader = []string{"run", "host", "operation", "id", "started_at", "duration_ms", "error"}
+
+// WriteCSV writes the records with the header, the durations are in milliseconds with microsecond precision.
+func WriteCSV(w io.Writer, records []Record) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write(csvHeader)
+	if err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, r := range records {
+		err = cw.Write([]string{
+			r.Run,
+			r.Host,
+			r.Operation,
+			r.ID,
+			r.StartedAt.Format(time.RFC3339Nano),
+			strconv.FormatFloat(float64(r.Duration.Microseconds())/1000, 'f', 3, 64),
+			r.Error,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	cw.Flush()
+
+	err = cw.Error()
+	if err != nil {
+		return fmt.Errorf("failed to write CSV records: %w", err)
+	}
+
+	return nil
+}
+
+// WriteCSVFile writes the records into the file.
+func WriteCSVFile(path string, records []Record) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer f.Close()
+
+	return WriteCSV(f, records)
+}
+
+// ReadCSV reads the records written by WriteCSV.
+func ReadCSV(r io.Reader) ([]Record, error) {
+	cr := csv.NewReader(r)
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	if !slices.Equal(header, csvHeader) {
+		return nil, fmt.Errorf("unexpected CSV header %v, expected %v", header, csvHeader)
+	}
+
+	var records []Record
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV record: %w", err)
+		}
+
+		startedAt, err := time.Parse(time.RFC3339Nano, row[4])
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time '%s': %w", row[4], err)
+		}
+
+		ms, err := strconv.ParseFloat(row[5], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration '%s': %w", row[5], err)
+		}
+
+		records = append(records, Record{
+			Run:       row[0],
+			Host:      row[1],
+			Operation: row[2],
+			ID:        row[3],
+			StartedAt: startedAt,
+			Duration:  time.Duration(ms * float64(time.Millisecond)),
+			Error:     row[6],
+		})
+	}
+
+	return records, nil
+}
+
+// ReadCSVFile reads the records from the file.
+func ReadCSVFile(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CSV file: %w", err)
+	}
+	defer f.Close()
+
+	records, err := ReadCSV(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read '%s': %w", path, err)
+	}
+
+	return records, nil
+}
+
+// Summary is the distribution of the successful operations of the run, the failed ones are only counted.
+type Summary struct {
+	Run       string
+	Operation string
+	Hosts     int
+	Errors    int
+	Distribution
+	Histogram []Bucket
+}
+
+// Summarize summarizes the records by the run and the operation, the summaries are sorted by the run
+// and by the first occurrence of the operation.
+func Summarize(records []Record, bounds []time.Duration) []Summary {
+	type key struct {
+		run       string
+		operation string
+	}
+
+	type group struct {
+		order     int
+		hosts     map[string]struct{}
+		errors    int
+		durations []time.Duration
+	}
+
+	groups := make(map[key]*group)
+	for _, r := range records {
+		k := key{run: r.Run, operation: r.Operation}
+
+		g, ok := groups[k]
+		if !ok {
+			g = &group{order: len(groups), hosts: make(map[string]struct{})}
+			groups[k] = g
+		}
+
+		g.hosts[r.Host] = struct{}{}
+
+		if r.Error != "" {
+			g.errors++
+
+			continue
+		}
+
+		g.durations = append(g.durations, r.Duration)
+	}
+
+	summaries := make([]Summary, 0, len(groups))
+	orders := make(map[key]int, len(groups))
+	for k, g := range groups {
+		orders[k] = g.order
+
+		summaries = append(summaries, Summary{
+			Run:          k.run,
+			Operation:    k.operation,
+			Hosts:        len(g.hosts),
+			Errors:       g.errors,
+			Distribution: Distribute(g.durations),
+			Histogram:    Histogram(g.durations, bounds),
+		})
+	}
+
+	slices.SortFunc(summaries, func(a, b Summary) int {
+		return cmp.Or(
+			cmp.Compare(a.Run, b.Run),
+			cmp.Compare(orders[key{a.Run, a.Operation}], orders[key{b.Run, b.Operation}]),
+		)
+	})
+
+	return summaries
+}
+
+type jsonBucket struct {
+	// UpperBoundMs is nil for the last bucket without the upper bound.
+	UpperBoundMs *float64 `json:"upperBoundMs"`
+	Count        int      `json:"count"`
+}
+
+type jsonSummary struct {
+	Run       string       `json:"run"`
+	Operation string       `json:"operation"`
+	Hosts     int          `json:"hosts"`
+	Count     int          `json:"count"`
+	Errors    int          `json:"errors"`
+	MinMs     float64      `json:"minMs"`
+	MeanMs    float64      `json:"meanMs"`
+	P50Ms     float64      `json:"p50Ms"`
+	P90Ms     float64      `json:"p90Ms"`
+	P99Ms     float64      `json:"p99Ms"`
+	MaxMs     float64      `json:"maxMs"`
+	Histogram []jsonBucket `json:"histogram"`
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// MarshalJSON writes the durations in milliseconds, so the summaries of different runs can be diffed.
+func (s Summary) MarshalJSON() ([]byte, error) {
+	summary := jsonSummary{
+		Run:       s.Run,
+		Operation: s.Operation,
+		Hosts:     s.Hosts,
+		Count:     s.Count,
+		Errors:    s.Errors,
+		MinMs:     ms(s.Min),
+		MeanMs:    ms(s.Mean),
+		P50Ms:     ms(s.P50),
+		P90Ms:     ms(s.P90),
+		P99Ms:     ms(s.P99),
+		MaxMs:     ms(s.Max),
+		Histogram: make([]jsonBucket, 0, len(s.Histogram)),
+	}
+
+	for i, b := range s.Histogram {
+		bucket := jsonBucket{Count: b.Count}
+		if i < len(s.Histogram)-1 {
+			bound := ms(b.UpperBound)
+			bucket.UpperBoundMs = &bound
+		}
+
+		summary.Histogram = append(summary.Histogram, bucket)
+	}
+
+	return json.Marshal(summary)
+}
+
+// PrintSummaries writes the summaries as a table.
+func PrintSummaries(w io.Writer, summaries []Summary) {
+	fmt.Fprintf(w, "%-20s %-24s %6s %8s %8s %10s %10s %10s %10s %10s %10s\n",
+		"run", "operation", "hosts", "count", "errors", "min", "mean", "p50", "p90", "p99", "max")
+
+	for _, s := range summaries {
+		fmt.Fprintf(w, "%-20s %-24s %6d %8d %8d %10s %10s %10s %10s %10s %10s\n",
+			s.Run, s.Operation, s.Hosts, s.Count, s.Errors,
+			s.Min.Round(time.Microsecond), s.Mean.Round(time.Microsecond), s.P50.Round(time.Microsecond),
+			s.P90.Round(time.Microsecond), s.P99.Round(time.Microsecond), s.Max.Round(time.Microsecond))
+	}
+}
+
+// WriteJSON writes the value as indented JSON.
+func WriteJSON(w io.Writer, value any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(value)
+	if err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package benchmark
+
+import (
+	"slices"
+	"time"
+)
+
+// Distribution describes the durations of the operation.
+type Distribution struct {
+	Count int
+	Min   time.Duration
+	Mean  time.Duration
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// Percentile returns the nearest-rank percentile of the sorted durations, p is between 0 and 1.
+func Percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	idx := int(float64(len(sorted)-1) * p)
+
+	return sorted[idx]
+}
+
+// Distribute computes the distribution of the durations, the durations are sorted in place.
+func Distribute(durations []time.Duration) Distribution {
+	slices.Sort(durations)
+
+	d := Distribution{Count: len(durations)}
+	if len(durations) == 0 {
+		return d
+	}
+
+	var sum time.Duration
+	for _, duration := range durations {
+		sum += duration
+	}
+
+	d.Min = durations[0]
+	d.Mean = sum / time.Duration(len(durations))
+	d.P50 = Percentile(durations, 0.5)
+	d.P90 = Percentile(durations, 0.9)
+	d.P99 = Percentile(durations, 0.99)
+	d.Max = durations[len(durations)-1]
+
+	return d
+}
+
+// Bucket counts the durations up to its upper bound that weren't counted by the previous buckets,
+// the last bucket has no upper bound and its UpperBound is 0.
+type Bucket struct {
+	UpperBound time.Duration
+	Count      int
+}
+
+// DefaultBounds are the exponential bounds from 1ms to about 1 minute.
+var DefaultBounds = exponentialBounds(time.Millisecond, 2, 17)
+
+func exponentialBounds(start time.Duration, factor, count int) []time.Duration {
+	bounds := make([]time.Duration, count)
+
+	bound := start
+	for i := range bounds {
+		bounds[i] = bound
+		bound *= time.Duration(factor)
+	}
+
+	return bounds
+}
+
+// Histogram counts the durations into the buckets with the sorted upper bounds, the durations above the last bound
+// are counted in the extra last bucket.
+func Histogram(durations []time.Duration, bounds []time.Duration) []Bucket {
+	buckets := make([]Bucket, len(bounds)+1)
+	for i, bound := range bounds {
+		buckets[i].UpperBound = bound
+	}
+
+	for _, duration := range durations {
+		idx, _ := slices.BinarySearch(bounds, duration)
+		buckets[idx].Count++
+	}
+
+	return buckets
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+)
+
+// Envelope flags of the Connect streaming protocol.
+const connectFlagEndStream = 0x02
+
+// ExecError is returned when the command in the sandbox fails.
+type ExecError struct {
+	// ExitCode is the exit code of the command, zero if the command failed before it exited.
+	ExitCode int32
+	Message  string
+}
+
+func (e *ExecError) Error() string {
+	return e.Message
+}
+
+type processStartRequest struct {
+	Process struct {
+		Cmd  string   `json:"cmd"`
+		Args []string `json:"args"`
+	} `json:"process"`
+}
+
+type processStartResponse struct {
+	Event struct {
+		Data *struct {
+			Stdout []byte `json:"stdout"`
+			Stderr []byte `json:"stderr"`
+		} `json:"data"`
+		End *struct {
+			ExitCode int32   `json:"exitCode"`
+			Error    *string `json:"error"`
+		} `json:"end"`
+	} `json:"event"`
+}
+
+type connectEndStream struct {
+	Error *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// EnvdURL returns the address of the envd in the sandbox.
+func (h *SandboxHandle) EnvdURL() string {
+	return fmt.Sprintf("https://%d-%s-%s.%s", consts.DefaultEnvdServerPort, h.SandboxID, h.ClientID, h.sdk.domain)
+}
+
+// Exec runs the command in the sandbox via the envd process service and waits for its exit code, the output is streamed to stdout and stderr if set.
+// The request uses the Connect streaming protocol with JSON encoding, the same as the SDKs. The error is ExecError if the command failed.
+func (h *SandboxHandle) Exec(ctx context.Context, command string, stdout, stderr io.Writer) error {
+	ctx, cancel := h.sdk.withTimeout(ctx)
+	defer cancel()
+
+	var start processStartRequest
+	start.Process.Cmd = "/bin/bash"
+	start.Process.Args = []string{"-l", "-c", command}
+
+	message, err := json.Marshal(start)
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	body.WriteByte(0)
+	binary.Write(&body, binary.BigEndian, uint32(len(message)))
+	body.Write(message)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, h.EnvdURL()+"/process.Process/Start", &body)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/connect+json")
+	request.Header.Set("Connect-Protocol-Version", "1")
+	request.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("user:")))
+
+	// The request starts the process, it isn't retried as it doesn't have the idempotency key
+	response, err := h.sdk.http.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(response.Body)
+
+		return &APIError{StatusCode: response.StatusCode, Message: strings.TrimSpace(string(data))}
+	}
+
+	header := make([]byte, 5)
+	for {
+		_, err = io.ReadFull(response.Body, header)
+		if err != nil {
+			return fmt.Errorf("failed to read the process event: %w", err)
+		}
+
+		payload := make([]byte, binary.BigEndian.Uint32(header[1:]))
+		_, err = io.ReadFull(response.Body, payload)
+		if err != nil {
+			return fmt.Errorf("failed to read the process event: %w", err)
+		}
+
+		if header[0]&connectFlagEndStream != 0 {
+			var end connectEndStream
+			err = json.Unmarshal(payload, &end)
+			if err == nil && end.Error != nil {
+				return &ExecError{Message: fmt.Sprintf("envd error [%s]: %s", end.Error.Code, end.Error.Message)}
+			}
+
+			return errors.New("process stream ended without the exit code")
+		}
+
+		var event processStartResponse
+		err = json.Unmarshal(payload, &event)
+		if err != nil {
+			return fmt.Errorf("failed to parse the process event: %w", err)
+		}
+
+		if data := event.Event.Data; data != nil {
+			if stdout != nil && len(data.Stdout) > 0 {
+				stdout.Write(data.Stdout)
+			}
+
+			if stderr != nil && len(data.Stderr) > 0 {
+				stderr.Write(data.Stderr)
+			}
+		}
+
+		end := event.Event.End
+		if end == nil {
+			continue
+		}
+
+		if end.Error != nil {
+			return &ExecError{ExitCode: end.ExitCode, Message: fmt.Sprintf("process error: %s", *end.Error)}
+		}
+
+		if end.ExitCode != 0 {
+			return &ExecError{ExitCode: end.ExitCode, Message: fmt.Sprintf("process exited with code %d", end.ExitCode)}
+		}
+
+		return nil
+	}
+}
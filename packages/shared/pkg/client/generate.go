@@ -0,0 +1,4 @@
+package client
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 -old-config-style -generate types --package client -o types.gen.go ../../../../spec/openapi.yml
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 -old-config-style -generate client --package client -o client.gen.go ../../../../spec/openapi.yml
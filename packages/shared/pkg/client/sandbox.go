@@ -0,0 +1,216 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SandboxHandle is the running sandbox, it's used to control the sandbox and to execute the commands in it.
+type SandboxHandle struct {
+	Sandbox
+
+	sdk *SDK
+}
+
+// CreateSandbox creates the sandbox from the template. The request has the idempotency key, so it's retried without creating more sandboxes.
+// If the sandbox allows warming, the delayed request is retried until the template is fetched to the node or the timeout of the SDK.
+func (s *SDK) CreateSandbox(ctx context.Context, sandbox NewSandbox) (*SandboxHandle, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	key := idempotencyKey()
+
+	for {
+		response, err := s.API.PostSandboxesWithResponse(ctx, &PostSandboxesParams{IdempotencyKey: key}, sandbox)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.JSON202 != nil {
+			err = waitWarming(ctx, response.JSON202)
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		err = checkResponse(response.HTTPResponse, response.Body, http.StatusCreated)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SandboxHandle{Sandbox: *response.JSON201, sdk: s}, nil
+	}
+}
+
+// ResumeSandbox resumes the paused sandbox. If the sandbox allows warming, the delayed request is retried like in CreateSandbox.
+func (s *SDK) ResumeSandbox(ctx context.Context, sandboxID string, sandbox ResumedSandbox) (*SandboxHandle, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	for {
+		response, err := s.API.PostSandboxesSandboxIDResumeWithResponse(ctx, sandboxID, sandbox)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.JSON202 != nil {
+			err = waitWarming(ctx, response.JSON202)
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		err = checkResponse(response.HTTPResponse, response.Body, http.StatusCreated)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SandboxHandle{Sandbox: *response.JSON201, sdk: s}, nil
+	}
+}
+
+// waitWarming waits before the start of the sandbox delayed by the API is retried, the template is being fetched to the node meanwhile.
+func waitWarming(ctx context.Context, warming *SandboxWarming) error {
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("the template is still being fetched to the node: %w", ctx.Err())
+	case <-time.After(time.Duration(warming.RetryAfter) * time.Second):
+		return nil
+	}
+}
+
+// ConnectSandbox returns the handle of the running sandbox, the envd version of the sandbox isn't known then.
+func (s *SDK) ConnectSandbox(ctx context.Context, sandboxID string) (*SandboxHandle, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	response, err := s.API.GetSandboxesSandboxIDWithResponse(ctx, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(response.HTTPResponse, response.Body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	sbx := response.JSON200
+
+	return &SandboxHandle{
+		Sandbox: Sandbox{
+			Alias:      sbx.Alias,
+			ClientID:   sbx.ClientID,
+			SandboxID:  sbx.SandboxID,
+			TemplateID: sbx.TemplateID,
+		},
+		sdk: s,
+	}, nil
+}
+
+// ListSandboxes returns the running sandboxes of the team.
+func (s *SDK) ListSandboxes(ctx context.Context, params GetSandboxesParams) ([]RunningSandbox, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	response, err := s.API.GetSandboxesWithResponse(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(response.HTTPResponse, response.Body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return *response.JSON200, nil
+}
+
+// Kill kills the sandbox, killing the sandbox that doesn't exist is not an error.
+func (h *SandboxHandle) Kill(ctx context.Context) error {
+	ctx, cancel := h.sdk.withTimeout(ctx)
+	defer cancel()
+
+	response, err := h.sdk.API.DeleteSandboxesSandboxIDWithResponse(ctx, h.SandboxID)
+	if err != nil {
+		return err
+	}
+
+	err = checkResponse(response.HTTPResponse, response.Body, http.StatusNoContent)
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Pause pauses the sandbox, it can be resumed with ResumeSandbox.
+func (h *SandboxHandle) Pause(ctx context.Context) error {
+	ctx, cancel := h.sdk.withTimeout(ctx)
+	defer cancel()
+
+	response, err := h.sdk.API.PostSandboxesSandboxIDPauseWithResponse(ctx, h.SandboxID)
+	if err != nil {
+		return err
+	}
+
+	return checkResponse(response.HTTPResponse, response.Body, http.StatusNoContent)
+}
+
+// SetTimeout sets the sandbox to be killed after the timeout from now.
+func (h *SandboxHandle) SetTimeout(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := h.sdk.withTimeout(ctx)
+	defer cancel()
+
+	response, err := h.sdk.API.PostSandboxesSandboxIDTimeoutWithResponse(ctx, h.SandboxID, PostSandboxesSandboxIDTimeoutJSONRequestBody{
+		Timeout: int32(timeout.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+
+	return checkResponse(response.HTTPResponse, response.Body, http.StatusNoContent)
+}
+
+// Logs returns the logs of the sandbox since the start time.
+func (h *SandboxHandle) Logs(ctx context.Context, start time.Time) ([]SandboxLog, error) {
+	ctx, cancel := h.sdk.withTimeout(ctx)
+	defer cancel()
+
+	startMs := start.UnixMilli()
+
+	response, err := h.sdk.API.GetSandboxesSandboxIDLogsWithResponse(ctx, h.SandboxID, &GetSandboxesSandboxIDLogsParams{Start: &startMs})
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(response.HTTPResponse, response.Body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.JSON200.Logs, nil
+}
+
+// Metrics returns the latest resource usage of the sandbox.
+func (h *SandboxHandle) Metrics(ctx context.Context) ([]SandboxMetric, error) {
+	ctx, cancel := h.sdk.withTimeout(ctx)
+	defer cancel()
+
+	response, err := h.sdk.API.GetSandboxesSandboxIDMetricsWithResponse(ctx, h.SandboxID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(response.HTTPResponse, response.Body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return *response.JSON200, nil
+}
@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultAPIURL = "https://api.e2b.dev"
+	DefaultDomain = "e2b.dev"
+
+	defaultTimeout = time.Minute
+	defaultRetries = 3
+
+	retryInitialBackoff = 200 * time.Millisecond
+	retryMaxBackoff     = 5 * time.Second
+)
+
+// Config is the configuration of the SDK, the credentials that aren't set are not sent.
+type Config struct {
+	// APIURL is the address of the API, DefaultAPIURL if empty.
+	APIURL string
+	// Domain is the domain of the sandboxes, used to reach envd in the sandboxes, DefaultDomain if empty.
+	Domain string
+
+	APIKey      string
+	AccessToken string
+	AdminToken  string
+
+	// Timeout is the timeout of each request including its retries, one minute if zero.
+	Timeout time.Duration
+	// Retries is how many times the failed request is retried, 3 if zero, negative to disable the retries.
+	// Only the idempotent requests are retried, the SDK sets the idempotency key of the sandbox create and template build requests.
+	Retries int
+
+	// HTTPClient is the client the requests are sent with, its transport is wrapped with the retries.
+	HTTPClient *http.Client
+}
+
+// SDK wraps the client generated from the API spec with the authentication, the retries and the errors,
+// so the tools don't have to send the HTTP requests themselves. The generated client is available for the endpoints not wrapped here.
+type SDK struct {
+	API *ClientWithResponses
+
+	domain  string
+	timeout time.Duration
+	http    *http.Client
+}
+
+func NewSDK(config Config) (*SDK, error) {
+	if config.APIURL == "" {
+		config.APIURL = DefaultAPIURL
+	}
+
+	if config.Domain == "" {
+		config.Domain = DefaultDomain
+	}
+
+	if config.Timeout == 0 {
+		config.Timeout = defaultTimeout
+	}
+
+	if config.Retries == 0 {
+		config.Retries = defaultRetries
+	}
+
+	httpClient := &http.Client{}
+	if config.HTTPClient != nil {
+		*httpClient = *config.HTTPClient
+	}
+
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	httpClient.Transport = &retryTransport{next: transport, retries: max(config.Retries, 0)}
+
+	api, err := NewClientWithResponses(
+		strings.TrimSuffix(config.APIURL, "/"),
+		WithHTTPClient(httpClient),
+		WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			if config.APIKey != "" {
+				req.Header.Set("X-API-Key", config.APIKey)
+			}
+
+			if config.AccessToken != "" {
+				req.Header.Set("Authorization", "Bearer "+config.AccessToken)
+			}
+
+			if config.AdminToken != "" {
+				req.Header.Set("X-Admin-Token", config.AdminToken)
+			}
+
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	return &SDK{
+		API:     api,
+		domain:  config.Domain,
+		timeout: config.Timeout,
+		http:    httpClient,
+	}, nil
+}
+
+// APIError is returned when the API responds with an unexpected status code.
+type APIError struct {
+	StatusCode int
+	// ErrorCode is the machine readable error code of the API, if present.
+	ErrorCode string
+	Message   string
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	if e.ErrorCode != "" {
+		return fmt.Sprintf("unexpected status code %d [%s]: %s", e.StatusCode, e.ErrorCode, e.Message)
+	}
+
+	return fmt.Sprintf("unexpected status code %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound returns true if the error is the API error with the 404 status code.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// checkResponse returns the API error if the response doesn't have the expected status code.
+func checkResponse(response *http.Response, body []byte, expected int) error {
+	if response.StatusCode == expected {
+		return nil
+	}
+
+	var apiErr Error
+	if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+		return &APIError{StatusCode: response.StatusCode, ErrorCode: apiErr.ErrorCode, Message: apiErr.Message, RequestID: apiErr.RequestID}
+	}
+
+	return &APIError{StatusCode: response.StatusCode, Message: strings.TrimSpace(string(body))}
+}
+
+// withTimeout limits the request including its retries by the timeout of the SDK.
+func (s *SDK) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, s.timeout)
+}
+
+// idempotencyKey sets the new idempotency key, so the request that creates a resource can be retried safely.
+func idempotencyKey() *IdempotencyKey {
+	key := uuid.NewString()
+
+	return &key
+}
+
+// retryTransport retries the idempotent requests that failed on the network or with a transient status code.
+type retryTransport struct {
+	next    http.RoundTripper
+	retries int
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	retryable := req.Header.Get("Idempotency-Key") != "" || isIdempotentMethod(req.Method)
+	if !retryable || (req.Body != nil && req.GetBody == nil) {
+		return t.next.RoundTrip(req)
+	}
+
+	backoff := retryInitialBackoff
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		response, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.retries || !shouldRetry(req.Context(), response, err) {
+			return response, err
+		}
+
+		if response != nil {
+			io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, retryMaxBackoff)
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func shouldRetry(ctx context.Context, response *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+
+	switch response.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const buildStatusInterval = 2 * time.Second
+
+// RequestTemplateBuild requests the build of the new template, or of the existing template if the template ID is set.
+// The requests have the idempotency key, so they're retried without requesting more builds.
+func (s *SDK) RequestTemplateBuild(ctx context.Context, templateID string, build TemplateBuildRequest) (*Template, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	if templateID == "" {
+		response, err := s.API.PostTemplatesWithResponse(ctx, &PostTemplatesParams{IdempotencyKey: idempotencyKey()}, build)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkResponse(response.HTTPResponse, response.Body, http.StatusAccepted)
+		if err != nil {
+			return nil, err
+		}
+
+		return response.JSON202, nil
+	}
+
+	response, err := s.API.PostTemplatesTemplateIDWithResponse(ctx, templateID, &PostTemplatesTemplateIDParams{IdempotencyKey: idempotencyKey()}, build)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(response.HTTPResponse, response.Body, http.StatusAccepted)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.JSON202, nil
+}
+
+// StartTemplateBuild starts the requested build after the image of the template was pushed.
+func (s *SDK) StartTemplateBuild(ctx context.Context, templateID, buildID string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	response, err := s.API.PostTemplatesTemplateIDBuildsBuildIDWithResponse(ctx, templateID, buildID, &PostTemplatesTemplateIDBuildsBuildIDParams{IdempotencyKey: idempotencyKey()})
+	if err != nil {
+		return err
+	}
+
+	return checkResponse(response.HTTPResponse, response.Body, http.StatusAccepted)
+}
+
+// TemplateBuildStatus returns the status of the build with its logs from the offset.
+func (s *SDK) TemplateBuildStatus(ctx context.Context, templateID, buildID string, logsOffset int32) (*TemplateBuild, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	response, err := s.API.GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse(ctx, templateID, buildID, &GetTemplatesTemplateIDBuildsBuildIDStatusParams{LogsOffset: &logsOffset})
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(response.HTTPResponse, response.Body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.JSON200, nil
+}
+
+// WaitForTemplateBuild waits until the build is finished, the build logs are streamed to the logs writer if set.
+// The status of the finished build is returned, the error is returned only if the status couldn't be checked.
+func (s *SDK) WaitForTemplateBuild(ctx context.Context, templateID, buildID string, logs io.Writer) (*TemplateBuild, error) {
+	ticker := time.NewTicker(buildStatusInterval)
+	defer ticker.Stop()
+
+	var offset int32
+
+	for {
+		build, err := s.TemplateBuildStatus(ctx, templateID, buildID, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get status of build '%s': %w", buildID, err)
+		}
+
+		for _, line := range build.Logs {
+			if logs != nil {
+				fmt.Fprintln(logs, line)
+			}
+		}
+
+		offset += int32(len(build.Logs))
+
+		if build.Status != TemplateBuildStatusBuilding {
+			return build, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,1650 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package client
+
+import (
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	AccessTokenAuthScopes = "AccessTokenAuth.Scopes"
+	AdminTokenAuthScopes  = "AdminTokenAuth.Scopes"
+	ApiKeyAuthScopes      = "ApiKeyAuth.Scopes"
+)
+
+// Defines values for ComponentHealth.
+const (
+	Degraded  ComponentHealth = "degraded"
+	Healthy   ComponentHealth = "healthy"
+	Unhealthy ComponentHealth = "unhealthy"
+)
+
+// Defines values for ComponentKind.
+const (
+	ComponentKindDatabase ComponentKind = "database"
+	ComponentKindNode     ComponentKind = "node"
+	ComponentKindProxy    ComponentKind = "proxy"
+	ComponentKindRedis    ComponentKind = "redis"
+	ComponentKindStorage  ComponentKind = "storage"
+)
+
+// Defines values for EgressPresetName.
+const (
+	EgressPresetNameFull                EgressPresetName = "full"
+	EgressPresetNameNone                EgressPresetName = "none"
+	EgressPresetNamePackageManagersOnly EgressPresetName = "package-managers-only"
+)
+
+// Defines values for HardeningLevel.
+const (
+	HardeningLevelNone     HardeningLevel = "none"
+	HardeningLevelStandard HardeningLevel = "standard"
+	HardeningLevelStrict   HardeningLevel = "strict"
+)
+
+// Defines values for HookFailurePolicy.
+const (
+	Fail   HookFailurePolicy = "fail"
+	Ignore HookFailurePolicy = "ignore"
+)
+
+// Defines values for NodeStatus.
+const (
+	NodeStatusDraining NodeStatus = "draining"
+	NodeStatusReady    NodeStatus = "ready"
+)
+
+// Defines values for SandboxChangedFileType.
+const (
+	Directory SandboxChangedFileType = "directory"
+	File      SandboxChangedFileType = "file"
+)
+
+// Defines values for SandboxDiagnosticsReason.
+const (
+	FirecrackerCrash SandboxDiagnosticsReason = "firecracker_crash"
+	GuestExit        SandboxDiagnosticsReason = "guest_exit"
+	GuestPanic       SandboxDiagnosticsReason = "guest_panic"
+	OomKilled        SandboxDiagnosticsReason = "oom_killed"
+	UffdCrash        SandboxDiagnosticsReason = "uffd_crash"
+)
+
+// Defines values for SandboxLogStream.
+const (
+	Stderr SandboxLogStream = "stderr"
+	Stdout SandboxLogStream = "stdout"
+	System SandboxLogStream = "system"
+)
+
+// Defines values for SandboxLogLevel.
+const (
+	SandboxLogLevelDebug SandboxLogLevel = "debug"
+	SandboxLogLevelError SandboxLogLevel = "error"
+	SandboxLogLevelInfo  SandboxLogLevel = "info"
+	SandboxLogLevelWarn  SandboxLogLevel = "warn"
+)
+
+// Defines values for SandboxPriority.
+const (
+	High   SandboxPriority = "high"
+	Low    SandboxPriority = "low"
+	Normal SandboxPriority = "normal"
+)
+
+// Defines values for SandboxState.
+const (
+	Paused  SandboxState = "paused"
+	Running SandboxState = "running"
+)
+
+// Defines values for SandboxWarmingStatus.
+const (
+	Warming SandboxWarmingStatus = "warming"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
+// Defines values for TeamBudgetStatus.
+const (
+	Exceeded TeamBudgetStatus = "exceeded"
+	Ok       TeamBudgetStatus = "ok"
+	Warning  TeamBudgetStatus = "warning"
+)
+
+// Defines values for TemplateBuildStatus.
+const (
+	TemplateBuildStatusBuilding TemplateBuildStatus = "building"
+	TemplateBuildStatusError    TemplateBuildStatus = "error"
+	TemplateBuildStatusReady    TemplateBuildStatus = "ready"
+)
+
+// Defines values for TemplateRebuildScheduleLastBuildStatus.
+const (
+	Building TemplateRebuildScheduleLastBuildStatus = "building"
+	Failed   TemplateRebuildScheduleLastBuildStatus = "failed"
+	Success  TemplateRebuildScheduleLastBuildStatus = "success"
+)
+
+// Defines values for GetSandboxesParamsSortBy.
+const (
+	EndAt     GetSandboxesParamsSortBy = "endAt"
+	StartedAt GetSandboxesParamsSortBy = "startedAt"
+)
+
+// Defines values for GetTemplatesParamsSortBy.
+const (
+	CreatedAt GetTemplatesParamsSortBy = "createdAt"
+	UpdatedAt GetTemplatesParamsSortBy = "updatedAt"
+)
+
+// ArtifactLabels Labels used to find the artifacts, at most 16 labels
+type ArtifactLabels map[string]string
+
+// BuildLogEntry defines model for BuildLogEntry.
+type BuildLogEntry struct {
+	// BuildID Identifier of the build
+	BuildID string `json:"buildID"`
+
+	// Line Position of the line in the build log
+	Line int32 `json:"line"`
+
+	// Message Log message
+	Message string `json:"message"`
+
+	// TemplateID Identifier of the template
+	TemplateID string `json:"templateID"`
+
+	// Timestamp Time the line was logged
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// BuildLogsExport defines model for BuildLogsExport.
+type BuildLogsExport struct {
+	// Bucket Name of the bucket the logs were exported to
+	Bucket string `json:"bucket"`
+
+	// Lines Number of the exported log lines
+	Lines int32 `json:"lines"`
+
+	// Object Name of the exported object, the lines are stored as newline delimited JSON
+	Object string `json:"object"`
+}
+
+// BuildLogsExportRequest defines model for BuildLogsExportRequest.
+type BuildLogsExportRequest struct {
+	// Bucket Name of the customer's GCS bucket the logs are exported to. The bucket must have the e2b-team-id label with the ID of the team and the service account of the API must be allowed to create objects in it.
+	Bucket string `json:"bucket"`
+
+	// Prefix Prefix of the exported object name
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// CPUCount CPU cores for the sandbox
+type CPUCount = int32
+
+// ClusterStatus defines model for ClusterStatus.
+type ClusterStatus struct {
+	// CheckedAt Time of the last health check of the components
+	CheckedAt  time.Time         `json:"checkedAt"`
+	Components []ComponentStatus `json:"components"`
+
+	// Status Health of the component or the cluster
+	Status ComponentHealth `json:"status"`
+}
+
+// ComponentHealth Health of the component or the cluster
+type ComponentHealth string
+
+// ComponentKind Kind of the component
+type ComponentKind string
+
+// ComponentStatus defines model for ComponentStatus.
+type ComponentStatus struct {
+	// CheckedAt Time of the last health check
+	CheckedAt time.Time `json:"checkedAt"`
+
+	// Kind Kind of the component
+	Kind ComponentKind `json:"kind"`
+
+	// LastError Last error of the component, kept after the component recovers
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastErrorAt Time of the last error of the component
+	LastErrorAt *time.Time `json:"lastErrorAt,omitempty"`
+
+	// LatencyMs Latency of the last health check in milliseconds
+	LatencyMs int64 `json:"latencyMs"`
+
+	// Message Reason the component is degraded
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the component, the node ID for the nodes
+	Name string `json:"name"`
+
+	// Status Health of the component or the cluster
+	Status ComponentHealth `json:"status"`
+}
+
+// DeepHealth defines model for DeepHealth.
+type DeepHealth struct {
+	Probes []HealthProbe `json:"probes"`
+
+	// Status Health of the component or the cluster
+	Status ComponentHealth `json:"status"`
+}
+
+// EgressPolicy Outbound traffic allowed from the sandboxes, the preset sets the defaults that are extended by the allowed hosts. The hosts are resolved when the sandbox starts and DNS is always allowed
+type EgressPolicy struct {
+	// AllowedHosts Hostnames the sandboxes can connect to in addition to the hosts of the preset
+	AllowedHosts *[]string `json:"allowedHosts,omitempty"`
+
+	// Preset Named egress policy, all the outbound traffic is allowed (full), no outbound traffic is allowed (none) or only the registries of the common package managers are reachable (package-managers-only)
+	Preset EgressPresetName `json:"preset"`
+}
+
+// EgressPreset defines model for EgressPreset.
+type EgressPreset struct {
+	// AllowAll Whether all the outbound traffic is allowed
+	AllowAll bool `json:"allowAll"`
+
+	// AllowedHosts Hostnames the sandboxes with the preset can connect to
+	AllowedHosts []string `json:"allowedHosts"`
+
+	// Description Description of the preset
+	Description string `json:"description"`
+
+	// Name Named egress policy, all the outbound traffic is allowed (full), no outbound traffic is allowed (none) or only the registries of the common package managers are reachable (package-managers-only)
+	Name EgressPresetName `json:"name"`
+}
+
+// EgressPresetName Named egress policy, all the outbound traffic is allowed (full), no outbound traffic is allowed (none) or only the registries of the common package managers are reachable (package-managers-only)
+type EgressPresetName string
+
+// EnvVars defines model for EnvVars.
+type EnvVars map[string]string
+
+// Error defines model for Error.
+type Error struct {
+	// Code Error code
+	Code int32 `json:"code"`
+
+	// ErrorCode Machine readable error code (e.g. E2B_SANDBOX_NOT_FOUND)
+	ErrorCode string `json:"errorCode"`
+
+	// Message Error
+	Message string `json:"message"`
+
+	// RequestID Identifier of the request, also returned in the X-Request-ID header
+	RequestID string `json:"requestID"`
+}
+
+// HardeningLevel Defaults of the hardening, the processes aren't restricted (none), the kernel administration syscalls are denied (standard) or additionally the debugging and namespace syscalls are denied, the processes can't gain privileges and /boot, /etc and /usr are read-only (strict)
+type HardeningLevel string
+
+// HealthProbe defines model for HealthProbe.
+type HealthProbe struct {
+	// DurationMs Duration of the probe in milliseconds
+	DurationMs int64 `json:"durationMs"`
+
+	// Name Name of the probed dependency
+	Name string `json:"name"`
+
+	// Status Health of the component or the cluster
+	Status ComponentHealth `json:"status"`
+}
+
+// HookFailurePolicy What happens when the hook fails, the failure is only logged (ignore) or the resume or pause of the sandbox fails (fail)
+type HookFailurePolicy string
+
+// Kernel defines model for Kernel.
+type Kernel struct {
+	// Checksum SHA-256 checksum of the kernel binary (hex encoded)
+	Checksum string `json:"checksum"`
+
+	// CreatedAt Time when the kernel was registered
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Description Description of the kernel
+	Description *string `json:"description,omitempty"`
+
+	// Version Version of the kernel
+	Version string `json:"version"`
+}
+
+// KilledSandboxes defines model for KilledSandboxes.
+type KilledSandboxes struct {
+	// SandboxIDs Identifiers of the killed sandboxes
+	SandboxIDs []string `json:"sandboxIDs"`
+}
+
+// LifecycleHook defines model for LifecycleHook.
+type LifecycleHook struct {
+	// Command Command executed in the sandbox, the hook succeeds when it exits with zero code
+	Command string `json:"command"`
+
+	// FailurePolicy What happens when the hook fails, the failure is only logged (ignore) or the resume or pause of the sandbox fails (fail)
+	FailurePolicy *HookFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// Timeout Maximum time the hook can run in seconds
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
+// MemoryMB Memory for the sandbox in MB
+type MemoryMB = int32
+
+// NewKernel defines model for NewKernel.
+type NewKernel struct {
+	// Checksum SHA-256 checksum of the kernel binary (hex encoded)
+	Checksum string `json:"checksum"`
+
+	// Description Description of the kernel
+	Description *string `json:"description,omitempty"`
+
+	// Version Version of the kernel, the binary is stored in the kernels bucket under this version
+	Version string `json:"version"`
+}
+
+// NewPinnedBuild defines model for NewPinnedBuild.
+type NewPinnedBuild struct {
+	// BuildID Identifier of the build
+	BuildID openapi_types.UUID `json:"buildID"`
+
+	// NodeID Identifier of the node the build is pinned on, the build is pinned on all nodes if not set
+	NodeID *string `json:"nodeID,omitempty"`
+}
+
+// NewSandbox defines model for NewSandbox.
+type NewSandbox struct {
+	// AllowWarming Whether the start of the sandbox can be delayed when its template isn't cached on the node, the template is fetched to the node in the background and the request responds with 202. The request should be retried after the Retry-After header then, the sandbox starts fast once the template is cached.
+	AllowWarming *bool `json:"allowWarming,omitempty"`
+
+	// Egress Outbound traffic allowed from the sandboxes, the preset sets the defaults that are extended by the allowed hosts. The hosts are resolved when the sandbox starts and DNS is always allowed
+	Egress  *EgressPolicy `json:"egress,omitempty"`
+	EnvVars *EnvVars      `json:"envVars,omitempty"`
+
+	// Image Reference of a public OCI image the sandbox boots from, e.g. docker.io/library/python:3.12. The rootfs is built from the image on the node and cached for the other sandboxes of the image, the template provides the kernel and the resources. The sandbox boots instead of resuming the memory snapshot of the template, so it can't be paused. The image needs /bin/sh for the init of the sandbox. The private images are pulled with the registry credential of the team for the registry of the image.
+	Image *string `json:"image,omitempty"`
+
+	// Labels Labels used to select the sandboxes
+	Labels   *SandboxLabels   `json:"labels,omitempty"`
+	Metadata *SandboxMetadata `json:"metadata,omitempty"`
+
+	// Priority Priority class of the sandbox. When the node is over capacity, the sandboxes are evicted (paused) by the eviction policy of the node, the lowest-priority-first policy evicts the low priority sandboxes first and the high priority sandboxes last. When the cluster is full, a high priority sandbox preempts (pauses) a low priority sandbox with at least its vCPUs and RAM instead of waiting for the capacity. Only the tiers entitled to it can start the high priority sandboxes. The evicted and preempted sandboxes can be resumed.
+	Priority *SandboxPriority `json:"priority,omitempty"`
+
+	// RegionFailover Whether the sandbox can be placed in another region allowed by the template when the preferred regions have no capacity
+	RegionFailover *bool `json:"regionFailover,omitempty"`
+
+	// Regions Preferred regions of the sandbox in the order of preference, they must be allowed by the template. The sandbox is placed in the first region with the capacity.
+	Regions *[]string `json:"regions,omitempty"`
+
+	// SecretEnvVars Names of the env vars that are secrets. They are removed from the sandbox and zeroed in its memory when the sandbox is paused, so they aren't persisted in the snapshot. They have to be set again after the sandbox is resumed.
+	SecretEnvVars *[]string `json:"secretEnvVars,omitempty"`
+
+	// Secrets References of the team secrets in the NAME or NAME@VERSION format, the latest version is used if the version is not set. The secrets are set as the env vars in the sandbox and as the files in /run/e2b/secrets. The secrets can be referenced in the values of the env vars as ${secrets.NAME} or ${secrets.NAME@VERSION} too. The values are never persisted in the snapshot or the template, only the references are kept, so the secrets are attached again to the resumed sandbox and to the sandboxes created from the template. The API key needs the secrets:use scope.
+	Secrets *SandboxSecrets `json:"secrets,omitempty"`
+
+	// TemplateID Identifier of the required template
+	TemplateID string `json:"templateID"`
+
+	// Timeout Time to live for the sandbox in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+
+	// Variant Name of the resource variant of the template
+	Variant *TemplateVariantName `json:"variant,omitempty"`
+}
+
+// NewSandboxArtifact defines model for NewSandboxArtifact.
+type NewSandboxArtifact struct {
+	// Labels Labels used to find the artifacts, at most 16 labels
+	Labels *ArtifactLabels `json:"labels,omitempty"`
+
+	// Name Name of the artifact, at most 128 letters, digits, '.', '_' or '-'. The artifact of the sandbox with the same name is replaced
+	Name string `json:"name"`
+
+	// Path Path of the file or the directory in the sandbox, relative paths are resolved from the home of the default user
+	Path string `json:"path"`
+}
+
+// NewTeamSecret defines model for NewTeamSecret.
+type NewTeamSecret struct {
+	// Name Name of the secret, it is the name of the env var in the sandbox
+	Name string `json:"name"`
+
+	// Value Value of the secret, it can't be read back. Setting the existing secret creates its new version.
+	Value string `json:"value"`
+}
+
+// Node defines model for Node.
+type Node struct {
+	// AllocatedCPU Number of allocated CPU cores
+	AllocatedCPU int32 `json:"allocatedCPU"`
+
+	// AllocatedMemoryMiB Amount of allocated memory in MiB
+	AllocatedMemoryMiB int32 `json:"allocatedMemoryMiB"`
+
+	// ClusterID Identifier of the cluster of the node
+	ClusterID *string `json:"clusterID,omitempty"`
+
+	// NodeID Identifier of the node
+	NodeID string `json:"nodeID"`
+
+	// Region Region of the node
+	Region *string `json:"region,omitempty"`
+
+	// SandboxCount Number of sandboxes running on the node
+	SandboxCount int32 `json:"sandboxCount"`
+
+	// Status Status of the node
+	Status NodeStatus `json:"status"`
+}
+
+// NodeDetail defines model for NodeDetail.
+type NodeDetail struct {
+	// CachedBuilds List of cached builds id on the node
+	CachedBuilds []string `json:"cachedBuilds"`
+
+	// NodeID Identifier of the node
+	NodeID string `json:"nodeID"`
+
+	// Sandboxes List of sandboxes running on the node
+	Sandboxes []RunningSandbox `json:"sandboxes"`
+
+	// Status Status of the node
+	Status NodeStatus `json:"status"`
+}
+
+// NodeHealthCheck defines model for NodeHealthCheck.
+type NodeHealthCheck struct {
+	// CheckedAt Time of the health check
+	CheckedAt time.Time `json:"checkedAt"`
+
+	// Error Error of the failed health check
+	Error *string `json:"error,omitempty"`
+
+	// Healthy Whether the node responded to the health check as serving
+	Healthy bool `json:"healthy"`
+
+	// LatencyMs Latency of the health check in milliseconds
+	LatencyMs int64 `json:"latencyMs"`
+}
+
+// NodeStatus Status of the node
+type NodeStatus string
+
+// NodeStatusChange defines model for NodeStatusChange.
+type NodeStatusChange struct {
+	// Status Status of the node
+	Status NodeStatus `json:"status"`
+}
+
+// PinnedBuild defines model for PinnedBuild.
+type PinnedBuild struct {
+	// BuildID Identifier of the build
+	BuildID openapi_types.UUID `json:"buildID"`
+
+	// CreatedAt Time when the build was pinned
+	CreatedAt time.Time `json:"createdAt"`
+
+	// NodeID Identifier of the node the build is pinned on, the build is pinned on all nodes if not set
+	NodeID *string `json:"nodeID,omitempty"`
+}
+
+// ReadinessProbe Probe that has to succeed before the sandbox is ready and receives traffic, at least one of the command or the HTTP port is required
+type ReadinessProbe struct {
+	// Command Command executed in the sandbox, the sandbox is ready when it exits with zero code
+	Command *string `json:"command,omitempty"`
+
+	// HttpPath Path of the HTTP endpoint
+	HttpPath *string `json:"httpPath,omitempty"`
+
+	// HttpPort Port in the sandbox, the sandbox is ready when the HTTP endpoint responds with a success status
+	HttpPort *int32 `json:"httpPort,omitempty"`
+
+	// Timeout Maximum time to wait for the sandbox to be ready in seconds
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
+// ReconciledTemplateBuild defines model for ReconciledTemplateBuild.
+type ReconciledTemplateBuild struct {
+	// BuildID Identifier of the build that was marked as failed
+	BuildID string `json:"buildID"`
+
+	// HeartbeatAt Last time the build reported it was running, null if it never did
+	HeartbeatAt *time.Time `json:"heartbeatAt"`
+
+	// TemplateID Identifier of the template
+	TemplateID string `json:"templateID"`
+}
+
+// Regions Regions the sandboxes of the template can run in, the sandboxes can run in any region if empty
+type Regions = []string
+
+// RegistryCredentialValue defines model for RegistryCredentialValue.
+type RegistryCredentialValue struct {
+	// Password Password or access token of the credential, it can't be read back
+	Password string `json:"password"`
+
+	// Username Username of the credential, e.g. AWS for ECR or _json_key_base64 for Artifact Registry
+	Username string `json:"username"`
+}
+
+// ResourceState defines model for ResourceState.
+type ResourceState struct {
+	// Aliases Aliases of the template the template can be imported by
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Etag ETag of the resource, the same as returned when the resource is read
+	Etag string `json:"etag"`
+
+	// Id Identifier of the resource, the name of the secret
+	Id string `json:"id"`
+}
+
+// ResumedSandbox defines model for ResumedSandbox.
+type ResumedSandbox struct {
+	// AllowWarming Whether the start of the sandbox can be delayed when its template isn't cached on the node, the template is fetched to the node in the background and the request responds with 202. The request should be retried after the Retry-After header then, the sandbox starts fast once the template is cached.
+	AllowWarming *bool `json:"allowWarming,omitempty"`
+
+	// Priority Priority class of the sandbox. When the node is over capacity, the sandboxes are evicted (paused) by the eviction policy of the node, the lowest-priority-first policy evicts the low priority sandboxes first and the high priority sandboxes last. When the cluster is full, a high priority sandbox preempts (pauses) a low priority sandbox with at least its vCPUs and RAM instead of waiting for the capacity. Only the tiers entitled to it can start the high priority sandboxes. The evicted and preempted sandboxes can be resumed.
+	Priority *SandboxPriority `json:"priority,omitempty"`
+
+	// Secrets References of the team secrets in the NAME or NAME@VERSION format, the latest version is used if the version is not set. The secrets are set as the env vars in the sandbox and as the files in /run/e2b/secrets. The secrets can be referenced in the values of the env vars as ${secrets.NAME} or ${secrets.NAME@VERSION} too. The values are never persisted in the snapshot or the template, only the references are kept, so the secrets are attached again to the resumed sandbox and to the sandboxes created from the template. The API key needs the secrets:use scope.
+	Secrets *SandboxSecrets `json:"secrets,omitempty"`
+
+	// Timeout Time to live for the sandbox in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
+// RunningSandbox defines model for RunningSandbox.
+type RunningSandbox struct {
+	// Alias Alias of the template
+	Alias *string `json:"alias,omitempty"`
+
+	// ClientID Identifier of the client
+	ClientID string `json:"clientID"`
+
+	// CpuCount CPU cores for the sandbox
+	CpuCount CPUCount `json:"cpuCount"`
+
+	// EndAt Time when the sandbox will expire
+	EndAt time.Time `json:"endAt"`
+
+	// Labels Labels used to select the sandboxes
+	Labels *SandboxLabels `json:"labels,omitempty"`
+
+	// MemoryMB Memory for the sandbox in MB
+	MemoryMB MemoryMB         `json:"memoryMB"`
+	Metadata *SandboxMetadata `json:"metadata,omitempty"`
+
+	// SandboxID Identifier of the sandbox
+	SandboxID string `json:"sandboxID"`
+
+	// StartedAt Time when the sandbox was started
+	StartedAt time.Time `json:"startedAt"`
+
+	// State State of the sandbox, the paused sandboxes have the startedAt and endAt set to the time of the pause
+	State *SandboxState `json:"state,omitempty"`
+
+	// TemplateID Identifier of the template from which is the sandbox created
+	TemplateID string `json:"templateID"`
+}
+
+// Sandbox defines model for Sandbox.
+type Sandbox struct {
+	// Alias Alias of the template
+	Alias *string `json:"alias,omitempty"`
+
+	// ClientID Identifier of the client
+	ClientID string `json:"clientID"`
+
+	// EnvdVersion Version of the envd running in the sandbox
+	EnvdVersion string `json:"envdVersion"`
+
+	// Region Region the sandbox runs in
+	Region *string `json:"region,omitempty"`
+
+	// SandboxID Identifier of the sandbox
+	SandboxID string `json:"sandboxID"`
+
+	// TemplateID Identifier of the template from which is the sandbox created
+	TemplateID string `json:"templateID"`
+}
+
+// SandboxArtifact defines model for SandboxArtifact.
+type SandboxArtifact struct {
+	// CreatedAt Time the artifact was persisted
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Labels Labels used to find the artifacts, at most 16 labels
+	Labels ArtifactLabels `json:"labels"`
+
+	// Name Name of the artifact
+	Name string `json:"name"`
+
+	// Path Path of the file or the directory in the sandbox
+	Path string `json:"path"`
+
+	// SandboxID Identifier of the sandbox the artifact was persisted from
+	SandboxID string `json:"sandboxID"`
+
+	// SizeBytes Size of the tar.gz archive of the artifact
+	SizeBytes int64 `json:"sizeBytes"`
+}
+
+// SandboxChangedFile defines model for SandboxChangedFile.
+type SandboxChangedFile struct {
+	// Path Path of the changed file in the sandbox
+	Path string `json:"path"`
+
+	// Type Type of the changed entry
+	Type SandboxChangedFileType `json:"type"`
+}
+
+// SandboxChangedFileType Type of the changed entry
+type SandboxChangedFileType string
+
+// SandboxCheckpoint defines model for SandboxCheckpoint.
+type SandboxCheckpoint struct {
+	// CheckpointID Identifier of the checkpoint
+	CheckpointID string `json:"checkpointID"`
+
+	// CreatedAt Time when the checkpoint was created
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// SandboxDiagnostics defines model for SandboxDiagnostics.
+type SandboxDiagnostics struct {
+	Envd SandboxDiagnosticsEnvd `json:"envd"`
+
+	// Error Error with which the sandbox terminated
+	Error string `json:"error"`
+
+	// FirecrackerLogs Last lines of the Firecracker output, including the guest serial console
+	FirecrackerLogs []string `json:"firecrackerLogs"`
+
+	// FirecrackerVersion Version of Firecracker
+	FirecrackerVersion string `json:"firecrackerVersion"`
+
+	// KernelVersion Version of the kernel
+	KernelVersion string                   `json:"kernelVersion"`
+	Memory        SandboxDiagnosticsMemory `json:"memory"`
+
+	// RamMB Memory for the sandbox in MB
+	RamMB MemoryMB `json:"ramMB"`
+
+	// Reason Why the sandbox terminated
+	Reason SandboxDiagnosticsReason `json:"reason"`
+	Rootfs SandboxDiagnosticsRootfs `json:"rootfs"`
+
+	// SandboxID Identifier of the sandbox
+	SandboxID string `json:"sandboxID"`
+
+	// StartedAt Time when the sandbox was started
+	StartedAt time.Time `json:"startedAt"`
+
+	// TemplateID Identifier of the template from which is the sandbox created
+	TemplateID string `json:"templateID"`
+
+	// TerminatedAt Time when the sandbox terminated
+	TerminatedAt time.Time `json:"terminatedAt"`
+
+	// Vcpu CPU cores for the sandbox
+	Vcpu CPUCount `json:"vcpu"`
+}
+
+// SandboxDiagnosticsReason Why the sandbox terminated
+type SandboxDiagnosticsReason string
+
+// SandboxDiagnosticsEnvd defines model for SandboxDiagnosticsEnvd.
+type SandboxDiagnosticsEnvd struct {
+	// LastHealthcheckError Error of the last failed envd health check
+	LastHealthcheckError *string `json:"lastHealthcheckError,omitempty"`
+
+	// LastHealthyAt Time of the last successful envd health check
+	LastHealthyAt *time.Time `json:"lastHealthyAt,omitempty"`
+
+	// Version Version of the envd running in the sandbox
+	Version string `json:"version"`
+}
+
+// SandboxDiagnosticsMemory defines model for SandboxDiagnosticsMemory.
+type SandboxDiagnosticsMemory struct {
+	// PageFaults Number of the page faults served by the memory handler (UFFD)
+	PageFaults int64 `json:"pageFaults"`
+
+	// PageSize Size of the memory page in bytes
+	PageSize int64 `json:"pageSize"`
+}
+
+// SandboxDiagnosticsRootfs defines model for SandboxDiagnosticsRootfs.
+type SandboxDiagnosticsRootfs struct {
+	// BlockSize Size of the rootfs block in bytes
+	BlockSize int64 `json:"blockSize"`
+
+	// DirtyBlocks Number of the rootfs blocks written by the sandbox (NBD)
+	DirtyBlocks int64 `json:"dirtyBlocks"`
+
+	// Error Error when collecting the rootfs stats
+	Error *string `json:"error,omitempty"`
+}
+
+// SandboxLabels Labels used to select the sandboxes
+type SandboxLabels map[string]string
+
+// SandboxLog Log entry with timestamp and line
+type SandboxLog struct {
+	// Level Severity of the sandbox log entry
+	Level *SandboxLogLevel `json:"level,omitempty"`
+
+	// Line Log line content
+	Line string `json:"line"`
+
+	// Stream Source of the log entry, the output of the processes or the events of the sandbox
+	Stream *SandboxLogStream `json:"stream,omitempty"`
+
+	// Timestamp Timestamp of the log entry
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// SandboxLogStream Source of the log entry, the output of the processes or the events of the sandbox
+type SandboxLogStream string
+
+// SandboxLogLevel Severity of the sandbox log entry
+type SandboxLogLevel string
+
+// SandboxLogs defines model for SandboxLogs.
+type SandboxLogs struct {
+	// Logs Logs of the sandbox
+	Logs []SandboxLog `json:"logs"`
+}
+
+// SandboxMetadata defines model for SandboxMetadata.
+type SandboxMetadata map[string]string
+
+// SandboxMetric Metric entry with timestamp and line
+type SandboxMetric struct {
+	// CpuCount Number of CPU cores
+	CpuCount int32 `json:"cpuCount"`
+
+	// CpuUsedPct CPU usage percentage
+	CpuUsedPct float32 `json:"cpuUsedPct"`
+
+	// DiskTotalMiB Total space of the sandbox filesystem in MiB
+	DiskTotalMiB *int64 `json:"diskTotalMiB,omitempty"`
+
+	// DiskUsedMiB Used space of the sandbox filesystem in MiB
+	DiskUsedMiB *int64 `json:"diskUsedMiB,omitempty"`
+
+	// MemTotalMiB Total memory in MiB
+	MemTotalMiB int64 `json:"memTotalMiB"`
+
+	// MemUsedMiB Memory used in MiB
+	MemUsedMiB int64 `json:"memUsedMiB"`
+
+	// OpenFds Open file descriptors of all processes in the sandbox
+	OpenFds *int64 `json:"openFds,omitempty"`
+
+	// Processes The processes in the sandbox using the most CPU and memory
+	Processes *[]SandboxProcessMetric `json:"processes,omitempty"`
+
+	// Timestamp Timestamp of the metric entry
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// SandboxNetworkActivity defines model for SandboxNetworkActivity.
+type SandboxNetworkActivity struct {
+	// Destinations Destinations the sandbox connected to since it started, sampled periodically so the short connections can be missed
+	Destinations []SandboxNetworkDestination `json:"destinations"`
+
+	// SandboxID Identifier of the sandbox
+	SandboxID string `json:"sandboxID"`
+}
+
+// SandboxNetworkDestination defines model for SandboxNetworkDestination.
+type SandboxNetworkDestination struct {
+	// BytesReceived Bytes received by the sandbox from the destination
+	BytesReceived int64 `json:"bytesReceived"`
+
+	// BytesSent Bytes sent by the sandbox to the destination
+	BytesSent int64 `json:"bytesSent"`
+
+	// Connections Number of the connections to the destination
+	Connections int64 `json:"connections"`
+
+	// Ip IP address the sandbox connected to
+	Ip string `json:"ip"`
+
+	// LastSeen Time the last connection to the destination was seen
+	LastSeen time.Time `json:"lastSeen"`
+
+	// Port Port the sandbox connected to, zero for the protocols without ports
+	Port int32 `json:"port"`
+
+	// Protocol Protocol of the connections, e.g. tcp, udp or icmp
+	Protocol string `json:"protocol"`
+}
+
+// SandboxPriority Priority class of the sandbox. When the node is over capacity, the sandboxes are evicted (paused) by the eviction policy of the node, the lowest-priority-first policy evicts the low priority sandboxes first and the high priority sandboxes last. When the cluster is full, a high priority sandbox preempts (pauses) a low priority sandbox with at least its vCPUs and RAM instead of waiting for the capacity. Only the tiers entitled to it can start the high priority sandboxes. The evicted and preempted sandboxes can be resumed.
+type SandboxPriority string
+
+// SandboxProcessMetric Resource usage of a process in the sandbox
+type SandboxProcessMetric struct {
+	// Cmd Command line of the process
+	Cmd string `json:"cmd"`
+
+	// CpuUsedPct CPU usage in percent of one CPU core
+	CpuUsedPct float32 `json:"cpuUsedPct"`
+
+	// MemRssMiB Resident memory in MiB
+	MemRssMiB int64 `json:"memRssMiB"`
+
+	// OpenFds Open file descriptors of the process
+	OpenFds int32 `json:"openFds"`
+
+	// Pid Process ID
+	Pid int32 `json:"pid"`
+}
+
+// SandboxRouting defines model for SandboxRouting.
+type SandboxRouting struct {
+	// BuildID Identifier of the build of the running sandbox
+	BuildID *string             `json:"buildID,omitempty"`
+	Node    *SandboxRoutingNode `json:"node,omitempty"`
+
+	// ProxyServerErrors Number of the 5xx responses the client proxies returned for the sandbox in the last 15 minutes, not set if the proxy logs couldn't be queried
+	ProxyServerErrors *int64 `json:"proxyServerErrors,omitempty"`
+
+	// Running Whether the sandbox is in the catalog of the API, the traffic of the sandbox that isn't running can't be routed
+	Running bool            `json:"running"`
+	Sandbox *RunningSandbox `json:"sandbox,omitempty"`
+
+	// SandboxID Identifier of the sandbox
+	SandboxID string `json:"sandboxID"`
+
+	// TeamID Identifier of the team of the running sandbox
+	TeamID *string `json:"teamID,omitempty"`
+}
+
+// SandboxRoutingNode defines model for SandboxRoutingNode.
+type SandboxRoutingNode struct {
+	// Address Address of the orchestrator of the node
+	Address         string           `json:"address"`
+	LastHealthCheck *NodeHealthCheck `json:"lastHealthCheck,omitempty"`
+
+	// NodeID Identifier of the node the traffic of the sandbox is routed to
+	NodeID string `json:"nodeID"`
+
+	// Status Status of the node
+	Status NodeStatus `json:"status"`
+}
+
+// SandboxSecrets References of the team secrets in the NAME or NAME@VERSION format, the latest version is used if the version is not set. The secrets are set as the env vars in the sandbox and as the files in /run/e2b/secrets. The secrets can be referenced in the values of the env vars as ${secrets.NAME} or ${secrets.NAME@VERSION} too. The values are never persisted in the snapshot or the template, only the references are kept, so the secrets are attached again to the resumed sandbox and to the sandboxes created from the template. The API key needs the secrets:use scope.
+type SandboxSecrets = []string
+
+// SandboxState State of the sandbox, the paused sandboxes have the startedAt and endAt set to the time of the pause
+type SandboxState string
+
+// SandboxUpdate defines model for SandboxUpdate.
+type SandboxUpdate struct {
+	// Labels Labels used to select the sandboxes
+	Labels *SandboxLabels `json:"labels,omitempty"`
+}
+
+// SandboxWarming defines model for SandboxWarming.
+type SandboxWarming struct {
+	// RetryAfter Seconds after which the request should be retried
+	RetryAfter int32 `json:"retryAfter"`
+
+	// Status State of the template on the node
+	Status SandboxWarmingStatus `json:"status"`
+}
+
+// SandboxWarmingStatus State of the template on the node
+type SandboxWarmingStatus string
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
+// Team defines model for Team.
+type Team struct {
+	// ApiKey API key for the team
+	ApiKey string `json:"apiKey"`
+
+	// IsDefault Whether the team is the default team
+	IsDefault bool `json:"isDefault"`
+
+	// Name Name of the team
+	Name string `json:"name"`
+
+	// TeamID Identifier of the team
+	TeamID string `json:"teamID"`
+}
+
+// TeamBudget defines model for TeamBudget.
+type TeamBudget struct {
+	// AccruedUsd Cost of the sandboxes accrued in the current period in USD
+	AccruedUsd float64 `json:"accruedUsd"`
+
+	// MonthlyLimitUsd Limit of the cost of the sandboxes in a month in USD
+	MonthlyLimitUsd float64 `json:"monthlyLimitUsd"`
+
+	// OverrideReason Reason of the override
+	OverrideReason *string `json:"overrideReason,omitempty"`
+
+	// OverrideUntil Time until the budget isn't enforced, set by the admins
+	OverrideUntil *time.Time `json:"overrideUntil,omitempty"`
+
+	// PauseSandboxes Whether the running sandboxes are paused when the limit is crossed
+	PauseSandboxes bool `json:"pauseSandboxes"`
+
+	// PeriodStart Start of the current period, the periods are UTC months
+	PeriodStart time.Time `json:"periodStart"`
+
+	// Status Status of the budget in the current period
+	Status TeamBudgetStatus `json:"status"`
+
+	// WarningPercent Percent of the limit the team is warned at
+	WarningPercent int32 `json:"warningPercent"`
+}
+
+// TeamBudgetOverride defines model for TeamBudgetOverride.
+type TeamBudgetOverride struct {
+	// Reason Reason of the override
+	Reason *string `json:"reason,omitempty"`
+
+	// Until Time until the budget isn't enforced
+	Until time.Time `json:"until"`
+}
+
+// TeamBudgetRequest defines model for TeamBudgetRequest.
+type TeamBudgetRequest struct {
+	// MonthlyLimitUsd Limit of the cost of the sandboxes in a month in USD
+	MonthlyLimitUsd float64 `json:"monthlyLimitUsd"`
+
+	// PauseSandboxes Whether the running sandboxes are paused when the limit is crossed
+	PauseSandboxes *bool `json:"pauseSandboxes,omitempty"`
+
+	// WarningPercent Percent of the limit the team is warned at
+	WarningPercent *int32 `json:"warningPercent,omitempty"`
+}
+
+// TeamBudgetStatus Status of the budget in the current period
+type TeamBudgetStatus string
+
+// TeamRegistryCredential defines model for TeamRegistryCredential.
+type TeamRegistryCredential struct {
+	// CreatedAt Time when the credential was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Registry Host of the registry, the Docker Hub hosts are stored as docker.io
+	Registry string `json:"registry"`
+
+	// UpdatedAt Time when the credential was last rotated
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Username Username of the credential
+	Username string `json:"username"`
+
+	// Version Version of the credential, it's increased every time the credential is rotated
+	Version int32 `json:"version"`
+}
+
+// TeamSecret defines model for TeamSecret.
+type TeamSecret struct {
+	// CreatedAt Time when the secret was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// LatestVersion Version of the last value of the secret
+	LatestVersion int32 `json:"latestVersion"`
+
+	// Name Name of the secret, it is the name of the env var in the sandbox
+	Name string `json:"name"`
+
+	// UpdatedAt Time when the value of the secret was last changed
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TeamSecretValue defines model for TeamSecretValue.
+type TeamSecretValue struct {
+	// Value Value of the secret, it can't be read back. Setting the different value creates the new version of the secret.
+	Value string `json:"value"`
+}
+
+// TeamSecretVersion defines model for TeamSecretVersion.
+type TeamSecretVersion struct {
+	// CreatedAt Time when the version was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Version Version of the secret
+	Version int32 `json:"version"`
+}
+
+// TeamState defines model for TeamState.
+type TeamState struct {
+	Sandboxes []ResourceState `json:"sandboxes"`
+	Secrets   []ResourceState `json:"secrets"`
+	Templates []ResourceState `json:"templates"`
+}
+
+// TeamUsageDay defines model for TeamUsageDay.
+type TeamUsageDay struct {
+	// BuildMinutes Total duration of the template builds finished in minutes
+	BuildMinutes float64 `json:"buildMinutes"`
+
+	// Builds Number of the template builds finished
+	Builds int64 `json:"builds"`
+
+	// Day Start of the UTC day
+	Day time.Time `json:"day"`
+
+	// ResumeLatencyP95Ms 95th percentile of the time it took to resume the sandboxes in milliseconds, not set if no sandbox was resumed
+	ResumeLatencyP95Ms *int64 `json:"resumeLatencyP95Ms,omitempty"`
+
+	// SandboxesResumed Number of the paused sandboxes resumed
+	SandboxesResumed int64 `json:"sandboxesResumed"`
+
+	// SandboxesStarted Number of the sandboxes started from the templates
+	SandboxesStarted int64 `json:"sandboxesStarted"`
+}
+
+// TeamUser defines model for TeamUser.
+type TeamUser struct {
+	// Email Email of the user
+	Email string `json:"email"`
+
+	// Id Identifier of the user
+	Id openapi_types.UUID `json:"id"`
+}
+
+// Template defines model for Template.
+type Template struct {
+	// Aliases Aliases of the template
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// AllowedRegions Regions the sandboxes of the template can run in, the sandboxes can run in any region if empty
+	AllowedRegions *Regions `json:"allowedRegions,omitempty"`
+
+	// BuildCount Number of times the template was built
+	BuildCount int32 `json:"buildCount"`
+
+	// BuildID Identifier of the last successful build for given template
+	BuildID string `json:"buildID"`
+
+	// CpuCount CPU cores for the sandbox
+	CpuCount CPUCount `json:"cpuCount"`
+
+	// CreatedAt Time when the template was created
+	CreatedAt time.Time `json:"createdAt"`
+	CreatedBy *TeamUser `json:"createdBy"`
+
+	// LastSpawnedAt Time when the template was last used
+	LastSpawnedAt time.Time `json:"lastSpawnedAt"`
+
+	// MemoryMB Memory for the sandbox in MB
+	MemoryMB MemoryMB `json:"memoryMB"`
+
+	// Public Whether the template is public or only accessible by the team
+	Public bool `json:"public"`
+
+	// SpawnCount Number of times the template was used
+	SpawnCount int64 `json:"spawnCount"`
+
+	// TemplateID Identifier of the template
+	TemplateID string `json:"templateID"`
+
+	// UpdatedAt Time when the template was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Variants Resource variants built with the build, returned when the build is requested
+	Variants *[]TemplateVariant `json:"variants,omitempty"`
+}
+
+// TemplateBuild defines model for TemplateBuild.
+type TemplateBuild struct {
+	// BuildID Identifier of the build
+	BuildID string `json:"buildID"`
+
+	// Logs Build logs
+	Logs []string `json:"logs"`
+
+	// Status Status of the template
+	Status TemplateBuildStatus `json:"status"`
+
+	// TemplateID Identifier of the template
+	TemplateID string `json:"templateID"`
+}
+
+// TemplateBuildStatus Status of the template
+type TemplateBuildStatus string
+
+// TemplateBuildRequest defines model for TemplateBuildRequest.
+type TemplateBuildRequest struct {
+	// Alias Alias of the template
+	Alias *string `json:"alias,omitempty"`
+
+	// CpuCount CPU cores for the sandbox
+	CpuCount *CPUCount `json:"cpuCount,omitempty"`
+
+	// Dockerfile Dockerfile for the template
+	Dockerfile string `json:"dockerfile"`
+
+	// Egress Outbound traffic allowed from the sandboxes, the preset sets the defaults that are extended by the allowed hosts. The hosts are resolved when the sandbox starts and DNS is always allowed
+	Egress *EgressPolicy `json:"egress,omitempty"`
+
+	// Hardening Hardening of the processes started by envd in the sandboxes of the template, the level sets the defaults that are extended by the other fields
+	Hardening *TemplateHardening `json:"hardening,omitempty"`
+
+	// Hooks Hooks executed in the sandbox, the sandbox logs contain their results
+	Hooks *TemplateHooks `json:"hooks,omitempty"`
+
+	// KernelArgs Additional kernel boot args in the key=value format, only the allowed args can be used
+	KernelArgs *[]string `json:"kernelArgs,omitempty"`
+
+	// KernelVersion Version of the kernel for the template, only the allowed versions can be used
+	KernelVersion *string `json:"kernelVersion,omitempty"`
+
+	// MemoryMB Memory for the sandbox in MB
+	MemoryMB *MemoryMB `json:"memoryMB,omitempty"`
+
+	// ReadinessProbe Probe that has to succeed before the sandbox is ready and receives traffic, at least one of the command or the HTTP port is required
+	ReadinessProbe *ReadinessProbe `json:"readinessProbe,omitempty"`
+
+	// RootfsBlockSize Block size of the rootfs diffs in bytes, a power of two between 4 KiB and 2 MiB. The larger blocks suit the templates reading large files, the smaller blocks the templates writing small files. Selected by the layout of the built rootfs if not set
+	RootfsBlockSize *int32 `json:"rootfsBlockSize,omitempty"`
+
+	// Secrets References of the team secrets in the NAME or NAME@VERSION format, the latest version is used if the version is not set. The secrets are set as the env vars in the sandbox and as the files in /run/e2b/secrets. The secrets can be referenced in the values of the env vars as ${secrets.NAME} or ${secrets.NAME@VERSION} too. The values are never persisted in the snapshot or the template, only the references are kept, so the secrets are attached again to the resumed sandbox and to the sandboxes created from the template. The API key needs the secrets:use scope.
+	Secrets *SandboxSecrets `json:"secrets,omitempty"`
+
+	// StartCmd Start command to execute in the template after the build
+	StartCmd *string `json:"startCmd,omitempty"`
+
+	// TeamID Identifier of the team
+	TeamID *string `json:"teamID,omitempty"`
+
+	// Variants Resource variants of the template built with the build. The image is pulled and provisioned once, each variant is snapshotted with its resources as its own build. The sandboxes select the variant by its name
+	Variants *[]TemplateVariantRequest `json:"variants,omitempty"`
+}
+
+// TemplateHardening Hardening of the processes started by envd in the sandboxes of the template, the level sets the defaults that are extended by the other fields
+type TemplateHardening struct {
+	// DeniedSyscalls Syscalls denied by the seccomp profile in addition to the syscalls of the level, the denied syscalls fail with EPERM
+	DeniedSyscalls *[]string `json:"deniedSyscalls,omitempty"`
+
+	// Level Defaults of the hardening, the processes aren't restricted (none), the kernel administration syscalls are denied (standard) or additionally the debugging and namespace syscalls are denied, the processes can't gain privileges and /boot, /etc and /usr are read-only (strict)
+	Level HardeningLevel `json:"level"`
+
+	// NoNewPrivileges The processes can't gain privileges (e.g. via sudo or setuid binaries), overrides the default of the level
+	NoNewPrivileges *bool `json:"noNewPrivileges,omitempty"`
+
+	// ReadOnlyPaths System paths mounted read-only in addition to the paths of the level
+	ReadOnlyPaths *[]string `json:"readOnlyPaths,omitempty"`
+}
+
+// TemplateHooks Hooks executed in the sandbox, the sandbox logs contain their results
+type TemplateHooks struct {
+	OnPause  *LifecycleHook `json:"onPause,omitempty"`
+	OnResume *LifecycleHook `json:"onResume,omitempty"`
+}
+
+// TemplateRebuildSchedule defines model for TemplateRebuildSchedule.
+type TemplateRebuildSchedule struct {
+	// IntervalHours Hours between the rebuilds of the template
+	IntervalHours int32 `json:"intervalHours"`
+
+	// LastBuildID Identifier of the last rebuild
+	LastBuildID *string `json:"lastBuildID,omitempty"`
+
+	// LastBuildStatus Status of the last rebuild, the template is served from the rebuild only once it succeeds
+	LastBuildStatus *TemplateRebuildScheduleLastBuildStatus `json:"lastBuildStatus,omitempty"`
+
+	// NextRunAt Time of the next rebuild
+	NextRunAt time.Time `json:"nextRunAt"`
+
+	// TemplateID Identifier of the template
+	TemplateID string `json:"templateID"`
+
+	// UpgradePackages Whether the packages of the image are upgraded when rebuilding
+	UpgradePackages bool `json:"upgradePackages"`
+
+	// WebhookSecret Secret the results posted to the webhook are signed with, the HMAC-SHA256 of the body is in the X-E2B-Signature header. A new secret is generated every time the schedule is set
+	WebhookSecret *string `json:"webhookSecret,omitempty"`
+
+	// WebhookUrl URL the result of every rebuild is posted to
+	WebhookUrl *string `json:"webhookUrl,omitempty"`
+}
+
+// TemplateRebuildScheduleLastBuildStatus Status of the last rebuild, the template is served from the rebuild only once it succeeds
+type TemplateRebuildScheduleLastBuildStatus string
+
+// TemplateRebuildScheduleRequest defines model for TemplateRebuildScheduleRequest.
+type TemplateRebuildScheduleRequest struct {
+	// IntervalHours Hours between the rebuilds of the template
+	IntervalHours *int32 `json:"intervalHours,omitempty"`
+
+	// UpgradePackages Whether the packages of the image are upgraded when rebuilding, so the template picks up the security updates
+	UpgradePackages *bool `json:"upgradePackages,omitempty"`
+
+	// WebhookUrl URL the result of every rebuild is posted to
+	WebhookUrl *string `json:"webhookUrl,omitempty"`
+}
+
+// TemplateStorageUsage defines model for TemplateStorageUsage.
+type TemplateStorageUsage struct {
+	// SnapshotBytes Size of the snapshots of the paused sandboxes started from the template in bytes
+	SnapshotBytes int64 `json:"snapshotBytes"`
+
+	// TemplateBytes Size of the stored builds of the template in bytes
+	TemplateBytes int64 `json:"templateBytes"`
+
+	// TemplateID Identifier of the template
+	TemplateID string `json:"templateID"`
+
+	// UpdatedAt Time when the storage was last measured
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TemplateUpdateRequest defines model for TemplateUpdateRequest.
+type TemplateUpdateRequest struct {
+	// AllowedRegions Regions the sandboxes of the template can run in, the sandboxes can run in any region if empty
+	AllowedRegions *Regions `json:"allowedRegions,omitempty"`
+
+	// Public Whether the template is public or only accessible by the team
+	Public *bool `json:"public,omitempty"`
+}
+
+// TemplateVariant defines model for TemplateVariant.
+type TemplateVariant struct {
+	// BuildID Identifier of the build of the variant, the variant is built with the build of the template
+	BuildID string `json:"buildID"`
+
+	// CpuCount CPU cores for the sandbox
+	CpuCount CPUCount `json:"cpuCount"`
+
+	// MemoryMB Memory for the sandbox in MB
+	MemoryMB MemoryMB `json:"memoryMB"`
+
+	// Name Name of the resource variant of the template
+	Name TemplateVariantName `json:"name"`
+}
+
+// TemplateVariantName Name of the resource variant of the template
+type TemplateVariantName = string
+
+// TemplateVariantRequest defines model for TemplateVariantRequest.
+type TemplateVariantRequest struct {
+	// CpuCount CPU cores for the sandbox
+	CpuCount CPUCount `json:"cpuCount"`
+
+	// MemoryMB Memory for the sandbox in MB
+	MemoryMB MemoryMB `json:"memoryMB"`
+
+	// Name Name of the resource variant of the template
+	Name TemplateVariantName `json:"name"`
+}
+
+// ArtifactName defines model for artifactName.
+type ArtifactName = string
+
+// BuildID defines model for buildID.
+type BuildID = string
+
+// CreatedAfter defines model for createdAfter.
+type CreatedAfter = time.Time
+
+// IdempotencyKey defines model for idempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for ifMatch.
+type IfMatch = string
+
+// LabelSelector defines model for labelSelector.
+type LabelSelector = []string
+
+// NodeID defines model for nodeID.
+type NodeID = string
+
+// PaginationLimit defines model for paginationLimit.
+type PaginationLimit = int32
+
+// PaginationNextToken defines model for paginationNextToken.
+type PaginationNextToken = string
+
+// Registry defines model for registry.
+type Registry = string
+
+// SandboxID defines model for sandboxID.
+type SandboxID = string
+
+// SecretName defines model for secretName.
+type SecretName = string
+
+// TeamID defines model for teamID.
+type TeamID = string
+
+// TemplateID defines model for templateID.
+type TemplateID = string
+
+// N202 defines model for 202.
+type N202 = SandboxWarming
+
+// N400 defines model for 400.
+type N400 = Error
+
+// N401 defines model for 401.
+type N401 = Error
+
+// N403 defines model for 403.
+type N403 = Error
+
+// N404 defines model for 404.
+type N404 = Error
+
+// N409 defines model for 409.
+type N409 = Error
+
+// N412 defines model for 412.
+type N412 = Error
+
+// N429 defines model for 429.
+type N429 = Error
+
+// N500 defines model for 500.
+type N500 = Error
+
+// N503 defines model for 503.
+type N503 = Error
+
+// GetArtifactsParams defines parameters for GetArtifacts.
+type GetArtifactsParams struct {
+	// SandboxID List only the artifacts of the sandbox
+	SandboxID *string `form:"sandboxID,omitempty" json:"sandboxID,omitempty"`
+
+	// Query Labels the artifacts must have (e.g. "job=abc&kind=report"). Query and each key and values must be URL encoded.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+}
+
+// DeleteBudgetParams defines parameters for DeleteBudget.
+type DeleteBudgetParams struct {
+	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
+}
+
+// GetBudgetParams defines parameters for GetBudget.
+type GetBudgetParams struct {
+	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
+}
+
+// PutBudgetParams defines parameters for PutBudget.
+type PutBudgetParams struct {
+	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
+}
+
+// GetBuildLogsParams defines parameters for GetBuildLogs.
+type GetBuildLogsParams struct {
+	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
+
+	// Query Text the log lines contain, the case is ignored
+	Query string `form:"query" json:"query"`
+
+	// TemplateID Search only the logs of the template
+	TemplateID *string `form:"templateID,omitempty" json:"templateID,omitempty"`
+
+	// CreatedAfter Return only the items created after the time
+	CreatedAfter *CreatedAfter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// Limit Maximum number of log lines that should be returned
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeletePinnedBuildsBuildIDParams defines parameters for DeletePinnedBuildsBuildID.
+type DeletePinnedBuildsBuildIDParams struct {
+	// NodeID Identifier of the node the build is unpinned from, the cluster-wide pin is removed if not set
+	NodeID *string `form:"nodeID,omitempty" json:"nodeID,omitempty"`
+}
+
+// DeleteSandboxesParams defines parameters for DeleteSandboxes.
+type DeleteSandboxesParams struct {
+	// Label Label selectors the sandboxes have to match, at least one selector is required
+	Label []string `form:"label" json:"label"`
+}
+
+// GetSandboxesParams defines parameters for GetSandboxes.
+type GetSandboxesParams struct {
+	// Query A query used to filter the sandboxes (e.g. "user=abc&app=prod"). Query and each key and values must be URL encoded.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// State Filter the sandboxes by the state, only the running sandboxes are returned by default
+	State *[]SandboxState `form:"state,omitempty" json:"state,omitempty"`
+
+	// TemplateID Filter the sandboxes by the template ID or alias
+	TemplateID *string `form:"templateID,omitempty" json:"templateID,omitempty"`
+
+	// Label Label selectors the sandboxes have to match, all selectors must match. Supported selectors are "key=value", "key!=value", "key" (the label exists) and "!key" (the label doesn't exist).
+	Label *LabelSelector `form:"label,omitempty" json:"label,omitempty"`
+
+	// CreatedAfter Return only the items created after the time
+	CreatedAfter *CreatedAfter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// SortBy Field to sort the sandboxes by
+	SortBy *GetSandboxesParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// Order Order of the sorted items
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Maximum number of items to return, if not set all items are returned
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// NextToken Cursor to start the list from, it is returned in the X-Next-Token header of the previous page
+	NextToken *PaginationNextToken `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
+// GetSandboxesParamsSortBy defines parameters for GetSandboxes.
+type GetSandboxesParamsSortBy string
+
+// PostSandboxesParams defines parameters for PostSandboxes.
+type PostSandboxesParams struct {
+	// IdempotencyKey Unique key of the request, the retried request with the same key returns the response of the first request instead of being processed again. The key is kept for 24 hours, the replayed response has the Idempotent-Replayed header.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PatchSandboxesSandboxIDParams defines parameters for PatchSandboxesSandboxID.
+type PatchSandboxesSandboxIDParams struct {
+	// IfMatch ETag of the resource returned by the previous request, the request fails with 412 if the resource was changed since. The "*" value matches any existing resource.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetSandboxesSandboxIDChangesParams defines parameters for GetSandboxesSandboxIDChanges.
+type GetSandboxesSandboxIDChangesParams struct {
+	// From ID of the checkpoint from which the changes are listed, defaults to the sandbox start
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To ID of the checkpoint up to which the changes are listed, defaults to the current state
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetSandboxesSandboxIDConsoleParams defines parameters for GetSandboxesSandboxIDConsole.
+type GetSandboxesSandboxIDConsoleParams struct {
+	// Writable Allow writing to the console, requires the admin token
+	Writable *bool `form:"writable,omitempty" json:"writable,omitempty"`
+}
+
+// GetSandboxesSandboxIDLogsParams defines parameters for GetSandboxesSandboxIDLogs.
+type GetSandboxesSandboxIDLogsParams struct {
+	// Start Starting timestamp of the logs that should be returned in milliseconds, deprecated in favor of since
+	Start *int64 `form:"start,omitempty" json:"start,omitempty"`
+
+	// Since Only the logs after the time are returned, the logs are kept for the retention period of the sandbox logs after the sandbox is killed
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Level Only the logs with the level or a more severe one are returned
+	Level *SandboxLogLevel `form:"level,omitempty" json:"level,omitempty"`
+
+	// Limit Maximum number of logs that should be returned
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostSandboxesSandboxIDRefreshesJSONBody defines parameters for PostSandboxesSandboxIDRefreshes.
+type PostSandboxesSandboxIDRefreshesJSONBody struct {
+	// Duration Duration for which the sandbox should be kept alive in seconds
+	Duration *int `json:"duration,omitempty"`
+}
+
+// PostSandboxesSandboxIDTimeoutJSONBody defines parameters for PostSandboxesSandboxIDTimeout.
+type PostSandboxesSandboxIDTimeoutJSONBody struct {
+	// Timeout Timeout in seconds from the current time after which the sandbox should expire
+	Timeout int32 `json:"timeout"`
+}
+
+// DeleteSecretsSecretNameParams defines parameters for DeleteSecretsSecretName.
+type DeleteSecretsSecretNameParams struct {
+	// IfMatch ETag of the resource returned by the previous request, the request fails with 412 if the resource was changed since. The "*" value matches any existing resource.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutSecretsSecretNameParams defines parameters for PutSecretsSecretName.
+type PutSecretsSecretNameParams struct {
+	// IfMatch ETag of the resource returned by the previous request, the request fails with 412 if the resource was changed since. The "*" value matches any existing resource.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetTemplatesParams defines parameters for GetTemplates.
+type GetTemplatesParams struct {
+	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
+
+	// Public Filter the templates by the visibility
+	Public *bool `form:"public,omitempty" json:"public,omitempty"`
+
+	// CreatedAfter Return only the items created after the time
+	CreatedAfter *CreatedAfter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// SortBy Field to sort the templates by
+	SortBy *GetTemplatesParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// Order Order of the sorted items
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Maximum number of items to return, if not set all items are returned
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// NextToken Cursor to start the list from, it is returned in the X-Next-Token header of the previous page
+	NextToken *PaginationNextToken `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
+// GetTemplatesParamsSortBy defines parameters for GetTemplates.
+type GetTemplatesParamsSortBy string
+
+// PostTemplatesParams defines parameters for PostTemplates.
+type PostTemplatesParams struct {
+	// IdempotencyKey Unique key of the request, the retried request with the same key returns the response of the first request instead of being processed again. The key is kept for 24 hours, the replayed response has the Idempotent-Replayed header.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteTemplatesTemplateIDParams defines parameters for DeleteTemplatesTemplateID.
+type DeleteTemplatesTemplateIDParams struct {
+	// IfMatch ETag of the resource returned by the previous request, the request fails with 412 if the resource was changed since. The "*" value matches any existing resource.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchTemplatesTemplateIDParams defines parameters for PatchTemplatesTemplateID.
+type PatchTemplatesTemplateIDParams struct {
+	// IfMatch ETag of the resource returned by the previous request, the request fails with 412 if the resource was changed since. The "*" value matches any existing resource.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostTemplatesTemplateIDParams defines parameters for PostTemplatesTemplateID.
+type PostTemplatesTemplateIDParams struct {
+	// IdempotencyKey Unique key of the request, the retried request with the same key returns the response of the first request instead of being processed again. The key is kept for 24 hours, the replayed response has the Idempotent-Replayed header.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostTemplatesTemplateIDBuildsBuildIDParams defines parameters for PostTemplatesTemplateIDBuildsBuildID.
+type PostTemplatesTemplateIDBuildsBuildIDParams struct {
+	// IdempotencyKey Unique key of the request, the retried request with the same key returns the response of the first request instead of being processed again. The key is kept for 24 hours, the replayed response has the Idempotent-Replayed header.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTemplatesTemplateIDBuildsBuildIDStatusParams defines parameters for GetTemplatesTemplateIDBuildsBuildIDStatus.
+type GetTemplatesTemplateIDBuildsBuildIDStatusParams struct {
+	// LogsOffset Index of the starting build log that should be returned with the template
+	LogsOffset *int32 `form:"logsOffset,omitempty" json:"logsOffset,omitempty"`
+}
+
+// GetUsageDailyParams defines parameters for GetUsageDaily.
+type GetUsageDailyParams struct {
+	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
+
+	// Start Return the usage of the days from the time, the last 30 days if not set
+	Start *time.Time `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Return the usage of the days until the time, now if not set
+	End *time.Time `form:"end,omitempty" json:"end,omitempty"`
+}
+
+// GetUsageStorageParams defines parameters for GetUsageStorage.
+type GetUsageStorageParams struct {
+	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
+}
+
+// PutBudgetJSONRequestBody defines body for PutBudget for application/json ContentType.
+type PutBudgetJSONRequestBody = TeamBudgetRequest
+
+// PostKernelsJSONRequestBody defines body for PostKernels for application/json ContentType.
+type PostKernelsJSONRequestBody = NewKernel
+
+// PostNodesNodeIDJSONRequestBody defines body for PostNodesNodeID for application/json ContentType.
+type PostNodesNodeIDJSONRequestBody = NodeStatusChange
+
+// PostPinnedBuildsJSONRequestBody defines body for PostPinnedBuilds for application/json ContentType.
+type PostPinnedBuildsJSONRequestBody = NewPinnedBuild
+
+// PutRegistryCredentialsRegistryJSONRequestBody defines body for PutRegistryCredentialsRegistry for application/json ContentType.
+type PutRegistryCredentialsRegistryJSONRequestBody = RegistryCredentialValue
+
+// PostSandboxesJSONRequestBody defines body for PostSandboxes for application/json ContentType.
+type PostSandboxesJSONRequestBody = NewSandbox
+
+// PatchSandboxesSandboxIDJSONRequestBody defines body for PatchSandboxesSandboxID for application/json ContentType.
+type PatchSandboxesSandboxIDJSONRequestBody = SandboxUpdate
+
+// PostSandboxesSandboxIDArtifactsJSONRequestBody defines body for PostSandboxesSandboxIDArtifacts for application/json ContentType.
+type PostSandboxesSandboxIDArtifactsJSONRequestBody = NewSandboxArtifact
+
+// PostSandboxesSandboxIDRefreshesJSONRequestBody defines body for PostSandboxesSandboxIDRefreshes for application/json ContentType.
+type PostSandboxesSandboxIDRefreshesJSONRequestBody PostSandboxesSandboxIDRefreshesJSONBody
+
+// PostSandboxesSandboxIDResumeJSONRequestBody defines body for PostSandboxesSandboxIDResume for application/json ContentType.
+type PostSandboxesSandboxIDResumeJSONRequestBody = ResumedSandbox
+
+// PostSandboxesSandboxIDTimeoutJSONRequestBody defines body for PostSandboxesSandboxIDTimeout for application/json ContentType.
+type PostSandboxesSandboxIDTimeoutJSONRequestBody PostSandboxesSandboxIDTimeoutJSONBody
+
+// PostSecretsJSONRequestBody defines body for PostSecrets for application/json ContentType.
+type PostSecretsJSONRequestBody = NewTeamSecret
+
+// PutSecretsSecretNameJSONRequestBody defines body for PutSecretsSecretName for application/json ContentType.
+type PutSecretsSecretNameJSONRequestBody = TeamSecretValue
+
+// PutTeamsTeamIDBudgetOverrideJSONRequestBody defines body for PutTeamsTeamIDBudgetOverride for application/json ContentType.
+type PutTeamsTeamIDBudgetOverrideJSONRequestBody = TeamBudgetOverride
+
+// PostTemplatesJSONRequestBody defines body for PostTemplates for application/json ContentType.
+type PostTemplatesJSONRequestBody = TemplateBuildRequest
+
+// PatchTemplatesTemplateIDJSONRequestBody defines body for PatchTemplatesTemplateID for application/json ContentType.
+type PatchTemplatesTemplateIDJSONRequestBody = TemplateUpdateRequest
+
+// PostTemplatesTemplateIDJSONRequestBody defines body for PostTemplatesTemplateID for application/json ContentType.
+type PostTemplatesTemplateIDJSONRequestBody = TemplateBuildRequest
+
+// PostTemplatesTemplateIDBuildsBuildIDLogsExportJSONRequestBody defines body for PostTemplatesTemplateIDBuildsBuildIDLogsExport for application/json ContentType.
+type PostTemplatesTemplateIDBuildsBuildIDLogsExportJSONRequestBody = BuildLogsExportRequest
+
+// PutTemplatesTemplateIDRebuildScheduleJSONRequestBody defines body for PutTemplatesTemplateIDRebuildSchedule for application/json ContentType.
+type PutTemplatesTemplateIDRebuildScheduleJSONRequestBody = TemplateRebuildScheduleRequest
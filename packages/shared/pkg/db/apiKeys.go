@@ -11,42 +11,45 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
 )
 
-func (db *DB) GetTeamAuth(ctx context.Context, apiKey string) (*models.Team, *models.Tier, error) {
-	result, err := db.
+// GetTeamAuth returns the team of the API key with its tier and the scopes of the API key, the scopes are nil if the key isn't limited.
+func (db *DB) GetTeamAuth(ctx context.Context, apiKey string) (*models.Team, *models.Tier, []string, error) {
+	key, err := db.
 		Client.
 		TeamAPIKey.
 		Query().
-		WithTeam().
 		Where(teamapikey.APIKey(apiKey)).
-		QueryTeam().
-		WithTeamTier().
+		WithTeam(func(query *models.TeamQuery) {
+			query.WithTeamTier()
+		}).
 		Only(ctx)
 
 	if err != nil {
 		errMsg := fmt.Errorf("failed to get team from API key: %w", err)
 
-		return nil, nil, errMsg
+		return nil, nil, nil, errMsg
 	}
+
+	result := key.Edges.Team
 	//
 	if result.IsBanned {
 		errMsg := fmt.Errorf("team is banned")
 
-		return nil, nil, errMsg
+		return nil, nil, nil, errMsg
 	}
 	//
 	if result.IsBlocked {
 		if result.BlockedReason == nil {
 			errMsg := fmt.Errorf("team was blocked")
 
-			return nil, nil, errMsg
+			return nil, nil, nil, errMsg
 		}
 
 		errMsg := fmt.Errorf("team was blocked - %s", *result.BlockedReason)
 
-		return nil, nil, errMsg
+		return nil, nil, nil, errMsg
 	}
 	//
-	return result, result.Edges.TeamTier, nil
+	return result, result.Edges.TeamTier, key.Scopes, nil
 }
 
 func (db *DB) GetUserID(ctx context.Context, token string) (*uuid.UUID, error) {
@@ -1,8 +1,11 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -13,16 +16,43 @@ import (
 
 type DB struct {
 	Client *models.Client
+
+	drv     *sql.Driver
+	replica *replica
 }
 
-var databaseURL = os.Getenv("POSTGRES_CONNECTION_STRING")
+var (
+	databaseURL            = os.Getenv("POSTGRES_CONNECTION_STRING")
+	readReplicaDatabaseURL = strings.TrimSpace(os.Getenv("POSTGRES_READ_REPLICA_CONNECTION_STRING"))
+)
 
 func NewClient() (*DB, error) {
 	if databaseURL == "" {
 		return nil, fmt.Errorf("database URL is empty")
 	}
 
-	drv, err := sql.Open(dialect.Postgres, databaseURL)
+	drv, err := open(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := models.NewClient(models.Driver(drv))
+
+	var r *replica
+	if readReplicaDatabaseURL != "" {
+		replicaDrv, err := open(readReplicaDatabaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open read replica: %w", err)
+		}
+
+		r = newReplica(replicaDrv)
+	}
+
+	return &DB{Client: client, drv: drv, replica: r}, nil
+}
+
+func open(url string) (*sql.Driver, error) {
+	drv, err := sql.Open(dialect.Postgres, url)
 	if err != nil {
 		return nil, err
 	}
@@ -31,11 +61,54 @@ func NewClient() (*DB, error) {
 	db := drv.DB()
 	db.SetMaxOpenConns(100)
 
-	client := models.NewClient(models.Driver(drv))
+	return drv, nil
+}
 
-	return &DB{Client: client}, nil
+// ReadOnly returns the database that routes the queries to the read replica if it is configured and not lagging behind,
+// otherwise the primary is used. Use it only for the queries that can tolerate slightly stale data.
+func (db *DB) ReadOnly() *DB {
+	if db.replica == nil || !db.replica.healthy() {
+		return db
+	}
+
+	return &DB{Client: db.replica.client, drv: db.replica.drv}
+}
+
+// Ping checks the connection to the database.
+func (db *DB) Ping(ctx context.Context) error {
+	return db.drv.DB().PingContext(ctx)
+}
+
+// HasReplica returns true if the read replica is configured.
+func (db *DB) HasReplica() bool {
+	return db.replica != nil
+}
+
+// ReplicaLag returns the lag of the read replica behind the primary, zero if no read replica is configured.
+func (db *DB) ReplicaLag(ctx context.Context) (time.Duration, error) {
+	if db.replica == nil {
+		return 0, nil
+	}
+
+	return db.replica.lag(ctx)
+}
+
+// ReplicaMaxLag returns the lag of the read replica above which the queries are routed to the primary.
+func (db *DB) ReplicaMaxLag() time.Duration {
+	if db.replica == nil {
+		return 0
+	}
+
+	return db.replica.maxLag
 }
 
 func (db *DB) Close() error {
+	if db.replica != nil {
+		err := db.replica.close()
+		if err != nil {
+			return err
+		}
+	}
+
 	return db.Client.Close()
 }
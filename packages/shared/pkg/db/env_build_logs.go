@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+)
+
+// buildLogsBatchSize is the maximum number of the log lines inserted in one statement.
+const buildLogsBatchSize = 1000
+
+// BuildLogLine is the line of the build log with the time it was logged.
+type BuildLogLine struct {
+	Message   string
+	Timestamp time.Time
+}
+
+// SaveEnvBuildLogs stores the log of the finished build, the previously stored lines of the build are replaced.
+func (db *DB) SaveEnvBuildLogs(ctx context.Context, teamID uuid.UUID, envID string, buildID uuid.UUID, lines []BuildLogLine) error {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting a transaction: %w", err)
+	}
+
+	_, err = tx.
+		EnvBuildLog.
+		Delete().
+		Where(envbuildlog.BuildID(buildID)).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete logs of build '%s': %w", buildID, err))
+	}
+
+	for start := 0; start < len(lines); start += buildLogsBatchSize {
+		end := min(start+buildLogsBatchSize, len(lines))
+
+		builders := make([]*models.EnvBuildLogCreate, 0, end-start)
+		for i, line := range lines[start:end] {
+			builders = append(builders, tx.
+				EnvBuildLog.
+				Create().
+				SetCreatedAt(line.Timestamp).
+				SetTeamID(teamID).
+				SetEnvID(envID).
+				SetBuildID(buildID).
+				SetLine(int32(start+i)).
+				SetMessage(line.Message),
+			)
+		}
+
+		err = tx.EnvBuildLog.CreateBulk(builders...).Exec(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("failed to save logs of build '%s': %w", buildID, err))
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetEnvBuildLogs returns the stored log lines of the build starting with the line at the offset.
+func (db *DB) GetEnvBuildLogs(ctx context.Context, buildID uuid.UUID, offset int32) ([]*models.EnvBuildLog, error) {
+	logs, err := db.
+		Client.
+		EnvBuildLog.
+		Query().
+		Where(envbuildlog.BuildID(buildID), envbuildlog.LineGTE(offset)).
+		Order(models.Asc(envbuildlog.FieldLine)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs of build '%s': %w", buildID, err)
+	}
+
+	return logs, nil
+}
+
+type SearchEnvBuildLogsOptions struct {
+	// Query is the text the log lines contain, the case is ignored.
+	Query        string
+	EnvID        *string
+	CreatedAfter *time.Time
+	Limit        int
+}
+
+// SearchEnvBuildLogs returns the stored build log lines of the team's templates containing the text, the newest lines first.
+func (db *DB) SearchEnvBuildLogs(ctx context.Context, teamID uuid.UUID, opts SearchEnvBuildLogsOptions) ([]*models.EnvBuildLog, error) {
+	query := db.
+		Client.
+		EnvBuildLog.
+		Query().
+		Where(
+			envbuildlog.TeamID(teamID),
+			envbuildlog.MessageContainsFold(opts.Query),
+		)
+
+	if opts.EnvID != nil {
+		query = query.Where(envbuildlog.EnvID(*opts.EnvID))
+	}
+
+	if opts.CreatedAfter != nil {
+		query = query.Where(envbuildlog.CreatedAtGT(*opts.CreatedAfter))
+	}
+
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+
+	logs, err := query.
+		Order(models.Desc(envbuildlog.FieldCreatedAt, envbuildlog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search build logs: %w", err)
+	}
+
+	return logs, nil
+}
+
+// DeleteExpiredEnvBuildLogs deletes the build log lines older than the build log retention of their team.
+func (db *DB) DeleteExpiredEnvBuildLogs(ctx context.Context) (int, error) {
+	retentions, err := db.
+		Client.
+		Team.
+		Query().
+		Unique(true).
+		Select(team.FieldBuildLogRetentionDays).
+		Ints(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get build log retentions: %w", err)
+	}
+
+	now := time.Now()
+
+	var deleted int
+	for _, days := range retentions {
+		count, err := db.
+			Client.
+			EnvBuildLog.
+			Delete().
+			Where(
+				envbuildlog.CreatedAtLT(now.AddDate(0, 0, -days)),
+				func(s *sql.Selector) {
+					teams := sql.Select(team.FieldID).From(sql.Table(team.Table)).Where(sql.EQ(team.FieldBuildLogRetentionDays, days))
+
+					s.Where(sql.In(s.C(envbuildlog.FieldTeamID), teams))
+				},
+			).
+			Exec(ctx)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete build logs older than %d days: %w", days, err)
+		}
+
+		deleted += count
+	}
+
+	return deleted, nil
+}
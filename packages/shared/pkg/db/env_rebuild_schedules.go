@@ -0,0 +1,269 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+)
+
+var (
+	ErrEnvRebuildScheduleNotFound = errors.New("template rebuild schedule not found")
+	ErrEnvBuildInProgress         = errors.New("template build is in progress")
+)
+
+// SetEnvRebuildSchedule creates the rebuild schedule of the env or replaces the existing one, the first rebuild is scheduled one interval from now.
+// The webhook secret is replaced with the schedule.
+func (db *DB) SetEnvRebuildSchedule(
+	ctx context.Context,
+	envID string,
+	intervalHours int32,
+	upgradePackages bool,
+	webhookURL *string,
+	webhookSecret *string,
+) (*models.EnvRebuildSchedule, error) {
+	nextRunAt := time.Now().Add(time.Duration(intervalHours) * time.Hour)
+
+	scheduleID, err := db.
+		Client.
+		EnvRebuildSchedule.
+		Create().
+		SetEnvID(envID).
+		SetIntervalHours(intervalHours).
+		SetNextRunAt(nextRunAt).
+		SetUpgradePackages(upgradePackages).
+		SetNillableWebhookURL(webhookURL).
+		SetNillableWebhookSecret(webhookSecret).
+		OnConflictColumns(envrebuildschedule.FieldEnvID).
+		Update(func(u *models.EnvRebuildScheduleUpsert) {
+			u.UpdateIntervalHours()
+			u.UpdateNextRunAt()
+			u.UpdateUpgradePackages()
+			u.UpdateWebhookURL()
+			u.UpdateWebhookSecret()
+			u.UpdateUpdatedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set rebuild schedule of env '%s': %w", envID, err)
+	}
+
+	schedule, err := db.
+		Client.
+		EnvRebuildSchedule.
+		Get(ctx, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rebuild schedule of env '%s': %w", envID, err)
+	}
+
+	return schedule, nil
+}
+
+// GetEnvRebuildSchedule returns the rebuild schedule of the env, the error is ErrEnvRebuildScheduleNotFound if the env isn't rebuilt automatically.
+func (db *DB) GetEnvRebuildSchedule(ctx context.Context, envID string) (*models.EnvRebuildSchedule, error) {
+	schedule, err := db.
+		Client.
+		EnvRebuildSchedule.
+		Query().
+		Where(envrebuildschedule.EnvID(envID)).
+		Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, fmt.Errorf("env '%s': %w", envID, ErrEnvRebuildScheduleNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to get rebuild schedule of env '%s': %w", envID, err)
+	}
+
+	return schedule, nil
+}
+
+// DeleteEnvRebuildSchedule stops the automatic rebuilds of the env, the running rebuild isn't canceled.
+// The error is ErrEnvRebuildScheduleNotFound if the env isn't rebuilt automatically.
+func (db *DB) DeleteEnvRebuildSchedule(ctx context.Context, envID string) error {
+	deleted, err := db.
+		Client.
+		EnvRebuildSchedule.
+		Delete().
+		Where(envrebuildschedule.EnvID(envID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete rebuild schedule of env '%s': %w", envID, err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("env '%s': %w", envID, ErrEnvRebuildScheduleNotFound)
+	}
+
+	return nil
+}
+
+// ClaimDueEnvRebuildSchedules returns the schedules whose rebuild is due and moves their next run by one interval.
+// The schedule is claimed only if its next run wasn't moved in between, so every rebuild is claimed by one API instance only.
+func (db *DB) ClaimDueEnvRebuildSchedules(ctx context.Context, limit int) ([]*models.EnvRebuildSchedule, error) {
+	now := time.Now()
+
+	due, err := db.
+		Client.
+		EnvRebuildSchedule.
+		Query().
+		Where(envrebuildschedule.NextRunAtLTE(now)).
+		Order(models.Asc(envrebuildschedule.FieldNextRunAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due rebuild schedules: %w", err)
+	}
+
+	claimed := make([]*models.EnvRebuildSchedule, 0, len(due))
+	for _, schedule := range due {
+		updated, err := db.
+			Client.
+			EnvRebuildSchedule.
+			Update().
+			Where(
+				envrebuildschedule.ID(schedule.ID),
+				envrebuildschedule.NextRunAt(schedule.NextRunAt),
+			).
+			SetNextRunAt(now.Add(time.Duration(schedule.IntervalHours) * time.Hour)).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim rebuild schedule of env '%s': %w", schedule.EnvID, err)
+		}
+
+		if updated == 0 {
+			continue
+		}
+
+		claimed = append(claimed, schedule)
+	}
+
+	return claimed, nil
+}
+
+// CreateEnvRebuild creates the build of the env from the configuration and the image of its last successful build,
+// the build is created in the building status, so it isn't marked as failed by the next build requested by the user.
+// The error is models.NotFoundError if the env has no successful build and ErrEnvBuildInProgress if the env is being built,
+// so the rebuild of the older image doesn't replace the build in progress.
+func (db *DB) CreateEnvRebuild(ctx context.Context, envID string) (*models.EnvBuild, error) {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting a transaction: %w", err)
+	}
+
+	building, err := tx.
+		EnvBuild.
+		Query().
+		Where(envbuild.EnvID(envID), envbuild.StatusEQ(envbuild.StatusBuilding)).
+		Exist(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to check builds of env '%s': %w", envID, err))
+	}
+
+	if building {
+		return nil, rollback(tx, fmt.Errorf("env '%s': %w", envID, ErrEnvBuildInProgress))
+	}
+
+	source, err := tx.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.EnvID(envID),
+			envbuild.StatusEQ(envbuild.StatusUploaded),
+			envbuild.VariantOfIsNil(),
+			envbuild.HasEnvWith(env.Not(env.HasSnapshots())),
+		).
+		Order(models.Desc(envbuild.FieldFinishedAt)).
+		First(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, rollback(tx, err)
+		}
+
+		return nil, rollback(tx, fmt.Errorf("failed to get last build of env '%s': %w", envID, err))
+	}
+
+	// The rebuilds are built from the image pushed for the first build of the chain, the rebuilds don't push their own images
+	sourceBuildID := source.ID
+	if source.SourceBuildID != nil {
+		sourceBuildID = *source.SourceBuildID
+	}
+
+	err = tx.
+		Env.
+		UpdateOneID(envID).
+		AddBuildCount(1).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to update env '%s': %w", envID, err))
+	}
+
+	build, err := tx.
+		EnvBuild.
+		Create().
+		SetID(uuid.New()).
+		SetEnvID(envID).
+		SetStatus(envbuild.StatusBuilding).
+		SetHeartbeatAt(time.Now()).
+		SetRAMMB(source.RAMMB).
+		SetVcpu(source.Vcpu).
+		SetKernelVersion(source.KernelVersion).
+		SetKernelArgs(source.KernelArgs).
+		SetNillableRootfsBlockSize(source.RootfsBlockSize).
+		SetFirecrackerVersion(source.FirecrackerVersion).
+		SetFreeDiskSizeMB(source.FreeDiskSizeMB).
+		SetNillableStartCmd(source.StartCmd).
+		SetReadinessProbe(source.ReadinessProbe).
+		SetHooks(source.Hooks).
+		SetHardening(source.Hardening).
+		SetEgress(source.Egress).
+		SetSecrets(source.Secrets).
+		SetNillableDockerfile(source.Dockerfile).
+		SetSourceBuildID(sourceBuildID).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create rebuild of env '%s': %w", envID, err))
+	}
+
+	err = tx.
+		EnvRebuildSchedule.
+		Update().
+		Where(envrebuildschedule.EnvID(envID)).
+		SetLastBuildID(build.ID).
+		SetLastBuildStatus(envrebuildschedule.LastBuildStatusBuilding).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to record rebuild of env '%s': %w", envID, err))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return build, nil
+}
+
+// SetEnvRebuildStatus records the result of the rebuild with the rebuild schedule of the env, if the env is still rebuilt automatically.
+func (db *DB) SetEnvRebuildStatus(ctx context.Context, envID string, buildID uuid.UUID, status envrebuildschedule.LastBuildStatus) error {
+	err := db.
+		Client.
+		EnvRebuildSchedule.
+		Update().
+		Where(envrebuildschedule.EnvID(envID), envrebuildschedule.LastBuildID(buildID)).
+		SetLastBuildStatus(status).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set rebuild status %s for '%s': %w", status, buildID, err)
+	}
+
+	return nil
+}
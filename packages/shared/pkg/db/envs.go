@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 )
 
 type TemplateCreator struct {
@@ -33,10 +35,39 @@ type Template struct {
 	SpawnCount    int64
 	BuildCount    int32
 	CreatedBy     *TemplateCreator
+	// AllowedRegions are the regions the sandboxes of the template can run in, any region if empty.
+	AllowedRegions []string
+}
+
+// ListEnvsOptions filters, sorts and paginates the listed envs, the zero value lists all envs sorted by the creation time.
+type ListEnvsOptions struct {
+	Public       *bool
+	CreatedAfter *time.Time
+	// SortBy is the env time field the envs are sorted by, defaults to the created_at.
+	SortBy string
+	Desc   bool
+	After  *PageCursor
+	// Limit is the maximum number of returned envs, 0 means no limit.
+	Limit int
+}
+
+// ErrEnvChanged is returned when the env was updated or built since the caller read it.
+var ErrEnvChanged = errors.New("env was changed")
+
+// EnvVersion identifies the state of the env the caller read, the env is changed by its update and by the new build.
+type EnvVersion struct {
+	UpdatedAt time.Time
+	// BuildFinishedAt is the finish time of the latest build of the env.
+	BuildFinishedAt time.Time
 }
 
 type UpdateEnvInput struct {
-	Public bool
+	// Public replaces the visibility of the env if not nil.
+	Public *bool
+	// AllowedRegions replaces the allowed regions of the env if not nil, an empty slice allows all regions.
+	AllowedRegions *[]string
+	// IfUnchanged updates the env only if it's still in the version if not nil, the error is ErrEnvChanged otherwise.
+	IfUnchanged *EnvVersion
 }
 
 func (db *DB) DeleteEnv(ctx context.Context, envID string) error {
@@ -54,24 +85,79 @@ func (db *DB) DeleteEnv(ctx context.Context, envID string) error {
 }
 
 func (db *DB) UpdateEnv(ctx context.Context, envID string, input UpdateEnvInput) error {
-	return db.Client.Env.UpdateOneID(envID).SetPublic(input.Public).Exec(ctx)
+	update := db.Client.Env.UpdateOneID(envID).SetNillablePublic(input.Public).SetUpdatedAt(time.Now())
+
+	// The version is compared in the update, so the concurrent updates of the same version don't overwrite each other
+	if input.IfUnchanged != nil {
+		update.Where(
+			env.UpdatedAt(input.IfUnchanged.UpdatedAt),
+			env.Not(env.HasBuildsWith(
+				envbuild.StatusEQ(envbuild.StatusUploaded),
+				envbuild.VariantOfIsNil(),
+				envbuild.FinishedAtGT(input.IfUnchanged.BuildFinishedAt),
+			)),
+		)
+	}
+
+	if input.AllowedRegions != nil {
+		if len(*input.AllowedRegions) == 0 {
+			update.ClearAllowedRegions()
+		} else {
+			update.SetAllowedRegions(*input.AllowedRegions)
+		}
+	}
+
+	err := update.Exec(ctx)
+	if input.IfUnchanged != nil && models.IsNotFound(err) {
+		return fmt.Errorf("env '%s': %w", envID, ErrEnvChanged)
+	}
+
+	return err
 }
 
-func (db *DB) GetEnvs(ctx context.Context, teamID uuid.UUID) (result []*Template, err error) {
-	envs, err := db.
+func (db *DB) GetEnvs(ctx context.Context, teamID uuid.UUID, opts ListEnvsOptions) (result []*Template, err error) {
+	sortBy := opts.SortBy
+	if sortBy == "" {
+		sortBy = env.FieldCreatedAt
+	}
+
+	order := models.Asc
+	if opts.Desc {
+		order = models.Desc
+	}
+
+	query := db.
 		Client.
 		Env.
 		Query().
 		Where(
 			env.TeamID(teamID),
-			env.HasBuildsWith(envbuild.StatusEQ(envbuild.StatusUploaded)),
+			env.HasBuildsWith(envbuild.StatusEQ(envbuild.StatusUploaded), envbuild.VariantOfIsNil()),
 			env.Not(env.HasSnapshots()),
-		).
-		Order(models.Asc(env.FieldCreatedAt)).
+		)
+
+	if opts.Public != nil {
+		query = query.Where(env.Public(*opts.Public))
+	}
+
+	if opts.CreatedAfter != nil {
+		query = query.Where(env.CreatedAtGT(*opts.CreatedAfter))
+	}
+
+	if opts.After != nil {
+		query = query.Where(opts.After.after(sortBy, env.FieldID, opts.Desc))
+	}
+
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+
+	envs, err := query.
+		Order(order(sortBy, env.FieldID)).
 		WithEnvAliases().
 		WithCreator().
 		WithBuilds(func(query *models.EnvBuildQuery) {
-			query.Where(envbuild.StatusEQ(envbuild.StatusUploaded)).Order(models.Desc(envbuild.FieldFinishedAt))
+			query.Where(envbuild.StatusEQ(envbuild.StatusUploaded), envbuild.VariantOfIsNil()).Order(models.Desc(envbuild.FieldFinishedAt))
 		}).
 		All(ctx)
 	if err != nil {
@@ -91,20 +177,21 @@ func (db *DB) GetEnvs(ctx context.Context, teamID uuid.UUID) (result []*Template
 
 		build := item.Edges.Builds[0]
 		result = append(result, &Template{
-			TemplateID:    item.ID,
-			TeamID:        item.TeamID,
-			BuildID:       build.ID.String(),
-			VCPU:          build.Vcpu,
-			RAMMB:         build.RAMMB,
-			DiskMB:        build.FreeDiskSizeMB,
-			Public:        item.Public,
-			Aliases:       &aliases,
-			CreatedAt:     item.CreatedAt,
-			UpdatedAt:     item.UpdatedAt,
-			LastSpawnedAt: item.LastSpawnedAt,
-			SpawnCount:    item.SpawnCount,
-			BuildCount:    item.BuildCount,
-			CreatedBy:     createdBy,
+			TemplateID:     item.ID,
+			TeamID:         item.TeamID,
+			BuildID:        build.ID.String(),
+			VCPU:           build.Vcpu,
+			RAMMB:          build.RAMMB,
+			DiskMB:         build.FreeDiskSizeMB,
+			Public:         item.Public,
+			Aliases:        &aliases,
+			CreatedAt:      item.CreatedAt,
+			UpdatedAt:      item.UpdatedAt,
+			LastSpawnedAt:  item.LastSpawnedAt,
+			SpawnCount:     item.SpawnCount,
+			BuildCount:     item.BuildCount,
+			CreatedBy:      createdBy,
+			AllowedRegions: item.AllowedRegions,
 		})
 	}
 
@@ -121,13 +208,13 @@ func (db *DB) GetEnv(ctx context.Context, aliasOrEnvID string) (result *Template
 				env.HasEnvAliasesWith(envalias.ID(aliasOrEnvID)),
 				env.ID(aliasOrEnvID),
 			),
-			env.HasBuildsWith(envbuild.StatusEQ(envbuild.StatusUploaded)),
+			env.HasBuildsWith(envbuild.StatusEQ(envbuild.StatusUploaded), envbuild.VariantOfIsNil()),
 		).
 		WithEnvAliases(func(query *models.EnvAliasQuery) {
 			query.Order(models.Asc(envalias.FieldID)) // TODO: remove once we have only 1 alias per env
 		}).
 		WithBuilds(func(query *models.EnvBuildQuery) {
-			query.Where(envbuild.StatusEQ(envbuild.StatusUploaded)).Order(models.Desc(envbuild.FieldFinishedAt)).Limit(1)
+			query.Where(envbuild.StatusEQ(envbuild.StatusUploaded), envbuild.VariantOfIsNil()).Order(models.Desc(envbuild.FieldFinishedAt)).Limit(1)
 		}).Only(ctx)
 
 	notFound := models.IsNotFound(err)
@@ -144,19 +231,20 @@ func (db *DB) GetEnv(ctx context.Context, aliasOrEnvID string) (result *Template
 
 	build = dbEnv.Edges.Builds[0]
 	return &Template{
-		TemplateID:    dbEnv.ID,
-		BuildID:       build.ID.String(),
-		VCPU:          build.Vcpu,
-		RAMMB:         build.RAMMB,
-		DiskMB:        build.FreeDiskSizeMB,
-		Public:        dbEnv.Public,
-		Aliases:       &aliases,
-		TeamID:        dbEnv.TeamID,
-		CreatedAt:     dbEnv.CreatedAt,
-		UpdatedAt:     dbEnv.UpdatedAt,
-		LastSpawnedAt: dbEnv.LastSpawnedAt,
-		SpawnCount:    dbEnv.SpawnCount,
-		BuildCount:    dbEnv.BuildCount,
+		TemplateID:     dbEnv.ID,
+		BuildID:        build.ID.String(),
+		VCPU:           build.Vcpu,
+		RAMMB:          build.RAMMB,
+		DiskMB:         build.FreeDiskSizeMB,
+		Public:         dbEnv.Public,
+		Aliases:        &aliases,
+		TeamID:         dbEnv.TeamID,
+		CreatedAt:      dbEnv.CreatedAt,
+		UpdatedAt:      dbEnv.UpdatedAt,
+		LastSpawnedAt:  dbEnv.LastSpawnedAt,
+		SpawnCount:     dbEnv.SpawnCount,
+		BuildCount:     dbEnv.BuildCount,
+		AllowedRegions: dbEnv.AllowedRegions,
 	}, build, nil
 }
 
@@ -180,14 +268,40 @@ func (db *DB) FinishEnvBuild(
 	return nil
 }
 
+// buildWithVariants matches the build and its resource variants, the variants are built with the build and share its status until they are finished.
+func buildWithVariants(buildID uuid.UUID) predicate.EnvBuild {
+	return envbuild.Or(envbuild.ID(buildID), envbuild.VariantOf(buildID))
+}
+
+// GetEnvBuildVariants returns the resource variants of the build.
+func (db *DB) GetEnvBuildVariants(ctx context.Context, buildID uuid.UUID) ([]*models.EnvBuild, error) {
+	variants, err := db.Client.EnvBuild.Query().
+		Where(envbuild.VariantOf(buildID)).
+		Order(models.Asc(envbuild.FieldVariant)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variants of env build '%s': %w", buildID, err)
+	}
+
+	return variants, nil
+}
+
+// EnvBuildSetStatus sets the status of the build and its resource variants.
 func (db *DB) EnvBuildSetStatus(
 	ctx context.Context,
 	envID string,
 	buildID uuid.UUID,
 	status envbuild.Status,
 ) error {
-	err := db.Client.EnvBuild.Update().Where(envbuild.ID(buildID), envbuild.EnvID(envID)).
-		SetStatus(status).SetFinishedAt(time.Now()).Exec(ctx)
+	update := db.Client.EnvBuild.Update().Where(buildWithVariants(buildID), envbuild.EnvID(envID)).
+		SetStatus(status).SetFinishedAt(time.Now())
+
+	// The build is interrupted if the heartbeat isn't renewed since it was started
+	if status == envbuild.StatusBuilding {
+		update.SetHeartbeatAt(time.Now())
+	}
+
+	err := update.Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to set env build status %s for '%s': %w", status, buildID, err)
 	}
@@ -195,6 +309,87 @@ func (db *DB) EnvBuildSetStatus(
 	return nil
 }
 
+// EnvBuildHeartbeat records the build is still running, the build that isn't in the building status anymore isn't changed.
+// It returns false if the build isn't in the building status, e.g. it was canceled. The resource variants of the build are built with it.
+func (db *DB) EnvBuildHeartbeat(ctx context.Context, envID string, buildID uuid.UUID) (bool, error) {
+	updated, err := db.Client.EnvBuild.Update().
+		Where(buildWithVariants(buildID), envbuild.EnvID(envID), envbuild.StatusEQ(envbuild.StatusBuilding)).
+		SetHeartbeatAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to record heartbeat of env build '%s': %w", buildID, err)
+	}
+
+	return updated > 0, nil
+}
+
+// CancelEnvBuild marks the running build and its resource variants as failed, it returns false if the build isn't in the building status.
+// The API instance running the build stops it when its heartbeat finds the build isn't building anymore.
+func (db *DB) CancelEnvBuild(ctx context.Context, envID string, buildID uuid.UUID) (bool, error) {
+	updated, err := db.Client.EnvBuild.Update().
+		Where(buildWithVariants(buildID), envbuild.EnvID(envID), envbuild.StatusEQ(envbuild.StatusBuilding)).
+		SetStatus(envbuild.StatusFailed).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel env build '%s': %w", buildID, err)
+	}
+
+	return updated > 0, nil
+}
+
+// FailInterruptedEnvBuilds marks the template builds in the building status without the heartbeat since the time as failed
+// and returns them. The snapshots are never interrupted this way, they don't have the heartbeat. The builds without
+// any heartbeat are never failed, they are run by the API instances that don't record it, e.g. during the rolling deploy.
+func (db *DB) FailInterruptedEnvBuilds(ctx context.Context, heartbeatBefore time.Time) ([]*models.EnvBuild, error) {
+	interrupted := []predicate.EnvBuild{
+		envbuild.StatusEQ(envbuild.StatusBuilding),
+		envbuild.HasEnvWith(env.Not(env.HasSnapshots())),
+		envbuild.HeartbeatAtLT(heartbeatBefore),
+	}
+
+	builds, err := db.Client.EnvBuild.Query().Where(interrupted...).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interrupted env builds: %w", err)
+	}
+
+	failed := make([]*models.EnvBuild, 0, len(builds))
+
+	for _, build := range builds {
+		// The conditions are checked again, so the build that renewed its heartbeat in the meantime isn't failed
+		updated, err := db.Client.EnvBuild.Update().
+			Where(append(interrupted, envbuild.ID(build.ID))...).
+			SetStatus(envbuild.StatusFailed).
+			SetFinishedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return failed, fmt.Errorf("failed to fail interrupted env build '%s': %w", build.ID, err)
+		}
+
+		if updated > 0 {
+			failed = append(failed, build)
+		}
+	}
+
+	return failed, nil
+}
+
+// EnvBuildSetFirecrackerVersion changes the firecracker version of the build and its resource variants, it is used when the build falls back from the canary version.
+func (db *DB) EnvBuildSetFirecrackerVersion(
+	ctx context.Context,
+	envID string,
+	buildID uuid.UUID,
+	firecrackerVersion string,
+) error {
+	err := db.Client.EnvBuild.Update().Where(buildWithVariants(buildID), envbuild.EnvID(envID)).
+		SetFirecrackerVersion(firecrackerVersion).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set firecracker version %s for env build '%s': %w", firecrackerVersion, buildID, err)
+	}
+
+	return nil
+}
+
 func (db *DB) UpdateEnvLastUsed(ctx context.Context, count int64, time time.Time, envID string) (err error) {
 	return db.Client.Env.UpdateOneID(envID).AddSpawnCount(count).SetLastSpawnedAt(time).Exec(ctx)
 }
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/idempotencykey"
+)
+
+// ClaimIdempotencyKey creates the key for the request in progress. The expired keys of the owner are removed first.
+// The returned bool is false if the key already exists, the existing key is returned then.
+func (db *DB) ClaimIdempotencyKey(ctx context.Context, ownerID uuid.UUID, key, requestHash string, expiresAt time.Time) (*models.IdempotencyKey, bool, error) {
+	_, err := db.
+		Client.
+		IdempotencyKey.
+		Delete().
+		Where(idempotencykey.OwnerID(ownerID), idempotencykey.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	id := uuid.New()
+	err = db.
+		Client.
+		IdempotencyKey.
+		Create().
+		SetID(id).
+		SetOwnerID(ownerID).
+		SetKey(key).
+		SetRequestHash(requestHash).
+		SetExpiresAt(expiresAt).
+		OnConflictColumns(idempotencykey.FieldOwnerID, idempotencykey.FieldKey).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create idempotency key: %w", err)
+	}
+
+	record, err := db.
+		Client.
+		IdempotencyKey.
+		Query().
+		Where(idempotencykey.OwnerID(ownerID), idempotencykey.Key(key)).
+		Only(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return record, record.ID == id, nil
+}
+
+// CompleteIdempotencyKey stores the response of the request, the response is returned for the retried requests with the same key.
+func (db *DB) CompleteIdempotencyKey(ctx context.Context, id uuid.UUID, statusCode int, response []byte) error {
+	err := db.
+		Client.
+		IdempotencyKey.
+		UpdateOneID(id).
+		SetStatusCode(statusCode).
+		SetResponse(response).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteIdempotencyKey removes the key, so the request with the same key is processed again.
+func (db *DB) DeleteIdempotencyKey(ctx context.Context, id uuid.UUID) error {
+	err := db.
+		Client.
+		IdempotencyKey.
+		DeleteOneID(id).
+		Exec(ctx)
+	if err != nil && !models.IsNotFound(err) {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+)
+
+var ErrKernelChecksumMismatch = errors.New("kernel is already registered with a different checksum")
+
+// RegisterKernel registers the kernel version with the checksum of its binary.
+// The kernels are immutable, registering the same version with a different checksum fails.
+func (db *DB) RegisterKernel(ctx context.Context, version, checksum string, description *string) (*models.Kernel, error) {
+	existing, err := db.GetKernel(ctx, version)
+	if err == nil {
+		if existing.Checksum != checksum {
+			return nil, fmt.Errorf("kernel '%s': %w", version, ErrKernelChecksumMismatch)
+		}
+
+		return existing, nil
+	}
+
+	if !models.IsNotFound(err) {
+		return nil, err
+	}
+
+	k, err := db.
+		Client.
+		Kernel.
+		Create().
+		SetID(version).
+		SetChecksum(checksum).
+		SetNillableDescription(description).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register kernel '%s': %w", version, err)
+	}
+
+	return k, nil
+}
+
+// GetKernel returns the registered kernel, the error is models.NotFoundError if the kernel is not registered.
+func (db *DB) GetKernel(ctx context.Context, version string) (*models.Kernel, error) {
+	k, err := db.
+		Client.
+		Kernel.
+		Query().
+		Where(kernel.ID(version)).
+		Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get kernel '%s': %w", version, err)
+	}
+
+	return k, nil
+}
+
+func (db *DB) GetKernels(ctx context.Context) ([]*models.Kernel, error) {
+	kernels, err := db.
+		Client.
+		Kernel.
+		Query().
+		Order(models.Asc(kernel.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kernels: %w", err)
+	}
+
+	return kernels, nil
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// CacheInvalidationChannel is the channel the database triggers notify about the changes of the cached tables.
+const CacheInvalidationChannel = "cache_invalidation"
+
+const (
+	listenerMinReconnectInterval = 10 * time.Second
+	listenerMaxReconnectInterval = time.Minute
+)
+
+// CacheInvalidation is the change of the row in the cached table.
+// The empty Table means that the notifications could be lost and all cached data should be invalidated.
+type CacheInvalidation struct {
+	Table string `json:"table"`
+	Key   string `json:"key"`
+	// At is the unix time of the change in seconds.
+	At float64 `json:"at"`
+}
+
+// Time returns the time of the change.
+func (i CacheInvalidation) Time() time.Time {
+	return time.UnixMicro(int64(i.At * 1e6))
+}
+
+// ListenCacheInvalidations calls the handler for each change of the cached tables until the context is canceled.
+func ListenCacheInvalidations(ctx context.Context, handler func(CacheInvalidation)) error {
+	if databaseURL == "" {
+		return fmt.Errorf("database URL is empty")
+	}
+
+	listener := pq.NewListener(databaseURL, listenerMinReconnectInterval, listenerMaxReconnectInterval, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("cache invalidation listener error: %v", err)
+		}
+	})
+	defer listener.Close()
+
+	err := listener.Listen(CacheInvalidationChannel)
+	if err != nil {
+		return fmt.Errorf("failed to listen on '%s': %w", CacheInvalidationChannel, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-listener.Notify:
+			// The nil notification is sent after the connection was re-established, the notifications in between were lost
+			if notification == nil {
+				handler(CacheInvalidation{})
+
+				continue
+			}
+
+			var invalidation CacheInvalidation
+			err = json.Unmarshal([]byte(notification.Extra), &invalidation)
+			if err != nil {
+				log.Printf("failed to parse cache invalidation '%s': %v", notification.Extra, err)
+
+				continue
+			}
+
+			handler(invalidation)
+		}
+	}
+}
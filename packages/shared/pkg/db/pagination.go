@@ -0,0 +1,28 @@
+package db
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// PageCursor is the position of the last returned item in the list sorted by the time field and the ID.
+type PageCursor struct {
+	Time time.Time
+	ID   string
+}
+
+// after selects the rows following the cursor in the list sorted by the timeField and the idField.
+func (c *PageCursor) after(timeField, idField string, desc bool) func(*sql.Selector) {
+	if desc {
+		return sql.OrPredicates(
+			sql.FieldLT(timeField, c.Time),
+			sql.AndPredicates(sql.FieldEQ(timeField, c.Time), sql.FieldLT(idField, c.ID)),
+		)
+	}
+
+	return sql.OrPredicates(
+		sql.FieldGT(timeField, c.Time),
+		sql.AndPredicates(sql.FieldEQ(timeField, c.Time), sql.FieldGT(idField, c.ID)),
+	)
+}
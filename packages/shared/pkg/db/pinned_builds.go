@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
+)
+
+var ErrBuildNotPinned = errors.New("build is not pinned")
+
+// PinBuild pins the build on the node, or on all nodes if the node ID is empty. Pinning the pinned build returns the existing pin.
+// The error is models.NotFoundError if the build doesn't exist.
+func (db *DB) PinBuild(ctx context.Context, buildID uuid.UUID, nodeID string) (*models.PinnedBuild, error) {
+	_, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(envbuild.ID(buildID)).
+		Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get build '%s': %w", buildID, err)
+	}
+
+	err = db.
+		Client.
+		PinnedBuild.
+		Create().
+		SetBuildID(buildID).
+		SetNodeID(nodeID).
+		OnConflictColumns(pinnedbuild.FieldBuildID, pinnedbuild.FieldNodeID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pin build '%s': %w", buildID, err)
+	}
+
+	pin, err := db.
+		Client.
+		PinnedBuild.
+		Query().
+		Where(pinnedbuild.BuildID(buildID), pinnedbuild.NodeID(nodeID)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned build '%s': %w", buildID, err)
+	}
+
+	return pin, nil
+}
+
+// UnpinBuild removes the pin of the build on the node, or the cluster-wide pin if the node ID is empty.
+// The error is ErrBuildNotPinned if the build isn't pinned.
+func (db *DB) UnpinBuild(ctx context.Context, buildID uuid.UUID, nodeID string) error {
+	deleted, err := db.
+		Client.
+		PinnedBuild.
+		Delete().
+		Where(pinnedbuild.BuildID(buildID), pinnedbuild.NodeID(nodeID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to unpin build '%s': %w", buildID, err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("build '%s': %w", buildID, ErrBuildNotPinned)
+	}
+
+	return nil
+}
+
+func (db *DB) GetPinnedBuilds(ctx context.Context) ([]*models.PinnedBuild, error) {
+	pins, err := db.
+		Client.
+		PinnedBuild.
+		Query().
+		Order(models.Asc(pinnedbuild.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pinned builds: %w", err)
+	}
+
+	return pins, nil
+}
+
+// GetPinnedEnvBuilds returns the builds pinned on the node, including the builds pinned on all nodes.
+func (db *DB) GetPinnedEnvBuilds(ctx context.Context, nodeID string) ([]*models.EnvBuild, error) {
+	pins, err := db.
+		Client.
+		PinnedBuild.
+		Query().
+		Where(pinnedbuild.NodeIDIn("", nodeID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pinned builds: %w", err)
+	}
+
+	if len(pins) == 0 {
+		return nil, nil
+	}
+
+	buildIDs := make([]uuid.UUID, 0, len(pins))
+	for _, pin := range pins {
+		buildIDs = append(buildIDs, pin.BuildID)
+	}
+
+	builds, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(envbuild.IDIn(buildIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned builds: %w", err)
+	}
+
+	return builds, nil
+}
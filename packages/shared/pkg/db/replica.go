@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+const (
+	replicaLagCheckInterval = 5 * time.Second
+	replicaLagCheckTimeout  = 2 * time.Second
+	defaultReplicaMaxLag    = 10 * time.Second
+)
+
+// The lag is 0 if the replica replayed all received WAL, otherwise it's the time since the last replayed transaction.
+const replicaLagQuery = `SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+type replica struct {
+	client *models.Client
+	drv    *sql.Driver
+	maxLag time.Duration
+
+	// isHealthy is false when the replica is unreachable or lags behind the primary more than maxLag.
+	isHealthy atomic.Bool
+	done      chan struct{}
+}
+
+func newReplica(drv *sql.Driver) *replica {
+	maxLag := defaultReplicaMaxLag
+	if value := os.Getenv("POSTGRES_READ_REPLICA_MAX_LAG"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Printf("invalid POSTGRES_READ_REPLICA_MAX_LAG '%s', using default %s: %v", value, defaultReplicaMaxLag, err)
+		} else {
+			maxLag = parsed
+		}
+	}
+
+	r := &replica{
+		client: models.NewClient(models.Driver(drv)),
+		drv:    drv,
+		maxLag: maxLag,
+		done:   make(chan struct{}),
+	}
+
+	r.check()
+	go r.monitor()
+
+	return r
+}
+
+func (r *replica) healthy() bool {
+	return r.isHealthy.Load()
+}
+
+func (r *replica) monitor() {
+	ticker := time.NewTicker(replicaLagCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			r.check()
+		}
+	}
+}
+
+func (r *replica) check() {
+	ctx, cancel := context.WithTimeout(context.Background(), replicaLagCheckTimeout)
+	defer cancel()
+
+	healthy := true
+
+	lag, err := r.lag(ctx)
+	if err != nil {
+		healthy = false
+		log.Printf("failed to get read replica lag, using primary: %v", err)
+	} else if lag > r.maxLag {
+		healthy = false
+	}
+
+	if r.isHealthy.Swap(healthy) != healthy {
+		if healthy {
+			log.Printf("read replica lag is %s, routing read queries to the replica", lag)
+		} else if err == nil {
+			log.Printf("read replica lag is %s (max %s), routing read queries to the primary", lag, r.maxLag)
+		}
+	}
+}
+
+func (r *replica) lag(ctx context.Context) (time.Duration, error) {
+	var seconds float64
+
+	err := r.drv.DB().QueryRowContext(ctx, replicaLagQuery).Scan(&seconds)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func (r *replica) close() error {
+	close(r.done)
+
+	return r.client.Close()
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+)
+
+// GetReplicationPolicies returns the regions the builds of the teams are replicated to, by the team ID.
+func (db *DB) GetReplicationPolicies(ctx context.Context) (map[uuid.UUID][]string, error) {
+	teams, err := db.
+		Client.
+		Team.
+		Query().
+		Where(team.ReplicationRegionsNotNil()).
+		Select(team.FieldID, team.FieldReplicationRegions).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replication policies: %w", err)
+	}
+
+	policies := make(map[uuid.UUID][]string, len(teams))
+	for _, t := range teams {
+		if len(t.ReplicationRegions) == 0 {
+			continue
+		}
+
+		policies[t.ID] = t.ReplicationRegions
+	}
+
+	return policies, nil
+}
+
+// GetBuildsToReplicate returns the uploaded builds of the team's templates and snapshots that weren't replicated to all the regions,
+// the oldest builds first.
+func (db *DB) GetBuildsToReplicate(ctx context.Context, teamID uuid.UUID, regions []string, limit int) ([]*models.EnvBuild, error) {
+	missing := make([]predicate.EnvBuild, 0, len(regions)+1)
+	missing = append(missing, envbuild.ReplicatedRegionsIsNil())
+
+	for _, region := range regions {
+		missing = append(missing, envbuild.Not(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(envbuild.FieldReplicatedRegions, region))
+		}))
+	}
+
+	builds, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.StatusEQ(envbuild.StatusUploaded),
+			envbuild.Or(envbuild.UploadStatusIsNil(), envbuild.UploadStatusEQ(envbuild.UploadStatusUploaded)),
+			envbuild.HasEnvWith(env.TeamID(teamID)),
+			envbuild.Or(missing...),
+		).
+		Order(models.Asc(envbuild.FieldFinishedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get builds to replicate for team '%s': %w", teamID, err)
+	}
+
+	return builds, nil
+}
+
+// SetBuildReplicatedRegions records the regions the build was replicated to.
+func (db *DB) SetBuildReplicatedRegions(ctx context.Context, buildID uuid.UUID, regions []string) error {
+	err := db.
+		Client.
+		EnvBuild.
+		UpdateOneID(buildID).
+		SetReplicatedRegions(regions).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set replicated regions of build '%s': %w", buildID, err)
+	}
+
+	return nil
+}
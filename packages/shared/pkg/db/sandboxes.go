@@ -0,0 +1,149 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+)
+
+type SandboxRecord struct {
+	SandboxID string
+	TeamID    uuid.UUID
+	EnvID     string
+	BuildID   uuid.UUID
+	Alias     *string
+	NodeID    string
+	// ClusterID is the cluster of the node, the sandbox traffic is routed through the cluster.
+	ClusterID string
+	StartedAt time.Time
+	EndAt     time.Time
+	VCPU      int64
+	RAMMB     int64
+	Metadata  map[string]string
+	Labels    map[string]string
+}
+
+// UpsertSandbox creates or updates the persisted record of the sandbox with the given state.
+func (db *DB) UpsertSandbox(ctx context.Context, record *SandboxRecord, state sandbox.State) error {
+	err := db.
+		Client.
+		Sandbox.
+		Create().
+		SetID(record.SandboxID).
+		SetTeamID(record.TeamID).
+		SetEnvID(record.EnvID).
+		SetBuildID(record.BuildID).
+		SetNillableAlias(record.Alias).
+		SetNodeID(record.NodeID).
+		SetClusterID(record.ClusterID).
+		SetState(state).
+		SetStartedAt(record.StartedAt).
+		SetEndAt(record.EndAt).
+		SetVcpu(record.VCPU).
+		SetRAMMB(record.RAMMB).
+		SetMetadata(record.Metadata).
+		SetLabels(record.Labels).
+		OnConflictColumns(sandbox.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to upsert sandbox '%s': %w", record.SandboxID, err)
+	}
+
+	return nil
+}
+
+// UpdateSandboxEndAt updates the end time of the persisted sandbox, the missing record is ignored.
+func (db *DB) UpdateSandboxEndAt(ctx context.Context, sandboxID string, endAt time.Time) error {
+	_, err := db.
+		Client.
+		Sandbox.
+		Update().
+		Where(sandbox.ID(sandboxID)).
+		SetEndAt(endAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update end time of sandbox '%s': %w", sandboxID, err)
+	}
+
+	return nil
+}
+
+// MarkSandboxLost marks the persisted sandbox as lost, so it is no longer reported as running.
+func (db *DB) MarkSandboxLost(ctx context.Context, sandboxID string) error {
+	_, err := db.
+		Client.
+		Sandbox.
+		Update().
+		Where(sandbox.ID(sandboxID), sandbox.StateNEQ(sandbox.StateLost)).
+		SetState(sandbox.StateLost).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark sandbox '%s' as lost: %w", sandboxID, err)
+	}
+
+	return nil
+}
+
+// DeleteSandbox deletes the persisted sandbox, the missing record is ignored.
+func (db *DB) DeleteSandbox(ctx context.Context, sandboxID string) error {
+	_, err := db.
+		Client.
+		Sandbox.
+		Delete().
+		Where(sandbox.ID(sandboxID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete sandbox '%s': %w", sandboxID, err)
+	}
+
+	return nil
+}
+
+// endSandboxQuery deletes the persisted sandbox and accrues its cost since the budget of its team was last metered,
+// the periodic metering charges only the running sandboxes, so the sandboxes ending between the meterings would escape it.
+// The record is deleted in the same statement, so the cost is accrued once even if more API instances end the sandbox.
+const endSandboxQuery = `WITH ended AS (
+    DELETE FROM "public"."sandboxes" WHERE id = $1 RETURNING team_id, started_at, vcpu, ram_mb
+)
+UPDATE "public"."team_budgets" AS b
+SET accrued_usd = b.accrued_usd + (e.vcpu * t.vcpu_hour_price_usd + e.ram_mb / 1024.0 * t.ram_gib_hour_price_usd) *
+    GREATEST(0, EXTRACT(EPOCH FROM ($2::timestamptz - GREATEST(e.started_at, b.metered_at, b.period_start))) / 3600)
+FROM ended AS e
+    JOIN "public"."teams" AS tm ON tm.id = e.team_id
+    JOIN "public"."tiers" AS t ON t.id = tm.tier
+WHERE b.team_id = e.team_id`
+
+// EndSandbox deletes the persisted sandbox that ended and accrues its cost not metered yet to the budget of its team.
+func (db *DB) EndSandbox(ctx context.Context, sandboxID string, endedAt time.Time) error {
+	_, err := db.drv.DB().ExecContext(ctx, endSandboxQuery, sandboxID, endedAt)
+	if err != nil {
+		return fmt.Errorf("failed to end sandbox '%s': %w", sandboxID, err)
+	}
+
+	return nil
+}
+
+// GetSandboxes returns the persisted sandboxes, if the teamID is nil the sandboxes of all teams are returned.
+func (db *DB) GetSandboxes(ctx context.Context, teamID *uuid.UUID) ([]*models.Sandbox, error) {
+	query := db.
+		Client.
+		Sandbox.
+		Query()
+
+	if teamID != nil {
+		query = query.Where(sandbox.TeamID(*teamID))
+	}
+
+	sandboxes, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sandboxes: %w", err)
+	}
+
+	return sandboxes, nil
+}
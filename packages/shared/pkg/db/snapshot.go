@@ -3,12 +3,14 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 
 	"github.com/google/uuid"
 )
@@ -23,6 +25,11 @@ type SnapshotInfo struct {
 	KernelVersion      string
 	FirecrackerVersion string
 	EnvdVersion        string
+	ReadinessProbe     *schema.ReadinessProbe
+	Hooks              *schema.LifecycleHooks
+	Hardening          *schema.HardeningPolicy
+	Egress             *schema.EgressPolicy
+	SecretRefs         []string
 }
 
 // Check if there exists snapshot with the ID, if yes then return a new
@@ -95,6 +102,11 @@ func (db *DB) NewSnapshotBuild(
 		SetEnvdVersion(snapshotConfig.EnvdVersion).
 		SetStatus(envbuild.StatusBuilding).
 		SetTotalDiskSizeMB(snapshotConfig.TotalDiskSizeMB).
+		SetReadinessProbe(snapshotConfig.ReadinessProbe).
+		SetHooks(snapshotConfig.Hooks).
+		SetHardening(snapshotConfig.Hardening).
+		SetEgress(snapshotConfig.Egress).
+		SetSecrets(snapshotConfig.SecretRefs).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create env build '%s': %w", snapshotConfig.SandboxID, err)
@@ -140,3 +152,144 @@ func (db *DB) GetLastSnapshot(ctx context.Context, sandboxID string, teamID uuid
 
 	return e.Edges.Snapshots[0], e.Edges.Builds[0], nil
 }
+
+type PausedSandbox struct {
+	SandboxID  string
+	TemplateID string
+	VCPU       int64
+	RAMMB      int64
+	Metadata   map[string]string
+	PausedAt   time.Time
+}
+
+// GetPausedSandboxes returns the sandboxes of the team with a successful snapshot build.
+// The sandboxes that were resumed are still returned, the caller has to filter out the running ones.
+func (db *DB) GetPausedSandboxes(ctx context.Context, teamID uuid.UUID) ([]*PausedSandbox, error) {
+	snapshots, err := db.
+		Client.
+		Snapshot.
+		Query().
+		Where(
+			snapshot.HasEnvWith(
+				env.TeamID(teamID),
+				env.HasBuildsWith(envbuild.StatusEQ(envbuild.StatusSuccess)),
+			),
+		).
+		WithEnv(func(query *models.EnvQuery) {
+			query.WithBuilds(func(query *models.EnvBuildQuery) {
+				query.Where(envbuild.StatusEQ(envbuild.StatusSuccess)).Order(models.Desc(envbuild.FieldFinishedAt))
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	result := make([]*PausedSandbox, 0, len(snapshots))
+	for _, s := range snapshots {
+		if s.Edges.Env == nil || len(s.Edges.Env.Edges.Builds) == 0 {
+			continue
+		}
+
+		build := s.Edges.Env.Edges.Builds[0]
+
+		pausedAt := s.CreatedAt
+		if build.FinishedAt != nil {
+			pausedAt = *build.FinishedAt
+		}
+
+		result = append(result, &PausedSandbox{
+			SandboxID:  s.SandboxID,
+			TemplateID: s.BaseEnvID,
+			VCPU:       build.Vcpu,
+			RAMMB:      build.RAMMB,
+			Metadata:   s.Metadata,
+			PausedAt:   pausedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// SnapshotBuildSetPaused marks the snapshot build as successful, the snapshot is on the node and its upload is pending.
+func (db *DB) SnapshotBuildSetPaused(ctx context.Context, envID string, buildID uuid.UUID, nodeID string) error {
+	err := db.
+		Client.
+		EnvBuild.
+		Update().
+		Where(envbuild.ID(buildID), envbuild.EnvID(envID)).
+		SetStatus(envbuild.StatusSuccess).
+		SetFinishedAt(time.Now()).
+		SetUploadStatus(envbuild.UploadStatusPending).
+		SetSnapshotNodeID(nodeID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set snapshot build '%s' as paused: %w", buildID, err)
+	}
+
+	return nil
+}
+
+func (db *DB) SnapshotBuildSetUploadStatus(ctx context.Context, buildID uuid.UUID, status envbuild.UploadStatus) error {
+	err := db.
+		Client.
+		EnvBuild.
+		UpdateOneID(buildID).
+		SetUploadStatus(status).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set upload status %s for snapshot build '%s': %w", status, buildID, err)
+	}
+
+	return nil
+}
+
+// GetPendingSnapshotUploads returns the snapshot builds taken on the node that are still being uploaded.
+func (db *DB) GetPendingSnapshotUploads(ctx context.Context, nodeID string) ([]*models.EnvBuild, error) {
+	builds, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.SnapshotNodeID(nodeID),
+			envbuild.UploadStatusEQ(envbuild.UploadStatusPending),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending snapshot uploads: %w", err)
+	}
+
+	return builds, nil
+}
+
+// HasPendingSnapshotUploads returns true if a snapshot of the env or a snapshot based on the env is still being uploaded.
+func (db *DB) HasPendingSnapshotUploads(ctx context.Context, envID string) (bool, error) {
+	pending, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.UploadStatusEQ(envbuild.UploadStatusPending),
+			envbuild.HasEnvWith(
+				env.Or(
+					env.ID(envID),
+					env.HasSnapshotsWith(snapshot.BaseEnvID(envID)),
+				),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check pending snapshot uploads of '%s': %w", envID, err)
+	}
+
+	return pending, nil
+}
+
+// SnapshotNodeOnly returns the node the snapshot has to be resumed on, because it's not uploaded to the storage.
+func SnapshotNodeOnly(build *models.EnvBuild) (string, bool) {
+	if build.UploadStatus == nil || *build.UploadStatus == envbuild.UploadStatusUploaded || build.SnapshotNodeID == nil {
+		return "", false
+	}
+
+	return *build.SnapshotNodeID, true
+}
@@ -0,0 +1,261 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+)
+
+var ErrTeamBudgetNotFound = errors.New("team budget not found")
+
+// BudgetPeriodStart returns the start of the UTC month the cost at the time is accrued in.
+func BudgetPeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// SetTeamBudget creates the budget of the team or replaces the limits of the existing one, the accrued cost is kept.
+// The cost of the new budget is accrued from now.
+func (db *DB) SetTeamBudget(ctx context.Context, teamID uuid.UUID, monthlyLimitUSD float64, warningPercent int32, pauseSandboxes bool) (*models.TeamBudget, error) {
+	now := time.Now()
+
+	err := db.
+		Client.
+		TeamBudget.
+		Create().
+		SetTeamID(teamID).
+		SetMonthlyLimitUsd(monthlyLimitUSD).
+		SetWarningPercent(warningPercent).
+		SetPauseSandboxes(pauseSandboxes).
+		SetPeriodStart(BudgetPeriodStart(now)).
+		SetMeteredAt(now).
+		OnConflictColumns(teambudget.FieldTeamID).
+		Update(func(u *models.TeamBudgetUpsert) {
+			u.UpdateMonthlyLimitUsd()
+			u.UpdateWarningPercent()
+			u.UpdatePauseSandboxes()
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set budget of team '%s': %w", teamID, err)
+	}
+
+	return db.GetTeamBudget(ctx, teamID)
+}
+
+// GetTeamBudget returns the budget of the team, the error is ErrTeamBudgetNotFound if the team has no budget.
+func (db *DB) GetTeamBudget(ctx context.Context, teamID uuid.UUID) (*models.TeamBudget, error) {
+	budget, err := db.
+		Client.
+		TeamBudget.
+		Query().
+		Where(teambudget.TeamID(teamID)).
+		Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, fmt.Errorf("team '%s': %w", teamID, ErrTeamBudgetNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to get budget of team '%s': %w", teamID, err)
+	}
+
+	return budget, nil
+}
+
+// DeleteTeamBudget deletes the budget of the team, the error is ErrTeamBudgetNotFound if the team has no budget.
+func (db *DB) DeleteTeamBudget(ctx context.Context, teamID uuid.UUID) error {
+	deleted, err := db.
+		Client.
+		TeamBudget.
+		Delete().
+		Where(teambudget.TeamID(teamID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete budget of team '%s': %w", teamID, err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("team '%s': %w", teamID, ErrTeamBudgetNotFound)
+	}
+
+	return nil
+}
+
+// SetTeamBudgetOverride suspends the enforcement of the team's budget until the time, the override is removed if the time is nil.
+// The error is ErrTeamBudgetNotFound if the team has no budget.
+func (db *DB) SetTeamBudgetOverride(ctx context.Context, teamID uuid.UUID, until *time.Time, reason *string) (*models.TeamBudget, error) {
+	update := db.
+		Client.
+		TeamBudget.
+		Update().
+		Where(teambudget.TeamID(teamID))
+
+	if until != nil {
+		update = update.SetOverrideUntil(*until).SetNillableOverrideReason(reason)
+	} else {
+		update = update.ClearOverrideUntil().ClearOverrideReason()
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set budget override of team '%s': %w", teamID, err)
+	}
+
+	if updated == 0 {
+		return nil, fmt.Errorf("team '%s': %w", teamID, ErrTeamBudgetNotFound)
+	}
+
+	return db.GetTeamBudget(ctx, teamID)
+}
+
+// GetTeamBudgets returns the budgets of all teams with the tiers of the teams the cost is metered with.
+func (db *DB) GetTeamBudgets(ctx context.Context) ([]*models.TeamBudget, map[uuid.UUID]*models.Tier, error) {
+	budgets, err := db.
+		Client.
+		TeamBudget.
+		Query().
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get team budgets: %w", err)
+	}
+
+	if len(budgets) == 0 {
+		return nil, nil, nil
+	}
+
+	teamIDs := make([]uuid.UUID, len(budgets))
+	for i, budget := range budgets {
+		teamIDs[i] = budget.TeamID
+	}
+
+	teams, err := db.
+		Client.
+		Team.
+		Query().
+		Where(team.IDIn(teamIDs...)).
+		WithTeamTier().
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get tiers of the teams with budget: %w", err)
+	}
+
+	tiers := make(map[uuid.UUID]*models.Tier, len(teams))
+	for _, t := range teams {
+		tiers[t.ID] = t.Edges.TeamTier
+	}
+
+	return budgets, tiers, nil
+}
+
+// MeterTeamBudget accrues the cost to the budget and moves its metering time, the accrued cost is reset when the period changes.
+// The cost is accrued only if the budget wasn't metered in between, so the cost is accrued by one API instance only,
+// the returned budget is nil otherwise.
+func (db *DB) MeterTeamBudget(ctx context.Context, budget *models.TeamBudget, cost float64, meteredAt time.Time) (*models.TeamBudget, error) {
+	update := db.
+		Client.
+		TeamBudget.
+		Update().
+		Where(
+			teambudget.ID(budget.ID),
+			teambudget.MeteredAt(budget.MeteredAt),
+		).
+		SetMeteredAt(meteredAt)
+
+	if period := BudgetPeriodStart(meteredAt); period.After(budget.PeriodStart) {
+		update = update.
+			SetPeriodStart(period).
+			SetAccruedUsd(cost).
+			ClearWarnedAt().
+			ClearExceededAt()
+	} else {
+		update = update.AddAccruedUsd(cost)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to meter budget of team '%s': %w", budget.TeamID, err)
+	}
+
+	if updated == 0 {
+		return nil, nil
+	}
+
+	metered, err := db.
+		Client.
+		TeamBudget.
+		Get(ctx, budget.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budget of team '%s': %w", budget.TeamID, err)
+	}
+
+	return metered, nil
+}
+
+// SetTeamBudgetNotified records when the team was warned about and notified of crossing the limit of the budget in the period.
+func (db *DB) SetTeamBudgetNotified(ctx context.Context, budgetID uuid.UUID, warnedAt, exceededAt *time.Time) error {
+	update := db.
+		Client.
+		TeamBudget.
+		UpdateOneID(budgetID)
+
+	if warnedAt != nil {
+		update = update.SetWarnedAt(*warnedAt)
+	} else {
+		update = update.ClearWarnedAt()
+	}
+
+	if exceededAt != nil {
+		update = update.SetExceededAt(*exceededAt)
+	} else {
+		update = update.ClearExceededAt()
+	}
+
+	err := update.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set budget notifications of '%s': %w", budgetID, err)
+	}
+
+	return nil
+}
+
+// GetOverBudgetTeams returns the teams that crossed the limit of their budget in the current period and aren't overridden by the admins.
+func (db *DB) GetOverBudgetTeams(ctx context.Context) ([]uuid.UUID, error) {
+	now := time.Now()
+
+	budgets, err := db.
+		Client.
+		TeamBudget.
+		Query().
+		Where(
+			func(s *sql.Selector) {
+				s.Where(sql.ColumnsGTE(s.C(teambudget.FieldAccruedUsd), s.C(teambudget.FieldMonthlyLimitUsd)))
+			},
+			teambudget.PeriodStartGTE(BudgetPeriodStart(now)),
+			teambudget.Or(
+				teambudget.OverrideUntilIsNil(),
+				teambudget.OverrideUntilLT(now),
+			),
+		).
+		Select(teambudget.FieldTeamID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get over budget teams: %w", err)
+	}
+
+	result := make([]uuid.UUID, len(budgets))
+	for i, budget := range budgets {
+		result[i] = budget.TeamID
+	}
+
+	return result, nil
+}
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+)
+
+var ErrTeamRegistryCredentialNotFound = errors.New("team registry credential not found")
+
+// SetTeamRegistryCredential creates the credential of the team for the registry or rotates the existing one, the password is sealed
+// with the number of the new version, so the sealed password can't be used for the other version.
+func (db *DB) SetTeamRegistryCredential(
+	ctx context.Context,
+	teamID uuid.UUID,
+	registry string,
+	username string,
+	seal func(version int32) (*SealedSecret, error),
+) (credential *models.TeamRegistryCredential, created bool, err error) {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("starting a transaction: %w", err)
+	}
+
+	// The row of the credential stays locked by the upsert until the commit, so the concurrent rotations get different versions.
+	// The password is set after the version is known.
+	credentialID, err := tx.
+		TeamRegistryCredential.
+		Create().
+		SetTeamID(teamID).
+		SetRegistry(registry).
+		SetUsername(username).
+		SetKeyID("").
+		SetEncryptedKey([]byte{}).
+		SetCiphertext([]byte{}).
+		OnConflictColumns(teamregistrycredential.FieldTeamID, teamregistrycredential.FieldRegistry).
+		Update(func(u *models.TeamRegistryCredentialUpsert) {
+			u.AddVersion(1)
+		}).
+		ID(ctx)
+	if err != nil {
+		return nil, false, rollback(tx, fmt.Errorf("failed to set registry credential for '%s': %w", registry, err))
+	}
+
+	credential, err = tx.
+		TeamRegistryCredential.
+		Get(ctx, credentialID)
+	if err != nil {
+		return nil, false, rollback(tx, fmt.Errorf("failed to get registry credential for '%s': %w", registry, err))
+	}
+
+	sealed, err := seal(credential.Version)
+	if err != nil {
+		return nil, false, rollback(tx, fmt.Errorf("failed to seal registry credential for '%s': %w", registry, err))
+	}
+
+	credential, err = tx.
+		TeamRegistryCredential.
+		UpdateOneID(credentialID).
+		SetUsername(username).
+		SetKeyID(sealed.KeyID).
+		SetEncryptedKey(sealed.EncryptedKey).
+		SetCiphertext(sealed.Ciphertext).
+		Save(ctx)
+	if err != nil {
+		return nil, false, rollback(tx, fmt.Errorf("failed to seal registry credential for '%s': %w", registry, err))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return credential, credential.Version == 1, nil
+}
+
+// GetTeamRegistryCredential returns the credential of the team for the registry with the sealed password,
+// the error is ErrTeamRegistryCredentialNotFound if the team has no credential for the registry.
+func (db *DB) GetTeamRegistryCredential(ctx context.Context, teamID uuid.UUID, registry string) (*models.TeamRegistryCredential, error) {
+	credential, err := db.
+		Client.
+		TeamRegistryCredential.
+		Query().
+		Where(teamregistrycredential.TeamID(teamID), teamregistrycredential.Registry(registry)).
+		Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, fmt.Errorf("registry '%s': %w", registry, ErrTeamRegistryCredentialNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to get registry credential for '%s': %w", registry, err)
+	}
+
+	return credential, nil
+}
+
+func (db *DB) GetTeamRegistryCredentials(ctx context.Context, teamID uuid.UUID) ([]*models.TeamRegistryCredential, error) {
+	credentials, err := db.
+		Client.
+		TeamRegistryCredential.
+		Query().
+		Where(teamregistrycredential.TeamID(teamID)).
+		Order(models.Asc(teamregistrycredential.FieldRegistry)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team registry credentials: %w", err)
+	}
+
+	return credentials, nil
+}
+
+// DeleteTeamRegistryCredential deletes the credential of the team for the registry,
+// the error is ErrTeamRegistryCredentialNotFound if the team has no credential for the registry.
+func (db *DB) DeleteTeamRegistryCredential(ctx context.Context, teamID uuid.UUID, registry string) error {
+	deleted, err := db.
+		Client.
+		TeamRegistryCredential.
+		Delete().
+		Where(teamregistrycredential.TeamID(teamID), teamregistrycredential.Registry(registry)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete registry credential for '%s': %w", registry, err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("registry '%s': %w", registry, ErrTeamRegistryCredentialNotFound)
+	}
+
+	return nil
+}
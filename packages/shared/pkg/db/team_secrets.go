@@ -0,0 +1,264 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecret"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+)
+
+var (
+	ErrTeamSecretNotFound        = errors.New("team secret not found")
+	ErrTeamSecretVersionMismatch = errors.New("team secret version mismatch")
+)
+
+// SealedSecret is the encrypted value of the secret version with its encrypted data key.
+type SealedSecret struct {
+	KeyID        string
+	EncryptedKey []byte
+	Ciphertext   []byte
+}
+
+// TeamSecretValue is the sealed value of the secret in the version.
+type TeamSecretValue struct {
+	Name    string
+	Version int32
+	Sealed  SealedSecret
+}
+
+// AddTeamSecretVersion creates the secret of the team or its new version, the value is sealed with the number of the new version,
+// so the sealed value can't be used for the other version. If the previous version is set, the version is added only if the latest
+// version of the secret is the previous version (0 if the secret doesn't exist), the error is ErrTeamSecretVersionMismatch otherwise.
+func (db *DB) AddTeamSecretVersion(
+	ctx context.Context,
+	teamID uuid.UUID,
+	name string,
+	previousVersion *int32,
+	seal func(version int32) (*SealedSecret, error),
+) (*models.TeamSecret, error) {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting a transaction: %w", err)
+	}
+
+	// The row of the secret stays locked by the upsert until the commit, so the concurrent versions get different numbers
+	secretID, err := tx.
+		TeamSecret.
+		Create().
+		SetTeamID(teamID).
+		SetName(name).
+		OnConflictColumns(teamsecret.FieldTeamID, teamsecret.FieldName).
+		Update(func(u *models.TeamSecretUpsert) {
+			u.AddLatestVersion(1)
+			u.UpdateUpdatedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to set team secret '%s': %w", name, err))
+	}
+
+	secret, err := tx.
+		TeamSecret.
+		Get(ctx, secretID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to get team secret '%s': %w", name, err))
+	}
+
+	if previousVersion != nil && secret.LatestVersion-1 != *previousVersion {
+		return nil, rollback(tx, fmt.Errorf("secret '%s' version %d: %w", name, secret.LatestVersion-1, ErrTeamSecretVersionMismatch))
+	}
+
+	sealed, err := seal(secret.LatestVersion)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to seal team secret '%s': %w", name, err))
+	}
+
+	err = tx.
+		TeamSecretVersion.
+		Create().
+		SetSecretID(secret.ID).
+		SetVersion(secret.LatestVersion).
+		SetKeyID(sealed.KeyID).
+		SetEncryptedKey(sealed.EncryptedKey).
+		SetCiphertext(sealed.Ciphertext).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create version of team secret '%s': %w", name, err))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return secret, nil
+}
+
+// DeleteTeamSecret deletes the secret of the team with all its versions, the error is ErrTeamSecretNotFound if the team has no secret with the name.
+func (db *DB) DeleteTeamSecret(ctx context.Context, teamID uuid.UUID, name string) error {
+	deleted, err := db.
+		Client.
+		TeamSecret.
+		Delete().
+		Where(teamsecret.TeamID(teamID), teamsecret.Name(name)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete team secret '%s': %w", name, err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("secret '%s': %w", name, ErrTeamSecretNotFound)
+	}
+
+	return nil
+}
+
+// GetTeamSecret returns the secret of the team, the error is ErrTeamSecretNotFound if the team has no secret with the name.
+func (db *DB) GetTeamSecret(ctx context.Context, teamID uuid.UUID, name string) (*models.TeamSecret, error) {
+	secret, err := db.
+		Client.
+		TeamSecret.
+		Query().
+		Where(teamsecret.TeamID(teamID), teamsecret.Name(name)).
+		Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, fmt.Errorf("secret '%s': %w", name, ErrTeamSecretNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to get team secret '%s': %w", name, err)
+	}
+
+	return secret, nil
+}
+
+func (db *DB) GetTeamSecrets(ctx context.Context, teamID uuid.UUID) ([]*models.TeamSecret, error) {
+	secrets, err := db.
+		Client.
+		TeamSecret.
+		Query().
+		Where(teamsecret.TeamID(teamID)).
+		Order(models.Asc(teamsecret.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team secrets: %w", err)
+	}
+
+	return secrets, nil
+}
+
+// GetTeamSecretVersions returns the versions of the secret from the newest, the error is ErrTeamSecretNotFound if the team has no secret with the name.
+func (db *DB) GetTeamSecretVersions(ctx context.Context, teamID uuid.UUID, name string) ([]*models.TeamSecretVersion, error) {
+	secret, err := db.
+		Client.
+		TeamSecret.
+		Query().
+		Where(teamsecret.TeamID(teamID), teamsecret.Name(name)).
+		Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, fmt.Errorf("secret '%s': %w", name, ErrTeamSecretNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to get team secret '%s': %w", name, err)
+	}
+
+	versions, err := db.
+		Client.
+		TeamSecretVersion.
+		Query().
+		Where(teamsecretversion.SecretID(secret.ID)).
+		Order(models.Desc(teamsecretversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of team secret '%s': %w", name, err)
+	}
+
+	return versions, nil
+}
+
+// GetTeamSecretValues returns the sealed values of the secrets of the team, the versions are by the names of the secrets,
+// the zero version is the latest one. The error is ErrTeamSecretNotFound if any of the secrets or their versions doesn't exist.
+func (db *DB) GetTeamSecretValues(ctx context.Context, teamID uuid.UUID, versions map[string]int32) ([]*TeamSecretValue, error) {
+	if len(versions) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(versions))
+	for name := range versions {
+		names = append(names, name)
+	}
+
+	secrets, err := db.
+		Client.
+		TeamSecret.
+		Query().
+		Where(teamsecret.TeamID(teamID), teamsecret.NameIn(names...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team secrets: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*models.TeamSecret, len(secrets))
+	resolved := make(map[uuid.UUID]int32, len(secrets))
+	predicates := make([]predicate.TeamSecretVersion, 0, len(secrets))
+
+	for _, secret := range secrets {
+		version := versions[secret.Name]
+		if version == 0 {
+			version = secret.LatestVersion
+		}
+
+		byID[secret.ID] = secret
+		resolved[secret.ID] = version
+		predicates = append(predicates, teamsecretversion.And(teamsecretversion.SecretID(secret.ID), teamsecretversion.Version(version)))
+	}
+
+	if len(secrets) != len(names) {
+		for _, name := range names {
+			if !slices.ContainsFunc(secrets, func(secret *models.TeamSecret) bool { return secret.Name == name }) {
+				return nil, fmt.Errorf("secret '%s': %w", name, ErrTeamSecretNotFound)
+			}
+		}
+	}
+
+	rows, err := db.
+		Client.
+		TeamSecretVersion.
+		Query().
+		Where(teamsecretversion.Or(predicates...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get versions of team secrets: %w", err)
+	}
+
+	if len(rows) != len(secrets) {
+		for id, secret := range byID {
+			if !slices.ContainsFunc(rows, func(row *models.TeamSecretVersion) bool { return row.SecretID == id }) {
+				return nil, fmt.Errorf("secret '%s' version %d: %w", secret.Name, resolved[id], ErrTeamSecretNotFound)
+			}
+		}
+	}
+
+	values := make([]*TeamSecretValue, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, &TeamSecretValue{
+			Name:    byID[row.SecretID].Name,
+			Version: row.Version,
+			Sealed: SealedSecret{
+				KeyID:        row.KeyID,
+				EncryptedKey: row.EncryptedKey,
+				Ciphertext:   row.Ciphertext,
+			},
+		})
+	}
+
+	return values, nil
+}
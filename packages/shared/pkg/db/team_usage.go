@@ -0,0 +1,337 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
+)
+
+// TeamUsage is the usage of the team in a day rolled up from the sandbox starts and the template builds.
+type TeamUsage struct {
+	SandboxesStarted int64
+	SandboxesResumed int64
+	ResumeP95Ms      *int64
+	Builds           int64
+	BuildSeconds     int64
+}
+
+// StoredBuild is the build of the team stored in the template bucket, the snapshots are counted with the template
+// the sandbox was started from.
+type StoredBuild struct {
+	TeamID   uuid.UUID
+	EnvID    string
+	BuildID  uuid.UUID
+	Snapshot bool
+}
+
+// RecordSandboxStart records the start or the resume of the sandbox with the time it took for the usage rollup.
+func (db *DB) RecordSandboxStart(ctx context.Context, teamID uuid.UUID, envID, sandboxID string, resumed bool, duration time.Duration) error {
+	err := db.
+		Client.
+		SandboxStart.
+		Create().
+		SetTeamID(teamID).
+		SetEnvID(envID).
+		SetSandboxID(sandboxID).
+		SetResumed(resumed).
+		SetDurationMs(duration.Milliseconds()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record start of sandbox '%s': %w", sandboxID, err)
+	}
+
+	return nil
+}
+
+// DeleteSandboxStarts deletes the recorded sandbox starts before the time, the days before it aren't rolled up anymore.
+func (db *DB) DeleteSandboxStarts(ctx context.Context, before time.Time) (int, error) {
+	deleted, err := db.
+		Client.
+		SandboxStart.
+		Delete().
+		Where(sandboxstart.CreatedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sandbox starts: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// GetTeamsUsage aggregates the usage of the teams in the day starting at the time from the sandbox starts and the template builds,
+// the builds of the snapshots of the paused sandboxes aren't counted.
+func (db *DB) GetTeamsUsage(ctx context.Context, day time.Time) (map[uuid.UUID]*TeamUsage, error) {
+	end := day.Add(24 * time.Hour)
+
+	var starts []struct {
+		TeamID  uuid.UUID `json:"team_id"`
+		Resumed bool      `json:"resumed"`
+		Count   int64     `json:"count"`
+		P95     float64   `json:"p95"`
+	}
+
+	err := db.
+		Client.
+		SandboxStart.
+		Query().
+		Where(sandboxstart.CreatedAtGTE(day), sandboxstart.CreatedAtLT(end)).
+		GroupBy(sandboxstart.FieldTeamID, sandboxstart.FieldResumed).
+		Aggregate(
+			models.Count(),
+			models.As(func(s *sql.Selector) string {
+				return fmt.Sprintf("percentile_cont(0.95) WITHIN GROUP (ORDER BY %s)", s.C(sandboxstart.FieldDurationMs))
+			}, "p95"),
+		).
+		Scan(ctx, &starts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate sandbox starts: %w", err)
+	}
+
+	var builds []struct {
+		EnvID   string  `json:"env_id"`
+		Count   int64   `json:"count"`
+		Seconds float64 `json:"seconds"`
+	}
+
+	err = db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.FinishedAtGTE(day),
+			envbuild.FinishedAtLT(end),
+			envbuild.HasEnvWith(env.Not(env.HasSnapshots())),
+		).
+		GroupBy(envbuild.FieldEnvID).
+		Aggregate(
+			models.Count(),
+			models.As(func(s *sql.Selector) string {
+				return fmt.Sprintf("SUM(EXTRACT(EPOCH FROM %s - %s))", s.C(envbuild.FieldFinishedAt), s.C(envbuild.FieldCreatedAt))
+			}, "seconds"),
+		).
+		Scan(ctx, &builds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate template builds: %w", err)
+	}
+
+	usage := make(map[uuid.UUID]*TeamUsage)
+	teamUsage := func(teamID uuid.UUID) *TeamUsage {
+		if _, ok := usage[teamID]; !ok {
+			usage[teamID] = &TeamUsage{}
+		}
+
+		return usage[teamID]
+	}
+
+	for _, start := range starts {
+		u := teamUsage(start.TeamID)
+
+		if !start.Resumed {
+			u.SandboxesStarted = start.Count
+
+			continue
+		}
+
+		p95 := int64(math.Round(start.P95))
+
+		u.SandboxesResumed = start.Count
+		u.ResumeP95Ms = &p95
+	}
+
+	if len(builds) == 0 {
+		return usage, nil
+	}
+
+	envIDs := make([]string, len(builds))
+	for i, build := range builds {
+		envIDs[i] = build.EnvID
+	}
+
+	envs, err := db.
+		Client.
+		Env.
+		Query().
+		Where(env.IDIn(envIDs...)).
+		Select(env.FieldID, env.FieldTeamID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams of the built templates: %w", err)
+	}
+
+	teams := make(map[string]uuid.UUID, len(envs))
+	for _, e := range envs {
+		teams[e.ID] = e.TeamID
+	}
+
+	for _, build := range builds {
+		teamID, ok := teams[build.EnvID]
+		if !ok {
+			continue
+		}
+
+		u := teamUsage(teamID)
+		u.Builds += build.Count
+		u.BuildSeconds += int64(math.Round(build.Seconds))
+	}
+
+	return usage, nil
+}
+
+// SetTeamUsage stores the usage of the team in the day starting at the time, the previously rolled up usage is replaced.
+func (db *DB) SetTeamUsage(ctx context.Context, teamID uuid.UUID, day time.Time, usage *TeamUsage) error {
+	err := db.
+		Client.
+		TeamUsageDaily.
+		Create().
+		SetTeamID(teamID).
+		SetDay(day).
+		SetSandboxesStarted(usage.SandboxesStarted).
+		SetSandboxesResumed(usage.SandboxesResumed).
+		SetNillableResumeP95Ms(usage.ResumeP95Ms).
+		SetBuilds(usage.Builds).
+		SetBuildSeconds(usage.BuildSeconds).
+		OnConflictColumns(teamusagedaily.FieldTeamID, teamusagedaily.FieldDay).
+		Update(func(u *models.TeamUsageDailyUpsert) {
+			u.UpdateSandboxesStarted()
+			u.UpdateSandboxesResumed()
+			u.UpdateResumeP95Ms()
+			u.UpdateBuilds()
+			u.UpdateBuildSeconds()
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set usage of team '%s': %w", teamID, err)
+	}
+
+	return nil
+}
+
+// GetTeamUsage returns the rolled up daily usage of the team in the days starting between the times, the oldest day first.
+func (db *DB) GetTeamUsage(ctx context.Context, teamID uuid.UUID, start, end time.Time) ([]*models.TeamUsageDaily, error) {
+	usage, err := db.
+		Client.
+		TeamUsageDaily.
+		Query().
+		Where(
+			teamusagedaily.TeamID(teamID),
+			teamusagedaily.DayGTE(start),
+			teamusagedaily.DayLTE(end),
+		).
+		Order(models.Asc(teamusagedaily.FieldDay)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage of team '%s': %w", teamID, err)
+	}
+
+	return usage, nil
+}
+
+// GetStoredBuilds returns the builds of all teams stored in the template bucket.
+func (db *DB) GetStoredBuilds(ctx context.Context) ([]StoredBuild, error) {
+	builds, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(envbuild.StatusEQ(envbuild.StatusUploaded), envbuild.EnvIDNotNil()).
+		WithEnv(func(query *models.EnvQuery) {
+			query.WithSnapshots()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stored builds: %w", err)
+	}
+
+	stored := make([]StoredBuild, 0, len(builds))
+	for _, build := range builds {
+		e := build.Edges.Env
+		if e == nil {
+			continue
+		}
+
+		s := StoredBuild{
+			TeamID:  e.TeamID,
+			EnvID:   e.ID,
+			BuildID: build.ID,
+		}
+
+		if len(e.Edges.Snapshots) > 0 {
+			s.EnvID = e.Edges.Snapshots[0].BaseEnvID
+			s.Snapshot = true
+		}
+
+		stored = append(stored, s)
+	}
+
+	return stored, nil
+}
+
+// SetTeamTemplateStorage stores the measured storage of the team's template, the previously measured storage is replaced.
+func (db *DB) SetTeamTemplateStorage(ctx context.Context, teamID uuid.UUID, envID string, templateBytes, snapshotBytes int64) error {
+	err := db.
+		Client.
+		TeamTemplateStorage.
+		Create().
+		SetTeamID(teamID).
+		SetEnvID(envID).
+		SetTemplateBytes(templateBytes).
+		SetSnapshotBytes(snapshotBytes).
+		OnConflictColumns(teamtemplatestorage.FieldEnvID).
+		Update(func(u *models.TeamTemplateStorageUpsert) {
+			u.UpdateTeamID()
+			u.UpdateTemplateBytes()
+			u.UpdateSnapshotBytes()
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set storage of env '%s': %w", envID, err)
+	}
+
+	return nil
+}
+
+// DeleteStaleTeamTemplateStorage deletes the storage of the templates that weren't measured since the time,
+// the templates have no stored builds anymore.
+func (db *DB) DeleteStaleTeamTemplateStorage(ctx context.Context, before time.Time) error {
+	_, err := db.
+		Client.
+		TeamTemplateStorage.
+		Delete().
+		Where(teamtemplatestorage.UpdatedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete stale template storage: %w", err)
+	}
+
+	return nil
+}
+
+// GetTeamTemplateStorage returns the measured storage of the team's templates, the largest first.
+func (db *DB) GetTeamTemplateStorage(ctx context.Context, teamID uuid.UUID) ([]*models.TeamTemplateStorage, error) {
+	storage, err := db.
+		Client.
+		TeamTemplateStorage.
+		Query().
+		Where(teamtemplatestorage.TeamID(teamID)).
+		Order(func(s *sql.Selector) {
+			s.OrderExpr(sql.Expr(fmt.Sprintf("%s + %s DESC", s.C(teamtemplatestorage.FieldTemplateBytes), s.C(teamtemplatestorage.FieldSnapshotBytes))))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template storage of team '%s': %w", teamID, err)
+	}
+
+	return storage, nil
+}
@@ -0,0 +1,155 @@
+package diagnostics
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+const (
+	// AdminTokenHeader is the header with the admin token required by all diagnostics endpoints.
+	AdminTokenHeader = "X-Admin-Token"
+
+	readHeaderTimeout = 5 * time.Second
+)
+
+// SandboxesFunc returns the sandboxes the service knows about, the result is encoded as JSON.
+type SandboxesFunc func(ctx context.Context) any
+
+// Endpoint is an additional diagnostics endpoint of the service, the result of the handler is encoded as JSON.
+type Endpoint struct {
+	Path    string
+	Handler func(ctx context.Context) any
+}
+
+type gcStats struct {
+	NumGC          uint32        `json:"numGC"`
+	LastGC         time.Time     `json:"lastGC"`
+	PauseTotal     time.Duration `json:"pauseTotal"`
+	HeapAlloc      uint64        `json:"heapAlloc"`
+	HeapSys        uint64        `json:"heapSys"`
+	HeapObjects    uint64        `json:"heapObjects"`
+	NextGC         uint64        `json:"nextGC"`
+	NumGoroutine   int           `json:"numGoroutine"`
+	GOMAXPROCS     int           `json:"gomaxprocs"`
+	GCCPUFraction  float64       `json:"gcCPUFraction"`
+	TotalAllocated uint64        `json:"totalAllocated"`
+}
+
+func writeJSON(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+
+	err := json.NewEncoder(w).Encode(value)
+	if err != nil {
+		log.Printf("failed to write diagnostics response: %v", err)
+	}
+}
+
+func withAdminToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(AdminTokenHeader)), []byte(token)) != 1 {
+			http.Error(w, "invalid admin token", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// NewServer creates the internal diagnostics server with pprof and runtime endpoints.
+// Returns nil when the admin token is empty, the diagnostics are not exposed without it.
+func NewServer(port int, adminToken string, sandboxes SandboxesFunc, endpoints ...Endpoint) *http.Server {
+	if adminToken == "" {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	mux.HandleFunc("/debug/goroutines", func(w http.ResponseWriter, r *http.Request) {
+		r.URL.RawQuery = "debug=2"
+
+		pprof.Handler("goroutine").ServeHTTP(w, r)
+	})
+
+	mux.HandleFunc("/debug/gc", func(w http.ResponseWriter, r *http.Request) {
+		var memStats runtime.MemStats
+		runtime.ReadMemStats(&memStats)
+
+		var stats debug.GCStats
+		debug.ReadGCStats(&stats)
+
+		writeJSON(w, gcStats{
+			NumGC:          memStats.NumGC,
+			LastGC:         stats.LastGC,
+			PauseTotal:     stats.PauseTotal,
+			HeapAlloc:      memStats.HeapAlloc,
+			HeapSys:        memStats.HeapSys,
+			HeapObjects:    memStats.HeapObjects,
+			NextGC:         memStats.NextGC,
+			NumGoroutine:   runtime.NumGoroutine(),
+			GOMAXPROCS:     runtime.GOMAXPROCS(0),
+			GCCPUFraction:  memStats.GCCPUFraction,
+			TotalAllocated: memStats.TotalAlloc,
+		})
+	})
+
+	mux.HandleFunc("/debug/buildinfo", func(w http.ResponseWriter, r *http.Request) {
+		info, ok := debug.ReadBuildInfo()
+		if !ok {
+			http.Error(w, "build info is not available", http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, info.String())
+	})
+
+	if sandboxes != nil {
+		mux.HandleFunc("/debug/sandboxes", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, sandboxes(r.Context()))
+		})
+	}
+
+	for _, endpoint := range endpoints {
+		mux.HandleFunc(endpoint.Path, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, endpoint.Handler(r.Context()))
+		})
+	}
+
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           withAdminToken(adminToken, mux),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+}
+
+// Start starts the diagnostics server in the background, the server can be nil.
+func Start(s *http.Server) {
+	if s == nil {
+		return
+	}
+
+	go func() {
+		log.Printf("diagnostics server (%s) starting", s.Addr)
+
+		err := s.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Printf("diagnostics server error: %v", err)
+		}
+	}()
+}
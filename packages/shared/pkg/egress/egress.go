@@ -0,0 +1,117 @@
+// Package egress resolves the egress presets of the templates and the sandboxes into the policies enforced by the orchestrator.
+package egress
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+const (
+	PresetFull            = "full"
+	PresetNone            = "none"
+	PresetPackageManagers = "package-managers-only"
+
+	maxAllowedHosts = 64
+)
+
+// Preset is the named egress policy, the teams attach it instead of writing the allowed hosts.
+type Preset struct {
+	Name        string
+	Description string
+	// AllowAll allows all the outbound traffic, the hosts are ignored.
+	AllowAll     bool
+	AllowedHosts []string
+}
+
+// packageManagerHosts serve the packages of the common package managers, the mirrors and the private registries aren't included.
+var packageManagerHosts = []string{
+	// pip
+	"pypi.org",
+	"files.pythonhosted.org",
+	// npm, yarn and pnpm
+	"registry.npmjs.org",
+	"registry.yarnpkg.com",
+	// apt
+	"deb.debian.org",
+	"security.debian.org",
+	"archive.ubuntu.com",
+	"security.ubuntu.com",
+	// go
+	"proxy.golang.org",
+	"sum.golang.org",
+	// cargo
+	"index.crates.io",
+	"static.crates.io",
+	// gem
+	"rubygems.org",
+	"index.rubygems.org",
+}
+
+var presets = []Preset{
+	{
+		Name:        PresetFull,
+		Description: "All outbound traffic is allowed",
+		AllowAll:    true,
+	},
+	{
+		Name:        PresetNone,
+		Description: "No outbound traffic is allowed except DNS",
+	},
+	{
+		Name:         PresetPackageManagers,
+		Description:  "Only the registries of pip, npm, apt, go, cargo and gem are reachable",
+		AllowedHosts: packageManagerHosts,
+	},
+}
+
+var hostnameRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// Presets returns the available presets.
+func Presets() []Preset {
+	return slices.Clone(presets)
+}
+
+// Resolve returns the policy of the preset extended by the allowed hosts.
+func Resolve(preset string, allowedHosts []string) (*schema.EgressPolicy, error) {
+	i := slices.IndexFunc(presets, func(p Preset) bool { return p.Name == preset })
+	if i < 0 {
+		names := make([]string, 0, len(presets))
+		for _, p := range presets {
+			names = append(names, p.Name)
+		}
+
+		return nil, fmt.Errorf("unknown egress preset '%s', the available presets are: %s", preset, strings.Join(names, ", "))
+	}
+
+	policy := &schema.EgressPolicy{
+		Preset:       preset,
+		AllowAll:     presets[i].AllowAll,
+		AllowedHosts: slices.Clone(presets[i].AllowedHosts),
+	}
+
+	if len(allowedHosts) > maxAllowedHosts {
+		return nil, fmt.Errorf("too many allowed hosts (%d), the maximum is %d", len(allowedHosts), maxAllowedHosts)
+	}
+
+	for _, host := range allowedHosts {
+		host = strings.ToLower(strings.TrimSuffix(host, "."))
+		if !hostnameRegex.MatchString(host) {
+			return nil, fmt.Errorf("allowed host '%s' is not a valid hostname", host)
+		}
+
+		policy.AllowedHosts = append(policy.AllowedHosts, host)
+	}
+
+	if policy.AllowAll {
+		policy.AllowedHosts = nil
+	}
+
+	slices.Sort(policy.AllowedHosts)
+	policy.AllowedHosts = slices.Compact(policy.AllowedHosts)
+
+	return policy, nil
+}
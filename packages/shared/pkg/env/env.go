@@ -1,8 +1,17 @@
 package env
 
-import "os"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
 
-var environment = GetEnv("ENVIRONMENT", "local")
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+)
+
+var (
+	environment    = GetEnv("ENVIRONMENT", "local")
+	deploymentMode = GetEnv("DEPLOYMENT_MODE", "nomad")
+)
 
 func IsProduction() bool {
 	return environment == "prod"
@@ -12,6 +21,20 @@ func IsLocal() bool {
 	return environment == "local"
 }
 
+// IsKubernetes returns true if the services are deployed to Kubernetes, the orchestrators register with the API then
+// instead of being discovered via Nomad.
+func IsKubernetes() bool {
+	return deploymentMode == "kubernetes"
+}
+
+// KubernetesNodeID returns the short ID of the Kubernetes node, the names of the nodes in the pool often share the prefix,
+// so the name itself can't be shortened. The orchestrator and the API must derive the same ID from the name.
+func KubernetesNodeID(nodeName string) string {
+	hash := sha256.Sum256([]byte(nodeName))
+
+	return hex.EncodeToString(hash[:])[:consts.NodeIDLength]
+}
+
 func GetEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
 	if len(value) == 0 {
@@ -0,0 +1,93 @@
+// Package errcode defines the error codes shared by the services, so the clients can react to the errors programmatically.
+package errcode
+
+import (
+	"errors"
+	"net/http"
+)
+
+type Code string
+
+const (
+	BadRequest   Code = "E2B_BAD_REQUEST"
+	Validation   Code = "E2B_VALIDATION"
+	Unauthorized Code = "E2B_UNAUTHORIZED"
+	Forbidden    Code = "E2B_FORBIDDEN"
+
+	NotFound           Code = "E2B_NOT_FOUND"
+	SandboxNotFound    Code = "E2B_SANDBOX_NOT_FOUND"
+	TemplateNotFound   Code = "E2B_TEMPLATE_NOT_FOUND"
+	BuildNotFound      Code = "E2B_BUILD_NOT_FOUND"
+	TeamNotFound       Code = "E2B_TEAM_NOT_FOUND"
+	CheckpointNotFound Code = "E2B_CHECKPOINT_NOT_FOUND"
+
+	Conflict                 Code = "E2B_CONFLICT"
+	SandboxAlreadyRunning    Code = "E2B_SANDBOX_ALREADY_RUNNING"
+	HookFailed               Code = "E2B_HOOK_FAILED"
+	IdempotencyKeyInProgress Code = "E2B_IDEMPOTENCY_KEY_IN_PROGRESS"
+	IdempotencyKeyReused     Code = "E2B_IDEMPOTENCY_KEY_REUSED"
+	PreconditionFailed       Code = "E2B_PRECONDITION_FAILED"
+
+	RateLimited    Code = "E2B_RATE_LIMITED"
+	NodeCapacity   Code = "E2B_NODE_CAPACITY"
+	BudgetExceeded Code = "E2B_BUDGET_EXCEEDED"
+
+	Unavailable Code = "E2B_UNAVAILABLE"
+	Internal    Code = "E2B_INTERNAL"
+)
+
+// FromHTTPStatus returns the generic code for the HTTP status.
+func FromHTTPStatus(status int) Code {
+	switch status {
+	case http.StatusBadRequest:
+		return BadRequest
+	case http.StatusUnauthorized:
+		return Unauthorized
+	case http.StatusForbidden:
+		return Forbidden
+	case http.StatusNotFound:
+		return NotFound
+	case http.StatusConflict:
+		return Conflict
+	case http.StatusPreconditionFailed:
+		return PreconditionFailed
+	case http.StatusTooManyRequests:
+		return RateLimited
+	case http.StatusServiceUnavailable:
+		return Unavailable
+	default:
+		return Internal
+	}
+}
+
+// Error attaches the code to the error.
+type Error struct {
+	Code Code
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func Wrap(code Code, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &Error{Code: code, Err: err}
+}
+
+// Of returns the code attached to the error or any error it wraps, including the gRPC status errors.
+func Of(err error) (Code, bool) {
+	var codeErr *Error
+	if errors.As(err, &codeErr) {
+		return codeErr.Code, true
+	}
+
+	return fromGRPCError(err)
+}
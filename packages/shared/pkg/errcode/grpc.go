@@ -0,0 +1,106 @@
+package errcode
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const domain = "e2b.dev"
+
+// GRPCError returns the gRPC status error with the code attached as the error info detail.
+func GRPCError(grpcCode codes.Code, code Code, message string) error {
+	st := status.New(grpcCode, message)
+
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(code),
+		Domain: domain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// FromGRPCCode returns the generic code for the gRPC status code.
+func FromGRPCCode(grpcCode codes.Code) Code {
+	switch grpcCode {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return BadRequest
+	case codes.FailedPrecondition:
+		return Conflict
+	case codes.Unauthenticated:
+		return Unauthorized
+	case codes.PermissionDenied:
+		return Forbidden
+	case codes.NotFound:
+		return NotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return Conflict
+	case codes.ResourceExhausted:
+		return NodeCapacity
+	case codes.Unavailable:
+		return Unavailable
+	default:
+		return Internal
+	}
+}
+
+func fromGRPCError(err error) (Code, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK {
+		return "", false
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == domain {
+			return Code(info.Reason), true
+		}
+	}
+
+	return FromGRPCCode(st.Code()), true
+}
+
+// withCode attaches the generic code to the gRPC status errors that don't have any code yet.
+func withCode(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK {
+		return err
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == domain {
+			return err
+		}
+	}
+
+	return GRPCError(st.Code(), FromGRPCCode(st.Code()), st.Message())
+}
+
+// UnaryServerInterceptor attaches the generic code to the returned errors that don't have any code.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		res, err := handler(ctx, req)
+		if err != nil {
+			return res, withCode(err)
+		}
+
+		return res, nil
+	}
+}
+
+// StreamServerInterceptor attaches the generic code to the returned errors that don't have any code.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil {
+			return withCode(err)
+		}
+
+		return nil
+	}
+}
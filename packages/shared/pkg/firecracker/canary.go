@@ -0,0 +1,75 @@
+// Package firecracker selects the firecracker version of the new template builds, so the new versions can be rolled out gradually.
+package firecracker
+
+import (
+	"hash/fnv"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+// Canary is the firecracker version rolled out to a subset of the templates.
+// It is disabled if the FIRECRACKER_CANARY_VERSION env var is not set.
+type Canary struct {
+	Version string
+	// Percentage of the templates (0-100) built with the canary version.
+	Percentage uint32
+	// Templates always built with the canary version, regardless of the percentage.
+	Templates []string
+}
+
+// CanaryFromEnv reads the canary from the FIRECRACKER_CANARY_VERSION, FIRECRACKER_CANARY_PERCENTAGE
+// and FIRECRACKER_CANARY_TEMPLATES (comma-separated) env vars.
+func CanaryFromEnv() Canary {
+	canary := Canary{
+		Version: strings.TrimSpace(os.Getenv("FIRECRACKER_CANARY_VERSION")),
+	}
+
+	if percentage, err := strconv.ParseUint(os.Getenv("FIRECRACKER_CANARY_PERCENTAGE"), 10, 32); err == nil {
+		canary.Percentage = min(uint32(percentage), 100)
+	}
+
+	for _, templateID := range strings.Split(os.Getenv("FIRECRACKER_CANARY_TEMPLATES"), ",") {
+		templateID = strings.TrimSpace(templateID)
+		if templateID != "" {
+			canary.Templates = append(canary.Templates, templateID)
+		}
+	}
+
+	return canary
+}
+
+// Includes returns whether the template is built with the canary version.
+// The templates are assigned to the canary by the hash of their ID, so the same templates stay on the canary when the percentage is increased.
+func (c Canary) Includes(templateID string) bool {
+	if c.Version == "" || c.Version == schema.DefaultFirecrackerVersion {
+		return false
+	}
+
+	if slices.Contains(c.Templates, templateID) {
+		return true
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(templateID))
+
+	return hash.Sum32()%100 < c.Percentage
+}
+
+// SelectVersion returns the firecracker version the template should be built with and whether it is the canary version.
+func SelectVersion(templateID string) (string, bool) {
+	canary := CanaryFromEnv()
+	if canary.Includes(templateID) {
+		return canary.Version, true
+	}
+
+	return schema.DefaultFirecrackerVersion, false
+}
+
+// IsCanary returns whether the version is not the stable version, the builds with such version can fall back to the stable version.
+func IsCanary(version string) bool {
+	return version != schema.DefaultFirecrackerVersion
+}
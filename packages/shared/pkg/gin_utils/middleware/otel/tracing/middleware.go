@@ -26,6 +26,8 @@ import (
 	"go.opentelemetry.io/otel/propagation"
 	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
 	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/requestid"
 )
 
 const (
@@ -73,6 +75,8 @@ func Middleware(service string) gin.HandlerFunc {
 		ctx, span := tracer.Start(ctx, spanName, opts...)
 		defer span.End()
 
+		requestid.SetSpanAttribute(ctx)
+
 		// pass the span through the request context
 		c.Request = c.Request.WithContext(ctx)
 
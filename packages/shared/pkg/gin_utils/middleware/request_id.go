@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/requestid"
+)
+
+const (
+	RequestIDHeader = requestid.Header
+	// RequestIDContextKey is the gin context key of the request ID.
+	RequestIDContextKey = "requestID"
+)
+
+// RequestID reuses the request ID sent by the client or generates a new one and returns it in the response header.
+// The request ID is also added to the request context, so it is propagated to the downstream services.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		c.Set(RequestIDContextKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+
+		c.Request = c.Request.WithContext(requestid.WithRequestID(c.Request.Context(), requestID))
+
+		c.Next()
+	}
+}
+
+// GetRequestID returns the request ID set by the RequestID middleware.
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(RequestIDContextKey)
+}
+
+// LogFormatter is the default gin log format with the request ID field.
+func LogFormatter(param gin.LogFormatterParams) string {
+	if param.Latency > time.Minute {
+		param.Latency = param.Latency.Truncate(time.Second)
+	}
+
+	requestID, _ := param.Keys[RequestIDContextKey].(string)
+
+	return fmt.Sprintf("[GIN] %v | %3d | %13v | %15s | %-7s %#v | %s=%s\n%s",
+		param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+		param.StatusCode,
+		param.Latency,
+		param.ClientIP,
+		param.Method,
+		param.Path,
+		requestid.LogField,
+		requestID,
+		param.ErrorMessage,
+	)
+}
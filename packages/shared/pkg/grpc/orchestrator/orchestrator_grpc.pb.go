@@ -25,10 +25,26 @@ const _ = grpc.SupportPackageIsVersion7
 type SandboxServiceClient interface {
 	Create(ctx context.Context, in *SandboxCreateRequest, opts ...grpc.CallOption) (*SandboxCreateResponse, error)
 	Update(ctx context.Context, in *SandboxUpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListResponse, error)
+	List(ctx context.Context, in *SandboxListRequest, opts ...grpc.CallOption) (*SandboxListResponse, error)
 	Delete(ctx context.Context, in *SandboxDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Pause returns once the snapshot is in the node cache, the snapshot is uploaded to the storage in the background.
 	Pause(ctx context.Context, in *SandboxPauseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SnapshotUploads(ctx context.Context, in *SandboxSnapshotUploadsRequest, opts ...grpc.CallOption) (*SandboxSnapshotUploadsResponse, error)
 	ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error)
+	SetPinnedBuilds(ctx context.Context, in *SandboxSetPinnedBuildsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Prefetch returns once the build is fetched to the node cache, the sandboxes of the build don't wait for the storage then.
+	Prefetch(ctx context.Context, in *SandboxPrefetchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Checkpoint(ctx context.Context, in *SandboxCheckpointRequest, opts ...grpc.CallOption) (*SandboxCheckpointResponse, error)
+	ChangedFiles(ctx context.Context, in *SandboxChangedFilesRequest, opts ...grpc.CallOption) (*SandboxChangedFilesResponse, error)
+	Diagnostics(ctx context.Context, in *SandboxDiagnosticsRequest, opts ...grpc.CallOption) (*SandboxDiagnosticsResponse, error)
+	Console(ctx context.Context, opts ...grpc.CallOption) (SandboxService_ConsoleClient, error)
+	// NetworkActivity returns the summary of the connections the sandbox made by the destination.
+	NetworkActivity(ctx context.Context, in *SandboxNetworkActivityRequest, opts ...grpc.CallOption) (*SandboxNetworkActivityResponse, error)
+	// SaveArtifact archives the path in the sandbox and stores it in the artifacts of the team, it returns once the archive is stored.
+	SaveArtifact(ctx context.Context, in *SandboxSaveArtifactRequest, opts ...grpc.CallOption) (*SandboxSaveArtifactResponse, error)
+	// Watch streams the changes of the sandboxes on the node. The stream is closed if the client can't keep up,
+	// the client should list the sandboxes again after reconnecting.
+	Watch(ctx context.Context, in *SandboxWatchRequest, opts ...grpc.CallOption) (SandboxService_WatchClient, error)
 }
 
 type sandboxServiceClient struct {
@@ -57,7 +73,7 @@ func (c *sandboxServiceClient) Update(ctx context.Context, in *SandboxUpdateRequ
 	return out, nil
 }
 
-func (c *sandboxServiceClient) List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListResponse, error) {
+func (c *sandboxServiceClient) List(ctx context.Context, in *SandboxListRequest, opts ...grpc.CallOption) (*SandboxListResponse, error) {
 	out := new(SandboxListResponse)
 	err := c.cc.Invoke(ctx, "/SandboxService/List", in, out, opts...)
 	if err != nil {
@@ -84,6 +100,15 @@ func (c *sandboxServiceClient) Pause(ctx context.Context, in *SandboxPauseReques
 	return out, nil
 }
 
+func (c *sandboxServiceClient) SnapshotUploads(ctx context.Context, in *SandboxSnapshotUploadsRequest, opts ...grpc.CallOption) (*SandboxSnapshotUploadsResponse, error) {
+	out := new(SandboxSnapshotUploadsResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/SnapshotUploads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandboxServiceClient) ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error) {
 	out := new(SandboxListCachedBuildsResponse)
 	err := c.cc.Invoke(ctx, "/SandboxService/ListCachedBuilds", in, out, opts...)
@@ -93,16 +118,158 @@ func (c *sandboxServiceClient) ListCachedBuilds(ctx context.Context, in *emptypb
 	return out, nil
 }
 
+func (c *sandboxServiceClient) SetPinnedBuilds(ctx context.Context, in *SandboxSetPinnedBuildsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/SetPinnedBuilds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Prefetch(ctx context.Context, in *SandboxPrefetchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/Prefetch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Checkpoint(ctx context.Context, in *SandboxCheckpointRequest, opts ...grpc.CallOption) (*SandboxCheckpointResponse, error) {
+	out := new(SandboxCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) ChangedFiles(ctx context.Context, in *SandboxChangedFilesRequest, opts ...grpc.CallOption) (*SandboxChangedFilesResponse, error) {
+	out := new(SandboxChangedFilesResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/ChangedFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Diagnostics(ctx context.Context, in *SandboxDiagnosticsRequest, opts ...grpc.CallOption) (*SandboxDiagnosticsResponse, error) {
+	out := new(SandboxDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Diagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Console(ctx context.Context, opts ...grpc.CallOption) (SandboxService_ConsoleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SandboxService_ServiceDesc.Streams[0], "/SandboxService/Console", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sandboxServiceConsoleClient{stream}
+	return x, nil
+}
+
+type SandboxService_ConsoleClient interface {
+	Send(*SandboxConsoleRequest) error
+	Recv() (*SandboxConsoleResponse, error)
+	grpc.ClientStream
+}
+
+type sandboxServiceConsoleClient struct {
+	grpc.ClientStream
+}
+
+func (x *sandboxServiceConsoleClient) Send(m *SandboxConsoleRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sandboxServiceConsoleClient) Recv() (*SandboxConsoleResponse, error) {
+	m := new(SandboxConsoleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sandboxServiceClient) NetworkActivity(ctx context.Context, in *SandboxNetworkActivityRequest, opts ...grpc.CallOption) (*SandboxNetworkActivityResponse, error) {
+	out := new(SandboxNetworkActivityResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/NetworkActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) SaveArtifact(ctx context.Context, in *SandboxSaveArtifactRequest, opts ...grpc.CallOption) (*SandboxSaveArtifactResponse, error) {
+	out := new(SandboxSaveArtifactResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/SaveArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Watch(ctx context.Context, in *SandboxWatchRequest, opts ...grpc.CallOption) (SandboxService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SandboxService_ServiceDesc.Streams[1], "/SandboxService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sandboxServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SandboxService_WatchClient interface {
+	Recv() (*SandboxEvent, error)
+	grpc.ClientStream
+}
+
+type sandboxServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *sandboxServiceWatchClient) Recv() (*SandboxEvent, error) {
+	m := new(SandboxEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SandboxServiceServer is the server API for SandboxService service.
 // All implementations must embed UnimplementedSandboxServiceServer
 // for forward compatibility
 type SandboxServiceServer interface {
 	Create(context.Context, *SandboxCreateRequest) (*SandboxCreateResponse, error)
 	Update(context.Context, *SandboxUpdateRequest) (*emptypb.Empty, error)
-	List(context.Context, *emptypb.Empty) (*SandboxListResponse, error)
+	List(context.Context, *SandboxListRequest) (*SandboxListResponse, error)
 	Delete(context.Context, *SandboxDeleteRequest) (*emptypb.Empty, error)
+	// Pause returns once the snapshot is in the node cache, the snapshot is uploaded to the storage in the background.
 	Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error)
+	SnapshotUploads(context.Context, *SandboxSnapshotUploadsRequest) (*SandboxSnapshotUploadsResponse, error)
 	ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error)
+	SetPinnedBuilds(context.Context, *SandboxSetPinnedBuildsRequest) (*emptypb.Empty, error)
+	// Prefetch returns once the build is fetched to the node cache, the sandboxes of the build don't wait for the storage then.
+	Prefetch(context.Context, *SandboxPrefetchRequest) (*emptypb.Empty, error)
+	Checkpoint(context.Context, *SandboxCheckpointRequest) (*SandboxCheckpointResponse, error)
+	ChangedFiles(context.Context, *SandboxChangedFilesRequest) (*SandboxChangedFilesResponse, error)
+	Diagnostics(context.Context, *SandboxDiagnosticsRequest) (*SandboxDiagnosticsResponse, error)
+	Console(SandboxService_ConsoleServer) error
+	// NetworkActivity returns the summary of the connections the sandbox made by the destination.
+	NetworkActivity(context.Context, *SandboxNetworkActivityRequest) (*SandboxNetworkActivityResponse, error)
+	// SaveArtifact archives the path in the sandbox and stores it in the artifacts of the team, it returns once the archive is stored.
+	SaveArtifact(context.Context, *SandboxSaveArtifactRequest) (*SandboxSaveArtifactResponse, error)
+	// Watch streams the changes of the sandboxes on the node. The stream is closed if the client can't keep up,
+	// the client should list the sandboxes again after reconnecting.
+	Watch(*SandboxWatchRequest, SandboxService_WatchServer) error
 	mustEmbedUnimplementedSandboxServiceServer()
 }
 
@@ -116,7 +283,7 @@ func (UnimplementedSandboxServiceServer) Create(context.Context, *SandboxCreateR
 func (UnimplementedSandboxServiceServer) Update(context.Context, *SandboxUpdateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedSandboxServiceServer) List(context.Context, *emptypb.Empty) (*SandboxListResponse, error) {
+func (UnimplementedSandboxServiceServer) List(context.Context, *SandboxListRequest) (*SandboxListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
 func (UnimplementedSandboxServiceServer) Delete(context.Context, *SandboxDeleteRequest) (*emptypb.Empty, error) {
@@ -125,9 +292,39 @@ func (UnimplementedSandboxServiceServer) Delete(context.Context, *SandboxDeleteR
 func (UnimplementedSandboxServiceServer) Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
 }
+func (UnimplementedSandboxServiceServer) SnapshotUploads(context.Context, *SandboxSnapshotUploadsRequest) (*SandboxSnapshotUploadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotUploads not implemented")
+}
 func (UnimplementedSandboxServiceServer) ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCachedBuilds not implemented")
 }
+func (UnimplementedSandboxServiceServer) SetPinnedBuilds(context.Context, *SandboxSetPinnedBuildsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPinnedBuilds not implemented")
+}
+func (UnimplementedSandboxServiceServer) Prefetch(context.Context, *SandboxPrefetchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prefetch not implemented")
+}
+func (UnimplementedSandboxServiceServer) Checkpoint(context.Context, *SandboxCheckpointRequest) (*SandboxCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (UnimplementedSandboxServiceServer) ChangedFiles(context.Context, *SandboxChangedFilesRequest) (*SandboxChangedFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangedFiles not implemented")
+}
+func (UnimplementedSandboxServiceServer) Diagnostics(context.Context, *SandboxDiagnosticsRequest) (*SandboxDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diagnostics not implemented")
+}
+func (UnimplementedSandboxServiceServer) Console(SandboxService_ConsoleServer) error {
+	return status.Errorf(codes.Unimplemented, "method Console not implemented")
+}
+func (UnimplementedSandboxServiceServer) NetworkActivity(context.Context, *SandboxNetworkActivityRequest) (*SandboxNetworkActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkActivity not implemented")
+}
+func (UnimplementedSandboxServiceServer) SaveArtifact(context.Context, *SandboxSaveArtifactRequest) (*SandboxSaveArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveArtifact not implemented")
+}
+func (UnimplementedSandboxServiceServer) Watch(*SandboxWatchRequest, SandboxService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedSandboxServiceServer) mustEmbedUnimplementedSandboxServiceServer() {}
 
 // UnsafeSandboxServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -178,7 +375,7 @@ func _SandboxService_Update_Handler(srv interface{}, ctx context.Context, dec fu
 }
 
 func _SandboxService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(SandboxListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -190,7 +387,7 @@ func _SandboxService_List_Handler(srv interface{}, ctx context.Context, dec func
 		FullMethod: "/SandboxService/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SandboxServiceServer).List(ctx, req.(*emptypb.Empty))
+		return srv.(SandboxServiceServer).List(ctx, req.(*SandboxListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -231,6 +428,24 @@ func _SandboxService_Pause_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_SnapshotUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxSnapshotUploadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).SnapshotUploads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/SnapshotUploads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).SnapshotUploads(ctx, req.(*SandboxSnapshotUploadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SandboxService_ListCachedBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -249,6 +464,179 @@ func _SandboxService_ListCachedBuilds_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_SetPinnedBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxSetPinnedBuildsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).SetPinnedBuilds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/SetPinnedBuilds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).SetPinnedBuilds(ctx, req.(*SandboxSetPinnedBuildsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Prefetch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxPrefetchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Prefetch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Prefetch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Prefetch(ctx, req.(*SandboxPrefetchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Checkpoint(ctx, req.(*SandboxCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_ChangedFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxChangedFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).ChangedFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/ChangedFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).ChangedFiles(ctx, req.(*SandboxChangedFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Diagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Diagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Diagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Diagnostics(ctx, req.(*SandboxDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Console_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SandboxServiceServer).Console(&sandboxServiceConsoleServer{stream})
+}
+
+type SandboxService_ConsoleServer interface {
+	Send(*SandboxConsoleResponse) error
+	Recv() (*SandboxConsoleRequest, error)
+	grpc.ServerStream
+}
+
+type sandboxServiceConsoleServer struct {
+	grpc.ServerStream
+}
+
+func (x *sandboxServiceConsoleServer) Send(m *SandboxConsoleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sandboxServiceConsoleServer) Recv() (*SandboxConsoleRequest, error) {
+	m := new(SandboxConsoleRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SandboxService_NetworkActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxNetworkActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).NetworkActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/NetworkActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).NetworkActivity(ctx, req.(*SandboxNetworkActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_SaveArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxSaveArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).SaveArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/SaveArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).SaveArtifact(ctx, req.(*SandboxSaveArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SandboxWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SandboxServiceServer).Watch(m, &sandboxServiceWatchServer{stream})
+}
+
+type SandboxService_WatchServer interface {
+	Send(*SandboxEvent) error
+	grpc.ServerStream
+}
+
+type sandboxServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *sandboxServiceWatchServer) Send(m *SandboxEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // SandboxService_ServiceDesc is the grpc.ServiceDesc for SandboxService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -276,10 +664,140 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pause",
 			Handler:    _SandboxService_Pause_Handler,
 		},
+		{
+			MethodName: "SnapshotUploads",
+			Handler:    _SandboxService_SnapshotUploads_Handler,
+		},
 		{
 			MethodName: "ListCachedBuilds",
 			Handler:    _SandboxService_ListCachedBuilds_Handler,
 		},
+		{
+			MethodName: "SetPinnedBuilds",
+			Handler:    _SandboxService_SetPinnedBuilds_Handler,
+		},
+		{
+			MethodName: "Prefetch",
+			Handler:    _SandboxService_Prefetch_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _SandboxService_Checkpoint_Handler,
+		},
+		{
+			MethodName: "ChangedFiles",
+			Handler:    _SandboxService_ChangedFiles_Handler,
+		},
+		{
+			MethodName: "Diagnostics",
+			Handler:    _SandboxService_Diagnostics_Handler,
+		},
+		{
+			MethodName: "NetworkActivity",
+			Handler:    _SandboxService_NetworkActivity_Handler,
+		},
+		{
+			MethodName: "SaveArtifact",
+			Handler:    _SandboxService_SaveArtifact_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Console",
+			Handler:       _SandboxService_Console_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _SandboxService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "orchestrator.proto",
+}
+
+// NodeServiceClient is the client API for NodeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NodeServiceClient interface {
+	Register(ctx context.Context, in *NodeRegisterRequest, opts ...grpc.CallOption) (*NodeRegisterResponse, error)
+}
+
+type nodeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNodeServiceClient(cc grpc.ClientConnInterface) NodeServiceClient {
+	return &nodeServiceClient{cc}
+}
+
+func (c *nodeServiceClient) Register(ctx context.Context, in *NodeRegisterRequest, opts ...grpc.CallOption) (*NodeRegisterResponse, error) {
+	out := new(NodeRegisterResponse)
+	err := c.cc.Invoke(ctx, "/NodeService/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeServiceServer is the server API for NodeService service.
+// All implementations must embed UnimplementedNodeServiceServer
+// for forward compatibility
+type NodeServiceServer interface {
+	Register(context.Context, *NodeRegisterRequest) (*NodeRegisterResponse, error)
+	mustEmbedUnimplementedNodeServiceServer()
+}
+
+// UnimplementedNodeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNodeServiceServer struct {
+}
+
+func (UnimplementedNodeServiceServer) Register(context.Context, *NodeRegisterRequest) (*NodeRegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedNodeServiceServer) mustEmbedUnimplementedNodeServiceServer() {}
+
+// UnsafeNodeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NodeServiceServer will
+// result in compilation errors.
+type UnsafeNodeServiceServer interface {
+	mustEmbedUnimplementedNodeServiceServer()
+}
+
+func RegisterNodeServiceServer(s grpc.ServiceRegistrar, srv NodeServiceServer) {
+	s.RegisterService(&NodeService_ServiceDesc, srv)
+}
+
+func _NodeService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeRegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/NodeService/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).Register(ctx, req.(*NodeRegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NodeService_ServiceDesc is the grpc.ServiceDesc for NodeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NodeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "NodeService",
+	HandlerType: (*NodeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _NodeService_Register_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orchestrator.proto",
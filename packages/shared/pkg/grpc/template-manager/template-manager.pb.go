@@ -35,6 +35,20 @@ type TemplateConfig struct {
 	FirecrackerVersion string `protobuf:"bytes,7,opt,name=firecrackerVersion,proto3" json:"firecrackerVersion,omitempty"`
 	StartCommand       string `protobuf:"bytes,8,opt,name=startCommand,proto3" json:"startCommand,omitempty"`
 	HugePages          bool   `protobuf:"varint,9,opt,name=hugePages,proto3" json:"hugePages,omitempty"`
+	// Additional kernel boot args in the key=value format.
+	KernelArgs []string `protobuf:"bytes,10,rep,name=kernelArgs,proto3" json:"kernelArgs,omitempty"`
+	// Block size of the rootfs diffs in bytes, the block size is selected by the rootfs layout if zero.
+	RootfsBlockSize int32 `protobuf:"varint,11,opt,name=rootfsBlockSize,proto3" json:"rootfsBlockSize,omitempty"`
+	// Build whose pushed Docker image the template is built from, the image of the own build if empty.
+	SourceBuildID string `protobuf:"bytes,12,opt,name=sourceBuildID,proto3" json:"sourceBuildID,omitempty"`
+	// Upgrade the packages of the image when provisioning, so the rebuilt templates pick up the security updates of the distribution.
+	UpgradePackages bool `protobuf:"varint,13,opt,name=upgradePackages,proto3" json:"upgradePackages,omitempty"`
+	// Timeout of each step of the build (pulling the image, provisioning, snapshotting) in seconds, the steps aren't limited if zero.
+	StepTimeoutSeconds int32 `protobuf:"varint,14,opt,name=stepTimeoutSeconds,proto3" json:"stepTimeoutSeconds,omitempty"`
+	// Timeout of the whole build including the upload in seconds, the build isn't limited if zero.
+	BuildTimeoutSeconds int32 `protobuf:"varint,15,opt,name=buildTimeoutSeconds,proto3" json:"buildTimeoutSeconds,omitempty"`
+	// Resource variants built from the same provisioned rootfs, each variant is snapshotted with its resources as its own build.
+	Variants []*TemplateVariant `protobuf:"bytes,16,rep,name=variants,proto3" json:"variants,omitempty"`
 }
 
 func (x *TemplateConfig) Reset() {
@@ -132,6 +146,119 @@ func (x *TemplateConfig) GetHugePages() bool {
 	return false
 }
 
+func (x *TemplateConfig) GetKernelArgs() []string {
+	if x != nil {
+		return x.KernelArgs
+	}
+	return nil
+}
+
+func (x *TemplateConfig) GetRootfsBlockSize() int32 {
+	if x != nil {
+		return x.RootfsBlockSize
+	}
+	return 0
+}
+
+func (x *TemplateConfig) GetSourceBuildID() string {
+	if x != nil {
+		return x.SourceBuildID
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetUpgradePackages() bool {
+	if x != nil {
+		return x.UpgradePackages
+	}
+	return false
+}
+
+func (x *TemplateConfig) GetStepTimeoutSeconds() int32 {
+	if x != nil {
+		return x.StepTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *TemplateConfig) GetBuildTimeoutSeconds() int32 {
+	if x != nil {
+		return x.BuildTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *TemplateConfig) GetVariants() []*TemplateVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// Resource variant of the template build.
+type TemplateVariant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildID   string `protobuf:"bytes,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	MemoryMB  int32  `protobuf:"varint,2,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
+	VCpuCount int32  `protobuf:"varint,3,opt,name=vCpuCount,proto3" json:"vCpuCount,omitempty"`
+}
+
+func (x *TemplateVariant) Reset() {
+	*x = TemplateVariant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateVariant) ProtoMessage() {}
+
+func (x *TemplateVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateVariant.ProtoReflect.Descriptor instead.
+func (*TemplateVariant) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TemplateVariant) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+func (x *TemplateVariant) GetMemoryMB() int32 {
+	if x != nil {
+		return x.MemoryMB
+	}
+	return 0
+}
+
+func (x *TemplateVariant) GetVCpuCount() int32 {
+	if x != nil {
+		return x.VCpuCount
+	}
+	return 0
+}
+
 type TemplateCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -143,7 +270,7 @@ type TemplateCreateRequest struct {
 func (x *TemplateCreateRequest) Reset() {
 	*x = TemplateCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[1]
+		mi := &file_template_manager_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -156,7 +283,7 @@ func (x *TemplateCreateRequest) String() string {
 func (*TemplateCreateRequest) ProtoMessage() {}
 
 func (x *TemplateCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[1]
+	mi := &file_template_manager_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +296,7 @@ func (x *TemplateCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateCreateRequest.ProtoReflect.Descriptor instead.
 func (*TemplateCreateRequest) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{1}
+	return file_template_manager_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TemplateCreateRequest) GetTemplate() *TemplateConfig {
@@ -191,7 +318,7 @@ type TemplateDeleteRequest struct {
 func (x *TemplateDeleteRequest) Reset() {
 	*x = TemplateDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[2]
+		mi := &file_template_manager_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -204,7 +331,7 @@ func (x *TemplateDeleteRequest) String() string {
 func (*TemplateDeleteRequest) ProtoMessage() {}
 
 func (x *TemplateDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[2]
+	mi := &file_template_manager_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,7 +344,7 @@ func (x *TemplateDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateDeleteRequest.ProtoReflect.Descriptor instead.
 func (*TemplateDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{2}
+	return file_template_manager_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TemplateDeleteRequest) GetTemplateID() string {
@@ -239,7 +366,7 @@ type TemplateBuildLog struct {
 func (x *TemplateBuildLog) Reset() {
 	*x = TemplateBuildLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[3]
+		mi := &file_template_manager_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -252,7 +379,7 @@ func (x *TemplateBuildLog) String() string {
 func (*TemplateBuildLog) ProtoMessage() {}
 
 func (x *TemplateBuildLog) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[3]
+	mi := &file_template_manager_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,7 +392,7 @@ func (x *TemplateBuildLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildLog.ProtoReflect.Descriptor instead.
 func (*TemplateBuildLog) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{3}
+	return file_template_manager_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TemplateBuildLog) GetLog() string {
@@ -281,7 +408,7 @@ var file_template_manager_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67,
 	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x02, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x04, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
 	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70,
 	0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65,
 	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c,
@@ -301,30 +428,55 @@ var file_template_manager_proto_rawDesc = []byte{
 	0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43,
 	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61,
 	0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x75, 0x67, 0x65, 0x50,
-	0x61, 0x67, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
-	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x37, 0x0a, 0x15, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x49, 0x44, 0x22, 0x24, 0x0a, 0x10, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x32, 0x92, 0x01, 0x0a, 0x0f, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a,
-	0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
-	0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0e,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x16,
-	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x33,
-	0x5a, 0x31, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x69, 0x6e, 0x66,
-	0x72, 0x61, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x41, 0x72,
+	0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72,
+	0x6f, 0x6f, 0x74, 0x66, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x12, 0x73, 0x74, 0x65, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x73, 0x74, 0x65, 0x70,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30,
+	0x0a, 0x13, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x2c, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x65,
+	0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x43, 0x70, 0x75, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x43, 0x70, 0x75,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x37, 0x0a, 0x15, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x49, 0x44, 0x22, 0x24, 0x0a, 0x10, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x32, 0x92, 0x01, 0x0a, 0x0f, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d,
+	0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x30, 0x01, 0x12, 0x40, 0x0a,
+	0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
+	0x33, 0x5a, 0x31, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -339,25 +491,27 @@ func file_template_manager_proto_rawDescGZIP() []byte {
 	return file_template_manager_proto_rawDescData
 }
 
-var file_template_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_template_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_template_manager_proto_goTypes = []interface{}{
 	(*TemplateConfig)(nil),        // 0: TemplateConfig
-	(*TemplateCreateRequest)(nil), // 1: TemplateCreateRequest
-	(*TemplateDeleteRequest)(nil), // 2: TemplateDeleteRequest
-	(*TemplateBuildLog)(nil),      // 3: TemplateBuildLog
-	(*emptypb.Empty)(nil),         // 4: google.protobuf.Empty
+	(*TemplateVariant)(nil),       // 1: TemplateVariant
+	(*TemplateCreateRequest)(nil), // 2: TemplateCreateRequest
+	(*TemplateDeleteRequest)(nil), // 3: TemplateDeleteRequest
+	(*TemplateBuildLog)(nil),      // 4: TemplateBuildLog
+	(*emptypb.Empty)(nil),         // 5: google.protobuf.Empty
 }
 var file_template_manager_proto_depIdxs = []int32{
-	0, // 0: TemplateCreateRequest.template:type_name -> TemplateConfig
-	1, // 1: TemplateService.TemplateCreate:input_type -> TemplateCreateRequest
-	2, // 2: TemplateService.TemplateDelete:input_type -> TemplateDeleteRequest
-	3, // 3: TemplateService.TemplateCreate:output_type -> TemplateBuildLog
-	4, // 4: TemplateService.TemplateDelete:output_type -> google.protobuf.Empty
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: TemplateConfig.variants:type_name -> TemplateVariant
+	0, // 1: TemplateCreateRequest.template:type_name -> TemplateConfig
+	2, // 2: TemplateService.TemplateCreate:input_type -> TemplateCreateRequest
+	3, // 3: TemplateService.TemplateDelete:input_type -> TemplateDeleteRequest
+	4, // 4: TemplateService.TemplateCreate:output_type -> TemplateBuildLog
+	5, // 5: TemplateService.TemplateDelete:output_type -> google.protobuf.Empty
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_template_manager_proto_init() }
@@ -379,7 +533,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateCreateRequest); i {
+			switch v := v.(*TemplateVariant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -391,7 +545,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateDeleteRequest); i {
+			switch v := v.(*TemplateCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -403,6 +557,18 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TemplateBuildLog); i {
 			case 0:
 				return &v.state
@@ -421,7 +587,7 @@ func file_template_manager_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_template_manager_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tokenMetadataKey is the metadata key with the token, gRPC metadata keys are lowercase.
+const tokenMetadataKey = "authorization"
+
+// TokenCredentials sends the token with every call, so the plain connections between the services can be authenticated
+// where the network isn't trusted, e.g. between the pods in Kubernetes.
+type TokenCredentials string
+
+func (t TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{tokenMetadataKey: "Bearer " + string(t)}, nil
+}
+
+func (t TokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// WithToken returns the dial option sending the token, no-op if the token is empty.
+func WithToken(token string) grpc.DialOption {
+	if token == "" {
+		return grpc.EmptyDialOption{}
+	}
+
+	return grpc.WithPerRPCCredentials(TokenCredentials(token))
+}
+
+func checkToken(ctx context.Context, token string, method string) error {
+	// The health checks are used by the probes that don't have the token
+	if token == "" || strings.HasPrefix(method, "/"+grpc_health_v1.Health_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(tokenMetadataKey)
+	if len(values) == 0 || subtle.ConstantTimeCompare([]byte(values[0]), []byte("Bearer "+token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid or missing token")
+	}
+
+	return nil
+}
+
+// UnaryServerTokenInterceptor rejects the calls without the token, all calls are allowed if the token is empty.
+func UnaryServerTokenInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		err := checkToken(ctx, token, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerTokenInterceptor rejects the streams without the token, all streams are allowed if the token is empty.
+func StreamServerTokenInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := checkToken(ss.Context(), token, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
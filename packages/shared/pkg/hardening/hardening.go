@@ -0,0 +1,159 @@
+// Package hardening resolves the hardening levels of the templates into the policies enforced by envd in the sandboxes.
+package hardening
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+const (
+	LevelNone     = "none"
+	LevelStandard = "standard"
+	LevelStrict   = "strict"
+
+	maxReadOnlyPaths  = 32
+	maxDeniedSyscalls = 128
+)
+
+// standardSyscalls administer the kernel of the sandbox, the processes in the sandbox don't need them.
+var standardSyscalls = []string{
+	"acct",
+	"add_key",
+	"bpf",
+	"clock_adjtime",
+	"clock_settime",
+	"delete_module",
+	"finit_module",
+	"init_module",
+	"iopl",
+	"ioperm",
+	"kexec_file_load",
+	"kexec_load",
+	"keyctl",
+	"mount",
+	"open_by_handle_at",
+	"perf_event_open",
+	"pivot_root",
+	"quotactl",
+	"reboot",
+	"request_key",
+	"settimeofday",
+	"swapoff",
+	"swapon",
+	"syslog",
+	"umount2",
+	"vhangup",
+}
+
+// strictSyscalls inspect the other processes or escape the namespaces, they are denied in addition to the standard syscalls.
+var strictSyscalls = []string{
+	"chroot",
+	"personality",
+	"process_vm_readv",
+	"process_vm_writev",
+	"ptrace",
+	"setns",
+	"unshare",
+	"userfaultfd",
+}
+
+// Syscalls are the syscalls that can be denied, envd knows their numbers on the supported architectures.
+var Syscalls = slices.Concat(standardSyscalls, strictSyscalls, []string{
+	"adjtimex",
+	"fanotify_init",
+	"io_uring_enter",
+	"io_uring_register",
+	"io_uring_setup",
+	"lookup_dcookie",
+	"memfd_create",
+	"mknod",
+	"mknodat",
+	"modify_ldt",
+	"move_pages",
+	"name_to_handle_at",
+	"nfsservctl",
+	"sethostname",
+	"setdomainname",
+	"uselib",
+})
+
+var strictReadOnlyPaths = []string{"/boot", "/etc", "/usr"}
+
+// Resolve returns the policy of the level extended by the overrides, nil is returned for the level without any restrictions.
+func Resolve(level string, noNewPrivileges *bool, readOnlyPaths, deniedSyscalls []string) (*schema.HardeningPolicy, error) {
+	policy := &schema.HardeningPolicy{Level: level}
+
+	switch level {
+	case LevelNone:
+	case LevelStandard:
+		policy.DeniedSyscalls = slices.Clone(standardSyscalls)
+	case LevelStrict:
+		policy.NoNewPrivileges = true
+		policy.ReadOnlyPaths = slices.Clone(strictReadOnlyPaths)
+		policy.DeniedSyscalls = slices.Concat(standardSyscalls, strictSyscalls)
+	default:
+		return nil, fmt.Errorf("unknown hardening level '%s'", level)
+	}
+
+	if noNewPrivileges != nil {
+		policy.NoNewPrivileges = *noNewPrivileges
+	}
+
+	if len(readOnlyPaths) > maxReadOnlyPaths {
+		return nil, fmt.Errorf("too many read-only paths (%d), the maximum is %d", len(readOnlyPaths), maxReadOnlyPaths)
+	}
+
+	for _, path := range readOnlyPaths {
+		err := validatePath(path)
+		if err != nil {
+			return nil, err
+		}
+
+		policy.ReadOnlyPaths = append(policy.ReadOnlyPaths, filepath.Clean(path))
+	}
+
+	if len(deniedSyscalls) > maxDeniedSyscalls {
+		return nil, fmt.Errorf("too many denied syscalls (%d), the maximum is %d", len(deniedSyscalls), maxDeniedSyscalls)
+	}
+
+	for _, syscall := range deniedSyscalls {
+		if !slices.Contains(Syscalls, syscall) {
+			return nil, fmt.Errorf("syscall '%s' can't be denied, the supported syscalls are: %s", syscall, strings.Join(Syscalls, ", "))
+		}
+
+		policy.DeniedSyscalls = append(policy.DeniedSyscalls, syscall)
+	}
+
+	slices.Sort(policy.ReadOnlyPaths)
+	policy.ReadOnlyPaths = slices.Compact(policy.ReadOnlyPaths)
+
+	slices.Sort(policy.DeniedSyscalls)
+	policy.DeniedSyscalls = slices.Compact(policy.DeniedSyscalls)
+
+	if !policy.NoNewPrivileges && len(policy.ReadOnlyPaths) == 0 && len(policy.DeniedSyscalls) == 0 {
+		return nil, nil
+	}
+
+	return policy, nil
+}
+
+// validatePath checks the path can be mounted read-only without breaking envd, the root and the runtime directories must stay writable.
+func validatePath(path string) error {
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("read-only path '%s' is not absolute", path)
+	}
+
+	clean := filepath.Clean(path)
+
+	for _, writable := range []string{"/", "/dev", "/proc", "/run", "/sys", "/tmp"} {
+		if clean == writable || (writable != "/" && strings.HasPrefix(clean, writable+"/")) {
+			return fmt.Errorf("path '%s' can't be read-only", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Package health runs the deep health checks of the services, the checks probe the dependencies the service needs
+// to work and distinguish the degraded service, which works with limitations, from the unhealthy one.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type Status string
+
+const (
+	Healthy   Status = "healthy"
+	Degraded  Status = "degraded"
+	Unhealthy Status = "unhealthy"
+)
+
+// Probe checks one dependency of the service.
+type Probe struct {
+	Name string
+	// Check returns an error if the dependency isn't usable, the service is unhealthy then.
+	// The error is wrapped by DegradedError if the service still works with the dependency, only with limitations.
+	Check func(ctx context.Context) error
+}
+
+type degradedError struct {
+	err error
+}
+
+func (e degradedError) Error() string {
+	return e.err.Error()
+}
+
+func (e degradedError) Unwrap() error {
+	return e.err
+}
+
+// DegradedError marks the error of the probe as degrading the service instead of making it unhealthy.
+func DegradedError(err error) error {
+	return degradedError{err: err}
+}
+
+type ProbeResult struct {
+	Name       string `json:"name"`
+	Status     Status `json:"status"`
+	DurationMs int64  `json:"durationMs"`
+	Error      string `json:"error,omitempty"`
+}
+
+type Result struct {
+	Status Status        `json:"status"`
+	Probes []ProbeResult `json:"probes"`
+}
+
+// Check runs the probes in parallel, every probe is limited by the timeout. The result is unhealthy if any probe failed,
+// degraded if any probe degraded the service and healthy otherwise.
+func Check(ctx context.Context, timeout time.Duration, probes []Probe) Result {
+	results := make([]ProbeResult, len(probes))
+
+	var wg sync.WaitGroup
+	for i, probe := range probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := probe.Check(probeCtx)
+
+			results[i] = ProbeResult{
+				Name:       probe.Name,
+				Status:     Healthy,
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+
+			if err != nil {
+				results[i].Status = Unhealthy
+				if errors.As(err, &degradedError{}) {
+					results[i].Status = Degraded
+				}
+
+				results[i].Error = err.Error()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	result := Result{Status: Healthy, Probes: results}
+	for _, probe := range results {
+		switch {
+		case probe.Status == Unhealthy:
+			result.Status = Unhealthy
+		case probe.Status == Degraded && result.Status == Healthy:
+			result.Status = Degraded
+		}
+	}
+
+	return result
+}
+
+// HTTPStatus returns the status code of the result for the HTTP health checks, the degraded service returns 429,
+// which Consul treats as a warning, so the degraded service isn't restarted or removed from the load balancing.
+func (r Result) HTTPStatus() int {
+	switch r.Status {
+	case Unhealthy:
+		return http.StatusServiceUnavailable
+	case Degraded:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusOK
+	}
+}
+
+// Handler returns the HTTP handler of the deep health check with the probes.
+func Handler(timeout time.Duration, probes []Probe) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		result := Check(r.Context(), timeout, probes)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(result.HTTPStatus())
+
+		err := json.NewEncoder(w).Encode(result)
+		if err != nil {
+			log.Printf("failed to write health check response: %v", err)
+		}
+	}
+}
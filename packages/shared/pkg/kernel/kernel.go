@@ -0,0 +1,83 @@
+// Package kernel validates the kernel versions and boot args that can be customized per template.
+package kernel
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+const maxArgs = 16
+
+// allowedArgs are the boot args that can be set by the template with the pattern of the allowed values.
+// The args required for the sandbox to work (console, networking, reboot and panic behavior) can't be overridden.
+var allowedArgs = map[string]*regexp.Regexp{
+	"transparent_hugepage":             regexp.MustCompile(`^(always|madvise|never)$`),
+	"transparent_hugepage_shmem":       regexp.MustCompile(`^(always|within_size|advise|never|deny|force)$`),
+	"cgroup_no_v1":                     regexp.MustCompile(`^(all|[a-z_]+(,[a-z_]+)*)$`),
+	"cgroup_enable":                    regexp.MustCompile(`^[a-z_]+(,[a-z_]+)*$`),
+	"systemd.unified_cgroup_hierarchy": regexp.MustCompile(`^(0|1)$`),
+	"swapaccount":                      regexp.MustCompile(`^(0|1)$`),
+	"numa_balancing":                   regexp.MustCompile(`^(enable|disable)$`),
+	"init_on_alloc":                    regexp.MustCompile(`^(0|1)$`),
+	"init_on_free":                     regexp.MustCompile(`^(0|1)$`),
+}
+
+// AllowedVersions returns the default kernel version and the versions allowed by the ALLOWED_KERNEL_VERSIONS env var (comma-separated).
+func AllowedVersions() []string {
+	versions := []string{schema.DefaultKernelVersion}
+
+	for _, version := range strings.Split(os.Getenv("ALLOWED_KERNEL_VERSIONS"), ",") {
+		version = strings.TrimSpace(version)
+		if version != "" && !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+	}
+
+	return versions
+}
+
+func ValidateVersion(version string) error {
+	allowed := AllowedVersions()
+	if !slices.Contains(allowed, version) {
+		return fmt.Errorf("kernel version '%s' is not allowed, the allowed versions are: %s", version, strings.Join(allowed, ", "))
+	}
+
+	return nil
+}
+
+// ValidateArgs checks the boot args are in the "key=value" format and both the key and the value are allowed.
+func ValidateArgs(args []string) error {
+	if len(args) > maxArgs {
+		return fmt.Errorf("too many kernel args (%d), the maximum is %d", len(args), maxArgs)
+	}
+
+	keys := make(map[string]bool, len(args))
+	for _, arg := range args {
+		key, value, found := strings.Cut(arg, "=")
+		if !found {
+			return fmt.Errorf("kernel arg '%s' is not in the key=value format", arg)
+		}
+
+		pattern, ok := allowedArgs[key]
+		if !ok {
+			return fmt.Errorf("kernel arg '%s' is not allowed", key)
+		}
+
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("value '%s' of the kernel arg '%s' is not allowed", value, key)
+		}
+
+		if keys[key] {
+			return fmt.Errorf("kernel arg '%s' is set multiple times", key)
+		}
+
+		keys[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+const AWS = "aws"
+
+// awsKeyManager wraps the data keys with the AWS KMS key, the credentials and the region are taken from the standard AWS env.
+type awsKeyManager struct {
+	client *kms.KMS
+	key    string
+}
+
+func newAWSKeyManager(_ context.Context, key string) (KeyManager, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+
+	return &awsKeyManager{
+		client: kms.New(sess),
+		key:    key,
+	}, nil
+}
+
+func (m *awsKeyManager) Name() string {
+	return AWS
+}
+
+// encryptionContext is the additional data of the data key, AWS KMS authenticates it with the ciphertext.
+func encryptionContext(additionalData string) map[string]*string {
+	return map[string]*string{"team_id": aws.String(additionalData)}
+}
+
+func (m *awsKeyManager) Wrap(ctx context.Context, additionalData string, dataKey []byte) ([]byte, string, error) {
+	out, err := m.client.EncryptWithContext(ctx, &kms.EncryptInput{
+		KeyId:             aws.String(m.key),
+		Plaintext:         dataKey,
+		EncryptionContext: encryptionContext(additionalData),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encrypt with AWS KMS: %w", err)
+	}
+
+	// The ARN of the key is returned even if the key is configured by its alias
+	return out.CiphertextBlob, aws.StringValue(out.KeyId), nil
+}
+
+func (m *awsKeyManager) Unwrap(ctx context.Context, additionalData, keyID string, wrapped []byte) ([]byte, error) {
+	out, err := m.client.DecryptWithContext(ctx, &kms.DecryptInput{
+		KeyId:             aws.String(keyID),
+		CiphertextBlob:    wrapped,
+		EncryptionContext: encryptionContext(additionalData),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with AWS KMS: %w", err)
+	}
+
+	return out.Plaintext, nil
+}
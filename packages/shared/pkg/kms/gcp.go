@@ -0,0 +1,110 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	GCP = "gcp"
+
+	gcpKMSEndpoint = "https://cloudkms.googleapis.com/v1/"
+	gcpKMSScope    = "https://www.googleapis.com/auth/cloudkms"
+)
+
+// gcpKeyManager wraps the data keys with the Cloud KMS key, the requests are authenticated by the default credentials of the node.
+type gcpKeyManager struct {
+	client *http.Client
+	// key is the resource name of the key, the version used for the encryption is picked by the KMS.
+	key string
+}
+
+func newGCPKeyManager(ctx context.Context, key string) (KeyManager, error) {
+	client, err := google.DefaultClient(ctx, gcpKMSScope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Cloud KMS client: %w", err)
+	}
+
+	return &gcpKeyManager{
+		client: client,
+		key:    key,
+	}, nil
+}
+
+func (m *gcpKeyManager) Name() string {
+	return GCP
+}
+
+func (m *gcpKeyManager) Wrap(ctx context.Context, additionalData string, dataKey []byte) ([]byte, string, error) {
+	var response struct {
+		Ciphertext []byte `json:"ciphertext"`
+	}
+
+	err := m.call(ctx, m.key+":encrypt", map[string][]byte{
+		"plaintext":                   dataKey,
+		"additionalAuthenticatedData": []byte(additionalData),
+	}, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The key version is stored in the ciphertext, the decryption is done with the key itself
+	return response.Ciphertext, m.key, nil
+}
+
+func (m *gcpKeyManager) Unwrap(ctx context.Context, additionalData, keyID string, wrapped []byte) ([]byte, error) {
+	var response struct {
+		Plaintext []byte `json:"plaintext"`
+	}
+
+	err := m.call(ctx, keyID+":decrypt", map[string][]byte{
+		"ciphertext":                  wrapped,
+		"additionalAuthenticatedData": []byte(additionalData),
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Plaintext, nil
+}
+
+// call sends the request to the Cloud KMS REST API, the bytes are encoded as base64 by the JSON encoding same as in the responses.
+func (m *gcpKeyManager) call(ctx context.Context, method string, body map[string][]byte, response any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal Cloud KMS request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, gcpKMSEndpoint+method, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create Cloud KMS request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call Cloud KMS: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+		return fmt.Errorf("Cloud KMS returned status %d: %s", resp.StatusCode, message)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(response)
+	if err != nil {
+		return fmt.Errorf("failed to decode Cloud KMS response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+// Package kms wraps the data keys of the envelope encryption with the keys that never leave the key management service.
+// The same key managers are used by the orchestrator for the snapshots and by the API for the secrets of the teams.
+package kms
+
+import (
+	"context"
+	"fmt"
+)
+
+// KeyManager wraps the data keys with the key of the KMS.
+// The additional data is authenticated with the wrapped key, so the key wrapped for one owner can't be used for another.
+type KeyManager interface {
+	Name() string
+	// Wrap returns the wrapped data key and the ID of the key it was wrapped with.
+	Wrap(ctx context.Context, additionalData string, dataKey []byte) (wrapped []byte, keyID string, err error)
+	Unwrap(ctx context.Context, additionalData, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// keyManagers are the constructors of the key managers by the names of the KMS.
+var keyManagers = map[string]func(ctx context.Context, key string) (KeyManager, error){
+	GCP:   newGCPKeyManager,
+	AWS:   newAWSKeyManager,
+	Vault: newVaultKeyManager,
+	Local: newLocalKeyManager,
+}
+
+// New returns the key manager of the KMS, the key is the resource name of the GCP KMS key, the ARN or alias of the AWS KMS key,
+// the name of the Vault transit key or the keys of the local key manager.
+func New(ctx context.Context, name, key string) (KeyManager, error) {
+	newKeyManager, ok := keyManagers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown KMS '%s'", name)
+	}
+
+	return newKeyManager(ctx, key)
+}
@@ -0,0 +1,117 @@
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const Local = "local"
+
+var ErrNotConfigured = errors.New("no key is configured for the local KMS")
+
+// localKeyManager wraps the data keys with the AES-256 keys from the configuration, it's meant for the deployments without the KMS.
+// The keys are in the ID:BASE64_KEY format separated by commas, the first key wraps the new data keys
+// and the others are kept for the unwrapping after the rotation. The key ID and the additional data are authenticated with the wrapped key.
+type localKeyManager struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+func newLocalKeyManager(_ context.Context, config string) (KeyManager, error) {
+	m := &localKeyManager{
+		keys: make(map[string]cipher.AEAD),
+	}
+
+	if config == "" {
+		return m, nil
+	}
+
+	for _, entry := range strings.Split(config, ",") {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid local KMS key, expected ID:BASE64_KEY")
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid local KMS key '%s': %w", id, err)
+		}
+
+		if len(key) != 32 {
+			return nil, fmt.Errorf("local KMS key '%s' must have 32 bytes, got %d", id, len(key))
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cipher: %w", err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cipher: %w", err)
+		}
+
+		if _, ok := m.keys[id]; ok {
+			return nil, fmt.Errorf("duplicate local KMS key '%s'", id)
+		}
+
+		if m.primary == "" {
+			m.primary = id
+		}
+
+		m.keys[id] = aead
+	}
+
+	return m, nil
+}
+
+func (m *localKeyManager) Name() string {
+	return Local
+}
+
+func (m *localKeyManager) Wrap(_ context.Context, additionalData string, dataKey []byte) ([]byte, string, error) {
+	if m.primary == "" {
+		return nil, "", ErrNotConfigured
+	}
+
+	aead := m.keys[m.primary]
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, dataKey, localAdditionalData(m.primary, additionalData)), m.primary, nil
+}
+
+func (m *localKeyManager) Unwrap(_ context.Context, additionalData, keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := m.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown local KMS key '%s'", keyID)
+	}
+
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+
+	nonce, ciphertext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+
+	dataKey, err := aead.Open(nil, nonce, ciphertext, localAdditionalData(keyID, additionalData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+
+	return dataKey, nil
+}
+
+func localAdditionalData(keyID, additionalData string) []byte {
+	return []byte(keyID + "/" + additionalData)
+}
@@ -0,0 +1,67 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T, id string) string {
+	key := make([]byte, 32)
+
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	return id + ":" + base64.StdEncoding.EncodeToString(key)
+}
+
+func TestLocalKeyManagerRotation(t *testing.T) {
+	ctx := context.Background()
+	oldKey, newKey := newTestKey(t, "old"), newTestKey(t, "new")
+
+	old, err := New(ctx, Local, oldKey)
+	require.NoError(t, err)
+
+	dataKey := bytes.Repeat([]byte{7}, 32)
+
+	wrapped, keyID, err := old.Wrap(ctx, "team", dataKey)
+	require.NoError(t, err)
+	require.Equal(t, "old", keyID)
+
+	// The old key is kept after the rotation, so the data keys wrapped before it can be unwrapped
+	rotated, err := New(ctx, Local, newKey+","+oldKey)
+	require.NoError(t, err)
+
+	unwrapped, err := rotated.Unwrap(ctx, "team", keyID, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, dataKey, unwrapped)
+
+	_, keyID, err = rotated.Wrap(ctx, "team", dataKey)
+	require.NoError(t, err)
+	require.Equal(t, "new", keyID)
+}
+
+func TestLocalKeyManagerAdditionalData(t *testing.T) {
+	ctx := context.Background()
+
+	manager, err := New(ctx, Local, newTestKey(t, "key"))
+	require.NoError(t, err)
+
+	wrapped, keyID, err := manager.Wrap(ctx, "team-a", bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+
+	_, err = manager.Unwrap(ctx, "team-b", keyID, wrapped)
+	require.Error(t, err)
+}
+
+func TestLocalKeyManagerNotConfigured(t *testing.T) {
+	manager, err := New(context.Background(), Local, "")
+	require.NoError(t, err)
+
+	_, _, err = manager.Wrap(context.Background(), "team", bytes.Repeat([]byte{1}, 32))
+	require.ErrorIs(t, err, ErrNotConfigured)
+}
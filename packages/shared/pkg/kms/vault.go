@@ -0,0 +1,146 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	Vault = "vault"
+
+	vaultAddrEnv  = "VAULT_ADDR"
+	vaultTokenEnv = "VAULT_TOKEN"
+	// vaultMountEnv is the path of the transit secrets engine, the default is "transit".
+	vaultMountEnv = "VAULT_TRANSIT_MOUNT"
+
+	vaultRequestTimeout = 10 * time.Second
+)
+
+// vaultKeyManager wraps the data keys with the key of the Vault transit secrets engine.
+type vaultKeyManager struct {
+	client *http.Client
+	addr   string
+	token  string
+	mount  string
+	key    string
+}
+
+func newVaultKeyManager(_ context.Context, key string) (KeyManager, error) {
+	addr := os.Getenv(vaultAddrEnv)
+	if addr == "" {
+		return nil, fmt.Errorf("%s is required for the Vault KMS", vaultAddrEnv)
+	}
+
+	mount := os.Getenv(vaultMountEnv)
+	if mount == "" {
+		mount = "transit"
+	}
+
+	return &vaultKeyManager{
+		client: &http.Client{Timeout: vaultRequestTimeout},
+		addr:   strings.TrimSuffix(addr, "/"),
+		token:  os.Getenv(vaultTokenEnv),
+		mount:  strings.Trim(mount, "/"),
+		key:    key,
+	}, nil
+}
+
+func (m *vaultKeyManager) Name() string {
+	return Vault
+}
+
+// Wrap encrypts the data key with the transit key, the additional data is not authenticated by Vault, so it's encrypted with the data key.
+func (m *vaultKeyManager) Wrap(ctx context.Context, additionalData string, dataKey []byte) ([]byte, string, error) {
+	var response struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+
+	plaintext := append([]byte(additionalData+":"), dataKey...)
+
+	err := m.call(ctx, "encrypt", map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+	}, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return []byte(response.Data.Ciphertext), m.key, nil
+}
+
+func (m *vaultKeyManager) Unwrap(ctx context.Context, additionalData, keyID string, wrapped []byte) ([]byte, error) {
+	var response struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+
+	if keyID != m.key {
+		return nil, fmt.Errorf("data key was wrapped with Vault transit key '%s', but '%s' is configured", keyID, m.key)
+	}
+
+	err := m.call(ctx, "decrypt", map[string]string{
+		"ciphertext": string(wrapped),
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(response.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode Vault plaintext: %w", err)
+	}
+
+	owner, dataKey, ok := bytes.Cut(plaintext, []byte(":"))
+	if !ok || string(owner) != additionalData {
+		return nil, fmt.Errorf("data key doesn't belong to '%s'", additionalData)
+	}
+
+	return dataKey, nil
+}
+
+func (m *vaultKeyManager) call(ctx context.Context, operation string, body map[string]string, response any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal Vault request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", m.addr, m.mount, operation, m.key)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create Vault request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", m.token)
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call Vault: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+		return fmt.Errorf("Vault returned status %d: %s", resp.StatusCode, message)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(response)
+	if err != nil {
+		return fmt.Errorf("failed to decode Vault response: %w", err)
+	}
+
+	return nil
+}
@@ -182,8 +182,26 @@ func (l *SandboxLogger) MemoryUsage(memoryMiB float64) {
 	}
 }
 
-func (l *SandboxLogger) Metrics(memTotalMiB, memUsedMiB uint64, cpuCount uint32, cpuUsedPct float32) {
-	l.exporter.logger.Info().
+// ProcessMetrics is the resource usage of a process in the sandbox.
+type ProcessMetrics struct {
+	PID        int32   `json:"pid"`
+	Cmd        string  `json:"cmd"`
+	CPUUsedPct float32 `json:"cpuUsedPct"`
+	MemRSSMiB  uint64  `json:"memRssMiB"`
+	OpenFDs    int32   `json:"openFds"`
+}
+
+func (l *SandboxLogger) Metrics(
+	memTotalMiB,
+	memUsedMiB uint64,
+	cpuCount uint32,
+	cpuUsedPct float32,
+	diskTotalMiB,
+	diskUsedMiB uint64,
+	openFDs int64,
+	processes []ProcessMetrics,
+) {
+	event := l.exporter.logger.Info().
 		Str("category", "metrics").
 		Str("instanceID", l.instanceID).
 		Str("envID", l.envID).
@@ -191,8 +209,21 @@ func (l *SandboxLogger) Metrics(memTotalMiB, memUsedMiB uint64, cpuCount uint32,
 		Float32("cpuUsedPct", cpuUsedPct).
 		Uint32("cpuCount", cpuCount).
 		Uint64("memTotalMiB", memTotalMiB).
-		Uint64("memUsedMiB", memUsedMiB).
-		Msg("Metrics")
+		Uint64("memUsedMiB", memUsedMiB)
+
+	// The older envd versions don't report the disk and the processes
+	if diskTotalMiB > 0 {
+		event = event.
+			Uint64("diskTotalMiB", diskTotalMiB).
+			Uint64("diskUsedMiB", diskUsedMiB).
+			Int64("openFds", openFDs)
+	}
+
+	if len(processes) > 0 {
+		event = event.Interface("processes", processes)
+	}
+
+	event.Msg("Metrics")
 
 	return
 }
@@ -241,3 +272,21 @@ func (l *SandboxLogger) Healthcheck(ok bool, alwaysReport bool) {
 			Msg(msg)
 	}
 }
+
+// Hook logs the result of the lifecycle hook executed in the sandbox.
+func (l *SandboxLogger) Hook(name string, duration time.Duration, err error) {
+	logEvent := l.exporter.logger.Info()
+	if err != nil {
+		logEvent = l.exporter.logger.Error().Str("error", err.Error())
+	}
+
+	logEvent.
+		Str("category", "hook").
+		Str("instanceID", l.instanceID).
+		Str("envID", l.envID).
+		Str("teamID", l.teamID).
+		Str("hook", name).
+		Dur("duration", duration).
+		Bool("success", err == nil).
+		Msgf("Sandbox %s hook finished", name)
+}
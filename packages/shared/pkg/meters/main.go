@@ -10,7 +10,22 @@ import (
 type CounterType string
 
 const (
-	SandboxCreateMeterName CounterType = "api.env.instance.started"
+	SandboxCreateMeterName         CounterType = "api.env.instance.started"
+	SandboxPreemptedMeterName      CounterType = "api.env.instance.preempted"
+	ResumeStageOverBudgetMeterName CounterType = "orchestrator.sandbox.resume.stage.over_budget"
+	SandboxStartedMeterName        CounterType = "orchestrator.sandbox.started"
+	SandboxStartFailedMeterName    CounterType = "orchestrator.sandbox.start.failed"
+	SandboxCrashedMeterName        CounterType = "orchestrator.sandbox.crashed"
+	NBDSlotsExhaustedMeterName     CounterType = "orchestrator.nbd.slots_pool.exhausted"
+	WatchdogShedMeterName          CounterType = "orchestrator.watchdog.shed"
+	StorageReadHedgedMeterName     CounterType = "orchestrator.storage.read.hedged"
+	StorageReadHedgeWonMeterName   CounterType = "orchestrator.storage.read.hedge_won"
+	StorageReadTimeoutMeterName    CounterType = "orchestrator.storage.read.timeout"
+	MemfilePrefetchBlocksMeterName CounterType = "orchestrator.sandbox.memfile.prefetch.blocks"
+	MemfilePrefetchDeferMeterName  CounterType = "orchestrator.sandbox.memfile.prefetch.deferred"
+	TemplateCacheRequestsMeterName CounterType = "orchestrator.template.cache.requests"
+	TemplateCacheBytesMeterName    CounterType = "orchestrator.template.cache.read.bytes"
+	GRPCRequestsMeterName          CounterType = "orchestrator.grpc.requests"
 )
 
 type UpDownCounterType string
@@ -22,19 +37,113 @@ const (
 	NewNetworkSlotSPoolCounterMeterName                      = "orchestrator.network.slots_pool.new"
 	ReusedNetworkSlotSPoolCounterMeterName                   = "orchestrator.network.slots_pool.reused"
 	NBDkSlotSReadyPoolCounterMeterName                       = "orchestrator.nbd.slots_pool.read"
+	NBDSlotsWaitingCounterMeterName                          = "orchestrator.nbd.slots_pool.waiting"
+)
+
+type HistogramType string
+
+const (
+	ResumeStageDurationMeterName  HistogramType = "orchestrator.sandbox.resume.stage.duration"
+	CacheInvalidationLagMeterName HistogramType = "api.cache.invalidation.lag"
+	ClockDriftMeterName           HistogramType = "orchestrator.sandbox.clock.drift"
+	SandboxStartDurationMeterName HistogramType = "orchestrator.sandbox.start.duration"
+	NetworkSlotWaitMeterName      HistogramType = "orchestrator.network.slots_pool.wait"
+	StorageReadDurationMeterName  HistogramType = "orchestrator.storage.read.duration"
+	GRPCDurationMeterName         HistogramType = "orchestrator.grpc.duration"
+)
+
+type GaugeFloatType string
+
+const (
+	ResumeStageBurnRateMeterName       GaugeFloatType = "orchestrator.sandbox.resume.stage.burn_rate"
+	NBDPoolUtilizationMeterName        GaugeFloatType = "orchestrator.nbd.slots_pool.utilization"
+	SandboxCgroupCPUUsageMeterName     GaugeFloatType = "orchestrator.sandbox.cgroup.cpu.usage"
+	SandboxCgroupCPUThrottledMeterName GaugeFloatType = "orchestrator.sandbox.cgroup.cpu.throttled"
+	SandboxCgroupMemoryUsageMeterName  GaugeFloatType = "orchestrator.sandbox.cgroup.memory.usage"
+	WatchdogLevelMeterName             GaugeFloatType = "orchestrator.watchdog.level"
 )
 
 var meter = otel.GetMeterProvider().Meter("nomad")
 var meterLock = sync.Mutex{}
 var counters = make(map[CounterType]metric.Int64Counter)
 var upDownCounters = make(map[UpDownCounterType]metric.Int64UpDownCounter)
+var histograms = make(map[HistogramType]metric.Float64Histogram)
+var gaugesFloat = make(map[GaugeFloatType]metric.Float64ObservableGauge)
 
 var counterDesc = map[CounterType]string{
-	SandboxCreateMeterName: "Number of currently waiting requests to create a new sandbox",
+	SandboxCreateMeterName:         "Number of currently waiting requests to create a new sandbox",
+	SandboxPreemptedMeterName:      "Number of low priority sandboxes paused to make room for the high priority sandboxes.",
+	ResumeStageOverBudgetMeterName: "Number of sandbox resumes with the stage over its latency budget.",
+	SandboxStartedMeterName:        "Number of sandboxes started on the node.",
+	SandboxStartFailedMeterName:    "Number of sandboxes that failed to start on the node.",
+	SandboxCrashedMeterName:        "Number of sandboxes terminated unexpectedly on the node.",
+	NBDSlotsExhaustedMeterName:     "Number of nbd device requests that timed out because all devices were used.",
+	WatchdogShedMeterName:          "Number of sandboxes evicted by the eviction policy because of the host memory pressure.",
+	StorageReadHedgedMeterName:     "Number of storage reads duplicated because the first read was slower than the usual reads of the provider.",
+	StorageReadHedgeWonMeterName:   "Number of hedged storage reads where the duplicate read finished first.",
+	StorageReadTimeoutMeterName:    "Number of storage reads that timed out by the adaptive timeout of the provider.",
+	MemfilePrefetchBlocksMeterName: "Number of memfile blocks prefetched for the sandboxes by the outcome, useful if the sandbox faulted the block later, wasted otherwise.",
+	MemfilePrefetchDeferMeterName:  "Number of times the memfile prefetch of a sandbox was deferred because of the host memory pressure.",
+	TemplateCacheRequestsMeterName: "Number of the templates requested by the sandboxes by the result, hit if the template was cached on the node.",
+	TemplateCacheBytesMeterName:    "Number of the bytes of the template files fetched to the node by the source, the storage cache servers or the bucket.",
+	GRPCRequestsMeterName:          "Number of the gRPC requests handled by the node by the method and the status code.",
 }
 
 var counterUnits = map[CounterType]string{
-	SandboxCreateMeterName: "{sandbox}",
+	SandboxCreateMeterName:         "{sandbox}",
+	SandboxPreemptedMeterName:      "{sandbox}",
+	ResumeStageOverBudgetMeterName: "{sandbox}",
+	SandboxStartedMeterName:        "{sandbox}",
+	SandboxStartFailedMeterName:    "{sandbox}",
+	SandboxCrashedMeterName:        "{sandbox}",
+	NBDSlotsExhaustedMeterName:     "{request}",
+	WatchdogShedMeterName:          "{sandbox}",
+	StorageReadHedgedMeterName:     "{read}",
+	StorageReadHedgeWonMeterName:   "{read}",
+	StorageReadTimeoutMeterName:    "{read}",
+	MemfilePrefetchBlocksMeterName: "{block}",
+	MemfilePrefetchDeferMeterName:  "{deferral}",
+	TemplateCacheRequestsMeterName: "{request}",
+	TemplateCacheBytesMeterName:    "By",
+	GRPCRequestsMeterName:          "{request}",
+}
+
+var histogramDesc = map[HistogramType]string{
+	ResumeStageDurationMeterName:  "Duration of the sandbox resume stage.",
+	CacheInvalidationLagMeterName: "Time between the database change and the invalidation of the API cache.",
+	ClockDriftMeterName:           "Drift of the sandbox clock corrected after the sandbox was restored.",
+	SandboxStartDurationMeterName: "Duration of the sandbox start on the node.",
+	NetworkSlotWaitMeterName:      "Time spent waiting for a network slot from the pool.",
+	StorageReadDurationMeterName:  "Duration of the storage reads, including the hedged reads.",
+	GRPCDurationMeterName:         "Duration of the gRPC requests handled by the node, the streams last until they are closed.",
+}
+
+var histogramUnits = map[HistogramType]string{
+	ResumeStageDurationMeterName:  "ms",
+	CacheInvalidationLagMeterName: "ms",
+	ClockDriftMeterName:           "ms",
+	SandboxStartDurationMeterName: "ms",
+	NetworkSlotWaitMeterName:      "ms",
+	StorageReadDurationMeterName:  "ms",
+	GRPCDurationMeterName:         "ms",
+}
+
+var gaugeFloatDesc = map[GaugeFloatType]string{
+	ResumeStageBurnRateMeterName:       "Rate at which the sandbox resume stage consumes its latency error budget.",
+	NBDPoolUtilizationMeterName:        "Ratio of the nbd devices used on the node to the host limit.",
+	SandboxCgroupCPUUsageMeterName:     "Total CPU time used by the jailed sandbox FC process.",
+	SandboxCgroupCPUThrottledMeterName: "Total time the jailed sandbox FC process was throttled by its CPU limit.",
+	SandboxCgroupMemoryUsageMeterName:  "Memory used by the jailed sandbox FC process, including the guest memory.",
+	WatchdogLevelMeterName:             "Level of the host resource pressure, 0 is normal, 1 is degraded and 2 is critical.",
+}
+
+var gaugeFloatUnits = map[GaugeFloatType]string{
+	ResumeStageBurnRateMeterName:       "1",
+	NBDPoolUtilizationMeterName:        "1",
+	SandboxCgroupCPUUsageMeterName:     "s",
+	SandboxCgroupCPUThrottledMeterName: "s",
+	SandboxCgroupMemoryUsageMeterName:  "By",
+	WatchdogLevelMeterName:             "1",
 }
 
 var upDownCounterDesc = map[UpDownCounterType]string{
@@ -44,6 +153,7 @@ var upDownCounterDesc = map[UpDownCounterType]string{
 	ReusedNetworkSlotSPoolCounterMeterName: "Number of reused network slots ready to be used.",
 	NewNetworkSlotSPoolCounterMeterName:    "Number of new network slots ready to be used.",
 	NBDkSlotSReadyPoolCounterMeterName:     "Number of nbd slots ready to be used.",
+	NBDSlotsWaitingCounterMeterName:        "Number of requests waiting for a free nbd slot.",
 }
 
 var upDownCounterUnits = map[UpDownCounterType]string{
@@ -53,6 +163,7 @@ var upDownCounterUnits = map[UpDownCounterType]string{
 	ReusedNetworkSlotSPoolCounterMeterName: "{slot}",
 	NewNetworkSlotSPoolCounterMeterName:    "{slot}",
 	NBDkSlotSReadyPoolCounterMeterName:     "{slot}",
+	NBDSlotsWaitingCounterMeterName:        "{request}",
 }
 
 func GetCounter(name CounterType) (metric.Int64Counter, error) {
@@ -90,3 +201,45 @@ func GetUpDownCounter(name UpDownCounterType) (metric.Int64UpDownCounter, error)
 
 	return counter, nil
 }
+
+func GetHistogram(name HistogramType) (metric.Float64Histogram, error) {
+	meterLock.Lock()
+	defer meterLock.Unlock()
+
+	if histogram, ok := histograms[name]; ok {
+		return histogram, nil
+	}
+
+	histogram, err := meter.Float64Histogram(string(name), metric.WithDescription(histogramDesc[name]), metric.WithUnit(histogramUnits[name]))
+	if err != nil {
+		return nil, err
+	}
+
+	histograms[name] = histogram
+
+	return histogram, nil
+}
+
+// GetGaugeFloat registers the gauge, the callback is called on each metrics collection.
+func GetGaugeFloat(name GaugeFloatType, callback metric.Float64Callback) (metric.Float64ObservableGauge, error) {
+	meterLock.Lock()
+	defer meterLock.Unlock()
+
+	if gauge, ok := gaugesFloat[name]; ok {
+		return gauge, nil
+	}
+
+	gauge, err := meter.Float64ObservableGauge(
+		string(name),
+		metric.WithDescription(gaugeFloatDesc[name]),
+		metric.WithUnit(gaugeFloatUnits[name]),
+		metric.WithFloat64Callback(callback),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	gaugesFloat[name] = gauge
+
+	return gauge, nil
+}
@@ -20,9 +20,22 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/idempotencykey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecret"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
@@ -43,12 +56,38 @@ type Client struct {
 	EnvAlias *EnvAliasClient
 	// EnvBuild is the client for interacting with the EnvBuild builders.
 	EnvBuild *EnvBuildClient
+	// EnvBuildLog is the client for interacting with the EnvBuildLog builders.
+	EnvBuildLog *EnvBuildLogClient
+	// EnvRebuildSchedule is the client for interacting with the EnvRebuildSchedule builders.
+	EnvRebuildSchedule *EnvRebuildScheduleClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// Kernel is the client for interacting with the Kernel builders.
+	Kernel *KernelClient
+	// PinnedBuild is the client for interacting with the PinnedBuild builders.
+	PinnedBuild *PinnedBuildClient
+	// Sandbox is the client for interacting with the Sandbox builders.
+	Sandbox *SandboxClient
+	// SandboxStart is the client for interacting with the SandboxStart builders.
+	SandboxStart *SandboxStartClient
 	// Snapshot is the client for interacting with the Snapshot builders.
 	Snapshot *SnapshotClient
 	// Team is the client for interacting with the Team builders.
 	Team *TeamClient
 	// TeamAPIKey is the client for interacting with the TeamAPIKey builders.
 	TeamAPIKey *TeamAPIKeyClient
+	// TeamBudget is the client for interacting with the TeamBudget builders.
+	TeamBudget *TeamBudgetClient
+	// TeamRegistryCredential is the client for interacting with the TeamRegistryCredential builders.
+	TeamRegistryCredential *TeamRegistryCredentialClient
+	// TeamSecret is the client for interacting with the TeamSecret builders.
+	TeamSecret *TeamSecretClient
+	// TeamSecretVersion is the client for interacting with the TeamSecretVersion builders.
+	TeamSecretVersion *TeamSecretVersionClient
+	// TeamTemplateStorage is the client for interacting with the TeamTemplateStorage builders.
+	TeamTemplateStorage *TeamTemplateStorageClient
+	// TeamUsageDaily is the client for interacting with the TeamUsageDaily builders.
+	TeamUsageDaily *TeamUsageDailyClient
 	// Tier is the client for interacting with the Tier builders.
 	Tier *TierClient
 	// User is the client for interacting with the User builders.
@@ -70,9 +109,22 @@ func (c *Client) init() {
 	c.Env = NewEnvClient(c.config)
 	c.EnvAlias = NewEnvAliasClient(c.config)
 	c.EnvBuild = NewEnvBuildClient(c.config)
+	c.EnvBuildLog = NewEnvBuildLogClient(c.config)
+	c.EnvRebuildSchedule = NewEnvRebuildScheduleClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.Kernel = NewKernelClient(c.config)
+	c.PinnedBuild = NewPinnedBuildClient(c.config)
+	c.Sandbox = NewSandboxClient(c.config)
+	c.SandboxStart = NewSandboxStartClient(c.config)
 	c.Snapshot = NewSnapshotClient(c.config)
 	c.Team = NewTeamClient(c.config)
 	c.TeamAPIKey = NewTeamAPIKeyClient(c.config)
+	c.TeamBudget = NewTeamBudgetClient(c.config)
+	c.TeamRegistryCredential = NewTeamRegistryCredentialClient(c.config)
+	c.TeamSecret = NewTeamSecretClient(c.config)
+	c.TeamSecretVersion = NewTeamSecretVersionClient(c.config)
+	c.TeamTemplateStorage = NewTeamTemplateStorageClient(c.config)
+	c.TeamUsageDaily = NewTeamUsageDailyClient(c.config)
 	c.Tier = NewTierClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UsersTeams = NewUsersTeamsClient(c.config)
@@ -169,18 +221,31 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AccessToken: NewAccessTokenClient(cfg),
-		Env:         NewEnvClient(cfg),
-		EnvAlias:    NewEnvAliasClient(cfg),
-		EnvBuild:    NewEnvBuildClient(cfg),
-		Snapshot:    NewSnapshotClient(cfg),
-		Team:        NewTeamClient(cfg),
-		TeamAPIKey:  NewTeamAPIKeyClient(cfg),
-		Tier:        NewTierClient(cfg),
-		User:        NewUserClient(cfg),
-		UsersTeams:  NewUsersTeamsClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AccessToken:            NewAccessTokenClient(cfg),
+		Env:                    NewEnvClient(cfg),
+		EnvAlias:               NewEnvAliasClient(cfg),
+		EnvBuild:               NewEnvBuildClient(cfg),
+		EnvBuildLog:            NewEnvBuildLogClient(cfg),
+		EnvRebuildSchedule:     NewEnvRebuildScheduleClient(cfg),
+		IdempotencyKey:         NewIdempotencyKeyClient(cfg),
+		Kernel:                 NewKernelClient(cfg),
+		PinnedBuild:            NewPinnedBuildClient(cfg),
+		Sandbox:                NewSandboxClient(cfg),
+		SandboxStart:           NewSandboxStartClient(cfg),
+		Snapshot:               NewSnapshotClient(cfg),
+		Team:                   NewTeamClient(cfg),
+		TeamAPIKey:             NewTeamAPIKeyClient(cfg),
+		TeamBudget:             NewTeamBudgetClient(cfg),
+		TeamRegistryCredential: NewTeamRegistryCredentialClient(cfg),
+		TeamSecret:             NewTeamSecretClient(cfg),
+		TeamSecretVersion:      NewTeamSecretVersionClient(cfg),
+		TeamTemplateStorage:    NewTeamTemplateStorageClient(cfg),
+		TeamUsageDaily:         NewTeamUsageDailyClient(cfg),
+		Tier:                   NewTierClient(cfg),
+		User:                   NewUserClient(cfg),
+		UsersTeams:             NewUsersTeamsClient(cfg),
 	}, nil
 }
 
@@ -198,18 +263,31 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AccessToken: NewAccessTokenClient(cfg),
-		Env:         NewEnvClient(cfg),
-		EnvAlias:    NewEnvAliasClient(cfg),
-		EnvBuild:    NewEnvBuildClient(cfg),
-		Snapshot:    NewSnapshotClient(cfg),
-		Team:        NewTeamClient(cfg),
-		TeamAPIKey:  NewTeamAPIKeyClient(cfg),
-		Tier:        NewTierClient(cfg),
-		User:        NewUserClient(cfg),
-		UsersTeams:  NewUsersTeamsClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AccessToken:            NewAccessTokenClient(cfg),
+		Env:                    NewEnvClient(cfg),
+		EnvAlias:               NewEnvAliasClient(cfg),
+		EnvBuild:               NewEnvBuildClient(cfg),
+		EnvBuildLog:            NewEnvBuildLogClient(cfg),
+		EnvRebuildSchedule:     NewEnvRebuildScheduleClient(cfg),
+		IdempotencyKey:         NewIdempotencyKeyClient(cfg),
+		Kernel:                 NewKernelClient(cfg),
+		PinnedBuild:            NewPinnedBuildClient(cfg),
+		Sandbox:                NewSandboxClient(cfg),
+		SandboxStart:           NewSandboxStartClient(cfg),
+		Snapshot:               NewSnapshotClient(cfg),
+		Team:                   NewTeamClient(cfg),
+		TeamAPIKey:             NewTeamAPIKeyClient(cfg),
+		TeamBudget:             NewTeamBudgetClient(cfg),
+		TeamRegistryCredential: NewTeamRegistryCredentialClient(cfg),
+		TeamSecret:             NewTeamSecretClient(cfg),
+		TeamSecretVersion:      NewTeamSecretVersionClient(cfg),
+		TeamTemplateStorage:    NewTeamTemplateStorageClient(cfg),
+		TeamUsageDaily:         NewTeamUsageDailyClient(cfg),
+		Tier:                   NewTierClient(cfg),
+		User:                   NewUserClient(cfg),
+		UsersTeams:             NewUsersTeamsClient(cfg),
 	}, nil
 }
 
@@ -239,8 +317,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AccessToken, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team, c.TeamAPIKey,
-		c.Tier, c.User, c.UsersTeams,
+		c.AccessToken, c.Env, c.EnvAlias, c.EnvBuild, c.EnvBuildLog,
+		c.EnvRebuildSchedule, c.IdempotencyKey, c.Kernel, c.PinnedBuild, c.Sandbox,
+		c.SandboxStart, c.Snapshot, c.Team, c.TeamAPIKey, c.TeamBudget,
+		c.TeamRegistryCredential, c.TeamSecret, c.TeamSecretVersion,
+		c.TeamTemplateStorage, c.TeamUsageDaily, c.Tier, c.User, c.UsersTeams,
 	} {
 		n.Use(hooks...)
 	}
@@ -250,8 +331,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AccessToken, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team, c.TeamAPIKey,
-		c.Tier, c.User, c.UsersTeams,
+		c.AccessToken, c.Env, c.EnvAlias, c.EnvBuild, c.EnvBuildLog,
+		c.EnvRebuildSchedule, c.IdempotencyKey, c.Kernel, c.PinnedBuild, c.Sandbox,
+		c.SandboxStart, c.Snapshot, c.Team, c.TeamAPIKey, c.TeamBudget,
+		c.TeamRegistryCredential, c.TeamSecret, c.TeamSecretVersion,
+		c.TeamTemplateStorage, c.TeamUsageDaily, c.Tier, c.User, c.UsersTeams,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -268,12 +352,38 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.EnvAlias.mutate(ctx, m)
 	case *EnvBuildMutation:
 		return c.EnvBuild.mutate(ctx, m)
+	case *EnvBuildLogMutation:
+		return c.EnvBuildLog.mutate(ctx, m)
+	case *EnvRebuildScheduleMutation:
+		return c.EnvRebuildSchedule.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *KernelMutation:
+		return c.Kernel.mutate(ctx, m)
+	case *PinnedBuildMutation:
+		return c.PinnedBuild.mutate(ctx, m)
+	case *SandboxMutation:
+		return c.Sandbox.mutate(ctx, m)
+	case *SandboxStartMutation:
+		return c.SandboxStart.mutate(ctx, m)
 	case *SnapshotMutation:
 		return c.Snapshot.mutate(ctx, m)
 	case *TeamMutation:
 		return c.Team.mutate(ctx, m)
 	case *TeamAPIKeyMutation:
 		return c.TeamAPIKey.mutate(ctx, m)
+	case *TeamBudgetMutation:
+		return c.TeamBudget.mutate(ctx, m)
+	case *TeamRegistryCredentialMutation:
+		return c.TeamRegistryCredential.mutate(ctx, m)
+	case *TeamSecretMutation:
+		return c.TeamSecret.mutate(ctx, m)
+	case *TeamSecretVersionMutation:
+		return c.TeamSecretVersion.mutate(ctx, m)
+	case *TeamTemplateStorageMutation:
+		return c.TeamTemplateStorage.mutate(ctx, m)
+	case *TeamUsageDailyMutation:
+		return c.TeamUsageDaily.mutate(ctx, m)
 	case *TierMutation:
 		return c.Tier.mutate(ctx, m)
 	case *UserMutation:
@@ -969,107 +1079,107 @@ func (c *EnvBuildClient) mutate(ctx context.Context, m *EnvBuildMutation) (Value
 	}
 }
 
-// SnapshotClient is a client for the Snapshot schema.
-type SnapshotClient struct {
+// EnvBuildLogClient is a client for the EnvBuildLog schema.
+type EnvBuildLogClient struct {
 	config
 }
 
-// NewSnapshotClient returns a client for the Snapshot from the given config.
-func NewSnapshotClient(c config) *SnapshotClient {
-	return &SnapshotClient{config: c}
+// NewEnvBuildLogClient returns a client for the EnvBuildLog from the given config.
+func NewEnvBuildLogClient(c config) *EnvBuildLogClient {
+	return &EnvBuildLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `snapshot.Hooks(f(g(h())))`.
-func (c *SnapshotClient) Use(hooks ...Hook) {
-	c.hooks.Snapshot = append(c.hooks.Snapshot, hooks...)
+// A call to `Use(f, g, h)` equals to `envbuildlog.Hooks(f(g(h())))`.
+func (c *EnvBuildLogClient) Use(hooks ...Hook) {
+	c.hooks.EnvBuildLog = append(c.hooks.EnvBuildLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `snapshot.Intercept(f(g(h())))`.
-func (c *SnapshotClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Snapshot = append(c.inters.Snapshot, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `envbuildlog.Intercept(f(g(h())))`.
+func (c *EnvBuildLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnvBuildLog = append(c.inters.EnvBuildLog, interceptors...)
 }
 
-// Create returns a builder for creating a Snapshot entity.
-func (c *SnapshotClient) Create() *SnapshotCreate {
-	mutation := newSnapshotMutation(c.config, OpCreate)
-	return &SnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EnvBuildLog entity.
+func (c *EnvBuildLogClient) Create() *EnvBuildLogCreate {
+	mutation := newEnvBuildLogMutation(c.config, OpCreate)
+	return &EnvBuildLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Snapshot entities.
-func (c *SnapshotClient) CreateBulk(builders ...*SnapshotCreate) *SnapshotCreateBulk {
-	return &SnapshotCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EnvBuildLog entities.
+func (c *EnvBuildLogClient) CreateBulk(builders ...*EnvBuildLogCreate) *EnvBuildLogCreateBulk {
+	return &EnvBuildLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SnapshotClient) MapCreateBulk(slice any, setFunc func(*SnapshotCreate, int)) *SnapshotCreateBulk {
+func (c *EnvBuildLogClient) MapCreateBulk(slice any, setFunc func(*EnvBuildLogCreate, int)) *EnvBuildLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SnapshotCreateBulk{err: fmt.Errorf("calling to SnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EnvBuildLogCreateBulk{err: fmt.Errorf("calling to EnvBuildLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SnapshotCreate, rv.Len())
+	builders := make([]*EnvBuildLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SnapshotCreateBulk{config: c.config, builders: builders}
+	return &EnvBuildLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Snapshot.
-func (c *SnapshotClient) Update() *SnapshotUpdate {
-	mutation := newSnapshotMutation(c.config, OpUpdate)
-	return &SnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EnvBuildLog.
+func (c *EnvBuildLogClient) Update() *EnvBuildLogUpdate {
+	mutation := newEnvBuildLogMutation(c.config, OpUpdate)
+	return &EnvBuildLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SnapshotClient) UpdateOne(s *Snapshot) *SnapshotUpdateOne {
-	mutation := newSnapshotMutation(c.config, OpUpdateOne, withSnapshot(s))
-	return &SnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnvBuildLogClient) UpdateOne(ebl *EnvBuildLog) *EnvBuildLogUpdateOne {
+	mutation := newEnvBuildLogMutation(c.config, OpUpdateOne, withEnvBuildLog(ebl))
+	return &EnvBuildLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SnapshotClient) UpdateOneID(id uuid.UUID) *SnapshotUpdateOne {
-	mutation := newSnapshotMutation(c.config, OpUpdateOne, withSnapshotID(id))
-	return &SnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnvBuildLogClient) UpdateOneID(id int) *EnvBuildLogUpdateOne {
+	mutation := newEnvBuildLogMutation(c.config, OpUpdateOne, withEnvBuildLogID(id))
+	return &EnvBuildLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Snapshot.
-func (c *SnapshotClient) Delete() *SnapshotDelete {
-	mutation := newSnapshotMutation(c.config, OpDelete)
-	return &SnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EnvBuildLog.
+func (c *EnvBuildLogClient) Delete() *EnvBuildLogDelete {
+	mutation := newEnvBuildLogMutation(c.config, OpDelete)
+	return &EnvBuildLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SnapshotClient) DeleteOne(s *Snapshot) *SnapshotDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *EnvBuildLogClient) DeleteOne(ebl *EnvBuildLog) *EnvBuildLogDeleteOne {
+	return c.DeleteOneID(ebl.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SnapshotClient) DeleteOneID(id uuid.UUID) *SnapshotDeleteOne {
-	builder := c.Delete().Where(snapshot.ID(id))
+func (c *EnvBuildLogClient) DeleteOneID(id int) *EnvBuildLogDeleteOne {
+	builder := c.Delete().Where(envbuildlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SnapshotDeleteOne{builder}
+	return &EnvBuildLogDeleteOne{builder}
 }
 
-// Query returns a query builder for Snapshot.
-func (c *SnapshotClient) Query() *SnapshotQuery {
-	return &SnapshotQuery{
+// Query returns a query builder for EnvBuildLog.
+func (c *EnvBuildLogClient) Query() *EnvBuildLogQuery {
+	return &EnvBuildLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSnapshot},
+		ctx:    &QueryContext{Type: TypeEnvBuildLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Snapshot entity by its id.
-func (c *SnapshotClient) Get(ctx context.Context, id uuid.UUID) (*Snapshot, error) {
-	return c.Query().Where(snapshot.ID(id)).Only(ctx)
+// Get returns a EnvBuildLog entity by its id.
+func (c *EnvBuildLogClient) Get(ctx context.Context, id int) (*EnvBuildLog, error) {
+	return c.Query().Where(envbuildlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SnapshotClient) GetX(ctx context.Context, id uuid.UUID) *Snapshot {
+func (c *EnvBuildLogClient) GetX(ctx context.Context, id int) *EnvBuildLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1077,151 +1187,132 @@ func (c *SnapshotClient) GetX(ctx context.Context, id uuid.UUID) *Snapshot {
 	return obj
 }
 
-// QueryEnv queries the env edge of a Snapshot.
-func (c *SnapshotClient) QueryEnv(s *Snapshot) *EnvQuery {
-	query := (&EnvClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
-			sqlgraph.To(env.Table, env.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, snapshot.EnvTable, snapshot.EnvColumn),
-		)
-		schemaConfig := s.schemaConfig
-		step.To.Schema = schemaConfig.Env
-		step.Edge.Schema = schemaConfig.Snapshot
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *SnapshotClient) Hooks() []Hook {
-	return c.hooks.Snapshot
+func (c *EnvBuildLogClient) Hooks() []Hook {
+	return c.hooks.EnvBuildLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *SnapshotClient) Interceptors() []Interceptor {
-	return c.inters.Snapshot
+func (c *EnvBuildLogClient) Interceptors() []Interceptor {
+	return c.inters.EnvBuildLog
 }
 
-func (c *SnapshotClient) mutate(ctx context.Context, m *SnapshotMutation) (Value, error) {
+func (c *EnvBuildLogClient) mutate(ctx context.Context, m *EnvBuildLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnvBuildLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnvBuildLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnvBuildLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EnvBuildLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("models: unknown Snapshot mutation op: %q", m.Op())
+		return nil, fmt.Errorf("models: unknown EnvBuildLog mutation op: %q", m.Op())
 	}
 }
 
-// TeamClient is a client for the Team schema.
-type TeamClient struct {
+// EnvRebuildScheduleClient is a client for the EnvRebuildSchedule schema.
+type EnvRebuildScheduleClient struct {
 	config
 }
 
-// NewTeamClient returns a client for the Team from the given config.
-func NewTeamClient(c config) *TeamClient {
-	return &TeamClient{config: c}
+// NewEnvRebuildScheduleClient returns a client for the EnvRebuildSchedule from the given config.
+func NewEnvRebuildScheduleClient(c config) *EnvRebuildScheduleClient {
+	return &EnvRebuildScheduleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `team.Hooks(f(g(h())))`.
-func (c *TeamClient) Use(hooks ...Hook) {
-	c.hooks.Team = append(c.hooks.Team, hooks...)
+// A call to `Use(f, g, h)` equals to `envrebuildschedule.Hooks(f(g(h())))`.
+func (c *EnvRebuildScheduleClient) Use(hooks ...Hook) {
+	c.hooks.EnvRebuildSchedule = append(c.hooks.EnvRebuildSchedule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `team.Intercept(f(g(h())))`.
-func (c *TeamClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Team = append(c.inters.Team, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `envrebuildschedule.Intercept(f(g(h())))`.
+func (c *EnvRebuildScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnvRebuildSchedule = append(c.inters.EnvRebuildSchedule, interceptors...)
 }
 
-// Create returns a builder for creating a Team entity.
-func (c *TeamClient) Create() *TeamCreate {
-	mutation := newTeamMutation(c.config, OpCreate)
-	return &TeamCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EnvRebuildSchedule entity.
+func (c *EnvRebuildScheduleClient) Create() *EnvRebuildScheduleCreate {
+	mutation := newEnvRebuildScheduleMutation(c.config, OpCreate)
+	return &EnvRebuildScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Team entities.
-func (c *TeamClient) CreateBulk(builders ...*TeamCreate) *TeamCreateBulk {
-	return &TeamCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EnvRebuildSchedule entities.
+func (c *EnvRebuildScheduleClient) CreateBulk(builders ...*EnvRebuildScheduleCreate) *EnvRebuildScheduleCreateBulk {
+	return &EnvRebuildScheduleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TeamClient) MapCreateBulk(slice any, setFunc func(*TeamCreate, int)) *TeamCreateBulk {
+func (c *EnvRebuildScheduleClient) MapCreateBulk(slice any, setFunc func(*EnvRebuildScheduleCreate, int)) *EnvRebuildScheduleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TeamCreateBulk{err: fmt.Errorf("calling to TeamClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EnvRebuildScheduleCreateBulk{err: fmt.Errorf("calling to EnvRebuildScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TeamCreate, rv.Len())
+	builders := make([]*EnvRebuildScheduleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TeamCreateBulk{config: c.config, builders: builders}
+	return &EnvRebuildScheduleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Team.
-func (c *TeamClient) Update() *TeamUpdate {
-	mutation := newTeamMutation(c.config, OpUpdate)
-	return &TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EnvRebuildSchedule.
+func (c *EnvRebuildScheduleClient) Update() *EnvRebuildScheduleUpdate {
+	mutation := newEnvRebuildScheduleMutation(c.config, OpUpdate)
+	return &EnvRebuildScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TeamClient) UpdateOne(t *Team) *TeamUpdateOne {
-	mutation := newTeamMutation(c.config, OpUpdateOne, withTeam(t))
-	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnvRebuildScheduleClient) UpdateOne(ers *EnvRebuildSchedule) *EnvRebuildScheduleUpdateOne {
+	mutation := newEnvRebuildScheduleMutation(c.config, OpUpdateOne, withEnvRebuildSchedule(ers))
+	return &EnvRebuildScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TeamClient) UpdateOneID(id uuid.UUID) *TeamUpdateOne {
-	mutation := newTeamMutation(c.config, OpUpdateOne, withTeamID(id))
-	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnvRebuildScheduleClient) UpdateOneID(id uuid.UUID) *EnvRebuildScheduleUpdateOne {
+	mutation := newEnvRebuildScheduleMutation(c.config, OpUpdateOne, withEnvRebuildScheduleID(id))
+	return &EnvRebuildScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Team.
-func (c *TeamClient) Delete() *TeamDelete {
-	mutation := newTeamMutation(c.config, OpDelete)
-	return &TeamDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EnvRebuildSchedule.
+func (c *EnvRebuildScheduleClient) Delete() *EnvRebuildScheduleDelete {
+	mutation := newEnvRebuildScheduleMutation(c.config, OpDelete)
+	return &EnvRebuildScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TeamClient) DeleteOne(t *Team) *TeamDeleteOne {
-	return c.DeleteOneID(t.ID)
+func (c *EnvRebuildScheduleClient) DeleteOne(ers *EnvRebuildSchedule) *EnvRebuildScheduleDeleteOne {
+	return c.DeleteOneID(ers.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TeamClient) DeleteOneID(id uuid.UUID) *TeamDeleteOne {
-	builder := c.Delete().Where(team.ID(id))
+func (c *EnvRebuildScheduleClient) DeleteOneID(id uuid.UUID) *EnvRebuildScheduleDeleteOne {
+	builder := c.Delete().Where(envrebuildschedule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TeamDeleteOne{builder}
+	return &EnvRebuildScheduleDeleteOne{builder}
 }
 
-// Query returns a query builder for Team.
-func (c *TeamClient) Query() *TeamQuery {
-	return &TeamQuery{
+// Query returns a query builder for EnvRebuildSchedule.
+func (c *EnvRebuildScheduleClient) Query() *EnvRebuildScheduleQuery {
+	return &EnvRebuildScheduleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTeam},
+		ctx:    &QueryContext{Type: TypeEnvRebuildSchedule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Team entity by its id.
-func (c *TeamClient) Get(ctx context.Context, id uuid.UUID) (*Team, error) {
-	return c.Query().Where(team.ID(id)).Only(ctx)
+// Get returns a EnvRebuildSchedule entity by its id.
+func (c *EnvRebuildScheduleClient) Get(ctx context.Context, id uuid.UUID) (*EnvRebuildSchedule, error) {
+	return c.Query().Where(envrebuildschedule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TeamClient) GetX(ctx context.Context, id uuid.UUID) *Team {
+func (c *EnvRebuildScheduleClient) GetX(ctx context.Context, id uuid.UUID) *EnvRebuildSchedule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1229,227 +1320,1747 @@ func (c *TeamClient) GetX(ctx context.Context, id uuid.UUID) *Team {
 	return obj
 }
 
-// QueryUsers queries the users edge of a Team.
-func (c *TeamClient) QueryUsers(t *Team) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(team.Table, team.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, team.UsersTable, team.UsersPrimaryKey...),
-		)
-		schemaConfig := t.schemaConfig
-		step.To.Schema = schemaConfig.User
-		step.Edge.Schema = schemaConfig.UsersTeams
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTeamAPIKeys queries the team_api_keys edge of a Team.
-func (c *TeamClient) QueryTeamAPIKeys(t *Team) *TeamAPIKeyQuery {
-	query := (&TeamAPIKeyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(team.Table, team.FieldID, id),
-			sqlgraph.To(teamapikey.Table, teamapikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamAPIKeysTable, team.TeamAPIKeysColumn),
-		)
-		schemaConfig := t.schemaConfig
-		step.To.Schema = schemaConfig.TeamAPIKey
-		step.Edge.Schema = schemaConfig.TeamAPIKey
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTeamTier queries the team_tier edge of a Team.
-func (c *TeamClient) QueryTeamTier(t *Team) *TierQuery {
-	query := (&TierClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(team.Table, team.FieldID, id),
-			sqlgraph.To(tier.Table, tier.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, team.TeamTierTable, team.TeamTierColumn),
-		)
-		schemaConfig := t.schemaConfig
-		step.To.Schema = schemaConfig.Tier
-		step.Edge.Schema = schemaConfig.Team
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEnvs queries the envs edge of a Team.
-func (c *TeamClient) QueryEnvs(t *Team) *EnvQuery {
-	query := (&EnvClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(team.Table, team.FieldID, id),
-			sqlgraph.To(env.Table, env.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, team.EnvsTable, team.EnvsColumn),
-		)
-		schemaConfig := t.schemaConfig
-		step.To.Schema = schemaConfig.Env
-		step.Edge.Schema = schemaConfig.Env
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUsersTeams queries the users_teams edge of a Team.
-func (c *TeamClient) QueryUsersTeams(t *Team) *UsersTeamsQuery {
-	query := (&UsersTeamsClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(team.Table, team.FieldID, id),
-			sqlgraph.To(usersteams.Table, usersteams.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, team.UsersTeamsTable, team.UsersTeamsColumn),
-		)
-		schemaConfig := t.schemaConfig
-		step.To.Schema = schemaConfig.UsersTeams
-		step.Edge.Schema = schemaConfig.UsersTeams
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *TeamClient) Hooks() []Hook {
-	return c.hooks.Team
+func (c *EnvRebuildScheduleClient) Hooks() []Hook {
+	return c.hooks.EnvRebuildSchedule
 }
 
 // Interceptors returns the client interceptors.
-func (c *TeamClient) Interceptors() []Interceptor {
-	return c.inters.Team
+func (c *EnvRebuildScheduleClient) Interceptors() []Interceptor {
+	return c.inters.EnvRebuildSchedule
 }
 
-func (c *TeamClient) mutate(ctx context.Context, m *TeamMutation) (Value, error) {
+func (c *EnvRebuildScheduleClient) mutate(ctx context.Context, m *EnvRebuildScheduleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TeamCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnvRebuildScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnvRebuildScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnvRebuildScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TeamDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EnvRebuildScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("models: unknown Team mutation op: %q", m.Op())
+		return nil, fmt.Errorf("models: unknown EnvRebuildSchedule mutation op: %q", m.Op())
 	}
 }
 
-// TeamAPIKeyClient is a client for the TeamAPIKey schema.
-type TeamAPIKeyClient struct {
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
 	config
 }
 
-// NewTeamAPIKeyClient returns a client for the TeamAPIKey from the given config.
-func NewTeamAPIKeyClient(c config) *TeamAPIKeyClient {
-	return &TeamAPIKeyClient{config: c}
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `teamapikey.Hooks(f(g(h())))`.
-func (c *TeamAPIKeyClient) Use(hooks ...Hook) {
-	c.hooks.TeamAPIKey = append(c.hooks.TeamAPIKey, hooks...)
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `teamapikey.Intercept(f(g(h())))`.
-func (c *TeamAPIKeyClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TeamAPIKey = append(c.inters.TeamAPIKey, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
 }
 
-// Create returns a builder for creating a TeamAPIKey entity.
-func (c *TeamAPIKeyClient) Create() *TeamAPIKeyCreate {
-	mutation := newTeamAPIKeyMutation(c.config, OpCreate)
-	return &TeamAPIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TeamAPIKey entities.
-func (c *TeamAPIKeyClient) CreateBulk(builders ...*TeamAPIKeyCreate) *TeamAPIKeyCreateBulk {
-	return &TeamAPIKeyCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TeamAPIKeyClient) MapCreateBulk(slice any, setFunc func(*TeamAPIKeyCreate, int)) *TeamAPIKeyCreateBulk {
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TeamAPIKeyCreateBulk{err: fmt.Errorf("calling to TeamAPIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TeamAPIKeyCreate, rv.Len())
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TeamAPIKeyCreateBulk{config: c.config, builders: builders}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TeamAPIKey.
-func (c *TeamAPIKeyClient) Update() *TeamAPIKeyUpdate {
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(ik *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(ik))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id uuid.UUID) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(ik *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(ik.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id uuid.UUID) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id uuid.UUID) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id uuid.UUID) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// KernelClient is a client for the Kernel schema.
+type KernelClient struct {
+	config
+}
+
+// NewKernelClient returns a client for the Kernel from the given config.
+func NewKernelClient(c config) *KernelClient {
+	return &KernelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `kernel.Hooks(f(g(h())))`.
+func (c *KernelClient) Use(hooks ...Hook) {
+	c.hooks.Kernel = append(c.hooks.Kernel, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `kernel.Intercept(f(g(h())))`.
+func (c *KernelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Kernel = append(c.inters.Kernel, interceptors...)
+}
+
+// Create returns a builder for creating a Kernel entity.
+func (c *KernelClient) Create() *KernelCreate {
+	mutation := newKernelMutation(c.config, OpCreate)
+	return &KernelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Kernel entities.
+func (c *KernelClient) CreateBulk(builders ...*KernelCreate) *KernelCreateBulk {
+	return &KernelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KernelClient) MapCreateBulk(slice any, setFunc func(*KernelCreate, int)) *KernelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KernelCreateBulk{err: fmt.Errorf("calling to KernelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KernelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KernelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Kernel.
+func (c *KernelClient) Update() *KernelUpdate {
+	mutation := newKernelMutation(c.config, OpUpdate)
+	return &KernelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KernelClient) UpdateOne(k *Kernel) *KernelUpdateOne {
+	mutation := newKernelMutation(c.config, OpUpdateOne, withKernel(k))
+	return &KernelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KernelClient) UpdateOneID(id string) *KernelUpdateOne {
+	mutation := newKernelMutation(c.config, OpUpdateOne, withKernelID(id))
+	return &KernelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Kernel.
+func (c *KernelClient) Delete() *KernelDelete {
+	mutation := newKernelMutation(c.config, OpDelete)
+	return &KernelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KernelClient) DeleteOne(k *Kernel) *KernelDeleteOne {
+	return c.DeleteOneID(k.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KernelClient) DeleteOneID(id string) *KernelDeleteOne {
+	builder := c.Delete().Where(kernel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KernelDeleteOne{builder}
+}
+
+// Query returns a query builder for Kernel.
+func (c *KernelClient) Query() *KernelQuery {
+	return &KernelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKernel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Kernel entity by its id.
+func (c *KernelClient) Get(ctx context.Context, id string) (*Kernel, error) {
+	return c.Query().Where(kernel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KernelClient) GetX(ctx context.Context, id string) *Kernel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KernelClient) Hooks() []Hook {
+	return c.hooks.Kernel
+}
+
+// Interceptors returns the client interceptors.
+func (c *KernelClient) Interceptors() []Interceptor {
+	return c.inters.Kernel
+}
+
+func (c *KernelClient) mutate(ctx context.Context, m *KernelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KernelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KernelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KernelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KernelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown Kernel mutation op: %q", m.Op())
+	}
+}
+
+// PinnedBuildClient is a client for the PinnedBuild schema.
+type PinnedBuildClient struct {
+	config
+}
+
+// NewPinnedBuildClient returns a client for the PinnedBuild from the given config.
+func NewPinnedBuildClient(c config) *PinnedBuildClient {
+	return &PinnedBuildClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pinnedbuild.Hooks(f(g(h())))`.
+func (c *PinnedBuildClient) Use(hooks ...Hook) {
+	c.hooks.PinnedBuild = append(c.hooks.PinnedBuild, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pinnedbuild.Intercept(f(g(h())))`.
+func (c *PinnedBuildClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PinnedBuild = append(c.inters.PinnedBuild, interceptors...)
+}
+
+// Create returns a builder for creating a PinnedBuild entity.
+func (c *PinnedBuildClient) Create() *PinnedBuildCreate {
+	mutation := newPinnedBuildMutation(c.config, OpCreate)
+	return &PinnedBuildCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PinnedBuild entities.
+func (c *PinnedBuildClient) CreateBulk(builders ...*PinnedBuildCreate) *PinnedBuildCreateBulk {
+	return &PinnedBuildCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PinnedBuildClient) MapCreateBulk(slice any, setFunc func(*PinnedBuildCreate, int)) *PinnedBuildCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PinnedBuildCreateBulk{err: fmt.Errorf("calling to PinnedBuildClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PinnedBuildCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PinnedBuildCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PinnedBuild.
+func (c *PinnedBuildClient) Update() *PinnedBuildUpdate {
+	mutation := newPinnedBuildMutation(c.config, OpUpdate)
+	return &PinnedBuildUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PinnedBuildClient) UpdateOne(pb *PinnedBuild) *PinnedBuildUpdateOne {
+	mutation := newPinnedBuildMutation(c.config, OpUpdateOne, withPinnedBuild(pb))
+	return &PinnedBuildUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PinnedBuildClient) UpdateOneID(id uuid.UUID) *PinnedBuildUpdateOne {
+	mutation := newPinnedBuildMutation(c.config, OpUpdateOne, withPinnedBuildID(id))
+	return &PinnedBuildUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PinnedBuild.
+func (c *PinnedBuildClient) Delete() *PinnedBuildDelete {
+	mutation := newPinnedBuildMutation(c.config, OpDelete)
+	return &PinnedBuildDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PinnedBuildClient) DeleteOne(pb *PinnedBuild) *PinnedBuildDeleteOne {
+	return c.DeleteOneID(pb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PinnedBuildClient) DeleteOneID(id uuid.UUID) *PinnedBuildDeleteOne {
+	builder := c.Delete().Where(pinnedbuild.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PinnedBuildDeleteOne{builder}
+}
+
+// Query returns a query builder for PinnedBuild.
+func (c *PinnedBuildClient) Query() *PinnedBuildQuery {
+	return &PinnedBuildQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePinnedBuild},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PinnedBuild entity by its id.
+func (c *PinnedBuildClient) Get(ctx context.Context, id uuid.UUID) (*PinnedBuild, error) {
+	return c.Query().Where(pinnedbuild.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PinnedBuildClient) GetX(ctx context.Context, id uuid.UUID) *PinnedBuild {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PinnedBuildClient) Hooks() []Hook {
+	return c.hooks.PinnedBuild
+}
+
+// Interceptors returns the client interceptors.
+func (c *PinnedBuildClient) Interceptors() []Interceptor {
+	return c.inters.PinnedBuild
+}
+
+func (c *PinnedBuildClient) mutate(ctx context.Context, m *PinnedBuildMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PinnedBuildCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PinnedBuildUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PinnedBuildUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PinnedBuildDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown PinnedBuild mutation op: %q", m.Op())
+	}
+}
+
+// SandboxClient is a client for the Sandbox schema.
+type SandboxClient struct {
+	config
+}
+
+// NewSandboxClient returns a client for the Sandbox from the given config.
+func NewSandboxClient(c config) *SandboxClient {
+	return &SandboxClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sandbox.Hooks(f(g(h())))`.
+func (c *SandboxClient) Use(hooks ...Hook) {
+	c.hooks.Sandbox = append(c.hooks.Sandbox, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sandbox.Intercept(f(g(h())))`.
+func (c *SandboxClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Sandbox = append(c.inters.Sandbox, interceptors...)
+}
+
+// Create returns a builder for creating a Sandbox entity.
+func (c *SandboxClient) Create() *SandboxCreate {
+	mutation := newSandboxMutation(c.config, OpCreate)
+	return &SandboxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Sandbox entities.
+func (c *SandboxClient) CreateBulk(builders ...*SandboxCreate) *SandboxCreateBulk {
+	return &SandboxCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SandboxClient) MapCreateBulk(slice any, setFunc func(*SandboxCreate, int)) *SandboxCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SandboxCreateBulk{err: fmt.Errorf("calling to SandboxClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SandboxCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SandboxCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Sandbox.
+func (c *SandboxClient) Update() *SandboxUpdate {
+	mutation := newSandboxMutation(c.config, OpUpdate)
+	return &SandboxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SandboxClient) UpdateOne(s *Sandbox) *SandboxUpdateOne {
+	mutation := newSandboxMutation(c.config, OpUpdateOne, withSandbox(s))
+	return &SandboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SandboxClient) UpdateOneID(id string) *SandboxUpdateOne {
+	mutation := newSandboxMutation(c.config, OpUpdateOne, withSandboxID(id))
+	return &SandboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Sandbox.
+func (c *SandboxClient) Delete() *SandboxDelete {
+	mutation := newSandboxMutation(c.config, OpDelete)
+	return &SandboxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SandboxClient) DeleteOne(s *Sandbox) *SandboxDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SandboxClient) DeleteOneID(id string) *SandboxDeleteOne {
+	builder := c.Delete().Where(sandbox.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SandboxDeleteOne{builder}
+}
+
+// Query returns a query builder for Sandbox.
+func (c *SandboxClient) Query() *SandboxQuery {
+	return &SandboxQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSandbox},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Sandbox entity by its id.
+func (c *SandboxClient) Get(ctx context.Context, id string) (*Sandbox, error) {
+	return c.Query().Where(sandbox.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SandboxClient) GetX(ctx context.Context, id string) *Sandbox {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SandboxClient) Hooks() []Hook {
+	return c.hooks.Sandbox
+}
+
+// Interceptors returns the client interceptors.
+func (c *SandboxClient) Interceptors() []Interceptor {
+	return c.inters.Sandbox
+}
+
+func (c *SandboxClient) mutate(ctx context.Context, m *SandboxMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SandboxCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SandboxUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SandboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SandboxDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown Sandbox mutation op: %q", m.Op())
+	}
+}
+
+// SandboxStartClient is a client for the SandboxStart schema.
+type SandboxStartClient struct {
+	config
+}
+
+// NewSandboxStartClient returns a client for the SandboxStart from the given config.
+func NewSandboxStartClient(c config) *SandboxStartClient {
+	return &SandboxStartClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sandboxstart.Hooks(f(g(h())))`.
+func (c *SandboxStartClient) Use(hooks ...Hook) {
+	c.hooks.SandboxStart = append(c.hooks.SandboxStart, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sandboxstart.Intercept(f(g(h())))`.
+func (c *SandboxStartClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SandboxStart = append(c.inters.SandboxStart, interceptors...)
+}
+
+// Create returns a builder for creating a SandboxStart entity.
+func (c *SandboxStartClient) Create() *SandboxStartCreate {
+	mutation := newSandboxStartMutation(c.config, OpCreate)
+	return &SandboxStartCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SandboxStart entities.
+func (c *SandboxStartClient) CreateBulk(builders ...*SandboxStartCreate) *SandboxStartCreateBulk {
+	return &SandboxStartCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SandboxStartClient) MapCreateBulk(slice any, setFunc func(*SandboxStartCreate, int)) *SandboxStartCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SandboxStartCreateBulk{err: fmt.Errorf("calling to SandboxStartClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SandboxStartCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SandboxStartCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SandboxStart.
+func (c *SandboxStartClient) Update() *SandboxStartUpdate {
+	mutation := newSandboxStartMutation(c.config, OpUpdate)
+	return &SandboxStartUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SandboxStartClient) UpdateOne(ss *SandboxStart) *SandboxStartUpdateOne {
+	mutation := newSandboxStartMutation(c.config, OpUpdateOne, withSandboxStart(ss))
+	return &SandboxStartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SandboxStartClient) UpdateOneID(id int) *SandboxStartUpdateOne {
+	mutation := newSandboxStartMutation(c.config, OpUpdateOne, withSandboxStartID(id))
+	return &SandboxStartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SandboxStart.
+func (c *SandboxStartClient) Delete() *SandboxStartDelete {
+	mutation := newSandboxStartMutation(c.config, OpDelete)
+	return &SandboxStartDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SandboxStartClient) DeleteOne(ss *SandboxStart) *SandboxStartDeleteOne {
+	return c.DeleteOneID(ss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SandboxStartClient) DeleteOneID(id int) *SandboxStartDeleteOne {
+	builder := c.Delete().Where(sandboxstart.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SandboxStartDeleteOne{builder}
+}
+
+// Query returns a query builder for SandboxStart.
+func (c *SandboxStartClient) Query() *SandboxStartQuery {
+	return &SandboxStartQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSandboxStart},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SandboxStart entity by its id.
+func (c *SandboxStartClient) Get(ctx context.Context, id int) (*SandboxStart, error) {
+	return c.Query().Where(sandboxstart.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SandboxStartClient) GetX(ctx context.Context, id int) *SandboxStart {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SandboxStartClient) Hooks() []Hook {
+	return c.hooks.SandboxStart
+}
+
+// Interceptors returns the client interceptors.
+func (c *SandboxStartClient) Interceptors() []Interceptor {
+	return c.inters.SandboxStart
+}
+
+func (c *SandboxStartClient) mutate(ctx context.Context, m *SandboxStartMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SandboxStartCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SandboxStartUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SandboxStartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SandboxStartDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown SandboxStart mutation op: %q", m.Op())
+	}
+}
+
+// SnapshotClient is a client for the Snapshot schema.
+type SnapshotClient struct {
+	config
+}
+
+// NewSnapshotClient returns a client for the Snapshot from the given config.
+func NewSnapshotClient(c config) *SnapshotClient {
+	return &SnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `snapshot.Hooks(f(g(h())))`.
+func (c *SnapshotClient) Use(hooks ...Hook) {
+	c.hooks.Snapshot = append(c.hooks.Snapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `snapshot.Intercept(f(g(h())))`.
+func (c *SnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Snapshot = append(c.inters.Snapshot, interceptors...)
+}
+
+// Create returns a builder for creating a Snapshot entity.
+func (c *SnapshotClient) Create() *SnapshotCreate {
+	mutation := newSnapshotMutation(c.config, OpCreate)
+	return &SnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Snapshot entities.
+func (c *SnapshotClient) CreateBulk(builders ...*SnapshotCreate) *SnapshotCreateBulk {
+	return &SnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SnapshotClient) MapCreateBulk(slice any, setFunc func(*SnapshotCreate, int)) *SnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SnapshotCreateBulk{err: fmt.Errorf("calling to SnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Snapshot.
+func (c *SnapshotClient) Update() *SnapshotUpdate {
+	mutation := newSnapshotMutation(c.config, OpUpdate)
+	return &SnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SnapshotClient) UpdateOne(s *Snapshot) *SnapshotUpdateOne {
+	mutation := newSnapshotMutation(c.config, OpUpdateOne, withSnapshot(s))
+	return &SnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SnapshotClient) UpdateOneID(id uuid.UUID) *SnapshotUpdateOne {
+	mutation := newSnapshotMutation(c.config, OpUpdateOne, withSnapshotID(id))
+	return &SnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Snapshot.
+func (c *SnapshotClient) Delete() *SnapshotDelete {
+	mutation := newSnapshotMutation(c.config, OpDelete)
+	return &SnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SnapshotClient) DeleteOne(s *Snapshot) *SnapshotDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SnapshotClient) DeleteOneID(id uuid.UUID) *SnapshotDeleteOne {
+	builder := c.Delete().Where(snapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for Snapshot.
+func (c *SnapshotClient) Query() *SnapshotQuery {
+	return &SnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Snapshot entity by its id.
+func (c *SnapshotClient) Get(ctx context.Context, id uuid.UUID) (*Snapshot, error) {
+	return c.Query().Where(snapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SnapshotClient) GetX(ctx context.Context, id uuid.UUID) *Snapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnv queries the env edge of a Snapshot.
+func (c *SnapshotClient) QueryEnv(s *Snapshot) *EnvQuery {
+	query := (&EnvClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(env.Table, env.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, snapshot.EnvTable, snapshot.EnvColumn),
+		)
+		schemaConfig := s.schemaConfig
+		step.To.Schema = schemaConfig.Env
+		step.Edge.Schema = schemaConfig.Snapshot
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SnapshotClient) Hooks() []Hook {
+	return c.hooks.Snapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *SnapshotClient) Interceptors() []Interceptor {
+	return c.inters.Snapshot
+}
+
+func (c *SnapshotClient) mutate(ctx context.Context, m *SnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown Snapshot mutation op: %q", m.Op())
+	}
+}
+
+// TeamClient is a client for the Team schema.
+type TeamClient struct {
+	config
+}
+
+// NewTeamClient returns a client for the Team from the given config.
+func NewTeamClient(c config) *TeamClient {
+	return &TeamClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `team.Hooks(f(g(h())))`.
+func (c *TeamClient) Use(hooks ...Hook) {
+	c.hooks.Team = append(c.hooks.Team, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `team.Intercept(f(g(h())))`.
+func (c *TeamClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Team = append(c.inters.Team, interceptors...)
+}
+
+// Create returns a builder for creating a Team entity.
+func (c *TeamClient) Create() *TeamCreate {
+	mutation := newTeamMutation(c.config, OpCreate)
+	return &TeamCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Team entities.
+func (c *TeamClient) CreateBulk(builders ...*TeamCreate) *TeamCreateBulk {
+	return &TeamCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamClient) MapCreateBulk(slice any, setFunc func(*TeamCreate, int)) *TeamCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamCreateBulk{err: fmt.Errorf("calling to TeamClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Team.
+func (c *TeamClient) Update() *TeamUpdate {
+	mutation := newTeamMutation(c.config, OpUpdate)
+	return &TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamClient) UpdateOne(t *Team) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeam(t))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamClient) UpdateOneID(id uuid.UUID) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeamID(id))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Team.
+func (c *TeamClient) Delete() *TeamDelete {
+	mutation := newTeamMutation(c.config, OpDelete)
+	return &TeamDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamClient) DeleteOne(t *Team) *TeamDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamClient) DeleteOneID(id uuid.UUID) *TeamDeleteOne {
+	builder := c.Delete().Where(team.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamDeleteOne{builder}
+}
+
+// Query returns a query builder for Team.
+func (c *TeamClient) Query() *TeamQuery {
+	return &TeamQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeam},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Team entity by its id.
+func (c *TeamClient) Get(ctx context.Context, id uuid.UUID) (*Team, error) {
+	return c.Query().Where(team.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamClient) GetX(ctx context.Context, id uuid.UUID) *Team {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a Team.
+func (c *TeamClient) QueryUsers(t *Team) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, team.UsersTable, team.UsersPrimaryKey...),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.UsersTeams
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeamAPIKeys queries the team_api_keys edge of a Team.
+func (c *TeamClient) QueryTeamAPIKeys(t *Team) *TeamAPIKeyQuery {
+	query := (&TeamAPIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(teamapikey.Table, teamapikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamAPIKeysTable, team.TeamAPIKeysColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.TeamAPIKey
+		step.Edge.Schema = schemaConfig.TeamAPIKey
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeamTier queries the team_tier edge of a Team.
+func (c *TeamClient) QueryTeamTier(t *Team) *TierQuery {
+	query := (&TierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(tier.Table, tier.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, team.TeamTierTable, team.TeamTierColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.Tier
+		step.Edge.Schema = schemaConfig.Team
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvs queries the envs edge of a Team.
+func (c *TeamClient) QueryEnvs(t *Team) *EnvQuery {
+	query := (&EnvClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(env.Table, env.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.EnvsTable, team.EnvsColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.Env
+		step.Edge.Schema = schemaConfig.Env
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsersTeams queries the users_teams edge of a Team.
+func (c *TeamClient) QueryUsersTeams(t *Team) *UsersTeamsQuery {
+	query := (&UsersTeamsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(usersteams.Table, usersteams.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, team.UsersTeamsTable, team.UsersTeamsColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.UsersTeams
+		step.Edge.Schema = schemaConfig.UsersTeams
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamClient) Hooks() []Hook {
+	return c.hooks.Team
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamClient) Interceptors() []Interceptor {
+	return c.inters.Team
+}
+
+func (c *TeamClient) mutate(ctx context.Context, m *TeamMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown Team mutation op: %q", m.Op())
+	}
+}
+
+// TeamAPIKeyClient is a client for the TeamAPIKey schema.
+type TeamAPIKeyClient struct {
+	config
+}
+
+// NewTeamAPIKeyClient returns a client for the TeamAPIKey from the given config.
+func NewTeamAPIKeyClient(c config) *TeamAPIKeyClient {
+	return &TeamAPIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teamapikey.Hooks(f(g(h())))`.
+func (c *TeamAPIKeyClient) Use(hooks ...Hook) {
+	c.hooks.TeamAPIKey = append(c.hooks.TeamAPIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teamapikey.Intercept(f(g(h())))`.
+func (c *TeamAPIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamAPIKey = append(c.inters.TeamAPIKey, interceptors...)
+}
+
+// Create returns a builder for creating a TeamAPIKey entity.
+func (c *TeamAPIKeyClient) Create() *TeamAPIKeyCreate {
+	mutation := newTeamAPIKeyMutation(c.config, OpCreate)
+	return &TeamAPIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamAPIKey entities.
+func (c *TeamAPIKeyClient) CreateBulk(builders ...*TeamAPIKeyCreate) *TeamAPIKeyCreateBulk {
+	return &TeamAPIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamAPIKeyClient) MapCreateBulk(slice any, setFunc func(*TeamAPIKeyCreate, int)) *TeamAPIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamAPIKeyCreateBulk{err: fmt.Errorf("calling to TeamAPIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamAPIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamAPIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamAPIKey.
+func (c *TeamAPIKeyClient) Update() *TeamAPIKeyUpdate {
 	mutation := newTeamAPIKeyMutation(c.config, OpUpdate)
 	return &TeamAPIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TeamAPIKeyClient) UpdateOne(tak *TeamAPIKey) *TeamAPIKeyUpdateOne {
-	mutation := newTeamAPIKeyMutation(c.config, OpUpdateOne, withTeamAPIKey(tak))
-	return &TeamAPIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TeamAPIKeyClient) UpdateOne(tak *TeamAPIKey) *TeamAPIKeyUpdateOne {
+	mutation := newTeamAPIKeyMutation(c.config, OpUpdateOne, withTeamAPIKey(tak))
+	return &TeamAPIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamAPIKeyClient) UpdateOneID(id uuid.UUID) *TeamAPIKeyUpdateOne {
+	mutation := newTeamAPIKeyMutation(c.config, OpUpdateOne, withTeamAPIKeyID(id))
+	return &TeamAPIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamAPIKey.
+func (c *TeamAPIKeyClient) Delete() *TeamAPIKeyDelete {
+	mutation := newTeamAPIKeyMutation(c.config, OpDelete)
+	return &TeamAPIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamAPIKeyClient) DeleteOne(tak *TeamAPIKey) *TeamAPIKeyDeleteOne {
+	return c.DeleteOneID(tak.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamAPIKeyClient) DeleteOneID(id uuid.UUID) *TeamAPIKeyDeleteOne {
+	builder := c.Delete().Where(teamapikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamAPIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamAPIKey.
+func (c *TeamAPIKeyClient) Query() *TeamAPIKeyQuery {
+	return &TeamAPIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamAPIKey entity by its id.
+func (c *TeamAPIKeyClient) Get(ctx context.Context, id uuid.UUID) (*TeamAPIKey, error) {
+	return c.Query().Where(teamapikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamAPIKeyClient) GetX(ctx context.Context, id uuid.UUID) *TeamAPIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTeam queries the team edge of a TeamAPIKey.
+func (c *TeamAPIKeyClient) QueryTeam(tak *TeamAPIKey) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tak.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teamapikey.Table, teamapikey.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teamapikey.TeamTable, teamapikey.TeamColumn),
+		)
+		schemaConfig := tak.schemaConfig
+		step.To.Schema = schemaConfig.Team
+		step.Edge.Schema = schemaConfig.TeamAPIKey
+		fromV = sqlgraph.Neighbors(tak.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreator queries the creator edge of a TeamAPIKey.
+func (c *TeamAPIKeyClient) QueryCreator(tak *TeamAPIKey) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tak.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teamapikey.Table, teamapikey.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teamapikey.CreatorTable, teamapikey.CreatorColumn),
+		)
+		schemaConfig := tak.schemaConfig
+		step.To.Schema = schemaConfig.User
+		step.Edge.Schema = schemaConfig.TeamAPIKey
+		fromV = sqlgraph.Neighbors(tak.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamAPIKeyClient) Hooks() []Hook {
+	return c.hooks.TeamAPIKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamAPIKeyClient) Interceptors() []Interceptor {
+	return c.inters.TeamAPIKey
+}
+
+func (c *TeamAPIKeyClient) mutate(ctx context.Context, m *TeamAPIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamAPIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamAPIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamAPIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamAPIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown TeamAPIKey mutation op: %q", m.Op())
+	}
+}
+
+// TeamBudgetClient is a client for the TeamBudget schema.
+type TeamBudgetClient struct {
+	config
+}
+
+// NewTeamBudgetClient returns a client for the TeamBudget from the given config.
+func NewTeamBudgetClient(c config) *TeamBudgetClient {
+	return &TeamBudgetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teambudget.Hooks(f(g(h())))`.
+func (c *TeamBudgetClient) Use(hooks ...Hook) {
+	c.hooks.TeamBudget = append(c.hooks.TeamBudget, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teambudget.Intercept(f(g(h())))`.
+func (c *TeamBudgetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamBudget = append(c.inters.TeamBudget, interceptors...)
+}
+
+// Create returns a builder for creating a TeamBudget entity.
+func (c *TeamBudgetClient) Create() *TeamBudgetCreate {
+	mutation := newTeamBudgetMutation(c.config, OpCreate)
+	return &TeamBudgetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamBudget entities.
+func (c *TeamBudgetClient) CreateBulk(builders ...*TeamBudgetCreate) *TeamBudgetCreateBulk {
+	return &TeamBudgetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamBudgetClient) MapCreateBulk(slice any, setFunc func(*TeamBudgetCreate, int)) *TeamBudgetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamBudgetCreateBulk{err: fmt.Errorf("calling to TeamBudgetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamBudgetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamBudgetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamBudget.
+func (c *TeamBudgetClient) Update() *TeamBudgetUpdate {
+	mutation := newTeamBudgetMutation(c.config, OpUpdate)
+	return &TeamBudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamBudgetClient) UpdateOne(tb *TeamBudget) *TeamBudgetUpdateOne {
+	mutation := newTeamBudgetMutation(c.config, OpUpdateOne, withTeamBudget(tb))
+	return &TeamBudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamBudgetClient) UpdateOneID(id uuid.UUID) *TeamBudgetUpdateOne {
+	mutation := newTeamBudgetMutation(c.config, OpUpdateOne, withTeamBudgetID(id))
+	return &TeamBudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamBudget.
+func (c *TeamBudgetClient) Delete() *TeamBudgetDelete {
+	mutation := newTeamBudgetMutation(c.config, OpDelete)
+	return &TeamBudgetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamBudgetClient) DeleteOne(tb *TeamBudget) *TeamBudgetDeleteOne {
+	return c.DeleteOneID(tb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamBudgetClient) DeleteOneID(id uuid.UUID) *TeamBudgetDeleteOne {
+	builder := c.Delete().Where(teambudget.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamBudgetDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamBudget.
+func (c *TeamBudgetClient) Query() *TeamBudgetQuery {
+	return &TeamBudgetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamBudget},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamBudget entity by its id.
+func (c *TeamBudgetClient) Get(ctx context.Context, id uuid.UUID) (*TeamBudget, error) {
+	return c.Query().Where(teambudget.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamBudgetClient) GetX(ctx context.Context, id uuid.UUID) *TeamBudget {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TeamBudgetClient) Hooks() []Hook {
+	return c.hooks.TeamBudget
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamBudgetClient) Interceptors() []Interceptor {
+	return c.inters.TeamBudget
+}
+
+func (c *TeamBudgetClient) mutate(ctx context.Context, m *TeamBudgetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamBudgetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamBudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamBudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamBudgetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown TeamBudget mutation op: %q", m.Op())
+	}
+}
+
+// TeamRegistryCredentialClient is a client for the TeamRegistryCredential schema.
+type TeamRegistryCredentialClient struct {
+	config
+}
+
+// NewTeamRegistryCredentialClient returns a client for the TeamRegistryCredential from the given config.
+func NewTeamRegistryCredentialClient(c config) *TeamRegistryCredentialClient {
+	return &TeamRegistryCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teamregistrycredential.Hooks(f(g(h())))`.
+func (c *TeamRegistryCredentialClient) Use(hooks ...Hook) {
+	c.hooks.TeamRegistryCredential = append(c.hooks.TeamRegistryCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teamregistrycredential.Intercept(f(g(h())))`.
+func (c *TeamRegistryCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamRegistryCredential = append(c.inters.TeamRegistryCredential, interceptors...)
+}
+
+// Create returns a builder for creating a TeamRegistryCredential entity.
+func (c *TeamRegistryCredentialClient) Create() *TeamRegistryCredentialCreate {
+	mutation := newTeamRegistryCredentialMutation(c.config, OpCreate)
+	return &TeamRegistryCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamRegistryCredential entities.
+func (c *TeamRegistryCredentialClient) CreateBulk(builders ...*TeamRegistryCredentialCreate) *TeamRegistryCredentialCreateBulk {
+	return &TeamRegistryCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamRegistryCredentialClient) MapCreateBulk(slice any, setFunc func(*TeamRegistryCredentialCreate, int)) *TeamRegistryCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamRegistryCredentialCreateBulk{err: fmt.Errorf("calling to TeamRegistryCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamRegistryCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamRegistryCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamRegistryCredential.
+func (c *TeamRegistryCredentialClient) Update() *TeamRegistryCredentialUpdate {
+	mutation := newTeamRegistryCredentialMutation(c.config, OpUpdate)
+	return &TeamRegistryCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamRegistryCredentialClient) UpdateOne(trc *TeamRegistryCredential) *TeamRegistryCredentialUpdateOne {
+	mutation := newTeamRegistryCredentialMutation(c.config, OpUpdateOne, withTeamRegistryCredential(trc))
+	return &TeamRegistryCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamRegistryCredentialClient) UpdateOneID(id uuid.UUID) *TeamRegistryCredentialUpdateOne {
+	mutation := newTeamRegistryCredentialMutation(c.config, OpUpdateOne, withTeamRegistryCredentialID(id))
+	return &TeamRegistryCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamRegistryCredential.
+func (c *TeamRegistryCredentialClient) Delete() *TeamRegistryCredentialDelete {
+	mutation := newTeamRegistryCredentialMutation(c.config, OpDelete)
+	return &TeamRegistryCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamRegistryCredentialClient) DeleteOne(trc *TeamRegistryCredential) *TeamRegistryCredentialDeleteOne {
+	return c.DeleteOneID(trc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamRegistryCredentialClient) DeleteOneID(id uuid.UUID) *TeamRegistryCredentialDeleteOne {
+	builder := c.Delete().Where(teamregistrycredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamRegistryCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamRegistryCredential.
+func (c *TeamRegistryCredentialClient) Query() *TeamRegistryCredentialQuery {
+	return &TeamRegistryCredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamRegistryCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamRegistryCredential entity by its id.
+func (c *TeamRegistryCredentialClient) Get(ctx context.Context, id uuid.UUID) (*TeamRegistryCredential, error) {
+	return c.Query().Where(teamregistrycredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamRegistryCredentialClient) GetX(ctx context.Context, id uuid.UUID) *TeamRegistryCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TeamRegistryCredentialClient) Hooks() []Hook {
+	return c.hooks.TeamRegistryCredential
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamRegistryCredentialClient) Interceptors() []Interceptor {
+	return c.inters.TeamRegistryCredential
+}
+
+func (c *TeamRegistryCredentialClient) mutate(ctx context.Context, m *TeamRegistryCredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamRegistryCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamRegistryCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamRegistryCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamRegistryCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown TeamRegistryCredential mutation op: %q", m.Op())
+	}
+}
+
+// TeamSecretClient is a client for the TeamSecret schema.
+type TeamSecretClient struct {
+	config
+}
+
+// NewTeamSecretClient returns a client for the TeamSecret from the given config.
+func NewTeamSecretClient(c config) *TeamSecretClient {
+	return &TeamSecretClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teamsecret.Hooks(f(g(h())))`.
+func (c *TeamSecretClient) Use(hooks ...Hook) {
+	c.hooks.TeamSecret = append(c.hooks.TeamSecret, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teamsecret.Intercept(f(g(h())))`.
+func (c *TeamSecretClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamSecret = append(c.inters.TeamSecret, interceptors...)
+}
+
+// Create returns a builder for creating a TeamSecret entity.
+func (c *TeamSecretClient) Create() *TeamSecretCreate {
+	mutation := newTeamSecretMutation(c.config, OpCreate)
+	return &TeamSecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamSecret entities.
+func (c *TeamSecretClient) CreateBulk(builders ...*TeamSecretCreate) *TeamSecretCreateBulk {
+	return &TeamSecretCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamSecretClient) MapCreateBulk(slice any, setFunc func(*TeamSecretCreate, int)) *TeamSecretCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamSecretCreateBulk{err: fmt.Errorf("calling to TeamSecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamSecretCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamSecretCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamSecret.
+func (c *TeamSecretClient) Update() *TeamSecretUpdate {
+	mutation := newTeamSecretMutation(c.config, OpUpdate)
+	return &TeamSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamSecretClient) UpdateOne(ts *TeamSecret) *TeamSecretUpdateOne {
+	mutation := newTeamSecretMutation(c.config, OpUpdateOne, withTeamSecret(ts))
+	return &TeamSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamSecretClient) UpdateOneID(id uuid.UUID) *TeamSecretUpdateOne {
+	mutation := newTeamSecretMutation(c.config, OpUpdateOne, withTeamSecretID(id))
+	return &TeamSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamSecret.
+func (c *TeamSecretClient) Delete() *TeamSecretDelete {
+	mutation := newTeamSecretMutation(c.config, OpDelete)
+	return &TeamSecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamSecretClient) DeleteOne(ts *TeamSecret) *TeamSecretDeleteOne {
+	return c.DeleteOneID(ts.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamSecretClient) DeleteOneID(id uuid.UUID) *TeamSecretDeleteOne {
+	builder := c.Delete().Where(teamsecret.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamSecretDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamSecret.
+func (c *TeamSecretClient) Query() *TeamSecretQuery {
+	return &TeamSecretQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamSecret},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamSecret entity by its id.
+func (c *TeamSecretClient) Get(ctx context.Context, id uuid.UUID) (*TeamSecret, error) {
+	return c.Query().Where(teamsecret.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamSecretClient) GetX(ctx context.Context, id uuid.UUID) *TeamSecret {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TeamSecretClient) Hooks() []Hook {
+	return c.hooks.TeamSecret
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamSecretClient) Interceptors() []Interceptor {
+	return c.inters.TeamSecret
+}
+
+func (c *TeamSecretClient) mutate(ctx context.Context, m *TeamSecretMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamSecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamSecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown TeamSecret mutation op: %q", m.Op())
+	}
+}
+
+// TeamSecretVersionClient is a client for the TeamSecretVersion schema.
+type TeamSecretVersionClient struct {
+	config
+}
+
+// NewTeamSecretVersionClient returns a client for the TeamSecretVersion from the given config.
+func NewTeamSecretVersionClient(c config) *TeamSecretVersionClient {
+	return &TeamSecretVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teamsecretversion.Hooks(f(g(h())))`.
+func (c *TeamSecretVersionClient) Use(hooks ...Hook) {
+	c.hooks.TeamSecretVersion = append(c.hooks.TeamSecretVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teamsecretversion.Intercept(f(g(h())))`.
+func (c *TeamSecretVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamSecretVersion = append(c.inters.TeamSecretVersion, interceptors...)
+}
+
+// Create returns a builder for creating a TeamSecretVersion entity.
+func (c *TeamSecretVersionClient) Create() *TeamSecretVersionCreate {
+	mutation := newTeamSecretVersionMutation(c.config, OpCreate)
+	return &TeamSecretVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamSecretVersion entities.
+func (c *TeamSecretVersionClient) CreateBulk(builders ...*TeamSecretVersionCreate) *TeamSecretVersionCreateBulk {
+	return &TeamSecretVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamSecretVersionClient) MapCreateBulk(slice any, setFunc func(*TeamSecretVersionCreate, int)) *TeamSecretVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamSecretVersionCreateBulk{err: fmt.Errorf("calling to TeamSecretVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamSecretVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamSecretVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamSecretVersion.
+func (c *TeamSecretVersionClient) Update() *TeamSecretVersionUpdate {
+	mutation := newTeamSecretVersionMutation(c.config, OpUpdate)
+	return &TeamSecretVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamSecretVersionClient) UpdateOne(tsv *TeamSecretVersion) *TeamSecretVersionUpdateOne {
+	mutation := newTeamSecretVersionMutation(c.config, OpUpdateOne, withTeamSecretVersion(tsv))
+	return &TeamSecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TeamAPIKeyClient) UpdateOneID(id uuid.UUID) *TeamAPIKeyUpdateOne {
-	mutation := newTeamAPIKeyMutation(c.config, OpUpdateOne, withTeamAPIKeyID(id))
-	return &TeamAPIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TeamSecretVersionClient) UpdateOneID(id uuid.UUID) *TeamSecretVersionUpdateOne {
+	mutation := newTeamSecretVersionMutation(c.config, OpUpdateOne, withTeamSecretVersionID(id))
+	return &TeamSecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TeamAPIKey.
-func (c *TeamAPIKeyClient) Delete() *TeamAPIKeyDelete {
-	mutation := newTeamAPIKeyMutation(c.config, OpDelete)
-	return &TeamAPIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TeamSecretVersion.
+func (c *TeamSecretVersionClient) Delete() *TeamSecretVersionDelete {
+	mutation := newTeamSecretVersionMutation(c.config, OpDelete)
+	return &TeamSecretVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TeamAPIKeyClient) DeleteOne(tak *TeamAPIKey) *TeamAPIKeyDeleteOne {
-	return c.DeleteOneID(tak.ID)
+func (c *TeamSecretVersionClient) DeleteOne(tsv *TeamSecretVersion) *TeamSecretVersionDeleteOne {
+	return c.DeleteOneID(tsv.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TeamAPIKeyClient) DeleteOneID(id uuid.UUID) *TeamAPIKeyDeleteOne {
-	builder := c.Delete().Where(teamapikey.ID(id))
+func (c *TeamSecretVersionClient) DeleteOneID(id uuid.UUID) *TeamSecretVersionDeleteOne {
+	builder := c.Delete().Where(teamsecretversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TeamAPIKeyDeleteOne{builder}
+	return &TeamSecretVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for TeamAPIKey.
-func (c *TeamAPIKeyClient) Query() *TeamAPIKeyQuery {
-	return &TeamAPIKeyQuery{
+// Query returns a query builder for TeamSecretVersion.
+func (c *TeamSecretVersionClient) Query() *TeamSecretVersionQuery {
+	return &TeamSecretVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTeamAPIKey},
+		ctx:    &QueryContext{Type: TypeTeamSecretVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TeamAPIKey entity by its id.
-func (c *TeamAPIKeyClient) Get(ctx context.Context, id uuid.UUID) (*TeamAPIKey, error) {
-	return c.Query().Where(teamapikey.ID(id)).Only(ctx)
+// Get returns a TeamSecretVersion entity by its id.
+func (c *TeamSecretVersionClient) Get(ctx context.Context, id uuid.UUID) (*TeamSecretVersion, error) {
+	return c.Query().Where(teamsecretversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TeamAPIKeyClient) GetX(ctx context.Context, id uuid.UUID) *TeamAPIKey {
+func (c *TeamSecretVersionClient) GetX(ctx context.Context, id uuid.UUID) *TeamSecretVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1457,66 +3068,294 @@ func (c *TeamAPIKeyClient) GetX(ctx context.Context, id uuid.UUID) *TeamAPIKey {
 	return obj
 }
 
-// QueryTeam queries the team edge of a TeamAPIKey.
-func (c *TeamAPIKeyClient) QueryTeam(tak *TeamAPIKey) *TeamQuery {
-	query := (&TeamClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := tak.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(teamapikey.Table, teamapikey.FieldID, id),
-			sqlgraph.To(team.Table, team.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, teamapikey.TeamTable, teamapikey.TeamColumn),
-		)
-		schemaConfig := tak.schemaConfig
-		step.To.Schema = schemaConfig.Team
-		step.Edge.Schema = schemaConfig.TeamAPIKey
-		fromV = sqlgraph.Neighbors(tak.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *TeamSecretVersionClient) Hooks() []Hook {
+	return c.hooks.TeamSecretVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamSecretVersionClient) Interceptors() []Interceptor {
+	return c.inters.TeamSecretVersion
+}
+
+func (c *TeamSecretVersionClient) mutate(ctx context.Context, m *TeamSecretVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamSecretVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamSecretVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamSecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamSecretVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown TeamSecretVersion mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryCreator queries the creator edge of a TeamAPIKey.
-func (c *TeamAPIKeyClient) QueryCreator(tak *TeamAPIKey) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := tak.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(teamapikey.Table, teamapikey.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, teamapikey.CreatorTable, teamapikey.CreatorColumn),
-		)
-		schemaConfig := tak.schemaConfig
-		step.To.Schema = schemaConfig.User
-		step.Edge.Schema = schemaConfig.TeamAPIKey
-		fromV = sqlgraph.Neighbors(tak.driver.Dialect(), step)
-		return fromV, nil
+// TeamTemplateStorageClient is a client for the TeamTemplateStorage schema.
+type TeamTemplateStorageClient struct {
+	config
+}
+
+// NewTeamTemplateStorageClient returns a client for the TeamTemplateStorage from the given config.
+func NewTeamTemplateStorageClient(c config) *TeamTemplateStorageClient {
+	return &TeamTemplateStorageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teamtemplatestorage.Hooks(f(g(h())))`.
+func (c *TeamTemplateStorageClient) Use(hooks ...Hook) {
+	c.hooks.TeamTemplateStorage = append(c.hooks.TeamTemplateStorage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teamtemplatestorage.Intercept(f(g(h())))`.
+func (c *TeamTemplateStorageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamTemplateStorage = append(c.inters.TeamTemplateStorage, interceptors...)
+}
+
+// Create returns a builder for creating a TeamTemplateStorage entity.
+func (c *TeamTemplateStorageClient) Create() *TeamTemplateStorageCreate {
+	mutation := newTeamTemplateStorageMutation(c.config, OpCreate)
+	return &TeamTemplateStorageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamTemplateStorage entities.
+func (c *TeamTemplateStorageClient) CreateBulk(builders ...*TeamTemplateStorageCreate) *TeamTemplateStorageCreateBulk {
+	return &TeamTemplateStorageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamTemplateStorageClient) MapCreateBulk(slice any, setFunc func(*TeamTemplateStorageCreate, int)) *TeamTemplateStorageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamTemplateStorageCreateBulk{err: fmt.Errorf("calling to TeamTemplateStorageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*TeamTemplateStorageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamTemplateStorageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamTemplateStorage.
+func (c *TeamTemplateStorageClient) Update() *TeamTemplateStorageUpdate {
+	mutation := newTeamTemplateStorageMutation(c.config, OpUpdate)
+	return &TeamTemplateStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamTemplateStorageClient) UpdateOne(tts *TeamTemplateStorage) *TeamTemplateStorageUpdateOne {
+	mutation := newTeamTemplateStorageMutation(c.config, OpUpdateOne, withTeamTemplateStorage(tts))
+	return &TeamTemplateStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamTemplateStorageClient) UpdateOneID(id int) *TeamTemplateStorageUpdateOne {
+	mutation := newTeamTemplateStorageMutation(c.config, OpUpdateOne, withTeamTemplateStorageID(id))
+	return &TeamTemplateStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamTemplateStorage.
+func (c *TeamTemplateStorageClient) Delete() *TeamTemplateStorageDelete {
+	mutation := newTeamTemplateStorageMutation(c.config, OpDelete)
+	return &TeamTemplateStorageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamTemplateStorageClient) DeleteOne(tts *TeamTemplateStorage) *TeamTemplateStorageDeleteOne {
+	return c.DeleteOneID(tts.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamTemplateStorageClient) DeleteOneID(id int) *TeamTemplateStorageDeleteOne {
+	builder := c.Delete().Where(teamtemplatestorage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamTemplateStorageDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamTemplateStorage.
+func (c *TeamTemplateStorageClient) Query() *TeamTemplateStorageQuery {
+	return &TeamTemplateStorageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamTemplateStorage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamTemplateStorage entity by its id.
+func (c *TeamTemplateStorageClient) Get(ctx context.Context, id int) (*TeamTemplateStorage, error) {
+	return c.Query().Where(teamtemplatestorage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamTemplateStorageClient) GetX(ctx context.Context, id int) *TeamTemplateStorage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *TeamAPIKeyClient) Hooks() []Hook {
-	return c.hooks.TeamAPIKey
+func (c *TeamTemplateStorageClient) Hooks() []Hook {
+	return c.hooks.TeamTemplateStorage
 }
 
 // Interceptors returns the client interceptors.
-func (c *TeamAPIKeyClient) Interceptors() []Interceptor {
-	return c.inters.TeamAPIKey
+func (c *TeamTemplateStorageClient) Interceptors() []Interceptor {
+	return c.inters.TeamTemplateStorage
 }
 
-func (c *TeamAPIKeyClient) mutate(ctx context.Context, m *TeamAPIKeyMutation) (Value, error) {
+func (c *TeamTemplateStorageClient) mutate(ctx context.Context, m *TeamTemplateStorageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TeamAPIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamTemplateStorageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TeamAPIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamTemplateStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TeamAPIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamTemplateStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TeamAPIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TeamTemplateStorageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("models: unknown TeamAPIKey mutation op: %q", m.Op())
+		return nil, fmt.Errorf("models: unknown TeamTemplateStorage mutation op: %q", m.Op())
+	}
+}
+
+// TeamUsageDailyClient is a client for the TeamUsageDaily schema.
+type TeamUsageDailyClient struct {
+	config
+}
+
+// NewTeamUsageDailyClient returns a client for the TeamUsageDaily from the given config.
+func NewTeamUsageDailyClient(c config) *TeamUsageDailyClient {
+	return &TeamUsageDailyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teamusagedaily.Hooks(f(g(h())))`.
+func (c *TeamUsageDailyClient) Use(hooks ...Hook) {
+	c.hooks.TeamUsageDaily = append(c.hooks.TeamUsageDaily, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teamusagedaily.Intercept(f(g(h())))`.
+func (c *TeamUsageDailyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamUsageDaily = append(c.inters.TeamUsageDaily, interceptors...)
+}
+
+// Create returns a builder for creating a TeamUsageDaily entity.
+func (c *TeamUsageDailyClient) Create() *TeamUsageDailyCreate {
+	mutation := newTeamUsageDailyMutation(c.config, OpCreate)
+	return &TeamUsageDailyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamUsageDaily entities.
+func (c *TeamUsageDailyClient) CreateBulk(builders ...*TeamUsageDailyCreate) *TeamUsageDailyCreateBulk {
+	return &TeamUsageDailyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamUsageDailyClient) MapCreateBulk(slice any, setFunc func(*TeamUsageDailyCreate, int)) *TeamUsageDailyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamUsageDailyCreateBulk{err: fmt.Errorf("calling to TeamUsageDailyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamUsageDailyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamUsageDailyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamUsageDaily.
+func (c *TeamUsageDailyClient) Update() *TeamUsageDailyUpdate {
+	mutation := newTeamUsageDailyMutation(c.config, OpUpdate)
+	return &TeamUsageDailyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamUsageDailyClient) UpdateOne(tud *TeamUsageDaily) *TeamUsageDailyUpdateOne {
+	mutation := newTeamUsageDailyMutation(c.config, OpUpdateOne, withTeamUsageDaily(tud))
+	return &TeamUsageDailyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamUsageDailyClient) UpdateOneID(id int) *TeamUsageDailyUpdateOne {
+	mutation := newTeamUsageDailyMutation(c.config, OpUpdateOne, withTeamUsageDailyID(id))
+	return &TeamUsageDailyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamUsageDaily.
+func (c *TeamUsageDailyClient) Delete() *TeamUsageDailyDelete {
+	mutation := newTeamUsageDailyMutation(c.config, OpDelete)
+	return &TeamUsageDailyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamUsageDailyClient) DeleteOne(tud *TeamUsageDaily) *TeamUsageDailyDeleteOne {
+	return c.DeleteOneID(tud.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamUsageDailyClient) DeleteOneID(id int) *TeamUsageDailyDeleteOne {
+	builder := c.Delete().Where(teamusagedaily.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamUsageDailyDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamUsageDaily.
+func (c *TeamUsageDailyClient) Query() *TeamUsageDailyQuery {
+	return &TeamUsageDailyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamUsageDaily},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamUsageDaily entity by its id.
+func (c *TeamUsageDailyClient) Get(ctx context.Context, id int) (*TeamUsageDaily, error) {
+	return c.Query().Where(teamusagedaily.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamUsageDailyClient) GetX(ctx context.Context, id int) *TeamUsageDaily {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TeamUsageDailyClient) Hooks() []Hook {
+	return c.hooks.TeamUsageDaily
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamUsageDailyClient) Interceptors() []Interceptor {
+	return c.inters.TeamUsageDaily
+}
+
+func (c *TeamUsageDailyClient) mutate(ctx context.Context, m *TeamUsageDailyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamUsageDailyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamUsageDailyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamUsageDailyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamUsageDailyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown TeamUsageDaily mutation op: %q", m.Op())
 	}
 }
 
@@ -2074,28 +3913,45 @@ func (c *UsersTeamsClient) mutate(ctx context.Context, m *UsersTeamsMutation) (V
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AccessToken, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey, Tier, User,
-		UsersTeams []ent.Hook
+		AccessToken, Env, EnvAlias, EnvBuild, EnvBuildLog, EnvRebuildSchedule,
+		IdempotencyKey, Kernel, PinnedBuild, Sandbox, SandboxStart, Snapshot, Team,
+		TeamAPIKey, TeamBudget, TeamRegistryCredential, TeamSecret, TeamSecretVersion,
+		TeamTemplateStorage, TeamUsageDaily, Tier, User, UsersTeams []ent.Hook
 	}
 	inters struct {
-		AccessToken, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey, Tier, User,
-		UsersTeams []ent.Interceptor
+		AccessToken, Env, EnvAlias, EnvBuild, EnvBuildLog, EnvRebuildSchedule,
+		IdempotencyKey, Kernel, PinnedBuild, Sandbox, SandboxStart, Snapshot, Team,
+		TeamAPIKey, TeamBudget, TeamRegistryCredential, TeamSecret, TeamSecretVersion,
+		TeamTemplateStorage, TeamUsageDaily, Tier, User, UsersTeams []ent.Interceptor
 	}
 )
 
 var (
 	// DefaultSchemaConfig represents the default schema names for all tables as defined in ent/schema.
 	DefaultSchemaConfig = SchemaConfig{
-		AccessToken: tableSchemas[1],
-		Env:         tableSchemas[1],
-		EnvAlias:    tableSchemas[1],
-		EnvBuild:    tableSchemas[1],
-		Snapshot:    tableSchemas[1],
-		Team:        tableSchemas[1],
-		TeamAPIKey:  tableSchemas[1],
-		Tier:        tableSchemas[1],
-		User:        tableSchemas[0],
-		UsersTeams:  tableSchemas[1],
+		AccessToken:            tableSchemas[1],
+		Env:                    tableSchemas[1],
+		EnvAlias:               tableSchemas[1],
+		EnvBuild:               tableSchemas[1],
+		EnvBuildLog:            tableSchemas[1],
+		EnvRebuildSchedule:     tableSchemas[1],
+		IdempotencyKey:         tableSchemas[1],
+		Kernel:                 tableSchemas[1],
+		PinnedBuild:            tableSchemas[1],
+		Sandbox:                tableSchemas[1],
+		SandboxStart:           tableSchemas[1],
+		Snapshot:               tableSchemas[1],
+		Team:                   tableSchemas[1],
+		TeamAPIKey:             tableSchemas[1],
+		TeamBudget:             tableSchemas[1],
+		TeamRegistryCredential: tableSchemas[1],
+		TeamSecret:             tableSchemas[1],
+		TeamSecretVersion:      tableSchemas[1],
+		TeamTemplateStorage:    tableSchemas[1],
+		TeamUsageDaily:         tableSchemas[1],
+		Tier:                   tableSchemas[1],
+		User:                   tableSchemas[0],
+		UsersTeams:             tableSchemas[1],
 	}
 	tableSchemas = [...]string{"auth", "public"}
 )
@@ -16,9 +16,22 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/idempotencykey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecret"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
@@ -82,16 +95,29 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			accesstoken.Table: accesstoken.ValidColumn,
-			env.Table:         env.ValidColumn,
-			envalias.Table:    envalias.ValidColumn,
-			envbuild.Table:    envbuild.ValidColumn,
-			snapshot.Table:    snapshot.ValidColumn,
-			team.Table:        team.ValidColumn,
-			teamapikey.Table:  teamapikey.ValidColumn,
-			tier.Table:        tier.ValidColumn,
-			user.Table:        user.ValidColumn,
-			usersteams.Table:  usersteams.ValidColumn,
+			accesstoken.Table:            accesstoken.ValidColumn,
+			env.Table:                    env.ValidColumn,
+			envalias.Table:               envalias.ValidColumn,
+			envbuild.Table:               envbuild.ValidColumn,
+			envbuildlog.Table:            envbuildlog.ValidColumn,
+			envrebuildschedule.Table:     envrebuildschedule.ValidColumn,
+			idempotencykey.Table:         idempotencykey.ValidColumn,
+			kernel.Table:                 kernel.ValidColumn,
+			pinnedbuild.Table:            pinnedbuild.ValidColumn,
+			sandbox.Table:                sandbox.ValidColumn,
+			sandboxstart.Table:           sandboxstart.ValidColumn,
+			snapshot.Table:               snapshot.ValidColumn,
+			team.Table:                   team.ValidColumn,
+			teamapikey.Table:             teamapikey.ValidColumn,
+			teambudget.Table:             teambudget.ValidColumn,
+			teamregistrycredential.Table: teamregistrycredential.ValidColumn,
+			teamsecret.Table:             teamsecret.ValidColumn,
+			teamsecretversion.Table:      teamsecretversion.ValidColumn,
+			teamtemplatestorage.Table:    teamtemplatestorage.ValidColumn,
+			teamusagedaily.Table:         teamusagedaily.ValidColumn,
+			tier.Table:                   tier.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			usersteams.Table:             usersteams.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -36,6 +37,8 @@ type Env struct {
 	SpawnCount int64 `json:"spawn_count,omitempty"`
 	// Timestamp of the last time the env was spawned
 	LastSpawnedAt time.Time `json:"last_spawned_at,omitempty"`
+	// Regions the sandboxes of the env can run in, any region if empty
+	AllowedRegions []string `json:"allowed_regions,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvQuery when eager-loading is set.
 	Edges        EnvEdges `json:"edges"`
@@ -119,6 +122,8 @@ func (*Env) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case env.FieldCreatedBy:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case env.FieldAllowedRegions:
+			values[i] = new([]byte)
 		case env.FieldPublic:
 			values[i] = new(sql.NullBool)
 		case env.FieldBuildCount, env.FieldSpawnCount:
@@ -199,6 +204,14 @@ func (e *Env) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				e.LastSpawnedAt = value.Time
 			}
+		case env.FieldAllowedRegions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_regions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &e.AllowedRegions); err != nil {
+					return fmt.Errorf("unmarshal field allowed_regions: %w", err)
+				}
+			}
 		default:
 			e.selectValues.Set(columns[i], values[i])
 		}
@@ -285,6 +298,9 @@ func (e *Env) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("last_spawned_at=")
 	builder.WriteString(e.LastSpawnedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_regions=")
+	builder.WriteString(fmt.Sprintf("%v", e.AllowedRegions))
 	builder.WriteByte(')')
 	return builder.String()
 }
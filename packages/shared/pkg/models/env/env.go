@@ -30,6 +30,8 @@ const (
 	FieldSpawnCount = "spawn_count"
 	// FieldLastSpawnedAt holds the string denoting the last_spawned_at field in the database.
 	FieldLastSpawnedAt = "last_spawned_at"
+	// FieldAllowedRegions holds the string denoting the allowed_regions field in the database.
+	FieldAllowedRegions = "allowed_regions"
 	// EdgeTeam holds the string denoting the team edge name in mutations.
 	EdgeTeam = "team"
 	// EdgeCreator holds the string denoting the creator edge name in mutations.
@@ -92,6 +94,7 @@ var Columns = []string{
 	FieldBuildCount,
 	FieldSpawnCount,
 	FieldLastSpawnedAt,
+	FieldAllowedRegions,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -377,6 +377,16 @@ func LastSpawnedAtNotNil() predicate.Env {
 	return predicate.Env(sql.FieldNotNull(FieldLastSpawnedAt))
 }
 
+// AllowedRegionsIsNil applies the IsNil predicate on the "allowed_regions" field.
+func AllowedRegionsIsNil() predicate.Env {
+	return predicate.Env(sql.FieldIsNull(FieldAllowedRegions))
+}
+
+// AllowedRegionsNotNil applies the NotNil predicate on the "allowed_regions" field.
+func AllowedRegionsNotNil() predicate.Env {
+	return predicate.Env(sql.FieldNotNull(FieldAllowedRegions))
+}
+
 // HasTeam applies the HasEdge predicate on the "team" edge.
 func HasTeam() predicate.Env {
 	return predicate.Env(func(s *sql.Selector) {
@@ -125,6 +125,12 @@ func (ec *EnvCreate) SetNillableLastSpawnedAt(t *time.Time) *EnvCreate {
 	return ec
 }
 
+// SetAllowedRegions sets the "allowed_regions" field.
+func (ec *EnvCreate) SetAllowedRegions(s []string) *EnvCreate {
+	ec.mutation.SetAllowedRegions(s)
+	return ec
+}
+
 // SetID sets the "id" field.
 func (ec *EnvCreate) SetID(s string) *EnvCreate {
 	ec.mutation.SetID(s)
@@ -337,6 +343,10 @@ func (ec *EnvCreate) createSpec() (*Env, *sqlgraph.CreateSpec) {
 		_spec.SetField(env.FieldLastSpawnedAt, field.TypeTime, value)
 		_node.LastSpawnedAt = value
 	}
+	if value, ok := ec.mutation.AllowedRegions(); ok {
+		_spec.SetField(env.FieldAllowedRegions, field.TypeJSON, value)
+		_node.AllowedRegions = value
+	}
 	if nodes := ec.mutation.TeamIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -584,6 +594,24 @@ func (u *EnvUpsert) ClearLastSpawnedAt() *EnvUpsert {
 	return u
 }
 
+// SetAllowedRegions sets the "allowed_regions" field.
+func (u *EnvUpsert) SetAllowedRegions(v []string) *EnvUpsert {
+	u.Set(env.FieldAllowedRegions, v)
+	return u
+}
+
+// UpdateAllowedRegions sets the "allowed_regions" field to the value that was provided on create.
+func (u *EnvUpsert) UpdateAllowedRegions() *EnvUpsert {
+	u.SetExcluded(env.FieldAllowedRegions)
+	return u
+}
+
+// ClearAllowedRegions clears the value of the "allowed_regions" field.
+func (u *EnvUpsert) ClearAllowedRegions() *EnvUpsert {
+	u.SetNull(env.FieldAllowedRegions)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -761,6 +789,27 @@ func (u *EnvUpsertOne) ClearLastSpawnedAt() *EnvUpsertOne {
 	})
 }
 
+// SetAllowedRegions sets the "allowed_regions" field.
+func (u *EnvUpsertOne) SetAllowedRegions(v []string) *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.SetAllowedRegions(v)
+	})
+}
+
+// UpdateAllowedRegions sets the "allowed_regions" field to the value that was provided on create.
+func (u *EnvUpsertOne) UpdateAllowedRegions() *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.UpdateAllowedRegions()
+	})
+}
+
+// ClearAllowedRegions clears the value of the "allowed_regions" field.
+func (u *EnvUpsertOne) ClearAllowedRegions() *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.ClearAllowedRegions()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1105,6 +1154,27 @@ func (u *EnvUpsertBulk) ClearLastSpawnedAt() *EnvUpsertBulk {
 	})
 }
 
+// SetAllowedRegions sets the "allowed_regions" field.
+func (u *EnvUpsertBulk) SetAllowedRegions(v []string) *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.SetAllowedRegions(v)
+	})
+}
+
+// UpdateAllowedRegions sets the "allowed_regions" field to the value that was provided on create.
+func (u *EnvUpsertBulk) UpdateAllowedRegions() *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.UpdateAllowedRegions()
+	})
+}
+
+// ClearAllowedRegions clears the value of the "allowed_regions" field.
+func (u *EnvUpsertBulk) ClearAllowedRegions() *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.ClearAllowedRegions()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
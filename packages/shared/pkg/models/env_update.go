@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
@@ -160,6 +161,24 @@ func (eu *EnvUpdate) ClearLastSpawnedAt() *EnvUpdate {
 	return eu
 }
 
+// SetAllowedRegions sets the "allowed_regions" field.
+func (eu *EnvUpdate) SetAllowedRegions(s []string) *EnvUpdate {
+	eu.mutation.SetAllowedRegions(s)
+	return eu
+}
+
+// AppendAllowedRegions appends s to the "allowed_regions" field.
+func (eu *EnvUpdate) AppendAllowedRegions(s []string) *EnvUpdate {
+	eu.mutation.AppendAllowedRegions(s)
+	return eu
+}
+
+// ClearAllowedRegions clears the value of the "allowed_regions" field.
+func (eu *EnvUpdate) ClearAllowedRegions() *EnvUpdate {
+	eu.mutation.ClearAllowedRegions()
+	return eu
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (eu *EnvUpdate) SetTeam(t *Team) *EnvUpdate {
 	return eu.SetTeamID(t.ID)
@@ -386,6 +405,17 @@ func (eu *EnvUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if eu.mutation.LastSpawnedAtCleared() {
 		_spec.ClearField(env.FieldLastSpawnedAt, field.TypeTime)
 	}
+	if value, ok := eu.mutation.AllowedRegions(); ok {
+		_spec.SetField(env.FieldAllowedRegions, field.TypeJSON, value)
+	}
+	if value, ok := eu.mutation.AppendedAllowedRegions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, env.FieldAllowedRegions, value)
+		})
+	}
+	if eu.mutation.AllowedRegionsCleared() {
+		_spec.ClearField(env.FieldAllowedRegions, field.TypeJSON)
+	}
 	if eu.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -740,6 +770,24 @@ func (euo *EnvUpdateOne) ClearLastSpawnedAt() *EnvUpdateOne {
 	return euo
 }
 
+// SetAllowedRegions sets the "allowed_regions" field.
+func (euo *EnvUpdateOne) SetAllowedRegions(s []string) *EnvUpdateOne {
+	euo.mutation.SetAllowedRegions(s)
+	return euo
+}
+
+// AppendAllowedRegions appends s to the "allowed_regions" field.
+func (euo *EnvUpdateOne) AppendAllowedRegions(s []string) *EnvUpdateOne {
+	euo.mutation.AppendAllowedRegions(s)
+	return euo
+}
+
+// ClearAllowedRegions clears the value of the "allowed_regions" field.
+func (euo *EnvUpdateOne) ClearAllowedRegions() *EnvUpdateOne {
+	euo.mutation.ClearAllowedRegions()
+	return euo
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (euo *EnvUpdateOne) SetTeam(t *Team) *EnvUpdateOne {
 	return euo.SetTeamID(t.ID)
@@ -996,6 +1044,17 @@ func (euo *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 	if euo.mutation.LastSpawnedAtCleared() {
 		_spec.ClearField(env.FieldLastSpawnedAt, field.TypeTime)
 	}
+	if value, ok := euo.mutation.AllowedRegions(); ok {
+		_spec.SetField(env.FieldAllowedRegions, field.TypeJSON, value)
+	}
+	if value, ok := euo.mutation.AppendedAllowedRegions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, env.FieldAllowedRegions, value)
+		})
+	}
+	if euo.mutation.AllowedRegionsCleared() {
+		_spec.ClearField(env.FieldAllowedRegions, field.TypeJSON)
+	}
 	if euo.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
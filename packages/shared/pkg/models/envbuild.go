@@ -3,6 +3,7 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
 
@@ -25,6 +27,8 @@ type EnvBuild struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// FinishedAt holds the value of the "finished_at" field.
 	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// HeartbeatAt holds the value of the "heartbeat_at" field.
+	HeartbeatAt *time.Time `json:"heartbeat_at,omitempty"`
 	// EnvID holds the value of the "env_id" field.
 	EnvID *string `json:"env_id,omitempty"`
 	// Status holds the value of the "status" field.
@@ -33,6 +37,14 @@ type EnvBuild struct {
 	Dockerfile *string `json:"dockerfile,omitempty"`
 	// StartCmd holds the value of the "start_cmd" field.
 	StartCmd *string `json:"start_cmd,omitempty"`
+	// ReadinessProbe holds the value of the "readiness_probe" field.
+	ReadinessProbe *schema.ReadinessProbe `json:"readiness_probe,omitempty"`
+	// Hooks holds the value of the "hooks" field.
+	Hooks *schema.LifecycleHooks `json:"hooks,omitempty"`
+	// Hardening holds the value of the "hardening" field.
+	Hardening *schema.HardeningPolicy `json:"hardening,omitempty"`
+	// Egress holds the value of the "egress" field.
+	Egress *schema.EgressPolicy `json:"egress,omitempty"`
 	// Vcpu holds the value of the "vcpu" field.
 	Vcpu int64 `json:"vcpu,omitempty"`
 	// RAMMB holds the value of the "ram_mb" field.
@@ -43,10 +55,28 @@ type EnvBuild struct {
 	TotalDiskSizeMB *int64 `json:"total_disk_size_mb,omitempty"`
 	// KernelVersion holds the value of the "kernel_version" field.
 	KernelVersion string `json:"kernel_version,omitempty"`
+	// KernelArgs holds the value of the "kernel_args" field.
+	KernelArgs []string `json:"kernel_args,omitempty"`
+	// RootfsBlockSize holds the value of the "rootfs_block_size" field.
+	RootfsBlockSize *int64 `json:"rootfs_block_size,omitempty"`
+	// Secrets holds the value of the "secrets" field.
+	Secrets []string `json:"secrets,omitempty"`
 	// FirecrackerVersion holds the value of the "firecracker_version" field.
 	FirecrackerVersion string `json:"firecracker_version,omitempty"`
 	// EnvdVersion holds the value of the "envd_version" field.
 	EnvdVersion *string `json:"envd_version,omitempty"`
+	// UploadStatus holds the value of the "upload_status" field.
+	UploadStatus *envbuild.UploadStatus `json:"upload_status,omitempty"`
+	// SnapshotNodeID holds the value of the "snapshot_node_id" field.
+	SnapshotNodeID *string `json:"snapshot_node_id,omitempty"`
+	// ReplicatedRegions holds the value of the "replicated_regions" field.
+	ReplicatedRegions []string `json:"replicated_regions,omitempty"`
+	// SourceBuildID holds the value of the "source_build_id" field.
+	SourceBuildID *uuid.UUID `json:"source_build_id,omitempty"`
+	// Variant holds the value of the "variant" field.
+	Variant *string `json:"variant,omitempty"`
+	// VariantOf holds the value of the "variant_of" field.
+	VariantOf *uuid.UUID `json:"variant_of,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvBuildQuery when eager-loading is set.
 	Edges        EnvBuildEdges `json:"edges"`
@@ -80,11 +110,15 @@ func (*EnvBuild) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case envbuild.FieldVcpu, envbuild.FieldRAMMB, envbuild.FieldFreeDiskSizeMB, envbuild.FieldTotalDiskSizeMB:
+		case envbuild.FieldSourceBuildID, envbuild.FieldVariantOf:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case envbuild.FieldReadinessProbe, envbuild.FieldHooks, envbuild.FieldHardening, envbuild.FieldEgress, envbuild.FieldKernelArgs, envbuild.FieldSecrets, envbuild.FieldReplicatedRegions:
+			values[i] = new([]byte)
+		case envbuild.FieldVcpu, envbuild.FieldRAMMB, envbuild.FieldFreeDiskSizeMB, envbuild.FieldTotalDiskSizeMB, envbuild.FieldRootfsBlockSize:
 			values[i] = new(sql.NullInt64)
-		case envbuild.FieldEnvID, envbuild.FieldStatus, envbuild.FieldDockerfile, envbuild.FieldStartCmd, envbuild.FieldKernelVersion, envbuild.FieldFirecrackerVersion, envbuild.FieldEnvdVersion:
+		case envbuild.FieldEnvID, envbuild.FieldStatus, envbuild.FieldDockerfile, envbuild.FieldStartCmd, envbuild.FieldKernelVersion, envbuild.FieldFirecrackerVersion, envbuild.FieldEnvdVersion, envbuild.FieldUploadStatus, envbuild.FieldSnapshotNodeID, envbuild.FieldVariant:
 			values[i] = new(sql.NullString)
-		case envbuild.FieldCreatedAt, envbuild.FieldUpdatedAt, envbuild.FieldFinishedAt:
+		case envbuild.FieldCreatedAt, envbuild.FieldUpdatedAt, envbuild.FieldFinishedAt, envbuild.FieldHeartbeatAt:
 			values[i] = new(sql.NullTime)
 		case envbuild.FieldID:
 			values[i] = new(uuid.UUID)
@@ -128,6 +162,13 @@ func (eb *EnvBuild) assignValues(columns []string, values []any) error {
 				eb.FinishedAt = new(time.Time)
 				*eb.FinishedAt = value.Time
 			}
+		case envbuild.FieldHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeat_at", values[i])
+			} else if value.Valid {
+				eb.HeartbeatAt = new(time.Time)
+				*eb.HeartbeatAt = value.Time
+			}
 		case envbuild.FieldEnvID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field env_id", values[i])
@@ -155,6 +196,38 @@ func (eb *EnvBuild) assignValues(columns []string, values []any) error {
 				eb.StartCmd = new(string)
 				*eb.StartCmd = value.String
 			}
+		case envbuild.FieldReadinessProbe:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field readiness_probe", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eb.ReadinessProbe); err != nil {
+					return fmt.Errorf("unmarshal field readiness_probe: %w", err)
+				}
+			}
+		case envbuild.FieldHooks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hooks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eb.Hooks); err != nil {
+					return fmt.Errorf("unmarshal field hooks: %w", err)
+				}
+			}
+		case envbuild.FieldHardening:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hardening", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eb.Hardening); err != nil {
+					return fmt.Errorf("unmarshal field hardening: %w", err)
+				}
+			}
+		case envbuild.FieldEgress:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field egress", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eb.Egress); err != nil {
+					return fmt.Errorf("unmarshal field egress: %w", err)
+				}
+			}
 		case envbuild.FieldVcpu:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field vcpu", values[i])
@@ -186,6 +259,29 @@ func (eb *EnvBuild) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				eb.KernelVersion = value.String
 			}
+		case envbuild.FieldKernelArgs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field kernel_args", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eb.KernelArgs); err != nil {
+					return fmt.Errorf("unmarshal field kernel_args: %w", err)
+				}
+			}
+		case envbuild.FieldRootfsBlockSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rootfs_block_size", values[i])
+			} else if value.Valid {
+				eb.RootfsBlockSize = new(int64)
+				*eb.RootfsBlockSize = value.Int64
+			}
+		case envbuild.FieldSecrets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secrets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eb.Secrets); err != nil {
+					return fmt.Errorf("unmarshal field secrets: %w", err)
+				}
+			}
 		case envbuild.FieldFirecrackerVersion:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field firecracker_version", values[i])
@@ -199,6 +295,49 @@ func (eb *EnvBuild) assignValues(columns []string, values []any) error {
 				eb.EnvdVersion = new(string)
 				*eb.EnvdVersion = value.String
 			}
+		case envbuild.FieldUploadStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_status", values[i])
+			} else if value.Valid {
+				eb.UploadStatus = new(envbuild.UploadStatus)
+				*eb.UploadStatus = envbuild.UploadStatus(value.String)
+			}
+		case envbuild.FieldSnapshotNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot_node_id", values[i])
+			} else if value.Valid {
+				eb.SnapshotNodeID = new(string)
+				*eb.SnapshotNodeID = value.String
+			}
+		case envbuild.FieldReplicatedRegions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field replicated_regions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eb.ReplicatedRegions); err != nil {
+					return fmt.Errorf("unmarshal field replicated_regions: %w", err)
+				}
+			}
+		case envbuild.FieldSourceBuildID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field source_build_id", values[i])
+			} else if value.Valid {
+				eb.SourceBuildID = new(uuid.UUID)
+				*eb.SourceBuildID = *value.S.(*uuid.UUID)
+			}
+		case envbuild.FieldVariant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant", values[i])
+			} else if value.Valid {
+				eb.Variant = new(string)
+				*eb.Variant = value.String
+			}
+		case envbuild.FieldVariantOf:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_of", values[i])
+			} else if value.Valid {
+				eb.VariantOf = new(uuid.UUID)
+				*eb.VariantOf = *value.S.(*uuid.UUID)
+			}
 		default:
 			eb.selectValues.Set(columns[i], values[i])
 		}
@@ -251,6 +390,11 @@ func (eb *EnvBuild) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := eb.HeartbeatAt; v != nil {
+		builder.WriteString("heartbeat_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := eb.EnvID; v != nil {
 		builder.WriteString("env_id=")
 		builder.WriteString(*v)
@@ -269,6 +413,18 @@ func (eb *EnvBuild) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("readiness_probe=")
+	builder.WriteString(fmt.Sprintf("%v", eb.ReadinessProbe))
+	builder.WriteString(", ")
+	builder.WriteString("hooks=")
+	builder.WriteString(fmt.Sprintf("%v", eb.Hooks))
+	builder.WriteString(", ")
+	builder.WriteString("hardening=")
+	builder.WriteString(fmt.Sprintf("%v", eb.Hardening))
+	builder.WriteString(", ")
+	builder.WriteString("egress=")
+	builder.WriteString(fmt.Sprintf("%v", eb.Egress))
+	builder.WriteString(", ")
 	builder.WriteString("vcpu=")
 	builder.WriteString(fmt.Sprintf("%v", eb.Vcpu))
 	builder.WriteString(", ")
@@ -286,6 +442,17 @@ func (eb *EnvBuild) String() string {
 	builder.WriteString("kernel_version=")
 	builder.WriteString(eb.KernelVersion)
 	builder.WriteString(", ")
+	builder.WriteString("kernel_args=")
+	builder.WriteString(fmt.Sprintf("%v", eb.KernelArgs))
+	builder.WriteString(", ")
+	if v := eb.RootfsBlockSize; v != nil {
+		builder.WriteString("rootfs_block_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("secrets=")
+	builder.WriteString(fmt.Sprintf("%v", eb.Secrets))
+	builder.WriteString(", ")
 	builder.WriteString("firecracker_version=")
 	builder.WriteString(eb.FirecrackerVersion)
 	builder.WriteString(", ")
@@ -293,6 +460,34 @@ func (eb *EnvBuild) String() string {
 		builder.WriteString("envd_version=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := eb.UploadStatus; v != nil {
+		builder.WriteString("upload_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := eb.SnapshotNodeID; v != nil {
+		builder.WriteString("snapshot_node_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("replicated_regions=")
+	builder.WriteString(fmt.Sprintf("%v", eb.ReplicatedRegions))
+	builder.WriteString(", ")
+	if v := eb.SourceBuildID; v != nil {
+		builder.WriteString("source_build_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := eb.Variant; v != nil {
+		builder.WriteString("variant=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := eb.VariantOf; v != nil {
+		builder.WriteString("variant_of=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -21,6 +21,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldFinishedAt holds the string denoting the finished_at field in the database.
 	FieldFinishedAt = "finished_at"
+	// FieldHeartbeatAt holds the string denoting the heartbeat_at field in the database.
+	FieldHeartbeatAt = "heartbeat_at"
 	// FieldEnvID holds the string denoting the env_id field in the database.
 	FieldEnvID = "env_id"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -29,6 +31,14 @@ const (
 	FieldDockerfile = "dockerfile"
 	// FieldStartCmd holds the string denoting the start_cmd field in the database.
 	FieldStartCmd = "start_cmd"
+	// FieldReadinessProbe holds the string denoting the readiness_probe field in the database.
+	FieldReadinessProbe = "readiness_probe"
+	// FieldHooks holds the string denoting the hooks field in the database.
+	FieldHooks = "hooks"
+	// FieldHardening holds the string denoting the hardening field in the database.
+	FieldHardening = "hardening"
+	// FieldEgress holds the string denoting the egress field in the database.
+	FieldEgress = "egress"
 	// FieldVcpu holds the string denoting the vcpu field in the database.
 	FieldVcpu = "vcpu"
 	// FieldRAMMB holds the string denoting the ram_mb field in the database.
@@ -39,10 +49,28 @@ const (
 	FieldTotalDiskSizeMB = "total_disk_size_mb"
 	// FieldKernelVersion holds the string denoting the kernel_version field in the database.
 	FieldKernelVersion = "kernel_version"
+	// FieldKernelArgs holds the string denoting the kernel_args field in the database.
+	FieldKernelArgs = "kernel_args"
+	// FieldRootfsBlockSize holds the string denoting the rootfs_block_size field in the database.
+	FieldRootfsBlockSize = "rootfs_block_size"
+	// FieldSecrets holds the string denoting the secrets field in the database.
+	FieldSecrets = "secrets"
 	// FieldFirecrackerVersion holds the string denoting the firecracker_version field in the database.
 	FieldFirecrackerVersion = "firecracker_version"
 	// FieldEnvdVersion holds the string denoting the envd_version field in the database.
 	FieldEnvdVersion = "envd_version"
+	// FieldUploadStatus holds the string denoting the upload_status field in the database.
+	FieldUploadStatus = "upload_status"
+	// FieldSnapshotNodeID holds the string denoting the snapshot_node_id field in the database.
+	FieldSnapshotNodeID = "snapshot_node_id"
+	// FieldReplicatedRegions holds the string denoting the replicated_regions field in the database.
+	FieldReplicatedRegions = "replicated_regions"
+	// FieldSourceBuildID holds the string denoting the source_build_id field in the database.
+	FieldSourceBuildID = "source_build_id"
+	// FieldVariant holds the string denoting the variant field in the database.
+	FieldVariant = "variant"
+	// FieldVariantOf holds the string denoting the variant_of field in the database.
+	FieldVariantOf = "variant_of"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
 	// Table holds the table name of the envbuild in the database.
@@ -62,17 +90,31 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldFinishedAt,
+	FieldHeartbeatAt,
 	FieldEnvID,
 	FieldStatus,
 	FieldDockerfile,
 	FieldStartCmd,
+	FieldReadinessProbe,
+	FieldHooks,
+	FieldHardening,
+	FieldEgress,
 	FieldVcpu,
 	FieldRAMMB,
 	FieldFreeDiskSizeMB,
 	FieldTotalDiskSizeMB,
 	FieldKernelVersion,
+	FieldKernelArgs,
+	FieldRootfsBlockSize,
+	FieldSecrets,
 	FieldFirecrackerVersion,
 	FieldEnvdVersion,
+	FieldUploadStatus,
+	FieldSnapshotNodeID,
+	FieldReplicatedRegions,
+	FieldSourceBuildID,
+	FieldVariant,
+	FieldVariantOf,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -125,6 +167,30 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// UploadStatus defines the type for the "upload_status" enum field.
+type UploadStatus string
+
+// UploadStatus values.
+const (
+	UploadStatusPending  UploadStatus = "pending"
+	UploadStatusUploaded UploadStatus = "uploaded"
+	UploadStatusFailed   UploadStatus = "failed"
+)
+
+func (us UploadStatus) String() string {
+	return string(us)
+}
+
+// UploadStatusValidator is a validator for the "upload_status" field enum values. It is called by the builders before save.
+func UploadStatusValidator(us UploadStatus) error {
+	switch us {
+	case UploadStatusPending, UploadStatusUploaded, UploadStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("envbuild: invalid enum value for upload_status field: %q", us)
+	}
+}
+
 // OrderOption defines the ordering options for the EnvBuild queries.
 type OrderOption func(*sql.Selector)
 
@@ -148,6 +214,11 @@ func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
 }
 
+// ByHeartbeatAt orders the results by the heartbeat_at field.
+func ByHeartbeatAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeartbeatAt, opts...).ToFunc()
+}
+
 // ByEnvID orders the results by the env_id field.
 func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
@@ -193,6 +264,11 @@ func ByKernelVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKernelVersion, opts...).ToFunc()
 }
 
+// ByRootfsBlockSize orders the results by the rootfs_block_size field.
+func ByRootfsBlockSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRootfsBlockSize, opts...).ToFunc()
+}
+
 // ByFirecrackerVersion orders the results by the firecracker_version field.
 func ByFirecrackerVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFirecrackerVersion, opts...).ToFunc()
@@ -203,6 +279,31 @@ func ByEnvdVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnvdVersion, opts...).ToFunc()
 }
 
+// ByUploadStatus orders the results by the upload_status field.
+func ByUploadStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadStatus, opts...).ToFunc()
+}
+
+// BySnapshotNodeID orders the results by the snapshot_node_id field.
+func BySnapshotNodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnapshotNodeID, opts...).ToFunc()
+}
+
+// BySourceBuildID orders the results by the source_build_id field.
+func BySourceBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceBuildID, opts...).ToFunc()
+}
+
+// ByVariant orders the results by the variant field.
+func ByVariant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariant, opts...).ToFunc()
+}
+
+// ByVariantOf orders the results by the variant_of field.
+func ByVariantOf(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariantOf, opts...).ToFunc()
+}
+
 // ByEnvField orders the results by env field.
 func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -72,6 +72,11 @@ func FinishedAt(v time.Time) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldFinishedAt, v))
 }
 
+// HeartbeatAt applies equality check predicate on the "heartbeat_at" field. It's identical to HeartbeatAtEQ.
+func HeartbeatAt(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
 // EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
 func EnvID(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldEnvID, v))
@@ -112,6 +117,11 @@ func KernelVersion(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldKernelVersion, v))
 }
 
+// RootfsBlockSize applies equality check predicate on the "rootfs_block_size" field. It's identical to RootfsBlockSizeEQ.
+func RootfsBlockSize(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldRootfsBlockSize, v))
+}
+
 // FirecrackerVersion applies equality check predicate on the "firecracker_version" field. It's identical to FirecrackerVersionEQ.
 func FirecrackerVersion(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldFirecrackerVersion, v))
@@ -122,6 +132,26 @@ func EnvdVersion(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldEnvdVersion, v))
 }
 
+// SnapshotNodeID applies equality check predicate on the "snapshot_node_id" field. It's identical to SnapshotNodeIDEQ.
+func SnapshotNodeID(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldSnapshotNodeID, v))
+}
+
+// SourceBuildID applies equality check predicate on the "source_build_id" field. It's identical to SourceBuildIDEQ.
+func SourceBuildID(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldSourceBuildID, v))
+}
+
+// Variant applies equality check predicate on the "variant" field. It's identical to VariantEQ.
+func Variant(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldVariant, v))
+}
+
+// VariantOf applies equality check predicate on the "variant_of" field. It's identical to VariantOfEQ.
+func VariantOf(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldVariantOf, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldCreatedAt, v))
@@ -252,6 +282,56 @@ func FinishedAtNotNil() predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldNotNull(FieldFinishedAt))
 }
 
+// HeartbeatAtEQ applies the EQ predicate on the "heartbeat_at" field.
+func HeartbeatAtEQ(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtNEQ applies the NEQ predicate on the "heartbeat_at" field.
+func HeartbeatAtNEQ(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtIn applies the In predicate on the "heartbeat_at" field.
+func HeartbeatAtIn(vs ...time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtNotIn applies the NotIn predicate on the "heartbeat_at" field.
+func HeartbeatAtNotIn(vs ...time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtGT applies the GT predicate on the "heartbeat_at" field.
+func HeartbeatAtGT(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtGTE applies the GTE predicate on the "heartbeat_at" field.
+func HeartbeatAtGTE(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLT applies the LT predicate on the "heartbeat_at" field.
+func HeartbeatAtLT(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLTE applies the LTE predicate on the "heartbeat_at" field.
+func HeartbeatAtLTE(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtIsNil applies the IsNil predicate on the "heartbeat_at" field.
+func HeartbeatAtIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldHeartbeatAt))
+}
+
+// HeartbeatAtNotNil applies the NotNil predicate on the "heartbeat_at" field.
+func HeartbeatAtNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldHeartbeatAt))
+}
+
 // EnvIDEQ applies the EQ predicate on the "env_id" field.
 func EnvIDEQ(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldEnvID, v))
@@ -497,6 +577,46 @@ func StartCmdContainsFold(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldContainsFold(FieldStartCmd, v))
 }
 
+// ReadinessProbeIsNil applies the IsNil predicate on the "readiness_probe" field.
+func ReadinessProbeIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldReadinessProbe))
+}
+
+// ReadinessProbeNotNil applies the NotNil predicate on the "readiness_probe" field.
+func ReadinessProbeNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldReadinessProbe))
+}
+
+// HooksIsNil applies the IsNil predicate on the "hooks" field.
+func HooksIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldHooks))
+}
+
+// HooksNotNil applies the NotNil predicate on the "hooks" field.
+func HooksNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldHooks))
+}
+
+// HardeningIsNil applies the IsNil predicate on the "hardening" field.
+func HardeningIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldHardening))
+}
+
+// HardeningNotNil applies the NotNil predicate on the "hardening" field.
+func HardeningNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldHardening))
+}
+
+// EgressIsNil applies the IsNil predicate on the "egress" field.
+func EgressIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldEgress))
+}
+
+// EgressNotNil applies the NotNil predicate on the "egress" field.
+func EgressNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldEgress))
+}
+
 // VcpuEQ applies the EQ predicate on the "vcpu" field.
 func VcpuEQ(v int64) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldVcpu, v))
@@ -732,6 +852,76 @@ func KernelVersionContainsFold(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldContainsFold(FieldKernelVersion, v))
 }
 
+// KernelArgsIsNil applies the IsNil predicate on the "kernel_args" field.
+func KernelArgsIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldKernelArgs))
+}
+
+// KernelArgsNotNil applies the NotNil predicate on the "kernel_args" field.
+func KernelArgsNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldKernelArgs))
+}
+
+// RootfsBlockSizeEQ applies the EQ predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldRootfsBlockSize, v))
+}
+
+// RootfsBlockSizeNEQ applies the NEQ predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeNEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldRootfsBlockSize, v))
+}
+
+// RootfsBlockSizeIn applies the In predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldRootfsBlockSize, vs...))
+}
+
+// RootfsBlockSizeNotIn applies the NotIn predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeNotIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldRootfsBlockSize, vs...))
+}
+
+// RootfsBlockSizeGT applies the GT predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeGT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldRootfsBlockSize, v))
+}
+
+// RootfsBlockSizeGTE applies the GTE predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeGTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldRootfsBlockSize, v))
+}
+
+// RootfsBlockSizeLT applies the LT predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeLT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldRootfsBlockSize, v))
+}
+
+// RootfsBlockSizeLTE applies the LTE predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeLTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldRootfsBlockSize, v))
+}
+
+// RootfsBlockSizeIsNil applies the IsNil predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldRootfsBlockSize))
+}
+
+// RootfsBlockSizeNotNil applies the NotNil predicate on the "rootfs_block_size" field.
+func RootfsBlockSizeNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldRootfsBlockSize))
+}
+
+// SecretsIsNil applies the IsNil predicate on the "secrets" field.
+func SecretsIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldSecrets))
+}
+
+// SecretsNotNil applies the NotNil predicate on the "secrets" field.
+func SecretsNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldSecrets))
+}
+
 // FirecrackerVersionEQ applies the EQ predicate on the "firecracker_version" field.
 func FirecrackerVersionEQ(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldFirecrackerVersion, v))
@@ -872,6 +1062,296 @@ func EnvdVersionContainsFold(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldContainsFold(FieldEnvdVersion, v))
 }
 
+// UploadStatusEQ applies the EQ predicate on the "upload_status" field.
+func UploadStatusEQ(v UploadStatus) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldUploadStatus, v))
+}
+
+// UploadStatusNEQ applies the NEQ predicate on the "upload_status" field.
+func UploadStatusNEQ(v UploadStatus) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldUploadStatus, v))
+}
+
+// UploadStatusIn applies the In predicate on the "upload_status" field.
+func UploadStatusIn(vs ...UploadStatus) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldUploadStatus, vs...))
+}
+
+// UploadStatusNotIn applies the NotIn predicate on the "upload_status" field.
+func UploadStatusNotIn(vs ...UploadStatus) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldUploadStatus, vs...))
+}
+
+// UploadStatusIsNil applies the IsNil predicate on the "upload_status" field.
+func UploadStatusIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldUploadStatus))
+}
+
+// UploadStatusNotNil applies the NotNil predicate on the "upload_status" field.
+func UploadStatusNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldUploadStatus))
+}
+
+// SnapshotNodeIDEQ applies the EQ predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDEQ(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDNEQ applies the NEQ predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDNEQ(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDIn applies the In predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDIn(vs ...string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldSnapshotNodeID, vs...))
+}
+
+// SnapshotNodeIDNotIn applies the NotIn predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDNotIn(vs ...string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldSnapshotNodeID, vs...))
+}
+
+// SnapshotNodeIDGT applies the GT predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDGT(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDGTE applies the GTE predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDGTE(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDLT applies the LT predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDLT(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDLTE applies the LTE predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDLTE(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDContains applies the Contains predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDContains(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldContains(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDHasPrefix applies the HasPrefix predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDHasPrefix(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldHasPrefix(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDHasSuffix applies the HasSuffix predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDHasSuffix(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldHasSuffix(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDIsNil applies the IsNil predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldSnapshotNodeID))
+}
+
+// SnapshotNodeIDNotNil applies the NotNil predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldSnapshotNodeID))
+}
+
+// SnapshotNodeIDEqualFold applies the EqualFold predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDEqualFold(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEqualFold(FieldSnapshotNodeID, v))
+}
+
+// SnapshotNodeIDContainsFold applies the ContainsFold predicate on the "snapshot_node_id" field.
+func SnapshotNodeIDContainsFold(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldContainsFold(FieldSnapshotNodeID, v))
+}
+
+// ReplicatedRegionsIsNil applies the IsNil predicate on the "replicated_regions" field.
+func ReplicatedRegionsIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldReplicatedRegions))
+}
+
+// ReplicatedRegionsNotNil applies the NotNil predicate on the "replicated_regions" field.
+func ReplicatedRegionsNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldReplicatedRegions))
+}
+
+// SourceBuildIDEQ applies the EQ predicate on the "source_build_id" field.
+func SourceBuildIDEQ(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDNEQ applies the NEQ predicate on the "source_build_id" field.
+func SourceBuildIDNEQ(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDIn applies the In predicate on the "source_build_id" field.
+func SourceBuildIDIn(vs ...uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldSourceBuildID, vs...))
+}
+
+// SourceBuildIDNotIn applies the NotIn predicate on the "source_build_id" field.
+func SourceBuildIDNotIn(vs ...uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldSourceBuildID, vs...))
+}
+
+// SourceBuildIDGT applies the GT predicate on the "source_build_id" field.
+func SourceBuildIDGT(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDGTE applies the GTE predicate on the "source_build_id" field.
+func SourceBuildIDGTE(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDLT applies the LT predicate on the "source_build_id" field.
+func SourceBuildIDLT(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDLTE applies the LTE predicate on the "source_build_id" field.
+func SourceBuildIDLTE(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDIsNil applies the IsNil predicate on the "source_build_id" field.
+func SourceBuildIDIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldSourceBuildID))
+}
+
+// SourceBuildIDNotNil applies the NotNil predicate on the "source_build_id" field.
+func SourceBuildIDNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldSourceBuildID))
+}
+
+// VariantEQ applies the EQ predicate on the "variant" field.
+func VariantEQ(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldVariant, v))
+}
+
+// VariantNEQ applies the NEQ predicate on the "variant" field.
+func VariantNEQ(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldVariant, v))
+}
+
+// VariantIn applies the In predicate on the "variant" field.
+func VariantIn(vs ...string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldVariant, vs...))
+}
+
+// VariantNotIn applies the NotIn predicate on the "variant" field.
+func VariantNotIn(vs ...string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldVariant, vs...))
+}
+
+// VariantGT applies the GT predicate on the "variant" field.
+func VariantGT(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldVariant, v))
+}
+
+// VariantGTE applies the GTE predicate on the "variant" field.
+func VariantGTE(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldVariant, v))
+}
+
+// VariantLT applies the LT predicate on the "variant" field.
+func VariantLT(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldVariant, v))
+}
+
+// VariantLTE applies the LTE predicate on the "variant" field.
+func VariantLTE(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldVariant, v))
+}
+
+// VariantContains applies the Contains predicate on the "variant" field.
+func VariantContains(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldContains(FieldVariant, v))
+}
+
+// VariantHasPrefix applies the HasPrefix predicate on the "variant" field.
+func VariantHasPrefix(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldHasPrefix(FieldVariant, v))
+}
+
+// VariantHasSuffix applies the HasSuffix predicate on the "variant" field.
+func VariantHasSuffix(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldHasSuffix(FieldVariant, v))
+}
+
+// VariantIsNil applies the IsNil predicate on the "variant" field.
+func VariantIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldVariant))
+}
+
+// VariantNotNil applies the NotNil predicate on the "variant" field.
+func VariantNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldVariant))
+}
+
+// VariantEqualFold applies the EqualFold predicate on the "variant" field.
+func VariantEqualFold(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEqualFold(FieldVariant, v))
+}
+
+// VariantContainsFold applies the ContainsFold predicate on the "variant" field.
+func VariantContainsFold(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldContainsFold(FieldVariant, v))
+}
+
+// VariantOfEQ applies the EQ predicate on the "variant_of" field.
+func VariantOfEQ(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldVariantOf, v))
+}
+
+// VariantOfNEQ applies the NEQ predicate on the "variant_of" field.
+func VariantOfNEQ(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldVariantOf, v))
+}
+
+// VariantOfIn applies the In predicate on the "variant_of" field.
+func VariantOfIn(vs ...uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldVariantOf, vs...))
+}
+
+// VariantOfNotIn applies the NotIn predicate on the "variant_of" field.
+func VariantOfNotIn(vs ...uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldVariantOf, vs...))
+}
+
+// VariantOfGT applies the GT predicate on the "variant_of" field.
+func VariantOfGT(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldVariantOf, v))
+}
+
+// VariantOfGTE applies the GTE predicate on the "variant_of" field.
+func VariantOfGTE(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldVariantOf, v))
+}
+
+// VariantOfLT applies the LT predicate on the "variant_of" field.
+func VariantOfLT(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldVariantOf, v))
+}
+
+// VariantOfLTE applies the LTE predicate on the "variant_of" field.
+func VariantOfLTE(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldVariantOf, v))
+}
+
+// VariantOfIsNil applies the IsNil predicate on the "variant_of" field.
+func VariantOfIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldVariantOf))
+}
+
+// VariantOfNotNil applies the NotNil predicate on the "variant_of" field.
+func VariantOfNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldVariantOf))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.EnvBuild {
 	return predicate.EnvBuild(func(s *sql.Selector) {
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
 
@@ -67,6 +68,20 @@ func (ebc *EnvBuildCreate) SetNillableFinishedAt(t *time.Time) *EnvBuildCreate {
 	return ebc
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (ebc *EnvBuildCreate) SetHeartbeatAt(t time.Time) *EnvBuildCreate {
+	ebc.mutation.SetHeartbeatAt(t)
+	return ebc
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableHeartbeatAt(t *time.Time) *EnvBuildCreate {
+	if t != nil {
+		ebc.SetHeartbeatAt(*t)
+	}
+	return ebc
+}
+
 // SetEnvID sets the "env_id" field.
 func (ebc *EnvBuildCreate) SetEnvID(s string) *EnvBuildCreate {
 	ebc.mutation.SetEnvID(s)
@@ -123,6 +138,30 @@ func (ebc *EnvBuildCreate) SetNillableStartCmd(s *string) *EnvBuildCreate {
 	return ebc
 }
 
+// SetReadinessProbe sets the "readiness_probe" field.
+func (ebc *EnvBuildCreate) SetReadinessProbe(sp *schema.ReadinessProbe) *EnvBuildCreate {
+	ebc.mutation.SetReadinessProbe(sp)
+	return ebc
+}
+
+// SetHooks sets the "hooks" field.
+func (ebc *EnvBuildCreate) SetHooks(sh *schema.LifecycleHooks) *EnvBuildCreate {
+	ebc.mutation.SetHooks(sh)
+	return ebc
+}
+
+// SetHardening sets the "hardening" field.
+func (ebc *EnvBuildCreate) SetHardening(sp *schema.HardeningPolicy) *EnvBuildCreate {
+	ebc.mutation.SetHardening(sp)
+	return ebc
+}
+
+// SetEgress sets the "egress" field.
+func (ebc *EnvBuildCreate) SetEgress(sp *schema.EgressPolicy) *EnvBuildCreate {
+	ebc.mutation.SetEgress(sp)
+	return ebc
+}
+
 // SetVcpu sets the "vcpu" field.
 func (ebc *EnvBuildCreate) SetVcpu(i int64) *EnvBuildCreate {
 	ebc.mutation.SetVcpu(i)
@@ -169,6 +208,32 @@ func (ebc *EnvBuildCreate) SetNillableKernelVersion(s *string) *EnvBuildCreate {
 	return ebc
 }
 
+// SetKernelArgs sets the "kernel_args" field.
+func (ebc *EnvBuildCreate) SetKernelArgs(s []string) *EnvBuildCreate {
+	ebc.mutation.SetKernelArgs(s)
+	return ebc
+}
+
+// SetRootfsBlockSize sets the "rootfs_block_size" field.
+func (ebc *EnvBuildCreate) SetRootfsBlockSize(i int64) *EnvBuildCreate {
+	ebc.mutation.SetRootfsBlockSize(i)
+	return ebc
+}
+
+// SetNillableRootfsBlockSize sets the "rootfs_block_size" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableRootfsBlockSize(i *int64) *EnvBuildCreate {
+	if i != nil {
+		ebc.SetRootfsBlockSize(*i)
+	}
+	return ebc
+}
+
+// SetSecrets sets the "secrets" field.
+func (ebc *EnvBuildCreate) SetSecrets(s []string) *EnvBuildCreate {
+	ebc.mutation.SetSecrets(s)
+	return ebc
+}
+
 // SetFirecrackerVersion sets the "firecracker_version" field.
 func (ebc *EnvBuildCreate) SetFirecrackerVersion(s string) *EnvBuildCreate {
 	ebc.mutation.SetFirecrackerVersion(s)
@@ -197,6 +262,82 @@ func (ebc *EnvBuildCreate) SetNillableEnvdVersion(s *string) *EnvBuildCreate {
 	return ebc
 }
 
+// SetUploadStatus sets the "upload_status" field.
+func (ebc *EnvBuildCreate) SetUploadStatus(es envbuild.UploadStatus) *EnvBuildCreate {
+	ebc.mutation.SetUploadStatus(es)
+	return ebc
+}
+
+// SetNillableUploadStatus sets the "upload_status" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableUploadStatus(es *envbuild.UploadStatus) *EnvBuildCreate {
+	if es != nil {
+		ebc.SetUploadStatus(*es)
+	}
+	return ebc
+}
+
+// SetSnapshotNodeID sets the "snapshot_node_id" field.
+func (ebc *EnvBuildCreate) SetSnapshotNodeID(s string) *EnvBuildCreate {
+	ebc.mutation.SetSnapshotNodeID(s)
+	return ebc
+}
+
+// SetNillableSnapshotNodeID sets the "snapshot_node_id" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableSnapshotNodeID(s *string) *EnvBuildCreate {
+	if s != nil {
+		ebc.SetSnapshotNodeID(*s)
+	}
+	return ebc
+}
+
+// SetReplicatedRegions sets the "replicated_regions" field.
+func (ebc *EnvBuildCreate) SetReplicatedRegions(s []string) *EnvBuildCreate {
+	ebc.mutation.SetReplicatedRegions(s)
+	return ebc
+}
+
+// SetSourceBuildID sets the "source_build_id" field.
+func (ebc *EnvBuildCreate) SetSourceBuildID(u uuid.UUID) *EnvBuildCreate {
+	ebc.mutation.SetSourceBuildID(u)
+	return ebc
+}
+
+// SetNillableSourceBuildID sets the "source_build_id" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableSourceBuildID(u *uuid.UUID) *EnvBuildCreate {
+	if u != nil {
+		ebc.SetSourceBuildID(*u)
+	}
+	return ebc
+}
+
+// SetVariant sets the "variant" field.
+func (ebc *EnvBuildCreate) SetVariant(s string) *EnvBuildCreate {
+	ebc.mutation.SetVariant(s)
+	return ebc
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableVariant(s *string) *EnvBuildCreate {
+	if s != nil {
+		ebc.SetVariant(*s)
+	}
+	return ebc
+}
+
+// SetVariantOf sets the "variant_of" field.
+func (ebc *EnvBuildCreate) SetVariantOf(u uuid.UUID) *EnvBuildCreate {
+	ebc.mutation.SetVariantOf(u)
+	return ebc
+}
+
+// SetNillableVariantOf sets the "variant_of" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableVariantOf(u *uuid.UUID) *EnvBuildCreate {
+	if u != nil {
+		ebc.SetVariantOf(*u)
+	}
+	return ebc
+}
+
 // SetID sets the "id" field.
 func (ebc *EnvBuildCreate) SetID(u uuid.UUID) *EnvBuildCreate {
 	ebc.mutation.SetID(u)
@@ -296,6 +437,11 @@ func (ebc *EnvBuildCreate) check() error {
 	if _, ok := ebc.mutation.FirecrackerVersion(); !ok {
 		return &ValidationError{Name: "firecracker_version", err: errors.New(`models: missing required field "EnvBuild.firecracker_version"`)}
 	}
+	if v, ok := ebc.mutation.UploadStatus(); ok {
+		if err := envbuild.UploadStatusValidator(v); err != nil {
+			return &ValidationError{Name: "upload_status", err: fmt.Errorf(`models: validator failed for field "EnvBuild.upload_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -345,6 +491,10 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_spec.SetField(envbuild.FieldFinishedAt, field.TypeTime, value)
 		_node.FinishedAt = &value
 	}
+	if value, ok := ebc.mutation.HeartbeatAt(); ok {
+		_spec.SetField(envbuild.FieldHeartbeatAt, field.TypeTime, value)
+		_node.HeartbeatAt = &value
+	}
 	if value, ok := ebc.mutation.Status(); ok {
 		_spec.SetField(envbuild.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -357,6 +507,22 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_spec.SetField(envbuild.FieldStartCmd, field.TypeString, value)
 		_node.StartCmd = &value
 	}
+	if value, ok := ebc.mutation.ReadinessProbe(); ok {
+		_spec.SetField(envbuild.FieldReadinessProbe, field.TypeJSON, value)
+		_node.ReadinessProbe = value
+	}
+	if value, ok := ebc.mutation.Hooks(); ok {
+		_spec.SetField(envbuild.FieldHooks, field.TypeJSON, value)
+		_node.Hooks = value
+	}
+	if value, ok := ebc.mutation.Hardening(); ok {
+		_spec.SetField(envbuild.FieldHardening, field.TypeJSON, value)
+		_node.Hardening = value
+	}
+	if value, ok := ebc.mutation.Egress(); ok {
+		_spec.SetField(envbuild.FieldEgress, field.TypeJSON, value)
+		_node.Egress = value
+	}
 	if value, ok := ebc.mutation.Vcpu(); ok {
 		_spec.SetField(envbuild.FieldVcpu, field.TypeInt64, value)
 		_node.Vcpu = value
@@ -377,6 +543,18 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_spec.SetField(envbuild.FieldKernelVersion, field.TypeString, value)
 		_node.KernelVersion = value
 	}
+	if value, ok := ebc.mutation.KernelArgs(); ok {
+		_spec.SetField(envbuild.FieldKernelArgs, field.TypeJSON, value)
+		_node.KernelArgs = value
+	}
+	if value, ok := ebc.mutation.RootfsBlockSize(); ok {
+		_spec.SetField(envbuild.FieldRootfsBlockSize, field.TypeInt64, value)
+		_node.RootfsBlockSize = &value
+	}
+	if value, ok := ebc.mutation.Secrets(); ok {
+		_spec.SetField(envbuild.FieldSecrets, field.TypeJSON, value)
+		_node.Secrets = value
+	}
 	if value, ok := ebc.mutation.FirecrackerVersion(); ok {
 		_spec.SetField(envbuild.FieldFirecrackerVersion, field.TypeString, value)
 		_node.FirecrackerVersion = value
@@ -385,6 +563,30 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_spec.SetField(envbuild.FieldEnvdVersion, field.TypeString, value)
 		_node.EnvdVersion = &value
 	}
+	if value, ok := ebc.mutation.UploadStatus(); ok {
+		_spec.SetField(envbuild.FieldUploadStatus, field.TypeEnum, value)
+		_node.UploadStatus = &value
+	}
+	if value, ok := ebc.mutation.SnapshotNodeID(); ok {
+		_spec.SetField(envbuild.FieldSnapshotNodeID, field.TypeString, value)
+		_node.SnapshotNodeID = &value
+	}
+	if value, ok := ebc.mutation.ReplicatedRegions(); ok {
+		_spec.SetField(envbuild.FieldReplicatedRegions, field.TypeJSON, value)
+		_node.ReplicatedRegions = value
+	}
+	if value, ok := ebc.mutation.SourceBuildID(); ok {
+		_spec.SetField(envbuild.FieldSourceBuildID, field.TypeUUID, value)
+		_node.SourceBuildID = &value
+	}
+	if value, ok := ebc.mutation.Variant(); ok {
+		_spec.SetField(envbuild.FieldVariant, field.TypeString, value)
+		_node.Variant = &value
+	}
+	if value, ok := ebc.mutation.VariantOf(); ok {
+		_spec.SetField(envbuild.FieldVariantOf, field.TypeUUID, value)
+		_node.VariantOf = &value
+	}
 	if nodes := ebc.mutation.EnvIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -485,6 +687,24 @@ func (u *EnvBuildUpsert) ClearFinishedAt() *EnvBuildUpsert {
 	return u
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *EnvBuildUpsert) SetHeartbeatAt(v time.Time) *EnvBuildUpsert {
+	u.Set(envbuild.FieldHeartbeatAt, v)
+	return u
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateHeartbeatAt() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldHeartbeatAt)
+	return u
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *EnvBuildUpsert) ClearHeartbeatAt() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldHeartbeatAt)
+	return u
+}
+
 // SetEnvID sets the "env_id" field.
 func (u *EnvBuildUpsert) SetEnvID(v string) *EnvBuildUpsert {
 	u.Set(envbuild.FieldEnvID, v)
@@ -551,6 +771,78 @@ func (u *EnvBuildUpsert) ClearStartCmd() *EnvBuildUpsert {
 	return u
 }
 
+// SetReadinessProbe sets the "readiness_probe" field.
+func (u *EnvBuildUpsert) SetReadinessProbe(v *schema.ReadinessProbe) *EnvBuildUpsert {
+	u.Set(envbuild.FieldReadinessProbe, v)
+	return u
+}
+
+// UpdateReadinessProbe sets the "readiness_probe" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateReadinessProbe() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldReadinessProbe)
+	return u
+}
+
+// ClearReadinessProbe clears the value of the "readiness_probe" field.
+func (u *EnvBuildUpsert) ClearReadinessProbe() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldReadinessProbe)
+	return u
+}
+
+// SetHooks sets the "hooks" field.
+func (u *EnvBuildUpsert) SetHooks(v *schema.LifecycleHooks) *EnvBuildUpsert {
+	u.Set(envbuild.FieldHooks, v)
+	return u
+}
+
+// UpdateHooks sets the "hooks" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateHooks() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldHooks)
+	return u
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (u *EnvBuildUpsert) ClearHooks() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldHooks)
+	return u
+}
+
+// SetHardening sets the "hardening" field.
+func (u *EnvBuildUpsert) SetHardening(v *schema.HardeningPolicy) *EnvBuildUpsert {
+	u.Set(envbuild.FieldHardening, v)
+	return u
+}
+
+// UpdateHardening sets the "hardening" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateHardening() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldHardening)
+	return u
+}
+
+// ClearHardening clears the value of the "hardening" field.
+func (u *EnvBuildUpsert) ClearHardening() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldHardening)
+	return u
+}
+
+// SetEgress sets the "egress" field.
+func (u *EnvBuildUpsert) SetEgress(v *schema.EgressPolicy) *EnvBuildUpsert {
+	u.Set(envbuild.FieldEgress, v)
+	return u
+}
+
+// UpdateEgress sets the "egress" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateEgress() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldEgress)
+	return u
+}
+
+// ClearEgress clears the value of the "egress" field.
+func (u *EnvBuildUpsert) ClearEgress() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldEgress)
+	return u
+}
+
 // SetVcpu sets the "vcpu" field.
 func (u *EnvBuildUpsert) SetVcpu(v int64) *EnvBuildUpsert {
 	u.Set(envbuild.FieldVcpu, v)
@@ -641,6 +933,66 @@ func (u *EnvBuildUpsert) UpdateKernelVersion() *EnvBuildUpsert {
 	return u
 }
 
+// SetKernelArgs sets the "kernel_args" field.
+func (u *EnvBuildUpsert) SetKernelArgs(v []string) *EnvBuildUpsert {
+	u.Set(envbuild.FieldKernelArgs, v)
+	return u
+}
+
+// UpdateKernelArgs sets the "kernel_args" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateKernelArgs() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldKernelArgs)
+	return u
+}
+
+// ClearKernelArgs clears the value of the "kernel_args" field.
+func (u *EnvBuildUpsert) ClearKernelArgs() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldKernelArgs)
+	return u
+}
+
+// SetRootfsBlockSize sets the "rootfs_block_size" field.
+func (u *EnvBuildUpsert) SetRootfsBlockSize(v int64) *EnvBuildUpsert {
+	u.Set(envbuild.FieldRootfsBlockSize, v)
+	return u
+}
+
+// UpdateRootfsBlockSize sets the "rootfs_block_size" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateRootfsBlockSize() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldRootfsBlockSize)
+	return u
+}
+
+// AddRootfsBlockSize adds v to the "rootfs_block_size" field.
+func (u *EnvBuildUpsert) AddRootfsBlockSize(v int64) *EnvBuildUpsert {
+	u.Add(envbuild.FieldRootfsBlockSize, v)
+	return u
+}
+
+// ClearRootfsBlockSize clears the value of the "rootfs_block_size" field.
+func (u *EnvBuildUpsert) ClearRootfsBlockSize() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldRootfsBlockSize)
+	return u
+}
+
+// SetSecrets sets the "secrets" field.
+func (u *EnvBuildUpsert) SetSecrets(v []string) *EnvBuildUpsert {
+	u.Set(envbuild.FieldSecrets, v)
+	return u
+}
+
+// UpdateSecrets sets the "secrets" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateSecrets() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldSecrets)
+	return u
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (u *EnvBuildUpsert) ClearSecrets() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldSecrets)
+	return u
+}
+
 // SetFirecrackerVersion sets the "firecracker_version" field.
 func (u *EnvBuildUpsert) SetFirecrackerVersion(v string) *EnvBuildUpsert {
 	u.Set(envbuild.FieldFirecrackerVersion, v)
@@ -671,6 +1023,114 @@ func (u *EnvBuildUpsert) ClearEnvdVersion() *EnvBuildUpsert {
 	return u
 }
 
+// SetUploadStatus sets the "upload_status" field.
+func (u *EnvBuildUpsert) SetUploadStatus(v envbuild.UploadStatus) *EnvBuildUpsert {
+	u.Set(envbuild.FieldUploadStatus, v)
+	return u
+}
+
+// UpdateUploadStatus sets the "upload_status" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateUploadStatus() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldUploadStatus)
+	return u
+}
+
+// ClearUploadStatus clears the value of the "upload_status" field.
+func (u *EnvBuildUpsert) ClearUploadStatus() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldUploadStatus)
+	return u
+}
+
+// SetSnapshotNodeID sets the "snapshot_node_id" field.
+func (u *EnvBuildUpsert) SetSnapshotNodeID(v string) *EnvBuildUpsert {
+	u.Set(envbuild.FieldSnapshotNodeID, v)
+	return u
+}
+
+// UpdateSnapshotNodeID sets the "snapshot_node_id" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateSnapshotNodeID() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldSnapshotNodeID)
+	return u
+}
+
+// ClearSnapshotNodeID clears the value of the "snapshot_node_id" field.
+func (u *EnvBuildUpsert) ClearSnapshotNodeID() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldSnapshotNodeID)
+	return u
+}
+
+// SetReplicatedRegions sets the "replicated_regions" field.
+func (u *EnvBuildUpsert) SetReplicatedRegions(v []string) *EnvBuildUpsert {
+	u.Set(envbuild.FieldReplicatedRegions, v)
+	return u
+}
+
+// UpdateReplicatedRegions sets the "replicated_regions" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateReplicatedRegions() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldReplicatedRegions)
+	return u
+}
+
+// ClearReplicatedRegions clears the value of the "replicated_regions" field.
+func (u *EnvBuildUpsert) ClearReplicatedRegions() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldReplicatedRegions)
+	return u
+}
+
+// SetSourceBuildID sets the "source_build_id" field.
+func (u *EnvBuildUpsert) SetSourceBuildID(v uuid.UUID) *EnvBuildUpsert {
+	u.Set(envbuild.FieldSourceBuildID, v)
+	return u
+}
+
+// UpdateSourceBuildID sets the "source_build_id" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateSourceBuildID() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldSourceBuildID)
+	return u
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (u *EnvBuildUpsert) ClearSourceBuildID() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldSourceBuildID)
+	return u
+}
+
+// SetVariant sets the "variant" field.
+func (u *EnvBuildUpsert) SetVariant(v string) *EnvBuildUpsert {
+	u.Set(envbuild.FieldVariant, v)
+	return u
+}
+
+// UpdateVariant sets the "variant" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateVariant() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldVariant)
+	return u
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (u *EnvBuildUpsert) ClearVariant() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldVariant)
+	return u
+}
+
+// SetVariantOf sets the "variant_of" field.
+func (u *EnvBuildUpsert) SetVariantOf(v uuid.UUID) *EnvBuildUpsert {
+	u.Set(envbuild.FieldVariantOf, v)
+	return u
+}
+
+// UpdateVariantOf sets the "variant_of" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateVariantOf() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldVariantOf)
+	return u
+}
+
+// ClearVariantOf clears the value of the "variant_of" field.
+func (u *EnvBuildUpsert) ClearVariantOf() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldVariantOf)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -757,6 +1217,27 @@ func (u *EnvBuildUpsertOne) ClearFinishedAt() *EnvBuildUpsertOne {
 	})
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *EnvBuildUpsertOne) SetHeartbeatAt(v time.Time) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateHeartbeatAt() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *EnvBuildUpsertOne) ClearHeartbeatAt() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearHeartbeatAt()
+	})
+}
+
 // SetEnvID sets the "env_id" field.
 func (u *EnvBuildUpsertOne) SetEnvID(v string) *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
@@ -834,63 +1315,147 @@ func (u *EnvBuildUpsertOne) ClearStartCmd() *EnvBuildUpsertOne {
 	})
 }
 
-// SetVcpu sets the "vcpu" field.
-func (u *EnvBuildUpsertOne) SetVcpu(v int64) *EnvBuildUpsertOne {
+// SetReadinessProbe sets the "readiness_probe" field.
+func (u *EnvBuildUpsertOne) SetReadinessProbe(v *schema.ReadinessProbe) *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
-		s.SetVcpu(v)
+		s.SetReadinessProbe(v)
 	})
 }
 
-// AddVcpu adds v to the "vcpu" field.
-func (u *EnvBuildUpsertOne) AddVcpu(v int64) *EnvBuildUpsertOne {
+// UpdateReadinessProbe sets the "readiness_probe" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateReadinessProbe() *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
-		s.AddVcpu(v)
+		s.UpdateReadinessProbe()
 	})
 }
 
-// UpdateVcpu sets the "vcpu" field to the value that was provided on create.
-func (u *EnvBuildUpsertOne) UpdateVcpu() *EnvBuildUpsertOne {
+// ClearReadinessProbe clears the value of the "readiness_probe" field.
+func (u *EnvBuildUpsertOne) ClearReadinessProbe() *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
-		s.UpdateVcpu()
+		s.ClearReadinessProbe()
 	})
 }
 
-// SetRAMMB sets the "ram_mb" field.
-func (u *EnvBuildUpsertOne) SetRAMMB(v int64) *EnvBuildUpsertOne {
+// SetHooks sets the "hooks" field.
+func (u *EnvBuildUpsertOne) SetHooks(v *schema.LifecycleHooks) *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
-		s.SetRAMMB(v)
+		s.SetHooks(v)
 	})
 }
 
-// AddRAMMB adds v to the "ram_mb" field.
-func (u *EnvBuildUpsertOne) AddRAMMB(v int64) *EnvBuildUpsertOne {
+// UpdateHooks sets the "hooks" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateHooks() *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
-		s.AddRAMMB(v)
+		s.UpdateHooks()
 	})
 }
 
-// UpdateRAMMB sets the "ram_mb" field to the value that was provided on create.
-func (u *EnvBuildUpsertOne) UpdateRAMMB() *EnvBuildUpsertOne {
+// ClearHooks clears the value of the "hooks" field.
+func (u *EnvBuildUpsertOne) ClearHooks() *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
-		s.UpdateRAMMB()
+		s.ClearHooks()
 	})
 }
 
-// SetFreeDiskSizeMB sets the "free_disk_size_mb" field.
-func (u *EnvBuildUpsertOne) SetFreeDiskSizeMB(v int64) *EnvBuildUpsertOne {
+// SetHardening sets the "hardening" field.
+func (u *EnvBuildUpsertOne) SetHardening(v *schema.HardeningPolicy) *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
-		s.SetFreeDiskSizeMB(v)
+		s.SetHardening(v)
 	})
 }
 
-// AddFreeDiskSizeMB adds v to the "free_disk_size_mb" field.
-func (u *EnvBuildUpsertOne) AddFreeDiskSizeMB(v int64) *EnvBuildUpsertOne {
+// UpdateHardening sets the "hardening" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateHardening() *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
-		s.AddFreeDiskSizeMB(v)
+		s.UpdateHardening()
 	})
 }
 
-// UpdateFreeDiskSizeMB sets the "free_disk_size_mb" field to the value that was provided on create.
+// ClearHardening clears the value of the "hardening" field.
+func (u *EnvBuildUpsertOne) ClearHardening() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearHardening()
+	})
+}
+
+// SetEgress sets the "egress" field.
+func (u *EnvBuildUpsertOne) SetEgress(v *schema.EgressPolicy) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetEgress(v)
+	})
+}
+
+// UpdateEgress sets the "egress" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateEgress() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateEgress()
+	})
+}
+
+// ClearEgress clears the value of the "egress" field.
+func (u *EnvBuildUpsertOne) ClearEgress() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearEgress()
+	})
+}
+
+// SetVcpu sets the "vcpu" field.
+func (u *EnvBuildUpsertOne) SetVcpu(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetVcpu(v)
+	})
+}
+
+// AddVcpu adds v to the "vcpu" field.
+func (u *EnvBuildUpsertOne) AddVcpu(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddVcpu(v)
+	})
+}
+
+// UpdateVcpu sets the "vcpu" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateVcpu() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateVcpu()
+	})
+}
+
+// SetRAMMB sets the "ram_mb" field.
+func (u *EnvBuildUpsertOne) SetRAMMB(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetRAMMB(v)
+	})
+}
+
+// AddRAMMB adds v to the "ram_mb" field.
+func (u *EnvBuildUpsertOne) AddRAMMB(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddRAMMB(v)
+	})
+}
+
+// UpdateRAMMB sets the "ram_mb" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateRAMMB() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateRAMMB()
+	})
+}
+
+// SetFreeDiskSizeMB sets the "free_disk_size_mb" field.
+func (u *EnvBuildUpsertOne) SetFreeDiskSizeMB(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetFreeDiskSizeMB(v)
+	})
+}
+
+// AddFreeDiskSizeMB adds v to the "free_disk_size_mb" field.
+func (u *EnvBuildUpsertOne) AddFreeDiskSizeMB(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddFreeDiskSizeMB(v)
+	})
+}
+
+// UpdateFreeDiskSizeMB sets the "free_disk_size_mb" field to the value that was provided on create.
 func (u *EnvBuildUpsertOne) UpdateFreeDiskSizeMB() *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
 		s.UpdateFreeDiskSizeMB()
@@ -939,6 +1504,76 @@ func (u *EnvBuildUpsertOne) UpdateKernelVersion() *EnvBuildUpsertOne {
 	})
 }
 
+// SetKernelArgs sets the "kernel_args" field.
+func (u *EnvBuildUpsertOne) SetKernelArgs(v []string) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetKernelArgs(v)
+	})
+}
+
+// UpdateKernelArgs sets the "kernel_args" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateKernelArgs() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateKernelArgs()
+	})
+}
+
+// ClearKernelArgs clears the value of the "kernel_args" field.
+func (u *EnvBuildUpsertOne) ClearKernelArgs() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearKernelArgs()
+	})
+}
+
+// SetRootfsBlockSize sets the "rootfs_block_size" field.
+func (u *EnvBuildUpsertOne) SetRootfsBlockSize(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetRootfsBlockSize(v)
+	})
+}
+
+// AddRootfsBlockSize adds v to the "rootfs_block_size" field.
+func (u *EnvBuildUpsertOne) AddRootfsBlockSize(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddRootfsBlockSize(v)
+	})
+}
+
+// UpdateRootfsBlockSize sets the "rootfs_block_size" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateRootfsBlockSize() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateRootfsBlockSize()
+	})
+}
+
+// ClearRootfsBlockSize clears the value of the "rootfs_block_size" field.
+func (u *EnvBuildUpsertOne) ClearRootfsBlockSize() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearRootfsBlockSize()
+	})
+}
+
+// SetSecrets sets the "secrets" field.
+func (u *EnvBuildUpsertOne) SetSecrets(v []string) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetSecrets(v)
+	})
+}
+
+// UpdateSecrets sets the "secrets" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateSecrets() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateSecrets()
+	})
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (u *EnvBuildUpsertOne) ClearSecrets() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearSecrets()
+	})
+}
+
 // SetFirecrackerVersion sets the "firecracker_version" field.
 func (u *EnvBuildUpsertOne) SetFirecrackerVersion(v string) *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
@@ -974,6 +1609,132 @@ func (u *EnvBuildUpsertOne) ClearEnvdVersion() *EnvBuildUpsertOne {
 	})
 }
 
+// SetUploadStatus sets the "upload_status" field.
+func (u *EnvBuildUpsertOne) SetUploadStatus(v envbuild.UploadStatus) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetUploadStatus(v)
+	})
+}
+
+// UpdateUploadStatus sets the "upload_status" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateUploadStatus() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateUploadStatus()
+	})
+}
+
+// ClearUploadStatus clears the value of the "upload_status" field.
+func (u *EnvBuildUpsertOne) ClearUploadStatus() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearUploadStatus()
+	})
+}
+
+// SetSnapshotNodeID sets the "snapshot_node_id" field.
+func (u *EnvBuildUpsertOne) SetSnapshotNodeID(v string) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetSnapshotNodeID(v)
+	})
+}
+
+// UpdateSnapshotNodeID sets the "snapshot_node_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateSnapshotNodeID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateSnapshotNodeID()
+	})
+}
+
+// ClearSnapshotNodeID clears the value of the "snapshot_node_id" field.
+func (u *EnvBuildUpsertOne) ClearSnapshotNodeID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearSnapshotNodeID()
+	})
+}
+
+// SetReplicatedRegions sets the "replicated_regions" field.
+func (u *EnvBuildUpsertOne) SetReplicatedRegions(v []string) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetReplicatedRegions(v)
+	})
+}
+
+// UpdateReplicatedRegions sets the "replicated_regions" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateReplicatedRegions() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateReplicatedRegions()
+	})
+}
+
+// ClearReplicatedRegions clears the value of the "replicated_regions" field.
+func (u *EnvBuildUpsertOne) ClearReplicatedRegions() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearReplicatedRegions()
+	})
+}
+
+// SetSourceBuildID sets the "source_build_id" field.
+func (u *EnvBuildUpsertOne) SetSourceBuildID(v uuid.UUID) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetSourceBuildID(v)
+	})
+}
+
+// UpdateSourceBuildID sets the "source_build_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateSourceBuildID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateSourceBuildID()
+	})
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (u *EnvBuildUpsertOne) ClearSourceBuildID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearSourceBuildID()
+	})
+}
+
+// SetVariant sets the "variant" field.
+func (u *EnvBuildUpsertOne) SetVariant(v string) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetVariant(v)
+	})
+}
+
+// UpdateVariant sets the "variant" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateVariant() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateVariant()
+	})
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (u *EnvBuildUpsertOne) ClearVariant() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearVariant()
+	})
+}
+
+// SetVariantOf sets the "variant_of" field.
+func (u *EnvBuildUpsertOne) SetVariantOf(v uuid.UUID) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetVariantOf(v)
+	})
+}
+
+// UpdateVariantOf sets the "variant_of" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateVariantOf() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateVariantOf()
+	})
+}
+
+// ClearVariantOf clears the value of the "variant_of" field.
+func (u *EnvBuildUpsertOne) ClearVariantOf() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearVariantOf()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvBuildUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1227,6 +1988,27 @@ func (u *EnvBuildUpsertBulk) ClearFinishedAt() *EnvBuildUpsertBulk {
 	})
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *EnvBuildUpsertBulk) SetHeartbeatAt(v time.Time) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateHeartbeatAt() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *EnvBuildUpsertBulk) ClearHeartbeatAt() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearHeartbeatAt()
+	})
+}
+
 // SetEnvID sets the "env_id" field.
 func (u *EnvBuildUpsertBulk) SetEnvID(v string) *EnvBuildUpsertBulk {
 	return u.Update(func(s *EnvBuildUpsert) {
@@ -1304,6 +2086,90 @@ func (u *EnvBuildUpsertBulk) ClearStartCmd() *EnvBuildUpsertBulk {
 	})
 }
 
+// SetReadinessProbe sets the "readiness_probe" field.
+func (u *EnvBuildUpsertBulk) SetReadinessProbe(v *schema.ReadinessProbe) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetReadinessProbe(v)
+	})
+}
+
+// UpdateReadinessProbe sets the "readiness_probe" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateReadinessProbe() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateReadinessProbe()
+	})
+}
+
+// ClearReadinessProbe clears the value of the "readiness_probe" field.
+func (u *EnvBuildUpsertBulk) ClearReadinessProbe() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearReadinessProbe()
+	})
+}
+
+// SetHooks sets the "hooks" field.
+func (u *EnvBuildUpsertBulk) SetHooks(v *schema.LifecycleHooks) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetHooks(v)
+	})
+}
+
+// UpdateHooks sets the "hooks" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateHooks() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateHooks()
+	})
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (u *EnvBuildUpsertBulk) ClearHooks() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearHooks()
+	})
+}
+
+// SetHardening sets the "hardening" field.
+func (u *EnvBuildUpsertBulk) SetHardening(v *schema.HardeningPolicy) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetHardening(v)
+	})
+}
+
+// UpdateHardening sets the "hardening" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateHardening() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateHardening()
+	})
+}
+
+// ClearHardening clears the value of the "hardening" field.
+func (u *EnvBuildUpsertBulk) ClearHardening() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearHardening()
+	})
+}
+
+// SetEgress sets the "egress" field.
+func (u *EnvBuildUpsertBulk) SetEgress(v *schema.EgressPolicy) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetEgress(v)
+	})
+}
+
+// UpdateEgress sets the "egress" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateEgress() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateEgress()
+	})
+}
+
+// ClearEgress clears the value of the "egress" field.
+func (u *EnvBuildUpsertBulk) ClearEgress() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearEgress()
+	})
+}
+
 // SetVcpu sets the "vcpu" field.
 func (u *EnvBuildUpsertBulk) SetVcpu(v int64) *EnvBuildUpsertBulk {
 	return u.Update(func(s *EnvBuildUpsert) {
@@ -1409,6 +2275,76 @@ func (u *EnvBuildUpsertBulk) UpdateKernelVersion() *EnvBuildUpsertBulk {
 	})
 }
 
+// SetKernelArgs sets the "kernel_args" field.
+func (u *EnvBuildUpsertBulk) SetKernelArgs(v []string) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetKernelArgs(v)
+	})
+}
+
+// UpdateKernelArgs sets the "kernel_args" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateKernelArgs() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateKernelArgs()
+	})
+}
+
+// ClearKernelArgs clears the value of the "kernel_args" field.
+func (u *EnvBuildUpsertBulk) ClearKernelArgs() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearKernelArgs()
+	})
+}
+
+// SetRootfsBlockSize sets the "rootfs_block_size" field.
+func (u *EnvBuildUpsertBulk) SetRootfsBlockSize(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetRootfsBlockSize(v)
+	})
+}
+
+// AddRootfsBlockSize adds v to the "rootfs_block_size" field.
+func (u *EnvBuildUpsertBulk) AddRootfsBlockSize(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddRootfsBlockSize(v)
+	})
+}
+
+// UpdateRootfsBlockSize sets the "rootfs_block_size" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateRootfsBlockSize() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateRootfsBlockSize()
+	})
+}
+
+// ClearRootfsBlockSize clears the value of the "rootfs_block_size" field.
+func (u *EnvBuildUpsertBulk) ClearRootfsBlockSize() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearRootfsBlockSize()
+	})
+}
+
+// SetSecrets sets the "secrets" field.
+func (u *EnvBuildUpsertBulk) SetSecrets(v []string) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetSecrets(v)
+	})
+}
+
+// UpdateSecrets sets the "secrets" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateSecrets() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateSecrets()
+	})
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (u *EnvBuildUpsertBulk) ClearSecrets() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearSecrets()
+	})
+}
+
 // SetFirecrackerVersion sets the "firecracker_version" field.
 func (u *EnvBuildUpsertBulk) SetFirecrackerVersion(v string) *EnvBuildUpsertBulk {
 	return u.Update(func(s *EnvBuildUpsert) {
@@ -1444,6 +2380,132 @@ func (u *EnvBuildUpsertBulk) ClearEnvdVersion() *EnvBuildUpsertBulk {
 	})
 }
 
+// SetUploadStatus sets the "upload_status" field.
+func (u *EnvBuildUpsertBulk) SetUploadStatus(v envbuild.UploadStatus) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetUploadStatus(v)
+	})
+}
+
+// UpdateUploadStatus sets the "upload_status" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateUploadStatus() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateUploadStatus()
+	})
+}
+
+// ClearUploadStatus clears the value of the "upload_status" field.
+func (u *EnvBuildUpsertBulk) ClearUploadStatus() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearUploadStatus()
+	})
+}
+
+// SetSnapshotNodeID sets the "snapshot_node_id" field.
+func (u *EnvBuildUpsertBulk) SetSnapshotNodeID(v string) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetSnapshotNodeID(v)
+	})
+}
+
+// UpdateSnapshotNodeID sets the "snapshot_node_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateSnapshotNodeID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateSnapshotNodeID()
+	})
+}
+
+// ClearSnapshotNodeID clears the value of the "snapshot_node_id" field.
+func (u *EnvBuildUpsertBulk) ClearSnapshotNodeID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearSnapshotNodeID()
+	})
+}
+
+// SetReplicatedRegions sets the "replicated_regions" field.
+func (u *EnvBuildUpsertBulk) SetReplicatedRegions(v []string) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetReplicatedRegions(v)
+	})
+}
+
+// UpdateReplicatedRegions sets the "replicated_regions" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateReplicatedRegions() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateReplicatedRegions()
+	})
+}
+
+// ClearReplicatedRegions clears the value of the "replicated_regions" field.
+func (u *EnvBuildUpsertBulk) ClearReplicatedRegions() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearReplicatedRegions()
+	})
+}
+
+// SetSourceBuildID sets the "source_build_id" field.
+func (u *EnvBuildUpsertBulk) SetSourceBuildID(v uuid.UUID) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetSourceBuildID(v)
+	})
+}
+
+// UpdateSourceBuildID sets the "source_build_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateSourceBuildID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateSourceBuildID()
+	})
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (u *EnvBuildUpsertBulk) ClearSourceBuildID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearSourceBuildID()
+	})
+}
+
+// SetVariant sets the "variant" field.
+func (u *EnvBuildUpsertBulk) SetVariant(v string) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetVariant(v)
+	})
+}
+
+// UpdateVariant sets the "variant" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateVariant() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateVariant()
+	})
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (u *EnvBuildUpsertBulk) ClearVariant() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearVariant()
+	})
+}
+
+// SetVariantOf sets the "variant_of" field.
+func (u *EnvBuildUpsertBulk) SetVariantOf(v uuid.UUID) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetVariantOf(v)
+	})
+}
+
+// UpdateVariantOf sets the "variant_of" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateVariantOf() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateVariantOf()
+	})
+}
+
+// ClearVariantOf clears the value of the "variant_of" field.
+func (u *EnvBuildUpsertBulk) ClearVariantOf() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearVariantOf()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvBuildUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
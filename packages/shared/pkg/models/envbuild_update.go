@@ -10,11 +10,14 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+	"github.com/google/uuid"
 )
 
 // EnvBuildUpdate is the builder for updating EnvBuild entities.
@@ -65,6 +68,26 @@ func (ebu *EnvBuildUpdate) ClearFinishedAt() *EnvBuildUpdate {
 	return ebu
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (ebu *EnvBuildUpdate) SetHeartbeatAt(t time.Time) *EnvBuildUpdate {
+	ebu.mutation.SetHeartbeatAt(t)
+	return ebu
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableHeartbeatAt(t *time.Time) *EnvBuildUpdate {
+	if t != nil {
+		ebu.SetHeartbeatAt(*t)
+	}
+	return ebu
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (ebu *EnvBuildUpdate) ClearHeartbeatAt() *EnvBuildUpdate {
+	ebu.mutation.ClearHeartbeatAt()
+	return ebu
+}
+
 // SetEnvID sets the "env_id" field.
 func (ebu *EnvBuildUpdate) SetEnvID(s string) *EnvBuildUpdate {
 	ebu.mutation.SetEnvID(s)
@@ -139,6 +162,54 @@ func (ebu *EnvBuildUpdate) ClearStartCmd() *EnvBuildUpdate {
 	return ebu
 }
 
+// SetReadinessProbe sets the "readiness_probe" field.
+func (ebu *EnvBuildUpdate) SetReadinessProbe(sp *schema.ReadinessProbe) *EnvBuildUpdate {
+	ebu.mutation.SetReadinessProbe(sp)
+	return ebu
+}
+
+// ClearReadinessProbe clears the value of the "readiness_probe" field.
+func (ebu *EnvBuildUpdate) ClearReadinessProbe() *EnvBuildUpdate {
+	ebu.mutation.ClearReadinessProbe()
+	return ebu
+}
+
+// SetHooks sets the "hooks" field.
+func (ebu *EnvBuildUpdate) SetHooks(sh *schema.LifecycleHooks) *EnvBuildUpdate {
+	ebu.mutation.SetHooks(sh)
+	return ebu
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (ebu *EnvBuildUpdate) ClearHooks() *EnvBuildUpdate {
+	ebu.mutation.ClearHooks()
+	return ebu
+}
+
+// SetHardening sets the "hardening" field.
+func (ebu *EnvBuildUpdate) SetHardening(sp *schema.HardeningPolicy) *EnvBuildUpdate {
+	ebu.mutation.SetHardening(sp)
+	return ebu
+}
+
+// ClearHardening clears the value of the "hardening" field.
+func (ebu *EnvBuildUpdate) ClearHardening() *EnvBuildUpdate {
+	ebu.mutation.ClearHardening()
+	return ebu
+}
+
+// SetEgress sets the "egress" field.
+func (ebu *EnvBuildUpdate) SetEgress(sp *schema.EgressPolicy) *EnvBuildUpdate {
+	ebu.mutation.SetEgress(sp)
+	return ebu
+}
+
+// ClearEgress clears the value of the "egress" field.
+func (ebu *EnvBuildUpdate) ClearEgress() *EnvBuildUpdate {
+	ebu.mutation.ClearEgress()
+	return ebu
+}
+
 // SetVcpu sets the "vcpu" field.
 func (ebu *EnvBuildUpdate) SetVcpu(i int64) *EnvBuildUpdate {
 	ebu.mutation.ResetVcpu()
@@ -243,6 +314,69 @@ func (ebu *EnvBuildUpdate) SetNillableKernelVersion(s *string) *EnvBuildUpdate {
 	return ebu
 }
 
+// SetKernelArgs sets the "kernel_args" field.
+func (ebu *EnvBuildUpdate) SetKernelArgs(s []string) *EnvBuildUpdate {
+	ebu.mutation.SetKernelArgs(s)
+	return ebu
+}
+
+// AppendKernelArgs appends s to the "kernel_args" field.
+func (ebu *EnvBuildUpdate) AppendKernelArgs(s []string) *EnvBuildUpdate {
+	ebu.mutation.AppendKernelArgs(s)
+	return ebu
+}
+
+// ClearKernelArgs clears the value of the "kernel_args" field.
+func (ebu *EnvBuildUpdate) ClearKernelArgs() *EnvBuildUpdate {
+	ebu.mutation.ClearKernelArgs()
+	return ebu
+}
+
+// SetRootfsBlockSize sets the "rootfs_block_size" field.
+func (ebu *EnvBuildUpdate) SetRootfsBlockSize(i int64) *EnvBuildUpdate {
+	ebu.mutation.ResetRootfsBlockSize()
+	ebu.mutation.SetRootfsBlockSize(i)
+	return ebu
+}
+
+// SetNillableRootfsBlockSize sets the "rootfs_block_size" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableRootfsBlockSize(i *int64) *EnvBuildUpdate {
+	if i != nil {
+		ebu.SetRootfsBlockSize(*i)
+	}
+	return ebu
+}
+
+// AddRootfsBlockSize adds i to the "rootfs_block_size" field.
+func (ebu *EnvBuildUpdate) AddRootfsBlockSize(i int64) *EnvBuildUpdate {
+	ebu.mutation.AddRootfsBlockSize(i)
+	return ebu
+}
+
+// ClearRootfsBlockSize clears the value of the "rootfs_block_size" field.
+func (ebu *EnvBuildUpdate) ClearRootfsBlockSize() *EnvBuildUpdate {
+	ebu.mutation.ClearRootfsBlockSize()
+	return ebu
+}
+
+// SetSecrets sets the "secrets" field.
+func (ebu *EnvBuildUpdate) SetSecrets(s []string) *EnvBuildUpdate {
+	ebu.mutation.SetSecrets(s)
+	return ebu
+}
+
+// AppendSecrets appends s to the "secrets" field.
+func (ebu *EnvBuildUpdate) AppendSecrets(s []string) *EnvBuildUpdate {
+	ebu.mutation.AppendSecrets(s)
+	return ebu
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (ebu *EnvBuildUpdate) ClearSecrets() *EnvBuildUpdate {
+	ebu.mutation.ClearSecrets()
+	return ebu
+}
+
 // SetFirecrackerVersion sets the "firecracker_version" field.
 func (ebu *EnvBuildUpdate) SetFirecrackerVersion(s string) *EnvBuildUpdate {
 	ebu.mutation.SetFirecrackerVersion(s)
@@ -277,6 +411,124 @@ func (ebu *EnvBuildUpdate) ClearEnvdVersion() *EnvBuildUpdate {
 	return ebu
 }
 
+// SetUploadStatus sets the "upload_status" field.
+func (ebu *EnvBuildUpdate) SetUploadStatus(es envbuild.UploadStatus) *EnvBuildUpdate {
+	ebu.mutation.SetUploadStatus(es)
+	return ebu
+}
+
+// SetNillableUploadStatus sets the "upload_status" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableUploadStatus(es *envbuild.UploadStatus) *EnvBuildUpdate {
+	if es != nil {
+		ebu.SetUploadStatus(*es)
+	}
+	return ebu
+}
+
+// ClearUploadStatus clears the value of the "upload_status" field.
+func (ebu *EnvBuildUpdate) ClearUploadStatus() *EnvBuildUpdate {
+	ebu.mutation.ClearUploadStatus()
+	return ebu
+}
+
+// SetSnapshotNodeID sets the "snapshot_node_id" field.
+func (ebu *EnvBuildUpdate) SetSnapshotNodeID(s string) *EnvBuildUpdate {
+	ebu.mutation.SetSnapshotNodeID(s)
+	return ebu
+}
+
+// SetNillableSnapshotNodeID sets the "snapshot_node_id" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableSnapshotNodeID(s *string) *EnvBuildUpdate {
+	if s != nil {
+		ebu.SetSnapshotNodeID(*s)
+	}
+	return ebu
+}
+
+// ClearSnapshotNodeID clears the value of the "snapshot_node_id" field.
+func (ebu *EnvBuildUpdate) ClearSnapshotNodeID() *EnvBuildUpdate {
+	ebu.mutation.ClearSnapshotNodeID()
+	return ebu
+}
+
+// SetReplicatedRegions sets the "replicated_regions" field.
+func (ebu *EnvBuildUpdate) SetReplicatedRegions(s []string) *EnvBuildUpdate {
+	ebu.mutation.SetReplicatedRegions(s)
+	return ebu
+}
+
+// AppendReplicatedRegions appends s to the "replicated_regions" field.
+func (ebu *EnvBuildUpdate) AppendReplicatedRegions(s []string) *EnvBuildUpdate {
+	ebu.mutation.AppendReplicatedRegions(s)
+	return ebu
+}
+
+// ClearReplicatedRegions clears the value of the "replicated_regions" field.
+func (ebu *EnvBuildUpdate) ClearReplicatedRegions() *EnvBuildUpdate {
+	ebu.mutation.ClearReplicatedRegions()
+	return ebu
+}
+
+// SetSourceBuildID sets the "source_build_id" field.
+func (ebu *EnvBuildUpdate) SetSourceBuildID(u uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.SetSourceBuildID(u)
+	return ebu
+}
+
+// SetNillableSourceBuildID sets the "source_build_id" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableSourceBuildID(u *uuid.UUID) *EnvBuildUpdate {
+	if u != nil {
+		ebu.SetSourceBuildID(*u)
+	}
+	return ebu
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (ebu *EnvBuildUpdate) ClearSourceBuildID() *EnvBuildUpdate {
+	ebu.mutation.ClearSourceBuildID()
+	return ebu
+}
+
+// SetVariant sets the "variant" field.
+func (ebu *EnvBuildUpdate) SetVariant(s string) *EnvBuildUpdate {
+	ebu.mutation.SetVariant(s)
+	return ebu
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableVariant(s *string) *EnvBuildUpdate {
+	if s != nil {
+		ebu.SetVariant(*s)
+	}
+	return ebu
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (ebu *EnvBuildUpdate) ClearVariant() *EnvBuildUpdate {
+	ebu.mutation.ClearVariant()
+	return ebu
+}
+
+// SetVariantOf sets the "variant_of" field.
+func (ebu *EnvBuildUpdate) SetVariantOf(u uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.SetVariantOf(u)
+	return ebu
+}
+
+// SetNillableVariantOf sets the "variant_of" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableVariantOf(u *uuid.UUID) *EnvBuildUpdate {
+	if u != nil {
+		ebu.SetVariantOf(*u)
+	}
+	return ebu
+}
+
+// ClearVariantOf clears the value of the "variant_of" field.
+func (ebu *EnvBuildUpdate) ClearVariantOf() *EnvBuildUpdate {
+	ebu.mutation.ClearVariantOf()
+	return ebu
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (ebu *EnvBuildUpdate) SetEnv(e *Env) *EnvBuildUpdate {
 	return ebu.SetEnvID(e.ID)
@@ -327,6 +579,11 @@ func (ebu *EnvBuildUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`models: validator failed for field "EnvBuild.status": %w`, err)}
 		}
 	}
+	if v, ok := ebu.mutation.UploadStatus(); ok {
+		if err := envbuild.UploadStatusValidator(v); err != nil {
+			return &ValidationError{Name: "upload_status", err: fmt.Errorf(`models: validator failed for field "EnvBuild.upload_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -357,6 +614,12 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ebu.mutation.FinishedAtCleared() {
 		_spec.ClearField(envbuild.FieldFinishedAt, field.TypeTime)
 	}
+	if value, ok := ebu.mutation.HeartbeatAt(); ok {
+		_spec.SetField(envbuild.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if ebu.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(envbuild.FieldHeartbeatAt, field.TypeTime)
+	}
 	if value, ok := ebu.mutation.Status(); ok {
 		_spec.SetField(envbuild.FieldStatus, field.TypeEnum, value)
 	}
@@ -372,6 +635,30 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ebu.mutation.StartCmdCleared() {
 		_spec.ClearField(envbuild.FieldStartCmd, field.TypeString)
 	}
+	if value, ok := ebu.mutation.ReadinessProbe(); ok {
+		_spec.SetField(envbuild.FieldReadinessProbe, field.TypeJSON, value)
+	}
+	if ebu.mutation.ReadinessProbeCleared() {
+		_spec.ClearField(envbuild.FieldReadinessProbe, field.TypeJSON)
+	}
+	if value, ok := ebu.mutation.Hooks(); ok {
+		_spec.SetField(envbuild.FieldHooks, field.TypeJSON, value)
+	}
+	if ebu.mutation.HooksCleared() {
+		_spec.ClearField(envbuild.FieldHooks, field.TypeJSON)
+	}
+	if value, ok := ebu.mutation.Hardening(); ok {
+		_spec.SetField(envbuild.FieldHardening, field.TypeJSON, value)
+	}
+	if ebu.mutation.HardeningCleared() {
+		_spec.ClearField(envbuild.FieldHardening, field.TypeJSON)
+	}
+	if value, ok := ebu.mutation.Egress(); ok {
+		_spec.SetField(envbuild.FieldEgress, field.TypeJSON, value)
+	}
+	if ebu.mutation.EgressCleared() {
+		_spec.ClearField(envbuild.FieldEgress, field.TypeJSON)
+	}
 	if value, ok := ebu.mutation.Vcpu(); ok {
 		_spec.SetField(envbuild.FieldVcpu, field.TypeInt64, value)
 	}
@@ -402,6 +689,37 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ebu.mutation.KernelVersion(); ok {
 		_spec.SetField(envbuild.FieldKernelVersion, field.TypeString, value)
 	}
+	if value, ok := ebu.mutation.KernelArgs(); ok {
+		_spec.SetField(envbuild.FieldKernelArgs, field.TypeJSON, value)
+	}
+	if value, ok := ebu.mutation.AppendedKernelArgs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, envbuild.FieldKernelArgs, value)
+		})
+	}
+	if ebu.mutation.KernelArgsCleared() {
+		_spec.ClearField(envbuild.FieldKernelArgs, field.TypeJSON)
+	}
+	if value, ok := ebu.mutation.RootfsBlockSize(); ok {
+		_spec.SetField(envbuild.FieldRootfsBlockSize, field.TypeInt64, value)
+	}
+	if value, ok := ebu.mutation.AddedRootfsBlockSize(); ok {
+		_spec.AddField(envbuild.FieldRootfsBlockSize, field.TypeInt64, value)
+	}
+	if ebu.mutation.RootfsBlockSizeCleared() {
+		_spec.ClearField(envbuild.FieldRootfsBlockSize, field.TypeInt64)
+	}
+	if value, ok := ebu.mutation.Secrets(); ok {
+		_spec.SetField(envbuild.FieldSecrets, field.TypeJSON, value)
+	}
+	if value, ok := ebu.mutation.AppendedSecrets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, envbuild.FieldSecrets, value)
+		})
+	}
+	if ebu.mutation.SecretsCleared() {
+		_spec.ClearField(envbuild.FieldSecrets, field.TypeJSON)
+	}
 	if value, ok := ebu.mutation.FirecrackerVersion(); ok {
 		_spec.SetField(envbuild.FieldFirecrackerVersion, field.TypeString, value)
 	}
@@ -411,6 +729,47 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ebu.mutation.EnvdVersionCleared() {
 		_spec.ClearField(envbuild.FieldEnvdVersion, field.TypeString)
 	}
+	if value, ok := ebu.mutation.UploadStatus(); ok {
+		_spec.SetField(envbuild.FieldUploadStatus, field.TypeEnum, value)
+	}
+	if ebu.mutation.UploadStatusCleared() {
+		_spec.ClearField(envbuild.FieldUploadStatus, field.TypeEnum)
+	}
+	if value, ok := ebu.mutation.SnapshotNodeID(); ok {
+		_spec.SetField(envbuild.FieldSnapshotNodeID, field.TypeString, value)
+	}
+	if ebu.mutation.SnapshotNodeIDCleared() {
+		_spec.ClearField(envbuild.FieldSnapshotNodeID, field.TypeString)
+	}
+	if value, ok := ebu.mutation.ReplicatedRegions(); ok {
+		_spec.SetField(envbuild.FieldReplicatedRegions, field.TypeJSON, value)
+	}
+	if value, ok := ebu.mutation.AppendedReplicatedRegions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, envbuild.FieldReplicatedRegions, value)
+		})
+	}
+	if ebu.mutation.ReplicatedRegionsCleared() {
+		_spec.ClearField(envbuild.FieldReplicatedRegions, field.TypeJSON)
+	}
+	if value, ok := ebu.mutation.SourceBuildID(); ok {
+		_spec.SetField(envbuild.FieldSourceBuildID, field.TypeUUID, value)
+	}
+	if ebu.mutation.SourceBuildIDCleared() {
+		_spec.ClearField(envbuild.FieldSourceBuildID, field.TypeUUID)
+	}
+	if value, ok := ebu.mutation.Variant(); ok {
+		_spec.SetField(envbuild.FieldVariant, field.TypeString, value)
+	}
+	if ebu.mutation.VariantCleared() {
+		_spec.ClearField(envbuild.FieldVariant, field.TypeString)
+	}
+	if value, ok := ebu.mutation.VariantOf(); ok {
+		_spec.SetField(envbuild.FieldVariantOf, field.TypeUUID, value)
+	}
+	if ebu.mutation.VariantOfCleared() {
+		_spec.ClearField(envbuild.FieldVariantOf, field.TypeUUID)
+	}
 	if ebu.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -500,6 +859,26 @@ func (ebuo *EnvBuildUpdateOne) ClearFinishedAt() *EnvBuildUpdateOne {
 	return ebuo
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (ebuo *EnvBuildUpdateOne) SetHeartbeatAt(t time.Time) *EnvBuildUpdateOne {
+	ebuo.mutation.SetHeartbeatAt(t)
+	return ebuo
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableHeartbeatAt(t *time.Time) *EnvBuildUpdateOne {
+	if t != nil {
+		ebuo.SetHeartbeatAt(*t)
+	}
+	return ebuo
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (ebuo *EnvBuildUpdateOne) ClearHeartbeatAt() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearHeartbeatAt()
+	return ebuo
+}
+
 // SetEnvID sets the "env_id" field.
 func (ebuo *EnvBuildUpdateOne) SetEnvID(s string) *EnvBuildUpdateOne {
 	ebuo.mutation.SetEnvID(s)
@@ -574,6 +953,54 @@ func (ebuo *EnvBuildUpdateOne) ClearStartCmd() *EnvBuildUpdateOne {
 	return ebuo
 }
 
+// SetReadinessProbe sets the "readiness_probe" field.
+func (ebuo *EnvBuildUpdateOne) SetReadinessProbe(sp *schema.ReadinessProbe) *EnvBuildUpdateOne {
+	ebuo.mutation.SetReadinessProbe(sp)
+	return ebuo
+}
+
+// ClearReadinessProbe clears the value of the "readiness_probe" field.
+func (ebuo *EnvBuildUpdateOne) ClearReadinessProbe() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearReadinessProbe()
+	return ebuo
+}
+
+// SetHooks sets the "hooks" field.
+func (ebuo *EnvBuildUpdateOne) SetHooks(sh *schema.LifecycleHooks) *EnvBuildUpdateOne {
+	ebuo.mutation.SetHooks(sh)
+	return ebuo
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (ebuo *EnvBuildUpdateOne) ClearHooks() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearHooks()
+	return ebuo
+}
+
+// SetHardening sets the "hardening" field.
+func (ebuo *EnvBuildUpdateOne) SetHardening(sp *schema.HardeningPolicy) *EnvBuildUpdateOne {
+	ebuo.mutation.SetHardening(sp)
+	return ebuo
+}
+
+// ClearHardening clears the value of the "hardening" field.
+func (ebuo *EnvBuildUpdateOne) ClearHardening() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearHardening()
+	return ebuo
+}
+
+// SetEgress sets the "egress" field.
+func (ebuo *EnvBuildUpdateOne) SetEgress(sp *schema.EgressPolicy) *EnvBuildUpdateOne {
+	ebuo.mutation.SetEgress(sp)
+	return ebuo
+}
+
+// ClearEgress clears the value of the "egress" field.
+func (ebuo *EnvBuildUpdateOne) ClearEgress() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearEgress()
+	return ebuo
+}
+
 // SetVcpu sets the "vcpu" field.
 func (ebuo *EnvBuildUpdateOne) SetVcpu(i int64) *EnvBuildUpdateOne {
 	ebuo.mutation.ResetVcpu()
@@ -678,6 +1105,69 @@ func (ebuo *EnvBuildUpdateOne) SetNillableKernelVersion(s *string) *EnvBuildUpda
 	return ebuo
 }
 
+// SetKernelArgs sets the "kernel_args" field.
+func (ebuo *EnvBuildUpdateOne) SetKernelArgs(s []string) *EnvBuildUpdateOne {
+	ebuo.mutation.SetKernelArgs(s)
+	return ebuo
+}
+
+// AppendKernelArgs appends s to the "kernel_args" field.
+func (ebuo *EnvBuildUpdateOne) AppendKernelArgs(s []string) *EnvBuildUpdateOne {
+	ebuo.mutation.AppendKernelArgs(s)
+	return ebuo
+}
+
+// ClearKernelArgs clears the value of the "kernel_args" field.
+func (ebuo *EnvBuildUpdateOne) ClearKernelArgs() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearKernelArgs()
+	return ebuo
+}
+
+// SetRootfsBlockSize sets the "rootfs_block_size" field.
+func (ebuo *EnvBuildUpdateOne) SetRootfsBlockSize(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.ResetRootfsBlockSize()
+	ebuo.mutation.SetRootfsBlockSize(i)
+	return ebuo
+}
+
+// SetNillableRootfsBlockSize sets the "rootfs_block_size" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableRootfsBlockSize(i *int64) *EnvBuildUpdateOne {
+	if i != nil {
+		ebuo.SetRootfsBlockSize(*i)
+	}
+	return ebuo
+}
+
+// AddRootfsBlockSize adds i to the "rootfs_block_size" field.
+func (ebuo *EnvBuildUpdateOne) AddRootfsBlockSize(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.AddRootfsBlockSize(i)
+	return ebuo
+}
+
+// ClearRootfsBlockSize clears the value of the "rootfs_block_size" field.
+func (ebuo *EnvBuildUpdateOne) ClearRootfsBlockSize() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearRootfsBlockSize()
+	return ebuo
+}
+
+// SetSecrets sets the "secrets" field.
+func (ebuo *EnvBuildUpdateOne) SetSecrets(s []string) *EnvBuildUpdateOne {
+	ebuo.mutation.SetSecrets(s)
+	return ebuo
+}
+
+// AppendSecrets appends s to the "secrets" field.
+func (ebuo *EnvBuildUpdateOne) AppendSecrets(s []string) *EnvBuildUpdateOne {
+	ebuo.mutation.AppendSecrets(s)
+	return ebuo
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (ebuo *EnvBuildUpdateOne) ClearSecrets() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearSecrets()
+	return ebuo
+}
+
 // SetFirecrackerVersion sets the "firecracker_version" field.
 func (ebuo *EnvBuildUpdateOne) SetFirecrackerVersion(s string) *EnvBuildUpdateOne {
 	ebuo.mutation.SetFirecrackerVersion(s)
@@ -712,6 +1202,124 @@ func (ebuo *EnvBuildUpdateOne) ClearEnvdVersion() *EnvBuildUpdateOne {
 	return ebuo
 }
 
+// SetUploadStatus sets the "upload_status" field.
+func (ebuo *EnvBuildUpdateOne) SetUploadStatus(es envbuild.UploadStatus) *EnvBuildUpdateOne {
+	ebuo.mutation.SetUploadStatus(es)
+	return ebuo
+}
+
+// SetNillableUploadStatus sets the "upload_status" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableUploadStatus(es *envbuild.UploadStatus) *EnvBuildUpdateOne {
+	if es != nil {
+		ebuo.SetUploadStatus(*es)
+	}
+	return ebuo
+}
+
+// ClearUploadStatus clears the value of the "upload_status" field.
+func (ebuo *EnvBuildUpdateOne) ClearUploadStatus() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearUploadStatus()
+	return ebuo
+}
+
+// SetSnapshotNodeID sets the "snapshot_node_id" field.
+func (ebuo *EnvBuildUpdateOne) SetSnapshotNodeID(s string) *EnvBuildUpdateOne {
+	ebuo.mutation.SetSnapshotNodeID(s)
+	return ebuo
+}
+
+// SetNillableSnapshotNodeID sets the "snapshot_node_id" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableSnapshotNodeID(s *string) *EnvBuildUpdateOne {
+	if s != nil {
+		ebuo.SetSnapshotNodeID(*s)
+	}
+	return ebuo
+}
+
+// ClearSnapshotNodeID clears the value of the "snapshot_node_id" field.
+func (ebuo *EnvBuildUpdateOne) ClearSnapshotNodeID() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearSnapshotNodeID()
+	return ebuo
+}
+
+// SetReplicatedRegions sets the "replicated_regions" field.
+func (ebuo *EnvBuildUpdateOne) SetReplicatedRegions(s []string) *EnvBuildUpdateOne {
+	ebuo.mutation.SetReplicatedRegions(s)
+	return ebuo
+}
+
+// AppendReplicatedRegions appends s to the "replicated_regions" field.
+func (ebuo *EnvBuildUpdateOne) AppendReplicatedRegions(s []string) *EnvBuildUpdateOne {
+	ebuo.mutation.AppendReplicatedRegions(s)
+	return ebuo
+}
+
+// ClearReplicatedRegions clears the value of the "replicated_regions" field.
+func (ebuo *EnvBuildUpdateOne) ClearReplicatedRegions() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearReplicatedRegions()
+	return ebuo
+}
+
+// SetSourceBuildID sets the "source_build_id" field.
+func (ebuo *EnvBuildUpdateOne) SetSourceBuildID(u uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.SetSourceBuildID(u)
+	return ebuo
+}
+
+// SetNillableSourceBuildID sets the "source_build_id" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableSourceBuildID(u *uuid.UUID) *EnvBuildUpdateOne {
+	if u != nil {
+		ebuo.SetSourceBuildID(*u)
+	}
+	return ebuo
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (ebuo *EnvBuildUpdateOne) ClearSourceBuildID() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearSourceBuildID()
+	return ebuo
+}
+
+// SetVariant sets the "variant" field.
+func (ebuo *EnvBuildUpdateOne) SetVariant(s string) *EnvBuildUpdateOne {
+	ebuo.mutation.SetVariant(s)
+	return ebuo
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableVariant(s *string) *EnvBuildUpdateOne {
+	if s != nil {
+		ebuo.SetVariant(*s)
+	}
+	return ebuo
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (ebuo *EnvBuildUpdateOne) ClearVariant() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearVariant()
+	return ebuo
+}
+
+// SetVariantOf sets the "variant_of" field.
+func (ebuo *EnvBuildUpdateOne) SetVariantOf(u uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.SetVariantOf(u)
+	return ebuo
+}
+
+// SetNillableVariantOf sets the "variant_of" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableVariantOf(u *uuid.UUID) *EnvBuildUpdateOne {
+	if u != nil {
+		ebuo.SetVariantOf(*u)
+	}
+	return ebuo
+}
+
+// ClearVariantOf clears the value of the "variant_of" field.
+func (ebuo *EnvBuildUpdateOne) ClearVariantOf() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearVariantOf()
+	return ebuo
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (ebuo *EnvBuildUpdateOne) SetEnv(e *Env) *EnvBuildUpdateOne {
 	return ebuo.SetEnvID(e.ID)
@@ -775,6 +1383,11 @@ func (ebuo *EnvBuildUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`models: validator failed for field "EnvBuild.status": %w`, err)}
 		}
 	}
+	if v, ok := ebuo.mutation.UploadStatus(); ok {
+		if err := envbuild.UploadStatusValidator(v); err != nil {
+			return &ValidationError{Name: "upload_status", err: fmt.Errorf(`models: validator failed for field "EnvBuild.upload_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -822,6 +1435,12 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 	if ebuo.mutation.FinishedAtCleared() {
 		_spec.ClearField(envbuild.FieldFinishedAt, field.TypeTime)
 	}
+	if value, ok := ebuo.mutation.HeartbeatAt(); ok {
+		_spec.SetField(envbuild.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if ebuo.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(envbuild.FieldHeartbeatAt, field.TypeTime)
+	}
 	if value, ok := ebuo.mutation.Status(); ok {
 		_spec.SetField(envbuild.FieldStatus, field.TypeEnum, value)
 	}
@@ -837,6 +1456,30 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 	if ebuo.mutation.StartCmdCleared() {
 		_spec.ClearField(envbuild.FieldStartCmd, field.TypeString)
 	}
+	if value, ok := ebuo.mutation.ReadinessProbe(); ok {
+		_spec.SetField(envbuild.FieldReadinessProbe, field.TypeJSON, value)
+	}
+	if ebuo.mutation.ReadinessProbeCleared() {
+		_spec.ClearField(envbuild.FieldReadinessProbe, field.TypeJSON)
+	}
+	if value, ok := ebuo.mutation.Hooks(); ok {
+		_spec.SetField(envbuild.FieldHooks, field.TypeJSON, value)
+	}
+	if ebuo.mutation.HooksCleared() {
+		_spec.ClearField(envbuild.FieldHooks, field.TypeJSON)
+	}
+	if value, ok := ebuo.mutation.Hardening(); ok {
+		_spec.SetField(envbuild.FieldHardening, field.TypeJSON, value)
+	}
+	if ebuo.mutation.HardeningCleared() {
+		_spec.ClearField(envbuild.FieldHardening, field.TypeJSON)
+	}
+	if value, ok := ebuo.mutation.Egress(); ok {
+		_spec.SetField(envbuild.FieldEgress, field.TypeJSON, value)
+	}
+	if ebuo.mutation.EgressCleared() {
+		_spec.ClearField(envbuild.FieldEgress, field.TypeJSON)
+	}
 	if value, ok := ebuo.mutation.Vcpu(); ok {
 		_spec.SetField(envbuild.FieldVcpu, field.TypeInt64, value)
 	}
@@ -867,6 +1510,37 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 	if value, ok := ebuo.mutation.KernelVersion(); ok {
 		_spec.SetField(envbuild.FieldKernelVersion, field.TypeString, value)
 	}
+	if value, ok := ebuo.mutation.KernelArgs(); ok {
+		_spec.SetField(envbuild.FieldKernelArgs, field.TypeJSON, value)
+	}
+	if value, ok := ebuo.mutation.AppendedKernelArgs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, envbuild.FieldKernelArgs, value)
+		})
+	}
+	if ebuo.mutation.KernelArgsCleared() {
+		_spec.ClearField(envbuild.FieldKernelArgs, field.TypeJSON)
+	}
+	if value, ok := ebuo.mutation.RootfsBlockSize(); ok {
+		_spec.SetField(envbuild.FieldRootfsBlockSize, field.TypeInt64, value)
+	}
+	if value, ok := ebuo.mutation.AddedRootfsBlockSize(); ok {
+		_spec.AddField(envbuild.FieldRootfsBlockSize, field.TypeInt64, value)
+	}
+	if ebuo.mutation.RootfsBlockSizeCleared() {
+		_spec.ClearField(envbuild.FieldRootfsBlockSize, field.TypeInt64)
+	}
+	if value, ok := ebuo.mutation.Secrets(); ok {
+		_spec.SetField(envbuild.FieldSecrets, field.TypeJSON, value)
+	}
+	if value, ok := ebuo.mutation.AppendedSecrets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, envbuild.FieldSecrets, value)
+		})
+	}
+	if ebuo.mutation.SecretsCleared() {
+		_spec.ClearField(envbuild.FieldSecrets, field.TypeJSON)
+	}
 	if value, ok := ebuo.mutation.FirecrackerVersion(); ok {
 		_spec.SetField(envbuild.FieldFirecrackerVersion, field.TypeString, value)
 	}
@@ -876,6 +1550,47 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 	if ebuo.mutation.EnvdVersionCleared() {
 		_spec.ClearField(envbuild.FieldEnvdVersion, field.TypeString)
 	}
+	if value, ok := ebuo.mutation.UploadStatus(); ok {
+		_spec.SetField(envbuild.FieldUploadStatus, field.TypeEnum, value)
+	}
+	if ebuo.mutation.UploadStatusCleared() {
+		_spec.ClearField(envbuild.FieldUploadStatus, field.TypeEnum)
+	}
+	if value, ok := ebuo.mutation.SnapshotNodeID(); ok {
+		_spec.SetField(envbuild.FieldSnapshotNodeID, field.TypeString, value)
+	}
+	if ebuo.mutation.SnapshotNodeIDCleared() {
+		_spec.ClearField(envbuild.FieldSnapshotNodeID, field.TypeString)
+	}
+	if value, ok := ebuo.mutation.ReplicatedRegions(); ok {
+		_spec.SetField(envbuild.FieldReplicatedRegions, field.TypeJSON, value)
+	}
+	if value, ok := ebuo.mutation.AppendedReplicatedRegions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, envbuild.FieldReplicatedRegions, value)
+		})
+	}
+	if ebuo.mutation.ReplicatedRegionsCleared() {
+		_spec.ClearField(envbuild.FieldReplicatedRegions, field.TypeJSON)
+	}
+	if value, ok := ebuo.mutation.SourceBuildID(); ok {
+		_spec.SetField(envbuild.FieldSourceBuildID, field.TypeUUID, value)
+	}
+	if ebuo.mutation.SourceBuildIDCleared() {
+		_spec.ClearField(envbuild.FieldSourceBuildID, field.TypeUUID)
+	}
+	if value, ok := ebuo.mutation.Variant(); ok {
+		_spec.SetField(envbuild.FieldVariant, field.TypeString, value)
+	}
+	if ebuo.mutation.VariantCleared() {
+		_spec.ClearField(envbuild.FieldVariant, field.TypeString)
+	}
+	if value, ok := ebuo.mutation.VariantOf(); ok {
+		_spec.SetField(envbuild.FieldVariantOf, field.TypeUUID, value)
+	}
+	if ebuo.mutation.VariantOfCleared() {
+		_spec.ClearField(envbuild.FieldVariantOf, field.TypeUUID)
+	}
 	if ebuo.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
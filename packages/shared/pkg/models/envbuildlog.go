@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/google/uuid"
+)
+
+// EnvBuildLog is the model entity for the EnvBuildLog schema.
+type EnvBuildLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// EnvID holds the value of the "env_id" field.
+	EnvID string `json:"env_id,omitempty"`
+	// BuildID holds the value of the "build_id" field.
+	BuildID uuid.UUID `json:"build_id,omitempty"`
+	// Line holds the value of the "line" field.
+	Line int32 `json:"line,omitempty"`
+	// Message holds the value of the "message" field.
+	Message      string `json:"message,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnvBuildLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case envbuildlog.FieldID, envbuildlog.FieldLine:
+			values[i] = new(sql.NullInt64)
+		case envbuildlog.FieldEnvID, envbuildlog.FieldMessage:
+			values[i] = new(sql.NullString)
+		case envbuildlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case envbuildlog.FieldTeamID, envbuildlog.FieldBuildID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnvBuildLog fields.
+func (ebl *EnvBuildLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case envbuildlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ebl.ID = int(value.Int64)
+		case envbuildlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ebl.CreatedAt = value.Time
+			}
+		case envbuildlog.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				ebl.TeamID = *value
+			}
+		case envbuildlog.FieldEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				ebl.EnvID = value.String
+			}
+		case envbuildlog.FieldBuildID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field build_id", values[i])
+			} else if value != nil {
+				ebl.BuildID = *value
+			}
+		case envbuildlog.FieldLine:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field line", values[i])
+			} else if value.Valid {
+				ebl.Line = int32(value.Int64)
+			}
+		case envbuildlog.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				ebl.Message = value.String
+			}
+		default:
+			ebl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnvBuildLog.
+// This includes values selected through modifiers, order, etc.
+func (ebl *EnvBuildLog) Value(name string) (ent.Value, error) {
+	return ebl.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EnvBuildLog.
+// Note that you need to call EnvBuildLog.Unwrap() before calling this method if this EnvBuildLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ebl *EnvBuildLog) Update() *EnvBuildLogUpdateOne {
+	return NewEnvBuildLogClient(ebl.config).UpdateOne(ebl)
+}
+
+// Unwrap unwraps the EnvBuildLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ebl *EnvBuildLog) Unwrap() *EnvBuildLog {
+	_tx, ok := ebl.config.driver.(*txDriver)
+	if !ok {
+		panic("models: EnvBuildLog is not a transactional entity")
+	}
+	ebl.config.driver = _tx.drv
+	return ebl
+}
+
+// String implements the fmt.Stringer.
+func (ebl *EnvBuildLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnvBuildLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ebl.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ebl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", ebl.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(ebl.EnvID)
+	builder.WriteString(", ")
+	builder.WriteString("build_id=")
+	builder.WriteString(fmt.Sprintf("%v", ebl.BuildID))
+	builder.WriteString(", ")
+	builder.WriteString("line=")
+	builder.WriteString(fmt.Sprintf("%v", ebl.Line))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(ebl.Message)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnvBuildLogs is a parsable slice of EnvBuildLog.
+type EnvBuildLogs []*EnvBuildLog
@@ -0,0 +1,94 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envbuildlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the envbuildlog type in the database.
+	Label = "env_build_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldBuildID holds the string denoting the build_id field in the database.
+	FieldBuildID = "build_id"
+	// FieldLine holds the string denoting the line field in the database.
+	FieldLine = "line"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// Table holds the table name of the envbuildlog in the database.
+	Table = "env_build_logs"
+)
+
+// Columns holds all SQL columns for envbuildlog fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldTeamID,
+	FieldEnvID,
+	FieldBuildID,
+	FieldLine,
+	FieldMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the EnvBuildLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// ByBuildID orders the results by the build_id field.
+func ByBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildID, opts...).ToFunc()
+}
+
+// ByLine orders the results by the line field.
+func ByLine(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLine, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
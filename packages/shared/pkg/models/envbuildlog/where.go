@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envbuildlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldTeamID, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldEnvID, v))
+}
+
+// BuildID applies equality check predicate on the "build_id" field. It's identical to BuildIDEQ.
+func BuildID(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldBuildID, v))
+}
+
+// Line applies equality check predicate on the "line" field. It's identical to LineEQ.
+func Line(v int32) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldLine, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLTE(FieldTeamID, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// EnvIDGT applies the GT predicate on the "env_id" field.
+func EnvIDGT(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGT(FieldEnvID, v))
+}
+
+// EnvIDGTE applies the GTE predicate on the "env_id" field.
+func EnvIDGTE(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGTE(FieldEnvID, v))
+}
+
+// EnvIDLT applies the LT predicate on the "env_id" field.
+func EnvIDLT(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLT(FieldEnvID, v))
+}
+
+// EnvIDLTE applies the LTE predicate on the "env_id" field.
+func EnvIDLTE(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLTE(FieldEnvID, v))
+}
+
+// EnvIDContains applies the Contains predicate on the "env_id" field.
+func EnvIDContains(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldContains(FieldEnvID, v))
+}
+
+// EnvIDHasPrefix applies the HasPrefix predicate on the "env_id" field.
+func EnvIDHasPrefix(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldHasPrefix(FieldEnvID, v))
+}
+
+// EnvIDHasSuffix applies the HasSuffix predicate on the "env_id" field.
+func EnvIDHasSuffix(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldHasSuffix(FieldEnvID, v))
+}
+
+// EnvIDEqualFold applies the EqualFold predicate on the "env_id" field.
+func EnvIDEqualFold(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEqualFold(FieldEnvID, v))
+}
+
+// EnvIDContainsFold applies the ContainsFold predicate on the "env_id" field.
+func EnvIDContainsFold(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldContainsFold(FieldEnvID, v))
+}
+
+// BuildIDEQ applies the EQ predicate on the "build_id" field.
+func BuildIDEQ(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldBuildID, v))
+}
+
+// BuildIDNEQ applies the NEQ predicate on the "build_id" field.
+func BuildIDNEQ(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNEQ(FieldBuildID, v))
+}
+
+// BuildIDIn applies the In predicate on the "build_id" field.
+func BuildIDIn(vs ...uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldIn(FieldBuildID, vs...))
+}
+
+// BuildIDNotIn applies the NotIn predicate on the "build_id" field.
+func BuildIDNotIn(vs ...uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNotIn(FieldBuildID, vs...))
+}
+
+// BuildIDGT applies the GT predicate on the "build_id" field.
+func BuildIDGT(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGT(FieldBuildID, v))
+}
+
+// BuildIDGTE applies the GTE predicate on the "build_id" field.
+func BuildIDGTE(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGTE(FieldBuildID, v))
+}
+
+// BuildIDLT applies the LT predicate on the "build_id" field.
+func BuildIDLT(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLT(FieldBuildID, v))
+}
+
+// BuildIDLTE applies the LTE predicate on the "build_id" field.
+func BuildIDLTE(v uuid.UUID) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLTE(FieldBuildID, v))
+}
+
+// LineEQ applies the EQ predicate on the "line" field.
+func LineEQ(v int32) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldLine, v))
+}
+
+// LineNEQ applies the NEQ predicate on the "line" field.
+func LineNEQ(v int32) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNEQ(FieldLine, v))
+}
+
+// LineIn applies the In predicate on the "line" field.
+func LineIn(vs ...int32) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldIn(FieldLine, vs...))
+}
+
+// LineNotIn applies the NotIn predicate on the "line" field.
+func LineNotIn(vs ...int32) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNotIn(FieldLine, vs...))
+}
+
+// LineGT applies the GT predicate on the "line" field.
+func LineGT(v int32) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGT(FieldLine, v))
+}
+
+// LineGTE applies the GTE predicate on the "line" field.
+func LineGTE(v int32) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGTE(FieldLine, v))
+}
+
+// LineLT applies the LT predicate on the "line" field.
+func LineLT(v int32) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLT(FieldLine, v))
+}
+
+// LineLTE applies the LTE predicate on the "line" field.
+func LineLTE(v int32) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLTE(FieldLine, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnvBuildLog) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnvBuildLog) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnvBuildLog) predicate.EnvBuildLog {
+	return predicate.EnvBuildLog(sql.NotPredicates(p))
+}
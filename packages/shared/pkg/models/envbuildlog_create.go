@@ -0,0 +1,542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/google/uuid"
+)
+
+// EnvBuildLogCreate is the builder for creating a EnvBuildLog entity.
+type EnvBuildLogCreate struct {
+	config
+	mutation *EnvBuildLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (eblc *EnvBuildLogCreate) SetCreatedAt(t time.Time) *EnvBuildLogCreate {
+	eblc.mutation.SetCreatedAt(t)
+	return eblc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (eblc *EnvBuildLogCreate) SetNillableCreatedAt(t *time.Time) *EnvBuildLogCreate {
+	if t != nil {
+		eblc.SetCreatedAt(*t)
+	}
+	return eblc
+}
+
+// SetTeamID sets the "team_id" field.
+func (eblc *EnvBuildLogCreate) SetTeamID(u uuid.UUID) *EnvBuildLogCreate {
+	eblc.mutation.SetTeamID(u)
+	return eblc
+}
+
+// SetEnvID sets the "env_id" field.
+func (eblc *EnvBuildLogCreate) SetEnvID(s string) *EnvBuildLogCreate {
+	eblc.mutation.SetEnvID(s)
+	return eblc
+}
+
+// SetBuildID sets the "build_id" field.
+func (eblc *EnvBuildLogCreate) SetBuildID(u uuid.UUID) *EnvBuildLogCreate {
+	eblc.mutation.SetBuildID(u)
+	return eblc
+}
+
+// SetLine sets the "line" field.
+func (eblc *EnvBuildLogCreate) SetLine(i int32) *EnvBuildLogCreate {
+	eblc.mutation.SetLine(i)
+	return eblc
+}
+
+// SetMessage sets the "message" field.
+func (eblc *EnvBuildLogCreate) SetMessage(s string) *EnvBuildLogCreate {
+	eblc.mutation.SetMessage(s)
+	return eblc
+}
+
+// Mutation returns the EnvBuildLogMutation object of the builder.
+func (eblc *EnvBuildLogCreate) Mutation() *EnvBuildLogMutation {
+	return eblc.mutation
+}
+
+// Save creates the EnvBuildLog in the database.
+func (eblc *EnvBuildLogCreate) Save(ctx context.Context) (*EnvBuildLog, error) {
+	eblc.defaults()
+	return withHooks(ctx, eblc.sqlSave, eblc.mutation, eblc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (eblc *EnvBuildLogCreate) SaveX(ctx context.Context) *EnvBuildLog {
+	v, err := eblc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eblc *EnvBuildLogCreate) Exec(ctx context.Context) error {
+	_, err := eblc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eblc *EnvBuildLogCreate) ExecX(ctx context.Context) {
+	if err := eblc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eblc *EnvBuildLogCreate) defaults() {
+	if _, ok := eblc.mutation.CreatedAt(); !ok {
+		v := envbuildlog.DefaultCreatedAt()
+		eblc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eblc *EnvBuildLogCreate) check() error {
+	if _, ok := eblc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "EnvBuildLog.created_at"`)}
+	}
+	if _, ok := eblc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "EnvBuildLog.team_id"`)}
+	}
+	if _, ok := eblc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`models: missing required field "EnvBuildLog.env_id"`)}
+	}
+	if _, ok := eblc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build_id", err: errors.New(`models: missing required field "EnvBuildLog.build_id"`)}
+	}
+	if _, ok := eblc.mutation.Line(); !ok {
+		return &ValidationError{Name: "line", err: errors.New(`models: missing required field "EnvBuildLog.line"`)}
+	}
+	if _, ok := eblc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`models: missing required field "EnvBuildLog.message"`)}
+	}
+	return nil
+}
+
+func (eblc *EnvBuildLogCreate) sqlSave(ctx context.Context) (*EnvBuildLog, error) {
+	if err := eblc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := eblc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, eblc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	eblc.mutation.id = &_node.ID
+	eblc.mutation.done = true
+	return _node, nil
+}
+
+func (eblc *EnvBuildLogCreate) createSpec() (*EnvBuildLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvBuildLog{config: eblc.config}
+		_spec = sqlgraph.NewCreateSpec(envbuildlog.Table, sqlgraph.NewFieldSpec(envbuildlog.FieldID, field.TypeInt))
+	)
+	_spec.Schema = eblc.schemaConfig.EnvBuildLog
+	_spec.OnConflict = eblc.conflict
+	if value, ok := eblc.mutation.CreatedAt(); ok {
+		_spec.SetField(envbuildlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := eblc.mutation.TeamID(); ok {
+		_spec.SetField(envbuildlog.FieldTeamID, field.TypeUUID, value)
+		_node.TeamID = value
+	}
+	if value, ok := eblc.mutation.EnvID(); ok {
+		_spec.SetField(envbuildlog.FieldEnvID, field.TypeString, value)
+		_node.EnvID = value
+	}
+	if value, ok := eblc.mutation.BuildID(); ok {
+		_spec.SetField(envbuildlog.FieldBuildID, field.TypeUUID, value)
+		_node.BuildID = value
+	}
+	if value, ok := eblc.mutation.Line(); ok {
+		_spec.SetField(envbuildlog.FieldLine, field.TypeInt32, value)
+		_node.Line = value
+	}
+	if value, ok := eblc.mutation.Message(); ok {
+		_spec.SetField(envbuildlog.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvBuildLog.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvBuildLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (eblc *EnvBuildLogCreate) OnConflict(opts ...sql.ConflictOption) *EnvBuildLogUpsertOne {
+	eblc.conflict = opts
+	return &EnvBuildLogUpsertOne{
+		create: eblc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvBuildLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eblc *EnvBuildLogCreate) OnConflictColumns(columns ...string) *EnvBuildLogUpsertOne {
+	eblc.conflict = append(eblc.conflict, sql.ConflictColumns(columns...))
+	return &EnvBuildLogUpsertOne{
+		create: eblc,
+	}
+}
+
+type (
+	// EnvBuildLogUpsertOne is the builder for "upsert"-ing
+	//  one EnvBuildLog node.
+	EnvBuildLogUpsertOne struct {
+		create *EnvBuildLogCreate
+	}
+
+	// EnvBuildLogUpsert is the "OnConflict" setter.
+	EnvBuildLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EnvBuildLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EnvBuildLogUpsertOne) UpdateNewValues() *EnvBuildLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(envbuildlog.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TeamID(); exists {
+			s.SetIgnore(envbuildlog.FieldTeamID)
+		}
+		if _, exists := u.create.mutation.EnvID(); exists {
+			s.SetIgnore(envbuildlog.FieldEnvID)
+		}
+		if _, exists := u.create.mutation.BuildID(); exists {
+			s.SetIgnore(envbuildlog.FieldBuildID)
+		}
+		if _, exists := u.create.mutation.Line(); exists {
+			s.SetIgnore(envbuildlog.FieldLine)
+		}
+		if _, exists := u.create.mutation.Message(); exists {
+			s.SetIgnore(envbuildlog.FieldMessage)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvBuildLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EnvBuildLogUpsertOne) Ignore() *EnvBuildLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvBuildLogUpsertOne) DoNothing() *EnvBuildLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvBuildLogCreate.OnConflict
+// documentation for more info.
+func (u *EnvBuildLogUpsertOne) Update(set func(*EnvBuildLogUpsert)) *EnvBuildLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvBuildLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *EnvBuildLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for EnvBuildLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvBuildLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EnvBuildLogUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EnvBuildLogUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EnvBuildLogCreateBulk is the builder for creating many EnvBuildLog entities in bulk.
+type EnvBuildLogCreateBulk struct {
+	config
+	err      error
+	builders []*EnvBuildLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EnvBuildLog entities in the database.
+func (eblcb *EnvBuildLogCreateBulk) Save(ctx context.Context) ([]*EnvBuildLog, error) {
+	if eblcb.err != nil {
+		return nil, eblcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eblcb.builders))
+	nodes := make([]*EnvBuildLog, len(eblcb.builders))
+	mutators := make([]Mutator, len(eblcb.builders))
+	for i := range eblcb.builders {
+		func(i int, root context.Context) {
+			builder := eblcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvBuildLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eblcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = eblcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eblcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eblcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eblcb *EnvBuildLogCreateBulk) SaveX(ctx context.Context) []*EnvBuildLog {
+	v, err := eblcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eblcb *EnvBuildLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := eblcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eblcb *EnvBuildLogCreateBulk) ExecX(ctx context.Context) {
+	if err := eblcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvBuildLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvBuildLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (eblcb *EnvBuildLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *EnvBuildLogUpsertBulk {
+	eblcb.conflict = opts
+	return &EnvBuildLogUpsertBulk{
+		create: eblcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvBuildLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eblcb *EnvBuildLogCreateBulk) OnConflictColumns(columns ...string) *EnvBuildLogUpsertBulk {
+	eblcb.conflict = append(eblcb.conflict, sql.ConflictColumns(columns...))
+	return &EnvBuildLogUpsertBulk{
+		create: eblcb,
+	}
+}
+
+// EnvBuildLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of EnvBuildLog nodes.
+type EnvBuildLogUpsertBulk struct {
+	create *EnvBuildLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EnvBuildLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EnvBuildLogUpsertBulk) UpdateNewValues() *EnvBuildLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(envbuildlog.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TeamID(); exists {
+				s.SetIgnore(envbuildlog.FieldTeamID)
+			}
+			if _, exists := b.mutation.EnvID(); exists {
+				s.SetIgnore(envbuildlog.FieldEnvID)
+			}
+			if _, exists := b.mutation.BuildID(); exists {
+				s.SetIgnore(envbuildlog.FieldBuildID)
+			}
+			if _, exists := b.mutation.Line(); exists {
+				s.SetIgnore(envbuildlog.FieldLine)
+			}
+			if _, exists := b.mutation.Message(); exists {
+				s.SetIgnore(envbuildlog.FieldMessage)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvBuildLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EnvBuildLogUpsertBulk) Ignore() *EnvBuildLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvBuildLogUpsertBulk) DoNothing() *EnvBuildLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvBuildLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *EnvBuildLogUpsertBulk) Update(set func(*EnvBuildLogUpsert)) *EnvBuildLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvBuildLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *EnvBuildLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the EnvBuildLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for EnvBuildLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvBuildLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
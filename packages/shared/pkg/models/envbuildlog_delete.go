@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// EnvBuildLogDelete is the builder for deleting a EnvBuildLog entity.
+type EnvBuildLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *EnvBuildLogMutation
+}
+
+// Where appends a list predicates to the EnvBuildLogDelete builder.
+func (ebld *EnvBuildLogDelete) Where(ps ...predicate.EnvBuildLog) *EnvBuildLogDelete {
+	ebld.mutation.Where(ps...)
+	return ebld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ebld *EnvBuildLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ebld.sqlExec, ebld.mutation, ebld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ebld *EnvBuildLogDelete) ExecX(ctx context.Context) int {
+	n, err := ebld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ebld *EnvBuildLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(envbuildlog.Table, sqlgraph.NewFieldSpec(envbuildlog.FieldID, field.TypeInt))
+	_spec.Node.Schema = ebld.schemaConfig.EnvBuildLog
+	ctx = internal.NewSchemaConfigContext(ctx, ebld.schemaConfig)
+	if ps := ebld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ebld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ebld.mutation.done = true
+	return affected, err
+}
+
+// EnvBuildLogDeleteOne is the builder for deleting a single EnvBuildLog entity.
+type EnvBuildLogDeleteOne struct {
+	ebld *EnvBuildLogDelete
+}
+
+// Where appends a list predicates to the EnvBuildLogDelete builder.
+func (ebldo *EnvBuildLogDeleteOne) Where(ps ...predicate.EnvBuildLog) *EnvBuildLogDeleteOne {
+	ebldo.ebld.mutation.Where(ps...)
+	return ebldo
+}
+
+// Exec executes the deletion query.
+func (ebldo *EnvBuildLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := ebldo.ebld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{envbuildlog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ebldo *EnvBuildLogDeleteOne) ExecX(ctx context.Context) {
+	if err := ebldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// EnvBuildLogQuery is the builder for querying EnvBuildLog entities.
+type EnvBuildLogQuery struct {
+	config
+	ctx        *QueryContext
+	order      []envbuildlog.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EnvBuildLog
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EnvBuildLogQuery builder.
+func (eblq *EnvBuildLogQuery) Where(ps ...predicate.EnvBuildLog) *EnvBuildLogQuery {
+	eblq.predicates = append(eblq.predicates, ps...)
+	return eblq
+}
+
+// Limit the number of records to be returned by this query.
+func (eblq *EnvBuildLogQuery) Limit(limit int) *EnvBuildLogQuery {
+	eblq.ctx.Limit = &limit
+	return eblq
+}
+
+// Offset to start from.
+func (eblq *EnvBuildLogQuery) Offset(offset int) *EnvBuildLogQuery {
+	eblq.ctx.Offset = &offset
+	return eblq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eblq *EnvBuildLogQuery) Unique(unique bool) *EnvBuildLogQuery {
+	eblq.ctx.Unique = &unique
+	return eblq
+}
+
+// Order specifies how the records should be ordered.
+func (eblq *EnvBuildLogQuery) Order(o ...envbuildlog.OrderOption) *EnvBuildLogQuery {
+	eblq.order = append(eblq.order, o...)
+	return eblq
+}
+
+// First returns the first EnvBuildLog entity from the query.
+// Returns a *NotFoundError when no EnvBuildLog was found.
+func (eblq *EnvBuildLogQuery) First(ctx context.Context) (*EnvBuildLog, error) {
+	nodes, err := eblq.Limit(1).All(setContextOp(ctx, eblq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{envbuildlog.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eblq *EnvBuildLogQuery) FirstX(ctx context.Context) *EnvBuildLog {
+	node, err := eblq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EnvBuildLog ID from the query.
+// Returns a *NotFoundError when no EnvBuildLog ID was found.
+func (eblq *EnvBuildLogQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eblq.Limit(1).IDs(setContextOp(ctx, eblq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{envbuildlog.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eblq *EnvBuildLogQuery) FirstIDX(ctx context.Context) int {
+	id, err := eblq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EnvBuildLog entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EnvBuildLog entity is found.
+// Returns a *NotFoundError when no EnvBuildLog entities are found.
+func (eblq *EnvBuildLogQuery) Only(ctx context.Context) (*EnvBuildLog, error) {
+	nodes, err := eblq.Limit(2).All(setContextOp(ctx, eblq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{envbuildlog.Label}
+	default:
+		return nil, &NotSingularError{envbuildlog.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eblq *EnvBuildLogQuery) OnlyX(ctx context.Context) *EnvBuildLog {
+	node, err := eblq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EnvBuildLog ID in the query.
+// Returns a *NotSingularError when more than one EnvBuildLog ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eblq *EnvBuildLogQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eblq.Limit(2).IDs(setContextOp(ctx, eblq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{envbuildlog.Label}
+	default:
+		err = &NotSingularError{envbuildlog.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eblq *EnvBuildLogQuery) OnlyIDX(ctx context.Context) int {
+	id, err := eblq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EnvBuildLogs.
+func (eblq *EnvBuildLogQuery) All(ctx context.Context) ([]*EnvBuildLog, error) {
+	ctx = setContextOp(ctx, eblq.ctx, "All")
+	if err := eblq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EnvBuildLog, *EnvBuildLogQuery]()
+	return withInterceptors[[]*EnvBuildLog](ctx, eblq, qr, eblq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eblq *EnvBuildLogQuery) AllX(ctx context.Context) []*EnvBuildLog {
+	nodes, err := eblq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EnvBuildLog IDs.
+func (eblq *EnvBuildLogQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if eblq.ctx.Unique == nil && eblq.path != nil {
+		eblq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eblq.ctx, "IDs")
+	if err = eblq.Select(envbuildlog.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eblq *EnvBuildLogQuery) IDsX(ctx context.Context) []int {
+	ids, err := eblq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eblq *EnvBuildLogQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eblq.ctx, "Count")
+	if err := eblq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eblq, querierCount[*EnvBuildLogQuery](), eblq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eblq *EnvBuildLogQuery) CountX(ctx context.Context) int {
+	count, err := eblq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eblq *EnvBuildLogQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eblq.ctx, "Exist")
+	switch _, err := eblq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eblq *EnvBuildLogQuery) ExistX(ctx context.Context) bool {
+	exist, err := eblq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EnvBuildLogQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eblq *EnvBuildLogQuery) Clone() *EnvBuildLogQuery {
+	if eblq == nil {
+		return nil
+	}
+	return &EnvBuildLogQuery{
+		config:     eblq.config,
+		ctx:        eblq.ctx.Clone(),
+		order:      append([]envbuildlog.OrderOption{}, eblq.order...),
+		inters:     append([]Interceptor{}, eblq.inters...),
+		predicates: append([]predicate.EnvBuildLog{}, eblq.predicates...),
+		// clone intermediate query.
+		sql:  eblq.sql.Clone(),
+		path: eblq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EnvBuildLog.Query().
+//		GroupBy(envbuildlog.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (eblq *EnvBuildLogQuery) GroupBy(field string, fields ...string) *EnvBuildLogGroupBy {
+	eblq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EnvBuildLogGroupBy{build: eblq}
+	grbuild.flds = &eblq.ctx.Fields
+	grbuild.label = envbuildlog.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.EnvBuildLog.Query().
+//		Select(envbuildlog.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (eblq *EnvBuildLogQuery) Select(fields ...string) *EnvBuildLogSelect {
+	eblq.ctx.Fields = append(eblq.ctx.Fields, fields...)
+	sbuild := &EnvBuildLogSelect{EnvBuildLogQuery: eblq}
+	sbuild.label = envbuildlog.Label
+	sbuild.flds, sbuild.scan = &eblq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EnvBuildLogSelect configured with the given aggregations.
+func (eblq *EnvBuildLogQuery) Aggregate(fns ...AggregateFunc) *EnvBuildLogSelect {
+	return eblq.Select().Aggregate(fns...)
+}
+
+func (eblq *EnvBuildLogQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eblq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eblq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eblq.ctx.Fields {
+		if !envbuildlog.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if eblq.path != nil {
+		prev, err := eblq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eblq.sql = prev
+	}
+	return nil
+}
+
+func (eblq *EnvBuildLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EnvBuildLog, error) {
+	var (
+		nodes = []*EnvBuildLog{}
+		_spec = eblq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EnvBuildLog).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EnvBuildLog{config: eblq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = eblq.schemaConfig.EnvBuildLog
+	ctx = internal.NewSchemaConfigContext(ctx, eblq.schemaConfig)
+	if len(eblq.modifiers) > 0 {
+		_spec.Modifiers = eblq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eblq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (eblq *EnvBuildLogQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eblq.querySpec()
+	_spec.Node.Schema = eblq.schemaConfig.EnvBuildLog
+	ctx = internal.NewSchemaConfigContext(ctx, eblq.schemaConfig)
+	if len(eblq.modifiers) > 0 {
+		_spec.Modifiers = eblq.modifiers
+	}
+	_spec.Node.Columns = eblq.ctx.Fields
+	if len(eblq.ctx.Fields) > 0 {
+		_spec.Unique = eblq.ctx.Unique != nil && *eblq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eblq.driver, _spec)
+}
+
+func (eblq *EnvBuildLogQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(envbuildlog.Table, envbuildlog.Columns, sqlgraph.NewFieldSpec(envbuildlog.FieldID, field.TypeInt))
+	_spec.From = eblq.sql
+	if unique := eblq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eblq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eblq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envbuildlog.FieldID)
+		for i := range fields {
+			if fields[i] != envbuildlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := eblq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eblq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eblq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eblq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eblq *EnvBuildLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eblq.driver.Dialect())
+	t1 := builder.Table(envbuildlog.Table)
+	columns := eblq.ctx.Fields
+	if len(columns) == 0 {
+		columns = envbuildlog.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eblq.sql != nil {
+		selector = eblq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eblq.ctx.Unique != nil && *eblq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(eblq.schemaConfig.EnvBuildLog)
+	ctx = internal.NewSchemaConfigContext(ctx, eblq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range eblq.modifiers {
+		m(selector)
+	}
+	for _, p := range eblq.predicates {
+		p(selector)
+	}
+	for _, p := range eblq.order {
+		p(selector)
+	}
+	if offset := eblq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eblq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (eblq *EnvBuildLogQuery) Modify(modifiers ...func(s *sql.Selector)) *EnvBuildLogSelect {
+	eblq.modifiers = append(eblq.modifiers, modifiers...)
+	return eblq.Select()
+}
+
+// EnvBuildLogGroupBy is the group-by builder for EnvBuildLog entities.
+type EnvBuildLogGroupBy struct {
+	selector
+	build *EnvBuildLogQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (eblgb *EnvBuildLogGroupBy) Aggregate(fns ...AggregateFunc) *EnvBuildLogGroupBy {
+	eblgb.fns = append(eblgb.fns, fns...)
+	return eblgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eblgb *EnvBuildLogGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eblgb.build.ctx, "GroupBy")
+	if err := eblgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvBuildLogQuery, *EnvBuildLogGroupBy](ctx, eblgb.build, eblgb, eblgb.build.inters, v)
+}
+
+func (eblgb *EnvBuildLogGroupBy) sqlScan(ctx context.Context, root *EnvBuildLogQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(eblgb.fns))
+	for _, fn := range eblgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*eblgb.flds)+len(eblgb.fns))
+		for _, f := range *eblgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*eblgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eblgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EnvBuildLogSelect is the builder for selecting fields of EnvBuildLog entities.
+type EnvBuildLogSelect struct {
+	*EnvBuildLogQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ebls *EnvBuildLogSelect) Aggregate(fns ...AggregateFunc) *EnvBuildLogSelect {
+	ebls.fns = append(ebls.fns, fns...)
+	return ebls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ebls *EnvBuildLogSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ebls.ctx, "Select")
+	if err := ebls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvBuildLogQuery, *EnvBuildLogSelect](ctx, ebls.EnvBuildLogQuery, ebls, ebls.inters, v)
+}
+
+func (ebls *EnvBuildLogSelect) sqlScan(ctx context.Context, root *EnvBuildLogQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ebls.fns))
+	for _, fn := range ebls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ebls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ebls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ebls *EnvBuildLogSelect) Modify(modifiers ...func(s *sql.Selector)) *EnvBuildLogSelect {
+	ebls.modifiers = append(ebls.modifiers, modifiers...)
+	return ebls
+}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// EnvBuildLogUpdate is the builder for updating EnvBuildLog entities.
+type EnvBuildLogUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EnvBuildLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EnvBuildLogUpdate builder.
+func (eblu *EnvBuildLogUpdate) Where(ps ...predicate.EnvBuildLog) *EnvBuildLogUpdate {
+	eblu.mutation.Where(ps...)
+	return eblu
+}
+
+// Mutation returns the EnvBuildLogMutation object of the builder.
+func (eblu *EnvBuildLogUpdate) Mutation() *EnvBuildLogMutation {
+	return eblu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eblu *EnvBuildLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eblu.sqlSave, eblu.mutation, eblu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eblu *EnvBuildLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := eblu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eblu *EnvBuildLogUpdate) Exec(ctx context.Context) error {
+	_, err := eblu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eblu *EnvBuildLogUpdate) ExecX(ctx context.Context) {
+	if err := eblu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (eblu *EnvBuildLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnvBuildLogUpdate {
+	eblu.modifiers = append(eblu.modifiers, modifiers...)
+	return eblu
+}
+
+func (eblu *EnvBuildLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(envbuildlog.Table, envbuildlog.Columns, sqlgraph.NewFieldSpec(envbuildlog.FieldID, field.TypeInt))
+	if ps := eblu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = eblu.schemaConfig.EnvBuildLog
+	ctx = internal.NewSchemaConfigContext(ctx, eblu.schemaConfig)
+	_spec.AddModifiers(eblu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, eblu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envbuildlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eblu.mutation.done = true
+	return n, nil
+}
+
+// EnvBuildLogUpdateOne is the builder for updating a single EnvBuildLog entity.
+type EnvBuildLogUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EnvBuildLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the EnvBuildLogMutation object of the builder.
+func (ebluo *EnvBuildLogUpdateOne) Mutation() *EnvBuildLogMutation {
+	return ebluo.mutation
+}
+
+// Where appends a list predicates to the EnvBuildLogUpdate builder.
+func (ebluo *EnvBuildLogUpdateOne) Where(ps ...predicate.EnvBuildLog) *EnvBuildLogUpdateOne {
+	ebluo.mutation.Where(ps...)
+	return ebluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ebluo *EnvBuildLogUpdateOne) Select(field string, fields ...string) *EnvBuildLogUpdateOne {
+	ebluo.fields = append([]string{field}, fields...)
+	return ebluo
+}
+
+// Save executes the query and returns the updated EnvBuildLog entity.
+func (ebluo *EnvBuildLogUpdateOne) Save(ctx context.Context) (*EnvBuildLog, error) {
+	return withHooks(ctx, ebluo.sqlSave, ebluo.mutation, ebluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ebluo *EnvBuildLogUpdateOne) SaveX(ctx context.Context) *EnvBuildLog {
+	node, err := ebluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ebluo *EnvBuildLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := ebluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ebluo *EnvBuildLogUpdateOne) ExecX(ctx context.Context) {
+	if err := ebluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ebluo *EnvBuildLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnvBuildLogUpdateOne {
+	ebluo.modifiers = append(ebluo.modifiers, modifiers...)
+	return ebluo
+}
+
+func (ebluo *EnvBuildLogUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuildLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(envbuildlog.Table, envbuildlog.Columns, sqlgraph.NewFieldSpec(envbuildlog.FieldID, field.TypeInt))
+	id, ok := ebluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "EnvBuildLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ebluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envbuildlog.FieldID)
+		for _, f := range fields {
+			if !envbuildlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != envbuildlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ebluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = ebluo.schemaConfig.EnvBuildLog
+	ctx = internal.NewSchemaConfigContext(ctx, ebluo.schemaConfig)
+	_spec.AddModifiers(ebluo.modifiers...)
+	_node = &EnvBuildLog{config: ebluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ebluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envbuildlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ebluo.mutation.done = true
+	return _node, nil
+}
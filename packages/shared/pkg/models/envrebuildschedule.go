@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+	"github.com/google/uuid"
+)
+
+// EnvRebuildSchedule is the model entity for the EnvRebuildSchedule schema.
+type EnvRebuildSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// EnvID holds the value of the "env_id" field.
+	EnvID string `json:"env_id,omitempty"`
+	// IntervalHours holds the value of the "interval_hours" field.
+	IntervalHours int32 `json:"interval_hours,omitempty"`
+	// NextRunAt holds the value of the "next_run_at" field.
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+	// UpgradePackages holds the value of the "upgrade_packages" field.
+	UpgradePackages bool `json:"upgrade_packages,omitempty"`
+	// WebhookURL holds the value of the "webhook_url" field.
+	WebhookURL *string `json:"webhook_url,omitempty"`
+	// WebhookSecret holds the value of the "webhook_secret" field.
+	WebhookSecret *string `json:"-"`
+	// LastBuildID holds the value of the "last_build_id" field.
+	LastBuildID *uuid.UUID `json:"last_build_id,omitempty"`
+	// LastBuildStatus holds the value of the "last_build_status" field.
+	LastBuildStatus *envrebuildschedule.LastBuildStatus `json:"last_build_status,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnvRebuildSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case envrebuildschedule.FieldLastBuildID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case envrebuildschedule.FieldUpgradePackages:
+			values[i] = new(sql.NullBool)
+		case envrebuildschedule.FieldIntervalHours:
+			values[i] = new(sql.NullInt64)
+		case envrebuildschedule.FieldEnvID, envrebuildschedule.FieldWebhookURL, envrebuildschedule.FieldWebhookSecret, envrebuildschedule.FieldLastBuildStatus:
+			values[i] = new(sql.NullString)
+		case envrebuildschedule.FieldCreatedAt, envrebuildschedule.FieldUpdatedAt, envrebuildschedule.FieldNextRunAt:
+			values[i] = new(sql.NullTime)
+		case envrebuildschedule.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnvRebuildSchedule fields.
+func (ers *EnvRebuildSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case envrebuildschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ers.ID = *value
+			}
+		case envrebuildschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ers.CreatedAt = value.Time
+			}
+		case envrebuildschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ers.UpdatedAt = value.Time
+			}
+		case envrebuildschedule.FieldEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				ers.EnvID = value.String
+			}
+		case envrebuildschedule.FieldIntervalHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval_hours", values[i])
+			} else if value.Valid {
+				ers.IntervalHours = int32(value.Int64)
+			}
+		case envrebuildschedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				ers.NextRunAt = value.Time
+			}
+		case envrebuildschedule.FieldUpgradePackages:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field upgrade_packages", values[i])
+			} else if value.Valid {
+				ers.UpgradePackages = value.Bool
+			}
+		case envrebuildschedule.FieldWebhookURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_url", values[i])
+			} else if value.Valid {
+				ers.WebhookURL = new(string)
+				*ers.WebhookURL = value.String
+			}
+		case envrebuildschedule.FieldWebhookSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_secret", values[i])
+			} else if value.Valid {
+				ers.WebhookSecret = new(string)
+				*ers.WebhookSecret = value.String
+			}
+		case envrebuildschedule.FieldLastBuildID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field last_build_id", values[i])
+			} else if value.Valid {
+				ers.LastBuildID = new(uuid.UUID)
+				*ers.LastBuildID = *value.S.(*uuid.UUID)
+			}
+		case envrebuildschedule.FieldLastBuildStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_build_status", values[i])
+			} else if value.Valid {
+				ers.LastBuildStatus = new(envrebuildschedule.LastBuildStatus)
+				*ers.LastBuildStatus = envrebuildschedule.LastBuildStatus(value.String)
+			}
+		default:
+			ers.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnvRebuildSchedule.
+// This includes values selected through modifiers, order, etc.
+func (ers *EnvRebuildSchedule) Value(name string) (ent.Value, error) {
+	return ers.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EnvRebuildSchedule.
+// Note that you need to call EnvRebuildSchedule.Unwrap() before calling this method if this EnvRebuildSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ers *EnvRebuildSchedule) Update() *EnvRebuildScheduleUpdateOne {
+	return NewEnvRebuildScheduleClient(ers.config).UpdateOne(ers)
+}
+
+// Unwrap unwraps the EnvRebuildSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ers *EnvRebuildSchedule) Unwrap() *EnvRebuildSchedule {
+	_tx, ok := ers.config.driver.(*txDriver)
+	if !ok {
+		panic("models: EnvRebuildSchedule is not a transactional entity")
+	}
+	ers.config.driver = _tx.drv
+	return ers
+}
+
+// String implements the fmt.Stringer.
+func (ers *EnvRebuildSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnvRebuildSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ers.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ers.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ers.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(ers.EnvID)
+	builder.WriteString(", ")
+	builder.WriteString("interval_hours=")
+	builder.WriteString(fmt.Sprintf("%v", ers.IntervalHours))
+	builder.WriteString(", ")
+	builder.WriteString("next_run_at=")
+	builder.WriteString(ers.NextRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("upgrade_packages=")
+	builder.WriteString(fmt.Sprintf("%v", ers.UpgradePackages))
+	builder.WriteString(", ")
+	if v := ers.WebhookURL; v != nil {
+		builder.WriteString("webhook_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("webhook_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := ers.LastBuildID; v != nil {
+		builder.WriteString("last_build_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ers.LastBuildStatus; v != nil {
+		builder.WriteString("last_build_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnvRebuildSchedules is a parsable slice of EnvRebuildSchedule.
+type EnvRebuildSchedules []*EnvRebuildSchedule
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envrebuildschedule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the envrebuildschedule type in the database.
+	Label = "env_rebuild_schedule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldIntervalHours holds the string denoting the interval_hours field in the database.
+	FieldIntervalHours = "interval_hours"
+	// FieldNextRunAt holds the string denoting the next_run_at field in the database.
+	FieldNextRunAt = "next_run_at"
+	// FieldUpgradePackages holds the string denoting the upgrade_packages field in the database.
+	FieldUpgradePackages = "upgrade_packages"
+	// FieldWebhookURL holds the string denoting the webhook_url field in the database.
+	FieldWebhookURL = "webhook_url"
+	// FieldWebhookSecret holds the string denoting the webhook_secret field in the database.
+	FieldWebhookSecret = "webhook_secret"
+	// FieldLastBuildID holds the string denoting the last_build_id field in the database.
+	FieldLastBuildID = "last_build_id"
+	// FieldLastBuildStatus holds the string denoting the last_build_status field in the database.
+	FieldLastBuildStatus = "last_build_status"
+	// Table holds the table name of the envrebuildschedule in the database.
+	Table = "env_rebuild_schedules"
+)
+
+// Columns holds all SQL columns for envrebuildschedule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldEnvID,
+	FieldIntervalHours,
+	FieldNextRunAt,
+	FieldUpgradePackages,
+	FieldWebhookURL,
+	FieldWebhookSecret,
+	FieldLastBuildID,
+	FieldLastBuildStatus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultIntervalHours holds the default value on creation for the "interval_hours" field.
+	DefaultIntervalHours int32
+	// DefaultUpgradePackages holds the default value on creation for the "upgrade_packages" field.
+	DefaultUpgradePackages bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// LastBuildStatus defines the type for the "last_build_status" enum field.
+type LastBuildStatus string
+
+// LastBuildStatus values.
+const (
+	LastBuildStatusBuilding LastBuildStatus = "building"
+	LastBuildStatusFailed   LastBuildStatus = "failed"
+	LastBuildStatusSuccess  LastBuildStatus = "success"
+)
+
+func (lbs LastBuildStatus) String() string {
+	return string(lbs)
+}
+
+// LastBuildStatusValidator is a validator for the "last_build_status" field enum values. It is called by the builders before save.
+func LastBuildStatusValidator(lbs LastBuildStatus) error {
+	switch lbs {
+	case LastBuildStatusBuilding, LastBuildStatusFailed, LastBuildStatusSuccess:
+		return nil
+	default:
+		return fmt.Errorf("envrebuildschedule: invalid enum value for last_build_status field: %q", lbs)
+	}
+}
+
+// OrderOption defines the ordering options for the EnvRebuildSchedule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// ByIntervalHours orders the results by the interval_hours field.
+func ByIntervalHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntervalHours, opts...).ToFunc()
+}
+
+// ByNextRunAt orders the results by the next_run_at field.
+func ByNextRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRunAt, opts...).ToFunc()
+}
+
+// ByUpgradePackages orders the results by the upgrade_packages field.
+func ByUpgradePackages(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpgradePackages, opts...).ToFunc()
+}
+
+// ByWebhookURL orders the results by the webhook_url field.
+func ByWebhookURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhookURL, opts...).ToFunc()
+}
+
+// ByWebhookSecret orders the results by the webhook_secret field.
+func ByWebhookSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhookSecret, opts...).ToFunc()
+}
+
+// ByLastBuildID orders the results by the last_build_id field.
+func ByLastBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastBuildID, opts...).ToFunc()
+}
+
+// ByLastBuildStatus orders the results by the last_build_status field.
+func ByLastBuildStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastBuildStatus, opts...).ToFunc()
+}
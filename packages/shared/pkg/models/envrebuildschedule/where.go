@@ -0,0 +1,581 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envrebuildschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldEnvID, v))
+}
+
+// IntervalHours applies equality check predicate on the "interval_hours" field. It's identical to IntervalHoursEQ.
+func IntervalHours(v int32) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldIntervalHours, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// UpgradePackages applies equality check predicate on the "upgrade_packages" field. It's identical to UpgradePackagesEQ.
+func UpgradePackages(v bool) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldUpgradePackages, v))
+}
+
+// WebhookURL applies equality check predicate on the "webhook_url" field. It's identical to WebhookURLEQ.
+func WebhookURL(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldWebhookURL, v))
+}
+
+// WebhookSecret applies equality check predicate on the "webhook_secret" field. It's identical to WebhookSecretEQ.
+func WebhookSecret(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldWebhookSecret, v))
+}
+
+// LastBuildID applies equality check predicate on the "last_build_id" field. It's identical to LastBuildIDEQ.
+func LastBuildID(v uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldLastBuildID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// EnvIDGT applies the GT predicate on the "env_id" field.
+func EnvIDGT(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGT(FieldEnvID, v))
+}
+
+// EnvIDGTE applies the GTE predicate on the "env_id" field.
+func EnvIDGTE(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGTE(FieldEnvID, v))
+}
+
+// EnvIDLT applies the LT predicate on the "env_id" field.
+func EnvIDLT(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLT(FieldEnvID, v))
+}
+
+// EnvIDLTE applies the LTE predicate on the "env_id" field.
+func EnvIDLTE(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLTE(FieldEnvID, v))
+}
+
+// EnvIDContains applies the Contains predicate on the "env_id" field.
+func EnvIDContains(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldContains(FieldEnvID, v))
+}
+
+// EnvIDHasPrefix applies the HasPrefix predicate on the "env_id" field.
+func EnvIDHasPrefix(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldHasPrefix(FieldEnvID, v))
+}
+
+// EnvIDHasSuffix applies the HasSuffix predicate on the "env_id" field.
+func EnvIDHasSuffix(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldHasSuffix(FieldEnvID, v))
+}
+
+// EnvIDEqualFold applies the EqualFold predicate on the "env_id" field.
+func EnvIDEqualFold(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEqualFold(FieldEnvID, v))
+}
+
+// EnvIDContainsFold applies the ContainsFold predicate on the "env_id" field.
+func EnvIDContainsFold(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldContainsFold(FieldEnvID, v))
+}
+
+// IntervalHoursEQ applies the EQ predicate on the "interval_hours" field.
+func IntervalHoursEQ(v int32) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldIntervalHours, v))
+}
+
+// IntervalHoursNEQ applies the NEQ predicate on the "interval_hours" field.
+func IntervalHoursNEQ(v int32) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldIntervalHours, v))
+}
+
+// IntervalHoursIn applies the In predicate on the "interval_hours" field.
+func IntervalHoursIn(vs ...int32) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldIntervalHours, vs...))
+}
+
+// IntervalHoursNotIn applies the NotIn predicate on the "interval_hours" field.
+func IntervalHoursNotIn(vs ...int32) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldIntervalHours, vs...))
+}
+
+// IntervalHoursGT applies the GT predicate on the "interval_hours" field.
+func IntervalHoursGT(v int32) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGT(FieldIntervalHours, v))
+}
+
+// IntervalHoursGTE applies the GTE predicate on the "interval_hours" field.
+func IntervalHoursGTE(v int32) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGTE(FieldIntervalHours, v))
+}
+
+// IntervalHoursLT applies the LT predicate on the "interval_hours" field.
+func IntervalHoursLT(v int32) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLT(FieldIntervalHours, v))
+}
+
+// IntervalHoursLTE applies the LTE predicate on the "interval_hours" field.
+func IntervalHoursLTE(v int32) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLTE(FieldIntervalHours, v))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// UpgradePackagesEQ applies the EQ predicate on the "upgrade_packages" field.
+func UpgradePackagesEQ(v bool) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldUpgradePackages, v))
+}
+
+// UpgradePackagesNEQ applies the NEQ predicate on the "upgrade_packages" field.
+func UpgradePackagesNEQ(v bool) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldUpgradePackages, v))
+}
+
+// WebhookURLEQ applies the EQ predicate on the "webhook_url" field.
+func WebhookURLEQ(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldWebhookURL, v))
+}
+
+// WebhookURLNEQ applies the NEQ predicate on the "webhook_url" field.
+func WebhookURLNEQ(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldWebhookURL, v))
+}
+
+// WebhookURLIn applies the In predicate on the "webhook_url" field.
+func WebhookURLIn(vs ...string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldWebhookURL, vs...))
+}
+
+// WebhookURLNotIn applies the NotIn predicate on the "webhook_url" field.
+func WebhookURLNotIn(vs ...string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldWebhookURL, vs...))
+}
+
+// WebhookURLGT applies the GT predicate on the "webhook_url" field.
+func WebhookURLGT(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGT(FieldWebhookURL, v))
+}
+
+// WebhookURLGTE applies the GTE predicate on the "webhook_url" field.
+func WebhookURLGTE(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGTE(FieldWebhookURL, v))
+}
+
+// WebhookURLLT applies the LT predicate on the "webhook_url" field.
+func WebhookURLLT(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLT(FieldWebhookURL, v))
+}
+
+// WebhookURLLTE applies the LTE predicate on the "webhook_url" field.
+func WebhookURLLTE(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLTE(FieldWebhookURL, v))
+}
+
+// WebhookURLContains applies the Contains predicate on the "webhook_url" field.
+func WebhookURLContains(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldContains(FieldWebhookURL, v))
+}
+
+// WebhookURLHasPrefix applies the HasPrefix predicate on the "webhook_url" field.
+func WebhookURLHasPrefix(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldHasPrefix(FieldWebhookURL, v))
+}
+
+// WebhookURLHasSuffix applies the HasSuffix predicate on the "webhook_url" field.
+func WebhookURLHasSuffix(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldHasSuffix(FieldWebhookURL, v))
+}
+
+// WebhookURLIsNil applies the IsNil predicate on the "webhook_url" field.
+func WebhookURLIsNil() predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIsNull(FieldWebhookURL))
+}
+
+// WebhookURLNotNil applies the NotNil predicate on the "webhook_url" field.
+func WebhookURLNotNil() predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotNull(FieldWebhookURL))
+}
+
+// WebhookURLEqualFold applies the EqualFold predicate on the "webhook_url" field.
+func WebhookURLEqualFold(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEqualFold(FieldWebhookURL, v))
+}
+
+// WebhookURLContainsFold applies the ContainsFold predicate on the "webhook_url" field.
+func WebhookURLContainsFold(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldContainsFold(FieldWebhookURL, v))
+}
+
+// WebhookSecretEQ applies the EQ predicate on the "webhook_secret" field.
+func WebhookSecretEQ(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldWebhookSecret, v))
+}
+
+// WebhookSecretNEQ applies the NEQ predicate on the "webhook_secret" field.
+func WebhookSecretNEQ(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldWebhookSecret, v))
+}
+
+// WebhookSecretIn applies the In predicate on the "webhook_secret" field.
+func WebhookSecretIn(vs ...string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldWebhookSecret, vs...))
+}
+
+// WebhookSecretNotIn applies the NotIn predicate on the "webhook_secret" field.
+func WebhookSecretNotIn(vs ...string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldWebhookSecret, vs...))
+}
+
+// WebhookSecretGT applies the GT predicate on the "webhook_secret" field.
+func WebhookSecretGT(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGT(FieldWebhookSecret, v))
+}
+
+// WebhookSecretGTE applies the GTE predicate on the "webhook_secret" field.
+func WebhookSecretGTE(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGTE(FieldWebhookSecret, v))
+}
+
+// WebhookSecretLT applies the LT predicate on the "webhook_secret" field.
+func WebhookSecretLT(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLT(FieldWebhookSecret, v))
+}
+
+// WebhookSecretLTE applies the LTE predicate on the "webhook_secret" field.
+func WebhookSecretLTE(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLTE(FieldWebhookSecret, v))
+}
+
+// WebhookSecretContains applies the Contains predicate on the "webhook_secret" field.
+func WebhookSecretContains(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldContains(FieldWebhookSecret, v))
+}
+
+// WebhookSecretHasPrefix applies the HasPrefix predicate on the "webhook_secret" field.
+func WebhookSecretHasPrefix(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldHasPrefix(FieldWebhookSecret, v))
+}
+
+// WebhookSecretHasSuffix applies the HasSuffix predicate on the "webhook_secret" field.
+func WebhookSecretHasSuffix(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldHasSuffix(FieldWebhookSecret, v))
+}
+
+// WebhookSecretIsNil applies the IsNil predicate on the "webhook_secret" field.
+func WebhookSecretIsNil() predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIsNull(FieldWebhookSecret))
+}
+
+// WebhookSecretNotNil applies the NotNil predicate on the "webhook_secret" field.
+func WebhookSecretNotNil() predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotNull(FieldWebhookSecret))
+}
+
+// WebhookSecretEqualFold applies the EqualFold predicate on the "webhook_secret" field.
+func WebhookSecretEqualFold(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEqualFold(FieldWebhookSecret, v))
+}
+
+// WebhookSecretContainsFold applies the ContainsFold predicate on the "webhook_secret" field.
+func WebhookSecretContainsFold(v string) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldContainsFold(FieldWebhookSecret, v))
+}
+
+// LastBuildIDEQ applies the EQ predicate on the "last_build_id" field.
+func LastBuildIDEQ(v uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldLastBuildID, v))
+}
+
+// LastBuildIDNEQ applies the NEQ predicate on the "last_build_id" field.
+func LastBuildIDNEQ(v uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldLastBuildID, v))
+}
+
+// LastBuildIDIn applies the In predicate on the "last_build_id" field.
+func LastBuildIDIn(vs ...uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldLastBuildID, vs...))
+}
+
+// LastBuildIDNotIn applies the NotIn predicate on the "last_build_id" field.
+func LastBuildIDNotIn(vs ...uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldLastBuildID, vs...))
+}
+
+// LastBuildIDGT applies the GT predicate on the "last_build_id" field.
+func LastBuildIDGT(v uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGT(FieldLastBuildID, v))
+}
+
+// LastBuildIDGTE applies the GTE predicate on the "last_build_id" field.
+func LastBuildIDGTE(v uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldGTE(FieldLastBuildID, v))
+}
+
+// LastBuildIDLT applies the LT predicate on the "last_build_id" field.
+func LastBuildIDLT(v uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLT(FieldLastBuildID, v))
+}
+
+// LastBuildIDLTE applies the LTE predicate on the "last_build_id" field.
+func LastBuildIDLTE(v uuid.UUID) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldLTE(FieldLastBuildID, v))
+}
+
+// LastBuildIDIsNil applies the IsNil predicate on the "last_build_id" field.
+func LastBuildIDIsNil() predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIsNull(FieldLastBuildID))
+}
+
+// LastBuildIDNotNil applies the NotNil predicate on the "last_build_id" field.
+func LastBuildIDNotNil() predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotNull(FieldLastBuildID))
+}
+
+// LastBuildStatusEQ applies the EQ predicate on the "last_build_status" field.
+func LastBuildStatusEQ(v LastBuildStatus) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldEQ(FieldLastBuildStatus, v))
+}
+
+// LastBuildStatusNEQ applies the NEQ predicate on the "last_build_status" field.
+func LastBuildStatusNEQ(v LastBuildStatus) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNEQ(FieldLastBuildStatus, v))
+}
+
+// LastBuildStatusIn applies the In predicate on the "last_build_status" field.
+func LastBuildStatusIn(vs ...LastBuildStatus) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIn(FieldLastBuildStatus, vs...))
+}
+
+// LastBuildStatusNotIn applies the NotIn predicate on the "last_build_status" field.
+func LastBuildStatusNotIn(vs ...LastBuildStatus) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotIn(FieldLastBuildStatus, vs...))
+}
+
+// LastBuildStatusIsNil applies the IsNil predicate on the "last_build_status" field.
+func LastBuildStatusIsNil() predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldIsNull(FieldLastBuildStatus))
+}
+
+// LastBuildStatusNotNil applies the NotNil predicate on the "last_build_status" field.
+func LastBuildStatusNotNil() predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.FieldNotNull(FieldLastBuildStatus))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnvRebuildSchedule) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnvRebuildSchedule) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnvRebuildSchedule) predicate.EnvRebuildSchedule {
+	return predicate.EnvRebuildSchedule(sql.NotPredicates(p))
+}
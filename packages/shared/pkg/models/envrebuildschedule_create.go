@@ -0,0 +1,1092 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+	"github.com/google/uuid"
+)
+
+// EnvRebuildScheduleCreate is the builder for creating a EnvRebuildSchedule entity.
+type EnvRebuildScheduleCreate struct {
+	config
+	mutation *EnvRebuildScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ersc *EnvRebuildScheduleCreate) SetCreatedAt(t time.Time) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetCreatedAt(t)
+	return ersc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ersc *EnvRebuildScheduleCreate) SetNillableCreatedAt(t *time.Time) *EnvRebuildScheduleCreate {
+	if t != nil {
+		ersc.SetCreatedAt(*t)
+	}
+	return ersc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ersc *EnvRebuildScheduleCreate) SetUpdatedAt(t time.Time) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetUpdatedAt(t)
+	return ersc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ersc *EnvRebuildScheduleCreate) SetNillableUpdatedAt(t *time.Time) *EnvRebuildScheduleCreate {
+	if t != nil {
+		ersc.SetUpdatedAt(*t)
+	}
+	return ersc
+}
+
+// SetEnvID sets the "env_id" field.
+func (ersc *EnvRebuildScheduleCreate) SetEnvID(s string) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetEnvID(s)
+	return ersc
+}
+
+// SetIntervalHours sets the "interval_hours" field.
+func (ersc *EnvRebuildScheduleCreate) SetIntervalHours(i int32) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetIntervalHours(i)
+	return ersc
+}
+
+// SetNillableIntervalHours sets the "interval_hours" field if the given value is not nil.
+func (ersc *EnvRebuildScheduleCreate) SetNillableIntervalHours(i *int32) *EnvRebuildScheduleCreate {
+	if i != nil {
+		ersc.SetIntervalHours(*i)
+	}
+	return ersc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (ersc *EnvRebuildScheduleCreate) SetNextRunAt(t time.Time) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetNextRunAt(t)
+	return ersc
+}
+
+// SetUpgradePackages sets the "upgrade_packages" field.
+func (ersc *EnvRebuildScheduleCreate) SetUpgradePackages(b bool) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetUpgradePackages(b)
+	return ersc
+}
+
+// SetNillableUpgradePackages sets the "upgrade_packages" field if the given value is not nil.
+func (ersc *EnvRebuildScheduleCreate) SetNillableUpgradePackages(b *bool) *EnvRebuildScheduleCreate {
+	if b != nil {
+		ersc.SetUpgradePackages(*b)
+	}
+	return ersc
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (ersc *EnvRebuildScheduleCreate) SetWebhookURL(s string) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetWebhookURL(s)
+	return ersc
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (ersc *EnvRebuildScheduleCreate) SetNillableWebhookURL(s *string) *EnvRebuildScheduleCreate {
+	if s != nil {
+		ersc.SetWebhookURL(*s)
+	}
+	return ersc
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (ersc *EnvRebuildScheduleCreate) SetWebhookSecret(s string) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetWebhookSecret(s)
+	return ersc
+}
+
+// SetNillableWebhookSecret sets the "webhook_secret" field if the given value is not nil.
+func (ersc *EnvRebuildScheduleCreate) SetNillableWebhookSecret(s *string) *EnvRebuildScheduleCreate {
+	if s != nil {
+		ersc.SetWebhookSecret(*s)
+	}
+	return ersc
+}
+
+// SetLastBuildID sets the "last_build_id" field.
+func (ersc *EnvRebuildScheduleCreate) SetLastBuildID(u uuid.UUID) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetLastBuildID(u)
+	return ersc
+}
+
+// SetNillableLastBuildID sets the "last_build_id" field if the given value is not nil.
+func (ersc *EnvRebuildScheduleCreate) SetNillableLastBuildID(u *uuid.UUID) *EnvRebuildScheduleCreate {
+	if u != nil {
+		ersc.SetLastBuildID(*u)
+	}
+	return ersc
+}
+
+// SetLastBuildStatus sets the "last_build_status" field.
+func (ersc *EnvRebuildScheduleCreate) SetLastBuildStatus(ebs envrebuildschedule.LastBuildStatus) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetLastBuildStatus(ebs)
+	return ersc
+}
+
+// SetNillableLastBuildStatus sets the "last_build_status" field if the given value is not nil.
+func (ersc *EnvRebuildScheduleCreate) SetNillableLastBuildStatus(ebs *envrebuildschedule.LastBuildStatus) *EnvRebuildScheduleCreate {
+	if ebs != nil {
+		ersc.SetLastBuildStatus(*ebs)
+	}
+	return ersc
+}
+
+// SetID sets the "id" field.
+func (ersc *EnvRebuildScheduleCreate) SetID(u uuid.UUID) *EnvRebuildScheduleCreate {
+	ersc.mutation.SetID(u)
+	return ersc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ersc *EnvRebuildScheduleCreate) SetNillableID(u *uuid.UUID) *EnvRebuildScheduleCreate {
+	if u != nil {
+		ersc.SetID(*u)
+	}
+	return ersc
+}
+
+// Mutation returns the EnvRebuildScheduleMutation object of the builder.
+func (ersc *EnvRebuildScheduleCreate) Mutation() *EnvRebuildScheduleMutation {
+	return ersc.mutation
+}
+
+// Save creates the EnvRebuildSchedule in the database.
+func (ersc *EnvRebuildScheduleCreate) Save(ctx context.Context) (*EnvRebuildSchedule, error) {
+	ersc.defaults()
+	return withHooks(ctx, ersc.sqlSave, ersc.mutation, ersc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ersc *EnvRebuildScheduleCreate) SaveX(ctx context.Context) *EnvRebuildSchedule {
+	v, err := ersc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ersc *EnvRebuildScheduleCreate) Exec(ctx context.Context) error {
+	_, err := ersc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ersc *EnvRebuildScheduleCreate) ExecX(ctx context.Context) {
+	if err := ersc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ersc *EnvRebuildScheduleCreate) defaults() {
+	if _, ok := ersc.mutation.CreatedAt(); !ok {
+		v := envrebuildschedule.DefaultCreatedAt()
+		ersc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ersc.mutation.UpdatedAt(); !ok {
+		v := envrebuildschedule.DefaultUpdatedAt()
+		ersc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ersc.mutation.IntervalHours(); !ok {
+		v := envrebuildschedule.DefaultIntervalHours
+		ersc.mutation.SetIntervalHours(v)
+	}
+	if _, ok := ersc.mutation.UpgradePackages(); !ok {
+		v := envrebuildschedule.DefaultUpgradePackages
+		ersc.mutation.SetUpgradePackages(v)
+	}
+	if _, ok := ersc.mutation.ID(); !ok {
+		v := envrebuildschedule.DefaultID()
+		ersc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ersc *EnvRebuildScheduleCreate) check() error {
+	if _, ok := ersc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "EnvRebuildSchedule.created_at"`)}
+	}
+	if _, ok := ersc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`models: missing required field "EnvRebuildSchedule.updated_at"`)}
+	}
+	if _, ok := ersc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`models: missing required field "EnvRebuildSchedule.env_id"`)}
+	}
+	if _, ok := ersc.mutation.IntervalHours(); !ok {
+		return &ValidationError{Name: "interval_hours", err: errors.New(`models: missing required field "EnvRebuildSchedule.interval_hours"`)}
+	}
+	if _, ok := ersc.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`models: missing required field "EnvRebuildSchedule.next_run_at"`)}
+	}
+	if _, ok := ersc.mutation.UpgradePackages(); !ok {
+		return &ValidationError{Name: "upgrade_packages", err: errors.New(`models: missing required field "EnvRebuildSchedule.upgrade_packages"`)}
+	}
+	if v, ok := ersc.mutation.LastBuildStatus(); ok {
+		if err := envrebuildschedule.LastBuildStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_build_status", err: fmt.Errorf(`models: validator failed for field "EnvRebuildSchedule.last_build_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ersc *EnvRebuildScheduleCreate) sqlSave(ctx context.Context) (*EnvRebuildSchedule, error) {
+	if err := ersc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ersc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ersc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ersc.mutation.id = &_node.ID
+	ersc.mutation.done = true
+	return _node, nil
+}
+
+func (ersc *EnvRebuildScheduleCreate) createSpec() (*EnvRebuildSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvRebuildSchedule{config: ersc.config}
+		_spec = sqlgraph.NewCreateSpec(envrebuildschedule.Table, sqlgraph.NewFieldSpec(envrebuildschedule.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = ersc.schemaConfig.EnvRebuildSchedule
+	_spec.OnConflict = ersc.conflict
+	if id, ok := ersc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ersc.mutation.CreatedAt(); ok {
+		_spec.SetField(envrebuildschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ersc.mutation.UpdatedAt(); ok {
+		_spec.SetField(envrebuildschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ersc.mutation.EnvID(); ok {
+		_spec.SetField(envrebuildschedule.FieldEnvID, field.TypeString, value)
+		_node.EnvID = value
+	}
+	if value, ok := ersc.mutation.IntervalHours(); ok {
+		_spec.SetField(envrebuildschedule.FieldIntervalHours, field.TypeInt32, value)
+		_node.IntervalHours = value
+	}
+	if value, ok := ersc.mutation.NextRunAt(); ok {
+		_spec.SetField(envrebuildschedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	if value, ok := ersc.mutation.UpgradePackages(); ok {
+		_spec.SetField(envrebuildschedule.FieldUpgradePackages, field.TypeBool, value)
+		_node.UpgradePackages = value
+	}
+	if value, ok := ersc.mutation.WebhookURL(); ok {
+		_spec.SetField(envrebuildschedule.FieldWebhookURL, field.TypeString, value)
+		_node.WebhookURL = &value
+	}
+	if value, ok := ersc.mutation.WebhookSecret(); ok {
+		_spec.SetField(envrebuildschedule.FieldWebhookSecret, field.TypeString, value)
+		_node.WebhookSecret = &value
+	}
+	if value, ok := ersc.mutation.LastBuildID(); ok {
+		_spec.SetField(envrebuildschedule.FieldLastBuildID, field.TypeUUID, value)
+		_node.LastBuildID = &value
+	}
+	if value, ok := ersc.mutation.LastBuildStatus(); ok {
+		_spec.SetField(envrebuildschedule.FieldLastBuildStatus, field.TypeEnum, value)
+		_node.LastBuildStatus = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvRebuildSchedule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvRebuildScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ersc *EnvRebuildScheduleCreate) OnConflict(opts ...sql.ConflictOption) *EnvRebuildScheduleUpsertOne {
+	ersc.conflict = opts
+	return &EnvRebuildScheduleUpsertOne{
+		create: ersc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvRebuildSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ersc *EnvRebuildScheduleCreate) OnConflictColumns(columns ...string) *EnvRebuildScheduleUpsertOne {
+	ersc.conflict = append(ersc.conflict, sql.ConflictColumns(columns...))
+	return &EnvRebuildScheduleUpsertOne{
+		create: ersc,
+	}
+}
+
+type (
+	// EnvRebuildScheduleUpsertOne is the builder for "upsert"-ing
+	//  one EnvRebuildSchedule node.
+	EnvRebuildScheduleUpsertOne struct {
+		create *EnvRebuildScheduleCreate
+	}
+
+	// EnvRebuildScheduleUpsert is the "OnConflict" setter.
+	EnvRebuildScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvRebuildScheduleUpsert) SetUpdatedAt(v time.Time) *EnvRebuildScheduleUpsert {
+	u.Set(envrebuildschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsert) UpdateUpdatedAt() *EnvRebuildScheduleUpsert {
+	u.SetExcluded(envrebuildschedule.FieldUpdatedAt)
+	return u
+}
+
+// SetIntervalHours sets the "interval_hours" field.
+func (u *EnvRebuildScheduleUpsert) SetIntervalHours(v int32) *EnvRebuildScheduleUpsert {
+	u.Set(envrebuildschedule.FieldIntervalHours, v)
+	return u
+}
+
+// UpdateIntervalHours sets the "interval_hours" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsert) UpdateIntervalHours() *EnvRebuildScheduleUpsert {
+	u.SetExcluded(envrebuildschedule.FieldIntervalHours)
+	return u
+}
+
+// AddIntervalHours adds v to the "interval_hours" field.
+func (u *EnvRebuildScheduleUpsert) AddIntervalHours(v int32) *EnvRebuildScheduleUpsert {
+	u.Add(envrebuildschedule.FieldIntervalHours, v)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *EnvRebuildScheduleUpsert) SetNextRunAt(v time.Time) *EnvRebuildScheduleUpsert {
+	u.Set(envrebuildschedule.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsert) UpdateNextRunAt() *EnvRebuildScheduleUpsert {
+	u.SetExcluded(envrebuildschedule.FieldNextRunAt)
+	return u
+}
+
+// SetUpgradePackages sets the "upgrade_packages" field.
+func (u *EnvRebuildScheduleUpsert) SetUpgradePackages(v bool) *EnvRebuildScheduleUpsert {
+	u.Set(envrebuildschedule.FieldUpgradePackages, v)
+	return u
+}
+
+// UpdateUpgradePackages sets the "upgrade_packages" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsert) UpdateUpgradePackages() *EnvRebuildScheduleUpsert {
+	u.SetExcluded(envrebuildschedule.FieldUpgradePackages)
+	return u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *EnvRebuildScheduleUpsert) SetWebhookURL(v string) *EnvRebuildScheduleUpsert {
+	u.Set(envrebuildschedule.FieldWebhookURL, v)
+	return u
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsert) UpdateWebhookURL() *EnvRebuildScheduleUpsert {
+	u.SetExcluded(envrebuildschedule.FieldWebhookURL)
+	return u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *EnvRebuildScheduleUpsert) ClearWebhookURL() *EnvRebuildScheduleUpsert {
+	u.SetNull(envrebuildschedule.FieldWebhookURL)
+	return u
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (u *EnvRebuildScheduleUpsert) SetWebhookSecret(v string) *EnvRebuildScheduleUpsert {
+	u.Set(envrebuildschedule.FieldWebhookSecret, v)
+	return u
+}
+
+// UpdateWebhookSecret sets the "webhook_secret" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsert) UpdateWebhookSecret() *EnvRebuildScheduleUpsert {
+	u.SetExcluded(envrebuildschedule.FieldWebhookSecret)
+	return u
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (u *EnvRebuildScheduleUpsert) ClearWebhookSecret() *EnvRebuildScheduleUpsert {
+	u.SetNull(envrebuildschedule.FieldWebhookSecret)
+	return u
+}
+
+// SetLastBuildID sets the "last_build_id" field.
+func (u *EnvRebuildScheduleUpsert) SetLastBuildID(v uuid.UUID) *EnvRebuildScheduleUpsert {
+	u.Set(envrebuildschedule.FieldLastBuildID, v)
+	return u
+}
+
+// UpdateLastBuildID sets the "last_build_id" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsert) UpdateLastBuildID() *EnvRebuildScheduleUpsert {
+	u.SetExcluded(envrebuildschedule.FieldLastBuildID)
+	return u
+}
+
+// ClearLastBuildID clears the value of the "last_build_id" field.
+func (u *EnvRebuildScheduleUpsert) ClearLastBuildID() *EnvRebuildScheduleUpsert {
+	u.SetNull(envrebuildschedule.FieldLastBuildID)
+	return u
+}
+
+// SetLastBuildStatus sets the "last_build_status" field.
+func (u *EnvRebuildScheduleUpsert) SetLastBuildStatus(v envrebuildschedule.LastBuildStatus) *EnvRebuildScheduleUpsert {
+	u.Set(envrebuildschedule.FieldLastBuildStatus, v)
+	return u
+}
+
+// UpdateLastBuildStatus sets the "last_build_status" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsert) UpdateLastBuildStatus() *EnvRebuildScheduleUpsert {
+	u.SetExcluded(envrebuildschedule.FieldLastBuildStatus)
+	return u
+}
+
+// ClearLastBuildStatus clears the value of the "last_build_status" field.
+func (u *EnvRebuildScheduleUpsert) ClearLastBuildStatus() *EnvRebuildScheduleUpsert {
+	u.SetNull(envrebuildschedule.FieldLastBuildStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EnvRebuildSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(envrebuildschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EnvRebuildScheduleUpsertOne) UpdateNewValues() *EnvRebuildScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(envrebuildschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(envrebuildschedule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.EnvID(); exists {
+			s.SetIgnore(envrebuildschedule.FieldEnvID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvRebuildSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EnvRebuildScheduleUpsertOne) Ignore() *EnvRebuildScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvRebuildScheduleUpsertOne) DoNothing() *EnvRebuildScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvRebuildScheduleCreate.OnConflict
+// documentation for more info.
+func (u *EnvRebuildScheduleUpsertOne) Update(set func(*EnvRebuildScheduleUpsert)) *EnvRebuildScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvRebuildScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvRebuildScheduleUpsertOne) SetUpdatedAt(v time.Time) *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertOne) UpdateUpdatedAt() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetIntervalHours sets the "interval_hours" field.
+func (u *EnvRebuildScheduleUpsertOne) SetIntervalHours(v int32) *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetIntervalHours(v)
+	})
+}
+
+// AddIntervalHours adds v to the "interval_hours" field.
+func (u *EnvRebuildScheduleUpsertOne) AddIntervalHours(v int32) *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.AddIntervalHours(v)
+	})
+}
+
+// UpdateIntervalHours sets the "interval_hours" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertOne) UpdateIntervalHours() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateIntervalHours()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *EnvRebuildScheduleUpsertOne) SetNextRunAt(v time.Time) *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertOne) UpdateNextRunAt() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// SetUpgradePackages sets the "upgrade_packages" field.
+func (u *EnvRebuildScheduleUpsertOne) SetUpgradePackages(v bool) *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetUpgradePackages(v)
+	})
+}
+
+// UpdateUpgradePackages sets the "upgrade_packages" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertOne) UpdateUpgradePackages() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateUpgradePackages()
+	})
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *EnvRebuildScheduleUpsertOne) SetWebhookURL(v string) *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetWebhookURL(v)
+	})
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertOne) UpdateWebhookURL() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateWebhookURL()
+	})
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *EnvRebuildScheduleUpsertOne) ClearWebhookURL() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.ClearWebhookURL()
+	})
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (u *EnvRebuildScheduleUpsertOne) SetWebhookSecret(v string) *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetWebhookSecret(v)
+	})
+}
+
+// UpdateWebhookSecret sets the "webhook_secret" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertOne) UpdateWebhookSecret() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateWebhookSecret()
+	})
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (u *EnvRebuildScheduleUpsertOne) ClearWebhookSecret() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.ClearWebhookSecret()
+	})
+}
+
+// SetLastBuildID sets the "last_build_id" field.
+func (u *EnvRebuildScheduleUpsertOne) SetLastBuildID(v uuid.UUID) *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetLastBuildID(v)
+	})
+}
+
+// UpdateLastBuildID sets the "last_build_id" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertOne) UpdateLastBuildID() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateLastBuildID()
+	})
+}
+
+// ClearLastBuildID clears the value of the "last_build_id" field.
+func (u *EnvRebuildScheduleUpsertOne) ClearLastBuildID() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.ClearLastBuildID()
+	})
+}
+
+// SetLastBuildStatus sets the "last_build_status" field.
+func (u *EnvRebuildScheduleUpsertOne) SetLastBuildStatus(v envrebuildschedule.LastBuildStatus) *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetLastBuildStatus(v)
+	})
+}
+
+// UpdateLastBuildStatus sets the "last_build_status" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertOne) UpdateLastBuildStatus() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateLastBuildStatus()
+	})
+}
+
+// ClearLastBuildStatus clears the value of the "last_build_status" field.
+func (u *EnvRebuildScheduleUpsertOne) ClearLastBuildStatus() *EnvRebuildScheduleUpsertOne {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.ClearLastBuildStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *EnvRebuildScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for EnvRebuildScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvRebuildScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EnvRebuildScheduleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: EnvRebuildScheduleUpsertOne.ID is not supported by MySQL driver. Use EnvRebuildScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EnvRebuildScheduleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EnvRebuildScheduleCreateBulk is the builder for creating many EnvRebuildSchedule entities in bulk.
+type EnvRebuildScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*EnvRebuildScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EnvRebuildSchedule entities in the database.
+func (erscb *EnvRebuildScheduleCreateBulk) Save(ctx context.Context) ([]*EnvRebuildSchedule, error) {
+	if erscb.err != nil {
+		return nil, erscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(erscb.builders))
+	nodes := make([]*EnvRebuildSchedule, len(erscb.builders))
+	mutators := make([]Mutator, len(erscb.builders))
+	for i := range erscb.builders {
+		func(i int, root context.Context) {
+			builder := erscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvRebuildScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, erscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = erscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, erscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, erscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (erscb *EnvRebuildScheduleCreateBulk) SaveX(ctx context.Context) []*EnvRebuildSchedule {
+	v, err := erscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (erscb *EnvRebuildScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := erscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erscb *EnvRebuildScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := erscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvRebuildSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvRebuildScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (erscb *EnvRebuildScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *EnvRebuildScheduleUpsertBulk {
+	erscb.conflict = opts
+	return &EnvRebuildScheduleUpsertBulk{
+		create: erscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvRebuildSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (erscb *EnvRebuildScheduleCreateBulk) OnConflictColumns(columns ...string) *EnvRebuildScheduleUpsertBulk {
+	erscb.conflict = append(erscb.conflict, sql.ConflictColumns(columns...))
+	return &EnvRebuildScheduleUpsertBulk{
+		create: erscb,
+	}
+}
+
+// EnvRebuildScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of EnvRebuildSchedule nodes.
+type EnvRebuildScheduleUpsertBulk struct {
+	create *EnvRebuildScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EnvRebuildSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(envrebuildschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EnvRebuildScheduleUpsertBulk) UpdateNewValues() *EnvRebuildScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(envrebuildschedule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(envrebuildschedule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.EnvID(); exists {
+				s.SetIgnore(envrebuildschedule.FieldEnvID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvRebuildSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EnvRebuildScheduleUpsertBulk) Ignore() *EnvRebuildScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvRebuildScheduleUpsertBulk) DoNothing() *EnvRebuildScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvRebuildScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *EnvRebuildScheduleUpsertBulk) Update(set func(*EnvRebuildScheduleUpsert)) *EnvRebuildScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvRebuildScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvRebuildScheduleUpsertBulk) SetUpdatedAt(v time.Time) *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertBulk) UpdateUpdatedAt() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetIntervalHours sets the "interval_hours" field.
+func (u *EnvRebuildScheduleUpsertBulk) SetIntervalHours(v int32) *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetIntervalHours(v)
+	})
+}
+
+// AddIntervalHours adds v to the "interval_hours" field.
+func (u *EnvRebuildScheduleUpsertBulk) AddIntervalHours(v int32) *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.AddIntervalHours(v)
+	})
+}
+
+// UpdateIntervalHours sets the "interval_hours" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertBulk) UpdateIntervalHours() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateIntervalHours()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *EnvRebuildScheduleUpsertBulk) SetNextRunAt(v time.Time) *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertBulk) UpdateNextRunAt() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// SetUpgradePackages sets the "upgrade_packages" field.
+func (u *EnvRebuildScheduleUpsertBulk) SetUpgradePackages(v bool) *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetUpgradePackages(v)
+	})
+}
+
+// UpdateUpgradePackages sets the "upgrade_packages" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertBulk) UpdateUpgradePackages() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateUpgradePackages()
+	})
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *EnvRebuildScheduleUpsertBulk) SetWebhookURL(v string) *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetWebhookURL(v)
+	})
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertBulk) UpdateWebhookURL() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateWebhookURL()
+	})
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *EnvRebuildScheduleUpsertBulk) ClearWebhookURL() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.ClearWebhookURL()
+	})
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (u *EnvRebuildScheduleUpsertBulk) SetWebhookSecret(v string) *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetWebhookSecret(v)
+	})
+}
+
+// UpdateWebhookSecret sets the "webhook_secret" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertBulk) UpdateWebhookSecret() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateWebhookSecret()
+	})
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (u *EnvRebuildScheduleUpsertBulk) ClearWebhookSecret() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.ClearWebhookSecret()
+	})
+}
+
+// SetLastBuildID sets the "last_build_id" field.
+func (u *EnvRebuildScheduleUpsertBulk) SetLastBuildID(v uuid.UUID) *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetLastBuildID(v)
+	})
+}
+
+// UpdateLastBuildID sets the "last_build_id" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertBulk) UpdateLastBuildID() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateLastBuildID()
+	})
+}
+
+// ClearLastBuildID clears the value of the "last_build_id" field.
+func (u *EnvRebuildScheduleUpsertBulk) ClearLastBuildID() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.ClearLastBuildID()
+	})
+}
+
+// SetLastBuildStatus sets the "last_build_status" field.
+func (u *EnvRebuildScheduleUpsertBulk) SetLastBuildStatus(v envrebuildschedule.LastBuildStatus) *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.SetLastBuildStatus(v)
+	})
+}
+
+// UpdateLastBuildStatus sets the "last_build_status" field to the value that was provided on create.
+func (u *EnvRebuildScheduleUpsertBulk) UpdateLastBuildStatus() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.UpdateLastBuildStatus()
+	})
+}
+
+// ClearLastBuildStatus clears the value of the "last_build_status" field.
+func (u *EnvRebuildScheduleUpsertBulk) ClearLastBuildStatus() *EnvRebuildScheduleUpsertBulk {
+	return u.Update(func(s *EnvRebuildScheduleUpsert) {
+		s.ClearLastBuildStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *EnvRebuildScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the EnvRebuildScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for EnvRebuildScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvRebuildScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// EnvRebuildScheduleDelete is the builder for deleting a EnvRebuildSchedule entity.
+type EnvRebuildScheduleDelete struct {
+	config
+	hooks    []Hook
+	mutation *EnvRebuildScheduleMutation
+}
+
+// Where appends a list predicates to the EnvRebuildScheduleDelete builder.
+func (ersd *EnvRebuildScheduleDelete) Where(ps ...predicate.EnvRebuildSchedule) *EnvRebuildScheduleDelete {
+	ersd.mutation.Where(ps...)
+	return ersd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ersd *EnvRebuildScheduleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ersd.sqlExec, ersd.mutation, ersd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ersd *EnvRebuildScheduleDelete) ExecX(ctx context.Context) int {
+	n, err := ersd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ersd *EnvRebuildScheduleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(envrebuildschedule.Table, sqlgraph.NewFieldSpec(envrebuildschedule.FieldID, field.TypeUUID))
+	_spec.Node.Schema = ersd.schemaConfig.EnvRebuildSchedule
+	ctx = internal.NewSchemaConfigContext(ctx, ersd.schemaConfig)
+	if ps := ersd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ersd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ersd.mutation.done = true
+	return affected, err
+}
+
+// EnvRebuildScheduleDeleteOne is the builder for deleting a single EnvRebuildSchedule entity.
+type EnvRebuildScheduleDeleteOne struct {
+	ersd *EnvRebuildScheduleDelete
+}
+
+// Where appends a list predicates to the EnvRebuildScheduleDelete builder.
+func (ersdo *EnvRebuildScheduleDeleteOne) Where(ps ...predicate.EnvRebuildSchedule) *EnvRebuildScheduleDeleteOne {
+	ersdo.ersd.mutation.Where(ps...)
+	return ersdo
+}
+
+// Exec executes the deletion query.
+func (ersdo *EnvRebuildScheduleDeleteOne) Exec(ctx context.Context) error {
+	n, err := ersdo.ersd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{envrebuildschedule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ersdo *EnvRebuildScheduleDeleteOne) ExecX(ctx context.Context) {
+	if err := ersdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
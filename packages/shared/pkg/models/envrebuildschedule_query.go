@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// EnvRebuildScheduleQuery is the builder for querying EnvRebuildSchedule entities.
+type EnvRebuildScheduleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []envrebuildschedule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EnvRebuildSchedule
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EnvRebuildScheduleQuery builder.
+func (ersq *EnvRebuildScheduleQuery) Where(ps ...predicate.EnvRebuildSchedule) *EnvRebuildScheduleQuery {
+	ersq.predicates = append(ersq.predicates, ps...)
+	return ersq
+}
+
+// Limit the number of records to be returned by this query.
+func (ersq *EnvRebuildScheduleQuery) Limit(limit int) *EnvRebuildScheduleQuery {
+	ersq.ctx.Limit = &limit
+	return ersq
+}
+
+// Offset to start from.
+func (ersq *EnvRebuildScheduleQuery) Offset(offset int) *EnvRebuildScheduleQuery {
+	ersq.ctx.Offset = &offset
+	return ersq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ersq *EnvRebuildScheduleQuery) Unique(unique bool) *EnvRebuildScheduleQuery {
+	ersq.ctx.Unique = &unique
+	return ersq
+}
+
+// Order specifies how the records should be ordered.
+func (ersq *EnvRebuildScheduleQuery) Order(o ...envrebuildschedule.OrderOption) *EnvRebuildScheduleQuery {
+	ersq.order = append(ersq.order, o...)
+	return ersq
+}
+
+// First returns the first EnvRebuildSchedule entity from the query.
+// Returns a *NotFoundError when no EnvRebuildSchedule was found.
+func (ersq *EnvRebuildScheduleQuery) First(ctx context.Context) (*EnvRebuildSchedule, error) {
+	nodes, err := ersq.Limit(1).All(setContextOp(ctx, ersq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{envrebuildschedule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ersq *EnvRebuildScheduleQuery) FirstX(ctx context.Context) *EnvRebuildSchedule {
+	node, err := ersq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EnvRebuildSchedule ID from the query.
+// Returns a *NotFoundError when no EnvRebuildSchedule ID was found.
+func (ersq *EnvRebuildScheduleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ersq.Limit(1).IDs(setContextOp(ctx, ersq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{envrebuildschedule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ersq *EnvRebuildScheduleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ersq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EnvRebuildSchedule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EnvRebuildSchedule entity is found.
+// Returns a *NotFoundError when no EnvRebuildSchedule entities are found.
+func (ersq *EnvRebuildScheduleQuery) Only(ctx context.Context) (*EnvRebuildSchedule, error) {
+	nodes, err := ersq.Limit(2).All(setContextOp(ctx, ersq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{envrebuildschedule.Label}
+	default:
+		return nil, &NotSingularError{envrebuildschedule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ersq *EnvRebuildScheduleQuery) OnlyX(ctx context.Context) *EnvRebuildSchedule {
+	node, err := ersq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EnvRebuildSchedule ID in the query.
+// Returns a *NotSingularError when more than one EnvRebuildSchedule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ersq *EnvRebuildScheduleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ersq.Limit(2).IDs(setContextOp(ctx, ersq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{envrebuildschedule.Label}
+	default:
+		err = &NotSingularError{envrebuildschedule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ersq *EnvRebuildScheduleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ersq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EnvRebuildSchedules.
+func (ersq *EnvRebuildScheduleQuery) All(ctx context.Context) ([]*EnvRebuildSchedule, error) {
+	ctx = setContextOp(ctx, ersq.ctx, "All")
+	if err := ersq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EnvRebuildSchedule, *EnvRebuildScheduleQuery]()
+	return withInterceptors[[]*EnvRebuildSchedule](ctx, ersq, qr, ersq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ersq *EnvRebuildScheduleQuery) AllX(ctx context.Context) []*EnvRebuildSchedule {
+	nodes, err := ersq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EnvRebuildSchedule IDs.
+func (ersq *EnvRebuildScheduleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ersq.ctx.Unique == nil && ersq.path != nil {
+		ersq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ersq.ctx, "IDs")
+	if err = ersq.Select(envrebuildschedule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ersq *EnvRebuildScheduleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ersq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ersq *EnvRebuildScheduleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ersq.ctx, "Count")
+	if err := ersq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ersq, querierCount[*EnvRebuildScheduleQuery](), ersq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ersq *EnvRebuildScheduleQuery) CountX(ctx context.Context) int {
+	count, err := ersq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ersq *EnvRebuildScheduleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ersq.ctx, "Exist")
+	switch _, err := ersq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ersq *EnvRebuildScheduleQuery) ExistX(ctx context.Context) bool {
+	exist, err := ersq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EnvRebuildScheduleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ersq *EnvRebuildScheduleQuery) Clone() *EnvRebuildScheduleQuery {
+	if ersq == nil {
+		return nil
+	}
+	return &EnvRebuildScheduleQuery{
+		config:     ersq.config,
+		ctx:        ersq.ctx.Clone(),
+		order:      append([]envrebuildschedule.OrderOption{}, ersq.order...),
+		inters:     append([]Interceptor{}, ersq.inters...),
+		predicates: append([]predicate.EnvRebuildSchedule{}, ersq.predicates...),
+		// clone intermediate query.
+		sql:  ersq.sql.Clone(),
+		path: ersq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EnvRebuildSchedule.Query().
+//		GroupBy(envrebuildschedule.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (ersq *EnvRebuildScheduleQuery) GroupBy(field string, fields ...string) *EnvRebuildScheduleGroupBy {
+	ersq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EnvRebuildScheduleGroupBy{build: ersq}
+	grbuild.flds = &ersq.ctx.Fields
+	grbuild.label = envrebuildschedule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.EnvRebuildSchedule.Query().
+//		Select(envrebuildschedule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ersq *EnvRebuildScheduleQuery) Select(fields ...string) *EnvRebuildScheduleSelect {
+	ersq.ctx.Fields = append(ersq.ctx.Fields, fields...)
+	sbuild := &EnvRebuildScheduleSelect{EnvRebuildScheduleQuery: ersq}
+	sbuild.label = envrebuildschedule.Label
+	sbuild.flds, sbuild.scan = &ersq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EnvRebuildScheduleSelect configured with the given aggregations.
+func (ersq *EnvRebuildScheduleQuery) Aggregate(fns ...AggregateFunc) *EnvRebuildScheduleSelect {
+	return ersq.Select().Aggregate(fns...)
+}
+
+func (ersq *EnvRebuildScheduleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ersq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ersq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ersq.ctx.Fields {
+		if !envrebuildschedule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if ersq.path != nil {
+		prev, err := ersq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ersq.sql = prev
+	}
+	return nil
+}
+
+func (ersq *EnvRebuildScheduleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EnvRebuildSchedule, error) {
+	var (
+		nodes = []*EnvRebuildSchedule{}
+		_spec = ersq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EnvRebuildSchedule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EnvRebuildSchedule{config: ersq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = ersq.schemaConfig.EnvRebuildSchedule
+	ctx = internal.NewSchemaConfigContext(ctx, ersq.schemaConfig)
+	if len(ersq.modifiers) > 0 {
+		_spec.Modifiers = ersq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ersq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ersq *EnvRebuildScheduleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ersq.querySpec()
+	_spec.Node.Schema = ersq.schemaConfig.EnvRebuildSchedule
+	ctx = internal.NewSchemaConfigContext(ctx, ersq.schemaConfig)
+	if len(ersq.modifiers) > 0 {
+		_spec.Modifiers = ersq.modifiers
+	}
+	_spec.Node.Columns = ersq.ctx.Fields
+	if len(ersq.ctx.Fields) > 0 {
+		_spec.Unique = ersq.ctx.Unique != nil && *ersq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ersq.driver, _spec)
+}
+
+func (ersq *EnvRebuildScheduleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(envrebuildschedule.Table, envrebuildschedule.Columns, sqlgraph.NewFieldSpec(envrebuildschedule.FieldID, field.TypeUUID))
+	_spec.From = ersq.sql
+	if unique := ersq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ersq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ersq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envrebuildschedule.FieldID)
+		for i := range fields {
+			if fields[i] != envrebuildschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ersq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ersq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ersq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ersq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ersq *EnvRebuildScheduleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ersq.driver.Dialect())
+	t1 := builder.Table(envrebuildschedule.Table)
+	columns := ersq.ctx.Fields
+	if len(columns) == 0 {
+		columns = envrebuildschedule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ersq.sql != nil {
+		selector = ersq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ersq.ctx.Unique != nil && *ersq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(ersq.schemaConfig.EnvRebuildSchedule)
+	ctx = internal.NewSchemaConfigContext(ctx, ersq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range ersq.modifiers {
+		m(selector)
+	}
+	for _, p := range ersq.predicates {
+		p(selector)
+	}
+	for _, p := range ersq.order {
+		p(selector)
+	}
+	if offset := ersq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ersq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ersq *EnvRebuildScheduleQuery) Modify(modifiers ...func(s *sql.Selector)) *EnvRebuildScheduleSelect {
+	ersq.modifiers = append(ersq.modifiers, modifiers...)
+	return ersq.Select()
+}
+
+// EnvRebuildScheduleGroupBy is the group-by builder for EnvRebuildSchedule entities.
+type EnvRebuildScheduleGroupBy struct {
+	selector
+	build *EnvRebuildScheduleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ersgb *EnvRebuildScheduleGroupBy) Aggregate(fns ...AggregateFunc) *EnvRebuildScheduleGroupBy {
+	ersgb.fns = append(ersgb.fns, fns...)
+	return ersgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ersgb *EnvRebuildScheduleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ersgb.build.ctx, "GroupBy")
+	if err := ersgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvRebuildScheduleQuery, *EnvRebuildScheduleGroupBy](ctx, ersgb.build, ersgb, ersgb.build.inters, v)
+}
+
+func (ersgb *EnvRebuildScheduleGroupBy) sqlScan(ctx context.Context, root *EnvRebuildScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ersgb.fns))
+	for _, fn := range ersgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ersgb.flds)+len(ersgb.fns))
+		for _, f := range *ersgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ersgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ersgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EnvRebuildScheduleSelect is the builder for selecting fields of EnvRebuildSchedule entities.
+type EnvRebuildScheduleSelect struct {
+	*EnvRebuildScheduleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (erss *EnvRebuildScheduleSelect) Aggregate(fns ...AggregateFunc) *EnvRebuildScheduleSelect {
+	erss.fns = append(erss.fns, fns...)
+	return erss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (erss *EnvRebuildScheduleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, erss.ctx, "Select")
+	if err := erss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvRebuildScheduleQuery, *EnvRebuildScheduleSelect](ctx, erss.EnvRebuildScheduleQuery, erss, erss.inters, v)
+}
+
+func (erss *EnvRebuildScheduleSelect) sqlScan(ctx context.Context, root *EnvRebuildScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(erss.fns))
+	for _, fn := range erss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*erss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := erss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (erss *EnvRebuildScheduleSelect) Modify(modifiers ...func(s *sql.Selector)) *EnvRebuildScheduleSelect {
+	erss.modifiers = append(erss.modifiers, modifiers...)
+	return erss
+}
@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// EnvRebuildScheduleUpdate is the builder for updating EnvRebuildSchedule entities.
+type EnvRebuildScheduleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EnvRebuildScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EnvRebuildScheduleUpdate builder.
+func (ersu *EnvRebuildScheduleUpdate) Where(ps ...predicate.EnvRebuildSchedule) *EnvRebuildScheduleUpdate {
+	ersu.mutation.Where(ps...)
+	return ersu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ersu *EnvRebuildScheduleUpdate) SetUpdatedAt(t time.Time) *EnvRebuildScheduleUpdate {
+	ersu.mutation.SetUpdatedAt(t)
+	return ersu
+}
+
+// SetIntervalHours sets the "interval_hours" field.
+func (ersu *EnvRebuildScheduleUpdate) SetIntervalHours(i int32) *EnvRebuildScheduleUpdate {
+	ersu.mutation.ResetIntervalHours()
+	ersu.mutation.SetIntervalHours(i)
+	return ersu
+}
+
+// SetNillableIntervalHours sets the "interval_hours" field if the given value is not nil.
+func (ersu *EnvRebuildScheduleUpdate) SetNillableIntervalHours(i *int32) *EnvRebuildScheduleUpdate {
+	if i != nil {
+		ersu.SetIntervalHours(*i)
+	}
+	return ersu
+}
+
+// AddIntervalHours adds i to the "interval_hours" field.
+func (ersu *EnvRebuildScheduleUpdate) AddIntervalHours(i int32) *EnvRebuildScheduleUpdate {
+	ersu.mutation.AddIntervalHours(i)
+	return ersu
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (ersu *EnvRebuildScheduleUpdate) SetNextRunAt(t time.Time) *EnvRebuildScheduleUpdate {
+	ersu.mutation.SetNextRunAt(t)
+	return ersu
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (ersu *EnvRebuildScheduleUpdate) SetNillableNextRunAt(t *time.Time) *EnvRebuildScheduleUpdate {
+	if t != nil {
+		ersu.SetNextRunAt(*t)
+	}
+	return ersu
+}
+
+// SetUpgradePackages sets the "upgrade_packages" field.
+func (ersu *EnvRebuildScheduleUpdate) SetUpgradePackages(b bool) *EnvRebuildScheduleUpdate {
+	ersu.mutation.SetUpgradePackages(b)
+	return ersu
+}
+
+// SetNillableUpgradePackages sets the "upgrade_packages" field if the given value is not nil.
+func (ersu *EnvRebuildScheduleUpdate) SetNillableUpgradePackages(b *bool) *EnvRebuildScheduleUpdate {
+	if b != nil {
+		ersu.SetUpgradePackages(*b)
+	}
+	return ersu
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (ersu *EnvRebuildScheduleUpdate) SetWebhookURL(s string) *EnvRebuildScheduleUpdate {
+	ersu.mutation.SetWebhookURL(s)
+	return ersu
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (ersu *EnvRebuildScheduleUpdate) SetNillableWebhookURL(s *string) *EnvRebuildScheduleUpdate {
+	if s != nil {
+		ersu.SetWebhookURL(*s)
+	}
+	return ersu
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (ersu *EnvRebuildScheduleUpdate) ClearWebhookURL() *EnvRebuildScheduleUpdate {
+	ersu.mutation.ClearWebhookURL()
+	return ersu
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (ersu *EnvRebuildScheduleUpdate) SetWebhookSecret(s string) *EnvRebuildScheduleUpdate {
+	ersu.mutation.SetWebhookSecret(s)
+	return ersu
+}
+
+// SetNillableWebhookSecret sets the "webhook_secret" field if the given value is not nil.
+func (ersu *EnvRebuildScheduleUpdate) SetNillableWebhookSecret(s *string) *EnvRebuildScheduleUpdate {
+	if s != nil {
+		ersu.SetWebhookSecret(*s)
+	}
+	return ersu
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (ersu *EnvRebuildScheduleUpdate) ClearWebhookSecret() *EnvRebuildScheduleUpdate {
+	ersu.mutation.ClearWebhookSecret()
+	return ersu
+}
+
+// SetLastBuildID sets the "last_build_id" field.
+func (ersu *EnvRebuildScheduleUpdate) SetLastBuildID(u uuid.UUID) *EnvRebuildScheduleUpdate {
+	ersu.mutation.SetLastBuildID(u)
+	return ersu
+}
+
+// SetNillableLastBuildID sets the "last_build_id" field if the given value is not nil.
+func (ersu *EnvRebuildScheduleUpdate) SetNillableLastBuildID(u *uuid.UUID) *EnvRebuildScheduleUpdate {
+	if u != nil {
+		ersu.SetLastBuildID(*u)
+	}
+	return ersu
+}
+
+// ClearLastBuildID clears the value of the "last_build_id" field.
+func (ersu *EnvRebuildScheduleUpdate) ClearLastBuildID() *EnvRebuildScheduleUpdate {
+	ersu.mutation.ClearLastBuildID()
+	return ersu
+}
+
+// SetLastBuildStatus sets the "last_build_status" field.
+func (ersu *EnvRebuildScheduleUpdate) SetLastBuildStatus(ebs envrebuildschedule.LastBuildStatus) *EnvRebuildScheduleUpdate {
+	ersu.mutation.SetLastBuildStatus(ebs)
+	return ersu
+}
+
+// SetNillableLastBuildStatus sets the "last_build_status" field if the given value is not nil.
+func (ersu *EnvRebuildScheduleUpdate) SetNillableLastBuildStatus(ebs *envrebuildschedule.LastBuildStatus) *EnvRebuildScheduleUpdate {
+	if ebs != nil {
+		ersu.SetLastBuildStatus(*ebs)
+	}
+	return ersu
+}
+
+// ClearLastBuildStatus clears the value of the "last_build_status" field.
+func (ersu *EnvRebuildScheduleUpdate) ClearLastBuildStatus() *EnvRebuildScheduleUpdate {
+	ersu.mutation.ClearLastBuildStatus()
+	return ersu
+}
+
+// Mutation returns the EnvRebuildScheduleMutation object of the builder.
+func (ersu *EnvRebuildScheduleUpdate) Mutation() *EnvRebuildScheduleMutation {
+	return ersu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ersu *EnvRebuildScheduleUpdate) Save(ctx context.Context) (int, error) {
+	ersu.defaults()
+	return withHooks(ctx, ersu.sqlSave, ersu.mutation, ersu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ersu *EnvRebuildScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := ersu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ersu *EnvRebuildScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := ersu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ersu *EnvRebuildScheduleUpdate) ExecX(ctx context.Context) {
+	if err := ersu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ersu *EnvRebuildScheduleUpdate) defaults() {
+	if _, ok := ersu.mutation.UpdatedAt(); !ok {
+		v := envrebuildschedule.UpdateDefaultUpdatedAt()
+		ersu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ersu *EnvRebuildScheduleUpdate) check() error {
+	if v, ok := ersu.mutation.LastBuildStatus(); ok {
+		if err := envrebuildschedule.LastBuildStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_build_status", err: fmt.Errorf(`models: validator failed for field "EnvRebuildSchedule.last_build_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ersu *EnvRebuildScheduleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnvRebuildScheduleUpdate {
+	ersu.modifiers = append(ersu.modifiers, modifiers...)
+	return ersu
+}
+
+func (ersu *EnvRebuildScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ersu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envrebuildschedule.Table, envrebuildschedule.Columns, sqlgraph.NewFieldSpec(envrebuildschedule.FieldID, field.TypeUUID))
+	if ps := ersu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ersu.mutation.UpdatedAt(); ok {
+		_spec.SetField(envrebuildschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ersu.mutation.IntervalHours(); ok {
+		_spec.SetField(envrebuildschedule.FieldIntervalHours, field.TypeInt32, value)
+	}
+	if value, ok := ersu.mutation.AddedIntervalHours(); ok {
+		_spec.AddField(envrebuildschedule.FieldIntervalHours, field.TypeInt32, value)
+	}
+	if value, ok := ersu.mutation.NextRunAt(); ok {
+		_spec.SetField(envrebuildschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := ersu.mutation.UpgradePackages(); ok {
+		_spec.SetField(envrebuildschedule.FieldUpgradePackages, field.TypeBool, value)
+	}
+	if value, ok := ersu.mutation.WebhookURL(); ok {
+		_spec.SetField(envrebuildschedule.FieldWebhookURL, field.TypeString, value)
+	}
+	if ersu.mutation.WebhookURLCleared() {
+		_spec.ClearField(envrebuildschedule.FieldWebhookURL, field.TypeString)
+	}
+	if value, ok := ersu.mutation.WebhookSecret(); ok {
+		_spec.SetField(envrebuildschedule.FieldWebhookSecret, field.TypeString, value)
+	}
+	if ersu.mutation.WebhookSecretCleared() {
+		_spec.ClearField(envrebuildschedule.FieldWebhookSecret, field.TypeString)
+	}
+	if value, ok := ersu.mutation.LastBuildID(); ok {
+		_spec.SetField(envrebuildschedule.FieldLastBuildID, field.TypeUUID, value)
+	}
+	if ersu.mutation.LastBuildIDCleared() {
+		_spec.ClearField(envrebuildschedule.FieldLastBuildID, field.TypeUUID)
+	}
+	if value, ok := ersu.mutation.LastBuildStatus(); ok {
+		_spec.SetField(envrebuildschedule.FieldLastBuildStatus, field.TypeEnum, value)
+	}
+	if ersu.mutation.LastBuildStatusCleared() {
+		_spec.ClearField(envrebuildschedule.FieldLastBuildStatus, field.TypeEnum)
+	}
+	_spec.Node.Schema = ersu.schemaConfig.EnvRebuildSchedule
+	ctx = internal.NewSchemaConfigContext(ctx, ersu.schemaConfig)
+	_spec.AddModifiers(ersu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ersu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envrebuildschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ersu.mutation.done = true
+	return n, nil
+}
+
+// EnvRebuildScheduleUpdateOne is the builder for updating a single EnvRebuildSchedule entity.
+type EnvRebuildScheduleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EnvRebuildScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetUpdatedAt(t time.Time) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.SetUpdatedAt(t)
+	return ersuo
+}
+
+// SetIntervalHours sets the "interval_hours" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetIntervalHours(i int32) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.ResetIntervalHours()
+	ersuo.mutation.SetIntervalHours(i)
+	return ersuo
+}
+
+// SetNillableIntervalHours sets the "interval_hours" field if the given value is not nil.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetNillableIntervalHours(i *int32) *EnvRebuildScheduleUpdateOne {
+	if i != nil {
+		ersuo.SetIntervalHours(*i)
+	}
+	return ersuo
+}
+
+// AddIntervalHours adds i to the "interval_hours" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) AddIntervalHours(i int32) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.AddIntervalHours(i)
+	return ersuo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetNextRunAt(t time.Time) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.SetNextRunAt(t)
+	return ersuo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetNillableNextRunAt(t *time.Time) *EnvRebuildScheduleUpdateOne {
+	if t != nil {
+		ersuo.SetNextRunAt(*t)
+	}
+	return ersuo
+}
+
+// SetUpgradePackages sets the "upgrade_packages" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetUpgradePackages(b bool) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.SetUpgradePackages(b)
+	return ersuo
+}
+
+// SetNillableUpgradePackages sets the "upgrade_packages" field if the given value is not nil.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetNillableUpgradePackages(b *bool) *EnvRebuildScheduleUpdateOne {
+	if b != nil {
+		ersuo.SetUpgradePackages(*b)
+	}
+	return ersuo
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetWebhookURL(s string) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.SetWebhookURL(s)
+	return ersuo
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetNillableWebhookURL(s *string) *EnvRebuildScheduleUpdateOne {
+	if s != nil {
+		ersuo.SetWebhookURL(*s)
+	}
+	return ersuo
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) ClearWebhookURL() *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.ClearWebhookURL()
+	return ersuo
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetWebhookSecret(s string) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.SetWebhookSecret(s)
+	return ersuo
+}
+
+// SetNillableWebhookSecret sets the "webhook_secret" field if the given value is not nil.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetNillableWebhookSecret(s *string) *EnvRebuildScheduleUpdateOne {
+	if s != nil {
+		ersuo.SetWebhookSecret(*s)
+	}
+	return ersuo
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) ClearWebhookSecret() *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.ClearWebhookSecret()
+	return ersuo
+}
+
+// SetLastBuildID sets the "last_build_id" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetLastBuildID(u uuid.UUID) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.SetLastBuildID(u)
+	return ersuo
+}
+
+// SetNillableLastBuildID sets the "last_build_id" field if the given value is not nil.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetNillableLastBuildID(u *uuid.UUID) *EnvRebuildScheduleUpdateOne {
+	if u != nil {
+		ersuo.SetLastBuildID(*u)
+	}
+	return ersuo
+}
+
+// ClearLastBuildID clears the value of the "last_build_id" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) ClearLastBuildID() *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.ClearLastBuildID()
+	return ersuo
+}
+
+// SetLastBuildStatus sets the "last_build_status" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetLastBuildStatus(ebs envrebuildschedule.LastBuildStatus) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.SetLastBuildStatus(ebs)
+	return ersuo
+}
+
+// SetNillableLastBuildStatus sets the "last_build_status" field if the given value is not nil.
+func (ersuo *EnvRebuildScheduleUpdateOne) SetNillableLastBuildStatus(ebs *envrebuildschedule.LastBuildStatus) *EnvRebuildScheduleUpdateOne {
+	if ebs != nil {
+		ersuo.SetLastBuildStatus(*ebs)
+	}
+	return ersuo
+}
+
+// ClearLastBuildStatus clears the value of the "last_build_status" field.
+func (ersuo *EnvRebuildScheduleUpdateOne) ClearLastBuildStatus() *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.ClearLastBuildStatus()
+	return ersuo
+}
+
+// Mutation returns the EnvRebuildScheduleMutation object of the builder.
+func (ersuo *EnvRebuildScheduleUpdateOne) Mutation() *EnvRebuildScheduleMutation {
+	return ersuo.mutation
+}
+
+// Where appends a list predicates to the EnvRebuildScheduleUpdate builder.
+func (ersuo *EnvRebuildScheduleUpdateOne) Where(ps ...predicate.EnvRebuildSchedule) *EnvRebuildScheduleUpdateOne {
+	ersuo.mutation.Where(ps...)
+	return ersuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ersuo *EnvRebuildScheduleUpdateOne) Select(field string, fields ...string) *EnvRebuildScheduleUpdateOne {
+	ersuo.fields = append([]string{field}, fields...)
+	return ersuo
+}
+
+// Save executes the query and returns the updated EnvRebuildSchedule entity.
+func (ersuo *EnvRebuildScheduleUpdateOne) Save(ctx context.Context) (*EnvRebuildSchedule, error) {
+	ersuo.defaults()
+	return withHooks(ctx, ersuo.sqlSave, ersuo.mutation, ersuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ersuo *EnvRebuildScheduleUpdateOne) SaveX(ctx context.Context) *EnvRebuildSchedule {
+	node, err := ersuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ersuo *EnvRebuildScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := ersuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ersuo *EnvRebuildScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := ersuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ersuo *EnvRebuildScheduleUpdateOne) defaults() {
+	if _, ok := ersuo.mutation.UpdatedAt(); !ok {
+		v := envrebuildschedule.UpdateDefaultUpdatedAt()
+		ersuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ersuo *EnvRebuildScheduleUpdateOne) check() error {
+	if v, ok := ersuo.mutation.LastBuildStatus(); ok {
+		if err := envrebuildschedule.LastBuildStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_build_status", err: fmt.Errorf(`models: validator failed for field "EnvRebuildSchedule.last_build_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ersuo *EnvRebuildScheduleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnvRebuildScheduleUpdateOne {
+	ersuo.modifiers = append(ersuo.modifiers, modifiers...)
+	return ersuo
+}
+
+func (ersuo *EnvRebuildScheduleUpdateOne) sqlSave(ctx context.Context) (_node *EnvRebuildSchedule, err error) {
+	if err := ersuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envrebuildschedule.Table, envrebuildschedule.Columns, sqlgraph.NewFieldSpec(envrebuildschedule.FieldID, field.TypeUUID))
+	id, ok := ersuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "EnvRebuildSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ersuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envrebuildschedule.FieldID)
+		for _, f := range fields {
+			if !envrebuildschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != envrebuildschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ersuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ersuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(envrebuildschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ersuo.mutation.IntervalHours(); ok {
+		_spec.SetField(envrebuildschedule.FieldIntervalHours, field.TypeInt32, value)
+	}
+	if value, ok := ersuo.mutation.AddedIntervalHours(); ok {
+		_spec.AddField(envrebuildschedule.FieldIntervalHours, field.TypeInt32, value)
+	}
+	if value, ok := ersuo.mutation.NextRunAt(); ok {
+		_spec.SetField(envrebuildschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := ersuo.mutation.UpgradePackages(); ok {
+		_spec.SetField(envrebuildschedule.FieldUpgradePackages, field.TypeBool, value)
+	}
+	if value, ok := ersuo.mutation.WebhookURL(); ok {
+		_spec.SetField(envrebuildschedule.FieldWebhookURL, field.TypeString, value)
+	}
+	if ersuo.mutation.WebhookURLCleared() {
+		_spec.ClearField(envrebuildschedule.FieldWebhookURL, field.TypeString)
+	}
+	if value, ok := ersuo.mutation.WebhookSecret(); ok {
+		_spec.SetField(envrebuildschedule.FieldWebhookSecret, field.TypeString, value)
+	}
+	if ersuo.mutation.WebhookSecretCleared() {
+		_spec.ClearField(envrebuildschedule.FieldWebhookSecret, field.TypeString)
+	}
+	if value, ok := ersuo.mutation.LastBuildID(); ok {
+		_spec.SetField(envrebuildschedule.FieldLastBuildID, field.TypeUUID, value)
+	}
+	if ersuo.mutation.LastBuildIDCleared() {
+		_spec.ClearField(envrebuildschedule.FieldLastBuildID, field.TypeUUID)
+	}
+	if value, ok := ersuo.mutation.LastBuildStatus(); ok {
+		_spec.SetField(envrebuildschedule.FieldLastBuildStatus, field.TypeEnum, value)
+	}
+	if ersuo.mutation.LastBuildStatusCleared() {
+		_spec.ClearField(envrebuildschedule.FieldLastBuildStatus, field.TypeEnum)
+	}
+	_spec.Node.Schema = ersuo.schemaConfig.EnvRebuildSchedule
+	ctx = internal.NewSchemaConfigContext(ctx, ersuo.schemaConfig)
+	_spec.AddModifiers(ersuo.modifiers...)
+	_node = &EnvRebuildSchedule{config: ersuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ersuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envrebuildschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ersuo.mutation.done = true
+	return _node, nil
+}
@@ -57,6 +57,90 @@ func (f EnvBuildFunc) Mutate(ctx context.Context, m models.Mutation) (models.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.EnvBuildMutation", m)
 }
 
+// The EnvBuildLogFunc type is an adapter to allow the use of ordinary
+// function as EnvBuildLog mutator.
+type EnvBuildLogFunc func(context.Context, *models.EnvBuildLogMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnvBuildLogFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.EnvBuildLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.EnvBuildLogMutation", m)
+}
+
+// The EnvRebuildScheduleFunc type is an adapter to allow the use of ordinary
+// function as EnvRebuildSchedule mutator.
+type EnvRebuildScheduleFunc func(context.Context, *models.EnvRebuildScheduleMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnvRebuildScheduleFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.EnvRebuildScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.EnvRebuildScheduleMutation", m)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *models.IdempotencyKeyMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.IdempotencyKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.IdempotencyKeyMutation", m)
+}
+
+// The KernelFunc type is an adapter to allow the use of ordinary
+// function as Kernel mutator.
+type KernelFunc func(context.Context, *models.KernelMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KernelFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.KernelMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.KernelMutation", m)
+}
+
+// The PinnedBuildFunc type is an adapter to allow the use of ordinary
+// function as PinnedBuild mutator.
+type PinnedBuildFunc func(context.Context, *models.PinnedBuildMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PinnedBuildFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.PinnedBuildMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.PinnedBuildMutation", m)
+}
+
+// The SandboxFunc type is an adapter to allow the use of ordinary
+// function as Sandbox mutator.
+type SandboxFunc func(context.Context, *models.SandboxMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SandboxFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.SandboxMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.SandboxMutation", m)
+}
+
+// The SandboxStartFunc type is an adapter to allow the use of ordinary
+// function as SandboxStart mutator.
+type SandboxStartFunc func(context.Context, *models.SandboxStartMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SandboxStartFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.SandboxStartMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.SandboxStartMutation", m)
+}
+
 // The SnapshotFunc type is an adapter to allow the use of ordinary
 // function as Snapshot mutator.
 type SnapshotFunc func(context.Context, *models.SnapshotMutation) (models.Value, error)
@@ -93,6 +177,78 @@ func (f TeamAPIKeyFunc) Mutate(ctx context.Context, m models.Mutation) (models.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TeamAPIKeyMutation", m)
 }
 
+// The TeamBudgetFunc type is an adapter to allow the use of ordinary
+// function as TeamBudget mutator.
+type TeamBudgetFunc func(context.Context, *models.TeamBudgetMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamBudgetFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.TeamBudgetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TeamBudgetMutation", m)
+}
+
+// The TeamRegistryCredentialFunc type is an adapter to allow the use of ordinary
+// function as TeamRegistryCredential mutator.
+type TeamRegistryCredentialFunc func(context.Context, *models.TeamRegistryCredentialMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamRegistryCredentialFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.TeamRegistryCredentialMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TeamRegistryCredentialMutation", m)
+}
+
+// The TeamSecretFunc type is an adapter to allow the use of ordinary
+// function as TeamSecret mutator.
+type TeamSecretFunc func(context.Context, *models.TeamSecretMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamSecretFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.TeamSecretMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TeamSecretMutation", m)
+}
+
+// The TeamSecretVersionFunc type is an adapter to allow the use of ordinary
+// function as TeamSecretVersion mutator.
+type TeamSecretVersionFunc func(context.Context, *models.TeamSecretVersionMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamSecretVersionFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.TeamSecretVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TeamSecretVersionMutation", m)
+}
+
+// The TeamTemplateStorageFunc type is an adapter to allow the use of ordinary
+// function as TeamTemplateStorage mutator.
+type TeamTemplateStorageFunc func(context.Context, *models.TeamTemplateStorageMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamTemplateStorageFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.TeamTemplateStorageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TeamTemplateStorageMutation", m)
+}
+
+// The TeamUsageDailyFunc type is an adapter to allow the use of ordinary
+// function as TeamUsageDaily mutator.
+type TeamUsageDailyFunc func(context.Context, *models.TeamUsageDailyMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamUsageDailyFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.TeamUsageDailyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TeamUsageDailyMutation", m)
+}
+
 // The TierFunc type is an adapter to allow the use of ordinary
 // function as Tier mutator.
 type TierFunc func(context.Context, *models.TierMutation) (models.Value, error)
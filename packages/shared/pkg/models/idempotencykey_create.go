@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/idempotencykey"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyCreate is the builder for creating a IdempotencyKey entity.
+type IdempotencyKeyCreate struct {
+	config
+	mutation *IdempotencyKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ikc *IdempotencyKeyCreate) SetCreatedAt(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetCreatedAt(t)
+	return ikc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableCreatedAt(t *time.Time) *IdempotencyKeyCreate {
+	if t != nil {
+		ikc.SetCreatedAt(*t)
+	}
+	return ikc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ikc *IdempotencyKeyCreate) SetExpiresAt(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetExpiresAt(t)
+	return ikc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ikc *IdempotencyKeyCreate) SetOwnerID(u uuid.UUID) *IdempotencyKeyCreate {
+	ikc.mutation.SetOwnerID(u)
+	return ikc
+}
+
+// SetKey sets the "key" field.
+func (ikc *IdempotencyKeyCreate) SetKey(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetKey(s)
+	return ikc
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (ikc *IdempotencyKeyCreate) SetRequestHash(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetRequestHash(s)
+	return ikc
+}
+
+// SetStatusCode sets the "status_code" field.
+func (ikc *IdempotencyKeyCreate) SetStatusCode(i int) *IdempotencyKeyCreate {
+	ikc.mutation.SetStatusCode(i)
+	return ikc
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableStatusCode(i *int) *IdempotencyKeyCreate {
+	if i != nil {
+		ikc.SetStatusCode(*i)
+	}
+	return ikc
+}
+
+// SetResponse sets the "response" field.
+func (ikc *IdempotencyKeyCreate) SetResponse(b []byte) *IdempotencyKeyCreate {
+	ikc.mutation.SetResponse(b)
+	return ikc
+}
+
+// SetID sets the "id" field.
+func (ikc *IdempotencyKeyCreate) SetID(u uuid.UUID) *IdempotencyKeyCreate {
+	ikc.mutation.SetID(u)
+	return ikc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableID(u *uuid.UUID) *IdempotencyKeyCreate {
+	if u != nil {
+		ikc.SetID(*u)
+	}
+	return ikc
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikc *IdempotencyKeyCreate) Mutation() *IdempotencyKeyMutation {
+	return ikc.mutation
+}
+
+// Save creates the IdempotencyKey in the database.
+func (ikc *IdempotencyKeyCreate) Save(ctx context.Context) (*IdempotencyKey, error) {
+	ikc.defaults()
+	return withHooks(ctx, ikc.sqlSave, ikc.mutation, ikc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ikc *IdempotencyKeyCreate) SaveX(ctx context.Context) *IdempotencyKey {
+	v, err := ikc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikc *IdempotencyKeyCreate) Exec(ctx context.Context) error {
+	_, err := ikc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikc *IdempotencyKeyCreate) ExecX(ctx context.Context) {
+	if err := ikc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ikc *IdempotencyKeyCreate) defaults() {
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		v := idempotencykey.DefaultCreatedAt()
+		ikc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ikc.mutation.ID(); !ok {
+		v := idempotencykey.DefaultID()
+		ikc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikc *IdempotencyKeyCreate) check() error {
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "IdempotencyKey.created_at"`)}
+	}
+	if _, ok := ikc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`models: missing required field "IdempotencyKey.expires_at"`)}
+	}
+	if _, ok := ikc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`models: missing required field "IdempotencyKey.owner_id"`)}
+	}
+	if _, ok := ikc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`models: missing required field "IdempotencyKey.key"`)}
+	}
+	if _, ok := ikc.mutation.RequestHash(); !ok {
+		return &ValidationError{Name: "request_hash", err: errors.New(`models: missing required field "IdempotencyKey.request_hash"`)}
+	}
+	return nil
+}
+
+func (ikc *IdempotencyKeyCreate) sqlSave(ctx context.Context) (*IdempotencyKey, error) {
+	if err := ikc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ikc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ikc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ikc.mutation.id = &_node.ID
+	ikc.mutation.done = true
+	return _node, nil
+}
+
+func (ikc *IdempotencyKeyCreate) createSpec() (*IdempotencyKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IdempotencyKey{config: ikc.config}
+		_spec = sqlgraph.NewCreateSpec(idempotencykey.Table, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = ikc.schemaConfig.IdempotencyKey
+	_spec.OnConflict = ikc.conflict
+	if id, ok := ikc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ikc.mutation.CreatedAt(); ok {
+		_spec.SetField(idempotencykey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ikc.mutation.ExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := ikc.mutation.OwnerID(); ok {
+		_spec.SetField(idempotencykey.FieldOwnerID, field.TypeUUID, value)
+		_node.OwnerID = value
+	}
+	if value, ok := ikc.mutation.Key(); ok {
+		_spec.SetField(idempotencykey.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := ikc.mutation.RequestHash(); ok {
+		_spec.SetField(idempotencykey.FieldRequestHash, field.TypeString, value)
+		_node.RequestHash = value
+	}
+	if value, ok := ikc.mutation.StatusCode(); ok {
+		_spec.SetField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = &value
+	}
+	if value, ok := ikc.mutation.Response(); ok {
+		_spec.SetField(idempotencykey.FieldResponse, field.TypeBytes, value)
+		_node.Response = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IdempotencyKey.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IdempotencyKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ikc *IdempotencyKeyCreate) OnConflict(opts ...sql.ConflictOption) *IdempotencyKeyUpsertOne {
+	ikc.conflict = opts
+	return &IdempotencyKeyUpsertOne{
+		create: ikc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ikc *IdempotencyKeyCreate) OnConflictColumns(columns ...string) *IdempotencyKeyUpsertOne {
+	ikc.conflict = append(ikc.conflict, sql.ConflictColumns(columns...))
+	return &IdempotencyKeyUpsertOne{
+		create: ikc,
+	}
+}
+
+type (
+	// IdempotencyKeyUpsertOne is the builder for "upsert"-ing
+	//  one IdempotencyKey node.
+	IdempotencyKeyUpsertOne struct {
+		create *IdempotencyKeyCreate
+	}
+
+	// IdempotencyKeyUpsert is the "OnConflict" setter.
+	IdempotencyKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatusCode sets the "status_code" field.
+func (u *IdempotencyKeyUpsert) SetStatusCode(v int) *IdempotencyKeyUpsert {
+	u.Set(idempotencykey.FieldStatusCode, v)
+	return u
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsert) UpdateStatusCode() *IdempotencyKeyUpsert {
+	u.SetExcluded(idempotencykey.FieldStatusCode)
+	return u
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *IdempotencyKeyUpsert) AddStatusCode(v int) *IdempotencyKeyUpsert {
+	u.Add(idempotencykey.FieldStatusCode, v)
+	return u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (u *IdempotencyKeyUpsert) ClearStatusCode() *IdempotencyKeyUpsert {
+	u.SetNull(idempotencykey.FieldStatusCode)
+	return u
+}
+
+// SetResponse sets the "response" field.
+func (u *IdempotencyKeyUpsert) SetResponse(v []byte) *IdempotencyKeyUpsert {
+	u.Set(idempotencykey.FieldResponse, v)
+	return u
+}
+
+// UpdateResponse sets the "response" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsert) UpdateResponse() *IdempotencyKeyUpsert {
+	u.SetExcluded(idempotencykey.FieldResponse)
+	return u
+}
+
+// ClearResponse clears the value of the "response" field.
+func (u *IdempotencyKeyUpsert) ClearResponse() *IdempotencyKeyUpsert {
+	u.SetNull(idempotencykey.FieldResponse)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(idempotencykey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IdempotencyKeyUpsertOne) UpdateNewValues() *IdempotencyKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(idempotencykey.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(idempotencykey.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(idempotencykey.FieldExpiresAt)
+		}
+		if _, exists := u.create.mutation.OwnerID(); exists {
+			s.SetIgnore(idempotencykey.FieldOwnerID)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(idempotencykey.FieldKey)
+		}
+		if _, exists := u.create.mutation.RequestHash(); exists {
+			s.SetIgnore(idempotencykey.FieldRequestHash)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *IdempotencyKeyUpsertOne) Ignore() *IdempotencyKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IdempotencyKeyUpsertOne) DoNothing() *IdempotencyKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IdempotencyKeyCreate.OnConflict
+// documentation for more info.
+func (u *IdempotencyKeyUpsertOne) Update(set func(*IdempotencyKeyUpsert)) *IdempotencyKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IdempotencyKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *IdempotencyKeyUpsertOne) SetStatusCode(v int) *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetStatusCode(v)
+	})
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *IdempotencyKeyUpsertOne) AddStatusCode(v int) *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.AddStatusCode(v)
+	})
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertOne) UpdateStatusCode() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateStatusCode()
+	})
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (u *IdempotencyKeyUpsertOne) ClearStatusCode() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearStatusCode()
+	})
+}
+
+// SetResponse sets the "response" field.
+func (u *IdempotencyKeyUpsertOne) SetResponse(v []byte) *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetResponse(v)
+	})
+}
+
+// UpdateResponse sets the "response" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertOne) UpdateResponse() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateResponse()
+	})
+}
+
+// ClearResponse clears the value of the "response" field.
+func (u *IdempotencyKeyUpsertOne) ClearResponse() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearResponse()
+	})
+}
+
+// Exec executes the query.
+func (u *IdempotencyKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for IdempotencyKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IdempotencyKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *IdempotencyKeyUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: IdempotencyKeyUpsertOne.ID is not supported by MySQL driver. Use IdempotencyKeyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *IdempotencyKeyUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// IdempotencyKeyCreateBulk is the builder for creating many IdempotencyKey entities in bulk.
+type IdempotencyKeyCreateBulk struct {
+	config
+	err      error
+	builders []*IdempotencyKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the IdempotencyKey entities in the database.
+func (ikcb *IdempotencyKeyCreateBulk) Save(ctx context.Context) ([]*IdempotencyKey, error) {
+	if ikcb.err != nil {
+		return nil, ikcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ikcb.builders))
+	nodes := make([]*IdempotencyKey, len(ikcb.builders))
+	mutators := make([]Mutator, len(ikcb.builders))
+	for i := range ikcb.builders {
+		func(i int, root context.Context) {
+			builder := ikcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdempotencyKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ikcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ikcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ikcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ikcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikcb *IdempotencyKeyCreateBulk) SaveX(ctx context.Context) []*IdempotencyKey {
+	v, err := ikcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikcb *IdempotencyKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := ikcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikcb *IdempotencyKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := ikcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IdempotencyKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IdempotencyKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ikcb *IdempotencyKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *IdempotencyKeyUpsertBulk {
+	ikcb.conflict = opts
+	return &IdempotencyKeyUpsertBulk{
+		create: ikcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ikcb *IdempotencyKeyCreateBulk) OnConflictColumns(columns ...string) *IdempotencyKeyUpsertBulk {
+	ikcb.conflict = append(ikcb.conflict, sql.ConflictColumns(columns...))
+	return &IdempotencyKeyUpsertBulk{
+		create: ikcb,
+	}
+}
+
+// IdempotencyKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of IdempotencyKey nodes.
+type IdempotencyKeyUpsertBulk struct {
+	create *IdempotencyKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(idempotencykey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IdempotencyKeyUpsertBulk) UpdateNewValues() *IdempotencyKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(idempotencykey.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(idempotencykey.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(idempotencykey.FieldExpiresAt)
+			}
+			if _, exists := b.mutation.OwnerID(); exists {
+				s.SetIgnore(idempotencykey.FieldOwnerID)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(idempotencykey.FieldKey)
+			}
+			if _, exists := b.mutation.RequestHash(); exists {
+				s.SetIgnore(idempotencykey.FieldRequestHash)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *IdempotencyKeyUpsertBulk) Ignore() *IdempotencyKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IdempotencyKeyUpsertBulk) DoNothing() *IdempotencyKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IdempotencyKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *IdempotencyKeyUpsertBulk) Update(set func(*IdempotencyKeyUpsert)) *IdempotencyKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IdempotencyKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *IdempotencyKeyUpsertBulk) SetStatusCode(v int) *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetStatusCode(v)
+	})
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *IdempotencyKeyUpsertBulk) AddStatusCode(v int) *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.AddStatusCode(v)
+	})
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertBulk) UpdateStatusCode() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateStatusCode()
+	})
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (u *IdempotencyKeyUpsertBulk) ClearStatusCode() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearStatusCode()
+	})
+}
+
+// SetResponse sets the "response" field.
+func (u *IdempotencyKeyUpsertBulk) SetResponse(v []byte) *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetResponse(v)
+	})
+}
+
+// UpdateResponse sets the "response" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertBulk) UpdateResponse() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateResponse()
+	})
+}
+
+// ClearResponse clears the value of the "response" field.
+func (u *IdempotencyKeyUpsertBulk) ClearResponse() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearResponse()
+	})
+}
+
+// Exec executes the query.
+func (u *IdempotencyKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the IdempotencyKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for IdempotencyKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IdempotencyKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,16 +7,29 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	AccessToken string // AccessToken table.
-	Env         string // Env table.
-	EnvAlias    string // EnvAlias table.
-	EnvBuild    string // EnvBuild table.
-	Snapshot    string // Snapshot table.
-	Team        string // Team table.
-	TeamAPIKey  string // TeamAPIKey table.
-	Tier        string // Tier table.
-	User        string // User table.
-	UsersTeams  string // UsersTeams table.
+	AccessToken            string // AccessToken table.
+	Env                    string // Env table.
+	EnvAlias               string // EnvAlias table.
+	EnvBuild               string // EnvBuild table.
+	EnvBuildLog            string // EnvBuildLog table.
+	EnvRebuildSchedule     string // EnvRebuildSchedule table.
+	IdempotencyKey         string // IdempotencyKey table.
+	Kernel                 string // Kernel table.
+	PinnedBuild            string // PinnedBuild table.
+	Sandbox                string // Sandbox table.
+	SandboxStart           string // SandboxStart table.
+	Snapshot               string // Snapshot table.
+	Team                   string // Team table.
+	TeamAPIKey             string // TeamAPIKey table.
+	TeamBudget             string // TeamBudget table.
+	TeamRegistryCredential string // TeamRegistryCredential table.
+	TeamSecret             string // TeamSecret table.
+	TeamSecretVersion      string // TeamSecretVersion table.
+	TeamTemplateStorage    string // TeamTemplateStorage table.
+	TeamUsageDaily         string // TeamUsageDaily table.
+	Tier                   string // Tier table.
+	User                   string // User table.
+	UsersTeams             string // UsersTeams table.
 }
 
 type schemaCtxKey struct{}
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+)
+
+// Kernel is the model entity for the Kernel schema.
+type Kernel struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
+	// Description holds the value of the "description" field.
+	Description  *string `json:"description,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Kernel) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case kernel.FieldID, kernel.FieldChecksum, kernel.FieldDescription:
+			values[i] = new(sql.NullString)
+		case kernel.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Kernel fields.
+func (k *Kernel) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case kernel.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				k.ID = value.String
+			}
+		case kernel.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				k.CreatedAt = value.Time
+			}
+		case kernel.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				k.Checksum = value.String
+			}
+		case kernel.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				k.Description = new(string)
+				*k.Description = value.String
+			}
+		default:
+			k.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Kernel.
+// This includes values selected through modifiers, order, etc.
+func (k *Kernel) Value(name string) (ent.Value, error) {
+	return k.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Kernel.
+// Note that you need to call Kernel.Unwrap() before calling this method if this Kernel
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (k *Kernel) Update() *KernelUpdateOne {
+	return NewKernelClient(k.config).UpdateOne(k)
+}
+
+// Unwrap unwraps the Kernel entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (k *Kernel) Unwrap() *Kernel {
+	_tx, ok := k.config.driver.(*txDriver)
+	if !ok {
+		panic("models: Kernel is not a transactional entity")
+	}
+	k.config.driver = _tx.drv
+	return k
+}
+
+// String implements the fmt.Stringer.
+func (k *Kernel) String() string {
+	var builder strings.Builder
+	builder.WriteString("Kernel(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", k.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(k.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(k.Checksum)
+	builder.WriteString(", ")
+	if v := k.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Kernels is a parsable slice of Kernel.
+type Kernels []*Kernel
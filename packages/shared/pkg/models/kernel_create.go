@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+)
+
+// KernelCreate is the builder for creating a Kernel entity.
+type KernelCreate struct {
+	config
+	mutation *KernelMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (kc *KernelCreate) SetCreatedAt(t time.Time) *KernelCreate {
+	kc.mutation.SetCreatedAt(t)
+	return kc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (kc *KernelCreate) SetNillableCreatedAt(t *time.Time) *KernelCreate {
+	if t != nil {
+		kc.SetCreatedAt(*t)
+	}
+	return kc
+}
+
+// SetChecksum sets the "checksum" field.
+func (kc *KernelCreate) SetChecksum(s string) *KernelCreate {
+	kc.mutation.SetChecksum(s)
+	return kc
+}
+
+// SetDescription sets the "description" field.
+func (kc *KernelCreate) SetDescription(s string) *KernelCreate {
+	kc.mutation.SetDescription(s)
+	return kc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (kc *KernelCreate) SetNillableDescription(s *string) *KernelCreate {
+	if s != nil {
+		kc.SetDescription(*s)
+	}
+	return kc
+}
+
+// SetID sets the "id" field.
+func (kc *KernelCreate) SetID(s string) *KernelCreate {
+	kc.mutation.SetID(s)
+	return kc
+}
+
+// Mutation returns the KernelMutation object of the builder.
+func (kc *KernelCreate) Mutation() *KernelMutation {
+	return kc.mutation
+}
+
+// Save creates the Kernel in the database.
+func (kc *KernelCreate) Save(ctx context.Context) (*Kernel, error) {
+	kc.defaults()
+	return withHooks(ctx, kc.sqlSave, kc.mutation, kc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (kc *KernelCreate) SaveX(ctx context.Context) *Kernel {
+	v, err := kc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kc *KernelCreate) Exec(ctx context.Context) error {
+	_, err := kc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kc *KernelCreate) ExecX(ctx context.Context) {
+	if err := kc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kc *KernelCreate) defaults() {
+	if _, ok := kc.mutation.CreatedAt(); !ok {
+		v := kernel.DefaultCreatedAt()
+		kc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kc *KernelCreate) check() error {
+	if _, ok := kc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "Kernel.created_at"`)}
+	}
+	if _, ok := kc.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`models: missing required field "Kernel.checksum"`)}
+	}
+	return nil
+}
+
+func (kc *KernelCreate) sqlSave(ctx context.Context) (*Kernel, error) {
+	if err := kc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := kc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, kc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Kernel.ID type: %T", _spec.ID.Value)
+		}
+	}
+	kc.mutation.id = &_node.ID
+	kc.mutation.done = true
+	return _node, nil
+}
+
+func (kc *KernelCreate) createSpec() (*Kernel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Kernel{config: kc.config}
+		_spec = sqlgraph.NewCreateSpec(kernel.Table, sqlgraph.NewFieldSpec(kernel.FieldID, field.TypeString))
+	)
+	_spec.Schema = kc.schemaConfig.Kernel
+	_spec.OnConflict = kc.conflict
+	if id, ok := kc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := kc.mutation.CreatedAt(); ok {
+		_spec.SetField(kernel.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := kc.mutation.Checksum(); ok {
+		_spec.SetField(kernel.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := kc.mutation.Description(); ok {
+		_spec.SetField(kernel.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Kernel.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KernelUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (kc *KernelCreate) OnConflict(opts ...sql.ConflictOption) *KernelUpsertOne {
+	kc.conflict = opts
+	return &KernelUpsertOne{
+		create: kc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Kernel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (kc *KernelCreate) OnConflictColumns(columns ...string) *KernelUpsertOne {
+	kc.conflict = append(kc.conflict, sql.ConflictColumns(columns...))
+	return &KernelUpsertOne{
+		create: kc,
+	}
+}
+
+type (
+	// KernelUpsertOne is the builder for "upsert"-ing
+	//  one Kernel node.
+	KernelUpsertOne struct {
+		create *KernelCreate
+	}
+
+	// KernelUpsert is the "OnConflict" setter.
+	KernelUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDescription sets the "description" field.
+func (u *KernelUpsert) SetDescription(v string) *KernelUpsert {
+	u.Set(kernel.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *KernelUpsert) UpdateDescription() *KernelUpsert {
+	u.SetExcluded(kernel.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *KernelUpsert) ClearDescription() *KernelUpsert {
+	u.SetNull(kernel.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Kernel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(kernel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *KernelUpsertOne) UpdateNewValues() *KernelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(kernel.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(kernel.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Checksum(); exists {
+			s.SetIgnore(kernel.FieldChecksum)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Kernel.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *KernelUpsertOne) Ignore() *KernelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KernelUpsertOne) DoNothing() *KernelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KernelCreate.OnConflict
+// documentation for more info.
+func (u *KernelUpsertOne) Update(set func(*KernelUpsert)) *KernelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KernelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *KernelUpsertOne) SetDescription(v string) *KernelUpsertOne {
+	return u.Update(func(s *KernelUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *KernelUpsertOne) UpdateDescription() *KernelUpsertOne {
+	return u.Update(func(s *KernelUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *KernelUpsertOne) ClearDescription() *KernelUpsertOne {
+	return u.Update(func(s *KernelUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *KernelUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for KernelCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KernelUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *KernelUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: KernelUpsertOne.ID is not supported by MySQL driver. Use KernelUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *KernelUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// KernelCreateBulk is the builder for creating many Kernel entities in bulk.
+type KernelCreateBulk struct {
+	config
+	err      error
+	builders []*KernelCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Kernel entities in the database.
+func (kcb *KernelCreateBulk) Save(ctx context.Context) ([]*Kernel, error) {
+	if kcb.err != nil {
+		return nil, kcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(kcb.builders))
+	nodes := make([]*Kernel, len(kcb.builders))
+	mutators := make([]Mutator, len(kcb.builders))
+	for i := range kcb.builders {
+		func(i int, root context.Context) {
+			builder := kcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KernelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, kcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = kcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, kcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, kcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kcb *KernelCreateBulk) SaveX(ctx context.Context) []*Kernel {
+	v, err := kcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kcb *KernelCreateBulk) Exec(ctx context.Context) error {
+	_, err := kcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kcb *KernelCreateBulk) ExecX(ctx context.Context) {
+	if err := kcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Kernel.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KernelUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (kcb *KernelCreateBulk) OnConflict(opts ...sql.ConflictOption) *KernelUpsertBulk {
+	kcb.conflict = opts
+	return &KernelUpsertBulk{
+		create: kcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Kernel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (kcb *KernelCreateBulk) OnConflictColumns(columns ...string) *KernelUpsertBulk {
+	kcb.conflict = append(kcb.conflict, sql.ConflictColumns(columns...))
+	return &KernelUpsertBulk{
+		create: kcb,
+	}
+}
+
+// KernelUpsertBulk is the builder for "upsert"-ing
+// a bulk of Kernel nodes.
+type KernelUpsertBulk struct {
+	create *KernelCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Kernel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(kernel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *KernelUpsertBulk) UpdateNewValues() *KernelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(kernel.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(kernel.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Checksum(); exists {
+				s.SetIgnore(kernel.FieldChecksum)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Kernel.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *KernelUpsertBulk) Ignore() *KernelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KernelUpsertBulk) DoNothing() *KernelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KernelCreateBulk.OnConflict
+// documentation for more info.
+func (u *KernelUpsertBulk) Update(set func(*KernelUpsert)) *KernelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KernelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *KernelUpsertBulk) SetDescription(v string) *KernelUpsertBulk {
+	return u.Update(func(s *KernelUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *KernelUpsertBulk) UpdateDescription() *KernelUpsertBulk {
+	return u.Update(func(s *KernelUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *KernelUpsertBulk) ClearDescription() *KernelUpsertBulk {
+	return u.Update(func(s *KernelUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *KernelUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the KernelCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for KernelCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KernelUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// KernelDelete is the builder for deleting a Kernel entity.
+type KernelDelete struct {
+	config
+	hooks    []Hook
+	mutation *KernelMutation
+}
+
+// Where appends a list predicates to the KernelDelete builder.
+func (kd *KernelDelete) Where(ps ...predicate.Kernel) *KernelDelete {
+	kd.mutation.Where(ps...)
+	return kd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (kd *KernelDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, kd.sqlExec, kd.mutation, kd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kd *KernelDelete) ExecX(ctx context.Context) int {
+	n, err := kd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (kd *KernelDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(kernel.Table, sqlgraph.NewFieldSpec(kernel.FieldID, field.TypeString))
+	_spec.Node.Schema = kd.schemaConfig.Kernel
+	ctx = internal.NewSchemaConfigContext(ctx, kd.schemaConfig)
+	if ps := kd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, kd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	kd.mutation.done = true
+	return affected, err
+}
+
+// KernelDeleteOne is the builder for deleting a single Kernel entity.
+type KernelDeleteOne struct {
+	kd *KernelDelete
+}
+
+// Where appends a list predicates to the KernelDelete builder.
+func (kdo *KernelDeleteOne) Where(ps ...predicate.Kernel) *KernelDeleteOne {
+	kdo.kd.mutation.Where(ps...)
+	return kdo
+}
+
+// Exec executes the deletion query.
+func (kdo *KernelDeleteOne) Exec(ctx context.Context) error {
+	n, err := kdo.kd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{kernel.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kdo *KernelDeleteOne) ExecX(ctx context.Context) {
+	if err := kdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
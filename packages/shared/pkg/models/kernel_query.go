@@ -0,0 +1,556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// KernelQuery is the builder for querying Kernel entities.
+type KernelQuery struct {
+	config
+	ctx        *QueryContext
+	order      []kernel.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Kernel
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KernelQuery builder.
+func (kq *KernelQuery) Where(ps ...predicate.Kernel) *KernelQuery {
+	kq.predicates = append(kq.predicates, ps...)
+	return kq
+}
+
+// Limit the number of records to be returned by this query.
+func (kq *KernelQuery) Limit(limit int) *KernelQuery {
+	kq.ctx.Limit = &limit
+	return kq
+}
+
+// Offset to start from.
+func (kq *KernelQuery) Offset(offset int) *KernelQuery {
+	kq.ctx.Offset = &offset
+	return kq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (kq *KernelQuery) Unique(unique bool) *KernelQuery {
+	kq.ctx.Unique = &unique
+	return kq
+}
+
+// Order specifies how the records should be ordered.
+func (kq *KernelQuery) Order(o ...kernel.OrderOption) *KernelQuery {
+	kq.order = append(kq.order, o...)
+	return kq
+}
+
+// First returns the first Kernel entity from the query.
+// Returns a *NotFoundError when no Kernel was found.
+func (kq *KernelQuery) First(ctx context.Context) (*Kernel, error) {
+	nodes, err := kq.Limit(1).All(setContextOp(ctx, kq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{kernel.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (kq *KernelQuery) FirstX(ctx context.Context) *Kernel {
+	node, err := kq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Kernel ID from the query.
+// Returns a *NotFoundError when no Kernel ID was found.
+func (kq *KernelQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = kq.Limit(1).IDs(setContextOp(ctx, kq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{kernel.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (kq *KernelQuery) FirstIDX(ctx context.Context) string {
+	id, err := kq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Kernel entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Kernel entity is found.
+// Returns a *NotFoundError when no Kernel entities are found.
+func (kq *KernelQuery) Only(ctx context.Context) (*Kernel, error) {
+	nodes, err := kq.Limit(2).All(setContextOp(ctx, kq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{kernel.Label}
+	default:
+		return nil, &NotSingularError{kernel.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (kq *KernelQuery) OnlyX(ctx context.Context) *Kernel {
+	node, err := kq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Kernel ID in the query.
+// Returns a *NotSingularError when more than one Kernel ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (kq *KernelQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = kq.Limit(2).IDs(setContextOp(ctx, kq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{kernel.Label}
+	default:
+		err = &NotSingularError{kernel.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (kq *KernelQuery) OnlyIDX(ctx context.Context) string {
+	id, err := kq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Kernels.
+func (kq *KernelQuery) All(ctx context.Context) ([]*Kernel, error) {
+	ctx = setContextOp(ctx, kq.ctx, "All")
+	if err := kq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Kernel, *KernelQuery]()
+	return withInterceptors[[]*Kernel](ctx, kq, qr, kq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (kq *KernelQuery) AllX(ctx context.Context) []*Kernel {
+	nodes, err := kq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Kernel IDs.
+func (kq *KernelQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if kq.ctx.Unique == nil && kq.path != nil {
+		kq.Unique(true)
+	}
+	ctx = setContextOp(ctx, kq.ctx, "IDs")
+	if err = kq.Select(kernel.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (kq *KernelQuery) IDsX(ctx context.Context) []string {
+	ids, err := kq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (kq *KernelQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, kq.ctx, "Count")
+	if err := kq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, kq, querierCount[*KernelQuery](), kq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (kq *KernelQuery) CountX(ctx context.Context) int {
+	count, err := kq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (kq *KernelQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, kq.ctx, "Exist")
+	switch _, err := kq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (kq *KernelQuery) ExistX(ctx context.Context) bool {
+	exist, err := kq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KernelQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (kq *KernelQuery) Clone() *KernelQuery {
+	if kq == nil {
+		return nil
+	}
+	return &KernelQuery{
+		config:     kq.config,
+		ctx:        kq.ctx.Clone(),
+		order:      append([]kernel.OrderOption{}, kq.order...),
+		inters:     append([]Interceptor{}, kq.inters...),
+		predicates: append([]predicate.Kernel{}, kq.predicates...),
+		// clone intermediate query.
+		sql:  kq.sql.Clone(),
+		path: kq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Kernel.Query().
+//		GroupBy(kernel.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (kq *KernelQuery) GroupBy(field string, fields ...string) *KernelGroupBy {
+	kq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KernelGroupBy{build: kq}
+	grbuild.flds = &kq.ctx.Fields
+	grbuild.label = kernel.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.Kernel.Query().
+//		Select(kernel.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (kq *KernelQuery) Select(fields ...string) *KernelSelect {
+	kq.ctx.Fields = append(kq.ctx.Fields, fields...)
+	sbuild := &KernelSelect{KernelQuery: kq}
+	sbuild.label = kernel.Label
+	sbuild.flds, sbuild.scan = &kq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KernelSelect configured with the given aggregations.
+func (kq *KernelQuery) Aggregate(fns ...AggregateFunc) *KernelSelect {
+	return kq.Select().Aggregate(fns...)
+}
+
+func (kq *KernelQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range kq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, kq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range kq.ctx.Fields {
+		if !kernel.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if kq.path != nil {
+		prev, err := kq.path(ctx)
+		if err != nil {
+			return err
+		}
+		kq.sql = prev
+	}
+	return nil
+}
+
+func (kq *KernelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Kernel, error) {
+	var (
+		nodes = []*Kernel{}
+		_spec = kq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Kernel).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Kernel{config: kq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = kq.schemaConfig.Kernel
+	ctx = internal.NewSchemaConfigContext(ctx, kq.schemaConfig)
+	if len(kq.modifiers) > 0 {
+		_spec.Modifiers = kq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, kq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (kq *KernelQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := kq.querySpec()
+	_spec.Node.Schema = kq.schemaConfig.Kernel
+	ctx = internal.NewSchemaConfigContext(ctx, kq.schemaConfig)
+	if len(kq.modifiers) > 0 {
+		_spec.Modifiers = kq.modifiers
+	}
+	_spec.Node.Columns = kq.ctx.Fields
+	if len(kq.ctx.Fields) > 0 {
+		_spec.Unique = kq.ctx.Unique != nil && *kq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, kq.driver, _spec)
+}
+
+func (kq *KernelQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(kernel.Table, kernel.Columns, sqlgraph.NewFieldSpec(kernel.FieldID, field.TypeString))
+	_spec.From = kq.sql
+	if unique := kq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if kq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := kq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kernel.FieldID)
+		for i := range fields {
+			if fields[i] != kernel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := kq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := kq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := kq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := kq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (kq *KernelQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(kq.driver.Dialect())
+	t1 := builder.Table(kernel.Table)
+	columns := kq.ctx.Fields
+	if len(columns) == 0 {
+		columns = kernel.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if kq.sql != nil {
+		selector = kq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if kq.ctx.Unique != nil && *kq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(kq.schemaConfig.Kernel)
+	ctx = internal.NewSchemaConfigContext(ctx, kq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range kq.modifiers {
+		m(selector)
+	}
+	for _, p := range kq.predicates {
+		p(selector)
+	}
+	for _, p := range kq.order {
+		p(selector)
+	}
+	if offset := kq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := kq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (kq *KernelQuery) Modify(modifiers ...func(s *sql.Selector)) *KernelSelect {
+	kq.modifiers = append(kq.modifiers, modifiers...)
+	return kq.Select()
+}
+
+// KernelGroupBy is the group-by builder for Kernel entities.
+type KernelGroupBy struct {
+	selector
+	build *KernelQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (kgb *KernelGroupBy) Aggregate(fns ...AggregateFunc) *KernelGroupBy {
+	kgb.fns = append(kgb.fns, fns...)
+	return kgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kgb *KernelGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kgb.build.ctx, "GroupBy")
+	if err := kgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KernelQuery, *KernelGroupBy](ctx, kgb.build, kgb, kgb.build.inters, v)
+}
+
+func (kgb *KernelGroupBy) sqlScan(ctx context.Context, root *KernelQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(kgb.fns))
+	for _, fn := range kgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*kgb.flds)+len(kgb.fns))
+		for _, f := range *kgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*kgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KernelSelect is the builder for selecting fields of Kernel entities.
+type KernelSelect struct {
+	*KernelQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ks *KernelSelect) Aggregate(fns ...AggregateFunc) *KernelSelect {
+	ks.fns = append(ks.fns, fns...)
+	return ks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ks *KernelSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ks.ctx, "Select")
+	if err := ks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KernelQuery, *KernelSelect](ctx, ks.KernelQuery, ks, ks.inters, v)
+}
+
+func (ks *KernelSelect) sqlScan(ctx context.Context, root *KernelQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ks.fns))
+	for _, fn := range ks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ks *KernelSelect) Modify(modifiers ...func(s *sql.Selector)) *KernelSelect {
+	ks.modifiers = append(ks.modifiers, modifiers...)
+	return ks
+}
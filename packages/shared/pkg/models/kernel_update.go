@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// KernelUpdate is the builder for updating Kernel entities.
+type KernelUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *KernelMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the KernelUpdate builder.
+func (ku *KernelUpdate) Where(ps ...predicate.Kernel) *KernelUpdate {
+	ku.mutation.Where(ps...)
+	return ku
+}
+
+// SetDescription sets the "description" field.
+func (ku *KernelUpdate) SetDescription(s string) *KernelUpdate {
+	ku.mutation.SetDescription(s)
+	return ku
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ku *KernelUpdate) SetNillableDescription(s *string) *KernelUpdate {
+	if s != nil {
+		ku.SetDescription(*s)
+	}
+	return ku
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ku *KernelUpdate) ClearDescription() *KernelUpdate {
+	ku.mutation.ClearDescription()
+	return ku
+}
+
+// Mutation returns the KernelMutation object of the builder.
+func (ku *KernelUpdate) Mutation() *KernelMutation {
+	return ku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ku *KernelUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ku.sqlSave, ku.mutation, ku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ku *KernelUpdate) SaveX(ctx context.Context) int {
+	affected, err := ku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ku *KernelUpdate) Exec(ctx context.Context) error {
+	_, err := ku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ku *KernelUpdate) ExecX(ctx context.Context) {
+	if err := ku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ku *KernelUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *KernelUpdate {
+	ku.modifiers = append(ku.modifiers, modifiers...)
+	return ku
+}
+
+func (ku *KernelUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(kernel.Table, kernel.Columns, sqlgraph.NewFieldSpec(kernel.FieldID, field.TypeString))
+	if ps := ku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ku.mutation.Description(); ok {
+		_spec.SetField(kernel.FieldDescription, field.TypeString, value)
+	}
+	if ku.mutation.DescriptionCleared() {
+		_spec.ClearField(kernel.FieldDescription, field.TypeString)
+	}
+	_spec.Node.Schema = ku.schemaConfig.Kernel
+	ctx = internal.NewSchemaConfigContext(ctx, ku.schemaConfig)
+	_spec.AddModifiers(ku.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kernel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ku.mutation.done = true
+	return n, nil
+}
+
+// KernelUpdateOne is the builder for updating a single Kernel entity.
+type KernelUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *KernelMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDescription sets the "description" field.
+func (kuo *KernelUpdateOne) SetDescription(s string) *KernelUpdateOne {
+	kuo.mutation.SetDescription(s)
+	return kuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (kuo *KernelUpdateOne) SetNillableDescription(s *string) *KernelUpdateOne {
+	if s != nil {
+		kuo.SetDescription(*s)
+	}
+	return kuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (kuo *KernelUpdateOne) ClearDescription() *KernelUpdateOne {
+	kuo.mutation.ClearDescription()
+	return kuo
+}
+
+// Mutation returns the KernelMutation object of the builder.
+func (kuo *KernelUpdateOne) Mutation() *KernelMutation {
+	return kuo.mutation
+}
+
+// Where appends a list predicates to the KernelUpdate builder.
+func (kuo *KernelUpdateOne) Where(ps ...predicate.Kernel) *KernelUpdateOne {
+	kuo.mutation.Where(ps...)
+	return kuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (kuo *KernelUpdateOne) Select(field string, fields ...string) *KernelUpdateOne {
+	kuo.fields = append([]string{field}, fields...)
+	return kuo
+}
+
+// Save executes the query and returns the updated Kernel entity.
+func (kuo *KernelUpdateOne) Save(ctx context.Context) (*Kernel, error) {
+	return withHooks(ctx, kuo.sqlSave, kuo.mutation, kuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kuo *KernelUpdateOne) SaveX(ctx context.Context) *Kernel {
+	node, err := kuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (kuo *KernelUpdateOne) Exec(ctx context.Context) error {
+	_, err := kuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kuo *KernelUpdateOne) ExecX(ctx context.Context) {
+	if err := kuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (kuo *KernelUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *KernelUpdateOne {
+	kuo.modifiers = append(kuo.modifiers, modifiers...)
+	return kuo
+}
+
+func (kuo *KernelUpdateOne) sqlSave(ctx context.Context) (_node *Kernel, err error) {
+	_spec := sqlgraph.NewUpdateSpec(kernel.Table, kernel.Columns, sqlgraph.NewFieldSpec(kernel.FieldID, field.TypeString))
+	id, ok := kuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "Kernel.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := kuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kernel.FieldID)
+		for _, f := range fields {
+			if !kernel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != kernel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := kuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kuo.mutation.Description(); ok {
+		_spec.SetField(kernel.FieldDescription, field.TypeString, value)
+	}
+	if kuo.mutation.DescriptionCleared() {
+		_spec.ClearField(kernel.FieldDescription, field.TypeString)
+	}
+	_spec.Node.Schema = kuo.schemaConfig.Kernel
+	ctx = internal.NewSchemaConfigContext(ctx, kuo.schemaConfig)
+	_spec.AddModifiers(kuo.modifiers...)
+	_node = &Kernel{config: kuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, kuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kernel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	kuo.mutation.done = true
+	return _node, nil
+}
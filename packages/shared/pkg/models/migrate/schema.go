@@ -38,6 +38,7 @@ var (
 		{Name: "build_count", Type: field.TypeInt32, Default: 1},
 		{Name: "spawn_count", Type: field.TypeInt64, Comment: "Number of times the env was spawned", Default: 0},
 		{Name: "last_spawned_at", Type: field.TypeTime, Nullable: true, Comment: "Timestamp of the last time the env was spawned"},
+		{Name: "allowed_regions", Type: field.TypeJSON, Nullable: true, Comment: "Regions the sandboxes of the env can run in, any region if empty", SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "team_id", Type: field.TypeUUID},
 		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
 	}
@@ -49,13 +50,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "envs_teams_envs",
-				Columns:    []*schema.Column{EnvsColumns[7]},
+				Columns:    []*schema.Column{EnvsColumns[8]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "envs_users_created_envs",
-				Columns:    []*schema.Column{EnvsColumns[8]},
+				Columns:    []*schema.Column{EnvsColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -87,16 +88,30 @@ var (
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "heartbeat_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "building", "failed", "success", "uploaded"}, Default: "waiting", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "dockerfile", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "start_cmd", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "readiness_probe", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "hooks", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "hardening", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "egress", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "vcpu", Type: field.TypeInt64},
 		{Name: "ram_mb", Type: field.TypeInt64},
 		{Name: "free_disk_size_mb", Type: field.TypeInt64},
 		{Name: "total_disk_size_mb", Type: field.TypeInt64, Nullable: true},
 		{Name: "kernel_version", Type: field.TypeString, Default: "vmlinux-6.1.102", SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "kernel_args", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "rootfs_block_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "secrets", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "firecracker_version", Type: field.TypeString, Default: "v1.10.1_1fcdaec", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "envd_version", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "upload_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "uploaded", "failed"}, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "snapshot_node_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "replicated_regions", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "source_build_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "variant", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "variant_of", Type: field.TypeUUID, Nullable: true},
 		{Name: "env_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// EnvBuildsTable holds the schema information for the "env_builds" table.
@@ -107,11 +122,205 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "env_builds_envs_builds",
-				Columns:    []*schema.Column{EnvBuildsColumns[14]},
+				Columns:    []*schema.Column{EnvBuildsColumns[28]},
 				RefColumns: []*schema.Column{EnvsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "envbuild_variant_of",
+				Unique:  false,
+				Columns: []*schema.Column{EnvBuildsColumns[27]},
+			},
+		},
+	}
+	// EnvBuildLogsColumns holds the columns for the "env_build_logs" table.
+	EnvBuildLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "team_id", Type: field.TypeUUID},
+		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "build_id", Type: field.TypeUUID},
+		{Name: "line", Type: field.TypeInt32},
+		{Name: "message", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+	}
+	// EnvBuildLogsTable holds the schema information for the "env_build_logs" table.
+	EnvBuildLogsTable = &schema.Table{
+		Name:       "env_build_logs",
+		Columns:    EnvBuildLogsColumns,
+		PrimaryKey: []*schema.Column{EnvBuildLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "envbuildlog_build_id_line",
+				Unique:  true,
+				Columns: []*schema.Column{EnvBuildLogsColumns[4], EnvBuildLogsColumns[5]},
+			},
+			{
+				Name:    "envbuildlog_team_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EnvBuildLogsColumns[2], EnvBuildLogsColumns[1]},
+			},
+		},
+	}
+	// EnvRebuildSchedulesColumns holds the columns for the "env_rebuild_schedules" table.
+	EnvRebuildSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "interval_hours", Type: field.TypeInt32, Default: 24},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "upgrade_packages", Type: field.TypeBool, Default: true},
+		{Name: "webhook_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "webhook_secret", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "last_build_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "last_build_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"building", "failed", "success"}, SchemaType: map[string]string{"postgres": "text"}},
+	}
+	// EnvRebuildSchedulesTable holds the schema information for the "env_rebuild_schedules" table.
+	EnvRebuildSchedulesTable = &schema.Table{
+		Name:       "env_rebuild_schedules",
+		Columns:    EnvRebuildSchedulesColumns,
+		PrimaryKey: []*schema.Column{EnvRebuildSchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "envrebuildschedule_env_id",
+				Unique:  true,
+				Columns: []*schema.Column{EnvRebuildSchedulesColumns[3]},
+			},
+			{
+				Name:    "envrebuildschedule_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{EnvRebuildSchedulesColumns[5]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "owner_id", Type: field.TypeUUID},
+		{Name: "key", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "request_hash", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "response", Type: field.TypeBytes, Nullable: true},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_owner_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[3], IdempotencyKeysColumns[4]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[2]},
+			},
+		},
+	}
+	// KernelsColumns holds the columns for the "kernels" table.
+	KernelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "checksum", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+	}
+	// KernelsTable holds the schema information for the "kernels" table.
+	KernelsTable = &schema.Table{
+		Name:       "kernels",
+		Columns:    KernelsColumns,
+		PrimaryKey: []*schema.Column{KernelsColumns[0]},
+	}
+	// PinnedBuildsColumns holds the columns for the "pinned_builds" table.
+	PinnedBuildsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "build_id", Type: field.TypeUUID},
+		{Name: "node_id", Type: field.TypeString, Default: "", SchemaType: map[string]string{"postgres": "text"}},
+	}
+	// PinnedBuildsTable holds the schema information for the "pinned_builds" table.
+	PinnedBuildsTable = &schema.Table{
+		Name:       "pinned_builds",
+		Columns:    PinnedBuildsColumns,
+		PrimaryKey: []*schema.Column{PinnedBuildsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pinnedbuild_build_id_node_id",
+				Unique:  true,
+				Columns: []*schema.Column{PinnedBuildsColumns[2], PinnedBuildsColumns[3]},
+			},
+		},
+	}
+	// SandboxesColumns holds the columns for the "sandboxes" table.
+	SandboxesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "team_id", Type: field.TypeUUID},
+		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "build_id", Type: field.TypeUUID},
+		{Name: "alias", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "node_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "cluster_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"creating", "running", "lost"}},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime},
+		{Name: "vcpu", Type: field.TypeInt64},
+		{Name: "ram_mb", Type: field.TypeInt64},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+	}
+	// SandboxesTable holds the schema information for the "sandboxes" table.
+	SandboxesTable = &schema.Table{
+		Name:       "sandboxes",
+		Columns:    SandboxesColumns,
+		PrimaryKey: []*schema.Column{SandboxesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sandbox_team_id",
+				Unique:  false,
+				Columns: []*schema.Column{SandboxesColumns[3]},
+			},
+			{
+				Name:    "sandbox_labels",
+				Unique:  false,
+				Columns: []*schema.Column{SandboxesColumns[15]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+		},
+	}
+	// SandboxStartsColumns holds the columns for the "sandbox_starts" table.
+	SandboxStartsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "team_id", Type: field.TypeUUID},
+		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "sandbox_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "resumed", Type: field.TypeBool},
+		{Name: "duration_ms", Type: field.TypeInt64},
+	}
+	// SandboxStartsTable holds the schema information for the "sandbox_starts" table.
+	SandboxStartsTable = &schema.Table{
+		Name:       "sandbox_starts",
+		Columns:    SandboxStartsColumns,
+		PrimaryKey: []*schema.Column{SandboxStartsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sandboxstart_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SandboxStartsColumns[1]},
+			},
+		},
 	}
 	// SnapshotsColumns holds the columns for the "snapshots" table.
 	SnapshotsColumns = []*schema.Column{
@@ -145,6 +354,9 @@ var (
 		{Name: "blocked_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "email", Type: field.TypeString, Size: 255, SchemaType: map[string]string{"postgres": "character varying(255)"}},
+		{Name: "encrypt_snapshots", Type: field.TypeBool, Default: "false"},
+		{Name: "replication_regions", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "build_log_retention_days", Type: field.TypeInt32, Default: "30"},
 		{Name: "tier", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// TeamsTable holds the schema information for the "teams" table.
@@ -155,7 +367,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "teams_tiers_teams",
-				Columns:    []*schema.Column{TeamsColumns[7]},
+				Columns:    []*schema.Column{TeamsColumns[10]},
 				RefColumns: []*schema.Column{TiersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -169,6 +381,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString, Default: "Unnamed API Key", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "last_used", Type: field.TypeTime, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "team_id", Type: field.TypeUUID},
 		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
 	}
@@ -180,18 +393,159 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "team_api_keys_teams_team_api_keys",
-				Columns:    []*schema.Column{TeamAPIKeysColumns[6]},
+				Columns:    []*schema.Column{TeamAPIKeysColumns[7]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "team_api_keys_users_created_api_keys",
-				Columns:    []*schema.Column{TeamAPIKeysColumns[7]},
+				Columns:    []*schema.Column{TeamAPIKeysColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// TeamBudgetsColumns holds the columns for the "team_budgets" table.
+	TeamBudgetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "team_id", Type: field.TypeUUID, Unique: true},
+		{Name: "monthly_limit_usd", Type: field.TypeFloat64},
+		{Name: "warning_percent", Type: field.TypeInt32, Default: 80},
+		{Name: "pause_sandboxes", Type: field.TypeBool, Default: false},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "accrued_usd", Type: field.TypeFloat64, Default: 0},
+		{Name: "metered_at", Type: field.TypeTime},
+		{Name: "warned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "exceeded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "override_until", Type: field.TypeTime, Nullable: true},
+		{Name: "override_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+	}
+	// TeamBudgetsTable holds the schema information for the "team_budgets" table.
+	TeamBudgetsTable = &schema.Table{
+		Name:       "team_budgets",
+		Columns:    TeamBudgetsColumns,
+		PrimaryKey: []*schema.Column{TeamBudgetsColumns[0]},
+	}
+	// TeamRegistryCredentialsColumns holds the columns for the "team_registry_credentials" table.
+	TeamRegistryCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "team_id", Type: field.TypeUUID},
+		{Name: "registry", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "username", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "version", Type: field.TypeInt32, Default: 1},
+		{Name: "key_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "encrypted_key", Type: field.TypeBytes},
+		{Name: "ciphertext", Type: field.TypeBytes},
+	}
+	// TeamRegistryCredentialsTable holds the schema information for the "team_registry_credentials" table.
+	TeamRegistryCredentialsTable = &schema.Table{
+		Name:       "team_registry_credentials",
+		Columns:    TeamRegistryCredentialsColumns,
+		PrimaryKey: []*schema.Column{TeamRegistryCredentialsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teamregistrycredential_team_id_registry",
+				Unique:  true,
+				Columns: []*schema.Column{TeamRegistryCredentialsColumns[3], TeamRegistryCredentialsColumns[4]},
+			},
+		},
+	}
+	// TeamSecretsColumns holds the columns for the "team_secrets" table.
+	TeamSecretsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "team_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "latest_version", Type: field.TypeInt32, Default: 1},
+	}
+	// TeamSecretsTable holds the schema information for the "team_secrets" table.
+	TeamSecretsTable = &schema.Table{
+		Name:       "team_secrets",
+		Columns:    TeamSecretsColumns,
+		PrimaryKey: []*schema.Column{TeamSecretsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teamsecret_team_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TeamSecretsColumns[3], TeamSecretsColumns[4]},
+			},
+		},
+	}
+	// TeamSecretVersionsColumns holds the columns for the "team_secret_versions" table.
+	TeamSecretVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "secret_id", Type: field.TypeUUID},
+		{Name: "version", Type: field.TypeInt32},
+		{Name: "key_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "encrypted_key", Type: field.TypeBytes},
+		{Name: "ciphertext", Type: field.TypeBytes},
+	}
+	// TeamSecretVersionsTable holds the schema information for the "team_secret_versions" table.
+	TeamSecretVersionsTable = &schema.Table{
+		Name:       "team_secret_versions",
+		Columns:    TeamSecretVersionsColumns,
+		PrimaryKey: []*schema.Column{TeamSecretVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teamsecretversion_secret_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{TeamSecretVersionsColumns[2], TeamSecretVersionsColumns[3]},
+			},
+		},
+	}
+	// TeamTemplateStorageColumns holds the columns for the "team_template_storage" table.
+	TeamTemplateStorageColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "team_id", Type: field.TypeUUID},
+		{Name: "env_id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "template_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "snapshot_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TeamTemplateStorageTable holds the schema information for the "team_template_storage" table.
+	TeamTemplateStorageTable = &schema.Table{
+		Name:       "team_template_storage",
+		Columns:    TeamTemplateStorageColumns,
+		PrimaryKey: []*schema.Column{TeamTemplateStorageColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teamtemplatestorage_team_id",
+				Unique:  false,
+				Columns: []*schema.Column{TeamTemplateStorageColumns[1]},
+			},
+		},
+	}
+	// TeamUsageDailyColumns holds the columns for the "team_usage_daily" table.
+	TeamUsageDailyColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "team_id", Type: field.TypeUUID},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "sandboxes_started", Type: field.TypeInt64, Default: 0},
+		{Name: "sandboxes_resumed", Type: field.TypeInt64, Default: 0},
+		{Name: "resume_p95_ms", Type: field.TypeInt64, Nullable: true},
+		{Name: "builds", Type: field.TypeInt64, Default: 0},
+		{Name: "build_seconds", Type: field.TypeInt64, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TeamUsageDailyTable holds the schema information for the "team_usage_daily" table.
+	TeamUsageDailyTable = &schema.Table{
+		Name:       "team_usage_daily",
+		Columns:    TeamUsageDailyColumns,
+		PrimaryKey: []*schema.Column{TeamUsageDailyColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teamusagedaily_team_id_day",
+				Unique:  true,
+				Columns: []*schema.Column{TeamUsageDailyColumns[1], TeamUsageDailyColumns[2]},
+			},
+		},
+	}
 	// TiersColumns holds the columns for the "tiers" table.
 	TiersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "text"}},
@@ -199,6 +553,9 @@ var (
 		{Name: "disk_mb", Type: field.TypeInt64, Default: "512"},
 		{Name: "concurrent_instances", Type: field.TypeInt64, Comment: "The number of instances the team can run concurrently"},
 		{Name: "max_length_hours", Type: field.TypeInt64},
+		{Name: "vcpu_hour_price_usd", Type: field.TypeFloat64, Default: "0.0504"},
+		{Name: "ram_gib_hour_price_usd", Type: field.TypeFloat64, Default: "0.0162"},
+		{Name: "high_priority", Type: field.TypeBool, Comment: "Whether the team can start the high priority sandboxes that preempt the low priority ones", Default: "false"},
 	}
 	// TiersTable holds the schema information for the "tiers" table.
 	TiersTable = &schema.Table{
@@ -257,9 +614,22 @@ var (
 		EnvsTable,
 		EnvAliasesTable,
 		EnvBuildsTable,
+		EnvBuildLogsTable,
+		EnvRebuildSchedulesTable,
+		IdempotencyKeysTable,
+		KernelsTable,
+		PinnedBuildsTable,
+		SandboxesTable,
+		SandboxStartsTable,
 		SnapshotsTable,
 		TeamsTable,
 		TeamAPIKeysTable,
+		TeamBudgetsTable,
+		TeamRegistryCredentialsTable,
+		TeamSecretsTable,
+		TeamSecretVersionsTable,
+		TeamTemplateStorageTable,
+		TeamUsageDailyTable,
 		TiersTable,
 		UsersTable,
 		UsersTeamsTable,
@@ -278,6 +648,19 @@ func init() {
 	}
 	EnvBuildsTable.ForeignKeys[0].RefTable = EnvsTable
 	EnvBuildsTable.Annotation = &entsql.Annotation{}
+	EnvBuildLogsTable.Annotation = &entsql.Annotation{
+		Table: "env_build_logs",
+	}
+	EnvRebuildSchedulesTable.Annotation = &entsql.Annotation{
+		Table: "env_rebuild_schedules",
+	}
+	IdempotencyKeysTable.Annotation = &entsql.Annotation{}
+	KernelsTable.Annotation = &entsql.Annotation{}
+	PinnedBuildsTable.Annotation = &entsql.Annotation{}
+	SandboxesTable.Annotation = &entsql.Annotation{}
+	SandboxStartsTable.Annotation = &entsql.Annotation{
+		Table: "sandbox_starts",
+	}
 	SnapshotsTable.ForeignKeys[0].RefTable = EnvsTable
 	SnapshotsTable.Annotation = &entsql.Annotation{}
 	TeamsTable.ForeignKeys[0].RefTable = TiersTable
@@ -285,6 +668,18 @@ func init() {
 	TeamAPIKeysTable.ForeignKeys[0].RefTable = TeamsTable
 	TeamAPIKeysTable.ForeignKeys[1].RefTable = UsersTable
 	TeamAPIKeysTable.Annotation = &entsql.Annotation{}
+	TeamBudgetsTable.Annotation = &entsql.Annotation{
+		Table: "team_budgets",
+	}
+	TeamRegistryCredentialsTable.Annotation = &entsql.Annotation{}
+	TeamSecretsTable.Annotation = &entsql.Annotation{}
+	TeamSecretVersionsTable.Annotation = &entsql.Annotation{}
+	TeamTemplateStorageTable.Annotation = &entsql.Annotation{
+		Table: "team_template_storage",
+	}
+	TeamUsageDailyTable.Annotation = &entsql.Annotation{
+		Table: "team_usage_daily",
+	}
 	TiersTable.Annotation = &entsql.Annotation{}
 	TiersTable.Annotation.Checks = map[string]string{
 		"tiers_concurrent_sessions_check": "concurrent_instances > 0",
@@ -15,13 +15,27 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/idempotencykey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecret"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
 
@@ -34,16 +48,29 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAccessToken = "AccessToken"
-	TypeEnv         = "Env"
-	TypeEnvAlias    = "EnvAlias"
-	TypeEnvBuild    = "EnvBuild"
-	TypeSnapshot    = "Snapshot"
-	TypeTeam        = "Team"
-	TypeTeamAPIKey  = "TeamAPIKey"
-	TypeTier        = "Tier"
-	TypeUser        = "User"
-	TypeUsersTeams  = "UsersTeams"
+	TypeAccessToken            = "AccessToken"
+	TypeEnv                    = "Env"
+	TypeEnvAlias               = "EnvAlias"
+	TypeEnvBuild               = "EnvBuild"
+	TypeEnvBuildLog            = "EnvBuildLog"
+	TypeEnvRebuildSchedule     = "EnvRebuildSchedule"
+	TypeIdempotencyKey         = "IdempotencyKey"
+	TypeKernel                 = "Kernel"
+	TypePinnedBuild            = "PinnedBuild"
+	TypeSandbox                = "Sandbox"
+	TypeSandboxStart           = "SandboxStart"
+	TypeSnapshot               = "Snapshot"
+	TypeTeam                   = "Team"
+	TypeTeamAPIKey             = "TeamAPIKey"
+	TypeTeamBudget             = "TeamBudget"
+	TypeTeamRegistryCredential = "TeamRegistryCredential"
+	TypeTeamSecret             = "TeamSecret"
+	TypeTeamSecretVersion      = "TeamSecretVersion"
+	TypeTeamTemplateStorage    = "TeamTemplateStorage"
+	TypeTeamUsageDaily         = "TeamUsageDaily"
+	TypeTier                   = "Tier"
+	TypeUser                   = "User"
+	TypeUsersTeams             = "UsersTeams"
 )
 
 // AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
@@ -511,34 +538,36 @@ func (m *AccessTokenMutation) ResetEdge(name string) error {
 // EnvMutation represents an operation that mutates the Env nodes in the graph.
 type EnvMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	public             *bool
-	build_count        *int32
-	addbuild_count     *int32
-	spawn_count        *int64
-	addspawn_count     *int64
-	last_spawned_at    *time.Time
-	clearedFields      map[string]struct{}
-	team               *uuid.UUID
-	clearedteam        bool
-	creator            *uuid.UUID
-	clearedcreator     bool
-	env_aliases        map[string]struct{}
-	removedenv_aliases map[string]struct{}
-	clearedenv_aliases bool
-	builds             map[uuid.UUID]struct{}
-	removedbuilds      map[uuid.UUID]struct{}
-	clearedbuilds      bool
-	snapshots          map[uuid.UUID]struct{}
-	removedsnapshots   map[uuid.UUID]struct{}
-	clearedsnapshots   bool
-	done               bool
-	oldValue           func(context.Context) (*Env, error)
-	predicates         []predicate.Env
+	op                    Op
+	typ                   string
+	id                    *string
+	created_at            *time.Time
+	updated_at            *time.Time
+	public                *bool
+	build_count           *int32
+	addbuild_count        *int32
+	spawn_count           *int64
+	addspawn_count        *int64
+	last_spawned_at       *time.Time
+	allowed_regions       *[]string
+	appendallowed_regions []string
+	clearedFields         map[string]struct{}
+	team                  *uuid.UUID
+	clearedteam           bool
+	creator               *uuid.UUID
+	clearedcreator        bool
+	env_aliases           map[string]struct{}
+	removedenv_aliases    map[string]struct{}
+	clearedenv_aliases    bool
+	builds                map[uuid.UUID]struct{}
+	removedbuilds         map[uuid.UUID]struct{}
+	clearedbuilds         bool
+	snapshots             map[uuid.UUID]struct{}
+	removedsnapshots      map[uuid.UUID]struct{}
+	clearedsnapshots      bool
+	done                  bool
+	oldValue              func(context.Context) (*Env, error)
+	predicates            []predicate.Env
 }
 
 var _ ent.Mutation = (*EnvMutation)(nil)
@@ -999,6 +1028,71 @@ func (m *EnvMutation) ResetLastSpawnedAt() {
 	delete(m.clearedFields, env.FieldLastSpawnedAt)
 }
 
+// SetAllowedRegions sets the "allowed_regions" field.
+func (m *EnvMutation) SetAllowedRegions(s []string) {
+	m.allowed_regions = &s
+	m.appendallowed_regions = nil
+}
+
+// AllowedRegions returns the value of the "allowed_regions" field in the mutation.
+func (m *EnvMutation) AllowedRegions() (r []string, exists bool) {
+	v := m.allowed_regions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedRegions returns the old "allowed_regions" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldAllowedRegions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedRegions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedRegions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedRegions: %w", err)
+	}
+	return oldValue.AllowedRegions, nil
+}
+
+// AppendAllowedRegions adds s to the "allowed_regions" field.
+func (m *EnvMutation) AppendAllowedRegions(s []string) {
+	m.appendallowed_regions = append(m.appendallowed_regions, s...)
+}
+
+// AppendedAllowedRegions returns the list of values that were appended to the "allowed_regions" field in this mutation.
+func (m *EnvMutation) AppendedAllowedRegions() ([]string, bool) {
+	if len(m.appendallowed_regions) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_regions, true
+}
+
+// ClearAllowedRegions clears the value of the "allowed_regions" field.
+func (m *EnvMutation) ClearAllowedRegions() {
+	m.allowed_regions = nil
+	m.appendallowed_regions = nil
+	m.clearedFields[env.FieldAllowedRegions] = struct{}{}
+}
+
+// AllowedRegionsCleared returns if the "allowed_regions" field was cleared in this mutation.
+func (m *EnvMutation) AllowedRegionsCleared() bool {
+	_, ok := m.clearedFields[env.FieldAllowedRegions]
+	return ok
+}
+
+// ResetAllowedRegions resets all changes to the "allowed_regions" field.
+func (m *EnvMutation) ResetAllowedRegions() {
+	m.allowed_regions = nil
+	m.appendallowed_regions = nil
+	delete(m.clearedFields, env.FieldAllowedRegions)
+}
+
 // ClearTeam clears the "team" edge to the Team entity.
 func (m *EnvMutation) ClearTeam() {
 	m.clearedteam = true
@@ -1262,7 +1356,7 @@ func (m *EnvMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *EnvMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
 		fields = append(fields, env.FieldCreatedAt)
 	}
@@ -1287,6 +1381,9 @@ func (m *EnvMutation) Fields() []string {
 	if m.last_spawned_at != nil {
 		fields = append(fields, env.FieldLastSpawnedAt)
 	}
+	if m.allowed_regions != nil {
+		fields = append(fields, env.FieldAllowedRegions)
+	}
 	return fields
 }
 
@@ -1311,6 +1408,8 @@ func (m *EnvMutation) Field(name string) (ent.Value, bool) {
 		return m.SpawnCount()
 	case env.FieldLastSpawnedAt:
 		return m.LastSpawnedAt()
+	case env.FieldAllowedRegions:
+		return m.AllowedRegions()
 	}
 	return nil, false
 }
@@ -1336,6 +1435,8 @@ func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, err
 		return m.OldSpawnCount(ctx)
 	case env.FieldLastSpawnedAt:
 		return m.OldLastSpawnedAt(ctx)
+	case env.FieldAllowedRegions:
+		return m.OldAllowedRegions(ctx)
 	}
 	return nil, fmt.Errorf("unknown Env field %s", name)
 }
@@ -1401,6 +1502,13 @@ func (m *EnvMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLastSpawnedAt(v)
 		return nil
+	case env.FieldAllowedRegions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedRegions(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Env field %s", name)
 }
@@ -1464,6 +1572,9 @@ func (m *EnvMutation) ClearedFields() []string {
 	if m.FieldCleared(env.FieldLastSpawnedAt) {
 		fields = append(fields, env.FieldLastSpawnedAt)
 	}
+	if m.FieldCleared(env.FieldAllowedRegions) {
+		fields = append(fields, env.FieldAllowedRegions)
+	}
 	return fields
 }
 
@@ -1484,6 +1595,9 @@ func (m *EnvMutation) ClearField(name string) error {
 	case env.FieldLastSpawnedAt:
 		m.ClearLastSpawnedAt()
 		return nil
+	case env.FieldAllowedRegions:
+		m.ClearAllowedRegions()
+		return nil
 	}
 	return fmt.Errorf("unknown Env nullable field %s", name)
 }
@@ -1516,6 +1630,9 @@ func (m *EnvMutation) ResetField(name string) error {
 	case env.FieldLastSpawnedAt:
 		m.ResetLastSpawnedAt()
 		return nil
+	case env.FieldAllowedRegions:
+		m.ResetAllowedRegions()
+		return nil
 	}
 	return fmt.Errorf("unknown Env field %s", name)
 }
@@ -2135,32 +2252,50 @@ func (m *EnvAliasMutation) ResetEdge(name string) error {
 // EnvBuildMutation represents an operation that mutates the EnvBuild nodes in the graph.
 type EnvBuildMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	created_at            *time.Time
-	updated_at            *time.Time
-	finished_at           *time.Time
-	status                *envbuild.Status
-	dockerfile            *string
-	start_cmd             *string
-	vcpu                  *int64
-	addvcpu               *int64
-	ram_mb                *int64
-	addram_mb             *int64
-	free_disk_size_mb     *int64
-	addfree_disk_size_mb  *int64
-	total_disk_size_mb    *int64
-	addtotal_disk_size_mb *int64
-	kernel_version        *string
-	firecracker_version   *string
-	envd_version          *string
-	clearedFields         map[string]struct{}
-	env                   *string
-	clearedenv            bool
-	done                  bool
-	oldValue              func(context.Context) (*EnvBuild, error)
-	predicates            []predicate.EnvBuild
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	created_at               *time.Time
+	updated_at               *time.Time
+	finished_at              *time.Time
+	heartbeat_at             *time.Time
+	status                   *envbuild.Status
+	dockerfile               *string
+	start_cmd                *string
+	readiness_probe          **schema.ReadinessProbe
+	_hooks                   **schema.LifecycleHooks
+	hardening                **schema.HardeningPolicy
+	egress                   **schema.EgressPolicy
+	vcpu                     *int64
+	addvcpu                  *int64
+	ram_mb                   *int64
+	addram_mb                *int64
+	free_disk_size_mb        *int64
+	addfree_disk_size_mb     *int64
+	total_disk_size_mb       *int64
+	addtotal_disk_size_mb    *int64
+	kernel_version           *string
+	kernel_args              *[]string
+	appendkernel_args        []string
+	rootfs_block_size        *int64
+	addrootfs_block_size     *int64
+	secrets                  *[]string
+	appendsecrets            []string
+	firecracker_version      *string
+	envd_version             *string
+	upload_status            *envbuild.UploadStatus
+	snapshot_node_id         *string
+	replicated_regions       *[]string
+	appendreplicated_regions []string
+	source_build_id          *uuid.UUID
+	variant                  *string
+	variant_of               *uuid.UUID
+	clearedFields            map[string]struct{}
+	env                      *string
+	clearedenv               bool
+	done                     bool
+	oldValue                 func(context.Context) (*EnvBuild, error)
+	predicates               []predicate.EnvBuild
 }
 
 var _ ent.Mutation = (*EnvBuildMutation)(nil)
@@ -2388,6 +2523,55 @@ func (m *EnvBuildMutation) ResetFinishedAt() {
 	delete(m.clearedFields, envbuild.FieldFinishedAt)
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (m *EnvBuildMutation) SetHeartbeatAt(t time.Time) {
+	m.heartbeat_at = &t
+}
+
+// HeartbeatAt returns the value of the "heartbeat_at" field in the mutation.
+func (m *EnvBuildMutation) HeartbeatAt() (r time.Time, exists bool) {
+	v := m.heartbeat_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeartbeatAt returns the old "heartbeat_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldHeartbeatAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeartbeatAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeartbeatAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeartbeatAt: %w", err)
+	}
+	return oldValue.HeartbeatAt, nil
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (m *EnvBuildMutation) ClearHeartbeatAt() {
+	m.heartbeat_at = nil
+	m.clearedFields[envbuild.FieldHeartbeatAt] = struct{}{}
+}
+
+// HeartbeatAtCleared returns if the "heartbeat_at" field was cleared in this mutation.
+func (m *EnvBuildMutation) HeartbeatAtCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldHeartbeatAt]
+	return ok
+}
+
+// ResetHeartbeatAt resets all changes to the "heartbeat_at" field.
+func (m *EnvBuildMutation) ResetHeartbeatAt() {
+	m.heartbeat_at = nil
+	delete(m.clearedFields, envbuild.FieldHeartbeatAt)
+}
+
 // SetEnvID sets the "env_id" field.
 func (m *EnvBuildMutation) SetEnvID(s string) {
 	m.env = &s
@@ -2571,6 +2755,202 @@ func (m *EnvBuildMutation) ResetStartCmd() {
 	delete(m.clearedFields, envbuild.FieldStartCmd)
 }
 
+// SetReadinessProbe sets the "readiness_probe" field.
+func (m *EnvBuildMutation) SetReadinessProbe(sp *schema.ReadinessProbe) {
+	m.readiness_probe = &sp
+}
+
+// ReadinessProbe returns the value of the "readiness_probe" field in the mutation.
+func (m *EnvBuildMutation) ReadinessProbe() (r *schema.ReadinessProbe, exists bool) {
+	v := m.readiness_probe
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadinessProbe returns the old "readiness_probe" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldReadinessProbe(ctx context.Context) (v *schema.ReadinessProbe, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadinessProbe is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadinessProbe requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadinessProbe: %w", err)
+	}
+	return oldValue.ReadinessProbe, nil
+}
+
+// ClearReadinessProbe clears the value of the "readiness_probe" field.
+func (m *EnvBuildMutation) ClearReadinessProbe() {
+	m.readiness_probe = nil
+	m.clearedFields[envbuild.FieldReadinessProbe] = struct{}{}
+}
+
+// ReadinessProbeCleared returns if the "readiness_probe" field was cleared in this mutation.
+func (m *EnvBuildMutation) ReadinessProbeCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldReadinessProbe]
+	return ok
+}
+
+// ResetReadinessProbe resets all changes to the "readiness_probe" field.
+func (m *EnvBuildMutation) ResetReadinessProbe() {
+	m.readiness_probe = nil
+	delete(m.clearedFields, envbuild.FieldReadinessProbe)
+}
+
+// SetHooks sets the "hooks" field.
+func (m *EnvBuildMutation) SetHooks(sh *schema.LifecycleHooks) {
+	m._hooks = &sh
+}
+
+// Hooks returns the value of the "hooks" field in the mutation.
+func (m *EnvBuildMutation) Hooks() (r *schema.LifecycleHooks, exists bool) {
+	v := m._hooks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHooks returns the old "hooks" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldHooks(ctx context.Context) (v *schema.LifecycleHooks, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHooks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHooks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHooks: %w", err)
+	}
+	return oldValue.Hooks, nil
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (m *EnvBuildMutation) ClearHooks() {
+	m._hooks = nil
+	m.clearedFields[envbuild.FieldHooks] = struct{}{}
+}
+
+// HooksCleared returns if the "hooks" field was cleared in this mutation.
+func (m *EnvBuildMutation) HooksCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldHooks]
+	return ok
+}
+
+// ResetHooks resets all changes to the "hooks" field.
+func (m *EnvBuildMutation) ResetHooks() {
+	m._hooks = nil
+	delete(m.clearedFields, envbuild.FieldHooks)
+}
+
+// SetHardening sets the "hardening" field.
+func (m *EnvBuildMutation) SetHardening(sp *schema.HardeningPolicy) {
+	m.hardening = &sp
+}
+
+// Hardening returns the value of the "hardening" field in the mutation.
+func (m *EnvBuildMutation) Hardening() (r *schema.HardeningPolicy, exists bool) {
+	v := m.hardening
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHardening returns the old "hardening" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldHardening(ctx context.Context) (v *schema.HardeningPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHardening is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHardening requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHardening: %w", err)
+	}
+	return oldValue.Hardening, nil
+}
+
+// ClearHardening clears the value of the "hardening" field.
+func (m *EnvBuildMutation) ClearHardening() {
+	m.hardening = nil
+	m.clearedFields[envbuild.FieldHardening] = struct{}{}
+}
+
+// HardeningCleared returns if the "hardening" field was cleared in this mutation.
+func (m *EnvBuildMutation) HardeningCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldHardening]
+	return ok
+}
+
+// ResetHardening resets all changes to the "hardening" field.
+func (m *EnvBuildMutation) ResetHardening() {
+	m.hardening = nil
+	delete(m.clearedFields, envbuild.FieldHardening)
+}
+
+// SetEgress sets the "egress" field.
+func (m *EnvBuildMutation) SetEgress(sp *schema.EgressPolicy) {
+	m.egress = &sp
+}
+
+// Egress returns the value of the "egress" field in the mutation.
+func (m *EnvBuildMutation) Egress() (r *schema.EgressPolicy, exists bool) {
+	v := m.egress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEgress returns the old "egress" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldEgress(ctx context.Context) (v *schema.EgressPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEgress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEgress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEgress: %w", err)
+	}
+	return oldValue.Egress, nil
+}
+
+// ClearEgress clears the value of the "egress" field.
+func (m *EnvBuildMutation) ClearEgress() {
+	m.egress = nil
+	m.clearedFields[envbuild.FieldEgress] = struct{}{}
+}
+
+// EgressCleared returns if the "egress" field was cleared in this mutation.
+func (m *EnvBuildMutation) EgressCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldEgress]
+	return ok
+}
+
+// ResetEgress resets all changes to the "egress" field.
+func (m *EnvBuildMutation) ResetEgress() {
+	m.egress = nil
+	delete(m.clearedFields, envbuild.FieldEgress)
+}
+
 // SetVcpu sets the "vcpu" field.
 func (m *EnvBuildMutation) SetVcpu(i int64) {
 	m.vcpu = &i
@@ -2845,127 +3225,11078 @@ func (m *EnvBuildMutation) ResetKernelVersion() {
 	m.kernel_version = nil
 }
 
-// SetFirecrackerVersion sets the "firecracker_version" field.
-func (m *EnvBuildMutation) SetFirecrackerVersion(s string) {
-	m.firecracker_version = &s
+// SetKernelArgs sets the "kernel_args" field.
+func (m *EnvBuildMutation) SetKernelArgs(s []string) {
+	m.kernel_args = &s
+	m.appendkernel_args = nil
 }
 
-// FirecrackerVersion returns the value of the "firecracker_version" field in the mutation.
-func (m *EnvBuildMutation) FirecrackerVersion() (r string, exists bool) {
-	v := m.firecracker_version
+// KernelArgs returns the value of the "kernel_args" field in the mutation.
+func (m *EnvBuildMutation) KernelArgs() (r []string, exists bool) {
+	v := m.kernel_args
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirecrackerVersion returns the old "firecracker_version" field's value of the EnvBuild entity.
+// OldKernelArgs returns the old "kernel_args" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFirecrackerVersion(ctx context.Context) (v string, err error) {
+func (m *EnvBuildMutation) OldKernelArgs(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirecrackerVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldKernelArgs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirecrackerVersion requires an ID field in the mutation")
+		return v, errors.New("OldKernelArgs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirecrackerVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldKernelArgs: %w", err)
 	}
-	return oldValue.FirecrackerVersion, nil
+	return oldValue.KernelArgs, nil
 }
 
-// ResetFirecrackerVersion resets all changes to the "firecracker_version" field.
-func (m *EnvBuildMutation) ResetFirecrackerVersion() {
-	m.firecracker_version = nil
+// AppendKernelArgs adds s to the "kernel_args" field.
+func (m *EnvBuildMutation) AppendKernelArgs(s []string) {
+	m.appendkernel_args = append(m.appendkernel_args, s...)
 }
 
-// SetEnvdVersion sets the "envd_version" field.
-func (m *EnvBuildMutation) SetEnvdVersion(s string) {
-	m.envd_version = &s
+// AppendedKernelArgs returns the list of values that were appended to the "kernel_args" field in this mutation.
+func (m *EnvBuildMutation) AppendedKernelArgs() ([]string, bool) {
+	if len(m.appendkernel_args) == 0 {
+		return nil, false
+	}
+	return m.appendkernel_args, true
 }
 
-// EnvdVersion returns the value of the "envd_version" field in the mutation.
-func (m *EnvBuildMutation) EnvdVersion() (r string, exists bool) {
-	v := m.envd_version
+// ClearKernelArgs clears the value of the "kernel_args" field.
+func (m *EnvBuildMutation) ClearKernelArgs() {
+	m.kernel_args = nil
+	m.appendkernel_args = nil
+	m.clearedFields[envbuild.FieldKernelArgs] = struct{}{}
+}
+
+// KernelArgsCleared returns if the "kernel_args" field was cleared in this mutation.
+func (m *EnvBuildMutation) KernelArgsCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldKernelArgs]
+	return ok
+}
+
+// ResetKernelArgs resets all changes to the "kernel_args" field.
+func (m *EnvBuildMutation) ResetKernelArgs() {
+	m.kernel_args = nil
+	m.appendkernel_args = nil
+	delete(m.clearedFields, envbuild.FieldKernelArgs)
+}
+
+// SetRootfsBlockSize sets the "rootfs_block_size" field.
+func (m *EnvBuildMutation) SetRootfsBlockSize(i int64) {
+	m.rootfs_block_size = &i
+	m.addrootfs_block_size = nil
+}
+
+// RootfsBlockSize returns the value of the "rootfs_block_size" field in the mutation.
+func (m *EnvBuildMutation) RootfsBlockSize() (r int64, exists bool) {
+	v := m.rootfs_block_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvdVersion returns the old "envd_version" field's value of the EnvBuild entity.
+// OldRootfsBlockSize returns the old "rootfs_block_size" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldEnvdVersion(ctx context.Context) (v *string, err error) {
+func (m *EnvBuildMutation) OldRootfsBlockSize(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvdVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldRootfsBlockSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvdVersion requires an ID field in the mutation")
+		return v, errors.New("OldRootfsBlockSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvdVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldRootfsBlockSize: %w", err)
 	}
-	return oldValue.EnvdVersion, nil
+	return oldValue.RootfsBlockSize, nil
 }
 
-// ClearEnvdVersion clears the value of the "envd_version" field.
-func (m *EnvBuildMutation) ClearEnvdVersion() {
-	m.envd_version = nil
-	m.clearedFields[envbuild.FieldEnvdVersion] = struct{}{}
+// AddRootfsBlockSize adds i to the "rootfs_block_size" field.
+func (m *EnvBuildMutation) AddRootfsBlockSize(i int64) {
+	if m.addrootfs_block_size != nil {
+		*m.addrootfs_block_size += i
+	} else {
+		m.addrootfs_block_size = &i
+	}
 }
 
-// EnvdVersionCleared returns if the "envd_version" field was cleared in this mutation.
-func (m *EnvBuildMutation) EnvdVersionCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldEnvdVersion]
-	return ok
+// AddedRootfsBlockSize returns the value that was added to the "rootfs_block_size" field in this mutation.
+func (m *EnvBuildMutation) AddedRootfsBlockSize() (r int64, exists bool) {
+	v := m.addrootfs_block_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEnvdVersion resets all changes to the "envd_version" field.
-func (m *EnvBuildMutation) ResetEnvdVersion() {
-	m.envd_version = nil
-	delete(m.clearedFields, envbuild.FieldEnvdVersion)
+// ClearRootfsBlockSize clears the value of the "rootfs_block_size" field.
+func (m *EnvBuildMutation) ClearRootfsBlockSize() {
+	m.rootfs_block_size = nil
+	m.addrootfs_block_size = nil
+	m.clearedFields[envbuild.FieldRootfsBlockSize] = struct{}{}
 }
 
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *EnvBuildMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+// RootfsBlockSizeCleared returns if the "rootfs_block_size" field was cleared in this mutation.
+func (m *EnvBuildMutation) RootfsBlockSizeCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldRootfsBlockSize]
+	return ok
 }
 
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *EnvBuildMutation) EnvCleared() bool {
-	return m.EnvIDCleared() || m.clearedenv
+// ResetRootfsBlockSize resets all changes to the "rootfs_block_size" field.
+func (m *EnvBuildMutation) ResetRootfsBlockSize() {
+	m.rootfs_block_size = nil
+	m.addrootfs_block_size = nil
+	delete(m.clearedFields, envbuild.FieldRootfsBlockSize)
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *EnvBuildMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetSecrets sets the "secrets" field.
+func (m *EnvBuildMutation) SetSecrets(s []string) {
+	m.secrets = &s
+	m.appendsecrets = nil
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *EnvBuildMutation) ResetEnv() {
-	m.env = nil
+// Secrets returns the value of the "secrets" field in the mutation.
+func (m *EnvBuildMutation) Secrets() (r []string, exists bool) {
+	v := m.secrets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecrets returns the old "secrets" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldSecrets(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecrets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecrets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecrets: %w", err)
+	}
+	return oldValue.Secrets, nil
+}
+
+// AppendSecrets adds s to the "secrets" field.
+func (m *EnvBuildMutation) AppendSecrets(s []string) {
+	m.appendsecrets = append(m.appendsecrets, s...)
+}
+
+// AppendedSecrets returns the list of values that were appended to the "secrets" field in this mutation.
+func (m *EnvBuildMutation) AppendedSecrets() ([]string, bool) {
+	if len(m.appendsecrets) == 0 {
+		return nil, false
+	}
+	return m.appendsecrets, true
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (m *EnvBuildMutation) ClearSecrets() {
+	m.secrets = nil
+	m.appendsecrets = nil
+	m.clearedFields[envbuild.FieldSecrets] = struct{}{}
+}
+
+// SecretsCleared returns if the "secrets" field was cleared in this mutation.
+func (m *EnvBuildMutation) SecretsCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldSecrets]
+	return ok
+}
+
+// ResetSecrets resets all changes to the "secrets" field.
+func (m *EnvBuildMutation) ResetSecrets() {
+	m.secrets = nil
+	m.appendsecrets = nil
+	delete(m.clearedFields, envbuild.FieldSecrets)
+}
+
+// SetFirecrackerVersion sets the "firecracker_version" field.
+func (m *EnvBuildMutation) SetFirecrackerVersion(s string) {
+	m.firecracker_version = &s
+}
+
+// FirecrackerVersion returns the value of the "firecracker_version" field in the mutation.
+func (m *EnvBuildMutation) FirecrackerVersion() (r string, exists bool) {
+	v := m.firecracker_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirecrackerVersion returns the old "firecracker_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldFirecrackerVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirecrackerVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirecrackerVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirecrackerVersion: %w", err)
+	}
+	return oldValue.FirecrackerVersion, nil
+}
+
+// ResetFirecrackerVersion resets all changes to the "firecracker_version" field.
+func (m *EnvBuildMutation) ResetFirecrackerVersion() {
+	m.firecracker_version = nil
+}
+
+// SetEnvdVersion sets the "envd_version" field.
+func (m *EnvBuildMutation) SetEnvdVersion(s string) {
+	m.envd_version = &s
+}
+
+// EnvdVersion returns the value of the "envd_version" field in the mutation.
+func (m *EnvBuildMutation) EnvdVersion() (r string, exists bool) {
+	v := m.envd_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvdVersion returns the old "envd_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldEnvdVersion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvdVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvdVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvdVersion: %w", err)
+	}
+	return oldValue.EnvdVersion, nil
+}
+
+// ClearEnvdVersion clears the value of the "envd_version" field.
+func (m *EnvBuildMutation) ClearEnvdVersion() {
+	m.envd_version = nil
+	m.clearedFields[envbuild.FieldEnvdVersion] = struct{}{}
+}
+
+// EnvdVersionCleared returns if the "envd_version" field was cleared in this mutation.
+func (m *EnvBuildMutation) EnvdVersionCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldEnvdVersion]
+	return ok
+}
+
+// ResetEnvdVersion resets all changes to the "envd_version" field.
+func (m *EnvBuildMutation) ResetEnvdVersion() {
+	m.envd_version = nil
+	delete(m.clearedFields, envbuild.FieldEnvdVersion)
+}
+
+// SetUploadStatus sets the "upload_status" field.
+func (m *EnvBuildMutation) SetUploadStatus(es envbuild.UploadStatus) {
+	m.upload_status = &es
+}
+
+// UploadStatus returns the value of the "upload_status" field in the mutation.
+func (m *EnvBuildMutation) UploadStatus() (r envbuild.UploadStatus, exists bool) {
+	v := m.upload_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadStatus returns the old "upload_status" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldUploadStatus(ctx context.Context) (v *envbuild.UploadStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadStatus: %w", err)
+	}
+	return oldValue.UploadStatus, nil
+}
+
+// ClearUploadStatus clears the value of the "upload_status" field.
+func (m *EnvBuildMutation) ClearUploadStatus() {
+	m.upload_status = nil
+	m.clearedFields[envbuild.FieldUploadStatus] = struct{}{}
+}
+
+// UploadStatusCleared returns if the "upload_status" field was cleared in this mutation.
+func (m *EnvBuildMutation) UploadStatusCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldUploadStatus]
+	return ok
+}
+
+// ResetUploadStatus resets all changes to the "upload_status" field.
+func (m *EnvBuildMutation) ResetUploadStatus() {
+	m.upload_status = nil
+	delete(m.clearedFields, envbuild.FieldUploadStatus)
+}
+
+// SetSnapshotNodeID sets the "snapshot_node_id" field.
+func (m *EnvBuildMutation) SetSnapshotNodeID(s string) {
+	m.snapshot_node_id = &s
+}
+
+// SnapshotNodeID returns the value of the "snapshot_node_id" field in the mutation.
+func (m *EnvBuildMutation) SnapshotNodeID() (r string, exists bool) {
+	v := m.snapshot_node_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshotNodeID returns the old "snapshot_node_id" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldSnapshotNodeID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshotNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshotNodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshotNodeID: %w", err)
+	}
+	return oldValue.SnapshotNodeID, nil
+}
+
+// ClearSnapshotNodeID clears the value of the "snapshot_node_id" field.
+func (m *EnvBuildMutation) ClearSnapshotNodeID() {
+	m.snapshot_node_id = nil
+	m.clearedFields[envbuild.FieldSnapshotNodeID] = struct{}{}
+}
+
+// SnapshotNodeIDCleared returns if the "snapshot_node_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) SnapshotNodeIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldSnapshotNodeID]
+	return ok
+}
+
+// ResetSnapshotNodeID resets all changes to the "snapshot_node_id" field.
+func (m *EnvBuildMutation) ResetSnapshotNodeID() {
+	m.snapshot_node_id = nil
+	delete(m.clearedFields, envbuild.FieldSnapshotNodeID)
+}
+
+// SetReplicatedRegions sets the "replicated_regions" field.
+func (m *EnvBuildMutation) SetReplicatedRegions(s []string) {
+	m.replicated_regions = &s
+	m.appendreplicated_regions = nil
+}
+
+// ReplicatedRegions returns the value of the "replicated_regions" field in the mutation.
+func (m *EnvBuildMutation) ReplicatedRegions() (r []string, exists bool) {
+	v := m.replicated_regions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplicatedRegions returns the old "replicated_regions" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldReplicatedRegions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplicatedRegions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplicatedRegions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplicatedRegions: %w", err)
+	}
+	return oldValue.ReplicatedRegions, nil
+}
+
+// AppendReplicatedRegions adds s to the "replicated_regions" field.
+func (m *EnvBuildMutation) AppendReplicatedRegions(s []string) {
+	m.appendreplicated_regions = append(m.appendreplicated_regions, s...)
+}
+
+// AppendedReplicatedRegions returns the list of values that were appended to the "replicated_regions" field in this mutation.
+func (m *EnvBuildMutation) AppendedReplicatedRegions() ([]string, bool) {
+	if len(m.appendreplicated_regions) == 0 {
+		return nil, false
+	}
+	return m.appendreplicated_regions, true
+}
+
+// ClearReplicatedRegions clears the value of the "replicated_regions" field.
+func (m *EnvBuildMutation) ClearReplicatedRegions() {
+	m.replicated_regions = nil
+	m.appendreplicated_regions = nil
+	m.clearedFields[envbuild.FieldReplicatedRegions] = struct{}{}
+}
+
+// ReplicatedRegionsCleared returns if the "replicated_regions" field was cleared in this mutation.
+func (m *EnvBuildMutation) ReplicatedRegionsCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldReplicatedRegions]
+	return ok
+}
+
+// ResetReplicatedRegions resets all changes to the "replicated_regions" field.
+func (m *EnvBuildMutation) ResetReplicatedRegions() {
+	m.replicated_regions = nil
+	m.appendreplicated_regions = nil
+	delete(m.clearedFields, envbuild.FieldReplicatedRegions)
+}
+
+// SetSourceBuildID sets the "source_build_id" field.
+func (m *EnvBuildMutation) SetSourceBuildID(u uuid.UUID) {
+	m.source_build_id = &u
+}
+
+// SourceBuildID returns the value of the "source_build_id" field in the mutation.
+func (m *EnvBuildMutation) SourceBuildID() (r uuid.UUID, exists bool) {
+	v := m.source_build_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceBuildID returns the old "source_build_id" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldSourceBuildID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceBuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceBuildID: %w", err)
+	}
+	return oldValue.SourceBuildID, nil
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (m *EnvBuildMutation) ClearSourceBuildID() {
+	m.source_build_id = nil
+	m.clearedFields[envbuild.FieldSourceBuildID] = struct{}{}
+}
+
+// SourceBuildIDCleared returns if the "source_build_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) SourceBuildIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldSourceBuildID]
+	return ok
+}
+
+// ResetSourceBuildID resets all changes to the "source_build_id" field.
+func (m *EnvBuildMutation) ResetSourceBuildID() {
+	m.source_build_id = nil
+	delete(m.clearedFields, envbuild.FieldSourceBuildID)
+}
+
+// SetVariant sets the "variant" field.
+func (m *EnvBuildMutation) SetVariant(s string) {
+	m.variant = &s
+}
+
+// Variant returns the value of the "variant" field in the mutation.
+func (m *EnvBuildMutation) Variant() (r string, exists bool) {
+	v := m.variant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariant returns the old "variant" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldVariant(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariant: %w", err)
+	}
+	return oldValue.Variant, nil
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (m *EnvBuildMutation) ClearVariant() {
+	m.variant = nil
+	m.clearedFields[envbuild.FieldVariant] = struct{}{}
+}
+
+// VariantCleared returns if the "variant" field was cleared in this mutation.
+func (m *EnvBuildMutation) VariantCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldVariant]
+	return ok
+}
+
+// ResetVariant resets all changes to the "variant" field.
+func (m *EnvBuildMutation) ResetVariant() {
+	m.variant = nil
+	delete(m.clearedFields, envbuild.FieldVariant)
+}
+
+// SetVariantOf sets the "variant_of" field.
+func (m *EnvBuildMutation) SetVariantOf(u uuid.UUID) {
+	m.variant_of = &u
+}
+
+// VariantOf returns the value of the "variant_of" field in the mutation.
+func (m *EnvBuildMutation) VariantOf() (r uuid.UUID, exists bool) {
+	v := m.variant_of
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariantOf returns the old "variant_of" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldVariantOf(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariantOf is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariantOf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariantOf: %w", err)
+	}
+	return oldValue.VariantOf, nil
+}
+
+// ClearVariantOf clears the value of the "variant_of" field.
+func (m *EnvBuildMutation) ClearVariantOf() {
+	m.variant_of = nil
+	m.clearedFields[envbuild.FieldVariantOf] = struct{}{}
+}
+
+// VariantOfCleared returns if the "variant_of" field was cleared in this mutation.
+func (m *EnvBuildMutation) VariantOfCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldVariantOf]
+	return ok
+}
+
+// ResetVariantOf resets all changes to the "variant_of" field.
+func (m *EnvBuildMutation) ResetVariantOf() {
+	m.variant_of = nil
+	delete(m.clearedFields, envbuild.FieldVariantOf)
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvBuildMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvBuildMutation) EnvCleared() bool {
+	return m.EnvIDCleared() || m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvBuildMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvBuildMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// Where appends a list predicates to the EnvBuildMutation builder.
+func (m *EnvBuildMutation) Where(ps ...predicate.EnvBuild) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvBuildMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvBuild, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvBuildMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvBuildMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvBuild).
+func (m *EnvBuildMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvBuildMutation) Fields() []string {
+	fields := make([]string, 0, 28)
+	if m.created_at != nil {
+		fields = append(fields, envbuild.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, envbuild.FieldUpdatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, envbuild.FieldFinishedAt)
+	}
+	if m.heartbeat_at != nil {
+		fields = append(fields, envbuild.FieldHeartbeatAt)
+	}
+	if m.env != nil {
+		fields = append(fields, envbuild.FieldEnvID)
+	}
+	if m.status != nil {
+		fields = append(fields, envbuild.FieldStatus)
+	}
+	if m.dockerfile != nil {
+		fields = append(fields, envbuild.FieldDockerfile)
+	}
+	if m.start_cmd != nil {
+		fields = append(fields, envbuild.FieldStartCmd)
+	}
+	if m.readiness_probe != nil {
+		fields = append(fields, envbuild.FieldReadinessProbe)
+	}
+	if m._hooks != nil {
+		fields = append(fields, envbuild.FieldHooks)
+	}
+	if m.hardening != nil {
+		fields = append(fields, envbuild.FieldHardening)
+	}
+	if m.egress != nil {
+		fields = append(fields, envbuild.FieldEgress)
+	}
+	if m.vcpu != nil {
+		fields = append(fields, envbuild.FieldVcpu)
+	}
+	if m.ram_mb != nil {
+		fields = append(fields, envbuild.FieldRAMMB)
+	}
+	if m.free_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	}
+	if m.total_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.kernel_version != nil {
+		fields = append(fields, envbuild.FieldKernelVersion)
+	}
+	if m.kernel_args != nil {
+		fields = append(fields, envbuild.FieldKernelArgs)
+	}
+	if m.rootfs_block_size != nil {
+		fields = append(fields, envbuild.FieldRootfsBlockSize)
+	}
+	if m.secrets != nil {
+		fields = append(fields, envbuild.FieldSecrets)
+	}
+	if m.firecracker_version != nil {
+		fields = append(fields, envbuild.FieldFirecrackerVersion)
+	}
+	if m.envd_version != nil {
+		fields = append(fields, envbuild.FieldEnvdVersion)
+	}
+	if m.upload_status != nil {
+		fields = append(fields, envbuild.FieldUploadStatus)
+	}
+	if m.snapshot_node_id != nil {
+		fields = append(fields, envbuild.FieldSnapshotNodeID)
+	}
+	if m.replicated_regions != nil {
+		fields = append(fields, envbuild.FieldReplicatedRegions)
+	}
+	if m.source_build_id != nil {
+		fields = append(fields, envbuild.FieldSourceBuildID)
+	}
+	if m.variant != nil {
+		fields = append(fields, envbuild.FieldVariant)
+	}
+	if m.variant_of != nil {
+		fields = append(fields, envbuild.FieldVariantOf)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		return m.CreatedAt()
+	case envbuild.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case envbuild.FieldFinishedAt:
+		return m.FinishedAt()
+	case envbuild.FieldHeartbeatAt:
+		return m.HeartbeatAt()
+	case envbuild.FieldEnvID:
+		return m.EnvID()
+	case envbuild.FieldStatus:
+		return m.Status()
+	case envbuild.FieldDockerfile:
+		return m.Dockerfile()
+	case envbuild.FieldStartCmd:
+		return m.StartCmd()
+	case envbuild.FieldReadinessProbe:
+		return m.ReadinessProbe()
+	case envbuild.FieldHooks:
+		return m.Hooks()
+	case envbuild.FieldHardening:
+		return m.Hardening()
+	case envbuild.FieldEgress:
+		return m.Egress()
+	case envbuild.FieldVcpu:
+		return m.Vcpu()
+	case envbuild.FieldRAMMB:
+		return m.RAMMB()
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.FreeDiskSizeMB()
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.TotalDiskSizeMB()
+	case envbuild.FieldKernelVersion:
+		return m.KernelVersion()
+	case envbuild.FieldKernelArgs:
+		return m.KernelArgs()
+	case envbuild.FieldRootfsBlockSize:
+		return m.RootfsBlockSize()
+	case envbuild.FieldSecrets:
+		return m.Secrets()
+	case envbuild.FieldFirecrackerVersion:
+		return m.FirecrackerVersion()
+	case envbuild.FieldEnvdVersion:
+		return m.EnvdVersion()
+	case envbuild.FieldUploadStatus:
+		return m.UploadStatus()
+	case envbuild.FieldSnapshotNodeID:
+		return m.SnapshotNodeID()
+	case envbuild.FieldReplicatedRegions:
+		return m.ReplicatedRegions()
+	case envbuild.FieldSourceBuildID:
+		return m.SourceBuildID()
+	case envbuild.FieldVariant:
+		return m.Variant()
+	case envbuild.FieldVariantOf:
+		return m.VariantOf()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case envbuild.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case envbuild.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case envbuild.FieldHeartbeatAt:
+		return m.OldHeartbeatAt(ctx)
+	case envbuild.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envbuild.FieldStatus:
+		return m.OldStatus(ctx)
+	case envbuild.FieldDockerfile:
+		return m.OldDockerfile(ctx)
+	case envbuild.FieldStartCmd:
+		return m.OldStartCmd(ctx)
+	case envbuild.FieldReadinessProbe:
+		return m.OldReadinessProbe(ctx)
+	case envbuild.FieldHooks:
+		return m.OldHooks(ctx)
+	case envbuild.FieldHardening:
+		return m.OldHardening(ctx)
+	case envbuild.FieldEgress:
+		return m.OldEgress(ctx)
+	case envbuild.FieldVcpu:
+		return m.OldVcpu(ctx)
+	case envbuild.FieldRAMMB:
+		return m.OldRAMMB(ctx)
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.OldFreeDiskSizeMB(ctx)
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.OldTotalDiskSizeMB(ctx)
+	case envbuild.FieldKernelVersion:
+		return m.OldKernelVersion(ctx)
+	case envbuild.FieldKernelArgs:
+		return m.OldKernelArgs(ctx)
+	case envbuild.FieldRootfsBlockSize:
+		return m.OldRootfsBlockSize(ctx)
+	case envbuild.FieldSecrets:
+		return m.OldSecrets(ctx)
+	case envbuild.FieldFirecrackerVersion:
+		return m.OldFirecrackerVersion(ctx)
+	case envbuild.FieldEnvdVersion:
+		return m.OldEnvdVersion(ctx)
+	case envbuild.FieldUploadStatus:
+		return m.OldUploadStatus(ctx)
+	case envbuild.FieldSnapshotNodeID:
+		return m.OldSnapshotNodeID(ctx)
+	case envbuild.FieldReplicatedRegions:
+		return m.OldReplicatedRegions(ctx)
+	case envbuild.FieldSourceBuildID:
+		return m.OldSourceBuildID(ctx)
+	case envbuild.FieldVariant:
+		return m.OldVariant(ctx)
+	case envbuild.FieldVariantOf:
+		return m.OldVariantOf(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvBuild field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envbuild.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case envbuild.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case envbuild.FieldHeartbeatAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeartbeatAt(v)
+		return nil
+	case envbuild.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envbuild.FieldStatus:
+		v, ok := value.(envbuild.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case envbuild.FieldDockerfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerfile(v)
+		return nil
+	case envbuild.FieldStartCmd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartCmd(v)
+		return nil
+	case envbuild.FieldReadinessProbe:
+		v, ok := value.(*schema.ReadinessProbe)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadinessProbe(v)
+		return nil
+	case envbuild.FieldHooks:
+		v, ok := value.(*schema.LifecycleHooks)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHooks(v)
+		return nil
+	case envbuild.FieldHardening:
+		v, ok := value.(*schema.HardeningPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHardening(v)
+		return nil
+	case envbuild.FieldEgress:
+		v, ok := value.(*schema.EgressPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEgress(v)
+		return nil
+	case envbuild.FieldVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVcpu(v)
+		return nil
+	case envbuild.FieldRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRAMMB(v)
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFreeDiskSizeMB(v)
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalDiskSizeMB(v)
+		return nil
+	case envbuild.FieldKernelVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKernelVersion(v)
+		return nil
+	case envbuild.FieldKernelArgs:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKernelArgs(v)
+		return nil
+	case envbuild.FieldRootfsBlockSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRootfsBlockSize(v)
+		return nil
+	case envbuild.FieldSecrets:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecrets(v)
+		return nil
+	case envbuild.FieldFirecrackerVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirecrackerVersion(v)
+		return nil
+	case envbuild.FieldEnvdVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvdVersion(v)
+		return nil
+	case envbuild.FieldUploadStatus:
+		v, ok := value.(envbuild.UploadStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadStatus(v)
+		return nil
+	case envbuild.FieldSnapshotNodeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshotNodeID(v)
+		return nil
+	case envbuild.FieldReplicatedRegions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplicatedRegions(v)
+		return nil
+	case envbuild.FieldSourceBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceBuildID(v)
+		return nil
+	case envbuild.FieldVariant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariant(v)
+		return nil
+	case envbuild.FieldVariantOf:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariantOf(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvBuildMutation) AddedFields() []string {
+	var fields []string
+	if m.addvcpu != nil {
+		fields = append(fields, envbuild.FieldVcpu)
+	}
+	if m.addram_mb != nil {
+		fields = append(fields, envbuild.FieldRAMMB)
+	}
+	if m.addfree_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	}
+	if m.addtotal_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.addrootfs_block_size != nil {
+		fields = append(fields, envbuild.FieldRootfsBlockSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case envbuild.FieldVcpu:
+		return m.AddedVcpu()
+	case envbuild.FieldRAMMB:
+		return m.AddedRAMMB()
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.AddedFreeDiskSizeMB()
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.AddedTotalDiskSizeMB()
+	case envbuild.FieldRootfsBlockSize:
+		return m.AddedRootfsBlockSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case envbuild.FieldVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVcpu(v)
+		return nil
+	case envbuild.FieldRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRAMMB(v)
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFreeDiskSizeMB(v)
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDiskSizeMB(v)
+		return nil
+	case envbuild.FieldRootfsBlockSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRootfsBlockSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvBuildMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(envbuild.FieldFinishedAt) {
+		fields = append(fields, envbuild.FieldFinishedAt)
+	}
+	if m.FieldCleared(envbuild.FieldHeartbeatAt) {
+		fields = append(fields, envbuild.FieldHeartbeatAt)
+	}
+	if m.FieldCleared(envbuild.FieldEnvID) {
+		fields = append(fields, envbuild.FieldEnvID)
+	}
+	if m.FieldCleared(envbuild.FieldDockerfile) {
+		fields = append(fields, envbuild.FieldDockerfile)
+	}
+	if m.FieldCleared(envbuild.FieldStartCmd) {
+		fields = append(fields, envbuild.FieldStartCmd)
+	}
+	if m.FieldCleared(envbuild.FieldReadinessProbe) {
+		fields = append(fields, envbuild.FieldReadinessProbe)
+	}
+	if m.FieldCleared(envbuild.FieldHooks) {
+		fields = append(fields, envbuild.FieldHooks)
+	}
+	if m.FieldCleared(envbuild.FieldHardening) {
+		fields = append(fields, envbuild.FieldHardening)
+	}
+	if m.FieldCleared(envbuild.FieldEgress) {
+		fields = append(fields, envbuild.FieldEgress)
+	}
+	if m.FieldCleared(envbuild.FieldTotalDiskSizeMB) {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.FieldCleared(envbuild.FieldKernelArgs) {
+		fields = append(fields, envbuild.FieldKernelArgs)
+	}
+	if m.FieldCleared(envbuild.FieldRootfsBlockSize) {
+		fields = append(fields, envbuild.FieldRootfsBlockSize)
+	}
+	if m.FieldCleared(envbuild.FieldSecrets) {
+		fields = append(fields, envbuild.FieldSecrets)
+	}
+	if m.FieldCleared(envbuild.FieldEnvdVersion) {
+		fields = append(fields, envbuild.FieldEnvdVersion)
+	}
+	if m.FieldCleared(envbuild.FieldUploadStatus) {
+		fields = append(fields, envbuild.FieldUploadStatus)
+	}
+	if m.FieldCleared(envbuild.FieldSnapshotNodeID) {
+		fields = append(fields, envbuild.FieldSnapshotNodeID)
+	}
+	if m.FieldCleared(envbuild.FieldReplicatedRegions) {
+		fields = append(fields, envbuild.FieldReplicatedRegions)
+	}
+	if m.FieldCleared(envbuild.FieldSourceBuildID) {
+		fields = append(fields, envbuild.FieldSourceBuildID)
+	}
+	if m.FieldCleared(envbuild.FieldVariant) {
+		fields = append(fields, envbuild.FieldVariant)
+	}
+	if m.FieldCleared(envbuild.FieldVariantOf) {
+		fields = append(fields, envbuild.FieldVariantOf)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvBuildMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvBuildMutation) ClearField(name string) error {
+	switch name {
+	case envbuild.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case envbuild.FieldHeartbeatAt:
+		m.ClearHeartbeatAt()
+		return nil
+	case envbuild.FieldEnvID:
+		m.ClearEnvID()
+		return nil
+	case envbuild.FieldDockerfile:
+		m.ClearDockerfile()
+		return nil
+	case envbuild.FieldStartCmd:
+		m.ClearStartCmd()
+		return nil
+	case envbuild.FieldReadinessProbe:
+		m.ClearReadinessProbe()
+		return nil
+	case envbuild.FieldHooks:
+		m.ClearHooks()
+		return nil
+	case envbuild.FieldHardening:
+		m.ClearHardening()
+		return nil
+	case envbuild.FieldEgress:
+		m.ClearEgress()
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		m.ClearTotalDiskSizeMB()
+		return nil
+	case envbuild.FieldKernelArgs:
+		m.ClearKernelArgs()
+		return nil
+	case envbuild.FieldRootfsBlockSize:
+		m.ClearRootfsBlockSize()
+		return nil
+	case envbuild.FieldSecrets:
+		m.ClearSecrets()
+		return nil
+	case envbuild.FieldEnvdVersion:
+		m.ClearEnvdVersion()
+		return nil
+	case envbuild.FieldUploadStatus:
+		m.ClearUploadStatus()
+		return nil
+	case envbuild.FieldSnapshotNodeID:
+		m.ClearSnapshotNodeID()
+		return nil
+	case envbuild.FieldReplicatedRegions:
+		m.ClearReplicatedRegions()
+		return nil
+	case envbuild.FieldSourceBuildID:
+		m.ClearSourceBuildID()
+		return nil
+	case envbuild.FieldVariant:
+		m.ClearVariant()
+		return nil
+	case envbuild.FieldVariantOf:
+		m.ClearVariantOf()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvBuildMutation) ResetField(name string) error {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case envbuild.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case envbuild.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case envbuild.FieldHeartbeatAt:
+		m.ResetHeartbeatAt()
+		return nil
+	case envbuild.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envbuild.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case envbuild.FieldDockerfile:
+		m.ResetDockerfile()
+		return nil
+	case envbuild.FieldStartCmd:
+		m.ResetStartCmd()
+		return nil
+	case envbuild.FieldReadinessProbe:
+		m.ResetReadinessProbe()
+		return nil
+	case envbuild.FieldHooks:
+		m.ResetHooks()
+		return nil
+	case envbuild.FieldHardening:
+		m.ResetHardening()
+		return nil
+	case envbuild.FieldEgress:
+		m.ResetEgress()
+		return nil
+	case envbuild.FieldVcpu:
+		m.ResetVcpu()
+		return nil
+	case envbuild.FieldRAMMB:
+		m.ResetRAMMB()
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		m.ResetFreeDiskSizeMB()
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		m.ResetTotalDiskSizeMB()
+		return nil
+	case envbuild.FieldKernelVersion:
+		m.ResetKernelVersion()
+		return nil
+	case envbuild.FieldKernelArgs:
+		m.ResetKernelArgs()
+		return nil
+	case envbuild.FieldRootfsBlockSize:
+		m.ResetRootfsBlockSize()
+		return nil
+	case envbuild.FieldSecrets:
+		m.ResetSecrets()
+		return nil
+	case envbuild.FieldFirecrackerVersion:
+		m.ResetFirecrackerVersion()
+		return nil
+	case envbuild.FieldEnvdVersion:
+		m.ResetEnvdVersion()
+		return nil
+	case envbuild.FieldUploadStatus:
+		m.ResetUploadStatus()
+		return nil
+	case envbuild.FieldSnapshotNodeID:
+		m.ResetSnapshotNodeID()
+		return nil
+	case envbuild.FieldReplicatedRegions:
+		m.ResetReplicatedRegions()
+		return nil
+	case envbuild.FieldSourceBuildID:
+		m.ResetSourceBuildID()
+		return nil
+	case envbuild.FieldVariant:
+		m.ResetVariant()
+		return nil
+	case envbuild.FieldVariantOf:
+		m.ResetVariantOf()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvBuildMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.env != nil {
+		edges = append(edges, envbuild.EdgeEnv)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvBuildMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envbuild.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvBuildMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvBuildMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvBuildMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenv {
+		edges = append(edges, envbuild.EdgeEnv)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvBuildMutation) EdgeCleared(name string) bool {
+	switch name {
+	case envbuild.EdgeEnv:
+		return m.clearedenv
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvBuildMutation) ClearEdge(name string) error {
+	switch name {
+	case envbuild.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvBuildMutation) ResetEdge(name string) error {
+	switch name {
+	case envbuild.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild edge %s", name)
+}
+
+// EnvBuildLogMutation represents an operation that mutates the EnvBuildLog nodes in the graph.
+type EnvBuildLogMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	team_id       *uuid.UUID
+	env_id        *string
+	build_id      *uuid.UUID
+	line          *int32
+	addline       *int32
+	message       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EnvBuildLog, error)
+	predicates    []predicate.EnvBuildLog
+}
+
+var _ ent.Mutation = (*EnvBuildLogMutation)(nil)
+
+// envbuildlogOption allows management of the mutation configuration using functional options.
+type envbuildlogOption func(*EnvBuildLogMutation)
+
+// newEnvBuildLogMutation creates new mutation for the EnvBuildLog entity.
+func newEnvBuildLogMutation(c config, op Op, opts ...envbuildlogOption) *EnvBuildLogMutation {
+	m := &EnvBuildLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvBuildLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvBuildLogID sets the ID field of the mutation.
+func withEnvBuildLogID(id int) envbuildlogOption {
+	return func(m *EnvBuildLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvBuildLog
+		)
+		m.oldValue = func(ctx context.Context) (*EnvBuildLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvBuildLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvBuildLog sets the old EnvBuildLog of the mutation.
+func withEnvBuildLog(node *EnvBuildLog) envbuildlogOption {
+	return func(m *EnvBuildLogMutation) {
+		m.oldValue = func(context.Context) (*EnvBuildLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvBuildLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvBuildLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvBuildLogMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvBuildLogMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvBuildLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvBuildLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvBuildLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EnvBuildLog entity.
+// If the EnvBuildLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvBuildLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *EnvBuildLogMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *EnvBuildLogMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the EnvBuildLog entity.
+// If the EnvBuildLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildLogMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *EnvBuildLogMutation) ResetTeamID() {
+	m.team_id = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *EnvBuildLogMutation) SetEnvID(s string) {
+	m.env_id = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvBuildLogMutation) EnvID() (r string, exists bool) {
+	v := m.env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the EnvBuildLog entity.
+// If the EnvBuildLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildLogMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvBuildLogMutation) ResetEnvID() {
+	m.env_id = nil
+}
+
+// SetBuildID sets the "build_id" field.
+func (m *EnvBuildLogMutation) SetBuildID(u uuid.UUID) {
+	m.build_id = &u
+}
+
+// BuildID returns the value of the "build_id" field in the mutation.
+func (m *EnvBuildLogMutation) BuildID() (r uuid.UUID, exists bool) {
+	v := m.build_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildID returns the old "build_id" field's value of the EnvBuildLog entity.
+// If the EnvBuildLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildLogMutation) OldBuildID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildID: %w", err)
+	}
+	return oldValue.BuildID, nil
+}
+
+// ResetBuildID resets all changes to the "build_id" field.
+func (m *EnvBuildLogMutation) ResetBuildID() {
+	m.build_id = nil
+}
+
+// SetLine sets the "line" field.
+func (m *EnvBuildLogMutation) SetLine(i int32) {
+	m.line = &i
+	m.addline = nil
+}
+
+// Line returns the value of the "line" field in the mutation.
+func (m *EnvBuildLogMutation) Line() (r int32, exists bool) {
+	v := m.line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLine returns the old "line" field's value of the EnvBuildLog entity.
+// If the EnvBuildLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildLogMutation) OldLine(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLine: %w", err)
+	}
+	return oldValue.Line, nil
+}
+
+// AddLine adds i to the "line" field.
+func (m *EnvBuildLogMutation) AddLine(i int32) {
+	if m.addline != nil {
+		*m.addline += i
+	} else {
+		m.addline = &i
+	}
+}
+
+// AddedLine returns the value that was added to the "line" field in this mutation.
+func (m *EnvBuildLogMutation) AddedLine() (r int32, exists bool) {
+	v := m.addline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLine resets all changes to the "line" field.
+func (m *EnvBuildLogMutation) ResetLine() {
+	m.line = nil
+	m.addline = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *EnvBuildLogMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *EnvBuildLogMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the EnvBuildLog entity.
+// If the EnvBuildLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildLogMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *EnvBuildLogMutation) ResetMessage() {
+	m.message = nil
+}
+
+// Where appends a list predicates to the EnvBuildLogMutation builder.
+func (m *EnvBuildLogMutation) Where(ps ...predicate.EnvBuildLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvBuildLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvBuildLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvBuildLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvBuildLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvBuildLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvBuildLog).
+func (m *EnvBuildLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvBuildLogMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, envbuildlog.FieldCreatedAt)
+	}
+	if m.team_id != nil {
+		fields = append(fields, envbuildlog.FieldTeamID)
+	}
+	if m.env_id != nil {
+		fields = append(fields, envbuildlog.FieldEnvID)
+	}
+	if m.build_id != nil {
+		fields = append(fields, envbuildlog.FieldBuildID)
+	}
+	if m.line != nil {
+		fields = append(fields, envbuildlog.FieldLine)
+	}
+	if m.message != nil {
+		fields = append(fields, envbuildlog.FieldMessage)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvBuildLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envbuildlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case envbuildlog.FieldTeamID:
+		return m.TeamID()
+	case envbuildlog.FieldEnvID:
+		return m.EnvID()
+	case envbuildlog.FieldBuildID:
+		return m.BuildID()
+	case envbuildlog.FieldLine:
+		return m.Line()
+	case envbuildlog.FieldMessage:
+		return m.Message()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvBuildLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envbuildlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case envbuildlog.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case envbuildlog.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envbuildlog.FieldBuildID:
+		return m.OldBuildID(ctx)
+	case envbuildlog.FieldLine:
+		return m.OldLine(ctx)
+	case envbuildlog.FieldMessage:
+		return m.OldMessage(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvBuildLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envbuildlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envbuildlog.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case envbuildlog.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envbuildlog.FieldBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildID(v)
+		return nil
+	case envbuildlog.FieldLine:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLine(v)
+		return nil
+	case envbuildlog.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuildLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvBuildLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addline != nil {
+		fields = append(fields, envbuildlog.FieldLine)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvBuildLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case envbuildlog.FieldLine:
+		return m.AddedLine()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case envbuildlog.FieldLine:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLine(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuildLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvBuildLogMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvBuildLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvBuildLogMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EnvBuildLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvBuildLogMutation) ResetField(name string) error {
+	switch name {
+	case envbuildlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case envbuildlog.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case envbuildlog.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envbuildlog.FieldBuildID:
+		m.ResetBuildID()
+		return nil
+	case envbuildlog.FieldLine:
+		m.ResetLine()
+		return nil
+	case envbuildlog.FieldMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuildLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvBuildLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvBuildLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvBuildLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvBuildLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvBuildLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvBuildLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvBuildLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EnvBuildLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvBuildLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EnvBuildLog edge %s", name)
+}
+
+// EnvRebuildScheduleMutation represents an operation that mutates the EnvRebuildSchedule nodes in the graph.
+type EnvRebuildScheduleMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	env_id            *string
+	interval_hours    *int32
+	addinterval_hours *int32
+	next_run_at       *time.Time
+	upgrade_packages  *bool
+	webhook_url       *string
+	webhook_secret    *string
+	last_build_id     *uuid.UUID
+	last_build_status *envrebuildschedule.LastBuildStatus
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*EnvRebuildSchedule, error)
+	predicates        []predicate.EnvRebuildSchedule
+}
+
+var _ ent.Mutation = (*EnvRebuildScheduleMutation)(nil)
+
+// envrebuildscheduleOption allows management of the mutation configuration using functional options.
+type envrebuildscheduleOption func(*EnvRebuildScheduleMutation)
+
+// newEnvRebuildScheduleMutation creates new mutation for the EnvRebuildSchedule entity.
+func newEnvRebuildScheduleMutation(c config, op Op, opts ...envrebuildscheduleOption) *EnvRebuildScheduleMutation {
+	m := &EnvRebuildScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvRebuildSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvRebuildScheduleID sets the ID field of the mutation.
+func withEnvRebuildScheduleID(id uuid.UUID) envrebuildscheduleOption {
+	return func(m *EnvRebuildScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvRebuildSchedule
+		)
+		m.oldValue = func(ctx context.Context) (*EnvRebuildSchedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvRebuildSchedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvRebuildSchedule sets the old EnvRebuildSchedule of the mutation.
+func withEnvRebuildSchedule(node *EnvRebuildSchedule) envrebuildscheduleOption {
+	return func(m *EnvRebuildScheduleMutation) {
+		m.oldValue = func(context.Context) (*EnvRebuildSchedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvRebuildScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvRebuildScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnvRebuildSchedule entities.
+func (m *EnvRebuildScheduleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvRebuildScheduleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvRebuildScheduleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvRebuildSchedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvRebuildScheduleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvRebuildScheduleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvRebuildScheduleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvRebuildScheduleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvRebuildScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvRebuildScheduleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *EnvRebuildScheduleMutation) SetEnvID(s string) {
+	m.env_id = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvRebuildScheduleMutation) EnvID() (r string, exists bool) {
+	v := m.env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvRebuildScheduleMutation) ResetEnvID() {
+	m.env_id = nil
+}
+
+// SetIntervalHours sets the "interval_hours" field.
+func (m *EnvRebuildScheduleMutation) SetIntervalHours(i int32) {
+	m.interval_hours = &i
+	m.addinterval_hours = nil
+}
+
+// IntervalHours returns the value of the "interval_hours" field in the mutation.
+func (m *EnvRebuildScheduleMutation) IntervalHours() (r int32, exists bool) {
+	v := m.interval_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntervalHours returns the old "interval_hours" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldIntervalHours(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntervalHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntervalHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntervalHours: %w", err)
+	}
+	return oldValue.IntervalHours, nil
+}
+
+// AddIntervalHours adds i to the "interval_hours" field.
+func (m *EnvRebuildScheduleMutation) AddIntervalHours(i int32) {
+	if m.addinterval_hours != nil {
+		*m.addinterval_hours += i
+	} else {
+		m.addinterval_hours = &i
+	}
+}
+
+// AddedIntervalHours returns the value that was added to the "interval_hours" field in this mutation.
+func (m *EnvRebuildScheduleMutation) AddedIntervalHours() (r int32, exists bool) {
+	v := m.addinterval_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIntervalHours resets all changes to the "interval_hours" field.
+func (m *EnvRebuildScheduleMutation) ResetIntervalHours() {
+	m.interval_hours = nil
+	m.addinterval_hours = nil
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (m *EnvRebuildScheduleMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *EnvRebuildScheduleMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunAt returns the old "next_run_at" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldNextRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
+}
+
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *EnvRebuildScheduleMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+}
+
+// SetUpgradePackages sets the "upgrade_packages" field.
+func (m *EnvRebuildScheduleMutation) SetUpgradePackages(b bool) {
+	m.upgrade_packages = &b
+}
+
+// UpgradePackages returns the value of the "upgrade_packages" field in the mutation.
+func (m *EnvRebuildScheduleMutation) UpgradePackages() (r bool, exists bool) {
+	v := m.upgrade_packages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpgradePackages returns the old "upgrade_packages" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldUpgradePackages(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpgradePackages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpgradePackages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpgradePackages: %w", err)
+	}
+	return oldValue.UpgradePackages, nil
+}
+
+// ResetUpgradePackages resets all changes to the "upgrade_packages" field.
+func (m *EnvRebuildScheduleMutation) ResetUpgradePackages() {
+	m.upgrade_packages = nil
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (m *EnvRebuildScheduleMutation) SetWebhookURL(s string) {
+	m.webhook_url = &s
+}
+
+// WebhookURL returns the value of the "webhook_url" field in the mutation.
+func (m *EnvRebuildScheduleMutation) WebhookURL() (r string, exists bool) {
+	v := m.webhook_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookURL returns the old "webhook_url" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldWebhookURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookURL: %w", err)
+	}
+	return oldValue.WebhookURL, nil
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (m *EnvRebuildScheduleMutation) ClearWebhookURL() {
+	m.webhook_url = nil
+	m.clearedFields[envrebuildschedule.FieldWebhookURL] = struct{}{}
+}
+
+// WebhookURLCleared returns if the "webhook_url" field was cleared in this mutation.
+func (m *EnvRebuildScheduleMutation) WebhookURLCleared() bool {
+	_, ok := m.clearedFields[envrebuildschedule.FieldWebhookURL]
+	return ok
+}
+
+// ResetWebhookURL resets all changes to the "webhook_url" field.
+func (m *EnvRebuildScheduleMutation) ResetWebhookURL() {
+	m.webhook_url = nil
+	delete(m.clearedFields, envrebuildschedule.FieldWebhookURL)
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (m *EnvRebuildScheduleMutation) SetWebhookSecret(s string) {
+	m.webhook_secret = &s
+}
+
+// WebhookSecret returns the value of the "webhook_secret" field in the mutation.
+func (m *EnvRebuildScheduleMutation) WebhookSecret() (r string, exists bool) {
+	v := m.webhook_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookSecret returns the old "webhook_secret" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldWebhookSecret(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookSecret: %w", err)
+	}
+	return oldValue.WebhookSecret, nil
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (m *EnvRebuildScheduleMutation) ClearWebhookSecret() {
+	m.webhook_secret = nil
+	m.clearedFields[envrebuildschedule.FieldWebhookSecret] = struct{}{}
+}
+
+// WebhookSecretCleared returns if the "webhook_secret" field was cleared in this mutation.
+func (m *EnvRebuildScheduleMutation) WebhookSecretCleared() bool {
+	_, ok := m.clearedFields[envrebuildschedule.FieldWebhookSecret]
+	return ok
+}
+
+// ResetWebhookSecret resets all changes to the "webhook_secret" field.
+func (m *EnvRebuildScheduleMutation) ResetWebhookSecret() {
+	m.webhook_secret = nil
+	delete(m.clearedFields, envrebuildschedule.FieldWebhookSecret)
+}
+
+// SetLastBuildID sets the "last_build_id" field.
+func (m *EnvRebuildScheduleMutation) SetLastBuildID(u uuid.UUID) {
+	m.last_build_id = &u
+}
+
+// LastBuildID returns the value of the "last_build_id" field in the mutation.
+func (m *EnvRebuildScheduleMutation) LastBuildID() (r uuid.UUID, exists bool) {
+	v := m.last_build_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastBuildID returns the old "last_build_id" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldLastBuildID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastBuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastBuildID: %w", err)
+	}
+	return oldValue.LastBuildID, nil
+}
+
+// ClearLastBuildID clears the value of the "last_build_id" field.
+func (m *EnvRebuildScheduleMutation) ClearLastBuildID() {
+	m.last_build_id = nil
+	m.clearedFields[envrebuildschedule.FieldLastBuildID] = struct{}{}
+}
+
+// LastBuildIDCleared returns if the "last_build_id" field was cleared in this mutation.
+func (m *EnvRebuildScheduleMutation) LastBuildIDCleared() bool {
+	_, ok := m.clearedFields[envrebuildschedule.FieldLastBuildID]
+	return ok
+}
+
+// ResetLastBuildID resets all changes to the "last_build_id" field.
+func (m *EnvRebuildScheduleMutation) ResetLastBuildID() {
+	m.last_build_id = nil
+	delete(m.clearedFields, envrebuildschedule.FieldLastBuildID)
+}
+
+// SetLastBuildStatus sets the "last_build_status" field.
+func (m *EnvRebuildScheduleMutation) SetLastBuildStatus(ebs envrebuildschedule.LastBuildStatus) {
+	m.last_build_status = &ebs
+}
+
+// LastBuildStatus returns the value of the "last_build_status" field in the mutation.
+func (m *EnvRebuildScheduleMutation) LastBuildStatus() (r envrebuildschedule.LastBuildStatus, exists bool) {
+	v := m.last_build_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastBuildStatus returns the old "last_build_status" field's value of the EnvRebuildSchedule entity.
+// If the EnvRebuildSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvRebuildScheduleMutation) OldLastBuildStatus(ctx context.Context) (v *envrebuildschedule.LastBuildStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastBuildStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastBuildStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastBuildStatus: %w", err)
+	}
+	return oldValue.LastBuildStatus, nil
+}
+
+// ClearLastBuildStatus clears the value of the "last_build_status" field.
+func (m *EnvRebuildScheduleMutation) ClearLastBuildStatus() {
+	m.last_build_status = nil
+	m.clearedFields[envrebuildschedule.FieldLastBuildStatus] = struct{}{}
+}
+
+// LastBuildStatusCleared returns if the "last_build_status" field was cleared in this mutation.
+func (m *EnvRebuildScheduleMutation) LastBuildStatusCleared() bool {
+	_, ok := m.clearedFields[envrebuildschedule.FieldLastBuildStatus]
+	return ok
+}
+
+// ResetLastBuildStatus resets all changes to the "last_build_status" field.
+func (m *EnvRebuildScheduleMutation) ResetLastBuildStatus() {
+	m.last_build_status = nil
+	delete(m.clearedFields, envrebuildschedule.FieldLastBuildStatus)
+}
+
+// Where appends a list predicates to the EnvRebuildScheduleMutation builder.
+func (m *EnvRebuildScheduleMutation) Where(ps ...predicate.EnvRebuildSchedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvRebuildScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvRebuildScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvRebuildSchedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvRebuildScheduleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvRebuildScheduleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvRebuildSchedule).
+func (m *EnvRebuildScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvRebuildScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, envrebuildschedule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, envrebuildschedule.FieldUpdatedAt)
+	}
+	if m.env_id != nil {
+		fields = append(fields, envrebuildschedule.FieldEnvID)
+	}
+	if m.interval_hours != nil {
+		fields = append(fields, envrebuildschedule.FieldIntervalHours)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, envrebuildschedule.FieldNextRunAt)
+	}
+	if m.upgrade_packages != nil {
+		fields = append(fields, envrebuildschedule.FieldUpgradePackages)
+	}
+	if m.webhook_url != nil {
+		fields = append(fields, envrebuildschedule.FieldWebhookURL)
+	}
+	if m.webhook_secret != nil {
+		fields = append(fields, envrebuildschedule.FieldWebhookSecret)
+	}
+	if m.last_build_id != nil {
+		fields = append(fields, envrebuildschedule.FieldLastBuildID)
+	}
+	if m.last_build_status != nil {
+		fields = append(fields, envrebuildschedule.FieldLastBuildStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvRebuildScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envrebuildschedule.FieldCreatedAt:
+		return m.CreatedAt()
+	case envrebuildschedule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case envrebuildschedule.FieldEnvID:
+		return m.EnvID()
+	case envrebuildschedule.FieldIntervalHours:
+		return m.IntervalHours()
+	case envrebuildschedule.FieldNextRunAt:
+		return m.NextRunAt()
+	case envrebuildschedule.FieldUpgradePackages:
+		return m.UpgradePackages()
+	case envrebuildschedule.FieldWebhookURL:
+		return m.WebhookURL()
+	case envrebuildschedule.FieldWebhookSecret:
+		return m.WebhookSecret()
+	case envrebuildschedule.FieldLastBuildID:
+		return m.LastBuildID()
+	case envrebuildschedule.FieldLastBuildStatus:
+		return m.LastBuildStatus()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvRebuildScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envrebuildschedule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case envrebuildschedule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case envrebuildschedule.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envrebuildschedule.FieldIntervalHours:
+		return m.OldIntervalHours(ctx)
+	case envrebuildschedule.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case envrebuildschedule.FieldUpgradePackages:
+		return m.OldUpgradePackages(ctx)
+	case envrebuildschedule.FieldWebhookURL:
+		return m.OldWebhookURL(ctx)
+	case envrebuildschedule.FieldWebhookSecret:
+		return m.OldWebhookSecret(ctx)
+	case envrebuildschedule.FieldLastBuildID:
+		return m.OldLastBuildID(ctx)
+	case envrebuildschedule.FieldLastBuildStatus:
+		return m.OldLastBuildStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvRebuildSchedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvRebuildScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envrebuildschedule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envrebuildschedule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case envrebuildschedule.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envrebuildschedule.FieldIntervalHours:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntervalHours(v)
+		return nil
+	case envrebuildschedule.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case envrebuildschedule.FieldUpgradePackages:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpgradePackages(v)
+		return nil
+	case envrebuildschedule.FieldWebhookURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookURL(v)
+		return nil
+	case envrebuildschedule.FieldWebhookSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookSecret(v)
+		return nil
+	case envrebuildschedule.FieldLastBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastBuildID(v)
+		return nil
+	case envrebuildschedule.FieldLastBuildStatus:
+		v, ok := value.(envrebuildschedule.LastBuildStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastBuildStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvRebuildSchedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvRebuildScheduleMutation) AddedFields() []string {
+	var fields []string
+	if m.addinterval_hours != nil {
+		fields = append(fields, envrebuildschedule.FieldIntervalHours)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvRebuildScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case envrebuildschedule.FieldIntervalHours:
+		return m.AddedIntervalHours()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvRebuildScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case envrebuildschedule.FieldIntervalHours:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntervalHours(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvRebuildSchedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvRebuildScheduleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(envrebuildschedule.FieldWebhookURL) {
+		fields = append(fields, envrebuildschedule.FieldWebhookURL)
+	}
+	if m.FieldCleared(envrebuildschedule.FieldWebhookSecret) {
+		fields = append(fields, envrebuildschedule.FieldWebhookSecret)
+	}
+	if m.FieldCleared(envrebuildschedule.FieldLastBuildID) {
+		fields = append(fields, envrebuildschedule.FieldLastBuildID)
+	}
+	if m.FieldCleared(envrebuildschedule.FieldLastBuildStatus) {
+		fields = append(fields, envrebuildschedule.FieldLastBuildStatus)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvRebuildScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvRebuildScheduleMutation) ClearField(name string) error {
+	switch name {
+	case envrebuildschedule.FieldWebhookURL:
+		m.ClearWebhookURL()
+		return nil
+	case envrebuildschedule.FieldWebhookSecret:
+		m.ClearWebhookSecret()
+		return nil
+	case envrebuildschedule.FieldLastBuildID:
+		m.ClearLastBuildID()
+		return nil
+	case envrebuildschedule.FieldLastBuildStatus:
+		m.ClearLastBuildStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvRebuildSchedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvRebuildScheduleMutation) ResetField(name string) error {
+	switch name {
+	case envrebuildschedule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case envrebuildschedule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case envrebuildschedule.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envrebuildschedule.FieldIntervalHours:
+		m.ResetIntervalHours()
+		return nil
+	case envrebuildschedule.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case envrebuildschedule.FieldUpgradePackages:
+		m.ResetUpgradePackages()
+		return nil
+	case envrebuildschedule.FieldWebhookURL:
+		m.ResetWebhookURL()
+		return nil
+	case envrebuildschedule.FieldWebhookSecret:
+		m.ResetWebhookSecret()
+		return nil
+	case envrebuildschedule.FieldLastBuildID:
+		m.ResetLastBuildID()
+		return nil
+	case envrebuildschedule.FieldLastBuildStatus:
+		m.ResetLastBuildStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvRebuildSchedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvRebuildScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvRebuildScheduleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvRebuildScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvRebuildScheduleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvRebuildScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvRebuildScheduleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvRebuildScheduleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EnvRebuildSchedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvRebuildScheduleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EnvRebuildSchedule edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	expires_at     *time.Time
+	owner_id       *uuid.UUID
+	key            *string
+	request_hash   *string
+	status_code    *int
+	addstatus_code *int
+	response       *[]byte
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*IdempotencyKey, error)
+	predicates     []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id uuid.UUID) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *IdempotencyKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *IdempotencyKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *IdempotencyKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *IdempotencyKeyMutation) SetOwnerID(u uuid.UUID) {
+	m.owner_id = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *IdempotencyKeyMutation) OwnerID() (r uuid.UUID, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *IdempotencyKeyMutation) ResetOwnerID() {
+	m.owner_id = nil
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyKeyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyKeyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyKeyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (m *IdempotencyKeyMutation) SetRequestHash(s string) {
+	m.request_hash = &s
+}
+
+// RequestHash returns the value of the "request_hash" field in the mutation.
+func (m *IdempotencyKeyMutation) RequestHash() (r string, exists bool) {
+	v := m.request_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHash returns the old "request_hash" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldRequestHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHash: %w", err)
+	}
+	return oldValue.RequestHash, nil
+}
+
+// ResetRequestHash resets all changes to the "request_hash" field.
+func (m *IdempotencyKeyMutation) ResetRequestHash() {
+	m.request_hash = nil
+}
+
+// SetStatusCode sets the "status_code" field.
+func (m *IdempotencyKeyMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
+}
+
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *IdempotencyKeyMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusCode returns the old "status_code" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldStatusCode(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
+	}
+	return oldValue.StatusCode, nil
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (m *IdempotencyKeyMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
+	}
+}
+
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *IdempotencyKeyMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (m *IdempotencyKeyMutation) ClearStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	m.clearedFields[idempotencykey.FieldStatusCode] = struct{}{}
+}
+
+// StatusCodeCleared returns if the "status_code" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) StatusCodeCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldStatusCode]
+	return ok
+}
+
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *IdempotencyKeyMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	delete(m.clearedFields, idempotencykey.FieldStatusCode)
+}
+
+// SetResponse sets the "response" field.
+func (m *IdempotencyKeyMutation) SetResponse(b []byte) {
+	m.response = &b
+}
+
+// Response returns the value of the "response" field in the mutation.
+func (m *IdempotencyKeyMutation) Response() (r []byte, exists bool) {
+	v := m.response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponse returns the old "response" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResponse(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+	}
+	return oldValue.Response, nil
+}
+
+// ClearResponse clears the value of the "response" field.
+func (m *IdempotencyKeyMutation) ClearResponse() {
+	m.response = nil
+	m.clearedFields[idempotencykey.FieldResponse] = struct{}{}
+}
+
+// ResponseCleared returns if the "response" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) ResponseCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldResponse]
+	return ok
+}
+
+// ResetResponse resets all changes to the "response" field.
+func (m *IdempotencyKeyMutation) ResetResponse() {
+	m.response = nil
+	delete(m.clearedFields, idempotencykey.FieldResponse)
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, idempotencykey.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, idempotencykey.FieldExpiresAt)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, idempotencykey.FieldOwnerID)
+	}
+	if m.key != nil {
+		fields = append(fields, idempotencykey.FieldKey)
+	}
+	if m.request_hash != nil {
+		fields = append(fields, idempotencykey.FieldRequestHash)
+	}
+	if m.status_code != nil {
+		fields = append(fields, idempotencykey.FieldStatusCode)
+	}
+	if m.response != nil {
+		fields = append(fields, idempotencykey.FieldResponse)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldCreatedAt:
+		return m.CreatedAt()
+	case idempotencykey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case idempotencykey.FieldOwnerID:
+		return m.OwnerID()
+	case idempotencykey.FieldKey:
+		return m.Key()
+	case idempotencykey.FieldRequestHash:
+		return m.RequestHash()
+	case idempotencykey.FieldStatusCode:
+		return m.StatusCode()
+	case idempotencykey.FieldResponse:
+		return m.Response()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case idempotencykey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case idempotencykey.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case idempotencykey.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencykey.FieldRequestHash:
+		return m.OldRequestHash(ctx)
+	case idempotencykey.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case idempotencykey.FieldResponse:
+		return m.OldResponse(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case idempotencykey.FieldOwnerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case idempotencykey.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencykey.FieldRequestHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHash(v)
+		return nil
+	case idempotencykey.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case idempotencykey.FieldResponse:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponse(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus_code != nil {
+		fields = append(fields, idempotencykey.FieldStatusCode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldStatusCode:
+		return m.AddedStatusCode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencykey.FieldStatusCode) {
+		fields = append(fields, idempotencykey.FieldStatusCode)
+	}
+	if m.FieldCleared(idempotencykey.FieldResponse) {
+		fields = append(fields, idempotencykey.FieldResponse)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	switch name {
+	case idempotencykey.FieldStatusCode:
+		m.ClearStatusCode()
+		return nil
+	case idempotencykey.FieldResponse:
+		m.ClearResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case idempotencykey.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case idempotencykey.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencykey.FieldRequestHash:
+		m.ResetRequestHash()
+		return nil
+	case idempotencykey.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case idempotencykey.FieldResponse:
+		m.ResetResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// KernelMutation represents an operation that mutates the Kernel nodes in the graph.
+type KernelMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	created_at    *time.Time
+	checksum      *string
+	description   *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Kernel, error)
+	predicates    []predicate.Kernel
+}
+
+var _ ent.Mutation = (*KernelMutation)(nil)
+
+// kernelOption allows management of the mutation configuration using functional options.
+type kernelOption func(*KernelMutation)
+
+// newKernelMutation creates new mutation for the Kernel entity.
+func newKernelMutation(c config, op Op, opts ...kernelOption) *KernelMutation {
+	m := &KernelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKernel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKernelID sets the ID field of the mutation.
+func withKernelID(id string) kernelOption {
+	return func(m *KernelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Kernel
+		)
+		m.oldValue = func(ctx context.Context) (*Kernel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Kernel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKernel sets the old Kernel of the mutation.
+func withKernel(node *Kernel) kernelOption {
+	return func(m *KernelMutation) {
+		m.oldValue = func(context.Context) (*Kernel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KernelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KernelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Kernel entities.
+func (m *KernelMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KernelMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KernelMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Kernel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *KernelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KernelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Kernel entity.
+// If the Kernel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KernelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KernelMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *KernelMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *KernelMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the Kernel entity.
+// If the Kernel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KernelMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *KernelMutation) ResetChecksum() {
+	m.checksum = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *KernelMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *KernelMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Kernel entity.
+// If the Kernel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KernelMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *KernelMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[kernel.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *KernelMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[kernel.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *KernelMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, kernel.FieldDescription)
+}
+
+// Where appends a list predicates to the KernelMutation builder.
+func (m *KernelMutation) Where(ps ...predicate.Kernel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KernelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KernelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Kernel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KernelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KernelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Kernel).
+func (m *KernelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KernelMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.created_at != nil {
+		fields = append(fields, kernel.FieldCreatedAt)
+	}
+	if m.checksum != nil {
+		fields = append(fields, kernel.FieldChecksum)
+	}
+	if m.description != nil {
+		fields = append(fields, kernel.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KernelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case kernel.FieldCreatedAt:
+		return m.CreatedAt()
+	case kernel.FieldChecksum:
+		return m.Checksum()
+	case kernel.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KernelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case kernel.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case kernel.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case kernel.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown Kernel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KernelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case kernel.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case kernel.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case kernel.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Kernel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KernelMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KernelMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KernelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Kernel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KernelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(kernel.FieldDescription) {
+		fields = append(fields, kernel.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KernelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KernelMutation) ClearField(name string) error {
+	switch name {
+	case kernel.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Kernel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KernelMutation) ResetField(name string) error {
+	switch name {
+	case kernel.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case kernel.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case kernel.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Kernel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KernelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KernelMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KernelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KernelMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KernelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KernelMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KernelMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Kernel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KernelMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Kernel edge %s", name)
+}
+
+// PinnedBuildMutation represents an operation that mutates the PinnedBuild nodes in the graph.
+type PinnedBuildMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	build_id      *uuid.UUID
+	node_id       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PinnedBuild, error)
+	predicates    []predicate.PinnedBuild
+}
+
+var _ ent.Mutation = (*PinnedBuildMutation)(nil)
+
+// pinnedbuildOption allows management of the mutation configuration using functional options.
+type pinnedbuildOption func(*PinnedBuildMutation)
+
+// newPinnedBuildMutation creates new mutation for the PinnedBuild entity.
+func newPinnedBuildMutation(c config, op Op, opts ...pinnedbuildOption) *PinnedBuildMutation {
+	m := &PinnedBuildMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePinnedBuild,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPinnedBuildID sets the ID field of the mutation.
+func withPinnedBuildID(id uuid.UUID) pinnedbuildOption {
+	return func(m *PinnedBuildMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PinnedBuild
+		)
+		m.oldValue = func(ctx context.Context) (*PinnedBuild, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PinnedBuild.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPinnedBuild sets the old PinnedBuild of the mutation.
+func withPinnedBuild(node *PinnedBuild) pinnedbuildOption {
+	return func(m *PinnedBuildMutation) {
+		m.oldValue = func(context.Context) (*PinnedBuild, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PinnedBuildMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PinnedBuildMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PinnedBuild entities.
+func (m *PinnedBuildMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PinnedBuildMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PinnedBuildMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PinnedBuild.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PinnedBuildMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PinnedBuildMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PinnedBuild entity.
+// If the PinnedBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedBuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PinnedBuildMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetBuildID sets the "build_id" field.
+func (m *PinnedBuildMutation) SetBuildID(u uuid.UUID) {
+	m.build_id = &u
+}
+
+// BuildID returns the value of the "build_id" field in the mutation.
+func (m *PinnedBuildMutation) BuildID() (r uuid.UUID, exists bool) {
+	v := m.build_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildID returns the old "build_id" field's value of the PinnedBuild entity.
+// If the PinnedBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedBuildMutation) OldBuildID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildID: %w", err)
+	}
+	return oldValue.BuildID, nil
+}
+
+// ResetBuildID resets all changes to the "build_id" field.
+func (m *PinnedBuildMutation) ResetBuildID() {
+	m.build_id = nil
+}
+
+// SetNodeID sets the "node_id" field.
+func (m *PinnedBuildMutation) SetNodeID(s string) {
+	m.node_id = &s
+}
+
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *PinnedBuildMutation) NodeID() (r string, exists bool) {
+	v := m.node_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeID returns the old "node_id" field's value of the PinnedBuild entity.
+// If the PinnedBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedBuildMutation) OldNodeID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+	}
+	return oldValue.NodeID, nil
+}
+
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *PinnedBuildMutation) ResetNodeID() {
+	m.node_id = nil
+}
+
+// Where appends a list predicates to the PinnedBuildMutation builder.
+func (m *PinnedBuildMutation) Where(ps ...predicate.PinnedBuild) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PinnedBuildMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PinnedBuildMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PinnedBuild, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PinnedBuildMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PinnedBuildMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PinnedBuild).
+func (m *PinnedBuildMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PinnedBuildMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.created_at != nil {
+		fields = append(fields, pinnedbuild.FieldCreatedAt)
+	}
+	if m.build_id != nil {
+		fields = append(fields, pinnedbuild.FieldBuildID)
+	}
+	if m.node_id != nil {
+		fields = append(fields, pinnedbuild.FieldNodeID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PinnedBuildMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pinnedbuild.FieldCreatedAt:
+		return m.CreatedAt()
+	case pinnedbuild.FieldBuildID:
+		return m.BuildID()
+	case pinnedbuild.FieldNodeID:
+		return m.NodeID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PinnedBuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pinnedbuild.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pinnedbuild.FieldBuildID:
+		return m.OldBuildID(ctx)
+	case pinnedbuild.FieldNodeID:
+		return m.OldNodeID(ctx)
+	}
+	return nil, fmt.Errorf("unknown PinnedBuild field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PinnedBuildMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pinnedbuild.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pinnedbuild.FieldBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildID(v)
+		return nil
+	case pinnedbuild.FieldNodeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedBuild field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PinnedBuildMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PinnedBuildMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PinnedBuildMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PinnedBuild numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PinnedBuildMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PinnedBuildMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PinnedBuildMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PinnedBuild nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PinnedBuildMutation) ResetField(name string) error {
+	switch name {
+	case pinnedbuild.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pinnedbuild.FieldBuildID:
+		m.ResetBuildID()
+		return nil
+	case pinnedbuild.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedBuild field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PinnedBuildMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PinnedBuildMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PinnedBuildMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PinnedBuildMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PinnedBuildMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PinnedBuildMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PinnedBuildMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PinnedBuild unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PinnedBuildMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PinnedBuild edge %s", name)
+}
+
+// SandboxMutation represents an operation that mutates the Sandbox nodes in the graph.
+type SandboxMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	team_id       *uuid.UUID
+	env_id        *string
+	build_id      *uuid.UUID
+	alias         *string
+	node_id       *string
+	cluster_id    *string
+	state         *sandbox.State
+	started_at    *time.Time
+	end_at        *time.Time
+	vcpu          *int64
+	addvcpu       *int64
+	ram_mb        *int64
+	addram_mb     *int64
+	metadata      *map[string]string
+	labels        *map[string]string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Sandbox, error)
+	predicates    []predicate.Sandbox
+}
+
+var _ ent.Mutation = (*SandboxMutation)(nil)
+
+// sandboxOption allows management of the mutation configuration using functional options.
+type sandboxOption func(*SandboxMutation)
+
+// newSandboxMutation creates new mutation for the Sandbox entity.
+func newSandboxMutation(c config, op Op, opts ...sandboxOption) *SandboxMutation {
+	m := &SandboxMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSandbox,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSandboxID sets the ID field of the mutation.
+func withSandboxID(id string) sandboxOption {
+	return func(m *SandboxMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Sandbox
+		)
+		m.oldValue = func(ctx context.Context) (*Sandbox, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Sandbox.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSandbox sets the old Sandbox of the mutation.
+func withSandbox(node *Sandbox) sandboxOption {
+	return func(m *SandboxMutation) {
+		m.oldValue = func(context.Context) (*Sandbox, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SandboxMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SandboxMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Sandbox entities.
+func (m *SandboxMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SandboxMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SandboxMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Sandbox.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SandboxMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SandboxMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SandboxMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SandboxMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SandboxMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SandboxMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *SandboxMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *SandboxMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *SandboxMutation) ResetTeamID() {
+	m.team_id = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *SandboxMutation) SetEnvID(s string) {
+	m.env_id = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *SandboxMutation) EnvID() (r string, exists bool) {
+	v := m.env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *SandboxMutation) ResetEnvID() {
+	m.env_id = nil
+}
+
+// SetBuildID sets the "build_id" field.
+func (m *SandboxMutation) SetBuildID(u uuid.UUID) {
+	m.build_id = &u
+}
+
+// BuildID returns the value of the "build_id" field in the mutation.
+func (m *SandboxMutation) BuildID() (r uuid.UUID, exists bool) {
+	v := m.build_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildID returns the old "build_id" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldBuildID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildID: %w", err)
+	}
+	return oldValue.BuildID, nil
+}
+
+// ResetBuildID resets all changes to the "build_id" field.
+func (m *SandboxMutation) ResetBuildID() {
+	m.build_id = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *SandboxMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *SandboxMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldAlias(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (m *SandboxMutation) ClearAlias() {
+	m.alias = nil
+	m.clearedFields[sandbox.FieldAlias] = struct{}{}
+}
+
+// AliasCleared returns if the "alias" field was cleared in this mutation.
+func (m *SandboxMutation) AliasCleared() bool {
+	_, ok := m.clearedFields[sandbox.FieldAlias]
+	return ok
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *SandboxMutation) ResetAlias() {
+	m.alias = nil
+	delete(m.clearedFields, sandbox.FieldAlias)
+}
+
+// SetNodeID sets the "node_id" field.
+func (m *SandboxMutation) SetNodeID(s string) {
+	m.node_id = &s
+}
+
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *SandboxMutation) NodeID() (r string, exists bool) {
+	v := m.node_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeID returns the old "node_id" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldNodeID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+	}
+	return oldValue.NodeID, nil
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (m *SandboxMutation) ClearNodeID() {
+	m.node_id = nil
+	m.clearedFields[sandbox.FieldNodeID] = struct{}{}
+}
+
+// NodeIDCleared returns if the "node_id" field was cleared in this mutation.
+func (m *SandboxMutation) NodeIDCleared() bool {
+	_, ok := m.clearedFields[sandbox.FieldNodeID]
+	return ok
+}
+
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *SandboxMutation) ResetNodeID() {
+	m.node_id = nil
+	delete(m.clearedFields, sandbox.FieldNodeID)
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (m *SandboxMutation) SetClusterID(s string) {
+	m.cluster_id = &s
+}
+
+// ClusterID returns the value of the "cluster_id" field in the mutation.
+func (m *SandboxMutation) ClusterID() (r string, exists bool) {
+	v := m.cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterID returns the old "cluster_id" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldClusterID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterID: %w", err)
+	}
+	return oldValue.ClusterID, nil
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (m *SandboxMutation) ClearClusterID() {
+	m.cluster_id = nil
+	m.clearedFields[sandbox.FieldClusterID] = struct{}{}
+}
+
+// ClusterIDCleared returns if the "cluster_id" field was cleared in this mutation.
+func (m *SandboxMutation) ClusterIDCleared() bool {
+	_, ok := m.clearedFields[sandbox.FieldClusterID]
+	return ok
+}
+
+// ResetClusterID resets all changes to the "cluster_id" field.
+func (m *SandboxMutation) ResetClusterID() {
+	m.cluster_id = nil
+	delete(m.clearedFields, sandbox.FieldClusterID)
+}
+
+// SetState sets the "state" field.
+func (m *SandboxMutation) SetState(s sandbox.State) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *SandboxMutation) State() (r sandbox.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldState(ctx context.Context) (v sandbox.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *SandboxMutation) ResetState() {
+	m.state = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *SandboxMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *SandboxMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *SandboxMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetEndAt sets the "end_at" field.
+func (m *SandboxMutation) SetEndAt(t time.Time) {
+	m.end_at = &t
+}
+
+// EndAt returns the value of the "end_at" field in the mutation.
+func (m *SandboxMutation) EndAt() (r time.Time, exists bool) {
+	v := m.end_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndAt returns the old "end_at" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldEndAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+	}
+	return oldValue.EndAt, nil
+}
+
+// ResetEndAt resets all changes to the "end_at" field.
+func (m *SandboxMutation) ResetEndAt() {
+	m.end_at = nil
+}
+
+// SetVcpu sets the "vcpu" field.
+func (m *SandboxMutation) SetVcpu(i int64) {
+	m.vcpu = &i
+	m.addvcpu = nil
+}
+
+// Vcpu returns the value of the "vcpu" field in the mutation.
+func (m *SandboxMutation) Vcpu() (r int64, exists bool) {
+	v := m.vcpu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVcpu returns the old "vcpu" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldVcpu(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVcpu is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVcpu requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVcpu: %w", err)
+	}
+	return oldValue.Vcpu, nil
+}
+
+// AddVcpu adds i to the "vcpu" field.
+func (m *SandboxMutation) AddVcpu(i int64) {
+	if m.addvcpu != nil {
+		*m.addvcpu += i
+	} else {
+		m.addvcpu = &i
+	}
+}
+
+// AddedVcpu returns the value that was added to the "vcpu" field in this mutation.
+func (m *SandboxMutation) AddedVcpu() (r int64, exists bool) {
+	v := m.addvcpu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVcpu resets all changes to the "vcpu" field.
+func (m *SandboxMutation) ResetVcpu() {
+	m.vcpu = nil
+	m.addvcpu = nil
+}
+
+// SetRAMMB sets the "ram_mb" field.
+func (m *SandboxMutation) SetRAMMB(i int64) {
+	m.ram_mb = &i
+	m.addram_mb = nil
+}
+
+// RAMMB returns the value of the "ram_mb" field in the mutation.
+func (m *SandboxMutation) RAMMB() (r int64, exists bool) {
+	v := m.ram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRAMMB returns the old "ram_mb" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldRAMMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRAMMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRAMMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRAMMB: %w", err)
+	}
+	return oldValue.RAMMB, nil
+}
+
+// AddRAMMB adds i to the "ram_mb" field.
+func (m *SandboxMutation) AddRAMMB(i int64) {
+	if m.addram_mb != nil {
+		*m.addram_mb += i
+	} else {
+		m.addram_mb = &i
+	}
+}
+
+// AddedRAMMB returns the value that was added to the "ram_mb" field in this mutation.
+func (m *SandboxMutation) AddedRAMMB() (r int64, exists bool) {
+	v := m.addram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRAMMB resets all changes to the "ram_mb" field.
+func (m *SandboxMutation) ResetRAMMB() {
+	m.ram_mb = nil
+	m.addram_mb = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SandboxMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SandboxMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *SandboxMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[sandbox.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *SandboxMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[sandbox.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SandboxMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, sandbox.FieldMetadata)
+}
+
+// SetLabels sets the "labels" field.
+func (m *SandboxMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *SandboxMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Sandbox entity.
+// If the Sandbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *SandboxMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[sandbox.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *SandboxMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[sandbox.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *SandboxMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, sandbox.FieldLabels)
+}
+
+// Where appends a list predicates to the SandboxMutation builder.
+func (m *SandboxMutation) Where(ps ...predicate.Sandbox) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SandboxMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SandboxMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Sandbox, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SandboxMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SandboxMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Sandbox).
+func (m *SandboxMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SandboxMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.created_at != nil {
+		fields = append(fields, sandbox.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, sandbox.FieldUpdatedAt)
+	}
+	if m.team_id != nil {
+		fields = append(fields, sandbox.FieldTeamID)
+	}
+	if m.env_id != nil {
+		fields = append(fields, sandbox.FieldEnvID)
+	}
+	if m.build_id != nil {
+		fields = append(fields, sandbox.FieldBuildID)
+	}
+	if m.alias != nil {
+		fields = append(fields, sandbox.FieldAlias)
+	}
+	if m.node_id != nil {
+		fields = append(fields, sandbox.FieldNodeID)
+	}
+	if m.cluster_id != nil {
+		fields = append(fields, sandbox.FieldClusterID)
+	}
+	if m.state != nil {
+		fields = append(fields, sandbox.FieldState)
+	}
+	if m.started_at != nil {
+		fields = append(fields, sandbox.FieldStartedAt)
+	}
+	if m.end_at != nil {
+		fields = append(fields, sandbox.FieldEndAt)
+	}
+	if m.vcpu != nil {
+		fields = append(fields, sandbox.FieldVcpu)
+	}
+	if m.ram_mb != nil {
+		fields = append(fields, sandbox.FieldRAMMB)
+	}
+	if m.metadata != nil {
+		fields = append(fields, sandbox.FieldMetadata)
+	}
+	if m.labels != nil {
+		fields = append(fields, sandbox.FieldLabels)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SandboxMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sandbox.FieldCreatedAt:
+		return m.CreatedAt()
+	case sandbox.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case sandbox.FieldTeamID:
+		return m.TeamID()
+	case sandbox.FieldEnvID:
+		return m.EnvID()
+	case sandbox.FieldBuildID:
+		return m.BuildID()
+	case sandbox.FieldAlias:
+		return m.Alias()
+	case sandbox.FieldNodeID:
+		return m.NodeID()
+	case sandbox.FieldClusterID:
+		return m.ClusterID()
+	case sandbox.FieldState:
+		return m.State()
+	case sandbox.FieldStartedAt:
+		return m.StartedAt()
+	case sandbox.FieldEndAt:
+		return m.EndAt()
+	case sandbox.FieldVcpu:
+		return m.Vcpu()
+	case sandbox.FieldRAMMB:
+		return m.RAMMB()
+	case sandbox.FieldMetadata:
+		return m.Metadata()
+	case sandbox.FieldLabels:
+		return m.Labels()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SandboxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sandbox.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sandbox.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case sandbox.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case sandbox.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case sandbox.FieldBuildID:
+		return m.OldBuildID(ctx)
+	case sandbox.FieldAlias:
+		return m.OldAlias(ctx)
+	case sandbox.FieldNodeID:
+		return m.OldNodeID(ctx)
+	case sandbox.FieldClusterID:
+		return m.OldClusterID(ctx)
+	case sandbox.FieldState:
+		return m.OldState(ctx)
+	case sandbox.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case sandbox.FieldEndAt:
+		return m.OldEndAt(ctx)
+	case sandbox.FieldVcpu:
+		return m.OldVcpu(ctx)
+	case sandbox.FieldRAMMB:
+		return m.OldRAMMB(ctx)
+	case sandbox.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case sandbox.FieldLabels:
+		return m.OldLabels(ctx)
+	}
+	return nil, fmt.Errorf("unknown Sandbox field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SandboxMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sandbox.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sandbox.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case sandbox.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case sandbox.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case sandbox.FieldBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildID(v)
+		return nil
+	case sandbox.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	case sandbox.FieldNodeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	case sandbox.FieldClusterID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterID(v)
+		return nil
+	case sandbox.FieldState:
+		v, ok := value.(sandbox.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case sandbox.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case sandbox.FieldEndAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAt(v)
+		return nil
+	case sandbox.FieldVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVcpu(v)
+		return nil
+	case sandbox.FieldRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRAMMB(v)
+		return nil
+	case sandbox.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case sandbox.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Sandbox field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SandboxMutation) AddedFields() []string {
+	var fields []string
+	if m.addvcpu != nil {
+		fields = append(fields, sandbox.FieldVcpu)
+	}
+	if m.addram_mb != nil {
+		fields = append(fields, sandbox.FieldRAMMB)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SandboxMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sandbox.FieldVcpu:
+		return m.AddedVcpu()
+	case sandbox.FieldRAMMB:
+		return m.AddedRAMMB()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SandboxMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sandbox.FieldVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVcpu(v)
+		return nil
+	case sandbox.FieldRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRAMMB(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Sandbox numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SandboxMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sandbox.FieldAlias) {
+		fields = append(fields, sandbox.FieldAlias)
+	}
+	if m.FieldCleared(sandbox.FieldNodeID) {
+		fields = append(fields, sandbox.FieldNodeID)
+	}
+	if m.FieldCleared(sandbox.FieldClusterID) {
+		fields = append(fields, sandbox.FieldClusterID)
+	}
+	if m.FieldCleared(sandbox.FieldMetadata) {
+		fields = append(fields, sandbox.FieldMetadata)
+	}
+	if m.FieldCleared(sandbox.FieldLabels) {
+		fields = append(fields, sandbox.FieldLabels)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SandboxMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SandboxMutation) ClearField(name string) error {
+	switch name {
+	case sandbox.FieldAlias:
+		m.ClearAlias()
+		return nil
+	case sandbox.FieldNodeID:
+		m.ClearNodeID()
+		return nil
+	case sandbox.FieldClusterID:
+		m.ClearClusterID()
+		return nil
+	case sandbox.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case sandbox.FieldLabels:
+		m.ClearLabels()
+		return nil
+	}
+	return fmt.Errorf("unknown Sandbox nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SandboxMutation) ResetField(name string) error {
+	switch name {
+	case sandbox.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sandbox.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case sandbox.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case sandbox.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case sandbox.FieldBuildID:
+		m.ResetBuildID()
+		return nil
+	case sandbox.FieldAlias:
+		m.ResetAlias()
+		return nil
+	case sandbox.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	case sandbox.FieldClusterID:
+		m.ResetClusterID()
+		return nil
+	case sandbox.FieldState:
+		m.ResetState()
+		return nil
+	case sandbox.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case sandbox.FieldEndAt:
+		m.ResetEndAt()
+		return nil
+	case sandbox.FieldVcpu:
+		m.ResetVcpu()
+		return nil
+	case sandbox.FieldRAMMB:
+		m.ResetRAMMB()
+		return nil
+	case sandbox.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case sandbox.FieldLabels:
+		m.ResetLabels()
+		return nil
+	}
+	return fmt.Errorf("unknown Sandbox field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SandboxMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SandboxMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SandboxMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SandboxMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SandboxMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SandboxMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SandboxMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Sandbox unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SandboxMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Sandbox edge %s", name)
+}
+
+// SandboxStartMutation represents an operation that mutates the SandboxStart nodes in the graph.
+type SandboxStartMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	team_id        *uuid.UUID
+	env_id         *string
+	sandbox_id     *string
+	resumed        *bool
+	duration_ms    *int64
+	addduration_ms *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*SandboxStart, error)
+	predicates     []predicate.SandboxStart
+}
+
+var _ ent.Mutation = (*SandboxStartMutation)(nil)
+
+// sandboxstartOption allows management of the mutation configuration using functional options.
+type sandboxstartOption func(*SandboxStartMutation)
+
+// newSandboxStartMutation creates new mutation for the SandboxStart entity.
+func newSandboxStartMutation(c config, op Op, opts ...sandboxstartOption) *SandboxStartMutation {
+	m := &SandboxStartMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSandboxStart,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSandboxStartID sets the ID field of the mutation.
+func withSandboxStartID(id int) sandboxstartOption {
+	return func(m *SandboxStartMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SandboxStart
+		)
+		m.oldValue = func(ctx context.Context) (*SandboxStart, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SandboxStart.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSandboxStart sets the old SandboxStart of the mutation.
+func withSandboxStart(node *SandboxStart) sandboxstartOption {
+	return func(m *SandboxStartMutation) {
+		m.oldValue = func(context.Context) (*SandboxStart, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SandboxStartMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SandboxStartMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SandboxStartMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SandboxStartMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SandboxStart.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SandboxStartMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SandboxStartMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SandboxStart entity.
+// If the SandboxStart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxStartMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SandboxStartMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *SandboxStartMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *SandboxStartMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the SandboxStart entity.
+// If the SandboxStart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxStartMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *SandboxStartMutation) ResetTeamID() {
+	m.team_id = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *SandboxStartMutation) SetEnvID(s string) {
+	m.env_id = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *SandboxStartMutation) EnvID() (r string, exists bool) {
+	v := m.env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the SandboxStart entity.
+// If the SandboxStart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxStartMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *SandboxStartMutation) ResetEnvID() {
+	m.env_id = nil
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (m *SandboxStartMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
+}
+
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *SandboxStartMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSandboxID returns the old "sandbox_id" field's value of the SandboxStart entity.
+// If the SandboxStart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxStartMutation) OldSandboxID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
+	}
+	return oldValue.SandboxID, nil
+}
+
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *SandboxStartMutation) ResetSandboxID() {
+	m.sandbox_id = nil
+}
+
+// SetResumed sets the "resumed" field.
+func (m *SandboxStartMutation) SetResumed(b bool) {
+	m.resumed = &b
+}
+
+// Resumed returns the value of the "resumed" field in the mutation.
+func (m *SandboxStartMutation) Resumed() (r bool, exists bool) {
+	v := m.resumed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumed returns the old "resumed" field's value of the SandboxStart entity.
+// If the SandboxStart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxStartMutation) OldResumed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumed: %w", err)
+	}
+	return oldValue.Resumed, nil
+}
+
+// ResetResumed resets all changes to the "resumed" field.
+func (m *SandboxStartMutation) ResetResumed() {
+	m.resumed = nil
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *SandboxStartMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *SandboxStartMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the SandboxStart entity.
+// If the SandboxStart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SandboxStartMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *SandboxStartMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *SandboxStartMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *SandboxStartMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// Where appends a list predicates to the SandboxStartMutation builder.
+func (m *SandboxStartMutation) Where(ps ...predicate.SandboxStart) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SandboxStartMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SandboxStartMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SandboxStart, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SandboxStartMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SandboxStartMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SandboxStart).
+func (m *SandboxStartMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SandboxStartMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, sandboxstart.FieldCreatedAt)
+	}
+	if m.team_id != nil {
+		fields = append(fields, sandboxstart.FieldTeamID)
+	}
+	if m.env_id != nil {
+		fields = append(fields, sandboxstart.FieldEnvID)
+	}
+	if m.sandbox_id != nil {
+		fields = append(fields, sandboxstart.FieldSandboxID)
+	}
+	if m.resumed != nil {
+		fields = append(fields, sandboxstart.FieldResumed)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, sandboxstart.FieldDurationMs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SandboxStartMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sandboxstart.FieldCreatedAt:
+		return m.CreatedAt()
+	case sandboxstart.FieldTeamID:
+		return m.TeamID()
+	case sandboxstart.FieldEnvID:
+		return m.EnvID()
+	case sandboxstart.FieldSandboxID:
+		return m.SandboxID()
+	case sandboxstart.FieldResumed:
+		return m.Resumed()
+	case sandboxstart.FieldDurationMs:
+		return m.DurationMs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SandboxStartMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sandboxstart.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sandboxstart.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case sandboxstart.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case sandboxstart.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case sandboxstart.FieldResumed:
+		return m.OldResumed(ctx)
+	case sandboxstart.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	}
+	return nil, fmt.Errorf("unknown SandboxStart field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SandboxStartMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sandboxstart.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sandboxstart.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case sandboxstart.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case sandboxstart.FieldSandboxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxID(v)
+		return nil
+	case sandboxstart.FieldResumed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumed(v)
+		return nil
+	case sandboxstart.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SandboxStart field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SandboxStartMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, sandboxstart.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SandboxStartMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sandboxstart.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SandboxStartMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sandboxstart.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SandboxStart numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SandboxStartMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SandboxStartMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SandboxStartMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SandboxStart nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SandboxStartMutation) ResetField(name string) error {
+	switch name {
+	case sandboxstart.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sandboxstart.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case sandboxstart.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case sandboxstart.FieldSandboxID:
+		m.ResetSandboxID()
+		return nil
+	case sandboxstart.FieldResumed:
+		m.ResetResumed()
+		return nil
+	case sandboxstart.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	}
+	return fmt.Errorf("unknown SandboxStart field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SandboxStartMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SandboxStartMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SandboxStartMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SandboxStartMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SandboxStartMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SandboxStartMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SandboxStartMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SandboxStart unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SandboxStartMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SandboxStart edge %s", name)
+}
+
+// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
+type SnapshotMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	base_env_id   *string
+	sandbox_id    *string
+	metadata      *map[string]string
+	clearedFields map[string]struct{}
+	env           *string
+	clearedenv    bool
+	done          bool
+	oldValue      func(context.Context) (*Snapshot, error)
+	predicates    []predicate.Snapshot
+}
+
+var _ ent.Mutation = (*SnapshotMutation)(nil)
+
+// snapshotOption allows management of the mutation configuration using functional options.
+type snapshotOption func(*SnapshotMutation)
+
+// newSnapshotMutation creates new mutation for the Snapshot entity.
+func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
+	m := &SnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSnapshotID sets the ID field of the mutation.
+func withSnapshotID(id uuid.UUID) snapshotOption {
+	return func(m *SnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Snapshot
+		)
+		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Snapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSnapshot sets the old Snapshot of the mutation.
+func withSnapshot(node *Snapshot) snapshotOption {
+	return func(m *SnapshotMutation) {
+		m.oldValue = func(context.Context) (*Snapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Snapshot entities.
+func (m *SnapshotMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (m *SnapshotMutation) SetBaseEnvID(s string) {
+	m.base_env_id = &s
+}
+
+// BaseEnvID returns the value of the "base_env_id" field in the mutation.
+func (m *SnapshotMutation) BaseEnvID() (r string, exists bool) {
+	v := m.base_env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseEnvID returns the old "base_env_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
+	}
+	return oldValue.BaseEnvID, nil
+}
+
+// ResetBaseEnvID resets all changes to the "base_env_id" field.
+func (m *SnapshotMutation) ResetBaseEnvID() {
+	m.base_env_id = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *SnapshotMutation) SetEnvID(s string) {
+	m.env = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *SnapshotMutation) EnvID() (r string, exists bool) {
+	v := m.env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *SnapshotMutation) ResetEnvID() {
+	m.env = nil
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (m *SnapshotMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
+}
+
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *SnapshotMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSandboxID returns the old "sandbox_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldSandboxID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
+	}
+	return oldValue.SandboxID, nil
+}
+
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *SnapshotMutation) ResetSandboxID() {
+	m.sandbox_id = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SnapshotMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SnapshotMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SnapshotMutation) ResetMetadata() {
+	m.metadata = nil
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *SnapshotMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[snapshot.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *SnapshotMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *SnapshotMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *SnapshotMutation) ResetEnv() {
+	m.env = nil
 	m.clearedenv = false
 }
 
-// Where appends a list predicates to the EnvBuildMutation builder.
-func (m *EnvBuildMutation) Where(ps ...predicate.EnvBuild) {
+// Where appends a list predicates to the SnapshotMutation builder.
+func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Snapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Snapshot).
+func (m *SnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, snapshot.FieldCreatedAt)
+	}
+	if m.base_env_id != nil {
+		fields = append(fields, snapshot.FieldBaseEnvID)
+	}
+	if m.env != nil {
+		fields = append(fields, snapshot.FieldEnvID)
+	}
+	if m.sandbox_id != nil {
+		fields = append(fields, snapshot.FieldSandboxID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, snapshot.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case snapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	case snapshot.FieldBaseEnvID:
+		return m.BaseEnvID()
+	case snapshot.FieldEnvID:
+		return m.EnvID()
+	case snapshot.FieldSandboxID:
+		return m.SandboxID()
+	case snapshot.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case snapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case snapshot.FieldBaseEnvID:
+		return m.OldBaseEnvID(ctx)
+	case snapshot.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case snapshot.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case snapshot.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown Snapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case snapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case snapshot.FieldBaseEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseEnvID(v)
+		return nil
+	case snapshot.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case snapshot.FieldSandboxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxID(v)
+		return nil
+	case snapshot.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SnapshotMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Snapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SnapshotMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SnapshotMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Snapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SnapshotMutation) ResetField(name string) error {
+	switch name {
+	case snapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case snapshot.FieldBaseEnvID:
+		m.ResetBaseEnvID()
+		return nil
+	case snapshot.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case snapshot.FieldSandboxID:
+		m.ResetSandboxID()
+		return nil
+	case snapshot.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.env != nil {
+		edges = append(edges, snapshot.EdgeEnv)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case snapshot.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenv {
+		edges = append(edges, snapshot.EdgeEnv)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SnapshotMutation) EdgeCleared(name string) bool {
+	switch name {
+	case snapshot.EdgeEnv:
+		return m.clearedenv
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SnapshotMutation) ClearEdge(name string) error {
+	switch name {
+	case snapshot.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SnapshotMutation) ResetEdge(name string) error {
+	switch name {
+	case snapshot.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot edge %s", name)
+}
+
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uuid.UUID
+	created_at                  *time.Time
+	is_banned                   *bool
+	is_blocked                  *bool
+	blocked_reason              *string
+	name                        *string
+	email                       *string
+	encrypt_snapshots           *bool
+	replication_regions         *[]string
+	appendreplication_regions   []string
+	build_log_retention_days    *int32
+	addbuild_log_retention_days *int32
+	clearedFields               map[string]struct{}
+	users                       map[uuid.UUID]struct{}
+	removedusers                map[uuid.UUID]struct{}
+	clearedusers                bool
+	team_api_keys               map[uuid.UUID]struct{}
+	removedteam_api_keys        map[uuid.UUID]struct{}
+	clearedteam_api_keys        bool
+	team_tier                   *string
+	clearedteam_tier            bool
+	envs                        map[string]struct{}
+	removedenvs                 map[string]struct{}
+	clearedenvs                 bool
+	users_teams                 map[int]struct{}
+	removedusers_teams          map[int]struct{}
+	clearedusers_teams          bool
+	done                        bool
+	oldValue                    func(context.Context) (*Team, error)
+	predicates                  []predicate.Team
+}
+
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeam,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id uuid.UUID) teamOption {
+	return func(m *TeamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Team
+		)
+		m.oldValue = func(ctx context.Context) (*Team, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Team.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Team entities.
+func (m *TeamMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetIsBanned sets the "is_banned" field.
+func (m *TeamMutation) SetIsBanned(b bool) {
+	m.is_banned = &b
+}
+
+// IsBanned returns the value of the "is_banned" field in the mutation.
+func (m *TeamMutation) IsBanned() (r bool, exists bool) {
+	v := m.is_banned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBanned returns the old "is_banned" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBanned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
+	}
+	return oldValue.IsBanned, nil
+}
+
+// ClearIsBanned clears the value of the "is_banned" field.
+func (m *TeamMutation) ClearIsBanned() {
+	m.is_banned = nil
+	m.clearedFields[team.FieldIsBanned] = struct{}{}
+}
+
+// IsBannedCleared returns if the "is_banned" field was cleared in this mutation.
+func (m *TeamMutation) IsBannedCleared() bool {
+	_, ok := m.clearedFields[team.FieldIsBanned]
+	return ok
+}
+
+// ResetIsBanned resets all changes to the "is_banned" field.
+func (m *TeamMutation) ResetIsBanned() {
+	m.is_banned = nil
+	delete(m.clearedFields, team.FieldIsBanned)
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (m *TeamMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
+}
+
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *TeamMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBlocked returns the old "is_blocked" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+	}
+	return oldValue.IsBlocked, nil
+}
+
+// ClearIsBlocked clears the value of the "is_blocked" field.
+func (m *TeamMutation) ClearIsBlocked() {
+	m.is_blocked = nil
+	m.clearedFields[team.FieldIsBlocked] = struct{}{}
+}
+
+// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
+func (m *TeamMutation) IsBlockedCleared() bool {
+	_, ok := m.clearedFields[team.FieldIsBlocked]
+	return ok
+}
+
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *TeamMutation) ResetIsBlocked() {
+	m.is_blocked = nil
+	delete(m.clearedFields, team.FieldIsBlocked)
+}
+
+// SetBlockedReason sets the "blocked_reason" field.
+func (m *TeamMutation) SetBlockedReason(s string) {
+	m.blocked_reason = &s
+}
+
+// BlockedReason returns the value of the "blocked_reason" field in the mutation.
+func (m *TeamMutation) BlockedReason() (r string, exists bool) {
+	v := m.blocked_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockedReason returns the old "blocked_reason" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldBlockedReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockedReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockedReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockedReason: %w", err)
+	}
+	return oldValue.BlockedReason, nil
+}
+
+// ClearBlockedReason clears the value of the "blocked_reason" field.
+func (m *TeamMutation) ClearBlockedReason() {
+	m.blocked_reason = nil
+	m.clearedFields[team.FieldBlockedReason] = struct{}{}
+}
+
+// BlockedReasonCleared returns if the "blocked_reason" field was cleared in this mutation.
+func (m *TeamMutation) BlockedReasonCleared() bool {
+	_, ok := m.clearedFields[team.FieldBlockedReason]
+	return ok
+}
+
+// ResetBlockedReason resets all changes to the "blocked_reason" field.
+func (m *TeamMutation) ResetBlockedReason() {
+	m.blocked_reason = nil
+	delete(m.clearedFields, team.FieldBlockedReason)
+}
+
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTier sets the "tier" field.
+func (m *TeamMutation) SetTier(s string) {
+	m.team_tier = &s
+}
+
+// Tier returns the value of the "tier" field in the mutation.
+func (m *TeamMutation) Tier() (r string, exists bool) {
+	v := m.team_tier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTier returns the old "tier" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldTier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+	}
+	return oldValue.Tier, nil
+}
+
+// ResetTier resets all changes to the "tier" field.
+func (m *TeamMutation) ResetTier() {
+	m.team_tier = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *TeamMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *TeamMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *TeamMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetEncryptSnapshots sets the "encrypt_snapshots" field.
+func (m *TeamMutation) SetEncryptSnapshots(b bool) {
+	m.encrypt_snapshots = &b
+}
+
+// EncryptSnapshots returns the value of the "encrypt_snapshots" field in the mutation.
+func (m *TeamMutation) EncryptSnapshots() (r bool, exists bool) {
+	v := m.encrypt_snapshots
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptSnapshots returns the old "encrypt_snapshots" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldEncryptSnapshots(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptSnapshots is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptSnapshots requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptSnapshots: %w", err)
+	}
+	return oldValue.EncryptSnapshots, nil
+}
+
+// ResetEncryptSnapshots resets all changes to the "encrypt_snapshots" field.
+func (m *TeamMutation) ResetEncryptSnapshots() {
+	m.encrypt_snapshots = nil
+}
+
+// SetReplicationRegions sets the "replication_regions" field.
+func (m *TeamMutation) SetReplicationRegions(s []string) {
+	m.replication_regions = &s
+	m.appendreplication_regions = nil
+}
+
+// ReplicationRegions returns the value of the "replication_regions" field in the mutation.
+func (m *TeamMutation) ReplicationRegions() (r []string, exists bool) {
+	v := m.replication_regions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplicationRegions returns the old "replication_regions" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldReplicationRegions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplicationRegions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplicationRegions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplicationRegions: %w", err)
+	}
+	return oldValue.ReplicationRegions, nil
+}
+
+// AppendReplicationRegions adds s to the "replication_regions" field.
+func (m *TeamMutation) AppendReplicationRegions(s []string) {
+	m.appendreplication_regions = append(m.appendreplication_regions, s...)
+}
+
+// AppendedReplicationRegions returns the list of values that were appended to the "replication_regions" field in this mutation.
+func (m *TeamMutation) AppendedReplicationRegions() ([]string, bool) {
+	if len(m.appendreplication_regions) == 0 {
+		return nil, false
+	}
+	return m.appendreplication_regions, true
+}
+
+// ClearReplicationRegions clears the value of the "replication_regions" field.
+func (m *TeamMutation) ClearReplicationRegions() {
+	m.replication_regions = nil
+	m.appendreplication_regions = nil
+	m.clearedFields[team.FieldReplicationRegions] = struct{}{}
+}
+
+// ReplicationRegionsCleared returns if the "replication_regions" field was cleared in this mutation.
+func (m *TeamMutation) ReplicationRegionsCleared() bool {
+	_, ok := m.clearedFields[team.FieldReplicationRegions]
+	return ok
+}
+
+// ResetReplicationRegions resets all changes to the "replication_regions" field.
+func (m *TeamMutation) ResetReplicationRegions() {
+	m.replication_regions = nil
+	m.appendreplication_regions = nil
+	delete(m.clearedFields, team.FieldReplicationRegions)
+}
+
+// SetBuildLogRetentionDays sets the "build_log_retention_days" field.
+func (m *TeamMutation) SetBuildLogRetentionDays(i int32) {
+	m.build_log_retention_days = &i
+	m.addbuild_log_retention_days = nil
+}
+
+// BuildLogRetentionDays returns the value of the "build_log_retention_days" field in the mutation.
+func (m *TeamMutation) BuildLogRetentionDays() (r int32, exists bool) {
+	v := m.build_log_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildLogRetentionDays returns the old "build_log_retention_days" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldBuildLogRetentionDays(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildLogRetentionDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildLogRetentionDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildLogRetentionDays: %w", err)
+	}
+	return oldValue.BuildLogRetentionDays, nil
+}
+
+// AddBuildLogRetentionDays adds i to the "build_log_retention_days" field.
+func (m *TeamMutation) AddBuildLogRetentionDays(i int32) {
+	if m.addbuild_log_retention_days != nil {
+		*m.addbuild_log_retention_days += i
+	} else {
+		m.addbuild_log_retention_days = &i
+	}
+}
+
+// AddedBuildLogRetentionDays returns the value that was added to the "build_log_retention_days" field in this mutation.
+func (m *TeamMutation) AddedBuildLogRetentionDays() (r int32, exists bool) {
+	v := m.addbuild_log_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBuildLogRetentionDays resets all changes to the "build_log_retention_days" field.
+func (m *TeamMutation) ResetBuildLogRetentionDays() {
+	m.build_log_retention_days = nil
+	m.addbuild_log_retention_days = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *TeamMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *TeamMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *TeamMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *TeamMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *TeamMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *TeamMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *TeamMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddTeamAPIKeyIDs adds the "team_api_keys" edge to the TeamAPIKey entity by ids.
+func (m *TeamMutation) AddTeamAPIKeyIDs(ids ...uuid.UUID) {
+	if m.team_api_keys == nil {
+		m.team_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.team_api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeamAPIKeys clears the "team_api_keys" edge to the TeamAPIKey entity.
+func (m *TeamMutation) ClearTeamAPIKeys() {
+	m.clearedteam_api_keys = true
+}
+
+// TeamAPIKeysCleared reports if the "team_api_keys" edge to the TeamAPIKey entity was cleared.
+func (m *TeamMutation) TeamAPIKeysCleared() bool {
+	return m.clearedteam_api_keys
+}
+
+// RemoveTeamAPIKeyIDs removes the "team_api_keys" edge to the TeamAPIKey entity by IDs.
+func (m *TeamMutation) RemoveTeamAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedteam_api_keys == nil {
+		m.removedteam_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.team_api_keys, ids[i])
+		m.removedteam_api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeamAPIKeys returns the removed IDs of the "team_api_keys" edge to the TeamAPIKey entity.
+func (m *TeamMutation) RemovedTeamAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedteam_api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamAPIKeysIDs returns the "team_api_keys" edge IDs in the mutation.
+func (m *TeamMutation) TeamAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.team_api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeamAPIKeys resets all changes to the "team_api_keys" edge.
+func (m *TeamMutation) ResetTeamAPIKeys() {
+	m.team_api_keys = nil
+	m.clearedteam_api_keys = false
+	m.removedteam_api_keys = nil
+}
+
+// SetTeamTierID sets the "team_tier" edge to the Tier entity by id.
+func (m *TeamMutation) SetTeamTierID(id string) {
+	m.team_tier = &id
+}
+
+// ClearTeamTier clears the "team_tier" edge to the Tier entity.
+func (m *TeamMutation) ClearTeamTier() {
+	m.clearedteam_tier = true
+	m.clearedFields[team.FieldTier] = struct{}{}
+}
+
+// TeamTierCleared reports if the "team_tier" edge to the Tier entity was cleared.
+func (m *TeamMutation) TeamTierCleared() bool {
+	return m.clearedteam_tier
+}
+
+// TeamTierID returns the "team_tier" edge ID in the mutation.
+func (m *TeamMutation) TeamTierID() (id string, exists bool) {
+	if m.team_tier != nil {
+		return *m.team_tier, true
+	}
+	return
+}
+
+// TeamTierIDs returns the "team_tier" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamTierID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) TeamTierIDs() (ids []string) {
+	if id := m.team_tier; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeamTier resets all changes to the "team_tier" edge.
+func (m *TeamMutation) ResetTeamTier() {
+	m.team_tier = nil
+	m.clearedteam_tier = false
+}
+
+// AddEnvIDs adds the "envs" edge to the Env entity by ids.
+func (m *TeamMutation) AddEnvIDs(ids ...string) {
+	if m.envs == nil {
+		m.envs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.envs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnvs clears the "envs" edge to the Env entity.
+func (m *TeamMutation) ClearEnvs() {
+	m.clearedenvs = true
+}
+
+// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
+func (m *TeamMutation) EnvsCleared() bool {
+	return m.clearedenvs
+}
+
+// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
+func (m *TeamMutation) RemoveEnvIDs(ids ...string) {
+	if m.removedenvs == nil {
+		m.removedenvs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.envs, ids[i])
+		m.removedenvs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
+func (m *TeamMutation) RemovedEnvsIDs() (ids []string) {
+	for id := range m.removedenvs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnvsIDs returns the "envs" edge IDs in the mutation.
+func (m *TeamMutation) EnvsIDs() (ids []string) {
+	for id := range m.envs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnvs resets all changes to the "envs" edge.
+func (m *TeamMutation) ResetEnvs() {
+	m.envs = nil
+	m.clearedenvs = false
+	m.removedenvs = nil
+}
+
+// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
+func (m *TeamMutation) AddUsersTeamIDs(ids ...int) {
+	if m.users_teams == nil {
+		m.users_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users_teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
+func (m *TeamMutation) ClearUsersTeams() {
+	m.clearedusers_teams = true
+}
+
+// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
+func (m *TeamMutation) UsersTeamsCleared() bool {
+	return m.clearedusers_teams
+}
+
+// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
+func (m *TeamMutation) RemoveUsersTeamIDs(ids ...int) {
+	if m.removedusers_teams == nil {
+		m.removedusers_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users_teams, ids[i])
+		m.removedusers_teams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
+func (m *TeamMutation) RemovedUsersTeamsIDs() (ids []int) {
+	for id := range m.removedusers_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
+func (m *TeamMutation) UsersTeamsIDs() (ids []int) {
+	for id := range m.users_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsersTeams resets all changes to the "users_teams" edge.
+func (m *TeamMutation) ResetUsersTeams() {
+	m.users_teams = nil
+	m.clearedusers_teams = false
+	m.removedusers_teams = nil
+}
+
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, team.FieldCreatedAt)
+	}
+	if m.is_banned != nil {
+		fields = append(fields, team.FieldIsBanned)
+	}
+	if m.is_blocked != nil {
+		fields = append(fields, team.FieldIsBlocked)
+	}
+	if m.blocked_reason != nil {
+		fields = append(fields, team.FieldBlockedReason)
+	}
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
+	}
+	if m.team_tier != nil {
+		fields = append(fields, team.FieldTier)
+	}
+	if m.email != nil {
+		fields = append(fields, team.FieldEmail)
+	}
+	if m.encrypt_snapshots != nil {
+		fields = append(fields, team.FieldEncryptSnapshots)
+	}
+	if m.replication_regions != nil {
+		fields = append(fields, team.FieldReplicationRegions)
+	}
+	if m.build_log_retention_days != nil {
+		fields = append(fields, team.FieldBuildLogRetentionDays)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldCreatedAt:
+		return m.CreatedAt()
+	case team.FieldIsBanned:
+		return m.IsBanned()
+	case team.FieldIsBlocked:
+		return m.IsBlocked()
+	case team.FieldBlockedReason:
+		return m.BlockedReason()
+	case team.FieldName:
+		return m.Name()
+	case team.FieldTier:
+		return m.Tier()
+	case team.FieldEmail:
+		return m.Email()
+	case team.FieldEncryptSnapshots:
+		return m.EncryptSnapshots()
+	case team.FieldReplicationRegions:
+		return m.ReplicationRegions()
+	case team.FieldBuildLogRetentionDays:
+		return m.BuildLogRetentionDays()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case team.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case team.FieldIsBanned:
+		return m.OldIsBanned(ctx)
+	case team.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case team.FieldBlockedReason:
+		return m.OldBlockedReason(ctx)
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldTier:
+		return m.OldTier(ctx)
+	case team.FieldEmail:
+		return m.OldEmail(ctx)
+	case team.FieldEncryptSnapshots:
+		return m.OldEncryptSnapshots(ctx)
+	case team.FieldReplicationRegions:
+		return m.OldReplicationRegions(ctx)
+	case team.FieldBuildLogRetentionDays:
+		return m.OldBuildLogRetentionDays(ctx)
+	}
+	return nil, fmt.Errorf("unknown Team field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case team.FieldIsBanned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBanned(v)
+		return nil
+	case team.FieldIsBlocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBlocked(v)
+		return nil
+	case team.FieldBlockedReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockedReason(v)
+		return nil
+	case team.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case team.FieldTier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTier(v)
+		return nil
+	case team.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case team.FieldEncryptSnapshots:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptSnapshots(v)
+		return nil
+	case team.FieldReplicationRegions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplicationRegions(v)
+		return nil
+	case team.FieldBuildLogRetentionDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildLogRetentionDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMutation) AddedFields() []string {
+	var fields []string
+	if m.addbuild_log_retention_days != nil {
+		fields = append(fields, team.FieldBuildLogRetentionDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldBuildLogRetentionDays:
+		return m.AddedBuildLogRetentionDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldBuildLogRetentionDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuildLogRetentionDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(team.FieldIsBanned) {
+		fields = append(fields, team.FieldIsBanned)
+	}
+	if m.FieldCleared(team.FieldIsBlocked) {
+		fields = append(fields, team.FieldIsBlocked)
+	}
+	if m.FieldCleared(team.FieldBlockedReason) {
+		fields = append(fields, team.FieldBlockedReason)
+	}
+	if m.FieldCleared(team.FieldReplicationRegions) {
+		fields = append(fields, team.FieldReplicationRegions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMutation) ClearField(name string) error {
+	switch name {
+	case team.FieldIsBanned:
+		m.ClearIsBanned()
+		return nil
+	case team.FieldIsBlocked:
+		m.ClearIsBlocked()
+		return nil
+	case team.FieldBlockedReason:
+		m.ClearBlockedReason()
+		return nil
+	case team.FieldReplicationRegions:
+		m.ClearReplicationRegions()
+		return nil
+	}
+	return fmt.Errorf("unknown Team nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMutation) ResetField(name string) error {
+	switch name {
+	case team.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case team.FieldIsBanned:
+		m.ResetIsBanned()
+		return nil
+	case team.FieldIsBlocked:
+		m.ResetIsBlocked()
+		return nil
+	case team.FieldBlockedReason:
+		m.ResetBlockedReason()
+		return nil
+	case team.FieldName:
+		m.ResetName()
+		return nil
+	case team.FieldTier:
+		m.ResetTier()
+		return nil
+	case team.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case team.FieldEncryptSnapshots:
+		m.ResetEncryptSnapshots()
+		return nil
+	case team.FieldReplicationRegions:
+		m.ResetReplicationRegions()
+		return nil
+	case team.FieldBuildLogRetentionDays:
+		m.ResetBuildLogRetentionDays()
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.users != nil {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.team_api_keys != nil {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.team_tier != nil {
+		edges = append(edges, team.EdgeTeamTier)
+	}
+	if m.envs != nil {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.users_teams != nil {
+		edges = append(edges, team.EdgeUsersTeams)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamAPIKeys:
+		ids := make([]ent.Value, 0, len(m.team_api_keys))
+		for id := range m.team_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamTier:
+		if id := m.team_tier; id != nil {
+			return []ent.Value{*id}
+		}
+	case team.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.envs))
+		for id := range m.envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.users_teams))
+		for id := range m.users_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedusers != nil {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.removedteam_api_keys != nil {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.removedenvs != nil {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.removedusers_teams != nil {
+		edges = append(edges, team.EdgeUsersTeams)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedteam_api_keys))
+		for id := range m.removedteam_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.removedenvs))
+		for id := range m.removedenvs {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.removedusers_teams))
+		for id := range m.removedusers_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedusers {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.clearedteam_api_keys {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.clearedteam_tier {
+		edges = append(edges, team.EdgeTeamTier)
+	}
+	if m.clearedenvs {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.clearedusers_teams {
+		edges = append(edges, team.EdgeUsersTeams)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMutation) EdgeCleared(name string) bool {
+	switch name {
+	case team.EdgeUsers:
+		return m.clearedusers
+	case team.EdgeTeamAPIKeys:
+		return m.clearedteam_api_keys
+	case team.EdgeTeamTier:
+		return m.clearedteam_tier
+	case team.EdgeEnvs:
+		return m.clearedenvs
+	case team.EdgeUsersTeams:
+		return m.clearedusers_teams
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMutation) ClearEdge(name string) error {
+	switch name {
+	case team.EdgeTeamTier:
+		m.ClearTeamTier()
+		return nil
+	}
+	return fmt.Errorf("unknown Team unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMutation) ResetEdge(name string) error {
+	switch name {
+	case team.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case team.EdgeTeamAPIKeys:
+		m.ResetTeamAPIKeys()
+		return nil
+	case team.EdgeTeamTier:
+		m.ResetTeamTier()
+		return nil
+	case team.EdgeEnvs:
+		m.ResetEnvs()
+		return nil
+	case team.EdgeUsersTeams:
+		m.ResetUsersTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown Team edge %s", name)
+}
+
+// TeamAPIKeyMutation represents an operation that mutates the TeamAPIKey nodes in the graph.
+type TeamAPIKeyMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	api_key        *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	name           *string
+	last_used      *time.Time
+	scopes         *[]string
+	appendscopes   []string
+	clearedFields  map[string]struct{}
+	team           *uuid.UUID
+	clearedteam    bool
+	creator        *uuid.UUID
+	clearedcreator bool
+	done           bool
+	oldValue       func(context.Context) (*TeamAPIKey, error)
+	predicates     []predicate.TeamAPIKey
+}
+
+var _ ent.Mutation = (*TeamAPIKeyMutation)(nil)
+
+// teamapikeyOption allows management of the mutation configuration using functional options.
+type teamapikeyOption func(*TeamAPIKeyMutation)
+
+// newTeamAPIKeyMutation creates new mutation for the TeamAPIKey entity.
+func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKeyMutation {
+	m := &TeamAPIKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamAPIKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamAPIKeyID sets the ID field of the mutation.
+func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
+	return func(m *TeamAPIKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamAPIKey
+		)
+		m.oldValue = func(ctx context.Context) (*TeamAPIKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamAPIKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeamAPIKey sets the old TeamAPIKey of the mutation.
+func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
+	return func(m *TeamAPIKeyMutation) {
+		m.oldValue = func(context.Context) (*TeamAPIKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamAPIKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TeamAPIKey entities.
+func (m *TeamAPIKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamAPIKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamAPIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamAPIKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAPIKey sets the "api_key" field.
+func (m *TeamAPIKeyMutation) SetAPIKey(s string) {
+	m.api_key = &s
+}
+
+// APIKey returns the value of the "api_key" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKey() (r string, exists bool) {
+	v := m.api_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKey returns the old "api_key" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldAPIKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
+	}
+	return oldValue.APIKey, nil
+}
+
+// ResetAPIKey resets all changes to the "api_key" field.
+func (m *TeamAPIKeyMutation) ResetAPIKey() {
+	m.api_key = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamAPIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamAPIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamAPIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamAPIKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamAPIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *TeamAPIKeyMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[teamapikey.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamAPIKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, teamapikey.FieldUpdatedAt)
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *TeamAPIKeyMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamAPIKeyMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamAPIKeyMutation) ResetTeamID() {
+	m.team = nil
+}
+
+// SetName sets the "name" field.
+func (m *TeamAPIKeyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamAPIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamAPIKeyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *TeamAPIKeyMutation) SetCreatedBy(u uuid.UUID) {
+	m.creator = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *TeamAPIKeyMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *TeamAPIKeyMutation) ClearCreatedBy() {
+	m.creator = nil
+	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *TeamAPIKeyMutation) ResetCreatedBy() {
+	m.creator = nil
+	delete(m.clearedFields, teamapikey.FieldCreatedBy)
+}
+
+// SetLastUsed sets the "last_used" field.
+func (m *TeamAPIKeyMutation) SetLastUsed(t time.Time) {
+	m.last_used = &t
+}
+
+// LastUsed returns the value of the "last_used" field in the mutation.
+func (m *TeamAPIKeyMutation) LastUsed() (r time.Time, exists bool) {
+	v := m.last_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsed returns the old "last_used" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldLastUsed(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
+	}
+	return oldValue.LastUsed, nil
+}
+
+// ClearLastUsed clears the value of the "last_used" field.
+func (m *TeamAPIKeyMutation) ClearLastUsed() {
+	m.last_used = nil
+	m.clearedFields[teamapikey.FieldLastUsed] = struct{}{}
+}
+
+// LastUsedCleared returns if the "last_used" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) LastUsedCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldLastUsed]
+	return ok
+}
+
+// ResetLastUsed resets all changes to the "last_used" field.
+func (m *TeamAPIKeyMutation) ResetLastUsed() {
+	m.last_used = nil
+	delete(m.clearedFields, teamapikey.FieldLastUsed)
+}
+
+// SetScopes sets the "scopes" field.
+func (m *TeamAPIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *TeamAPIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *TeamAPIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *TeamAPIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *TeamAPIKeyMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[teamapikey.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *TeamAPIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, teamapikey.FieldScopes)
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *TeamAPIKeyMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[teamapikey.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *TeamAPIKeyMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *TeamAPIKeyMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *TeamAPIKeyMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *TeamAPIKeyMutation) SetCreatorID(id uuid.UUID) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *TeamAPIKeyMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *TeamAPIKeyMutation) CreatorCleared() bool {
+	return m.CreatedByCleared() || m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *TeamAPIKeyMutation) CreatorID() (id uuid.UUID, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *TeamAPIKeyMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *TeamAPIKeyMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// Where appends a list predicates to the TeamAPIKeyMutation builder.
+func (m *TeamAPIKeyMutation) Where(ps ...predicate.TeamAPIKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamAPIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamAPIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamAPIKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamAPIKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TeamAPIKey).
+func (m *TeamAPIKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamAPIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.api_key != nil {
+		fields = append(fields, teamapikey.FieldAPIKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, teamapikey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, teamapikey.FieldUpdatedAt)
+	}
+	if m.team != nil {
+		fields = append(fields, teamapikey.FieldTeamID)
+	}
+	if m.name != nil {
+		fields = append(fields, teamapikey.FieldName)
+	}
+	if m.creator != nil {
+		fields = append(fields, teamapikey.FieldCreatedBy)
+	}
+	if m.last_used != nil {
+		fields = append(fields, teamapikey.FieldLastUsed)
+	}
+	if m.scopes != nil {
+		fields = append(fields, teamapikey.FieldScopes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case teamapikey.FieldAPIKey:
+		return m.APIKey()
+	case teamapikey.FieldCreatedAt:
+		return m.CreatedAt()
+	case teamapikey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case teamapikey.FieldTeamID:
+		return m.TeamID()
+	case teamapikey.FieldName:
+		return m.Name()
+	case teamapikey.FieldCreatedBy:
+		return m.CreatedBy()
+	case teamapikey.FieldLastUsed:
+		return m.LastUsed()
+	case teamapikey.FieldScopes:
+		return m.Scopes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamAPIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case teamapikey.FieldAPIKey:
+		return m.OldAPIKey(ctx)
+	case teamapikey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case teamapikey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case teamapikey.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teamapikey.FieldName:
+		return m.OldName(ctx)
+	case teamapikey.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case teamapikey.FieldLastUsed:
+		return m.OldLastUsed(ctx)
+	case teamapikey.FieldScopes:
+		return m.OldScopes(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamAPIKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamAPIKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teamapikey.FieldAPIKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKey(v)
+		return nil
+	case teamapikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case teamapikey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case teamapikey.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case teamapikey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case teamapikey.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case teamapikey.FieldLastUsed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsed(v)
+		return nil
+	case teamapikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamAPIKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamAPIKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamAPIKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TeamAPIKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamAPIKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(teamapikey.FieldUpdatedAt) {
+		fields = append(fields, teamapikey.FieldUpdatedAt)
+	}
+	if m.FieldCleared(teamapikey.FieldCreatedBy) {
+		fields = append(fields, teamapikey.FieldCreatedBy)
+	}
+	if m.FieldCleared(teamapikey.FieldLastUsed) {
+		fields = append(fields, teamapikey.FieldLastUsed)
+	}
+	if m.FieldCleared(teamapikey.FieldScopes) {
+		fields = append(fields, teamapikey.FieldScopes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamAPIKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamAPIKeyMutation) ClearField(name string) error {
+	switch name {
+	case teamapikey.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case teamapikey.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case teamapikey.FieldLastUsed:
+		m.ClearLastUsed()
+		return nil
+	case teamapikey.FieldScopes:
+		m.ClearScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamAPIKeyMutation) ResetField(name string) error {
+	switch name {
+	case teamapikey.FieldAPIKey:
+		m.ResetAPIKey()
+		return nil
+	case teamapikey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case teamapikey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case teamapikey.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case teamapikey.FieldName:
+		m.ResetName()
+		return nil
+	case teamapikey.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case teamapikey.FieldLastUsed:
+		m.ResetLastUsed()
+		return nil
+	case teamapikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamAPIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.team != nil {
+		edges = append(edges, teamapikey.EdgeTeam)
+	}
+	if m.creator != nil {
+		edges = append(edges, teamapikey.EdgeCreator)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamAPIKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case teamapikey.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case teamapikey.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamAPIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamAPIKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamAPIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteam {
+		edges = append(edges, teamapikey.EdgeTeam)
+	}
+	if m.clearedcreator {
+		edges = append(edges, teamapikey.EdgeCreator)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamAPIKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case teamapikey.EdgeTeam:
+		return m.clearedteam
+	case teamapikey.EdgeCreator:
+		return m.clearedcreator
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamAPIKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case teamapikey.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case teamapikey.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamAPIKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case teamapikey.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case teamapikey.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey edge %s", name)
+}
+
+// TeamBudgetMutation represents an operation that mutates the TeamBudget nodes in the graph.
+type TeamBudgetMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	created_at           *time.Time
+	updated_at           *time.Time
+	team_id              *uuid.UUID
+	monthly_limit_usd    *float64
+	addmonthly_limit_usd *float64
+	warning_percent      *int32
+	addwarning_percent   *int32
+	pause_sandboxes      *bool
+	period_start         *time.Time
+	accrued_usd          *float64
+	addaccrued_usd       *float64
+	metered_at           *time.Time
+	warned_at            *time.Time
+	exceeded_at          *time.Time
+	override_until       *time.Time
+	override_reason      *string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*TeamBudget, error)
+	predicates           []predicate.TeamBudget
+}
+
+var _ ent.Mutation = (*TeamBudgetMutation)(nil)
+
+// teambudgetOption allows management of the mutation configuration using functional options.
+type teambudgetOption func(*TeamBudgetMutation)
+
+// newTeamBudgetMutation creates new mutation for the TeamBudget entity.
+func newTeamBudgetMutation(c config, op Op, opts ...teambudgetOption) *TeamBudgetMutation {
+	m := &TeamBudgetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamBudget,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamBudgetID sets the ID field of the mutation.
+func withTeamBudgetID(id uuid.UUID) teambudgetOption {
+	return func(m *TeamBudgetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamBudget
+		)
+		m.oldValue = func(ctx context.Context) (*TeamBudget, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamBudget.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeamBudget sets the old TeamBudget of the mutation.
+func withTeamBudget(node *TeamBudget) teambudgetOption {
+	return func(m *TeamBudgetMutation) {
+		m.oldValue = func(context.Context) (*TeamBudget, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamBudgetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamBudgetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TeamBudget entities.
+func (m *TeamBudgetMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamBudgetMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamBudgetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamBudget.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamBudgetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamBudgetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamBudgetMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamBudgetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamBudgetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamBudgetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *TeamBudgetMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamBudgetMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamBudgetMutation) ResetTeamID() {
+	m.team_id = nil
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (m *TeamBudgetMutation) SetMonthlyLimitUsd(f float64) {
+	m.monthly_limit_usd = &f
+	m.addmonthly_limit_usd = nil
+}
+
+// MonthlyLimitUsd returns the value of the "monthly_limit_usd" field in the mutation.
+func (m *TeamBudgetMutation) MonthlyLimitUsd() (r float64, exists bool) {
+	v := m.monthly_limit_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyLimitUsd returns the old "monthly_limit_usd" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldMonthlyLimitUsd(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyLimitUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyLimitUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyLimitUsd: %w", err)
+	}
+	return oldValue.MonthlyLimitUsd, nil
+}
+
+// AddMonthlyLimitUsd adds f to the "monthly_limit_usd" field.
+func (m *TeamBudgetMutation) AddMonthlyLimitUsd(f float64) {
+	if m.addmonthly_limit_usd != nil {
+		*m.addmonthly_limit_usd += f
+	} else {
+		m.addmonthly_limit_usd = &f
+	}
+}
+
+// AddedMonthlyLimitUsd returns the value that was added to the "monthly_limit_usd" field in this mutation.
+func (m *TeamBudgetMutation) AddedMonthlyLimitUsd() (r float64, exists bool) {
+	v := m.addmonthly_limit_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMonthlyLimitUsd resets all changes to the "monthly_limit_usd" field.
+func (m *TeamBudgetMutation) ResetMonthlyLimitUsd() {
+	m.monthly_limit_usd = nil
+	m.addmonthly_limit_usd = nil
+}
+
+// SetWarningPercent sets the "warning_percent" field.
+func (m *TeamBudgetMutation) SetWarningPercent(i int32) {
+	m.warning_percent = &i
+	m.addwarning_percent = nil
+}
+
+// WarningPercent returns the value of the "warning_percent" field in the mutation.
+func (m *TeamBudgetMutation) WarningPercent() (r int32, exists bool) {
+	v := m.warning_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWarningPercent returns the old "warning_percent" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldWarningPercent(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWarningPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWarningPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWarningPercent: %w", err)
+	}
+	return oldValue.WarningPercent, nil
+}
+
+// AddWarningPercent adds i to the "warning_percent" field.
+func (m *TeamBudgetMutation) AddWarningPercent(i int32) {
+	if m.addwarning_percent != nil {
+		*m.addwarning_percent += i
+	} else {
+		m.addwarning_percent = &i
+	}
+}
+
+// AddedWarningPercent returns the value that was added to the "warning_percent" field in this mutation.
+func (m *TeamBudgetMutation) AddedWarningPercent() (r int32, exists bool) {
+	v := m.addwarning_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWarningPercent resets all changes to the "warning_percent" field.
+func (m *TeamBudgetMutation) ResetWarningPercent() {
+	m.warning_percent = nil
+	m.addwarning_percent = nil
+}
+
+// SetPauseSandboxes sets the "pause_sandboxes" field.
+func (m *TeamBudgetMutation) SetPauseSandboxes(b bool) {
+	m.pause_sandboxes = &b
+}
+
+// PauseSandboxes returns the value of the "pause_sandboxes" field in the mutation.
+func (m *TeamBudgetMutation) PauseSandboxes() (r bool, exists bool) {
+	v := m.pause_sandboxes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPauseSandboxes returns the old "pause_sandboxes" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldPauseSandboxes(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPauseSandboxes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPauseSandboxes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPauseSandboxes: %w", err)
+	}
+	return oldValue.PauseSandboxes, nil
+}
+
+// ResetPauseSandboxes resets all changes to the "pause_sandboxes" field.
+func (m *TeamBudgetMutation) ResetPauseSandboxes() {
+	m.pause_sandboxes = nil
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (m *TeamBudgetMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
+}
+
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *TeamBudgetMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriodStart returns the old "period_start" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
+	}
+	return oldValue.PeriodStart, nil
+}
+
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *TeamBudgetMutation) ResetPeriodStart() {
+	m.period_start = nil
+}
+
+// SetAccruedUsd sets the "accrued_usd" field.
+func (m *TeamBudgetMutation) SetAccruedUsd(f float64) {
+	m.accrued_usd = &f
+	m.addaccrued_usd = nil
+}
+
+// AccruedUsd returns the value of the "accrued_usd" field in the mutation.
+func (m *TeamBudgetMutation) AccruedUsd() (r float64, exists bool) {
+	v := m.accrued_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccruedUsd returns the old "accrued_usd" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldAccruedUsd(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccruedUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccruedUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccruedUsd: %w", err)
+	}
+	return oldValue.AccruedUsd, nil
+}
+
+// AddAccruedUsd adds f to the "accrued_usd" field.
+func (m *TeamBudgetMutation) AddAccruedUsd(f float64) {
+	if m.addaccrued_usd != nil {
+		*m.addaccrued_usd += f
+	} else {
+		m.addaccrued_usd = &f
+	}
+}
+
+// AddedAccruedUsd returns the value that was added to the "accrued_usd" field in this mutation.
+func (m *TeamBudgetMutation) AddedAccruedUsd() (r float64, exists bool) {
+	v := m.addaccrued_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAccruedUsd resets all changes to the "accrued_usd" field.
+func (m *TeamBudgetMutation) ResetAccruedUsd() {
+	m.accrued_usd = nil
+	m.addaccrued_usd = nil
+}
+
+// SetMeteredAt sets the "metered_at" field.
+func (m *TeamBudgetMutation) SetMeteredAt(t time.Time) {
+	m.metered_at = &t
+}
+
+// MeteredAt returns the value of the "metered_at" field in the mutation.
+func (m *TeamBudgetMutation) MeteredAt() (r time.Time, exists bool) {
+	v := m.metered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeteredAt returns the old "metered_at" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldMeteredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeteredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeteredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeteredAt: %w", err)
+	}
+	return oldValue.MeteredAt, nil
+}
+
+// ResetMeteredAt resets all changes to the "metered_at" field.
+func (m *TeamBudgetMutation) ResetMeteredAt() {
+	m.metered_at = nil
+}
+
+// SetWarnedAt sets the "warned_at" field.
+func (m *TeamBudgetMutation) SetWarnedAt(t time.Time) {
+	m.warned_at = &t
+}
+
+// WarnedAt returns the value of the "warned_at" field in the mutation.
+func (m *TeamBudgetMutation) WarnedAt() (r time.Time, exists bool) {
+	v := m.warned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWarnedAt returns the old "warned_at" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldWarnedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWarnedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWarnedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWarnedAt: %w", err)
+	}
+	return oldValue.WarnedAt, nil
+}
+
+// ClearWarnedAt clears the value of the "warned_at" field.
+func (m *TeamBudgetMutation) ClearWarnedAt() {
+	m.warned_at = nil
+	m.clearedFields[teambudget.FieldWarnedAt] = struct{}{}
+}
+
+// WarnedAtCleared returns if the "warned_at" field was cleared in this mutation.
+func (m *TeamBudgetMutation) WarnedAtCleared() bool {
+	_, ok := m.clearedFields[teambudget.FieldWarnedAt]
+	return ok
+}
+
+// ResetWarnedAt resets all changes to the "warned_at" field.
+func (m *TeamBudgetMutation) ResetWarnedAt() {
+	m.warned_at = nil
+	delete(m.clearedFields, teambudget.FieldWarnedAt)
+}
+
+// SetExceededAt sets the "exceeded_at" field.
+func (m *TeamBudgetMutation) SetExceededAt(t time.Time) {
+	m.exceeded_at = &t
+}
+
+// ExceededAt returns the value of the "exceeded_at" field in the mutation.
+func (m *TeamBudgetMutation) ExceededAt() (r time.Time, exists bool) {
+	v := m.exceeded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExceededAt returns the old "exceeded_at" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldExceededAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExceededAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExceededAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExceededAt: %w", err)
+	}
+	return oldValue.ExceededAt, nil
+}
+
+// ClearExceededAt clears the value of the "exceeded_at" field.
+func (m *TeamBudgetMutation) ClearExceededAt() {
+	m.exceeded_at = nil
+	m.clearedFields[teambudget.FieldExceededAt] = struct{}{}
+}
+
+// ExceededAtCleared returns if the "exceeded_at" field was cleared in this mutation.
+func (m *TeamBudgetMutation) ExceededAtCleared() bool {
+	_, ok := m.clearedFields[teambudget.FieldExceededAt]
+	return ok
+}
+
+// ResetExceededAt resets all changes to the "exceeded_at" field.
+func (m *TeamBudgetMutation) ResetExceededAt() {
+	m.exceeded_at = nil
+	delete(m.clearedFields, teambudget.FieldExceededAt)
+}
+
+// SetOverrideUntil sets the "override_until" field.
+func (m *TeamBudgetMutation) SetOverrideUntil(t time.Time) {
+	m.override_until = &t
+}
+
+// OverrideUntil returns the value of the "override_until" field in the mutation.
+func (m *TeamBudgetMutation) OverrideUntil() (r time.Time, exists bool) {
+	v := m.override_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOverrideUntil returns the old "override_until" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldOverrideUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverrideUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverrideUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverrideUntil: %w", err)
+	}
+	return oldValue.OverrideUntil, nil
+}
+
+// ClearOverrideUntil clears the value of the "override_until" field.
+func (m *TeamBudgetMutation) ClearOverrideUntil() {
+	m.override_until = nil
+	m.clearedFields[teambudget.FieldOverrideUntil] = struct{}{}
+}
+
+// OverrideUntilCleared returns if the "override_until" field was cleared in this mutation.
+func (m *TeamBudgetMutation) OverrideUntilCleared() bool {
+	_, ok := m.clearedFields[teambudget.FieldOverrideUntil]
+	return ok
+}
+
+// ResetOverrideUntil resets all changes to the "override_until" field.
+func (m *TeamBudgetMutation) ResetOverrideUntil() {
+	m.override_until = nil
+	delete(m.clearedFields, teambudget.FieldOverrideUntil)
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (m *TeamBudgetMutation) SetOverrideReason(s string) {
+	m.override_reason = &s
+}
+
+// OverrideReason returns the value of the "override_reason" field in the mutation.
+func (m *TeamBudgetMutation) OverrideReason() (r string, exists bool) {
+	v := m.override_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOverrideReason returns the old "override_reason" field's value of the TeamBudget entity.
+// If the TeamBudget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamBudgetMutation) OldOverrideReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverrideReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverrideReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverrideReason: %w", err)
+	}
+	return oldValue.OverrideReason, nil
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (m *TeamBudgetMutation) ClearOverrideReason() {
+	m.override_reason = nil
+	m.clearedFields[teambudget.FieldOverrideReason] = struct{}{}
+}
+
+// OverrideReasonCleared returns if the "override_reason" field was cleared in this mutation.
+func (m *TeamBudgetMutation) OverrideReasonCleared() bool {
+	_, ok := m.clearedFields[teambudget.FieldOverrideReason]
+	return ok
+}
+
+// ResetOverrideReason resets all changes to the "override_reason" field.
+func (m *TeamBudgetMutation) ResetOverrideReason() {
+	m.override_reason = nil
+	delete(m.clearedFields, teambudget.FieldOverrideReason)
+}
+
+// Where appends a list predicates to the TeamBudgetMutation builder.
+func (m *TeamBudgetMutation) Where(ps ...predicate.TeamBudget) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamBudgetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamBudgetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamBudget, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamBudgetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamBudgetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TeamBudget).
+func (m *TeamBudgetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamBudgetMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, teambudget.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, teambudget.FieldUpdatedAt)
+	}
+	if m.team_id != nil {
+		fields = append(fields, teambudget.FieldTeamID)
+	}
+	if m.monthly_limit_usd != nil {
+		fields = append(fields, teambudget.FieldMonthlyLimitUsd)
+	}
+	if m.warning_percent != nil {
+		fields = append(fields, teambudget.FieldWarningPercent)
+	}
+	if m.pause_sandboxes != nil {
+		fields = append(fields, teambudget.FieldPauseSandboxes)
+	}
+	if m.period_start != nil {
+		fields = append(fields, teambudget.FieldPeriodStart)
+	}
+	if m.accrued_usd != nil {
+		fields = append(fields, teambudget.FieldAccruedUsd)
+	}
+	if m.metered_at != nil {
+		fields = append(fields, teambudget.FieldMeteredAt)
+	}
+	if m.warned_at != nil {
+		fields = append(fields, teambudget.FieldWarnedAt)
+	}
+	if m.exceeded_at != nil {
+		fields = append(fields, teambudget.FieldExceededAt)
+	}
+	if m.override_until != nil {
+		fields = append(fields, teambudget.FieldOverrideUntil)
+	}
+	if m.override_reason != nil {
+		fields = append(fields, teambudget.FieldOverrideReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamBudgetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case teambudget.FieldCreatedAt:
+		return m.CreatedAt()
+	case teambudget.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case teambudget.FieldTeamID:
+		return m.TeamID()
+	case teambudget.FieldMonthlyLimitUsd:
+		return m.MonthlyLimitUsd()
+	case teambudget.FieldWarningPercent:
+		return m.WarningPercent()
+	case teambudget.FieldPauseSandboxes:
+		return m.PauseSandboxes()
+	case teambudget.FieldPeriodStart:
+		return m.PeriodStart()
+	case teambudget.FieldAccruedUsd:
+		return m.AccruedUsd()
+	case teambudget.FieldMeteredAt:
+		return m.MeteredAt()
+	case teambudget.FieldWarnedAt:
+		return m.WarnedAt()
+	case teambudget.FieldExceededAt:
+		return m.ExceededAt()
+	case teambudget.FieldOverrideUntil:
+		return m.OverrideUntil()
+	case teambudget.FieldOverrideReason:
+		return m.OverrideReason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamBudgetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case teambudget.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case teambudget.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case teambudget.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teambudget.FieldMonthlyLimitUsd:
+		return m.OldMonthlyLimitUsd(ctx)
+	case teambudget.FieldWarningPercent:
+		return m.OldWarningPercent(ctx)
+	case teambudget.FieldPauseSandboxes:
+		return m.OldPauseSandboxes(ctx)
+	case teambudget.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case teambudget.FieldAccruedUsd:
+		return m.OldAccruedUsd(ctx)
+	case teambudget.FieldMeteredAt:
+		return m.OldMeteredAt(ctx)
+	case teambudget.FieldWarnedAt:
+		return m.OldWarnedAt(ctx)
+	case teambudget.FieldExceededAt:
+		return m.OldExceededAt(ctx)
+	case teambudget.FieldOverrideUntil:
+		return m.OldOverrideUntil(ctx)
+	case teambudget.FieldOverrideReason:
+		return m.OldOverrideReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamBudget field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamBudgetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teambudget.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case teambudget.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case teambudget.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case teambudget.FieldMonthlyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyLimitUsd(v)
+		return nil
+	case teambudget.FieldWarningPercent:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWarningPercent(v)
+		return nil
+	case teambudget.FieldPauseSandboxes:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPauseSandboxes(v)
+		return nil
+	case teambudget.FieldPeriodStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodStart(v)
+		return nil
+	case teambudget.FieldAccruedUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccruedUsd(v)
+		return nil
+	case teambudget.FieldMeteredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeteredAt(v)
+		return nil
+	case teambudget.FieldWarnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWarnedAt(v)
+		return nil
+	case teambudget.FieldExceededAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExceededAt(v)
+		return nil
+	case teambudget.FieldOverrideUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverrideUntil(v)
+		return nil
+	case teambudget.FieldOverrideReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverrideReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamBudget field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamBudgetMutation) AddedFields() []string {
+	var fields []string
+	if m.addmonthly_limit_usd != nil {
+		fields = append(fields, teambudget.FieldMonthlyLimitUsd)
+	}
+	if m.addwarning_percent != nil {
+		fields = append(fields, teambudget.FieldWarningPercent)
+	}
+	if m.addaccrued_usd != nil {
+		fields = append(fields, teambudget.FieldAccruedUsd)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamBudgetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teambudget.FieldMonthlyLimitUsd:
+		return m.AddedMonthlyLimitUsd()
+	case teambudget.FieldWarningPercent:
+		return m.AddedWarningPercent()
+	case teambudget.FieldAccruedUsd:
+		return m.AddedAccruedUsd()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamBudgetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case teambudget.FieldMonthlyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyLimitUsd(v)
+		return nil
+	case teambudget.FieldWarningPercent:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWarningPercent(v)
+		return nil
+	case teambudget.FieldAccruedUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccruedUsd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamBudget numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamBudgetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(teambudget.FieldWarnedAt) {
+		fields = append(fields, teambudget.FieldWarnedAt)
+	}
+	if m.FieldCleared(teambudget.FieldExceededAt) {
+		fields = append(fields, teambudget.FieldExceededAt)
+	}
+	if m.FieldCleared(teambudget.FieldOverrideUntil) {
+		fields = append(fields, teambudget.FieldOverrideUntil)
+	}
+	if m.FieldCleared(teambudget.FieldOverrideReason) {
+		fields = append(fields, teambudget.FieldOverrideReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamBudgetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamBudgetMutation) ClearField(name string) error {
+	switch name {
+	case teambudget.FieldWarnedAt:
+		m.ClearWarnedAt()
+		return nil
+	case teambudget.FieldExceededAt:
+		m.ClearExceededAt()
+		return nil
+	case teambudget.FieldOverrideUntil:
+		m.ClearOverrideUntil()
+		return nil
+	case teambudget.FieldOverrideReason:
+		m.ClearOverrideReason()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamBudget nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamBudgetMutation) ResetField(name string) error {
+	switch name {
+	case teambudget.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case teambudget.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case teambudget.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case teambudget.FieldMonthlyLimitUsd:
+		m.ResetMonthlyLimitUsd()
+		return nil
+	case teambudget.FieldWarningPercent:
+		m.ResetWarningPercent()
+		return nil
+	case teambudget.FieldPauseSandboxes:
+		m.ResetPauseSandboxes()
+		return nil
+	case teambudget.FieldPeriodStart:
+		m.ResetPeriodStart()
+		return nil
+	case teambudget.FieldAccruedUsd:
+		m.ResetAccruedUsd()
+		return nil
+	case teambudget.FieldMeteredAt:
+		m.ResetMeteredAt()
+		return nil
+	case teambudget.FieldWarnedAt:
+		m.ResetWarnedAt()
+		return nil
+	case teambudget.FieldExceededAt:
+		m.ResetExceededAt()
+		return nil
+	case teambudget.FieldOverrideUntil:
+		m.ResetOverrideUntil()
+		return nil
+	case teambudget.FieldOverrideReason:
+		m.ResetOverrideReason()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamBudget field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamBudgetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamBudgetMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamBudgetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamBudgetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamBudgetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamBudgetMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamBudgetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TeamBudget unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamBudgetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TeamBudget edge %s", name)
+}
+
+// TeamRegistryCredentialMutation represents an operation that mutates the TeamRegistryCredential nodes in the graph.
+type TeamRegistryCredentialMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	team_id       *uuid.UUID
+	registry      *string
+	username      *string
+	version       *int32
+	addversion    *int32
+	key_id        *string
+	encrypted_key *[]byte
+	ciphertext    *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TeamRegistryCredential, error)
+	predicates    []predicate.TeamRegistryCredential
+}
+
+var _ ent.Mutation = (*TeamRegistryCredentialMutation)(nil)
+
+// teamregistrycredentialOption allows management of the mutation configuration using functional options.
+type teamregistrycredentialOption func(*TeamRegistryCredentialMutation)
+
+// newTeamRegistryCredentialMutation creates new mutation for the TeamRegistryCredential entity.
+func newTeamRegistryCredentialMutation(c config, op Op, opts ...teamregistrycredentialOption) *TeamRegistryCredentialMutation {
+	m := &TeamRegistryCredentialMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamRegistryCredential,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamRegistryCredentialID sets the ID field of the mutation.
+func withTeamRegistryCredentialID(id uuid.UUID) teamregistrycredentialOption {
+	return func(m *TeamRegistryCredentialMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamRegistryCredential
+		)
+		m.oldValue = func(ctx context.Context) (*TeamRegistryCredential, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamRegistryCredential.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeamRegistryCredential sets the old TeamRegistryCredential of the mutation.
+func withTeamRegistryCredential(node *TeamRegistryCredential) teamregistrycredentialOption {
+	return func(m *TeamRegistryCredentialMutation) {
+		m.oldValue = func(context.Context) (*TeamRegistryCredential, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamRegistryCredentialMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamRegistryCredentialMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TeamRegistryCredential entities.
+func (m *TeamRegistryCredentialMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamRegistryCredentialMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamRegistryCredentialMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamRegistryCredential.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamRegistryCredentialMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamRegistryCredentialMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TeamRegistryCredential entity.
+// If the TeamRegistryCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamRegistryCredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamRegistryCredentialMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamRegistryCredentialMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamRegistryCredentialMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamRegistryCredential entity.
+// If the TeamRegistryCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamRegistryCredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamRegistryCredentialMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *TeamRegistryCredentialMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamRegistryCredentialMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the TeamRegistryCredential entity.
+// If the TeamRegistryCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamRegistryCredentialMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamRegistryCredentialMutation) ResetTeamID() {
+	m.team_id = nil
+}
+
+// SetRegistry sets the "registry" field.
+func (m *TeamRegistryCredentialMutation) SetRegistry(s string) {
+	m.registry = &s
+}
+
+// Registry returns the value of the "registry" field in the mutation.
+func (m *TeamRegistryCredentialMutation) Registry() (r string, exists bool) {
+	v := m.registry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistry returns the old "registry" field's value of the TeamRegistryCredential entity.
+// If the TeamRegistryCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamRegistryCredentialMutation) OldRegistry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistry: %w", err)
+	}
+	return oldValue.Registry, nil
+}
+
+// ResetRegistry resets all changes to the "registry" field.
+func (m *TeamRegistryCredentialMutation) ResetRegistry() {
+	m.registry = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *TeamRegistryCredentialMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *TeamRegistryCredentialMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the TeamRegistryCredential entity.
+// If the TeamRegistryCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamRegistryCredentialMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *TeamRegistryCredentialMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *TeamRegistryCredentialMutation) SetVersion(i int32) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *TeamRegistryCredentialMutation) Version() (r int32, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the TeamRegistryCredential entity.
+// If the TeamRegistryCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamRegistryCredentialMutation) OldVersion(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *TeamRegistryCredentialMutation) AddVersion(i int32) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *TeamRegistryCredentialMutation) AddedVersion() (r int32, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *TeamRegistryCredentialMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *TeamRegistryCredentialMutation) SetKeyID(s string) {
+	m.key_id = &s
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *TeamRegistryCredentialMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyID returns the old "key_id" field's value of the TeamRegistryCredential entity.
+// If the TeamRegistryCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamRegistryCredentialMutation) OldKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+	}
+	return oldValue.KeyID, nil
+}
+
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *TeamRegistryCredentialMutation) ResetKeyID() {
+	m.key_id = nil
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (m *TeamRegistryCredentialMutation) SetEncryptedKey(b []byte) {
+	m.encrypted_key = &b
+}
+
+// EncryptedKey returns the value of the "encrypted_key" field in the mutation.
+func (m *TeamRegistryCredentialMutation) EncryptedKey() (r []byte, exists bool) {
+	v := m.encrypted_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptedKey returns the old "encrypted_key" field's value of the TeamRegistryCredential entity.
+// If the TeamRegistryCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamRegistryCredentialMutation) OldEncryptedKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedKey: %w", err)
+	}
+	return oldValue.EncryptedKey, nil
+}
+
+// ResetEncryptedKey resets all changes to the "encrypted_key" field.
+func (m *TeamRegistryCredentialMutation) ResetEncryptedKey() {
+	m.encrypted_key = nil
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (m *TeamRegistryCredentialMutation) SetCiphertext(b []byte) {
+	m.ciphertext = &b
+}
+
+// Ciphertext returns the value of the "ciphertext" field in the mutation.
+func (m *TeamRegistryCredentialMutation) Ciphertext() (r []byte, exists bool) {
+	v := m.ciphertext
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiphertext returns the old "ciphertext" field's value of the TeamRegistryCredential entity.
+// If the TeamRegistryCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamRegistryCredentialMutation) OldCiphertext(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiphertext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiphertext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiphertext: %w", err)
+	}
+	return oldValue.Ciphertext, nil
+}
+
+// ResetCiphertext resets all changes to the "ciphertext" field.
+func (m *TeamRegistryCredentialMutation) ResetCiphertext() {
+	m.ciphertext = nil
+}
+
+// Where appends a list predicates to the TeamRegistryCredentialMutation builder.
+func (m *TeamRegistryCredentialMutation) Where(ps ...predicate.TeamRegistryCredential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvBuildMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamRegistryCredentialMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EnvBuild, len(ps))
+func (m *TeamRegistryCredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamRegistryCredential, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2973,66 +14304,51 @@ func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvBuildMutation) Op() Op {
+func (m *TeamRegistryCredentialMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvBuildMutation) SetOp(op Op) {
+func (m *TeamRegistryCredentialMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EnvBuild).
-func (m *EnvBuildMutation) Type() string {
+// Type returns the node type of this mutation (TeamRegistryCredential).
+func (m *TeamRegistryCredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvBuildMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *TeamRegistryCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, envbuild.FieldCreatedAt)
+		fields = append(fields, teamregistrycredential.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, envbuild.FieldUpdatedAt)
-	}
-	if m.finished_at != nil {
-		fields = append(fields, envbuild.FieldFinishedAt)
-	}
-	if m.env != nil {
-		fields = append(fields, envbuild.FieldEnvID)
-	}
-	if m.status != nil {
-		fields = append(fields, envbuild.FieldStatus)
-	}
-	if m.dockerfile != nil {
-		fields = append(fields, envbuild.FieldDockerfile)
-	}
-	if m.start_cmd != nil {
-		fields = append(fields, envbuild.FieldStartCmd)
+		fields = append(fields, teamregistrycredential.FieldUpdatedAt)
 	}
-	if m.vcpu != nil {
-		fields = append(fields, envbuild.FieldVcpu)
+	if m.team_id != nil {
+		fields = append(fields, teamregistrycredential.FieldTeamID)
 	}
-	if m.ram_mb != nil {
-		fields = append(fields, envbuild.FieldRAMMB)
+	if m.registry != nil {
+		fields = append(fields, teamregistrycredential.FieldRegistry)
 	}
-	if m.free_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	if m.username != nil {
+		fields = append(fields, teamregistrycredential.FieldUsername)
 	}
-	if m.total_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	if m.version != nil {
+		fields = append(fields, teamregistrycredential.FieldVersion)
 	}
-	if m.kernel_version != nil {
-		fields = append(fields, envbuild.FieldKernelVersion)
+	if m.key_id != nil {
+		fields = append(fields, teamregistrycredential.FieldKeyID)
 	}
-	if m.firecracker_version != nil {
-		fields = append(fields, envbuild.FieldFirecrackerVersion)
+	if m.encrypted_key != nil {
+		fields = append(fields, teamregistrycredential.FieldEncryptedKey)
 	}
-	if m.envd_version != nil {
-		fields = append(fields, envbuild.FieldEnvdVersion)
+	if m.ciphertext != nil {
+		fields = append(fields, teamregistrycredential.FieldCiphertext)
 	}
 	return fields
 }
@@ -3040,36 +14356,26 @@ func (m *EnvBuildMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamRegistryCredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case teamregistrycredential.FieldCreatedAt:
 		return m.CreatedAt()
-	case envbuild.FieldUpdatedAt:
+	case teamregistrycredential.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case envbuild.FieldFinishedAt:
-		return m.FinishedAt()
-	case envbuild.FieldEnvID:
-		return m.EnvID()
-	case envbuild.FieldStatus:
-		return m.Status()
-	case envbuild.FieldDockerfile:
-		return m.Dockerfile()
-	case envbuild.FieldStartCmd:
-		return m.StartCmd()
-	case envbuild.FieldVcpu:
-		return m.Vcpu()
-	case envbuild.FieldRAMMB:
-		return m.RAMMB()
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.FreeDiskSizeMB()
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.TotalDiskSizeMB()
-	case envbuild.FieldKernelVersion:
-		return m.KernelVersion()
-	case envbuild.FieldFirecrackerVersion:
-		return m.FirecrackerVersion()
-	case envbuild.FieldEnvdVersion:
-		return m.EnvdVersion()
+	case teamregistrycredential.FieldTeamID:
+		return m.TeamID()
+	case teamregistrycredential.FieldRegistry:
+		return m.Registry()
+	case teamregistrycredential.FieldUsername:
+		return m.Username()
+	case teamregistrycredential.FieldVersion:
+		return m.Version()
+	case teamregistrycredential.FieldKeyID:
+		return m.KeyID()
+	case teamregistrycredential.FieldEncryptedKey:
+		return m.EncryptedKey()
+	case teamregistrycredential.FieldCiphertext:
+		return m.Ciphertext()
 	}
 	return nil, false
 }
@@ -3077,162 +14383,108 @@ func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamRegistryCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case teamregistrycredential.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case envbuild.FieldUpdatedAt:
+	case teamregistrycredential.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case envbuild.FieldFinishedAt:
-		return m.OldFinishedAt(ctx)
-	case envbuild.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case envbuild.FieldStatus:
-		return m.OldStatus(ctx)
-	case envbuild.FieldDockerfile:
-		return m.OldDockerfile(ctx)
-	case envbuild.FieldStartCmd:
-		return m.OldStartCmd(ctx)
-	case envbuild.FieldVcpu:
-		return m.OldVcpu(ctx)
-	case envbuild.FieldRAMMB:
-		return m.OldRAMMB(ctx)
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.OldFreeDiskSizeMB(ctx)
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.OldTotalDiskSizeMB(ctx)
-	case envbuild.FieldKernelVersion:
-		return m.OldKernelVersion(ctx)
-	case envbuild.FieldFirecrackerVersion:
-		return m.OldFirecrackerVersion(ctx)
-	case envbuild.FieldEnvdVersion:
-		return m.OldEnvdVersion(ctx)
-	}
-	return nil, fmt.Errorf("unknown EnvBuild field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case envbuild.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case envbuild.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case envbuild.FieldFinishedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFinishedAt(v)
-		return nil
-	case envbuild.FieldEnvID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnvID(v)
-		return nil
-	case envbuild.FieldStatus:
-		v, ok := value.(envbuild.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case envbuild.FieldDockerfile:
-		v, ok := value.(string)
+	case teamregistrycredential.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teamregistrycredential.FieldRegistry:
+		return m.OldRegistry(ctx)
+	case teamregistrycredential.FieldUsername:
+		return m.OldUsername(ctx)
+	case teamregistrycredential.FieldVersion:
+		return m.OldVersion(ctx)
+	case teamregistrycredential.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case teamregistrycredential.FieldEncryptedKey:
+		return m.OldEncryptedKey(ctx)
+	case teamregistrycredential.FieldCiphertext:
+		return m.OldCiphertext(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamRegistryCredential field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamRegistryCredentialMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teamregistrycredential.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDockerfile(v)
+		m.SetCreatedAt(v)
 		return nil
-	case envbuild.FieldStartCmd:
-		v, ok := value.(string)
+	case teamregistrycredential.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartCmd(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case envbuild.FieldVcpu:
-		v, ok := value.(int64)
+	case teamregistrycredential.FieldTeamID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVcpu(v)
+		m.SetTeamID(v)
 		return nil
-	case envbuild.FieldRAMMB:
-		v, ok := value.(int64)
+	case teamregistrycredential.FieldRegistry:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRAMMB(v)
+		m.SetRegistry(v)
 		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		v, ok := value.(int64)
+	case teamregistrycredential.FieldUsername:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFreeDiskSizeMB(v)
+		m.SetUsername(v)
 		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		v, ok := value.(int64)
+	case teamregistrycredential.FieldVersion:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalDiskSizeMB(v)
+		m.SetVersion(v)
 		return nil
-	case envbuild.FieldKernelVersion:
+	case teamregistrycredential.FieldKeyID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKernelVersion(v)
+		m.SetKeyID(v)
 		return nil
-	case envbuild.FieldFirecrackerVersion:
-		v, ok := value.(string)
+	case teamregistrycredential.FieldEncryptedKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirecrackerVersion(v)
+		m.SetEncryptedKey(v)
 		return nil
-	case envbuild.FieldEnvdVersion:
-		v, ok := value.(string)
+	case teamregistrycredential.FieldCiphertext:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvdVersion(v)
+		m.SetCiphertext(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild field %s", name)
+	return fmt.Errorf("unknown TeamRegistryCredential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvBuildMutation) AddedFields() []string {
+func (m *TeamRegistryCredentialMutation) AddedFields() []string {
 	var fields []string
-	if m.addvcpu != nil {
-		fields = append(fields, envbuild.FieldVcpu)
-	}
-	if m.addram_mb != nil {
-		fields = append(fields, envbuild.FieldRAMMB)
-	}
-	if m.addfree_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
-	}
-	if m.addtotal_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	if m.addversion != nil {
+		fields = append(fields, teamregistrycredential.FieldVersion)
 	}
 	return fields
 }
@@ -3240,16 +14492,10 @@ func (m *EnvBuildMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamRegistryCredentialMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case envbuild.FieldVcpu:
-		return m.AddedVcpu()
-	case envbuild.FieldRAMMB:
-		return m.AddedRAMMB()
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.AddedFreeDiskSizeMB()
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.AddedTotalDiskSizeMB()
+	case teamregistrycredential.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -3257,251 +14503,150 @@ func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvBuildMutation) AddField(name string, value ent.Value) error {
+func (m *TeamRegistryCredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case envbuild.FieldVcpu:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVcpu(v)
-		return nil
-	case envbuild.FieldRAMMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRAMMB(v)
-		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFreeDiskSizeMB(v)
-		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		v, ok := value.(int64)
+	case teamregistrycredential.FieldVersion:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalDiskSizeMB(v)
+		m.AddVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild numeric field %s", name)
+	return fmt.Errorf("unknown TeamRegistryCredential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvBuildMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(envbuild.FieldFinishedAt) {
-		fields = append(fields, envbuild.FieldFinishedAt)
-	}
-	if m.FieldCleared(envbuild.FieldEnvID) {
-		fields = append(fields, envbuild.FieldEnvID)
-	}
-	if m.FieldCleared(envbuild.FieldDockerfile) {
-		fields = append(fields, envbuild.FieldDockerfile)
-	}
-	if m.FieldCleared(envbuild.FieldStartCmd) {
-		fields = append(fields, envbuild.FieldStartCmd)
-	}
-	if m.FieldCleared(envbuild.FieldTotalDiskSizeMB) {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
-	}
-	if m.FieldCleared(envbuild.FieldEnvdVersion) {
-		fields = append(fields, envbuild.FieldEnvdVersion)
-	}
-	return fields
+func (m *TeamRegistryCredentialMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvBuildMutation) FieldCleared(name string) bool {
+func (m *TeamRegistryCredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvBuildMutation) ClearField(name string) error {
-	switch name {
-	case envbuild.FieldFinishedAt:
-		m.ClearFinishedAt()
-		return nil
-	case envbuild.FieldEnvID:
-		m.ClearEnvID()
-		return nil
-	case envbuild.FieldDockerfile:
-		m.ClearDockerfile()
-		return nil
-	case envbuild.FieldStartCmd:
-		m.ClearStartCmd()
-		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		m.ClearTotalDiskSizeMB()
-		return nil
-	case envbuild.FieldEnvdVersion:
-		m.ClearEnvdVersion()
-		return nil
-	}
-	return fmt.Errorf("unknown EnvBuild nullable field %s", name)
+func (m *TeamRegistryCredentialMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamRegistryCredential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvBuildMutation) ResetField(name string) error {
+func (m *TeamRegistryCredentialMutation) ResetField(name string) error {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case teamregistrycredential.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case envbuild.FieldUpdatedAt:
+	case teamregistrycredential.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case envbuild.FieldFinishedAt:
-		m.ResetFinishedAt()
-		return nil
-	case envbuild.FieldEnvID:
-		m.ResetEnvID()
-		return nil
-	case envbuild.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case envbuild.FieldDockerfile:
-		m.ResetDockerfile()
-		return nil
-	case envbuild.FieldStartCmd:
-		m.ResetStartCmd()
-		return nil
-	case envbuild.FieldVcpu:
-		m.ResetVcpu()
+	case teamregistrycredential.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case envbuild.FieldRAMMB:
-		m.ResetRAMMB()
+	case teamregistrycredential.FieldRegistry:
+		m.ResetRegistry()
 		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		m.ResetFreeDiskSizeMB()
+	case teamregistrycredential.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		m.ResetTotalDiskSizeMB()
+	case teamregistrycredential.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case envbuild.FieldKernelVersion:
-		m.ResetKernelVersion()
+	case teamregistrycredential.FieldKeyID:
+		m.ResetKeyID()
 		return nil
-	case envbuild.FieldFirecrackerVersion:
-		m.ResetFirecrackerVersion()
+	case teamregistrycredential.FieldEncryptedKey:
+		m.ResetEncryptedKey()
 		return nil
-	case envbuild.FieldEnvdVersion:
-		m.ResetEnvdVersion()
+	case teamregistrycredential.FieldCiphertext:
+		m.ResetCiphertext()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild field %s", name)
+	return fmt.Errorf("unknown TeamRegistryCredential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvBuildMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.env != nil {
-		edges = append(edges, envbuild.EdgeEnv)
-	}
+func (m *TeamRegistryCredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvBuildMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case envbuild.EdgeEnv:
-		if id := m.env; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *TeamRegistryCredentialMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvBuildMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TeamRegistryCredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvBuildMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamRegistryCredentialMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvBuildMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenv {
-		edges = append(edges, envbuild.EdgeEnv)
-	}
+func (m *TeamRegistryCredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvBuildMutation) EdgeCleared(name string) bool {
-	switch name {
-	case envbuild.EdgeEnv:
-		return m.clearedenv
-	}
+func (m *TeamRegistryCredentialMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvBuildMutation) ClearEdge(name string) error {
-	switch name {
-	case envbuild.EdgeEnv:
-		m.ClearEnv()
-		return nil
-	}
-	return fmt.Errorf("unknown EnvBuild unique edge %s", name)
+func (m *TeamRegistryCredentialMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TeamRegistryCredential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvBuildMutation) ResetEdge(name string) error {
-	switch name {
-	case envbuild.EdgeEnv:
-		m.ResetEnv()
-		return nil
-	}
-	return fmt.Errorf("unknown EnvBuild edge %s", name)
+func (m *TeamRegistryCredentialMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TeamRegistryCredential edge %s", name)
 }
 
-// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
-type SnapshotMutation struct {
+// TeamSecretMutation represents an operation that mutates the TeamSecret nodes in the graph.
+type TeamSecretMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	base_env_id   *string
-	sandbox_id    *string
-	metadata      *map[string]string
-	clearedFields map[string]struct{}
-	env           *string
-	clearedenv    bool
-	done          bool
-	oldValue      func(context.Context) (*Snapshot, error)
-	predicates    []predicate.Snapshot
-}
-
-var _ ent.Mutation = (*SnapshotMutation)(nil)
-
-// snapshotOption allows management of the mutation configuration using functional options.
-type snapshotOption func(*SnapshotMutation)
-
-// newSnapshotMutation creates new mutation for the Snapshot entity.
-func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
-	m := &SnapshotMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	team_id           *uuid.UUID
+	name              *string
+	latest_version    *int32
+	addlatest_version *int32
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*TeamSecret, error)
+	predicates        []predicate.TeamSecret
+}
+
+var _ ent.Mutation = (*TeamSecretMutation)(nil)
+
+// teamsecretOption allows management of the mutation configuration using functional options.
+type teamsecretOption func(*TeamSecretMutation)
+
+// newTeamSecretMutation creates new mutation for the TeamSecret entity.
+func newTeamSecretMutation(c config, op Op, opts ...teamsecretOption) *TeamSecretMutation {
+	m := &TeamSecretMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSnapshot,
+		typ:           TypeTeamSecret,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3510,20 +14655,20 @@ func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutat
 	return m
 }
 
-// withSnapshotID sets the ID field of the mutation.
-func withSnapshotID(id uuid.UUID) snapshotOption {
-	return func(m *SnapshotMutation) {
+// withTeamSecretID sets the ID field of the mutation.
+func withTeamSecretID(id uuid.UUID) teamsecretOption {
+	return func(m *TeamSecretMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Snapshot
+			value *TeamSecret
 		)
-		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
+		m.oldValue = func(ctx context.Context) (*TeamSecret, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Snapshot.Get(ctx, id)
+					value, err = m.Client().TeamSecret.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3532,10 +14677,10 @@ func withSnapshotID(id uuid.UUID) snapshotOption {
 	}
 }
 
-// withSnapshot sets the old Snapshot of the mutation.
-func withSnapshot(node *Snapshot) snapshotOption {
-	return func(m *SnapshotMutation) {
-		m.oldValue = func(context.Context) (*Snapshot, error) {
+// withTeamSecret sets the old TeamSecret of the mutation.
+func withTeamSecret(node *TeamSecret) teamsecretOption {
+	return func(m *TeamSecretMutation) {
+		m.oldValue = func(context.Context) (*TeamSecret, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3544,7 +14689,7 @@ func withSnapshot(node *Snapshot) snapshotOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SnapshotMutation) Client() *Client {
+func (m TeamSecretMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3552,7 +14697,7 @@ func (m SnapshotMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SnapshotMutation) Tx() (*Tx, error) {
+func (m TeamSecretMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -3562,14 +14707,14 @@ func (m SnapshotMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Snapshot entities.
-func (m *SnapshotMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TeamSecret entities.
+func (m *TeamSecretMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TeamSecretMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3580,7 +14725,7 @@ func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TeamSecretMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3589,19 +14734,19 @@ func (m *SnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TeamSecret.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SnapshotMutation) SetCreatedAt(t time.Time) {
+func (m *TeamSecretMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *TeamSecretMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3609,10 +14754,10 @@ func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TeamSecret entity.
+// If the TeamSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TeamSecretMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3627,190 +14772,183 @@ func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SnapshotMutation) ResetCreatedAt() {
+func (m *TeamSecretMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetBaseEnvID sets the "base_env_id" field.
-func (m *SnapshotMutation) SetBaseEnvID(s string) {
-	m.base_env_id = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamSecretMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// BaseEnvID returns the value of the "base_env_id" field in the mutation.
-func (m *SnapshotMutation) BaseEnvID() (r string, exists bool) {
-	v := m.base_env_id
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamSecretMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseEnvID returns the old "base_env_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamSecret entity.
+// If the TeamSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
+func (m *TeamSecretMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.BaseEnvID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetBaseEnvID resets all changes to the "base_env_id" field.
-func (m *SnapshotMutation) ResetBaseEnvID() {
-	m.base_env_id = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamSecretMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *SnapshotMutation) SetEnvID(s string) {
-	m.env = &s
+// SetTeamID sets the "team_id" field.
+func (m *TeamSecretMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *SnapshotMutation) EnvID() (r string, exists bool) {
-	v := m.env
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamSecretMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvID returns the old "env_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the TeamSecret entity.
+// If the TeamSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldEnvID(ctx context.Context) (v string, err error) {
+func (m *TeamSecretMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.EnvID, nil
+	return oldValue.TeamID, nil
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *SnapshotMutation) ResetEnvID() {
-	m.env = nil
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamSecretMutation) ResetTeamID() {
+	m.team_id = nil
 }
 
-// SetSandboxID sets the "sandbox_id" field.
-func (m *SnapshotMutation) SetSandboxID(s string) {
-	m.sandbox_id = &s
+// SetName sets the "name" field.
+func (m *TeamSecretMutation) SetName(s string) {
+	m.name = &s
 }
 
-// SandboxID returns the value of the "sandbox_id" field in the mutation.
-func (m *SnapshotMutation) SandboxID() (r string, exists bool) {
-	v := m.sandbox_id
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamSecretMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSandboxID returns the old "sandbox_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the TeamSecret entity.
+// If the TeamSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldSandboxID(ctx context.Context) (v string, err error) {
+func (m *TeamSecretMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSandboxID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.SandboxID, nil
+	return oldValue.Name, nil
 }
 
-// ResetSandboxID resets all changes to the "sandbox_id" field.
-func (m *SnapshotMutation) ResetSandboxID() {
-	m.sandbox_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *TeamSecretMutation) ResetName() {
+	m.name = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *SnapshotMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetLatestVersion sets the "latest_version" field.
+func (m *TeamSecretMutation) SetLatestVersion(i int32) {
+	m.latest_version = &i
+	m.addlatest_version = nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SnapshotMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// LatestVersion returns the value of the "latest_version" field in the mutation.
+func (m *TeamSecretMutation) LatestVersion() (r int32, exists bool) {
+	v := m.latest_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldLatestVersion returns the old "latest_version" field's value of the TeamSecret entity.
+// If the TeamSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *TeamSecretMutation) OldLatestVersion(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldLatestVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldLatestVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldLatestVersion: %w", err)
 	}
-	return oldValue.Metadata, nil
-}
-
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SnapshotMutation) ResetMetadata() {
-	m.metadata = nil
-}
-
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *SnapshotMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[snapshot.FieldEnvID] = struct{}{}
+	return oldValue.LatestVersion, nil
 }
 
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *SnapshotMutation) EnvCleared() bool {
-	return m.clearedenv
+// AddLatestVersion adds i to the "latest_version" field.
+func (m *TeamSecretMutation) AddLatestVersion(i int32) {
+	if m.addlatest_version != nil {
+		*m.addlatest_version += i
+	} else {
+		m.addlatest_version = &i
+	}
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *SnapshotMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
+// AddedLatestVersion returns the value that was added to the "latest_version" field in this mutation.
+func (m *TeamSecretMutation) AddedLatestVersion() (r int32, exists bool) {
+	v := m.addlatest_version
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *SnapshotMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
+// ResetLatestVersion resets all changes to the "latest_version" field.
+func (m *TeamSecretMutation) ResetLatestVersion() {
+	m.latest_version = nil
+	m.addlatest_version = nil
 }
 
-// Where appends a list predicates to the SnapshotMutation builder.
-func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
+// Where appends a list predicates to the TeamSecretMutation builder.
+func (m *TeamSecretMutation) Where(ps ...predicate.TeamSecret) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SnapshotMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamSecretMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Snapshot, len(ps))
+func (m *TeamSecretMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamSecret, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3818,39 +14956,39 @@ func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SnapshotMutation) Op() Op {
+func (m *TeamSecretMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SnapshotMutation) SetOp(op Op) {
+func (m *TeamSecretMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Snapshot).
-func (m *SnapshotMutation) Type() string {
+// Type returns the node type of this mutation (TeamSecret).
+func (m *TeamSecretMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SnapshotMutation) Fields() []string {
+func (m *TeamSecretMutation) Fields() []string {
 	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, snapshot.FieldCreatedAt)
+		fields = append(fields, teamsecret.FieldCreatedAt)
 	}
-	if m.base_env_id != nil {
-		fields = append(fields, snapshot.FieldBaseEnvID)
+	if m.updated_at != nil {
+		fields = append(fields, teamsecret.FieldUpdatedAt)
 	}
-	if m.env != nil {
-		fields = append(fields, snapshot.FieldEnvID)
+	if m.team_id != nil {
+		fields = append(fields, teamsecret.FieldTeamID)
 	}
-	if m.sandbox_id != nil {
-		fields = append(fields, snapshot.FieldSandboxID)
+	if m.name != nil {
+		fields = append(fields, teamsecret.FieldName)
 	}
-	if m.metadata != nil {
-		fields = append(fields, snapshot.FieldMetadata)
+	if m.latest_version != nil {
+		fields = append(fields, teamsecret.FieldLatestVersion)
 	}
 	return fields
 }
@@ -3858,18 +14996,18 @@ func (m *SnapshotMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamSecretMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case teamsecret.FieldCreatedAt:
 		return m.CreatedAt()
-	case snapshot.FieldBaseEnvID:
-		return m.BaseEnvID()
-	case snapshot.FieldEnvID:
-		return m.EnvID()
-	case snapshot.FieldSandboxID:
-		return m.SandboxID()
-	case snapshot.FieldMetadata:
-		return m.Metadata()
+	case teamsecret.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case teamsecret.FieldTeamID:
+		return m.TeamID()
+	case teamsecret.FieldName:
+		return m.Name()
+	case teamsecret.FieldLatestVersion:
+		return m.LatestVersion()
 	}
 	return nil, false
 }
@@ -3877,247 +15015,223 @@ func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamSecretMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case teamsecret.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case snapshot.FieldBaseEnvID:
-		return m.OldBaseEnvID(ctx)
-	case snapshot.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case snapshot.FieldSandboxID:
-		return m.OldSandboxID(ctx)
-	case snapshot.FieldMetadata:
-		return m.OldMetadata(ctx)
+	case teamsecret.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case teamsecret.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teamsecret.FieldName:
+		return m.OldName(ctx)
+	case teamsecret.FieldLatestVersion:
+		return m.OldLatestVersion(ctx)
 	}
-	return nil, fmt.Errorf("unknown Snapshot field %s", name)
+	return nil, fmt.Errorf("unknown TeamSecret field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
+func (m *TeamSecretMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case teamsecret.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case snapshot.FieldBaseEnvID:
-		v, ok := value.(string)
+	case teamsecret.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBaseEnvID(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case snapshot.FieldEnvID:
-		v, ok := value.(string)
+	case teamsecret.FieldTeamID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvID(v)
+		m.SetTeamID(v)
 		return nil
-	case snapshot.FieldSandboxID:
+	case teamsecret.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSandboxID(v)
+		m.SetName(v)
 		return nil
-	case snapshot.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case teamsecret.FieldLatestVersion:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetLatestVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot field %s", name)
+	return fmt.Errorf("unknown TeamSecret field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SnapshotMutation) AddedFields() []string {
-	return nil
+func (m *TeamSecretMutation) AddedFields() []string {
+	var fields []string
+	if m.addlatest_version != nil {
+		fields = append(fields, teamsecret.FieldLatestVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamSecretMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teamsecret.FieldLatestVersion:
+		return m.AddedLatestVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
+func (m *TeamSecretMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case teamsecret.FieldLatestVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatestVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Snapshot numeric field %s", name)
+	return fmt.Errorf("unknown TeamSecret numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SnapshotMutation) ClearedFields() []string {
+func (m *TeamSecretMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SnapshotMutation) FieldCleared(name string) bool {
+func (m *TeamSecretMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SnapshotMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Snapshot nullable field %s", name)
+func (m *TeamSecretMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamSecret nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SnapshotMutation) ResetField(name string) error {
+func (m *TeamSecretMutation) ResetField(name string) error {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case teamsecret.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case snapshot.FieldBaseEnvID:
-		m.ResetBaseEnvID()
+	case teamsecret.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case snapshot.FieldEnvID:
-		m.ResetEnvID()
+	case teamsecret.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case snapshot.FieldSandboxID:
-		m.ResetSandboxID()
+	case teamsecret.FieldName:
+		m.ResetName()
 		return nil
-	case snapshot.FieldMetadata:
-		m.ResetMetadata()
+	case teamsecret.FieldLatestVersion:
+		m.ResetLatestVersion()
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot field %s", name)
+	return fmt.Errorf("unknown TeamSecret field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SnapshotMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.env != nil {
-		edges = append(edges, snapshot.EdgeEnv)
-	}
+func (m *TeamSecretMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case snapshot.EdgeEnv:
-		if id := m.env; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *TeamSecretMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SnapshotMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TeamSecretMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamSecretMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SnapshotMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenv {
-		edges = append(edges, snapshot.EdgeEnv)
-	}
+func (m *TeamSecretMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SnapshotMutation) EdgeCleared(name string) bool {
-	switch name {
-	case snapshot.EdgeEnv:
-		return m.clearedenv
-	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *SnapshotMutation) ClearEdge(name string) error {
-	switch name {
-	case snapshot.EdgeEnv:
-		m.ClearEnv()
-		return nil
-	}
-	return fmt.Errorf("unknown Snapshot unique edge %s", name)
+func (m *TeamSecretMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamSecretMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TeamSecret unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SnapshotMutation) ResetEdge(name string) error {
-	switch name {
-	case snapshot.EdgeEnv:
-		m.ResetEnv()
-		return nil
-	}
-	return fmt.Errorf("unknown Snapshot edge %s", name)
+func (m *TeamSecretMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TeamSecret edge %s", name)
 }
 
-// TeamMutation represents an operation that mutates the Team nodes in the graph.
-type TeamMutation struct {
+// TeamSecretVersionMutation represents an operation that mutates the TeamSecretVersion nodes in the graph.
+type TeamSecretVersionMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	created_at           *time.Time
-	is_banned            *bool
-	is_blocked           *bool
-	blocked_reason       *string
-	name                 *string
-	email                *string
-	clearedFields        map[string]struct{}
-	users                map[uuid.UUID]struct{}
-	removedusers         map[uuid.UUID]struct{}
-	clearedusers         bool
-	team_api_keys        map[uuid.UUID]struct{}
-	removedteam_api_keys map[uuid.UUID]struct{}
-	clearedteam_api_keys bool
-	team_tier            *string
-	clearedteam_tier     bool
-	envs                 map[string]struct{}
-	removedenvs          map[string]struct{}
-	clearedenvs          bool
-	users_teams          map[int]struct{}
-	removedusers_teams   map[int]struct{}
-	clearedusers_teams   bool
-	done                 bool
-	oldValue             func(context.Context) (*Team, error)
-	predicates           []predicate.Team
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	secret_id     *uuid.UUID
+	version       *int32
+	addversion    *int32
+	key_id        *string
+	encrypted_key *[]byte
+	ciphertext    *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TeamSecretVersion, error)
+	predicates    []predicate.TeamSecretVersion
 }
 
-var _ ent.Mutation = (*TeamMutation)(nil)
+var _ ent.Mutation = (*TeamSecretVersionMutation)(nil)
 
-// teamOption allows management of the mutation configuration using functional options.
-type teamOption func(*TeamMutation)
+// teamsecretversionOption allows management of the mutation configuration using functional options.
+type teamsecretversionOption func(*TeamSecretVersionMutation)
 
-// newTeamMutation creates new mutation for the Team entity.
-func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
-	m := &TeamMutation{
+// newTeamSecretVersionMutation creates new mutation for the TeamSecretVersion entity.
+func newTeamSecretVersionMutation(c config, op Op, opts ...teamsecretversionOption) *TeamSecretVersionMutation {
+	m := &TeamSecretVersionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeam,
+		typ:           TypeTeamSecretVersion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4126,20 +15240,20 @@ func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
 	return m
 }
 
-// withTeamID sets the ID field of the mutation.
-func withTeamID(id uuid.UUID) teamOption {
-	return func(m *TeamMutation) {
+// withTeamSecretVersionID sets the ID field of the mutation.
+func withTeamSecretVersionID(id uuid.UUID) teamsecretversionOption {
+	return func(m *TeamSecretVersionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Team
+			value *TeamSecretVersion
 		)
-		m.oldValue = func(ctx context.Context) (*Team, error) {
+		m.oldValue = func(ctx context.Context) (*TeamSecretVersion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Team.Get(ctx, id)
+					value, err = m.Client().TeamSecretVersion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4148,10 +15262,10 @@ func withTeamID(id uuid.UUID) teamOption {
 	}
 }
 
-// withTeam sets the old Team of the mutation.
-func withTeam(node *Team) teamOption {
-	return func(m *TeamMutation) {
-		m.oldValue = func(context.Context) (*Team, error) {
+// withTeamSecretVersion sets the old TeamSecretVersion of the mutation.
+func withTeamSecretVersion(node *TeamSecretVersion) teamsecretversionOption {
+	return func(m *TeamSecretVersionMutation) {
+		m.oldValue = func(context.Context) (*TeamSecretVersion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4160,7 +15274,7 @@ func withTeam(node *Team) teamOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamMutation) Client() *Client {
+func (m TeamSecretVersionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4168,7 +15282,7 @@ func (m TeamMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TeamMutation) Tx() (*Tx, error) {
+func (m TeamSecretVersionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -4178,14 +15292,14 @@ func (m TeamMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Team entities.
-func (m *TeamMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TeamSecretVersion entities.
+func (m *TeamSecretVersionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TeamSecretVersionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4196,7 +15310,7 @@ func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TeamSecretVersionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4205,19 +15319,19 @@ func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TeamSecretVersion.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TeamMutation) SetCreatedAt(t time.Time) {
+func (m *TeamSecretVersionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *TeamSecretVersionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4225,10 +15339,10 @@ func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TeamSecretVersion entity.
+// If the TeamSecretVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TeamSecretVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4243,530 +15357,835 @@ func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TeamMutation) ResetCreatedAt() {
+func (m *TeamSecretVersionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetIsBanned sets the "is_banned" field.
-func (m *TeamMutation) SetIsBanned(b bool) {
-	m.is_banned = &b
+// SetSecretID sets the "secret_id" field.
+func (m *TeamSecretVersionMutation) SetSecretID(u uuid.UUID) {
+	m.secret_id = &u
 }
 
-// IsBanned returns the value of the "is_banned" field in the mutation.
-func (m *TeamMutation) IsBanned() (r bool, exists bool) {
-	v := m.is_banned
+// SecretID returns the value of the "secret_id" field in the mutation.
+func (m *TeamSecretVersionMutation) SecretID() (r uuid.UUID, exists bool) {
+	v := m.secret_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBanned returns the old "is_banned" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldSecretID returns the old "secret_id" field's value of the TeamSecretVersion entity.
+// If the TeamSecretVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
+func (m *TeamSecretVersionMutation) OldSecretID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecretID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBanned requires an ID field in the mutation")
+		return v, errors.New("OldSecretID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecretID: %w", err)
 	}
-	return oldValue.IsBanned, nil
+	return oldValue.SecretID, nil
 }
 
-// ClearIsBanned clears the value of the "is_banned" field.
-func (m *TeamMutation) ClearIsBanned() {
-	m.is_banned = nil
-	m.clearedFields[team.FieldIsBanned] = struct{}{}
+// ResetSecretID resets all changes to the "secret_id" field.
+func (m *TeamSecretVersionMutation) ResetSecretID() {
+	m.secret_id = nil
 }
 
-// IsBannedCleared returns if the "is_banned" field was cleared in this mutation.
-func (m *TeamMutation) IsBannedCleared() bool {
-	_, ok := m.clearedFields[team.FieldIsBanned]
-	return ok
+// SetVersion sets the "version" field.
+func (m *TeamSecretVersionMutation) SetVersion(i int32) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// ResetIsBanned resets all changes to the "is_banned" field.
-func (m *TeamMutation) ResetIsBanned() {
-	m.is_banned = nil
-	delete(m.clearedFields, team.FieldIsBanned)
+// Version returns the value of the "version" field in the mutation.
+func (m *TeamSecretVersionMutation) Version() (r int32, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetIsBlocked sets the "is_blocked" field.
-func (m *TeamMutation) SetIsBlocked(b bool) {
-	m.is_blocked = &b
+// OldVersion returns the old "version" field's value of the TeamSecretVersion entity.
+// If the TeamSecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamSecretVersionMutation) OldVersion(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
 }
 
-// IsBlocked returns the value of the "is_blocked" field in the mutation.
-func (m *TeamMutation) IsBlocked() (r bool, exists bool) {
-	v := m.is_blocked
+// AddVersion adds i to the "version" field.
+func (m *TeamSecretVersionMutation) AddVersion(i int32) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *TeamSecretVersionMutation) AddedVersion() (r int32, exists bool) {
+	v := m.addversion
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBlocked returns the old "is_blocked" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// ResetVersion resets all changes to the "version" field.
+func (m *TeamSecretVersionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *TeamSecretVersionMutation) SetKeyID(s string) {
+	m.key_id = &s
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *TeamSecretVersionMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyID returns the old "key_id" field's value of the TeamSecretVersion entity.
+// If the TeamSecretVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+func (m *TeamSecretVersionMutation) OldKeyID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
 	}
-	return oldValue.IsBlocked, nil
+	return oldValue.KeyID, nil
 }
 
-// ClearIsBlocked clears the value of the "is_blocked" field.
-func (m *TeamMutation) ClearIsBlocked() {
-	m.is_blocked = nil
-	m.clearedFields[team.FieldIsBlocked] = struct{}{}
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *TeamSecretVersionMutation) ResetKeyID() {
+	m.key_id = nil
 }
 
-// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
-func (m *TeamMutation) IsBlockedCleared() bool {
-	_, ok := m.clearedFields[team.FieldIsBlocked]
-	return ok
+// SetEncryptedKey sets the "encrypted_key" field.
+func (m *TeamSecretVersionMutation) SetEncryptedKey(b []byte) {
+	m.encrypted_key = &b
 }
 
-// ResetIsBlocked resets all changes to the "is_blocked" field.
-func (m *TeamMutation) ResetIsBlocked() {
-	m.is_blocked = nil
-	delete(m.clearedFields, team.FieldIsBlocked)
+// EncryptedKey returns the value of the "encrypted_key" field in the mutation.
+func (m *TeamSecretVersionMutation) EncryptedKey() (r []byte, exists bool) {
+	v := m.encrypted_key
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetBlockedReason sets the "blocked_reason" field.
-func (m *TeamMutation) SetBlockedReason(s string) {
-	m.blocked_reason = &s
+// OldEncryptedKey returns the old "encrypted_key" field's value of the TeamSecretVersion entity.
+// If the TeamSecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamSecretVersionMutation) OldEncryptedKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedKey: %w", err)
+	}
+	return oldValue.EncryptedKey, nil
+}
+
+// ResetEncryptedKey resets all changes to the "encrypted_key" field.
+func (m *TeamSecretVersionMutation) ResetEncryptedKey() {
+	m.encrypted_key = nil
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (m *TeamSecretVersionMutation) SetCiphertext(b []byte) {
+	m.ciphertext = &b
+}
+
+// Ciphertext returns the value of the "ciphertext" field in the mutation.
+func (m *TeamSecretVersionMutation) Ciphertext() (r []byte, exists bool) {
+	v := m.ciphertext
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiphertext returns the old "ciphertext" field's value of the TeamSecretVersion entity.
+// If the TeamSecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamSecretVersionMutation) OldCiphertext(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiphertext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiphertext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiphertext: %w", err)
+	}
+	return oldValue.Ciphertext, nil
+}
+
+// ResetCiphertext resets all changes to the "ciphertext" field.
+func (m *TeamSecretVersionMutation) ResetCiphertext() {
+	m.ciphertext = nil
+}
+
+// Where appends a list predicates to the TeamSecretVersionMutation builder.
+func (m *TeamSecretVersionMutation) Where(ps ...predicate.TeamSecretVersion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamSecretVersionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamSecretVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamSecretVersion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamSecretVersionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamSecretVersionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TeamSecretVersion).
+func (m *TeamSecretVersionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamSecretVersionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, teamsecretversion.FieldCreatedAt)
+	}
+	if m.secret_id != nil {
+		fields = append(fields, teamsecretversion.FieldSecretID)
+	}
+	if m.version != nil {
+		fields = append(fields, teamsecretversion.FieldVersion)
+	}
+	if m.key_id != nil {
+		fields = append(fields, teamsecretversion.FieldKeyID)
+	}
+	if m.encrypted_key != nil {
+		fields = append(fields, teamsecretversion.FieldEncryptedKey)
+	}
+	if m.ciphertext != nil {
+		fields = append(fields, teamsecretversion.FieldCiphertext)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamSecretVersionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case teamsecretversion.FieldCreatedAt:
+		return m.CreatedAt()
+	case teamsecretversion.FieldSecretID:
+		return m.SecretID()
+	case teamsecretversion.FieldVersion:
+		return m.Version()
+	case teamsecretversion.FieldKeyID:
+		return m.KeyID()
+	case teamsecretversion.FieldEncryptedKey:
+		return m.EncryptedKey()
+	case teamsecretversion.FieldCiphertext:
+		return m.Ciphertext()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamSecretVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case teamsecretversion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case teamsecretversion.FieldSecretID:
+		return m.OldSecretID(ctx)
+	case teamsecretversion.FieldVersion:
+		return m.OldVersion(ctx)
+	case teamsecretversion.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case teamsecretversion.FieldEncryptedKey:
+		return m.OldEncryptedKey(ctx)
+	case teamsecretversion.FieldCiphertext:
+		return m.OldCiphertext(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamSecretVersion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamSecretVersionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teamsecretversion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case teamsecretversion.FieldSecretID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretID(v)
+		return nil
+	case teamsecretversion.FieldVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case teamsecretversion.FieldKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case teamsecretversion.FieldEncryptedKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedKey(v)
+		return nil
+	case teamsecretversion.FieldCiphertext:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiphertext(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamSecretVersion field %s", name)
 }
 
-// BlockedReason returns the value of the "blocked_reason" field in the mutation.
-func (m *TeamMutation) BlockedReason() (r string, exists bool) {
-	v := m.blocked_reason
-	if v == nil {
-		return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamSecretVersionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, teamsecretversion.FieldVersion)
 	}
-	return *v, true
+	return fields
 }
 
-// OldBlockedReason returns the old "blocked_reason" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldBlockedReason(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBlockedReason is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBlockedReason requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBlockedReason: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamSecretVersionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teamsecretversion.FieldVersion:
+		return m.AddedVersion()
 	}
-	return oldValue.BlockedReason, nil
+	return nil, false
 }
 
-// ClearBlockedReason clears the value of the "blocked_reason" field.
-func (m *TeamMutation) ClearBlockedReason() {
-	m.blocked_reason = nil
-	m.clearedFields[team.FieldBlockedReason] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamSecretVersionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case teamsecretversion.FieldVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamSecretVersion numeric field %s", name)
 }
 
-// BlockedReasonCleared returns if the "blocked_reason" field was cleared in this mutation.
-func (m *TeamMutation) BlockedReasonCleared() bool {
-	_, ok := m.clearedFields[team.FieldBlockedReason]
-	return ok
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamSecretVersionMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetBlockedReason resets all changes to the "blocked_reason" field.
-func (m *TeamMutation) ResetBlockedReason() {
-	m.blocked_reason = nil
-	delete(m.clearedFields, team.FieldBlockedReason)
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamSecretVersionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetName sets the "name" field.
-func (m *TeamMutation) SetName(s string) {
-	m.name = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamSecretVersionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamSecretVersion nullable field %s", name)
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamSecretVersionMutation) ResetField(name string) error {
+	switch name {
+	case teamsecretversion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case teamsecretversion.FieldSecretID:
+		m.ResetSecretID()
+		return nil
+	case teamsecretversion.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case teamsecretversion.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case teamsecretversion.FieldEncryptedKey:
+		m.ResetEncryptedKey()
+		return nil
+	case teamsecretversion.FieldCiphertext:
+		m.ResetCiphertext()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TeamSecretVersion field %s", name)
 }
 
-// OldName returns the old "name" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamSecretVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TeamMutation) ResetName() {
-	m.name = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamSecretVersionMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetTier sets the "tier" field.
-func (m *TeamMutation) SetTier(s string) {
-	m.team_tier = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamSecretVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Tier returns the value of the "tier" field in the mutation.
-func (m *TeamMutation) Tier() (r string, exists bool) {
-	v := m.team_tier
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamSecretVersionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldTier returns the old "tier" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldTier(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTier is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTier requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTier: %w", err)
-	}
-	return oldValue.Tier, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamSecretVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetTier resets all changes to the "tier" field.
-func (m *TeamMutation) ResetTier() {
-	m.team_tier = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamSecretVersionMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetEmail sets the "email" field.
-func (m *TeamMutation) SetEmail(s string) {
-	m.email = &s
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamSecretVersionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TeamSecretVersion unique edge %s", name)
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *TeamMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamSecretVersionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TeamSecretVersion edge %s", name)
 }
 
-// OldEmail returns the old "email" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+// TeamTemplateStorageMutation represents an operation that mutates the TeamTemplateStorage nodes in the graph.
+type TeamTemplateStorageMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	team_id           *uuid.UUID
+	env_id            *string
+	template_bytes    *int64
+	addtemplate_bytes *int64
+	snapshot_bytes    *int64
+	addsnapshot_bytes *int64
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*TeamTemplateStorage, error)
+	predicates        []predicate.TeamTemplateStorage
+}
+
+var _ ent.Mutation = (*TeamTemplateStorageMutation)(nil)
+
+// teamtemplatestorageOption allows management of the mutation configuration using functional options.
+type teamtemplatestorageOption func(*TeamTemplateStorageMutation)
+
+// newTeamTemplateStorageMutation creates new mutation for the TeamTemplateStorage entity.
+func newTeamTemplateStorageMutation(c config, op Op, opts ...teamtemplatestorageOption) *TeamTemplateStorageMutation {
+	m := &TeamTemplateStorageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamTemplateStorage,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *TeamMutation) ResetEmail() {
-	m.email = nil
+	return m
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *TeamMutation) AddUserIDs(ids ...uuid.UUID) {
-	if m.users == nil {
-		m.users = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
+// withTeamTemplateStorageID sets the ID field of the mutation.
+func withTeamTemplateStorageID(id int) teamtemplatestorageOption {
+	return func(m *TeamTemplateStorageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamTemplateStorage
+		)
+		m.oldValue = func(ctx context.Context) (*TeamTemplateStorage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamTemplateStorage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *TeamMutation) ClearUsers() {
-	m.clearedusers = true
+// withTeamTemplateStorage sets the old TeamTemplateStorage of the mutation.
+func withTeamTemplateStorage(node *TeamTemplateStorage) teamtemplatestorageOption {
+	return func(m *TeamTemplateStorageMutation) {
+		m.oldValue = func(context.Context) (*TeamTemplateStorage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *TeamMutation) UsersCleared() bool {
-	return m.clearedusers
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamTemplateStorageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *TeamMutation) RemoveUserIDs(ids ...uuid.UUID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamTemplateStorageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *TeamMutation) RemovedUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamTemplateStorageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return
+	return *m.id, true
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *TeamMutation) UsersIDs() (ids []uuid.UUID) {
-	for id := range m.users {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamTemplateStorageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamTemplateStorage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *TeamMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// SetTeamID sets the "team_id" field.
+func (m *TeamTemplateStorageMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
 }
 
-// AddTeamAPIKeyIDs adds the "team_api_keys" edge to the TeamAPIKey entity by ids.
-func (m *TeamMutation) AddTeamAPIKeyIDs(ids ...uuid.UUID) {
-	if m.team_api_keys == nil {
-		m.team_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.team_api_keys[ids[i]] = struct{}{}
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamTemplateStorageMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearTeamAPIKeys clears the "team_api_keys" edge to the TeamAPIKey entity.
-func (m *TeamMutation) ClearTeamAPIKeys() {
-	m.clearedteam_api_keys = true
-}
-
-// TeamAPIKeysCleared reports if the "team_api_keys" edge to the TeamAPIKey entity was cleared.
-func (m *TeamMutation) TeamAPIKeysCleared() bool {
-	return m.clearedteam_api_keys
-}
-
-// RemoveTeamAPIKeyIDs removes the "team_api_keys" edge to the TeamAPIKey entity by IDs.
-func (m *TeamMutation) RemoveTeamAPIKeyIDs(ids ...uuid.UUID) {
-	if m.removedteam_api_keys == nil {
-		m.removedteam_api_keys = make(map[uuid.UUID]struct{})
+// OldTeamID returns the old "team_id" field's value of the TeamTemplateStorage entity.
+// If the TeamTemplateStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamTemplateStorageMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.team_api_keys, ids[i])
-		m.removedteam_api_keys[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
+	return oldValue.TeamID, nil
 }
 
-// RemovedTeamAPIKeys returns the removed IDs of the "team_api_keys" edge to the TeamAPIKey entity.
-func (m *TeamMutation) RemovedTeamAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.removedteam_api_keys {
-		ids = append(ids, id)
-	}
-	return
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamTemplateStorageMutation) ResetTeamID() {
+	m.team_id = nil
 }
 
-// TeamAPIKeysIDs returns the "team_api_keys" edge IDs in the mutation.
-func (m *TeamMutation) TeamAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.team_api_keys {
-		ids = append(ids, id)
-	}
-	return
+// SetEnvID sets the "env_id" field.
+func (m *TeamTemplateStorageMutation) SetEnvID(s string) {
+	m.env_id = &s
 }
 
-// ResetTeamAPIKeys resets all changes to the "team_api_keys" edge.
-func (m *TeamMutation) ResetTeamAPIKeys() {
-	m.team_api_keys = nil
-	m.clearedteam_api_keys = false
-	m.removedteam_api_keys = nil
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *TeamTemplateStorageMutation) EnvID() (r string, exists bool) {
+	v := m.env_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetTeamTierID sets the "team_tier" edge to the Tier entity by id.
-func (m *TeamMutation) SetTeamTierID(id string) {
-	m.team_tier = &id
+// OldEnvID returns the old "env_id" field's value of the TeamTemplateStorage entity.
+// If the TeamTemplateStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamTemplateStorageMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
 }
 
-// ClearTeamTier clears the "team_tier" edge to the Tier entity.
-func (m *TeamMutation) ClearTeamTier() {
-	m.clearedteam_tier = true
-	m.clearedFields[team.FieldTier] = struct{}{}
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *TeamTemplateStorageMutation) ResetEnvID() {
+	m.env_id = nil
 }
 
-// TeamTierCleared reports if the "team_tier" edge to the Tier entity was cleared.
-func (m *TeamMutation) TeamTierCleared() bool {
-	return m.clearedteam_tier
+// SetTemplateBytes sets the "template_bytes" field.
+func (m *TeamTemplateStorageMutation) SetTemplateBytes(i int64) {
+	m.template_bytes = &i
+	m.addtemplate_bytes = nil
 }
 
-// TeamTierID returns the "team_tier" edge ID in the mutation.
-func (m *TeamMutation) TeamTierID() (id string, exists bool) {
-	if m.team_tier != nil {
-		return *m.team_tier, true
+// TemplateBytes returns the value of the "template_bytes" field in the mutation.
+func (m *TeamTemplateStorageMutation) TemplateBytes() (r int64, exists bool) {
+	v := m.template_bytes
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TeamTierIDs returns the "team_tier" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamTierID instead. It exists only for internal usage by the builders.
-func (m *TeamMutation) TeamTierIDs() (ids []string) {
-	if id := m.team_tier; id != nil {
-		ids = append(ids, *id)
+// OldTemplateBytes returns the old "template_bytes" field's value of the TeamTemplateStorage entity.
+// If the TeamTemplateStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamTemplateStorageMutation) OldTemplateBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateBytes is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateBytes: %w", err)
+	}
+	return oldValue.TemplateBytes, nil
 }
 
-// ResetTeamTier resets all changes to the "team_tier" edge.
-func (m *TeamMutation) ResetTeamTier() {
-	m.team_tier = nil
-	m.clearedteam_tier = false
+// AddTemplateBytes adds i to the "template_bytes" field.
+func (m *TeamTemplateStorageMutation) AddTemplateBytes(i int64) {
+	if m.addtemplate_bytes != nil {
+		*m.addtemplate_bytes += i
+	} else {
+		m.addtemplate_bytes = &i
+	}
 }
 
-// AddEnvIDs adds the "envs" edge to the Env entity by ids.
-func (m *TeamMutation) AddEnvIDs(ids ...string) {
-	if m.envs == nil {
-		m.envs = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.envs[ids[i]] = struct{}{}
+// AddedTemplateBytes returns the value that was added to the "template_bytes" field in this mutation.
+func (m *TeamTemplateStorageMutation) AddedTemplateBytes() (r int64, exists bool) {
+	v := m.addtemplate_bytes
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearEnvs clears the "envs" edge to the Env entity.
-func (m *TeamMutation) ClearEnvs() {
-	m.clearedenvs = true
+// ResetTemplateBytes resets all changes to the "template_bytes" field.
+func (m *TeamTemplateStorageMutation) ResetTemplateBytes() {
+	m.template_bytes = nil
+	m.addtemplate_bytes = nil
 }
 
-// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
-func (m *TeamMutation) EnvsCleared() bool {
-	return m.clearedenvs
+// SetSnapshotBytes sets the "snapshot_bytes" field.
+func (m *TeamTemplateStorageMutation) SetSnapshotBytes(i int64) {
+	m.snapshot_bytes = &i
+	m.addsnapshot_bytes = nil
 }
 
-// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
-func (m *TeamMutation) RemoveEnvIDs(ids ...string) {
-	if m.removedenvs == nil {
-		m.removedenvs = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.envs, ids[i])
-		m.removedenvs[ids[i]] = struct{}{}
+// SnapshotBytes returns the value of the "snapshot_bytes" field in the mutation.
+func (m *TeamTemplateStorageMutation) SnapshotBytes() (r int64, exists bool) {
+	v := m.snapshot_bytes
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
-func (m *TeamMutation) RemovedEnvsIDs() (ids []string) {
-	for id := range m.removedenvs {
-		ids = append(ids, id)
+// OldSnapshotBytes returns the old "snapshot_bytes" field's value of the TeamTemplateStorage entity.
+// If the TeamTemplateStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamTemplateStorageMutation) OldSnapshotBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshotBytes is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// EnvsIDs returns the "envs" edge IDs in the mutation.
-func (m *TeamMutation) EnvsIDs() (ids []string) {
-	for id := range m.envs {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshotBytes requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshotBytes: %w", err)
+	}
+	return oldValue.SnapshotBytes, nil
 }
 
-// ResetEnvs resets all changes to the "envs" edge.
-func (m *TeamMutation) ResetEnvs() {
-	m.envs = nil
-	m.clearedenvs = false
-	m.removedenvs = nil
+// AddSnapshotBytes adds i to the "snapshot_bytes" field.
+func (m *TeamTemplateStorageMutation) AddSnapshotBytes(i int64) {
+	if m.addsnapshot_bytes != nil {
+		*m.addsnapshot_bytes += i
+	} else {
+		m.addsnapshot_bytes = &i
+	}
 }
 
-// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
-func (m *TeamMutation) AddUsersTeamIDs(ids ...int) {
-	if m.users_teams == nil {
-		m.users_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users_teams[ids[i]] = struct{}{}
+// AddedSnapshotBytes returns the value that was added to the "snapshot_bytes" field in this mutation.
+func (m *TeamTemplateStorageMutation) AddedSnapshotBytes() (r int64, exists bool) {
+	v := m.addsnapshot_bytes
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
-func (m *TeamMutation) ClearUsersTeams() {
-	m.clearedusers_teams = true
+// ResetSnapshotBytes resets all changes to the "snapshot_bytes" field.
+func (m *TeamTemplateStorageMutation) ResetSnapshotBytes() {
+	m.snapshot_bytes = nil
+	m.addsnapshot_bytes = nil
 }
 
-// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
-func (m *TeamMutation) UsersTeamsCleared() bool {
-	return m.clearedusers_teams
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamTemplateStorageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
-func (m *TeamMutation) RemoveUsersTeamIDs(ids ...int) {
-	if m.removedusers_teams == nil {
-		m.removedusers_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users_teams, ids[i])
-		m.removedusers_teams[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamTemplateStorageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
-func (m *TeamMutation) RemovedUsersTeamsIDs() (ids []int) {
-	for id := range m.removedusers_teams {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamTemplateStorage entity.
+// If the TeamTemplateStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamTemplateStorageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
-func (m *TeamMutation) UsersTeamsIDs() (ids []int) {
-	for id := range m.users_teams {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetUsersTeams resets all changes to the "users_teams" edge.
-func (m *TeamMutation) ResetUsersTeams() {
-	m.users_teams = nil
-	m.clearedusers_teams = false
-	m.removedusers_teams = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamTemplateStorageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the TeamMutation builder.
-func (m *TeamMutation) Where(ps ...predicate.Team) {
+// Where appends a list predicates to the TeamTemplateStorageMutation builder.
+func (m *TeamTemplateStorageMutation) Where(ps ...predicate.TeamTemplateStorage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamTemplateStorageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Team, len(ps))
+func (m *TeamTemplateStorageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamTemplateStorage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4774,45 +16193,39 @@ func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TeamMutation) Op() Op {
+func (m *TeamTemplateStorageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TeamMutation) SetOp(op Op) {
+func (m *TeamTemplateStorageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Team).
-func (m *TeamMutation) Type() string {
+// Type returns the node type of this mutation (TeamTemplateStorage).
+func (m *TeamTemplateStorageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TeamMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.created_at != nil {
-		fields = append(fields, team.FieldCreatedAt)
-	}
-	if m.is_banned != nil {
-		fields = append(fields, team.FieldIsBanned)
-	}
-	if m.is_blocked != nil {
-		fields = append(fields, team.FieldIsBlocked)
-	}
-	if m.blocked_reason != nil {
-		fields = append(fields, team.FieldBlockedReason)
+func (m *TeamTemplateStorageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.team_id != nil {
+		fields = append(fields, teamtemplatestorage.FieldTeamID)
 	}
-	if m.name != nil {
-		fields = append(fields, team.FieldName)
+	if m.env_id != nil {
+		fields = append(fields, teamtemplatestorage.FieldEnvID)
 	}
-	if m.team_tier != nil {
-		fields = append(fields, team.FieldTier)
+	if m.template_bytes != nil {
+		fields = append(fields, teamtemplatestorage.FieldTemplateBytes)
 	}
-	if m.email != nil {
-		fields = append(fields, team.FieldEmail)
+	if m.snapshot_bytes != nil {
+		fields = append(fields, teamtemplatestorage.FieldSnapshotBytes)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, teamtemplatestorage.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4820,22 +16233,18 @@ func (m *TeamMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamTemplateStorageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case team.FieldCreatedAt:
-		return m.CreatedAt()
-	case team.FieldIsBanned:
-		return m.IsBanned()
-	case team.FieldIsBlocked:
-		return m.IsBlocked()
-	case team.FieldBlockedReason:
-		return m.BlockedReason()
-	case team.FieldName:
-		return m.Name()
-	case team.FieldTier:
-		return m.Tier()
-	case team.FieldEmail:
-		return m.Email()
+	case teamtemplatestorage.FieldTeamID:
+		return m.TeamID()
+	case teamtemplatestorage.FieldEnvID:
+		return m.EnvID()
+	case teamtemplatestorage.FieldTemplateBytes:
+		return m.TemplateBytes()
+	case teamtemplatestorage.FieldSnapshotBytes:
+		return m.SnapshotBytes()
+	case teamtemplatestorage.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -4843,387 +16252,241 @@ func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamTemplateStorageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case team.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case team.FieldIsBanned:
-		return m.OldIsBanned(ctx)
-	case team.FieldIsBlocked:
-		return m.OldIsBlocked(ctx)
-	case team.FieldBlockedReason:
-		return m.OldBlockedReason(ctx)
-	case team.FieldName:
-		return m.OldName(ctx)
-	case team.FieldTier:
-		return m.OldTier(ctx)
-	case team.FieldEmail:
-		return m.OldEmail(ctx)
+	case teamtemplatestorage.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teamtemplatestorage.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case teamtemplatestorage.FieldTemplateBytes:
+		return m.OldTemplateBytes(ctx)
+	case teamtemplatestorage.FieldSnapshotBytes:
+		return m.OldSnapshotBytes(ctx)
+	case teamtemplatestorage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Team field %s", name)
+	return nil, fmt.Errorf("unknown TeamTemplateStorage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) SetField(name string, value ent.Value) error {
+func (m *TeamTemplateStorageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case team.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case team.FieldIsBanned:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBanned(v)
-		return nil
-	case team.FieldIsBlocked:
-		v, ok := value.(bool)
+	case teamtemplatestorage.FieldTeamID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsBlocked(v)
+		m.SetTeamID(v)
 		return nil
-	case team.FieldBlockedReason:
+	case teamtemplatestorage.FieldEnvID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBlockedReason(v)
+		m.SetEnvID(v)
 		return nil
-	case team.FieldName:
-		v, ok := value.(string)
+	case teamtemplatestorage.FieldTemplateBytes:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTemplateBytes(v)
 		return nil
-	case team.FieldTier:
-		v, ok := value.(string)
+	case teamtemplatestorage.FieldSnapshotBytes:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTier(v)
+		m.SetSnapshotBytes(v)
 		return nil
-	case team.FieldEmail:
-		v, ok := value.(string)
+	case teamtemplatestorage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return fmt.Errorf("unknown TeamTemplateStorage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TeamMutation) AddedFields() []string {
-	return nil
+func (m *TeamTemplateStorageMutation) AddedFields() []string {
+	var fields []string
+	if m.addtemplate_bytes != nil {
+		fields = append(fields, teamtemplatestorage.FieldTemplateBytes)
+	}
+	if m.addsnapshot_bytes != nil {
+		fields = append(fields, teamtemplatestorage.FieldSnapshotBytes)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamTemplateStorageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teamtemplatestorage.FieldTemplateBytes:
+		return m.AddedTemplateBytes()
+	case teamtemplatestorage.FieldSnapshotBytes:
+		return m.AddedSnapshotBytes()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) AddField(name string, value ent.Value) error {
+func (m *TeamTemplateStorageMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case teamtemplatestorage.FieldTemplateBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTemplateBytes(v)
+		return nil
+	case teamtemplatestorage.FieldSnapshotBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSnapshotBytes(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Team numeric field %s", name)
+	return fmt.Errorf("unknown TeamTemplateStorage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(team.FieldIsBanned) {
-		fields = append(fields, team.FieldIsBanned)
-	}
-	if m.FieldCleared(team.FieldIsBlocked) {
-		fields = append(fields, team.FieldIsBlocked)
-	}
-	if m.FieldCleared(team.FieldBlockedReason) {
-		fields = append(fields, team.FieldBlockedReason)
-	}
-	return fields
+func (m *TeamTemplateStorageMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamMutation) FieldCleared(name string) bool {
+func (m *TeamTemplateStorageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TeamMutation) ClearField(name string) error {
-	switch name {
-	case team.FieldIsBanned:
-		m.ClearIsBanned()
-		return nil
-	case team.FieldIsBlocked:
-		m.ClearIsBlocked()
-		return nil
-	case team.FieldBlockedReason:
-		m.ClearBlockedReason()
-		return nil
-	}
-	return fmt.Errorf("unknown Team nullable field %s", name)
+func (m *TeamTemplateStorageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamTemplateStorage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamMutation) ResetField(name string) error {
+func (m *TeamTemplateStorageMutation) ResetField(name string) error {
 	switch name {
-	case team.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case team.FieldIsBanned:
-		m.ResetIsBanned()
-		return nil
-	case team.FieldIsBlocked:
-		m.ResetIsBlocked()
+	case teamtemplatestorage.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case team.FieldBlockedReason:
-		m.ResetBlockedReason()
+	case teamtemplatestorage.FieldEnvID:
+		m.ResetEnvID()
 		return nil
-	case team.FieldName:
-		m.ResetName()
+	case teamtemplatestorage.FieldTemplateBytes:
+		m.ResetTemplateBytes()
 		return nil
-	case team.FieldTier:
-		m.ResetTier()
+	case teamtemplatestorage.FieldSnapshotBytes:
+		m.ResetSnapshotBytes()
 		return nil
-	case team.FieldEmail:
-		m.ResetEmail()
+	case teamtemplatestorage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.users != nil {
-		edges = append(edges, team.EdgeUsers)
-	}
-	if m.team_api_keys != nil {
-		edges = append(edges, team.EdgeTeamAPIKeys)
-	}
-	if m.team_tier != nil {
-		edges = append(edges, team.EdgeTeamTier)
-	}
-	if m.envs != nil {
-		edges = append(edges, team.EdgeEnvs)
-	}
-	if m.users_teams != nil {
-		edges = append(edges, team.EdgeUsersTeams)
-	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TeamMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case team.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamAPIKeys:
-		ids := make([]ent.Value, 0, len(m.team_api_keys))
-		for id := range m.team_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamTier:
-		if id := m.team_tier; id != nil {
-			return []ent.Value{*id}
-		}
-	case team.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.envs))
-		for id := range m.envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.users_teams))
-		for id := range m.users_teams {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedusers != nil {
-		edges = append(edges, team.EdgeUsers)
-	}
-	if m.removedteam_api_keys != nil {
-		edges = append(edges, team.EdgeTeamAPIKeys)
-	}
-	if m.removedenvs != nil {
-		edges = append(edges, team.EdgeEnvs)
-	}
-	if m.removedusers_teams != nil {
-		edges = append(edges, team.EdgeUsersTeams)
-	}
-	return edges
+	return fmt.Errorf("unknown TeamTemplateStorage field %s", name)
 }
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case team.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedteam_api_keys))
-		for id := range m.removedteam_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.removedenvs))
-		for id := range m.removedenvs {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.removedusers_teams))
-		for id := range m.removedusers_teams {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamTemplateStorageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamTemplateStorageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamTemplateStorageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamTemplateStorageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedusers {
-		edges = append(edges, team.EdgeUsers)
-	}
-	if m.clearedteam_api_keys {
-		edges = append(edges, team.EdgeTeamAPIKeys)
-	}
-	if m.clearedteam_tier {
-		edges = append(edges, team.EdgeTeamTier)
-	}
-	if m.clearedenvs {
-		edges = append(edges, team.EdgeEnvs)
-	}
-	if m.clearedusers_teams {
-		edges = append(edges, team.EdgeUsersTeams)
-	}
+func (m *TeamTemplateStorageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamMutation) EdgeCleared(name string) bool {
-	switch name {
-	case team.EdgeUsers:
-		return m.clearedusers
-	case team.EdgeTeamAPIKeys:
-		return m.clearedteam_api_keys
-	case team.EdgeTeamTier:
-		return m.clearedteam_tier
-	case team.EdgeEnvs:
-		return m.clearedenvs
-	case team.EdgeUsersTeams:
-		return m.clearedusers_teams
-	}
+func (m *TeamTemplateStorageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamMutation) ClearEdge(name string) error {
-	switch name {
-	case team.EdgeTeamTier:
-		m.ClearTeamTier()
-		return nil
-	}
-	return fmt.Errorf("unknown Team unique edge %s", name)
+func (m *TeamTemplateStorageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TeamTemplateStorage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TeamMutation) ResetEdge(name string) error {
-	switch name {
-	case team.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case team.EdgeTeamAPIKeys:
-		m.ResetTeamAPIKeys()
-		return nil
-	case team.EdgeTeamTier:
-		m.ResetTeamTier()
-		return nil
-	case team.EdgeEnvs:
-		m.ResetEnvs()
-		return nil
-	case team.EdgeUsersTeams:
-		m.ResetUsersTeams()
-		return nil
-	}
-	return fmt.Errorf("unknown Team edge %s", name)
+func (m *TeamTemplateStorageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TeamTemplateStorage edge %s", name)
 }
 
-// TeamAPIKeyMutation represents an operation that mutates the TeamAPIKey nodes in the graph.
-type TeamAPIKeyMutation struct {
+// TeamUsageDailyMutation represents an operation that mutates the TeamUsageDaily nodes in the graph.
+type TeamUsageDailyMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	api_key        *string
-	created_at     *time.Time
-	updated_at     *time.Time
-	name           *string
-	last_used      *time.Time
-	clearedFields  map[string]struct{}
-	team           *uuid.UUID
-	clearedteam    bool
-	creator        *uuid.UUID
-	clearedcreator bool
-	done           bool
-	oldValue       func(context.Context) (*TeamAPIKey, error)
-	predicates     []predicate.TeamAPIKey
+	op                   Op
+	typ                  string
+	id                   *int
+	team_id              *uuid.UUID
+	day                  *time.Time
+	sandboxes_started    *int64
+	addsandboxes_started *int64
+	sandboxes_resumed    *int64
+	addsandboxes_resumed *int64
+	resume_p95_ms        *int64
+	addresume_p95_ms     *int64
+	builds               *int64
+	addbuilds            *int64
+	build_seconds        *int64
+	addbuild_seconds     *int64
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*TeamUsageDaily, error)
+	predicates           []predicate.TeamUsageDaily
 }
 
-var _ ent.Mutation = (*TeamAPIKeyMutation)(nil)
+var _ ent.Mutation = (*TeamUsageDailyMutation)(nil)
 
-// teamapikeyOption allows management of the mutation configuration using functional options.
-type teamapikeyOption func(*TeamAPIKeyMutation)
+// teamusagedailyOption allows management of the mutation configuration using functional options.
+type teamusagedailyOption func(*TeamUsageDailyMutation)
 
-// newTeamAPIKeyMutation creates new mutation for the TeamAPIKey entity.
-func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKeyMutation {
-	m := &TeamAPIKeyMutation{
+// newTeamUsageDailyMutation creates new mutation for the TeamUsageDaily entity.
+func newTeamUsageDailyMutation(c config, op Op, opts ...teamusagedailyOption) *TeamUsageDailyMutation {
+	m := &TeamUsageDailyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeamAPIKey,
+		typ:           TypeTeamUsageDaily,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5232,20 +16495,20 @@ func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKe
 	return m
 }
 
-// withTeamAPIKeyID sets the ID field of the mutation.
-func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
-	return func(m *TeamAPIKeyMutation) {
+// withTeamUsageDailyID sets the ID field of the mutation.
+func withTeamUsageDailyID(id int) teamusagedailyOption {
+	return func(m *TeamUsageDailyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TeamAPIKey
+			value *TeamUsageDaily
 		)
-		m.oldValue = func(ctx context.Context) (*TeamAPIKey, error) {
+		m.oldValue = func(ctx context.Context) (*TeamUsageDaily, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TeamAPIKey.Get(ctx, id)
+					value, err = m.Client().TeamUsageDaily.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5254,10 +16517,10 @@ func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
 	}
 }
 
-// withTeamAPIKey sets the old TeamAPIKey of the mutation.
-func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
-	return func(m *TeamAPIKeyMutation) {
-		m.oldValue = func(context.Context) (*TeamAPIKey, error) {
+// withTeamUsageDaily sets the old TeamUsageDaily of the mutation.
+func withTeamUsageDaily(node *TeamUsageDaily) teamusagedailyOption {
+	return func(m *TeamUsageDailyMutation) {
+		m.oldValue = func(context.Context) (*TeamUsageDaily, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5266,7 +16529,7 @@ func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamAPIKeyMutation) Client() *Client {
+func (m TeamUsageDailyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5274,7 +16537,7 @@ func (m TeamAPIKeyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
+func (m TeamUsageDailyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -5283,15 +16546,9 @@ func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TeamAPIKey entities.
-func (m *TeamAPIKeyMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TeamAPIKeyMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TeamUsageDailyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5302,388 +16559,432 @@ func (m *TeamAPIKeyMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TeamAPIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TeamUsageDailyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TeamAPIKey.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TeamUsageDaily.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAPIKey sets the "api_key" field.
-func (m *TeamAPIKeyMutation) SetAPIKey(s string) {
-	m.api_key = &s
+// SetTeamID sets the "team_id" field.
+func (m *TeamUsageDailyMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
 }
 
-// APIKey returns the value of the "api_key" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKey() (r string, exists bool) {
-	v := m.api_key
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamUsageDailyMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPIKey returns the old "api_key" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the TeamUsageDaily entity.
+// If the TeamUsageDaily object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKey(ctx context.Context) (v string, err error) {
+func (m *TeamUsageDailyMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKey requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.APIKey, nil
+	return oldValue.TeamID, nil
 }
 
-// ResetAPIKey resets all changes to the "api_key" field.
-func (m *TeamAPIKeyMutation) ResetAPIKey() {
-	m.api_key = nil
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamUsageDailyMutation) ResetTeamID() {
+	m.team_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TeamAPIKeyMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDay sets the "day" field.
+func (m *TeamUsageDailyMutation) SetDay(t time.Time) {
+	m.day = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TeamAPIKeyMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Day returns the value of the "day" field in the mutation.
+func (m *TeamUsageDailyMutation) Day() (r time.Time, exists bool) {
+	v := m.day
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldDay returns the old "day" field's value of the TeamUsageDaily entity.
+// If the TeamUsageDaily object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TeamUsageDailyMutation) OldDay(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDay requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Day, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TeamAPIKeyMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetDay resets all changes to the "day" field.
+func (m *TeamUsageDailyMutation) ResetDay() {
+	m.day = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TeamAPIKeyMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetSandboxesStarted sets the "sandboxes_started" field.
+func (m *TeamUsageDailyMutation) SetSandboxesStarted(i int64) {
+	m.sandboxes_started = &i
+	m.addsandboxes_started = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TeamAPIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// SandboxesStarted returns the value of the "sandboxes_started" field in the mutation.
+func (m *TeamUsageDailyMutation) SandboxesStarted() (r int64, exists bool) {
+	v := m.sandboxes_started
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldSandboxesStarted returns the old "sandboxes_started" field's value of the TeamUsageDaily entity.
+// If the TeamUsageDaily object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TeamUsageDailyMutation) OldSandboxesStarted(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSandboxesStarted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSandboxesStarted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSandboxesStarted: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.SandboxesStarted, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *TeamAPIKeyMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[teamapikey.FieldUpdatedAt] = struct{}{}
+// AddSandboxesStarted adds i to the "sandboxes_started" field.
+func (m *TeamUsageDailyMutation) AddSandboxesStarted(i int64) {
+	if m.addsandboxes_started != nil {
+		*m.addsandboxes_started += i
+	} else {
+		m.addsandboxes_started = &i
+	}
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldUpdatedAt]
-	return ok
+// AddedSandboxesStarted returns the value that was added to the "sandboxes_started" field in this mutation.
+func (m *TeamUsageDailyMutation) AddedSandboxesStarted() (r int64, exists bool) {
+	v := m.addsandboxes_started
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TeamAPIKeyMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, teamapikey.FieldUpdatedAt)
+// ResetSandboxesStarted resets all changes to the "sandboxes_started" field.
+func (m *TeamUsageDailyMutation) ResetSandboxesStarted() {
+	m.sandboxes_started = nil
+	m.addsandboxes_started = nil
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *TeamAPIKeyMutation) SetTeamID(u uuid.UUID) {
-	m.team = &u
+// SetSandboxesResumed sets the "sandboxes_resumed" field.
+func (m *TeamUsageDailyMutation) SetSandboxesResumed(i int64) {
+	m.sandboxes_resumed = &i
+	m.addsandboxes_resumed = nil
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *TeamAPIKeyMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.team
+// SandboxesResumed returns the value of the "sandboxes_resumed" field in the mutation.
+func (m *TeamUsageDailyMutation) SandboxesResumed() (r int64, exists bool) {
+	v := m.sandboxes_resumed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldSandboxesResumed returns the old "sandboxes_resumed" field's value of the TeamUsageDaily entity.
+// If the TeamUsageDaily object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TeamUsageDailyMutation) OldSandboxesResumed(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSandboxesResumed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
+		return v, errors.New("OldSandboxesResumed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSandboxesResumed: %w", err)
 	}
-	return oldValue.TeamID, nil
-}
-
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *TeamAPIKeyMutation) ResetTeamID() {
-	m.team = nil
+	return oldValue.SandboxesResumed, nil
 }
 
-// SetName sets the "name" field.
-func (m *TeamAPIKeyMutation) SetName(s string) {
-	m.name = &s
+// AddSandboxesResumed adds i to the "sandboxes_resumed" field.
+func (m *TeamUsageDailyMutation) AddSandboxesResumed(i int64) {
+	if m.addsandboxes_resumed != nil {
+		*m.addsandboxes_resumed += i
+	} else {
+		m.addsandboxes_resumed = &i
+	}
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamAPIKeyMutation) Name() (r string, exists bool) {
-	v := m.name
+// AddedSandboxesResumed returns the value that was added to the "sandboxes_resumed" field in this mutation.
+func (m *TeamUsageDailyMutation) AddedSandboxesResumed() (r int64, exists bool) {
+	v := m.addsandboxes_resumed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *TeamAPIKeyMutation) ResetName() {
-	m.name = nil
+// ResetSandboxesResumed resets all changes to the "sandboxes_resumed" field.
+func (m *TeamUsageDailyMutation) ResetSandboxesResumed() {
+	m.sandboxes_resumed = nil
+	m.addsandboxes_resumed = nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *TeamAPIKeyMutation) SetCreatedBy(u uuid.UUID) {
-	m.creator = &u
+// SetResumeP95Ms sets the "resume_p95_ms" field.
+func (m *TeamUsageDailyMutation) SetResumeP95Ms(i int64) {
+	m.resume_p95_ms = &i
+	m.addresume_p95_ms = nil
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *TeamAPIKeyMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.creator
+// ResumeP95Ms returns the value of the "resume_p95_ms" field in the mutation.
+func (m *TeamUsageDailyMutation) ResumeP95Ms() (r int64, exists bool) {
+	v := m.resume_p95_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldResumeP95Ms returns the old "resume_p95_ms" field's value of the TeamUsageDaily entity.
+// If the TeamUsageDaily object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *TeamUsageDailyMutation) OldResumeP95Ms(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldResumeP95Ms is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldResumeP95Ms requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldResumeP95Ms: %w", err)
+	}
+	return oldValue.ResumeP95Ms, nil
+}
+
+// AddResumeP95Ms adds i to the "resume_p95_ms" field.
+func (m *TeamUsageDailyMutation) AddResumeP95Ms(i int64) {
+	if m.addresume_p95_ms != nil {
+		*m.addresume_p95_ms += i
+	} else {
+		m.addresume_p95_ms = &i
 	}
-	return oldValue.CreatedBy, nil
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *TeamAPIKeyMutation) ClearCreatedBy() {
-	m.creator = nil
-	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+// AddedResumeP95Ms returns the value that was added to the "resume_p95_ms" field in this mutation.
+func (m *TeamUsageDailyMutation) AddedResumeP95Ms() (r int64, exists bool) {
+	v := m.addresume_p95_ms
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldCreatedBy]
+// ClearResumeP95Ms clears the value of the "resume_p95_ms" field.
+func (m *TeamUsageDailyMutation) ClearResumeP95Ms() {
+	m.resume_p95_ms = nil
+	m.addresume_p95_ms = nil
+	m.clearedFields[teamusagedaily.FieldResumeP95Ms] = struct{}{}
+}
+
+// ResumeP95MsCleared returns if the "resume_p95_ms" field was cleared in this mutation.
+func (m *TeamUsageDailyMutation) ResumeP95MsCleared() bool {
+	_, ok := m.clearedFields[teamusagedaily.FieldResumeP95Ms]
 	return ok
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *TeamAPIKeyMutation) ResetCreatedBy() {
-	m.creator = nil
-	delete(m.clearedFields, teamapikey.FieldCreatedBy)
+// ResetResumeP95Ms resets all changes to the "resume_p95_ms" field.
+func (m *TeamUsageDailyMutation) ResetResumeP95Ms() {
+	m.resume_p95_ms = nil
+	m.addresume_p95_ms = nil
+	delete(m.clearedFields, teamusagedaily.FieldResumeP95Ms)
 }
 
-// SetLastUsed sets the "last_used" field.
-func (m *TeamAPIKeyMutation) SetLastUsed(t time.Time) {
-	m.last_used = &t
+// SetBuilds sets the "builds" field.
+func (m *TeamUsageDailyMutation) SetBuilds(i int64) {
+	m.builds = &i
+	m.addbuilds = nil
 }
 
-// LastUsed returns the value of the "last_used" field in the mutation.
-func (m *TeamAPIKeyMutation) LastUsed() (r time.Time, exists bool) {
-	v := m.last_used
+// Builds returns the value of the "builds" field in the mutation.
+func (m *TeamUsageDailyMutation) Builds() (r int64, exists bool) {
+	v := m.builds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUsed returns the old "last_used" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldBuilds returns the old "builds" field's value of the TeamUsageDaily entity.
+// If the TeamUsageDaily object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldLastUsed(ctx context.Context) (v *time.Time, err error) {
+func (m *TeamUsageDailyMutation) OldBuilds(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldBuilds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUsed requires an ID field in the mutation")
+		return v, errors.New("OldBuilds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldBuilds: %w", err)
 	}
-	return oldValue.LastUsed, nil
+	return oldValue.Builds, nil
 }
 
-// ClearLastUsed clears the value of the "last_used" field.
-func (m *TeamAPIKeyMutation) ClearLastUsed() {
-	m.last_used = nil
-	m.clearedFields[teamapikey.FieldLastUsed] = struct{}{}
+// AddBuilds adds i to the "builds" field.
+func (m *TeamUsageDailyMutation) AddBuilds(i int64) {
+	if m.addbuilds != nil {
+		*m.addbuilds += i
+	} else {
+		m.addbuilds = &i
+	}
 }
 
-// LastUsedCleared returns if the "last_used" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) LastUsedCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldLastUsed]
-	return ok
+// AddedBuilds returns the value that was added to the "builds" field in this mutation.
+func (m *TeamUsageDailyMutation) AddedBuilds() (r int64, exists bool) {
+	v := m.addbuilds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetLastUsed resets all changes to the "last_used" field.
-func (m *TeamAPIKeyMutation) ResetLastUsed() {
-	m.last_used = nil
-	delete(m.clearedFields, teamapikey.FieldLastUsed)
+// ResetBuilds resets all changes to the "builds" field.
+func (m *TeamUsageDailyMutation) ResetBuilds() {
+	m.builds = nil
+	m.addbuilds = nil
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *TeamAPIKeyMutation) ClearTeam() {
-	m.clearedteam = true
-	m.clearedFields[teamapikey.FieldTeamID] = struct{}{}
+// SetBuildSeconds sets the "build_seconds" field.
+func (m *TeamUsageDailyMutation) SetBuildSeconds(i int64) {
+	m.build_seconds = &i
+	m.addbuild_seconds = nil
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *TeamAPIKeyMutation) TeamCleared() bool {
-	return m.clearedteam
+// BuildSeconds returns the value of the "build_seconds" field in the mutation.
+func (m *TeamUsageDailyMutation) BuildSeconds() (r int64, exists bool) {
+	v := m.build_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *TeamAPIKeyMutation) TeamIDs() (ids []uuid.UUID) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
+// OldBuildSeconds returns the old "build_seconds" field's value of the TeamUsageDaily entity.
+// If the TeamUsageDaily object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamUsageDailyMutation) OldBuildSeconds(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildSeconds is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildSeconds: %w", err)
+	}
+	return oldValue.BuildSeconds, nil
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *TeamAPIKeyMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// AddBuildSeconds adds i to the "build_seconds" field.
+func (m *TeamUsageDailyMutation) AddBuildSeconds(i int64) {
+	if m.addbuild_seconds != nil {
+		*m.addbuild_seconds += i
+	} else {
+		m.addbuild_seconds = &i
+	}
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *TeamAPIKeyMutation) SetCreatorID(id uuid.UUID) {
-	m.creator = &id
+// AddedBuildSeconds returns the value that was added to the "build_seconds" field in this mutation.
+func (m *TeamUsageDailyMutation) AddedBuildSeconds() (r int64, exists bool) {
+	v := m.addbuild_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *TeamAPIKeyMutation) ClearCreator() {
-	m.clearedcreator = true
-	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+// ResetBuildSeconds resets all changes to the "build_seconds" field.
+func (m *TeamUsageDailyMutation) ResetBuildSeconds() {
+	m.build_seconds = nil
+	m.addbuild_seconds = nil
 }
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *TeamAPIKeyMutation) CreatorCleared() bool {
-	return m.CreatedByCleared() || m.clearedcreator
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamUsageDailyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *TeamAPIKeyMutation) CreatorID() (id uuid.UUID, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamUsageDailyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *TeamAPIKeyMutation) CreatorIDs() (ids []uuid.UUID) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamUsageDaily entity.
+// If the TeamUsageDaily object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamUsageDailyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreator resets all changes to the "creator" edge.
-func (m *TeamAPIKeyMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamUsageDailyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the TeamAPIKeyMutation builder.
-func (m *TeamAPIKeyMutation) Where(ps ...predicate.TeamAPIKey) {
+// Where appends a list predicates to the TeamUsageDailyMutation builder.
+func (m *TeamUsageDailyMutation) Where(ps ...predicate.TeamUsageDaily) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TeamAPIKeyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamUsageDailyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TeamAPIKey, len(ps))
+func (m *TeamUsageDailyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamUsageDaily, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5691,45 +16992,48 @@ func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TeamAPIKeyMutation) Op() Op {
+func (m *TeamUsageDailyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TeamAPIKeyMutation) SetOp(op Op) {
+func (m *TeamUsageDailyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TeamAPIKey).
-func (m *TeamAPIKeyMutation) Type() string {
+// Type returns the node type of this mutation (TeamUsageDaily).
+func (m *TeamUsageDailyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TeamAPIKeyMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.api_key != nil {
-		fields = append(fields, teamapikey.FieldAPIKey)
+func (m *TeamUsageDailyMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.team_id != nil {
+		fields = append(fields, teamusagedaily.FieldTeamID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, teamapikey.FieldCreatedAt)
+	if m.day != nil {
+		fields = append(fields, teamusagedaily.FieldDay)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, teamapikey.FieldUpdatedAt)
+	if m.sandboxes_started != nil {
+		fields = append(fields, teamusagedaily.FieldSandboxesStarted)
 	}
-	if m.team != nil {
-		fields = append(fields, teamapikey.FieldTeamID)
+	if m.sandboxes_resumed != nil {
+		fields = append(fields, teamusagedaily.FieldSandboxesResumed)
 	}
-	if m.name != nil {
-		fields = append(fields, teamapikey.FieldName)
+	if m.resume_p95_ms != nil {
+		fields = append(fields, teamusagedaily.FieldResumeP95Ms)
 	}
-	if m.creator != nil {
-		fields = append(fields, teamapikey.FieldCreatedBy)
+	if m.builds != nil {
+		fields = append(fields, teamusagedaily.FieldBuilds)
 	}
-	if m.last_used != nil {
-		fields = append(fields, teamapikey.FieldLastUsed)
+	if m.build_seconds != nil {
+		fields = append(fields, teamusagedaily.FieldBuildSeconds)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, teamusagedaily.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5737,22 +17041,24 @@ func (m *TeamAPIKeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamUsageDailyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		return m.APIKey()
-	case teamapikey.FieldCreatedAt:
-		return m.CreatedAt()
-	case teamapikey.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case teamapikey.FieldTeamID:
+	case teamusagedaily.FieldTeamID:
 		return m.TeamID()
-	case teamapikey.FieldName:
-		return m.Name()
-	case teamapikey.FieldCreatedBy:
-		return m.CreatedBy()
-	case teamapikey.FieldLastUsed:
-		return m.LastUsed()
+	case teamusagedaily.FieldDay:
+		return m.Day()
+	case teamusagedaily.FieldSandboxesStarted:
+		return m.SandboxesStarted()
+	case teamusagedaily.FieldSandboxesResumed:
+		return m.SandboxesResumed()
+	case teamusagedaily.FieldResumeP95Ms:
+		return m.ResumeP95Ms()
+	case teamusagedaily.FieldBuilds:
+		return m.Builds()
+	case teamusagedaily.FieldBuildSeconds:
+		return m.BuildSeconds()
+	case teamusagedaily.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5760,287 +17066,311 @@ func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TeamAPIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamUsageDailyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		return m.OldAPIKey(ctx)
-	case teamapikey.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case teamapikey.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case teamapikey.FieldTeamID:
+	case teamusagedaily.FieldTeamID:
 		return m.OldTeamID(ctx)
-	case teamapikey.FieldName:
-		return m.OldName(ctx)
-	case teamapikey.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case teamapikey.FieldLastUsed:
-		return m.OldLastUsed(ctx)
+	case teamusagedaily.FieldDay:
+		return m.OldDay(ctx)
+	case teamusagedaily.FieldSandboxesStarted:
+		return m.OldSandboxesStarted(ctx)
+	case teamusagedaily.FieldSandboxesResumed:
+		return m.OldSandboxesResumed(ctx)
+	case teamusagedaily.FieldResumeP95Ms:
+		return m.OldResumeP95Ms(ctx)
+	case teamusagedaily.FieldBuilds:
+		return m.OldBuilds(ctx)
+	case teamusagedaily.FieldBuildSeconds:
+		return m.OldBuildSeconds(ctx)
+	case teamusagedaily.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return nil, fmt.Errorf("unknown TeamUsageDaily field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamAPIKeyMutation) SetField(name string, value ent.Value) error {
+func (m *TeamUsageDailyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		v, ok := value.(string)
+	case teamusagedaily.FieldTeamID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIKey(v)
+		m.SetTeamID(v)
 		return nil
-	case teamapikey.FieldCreatedAt:
+	case teamusagedaily.FieldDay:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDay(v)
 		return nil
-	case teamapikey.FieldUpdatedAt:
+	case teamusagedaily.FieldSandboxesStarted:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxesStarted(v)
+		return nil
+	case teamusagedaily.FieldSandboxesResumed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxesResumed(v)
+		return nil
+	case teamusagedaily.FieldResumeP95Ms:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumeP95Ms(v)
+		return nil
+	case teamusagedaily.FieldBuilds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuilds(v)
+		return nil
+	case teamusagedaily.FieldBuildSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildSeconds(v)
+		return nil
+	case teamusagedaily.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case teamapikey.FieldTeamID:
-		v, ok := value.(uuid.UUID)
+	}
+	return fmt.Errorf("unknown TeamUsageDaily field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamUsageDailyMutation) AddedFields() []string {
+	var fields []string
+	if m.addsandboxes_started != nil {
+		fields = append(fields, teamusagedaily.FieldSandboxesStarted)
+	}
+	if m.addsandboxes_resumed != nil {
+		fields = append(fields, teamusagedaily.FieldSandboxesResumed)
+	}
+	if m.addresume_p95_ms != nil {
+		fields = append(fields, teamusagedaily.FieldResumeP95Ms)
+	}
+	if m.addbuilds != nil {
+		fields = append(fields, teamusagedaily.FieldBuilds)
+	}
+	if m.addbuild_seconds != nil {
+		fields = append(fields, teamusagedaily.FieldBuildSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamUsageDailyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teamusagedaily.FieldSandboxesStarted:
+		return m.AddedSandboxesStarted()
+	case teamusagedaily.FieldSandboxesResumed:
+		return m.AddedSandboxesResumed()
+	case teamusagedaily.FieldResumeP95Ms:
+		return m.AddedResumeP95Ms()
+	case teamusagedaily.FieldBuilds:
+		return m.AddedBuilds()
+	case teamusagedaily.FieldBuildSeconds:
+		return m.AddedBuildSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamUsageDailyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case teamusagedaily.FieldSandboxesStarted:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSandboxesStarted(v)
+		return nil
+	case teamusagedaily.FieldSandboxesResumed:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamID(v)
+		m.AddSandboxesResumed(v)
 		return nil
-	case teamapikey.FieldName:
-		v, ok := value.(string)
+	case teamusagedaily.FieldResumeP95Ms:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.AddResumeP95Ms(v)
 		return nil
-	case teamapikey.FieldCreatedBy:
-		v, ok := value.(uuid.UUID)
+	case teamusagedaily.FieldBuilds:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.AddBuilds(v)
 		return nil
-	case teamapikey.FieldLastUsed:
-		v, ok := value.(time.Time)
+	case teamusagedaily.FieldBuildSeconds:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUsed(v)
+		m.AddBuildSeconds(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TeamAPIKeyMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TeamAPIKeyMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TeamAPIKeyMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown TeamAPIKey numeric field %s", name)
+	return fmt.Errorf("unknown TeamUsageDaily numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamAPIKeyMutation) ClearedFields() []string {
+func (m *TeamUsageDailyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(teamapikey.FieldUpdatedAt) {
-		fields = append(fields, teamapikey.FieldUpdatedAt)
-	}
-	if m.FieldCleared(teamapikey.FieldCreatedBy) {
-		fields = append(fields, teamapikey.FieldCreatedBy)
-	}
-	if m.FieldCleared(teamapikey.FieldLastUsed) {
-		fields = append(fields, teamapikey.FieldLastUsed)
+	if m.FieldCleared(teamusagedaily.FieldResumeP95Ms) {
+		fields = append(fields, teamusagedaily.FieldResumeP95Ms)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamAPIKeyMutation) FieldCleared(name string) bool {
+func (m *TeamUsageDailyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TeamAPIKeyMutation) ClearField(name string) error {
+func (m *TeamUsageDailyMutation) ClearField(name string) error {
 	switch name {
-	case teamapikey.FieldUpdatedAt:
-		m.ClearUpdatedAt()
-		return nil
-	case teamapikey.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case teamapikey.FieldLastUsed:
-		m.ClearLastUsed()
+	case teamusagedaily.FieldResumeP95Ms:
+		m.ClearResumeP95Ms()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey nullable field %s", name)
+	return fmt.Errorf("unknown TeamUsageDaily nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamAPIKeyMutation) ResetField(name string) error {
+func (m *TeamUsageDailyMutation) ResetField(name string) error {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		m.ResetAPIKey()
+	case teamusagedaily.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case teamapikey.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case teamusagedaily.FieldDay:
+		m.ResetDay()
 		return nil
-	case teamapikey.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case teamusagedaily.FieldSandboxesStarted:
+		m.ResetSandboxesStarted()
 		return nil
-	case teamapikey.FieldTeamID:
-		m.ResetTeamID()
+	case teamusagedaily.FieldSandboxesResumed:
+		m.ResetSandboxesResumed()
 		return nil
-	case teamapikey.FieldName:
-		m.ResetName()
+	case teamusagedaily.FieldResumeP95Ms:
+		m.ResetResumeP95Ms()
 		return nil
-	case teamapikey.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case teamusagedaily.FieldBuilds:
+		m.ResetBuilds()
 		return nil
-	case teamapikey.FieldLastUsed:
-		m.ResetLastUsed()
+	case teamusagedaily.FieldBuildSeconds:
+		m.ResetBuildSeconds()
+		return nil
+	case teamusagedaily.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return fmt.Errorf("unknown TeamUsageDaily field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamAPIKeyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.team != nil {
-		edges = append(edges, teamapikey.EdgeTeam)
-	}
-	if m.creator != nil {
-		edges = append(edges, teamapikey.EdgeCreator)
-	}
+func (m *TeamUsageDailyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TeamAPIKeyMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case teamapikey.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case teamapikey.EdgeCreator:
-		if id := m.creator; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *TeamUsageDailyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamAPIKeyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TeamUsageDailyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TeamAPIKeyMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamUsageDailyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamAPIKeyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedteam {
-		edges = append(edges, teamapikey.EdgeTeam)
-	}
-	if m.clearedcreator {
-		edges = append(edges, teamapikey.EdgeCreator)
-	}
+func (m *TeamUsageDailyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamAPIKeyMutation) EdgeCleared(name string) bool {
-	switch name {
-	case teamapikey.EdgeTeam:
-		return m.clearedteam
-	case teamapikey.EdgeCreator:
-		return m.clearedcreator
-	}
+func (m *TeamUsageDailyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamAPIKeyMutation) ClearEdge(name string) error {
-	switch name {
-	case teamapikey.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case teamapikey.EdgeCreator:
-		m.ClearCreator()
-		return nil
-	}
-	return fmt.Errorf("unknown TeamAPIKey unique edge %s", name)
+func (m *TeamUsageDailyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TeamUsageDaily unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TeamAPIKeyMutation) ResetEdge(name string) error {
-	switch name {
-	case teamapikey.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case teamapikey.EdgeCreator:
-		m.ResetCreator()
-		return nil
-	}
-	return fmt.Errorf("unknown TeamAPIKey edge %s", name)
+func (m *TeamUsageDailyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TeamUsageDaily edge %s", name)
 }
 
 // TierMutation represents an operation that mutates the Tier nodes in the graph.
 type TierMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *string
-	name                    *string
-	disk_mb                 *int64
-	adddisk_mb              *int64
-	concurrent_instances    *int64
-	addconcurrent_instances *int64
-	max_length_hours        *int64
-	addmax_length_hours     *int64
-	clearedFields           map[string]struct{}
-	teams                   map[uuid.UUID]struct{}
-	removedteams            map[uuid.UUID]struct{}
-	clearedteams            bool
-	done                    bool
-	oldValue                func(context.Context) (*Tier, error)
-	predicates              []predicate.Tier
+	op                        Op
+	typ                       string
+	id                        *string
+	name                      *string
+	disk_mb                   *int64
+	adddisk_mb                *int64
+	concurrent_instances      *int64
+	addconcurrent_instances   *int64
+	max_length_hours          *int64
+	addmax_length_hours       *int64
+	vcpu_hour_price_usd       *float64
+	addvcpu_hour_price_usd    *float64
+	ram_gib_hour_price_usd    *float64
+	addram_gib_hour_price_usd *float64
+	high_priority             *bool
+	clearedFields             map[string]struct{}
+	teams                     map[uuid.UUID]struct{}
+	removedteams              map[uuid.UUID]struct{}
+	clearedteams              bool
+	done                      bool
+	oldValue                  func(context.Context) (*Tier, error)
+	predicates                []predicate.Tier
 }
 
 var _ ent.Mutation = (*TierMutation)(nil)
@@ -6351,6 +17681,154 @@ func (m *TierMutation) ResetMaxLengthHours() {
 	m.addmax_length_hours = nil
 }
 
+// SetVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field.
+func (m *TierMutation) SetVcpuHourPriceUsd(f float64) {
+	m.vcpu_hour_price_usd = &f
+	m.addvcpu_hour_price_usd = nil
+}
+
+// VcpuHourPriceUsd returns the value of the "vcpu_hour_price_usd" field in the mutation.
+func (m *TierMutation) VcpuHourPriceUsd() (r float64, exists bool) {
+	v := m.vcpu_hour_price_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVcpuHourPriceUsd returns the old "vcpu_hour_price_usd" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldVcpuHourPriceUsd(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVcpuHourPriceUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVcpuHourPriceUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVcpuHourPriceUsd: %w", err)
+	}
+	return oldValue.VcpuHourPriceUsd, nil
+}
+
+// AddVcpuHourPriceUsd adds f to the "vcpu_hour_price_usd" field.
+func (m *TierMutation) AddVcpuHourPriceUsd(f float64) {
+	if m.addvcpu_hour_price_usd != nil {
+		*m.addvcpu_hour_price_usd += f
+	} else {
+		m.addvcpu_hour_price_usd = &f
+	}
+}
+
+// AddedVcpuHourPriceUsd returns the value that was added to the "vcpu_hour_price_usd" field in this mutation.
+func (m *TierMutation) AddedVcpuHourPriceUsd() (r float64, exists bool) {
+	v := m.addvcpu_hour_price_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVcpuHourPriceUsd resets all changes to the "vcpu_hour_price_usd" field.
+func (m *TierMutation) ResetVcpuHourPriceUsd() {
+	m.vcpu_hour_price_usd = nil
+	m.addvcpu_hour_price_usd = nil
+}
+
+// SetRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field.
+func (m *TierMutation) SetRAMGibHourPriceUsd(f float64) {
+	m.ram_gib_hour_price_usd = &f
+	m.addram_gib_hour_price_usd = nil
+}
+
+// RAMGibHourPriceUsd returns the value of the "ram_gib_hour_price_usd" field in the mutation.
+func (m *TierMutation) RAMGibHourPriceUsd() (r float64, exists bool) {
+	v := m.ram_gib_hour_price_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRAMGibHourPriceUsd returns the old "ram_gib_hour_price_usd" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldRAMGibHourPriceUsd(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRAMGibHourPriceUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRAMGibHourPriceUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRAMGibHourPriceUsd: %w", err)
+	}
+	return oldValue.RAMGibHourPriceUsd, nil
+}
+
+// AddRAMGibHourPriceUsd adds f to the "ram_gib_hour_price_usd" field.
+func (m *TierMutation) AddRAMGibHourPriceUsd(f float64) {
+	if m.addram_gib_hour_price_usd != nil {
+		*m.addram_gib_hour_price_usd += f
+	} else {
+		m.addram_gib_hour_price_usd = &f
+	}
+}
+
+// AddedRAMGibHourPriceUsd returns the value that was added to the "ram_gib_hour_price_usd" field in this mutation.
+func (m *TierMutation) AddedRAMGibHourPriceUsd() (r float64, exists bool) {
+	v := m.addram_gib_hour_price_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRAMGibHourPriceUsd resets all changes to the "ram_gib_hour_price_usd" field.
+func (m *TierMutation) ResetRAMGibHourPriceUsd() {
+	m.ram_gib_hour_price_usd = nil
+	m.addram_gib_hour_price_usd = nil
+}
+
+// SetHighPriority sets the "high_priority" field.
+func (m *TierMutation) SetHighPriority(b bool) {
+	m.high_priority = &b
+}
+
+// HighPriority returns the value of the "high_priority" field in the mutation.
+func (m *TierMutation) HighPriority() (r bool, exists bool) {
+	v := m.high_priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHighPriority returns the old "high_priority" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldHighPriority(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHighPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHighPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHighPriority: %w", err)
+	}
+	return oldValue.HighPriority, nil
+}
+
+// ResetHighPriority resets all changes to the "high_priority" field.
+func (m *TierMutation) ResetHighPriority() {
+	m.high_priority = nil
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by ids.
 func (m *TierMutation) AddTeamIDs(ids ...uuid.UUID) {
 	if m.teams == nil {
@@ -6439,7 +17917,7 @@ func (m *TierMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TierMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 7)
 	if m.name != nil {
 		fields = append(fields, tier.FieldName)
 	}
@@ -6452,6 +17930,15 @@ func (m *TierMutation) Fields() []string {
 	if m.max_length_hours != nil {
 		fields = append(fields, tier.FieldMaxLengthHours)
 	}
+	if m.vcpu_hour_price_usd != nil {
+		fields = append(fields, tier.FieldVcpuHourPriceUsd)
+	}
+	if m.ram_gib_hour_price_usd != nil {
+		fields = append(fields, tier.FieldRAMGibHourPriceUsd)
+	}
+	if m.high_priority != nil {
+		fields = append(fields, tier.FieldHighPriority)
+	}
 	return fields
 }
 
@@ -6468,6 +17955,12 @@ func (m *TierMutation) Field(name string) (ent.Value, bool) {
 		return m.ConcurrentInstances()
 	case tier.FieldMaxLengthHours:
 		return m.MaxLengthHours()
+	case tier.FieldVcpuHourPriceUsd:
+		return m.VcpuHourPriceUsd()
+	case tier.FieldRAMGibHourPriceUsd:
+		return m.RAMGibHourPriceUsd()
+	case tier.FieldHighPriority:
+		return m.HighPriority()
 	}
 	return nil, false
 }
@@ -6485,6 +17978,12 @@ func (m *TierMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldConcurrentInstances(ctx)
 	case tier.FieldMaxLengthHours:
 		return m.OldMaxLengthHours(ctx)
+	case tier.FieldVcpuHourPriceUsd:
+		return m.OldVcpuHourPriceUsd(ctx)
+	case tier.FieldRAMGibHourPriceUsd:
+		return m.OldRAMGibHourPriceUsd(ctx)
+	case tier.FieldHighPriority:
+		return m.OldHighPriority(ctx)
 	}
 	return nil, fmt.Errorf("unknown Tier field %s", name)
 }
@@ -6522,6 +18021,27 @@ func (m *TierMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetMaxLengthHours(v)
 		return nil
+	case tier.FieldVcpuHourPriceUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVcpuHourPriceUsd(v)
+		return nil
+	case tier.FieldRAMGibHourPriceUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRAMGibHourPriceUsd(v)
+		return nil
+	case tier.FieldHighPriority:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHighPriority(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Tier field %s", name)
 }
@@ -6539,6 +18059,12 @@ func (m *TierMutation) AddedFields() []string {
 	if m.addmax_length_hours != nil {
 		fields = append(fields, tier.FieldMaxLengthHours)
 	}
+	if m.addvcpu_hour_price_usd != nil {
+		fields = append(fields, tier.FieldVcpuHourPriceUsd)
+	}
+	if m.addram_gib_hour_price_usd != nil {
+		fields = append(fields, tier.FieldRAMGibHourPriceUsd)
+	}
 	return fields
 }
 
@@ -6553,6 +18079,10 @@ func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedConcurrentInstances()
 	case tier.FieldMaxLengthHours:
 		return m.AddedMaxLengthHours()
+	case tier.FieldVcpuHourPriceUsd:
+		return m.AddedVcpuHourPriceUsd()
+	case tier.FieldRAMGibHourPriceUsd:
+		return m.AddedRAMGibHourPriceUsd()
 	}
 	return nil, false
 }
@@ -6583,6 +18113,20 @@ func (m *TierMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddMaxLengthHours(v)
 		return nil
+	case tier.FieldVcpuHourPriceUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVcpuHourPriceUsd(v)
+		return nil
+	case tier.FieldRAMGibHourPriceUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRAMGibHourPriceUsd(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Tier numeric field %s", name)
 }
@@ -6622,6 +18166,15 @@ func (m *TierMutation) ResetField(name string) error {
 	case tier.FieldMaxLengthHours:
 		m.ResetMaxLengthHours()
 		return nil
+	case tier.FieldVcpuHourPriceUsd:
+		m.ResetVcpuHourPriceUsd()
+		return nil
+	case tier.FieldRAMGibHourPriceUsd:
+		m.ResetRAMGibHourPriceUsd()
+		return nil
+	case tier.FieldHighPriority:
+		m.ResetHighPriority()
+		return nil
 	}
 	return fmt.Errorf("unknown Tier field %s", name)
 }
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
+	"github.com/google/uuid"
+)
+
+// PinnedBuild is the model entity for the PinnedBuild schema.
+type PinnedBuild struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// BuildID holds the value of the "build_id" field.
+	BuildID uuid.UUID `json:"build_id,omitempty"`
+	// NodeID holds the value of the "node_id" field.
+	NodeID       string `json:"node_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PinnedBuild) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pinnedbuild.FieldNodeID:
+			values[i] = new(sql.NullString)
+		case pinnedbuild.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case pinnedbuild.FieldID, pinnedbuild.FieldBuildID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PinnedBuild fields.
+func (pb *PinnedBuild) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pinnedbuild.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pb.ID = *value
+			}
+		case pinnedbuild.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pb.CreatedAt = value.Time
+			}
+		case pinnedbuild.FieldBuildID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field build_id", values[i])
+			} else if value != nil {
+				pb.BuildID = *value
+			}
+		case pinnedbuild.FieldNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				pb.NodeID = value.String
+			}
+		default:
+			pb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PinnedBuild.
+// This includes values selected through modifiers, order, etc.
+func (pb *PinnedBuild) Value(name string) (ent.Value, error) {
+	return pb.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PinnedBuild.
+// Note that you need to call PinnedBuild.Unwrap() before calling this method if this PinnedBuild
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pb *PinnedBuild) Update() *PinnedBuildUpdateOne {
+	return NewPinnedBuildClient(pb.config).UpdateOne(pb)
+}
+
+// Unwrap unwraps the PinnedBuild entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pb *PinnedBuild) Unwrap() *PinnedBuild {
+	_tx, ok := pb.config.driver.(*txDriver)
+	if !ok {
+		panic("models: PinnedBuild is not a transactional entity")
+	}
+	pb.config.driver = _tx.drv
+	return pb
+}
+
+// String implements the fmt.Stringer.
+func (pb *PinnedBuild) String() string {
+	var builder strings.Builder
+	builder.WriteString("PinnedBuild(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pb.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("build_id=")
+	builder.WriteString(fmt.Sprintf("%v", pb.BuildID))
+	builder.WriteString(", ")
+	builder.WriteString("node_id=")
+	builder.WriteString(pb.NodeID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PinnedBuilds is a parsable slice of PinnedBuild.
+type PinnedBuilds []*PinnedBuild
@@ -0,0 +1,75 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pinnedbuild
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the pinnedbuild type in the database.
+	Label = "pinned_build"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldBuildID holds the string denoting the build_id field in the database.
+	FieldBuildID = "build_id"
+	// FieldNodeID holds the string denoting the node_id field in the database.
+	FieldNodeID = "node_id"
+	// Table holds the table name of the pinnedbuild in the database.
+	Table = "pinned_builds"
+)
+
+// Columns holds all SQL columns for pinnedbuild fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldBuildID,
+	FieldNodeID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultNodeID holds the default value on creation for the "node_id" field.
+	DefaultNodeID string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PinnedBuild queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBuildID orders the results by the build_id field.
+func ByBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildID, opts...).ToFunc()
+}
+
+// ByNodeID orders the results by the node_id field.
+func ByNodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodeID, opts...).ToFunc()
+}
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pinnedbuild
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BuildID applies equality check predicate on the "build_id" field. It's identical to BuildIDEQ.
+func BuildID(v uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldEQ(FieldBuildID, v))
+}
+
+// NodeID applies equality check predicate on the "node_id" field. It's identical to NodeIDEQ.
+func NodeID(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldEQ(FieldNodeID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// BuildIDEQ applies the EQ predicate on the "build_id" field.
+func BuildIDEQ(v uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldEQ(FieldBuildID, v))
+}
+
+// BuildIDNEQ applies the NEQ predicate on the "build_id" field.
+func BuildIDNEQ(v uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldNEQ(FieldBuildID, v))
+}
+
+// BuildIDIn applies the In predicate on the "build_id" field.
+func BuildIDIn(vs ...uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldIn(FieldBuildID, vs...))
+}
+
+// BuildIDNotIn applies the NotIn predicate on the "build_id" field.
+func BuildIDNotIn(vs ...uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldNotIn(FieldBuildID, vs...))
+}
+
+// BuildIDGT applies the GT predicate on the "build_id" field.
+func BuildIDGT(v uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldGT(FieldBuildID, v))
+}
+
+// BuildIDGTE applies the GTE predicate on the "build_id" field.
+func BuildIDGTE(v uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldGTE(FieldBuildID, v))
+}
+
+// BuildIDLT applies the LT predicate on the "build_id" field.
+func BuildIDLT(v uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldLT(FieldBuildID, v))
+}
+
+// BuildIDLTE applies the LTE predicate on the "build_id" field.
+func BuildIDLTE(v uuid.UUID) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldLTE(FieldBuildID, v))
+}
+
+// NodeIDEQ applies the EQ predicate on the "node_id" field.
+func NodeIDEQ(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldEQ(FieldNodeID, v))
+}
+
+// NodeIDNEQ applies the NEQ predicate on the "node_id" field.
+func NodeIDNEQ(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldNEQ(FieldNodeID, v))
+}
+
+// NodeIDIn applies the In predicate on the "node_id" field.
+func NodeIDIn(vs ...string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldIn(FieldNodeID, vs...))
+}
+
+// NodeIDNotIn applies the NotIn predicate on the "node_id" field.
+func NodeIDNotIn(vs ...string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldNotIn(FieldNodeID, vs...))
+}
+
+// NodeIDGT applies the GT predicate on the "node_id" field.
+func NodeIDGT(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldGT(FieldNodeID, v))
+}
+
+// NodeIDGTE applies the GTE predicate on the "node_id" field.
+func NodeIDGTE(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldGTE(FieldNodeID, v))
+}
+
+// NodeIDLT applies the LT predicate on the "node_id" field.
+func NodeIDLT(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldLT(FieldNodeID, v))
+}
+
+// NodeIDLTE applies the LTE predicate on the "node_id" field.
+func NodeIDLTE(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldLTE(FieldNodeID, v))
+}
+
+// NodeIDContains applies the Contains predicate on the "node_id" field.
+func NodeIDContains(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldContains(FieldNodeID, v))
+}
+
+// NodeIDHasPrefix applies the HasPrefix predicate on the "node_id" field.
+func NodeIDHasPrefix(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldHasPrefix(FieldNodeID, v))
+}
+
+// NodeIDHasSuffix applies the HasSuffix predicate on the "node_id" field.
+func NodeIDHasSuffix(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldHasSuffix(FieldNodeID, v))
+}
+
+// NodeIDEqualFold applies the EqualFold predicate on the "node_id" field.
+func NodeIDEqualFold(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldEqualFold(FieldNodeID, v))
+}
+
+// NodeIDContainsFold applies the ContainsFold predicate on the "node_id" field.
+func NodeIDContainsFold(v string) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.FieldContainsFold(FieldNodeID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PinnedBuild) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PinnedBuild) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PinnedBuild) predicate.PinnedBuild {
+	return predicate.PinnedBuild(sql.NotPredicates(p))
+}
@@ -0,0 +1,538 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
+	"github.com/google/uuid"
+)
+
+// PinnedBuildCreate is the builder for creating a PinnedBuild entity.
+type PinnedBuildCreate struct {
+	config
+	mutation *PinnedBuildMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pbc *PinnedBuildCreate) SetCreatedAt(t time.Time) *PinnedBuildCreate {
+	pbc.mutation.SetCreatedAt(t)
+	return pbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pbc *PinnedBuildCreate) SetNillableCreatedAt(t *time.Time) *PinnedBuildCreate {
+	if t != nil {
+		pbc.SetCreatedAt(*t)
+	}
+	return pbc
+}
+
+// SetBuildID sets the "build_id" field.
+func (pbc *PinnedBuildCreate) SetBuildID(u uuid.UUID) *PinnedBuildCreate {
+	pbc.mutation.SetBuildID(u)
+	return pbc
+}
+
+// SetNodeID sets the "node_id" field.
+func (pbc *PinnedBuildCreate) SetNodeID(s string) *PinnedBuildCreate {
+	pbc.mutation.SetNodeID(s)
+	return pbc
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (pbc *PinnedBuildCreate) SetNillableNodeID(s *string) *PinnedBuildCreate {
+	if s != nil {
+		pbc.SetNodeID(*s)
+	}
+	return pbc
+}
+
+// SetID sets the "id" field.
+func (pbc *PinnedBuildCreate) SetID(u uuid.UUID) *PinnedBuildCreate {
+	pbc.mutation.SetID(u)
+	return pbc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pbc *PinnedBuildCreate) SetNillableID(u *uuid.UUID) *PinnedBuildCreate {
+	if u != nil {
+		pbc.SetID(*u)
+	}
+	return pbc
+}
+
+// Mutation returns the PinnedBuildMutation object of the builder.
+func (pbc *PinnedBuildCreate) Mutation() *PinnedBuildMutation {
+	return pbc.mutation
+}
+
+// Save creates the PinnedBuild in the database.
+func (pbc *PinnedBuildCreate) Save(ctx context.Context) (*PinnedBuild, error) {
+	pbc.defaults()
+	return withHooks(ctx, pbc.sqlSave, pbc.mutation, pbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pbc *PinnedBuildCreate) SaveX(ctx context.Context) *PinnedBuild {
+	v, err := pbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pbc *PinnedBuildCreate) Exec(ctx context.Context) error {
+	_, err := pbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbc *PinnedBuildCreate) ExecX(ctx context.Context) {
+	if err := pbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pbc *PinnedBuildCreate) defaults() {
+	if _, ok := pbc.mutation.CreatedAt(); !ok {
+		v := pinnedbuild.DefaultCreatedAt()
+		pbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pbc.mutation.NodeID(); !ok {
+		v := pinnedbuild.DefaultNodeID
+		pbc.mutation.SetNodeID(v)
+	}
+	if _, ok := pbc.mutation.ID(); !ok {
+		v := pinnedbuild.DefaultID()
+		pbc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbc *PinnedBuildCreate) check() error {
+	if _, ok := pbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "PinnedBuild.created_at"`)}
+	}
+	if _, ok := pbc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build_id", err: errors.New(`models: missing required field "PinnedBuild.build_id"`)}
+	}
+	if _, ok := pbc.mutation.NodeID(); !ok {
+		return &ValidationError{Name: "node_id", err: errors.New(`models: missing required field "PinnedBuild.node_id"`)}
+	}
+	return nil
+}
+
+func (pbc *PinnedBuildCreate) sqlSave(ctx context.Context) (*PinnedBuild, error) {
+	if err := pbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pbc.mutation.id = &_node.ID
+	pbc.mutation.done = true
+	return _node, nil
+}
+
+func (pbc *PinnedBuildCreate) createSpec() (*PinnedBuild, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PinnedBuild{config: pbc.config}
+		_spec = sqlgraph.NewCreateSpec(pinnedbuild.Table, sqlgraph.NewFieldSpec(pinnedbuild.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = pbc.schemaConfig.PinnedBuild
+	_spec.OnConflict = pbc.conflict
+	if id, ok := pbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pbc.mutation.CreatedAt(); ok {
+		_spec.SetField(pinnedbuild.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pbc.mutation.BuildID(); ok {
+		_spec.SetField(pinnedbuild.FieldBuildID, field.TypeUUID, value)
+		_node.BuildID = value
+	}
+	if value, ok := pbc.mutation.NodeID(); ok {
+		_spec.SetField(pinnedbuild.FieldNodeID, field.TypeString, value)
+		_node.NodeID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PinnedBuild.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PinnedBuildUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pbc *PinnedBuildCreate) OnConflict(opts ...sql.ConflictOption) *PinnedBuildUpsertOne {
+	pbc.conflict = opts
+	return &PinnedBuildUpsertOne{
+		create: pbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PinnedBuild.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pbc *PinnedBuildCreate) OnConflictColumns(columns ...string) *PinnedBuildUpsertOne {
+	pbc.conflict = append(pbc.conflict, sql.ConflictColumns(columns...))
+	return &PinnedBuildUpsertOne{
+		create: pbc,
+	}
+}
+
+type (
+	// PinnedBuildUpsertOne is the builder for "upsert"-ing
+	//  one PinnedBuild node.
+	PinnedBuildUpsertOne struct {
+		create *PinnedBuildCreate
+	}
+
+	// PinnedBuildUpsert is the "OnConflict" setter.
+	PinnedBuildUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PinnedBuild.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pinnedbuild.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PinnedBuildUpsertOne) UpdateNewValues() *PinnedBuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pinnedbuild.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pinnedbuild.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.BuildID(); exists {
+			s.SetIgnore(pinnedbuild.FieldBuildID)
+		}
+		if _, exists := u.create.mutation.NodeID(); exists {
+			s.SetIgnore(pinnedbuild.FieldNodeID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PinnedBuild.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PinnedBuildUpsertOne) Ignore() *PinnedBuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PinnedBuildUpsertOne) DoNothing() *PinnedBuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PinnedBuildCreate.OnConflict
+// documentation for more info.
+func (u *PinnedBuildUpsertOne) Update(set func(*PinnedBuildUpsert)) *PinnedBuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PinnedBuildUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PinnedBuildUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for PinnedBuildCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PinnedBuildUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PinnedBuildUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: PinnedBuildUpsertOne.ID is not supported by MySQL driver. Use PinnedBuildUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PinnedBuildUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PinnedBuildCreateBulk is the builder for creating many PinnedBuild entities in bulk.
+type PinnedBuildCreateBulk struct {
+	config
+	err      error
+	builders []*PinnedBuildCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PinnedBuild entities in the database.
+func (pbcb *PinnedBuildCreateBulk) Save(ctx context.Context) ([]*PinnedBuild, error) {
+	if pbcb.err != nil {
+		return nil, pbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pbcb.builders))
+	nodes := make([]*PinnedBuild, len(pbcb.builders))
+	mutators := make([]Mutator, len(pbcb.builders))
+	for i := range pbcb.builders {
+		func(i int, root context.Context) {
+			builder := pbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PinnedBuildMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbcb *PinnedBuildCreateBulk) SaveX(ctx context.Context) []*PinnedBuild {
+	v, err := pbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pbcb *PinnedBuildCreateBulk) Exec(ctx context.Context) error {
+	_, err := pbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbcb *PinnedBuildCreateBulk) ExecX(ctx context.Context) {
+	if err := pbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PinnedBuild.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PinnedBuildUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pbcb *PinnedBuildCreateBulk) OnConflict(opts ...sql.ConflictOption) *PinnedBuildUpsertBulk {
+	pbcb.conflict = opts
+	return &PinnedBuildUpsertBulk{
+		create: pbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PinnedBuild.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pbcb *PinnedBuildCreateBulk) OnConflictColumns(columns ...string) *PinnedBuildUpsertBulk {
+	pbcb.conflict = append(pbcb.conflict, sql.ConflictColumns(columns...))
+	return &PinnedBuildUpsertBulk{
+		create: pbcb,
+	}
+}
+
+// PinnedBuildUpsertBulk is the builder for "upsert"-ing
+// a bulk of PinnedBuild nodes.
+type PinnedBuildUpsertBulk struct {
+	create *PinnedBuildCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PinnedBuild.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pinnedbuild.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PinnedBuildUpsertBulk) UpdateNewValues() *PinnedBuildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pinnedbuild.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pinnedbuild.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.BuildID(); exists {
+				s.SetIgnore(pinnedbuild.FieldBuildID)
+			}
+			if _, exists := b.mutation.NodeID(); exists {
+				s.SetIgnore(pinnedbuild.FieldNodeID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PinnedBuild.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PinnedBuildUpsertBulk) Ignore() *PinnedBuildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PinnedBuildUpsertBulk) DoNothing() *PinnedBuildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PinnedBuildCreateBulk.OnConflict
+// documentation for more info.
+func (u *PinnedBuildUpsertBulk) Update(set func(*PinnedBuildUpsert)) *PinnedBuildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PinnedBuildUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PinnedBuildUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the PinnedBuildCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for PinnedBuildCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PinnedBuildUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// PinnedBuildDelete is the builder for deleting a PinnedBuild entity.
+type PinnedBuildDelete struct {
+	config
+	hooks    []Hook
+	mutation *PinnedBuildMutation
+}
+
+// Where appends a list predicates to the PinnedBuildDelete builder.
+func (pbd *PinnedBuildDelete) Where(ps ...predicate.PinnedBuild) *PinnedBuildDelete {
+	pbd.mutation.Where(ps...)
+	return pbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pbd *PinnedBuildDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pbd.sqlExec, pbd.mutation, pbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbd *PinnedBuildDelete) ExecX(ctx context.Context) int {
+	n, err := pbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pbd *PinnedBuildDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pinnedbuild.Table, sqlgraph.NewFieldSpec(pinnedbuild.FieldID, field.TypeUUID))
+	_spec.Node.Schema = pbd.schemaConfig.PinnedBuild
+	ctx = internal.NewSchemaConfigContext(ctx, pbd.schemaConfig)
+	if ps := pbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pbd.mutation.done = true
+	return affected, err
+}
+
+// PinnedBuildDeleteOne is the builder for deleting a single PinnedBuild entity.
+type PinnedBuildDeleteOne struct {
+	pbd *PinnedBuildDelete
+}
+
+// Where appends a list predicates to the PinnedBuildDelete builder.
+func (pbdo *PinnedBuildDeleteOne) Where(ps ...predicate.PinnedBuild) *PinnedBuildDeleteOne {
+	pbdo.pbd.mutation.Where(ps...)
+	return pbdo
+}
+
+// Exec executes the deletion query.
+func (pbdo *PinnedBuildDeleteOne) Exec(ctx context.Context) error {
+	n, err := pbdo.pbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pinnedbuild.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbdo *PinnedBuildDeleteOne) ExecX(ctx context.Context) {
+	if err := pbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// PinnedBuildQuery is the builder for querying PinnedBuild entities.
+type PinnedBuildQuery struct {
+	config
+	ctx        *QueryContext
+	order      []pinnedbuild.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PinnedBuild
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PinnedBuildQuery builder.
+func (pbq *PinnedBuildQuery) Where(ps ...predicate.PinnedBuild) *PinnedBuildQuery {
+	pbq.predicates = append(pbq.predicates, ps...)
+	return pbq
+}
+
+// Limit the number of records to be returned by this query.
+func (pbq *PinnedBuildQuery) Limit(limit int) *PinnedBuildQuery {
+	pbq.ctx.Limit = &limit
+	return pbq
+}
+
+// Offset to start from.
+func (pbq *PinnedBuildQuery) Offset(offset int) *PinnedBuildQuery {
+	pbq.ctx.Offset = &offset
+	return pbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pbq *PinnedBuildQuery) Unique(unique bool) *PinnedBuildQuery {
+	pbq.ctx.Unique = &unique
+	return pbq
+}
+
+// Order specifies how the records should be ordered.
+func (pbq *PinnedBuildQuery) Order(o ...pinnedbuild.OrderOption) *PinnedBuildQuery {
+	pbq.order = append(pbq.order, o...)
+	return pbq
+}
+
+// First returns the first PinnedBuild entity from the query.
+// Returns a *NotFoundError when no PinnedBuild was found.
+func (pbq *PinnedBuildQuery) First(ctx context.Context) (*PinnedBuild, error) {
+	nodes, err := pbq.Limit(1).All(setContextOp(ctx, pbq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pinnedbuild.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pbq *PinnedBuildQuery) FirstX(ctx context.Context) *PinnedBuild {
+	node, err := pbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PinnedBuild ID from the query.
+// Returns a *NotFoundError when no PinnedBuild ID was found.
+func (pbq *PinnedBuildQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pbq.Limit(1).IDs(setContextOp(ctx, pbq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pinnedbuild.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pbq *PinnedBuildQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PinnedBuild entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PinnedBuild entity is found.
+// Returns a *NotFoundError when no PinnedBuild entities are found.
+func (pbq *PinnedBuildQuery) Only(ctx context.Context) (*PinnedBuild, error) {
+	nodes, err := pbq.Limit(2).All(setContextOp(ctx, pbq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pinnedbuild.Label}
+	default:
+		return nil, &NotSingularError{pinnedbuild.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pbq *PinnedBuildQuery) OnlyX(ctx context.Context) *PinnedBuild {
+	node, err := pbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PinnedBuild ID in the query.
+// Returns a *NotSingularError when more than one PinnedBuild ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pbq *PinnedBuildQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pbq.Limit(2).IDs(setContextOp(ctx, pbq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pinnedbuild.Label}
+	default:
+		err = &NotSingularError{pinnedbuild.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pbq *PinnedBuildQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PinnedBuilds.
+func (pbq *PinnedBuildQuery) All(ctx context.Context) ([]*PinnedBuild, error) {
+	ctx = setContextOp(ctx, pbq.ctx, "All")
+	if err := pbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PinnedBuild, *PinnedBuildQuery]()
+	return withInterceptors[[]*PinnedBuild](ctx, pbq, qr, pbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pbq *PinnedBuildQuery) AllX(ctx context.Context) []*PinnedBuild {
+	nodes, err := pbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PinnedBuild IDs.
+func (pbq *PinnedBuildQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if pbq.ctx.Unique == nil && pbq.path != nil {
+		pbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pbq.ctx, "IDs")
+	if err = pbq.Select(pinnedbuild.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pbq *PinnedBuildQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pbq *PinnedBuildQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pbq.ctx, "Count")
+	if err := pbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pbq, querierCount[*PinnedBuildQuery](), pbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pbq *PinnedBuildQuery) CountX(ctx context.Context) int {
+	count, err := pbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pbq *PinnedBuildQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pbq.ctx, "Exist")
+	switch _, err := pbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pbq *PinnedBuildQuery) ExistX(ctx context.Context) bool {
+	exist, err := pbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PinnedBuildQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pbq *PinnedBuildQuery) Clone() *PinnedBuildQuery {
+	if pbq == nil {
+		return nil
+	}
+	return &PinnedBuildQuery{
+		config:     pbq.config,
+		ctx:        pbq.ctx.Clone(),
+		order:      append([]pinnedbuild.OrderOption{}, pbq.order...),
+		inters:     append([]Interceptor{}, pbq.inters...),
+		predicates: append([]predicate.PinnedBuild{}, pbq.predicates...),
+		// clone intermediate query.
+		sql:  pbq.sql.Clone(),
+		path: pbq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PinnedBuild.Query().
+//		GroupBy(pinnedbuild.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (pbq *PinnedBuildQuery) GroupBy(field string, fields ...string) *PinnedBuildGroupBy {
+	pbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PinnedBuildGroupBy{build: pbq}
+	grbuild.flds = &pbq.ctx.Fields
+	grbuild.label = pinnedbuild.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PinnedBuild.Query().
+//		Select(pinnedbuild.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pbq *PinnedBuildQuery) Select(fields ...string) *PinnedBuildSelect {
+	pbq.ctx.Fields = append(pbq.ctx.Fields, fields...)
+	sbuild := &PinnedBuildSelect{PinnedBuildQuery: pbq}
+	sbuild.label = pinnedbuild.Label
+	sbuild.flds, sbuild.scan = &pbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PinnedBuildSelect configured with the given aggregations.
+func (pbq *PinnedBuildQuery) Aggregate(fns ...AggregateFunc) *PinnedBuildSelect {
+	return pbq.Select().Aggregate(fns...)
+}
+
+func (pbq *PinnedBuildQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pbq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pbq.ctx.Fields {
+		if !pinnedbuild.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if pbq.path != nil {
+		prev, err := pbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pbq.sql = prev
+	}
+	return nil
+}
+
+func (pbq *PinnedBuildQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PinnedBuild, error) {
+	var (
+		nodes = []*PinnedBuild{}
+		_spec = pbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PinnedBuild).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PinnedBuild{config: pbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = pbq.schemaConfig.PinnedBuild
+	ctx = internal.NewSchemaConfigContext(ctx, pbq.schemaConfig)
+	if len(pbq.modifiers) > 0 {
+		_spec.Modifiers = pbq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pbq *PinnedBuildQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pbq.querySpec()
+	_spec.Node.Schema = pbq.schemaConfig.PinnedBuild
+	ctx = internal.NewSchemaConfigContext(ctx, pbq.schemaConfig)
+	if len(pbq.modifiers) > 0 {
+		_spec.Modifiers = pbq.modifiers
+	}
+	_spec.Node.Columns = pbq.ctx.Fields
+	if len(pbq.ctx.Fields) > 0 {
+		_spec.Unique = pbq.ctx.Unique != nil && *pbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pbq.driver, _spec)
+}
+
+func (pbq *PinnedBuildQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pinnedbuild.Table, pinnedbuild.Columns, sqlgraph.NewFieldSpec(pinnedbuild.FieldID, field.TypeUUID))
+	_spec.From = pbq.sql
+	if unique := pbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pinnedbuild.FieldID)
+		for i := range fields {
+			if fields[i] != pinnedbuild.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pbq *PinnedBuildQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pbq.driver.Dialect())
+	t1 := builder.Table(pinnedbuild.Table)
+	columns := pbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pinnedbuild.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pbq.sql != nil {
+		selector = pbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pbq.ctx.Unique != nil && *pbq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(pbq.schemaConfig.PinnedBuild)
+	ctx = internal.NewSchemaConfigContext(ctx, pbq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range pbq.modifiers {
+		m(selector)
+	}
+	for _, p := range pbq.predicates {
+		p(selector)
+	}
+	for _, p := range pbq.order {
+		p(selector)
+	}
+	if offset := pbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pbq *PinnedBuildQuery) Modify(modifiers ...func(s *sql.Selector)) *PinnedBuildSelect {
+	pbq.modifiers = append(pbq.modifiers, modifiers...)
+	return pbq.Select()
+}
+
+// PinnedBuildGroupBy is the group-by builder for PinnedBuild entities.
+type PinnedBuildGroupBy struct {
+	selector
+	build *PinnedBuildQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pbgb *PinnedBuildGroupBy) Aggregate(fns ...AggregateFunc) *PinnedBuildGroupBy {
+	pbgb.fns = append(pbgb.fns, fns...)
+	return pbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pbgb *PinnedBuildGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pbgb.build.ctx, "GroupBy")
+	if err := pbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PinnedBuildQuery, *PinnedBuildGroupBy](ctx, pbgb.build, pbgb, pbgb.build.inters, v)
+}
+
+func (pbgb *PinnedBuildGroupBy) sqlScan(ctx context.Context, root *PinnedBuildQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pbgb.fns))
+	for _, fn := range pbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pbgb.flds)+len(pbgb.fns))
+		for _, f := range *pbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PinnedBuildSelect is the builder for selecting fields of PinnedBuild entities.
+type PinnedBuildSelect struct {
+	*PinnedBuildQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pbs *PinnedBuildSelect) Aggregate(fns ...AggregateFunc) *PinnedBuildSelect {
+	pbs.fns = append(pbs.fns, fns...)
+	return pbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pbs *PinnedBuildSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pbs.ctx, "Select")
+	if err := pbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PinnedBuildQuery, *PinnedBuildSelect](ctx, pbs.PinnedBuildQuery, pbs, pbs.inters, v)
+}
+
+func (pbs *PinnedBuildSelect) sqlScan(ctx context.Context, root *PinnedBuildQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pbs.fns))
+	for _, fn := range pbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pbs *PinnedBuildSelect) Modify(modifiers ...func(s *sql.Selector)) *PinnedBuildSelect {
+	pbs.modifiers = append(pbs.modifiers, modifiers...)
+	return pbs
+}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// PinnedBuildUpdate is the builder for updating PinnedBuild entities.
+type PinnedBuildUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PinnedBuildMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PinnedBuildUpdate builder.
+func (pbu *PinnedBuildUpdate) Where(ps ...predicate.PinnedBuild) *PinnedBuildUpdate {
+	pbu.mutation.Where(ps...)
+	return pbu
+}
+
+// Mutation returns the PinnedBuildMutation object of the builder.
+func (pbu *PinnedBuildUpdate) Mutation() *PinnedBuildMutation {
+	return pbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pbu *PinnedBuildUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pbu.sqlSave, pbu.mutation, pbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbu *PinnedBuildUpdate) SaveX(ctx context.Context) int {
+	affected, err := pbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pbu *PinnedBuildUpdate) Exec(ctx context.Context) error {
+	_, err := pbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbu *PinnedBuildUpdate) ExecX(ctx context.Context) {
+	if err := pbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pbu *PinnedBuildUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PinnedBuildUpdate {
+	pbu.modifiers = append(pbu.modifiers, modifiers...)
+	return pbu
+}
+
+func (pbu *PinnedBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pinnedbuild.Table, pinnedbuild.Columns, sqlgraph.NewFieldSpec(pinnedbuild.FieldID, field.TypeUUID))
+	if ps := pbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = pbu.schemaConfig.PinnedBuild
+	ctx = internal.NewSchemaConfigContext(ctx, pbu.schemaConfig)
+	_spec.AddModifiers(pbu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedbuild.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pbu.mutation.done = true
+	return n, nil
+}
+
+// PinnedBuildUpdateOne is the builder for updating a single PinnedBuild entity.
+type PinnedBuildUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PinnedBuildMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the PinnedBuildMutation object of the builder.
+func (pbuo *PinnedBuildUpdateOne) Mutation() *PinnedBuildMutation {
+	return pbuo.mutation
+}
+
+// Where appends a list predicates to the PinnedBuildUpdate builder.
+func (pbuo *PinnedBuildUpdateOne) Where(ps ...predicate.PinnedBuild) *PinnedBuildUpdateOne {
+	pbuo.mutation.Where(ps...)
+	return pbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pbuo *PinnedBuildUpdateOne) Select(field string, fields ...string) *PinnedBuildUpdateOne {
+	pbuo.fields = append([]string{field}, fields...)
+	return pbuo
+}
+
+// Save executes the query and returns the updated PinnedBuild entity.
+func (pbuo *PinnedBuildUpdateOne) Save(ctx context.Context) (*PinnedBuild, error) {
+	return withHooks(ctx, pbuo.sqlSave, pbuo.mutation, pbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbuo *PinnedBuildUpdateOne) SaveX(ctx context.Context) *PinnedBuild {
+	node, err := pbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pbuo *PinnedBuildUpdateOne) Exec(ctx context.Context) error {
+	_, err := pbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbuo *PinnedBuildUpdateOne) ExecX(ctx context.Context) {
+	if err := pbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pbuo *PinnedBuildUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PinnedBuildUpdateOne {
+	pbuo.modifiers = append(pbuo.modifiers, modifiers...)
+	return pbuo
+}
+
+func (pbuo *PinnedBuildUpdateOne) sqlSave(ctx context.Context) (_node *PinnedBuild, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pinnedbuild.Table, pinnedbuild.Columns, sqlgraph.NewFieldSpec(pinnedbuild.FieldID, field.TypeUUID))
+	id, ok := pbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "PinnedBuild.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pinnedbuild.FieldID)
+		for _, f := range fields {
+			if !pinnedbuild.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != pinnedbuild.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = pbuo.schemaConfig.PinnedBuild
+	ctx = internal.NewSchemaConfigContext(ctx, pbuo.schemaConfig)
+	_spec.AddModifiers(pbuo.modifiers...)
+	_node = &PinnedBuild{config: pbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedbuild.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pbuo.mutation.done = true
+	return _node, nil
+}
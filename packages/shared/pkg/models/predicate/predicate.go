@@ -18,6 +18,27 @@ type EnvAlias func(*sql.Selector)
 // EnvBuild is the predicate function for envbuild builders.
 type EnvBuild func(*sql.Selector)
 
+// EnvBuildLog is the predicate function for envbuildlog builders.
+type EnvBuildLog func(*sql.Selector)
+
+// EnvRebuildSchedule is the predicate function for envrebuildschedule builders.
+type EnvRebuildSchedule func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// Kernel is the predicate function for kernel builders.
+type Kernel func(*sql.Selector)
+
+// PinnedBuild is the predicate function for pinnedbuild builders.
+type PinnedBuild func(*sql.Selector)
+
+// Sandbox is the predicate function for sandbox builders.
+type Sandbox func(*sql.Selector)
+
+// SandboxStart is the predicate function for sandboxstart builders.
+type SandboxStart func(*sql.Selector)
+
 // Snapshot is the predicate function for snapshot builders.
 type Snapshot func(*sql.Selector)
 
@@ -27,6 +48,24 @@ type Team func(*sql.Selector)
 // TeamAPIKey is the predicate function for teamapikey builders.
 type TeamAPIKey func(*sql.Selector)
 
+// TeamBudget is the predicate function for teambudget builders.
+type TeamBudget func(*sql.Selector)
+
+// TeamRegistryCredential is the predicate function for teamregistrycredential builders.
+type TeamRegistryCredential func(*sql.Selector)
+
+// TeamSecret is the predicate function for teamsecret builders.
+type TeamSecret func(*sql.Selector)
+
+// TeamSecretVersion is the predicate function for teamsecretversion builders.
+type TeamSecretVersion func(*sql.Selector)
+
+// TeamTemplateStorage is the predicate function for teamtemplatestorage builders.
+type TeamTemplateStorage func(*sql.Selector)
+
+// TeamUsageDaily is the predicate function for teamusagedaily builders.
+type TeamUsageDaily func(*sql.Selector)
+
 // Tier is the predicate function for tier builders.
 type Tier func(*sql.Selector)
 
@@ -8,12 +8,27 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envrebuildschedule"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/idempotencykey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/kernel"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/pinnedbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecret"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
 	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+	"github.com/google/uuid"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -55,13 +70,91 @@ func init() {
 	// envbuild.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	envbuild.DefaultUpdatedAt = envbuildDescUpdatedAt.Default.(func() time.Time)
 	// envbuildDescKernelVersion is the schema descriptor for kernel_version field.
-	envbuildDescKernelVersion := envbuildFields[12].Descriptor()
+	envbuildDescKernelVersion := envbuildFields[17].Descriptor()
 	// envbuild.DefaultKernelVersion holds the default value on creation for the kernel_version field.
 	envbuild.DefaultKernelVersion = envbuildDescKernelVersion.Default.(string)
 	// envbuildDescFirecrackerVersion is the schema descriptor for firecracker_version field.
-	envbuildDescFirecrackerVersion := envbuildFields[13].Descriptor()
+	envbuildDescFirecrackerVersion := envbuildFields[21].Descriptor()
 	// envbuild.DefaultFirecrackerVersion holds the default value on creation for the firecracker_version field.
 	envbuild.DefaultFirecrackerVersion = envbuildDescFirecrackerVersion.Default.(string)
+	envbuildlogFields := schema.EnvBuildLog{}.Fields()
+	_ = envbuildlogFields
+	// envbuildlogDescCreatedAt is the schema descriptor for created_at field.
+	envbuildlogDescCreatedAt := envbuildlogFields[0].Descriptor()
+	// envbuildlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	envbuildlog.DefaultCreatedAt = envbuildlogDescCreatedAt.Default.(func() time.Time)
+	envrebuildscheduleFields := schema.EnvRebuildSchedule{}.Fields()
+	_ = envrebuildscheduleFields
+	// envrebuildscheduleDescCreatedAt is the schema descriptor for created_at field.
+	envrebuildscheduleDescCreatedAt := envrebuildscheduleFields[1].Descriptor()
+	// envrebuildschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	envrebuildschedule.DefaultCreatedAt = envrebuildscheduleDescCreatedAt.Default.(func() time.Time)
+	// envrebuildscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	envrebuildscheduleDescUpdatedAt := envrebuildscheduleFields[2].Descriptor()
+	// envrebuildschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	envrebuildschedule.DefaultUpdatedAt = envrebuildscheduleDescUpdatedAt.Default.(func() time.Time)
+	// envrebuildschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	envrebuildschedule.UpdateDefaultUpdatedAt = envrebuildscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// envrebuildscheduleDescIntervalHours is the schema descriptor for interval_hours field.
+	envrebuildscheduleDescIntervalHours := envrebuildscheduleFields[4].Descriptor()
+	// envrebuildschedule.DefaultIntervalHours holds the default value on creation for the interval_hours field.
+	envrebuildschedule.DefaultIntervalHours = envrebuildscheduleDescIntervalHours.Default.(int32)
+	// envrebuildscheduleDescUpgradePackages is the schema descriptor for upgrade_packages field.
+	envrebuildscheduleDescUpgradePackages := envrebuildscheduleFields[6].Descriptor()
+	// envrebuildschedule.DefaultUpgradePackages holds the default value on creation for the upgrade_packages field.
+	envrebuildschedule.DefaultUpgradePackages = envrebuildscheduleDescUpgradePackages.Default.(bool)
+	// envrebuildscheduleDescID is the schema descriptor for id field.
+	envrebuildscheduleDescID := envrebuildscheduleFields[0].Descriptor()
+	// envrebuildschedule.DefaultID holds the default value on creation for the id field.
+	envrebuildschedule.DefaultID = envrebuildscheduleDescID.Default.(func() uuid.UUID)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
+	kernelFields := schema.Kernel{}.Fields()
+	_ = kernelFields
+	// kernelDescCreatedAt is the schema descriptor for created_at field.
+	kernelDescCreatedAt := kernelFields[1].Descriptor()
+	// kernel.DefaultCreatedAt holds the default value on creation for the created_at field.
+	kernel.DefaultCreatedAt = kernelDescCreatedAt.Default.(func() time.Time)
+	pinnedbuildFields := schema.PinnedBuild{}.Fields()
+	_ = pinnedbuildFields
+	// pinnedbuildDescCreatedAt is the schema descriptor for created_at field.
+	pinnedbuildDescCreatedAt := pinnedbuildFields[1].Descriptor()
+	// pinnedbuild.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pinnedbuild.DefaultCreatedAt = pinnedbuildDescCreatedAt.Default.(func() time.Time)
+	// pinnedbuildDescNodeID is the schema descriptor for node_id field.
+	pinnedbuildDescNodeID := pinnedbuildFields[3].Descriptor()
+	// pinnedbuild.DefaultNodeID holds the default value on creation for the node_id field.
+	pinnedbuild.DefaultNodeID = pinnedbuildDescNodeID.Default.(string)
+	// pinnedbuildDescID is the schema descriptor for id field.
+	pinnedbuildDescID := pinnedbuildFields[0].Descriptor()
+	// pinnedbuild.DefaultID holds the default value on creation for the id field.
+	pinnedbuild.DefaultID = pinnedbuildDescID.Default.(func() uuid.UUID)
+	sandboxFields := schema.Sandbox{}.Fields()
+	_ = sandboxFields
+	// sandboxDescCreatedAt is the schema descriptor for created_at field.
+	sandboxDescCreatedAt := sandboxFields[1].Descriptor()
+	// sandbox.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sandbox.DefaultCreatedAt = sandboxDescCreatedAt.Default.(func() time.Time)
+	// sandboxDescUpdatedAt is the schema descriptor for updated_at field.
+	sandboxDescUpdatedAt := sandboxFields[2].Descriptor()
+	// sandbox.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sandbox.DefaultUpdatedAt = sandboxDescUpdatedAt.Default.(func() time.Time)
+	// sandbox.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sandbox.UpdateDefaultUpdatedAt = sandboxDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sandboxstartFields := schema.SandboxStart{}.Fields()
+	_ = sandboxstartFields
+	// sandboxstartDescCreatedAt is the schema descriptor for created_at field.
+	sandboxstartDescCreatedAt := sandboxstartFields[0].Descriptor()
+	// sandboxstart.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sandboxstart.DefaultCreatedAt = sandboxstartDescCreatedAt.Default.(func() time.Time)
 	snapshotFields := schema.Snapshot{}.Fields()
 	_ = snapshotFields
 	// snapshotDescCreatedAt is the schema descriptor for created_at field.
@@ -78,6 +171,14 @@ func init() {
 	teamDescEmail := teamFields[7].Descriptor()
 	// team.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	team.EmailValidator = teamDescEmail.Validators[0].(func(string) error)
+	// teamDescEncryptSnapshots is the schema descriptor for encrypt_snapshots field.
+	teamDescEncryptSnapshots := teamFields[8].Descriptor()
+	// team.DefaultEncryptSnapshots holds the default value on creation for the encrypt_snapshots field.
+	team.DefaultEncryptSnapshots = teamDescEncryptSnapshots.Default.(bool)
+	// teamDescBuildLogRetentionDays is the schema descriptor for build_log_retention_days field.
+	teamDescBuildLogRetentionDays := teamFields[10].Descriptor()
+	// team.DefaultBuildLogRetentionDays holds the default value on creation for the build_log_retention_days field.
+	team.DefaultBuildLogRetentionDays = teamDescBuildLogRetentionDays.Default.(int32)
 	teamapikeyFields := schema.TeamAPIKey{}.Fields()
 	_ = teamapikeyFields
 	// teamapikeyDescCreatedAt is the schema descriptor for created_at field.
@@ -88,6 +189,138 @@ func init() {
 	teamapikeyDescName := teamapikeyFields[5].Descriptor()
 	// teamapikey.DefaultName holds the default value on creation for the name field.
 	teamapikey.DefaultName = teamapikeyDescName.Default.(string)
+	teambudgetFields := schema.TeamBudget{}.Fields()
+	_ = teambudgetFields
+	// teambudgetDescCreatedAt is the schema descriptor for created_at field.
+	teambudgetDescCreatedAt := teambudgetFields[1].Descriptor()
+	// teambudget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teambudget.DefaultCreatedAt = teambudgetDescCreatedAt.Default.(func() time.Time)
+	// teambudgetDescUpdatedAt is the schema descriptor for updated_at field.
+	teambudgetDescUpdatedAt := teambudgetFields[2].Descriptor()
+	// teambudget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teambudget.DefaultUpdatedAt = teambudgetDescUpdatedAt.Default.(func() time.Time)
+	// teambudget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teambudget.UpdateDefaultUpdatedAt = teambudgetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// teambudgetDescWarningPercent is the schema descriptor for warning_percent field.
+	teambudgetDescWarningPercent := teambudgetFields[5].Descriptor()
+	// teambudget.DefaultWarningPercent holds the default value on creation for the warning_percent field.
+	teambudget.DefaultWarningPercent = teambudgetDescWarningPercent.Default.(int32)
+	// teambudgetDescPauseSandboxes is the schema descriptor for pause_sandboxes field.
+	teambudgetDescPauseSandboxes := teambudgetFields[6].Descriptor()
+	// teambudget.DefaultPauseSandboxes holds the default value on creation for the pause_sandboxes field.
+	teambudget.DefaultPauseSandboxes = teambudgetDescPauseSandboxes.Default.(bool)
+	// teambudgetDescAccruedUsd is the schema descriptor for accrued_usd field.
+	teambudgetDescAccruedUsd := teambudgetFields[8].Descriptor()
+	// teambudget.DefaultAccruedUsd holds the default value on creation for the accrued_usd field.
+	teambudget.DefaultAccruedUsd = teambudgetDescAccruedUsd.Default.(float64)
+	// teambudgetDescID is the schema descriptor for id field.
+	teambudgetDescID := teambudgetFields[0].Descriptor()
+	// teambudget.DefaultID holds the default value on creation for the id field.
+	teambudget.DefaultID = teambudgetDescID.Default.(func() uuid.UUID)
+	teamregistrycredentialFields := schema.TeamRegistryCredential{}.Fields()
+	_ = teamregistrycredentialFields
+	// teamregistrycredentialDescCreatedAt is the schema descriptor for created_at field.
+	teamregistrycredentialDescCreatedAt := teamregistrycredentialFields[1].Descriptor()
+	// teamregistrycredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teamregistrycredential.DefaultCreatedAt = teamregistrycredentialDescCreatedAt.Default.(func() time.Time)
+	// teamregistrycredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	teamregistrycredentialDescUpdatedAt := teamregistrycredentialFields[2].Descriptor()
+	// teamregistrycredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teamregistrycredential.DefaultUpdatedAt = teamregistrycredentialDescUpdatedAt.Default.(func() time.Time)
+	// teamregistrycredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teamregistrycredential.UpdateDefaultUpdatedAt = teamregistrycredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// teamregistrycredentialDescVersion is the schema descriptor for version field.
+	teamregistrycredentialDescVersion := teamregistrycredentialFields[6].Descriptor()
+	// teamregistrycredential.DefaultVersion holds the default value on creation for the version field.
+	teamregistrycredential.DefaultVersion = teamregistrycredentialDescVersion.Default.(int32)
+	// teamregistrycredentialDescID is the schema descriptor for id field.
+	teamregistrycredentialDescID := teamregistrycredentialFields[0].Descriptor()
+	// teamregistrycredential.DefaultID holds the default value on creation for the id field.
+	teamregistrycredential.DefaultID = teamregistrycredentialDescID.Default.(func() uuid.UUID)
+	teamsecretFields := schema.TeamSecret{}.Fields()
+	_ = teamsecretFields
+	// teamsecretDescCreatedAt is the schema descriptor for created_at field.
+	teamsecretDescCreatedAt := teamsecretFields[1].Descriptor()
+	// teamsecret.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teamsecret.DefaultCreatedAt = teamsecretDescCreatedAt.Default.(func() time.Time)
+	// teamsecretDescUpdatedAt is the schema descriptor for updated_at field.
+	teamsecretDescUpdatedAt := teamsecretFields[2].Descriptor()
+	// teamsecret.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teamsecret.DefaultUpdatedAt = teamsecretDescUpdatedAt.Default.(func() time.Time)
+	// teamsecret.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teamsecret.UpdateDefaultUpdatedAt = teamsecretDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// teamsecretDescLatestVersion is the schema descriptor for latest_version field.
+	teamsecretDescLatestVersion := teamsecretFields[5].Descriptor()
+	// teamsecret.DefaultLatestVersion holds the default value on creation for the latest_version field.
+	teamsecret.DefaultLatestVersion = teamsecretDescLatestVersion.Default.(int32)
+	// teamsecretDescID is the schema descriptor for id field.
+	teamsecretDescID := teamsecretFields[0].Descriptor()
+	// teamsecret.DefaultID holds the default value on creation for the id field.
+	teamsecret.DefaultID = teamsecretDescID.Default.(func() uuid.UUID)
+	teamsecretversionFields := schema.TeamSecretVersion{}.Fields()
+	_ = teamsecretversionFields
+	// teamsecretversionDescCreatedAt is the schema descriptor for created_at field.
+	teamsecretversionDescCreatedAt := teamsecretversionFields[1].Descriptor()
+	// teamsecretversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teamsecretversion.DefaultCreatedAt = teamsecretversionDescCreatedAt.Default.(func() time.Time)
+	// teamsecretversionDescID is the schema descriptor for id field.
+	teamsecretversionDescID := teamsecretversionFields[0].Descriptor()
+	// teamsecretversion.DefaultID holds the default value on creation for the id field.
+	teamsecretversion.DefaultID = teamsecretversionDescID.Default.(func() uuid.UUID)
+	teamtemplatestorageFields := schema.TeamTemplateStorage{}.Fields()
+	_ = teamtemplatestorageFields
+	// teamtemplatestorageDescTemplateBytes is the schema descriptor for template_bytes field.
+	teamtemplatestorageDescTemplateBytes := teamtemplatestorageFields[2].Descriptor()
+	// teamtemplatestorage.DefaultTemplateBytes holds the default value on creation for the template_bytes field.
+	teamtemplatestorage.DefaultTemplateBytes = teamtemplatestorageDescTemplateBytes.Default.(int64)
+	// teamtemplatestorageDescSnapshotBytes is the schema descriptor for snapshot_bytes field.
+	teamtemplatestorageDescSnapshotBytes := teamtemplatestorageFields[3].Descriptor()
+	// teamtemplatestorage.DefaultSnapshotBytes holds the default value on creation for the snapshot_bytes field.
+	teamtemplatestorage.DefaultSnapshotBytes = teamtemplatestorageDescSnapshotBytes.Default.(int64)
+	// teamtemplatestorageDescUpdatedAt is the schema descriptor for updated_at field.
+	teamtemplatestorageDescUpdatedAt := teamtemplatestorageFields[4].Descriptor()
+	// teamtemplatestorage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teamtemplatestorage.DefaultUpdatedAt = teamtemplatestorageDescUpdatedAt.Default.(func() time.Time)
+	// teamtemplatestorage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teamtemplatestorage.UpdateDefaultUpdatedAt = teamtemplatestorageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	teamusagedailyFields := schema.TeamUsageDaily{}.Fields()
+	_ = teamusagedailyFields
+	// teamusagedailyDescSandboxesStarted is the schema descriptor for sandboxes_started field.
+	teamusagedailyDescSandboxesStarted := teamusagedailyFields[2].Descriptor()
+	// teamusagedaily.DefaultSandboxesStarted holds the default value on creation for the sandboxes_started field.
+	teamusagedaily.DefaultSandboxesStarted = teamusagedailyDescSandboxesStarted.Default.(int64)
+	// teamusagedailyDescSandboxesResumed is the schema descriptor for sandboxes_resumed field.
+	teamusagedailyDescSandboxesResumed := teamusagedailyFields[3].Descriptor()
+	// teamusagedaily.DefaultSandboxesResumed holds the default value on creation for the sandboxes_resumed field.
+	teamusagedaily.DefaultSandboxesResumed = teamusagedailyDescSandboxesResumed.Default.(int64)
+	// teamusagedailyDescBuilds is the schema descriptor for builds field.
+	teamusagedailyDescBuilds := teamusagedailyFields[5].Descriptor()
+	// teamusagedaily.DefaultBuilds holds the default value on creation for the builds field.
+	teamusagedaily.DefaultBuilds = teamusagedailyDescBuilds.Default.(int64)
+	// teamusagedailyDescBuildSeconds is the schema descriptor for build_seconds field.
+	teamusagedailyDescBuildSeconds := teamusagedailyFields[6].Descriptor()
+	// teamusagedaily.DefaultBuildSeconds holds the default value on creation for the build_seconds field.
+	teamusagedaily.DefaultBuildSeconds = teamusagedailyDescBuildSeconds.Default.(int64)
+	// teamusagedailyDescUpdatedAt is the schema descriptor for updated_at field.
+	teamusagedailyDescUpdatedAt := teamusagedailyFields[7].Descriptor()
+	// teamusagedaily.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teamusagedaily.DefaultUpdatedAt = teamusagedailyDescUpdatedAt.Default.(func() time.Time)
+	// teamusagedaily.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teamusagedaily.UpdateDefaultUpdatedAt = teamusagedailyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tierFields := schema.Tier{}.Fields()
+	_ = tierFields
+	// tierDescVcpuHourPriceUsd is the schema descriptor for vcpu_hour_price_usd field.
+	tierDescVcpuHourPriceUsd := tierFields[5].Descriptor()
+	// tier.DefaultVcpuHourPriceUsd holds the default value on creation for the vcpu_hour_price_usd field.
+	tier.DefaultVcpuHourPriceUsd = tierDescVcpuHourPriceUsd.Default.(float64)
+	// tierDescRAMGibHourPriceUsd is the schema descriptor for ram_gib_hour_price_usd field.
+	tierDescRAMGibHourPriceUsd := tierFields[6].Descriptor()
+	// tier.DefaultRAMGibHourPriceUsd holds the default value on creation for the ram_gib_hour_price_usd field.
+	tier.DefaultRAMGibHourPriceUsd = tierDescRAMGibHourPriceUsd.Default.(float64)
+	// tierDescHighPriority is the schema descriptor for high_priority field.
+	tierDescHighPriority := tierFields[7].Descriptor()
+	// tier.DefaultHighPriority holds the default value on creation for the high_priority field.
+	tier.DefaultHighPriority = tierDescHighPriority.Default.(bool)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
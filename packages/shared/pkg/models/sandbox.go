@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/google/uuid"
+)
+
+// Sandbox is the model entity for the Sandbox schema.
+type Sandbox struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// EnvID holds the value of the "env_id" field.
+	EnvID string `json:"env_id,omitempty"`
+	// BuildID holds the value of the "build_id" field.
+	BuildID uuid.UUID `json:"build_id,omitempty"`
+	// Alias holds the value of the "alias" field.
+	Alias *string `json:"alias,omitempty"`
+	// NodeID holds the value of the "node_id" field.
+	NodeID string `json:"node_id,omitempty"`
+	// ClusterID holds the value of the "cluster_id" field.
+	ClusterID string `json:"cluster_id,omitempty"`
+	// State holds the value of the "state" field.
+	State sandbox.State `json:"state,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndAt holds the value of the "end_at" field.
+	EndAt time.Time `json:"end_at,omitempty"`
+	// Vcpu holds the value of the "vcpu" field.
+	Vcpu int64 `json:"vcpu,omitempty"`
+	// RAMMB holds the value of the "ram_mb" field.
+	RAMMB int64 `json:"ram_mb,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels       map[string]string `json:"labels,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Sandbox) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sandbox.FieldMetadata, sandbox.FieldLabels:
+			values[i] = new([]byte)
+		case sandbox.FieldVcpu, sandbox.FieldRAMMB:
+			values[i] = new(sql.NullInt64)
+		case sandbox.FieldID, sandbox.FieldEnvID, sandbox.FieldAlias, sandbox.FieldNodeID, sandbox.FieldClusterID, sandbox.FieldState:
+			values[i] = new(sql.NullString)
+		case sandbox.FieldCreatedAt, sandbox.FieldUpdatedAt, sandbox.FieldStartedAt, sandbox.FieldEndAt:
+			values[i] = new(sql.NullTime)
+		case sandbox.FieldTeamID, sandbox.FieldBuildID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Sandbox fields.
+func (s *Sandbox) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sandbox.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				s.ID = value.String
+			}
+		case sandbox.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case sandbox.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case sandbox.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				s.TeamID = *value
+			}
+		case sandbox.FieldEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				s.EnvID = value.String
+			}
+		case sandbox.FieldBuildID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field build_id", values[i])
+			} else if value != nil {
+				s.BuildID = *value
+			}
+		case sandbox.FieldAlias:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alias", values[i])
+			} else if value.Valid {
+				s.Alias = new(string)
+				*s.Alias = value.String
+			}
+		case sandbox.FieldNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				s.NodeID = value.String
+			}
+		case sandbox.FieldClusterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_id", values[i])
+			} else if value.Valid {
+				s.ClusterID = value.String
+			}
+		case sandbox.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				s.State = sandbox.State(value.String)
+			}
+		case sandbox.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				s.StartedAt = value.Time
+			}
+		case sandbox.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				s.EndAt = value.Time
+			}
+		case sandbox.FieldVcpu:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vcpu", values[i])
+			} else if value.Valid {
+				s.Vcpu = value.Int64
+			}
+		case sandbox.FieldRAMMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ram_mb", values[i])
+			} else if value.Valid {
+				s.RAMMB = value.Int64
+			}
+		case sandbox.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case sandbox.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Sandbox.
+// This includes values selected through modifiers, order, etc.
+func (s *Sandbox) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Sandbox.
+// Note that you need to call Sandbox.Unwrap() before calling this method if this Sandbox
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Sandbox) Update() *SandboxUpdateOne {
+	return NewSandboxClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Sandbox entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Sandbox) Unwrap() *Sandbox {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("models: Sandbox is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Sandbox) String() string {
+	var builder strings.Builder
+	builder.WriteString("Sandbox(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(s.EnvID)
+	builder.WriteString(", ")
+	builder.WriteString("build_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.BuildID))
+	builder.WriteString(", ")
+	if v := s.Alias; v != nil {
+		builder.WriteString("alias=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("node_id=")
+	builder.WriteString(s.NodeID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_id=")
+	builder.WriteString(s.ClusterID)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", s.State))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(s.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(s.EndAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("vcpu=")
+	builder.WriteString(fmt.Sprintf("%v", s.Vcpu))
+	builder.WriteString(", ")
+	builder.WriteString("ram_mb=")
+	builder.WriteString(fmt.Sprintf("%v", s.RAMMB))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", s.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", s.Labels))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Sandboxes is a parsable slice of Sandbox.
+type Sandboxes []*Sandbox
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sandbox
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the sandbox type in the database.
+	Label = "sandbox"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldBuildID holds the string denoting the build_id field in the database.
+	FieldBuildID = "build_id"
+	// FieldAlias holds the string denoting the alias field in the database.
+	FieldAlias = "alias"
+	// FieldNodeID holds the string denoting the node_id field in the database.
+	FieldNodeID = "node_id"
+	// FieldClusterID holds the string denoting the cluster_id field in the database.
+	FieldClusterID = "cluster_id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndAt holds the string denoting the end_at field in the database.
+	FieldEndAt = "end_at"
+	// FieldVcpu holds the string denoting the vcpu field in the database.
+	FieldVcpu = "vcpu"
+	// FieldRAMMB holds the string denoting the ram_mb field in the database.
+	FieldRAMMB = "ram_mb"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// Table holds the table name of the sandbox in the database.
+	Table = "sandboxes"
+)
+
+// Columns holds all SQL columns for sandbox fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldTeamID,
+	FieldEnvID,
+	FieldBuildID,
+	FieldAlias,
+	FieldNodeID,
+	FieldClusterID,
+	FieldState,
+	FieldStartedAt,
+	FieldEndAt,
+	FieldVcpu,
+	FieldRAMMB,
+	FieldMetadata,
+	FieldLabels,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// State defines the type for the "state" enum field.
+type State string
+
+// State values.
+const (
+	StateCreating State = "creating"
+	StateRunning  State = "running"
+	StateLost     State = "lost"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StateCreating, StateRunning, StateLost:
+		return nil
+	default:
+		return fmt.Errorf("sandbox: invalid enum value for state field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Sandbox queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// ByBuildID orders the results by the build_id field.
+func ByBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildID, opts...).ToFunc()
+}
+
+// ByAlias orders the results by the alias field.
+func ByAlias(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlias, opts...).ToFunc()
+}
+
+// ByNodeID orders the results by the node_id field.
+func ByNodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodeID, opts...).ToFunc()
+}
+
+// ByClusterID orders the results by the cluster_id field.
+func ByClusterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterID, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByEndAt orders the results by the end_at field.
+func ByEndAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndAt, opts...).ToFunc()
+}
+
+// ByVcpu orders the results by the vcpu field.
+func ByVcpu(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVcpu, opts...).ToFunc()
+}
+
+// ByRAMMB orders the results by the ram_mb field.
+func ByRAMMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRAMMB, opts...).ToFunc()
+}
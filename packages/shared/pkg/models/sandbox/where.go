@@ -0,0 +1,791 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sandbox
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldTeamID, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldEnvID, v))
+}
+
+// BuildID applies equality check predicate on the "build_id" field. It's identical to BuildIDEQ.
+func BuildID(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldBuildID, v))
+}
+
+// Alias applies equality check predicate on the "alias" field. It's identical to AliasEQ.
+func Alias(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldAlias, v))
+}
+
+// NodeID applies equality check predicate on the "node_id" field. It's identical to NodeIDEQ.
+func NodeID(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldNodeID, v))
+}
+
+// ClusterID applies equality check predicate on the "cluster_id" field. It's identical to ClusterIDEQ.
+func ClusterID(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldClusterID, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndAt applies equality check predicate on the "end_at" field. It's identical to EndAtEQ.
+func EndAt(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldEndAt, v))
+}
+
+// Vcpu applies equality check predicate on the "vcpu" field. It's identical to VcpuEQ.
+func Vcpu(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldVcpu, v))
+}
+
+// RAMMB applies equality check predicate on the "ram_mb" field. It's identical to RAMMBEQ.
+func RAMMB(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldRAMMB, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldTeamID, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// EnvIDGT applies the GT predicate on the "env_id" field.
+func EnvIDGT(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldEnvID, v))
+}
+
+// EnvIDGTE applies the GTE predicate on the "env_id" field.
+func EnvIDGTE(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldEnvID, v))
+}
+
+// EnvIDLT applies the LT predicate on the "env_id" field.
+func EnvIDLT(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldEnvID, v))
+}
+
+// EnvIDLTE applies the LTE predicate on the "env_id" field.
+func EnvIDLTE(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldEnvID, v))
+}
+
+// EnvIDContains applies the Contains predicate on the "env_id" field.
+func EnvIDContains(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldContains(FieldEnvID, v))
+}
+
+// EnvIDHasPrefix applies the HasPrefix predicate on the "env_id" field.
+func EnvIDHasPrefix(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldHasPrefix(FieldEnvID, v))
+}
+
+// EnvIDHasSuffix applies the HasSuffix predicate on the "env_id" field.
+func EnvIDHasSuffix(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldHasSuffix(FieldEnvID, v))
+}
+
+// EnvIDEqualFold applies the EqualFold predicate on the "env_id" field.
+func EnvIDEqualFold(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEqualFold(FieldEnvID, v))
+}
+
+// EnvIDContainsFold applies the ContainsFold predicate on the "env_id" field.
+func EnvIDContainsFold(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldContainsFold(FieldEnvID, v))
+}
+
+// BuildIDEQ applies the EQ predicate on the "build_id" field.
+func BuildIDEQ(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldBuildID, v))
+}
+
+// BuildIDNEQ applies the NEQ predicate on the "build_id" field.
+func BuildIDNEQ(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldBuildID, v))
+}
+
+// BuildIDIn applies the In predicate on the "build_id" field.
+func BuildIDIn(vs ...uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldBuildID, vs...))
+}
+
+// BuildIDNotIn applies the NotIn predicate on the "build_id" field.
+func BuildIDNotIn(vs ...uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldBuildID, vs...))
+}
+
+// BuildIDGT applies the GT predicate on the "build_id" field.
+func BuildIDGT(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldBuildID, v))
+}
+
+// BuildIDGTE applies the GTE predicate on the "build_id" field.
+func BuildIDGTE(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldBuildID, v))
+}
+
+// BuildIDLT applies the LT predicate on the "build_id" field.
+func BuildIDLT(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldBuildID, v))
+}
+
+// BuildIDLTE applies the LTE predicate on the "build_id" field.
+func BuildIDLTE(v uuid.UUID) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldBuildID, v))
+}
+
+// AliasEQ applies the EQ predicate on the "alias" field.
+func AliasEQ(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldAlias, v))
+}
+
+// AliasNEQ applies the NEQ predicate on the "alias" field.
+func AliasNEQ(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldAlias, v))
+}
+
+// AliasIn applies the In predicate on the "alias" field.
+func AliasIn(vs ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldAlias, vs...))
+}
+
+// AliasNotIn applies the NotIn predicate on the "alias" field.
+func AliasNotIn(vs ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldAlias, vs...))
+}
+
+// AliasGT applies the GT predicate on the "alias" field.
+func AliasGT(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldAlias, v))
+}
+
+// AliasGTE applies the GTE predicate on the "alias" field.
+func AliasGTE(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldAlias, v))
+}
+
+// AliasLT applies the LT predicate on the "alias" field.
+func AliasLT(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldAlias, v))
+}
+
+// AliasLTE applies the LTE predicate on the "alias" field.
+func AliasLTE(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldAlias, v))
+}
+
+// AliasContains applies the Contains predicate on the "alias" field.
+func AliasContains(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldContains(FieldAlias, v))
+}
+
+// AliasHasPrefix applies the HasPrefix predicate on the "alias" field.
+func AliasHasPrefix(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldHasPrefix(FieldAlias, v))
+}
+
+// AliasHasSuffix applies the HasSuffix predicate on the "alias" field.
+func AliasHasSuffix(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldHasSuffix(FieldAlias, v))
+}
+
+// AliasIsNil applies the IsNil predicate on the "alias" field.
+func AliasIsNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIsNull(FieldAlias))
+}
+
+// AliasNotNil applies the NotNil predicate on the "alias" field.
+func AliasNotNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotNull(FieldAlias))
+}
+
+// AliasEqualFold applies the EqualFold predicate on the "alias" field.
+func AliasEqualFold(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEqualFold(FieldAlias, v))
+}
+
+// AliasContainsFold applies the ContainsFold predicate on the "alias" field.
+func AliasContainsFold(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldContainsFold(FieldAlias, v))
+}
+
+// NodeIDEQ applies the EQ predicate on the "node_id" field.
+func NodeIDEQ(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldNodeID, v))
+}
+
+// NodeIDNEQ applies the NEQ predicate on the "node_id" field.
+func NodeIDNEQ(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldNodeID, v))
+}
+
+// NodeIDIn applies the In predicate on the "node_id" field.
+func NodeIDIn(vs ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldNodeID, vs...))
+}
+
+// NodeIDNotIn applies the NotIn predicate on the "node_id" field.
+func NodeIDNotIn(vs ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldNodeID, vs...))
+}
+
+// NodeIDGT applies the GT predicate on the "node_id" field.
+func NodeIDGT(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldNodeID, v))
+}
+
+// NodeIDGTE applies the GTE predicate on the "node_id" field.
+func NodeIDGTE(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldNodeID, v))
+}
+
+// NodeIDLT applies the LT predicate on the "node_id" field.
+func NodeIDLT(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldNodeID, v))
+}
+
+// NodeIDLTE applies the LTE predicate on the "node_id" field.
+func NodeIDLTE(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldNodeID, v))
+}
+
+// NodeIDContains applies the Contains predicate on the "node_id" field.
+func NodeIDContains(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldContains(FieldNodeID, v))
+}
+
+// NodeIDHasPrefix applies the HasPrefix predicate on the "node_id" field.
+func NodeIDHasPrefix(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldHasPrefix(FieldNodeID, v))
+}
+
+// NodeIDHasSuffix applies the HasSuffix predicate on the "node_id" field.
+func NodeIDHasSuffix(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldHasSuffix(FieldNodeID, v))
+}
+
+// NodeIDIsNil applies the IsNil predicate on the "node_id" field.
+func NodeIDIsNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIsNull(FieldNodeID))
+}
+
+// NodeIDNotNil applies the NotNil predicate on the "node_id" field.
+func NodeIDNotNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotNull(FieldNodeID))
+}
+
+// NodeIDEqualFold applies the EqualFold predicate on the "node_id" field.
+func NodeIDEqualFold(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEqualFold(FieldNodeID, v))
+}
+
+// NodeIDContainsFold applies the ContainsFold predicate on the "node_id" field.
+func NodeIDContainsFold(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldContainsFold(FieldNodeID, v))
+}
+
+// ClusterIDEQ applies the EQ predicate on the "cluster_id" field.
+func ClusterIDEQ(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldClusterID, v))
+}
+
+// ClusterIDNEQ applies the NEQ predicate on the "cluster_id" field.
+func ClusterIDNEQ(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldClusterID, v))
+}
+
+// ClusterIDIn applies the In predicate on the "cluster_id" field.
+func ClusterIDIn(vs ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldClusterID, vs...))
+}
+
+// ClusterIDNotIn applies the NotIn predicate on the "cluster_id" field.
+func ClusterIDNotIn(vs ...string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldClusterID, vs...))
+}
+
+// ClusterIDGT applies the GT predicate on the "cluster_id" field.
+func ClusterIDGT(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldClusterID, v))
+}
+
+// ClusterIDGTE applies the GTE predicate on the "cluster_id" field.
+func ClusterIDGTE(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldClusterID, v))
+}
+
+// ClusterIDLT applies the LT predicate on the "cluster_id" field.
+func ClusterIDLT(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldClusterID, v))
+}
+
+// ClusterIDLTE applies the LTE predicate on the "cluster_id" field.
+func ClusterIDLTE(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldClusterID, v))
+}
+
+// ClusterIDContains applies the Contains predicate on the "cluster_id" field.
+func ClusterIDContains(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldContains(FieldClusterID, v))
+}
+
+// ClusterIDHasPrefix applies the HasPrefix predicate on the "cluster_id" field.
+func ClusterIDHasPrefix(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldHasPrefix(FieldClusterID, v))
+}
+
+// ClusterIDHasSuffix applies the HasSuffix predicate on the "cluster_id" field.
+func ClusterIDHasSuffix(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldHasSuffix(FieldClusterID, v))
+}
+
+// ClusterIDIsNil applies the IsNil predicate on the "cluster_id" field.
+func ClusterIDIsNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIsNull(FieldClusterID))
+}
+
+// ClusterIDNotNil applies the NotNil predicate on the "cluster_id" field.
+func ClusterIDNotNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotNull(FieldClusterID))
+}
+
+// ClusterIDEqualFold applies the EqualFold predicate on the "cluster_id" field.
+func ClusterIDEqualFold(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEqualFold(FieldClusterID, v))
+}
+
+// ClusterIDContainsFold applies the ContainsFold predicate on the "cluster_id" field.
+func ClusterIDContainsFold(v string) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldContainsFold(FieldClusterID, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// EndAtEQ applies the EQ predicate on the "end_at" field.
+func EndAtEQ(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldEndAt, v))
+}
+
+// EndAtNEQ applies the NEQ predicate on the "end_at" field.
+func EndAtNEQ(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldEndAt, v))
+}
+
+// EndAtIn applies the In predicate on the "end_at" field.
+func EndAtIn(vs ...time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldEndAt, vs...))
+}
+
+// EndAtNotIn applies the NotIn predicate on the "end_at" field.
+func EndAtNotIn(vs ...time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldEndAt, vs...))
+}
+
+// EndAtGT applies the GT predicate on the "end_at" field.
+func EndAtGT(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldEndAt, v))
+}
+
+// EndAtGTE applies the GTE predicate on the "end_at" field.
+func EndAtGTE(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldEndAt, v))
+}
+
+// EndAtLT applies the LT predicate on the "end_at" field.
+func EndAtLT(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldEndAt, v))
+}
+
+// EndAtLTE applies the LTE predicate on the "end_at" field.
+func EndAtLTE(v time.Time) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldEndAt, v))
+}
+
+// VcpuEQ applies the EQ predicate on the "vcpu" field.
+func VcpuEQ(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldVcpu, v))
+}
+
+// VcpuNEQ applies the NEQ predicate on the "vcpu" field.
+func VcpuNEQ(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldVcpu, v))
+}
+
+// VcpuIn applies the In predicate on the "vcpu" field.
+func VcpuIn(vs ...int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldVcpu, vs...))
+}
+
+// VcpuNotIn applies the NotIn predicate on the "vcpu" field.
+func VcpuNotIn(vs ...int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldVcpu, vs...))
+}
+
+// VcpuGT applies the GT predicate on the "vcpu" field.
+func VcpuGT(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldVcpu, v))
+}
+
+// VcpuGTE applies the GTE predicate on the "vcpu" field.
+func VcpuGTE(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldVcpu, v))
+}
+
+// VcpuLT applies the LT predicate on the "vcpu" field.
+func VcpuLT(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldVcpu, v))
+}
+
+// VcpuLTE applies the LTE predicate on the "vcpu" field.
+func VcpuLTE(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldVcpu, v))
+}
+
+// RAMMBEQ applies the EQ predicate on the "ram_mb" field.
+func RAMMBEQ(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldEQ(FieldRAMMB, v))
+}
+
+// RAMMBNEQ applies the NEQ predicate on the "ram_mb" field.
+func RAMMBNEQ(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNEQ(FieldRAMMB, v))
+}
+
+// RAMMBIn applies the In predicate on the "ram_mb" field.
+func RAMMBIn(vs ...int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIn(FieldRAMMB, vs...))
+}
+
+// RAMMBNotIn applies the NotIn predicate on the "ram_mb" field.
+func RAMMBNotIn(vs ...int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotIn(FieldRAMMB, vs...))
+}
+
+// RAMMBGT applies the GT predicate on the "ram_mb" field.
+func RAMMBGT(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGT(FieldRAMMB, v))
+}
+
+// RAMMBGTE applies the GTE predicate on the "ram_mb" field.
+func RAMMBGTE(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldGTE(FieldRAMMB, v))
+}
+
+// RAMMBLT applies the LT predicate on the "ram_mb" field.
+func RAMMBLT(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLT(FieldRAMMB, v))
+}
+
+// RAMMBLTE applies the LTE predicate on the "ram_mb" field.
+func RAMMBLTE(v int64) predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldLTE(FieldRAMMB, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotNull(FieldMetadata))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Sandbox {
+	return predicate.Sandbox(sql.FieldNotNull(FieldLabels))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Sandbox) predicate.Sandbox {
+	return predicate.Sandbox(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Sandbox) predicate.Sandbox {
+	return predicate.Sandbox(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Sandbox) predicate.Sandbox {
+	return predicate.Sandbox(sql.NotPredicates(p))
+}
@@ -0,0 +1,1384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandbox"
+	"github.com/google/uuid"
+)
+
+// SandboxCreate is the builder for creating a Sandbox entity.
+type SandboxCreate struct {
+	config
+	mutation *SandboxMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SandboxCreate) SetCreatedAt(t time.Time) *SandboxCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SandboxCreate) SetNillableCreatedAt(t *time.Time) *SandboxCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SandboxCreate) SetUpdatedAt(t time.Time) *SandboxCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SandboxCreate) SetNillableUpdatedAt(t *time.Time) *SandboxCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetTeamID sets the "team_id" field.
+func (sc *SandboxCreate) SetTeamID(u uuid.UUID) *SandboxCreate {
+	sc.mutation.SetTeamID(u)
+	return sc
+}
+
+// SetEnvID sets the "env_id" field.
+func (sc *SandboxCreate) SetEnvID(s string) *SandboxCreate {
+	sc.mutation.SetEnvID(s)
+	return sc
+}
+
+// SetBuildID sets the "build_id" field.
+func (sc *SandboxCreate) SetBuildID(u uuid.UUID) *SandboxCreate {
+	sc.mutation.SetBuildID(u)
+	return sc
+}
+
+// SetAlias sets the "alias" field.
+func (sc *SandboxCreate) SetAlias(s string) *SandboxCreate {
+	sc.mutation.SetAlias(s)
+	return sc
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (sc *SandboxCreate) SetNillableAlias(s *string) *SandboxCreate {
+	if s != nil {
+		sc.SetAlias(*s)
+	}
+	return sc
+}
+
+// SetNodeID sets the "node_id" field.
+func (sc *SandboxCreate) SetNodeID(s string) *SandboxCreate {
+	sc.mutation.SetNodeID(s)
+	return sc
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (sc *SandboxCreate) SetNillableNodeID(s *string) *SandboxCreate {
+	if s != nil {
+		sc.SetNodeID(*s)
+	}
+	return sc
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (sc *SandboxCreate) SetClusterID(s string) *SandboxCreate {
+	sc.mutation.SetClusterID(s)
+	return sc
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (sc *SandboxCreate) SetNillableClusterID(s *string) *SandboxCreate {
+	if s != nil {
+		sc.SetClusterID(*s)
+	}
+	return sc
+}
+
+// SetState sets the "state" field.
+func (sc *SandboxCreate) SetState(s sandbox.State) *SandboxCreate {
+	sc.mutation.SetState(s)
+	return sc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sc *SandboxCreate) SetStartedAt(t time.Time) *SandboxCreate {
+	sc.mutation.SetStartedAt(t)
+	return sc
+}
+
+// SetEndAt sets the "end_at" field.
+func (sc *SandboxCreate) SetEndAt(t time.Time) *SandboxCreate {
+	sc.mutation.SetEndAt(t)
+	return sc
+}
+
+// SetVcpu sets the "vcpu" field.
+func (sc *SandboxCreate) SetVcpu(i int64) *SandboxCreate {
+	sc.mutation.SetVcpu(i)
+	return sc
+}
+
+// SetRAMMB sets the "ram_mb" field.
+func (sc *SandboxCreate) SetRAMMB(i int64) *SandboxCreate {
+	sc.mutation.SetRAMMB(i)
+	return sc
+}
+
+// SetMetadata sets the "metadata" field.
+func (sc *SandboxCreate) SetMetadata(m map[string]string) *SandboxCreate {
+	sc.mutation.SetMetadata(m)
+	return sc
+}
+
+// SetLabels sets the "labels" field.
+func (sc *SandboxCreate) SetLabels(m map[string]string) *SandboxCreate {
+	sc.mutation.SetLabels(m)
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SandboxCreate) SetID(s string) *SandboxCreate {
+	sc.mutation.SetID(s)
+	return sc
+}
+
+// Mutation returns the SandboxMutation object of the builder.
+func (sc *SandboxCreate) Mutation() *SandboxMutation {
+	return sc.mutation
+}
+
+// Save creates the Sandbox in the database.
+func (sc *SandboxCreate) Save(ctx context.Context) (*Sandbox, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SandboxCreate) SaveX(ctx context.Context) *Sandbox {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SandboxCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SandboxCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SandboxCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := sandbox.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := sandbox.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SandboxCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "Sandbox.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`models: missing required field "Sandbox.updated_at"`)}
+	}
+	if _, ok := sc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "Sandbox.team_id"`)}
+	}
+	if _, ok := sc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`models: missing required field "Sandbox.env_id"`)}
+	}
+	if _, ok := sc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build_id", err: errors.New(`models: missing required field "Sandbox.build_id"`)}
+	}
+	if _, ok := sc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`models: missing required field "Sandbox.state"`)}
+	}
+	if v, ok := sc.mutation.State(); ok {
+		if err := sandbox.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`models: validator failed for field "Sandbox.state": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`models: missing required field "Sandbox.started_at"`)}
+	}
+	if _, ok := sc.mutation.EndAt(); !ok {
+		return &ValidationError{Name: "end_at", err: errors.New(`models: missing required field "Sandbox.end_at"`)}
+	}
+	if _, ok := sc.mutation.Vcpu(); !ok {
+		return &ValidationError{Name: "vcpu", err: errors.New(`models: missing required field "Sandbox.vcpu"`)}
+	}
+	if _, ok := sc.mutation.RAMMB(); !ok {
+		return &ValidationError{Name: "ram_mb", err: errors.New(`models: missing required field "Sandbox.ram_mb"`)}
+	}
+	return nil
+}
+
+func (sc *SandboxCreate) sqlSave(ctx context.Context) (*Sandbox, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Sandbox.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SandboxCreate) createSpec() (*Sandbox, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Sandbox{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(sandbox.Table, sqlgraph.NewFieldSpec(sandbox.FieldID, field.TypeString))
+	)
+	_spec.Schema = sc.schemaConfig.Sandbox
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(sandbox.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(sandbox.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.TeamID(); ok {
+		_spec.SetField(sandbox.FieldTeamID, field.TypeUUID, value)
+		_node.TeamID = value
+	}
+	if value, ok := sc.mutation.EnvID(); ok {
+		_spec.SetField(sandbox.FieldEnvID, field.TypeString, value)
+		_node.EnvID = value
+	}
+	if value, ok := sc.mutation.BuildID(); ok {
+		_spec.SetField(sandbox.FieldBuildID, field.TypeUUID, value)
+		_node.BuildID = value
+	}
+	if value, ok := sc.mutation.Alias(); ok {
+		_spec.SetField(sandbox.FieldAlias, field.TypeString, value)
+		_node.Alias = &value
+	}
+	if value, ok := sc.mutation.NodeID(); ok {
+		_spec.SetField(sandbox.FieldNodeID, field.TypeString, value)
+		_node.NodeID = value
+	}
+	if value, ok := sc.mutation.ClusterID(); ok {
+		_spec.SetField(sandbox.FieldClusterID, field.TypeString, value)
+		_node.ClusterID = value
+	}
+	if value, ok := sc.mutation.State(); ok {
+		_spec.SetField(sandbox.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := sc.mutation.StartedAt(); ok {
+		_spec.SetField(sandbox.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := sc.mutation.EndAt(); ok {
+		_spec.SetField(sandbox.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = value
+	}
+	if value, ok := sc.mutation.Vcpu(); ok {
+		_spec.SetField(sandbox.FieldVcpu, field.TypeInt64, value)
+		_node.Vcpu = value
+	}
+	if value, ok := sc.mutation.RAMMB(); ok {
+		_spec.SetField(sandbox.FieldRAMMB, field.TypeInt64, value)
+		_node.RAMMB = value
+	}
+	if value, ok := sc.mutation.Metadata(); ok {
+		_spec.SetField(sandbox.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := sc.mutation.Labels(); ok {
+		_spec.SetField(sandbox.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sandbox.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SandboxUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SandboxCreate) OnConflict(opts ...sql.ConflictOption) *SandboxUpsertOne {
+	sc.conflict = opts
+	return &SandboxUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sandbox.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SandboxCreate) OnConflictColumns(columns ...string) *SandboxUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SandboxUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SandboxUpsertOne is the builder for "upsert"-ing
+	//  one Sandbox node.
+	SandboxUpsertOne struct {
+		create *SandboxCreate
+	}
+
+	// SandboxUpsert is the "OnConflict" setter.
+	SandboxUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SandboxUpsert) SetUpdatedAt(v time.Time) *SandboxUpsert {
+	u.Set(sandbox.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateUpdatedAt() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldUpdatedAt)
+	return u
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *SandboxUpsert) SetTeamID(v uuid.UUID) *SandboxUpsert {
+	u.Set(sandbox.FieldTeamID, v)
+	return u
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateTeamID() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldTeamID)
+	return u
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *SandboxUpsert) SetEnvID(v string) *SandboxUpsert {
+	u.Set(sandbox.FieldEnvID, v)
+	return u
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateEnvID() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldEnvID)
+	return u
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *SandboxUpsert) SetBuildID(v uuid.UUID) *SandboxUpsert {
+	u.Set(sandbox.FieldBuildID, v)
+	return u
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateBuildID() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldBuildID)
+	return u
+}
+
+// SetAlias sets the "alias" field.
+func (u *SandboxUpsert) SetAlias(v string) *SandboxUpsert {
+	u.Set(sandbox.FieldAlias, v)
+	return u
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateAlias() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldAlias)
+	return u
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (u *SandboxUpsert) ClearAlias() *SandboxUpsert {
+	u.SetNull(sandbox.FieldAlias)
+	return u
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *SandboxUpsert) SetNodeID(v string) *SandboxUpsert {
+	u.Set(sandbox.FieldNodeID, v)
+	return u
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateNodeID() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldNodeID)
+	return u
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (u *SandboxUpsert) ClearNodeID() *SandboxUpsert {
+	u.SetNull(sandbox.FieldNodeID)
+	return u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *SandboxUpsert) SetClusterID(v string) *SandboxUpsert {
+	u.Set(sandbox.FieldClusterID, v)
+	return u
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateClusterID() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldClusterID)
+	return u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *SandboxUpsert) ClearClusterID() *SandboxUpsert {
+	u.SetNull(sandbox.FieldClusterID)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *SandboxUpsert) SetState(v sandbox.State) *SandboxUpsert {
+	u.Set(sandbox.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateState() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldState)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SandboxUpsert) SetStartedAt(v time.Time) *SandboxUpsert {
+	u.Set(sandbox.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateStartedAt() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldStartedAt)
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *SandboxUpsert) SetEndAt(v time.Time) *SandboxUpsert {
+	u.Set(sandbox.FieldEndAt, v)
+	return u
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateEndAt() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldEndAt)
+	return u
+}
+
+// SetVcpu sets the "vcpu" field.
+func (u *SandboxUpsert) SetVcpu(v int64) *SandboxUpsert {
+	u.Set(sandbox.FieldVcpu, v)
+	return u
+}
+
+// UpdateVcpu sets the "vcpu" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateVcpu() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldVcpu)
+	return u
+}
+
+// AddVcpu adds v to the "vcpu" field.
+func (u *SandboxUpsert) AddVcpu(v int64) *SandboxUpsert {
+	u.Add(sandbox.FieldVcpu, v)
+	return u
+}
+
+// SetRAMMB sets the "ram_mb" field.
+func (u *SandboxUpsert) SetRAMMB(v int64) *SandboxUpsert {
+	u.Set(sandbox.FieldRAMMB, v)
+	return u
+}
+
+// UpdateRAMMB sets the "ram_mb" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateRAMMB() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldRAMMB)
+	return u
+}
+
+// AddRAMMB adds v to the "ram_mb" field.
+func (u *SandboxUpsert) AddRAMMB(v int64) *SandboxUpsert {
+	u.Add(sandbox.FieldRAMMB, v)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SandboxUpsert) SetMetadata(v map[string]string) *SandboxUpsert {
+	u.Set(sandbox.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateMetadata() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *SandboxUpsert) ClearMetadata() *SandboxUpsert {
+	u.SetNull(sandbox.FieldMetadata)
+	return u
+}
+
+// SetLabels sets the "labels" field.
+func (u *SandboxUpsert) SetLabels(v map[string]string) *SandboxUpsert {
+	u.Set(sandbox.FieldLabels, v)
+	return u
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *SandboxUpsert) UpdateLabels() *SandboxUpsert {
+	u.SetExcluded(sandbox.FieldLabels)
+	return u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *SandboxUpsert) ClearLabels() *SandboxUpsert {
+	u.SetNull(sandbox.FieldLabels)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Sandbox.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sandbox.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SandboxUpsertOne) UpdateNewValues() *SandboxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(sandbox.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(sandbox.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sandbox.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SandboxUpsertOne) Ignore() *SandboxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SandboxUpsertOne) DoNothing() *SandboxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SandboxCreate.OnConflict
+// documentation for more info.
+func (u *SandboxUpsertOne) Update(set func(*SandboxUpsert)) *SandboxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SandboxUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SandboxUpsertOne) SetUpdatedAt(v time.Time) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateUpdatedAt() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *SandboxUpsertOne) SetTeamID(v uuid.UUID) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateTeamID() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *SandboxUpsertOne) SetEnvID(v string) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetEnvID(v)
+	})
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateEnvID() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateEnvID()
+	})
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *SandboxUpsertOne) SetBuildID(v uuid.UUID) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetBuildID(v)
+	})
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateBuildID() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateBuildID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *SandboxUpsertOne) SetAlias(v string) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateAlias() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (u *SandboxUpsertOne) ClearAlias() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearAlias()
+	})
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *SandboxUpsertOne) SetNodeID(v string) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateNodeID() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (u *SandboxUpsertOne) ClearNodeID() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearNodeID()
+	})
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *SandboxUpsertOne) SetClusterID(v string) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateClusterID() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *SandboxUpsertOne) ClearClusterID() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearClusterID()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *SandboxUpsertOne) SetState(v sandbox.State) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateState() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SandboxUpsertOne) SetStartedAt(v time.Time) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateStartedAt() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *SandboxUpsertOne) SetEndAt(v time.Time) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateEndAt() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// SetVcpu sets the "vcpu" field.
+func (u *SandboxUpsertOne) SetVcpu(v int64) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetVcpu(v)
+	})
+}
+
+// AddVcpu adds v to the "vcpu" field.
+func (u *SandboxUpsertOne) AddVcpu(v int64) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.AddVcpu(v)
+	})
+}
+
+// UpdateVcpu sets the "vcpu" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateVcpu() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateVcpu()
+	})
+}
+
+// SetRAMMB sets the "ram_mb" field.
+func (u *SandboxUpsertOne) SetRAMMB(v int64) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetRAMMB(v)
+	})
+}
+
+// AddRAMMB adds v to the "ram_mb" field.
+func (u *SandboxUpsertOne) AddRAMMB(v int64) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.AddRAMMB(v)
+	})
+}
+
+// UpdateRAMMB sets the "ram_mb" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateRAMMB() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateRAMMB()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SandboxUpsertOne) SetMetadata(v map[string]string) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateMetadata() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *SandboxUpsertOne) ClearMetadata() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *SandboxUpsertOne) SetLabels(v map[string]string) *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *SandboxUpsertOne) UpdateLabels() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *SandboxUpsertOne) ClearLabels() *SandboxUpsertOne {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// Exec executes the query.
+func (u *SandboxUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for SandboxCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SandboxUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SandboxUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: SandboxUpsertOne.ID is not supported by MySQL driver. Use SandboxUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SandboxUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SandboxCreateBulk is the builder for creating many Sandbox entities in bulk.
+type SandboxCreateBulk struct {
+	config
+	err      error
+	builders []*SandboxCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Sandbox entities in the database.
+func (scb *SandboxCreateBulk) Save(ctx context.Context) ([]*Sandbox, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Sandbox, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SandboxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SandboxCreateBulk) SaveX(ctx context.Context) []*Sandbox {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SandboxCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SandboxCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sandbox.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SandboxUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SandboxCreateBulk) OnConflict(opts ...sql.ConflictOption) *SandboxUpsertBulk {
+	scb.conflict = opts
+	return &SandboxUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sandbox.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SandboxCreateBulk) OnConflictColumns(columns ...string) *SandboxUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SandboxUpsertBulk{
+		create: scb,
+	}
+}
+
+// SandboxUpsertBulk is the builder for "upsert"-ing
+// a bulk of Sandbox nodes.
+type SandboxUpsertBulk struct {
+	create *SandboxCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Sandbox.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sandbox.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SandboxUpsertBulk) UpdateNewValues() *SandboxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(sandbox.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(sandbox.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sandbox.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SandboxUpsertBulk) Ignore() *SandboxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SandboxUpsertBulk) DoNothing() *SandboxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SandboxCreateBulk.OnConflict
+// documentation for more info.
+func (u *SandboxUpsertBulk) Update(set func(*SandboxUpsert)) *SandboxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SandboxUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SandboxUpsertBulk) SetUpdatedAt(v time.Time) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateUpdatedAt() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *SandboxUpsertBulk) SetTeamID(v uuid.UUID) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateTeamID() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *SandboxUpsertBulk) SetEnvID(v string) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetEnvID(v)
+	})
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateEnvID() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateEnvID()
+	})
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *SandboxUpsertBulk) SetBuildID(v uuid.UUID) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetBuildID(v)
+	})
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateBuildID() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateBuildID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *SandboxUpsertBulk) SetAlias(v string) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateAlias() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (u *SandboxUpsertBulk) ClearAlias() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearAlias()
+	})
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *SandboxUpsertBulk) SetNodeID(v string) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateNodeID() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (u *SandboxUpsertBulk) ClearNodeID() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearNodeID()
+	})
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *SandboxUpsertBulk) SetClusterID(v string) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateClusterID() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *SandboxUpsertBulk) ClearClusterID() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearClusterID()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *SandboxUpsertBulk) SetState(v sandbox.State) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateState() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SandboxUpsertBulk) SetStartedAt(v time.Time) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateStartedAt() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *SandboxUpsertBulk) SetEndAt(v time.Time) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateEndAt() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// SetVcpu sets the "vcpu" field.
+func (u *SandboxUpsertBulk) SetVcpu(v int64) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetVcpu(v)
+	})
+}
+
+// AddVcpu adds v to the "vcpu" field.
+func (u *SandboxUpsertBulk) AddVcpu(v int64) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.AddVcpu(v)
+	})
+}
+
+// UpdateVcpu sets the "vcpu" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateVcpu() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateVcpu()
+	})
+}
+
+// SetRAMMB sets the "ram_mb" field.
+func (u *SandboxUpsertBulk) SetRAMMB(v int64) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetRAMMB(v)
+	})
+}
+
+// AddRAMMB adds v to the "ram_mb" field.
+func (u *SandboxUpsertBulk) AddRAMMB(v int64) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.AddRAMMB(v)
+	})
+}
+
+// UpdateRAMMB sets the "ram_mb" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateRAMMB() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateRAMMB()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SandboxUpsertBulk) SetMetadata(v map[string]string) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateMetadata() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *SandboxUpsertBulk) ClearMetadata() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *SandboxUpsertBulk) SetLabels(v map[string]string) *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *SandboxUpsertBulk) UpdateLabels() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *SandboxUpsertBulk) ClearLabels() *SandboxUpsertBulk {
+	return u.Update(func(s *SandboxUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// Exec executes the query.
+func (u *SandboxUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the SandboxCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for SandboxCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SandboxUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
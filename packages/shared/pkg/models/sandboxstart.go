@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
+	"github.com/google/uuid"
+)
+
+// SandboxStart is the model entity for the SandboxStart schema.
+type SandboxStart struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// EnvID holds the value of the "env_id" field.
+	EnvID string `json:"env_id,omitempty"`
+	// SandboxID holds the value of the "sandbox_id" field.
+	SandboxID string `json:"sandbox_id,omitempty"`
+	// Resumed holds the value of the "resumed" field.
+	Resumed bool `json:"resumed,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs   int64 `json:"duration_ms,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SandboxStart) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sandboxstart.FieldResumed:
+			values[i] = new(sql.NullBool)
+		case sandboxstart.FieldID, sandboxstart.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case sandboxstart.FieldEnvID, sandboxstart.FieldSandboxID:
+			values[i] = new(sql.NullString)
+		case sandboxstart.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case sandboxstart.FieldTeamID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SandboxStart fields.
+func (ss *SandboxStart) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sandboxstart.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ss.ID = int(value.Int64)
+		case sandboxstart.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ss.CreatedAt = value.Time
+			}
+		case sandboxstart.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				ss.TeamID = *value
+			}
+		case sandboxstart.FieldEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				ss.EnvID = value.String
+			}
+		case sandboxstart.FieldSandboxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sandbox_id", values[i])
+			} else if value.Valid {
+				ss.SandboxID = value.String
+			}
+		case sandboxstart.FieldResumed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field resumed", values[i])
+			} else if value.Valid {
+				ss.Resumed = value.Bool
+			}
+		case sandboxstart.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				ss.DurationMs = value.Int64
+			}
+		default:
+			ss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SandboxStart.
+// This includes values selected through modifiers, order, etc.
+func (ss *SandboxStart) Value(name string) (ent.Value, error) {
+	return ss.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SandboxStart.
+// Note that you need to call SandboxStart.Unwrap() before calling this method if this SandboxStart
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *SandboxStart) Update() *SandboxStartUpdateOne {
+	return NewSandboxStartClient(ss.config).UpdateOne(ss)
+}
+
+// Unwrap unwraps the SandboxStart entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *SandboxStart) Unwrap() *SandboxStart {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("models: SandboxStart is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *SandboxStart) String() string {
+	var builder strings.Builder
+	builder.WriteString("SandboxStart(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ss.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", ss.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(ss.EnvID)
+	builder.WriteString(", ")
+	builder.WriteString("sandbox_id=")
+	builder.WriteString(ss.SandboxID)
+	builder.WriteString(", ")
+	builder.WriteString("resumed=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Resumed))
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", ss.DurationMs))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SandboxStarts is a parsable slice of SandboxStart.
+type SandboxStarts []*SandboxStart
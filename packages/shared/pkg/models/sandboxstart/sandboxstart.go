@@ -0,0 +1,94 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sandboxstart
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the sandboxstart type in the database.
+	Label = "sandbox_start"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldSandboxID holds the string denoting the sandbox_id field in the database.
+	FieldSandboxID = "sandbox_id"
+	// FieldResumed holds the string denoting the resumed field in the database.
+	FieldResumed = "resumed"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// Table holds the table name of the sandboxstart in the database.
+	Table = "sandbox_starts"
+)
+
+// Columns holds all SQL columns for sandboxstart fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldTeamID,
+	FieldEnvID,
+	FieldSandboxID,
+	FieldResumed,
+	FieldDurationMs,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the SandboxStart queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// BySandboxID orders the results by the sandbox_id field.
+func BySandboxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSandboxID, opts...).ToFunc()
+}
+
+// ByResumed orders the results by the resumed field.
+func ByResumed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumed, opts...).ToFunc()
+}
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sandboxstart
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldTeamID, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldEnvID, v))
+}
+
+// SandboxID applies equality check predicate on the "sandbox_id" field. It's identical to SandboxIDEQ.
+func SandboxID(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldSandboxID, v))
+}
+
+// Resumed applies equality check predicate on the "resumed" field. It's identical to ResumedEQ.
+func Resumed(v bool) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldResumed, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v uuid.UUID) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v uuid.UUID) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v uuid.UUID) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v uuid.UUID) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLTE(FieldTeamID, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// EnvIDGT applies the GT predicate on the "env_id" field.
+func EnvIDGT(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGT(FieldEnvID, v))
+}
+
+// EnvIDGTE applies the GTE predicate on the "env_id" field.
+func EnvIDGTE(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGTE(FieldEnvID, v))
+}
+
+// EnvIDLT applies the LT predicate on the "env_id" field.
+func EnvIDLT(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLT(FieldEnvID, v))
+}
+
+// EnvIDLTE applies the LTE predicate on the "env_id" field.
+func EnvIDLTE(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLTE(FieldEnvID, v))
+}
+
+// EnvIDContains applies the Contains predicate on the "env_id" field.
+func EnvIDContains(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldContains(FieldEnvID, v))
+}
+
+// EnvIDHasPrefix applies the HasPrefix predicate on the "env_id" field.
+func EnvIDHasPrefix(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldHasPrefix(FieldEnvID, v))
+}
+
+// EnvIDHasSuffix applies the HasSuffix predicate on the "env_id" field.
+func EnvIDHasSuffix(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldHasSuffix(FieldEnvID, v))
+}
+
+// EnvIDEqualFold applies the EqualFold predicate on the "env_id" field.
+func EnvIDEqualFold(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEqualFold(FieldEnvID, v))
+}
+
+// EnvIDContainsFold applies the ContainsFold predicate on the "env_id" field.
+func EnvIDContainsFold(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldContainsFold(FieldEnvID, v))
+}
+
+// SandboxIDEQ applies the EQ predicate on the "sandbox_id" field.
+func SandboxIDEQ(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldSandboxID, v))
+}
+
+// SandboxIDNEQ applies the NEQ predicate on the "sandbox_id" field.
+func SandboxIDNEQ(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNEQ(FieldSandboxID, v))
+}
+
+// SandboxIDIn applies the In predicate on the "sandbox_id" field.
+func SandboxIDIn(vs ...string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldIn(FieldSandboxID, vs...))
+}
+
+// SandboxIDNotIn applies the NotIn predicate on the "sandbox_id" field.
+func SandboxIDNotIn(vs ...string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNotIn(FieldSandboxID, vs...))
+}
+
+// SandboxIDGT applies the GT predicate on the "sandbox_id" field.
+func SandboxIDGT(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGT(FieldSandboxID, v))
+}
+
+// SandboxIDGTE applies the GTE predicate on the "sandbox_id" field.
+func SandboxIDGTE(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGTE(FieldSandboxID, v))
+}
+
+// SandboxIDLT applies the LT predicate on the "sandbox_id" field.
+func SandboxIDLT(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLT(FieldSandboxID, v))
+}
+
+// SandboxIDLTE applies the LTE predicate on the "sandbox_id" field.
+func SandboxIDLTE(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLTE(FieldSandboxID, v))
+}
+
+// SandboxIDContains applies the Contains predicate on the "sandbox_id" field.
+func SandboxIDContains(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldContains(FieldSandboxID, v))
+}
+
+// SandboxIDHasPrefix applies the HasPrefix predicate on the "sandbox_id" field.
+func SandboxIDHasPrefix(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldHasPrefix(FieldSandboxID, v))
+}
+
+// SandboxIDHasSuffix applies the HasSuffix predicate on the "sandbox_id" field.
+func SandboxIDHasSuffix(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldHasSuffix(FieldSandboxID, v))
+}
+
+// SandboxIDEqualFold applies the EqualFold predicate on the "sandbox_id" field.
+func SandboxIDEqualFold(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEqualFold(FieldSandboxID, v))
+}
+
+// SandboxIDContainsFold applies the ContainsFold predicate on the "sandbox_id" field.
+func SandboxIDContainsFold(v string) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldContainsFold(FieldSandboxID, v))
+}
+
+// ResumedEQ applies the EQ predicate on the "resumed" field.
+func ResumedEQ(v bool) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldResumed, v))
+}
+
+// ResumedNEQ applies the NEQ predicate on the "resumed" field.
+func ResumedNEQ(v bool) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNEQ(FieldResumed, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SandboxStart) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SandboxStart) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SandboxStart) predicate.SandboxStart {
+	return predicate.SandboxStart(sql.NotPredicates(p))
+}
@@ -0,0 +1,542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
+	"github.com/google/uuid"
+)
+
+// SandboxStartCreate is the builder for creating a SandboxStart entity.
+type SandboxStartCreate struct {
+	config
+	mutation *SandboxStartMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssc *SandboxStartCreate) SetCreatedAt(t time.Time) *SandboxStartCreate {
+	ssc.mutation.SetCreatedAt(t)
+	return ssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssc *SandboxStartCreate) SetNillableCreatedAt(t *time.Time) *SandboxStartCreate {
+	if t != nil {
+		ssc.SetCreatedAt(*t)
+	}
+	return ssc
+}
+
+// SetTeamID sets the "team_id" field.
+func (ssc *SandboxStartCreate) SetTeamID(u uuid.UUID) *SandboxStartCreate {
+	ssc.mutation.SetTeamID(u)
+	return ssc
+}
+
+// SetEnvID sets the "env_id" field.
+func (ssc *SandboxStartCreate) SetEnvID(s string) *SandboxStartCreate {
+	ssc.mutation.SetEnvID(s)
+	return ssc
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (ssc *SandboxStartCreate) SetSandboxID(s string) *SandboxStartCreate {
+	ssc.mutation.SetSandboxID(s)
+	return ssc
+}
+
+// SetResumed sets the "resumed" field.
+func (ssc *SandboxStartCreate) SetResumed(b bool) *SandboxStartCreate {
+	ssc.mutation.SetResumed(b)
+	return ssc
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (ssc *SandboxStartCreate) SetDurationMs(i int64) *SandboxStartCreate {
+	ssc.mutation.SetDurationMs(i)
+	return ssc
+}
+
+// Mutation returns the SandboxStartMutation object of the builder.
+func (ssc *SandboxStartCreate) Mutation() *SandboxStartMutation {
+	return ssc.mutation
+}
+
+// Save creates the SandboxStart in the database.
+func (ssc *SandboxStartCreate) Save(ctx context.Context) (*SandboxStart, error) {
+	ssc.defaults()
+	return withHooks(ctx, ssc.sqlSave, ssc.mutation, ssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *SandboxStartCreate) SaveX(ctx context.Context) *SandboxStart {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *SandboxStartCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *SandboxStartCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *SandboxStartCreate) defaults() {
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		v := sandboxstart.DefaultCreatedAt()
+		ssc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *SandboxStartCreate) check() error {
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "SandboxStart.created_at"`)}
+	}
+	if _, ok := ssc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "SandboxStart.team_id"`)}
+	}
+	if _, ok := ssc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`models: missing required field "SandboxStart.env_id"`)}
+	}
+	if _, ok := ssc.mutation.SandboxID(); !ok {
+		return &ValidationError{Name: "sandbox_id", err: errors.New(`models: missing required field "SandboxStart.sandbox_id"`)}
+	}
+	if _, ok := ssc.mutation.Resumed(); !ok {
+		return &ValidationError{Name: "resumed", err: errors.New(`models: missing required field "SandboxStart.resumed"`)}
+	}
+	if _, ok := ssc.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`models: missing required field "SandboxStart.duration_ms"`)}
+	}
+	return nil
+}
+
+func (ssc *SandboxStartCreate) sqlSave(ctx context.Context) (*SandboxStart, error) {
+	if err := ssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ssc.mutation.id = &_node.ID
+	ssc.mutation.done = true
+	return _node, nil
+}
+
+func (ssc *SandboxStartCreate) createSpec() (*SandboxStart, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SandboxStart{config: ssc.config}
+		_spec = sqlgraph.NewCreateSpec(sandboxstart.Table, sqlgraph.NewFieldSpec(sandboxstart.FieldID, field.TypeInt))
+	)
+	_spec.Schema = ssc.schemaConfig.SandboxStart
+	_spec.OnConflict = ssc.conflict
+	if value, ok := ssc.mutation.CreatedAt(); ok {
+		_spec.SetField(sandboxstart.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ssc.mutation.TeamID(); ok {
+		_spec.SetField(sandboxstart.FieldTeamID, field.TypeUUID, value)
+		_node.TeamID = value
+	}
+	if value, ok := ssc.mutation.EnvID(); ok {
+		_spec.SetField(sandboxstart.FieldEnvID, field.TypeString, value)
+		_node.EnvID = value
+	}
+	if value, ok := ssc.mutation.SandboxID(); ok {
+		_spec.SetField(sandboxstart.FieldSandboxID, field.TypeString, value)
+		_node.SandboxID = value
+	}
+	if value, ok := ssc.mutation.Resumed(); ok {
+		_spec.SetField(sandboxstart.FieldResumed, field.TypeBool, value)
+		_node.Resumed = value
+	}
+	if value, ok := ssc.mutation.DurationMs(); ok {
+		_spec.SetField(sandboxstart.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SandboxStart.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SandboxStartUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ssc *SandboxStartCreate) OnConflict(opts ...sql.ConflictOption) *SandboxStartUpsertOne {
+	ssc.conflict = opts
+	return &SandboxStartUpsertOne{
+		create: ssc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SandboxStart.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ssc *SandboxStartCreate) OnConflictColumns(columns ...string) *SandboxStartUpsertOne {
+	ssc.conflict = append(ssc.conflict, sql.ConflictColumns(columns...))
+	return &SandboxStartUpsertOne{
+		create: ssc,
+	}
+}
+
+type (
+	// SandboxStartUpsertOne is the builder for "upsert"-ing
+	//  one SandboxStart node.
+	SandboxStartUpsertOne struct {
+		create *SandboxStartCreate
+	}
+
+	// SandboxStartUpsert is the "OnConflict" setter.
+	SandboxStartUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SandboxStart.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SandboxStartUpsertOne) UpdateNewValues() *SandboxStartUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(sandboxstart.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TeamID(); exists {
+			s.SetIgnore(sandboxstart.FieldTeamID)
+		}
+		if _, exists := u.create.mutation.EnvID(); exists {
+			s.SetIgnore(sandboxstart.FieldEnvID)
+		}
+		if _, exists := u.create.mutation.SandboxID(); exists {
+			s.SetIgnore(sandboxstart.FieldSandboxID)
+		}
+		if _, exists := u.create.mutation.Resumed(); exists {
+			s.SetIgnore(sandboxstart.FieldResumed)
+		}
+		if _, exists := u.create.mutation.DurationMs(); exists {
+			s.SetIgnore(sandboxstart.FieldDurationMs)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SandboxStart.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SandboxStartUpsertOne) Ignore() *SandboxStartUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SandboxStartUpsertOne) DoNothing() *SandboxStartUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SandboxStartCreate.OnConflict
+// documentation for more info.
+func (u *SandboxStartUpsertOne) Update(set func(*SandboxStartUpsert)) *SandboxStartUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SandboxStartUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *SandboxStartUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for SandboxStartCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SandboxStartUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SandboxStartUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SandboxStartUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SandboxStartCreateBulk is the builder for creating many SandboxStart entities in bulk.
+type SandboxStartCreateBulk struct {
+	config
+	err      error
+	builders []*SandboxStartCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SandboxStart entities in the database.
+func (sscb *SandboxStartCreateBulk) Save(ctx context.Context) ([]*SandboxStart, error) {
+	if sscb.err != nil {
+		return nil, sscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*SandboxStart, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SandboxStartMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *SandboxStartCreateBulk) SaveX(ctx context.Context) []*SandboxStart {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *SandboxStartCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *SandboxStartCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SandboxStart.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SandboxStartUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sscb *SandboxStartCreateBulk) OnConflict(opts ...sql.ConflictOption) *SandboxStartUpsertBulk {
+	sscb.conflict = opts
+	return &SandboxStartUpsertBulk{
+		create: sscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SandboxStart.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sscb *SandboxStartCreateBulk) OnConflictColumns(columns ...string) *SandboxStartUpsertBulk {
+	sscb.conflict = append(sscb.conflict, sql.ConflictColumns(columns...))
+	return &SandboxStartUpsertBulk{
+		create: sscb,
+	}
+}
+
+// SandboxStartUpsertBulk is the builder for "upsert"-ing
+// a bulk of SandboxStart nodes.
+type SandboxStartUpsertBulk struct {
+	create *SandboxStartCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SandboxStart.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SandboxStartUpsertBulk) UpdateNewValues() *SandboxStartUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(sandboxstart.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TeamID(); exists {
+				s.SetIgnore(sandboxstart.FieldTeamID)
+			}
+			if _, exists := b.mutation.EnvID(); exists {
+				s.SetIgnore(sandboxstart.FieldEnvID)
+			}
+			if _, exists := b.mutation.SandboxID(); exists {
+				s.SetIgnore(sandboxstart.FieldSandboxID)
+			}
+			if _, exists := b.mutation.Resumed(); exists {
+				s.SetIgnore(sandboxstart.FieldResumed)
+			}
+			if _, exists := b.mutation.DurationMs(); exists {
+				s.SetIgnore(sandboxstart.FieldDurationMs)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SandboxStart.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SandboxStartUpsertBulk) Ignore() *SandboxStartUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SandboxStartUpsertBulk) DoNothing() *SandboxStartUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SandboxStartCreateBulk.OnConflict
+// documentation for more info.
+func (u *SandboxStartUpsertBulk) Update(set func(*SandboxStartUpsert)) *SandboxStartUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SandboxStartUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *SandboxStartUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the SandboxStartCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for SandboxStartCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SandboxStartUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
+)
+
+// SandboxStartDelete is the builder for deleting a SandboxStart entity.
+type SandboxStartDelete struct {
+	config
+	hooks    []Hook
+	mutation *SandboxStartMutation
+}
+
+// Where appends a list predicates to the SandboxStartDelete builder.
+func (ssd *SandboxStartDelete) Where(ps ...predicate.SandboxStart) *SandboxStartDelete {
+	ssd.mutation.Where(ps...)
+	return ssd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ssd *SandboxStartDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ssd.sqlExec, ssd.mutation, ssd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssd *SandboxStartDelete) ExecX(ctx context.Context) int {
+	n, err := ssd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ssd *SandboxStartDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sandboxstart.Table, sqlgraph.NewFieldSpec(sandboxstart.FieldID, field.TypeInt))
+	_spec.Node.Schema = ssd.schemaConfig.SandboxStart
+	ctx = internal.NewSchemaConfigContext(ctx, ssd.schemaConfig)
+	if ps := ssd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ssd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ssd.mutation.done = true
+	return affected, err
+}
+
+// SandboxStartDeleteOne is the builder for deleting a single SandboxStart entity.
+type SandboxStartDeleteOne struct {
+	ssd *SandboxStartDelete
+}
+
+// Where appends a list predicates to the SandboxStartDelete builder.
+func (ssdo *SandboxStartDeleteOne) Where(ps ...predicate.SandboxStart) *SandboxStartDeleteOne {
+	ssdo.ssd.mutation.Where(ps...)
+	return ssdo
+}
+
+// Exec executes the deletion query.
+func (ssdo *SandboxStartDeleteOne) Exec(ctx context.Context) error {
+	n, err := ssdo.ssd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sandboxstart.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssdo *SandboxStartDeleteOne) ExecX(ctx context.Context) {
+	if err := ssdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
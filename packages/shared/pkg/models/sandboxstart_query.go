@@ -0,0 +1,556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
+)
+
+// SandboxStartQuery is the builder for querying SandboxStart entities.
+type SandboxStartQuery struct {
+	config
+	ctx        *QueryContext
+	order      []sandboxstart.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SandboxStart
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SandboxStartQuery builder.
+func (ssq *SandboxStartQuery) Where(ps ...predicate.SandboxStart) *SandboxStartQuery {
+	ssq.predicates = append(ssq.predicates, ps...)
+	return ssq
+}
+
+// Limit the number of records to be returned by this query.
+func (ssq *SandboxStartQuery) Limit(limit int) *SandboxStartQuery {
+	ssq.ctx.Limit = &limit
+	return ssq
+}
+
+// Offset to start from.
+func (ssq *SandboxStartQuery) Offset(offset int) *SandboxStartQuery {
+	ssq.ctx.Offset = &offset
+	return ssq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ssq *SandboxStartQuery) Unique(unique bool) *SandboxStartQuery {
+	ssq.ctx.Unique = &unique
+	return ssq
+}
+
+// Order specifies how the records should be ordered.
+func (ssq *SandboxStartQuery) Order(o ...sandboxstart.OrderOption) *SandboxStartQuery {
+	ssq.order = append(ssq.order, o...)
+	return ssq
+}
+
+// First returns the first SandboxStart entity from the query.
+// Returns a *NotFoundError when no SandboxStart was found.
+func (ssq *SandboxStartQuery) First(ctx context.Context) (*SandboxStart, error) {
+	nodes, err := ssq.Limit(1).All(setContextOp(ctx, ssq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sandboxstart.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ssq *SandboxStartQuery) FirstX(ctx context.Context) *SandboxStart {
+	node, err := ssq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SandboxStart ID from the query.
+// Returns a *NotFoundError when no SandboxStart ID was found.
+func (ssq *SandboxStartQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ssq.Limit(1).IDs(setContextOp(ctx, ssq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sandboxstart.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ssq *SandboxStartQuery) FirstIDX(ctx context.Context) int {
+	id, err := ssq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SandboxStart entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SandboxStart entity is found.
+// Returns a *NotFoundError when no SandboxStart entities are found.
+func (ssq *SandboxStartQuery) Only(ctx context.Context) (*SandboxStart, error) {
+	nodes, err := ssq.Limit(2).All(setContextOp(ctx, ssq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sandboxstart.Label}
+	default:
+		return nil, &NotSingularError{sandboxstart.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ssq *SandboxStartQuery) OnlyX(ctx context.Context) *SandboxStart {
+	node, err := ssq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SandboxStart ID in the query.
+// Returns a *NotSingularError when more than one SandboxStart ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ssq *SandboxStartQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ssq.Limit(2).IDs(setContextOp(ctx, ssq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sandboxstart.Label}
+	default:
+		err = &NotSingularError{sandboxstart.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ssq *SandboxStartQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ssq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SandboxStarts.
+func (ssq *SandboxStartQuery) All(ctx context.Context) ([]*SandboxStart, error) {
+	ctx = setContextOp(ctx, ssq.ctx, "All")
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SandboxStart, *SandboxStartQuery]()
+	return withInterceptors[[]*SandboxStart](ctx, ssq, qr, ssq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ssq *SandboxStartQuery) AllX(ctx context.Context) []*SandboxStart {
+	nodes, err := ssq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SandboxStart IDs.
+func (ssq *SandboxStartQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ssq.ctx.Unique == nil && ssq.path != nil {
+		ssq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ssq.ctx, "IDs")
+	if err = ssq.Select(sandboxstart.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ssq *SandboxStartQuery) IDsX(ctx context.Context) []int {
+	ids, err := ssq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ssq *SandboxStartQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ssq.ctx, "Count")
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ssq, querierCount[*SandboxStartQuery](), ssq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ssq *SandboxStartQuery) CountX(ctx context.Context) int {
+	count, err := ssq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ssq *SandboxStartQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ssq.ctx, "Exist")
+	switch _, err := ssq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ssq *SandboxStartQuery) ExistX(ctx context.Context) bool {
+	exist, err := ssq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SandboxStartQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ssq *SandboxStartQuery) Clone() *SandboxStartQuery {
+	if ssq == nil {
+		return nil
+	}
+	return &SandboxStartQuery{
+		config:     ssq.config,
+		ctx:        ssq.ctx.Clone(),
+		order:      append([]sandboxstart.OrderOption{}, ssq.order...),
+		inters:     append([]Interceptor{}, ssq.inters...),
+		predicates: append([]predicate.SandboxStart{}, ssq.predicates...),
+		// clone intermediate query.
+		sql:  ssq.sql.Clone(),
+		path: ssq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SandboxStart.Query().
+//		GroupBy(sandboxstart.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (ssq *SandboxStartQuery) GroupBy(field string, fields ...string) *SandboxStartGroupBy {
+	ssq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SandboxStartGroupBy{build: ssq}
+	grbuild.flds = &ssq.ctx.Fields
+	grbuild.label = sandboxstart.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SandboxStart.Query().
+//		Select(sandboxstart.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ssq *SandboxStartQuery) Select(fields ...string) *SandboxStartSelect {
+	ssq.ctx.Fields = append(ssq.ctx.Fields, fields...)
+	sbuild := &SandboxStartSelect{SandboxStartQuery: ssq}
+	sbuild.label = sandboxstart.Label
+	sbuild.flds, sbuild.scan = &ssq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SandboxStartSelect configured with the given aggregations.
+func (ssq *SandboxStartQuery) Aggregate(fns ...AggregateFunc) *SandboxStartSelect {
+	return ssq.Select().Aggregate(fns...)
+}
+
+func (ssq *SandboxStartQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ssq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ssq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ssq.ctx.Fields {
+		if !sandboxstart.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if ssq.path != nil {
+		prev, err := ssq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ssq.sql = prev
+	}
+	return nil
+}
+
+func (ssq *SandboxStartQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SandboxStart, error) {
+	var (
+		nodes = []*SandboxStart{}
+		_spec = ssq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SandboxStart).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SandboxStart{config: ssq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = ssq.schemaConfig.SandboxStart
+	ctx = internal.NewSchemaConfigContext(ctx, ssq.schemaConfig)
+	if len(ssq.modifiers) > 0 {
+		_spec.Modifiers = ssq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ssq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ssq *SandboxStartQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ssq.querySpec()
+	_spec.Node.Schema = ssq.schemaConfig.SandboxStart
+	ctx = internal.NewSchemaConfigContext(ctx, ssq.schemaConfig)
+	if len(ssq.modifiers) > 0 {
+		_spec.Modifiers = ssq.modifiers
+	}
+	_spec.Node.Columns = ssq.ctx.Fields
+	if len(ssq.ctx.Fields) > 0 {
+		_spec.Unique = ssq.ctx.Unique != nil && *ssq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ssq.driver, _spec)
+}
+
+func (ssq *SandboxStartQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sandboxstart.Table, sandboxstart.Columns, sqlgraph.NewFieldSpec(sandboxstart.FieldID, field.TypeInt))
+	_spec.From = ssq.sql
+	if unique := ssq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ssq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ssq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sandboxstart.FieldID)
+		for i := range fields {
+			if fields[i] != sandboxstart.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ssq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ssq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ssq *SandboxStartQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ssq.driver.Dialect())
+	t1 := builder.Table(sandboxstart.Table)
+	columns := ssq.ctx.Fields
+	if len(columns) == 0 {
+		columns = sandboxstart.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ssq.sql != nil {
+		selector = ssq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ssq.ctx.Unique != nil && *ssq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(ssq.schemaConfig.SandboxStart)
+	ctx = internal.NewSchemaConfigContext(ctx, ssq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range ssq.modifiers {
+		m(selector)
+	}
+	for _, p := range ssq.predicates {
+		p(selector)
+	}
+	for _, p := range ssq.order {
+		p(selector)
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ssq *SandboxStartQuery) Modify(modifiers ...func(s *sql.Selector)) *SandboxStartSelect {
+	ssq.modifiers = append(ssq.modifiers, modifiers...)
+	return ssq.Select()
+}
+
+// SandboxStartGroupBy is the group-by builder for SandboxStart entities.
+type SandboxStartGroupBy struct {
+	selector
+	build *SandboxStartQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ssgb *SandboxStartGroupBy) Aggregate(fns ...AggregateFunc) *SandboxStartGroupBy {
+	ssgb.fns = append(ssgb.fns, fns...)
+	return ssgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssgb *SandboxStartGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ssgb.build.ctx, "GroupBy")
+	if err := ssgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SandboxStartQuery, *SandboxStartGroupBy](ctx, ssgb.build, ssgb, ssgb.build.inters, v)
+}
+
+func (ssgb *SandboxStartGroupBy) sqlScan(ctx context.Context, root *SandboxStartQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ssgb.fns))
+	for _, fn := range ssgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ssgb.flds)+len(ssgb.fns))
+		for _, f := range *ssgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ssgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SandboxStartSelect is the builder for selecting fields of SandboxStart entities.
+type SandboxStartSelect struct {
+	*SandboxStartQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sss *SandboxStartSelect) Aggregate(fns ...AggregateFunc) *SandboxStartSelect {
+	sss.fns = append(sss.fns, fns...)
+	return sss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sss *SandboxStartSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sss.ctx, "Select")
+	if err := sss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SandboxStartQuery, *SandboxStartSelect](ctx, sss.SandboxStartQuery, sss, sss.inters, v)
+}
+
+func (sss *SandboxStartSelect) sqlScan(ctx context.Context, root *SandboxStartQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sss.fns))
+	for _, fn := range sss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sss *SandboxStartSelect) Modify(modifiers ...func(s *sql.Selector)) *SandboxStartSelect {
+	sss.modifiers = append(sss.modifiers, modifiers...)
+	return sss
+}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/sandboxstart"
+)
+
+// SandboxStartUpdate is the builder for updating SandboxStart entities.
+type SandboxStartUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SandboxStartMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SandboxStartUpdate builder.
+func (ssu *SandboxStartUpdate) Where(ps ...predicate.SandboxStart) *SandboxStartUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// Mutation returns the SandboxStartMutation object of the builder.
+func (ssu *SandboxStartUpdate) Mutation() *SandboxStartMutation {
+	return ssu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *SandboxStartUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ssu.sqlSave, ssu.mutation, ssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *SandboxStartUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *SandboxStartUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *SandboxStartUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ssu *SandboxStartUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SandboxStartUpdate {
+	ssu.modifiers = append(ssu.modifiers, modifiers...)
+	return ssu
+}
+
+func (ssu *SandboxStartUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sandboxstart.Table, sandboxstart.Columns, sqlgraph.NewFieldSpec(sandboxstart.FieldID, field.TypeInt))
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = ssu.schemaConfig.SandboxStart
+	ctx = internal.NewSchemaConfigContext(ctx, ssu.schemaConfig)
+	_spec.AddModifiers(ssu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sandboxstart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssu.mutation.done = true
+	return n, nil
+}
+
+// SandboxStartUpdateOne is the builder for updating a single SandboxStart entity.
+type SandboxStartUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SandboxStartMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the SandboxStartMutation object of the builder.
+func (ssuo *SandboxStartUpdateOne) Mutation() *SandboxStartMutation {
+	return ssuo.mutation
+}
+
+// Where appends a list predicates to the SandboxStartUpdate builder.
+func (ssuo *SandboxStartUpdateOne) Where(ps ...predicate.SandboxStart) *SandboxStartUpdateOne {
+	ssuo.mutation.Where(ps...)
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *SandboxStartUpdateOne) Select(field string, fields ...string) *SandboxStartUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated SandboxStart entity.
+func (ssuo *SandboxStartUpdateOne) Save(ctx context.Context) (*SandboxStart, error) {
+	return withHooks(ctx, ssuo.sqlSave, ssuo.mutation, ssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *SandboxStartUpdateOne) SaveX(ctx context.Context) *SandboxStart {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *SandboxStartUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *SandboxStartUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ssuo *SandboxStartUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SandboxStartUpdateOne {
+	ssuo.modifiers = append(ssuo.modifiers, modifiers...)
+	return ssuo
+}
+
+func (ssuo *SandboxStartUpdateOne) sqlSave(ctx context.Context) (_node *SandboxStart, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sandboxstart.Table, sandboxstart.Columns, sqlgraph.NewFieldSpec(sandboxstart.FieldID, field.TypeInt))
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "SandboxStart.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sandboxstart.FieldID)
+		for _, f := range fields {
+			if !sandboxstart.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != sandboxstart.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = ssuo.schemaConfig.SandboxStart
+	ctx = internal.NewSchemaConfigContext(ctx, ssuo.schemaConfig)
+	_spec.AddModifiers(ssuo.modifiers...)
+	_node = &SandboxStart{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sandboxstart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssuo.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -33,6 +34,12 @@ type Team struct {
 	Tier string `json:"tier,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
+	// EncryptSnapshots holds the value of the "encrypt_snapshots" field.
+	EncryptSnapshots bool `json:"encrypt_snapshots,omitempty"`
+	// ReplicationRegions holds the value of the "replication_regions" field.
+	ReplicationRegions []string `json:"replication_regions,omitempty"`
+	// BuildLogRetentionDays holds the value of the "build_log_retention_days" field.
+	BuildLogRetentionDays int32 `json:"build_log_retention_days,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TeamQuery when eager-loading is set.
 	Edges        TeamEdges `json:"edges"`
@@ -110,8 +117,12 @@ func (*Team) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case team.FieldIsBanned, team.FieldIsBlocked:
+		case team.FieldReplicationRegions:
+			values[i] = new([]byte)
+		case team.FieldIsBanned, team.FieldIsBlocked, team.FieldEncryptSnapshots:
 			values[i] = new(sql.NullBool)
+		case team.FieldBuildLogRetentionDays:
+			values[i] = new(sql.NullInt64)
 		case team.FieldBlockedReason, team.FieldName, team.FieldTier, team.FieldEmail:
 			values[i] = new(sql.NullString)
 		case team.FieldCreatedAt:
@@ -182,6 +193,26 @@ func (t *Team) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.Email = value.String
 			}
+		case team.FieldEncryptSnapshots:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypt_snapshots", values[i])
+			} else if value.Valid {
+				t.EncryptSnapshots = value.Bool
+			}
+		case team.FieldReplicationRegions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field replication_regions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.ReplicationRegions); err != nil {
+					return fmt.Errorf("unmarshal field replication_regions: %w", err)
+				}
+			}
+		case team.FieldBuildLogRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field build_log_retention_days", values[i])
+			} else if value.Valid {
+				t.BuildLogRetentionDays = int32(value.Int64)
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -265,6 +296,15 @@ func (t *Team) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("email=")
 	builder.WriteString(t.Email)
+	builder.WriteString(", ")
+	builder.WriteString("encrypt_snapshots=")
+	builder.WriteString(fmt.Sprintf("%v", t.EncryptSnapshots))
+	builder.WriteString(", ")
+	builder.WriteString("replication_regions=")
+	builder.WriteString(fmt.Sprintf("%v", t.ReplicationRegions))
+	builder.WriteString(", ")
+	builder.WriteString("build_log_retention_days=")
+	builder.WriteString(fmt.Sprintf("%v", t.BuildLogRetentionDays))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,12 @@ const (
 	FieldTier = "tier"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldEncryptSnapshots holds the string denoting the encrypt_snapshots field in the database.
+	FieldEncryptSnapshots = "encrypt_snapshots"
+	// FieldReplicationRegions holds the string denoting the replication_regions field in the database.
+	FieldReplicationRegions = "replication_regions"
+	// FieldBuildLogRetentionDays holds the string denoting the build_log_retention_days field in the database.
+	FieldBuildLogRetentionDays = "build_log_retention_days"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
 	// EdgeTeamAPIKeys holds the string denoting the team_api_keys edge name in mutations.
@@ -85,6 +91,9 @@ var Columns = []string{
 	FieldName,
 	FieldTier,
 	FieldEmail,
+	FieldEncryptSnapshots,
+	FieldReplicationRegions,
+	FieldBuildLogRetentionDays,
 }
 
 var (
@@ -108,6 +117,10 @@ var (
 	DefaultCreatedAt func() time.Time
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
+	// DefaultEncryptSnapshots holds the default value on creation for the "encrypt_snapshots" field.
+	DefaultEncryptSnapshots bool
+	// DefaultBuildLogRetentionDays holds the default value on creation for the "build_log_retention_days" field.
+	DefaultBuildLogRetentionDays int32
 )
 
 // OrderOption defines the ordering options for the Team queries.
@@ -153,6 +166,16 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByEncryptSnapshots orders the results by the encrypt_snapshots field.
+func ByEncryptSnapshots(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptSnapshots, opts...).ToFunc()
+}
+
+// ByBuildLogRetentionDays orders the results by the build_log_retention_days field.
+func ByBuildLogRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildLogRetentionDays, opts...).ToFunc()
+}
+
 // ByUsersCount orders the results by users count.
 func ByUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -92,6 +92,16 @@ func Email(v string) predicate.Team {
 	return predicate.Team(sql.FieldEQ(FieldEmail, v))
 }
 
+// EncryptSnapshots applies equality check predicate on the "encrypt_snapshots" field. It's identical to EncryptSnapshotsEQ.
+func EncryptSnapshots(v bool) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldEncryptSnapshots, v))
+}
+
+// BuildLogRetentionDays applies equality check predicate on the "build_log_retention_days" field. It's identical to BuildLogRetentionDaysEQ.
+func BuildLogRetentionDays(v int32) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldBuildLogRetentionDays, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Team {
 	return predicate.Team(sql.FieldEQ(FieldCreatedAt, v))
@@ -442,6 +452,66 @@ func EmailContainsFold(v string) predicate.Team {
 	return predicate.Team(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// EncryptSnapshotsEQ applies the EQ predicate on the "encrypt_snapshots" field.
+func EncryptSnapshotsEQ(v bool) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldEncryptSnapshots, v))
+}
+
+// EncryptSnapshotsNEQ applies the NEQ predicate on the "encrypt_snapshots" field.
+func EncryptSnapshotsNEQ(v bool) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldEncryptSnapshots, v))
+}
+
+// ReplicationRegionsIsNil applies the IsNil predicate on the "replication_regions" field.
+func ReplicationRegionsIsNil() predicate.Team {
+	return predicate.Team(sql.FieldIsNull(FieldReplicationRegions))
+}
+
+// ReplicationRegionsNotNil applies the NotNil predicate on the "replication_regions" field.
+func ReplicationRegionsNotNil() predicate.Team {
+	return predicate.Team(sql.FieldNotNull(FieldReplicationRegions))
+}
+
+// BuildLogRetentionDaysEQ applies the EQ predicate on the "build_log_retention_days" field.
+func BuildLogRetentionDaysEQ(v int32) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldBuildLogRetentionDays, v))
+}
+
+// BuildLogRetentionDaysNEQ applies the NEQ predicate on the "build_log_retention_days" field.
+func BuildLogRetentionDaysNEQ(v int32) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldBuildLogRetentionDays, v))
+}
+
+// BuildLogRetentionDaysIn applies the In predicate on the "build_log_retention_days" field.
+func BuildLogRetentionDaysIn(vs ...int32) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldBuildLogRetentionDays, vs...))
+}
+
+// BuildLogRetentionDaysNotIn applies the NotIn predicate on the "build_log_retention_days" field.
+func BuildLogRetentionDaysNotIn(vs ...int32) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldBuildLogRetentionDays, vs...))
+}
+
+// BuildLogRetentionDaysGT applies the GT predicate on the "build_log_retention_days" field.
+func BuildLogRetentionDaysGT(v int32) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldBuildLogRetentionDays, v))
+}
+
+// BuildLogRetentionDaysGTE applies the GTE predicate on the "build_log_retention_days" field.
+func BuildLogRetentionDaysGTE(v int32) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldBuildLogRetentionDays, v))
+}
+
+// BuildLogRetentionDaysLT applies the LT predicate on the "build_log_retention_days" field.
+func BuildLogRetentionDaysLT(v int32) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldBuildLogRetentionDays, v))
+}
+
+// BuildLogRetentionDaysLTE applies the LTE predicate on the "build_log_retention_days" field.
+func BuildLogRetentionDaysLTE(v int32) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldBuildLogRetentionDays, v))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.Team {
 	return predicate.Team(func(s *sql.Selector) {
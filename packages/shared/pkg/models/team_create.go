@@ -103,6 +103,40 @@ func (tc *TeamCreate) SetEmail(s string) *TeamCreate {
 	return tc
 }
 
+// SetEncryptSnapshots sets the "encrypt_snapshots" field.
+func (tc *TeamCreate) SetEncryptSnapshots(b bool) *TeamCreate {
+	tc.mutation.SetEncryptSnapshots(b)
+	return tc
+}
+
+// SetNillableEncryptSnapshots sets the "encrypt_snapshots" field if the given value is not nil.
+func (tc *TeamCreate) SetNillableEncryptSnapshots(b *bool) *TeamCreate {
+	if b != nil {
+		tc.SetEncryptSnapshots(*b)
+	}
+	return tc
+}
+
+// SetReplicationRegions sets the "replication_regions" field.
+func (tc *TeamCreate) SetReplicationRegions(s []string) *TeamCreate {
+	tc.mutation.SetReplicationRegions(s)
+	return tc
+}
+
+// SetBuildLogRetentionDays sets the "build_log_retention_days" field.
+func (tc *TeamCreate) SetBuildLogRetentionDays(i int32) *TeamCreate {
+	tc.mutation.SetBuildLogRetentionDays(i)
+	return tc
+}
+
+// SetNillableBuildLogRetentionDays sets the "build_log_retention_days" field if the given value is not nil.
+func (tc *TeamCreate) SetNillableBuildLogRetentionDays(i *int32) *TeamCreate {
+	if i != nil {
+		tc.SetBuildLogRetentionDays(*i)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TeamCreate) SetID(u uuid.UUID) *TeamCreate {
 	tc.mutation.SetID(u)
@@ -219,6 +253,14 @@ func (tc *TeamCreate) defaults() {
 		v := team.DefaultCreatedAt()
 		tc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := tc.mutation.EncryptSnapshots(); !ok {
+		v := team.DefaultEncryptSnapshots
+		tc.mutation.SetEncryptSnapshots(v)
+	}
+	if _, ok := tc.mutation.BuildLogRetentionDays(); !ok {
+		v := team.DefaultBuildLogRetentionDays
+		tc.mutation.SetBuildLogRetentionDays(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -240,6 +282,12 @@ func (tc *TeamCreate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`models: validator failed for field "Team.email": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.EncryptSnapshots(); !ok {
+		return &ValidationError{Name: "encrypt_snapshots", err: errors.New(`models: missing required field "Team.encrypt_snapshots"`)}
+	}
+	if _, ok := tc.mutation.BuildLogRetentionDays(); !ok {
+		return &ValidationError{Name: "build_log_retention_days", err: errors.New(`models: missing required field "Team.build_log_retention_days"`)}
+	}
 	if _, ok := tc.mutation.TeamTierID(); !ok {
 		return &ValidationError{Name: "team_tier", err: errors.New(`models: missing required edge "Team.team_tier"`)}
 	}
@@ -304,6 +352,18 @@ func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		_spec.SetField(team.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := tc.mutation.EncryptSnapshots(); ok {
+		_spec.SetField(team.FieldEncryptSnapshots, field.TypeBool, value)
+		_node.EncryptSnapshots = value
+	}
+	if value, ok := tc.mutation.ReplicationRegions(); ok {
+		_spec.SetField(team.FieldReplicationRegions, field.TypeJSON, value)
+		_node.ReplicationRegions = value
+	}
+	if value, ok := tc.mutation.BuildLogRetentionDays(); ok {
+		_spec.SetField(team.FieldBuildLogRetentionDays, field.TypeInt32, value)
+		_node.BuildLogRetentionDays = value
+	}
 	if nodes := tc.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -536,6 +596,54 @@ func (u *TeamUpsert) UpdateEmail() *TeamUpsert {
 	return u
 }
 
+// SetEncryptSnapshots sets the "encrypt_snapshots" field.
+func (u *TeamUpsert) SetEncryptSnapshots(v bool) *TeamUpsert {
+	u.Set(team.FieldEncryptSnapshots, v)
+	return u
+}
+
+// UpdateEncryptSnapshots sets the "encrypt_snapshots" field to the value that was provided on create.
+func (u *TeamUpsert) UpdateEncryptSnapshots() *TeamUpsert {
+	u.SetExcluded(team.FieldEncryptSnapshots)
+	return u
+}
+
+// SetReplicationRegions sets the "replication_regions" field.
+func (u *TeamUpsert) SetReplicationRegions(v []string) *TeamUpsert {
+	u.Set(team.FieldReplicationRegions, v)
+	return u
+}
+
+// UpdateReplicationRegions sets the "replication_regions" field to the value that was provided on create.
+func (u *TeamUpsert) UpdateReplicationRegions() *TeamUpsert {
+	u.SetExcluded(team.FieldReplicationRegions)
+	return u
+}
+
+// ClearReplicationRegions clears the value of the "replication_regions" field.
+func (u *TeamUpsert) ClearReplicationRegions() *TeamUpsert {
+	u.SetNull(team.FieldReplicationRegions)
+	return u
+}
+
+// SetBuildLogRetentionDays sets the "build_log_retention_days" field.
+func (u *TeamUpsert) SetBuildLogRetentionDays(v int32) *TeamUpsert {
+	u.Set(team.FieldBuildLogRetentionDays, v)
+	return u
+}
+
+// UpdateBuildLogRetentionDays sets the "build_log_retention_days" field to the value that was provided on create.
+func (u *TeamUpsert) UpdateBuildLogRetentionDays() *TeamUpsert {
+	u.SetExcluded(team.FieldBuildLogRetentionDays)
+	return u
+}
+
+// AddBuildLogRetentionDays adds v to the "build_log_retention_days" field.
+func (u *TeamUpsert) AddBuildLogRetentionDays(v int32) *TeamUpsert {
+	u.Add(team.FieldBuildLogRetentionDays, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -692,6 +800,62 @@ func (u *TeamUpsertOne) UpdateEmail() *TeamUpsertOne {
 	})
 }
 
+// SetEncryptSnapshots sets the "encrypt_snapshots" field.
+func (u *TeamUpsertOne) SetEncryptSnapshots(v bool) *TeamUpsertOne {
+	return u.Update(func(s *TeamUpsert) {
+		s.SetEncryptSnapshots(v)
+	})
+}
+
+// UpdateEncryptSnapshots sets the "encrypt_snapshots" field to the value that was provided on create.
+func (u *TeamUpsertOne) UpdateEncryptSnapshots() *TeamUpsertOne {
+	return u.Update(func(s *TeamUpsert) {
+		s.UpdateEncryptSnapshots()
+	})
+}
+
+// SetReplicationRegions sets the "replication_regions" field.
+func (u *TeamUpsertOne) SetReplicationRegions(v []string) *TeamUpsertOne {
+	return u.Update(func(s *TeamUpsert) {
+		s.SetReplicationRegions(v)
+	})
+}
+
+// UpdateReplicationRegions sets the "replication_regions" field to the value that was provided on create.
+func (u *TeamUpsertOne) UpdateReplicationRegions() *TeamUpsertOne {
+	return u.Update(func(s *TeamUpsert) {
+		s.UpdateReplicationRegions()
+	})
+}
+
+// ClearReplicationRegions clears the value of the "replication_regions" field.
+func (u *TeamUpsertOne) ClearReplicationRegions() *TeamUpsertOne {
+	return u.Update(func(s *TeamUpsert) {
+		s.ClearReplicationRegions()
+	})
+}
+
+// SetBuildLogRetentionDays sets the "build_log_retention_days" field.
+func (u *TeamUpsertOne) SetBuildLogRetentionDays(v int32) *TeamUpsertOne {
+	return u.Update(func(s *TeamUpsert) {
+		s.SetBuildLogRetentionDays(v)
+	})
+}
+
+// AddBuildLogRetentionDays adds v to the "build_log_retention_days" field.
+func (u *TeamUpsertOne) AddBuildLogRetentionDays(v int32) *TeamUpsertOne {
+	return u.Update(func(s *TeamUpsert) {
+		s.AddBuildLogRetentionDays(v)
+	})
+}
+
+// UpdateBuildLogRetentionDays sets the "build_log_retention_days" field to the value that was provided on create.
+func (u *TeamUpsertOne) UpdateBuildLogRetentionDays() *TeamUpsertOne {
+	return u.Update(func(s *TeamUpsert) {
+		s.UpdateBuildLogRetentionDays()
+	})
+}
+
 // Exec executes the query.
 func (u *TeamUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1015,6 +1179,62 @@ func (u *TeamUpsertBulk) UpdateEmail() *TeamUpsertBulk {
 	})
 }
 
+// SetEncryptSnapshots sets the "encrypt_snapshots" field.
+func (u *TeamUpsertBulk) SetEncryptSnapshots(v bool) *TeamUpsertBulk {
+	return u.Update(func(s *TeamUpsert) {
+		s.SetEncryptSnapshots(v)
+	})
+}
+
+// UpdateEncryptSnapshots sets the "encrypt_snapshots" field to the value that was provided on create.
+func (u *TeamUpsertBulk) UpdateEncryptSnapshots() *TeamUpsertBulk {
+	return u.Update(func(s *TeamUpsert) {
+		s.UpdateEncryptSnapshots()
+	})
+}
+
+// SetReplicationRegions sets the "replication_regions" field.
+func (u *TeamUpsertBulk) SetReplicationRegions(v []string) *TeamUpsertBulk {
+	return u.Update(func(s *TeamUpsert) {
+		s.SetReplicationRegions(v)
+	})
+}
+
+// UpdateReplicationRegions sets the "replication_regions" field to the value that was provided on create.
+func (u *TeamUpsertBulk) UpdateReplicationRegions() *TeamUpsertBulk {
+	return u.Update(func(s *TeamUpsert) {
+		s.UpdateReplicationRegions()
+	})
+}
+
+// ClearReplicationRegions clears the value of the "replication_regions" field.
+func (u *TeamUpsertBulk) ClearReplicationRegions() *TeamUpsertBulk {
+	return u.Update(func(s *TeamUpsert) {
+		s.ClearReplicationRegions()
+	})
+}
+
+// SetBuildLogRetentionDays sets the "build_log_retention_days" field.
+func (u *TeamUpsertBulk) SetBuildLogRetentionDays(v int32) *TeamUpsertBulk {
+	return u.Update(func(s *TeamUpsert) {
+		s.SetBuildLogRetentionDays(v)
+	})
+}
+
+// AddBuildLogRetentionDays adds v to the "build_log_retention_days" field.
+func (u *TeamUpsertBulk) AddBuildLogRetentionDays(v int32) *TeamUpsertBulk {
+	return u.Update(func(s *TeamUpsert) {
+		s.AddBuildLogRetentionDays(v)
+	})
+}
+
+// UpdateBuildLogRetentionDays sets the "build_log_retention_days" field to the value that was provided on create.
+func (u *TeamUpsertBulk) UpdateBuildLogRetentionDays() *TeamUpsertBulk {
+	return u.Update(func(s *TeamUpsert) {
+		s.UpdateBuildLogRetentionDays()
+	})
+}
+
 // Exec executes the query.
 func (u *TeamUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
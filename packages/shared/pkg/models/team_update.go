@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
@@ -137,6 +138,59 @@ func (tu *TeamUpdate) SetNillableEmail(s *string) *TeamUpdate {
 	return tu
 }
 
+// SetEncryptSnapshots sets the "encrypt_snapshots" field.
+func (tu *TeamUpdate) SetEncryptSnapshots(b bool) *TeamUpdate {
+	tu.mutation.SetEncryptSnapshots(b)
+	return tu
+}
+
+// SetNillableEncryptSnapshots sets the "encrypt_snapshots" field if the given value is not nil.
+func (tu *TeamUpdate) SetNillableEncryptSnapshots(b *bool) *TeamUpdate {
+	if b != nil {
+		tu.SetEncryptSnapshots(*b)
+	}
+	return tu
+}
+
+// SetReplicationRegions sets the "replication_regions" field.
+func (tu *TeamUpdate) SetReplicationRegions(s []string) *TeamUpdate {
+	tu.mutation.SetReplicationRegions(s)
+	return tu
+}
+
+// AppendReplicationRegions appends s to the "replication_regions" field.
+func (tu *TeamUpdate) AppendReplicationRegions(s []string) *TeamUpdate {
+	tu.mutation.AppendReplicationRegions(s)
+	return tu
+}
+
+// ClearReplicationRegions clears the value of the "replication_regions" field.
+func (tu *TeamUpdate) ClearReplicationRegions() *TeamUpdate {
+	tu.mutation.ClearReplicationRegions()
+	return tu
+}
+
+// SetBuildLogRetentionDays sets the "build_log_retention_days" field.
+func (tu *TeamUpdate) SetBuildLogRetentionDays(i int32) *TeamUpdate {
+	tu.mutation.ResetBuildLogRetentionDays()
+	tu.mutation.SetBuildLogRetentionDays(i)
+	return tu
+}
+
+// SetNillableBuildLogRetentionDays sets the "build_log_retention_days" field if the given value is not nil.
+func (tu *TeamUpdate) SetNillableBuildLogRetentionDays(i *int32) *TeamUpdate {
+	if i != nil {
+		tu.SetBuildLogRetentionDays(*i)
+	}
+	return tu
+}
+
+// AddBuildLogRetentionDays adds i to the "build_log_retention_days" field.
+func (tu *TeamUpdate) AddBuildLogRetentionDays(i int32) *TeamUpdate {
+	tu.mutation.AddBuildLogRetentionDays(i)
+	return tu
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (tu *TeamUpdate) AddUserIDs(ids ...uuid.UUID) *TeamUpdate {
 	tu.mutation.AddUserIDs(ids...)
@@ -385,6 +439,26 @@ func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.Email(); ok {
 		_spec.SetField(team.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := tu.mutation.EncryptSnapshots(); ok {
+		_spec.SetField(team.FieldEncryptSnapshots, field.TypeBool, value)
+	}
+	if value, ok := tu.mutation.ReplicationRegions(); ok {
+		_spec.SetField(team.FieldReplicationRegions, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedReplicationRegions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, team.FieldReplicationRegions, value)
+		})
+	}
+	if tu.mutation.ReplicationRegionsCleared() {
+		_spec.ClearField(team.FieldReplicationRegions, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.BuildLogRetentionDays(); ok {
+		_spec.SetField(team.FieldBuildLogRetentionDays, field.TypeInt32, value)
+	}
+	if value, ok := tu.mutation.AddedBuildLogRetentionDays(); ok {
+		_spec.AddField(team.FieldBuildLogRetentionDays, field.TypeInt32, value)
+	}
 	if tu.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -746,6 +820,59 @@ func (tuo *TeamUpdateOne) SetNillableEmail(s *string) *TeamUpdateOne {
 	return tuo
 }
 
+// SetEncryptSnapshots sets the "encrypt_snapshots" field.
+func (tuo *TeamUpdateOne) SetEncryptSnapshots(b bool) *TeamUpdateOne {
+	tuo.mutation.SetEncryptSnapshots(b)
+	return tuo
+}
+
+// SetNillableEncryptSnapshots sets the "encrypt_snapshots" field if the given value is not nil.
+func (tuo *TeamUpdateOne) SetNillableEncryptSnapshots(b *bool) *TeamUpdateOne {
+	if b != nil {
+		tuo.SetEncryptSnapshots(*b)
+	}
+	return tuo
+}
+
+// SetReplicationRegions sets the "replication_regions" field.
+func (tuo *TeamUpdateOne) SetReplicationRegions(s []string) *TeamUpdateOne {
+	tuo.mutation.SetReplicationRegions(s)
+	return tuo
+}
+
+// AppendReplicationRegions appends s to the "replication_regions" field.
+func (tuo *TeamUpdateOne) AppendReplicationRegions(s []string) *TeamUpdateOne {
+	tuo.mutation.AppendReplicationRegions(s)
+	return tuo
+}
+
+// ClearReplicationRegions clears the value of the "replication_regions" field.
+func (tuo *TeamUpdateOne) ClearReplicationRegions() *TeamUpdateOne {
+	tuo.mutation.ClearReplicationRegions()
+	return tuo
+}
+
+// SetBuildLogRetentionDays sets the "build_log_retention_days" field.
+func (tuo *TeamUpdateOne) SetBuildLogRetentionDays(i int32) *TeamUpdateOne {
+	tuo.mutation.ResetBuildLogRetentionDays()
+	tuo.mutation.SetBuildLogRetentionDays(i)
+	return tuo
+}
+
+// SetNillableBuildLogRetentionDays sets the "build_log_retention_days" field if the given value is not nil.
+func (tuo *TeamUpdateOne) SetNillableBuildLogRetentionDays(i *int32) *TeamUpdateOne {
+	if i != nil {
+		tuo.SetBuildLogRetentionDays(*i)
+	}
+	return tuo
+}
+
+// AddBuildLogRetentionDays adds i to the "build_log_retention_days" field.
+func (tuo *TeamUpdateOne) AddBuildLogRetentionDays(i int32) *TeamUpdateOne {
+	tuo.mutation.AddBuildLogRetentionDays(i)
+	return tuo
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (tuo *TeamUpdateOne) AddUserIDs(ids ...uuid.UUID) *TeamUpdateOne {
 	tuo.mutation.AddUserIDs(ids...)
@@ -1024,6 +1151,26 @@ func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error)
 	if value, ok := tuo.mutation.Email(); ok {
 		_spec.SetField(team.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := tuo.mutation.EncryptSnapshots(); ok {
+		_spec.SetField(team.FieldEncryptSnapshots, field.TypeBool, value)
+	}
+	if value, ok := tuo.mutation.ReplicationRegions(); ok {
+		_spec.SetField(team.FieldReplicationRegions, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedReplicationRegions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, team.FieldReplicationRegions, value)
+		})
+	}
+	if tuo.mutation.ReplicationRegionsCleared() {
+		_spec.ClearField(team.FieldReplicationRegions, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.BuildLogRetentionDays(); ok {
+		_spec.SetField(team.FieldBuildLogRetentionDays, field.TypeInt32, value)
+	}
+	if value, ok := tuo.mutation.AddedBuildLogRetentionDays(); ok {
+		_spec.AddField(team.FieldBuildLogRetentionDays, field.TypeInt32, value)
+	}
 	if tuo.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -34,6 +35,8 @@ type TeamAPIKey struct {
 	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
 	// LastUsed holds the value of the "last_used" field.
 	LastUsed *time.Time `json:"last_used,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TeamAPIKeyQuery when eager-loading is set.
 	Edges        TeamAPIKeyEdges `json:"edges"`
@@ -84,6 +87,8 @@ func (*TeamAPIKey) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case teamapikey.FieldCreatedBy:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case teamapikey.FieldScopes:
+			values[i] = new([]byte)
 		case teamapikey.FieldAPIKey, teamapikey.FieldName:
 			values[i] = new(sql.NullString)
 		case teamapikey.FieldCreatedAt, teamapikey.FieldUpdatedAt, teamapikey.FieldLastUsed:
@@ -156,6 +161,14 @@ func (tak *TeamAPIKey) assignValues(columns []string, values []any) error {
 				tak.LastUsed = new(time.Time)
 				*tak.LastUsed = value.Time
 			}
+		case teamapikey.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tak.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
 		default:
 			tak.selectValues.Set(columns[i], values[i])
 		}
@@ -227,6 +240,9 @@ func (tak *TeamAPIKey) String() string {
 		builder.WriteString("last_used=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", tak.Scopes))
 	builder.WriteByte(')')
 	return builder.String()
 }
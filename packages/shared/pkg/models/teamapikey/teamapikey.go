@@ -28,6 +28,8 @@ const (
 	FieldCreatedBy = "created_by"
 	// FieldLastUsed holds the string denoting the last_used field in the database.
 	FieldLastUsed = "last_used"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
 	// EdgeTeam holds the string denoting the team edge name in mutations.
 	EdgeTeam = "team"
 	// EdgeCreator holds the string denoting the creator edge name in mutations.
@@ -60,6 +62,7 @@ var Columns = []string{
 	FieldName,
 	FieldCreatedBy,
 	FieldLastUsed,
+	FieldScopes,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
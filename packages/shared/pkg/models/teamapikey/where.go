@@ -412,6 +412,16 @@ func LastUsedNotNil() predicate.TeamAPIKey {
 	return predicate.TeamAPIKey(sql.FieldNotNull(FieldLastUsed))
 }
 
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldNotNull(FieldScopes))
+}
+
 // HasTeam applies the HasEdge predicate on the "team" edge.
 func HasTeam() predicate.TeamAPIKey {
 	return predicate.TeamAPIKey(func(s *sql.Selector) {
@@ -108,6 +108,12 @@ func (takc *TeamAPIKeyCreate) SetNillableLastUsed(t *time.Time) *TeamAPIKeyCreat
 	return takc
 }
 
+// SetScopes sets the "scopes" field.
+func (takc *TeamAPIKeyCreate) SetScopes(s []string) *TeamAPIKeyCreate {
+	takc.mutation.SetScopes(s)
+	return takc
+}
+
 // SetID sets the "id" field.
 func (takc *TeamAPIKeyCreate) SetID(u uuid.UUID) *TeamAPIKeyCreate {
 	takc.mutation.SetID(u)
@@ -257,6 +263,10 @@ func (takc *TeamAPIKeyCreate) createSpec() (*TeamAPIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(teamapikey.FieldLastUsed, field.TypeTime, value)
 		_node.LastUsed = &value
 	}
+	if value, ok := takc.mutation.Scopes(); ok {
+		_spec.SetField(teamapikey.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
 	if nodes := takc.mutation.TeamIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -435,6 +445,24 @@ func (u *TeamAPIKeyUpsert) ClearLastUsed() *TeamAPIKeyUpsert {
 	return u
 }
 
+// SetScopes sets the "scopes" field.
+func (u *TeamAPIKeyUpsert) SetScopes(v []string) *TeamAPIKeyUpsert {
+	u.Set(teamapikey.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsert) UpdateScopes() *TeamAPIKeyUpsert {
+	u.SetExcluded(teamapikey.FieldScopes)
+	return u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TeamAPIKeyUpsert) ClearScopes() *TeamAPIKeyUpsert {
+	u.SetNull(teamapikey.FieldScopes)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -591,6 +619,27 @@ func (u *TeamAPIKeyUpsertOne) ClearLastUsed() *TeamAPIKeyUpsertOne {
 	})
 }
 
+// SetScopes sets the "scopes" field.
+func (u *TeamAPIKeyUpsertOne) SetScopes(v []string) *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertOne) UpdateScopes() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TeamAPIKeyUpsertOne) ClearScopes() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearScopes()
+	})
+}
+
 // Exec executes the query.
 func (u *TeamAPIKeyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -914,6 +963,27 @@ func (u *TeamAPIKeyUpsertBulk) ClearLastUsed() *TeamAPIKeyUpsertBulk {
 	})
 }
 
+// SetScopes sets the "scopes" field.
+func (u *TeamAPIKeyUpsertBulk) SetScopes(v []string) *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertBulk) UpdateScopes() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TeamAPIKeyUpsertBulk) ClearScopes() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearScopes()
+	})
+}
+
 // Exec executes the query.
 func (u *TeamAPIKeyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
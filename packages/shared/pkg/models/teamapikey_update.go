@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
@@ -135,6 +136,24 @@ func (taku *TeamAPIKeyUpdate) ClearLastUsed() *TeamAPIKeyUpdate {
 	return taku
 }
 
+// SetScopes sets the "scopes" field.
+func (taku *TeamAPIKeyUpdate) SetScopes(s []string) *TeamAPIKeyUpdate {
+	taku.mutation.SetScopes(s)
+	return taku
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (taku *TeamAPIKeyUpdate) AppendScopes(s []string) *TeamAPIKeyUpdate {
+	taku.mutation.AppendScopes(s)
+	return taku
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (taku *TeamAPIKeyUpdate) ClearScopes() *TeamAPIKeyUpdate {
+	taku.mutation.ClearScopes()
+	return taku
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (taku *TeamAPIKeyUpdate) SetTeam(t *Team) *TeamAPIKeyUpdate {
 	return taku.SetTeamID(t.ID)
@@ -247,6 +266,17 @@ func (taku *TeamAPIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if taku.mutation.LastUsedCleared() {
 		_spec.ClearField(teamapikey.FieldLastUsed, field.TypeTime)
 	}
+	if value, ok := taku.mutation.Scopes(); ok {
+		_spec.SetField(teamapikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := taku.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamapikey.FieldScopes, value)
+		})
+	}
+	if taku.mutation.ScopesCleared() {
+		_spec.ClearField(teamapikey.FieldScopes, field.TypeJSON)
+	}
 	if taku.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -435,6 +465,24 @@ func (takuo *TeamAPIKeyUpdateOne) ClearLastUsed() *TeamAPIKeyUpdateOne {
 	return takuo
 }
 
+// SetScopes sets the "scopes" field.
+func (takuo *TeamAPIKeyUpdateOne) SetScopes(s []string) *TeamAPIKeyUpdateOne {
+	takuo.mutation.SetScopes(s)
+	return takuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (takuo *TeamAPIKeyUpdateOne) AppendScopes(s []string) *TeamAPIKeyUpdateOne {
+	takuo.mutation.AppendScopes(s)
+	return takuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (takuo *TeamAPIKeyUpdateOne) ClearScopes() *TeamAPIKeyUpdateOne {
+	takuo.mutation.ClearScopes()
+	return takuo
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (takuo *TeamAPIKeyUpdateOne) SetTeam(t *Team) *TeamAPIKeyUpdateOne {
 	return takuo.SetTeamID(t.ID)
@@ -577,6 +625,17 @@ func (takuo *TeamAPIKeyUpdateOne) sqlSave(ctx context.Context) (_node *TeamAPIKe
 	if takuo.mutation.LastUsedCleared() {
 		_spec.ClearField(teamapikey.FieldLastUsed, field.TypeTime)
 	}
+	if value, ok := takuo.mutation.Scopes(); ok {
+		_spec.SetField(teamapikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := takuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamapikey.FieldScopes, value)
+		})
+	}
+	if takuo.mutation.ScopesCleared() {
+		_spec.ClearField(teamapikey.FieldScopes, field.TypeJSON)
+	}
 	if takuo.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
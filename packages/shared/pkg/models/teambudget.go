@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+	"github.com/google/uuid"
+)
+
+// TeamBudget is the model entity for the TeamBudget schema.
+type TeamBudget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// MonthlyLimitUsd holds the value of the "monthly_limit_usd" field.
+	MonthlyLimitUsd float64 `json:"monthly_limit_usd,omitempty"`
+	// WarningPercent holds the value of the "warning_percent" field.
+	WarningPercent int32 `json:"warning_percent,omitempty"`
+	// PauseSandboxes holds the value of the "pause_sandboxes" field.
+	PauseSandboxes bool `json:"pause_sandboxes,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// AccruedUsd holds the value of the "accrued_usd" field.
+	AccruedUsd float64 `json:"accrued_usd,omitempty"`
+	// MeteredAt holds the value of the "metered_at" field.
+	MeteredAt time.Time `json:"metered_at,omitempty"`
+	// WarnedAt holds the value of the "warned_at" field.
+	WarnedAt *time.Time `json:"warned_at,omitempty"`
+	// ExceededAt holds the value of the "exceeded_at" field.
+	ExceededAt *time.Time `json:"exceeded_at,omitempty"`
+	// OverrideUntil holds the value of the "override_until" field.
+	OverrideUntil *time.Time `json:"override_until,omitempty"`
+	// OverrideReason holds the value of the "override_reason" field.
+	OverrideReason *string `json:"override_reason,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamBudget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teambudget.FieldPauseSandboxes:
+			values[i] = new(sql.NullBool)
+		case teambudget.FieldMonthlyLimitUsd, teambudget.FieldAccruedUsd:
+			values[i] = new(sql.NullFloat64)
+		case teambudget.FieldWarningPercent:
+			values[i] = new(sql.NullInt64)
+		case teambudget.FieldOverrideReason:
+			values[i] = new(sql.NullString)
+		case teambudget.FieldCreatedAt, teambudget.FieldUpdatedAt, teambudget.FieldPeriodStart, teambudget.FieldMeteredAt, teambudget.FieldWarnedAt, teambudget.FieldExceededAt, teambudget.FieldOverrideUntil:
+			values[i] = new(sql.NullTime)
+		case teambudget.FieldID, teambudget.FieldTeamID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamBudget fields.
+func (tb *TeamBudget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teambudget.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tb.ID = *value
+			}
+		case teambudget.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tb.CreatedAt = value.Time
+			}
+		case teambudget.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tb.UpdatedAt = value.Time
+			}
+		case teambudget.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				tb.TeamID = *value
+			}
+		case teambudget.FieldMonthlyLimitUsd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_limit_usd", values[i])
+			} else if value.Valid {
+				tb.MonthlyLimitUsd = value.Float64
+			}
+		case teambudget.FieldWarningPercent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field warning_percent", values[i])
+			} else if value.Valid {
+				tb.WarningPercent = int32(value.Int64)
+			}
+		case teambudget.FieldPauseSandboxes:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pause_sandboxes", values[i])
+			} else if value.Valid {
+				tb.PauseSandboxes = value.Bool
+			}
+		case teambudget.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				tb.PeriodStart = value.Time
+			}
+		case teambudget.FieldAccruedUsd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field accrued_usd", values[i])
+			} else if value.Valid {
+				tb.AccruedUsd = value.Float64
+			}
+		case teambudget.FieldMeteredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field metered_at", values[i])
+			} else if value.Valid {
+				tb.MeteredAt = value.Time
+			}
+		case teambudget.FieldWarnedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field warned_at", values[i])
+			} else if value.Valid {
+				tb.WarnedAt = new(time.Time)
+				*tb.WarnedAt = value.Time
+			}
+		case teambudget.FieldExceededAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field exceeded_at", values[i])
+			} else if value.Valid {
+				tb.ExceededAt = new(time.Time)
+				*tb.ExceededAt = value.Time
+			}
+		case teambudget.FieldOverrideUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field override_until", values[i])
+			} else if value.Valid {
+				tb.OverrideUntil = new(time.Time)
+				*tb.OverrideUntil = value.Time
+			}
+		case teambudget.FieldOverrideReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field override_reason", values[i])
+			} else if value.Valid {
+				tb.OverrideReason = new(string)
+				*tb.OverrideReason = value.String
+			}
+		default:
+			tb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamBudget.
+// This includes values selected through modifiers, order, etc.
+func (tb *TeamBudget) Value(name string) (ent.Value, error) {
+	return tb.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TeamBudget.
+// Note that you need to call TeamBudget.Unwrap() before calling this method if this TeamBudget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tb *TeamBudget) Update() *TeamBudgetUpdateOne {
+	return NewTeamBudgetClient(tb.config).UpdateOne(tb)
+}
+
+// Unwrap unwraps the TeamBudget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tb *TeamBudget) Unwrap() *TeamBudget {
+	_tx, ok := tb.config.driver.(*txDriver)
+	if !ok {
+		panic("models: TeamBudget is not a transactional entity")
+	}
+	tb.config.driver = _tx.drv
+	return tb
+}
+
+// String implements the fmt.Stringer.
+func (tb *TeamBudget) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamBudget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tb.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tb.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", tb.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("monthly_limit_usd=")
+	builder.WriteString(fmt.Sprintf("%v", tb.MonthlyLimitUsd))
+	builder.WriteString(", ")
+	builder.WriteString("warning_percent=")
+	builder.WriteString(fmt.Sprintf("%v", tb.WarningPercent))
+	builder.WriteString(", ")
+	builder.WriteString("pause_sandboxes=")
+	builder.WriteString(fmt.Sprintf("%v", tb.PauseSandboxes))
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(tb.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("accrued_usd=")
+	builder.WriteString(fmt.Sprintf("%v", tb.AccruedUsd))
+	builder.WriteString(", ")
+	builder.WriteString("metered_at=")
+	builder.WriteString(tb.MeteredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tb.WarnedAt; v != nil {
+		builder.WriteString("warned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := tb.ExceededAt; v != nil {
+		builder.WriteString("exceeded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := tb.OverrideUntil; v != nil {
+		builder.WriteString("override_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := tb.OverrideReason; v != nil {
+		builder.WriteString("override_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamBudgets is a parsable slice of TeamBudget.
+type TeamBudgets []*TeamBudget
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teambudget
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the teambudget type in the database.
+	Label = "team_budget"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldMonthlyLimitUsd holds the string denoting the monthly_limit_usd field in the database.
+	FieldMonthlyLimitUsd = "monthly_limit_usd"
+	// FieldWarningPercent holds the string denoting the warning_percent field in the database.
+	FieldWarningPercent = "warning_percent"
+	// FieldPauseSandboxes holds the string denoting the pause_sandboxes field in the database.
+	FieldPauseSandboxes = "pause_sandboxes"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldAccruedUsd holds the string denoting the accrued_usd field in the database.
+	FieldAccruedUsd = "accrued_usd"
+	// FieldMeteredAt holds the string denoting the metered_at field in the database.
+	FieldMeteredAt = "metered_at"
+	// FieldWarnedAt holds the string denoting the warned_at field in the database.
+	FieldWarnedAt = "warned_at"
+	// FieldExceededAt holds the string denoting the exceeded_at field in the database.
+	FieldExceededAt = "exceeded_at"
+	// FieldOverrideUntil holds the string denoting the override_until field in the database.
+	FieldOverrideUntil = "override_until"
+	// FieldOverrideReason holds the string denoting the override_reason field in the database.
+	FieldOverrideReason = "override_reason"
+	// Table holds the table name of the teambudget in the database.
+	Table = "team_budgets"
+)
+
+// Columns holds all SQL columns for teambudget fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldTeamID,
+	FieldMonthlyLimitUsd,
+	FieldWarningPercent,
+	FieldPauseSandboxes,
+	FieldPeriodStart,
+	FieldAccruedUsd,
+	FieldMeteredAt,
+	FieldWarnedAt,
+	FieldExceededAt,
+	FieldOverrideUntil,
+	FieldOverrideReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultWarningPercent holds the default value on creation for the "warning_percent" field.
+	DefaultWarningPercent int32
+	// DefaultPauseSandboxes holds the default value on creation for the "pause_sandboxes" field.
+	DefaultPauseSandboxes bool
+	// DefaultAccruedUsd holds the default value on creation for the "accrued_usd" field.
+	DefaultAccruedUsd float64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the TeamBudget queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByMonthlyLimitUsd orders the results by the monthly_limit_usd field.
+func ByMonthlyLimitUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyLimitUsd, opts...).ToFunc()
+}
+
+// ByWarningPercent orders the results by the warning_percent field.
+func ByWarningPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarningPercent, opts...).ToFunc()
+}
+
+// ByPauseSandboxes orders the results by the pause_sandboxes field.
+func ByPauseSandboxes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPauseSandboxes, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByAccruedUsd orders the results by the accrued_usd field.
+func ByAccruedUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccruedUsd, opts...).ToFunc()
+}
+
+// ByMeteredAt orders the results by the metered_at field.
+func ByMeteredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMeteredAt, opts...).ToFunc()
+}
+
+// ByWarnedAt orders the results by the warned_at field.
+func ByWarnedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarnedAt, opts...).ToFunc()
+}
+
+// ByExceededAt orders the results by the exceeded_at field.
+func ByExceededAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExceededAt, opts...).ToFunc()
+}
+
+// ByOverrideUntil orders the results by the override_until field.
+func ByOverrideUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverrideUntil, opts...).ToFunc()
+}
+
+// ByOverrideReason orders the results by the override_reason field.
+func ByOverrideReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverrideReason, opts...).ToFunc()
+}
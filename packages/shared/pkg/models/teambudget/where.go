@@ -0,0 +1,691 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teambudget
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldTeamID, v))
+}
+
+// MonthlyLimitUsd applies equality check predicate on the "monthly_limit_usd" field. It's identical to MonthlyLimitUsdEQ.
+func MonthlyLimitUsd(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldMonthlyLimitUsd, v))
+}
+
+// WarningPercent applies equality check predicate on the "warning_percent" field. It's identical to WarningPercentEQ.
+func WarningPercent(v int32) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldWarningPercent, v))
+}
+
+// PauseSandboxes applies equality check predicate on the "pause_sandboxes" field. It's identical to PauseSandboxesEQ.
+func PauseSandboxes(v bool) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldPauseSandboxes, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// AccruedUsd applies equality check predicate on the "accrued_usd" field. It's identical to AccruedUsdEQ.
+func AccruedUsd(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldAccruedUsd, v))
+}
+
+// MeteredAt applies equality check predicate on the "metered_at" field. It's identical to MeteredAtEQ.
+func MeteredAt(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldMeteredAt, v))
+}
+
+// WarnedAt applies equality check predicate on the "warned_at" field. It's identical to WarnedAtEQ.
+func WarnedAt(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldWarnedAt, v))
+}
+
+// ExceededAt applies equality check predicate on the "exceeded_at" field. It's identical to ExceededAtEQ.
+func ExceededAt(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldExceededAt, v))
+}
+
+// OverrideUntil applies equality check predicate on the "override_until" field. It's identical to OverrideUntilEQ.
+func OverrideUntil(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldOverrideUntil, v))
+}
+
+// OverrideReason applies equality check predicate on the "override_reason" field. It's identical to OverrideReasonEQ.
+func OverrideReason(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldOverrideReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v uuid.UUID) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldTeamID, v))
+}
+
+// MonthlyLimitUsdEQ applies the EQ predicate on the "monthly_limit_usd" field.
+func MonthlyLimitUsdEQ(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldMonthlyLimitUsd, v))
+}
+
+// MonthlyLimitUsdNEQ applies the NEQ predicate on the "monthly_limit_usd" field.
+func MonthlyLimitUsdNEQ(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldMonthlyLimitUsd, v))
+}
+
+// MonthlyLimitUsdIn applies the In predicate on the "monthly_limit_usd" field.
+func MonthlyLimitUsdIn(vs ...float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldMonthlyLimitUsd, vs...))
+}
+
+// MonthlyLimitUsdNotIn applies the NotIn predicate on the "monthly_limit_usd" field.
+func MonthlyLimitUsdNotIn(vs ...float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldMonthlyLimitUsd, vs...))
+}
+
+// MonthlyLimitUsdGT applies the GT predicate on the "monthly_limit_usd" field.
+func MonthlyLimitUsdGT(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldMonthlyLimitUsd, v))
+}
+
+// MonthlyLimitUsdGTE applies the GTE predicate on the "monthly_limit_usd" field.
+func MonthlyLimitUsdGTE(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldMonthlyLimitUsd, v))
+}
+
+// MonthlyLimitUsdLT applies the LT predicate on the "monthly_limit_usd" field.
+func MonthlyLimitUsdLT(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldMonthlyLimitUsd, v))
+}
+
+// MonthlyLimitUsdLTE applies the LTE predicate on the "monthly_limit_usd" field.
+func MonthlyLimitUsdLTE(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldMonthlyLimitUsd, v))
+}
+
+// WarningPercentEQ applies the EQ predicate on the "warning_percent" field.
+func WarningPercentEQ(v int32) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldWarningPercent, v))
+}
+
+// WarningPercentNEQ applies the NEQ predicate on the "warning_percent" field.
+func WarningPercentNEQ(v int32) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldWarningPercent, v))
+}
+
+// WarningPercentIn applies the In predicate on the "warning_percent" field.
+func WarningPercentIn(vs ...int32) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldWarningPercent, vs...))
+}
+
+// WarningPercentNotIn applies the NotIn predicate on the "warning_percent" field.
+func WarningPercentNotIn(vs ...int32) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldWarningPercent, vs...))
+}
+
+// WarningPercentGT applies the GT predicate on the "warning_percent" field.
+func WarningPercentGT(v int32) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldWarningPercent, v))
+}
+
+// WarningPercentGTE applies the GTE predicate on the "warning_percent" field.
+func WarningPercentGTE(v int32) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldWarningPercent, v))
+}
+
+// WarningPercentLT applies the LT predicate on the "warning_percent" field.
+func WarningPercentLT(v int32) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldWarningPercent, v))
+}
+
+// WarningPercentLTE applies the LTE predicate on the "warning_percent" field.
+func WarningPercentLTE(v int32) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldWarningPercent, v))
+}
+
+// PauseSandboxesEQ applies the EQ predicate on the "pause_sandboxes" field.
+func PauseSandboxesEQ(v bool) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldPauseSandboxes, v))
+}
+
+// PauseSandboxesNEQ applies the NEQ predicate on the "pause_sandboxes" field.
+func PauseSandboxesNEQ(v bool) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldPauseSandboxes, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// AccruedUsdEQ applies the EQ predicate on the "accrued_usd" field.
+func AccruedUsdEQ(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldAccruedUsd, v))
+}
+
+// AccruedUsdNEQ applies the NEQ predicate on the "accrued_usd" field.
+func AccruedUsdNEQ(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldAccruedUsd, v))
+}
+
+// AccruedUsdIn applies the In predicate on the "accrued_usd" field.
+func AccruedUsdIn(vs ...float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldAccruedUsd, vs...))
+}
+
+// AccruedUsdNotIn applies the NotIn predicate on the "accrued_usd" field.
+func AccruedUsdNotIn(vs ...float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldAccruedUsd, vs...))
+}
+
+// AccruedUsdGT applies the GT predicate on the "accrued_usd" field.
+func AccruedUsdGT(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldAccruedUsd, v))
+}
+
+// AccruedUsdGTE applies the GTE predicate on the "accrued_usd" field.
+func AccruedUsdGTE(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldAccruedUsd, v))
+}
+
+// AccruedUsdLT applies the LT predicate on the "accrued_usd" field.
+func AccruedUsdLT(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldAccruedUsd, v))
+}
+
+// AccruedUsdLTE applies the LTE predicate on the "accrued_usd" field.
+func AccruedUsdLTE(v float64) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldAccruedUsd, v))
+}
+
+// MeteredAtEQ applies the EQ predicate on the "metered_at" field.
+func MeteredAtEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldMeteredAt, v))
+}
+
+// MeteredAtNEQ applies the NEQ predicate on the "metered_at" field.
+func MeteredAtNEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldMeteredAt, v))
+}
+
+// MeteredAtIn applies the In predicate on the "metered_at" field.
+func MeteredAtIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldMeteredAt, vs...))
+}
+
+// MeteredAtNotIn applies the NotIn predicate on the "metered_at" field.
+func MeteredAtNotIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldMeteredAt, vs...))
+}
+
+// MeteredAtGT applies the GT predicate on the "metered_at" field.
+func MeteredAtGT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldMeteredAt, v))
+}
+
+// MeteredAtGTE applies the GTE predicate on the "metered_at" field.
+func MeteredAtGTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldMeteredAt, v))
+}
+
+// MeteredAtLT applies the LT predicate on the "metered_at" field.
+func MeteredAtLT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldMeteredAt, v))
+}
+
+// MeteredAtLTE applies the LTE predicate on the "metered_at" field.
+func MeteredAtLTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldMeteredAt, v))
+}
+
+// WarnedAtEQ applies the EQ predicate on the "warned_at" field.
+func WarnedAtEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldWarnedAt, v))
+}
+
+// WarnedAtNEQ applies the NEQ predicate on the "warned_at" field.
+func WarnedAtNEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldWarnedAt, v))
+}
+
+// WarnedAtIn applies the In predicate on the "warned_at" field.
+func WarnedAtIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldWarnedAt, vs...))
+}
+
+// WarnedAtNotIn applies the NotIn predicate on the "warned_at" field.
+func WarnedAtNotIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldWarnedAt, vs...))
+}
+
+// WarnedAtGT applies the GT predicate on the "warned_at" field.
+func WarnedAtGT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldWarnedAt, v))
+}
+
+// WarnedAtGTE applies the GTE predicate on the "warned_at" field.
+func WarnedAtGTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldWarnedAt, v))
+}
+
+// WarnedAtLT applies the LT predicate on the "warned_at" field.
+func WarnedAtLT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldWarnedAt, v))
+}
+
+// WarnedAtLTE applies the LTE predicate on the "warned_at" field.
+func WarnedAtLTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldWarnedAt, v))
+}
+
+// WarnedAtIsNil applies the IsNil predicate on the "warned_at" field.
+func WarnedAtIsNil() predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIsNull(FieldWarnedAt))
+}
+
+// WarnedAtNotNil applies the NotNil predicate on the "warned_at" field.
+func WarnedAtNotNil() predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotNull(FieldWarnedAt))
+}
+
+// ExceededAtEQ applies the EQ predicate on the "exceeded_at" field.
+func ExceededAtEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldExceededAt, v))
+}
+
+// ExceededAtNEQ applies the NEQ predicate on the "exceeded_at" field.
+func ExceededAtNEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldExceededAt, v))
+}
+
+// ExceededAtIn applies the In predicate on the "exceeded_at" field.
+func ExceededAtIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldExceededAt, vs...))
+}
+
+// ExceededAtNotIn applies the NotIn predicate on the "exceeded_at" field.
+func ExceededAtNotIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldExceededAt, vs...))
+}
+
+// ExceededAtGT applies the GT predicate on the "exceeded_at" field.
+func ExceededAtGT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldExceededAt, v))
+}
+
+// ExceededAtGTE applies the GTE predicate on the "exceeded_at" field.
+func ExceededAtGTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldExceededAt, v))
+}
+
+// ExceededAtLT applies the LT predicate on the "exceeded_at" field.
+func ExceededAtLT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldExceededAt, v))
+}
+
+// ExceededAtLTE applies the LTE predicate on the "exceeded_at" field.
+func ExceededAtLTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldExceededAt, v))
+}
+
+// ExceededAtIsNil applies the IsNil predicate on the "exceeded_at" field.
+func ExceededAtIsNil() predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIsNull(FieldExceededAt))
+}
+
+// ExceededAtNotNil applies the NotNil predicate on the "exceeded_at" field.
+func ExceededAtNotNil() predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotNull(FieldExceededAt))
+}
+
+// OverrideUntilEQ applies the EQ predicate on the "override_until" field.
+func OverrideUntilEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldOverrideUntil, v))
+}
+
+// OverrideUntilNEQ applies the NEQ predicate on the "override_until" field.
+func OverrideUntilNEQ(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldOverrideUntil, v))
+}
+
+// OverrideUntilIn applies the In predicate on the "override_until" field.
+func OverrideUntilIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldOverrideUntil, vs...))
+}
+
+// OverrideUntilNotIn applies the NotIn predicate on the "override_until" field.
+func OverrideUntilNotIn(vs ...time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldOverrideUntil, vs...))
+}
+
+// OverrideUntilGT applies the GT predicate on the "override_until" field.
+func OverrideUntilGT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldOverrideUntil, v))
+}
+
+// OverrideUntilGTE applies the GTE predicate on the "override_until" field.
+func OverrideUntilGTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldOverrideUntil, v))
+}
+
+// OverrideUntilLT applies the LT predicate on the "override_until" field.
+func OverrideUntilLT(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldOverrideUntil, v))
+}
+
+// OverrideUntilLTE applies the LTE predicate on the "override_until" field.
+func OverrideUntilLTE(v time.Time) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldOverrideUntil, v))
+}
+
+// OverrideUntilIsNil applies the IsNil predicate on the "override_until" field.
+func OverrideUntilIsNil() predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIsNull(FieldOverrideUntil))
+}
+
+// OverrideUntilNotNil applies the NotNil predicate on the "override_until" field.
+func OverrideUntilNotNil() predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotNull(FieldOverrideUntil))
+}
+
+// OverrideReasonEQ applies the EQ predicate on the "override_reason" field.
+func OverrideReasonEQ(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEQ(FieldOverrideReason, v))
+}
+
+// OverrideReasonNEQ applies the NEQ predicate on the "override_reason" field.
+func OverrideReasonNEQ(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNEQ(FieldOverrideReason, v))
+}
+
+// OverrideReasonIn applies the In predicate on the "override_reason" field.
+func OverrideReasonIn(vs ...string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIn(FieldOverrideReason, vs...))
+}
+
+// OverrideReasonNotIn applies the NotIn predicate on the "override_reason" field.
+func OverrideReasonNotIn(vs ...string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotIn(FieldOverrideReason, vs...))
+}
+
+// OverrideReasonGT applies the GT predicate on the "override_reason" field.
+func OverrideReasonGT(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGT(FieldOverrideReason, v))
+}
+
+// OverrideReasonGTE applies the GTE predicate on the "override_reason" field.
+func OverrideReasonGTE(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldGTE(FieldOverrideReason, v))
+}
+
+// OverrideReasonLT applies the LT predicate on the "override_reason" field.
+func OverrideReasonLT(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLT(FieldOverrideReason, v))
+}
+
+// OverrideReasonLTE applies the LTE predicate on the "override_reason" field.
+func OverrideReasonLTE(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldLTE(FieldOverrideReason, v))
+}
+
+// OverrideReasonContains applies the Contains predicate on the "override_reason" field.
+func OverrideReasonContains(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldContains(FieldOverrideReason, v))
+}
+
+// OverrideReasonHasPrefix applies the HasPrefix predicate on the "override_reason" field.
+func OverrideReasonHasPrefix(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldHasPrefix(FieldOverrideReason, v))
+}
+
+// OverrideReasonHasSuffix applies the HasSuffix predicate on the "override_reason" field.
+func OverrideReasonHasSuffix(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldHasSuffix(FieldOverrideReason, v))
+}
+
+// OverrideReasonIsNil applies the IsNil predicate on the "override_reason" field.
+func OverrideReasonIsNil() predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldIsNull(FieldOverrideReason))
+}
+
+// OverrideReasonNotNil applies the NotNil predicate on the "override_reason" field.
+func OverrideReasonNotNil() predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldNotNull(FieldOverrideReason))
+}
+
+// OverrideReasonEqualFold applies the EqualFold predicate on the "override_reason" field.
+func OverrideReasonEqualFold(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldEqualFold(FieldOverrideReason, v))
+}
+
+// OverrideReasonContainsFold applies the ContainsFold predicate on the "override_reason" field.
+func OverrideReasonContainsFold(v string) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.FieldContainsFold(FieldOverrideReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamBudget) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamBudget) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamBudget) predicate.TeamBudget {
+	return predicate.TeamBudget(sql.NotPredicates(p))
+}
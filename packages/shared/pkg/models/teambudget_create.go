@@ -0,0 +1,1298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+	"github.com/google/uuid"
+)
+
+// TeamBudgetCreate is the builder for creating a TeamBudget entity.
+type TeamBudgetCreate struct {
+	config
+	mutation *TeamBudgetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tbc *TeamBudgetCreate) SetCreatedAt(t time.Time) *TeamBudgetCreate {
+	tbc.mutation.SetCreatedAt(t)
+	return tbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillableCreatedAt(t *time.Time) *TeamBudgetCreate {
+	if t != nil {
+		tbc.SetCreatedAt(*t)
+	}
+	return tbc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tbc *TeamBudgetCreate) SetUpdatedAt(t time.Time) *TeamBudgetCreate {
+	tbc.mutation.SetUpdatedAt(t)
+	return tbc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillableUpdatedAt(t *time.Time) *TeamBudgetCreate {
+	if t != nil {
+		tbc.SetUpdatedAt(*t)
+	}
+	return tbc
+}
+
+// SetTeamID sets the "team_id" field.
+func (tbc *TeamBudgetCreate) SetTeamID(u uuid.UUID) *TeamBudgetCreate {
+	tbc.mutation.SetTeamID(u)
+	return tbc
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (tbc *TeamBudgetCreate) SetMonthlyLimitUsd(f float64) *TeamBudgetCreate {
+	tbc.mutation.SetMonthlyLimitUsd(f)
+	return tbc
+}
+
+// SetWarningPercent sets the "warning_percent" field.
+func (tbc *TeamBudgetCreate) SetWarningPercent(i int32) *TeamBudgetCreate {
+	tbc.mutation.SetWarningPercent(i)
+	return tbc
+}
+
+// SetNillableWarningPercent sets the "warning_percent" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillableWarningPercent(i *int32) *TeamBudgetCreate {
+	if i != nil {
+		tbc.SetWarningPercent(*i)
+	}
+	return tbc
+}
+
+// SetPauseSandboxes sets the "pause_sandboxes" field.
+func (tbc *TeamBudgetCreate) SetPauseSandboxes(b bool) *TeamBudgetCreate {
+	tbc.mutation.SetPauseSandboxes(b)
+	return tbc
+}
+
+// SetNillablePauseSandboxes sets the "pause_sandboxes" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillablePauseSandboxes(b *bool) *TeamBudgetCreate {
+	if b != nil {
+		tbc.SetPauseSandboxes(*b)
+	}
+	return tbc
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (tbc *TeamBudgetCreate) SetPeriodStart(t time.Time) *TeamBudgetCreate {
+	tbc.mutation.SetPeriodStart(t)
+	return tbc
+}
+
+// SetAccruedUsd sets the "accrued_usd" field.
+func (tbc *TeamBudgetCreate) SetAccruedUsd(f float64) *TeamBudgetCreate {
+	tbc.mutation.SetAccruedUsd(f)
+	return tbc
+}
+
+// SetNillableAccruedUsd sets the "accrued_usd" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillableAccruedUsd(f *float64) *TeamBudgetCreate {
+	if f != nil {
+		tbc.SetAccruedUsd(*f)
+	}
+	return tbc
+}
+
+// SetMeteredAt sets the "metered_at" field.
+func (tbc *TeamBudgetCreate) SetMeteredAt(t time.Time) *TeamBudgetCreate {
+	tbc.mutation.SetMeteredAt(t)
+	return tbc
+}
+
+// SetWarnedAt sets the "warned_at" field.
+func (tbc *TeamBudgetCreate) SetWarnedAt(t time.Time) *TeamBudgetCreate {
+	tbc.mutation.SetWarnedAt(t)
+	return tbc
+}
+
+// SetNillableWarnedAt sets the "warned_at" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillableWarnedAt(t *time.Time) *TeamBudgetCreate {
+	if t != nil {
+		tbc.SetWarnedAt(*t)
+	}
+	return tbc
+}
+
+// SetExceededAt sets the "exceeded_at" field.
+func (tbc *TeamBudgetCreate) SetExceededAt(t time.Time) *TeamBudgetCreate {
+	tbc.mutation.SetExceededAt(t)
+	return tbc
+}
+
+// SetNillableExceededAt sets the "exceeded_at" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillableExceededAt(t *time.Time) *TeamBudgetCreate {
+	if t != nil {
+		tbc.SetExceededAt(*t)
+	}
+	return tbc
+}
+
+// SetOverrideUntil sets the "override_until" field.
+func (tbc *TeamBudgetCreate) SetOverrideUntil(t time.Time) *TeamBudgetCreate {
+	tbc.mutation.SetOverrideUntil(t)
+	return tbc
+}
+
+// SetNillableOverrideUntil sets the "override_until" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillableOverrideUntil(t *time.Time) *TeamBudgetCreate {
+	if t != nil {
+		tbc.SetOverrideUntil(*t)
+	}
+	return tbc
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (tbc *TeamBudgetCreate) SetOverrideReason(s string) *TeamBudgetCreate {
+	tbc.mutation.SetOverrideReason(s)
+	return tbc
+}
+
+// SetNillableOverrideReason sets the "override_reason" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillableOverrideReason(s *string) *TeamBudgetCreate {
+	if s != nil {
+		tbc.SetOverrideReason(*s)
+	}
+	return tbc
+}
+
+// SetID sets the "id" field.
+func (tbc *TeamBudgetCreate) SetID(u uuid.UUID) *TeamBudgetCreate {
+	tbc.mutation.SetID(u)
+	return tbc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tbc *TeamBudgetCreate) SetNillableID(u *uuid.UUID) *TeamBudgetCreate {
+	if u != nil {
+		tbc.SetID(*u)
+	}
+	return tbc
+}
+
+// Mutation returns the TeamBudgetMutation object of the builder.
+func (tbc *TeamBudgetCreate) Mutation() *TeamBudgetMutation {
+	return tbc.mutation
+}
+
+// Save creates the TeamBudget in the database.
+func (tbc *TeamBudgetCreate) Save(ctx context.Context) (*TeamBudget, error) {
+	tbc.defaults()
+	return withHooks(ctx, tbc.sqlSave, tbc.mutation, tbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tbc *TeamBudgetCreate) SaveX(ctx context.Context) *TeamBudget {
+	v, err := tbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tbc *TeamBudgetCreate) Exec(ctx context.Context) error {
+	_, err := tbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbc *TeamBudgetCreate) ExecX(ctx context.Context) {
+	if err := tbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tbc *TeamBudgetCreate) defaults() {
+	if _, ok := tbc.mutation.CreatedAt(); !ok {
+		v := teambudget.DefaultCreatedAt()
+		tbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tbc.mutation.UpdatedAt(); !ok {
+		v := teambudget.DefaultUpdatedAt()
+		tbc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tbc.mutation.WarningPercent(); !ok {
+		v := teambudget.DefaultWarningPercent
+		tbc.mutation.SetWarningPercent(v)
+	}
+	if _, ok := tbc.mutation.PauseSandboxes(); !ok {
+		v := teambudget.DefaultPauseSandboxes
+		tbc.mutation.SetPauseSandboxes(v)
+	}
+	if _, ok := tbc.mutation.AccruedUsd(); !ok {
+		v := teambudget.DefaultAccruedUsd
+		tbc.mutation.SetAccruedUsd(v)
+	}
+	if _, ok := tbc.mutation.ID(); !ok {
+		v := teambudget.DefaultID()
+		tbc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tbc *TeamBudgetCreate) check() error {
+	if _, ok := tbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "TeamBudget.created_at"`)}
+	}
+	if _, ok := tbc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`models: missing required field "TeamBudget.updated_at"`)}
+	}
+	if _, ok := tbc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "TeamBudget.team_id"`)}
+	}
+	if _, ok := tbc.mutation.MonthlyLimitUsd(); !ok {
+		return &ValidationError{Name: "monthly_limit_usd", err: errors.New(`models: missing required field "TeamBudget.monthly_limit_usd"`)}
+	}
+	if _, ok := tbc.mutation.WarningPercent(); !ok {
+		return &ValidationError{Name: "warning_percent", err: errors.New(`models: missing required field "TeamBudget.warning_percent"`)}
+	}
+	if _, ok := tbc.mutation.PauseSandboxes(); !ok {
+		return &ValidationError{Name: "pause_sandboxes", err: errors.New(`models: missing required field "TeamBudget.pause_sandboxes"`)}
+	}
+	if _, ok := tbc.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`models: missing required field "TeamBudget.period_start"`)}
+	}
+	if _, ok := tbc.mutation.AccruedUsd(); !ok {
+		return &ValidationError{Name: "accrued_usd", err: errors.New(`models: missing required field "TeamBudget.accrued_usd"`)}
+	}
+	if _, ok := tbc.mutation.MeteredAt(); !ok {
+		return &ValidationError{Name: "metered_at", err: errors.New(`models: missing required field "TeamBudget.metered_at"`)}
+	}
+	return nil
+}
+
+func (tbc *TeamBudgetCreate) sqlSave(ctx context.Context) (*TeamBudget, error) {
+	if err := tbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tbc.mutation.id = &_node.ID
+	tbc.mutation.done = true
+	return _node, nil
+}
+
+func (tbc *TeamBudgetCreate) createSpec() (*TeamBudget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamBudget{config: tbc.config}
+		_spec = sqlgraph.NewCreateSpec(teambudget.Table, sqlgraph.NewFieldSpec(teambudget.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = tbc.schemaConfig.TeamBudget
+	_spec.OnConflict = tbc.conflict
+	if id, ok := tbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tbc.mutation.CreatedAt(); ok {
+		_spec.SetField(teambudget.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tbc.mutation.UpdatedAt(); ok {
+		_spec.SetField(teambudget.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tbc.mutation.TeamID(); ok {
+		_spec.SetField(teambudget.FieldTeamID, field.TypeUUID, value)
+		_node.TeamID = value
+	}
+	if value, ok := tbc.mutation.MonthlyLimitUsd(); ok {
+		_spec.SetField(teambudget.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+		_node.MonthlyLimitUsd = value
+	}
+	if value, ok := tbc.mutation.WarningPercent(); ok {
+		_spec.SetField(teambudget.FieldWarningPercent, field.TypeInt32, value)
+		_node.WarningPercent = value
+	}
+	if value, ok := tbc.mutation.PauseSandboxes(); ok {
+		_spec.SetField(teambudget.FieldPauseSandboxes, field.TypeBool, value)
+		_node.PauseSandboxes = value
+	}
+	if value, ok := tbc.mutation.PeriodStart(); ok {
+		_spec.SetField(teambudget.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := tbc.mutation.AccruedUsd(); ok {
+		_spec.SetField(teambudget.FieldAccruedUsd, field.TypeFloat64, value)
+		_node.AccruedUsd = value
+	}
+	if value, ok := tbc.mutation.MeteredAt(); ok {
+		_spec.SetField(teambudget.FieldMeteredAt, field.TypeTime, value)
+		_node.MeteredAt = value
+	}
+	if value, ok := tbc.mutation.WarnedAt(); ok {
+		_spec.SetField(teambudget.FieldWarnedAt, field.TypeTime, value)
+		_node.WarnedAt = &value
+	}
+	if value, ok := tbc.mutation.ExceededAt(); ok {
+		_spec.SetField(teambudget.FieldExceededAt, field.TypeTime, value)
+		_node.ExceededAt = &value
+	}
+	if value, ok := tbc.mutation.OverrideUntil(); ok {
+		_spec.SetField(teambudget.FieldOverrideUntil, field.TypeTime, value)
+		_node.OverrideUntil = &value
+	}
+	if value, ok := tbc.mutation.OverrideReason(); ok {
+		_spec.SetField(teambudget.FieldOverrideReason, field.TypeString, value)
+		_node.OverrideReason = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamBudget.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamBudgetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tbc *TeamBudgetCreate) OnConflict(opts ...sql.ConflictOption) *TeamBudgetUpsertOne {
+	tbc.conflict = opts
+	return &TeamBudgetUpsertOne{
+		create: tbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamBudget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tbc *TeamBudgetCreate) OnConflictColumns(columns ...string) *TeamBudgetUpsertOne {
+	tbc.conflict = append(tbc.conflict, sql.ConflictColumns(columns...))
+	return &TeamBudgetUpsertOne{
+		create: tbc,
+	}
+}
+
+type (
+	// TeamBudgetUpsertOne is the builder for "upsert"-ing
+	//  one TeamBudget node.
+	TeamBudgetUpsertOne struct {
+		create *TeamBudgetCreate
+	}
+
+	// TeamBudgetUpsert is the "OnConflict" setter.
+	TeamBudgetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamBudgetUpsert) SetUpdatedAt(v time.Time) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdateUpdatedAt() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldUpdatedAt)
+	return u
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (u *TeamBudgetUpsert) SetMonthlyLimitUsd(v float64) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldMonthlyLimitUsd, v)
+	return u
+}
+
+// UpdateMonthlyLimitUsd sets the "monthly_limit_usd" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdateMonthlyLimitUsd() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldMonthlyLimitUsd)
+	return u
+}
+
+// AddMonthlyLimitUsd adds v to the "monthly_limit_usd" field.
+func (u *TeamBudgetUpsert) AddMonthlyLimitUsd(v float64) *TeamBudgetUpsert {
+	u.Add(teambudget.FieldMonthlyLimitUsd, v)
+	return u
+}
+
+// SetWarningPercent sets the "warning_percent" field.
+func (u *TeamBudgetUpsert) SetWarningPercent(v int32) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldWarningPercent, v)
+	return u
+}
+
+// UpdateWarningPercent sets the "warning_percent" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdateWarningPercent() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldWarningPercent)
+	return u
+}
+
+// AddWarningPercent adds v to the "warning_percent" field.
+func (u *TeamBudgetUpsert) AddWarningPercent(v int32) *TeamBudgetUpsert {
+	u.Add(teambudget.FieldWarningPercent, v)
+	return u
+}
+
+// SetPauseSandboxes sets the "pause_sandboxes" field.
+func (u *TeamBudgetUpsert) SetPauseSandboxes(v bool) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldPauseSandboxes, v)
+	return u
+}
+
+// UpdatePauseSandboxes sets the "pause_sandboxes" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdatePauseSandboxes() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldPauseSandboxes)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *TeamBudgetUpsert) SetPeriodStart(v time.Time) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdatePeriodStart() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldPeriodStart)
+	return u
+}
+
+// SetAccruedUsd sets the "accrued_usd" field.
+func (u *TeamBudgetUpsert) SetAccruedUsd(v float64) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldAccruedUsd, v)
+	return u
+}
+
+// UpdateAccruedUsd sets the "accrued_usd" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdateAccruedUsd() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldAccruedUsd)
+	return u
+}
+
+// AddAccruedUsd adds v to the "accrued_usd" field.
+func (u *TeamBudgetUpsert) AddAccruedUsd(v float64) *TeamBudgetUpsert {
+	u.Add(teambudget.FieldAccruedUsd, v)
+	return u
+}
+
+// SetMeteredAt sets the "metered_at" field.
+func (u *TeamBudgetUpsert) SetMeteredAt(v time.Time) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldMeteredAt, v)
+	return u
+}
+
+// UpdateMeteredAt sets the "metered_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdateMeteredAt() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldMeteredAt)
+	return u
+}
+
+// SetWarnedAt sets the "warned_at" field.
+func (u *TeamBudgetUpsert) SetWarnedAt(v time.Time) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldWarnedAt, v)
+	return u
+}
+
+// UpdateWarnedAt sets the "warned_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdateWarnedAt() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldWarnedAt)
+	return u
+}
+
+// ClearWarnedAt clears the value of the "warned_at" field.
+func (u *TeamBudgetUpsert) ClearWarnedAt() *TeamBudgetUpsert {
+	u.SetNull(teambudget.FieldWarnedAt)
+	return u
+}
+
+// SetExceededAt sets the "exceeded_at" field.
+func (u *TeamBudgetUpsert) SetExceededAt(v time.Time) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldExceededAt, v)
+	return u
+}
+
+// UpdateExceededAt sets the "exceeded_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdateExceededAt() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldExceededAt)
+	return u
+}
+
+// ClearExceededAt clears the value of the "exceeded_at" field.
+func (u *TeamBudgetUpsert) ClearExceededAt() *TeamBudgetUpsert {
+	u.SetNull(teambudget.FieldExceededAt)
+	return u
+}
+
+// SetOverrideUntil sets the "override_until" field.
+func (u *TeamBudgetUpsert) SetOverrideUntil(v time.Time) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldOverrideUntil, v)
+	return u
+}
+
+// UpdateOverrideUntil sets the "override_until" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdateOverrideUntil() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldOverrideUntil)
+	return u
+}
+
+// ClearOverrideUntil clears the value of the "override_until" field.
+func (u *TeamBudgetUpsert) ClearOverrideUntil() *TeamBudgetUpsert {
+	u.SetNull(teambudget.FieldOverrideUntil)
+	return u
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (u *TeamBudgetUpsert) SetOverrideReason(v string) *TeamBudgetUpsert {
+	u.Set(teambudget.FieldOverrideReason, v)
+	return u
+}
+
+// UpdateOverrideReason sets the "override_reason" field to the value that was provided on create.
+func (u *TeamBudgetUpsert) UpdateOverrideReason() *TeamBudgetUpsert {
+	u.SetExcluded(teambudget.FieldOverrideReason)
+	return u
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (u *TeamBudgetUpsert) ClearOverrideReason() *TeamBudgetUpsert {
+	u.SetNull(teambudget.FieldOverrideReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TeamBudget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teambudget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamBudgetUpsertOne) UpdateNewValues() *TeamBudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(teambudget.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(teambudget.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TeamID(); exists {
+			s.SetIgnore(teambudget.FieldTeamID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamBudget.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamBudgetUpsertOne) Ignore() *TeamBudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamBudgetUpsertOne) DoNothing() *TeamBudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamBudgetCreate.OnConflict
+// documentation for more info.
+func (u *TeamBudgetUpsertOne) Update(set func(*TeamBudgetUpsert)) *TeamBudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamBudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamBudgetUpsertOne) SetUpdatedAt(v time.Time) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdateUpdatedAt() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (u *TeamBudgetUpsertOne) SetMonthlyLimitUsd(v float64) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetMonthlyLimitUsd(v)
+	})
+}
+
+// AddMonthlyLimitUsd adds v to the "monthly_limit_usd" field.
+func (u *TeamBudgetUpsertOne) AddMonthlyLimitUsd(v float64) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.AddMonthlyLimitUsd(v)
+	})
+}
+
+// UpdateMonthlyLimitUsd sets the "monthly_limit_usd" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdateMonthlyLimitUsd() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateMonthlyLimitUsd()
+	})
+}
+
+// SetWarningPercent sets the "warning_percent" field.
+func (u *TeamBudgetUpsertOne) SetWarningPercent(v int32) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetWarningPercent(v)
+	})
+}
+
+// AddWarningPercent adds v to the "warning_percent" field.
+func (u *TeamBudgetUpsertOne) AddWarningPercent(v int32) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.AddWarningPercent(v)
+	})
+}
+
+// UpdateWarningPercent sets the "warning_percent" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdateWarningPercent() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateWarningPercent()
+	})
+}
+
+// SetPauseSandboxes sets the "pause_sandboxes" field.
+func (u *TeamBudgetUpsertOne) SetPauseSandboxes(v bool) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetPauseSandboxes(v)
+	})
+}
+
+// UpdatePauseSandboxes sets the "pause_sandboxes" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdatePauseSandboxes() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdatePauseSandboxes()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *TeamBudgetUpsertOne) SetPeriodStart(v time.Time) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdatePeriodStart() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetAccruedUsd sets the "accrued_usd" field.
+func (u *TeamBudgetUpsertOne) SetAccruedUsd(v float64) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetAccruedUsd(v)
+	})
+}
+
+// AddAccruedUsd adds v to the "accrued_usd" field.
+func (u *TeamBudgetUpsertOne) AddAccruedUsd(v float64) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.AddAccruedUsd(v)
+	})
+}
+
+// UpdateAccruedUsd sets the "accrued_usd" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdateAccruedUsd() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateAccruedUsd()
+	})
+}
+
+// SetMeteredAt sets the "metered_at" field.
+func (u *TeamBudgetUpsertOne) SetMeteredAt(v time.Time) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetMeteredAt(v)
+	})
+}
+
+// UpdateMeteredAt sets the "metered_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdateMeteredAt() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateMeteredAt()
+	})
+}
+
+// SetWarnedAt sets the "warned_at" field.
+func (u *TeamBudgetUpsertOne) SetWarnedAt(v time.Time) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetWarnedAt(v)
+	})
+}
+
+// UpdateWarnedAt sets the "warned_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdateWarnedAt() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateWarnedAt()
+	})
+}
+
+// ClearWarnedAt clears the value of the "warned_at" field.
+func (u *TeamBudgetUpsertOne) ClearWarnedAt() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.ClearWarnedAt()
+	})
+}
+
+// SetExceededAt sets the "exceeded_at" field.
+func (u *TeamBudgetUpsertOne) SetExceededAt(v time.Time) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetExceededAt(v)
+	})
+}
+
+// UpdateExceededAt sets the "exceeded_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdateExceededAt() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateExceededAt()
+	})
+}
+
+// ClearExceededAt clears the value of the "exceeded_at" field.
+func (u *TeamBudgetUpsertOne) ClearExceededAt() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.ClearExceededAt()
+	})
+}
+
+// SetOverrideUntil sets the "override_until" field.
+func (u *TeamBudgetUpsertOne) SetOverrideUntil(v time.Time) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetOverrideUntil(v)
+	})
+}
+
+// UpdateOverrideUntil sets the "override_until" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdateOverrideUntil() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateOverrideUntil()
+	})
+}
+
+// ClearOverrideUntil clears the value of the "override_until" field.
+func (u *TeamBudgetUpsertOne) ClearOverrideUntil() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.ClearOverrideUntil()
+	})
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (u *TeamBudgetUpsertOne) SetOverrideReason(v string) *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetOverrideReason(v)
+	})
+}
+
+// UpdateOverrideReason sets the "override_reason" field to the value that was provided on create.
+func (u *TeamBudgetUpsertOne) UpdateOverrideReason() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateOverrideReason()
+	})
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (u *TeamBudgetUpsertOne) ClearOverrideReason() *TeamBudgetUpsertOne {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.ClearOverrideReason()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamBudgetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamBudgetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamBudgetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamBudgetUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: TeamBudgetUpsertOne.ID is not supported by MySQL driver. Use TeamBudgetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamBudgetUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamBudgetCreateBulk is the builder for creating many TeamBudget entities in bulk.
+type TeamBudgetCreateBulk struct {
+	config
+	err      error
+	builders []*TeamBudgetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamBudget entities in the database.
+func (tbcb *TeamBudgetCreateBulk) Save(ctx context.Context) ([]*TeamBudget, error) {
+	if tbcb.err != nil {
+		return nil, tbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tbcb.builders))
+	nodes := make([]*TeamBudget, len(tbcb.builders))
+	mutators := make([]Mutator, len(tbcb.builders))
+	for i := range tbcb.builders {
+		func(i int, root context.Context) {
+			builder := tbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamBudgetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tbcb *TeamBudgetCreateBulk) SaveX(ctx context.Context) []*TeamBudget {
+	v, err := tbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tbcb *TeamBudgetCreateBulk) Exec(ctx context.Context) error {
+	_, err := tbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbcb *TeamBudgetCreateBulk) ExecX(ctx context.Context) {
+	if err := tbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamBudget.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamBudgetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tbcb *TeamBudgetCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamBudgetUpsertBulk {
+	tbcb.conflict = opts
+	return &TeamBudgetUpsertBulk{
+		create: tbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamBudget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tbcb *TeamBudgetCreateBulk) OnConflictColumns(columns ...string) *TeamBudgetUpsertBulk {
+	tbcb.conflict = append(tbcb.conflict, sql.ConflictColumns(columns...))
+	return &TeamBudgetUpsertBulk{
+		create: tbcb,
+	}
+}
+
+// TeamBudgetUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamBudget nodes.
+type TeamBudgetUpsertBulk struct {
+	create *TeamBudgetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamBudget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teambudget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamBudgetUpsertBulk) UpdateNewValues() *TeamBudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(teambudget.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(teambudget.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TeamID(); exists {
+				s.SetIgnore(teambudget.FieldTeamID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamBudget.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamBudgetUpsertBulk) Ignore() *TeamBudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamBudgetUpsertBulk) DoNothing() *TeamBudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamBudgetCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamBudgetUpsertBulk) Update(set func(*TeamBudgetUpsert)) *TeamBudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamBudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamBudgetUpsertBulk) SetUpdatedAt(v time.Time) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdateUpdatedAt() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (u *TeamBudgetUpsertBulk) SetMonthlyLimitUsd(v float64) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetMonthlyLimitUsd(v)
+	})
+}
+
+// AddMonthlyLimitUsd adds v to the "monthly_limit_usd" field.
+func (u *TeamBudgetUpsertBulk) AddMonthlyLimitUsd(v float64) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.AddMonthlyLimitUsd(v)
+	})
+}
+
+// UpdateMonthlyLimitUsd sets the "monthly_limit_usd" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdateMonthlyLimitUsd() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateMonthlyLimitUsd()
+	})
+}
+
+// SetWarningPercent sets the "warning_percent" field.
+func (u *TeamBudgetUpsertBulk) SetWarningPercent(v int32) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetWarningPercent(v)
+	})
+}
+
+// AddWarningPercent adds v to the "warning_percent" field.
+func (u *TeamBudgetUpsertBulk) AddWarningPercent(v int32) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.AddWarningPercent(v)
+	})
+}
+
+// UpdateWarningPercent sets the "warning_percent" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdateWarningPercent() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateWarningPercent()
+	})
+}
+
+// SetPauseSandboxes sets the "pause_sandboxes" field.
+func (u *TeamBudgetUpsertBulk) SetPauseSandboxes(v bool) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetPauseSandboxes(v)
+	})
+}
+
+// UpdatePauseSandboxes sets the "pause_sandboxes" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdatePauseSandboxes() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdatePauseSandboxes()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *TeamBudgetUpsertBulk) SetPeriodStart(v time.Time) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdatePeriodStart() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetAccruedUsd sets the "accrued_usd" field.
+func (u *TeamBudgetUpsertBulk) SetAccruedUsd(v float64) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetAccruedUsd(v)
+	})
+}
+
+// AddAccruedUsd adds v to the "accrued_usd" field.
+func (u *TeamBudgetUpsertBulk) AddAccruedUsd(v float64) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.AddAccruedUsd(v)
+	})
+}
+
+// UpdateAccruedUsd sets the "accrued_usd" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdateAccruedUsd() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateAccruedUsd()
+	})
+}
+
+// SetMeteredAt sets the "metered_at" field.
+func (u *TeamBudgetUpsertBulk) SetMeteredAt(v time.Time) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetMeteredAt(v)
+	})
+}
+
+// UpdateMeteredAt sets the "metered_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdateMeteredAt() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateMeteredAt()
+	})
+}
+
+// SetWarnedAt sets the "warned_at" field.
+func (u *TeamBudgetUpsertBulk) SetWarnedAt(v time.Time) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetWarnedAt(v)
+	})
+}
+
+// UpdateWarnedAt sets the "warned_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdateWarnedAt() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateWarnedAt()
+	})
+}
+
+// ClearWarnedAt clears the value of the "warned_at" field.
+func (u *TeamBudgetUpsertBulk) ClearWarnedAt() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.ClearWarnedAt()
+	})
+}
+
+// SetExceededAt sets the "exceeded_at" field.
+func (u *TeamBudgetUpsertBulk) SetExceededAt(v time.Time) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetExceededAt(v)
+	})
+}
+
+// UpdateExceededAt sets the "exceeded_at" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdateExceededAt() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateExceededAt()
+	})
+}
+
+// ClearExceededAt clears the value of the "exceeded_at" field.
+func (u *TeamBudgetUpsertBulk) ClearExceededAt() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.ClearExceededAt()
+	})
+}
+
+// SetOverrideUntil sets the "override_until" field.
+func (u *TeamBudgetUpsertBulk) SetOverrideUntil(v time.Time) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetOverrideUntil(v)
+	})
+}
+
+// UpdateOverrideUntil sets the "override_until" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdateOverrideUntil() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateOverrideUntil()
+	})
+}
+
+// ClearOverrideUntil clears the value of the "override_until" field.
+func (u *TeamBudgetUpsertBulk) ClearOverrideUntil() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.ClearOverrideUntil()
+	})
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (u *TeamBudgetUpsertBulk) SetOverrideReason(v string) *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.SetOverrideReason(v)
+	})
+}
+
+// UpdateOverrideReason sets the "override_reason" field to the value that was provided on create.
+func (u *TeamBudgetUpsertBulk) UpdateOverrideReason() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.UpdateOverrideReason()
+	})
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (u *TeamBudgetUpsertBulk) ClearOverrideReason() *TeamBudgetUpsertBulk {
+	return u.Update(func(s *TeamBudgetUpsert) {
+		s.ClearOverrideReason()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamBudgetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the TeamBudgetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamBudgetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamBudgetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+)
+
+// TeamBudgetDelete is the builder for deleting a TeamBudget entity.
+type TeamBudgetDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamBudgetMutation
+}
+
+// Where appends a list predicates to the TeamBudgetDelete builder.
+func (tbd *TeamBudgetDelete) Where(ps ...predicate.TeamBudget) *TeamBudgetDelete {
+	tbd.mutation.Where(ps...)
+	return tbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tbd *TeamBudgetDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tbd.sqlExec, tbd.mutation, tbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbd *TeamBudgetDelete) ExecX(ctx context.Context) int {
+	n, err := tbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tbd *TeamBudgetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teambudget.Table, sqlgraph.NewFieldSpec(teambudget.FieldID, field.TypeUUID))
+	_spec.Node.Schema = tbd.schemaConfig.TeamBudget
+	ctx = internal.NewSchemaConfigContext(ctx, tbd.schemaConfig)
+	if ps := tbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tbd.mutation.done = true
+	return affected, err
+}
+
+// TeamBudgetDeleteOne is the builder for deleting a single TeamBudget entity.
+type TeamBudgetDeleteOne struct {
+	tbd *TeamBudgetDelete
+}
+
+// Where appends a list predicates to the TeamBudgetDelete builder.
+func (tbdo *TeamBudgetDeleteOne) Where(ps ...predicate.TeamBudget) *TeamBudgetDeleteOne {
+	tbdo.tbd.mutation.Where(ps...)
+	return tbdo
+}
+
+// Exec executes the deletion query.
+func (tbdo *TeamBudgetDeleteOne) Exec(ctx context.Context) error {
+	n, err := tbdo.tbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teambudget.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbdo *TeamBudgetDeleteOne) ExecX(ctx context.Context) {
+	if err := tbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
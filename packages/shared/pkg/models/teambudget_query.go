@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+	"github.com/google/uuid"
+)
+
+// TeamBudgetQuery is the builder for querying TeamBudget entities.
+type TeamBudgetQuery struct {
+	config
+	ctx        *QueryContext
+	order      []teambudget.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TeamBudget
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TeamBudgetQuery builder.
+func (tbq *TeamBudgetQuery) Where(ps ...predicate.TeamBudget) *TeamBudgetQuery {
+	tbq.predicates = append(tbq.predicates, ps...)
+	return tbq
+}
+
+// Limit the number of records to be returned by this query.
+func (tbq *TeamBudgetQuery) Limit(limit int) *TeamBudgetQuery {
+	tbq.ctx.Limit = &limit
+	return tbq
+}
+
+// Offset to start from.
+func (tbq *TeamBudgetQuery) Offset(offset int) *TeamBudgetQuery {
+	tbq.ctx.Offset = &offset
+	return tbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tbq *TeamBudgetQuery) Unique(unique bool) *TeamBudgetQuery {
+	tbq.ctx.Unique = &unique
+	return tbq
+}
+
+// Order specifies how the records should be ordered.
+func (tbq *TeamBudgetQuery) Order(o ...teambudget.OrderOption) *TeamBudgetQuery {
+	tbq.order = append(tbq.order, o...)
+	return tbq
+}
+
+// First returns the first TeamBudget entity from the query.
+// Returns a *NotFoundError when no TeamBudget was found.
+func (tbq *TeamBudgetQuery) First(ctx context.Context) (*TeamBudget, error) {
+	nodes, err := tbq.Limit(1).All(setContextOp(ctx, tbq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{teambudget.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tbq *TeamBudgetQuery) FirstX(ctx context.Context) *TeamBudget {
+	node, err := tbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TeamBudget ID from the query.
+// Returns a *NotFoundError when no TeamBudget ID was found.
+func (tbq *TeamBudgetQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tbq.Limit(1).IDs(setContextOp(ctx, tbq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{teambudget.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tbq *TeamBudgetQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TeamBudget entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TeamBudget entity is found.
+// Returns a *NotFoundError when no TeamBudget entities are found.
+func (tbq *TeamBudgetQuery) Only(ctx context.Context) (*TeamBudget, error) {
+	nodes, err := tbq.Limit(2).All(setContextOp(ctx, tbq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{teambudget.Label}
+	default:
+		return nil, &NotSingularError{teambudget.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tbq *TeamBudgetQuery) OnlyX(ctx context.Context) *TeamBudget {
+	node, err := tbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TeamBudget ID in the query.
+// Returns a *NotSingularError when more than one TeamBudget ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tbq *TeamBudgetQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tbq.Limit(2).IDs(setContextOp(ctx, tbq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{teambudget.Label}
+	default:
+		err = &NotSingularError{teambudget.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tbq *TeamBudgetQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TeamBudgets.
+func (tbq *TeamBudgetQuery) All(ctx context.Context) ([]*TeamBudget, error) {
+	ctx = setContextOp(ctx, tbq.ctx, "All")
+	if err := tbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TeamBudget, *TeamBudgetQuery]()
+	return withInterceptors[[]*TeamBudget](ctx, tbq, qr, tbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tbq *TeamBudgetQuery) AllX(ctx context.Context) []*TeamBudget {
+	nodes, err := tbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TeamBudget IDs.
+func (tbq *TeamBudgetQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tbq.ctx.Unique == nil && tbq.path != nil {
+		tbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tbq.ctx, "IDs")
+	if err = tbq.Select(teambudget.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tbq *TeamBudgetQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tbq *TeamBudgetQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tbq.ctx, "Count")
+	if err := tbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tbq, querierCount[*TeamBudgetQuery](), tbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tbq *TeamBudgetQuery) CountX(ctx context.Context) int {
+	count, err := tbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tbq *TeamBudgetQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tbq.ctx, "Exist")
+	switch _, err := tbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tbq *TeamBudgetQuery) ExistX(ctx context.Context) bool {
+	exist, err := tbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TeamBudgetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tbq *TeamBudgetQuery) Clone() *TeamBudgetQuery {
+	if tbq == nil {
+		return nil
+	}
+	return &TeamBudgetQuery{
+		config:     tbq.config,
+		ctx:        tbq.ctx.Clone(),
+		order:      append([]teambudget.OrderOption{}, tbq.order...),
+		inters:     append([]Interceptor{}, tbq.inters...),
+		predicates: append([]predicate.TeamBudget{}, tbq.predicates...),
+		// clone intermediate query.
+		sql:  tbq.sql.Clone(),
+		path: tbq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TeamBudget.Query().
+//		GroupBy(teambudget.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (tbq *TeamBudgetQuery) GroupBy(field string, fields ...string) *TeamBudgetGroupBy {
+	tbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TeamBudgetGroupBy{build: tbq}
+	grbuild.flds = &tbq.ctx.Fields
+	grbuild.label = teambudget.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TeamBudget.Query().
+//		Select(teambudget.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tbq *TeamBudgetQuery) Select(fields ...string) *TeamBudgetSelect {
+	tbq.ctx.Fields = append(tbq.ctx.Fields, fields...)
+	sbuild := &TeamBudgetSelect{TeamBudgetQuery: tbq}
+	sbuild.label = teambudget.Label
+	sbuild.flds, sbuild.scan = &tbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TeamBudgetSelect configured with the given aggregations.
+func (tbq *TeamBudgetQuery) Aggregate(fns ...AggregateFunc) *TeamBudgetSelect {
+	return tbq.Select().Aggregate(fns...)
+}
+
+func (tbq *TeamBudgetQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tbq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tbq.ctx.Fields {
+		if !teambudget.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if tbq.path != nil {
+		prev, err := tbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tbq.sql = prev
+	}
+	return nil
+}
+
+func (tbq *TeamBudgetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TeamBudget, error) {
+	var (
+		nodes = []*TeamBudget{}
+		_spec = tbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TeamBudget).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TeamBudget{config: tbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = tbq.schemaConfig.TeamBudget
+	ctx = internal.NewSchemaConfigContext(ctx, tbq.schemaConfig)
+	if len(tbq.modifiers) > 0 {
+		_spec.Modifiers = tbq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tbq *TeamBudgetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tbq.querySpec()
+	_spec.Node.Schema = tbq.schemaConfig.TeamBudget
+	ctx = internal.NewSchemaConfigContext(ctx, tbq.schemaConfig)
+	if len(tbq.modifiers) > 0 {
+		_spec.Modifiers = tbq.modifiers
+	}
+	_spec.Node.Columns = tbq.ctx.Fields
+	if len(tbq.ctx.Fields) > 0 {
+		_spec.Unique = tbq.ctx.Unique != nil && *tbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tbq.driver, _spec)
+}
+
+func (tbq *TeamBudgetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(teambudget.Table, teambudget.Columns, sqlgraph.NewFieldSpec(teambudget.FieldID, field.TypeUUID))
+	_spec.From = tbq.sql
+	if unique := tbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teambudget.FieldID)
+		for i := range fields {
+			if fields[i] != teambudget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tbq *TeamBudgetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tbq.driver.Dialect())
+	t1 := builder.Table(teambudget.Table)
+	columns := tbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = teambudget.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tbq.sql != nil {
+		selector = tbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tbq.ctx.Unique != nil && *tbq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(tbq.schemaConfig.TeamBudget)
+	ctx = internal.NewSchemaConfigContext(ctx, tbq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range tbq.modifiers {
+		m(selector)
+	}
+	for _, p := range tbq.predicates {
+		p(selector)
+	}
+	for _, p := range tbq.order {
+		p(selector)
+	}
+	if offset := tbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tbq *TeamBudgetQuery) Modify(modifiers ...func(s *sql.Selector)) *TeamBudgetSelect {
+	tbq.modifiers = append(tbq.modifiers, modifiers...)
+	return tbq.Select()
+}
+
+// TeamBudgetGroupBy is the group-by builder for TeamBudget entities.
+type TeamBudgetGroupBy struct {
+	selector
+	build *TeamBudgetQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tbgb *TeamBudgetGroupBy) Aggregate(fns ...AggregateFunc) *TeamBudgetGroupBy {
+	tbgb.fns = append(tbgb.fns, fns...)
+	return tbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tbgb *TeamBudgetGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tbgb.build.ctx, "GroupBy")
+	if err := tbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamBudgetQuery, *TeamBudgetGroupBy](ctx, tbgb.build, tbgb, tbgb.build.inters, v)
+}
+
+func (tbgb *TeamBudgetGroupBy) sqlScan(ctx context.Context, root *TeamBudgetQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tbgb.fns))
+	for _, fn := range tbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tbgb.flds)+len(tbgb.fns))
+		for _, f := range *tbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TeamBudgetSelect is the builder for selecting fields of TeamBudget entities.
+type TeamBudgetSelect struct {
+	*TeamBudgetQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tbs *TeamBudgetSelect) Aggregate(fns ...AggregateFunc) *TeamBudgetSelect {
+	tbs.fns = append(tbs.fns, fns...)
+	return tbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tbs *TeamBudgetSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tbs.ctx, "Select")
+	if err := tbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamBudgetQuery, *TeamBudgetSelect](ctx, tbs.TeamBudgetQuery, tbs, tbs.inters, v)
+}
+
+func (tbs *TeamBudgetSelect) sqlScan(ctx context.Context, root *TeamBudgetQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tbs.fns))
+	for _, fn := range tbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tbs *TeamBudgetSelect) Modify(modifiers ...func(s *sql.Selector)) *TeamBudgetSelect {
+	tbs.modifiers = append(tbs.modifiers, modifiers...)
+	return tbs
+}
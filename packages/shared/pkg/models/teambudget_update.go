@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teambudget"
+)
+
+// TeamBudgetUpdate is the builder for updating TeamBudget entities.
+type TeamBudgetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TeamBudgetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TeamBudgetUpdate builder.
+func (tbu *TeamBudgetUpdate) Where(ps ...predicate.TeamBudget) *TeamBudgetUpdate {
+	tbu.mutation.Where(ps...)
+	return tbu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tbu *TeamBudgetUpdate) SetUpdatedAt(t time.Time) *TeamBudgetUpdate {
+	tbu.mutation.SetUpdatedAt(t)
+	return tbu
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (tbu *TeamBudgetUpdate) SetMonthlyLimitUsd(f float64) *TeamBudgetUpdate {
+	tbu.mutation.ResetMonthlyLimitUsd()
+	tbu.mutation.SetMonthlyLimitUsd(f)
+	return tbu
+}
+
+// SetNillableMonthlyLimitUsd sets the "monthly_limit_usd" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillableMonthlyLimitUsd(f *float64) *TeamBudgetUpdate {
+	if f != nil {
+		tbu.SetMonthlyLimitUsd(*f)
+	}
+	return tbu
+}
+
+// AddMonthlyLimitUsd adds f to the "monthly_limit_usd" field.
+func (tbu *TeamBudgetUpdate) AddMonthlyLimitUsd(f float64) *TeamBudgetUpdate {
+	tbu.mutation.AddMonthlyLimitUsd(f)
+	return tbu
+}
+
+// SetWarningPercent sets the "warning_percent" field.
+func (tbu *TeamBudgetUpdate) SetWarningPercent(i int32) *TeamBudgetUpdate {
+	tbu.mutation.ResetWarningPercent()
+	tbu.mutation.SetWarningPercent(i)
+	return tbu
+}
+
+// SetNillableWarningPercent sets the "warning_percent" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillableWarningPercent(i *int32) *TeamBudgetUpdate {
+	if i != nil {
+		tbu.SetWarningPercent(*i)
+	}
+	return tbu
+}
+
+// AddWarningPercent adds i to the "warning_percent" field.
+func (tbu *TeamBudgetUpdate) AddWarningPercent(i int32) *TeamBudgetUpdate {
+	tbu.mutation.AddWarningPercent(i)
+	return tbu
+}
+
+// SetPauseSandboxes sets the "pause_sandboxes" field.
+func (tbu *TeamBudgetUpdate) SetPauseSandboxes(b bool) *TeamBudgetUpdate {
+	tbu.mutation.SetPauseSandboxes(b)
+	return tbu
+}
+
+// SetNillablePauseSandboxes sets the "pause_sandboxes" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillablePauseSandboxes(b *bool) *TeamBudgetUpdate {
+	if b != nil {
+		tbu.SetPauseSandboxes(*b)
+	}
+	return tbu
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (tbu *TeamBudgetUpdate) SetPeriodStart(t time.Time) *TeamBudgetUpdate {
+	tbu.mutation.SetPeriodStart(t)
+	return tbu
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillablePeriodStart(t *time.Time) *TeamBudgetUpdate {
+	if t != nil {
+		tbu.SetPeriodStart(*t)
+	}
+	return tbu
+}
+
+// SetAccruedUsd sets the "accrued_usd" field.
+func (tbu *TeamBudgetUpdate) SetAccruedUsd(f float64) *TeamBudgetUpdate {
+	tbu.mutation.ResetAccruedUsd()
+	tbu.mutation.SetAccruedUsd(f)
+	return tbu
+}
+
+// SetNillableAccruedUsd sets the "accrued_usd" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillableAccruedUsd(f *float64) *TeamBudgetUpdate {
+	if f != nil {
+		tbu.SetAccruedUsd(*f)
+	}
+	return tbu
+}
+
+// AddAccruedUsd adds f to the "accrued_usd" field.
+func (tbu *TeamBudgetUpdate) AddAccruedUsd(f float64) *TeamBudgetUpdate {
+	tbu.mutation.AddAccruedUsd(f)
+	return tbu
+}
+
+// SetMeteredAt sets the "metered_at" field.
+func (tbu *TeamBudgetUpdate) SetMeteredAt(t time.Time) *TeamBudgetUpdate {
+	tbu.mutation.SetMeteredAt(t)
+	return tbu
+}
+
+// SetNillableMeteredAt sets the "metered_at" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillableMeteredAt(t *time.Time) *TeamBudgetUpdate {
+	if t != nil {
+		tbu.SetMeteredAt(*t)
+	}
+	return tbu
+}
+
+// SetWarnedAt sets the "warned_at" field.
+func (tbu *TeamBudgetUpdate) SetWarnedAt(t time.Time) *TeamBudgetUpdate {
+	tbu.mutation.SetWarnedAt(t)
+	return tbu
+}
+
+// SetNillableWarnedAt sets the "warned_at" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillableWarnedAt(t *time.Time) *TeamBudgetUpdate {
+	if t != nil {
+		tbu.SetWarnedAt(*t)
+	}
+	return tbu
+}
+
+// ClearWarnedAt clears the value of the "warned_at" field.
+func (tbu *TeamBudgetUpdate) ClearWarnedAt() *TeamBudgetUpdate {
+	tbu.mutation.ClearWarnedAt()
+	return tbu
+}
+
+// SetExceededAt sets the "exceeded_at" field.
+func (tbu *TeamBudgetUpdate) SetExceededAt(t time.Time) *TeamBudgetUpdate {
+	tbu.mutation.SetExceededAt(t)
+	return tbu
+}
+
+// SetNillableExceededAt sets the "exceeded_at" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillableExceededAt(t *time.Time) *TeamBudgetUpdate {
+	if t != nil {
+		tbu.SetExceededAt(*t)
+	}
+	return tbu
+}
+
+// ClearExceededAt clears the value of the "exceeded_at" field.
+func (tbu *TeamBudgetUpdate) ClearExceededAt() *TeamBudgetUpdate {
+	tbu.mutation.ClearExceededAt()
+	return tbu
+}
+
+// SetOverrideUntil sets the "override_until" field.
+func (tbu *TeamBudgetUpdate) SetOverrideUntil(t time.Time) *TeamBudgetUpdate {
+	tbu.mutation.SetOverrideUntil(t)
+	return tbu
+}
+
+// SetNillableOverrideUntil sets the "override_until" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillableOverrideUntil(t *time.Time) *TeamBudgetUpdate {
+	if t != nil {
+		tbu.SetOverrideUntil(*t)
+	}
+	return tbu
+}
+
+// ClearOverrideUntil clears the value of the "override_until" field.
+func (tbu *TeamBudgetUpdate) ClearOverrideUntil() *TeamBudgetUpdate {
+	tbu.mutation.ClearOverrideUntil()
+	return tbu
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (tbu *TeamBudgetUpdate) SetOverrideReason(s string) *TeamBudgetUpdate {
+	tbu.mutation.SetOverrideReason(s)
+	return tbu
+}
+
+// SetNillableOverrideReason sets the "override_reason" field if the given value is not nil.
+func (tbu *TeamBudgetUpdate) SetNillableOverrideReason(s *string) *TeamBudgetUpdate {
+	if s != nil {
+		tbu.SetOverrideReason(*s)
+	}
+	return tbu
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (tbu *TeamBudgetUpdate) ClearOverrideReason() *TeamBudgetUpdate {
+	tbu.mutation.ClearOverrideReason()
+	return tbu
+}
+
+// Mutation returns the TeamBudgetMutation object of the builder.
+func (tbu *TeamBudgetUpdate) Mutation() *TeamBudgetMutation {
+	return tbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tbu *TeamBudgetUpdate) Save(ctx context.Context) (int, error) {
+	tbu.defaults()
+	return withHooks(ctx, tbu.sqlSave, tbu.mutation, tbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tbu *TeamBudgetUpdate) SaveX(ctx context.Context) int {
+	affected, err := tbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tbu *TeamBudgetUpdate) Exec(ctx context.Context) error {
+	_, err := tbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbu *TeamBudgetUpdate) ExecX(ctx context.Context) {
+	if err := tbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tbu *TeamBudgetUpdate) defaults() {
+	if _, ok := tbu.mutation.UpdatedAt(); !ok {
+		v := teambudget.UpdateDefaultUpdatedAt()
+		tbu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tbu *TeamBudgetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamBudgetUpdate {
+	tbu.modifiers = append(tbu.modifiers, modifiers...)
+	return tbu
+}
+
+func (tbu *TeamBudgetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teambudget.Table, teambudget.Columns, sqlgraph.NewFieldSpec(teambudget.FieldID, field.TypeUUID))
+	if ps := tbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tbu.mutation.UpdatedAt(); ok {
+		_spec.SetField(teambudget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tbu.mutation.MonthlyLimitUsd(); ok {
+		_spec.SetField(teambudget.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tbu.mutation.AddedMonthlyLimitUsd(); ok {
+		_spec.AddField(teambudget.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tbu.mutation.WarningPercent(); ok {
+		_spec.SetField(teambudget.FieldWarningPercent, field.TypeInt32, value)
+	}
+	if value, ok := tbu.mutation.AddedWarningPercent(); ok {
+		_spec.AddField(teambudget.FieldWarningPercent, field.TypeInt32, value)
+	}
+	if value, ok := tbu.mutation.PauseSandboxes(); ok {
+		_spec.SetField(teambudget.FieldPauseSandboxes, field.TypeBool, value)
+	}
+	if value, ok := tbu.mutation.PeriodStart(); ok {
+		_spec.SetField(teambudget.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := tbu.mutation.AccruedUsd(); ok {
+		_spec.SetField(teambudget.FieldAccruedUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tbu.mutation.AddedAccruedUsd(); ok {
+		_spec.AddField(teambudget.FieldAccruedUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tbu.mutation.MeteredAt(); ok {
+		_spec.SetField(teambudget.FieldMeteredAt, field.TypeTime, value)
+	}
+	if value, ok := tbu.mutation.WarnedAt(); ok {
+		_spec.SetField(teambudget.FieldWarnedAt, field.TypeTime, value)
+	}
+	if tbu.mutation.WarnedAtCleared() {
+		_spec.ClearField(teambudget.FieldWarnedAt, field.TypeTime)
+	}
+	if value, ok := tbu.mutation.ExceededAt(); ok {
+		_spec.SetField(teambudget.FieldExceededAt, field.TypeTime, value)
+	}
+	if tbu.mutation.ExceededAtCleared() {
+		_spec.ClearField(teambudget.FieldExceededAt, field.TypeTime)
+	}
+	if value, ok := tbu.mutation.OverrideUntil(); ok {
+		_spec.SetField(teambudget.FieldOverrideUntil, field.TypeTime, value)
+	}
+	if tbu.mutation.OverrideUntilCleared() {
+		_spec.ClearField(teambudget.FieldOverrideUntil, field.TypeTime)
+	}
+	if value, ok := tbu.mutation.OverrideReason(); ok {
+		_spec.SetField(teambudget.FieldOverrideReason, field.TypeString, value)
+	}
+	if tbu.mutation.OverrideReasonCleared() {
+		_spec.ClearField(teambudget.FieldOverrideReason, field.TypeString)
+	}
+	_spec.Node.Schema = tbu.schemaConfig.TeamBudget
+	ctx = internal.NewSchemaConfigContext(ctx, tbu.schemaConfig)
+	_spec.AddModifiers(tbu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teambudget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tbu.mutation.done = true
+	return n, nil
+}
+
+// TeamBudgetUpdateOne is the builder for updating a single TeamBudget entity.
+type TeamBudgetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TeamBudgetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tbuo *TeamBudgetUpdateOne) SetUpdatedAt(t time.Time) *TeamBudgetUpdateOne {
+	tbuo.mutation.SetUpdatedAt(t)
+	return tbuo
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (tbuo *TeamBudgetUpdateOne) SetMonthlyLimitUsd(f float64) *TeamBudgetUpdateOne {
+	tbuo.mutation.ResetMonthlyLimitUsd()
+	tbuo.mutation.SetMonthlyLimitUsd(f)
+	return tbuo
+}
+
+// SetNillableMonthlyLimitUsd sets the "monthly_limit_usd" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillableMonthlyLimitUsd(f *float64) *TeamBudgetUpdateOne {
+	if f != nil {
+		tbuo.SetMonthlyLimitUsd(*f)
+	}
+	return tbuo
+}
+
+// AddMonthlyLimitUsd adds f to the "monthly_limit_usd" field.
+func (tbuo *TeamBudgetUpdateOne) AddMonthlyLimitUsd(f float64) *TeamBudgetUpdateOne {
+	tbuo.mutation.AddMonthlyLimitUsd(f)
+	return tbuo
+}
+
+// SetWarningPercent sets the "warning_percent" field.
+func (tbuo *TeamBudgetUpdateOne) SetWarningPercent(i int32) *TeamBudgetUpdateOne {
+	tbuo.mutation.ResetWarningPercent()
+	tbuo.mutation.SetWarningPercent(i)
+	return tbuo
+}
+
+// SetNillableWarningPercent sets the "warning_percent" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillableWarningPercent(i *int32) *TeamBudgetUpdateOne {
+	if i != nil {
+		tbuo.SetWarningPercent(*i)
+	}
+	return tbuo
+}
+
+// AddWarningPercent adds i to the "warning_percent" field.
+func (tbuo *TeamBudgetUpdateOne) AddWarningPercent(i int32) *TeamBudgetUpdateOne {
+	tbuo.mutation.AddWarningPercent(i)
+	return tbuo
+}
+
+// SetPauseSandboxes sets the "pause_sandboxes" field.
+func (tbuo *TeamBudgetUpdateOne) SetPauseSandboxes(b bool) *TeamBudgetUpdateOne {
+	tbuo.mutation.SetPauseSandboxes(b)
+	return tbuo
+}
+
+// SetNillablePauseSandboxes sets the "pause_sandboxes" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillablePauseSandboxes(b *bool) *TeamBudgetUpdateOne {
+	if b != nil {
+		tbuo.SetPauseSandboxes(*b)
+	}
+	return tbuo
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (tbuo *TeamBudgetUpdateOne) SetPeriodStart(t time.Time) *TeamBudgetUpdateOne {
+	tbuo.mutation.SetPeriodStart(t)
+	return tbuo
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillablePeriodStart(t *time.Time) *TeamBudgetUpdateOne {
+	if t != nil {
+		tbuo.SetPeriodStart(*t)
+	}
+	return tbuo
+}
+
+// SetAccruedUsd sets the "accrued_usd" field.
+func (tbuo *TeamBudgetUpdateOne) SetAccruedUsd(f float64) *TeamBudgetUpdateOne {
+	tbuo.mutation.ResetAccruedUsd()
+	tbuo.mutation.SetAccruedUsd(f)
+	return tbuo
+}
+
+// SetNillableAccruedUsd sets the "accrued_usd" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillableAccruedUsd(f *float64) *TeamBudgetUpdateOne {
+	if f != nil {
+		tbuo.SetAccruedUsd(*f)
+	}
+	return tbuo
+}
+
+// AddAccruedUsd adds f to the "accrued_usd" field.
+func (tbuo *TeamBudgetUpdateOne) AddAccruedUsd(f float64) *TeamBudgetUpdateOne {
+	tbuo.mutation.AddAccruedUsd(f)
+	return tbuo
+}
+
+// SetMeteredAt sets the "metered_at" field.
+func (tbuo *TeamBudgetUpdateOne) SetMeteredAt(t time.Time) *TeamBudgetUpdateOne {
+	tbuo.mutation.SetMeteredAt(t)
+	return tbuo
+}
+
+// SetNillableMeteredAt sets the "metered_at" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillableMeteredAt(t *time.Time) *TeamBudgetUpdateOne {
+	if t != nil {
+		tbuo.SetMeteredAt(*t)
+	}
+	return tbuo
+}
+
+// SetWarnedAt sets the "warned_at" field.
+func (tbuo *TeamBudgetUpdateOne) SetWarnedAt(t time.Time) *TeamBudgetUpdateOne {
+	tbuo.mutation.SetWarnedAt(t)
+	return tbuo
+}
+
+// SetNillableWarnedAt sets the "warned_at" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillableWarnedAt(t *time.Time) *TeamBudgetUpdateOne {
+	if t != nil {
+		tbuo.SetWarnedAt(*t)
+	}
+	return tbuo
+}
+
+// ClearWarnedAt clears the value of the "warned_at" field.
+func (tbuo *TeamBudgetUpdateOne) ClearWarnedAt() *TeamBudgetUpdateOne {
+	tbuo.mutation.ClearWarnedAt()
+	return tbuo
+}
+
+// SetExceededAt sets the "exceeded_at" field.
+func (tbuo *TeamBudgetUpdateOne) SetExceededAt(t time.Time) *TeamBudgetUpdateOne {
+	tbuo.mutation.SetExceededAt(t)
+	return tbuo
+}
+
+// SetNillableExceededAt sets the "exceeded_at" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillableExceededAt(t *time.Time) *TeamBudgetUpdateOne {
+	if t != nil {
+		tbuo.SetExceededAt(*t)
+	}
+	return tbuo
+}
+
+// ClearExceededAt clears the value of the "exceeded_at" field.
+func (tbuo *TeamBudgetUpdateOne) ClearExceededAt() *TeamBudgetUpdateOne {
+	tbuo.mutation.ClearExceededAt()
+	return tbuo
+}
+
+// SetOverrideUntil sets the "override_until" field.
+func (tbuo *TeamBudgetUpdateOne) SetOverrideUntil(t time.Time) *TeamBudgetUpdateOne {
+	tbuo.mutation.SetOverrideUntil(t)
+	return tbuo
+}
+
+// SetNillableOverrideUntil sets the "override_until" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillableOverrideUntil(t *time.Time) *TeamBudgetUpdateOne {
+	if t != nil {
+		tbuo.SetOverrideUntil(*t)
+	}
+	return tbuo
+}
+
+// ClearOverrideUntil clears the value of the "override_until" field.
+func (tbuo *TeamBudgetUpdateOne) ClearOverrideUntil() *TeamBudgetUpdateOne {
+	tbuo.mutation.ClearOverrideUntil()
+	return tbuo
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (tbuo *TeamBudgetUpdateOne) SetOverrideReason(s string) *TeamBudgetUpdateOne {
+	tbuo.mutation.SetOverrideReason(s)
+	return tbuo
+}
+
+// SetNillableOverrideReason sets the "override_reason" field if the given value is not nil.
+func (tbuo *TeamBudgetUpdateOne) SetNillableOverrideReason(s *string) *TeamBudgetUpdateOne {
+	if s != nil {
+		tbuo.SetOverrideReason(*s)
+	}
+	return tbuo
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (tbuo *TeamBudgetUpdateOne) ClearOverrideReason() *TeamBudgetUpdateOne {
+	tbuo.mutation.ClearOverrideReason()
+	return tbuo
+}
+
+// Mutation returns the TeamBudgetMutation object of the builder.
+func (tbuo *TeamBudgetUpdateOne) Mutation() *TeamBudgetMutation {
+	return tbuo.mutation
+}
+
+// Where appends a list predicates to the TeamBudgetUpdate builder.
+func (tbuo *TeamBudgetUpdateOne) Where(ps ...predicate.TeamBudget) *TeamBudgetUpdateOne {
+	tbuo.mutation.Where(ps...)
+	return tbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tbuo *TeamBudgetUpdateOne) Select(field string, fields ...string) *TeamBudgetUpdateOne {
+	tbuo.fields = append([]string{field}, fields...)
+	return tbuo
+}
+
+// Save executes the query and returns the updated TeamBudget entity.
+func (tbuo *TeamBudgetUpdateOne) Save(ctx context.Context) (*TeamBudget, error) {
+	tbuo.defaults()
+	return withHooks(ctx, tbuo.sqlSave, tbuo.mutation, tbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tbuo *TeamBudgetUpdateOne) SaveX(ctx context.Context) *TeamBudget {
+	node, err := tbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tbuo *TeamBudgetUpdateOne) Exec(ctx context.Context) error {
+	_, err := tbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbuo *TeamBudgetUpdateOne) ExecX(ctx context.Context) {
+	if err := tbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tbuo *TeamBudgetUpdateOne) defaults() {
+	if _, ok := tbuo.mutation.UpdatedAt(); !ok {
+		v := teambudget.UpdateDefaultUpdatedAt()
+		tbuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tbuo *TeamBudgetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamBudgetUpdateOne {
+	tbuo.modifiers = append(tbuo.modifiers, modifiers...)
+	return tbuo
+}
+
+func (tbuo *TeamBudgetUpdateOne) sqlSave(ctx context.Context) (_node *TeamBudget, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teambudget.Table, teambudget.Columns, sqlgraph.NewFieldSpec(teambudget.FieldID, field.TypeUUID))
+	id, ok := tbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "TeamBudget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teambudget.FieldID)
+		for _, f := range fields {
+			if !teambudget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != teambudget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tbuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(teambudget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tbuo.mutation.MonthlyLimitUsd(); ok {
+		_spec.SetField(teambudget.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tbuo.mutation.AddedMonthlyLimitUsd(); ok {
+		_spec.AddField(teambudget.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tbuo.mutation.WarningPercent(); ok {
+		_spec.SetField(teambudget.FieldWarningPercent, field.TypeInt32, value)
+	}
+	if value, ok := tbuo.mutation.AddedWarningPercent(); ok {
+		_spec.AddField(teambudget.FieldWarningPercent, field.TypeInt32, value)
+	}
+	if value, ok := tbuo.mutation.PauseSandboxes(); ok {
+		_spec.SetField(teambudget.FieldPauseSandboxes, field.TypeBool, value)
+	}
+	if value, ok := tbuo.mutation.PeriodStart(); ok {
+		_spec.SetField(teambudget.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := tbuo.mutation.AccruedUsd(); ok {
+		_spec.SetField(teambudget.FieldAccruedUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tbuo.mutation.AddedAccruedUsd(); ok {
+		_spec.AddField(teambudget.FieldAccruedUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tbuo.mutation.MeteredAt(); ok {
+		_spec.SetField(teambudget.FieldMeteredAt, field.TypeTime, value)
+	}
+	if value, ok := tbuo.mutation.WarnedAt(); ok {
+		_spec.SetField(teambudget.FieldWarnedAt, field.TypeTime, value)
+	}
+	if tbuo.mutation.WarnedAtCleared() {
+		_spec.ClearField(teambudget.FieldWarnedAt, field.TypeTime)
+	}
+	if value, ok := tbuo.mutation.ExceededAt(); ok {
+		_spec.SetField(teambudget.FieldExceededAt, field.TypeTime, value)
+	}
+	if tbuo.mutation.ExceededAtCleared() {
+		_spec.ClearField(teambudget.FieldExceededAt, field.TypeTime)
+	}
+	if value, ok := tbuo.mutation.OverrideUntil(); ok {
+		_spec.SetField(teambudget.FieldOverrideUntil, field.TypeTime, value)
+	}
+	if tbuo.mutation.OverrideUntilCleared() {
+		_spec.ClearField(teambudget.FieldOverrideUntil, field.TypeTime)
+	}
+	if value, ok := tbuo.mutation.OverrideReason(); ok {
+		_spec.SetField(teambudget.FieldOverrideReason, field.TypeString, value)
+	}
+	if tbuo.mutation.OverrideReasonCleared() {
+		_spec.ClearField(teambudget.FieldOverrideReason, field.TypeString)
+	}
+	_spec.Node.Schema = tbuo.schemaConfig.TeamBudget
+	ctx = internal.NewSchemaConfigContext(ctx, tbuo.schemaConfig)
+	_spec.AddModifiers(tbuo.modifiers...)
+	_node = &TeamBudget{config: tbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teambudget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tbuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+	"github.com/google/uuid"
+)
+
+// TeamRegistryCredential is the model entity for the TeamRegistryCredential schema.
+type TeamRegistryCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// Registry holds the value of the "registry" field.
+	Registry string `json:"registry,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int32 `json:"version,omitempty"`
+	// KeyID holds the value of the "key_id" field.
+	KeyID string `json:"key_id,omitempty"`
+	// EncryptedKey holds the value of the "encrypted_key" field.
+	EncryptedKey []byte `json:"-"`
+	// Ciphertext holds the value of the "ciphertext" field.
+	Ciphertext   []byte `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamRegistryCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teamregistrycredential.FieldEncryptedKey, teamregistrycredential.FieldCiphertext:
+			values[i] = new([]byte)
+		case teamregistrycredential.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case teamregistrycredential.FieldRegistry, teamregistrycredential.FieldUsername, teamregistrycredential.FieldKeyID:
+			values[i] = new(sql.NullString)
+		case teamregistrycredential.FieldCreatedAt, teamregistrycredential.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case teamregistrycredential.FieldID, teamregistrycredential.FieldTeamID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamRegistryCredential fields.
+func (trc *TeamRegistryCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teamregistrycredential.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				trc.ID = *value
+			}
+		case teamregistrycredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				trc.CreatedAt = value.Time
+			}
+		case teamregistrycredential.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				trc.UpdatedAt = value.Time
+			}
+		case teamregistrycredential.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				trc.TeamID = *value
+			}
+		case teamregistrycredential.FieldRegistry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field registry", values[i])
+			} else if value.Valid {
+				trc.Registry = value.String
+			}
+		case teamregistrycredential.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				trc.Username = value.String
+			}
+		case teamregistrycredential.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				trc.Version = int32(value.Int64)
+			}
+		case teamregistrycredential.FieldKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				trc.KeyID = value.String
+			}
+		case teamregistrycredential.FieldEncryptedKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_key", values[i])
+			} else if value != nil {
+				trc.EncryptedKey = *value
+			}
+		case teamregistrycredential.FieldCiphertext:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ciphertext", values[i])
+			} else if value != nil {
+				trc.Ciphertext = *value
+			}
+		default:
+			trc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamRegistryCredential.
+// This includes values selected through modifiers, order, etc.
+func (trc *TeamRegistryCredential) Value(name string) (ent.Value, error) {
+	return trc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TeamRegistryCredential.
+// Note that you need to call TeamRegistryCredential.Unwrap() before calling this method if this TeamRegistryCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (trc *TeamRegistryCredential) Update() *TeamRegistryCredentialUpdateOne {
+	return NewTeamRegistryCredentialClient(trc.config).UpdateOne(trc)
+}
+
+// Unwrap unwraps the TeamRegistryCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (trc *TeamRegistryCredential) Unwrap() *TeamRegistryCredential {
+	_tx, ok := trc.config.driver.(*txDriver)
+	if !ok {
+		panic("models: TeamRegistryCredential is not a transactional entity")
+	}
+	trc.config.driver = _tx.drv
+	return trc
+}
+
+// String implements the fmt.Stringer.
+func (trc *TeamRegistryCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamRegistryCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", trc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(trc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(trc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", trc.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("registry=")
+	builder.WriteString(trc.Registry)
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(trc.Username)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", trc.Version))
+	builder.WriteString(", ")
+	builder.WriteString("key_id=")
+	builder.WriteString(trc.KeyID)
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("ciphertext=<sensitive>")
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamRegistryCredentials is a parsable slice of TeamRegistryCredential.
+type TeamRegistryCredentials []*TeamRegistryCredential
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teamregistrycredential
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldTeamID, v))
+}
+
+// Registry applies equality check predicate on the "registry" field. It's identical to RegistryEQ.
+func Registry(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldRegistry, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldUsername, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int32) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldVersion, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldKeyID, v))
+}
+
+// EncryptedKey applies equality check predicate on the "encrypted_key" field. It's identical to EncryptedKeyEQ.
+func EncryptedKey(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldEncryptedKey, v))
+}
+
+// Ciphertext applies equality check predicate on the "ciphertext" field. It's identical to CiphertextEQ.
+func Ciphertext(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldCiphertext, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v uuid.UUID) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldTeamID, v))
+}
+
+// RegistryEQ applies the EQ predicate on the "registry" field.
+func RegistryEQ(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldRegistry, v))
+}
+
+// RegistryNEQ applies the NEQ predicate on the "registry" field.
+func RegistryNEQ(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldRegistry, v))
+}
+
+// RegistryIn applies the In predicate on the "registry" field.
+func RegistryIn(vs ...string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldRegistry, vs...))
+}
+
+// RegistryNotIn applies the NotIn predicate on the "registry" field.
+func RegistryNotIn(vs ...string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldRegistry, vs...))
+}
+
+// RegistryGT applies the GT predicate on the "registry" field.
+func RegistryGT(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldRegistry, v))
+}
+
+// RegistryGTE applies the GTE predicate on the "registry" field.
+func RegistryGTE(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldRegistry, v))
+}
+
+// RegistryLT applies the LT predicate on the "registry" field.
+func RegistryLT(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldRegistry, v))
+}
+
+// RegistryLTE applies the LTE predicate on the "registry" field.
+func RegistryLTE(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldRegistry, v))
+}
+
+// RegistryContains applies the Contains predicate on the "registry" field.
+func RegistryContains(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldContains(FieldRegistry, v))
+}
+
+// RegistryHasPrefix applies the HasPrefix predicate on the "registry" field.
+func RegistryHasPrefix(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldHasPrefix(FieldRegistry, v))
+}
+
+// RegistryHasSuffix applies the HasSuffix predicate on the "registry" field.
+func RegistryHasSuffix(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldHasSuffix(FieldRegistry, v))
+}
+
+// RegistryEqualFold applies the EqualFold predicate on the "registry" field.
+func RegistryEqualFold(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEqualFold(FieldRegistry, v))
+}
+
+// RegistryContainsFold applies the ContainsFold predicate on the "registry" field.
+func RegistryContainsFold(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldContainsFold(FieldRegistry, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int32) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int32) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int32) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int32) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int32) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int32) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int32) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int32) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldVersion, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldContains(FieldKeyID, v))
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldHasPrefix(FieldKeyID, v))
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldHasSuffix(FieldKeyID, v))
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEqualFold(FieldKeyID, v))
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldContainsFold(FieldKeyID, v))
+}
+
+// EncryptedKeyEQ applies the EQ predicate on the "encrypted_key" field.
+func EncryptedKeyEQ(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyNEQ applies the NEQ predicate on the "encrypted_key" field.
+func EncryptedKeyNEQ(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyIn applies the In predicate on the "encrypted_key" field.
+func EncryptedKeyIn(vs ...[]byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldEncryptedKey, vs...))
+}
+
+// EncryptedKeyNotIn applies the NotIn predicate on the "encrypted_key" field.
+func EncryptedKeyNotIn(vs ...[]byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldEncryptedKey, vs...))
+}
+
+// EncryptedKeyGT applies the GT predicate on the "encrypted_key" field.
+func EncryptedKeyGT(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyGTE applies the GTE predicate on the "encrypted_key" field.
+func EncryptedKeyGTE(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyLT applies the LT predicate on the "encrypted_key" field.
+func EncryptedKeyLT(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyLTE applies the LTE predicate on the "encrypted_key" field.
+func EncryptedKeyLTE(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldEncryptedKey, v))
+}
+
+// CiphertextEQ applies the EQ predicate on the "ciphertext" field.
+func CiphertextEQ(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldEQ(FieldCiphertext, v))
+}
+
+// CiphertextNEQ applies the NEQ predicate on the "ciphertext" field.
+func CiphertextNEQ(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNEQ(FieldCiphertext, v))
+}
+
+// CiphertextIn applies the In predicate on the "ciphertext" field.
+func CiphertextIn(vs ...[]byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldIn(FieldCiphertext, vs...))
+}
+
+// CiphertextNotIn applies the NotIn predicate on the "ciphertext" field.
+func CiphertextNotIn(vs ...[]byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldNotIn(FieldCiphertext, vs...))
+}
+
+// CiphertextGT applies the GT predicate on the "ciphertext" field.
+func CiphertextGT(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGT(FieldCiphertext, v))
+}
+
+// CiphertextGTE applies the GTE predicate on the "ciphertext" field.
+func CiphertextGTE(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldGTE(FieldCiphertext, v))
+}
+
+// CiphertextLT applies the LT predicate on the "ciphertext" field.
+func CiphertextLT(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLT(FieldCiphertext, v))
+}
+
+// CiphertextLTE applies the LTE predicate on the "ciphertext" field.
+func CiphertextLTE(v []byte) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.FieldLTE(FieldCiphertext, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamRegistryCredential) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamRegistryCredential) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamRegistryCredential) predicate.TeamRegistryCredential {
+	return predicate.TeamRegistryCredential(sql.NotPredicates(p))
+}
@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+	"github.com/google/uuid"
+)
+
+// TeamRegistryCredentialCreate is the builder for creating a TeamRegistryCredential entity.
+type TeamRegistryCredentialCreate struct {
+	config
+	mutation *TeamRegistryCredentialMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (trcc *TeamRegistryCredentialCreate) SetCreatedAt(t time.Time) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetCreatedAt(t)
+	return trcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (trcc *TeamRegistryCredentialCreate) SetNillableCreatedAt(t *time.Time) *TeamRegistryCredentialCreate {
+	if t != nil {
+		trcc.SetCreatedAt(*t)
+	}
+	return trcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trcc *TeamRegistryCredentialCreate) SetUpdatedAt(t time.Time) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetUpdatedAt(t)
+	return trcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (trcc *TeamRegistryCredentialCreate) SetNillableUpdatedAt(t *time.Time) *TeamRegistryCredentialCreate {
+	if t != nil {
+		trcc.SetUpdatedAt(*t)
+	}
+	return trcc
+}
+
+// SetTeamID sets the "team_id" field.
+func (trcc *TeamRegistryCredentialCreate) SetTeamID(u uuid.UUID) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetTeamID(u)
+	return trcc
+}
+
+// SetRegistry sets the "registry" field.
+func (trcc *TeamRegistryCredentialCreate) SetRegistry(s string) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetRegistry(s)
+	return trcc
+}
+
+// SetUsername sets the "username" field.
+func (trcc *TeamRegistryCredentialCreate) SetUsername(s string) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetUsername(s)
+	return trcc
+}
+
+// SetVersion sets the "version" field.
+func (trcc *TeamRegistryCredentialCreate) SetVersion(i int32) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetVersion(i)
+	return trcc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (trcc *TeamRegistryCredentialCreate) SetNillableVersion(i *int32) *TeamRegistryCredentialCreate {
+	if i != nil {
+		trcc.SetVersion(*i)
+	}
+	return trcc
+}
+
+// SetKeyID sets the "key_id" field.
+func (trcc *TeamRegistryCredentialCreate) SetKeyID(s string) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetKeyID(s)
+	return trcc
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (trcc *TeamRegistryCredentialCreate) SetEncryptedKey(b []byte) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetEncryptedKey(b)
+	return trcc
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (trcc *TeamRegistryCredentialCreate) SetCiphertext(b []byte) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetCiphertext(b)
+	return trcc
+}
+
+// SetID sets the "id" field.
+func (trcc *TeamRegistryCredentialCreate) SetID(u uuid.UUID) *TeamRegistryCredentialCreate {
+	trcc.mutation.SetID(u)
+	return trcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (trcc *TeamRegistryCredentialCreate) SetNillableID(u *uuid.UUID) *TeamRegistryCredentialCreate {
+	if u != nil {
+		trcc.SetID(*u)
+	}
+	return trcc
+}
+
+// Mutation returns the TeamRegistryCredentialMutation object of the builder.
+func (trcc *TeamRegistryCredentialCreate) Mutation() *TeamRegistryCredentialMutation {
+	return trcc.mutation
+}
+
+// Save creates the TeamRegistryCredential in the database.
+func (trcc *TeamRegistryCredentialCreate) Save(ctx context.Context) (*TeamRegistryCredential, error) {
+	trcc.defaults()
+	return withHooks(ctx, trcc.sqlSave, trcc.mutation, trcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trcc *TeamRegistryCredentialCreate) SaveX(ctx context.Context) *TeamRegistryCredential {
+	v, err := trcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcc *TeamRegistryCredentialCreate) Exec(ctx context.Context) error {
+	_, err := trcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcc *TeamRegistryCredentialCreate) ExecX(ctx context.Context) {
+	if err := trcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trcc *TeamRegistryCredentialCreate) defaults() {
+	if _, ok := trcc.mutation.CreatedAt(); !ok {
+		v := teamregistrycredential.DefaultCreatedAt()
+		trcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := trcc.mutation.UpdatedAt(); !ok {
+		v := teamregistrycredential.DefaultUpdatedAt()
+		trcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := trcc.mutation.Version(); !ok {
+		v := teamregistrycredential.DefaultVersion
+		trcc.mutation.SetVersion(v)
+	}
+	if _, ok := trcc.mutation.ID(); !ok {
+		v := teamregistrycredential.DefaultID()
+		trcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trcc *TeamRegistryCredentialCreate) check() error {
+	if _, ok := trcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "TeamRegistryCredential.created_at"`)}
+	}
+	if _, ok := trcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`models: missing required field "TeamRegistryCredential.updated_at"`)}
+	}
+	if _, ok := trcc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "TeamRegistryCredential.team_id"`)}
+	}
+	if _, ok := trcc.mutation.Registry(); !ok {
+		return &ValidationError{Name: "registry", err: errors.New(`models: missing required field "TeamRegistryCredential.registry"`)}
+	}
+	if _, ok := trcc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`models: missing required field "TeamRegistryCredential.username"`)}
+	}
+	if _, ok := trcc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`models: missing required field "TeamRegistryCredential.version"`)}
+	}
+	if _, ok := trcc.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`models: missing required field "TeamRegistryCredential.key_id"`)}
+	}
+	if _, ok := trcc.mutation.EncryptedKey(); !ok {
+		return &ValidationError{Name: "encrypted_key", err: errors.New(`models: missing required field "TeamRegistryCredential.encrypted_key"`)}
+	}
+	if _, ok := trcc.mutation.Ciphertext(); !ok {
+		return &ValidationError{Name: "ciphertext", err: errors.New(`models: missing required field "TeamRegistryCredential.ciphertext"`)}
+	}
+	return nil
+}
+
+func (trcc *TeamRegistryCredentialCreate) sqlSave(ctx context.Context) (*TeamRegistryCredential, error) {
+	if err := trcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	trcc.mutation.id = &_node.ID
+	trcc.mutation.done = true
+	return _node, nil
+}
+
+func (trcc *TeamRegistryCredentialCreate) createSpec() (*TeamRegistryCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamRegistryCredential{config: trcc.config}
+		_spec = sqlgraph.NewCreateSpec(teamregistrycredential.Table, sqlgraph.NewFieldSpec(teamregistrycredential.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = trcc.schemaConfig.TeamRegistryCredential
+	_spec.OnConflict = trcc.conflict
+	if id, ok := trcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := trcc.mutation.CreatedAt(); ok {
+		_spec.SetField(teamregistrycredential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := trcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamregistrycredential.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := trcc.mutation.TeamID(); ok {
+		_spec.SetField(teamregistrycredential.FieldTeamID, field.TypeUUID, value)
+		_node.TeamID = value
+	}
+	if value, ok := trcc.mutation.Registry(); ok {
+		_spec.SetField(teamregistrycredential.FieldRegistry, field.TypeString, value)
+		_node.Registry = value
+	}
+	if value, ok := trcc.mutation.Username(); ok {
+		_spec.SetField(teamregistrycredential.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := trcc.mutation.Version(); ok {
+		_spec.SetField(teamregistrycredential.FieldVersion, field.TypeInt32, value)
+		_node.Version = value
+	}
+	if value, ok := trcc.mutation.KeyID(); ok {
+		_spec.SetField(teamregistrycredential.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := trcc.mutation.EncryptedKey(); ok {
+		_spec.SetField(teamregistrycredential.FieldEncryptedKey, field.TypeBytes, value)
+		_node.EncryptedKey = value
+	}
+	if value, ok := trcc.mutation.Ciphertext(); ok {
+		_spec.SetField(teamregistrycredential.FieldCiphertext, field.TypeBytes, value)
+		_node.Ciphertext = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamRegistryCredential.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamRegistryCredentialUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (trcc *TeamRegistryCredentialCreate) OnConflict(opts ...sql.ConflictOption) *TeamRegistryCredentialUpsertOne {
+	trcc.conflict = opts
+	return &TeamRegistryCredentialUpsertOne{
+		create: trcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamRegistryCredential.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trcc *TeamRegistryCredentialCreate) OnConflictColumns(columns ...string) *TeamRegistryCredentialUpsertOne {
+	trcc.conflict = append(trcc.conflict, sql.ConflictColumns(columns...))
+	return &TeamRegistryCredentialUpsertOne{
+		create: trcc,
+	}
+}
+
+type (
+	// TeamRegistryCredentialUpsertOne is the builder for "upsert"-ing
+	//  one TeamRegistryCredential node.
+	TeamRegistryCredentialUpsertOne struct {
+		create *TeamRegistryCredentialCreate
+	}
+
+	// TeamRegistryCredentialUpsert is the "OnConflict" setter.
+	TeamRegistryCredentialUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamRegistryCredentialUpsert) SetUpdatedAt(v time.Time) *TeamRegistryCredentialUpsert {
+	u.Set(teamregistrycredential.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsert) UpdateUpdatedAt() *TeamRegistryCredentialUpsert {
+	u.SetExcluded(teamregistrycredential.FieldUpdatedAt)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *TeamRegistryCredentialUpsert) SetUsername(v string) *TeamRegistryCredentialUpsert {
+	u.Set(teamregistrycredential.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsert) UpdateUsername() *TeamRegistryCredentialUpsert {
+	u.SetExcluded(teamregistrycredential.FieldUsername)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *TeamRegistryCredentialUpsert) SetVersion(v int32) *TeamRegistryCredentialUpsert {
+	u.Set(teamregistrycredential.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsert) UpdateVersion() *TeamRegistryCredentialUpsert {
+	u.SetExcluded(teamregistrycredential.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TeamRegistryCredentialUpsert) AddVersion(v int32) *TeamRegistryCredentialUpsert {
+	u.Add(teamregistrycredential.FieldVersion, v)
+	return u
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *TeamRegistryCredentialUpsert) SetKeyID(v string) *TeamRegistryCredentialUpsert {
+	u.Set(teamregistrycredential.FieldKeyID, v)
+	return u
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsert) UpdateKeyID() *TeamRegistryCredentialUpsert {
+	u.SetExcluded(teamregistrycredential.FieldKeyID)
+	return u
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (u *TeamRegistryCredentialUpsert) SetEncryptedKey(v []byte) *TeamRegistryCredentialUpsert {
+	u.Set(teamregistrycredential.FieldEncryptedKey, v)
+	return u
+}
+
+// UpdateEncryptedKey sets the "encrypted_key" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsert) UpdateEncryptedKey() *TeamRegistryCredentialUpsert {
+	u.SetExcluded(teamregistrycredential.FieldEncryptedKey)
+	return u
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *TeamRegistryCredentialUpsert) SetCiphertext(v []byte) *TeamRegistryCredentialUpsert {
+	u.Set(teamregistrycredential.FieldCiphertext, v)
+	return u
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsert) UpdateCiphertext() *TeamRegistryCredentialUpsert {
+	u.SetExcluded(teamregistrycredential.FieldCiphertext)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TeamRegistryCredential.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamregistrycredential.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamRegistryCredentialUpsertOne) UpdateNewValues() *TeamRegistryCredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(teamregistrycredential.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(teamregistrycredential.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TeamID(); exists {
+			s.SetIgnore(teamregistrycredential.FieldTeamID)
+		}
+		if _, exists := u.create.mutation.Registry(); exists {
+			s.SetIgnore(teamregistrycredential.FieldRegistry)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamRegistryCredential.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamRegistryCredentialUpsertOne) Ignore() *TeamRegistryCredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamRegistryCredentialUpsertOne) DoNothing() *TeamRegistryCredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamRegistryCredentialCreate.OnConflict
+// documentation for more info.
+func (u *TeamRegistryCredentialUpsertOne) Update(set func(*TeamRegistryCredentialUpsert)) *TeamRegistryCredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamRegistryCredentialUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamRegistryCredentialUpsertOne) SetUpdatedAt(v time.Time) *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertOne) UpdateUpdatedAt() *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *TeamRegistryCredentialUpsertOne) SetUsername(v string) *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertOne) UpdateUsername() *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *TeamRegistryCredentialUpsertOne) SetVersion(v int32) *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TeamRegistryCredentialUpsertOne) AddVersion(v int32) *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertOne) UpdateVersion() *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *TeamRegistryCredentialUpsertOne) SetKeyID(v string) *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertOne) UpdateKeyID() *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (u *TeamRegistryCredentialUpsertOne) SetEncryptedKey(v []byte) *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetEncryptedKey(v)
+	})
+}
+
+// UpdateEncryptedKey sets the "encrypted_key" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertOne) UpdateEncryptedKey() *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateEncryptedKey()
+	})
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *TeamRegistryCredentialUpsertOne) SetCiphertext(v []byte) *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetCiphertext(v)
+	})
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertOne) UpdateCiphertext() *TeamRegistryCredentialUpsertOne {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateCiphertext()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamRegistryCredentialUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamRegistryCredentialCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamRegistryCredentialUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamRegistryCredentialUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: TeamRegistryCredentialUpsertOne.ID is not supported by MySQL driver. Use TeamRegistryCredentialUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamRegistryCredentialUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamRegistryCredentialCreateBulk is the builder for creating many TeamRegistryCredential entities in bulk.
+type TeamRegistryCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*TeamRegistryCredentialCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamRegistryCredential entities in the database.
+func (trccb *TeamRegistryCredentialCreateBulk) Save(ctx context.Context) ([]*TeamRegistryCredential, error) {
+	if trccb.err != nil {
+		return nil, trccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trccb.builders))
+	nodes := make([]*TeamRegistryCredential, len(trccb.builders))
+	mutators := make([]Mutator, len(trccb.builders))
+	for i := range trccb.builders {
+		func(i int, root context.Context) {
+			builder := trccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamRegistryCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = trccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trccb *TeamRegistryCredentialCreateBulk) SaveX(ctx context.Context) []*TeamRegistryCredential {
+	v, err := trccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trccb *TeamRegistryCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := trccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trccb *TeamRegistryCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := trccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamRegistryCredential.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamRegistryCredentialUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (trccb *TeamRegistryCredentialCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamRegistryCredentialUpsertBulk {
+	trccb.conflict = opts
+	return &TeamRegistryCredentialUpsertBulk{
+		create: trccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamRegistryCredential.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trccb *TeamRegistryCredentialCreateBulk) OnConflictColumns(columns ...string) *TeamRegistryCredentialUpsertBulk {
+	trccb.conflict = append(trccb.conflict, sql.ConflictColumns(columns...))
+	return &TeamRegistryCredentialUpsertBulk{
+		create: trccb,
+	}
+}
+
+// TeamRegistryCredentialUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamRegistryCredential nodes.
+type TeamRegistryCredentialUpsertBulk struct {
+	create *TeamRegistryCredentialCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamRegistryCredential.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamregistrycredential.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamRegistryCredentialUpsertBulk) UpdateNewValues() *TeamRegistryCredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(teamregistrycredential.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(teamregistrycredential.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TeamID(); exists {
+				s.SetIgnore(teamregistrycredential.FieldTeamID)
+			}
+			if _, exists := b.mutation.Registry(); exists {
+				s.SetIgnore(teamregistrycredential.FieldRegistry)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamRegistryCredential.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamRegistryCredentialUpsertBulk) Ignore() *TeamRegistryCredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamRegistryCredentialUpsertBulk) DoNothing() *TeamRegistryCredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamRegistryCredentialCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamRegistryCredentialUpsertBulk) Update(set func(*TeamRegistryCredentialUpsert)) *TeamRegistryCredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamRegistryCredentialUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamRegistryCredentialUpsertBulk) SetUpdatedAt(v time.Time) *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertBulk) UpdateUpdatedAt() *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *TeamRegistryCredentialUpsertBulk) SetUsername(v string) *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertBulk) UpdateUsername() *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *TeamRegistryCredentialUpsertBulk) SetVersion(v int32) *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TeamRegistryCredentialUpsertBulk) AddVersion(v int32) *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertBulk) UpdateVersion() *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *TeamRegistryCredentialUpsertBulk) SetKeyID(v string) *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertBulk) UpdateKeyID() *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (u *TeamRegistryCredentialUpsertBulk) SetEncryptedKey(v []byte) *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetEncryptedKey(v)
+	})
+}
+
+// UpdateEncryptedKey sets the "encrypted_key" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertBulk) UpdateEncryptedKey() *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateEncryptedKey()
+	})
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *TeamRegistryCredentialUpsertBulk) SetCiphertext(v []byte) *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.SetCiphertext(v)
+	})
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *TeamRegistryCredentialUpsertBulk) UpdateCiphertext() *TeamRegistryCredentialUpsertBulk {
+	return u.Update(func(s *TeamRegistryCredentialUpsert) {
+		s.UpdateCiphertext()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamRegistryCredentialUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the TeamRegistryCredentialCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamRegistryCredentialCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamRegistryCredentialUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+)
+
+// TeamRegistryCredentialDelete is the builder for deleting a TeamRegistryCredential entity.
+type TeamRegistryCredentialDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamRegistryCredentialMutation
+}
+
+// Where appends a list predicates to the TeamRegistryCredentialDelete builder.
+func (trcd *TeamRegistryCredentialDelete) Where(ps ...predicate.TeamRegistryCredential) *TeamRegistryCredentialDelete {
+	trcd.mutation.Where(ps...)
+	return trcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (trcd *TeamRegistryCredentialDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, trcd.sqlExec, trcd.mutation, trcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcd *TeamRegistryCredentialDelete) ExecX(ctx context.Context) int {
+	n, err := trcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (trcd *TeamRegistryCredentialDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teamregistrycredential.Table, sqlgraph.NewFieldSpec(teamregistrycredential.FieldID, field.TypeUUID))
+	_spec.Node.Schema = trcd.schemaConfig.TeamRegistryCredential
+	ctx = internal.NewSchemaConfigContext(ctx, trcd.schemaConfig)
+	if ps := trcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, trcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	trcd.mutation.done = true
+	return affected, err
+}
+
+// TeamRegistryCredentialDeleteOne is the builder for deleting a single TeamRegistryCredential entity.
+type TeamRegistryCredentialDeleteOne struct {
+	trcd *TeamRegistryCredentialDelete
+}
+
+// Where appends a list predicates to the TeamRegistryCredentialDelete builder.
+func (trcdo *TeamRegistryCredentialDeleteOne) Where(ps ...predicate.TeamRegistryCredential) *TeamRegistryCredentialDeleteOne {
+	trcdo.trcd.mutation.Where(ps...)
+	return trcdo
+}
+
+// Exec executes the deletion query.
+func (trcdo *TeamRegistryCredentialDeleteOne) Exec(ctx context.Context) error {
+	n, err := trcdo.trcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teamregistrycredential.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcdo *TeamRegistryCredentialDeleteOne) ExecX(ctx context.Context) {
+	if err := trcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
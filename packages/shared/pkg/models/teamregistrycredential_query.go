@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+	"github.com/google/uuid"
+)
+
+// TeamRegistryCredentialQuery is the builder for querying TeamRegistryCredential entities.
+type TeamRegistryCredentialQuery struct {
+	config
+	ctx        *QueryContext
+	order      []teamregistrycredential.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TeamRegistryCredential
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TeamRegistryCredentialQuery builder.
+func (trcq *TeamRegistryCredentialQuery) Where(ps ...predicate.TeamRegistryCredential) *TeamRegistryCredentialQuery {
+	trcq.predicates = append(trcq.predicates, ps...)
+	return trcq
+}
+
+// Limit the number of records to be returned by this query.
+func (trcq *TeamRegistryCredentialQuery) Limit(limit int) *TeamRegistryCredentialQuery {
+	trcq.ctx.Limit = &limit
+	return trcq
+}
+
+// Offset to start from.
+func (trcq *TeamRegistryCredentialQuery) Offset(offset int) *TeamRegistryCredentialQuery {
+	trcq.ctx.Offset = &offset
+	return trcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trcq *TeamRegistryCredentialQuery) Unique(unique bool) *TeamRegistryCredentialQuery {
+	trcq.ctx.Unique = &unique
+	return trcq
+}
+
+// Order specifies how the records should be ordered.
+func (trcq *TeamRegistryCredentialQuery) Order(o ...teamregistrycredential.OrderOption) *TeamRegistryCredentialQuery {
+	trcq.order = append(trcq.order, o...)
+	return trcq
+}
+
+// First returns the first TeamRegistryCredential entity from the query.
+// Returns a *NotFoundError when no TeamRegistryCredential was found.
+func (trcq *TeamRegistryCredentialQuery) First(ctx context.Context) (*TeamRegistryCredential, error) {
+	nodes, err := trcq.Limit(1).All(setContextOp(ctx, trcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{teamregistrycredential.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trcq *TeamRegistryCredentialQuery) FirstX(ctx context.Context) *TeamRegistryCredential {
+	node, err := trcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TeamRegistryCredential ID from the query.
+// Returns a *NotFoundError when no TeamRegistryCredential ID was found.
+func (trcq *TeamRegistryCredentialQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = trcq.Limit(1).IDs(setContextOp(ctx, trcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{teamregistrycredential.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trcq *TeamRegistryCredentialQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := trcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TeamRegistryCredential entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TeamRegistryCredential entity is found.
+// Returns a *NotFoundError when no TeamRegistryCredential entities are found.
+func (trcq *TeamRegistryCredentialQuery) Only(ctx context.Context) (*TeamRegistryCredential, error) {
+	nodes, err := trcq.Limit(2).All(setContextOp(ctx, trcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{teamregistrycredential.Label}
+	default:
+		return nil, &NotSingularError{teamregistrycredential.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trcq *TeamRegistryCredentialQuery) OnlyX(ctx context.Context) *TeamRegistryCredential {
+	node, err := trcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TeamRegistryCredential ID in the query.
+// Returns a *NotSingularError when more than one TeamRegistryCredential ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trcq *TeamRegistryCredentialQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = trcq.Limit(2).IDs(setContextOp(ctx, trcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{teamregistrycredential.Label}
+	default:
+		err = &NotSingularError{teamregistrycredential.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trcq *TeamRegistryCredentialQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := trcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TeamRegistryCredentials.
+func (trcq *TeamRegistryCredentialQuery) All(ctx context.Context) ([]*TeamRegistryCredential, error) {
+	ctx = setContextOp(ctx, trcq.ctx, "All")
+	if err := trcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TeamRegistryCredential, *TeamRegistryCredentialQuery]()
+	return withInterceptors[[]*TeamRegistryCredential](ctx, trcq, qr, trcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trcq *TeamRegistryCredentialQuery) AllX(ctx context.Context) []*TeamRegistryCredential {
+	nodes, err := trcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TeamRegistryCredential IDs.
+func (trcq *TeamRegistryCredentialQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if trcq.ctx.Unique == nil && trcq.path != nil {
+		trcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, trcq.ctx, "IDs")
+	if err = trcq.Select(teamregistrycredential.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trcq *TeamRegistryCredentialQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := trcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trcq *TeamRegistryCredentialQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, trcq.ctx, "Count")
+	if err := trcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, trcq, querierCount[*TeamRegistryCredentialQuery](), trcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trcq *TeamRegistryCredentialQuery) CountX(ctx context.Context) int {
+	count, err := trcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trcq *TeamRegistryCredentialQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, trcq.ctx, "Exist")
+	switch _, err := trcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trcq *TeamRegistryCredentialQuery) ExistX(ctx context.Context) bool {
+	exist, err := trcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TeamRegistryCredentialQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trcq *TeamRegistryCredentialQuery) Clone() *TeamRegistryCredentialQuery {
+	if trcq == nil {
+		return nil
+	}
+	return &TeamRegistryCredentialQuery{
+		config:     trcq.config,
+		ctx:        trcq.ctx.Clone(),
+		order:      append([]teamregistrycredential.OrderOption{}, trcq.order...),
+		inters:     append([]Interceptor{}, trcq.inters...),
+		predicates: append([]predicate.TeamRegistryCredential{}, trcq.predicates...),
+		// clone intermediate query.
+		sql:  trcq.sql.Clone(),
+		path: trcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TeamRegistryCredential.Query().
+//		GroupBy(teamregistrycredential.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (trcq *TeamRegistryCredentialQuery) GroupBy(field string, fields ...string) *TeamRegistryCredentialGroupBy {
+	trcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TeamRegistryCredentialGroupBy{build: trcq}
+	grbuild.flds = &trcq.ctx.Fields
+	grbuild.label = teamregistrycredential.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TeamRegistryCredential.Query().
+//		Select(teamregistrycredential.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (trcq *TeamRegistryCredentialQuery) Select(fields ...string) *TeamRegistryCredentialSelect {
+	trcq.ctx.Fields = append(trcq.ctx.Fields, fields...)
+	sbuild := &TeamRegistryCredentialSelect{TeamRegistryCredentialQuery: trcq}
+	sbuild.label = teamregistrycredential.Label
+	sbuild.flds, sbuild.scan = &trcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TeamRegistryCredentialSelect configured with the given aggregations.
+func (trcq *TeamRegistryCredentialQuery) Aggregate(fns ...AggregateFunc) *TeamRegistryCredentialSelect {
+	return trcq.Select().Aggregate(fns...)
+}
+
+func (trcq *TeamRegistryCredentialQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range trcq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, trcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range trcq.ctx.Fields {
+		if !teamregistrycredential.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if trcq.path != nil {
+		prev, err := trcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trcq.sql = prev
+	}
+	return nil
+}
+
+func (trcq *TeamRegistryCredentialQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TeamRegistryCredential, error) {
+	var (
+		nodes = []*TeamRegistryCredential{}
+		_spec = trcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TeamRegistryCredential).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TeamRegistryCredential{config: trcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = trcq.schemaConfig.TeamRegistryCredential
+	ctx = internal.NewSchemaConfigContext(ctx, trcq.schemaConfig)
+	if len(trcq.modifiers) > 0 {
+		_spec.Modifiers = trcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (trcq *TeamRegistryCredentialQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trcq.querySpec()
+	_spec.Node.Schema = trcq.schemaConfig.TeamRegistryCredential
+	ctx = internal.NewSchemaConfigContext(ctx, trcq.schemaConfig)
+	if len(trcq.modifiers) > 0 {
+		_spec.Modifiers = trcq.modifiers
+	}
+	_spec.Node.Columns = trcq.ctx.Fields
+	if len(trcq.ctx.Fields) > 0 {
+		_spec.Unique = trcq.ctx.Unique != nil && *trcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, trcq.driver, _spec)
+}
+
+func (trcq *TeamRegistryCredentialQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(teamregistrycredential.Table, teamregistrycredential.Columns, sqlgraph.NewFieldSpec(teamregistrycredential.FieldID, field.TypeUUID))
+	_spec.From = trcq.sql
+	if unique := trcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if trcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := trcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamregistrycredential.FieldID)
+		for i := range fields {
+			if fields[i] != teamregistrycredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := trcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trcq *TeamRegistryCredentialQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trcq.driver.Dialect())
+	t1 := builder.Table(teamregistrycredential.Table)
+	columns := trcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = teamregistrycredential.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trcq.sql != nil {
+		selector = trcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trcq.ctx.Unique != nil && *trcq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(trcq.schemaConfig.TeamRegistryCredential)
+	ctx = internal.NewSchemaConfigContext(ctx, trcq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range trcq.modifiers {
+		m(selector)
+	}
+	for _, p := range trcq.predicates {
+		p(selector)
+	}
+	for _, p := range trcq.order {
+		p(selector)
+	}
+	if offset := trcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (trcq *TeamRegistryCredentialQuery) Modify(modifiers ...func(s *sql.Selector)) *TeamRegistryCredentialSelect {
+	trcq.modifiers = append(trcq.modifiers, modifiers...)
+	return trcq.Select()
+}
+
+// TeamRegistryCredentialGroupBy is the group-by builder for TeamRegistryCredential entities.
+type TeamRegistryCredentialGroupBy struct {
+	selector
+	build *TeamRegistryCredentialQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trcgb *TeamRegistryCredentialGroupBy) Aggregate(fns ...AggregateFunc) *TeamRegistryCredentialGroupBy {
+	trcgb.fns = append(trcgb.fns, fns...)
+	return trcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trcgb *TeamRegistryCredentialGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trcgb.build.ctx, "GroupBy")
+	if err := trcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamRegistryCredentialQuery, *TeamRegistryCredentialGroupBy](ctx, trcgb.build, trcgb, trcgb.build.inters, v)
+}
+
+func (trcgb *TeamRegistryCredentialGroupBy) sqlScan(ctx context.Context, root *TeamRegistryCredentialQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(trcgb.fns))
+	for _, fn := range trcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*trcgb.flds)+len(trcgb.fns))
+		for _, f := range *trcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*trcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TeamRegistryCredentialSelect is the builder for selecting fields of TeamRegistryCredential entities.
+type TeamRegistryCredentialSelect struct {
+	*TeamRegistryCredentialQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (trcs *TeamRegistryCredentialSelect) Aggregate(fns ...AggregateFunc) *TeamRegistryCredentialSelect {
+	trcs.fns = append(trcs.fns, fns...)
+	return trcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trcs *TeamRegistryCredentialSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trcs.ctx, "Select")
+	if err := trcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamRegistryCredentialQuery, *TeamRegistryCredentialSelect](ctx, trcs.TeamRegistryCredentialQuery, trcs, trcs.inters, v)
+}
+
+func (trcs *TeamRegistryCredentialSelect) sqlScan(ctx context.Context, root *TeamRegistryCredentialQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(trcs.fns))
+	for _, fn := range trcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*trcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (trcs *TeamRegistryCredentialSelect) Modify(modifiers ...func(s *sql.Selector)) *TeamRegistryCredentialSelect {
+	trcs.modifiers = append(trcs.modifiers, modifiers...)
+	return trcs
+}
@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamregistrycredential"
+)
+
+// TeamRegistryCredentialUpdate is the builder for updating TeamRegistryCredential entities.
+type TeamRegistryCredentialUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TeamRegistryCredentialMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TeamRegistryCredentialUpdate builder.
+func (trcu *TeamRegistryCredentialUpdate) Where(ps ...predicate.TeamRegistryCredential) *TeamRegistryCredentialUpdate {
+	trcu.mutation.Where(ps...)
+	return trcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trcu *TeamRegistryCredentialUpdate) SetUpdatedAt(t time.Time) *TeamRegistryCredentialUpdate {
+	trcu.mutation.SetUpdatedAt(t)
+	return trcu
+}
+
+// SetUsername sets the "username" field.
+func (trcu *TeamRegistryCredentialUpdate) SetUsername(s string) *TeamRegistryCredentialUpdate {
+	trcu.mutation.SetUsername(s)
+	return trcu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (trcu *TeamRegistryCredentialUpdate) SetNillableUsername(s *string) *TeamRegistryCredentialUpdate {
+	if s != nil {
+		trcu.SetUsername(*s)
+	}
+	return trcu
+}
+
+// SetVersion sets the "version" field.
+func (trcu *TeamRegistryCredentialUpdate) SetVersion(i int32) *TeamRegistryCredentialUpdate {
+	trcu.mutation.ResetVersion()
+	trcu.mutation.SetVersion(i)
+	return trcu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (trcu *TeamRegistryCredentialUpdate) SetNillableVersion(i *int32) *TeamRegistryCredentialUpdate {
+	if i != nil {
+		trcu.SetVersion(*i)
+	}
+	return trcu
+}
+
+// AddVersion adds i to the "version" field.
+func (trcu *TeamRegistryCredentialUpdate) AddVersion(i int32) *TeamRegistryCredentialUpdate {
+	trcu.mutation.AddVersion(i)
+	return trcu
+}
+
+// SetKeyID sets the "key_id" field.
+func (trcu *TeamRegistryCredentialUpdate) SetKeyID(s string) *TeamRegistryCredentialUpdate {
+	trcu.mutation.SetKeyID(s)
+	return trcu
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (trcu *TeamRegistryCredentialUpdate) SetNillableKeyID(s *string) *TeamRegistryCredentialUpdate {
+	if s != nil {
+		trcu.SetKeyID(*s)
+	}
+	return trcu
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (trcu *TeamRegistryCredentialUpdate) SetEncryptedKey(b []byte) *TeamRegistryCredentialUpdate {
+	trcu.mutation.SetEncryptedKey(b)
+	return trcu
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (trcu *TeamRegistryCredentialUpdate) SetCiphertext(b []byte) *TeamRegistryCredentialUpdate {
+	trcu.mutation.SetCiphertext(b)
+	return trcu
+}
+
+// Mutation returns the TeamRegistryCredentialMutation object of the builder.
+func (trcu *TeamRegistryCredentialUpdate) Mutation() *TeamRegistryCredentialMutation {
+	return trcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (trcu *TeamRegistryCredentialUpdate) Save(ctx context.Context) (int, error) {
+	trcu.defaults()
+	return withHooks(ctx, trcu.sqlSave, trcu.mutation, trcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcu *TeamRegistryCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := trcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (trcu *TeamRegistryCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := trcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcu *TeamRegistryCredentialUpdate) ExecX(ctx context.Context) {
+	if err := trcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trcu *TeamRegistryCredentialUpdate) defaults() {
+	if _, ok := trcu.mutation.UpdatedAt(); !ok {
+		v := teamregistrycredential.UpdateDefaultUpdatedAt()
+		trcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (trcu *TeamRegistryCredentialUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamRegistryCredentialUpdate {
+	trcu.modifiers = append(trcu.modifiers, modifiers...)
+	return trcu
+}
+
+func (trcu *TeamRegistryCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamregistrycredential.Table, teamregistrycredential.Columns, sqlgraph.NewFieldSpec(teamregistrycredential.FieldID, field.TypeUUID))
+	if ps := trcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := trcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamregistrycredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := trcu.mutation.Username(); ok {
+		_spec.SetField(teamregistrycredential.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := trcu.mutation.Version(); ok {
+		_spec.SetField(teamregistrycredential.FieldVersion, field.TypeInt32, value)
+	}
+	if value, ok := trcu.mutation.AddedVersion(); ok {
+		_spec.AddField(teamregistrycredential.FieldVersion, field.TypeInt32, value)
+	}
+	if value, ok := trcu.mutation.KeyID(); ok {
+		_spec.SetField(teamregistrycredential.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := trcu.mutation.EncryptedKey(); ok {
+		_spec.SetField(teamregistrycredential.FieldEncryptedKey, field.TypeBytes, value)
+	}
+	if value, ok := trcu.mutation.Ciphertext(); ok {
+		_spec.SetField(teamregistrycredential.FieldCiphertext, field.TypeBytes, value)
+	}
+	_spec.Node.Schema = trcu.schemaConfig.TeamRegistryCredential
+	ctx = internal.NewSchemaConfigContext(ctx, trcu.schemaConfig)
+	_spec.AddModifiers(trcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, trcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamregistrycredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	trcu.mutation.done = true
+	return n, nil
+}
+
+// TeamRegistryCredentialUpdateOne is the builder for updating a single TeamRegistryCredential entity.
+type TeamRegistryCredentialUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TeamRegistryCredentialMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trcuo *TeamRegistryCredentialUpdateOne) SetUpdatedAt(t time.Time) *TeamRegistryCredentialUpdateOne {
+	trcuo.mutation.SetUpdatedAt(t)
+	return trcuo
+}
+
+// SetUsername sets the "username" field.
+func (trcuo *TeamRegistryCredentialUpdateOne) SetUsername(s string) *TeamRegistryCredentialUpdateOne {
+	trcuo.mutation.SetUsername(s)
+	return trcuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (trcuo *TeamRegistryCredentialUpdateOne) SetNillableUsername(s *string) *TeamRegistryCredentialUpdateOne {
+	if s != nil {
+		trcuo.SetUsername(*s)
+	}
+	return trcuo
+}
+
+// SetVersion sets the "version" field.
+func (trcuo *TeamRegistryCredentialUpdateOne) SetVersion(i int32) *TeamRegistryCredentialUpdateOne {
+	trcuo.mutation.ResetVersion()
+	trcuo.mutation.SetVersion(i)
+	return trcuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (trcuo *TeamRegistryCredentialUpdateOne) SetNillableVersion(i *int32) *TeamRegistryCredentialUpdateOne {
+	if i != nil {
+		trcuo.SetVersion(*i)
+	}
+	return trcuo
+}
+
+// AddVersion adds i to the "version" field.
+func (trcuo *TeamRegistryCredentialUpdateOne) AddVersion(i int32) *TeamRegistryCredentialUpdateOne {
+	trcuo.mutation.AddVersion(i)
+	return trcuo
+}
+
+// SetKeyID sets the "key_id" field.
+func (trcuo *TeamRegistryCredentialUpdateOne) SetKeyID(s string) *TeamRegistryCredentialUpdateOne {
+	trcuo.mutation.SetKeyID(s)
+	return trcuo
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (trcuo *TeamRegistryCredentialUpdateOne) SetNillableKeyID(s *string) *TeamRegistryCredentialUpdateOne {
+	if s != nil {
+		trcuo.SetKeyID(*s)
+	}
+	return trcuo
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (trcuo *TeamRegistryCredentialUpdateOne) SetEncryptedKey(b []byte) *TeamRegistryCredentialUpdateOne {
+	trcuo.mutation.SetEncryptedKey(b)
+	return trcuo
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (trcuo *TeamRegistryCredentialUpdateOne) SetCiphertext(b []byte) *TeamRegistryCredentialUpdateOne {
+	trcuo.mutation.SetCiphertext(b)
+	return trcuo
+}
+
+// Mutation returns the TeamRegistryCredentialMutation object of the builder.
+func (trcuo *TeamRegistryCredentialUpdateOne) Mutation() *TeamRegistryCredentialMutation {
+	return trcuo.mutation
+}
+
+// Where appends a list predicates to the TeamRegistryCredentialUpdate builder.
+func (trcuo *TeamRegistryCredentialUpdateOne) Where(ps ...predicate.TeamRegistryCredential) *TeamRegistryCredentialUpdateOne {
+	trcuo.mutation.Where(ps...)
+	return trcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (trcuo *TeamRegistryCredentialUpdateOne) Select(field string, fields ...string) *TeamRegistryCredentialUpdateOne {
+	trcuo.fields = append([]string{field}, fields...)
+	return trcuo
+}
+
+// Save executes the query and returns the updated TeamRegistryCredential entity.
+func (trcuo *TeamRegistryCredentialUpdateOne) Save(ctx context.Context) (*TeamRegistryCredential, error) {
+	trcuo.defaults()
+	return withHooks(ctx, trcuo.sqlSave, trcuo.mutation, trcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcuo *TeamRegistryCredentialUpdateOne) SaveX(ctx context.Context) *TeamRegistryCredential {
+	node, err := trcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (trcuo *TeamRegistryCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := trcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcuo *TeamRegistryCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := trcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trcuo *TeamRegistryCredentialUpdateOne) defaults() {
+	if _, ok := trcuo.mutation.UpdatedAt(); !ok {
+		v := teamregistrycredential.UpdateDefaultUpdatedAt()
+		trcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (trcuo *TeamRegistryCredentialUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamRegistryCredentialUpdateOne {
+	trcuo.modifiers = append(trcuo.modifiers, modifiers...)
+	return trcuo
+}
+
+func (trcuo *TeamRegistryCredentialUpdateOne) sqlSave(ctx context.Context) (_node *TeamRegistryCredential, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamregistrycredential.Table, teamregistrycredential.Columns, sqlgraph.NewFieldSpec(teamregistrycredential.FieldID, field.TypeUUID))
+	id, ok := trcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "TeamRegistryCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := trcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamregistrycredential.FieldID)
+		for _, f := range fields {
+			if !teamregistrycredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != teamregistrycredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := trcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := trcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamregistrycredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := trcuo.mutation.Username(); ok {
+		_spec.SetField(teamregistrycredential.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := trcuo.mutation.Version(); ok {
+		_spec.SetField(teamregistrycredential.FieldVersion, field.TypeInt32, value)
+	}
+	if value, ok := trcuo.mutation.AddedVersion(); ok {
+		_spec.AddField(teamregistrycredential.FieldVersion, field.TypeInt32, value)
+	}
+	if value, ok := trcuo.mutation.KeyID(); ok {
+		_spec.SetField(teamregistrycredential.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := trcuo.mutation.EncryptedKey(); ok {
+		_spec.SetField(teamregistrycredential.FieldEncryptedKey, field.TypeBytes, value)
+	}
+	if value, ok := trcuo.mutation.Ciphertext(); ok {
+		_spec.SetField(teamregistrycredential.FieldCiphertext, field.TypeBytes, value)
+	}
+	_spec.Node.Schema = trcuo.schemaConfig.TeamRegistryCredential
+	ctx = internal.NewSchemaConfigContext(ctx, trcuo.schemaConfig)
+	_spec.AddModifiers(trcuo.modifiers...)
+	_node = &TeamRegistryCredential{config: trcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, trcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamregistrycredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	trcuo.mutation.done = true
+	return _node, nil
+}
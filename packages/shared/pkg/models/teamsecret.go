@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecret"
+	"github.com/google/uuid"
+)
+
+// TeamSecret is the model entity for the TeamSecret schema.
+type TeamSecret struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// LatestVersion holds the value of the "latest_version" field.
+	LatestVersion int32 `json:"latest_version,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamSecret) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teamsecret.FieldLatestVersion:
+			values[i] = new(sql.NullInt64)
+		case teamsecret.FieldName:
+			values[i] = new(sql.NullString)
+		case teamsecret.FieldCreatedAt, teamsecret.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case teamsecret.FieldID, teamsecret.FieldTeamID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamSecret fields.
+func (ts *TeamSecret) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teamsecret.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ts.ID = *value
+			}
+		case teamsecret.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ts.CreatedAt = value.Time
+			}
+		case teamsecret.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ts.UpdatedAt = value.Time
+			}
+		case teamsecret.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				ts.TeamID = *value
+			}
+		case teamsecret.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ts.Name = value.String
+			}
+		case teamsecret.FieldLatestVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latest_version", values[i])
+			} else if value.Valid {
+				ts.LatestVersion = int32(value.Int64)
+			}
+		default:
+			ts.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamSecret.
+// This includes values selected through modifiers, order, etc.
+func (ts *TeamSecret) Value(name string) (ent.Value, error) {
+	return ts.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TeamSecret.
+// Note that you need to call TeamSecret.Unwrap() before calling this method if this TeamSecret
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ts *TeamSecret) Update() *TeamSecretUpdateOne {
+	return NewTeamSecretClient(ts.config).UpdateOne(ts)
+}
+
+// Unwrap unwraps the TeamSecret entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ts *TeamSecret) Unwrap() *TeamSecret {
+	_tx, ok := ts.config.driver.(*txDriver)
+	if !ok {
+		panic("models: TeamSecret is not a transactional entity")
+	}
+	ts.config.driver = _tx.drv
+	return ts
+}
+
+// String implements the fmt.Stringer.
+func (ts *TeamSecret) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamSecret(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ts.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ts.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ts.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", ts.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ts.Name)
+	builder.WriteString(", ")
+	builder.WriteString("latest_version=")
+	builder.WriteString(fmt.Sprintf("%v", ts.LatestVersion))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamSecrets is a parsable slice of TeamSecret.
+type TeamSecrets []*TeamSecret
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teamsecret
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldTeamID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldName, v))
+}
+
+// LatestVersion applies equality check predicate on the "latest_version" field. It's identical to LatestVersionEQ.
+func LatestVersion(v int32) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldLatestVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v uuid.UUID) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLTE(FieldTeamID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldContainsFold(FieldName, v))
+}
+
+// LatestVersionEQ applies the EQ predicate on the "latest_version" field.
+func LatestVersionEQ(v int32) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldEQ(FieldLatestVersion, v))
+}
+
+// LatestVersionNEQ applies the NEQ predicate on the "latest_version" field.
+func LatestVersionNEQ(v int32) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNEQ(FieldLatestVersion, v))
+}
+
+// LatestVersionIn applies the In predicate on the "latest_version" field.
+func LatestVersionIn(vs ...int32) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldIn(FieldLatestVersion, vs...))
+}
+
+// LatestVersionNotIn applies the NotIn predicate on the "latest_version" field.
+func LatestVersionNotIn(vs ...int32) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldNotIn(FieldLatestVersion, vs...))
+}
+
+// LatestVersionGT applies the GT predicate on the "latest_version" field.
+func LatestVersionGT(v int32) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGT(FieldLatestVersion, v))
+}
+
+// LatestVersionGTE applies the GTE predicate on the "latest_version" field.
+func LatestVersionGTE(v int32) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldGTE(FieldLatestVersion, v))
+}
+
+// LatestVersionLT applies the LT predicate on the "latest_version" field.
+func LatestVersionLT(v int32) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLT(FieldLatestVersion, v))
+}
+
+// LatestVersionLTE applies the LTE predicate on the "latest_version" field.
+func LatestVersionLTE(v int32) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.FieldLTE(FieldLatestVersion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamSecret) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamSecret) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamSecret) predicate.TeamSecret {
+	return predicate.TeamSecret(sql.NotPredicates(p))
+}
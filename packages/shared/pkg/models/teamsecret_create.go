@@ -0,0 +1,676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecret"
+	"github.com/google/uuid"
+)
+
+// TeamSecretCreate is the builder for creating a TeamSecret entity.
+type TeamSecretCreate struct {
+	config
+	mutation *TeamSecretMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsc *TeamSecretCreate) SetCreatedAt(t time.Time) *TeamSecretCreate {
+	tsc.mutation.SetCreatedAt(t)
+	return tsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsc *TeamSecretCreate) SetNillableCreatedAt(t *time.Time) *TeamSecretCreate {
+	if t != nil {
+		tsc.SetCreatedAt(*t)
+	}
+	return tsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsc *TeamSecretCreate) SetUpdatedAt(t time.Time) *TeamSecretCreate {
+	tsc.mutation.SetUpdatedAt(t)
+	return tsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tsc *TeamSecretCreate) SetNillableUpdatedAt(t *time.Time) *TeamSecretCreate {
+	if t != nil {
+		tsc.SetUpdatedAt(*t)
+	}
+	return tsc
+}
+
+// SetTeamID sets the "team_id" field.
+func (tsc *TeamSecretCreate) SetTeamID(u uuid.UUID) *TeamSecretCreate {
+	tsc.mutation.SetTeamID(u)
+	return tsc
+}
+
+// SetName sets the "name" field.
+func (tsc *TeamSecretCreate) SetName(s string) *TeamSecretCreate {
+	tsc.mutation.SetName(s)
+	return tsc
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (tsc *TeamSecretCreate) SetLatestVersion(i int32) *TeamSecretCreate {
+	tsc.mutation.SetLatestVersion(i)
+	return tsc
+}
+
+// SetNillableLatestVersion sets the "latest_version" field if the given value is not nil.
+func (tsc *TeamSecretCreate) SetNillableLatestVersion(i *int32) *TeamSecretCreate {
+	if i != nil {
+		tsc.SetLatestVersion(*i)
+	}
+	return tsc
+}
+
+// SetID sets the "id" field.
+func (tsc *TeamSecretCreate) SetID(u uuid.UUID) *TeamSecretCreate {
+	tsc.mutation.SetID(u)
+	return tsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tsc *TeamSecretCreate) SetNillableID(u *uuid.UUID) *TeamSecretCreate {
+	if u != nil {
+		tsc.SetID(*u)
+	}
+	return tsc
+}
+
+// Mutation returns the TeamSecretMutation object of the builder.
+func (tsc *TeamSecretCreate) Mutation() *TeamSecretMutation {
+	return tsc.mutation
+}
+
+// Save creates the TeamSecret in the database.
+func (tsc *TeamSecretCreate) Save(ctx context.Context) (*TeamSecret, error) {
+	tsc.defaults()
+	return withHooks(ctx, tsc.sqlSave, tsc.mutation, tsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsc *TeamSecretCreate) SaveX(ctx context.Context) *TeamSecret {
+	v, err := tsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsc *TeamSecretCreate) Exec(ctx context.Context) error {
+	_, err := tsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsc *TeamSecretCreate) ExecX(ctx context.Context) {
+	if err := tsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsc *TeamSecretCreate) defaults() {
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		v := teamsecret.DefaultCreatedAt()
+		tsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tsc.mutation.UpdatedAt(); !ok {
+		v := teamsecret.DefaultUpdatedAt()
+		tsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tsc.mutation.LatestVersion(); !ok {
+		v := teamsecret.DefaultLatestVersion
+		tsc.mutation.SetLatestVersion(v)
+	}
+	if _, ok := tsc.mutation.ID(); !ok {
+		v := teamsecret.DefaultID()
+		tsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsc *TeamSecretCreate) check() error {
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "TeamSecret.created_at"`)}
+	}
+	if _, ok := tsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`models: missing required field "TeamSecret.updated_at"`)}
+	}
+	if _, ok := tsc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "TeamSecret.team_id"`)}
+	}
+	if _, ok := tsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`models: missing required field "TeamSecret.name"`)}
+	}
+	if _, ok := tsc.mutation.LatestVersion(); !ok {
+		return &ValidationError{Name: "latest_version", err: errors.New(`models: missing required field "TeamSecret.latest_version"`)}
+	}
+	return nil
+}
+
+func (tsc *TeamSecretCreate) sqlSave(ctx context.Context) (*TeamSecret, error) {
+	if err := tsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tsc.mutation.id = &_node.ID
+	tsc.mutation.done = true
+	return _node, nil
+}
+
+func (tsc *TeamSecretCreate) createSpec() (*TeamSecret, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamSecret{config: tsc.config}
+		_spec = sqlgraph.NewCreateSpec(teamsecret.Table, sqlgraph.NewFieldSpec(teamsecret.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = tsc.schemaConfig.TeamSecret
+	_spec.OnConflict = tsc.conflict
+	if id, ok := tsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tsc.mutation.CreatedAt(); ok {
+		_spec.SetField(teamsecret.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamsecret.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tsc.mutation.TeamID(); ok {
+		_spec.SetField(teamsecret.FieldTeamID, field.TypeUUID, value)
+		_node.TeamID = value
+	}
+	if value, ok := tsc.mutation.Name(); ok {
+		_spec.SetField(teamsecret.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tsc.mutation.LatestVersion(); ok {
+		_spec.SetField(teamsecret.FieldLatestVersion, field.TypeInt32, value)
+		_node.LatestVersion = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamSecret.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamSecretUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tsc *TeamSecretCreate) OnConflict(opts ...sql.ConflictOption) *TeamSecretUpsertOne {
+	tsc.conflict = opts
+	return &TeamSecretUpsertOne{
+		create: tsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamSecret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tsc *TeamSecretCreate) OnConflictColumns(columns ...string) *TeamSecretUpsertOne {
+	tsc.conflict = append(tsc.conflict, sql.ConflictColumns(columns...))
+	return &TeamSecretUpsertOne{
+		create: tsc,
+	}
+}
+
+type (
+	// TeamSecretUpsertOne is the builder for "upsert"-ing
+	//  one TeamSecret node.
+	TeamSecretUpsertOne struct {
+		create *TeamSecretCreate
+	}
+
+	// TeamSecretUpsert is the "OnConflict" setter.
+	TeamSecretUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamSecretUpsert) SetUpdatedAt(v time.Time) *TeamSecretUpsert {
+	u.Set(teamsecret.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamSecretUpsert) UpdateUpdatedAt() *TeamSecretUpsert {
+	u.SetExcluded(teamsecret.FieldUpdatedAt)
+	return u
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (u *TeamSecretUpsert) SetLatestVersion(v int32) *TeamSecretUpsert {
+	u.Set(teamsecret.FieldLatestVersion, v)
+	return u
+}
+
+// UpdateLatestVersion sets the "latest_version" field to the value that was provided on create.
+func (u *TeamSecretUpsert) UpdateLatestVersion() *TeamSecretUpsert {
+	u.SetExcluded(teamsecret.FieldLatestVersion)
+	return u
+}
+
+// AddLatestVersion adds v to the "latest_version" field.
+func (u *TeamSecretUpsert) AddLatestVersion(v int32) *TeamSecretUpsert {
+	u.Add(teamsecret.FieldLatestVersion, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TeamSecret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamsecret.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamSecretUpsertOne) UpdateNewValues() *TeamSecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(teamsecret.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(teamsecret.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TeamID(); exists {
+			s.SetIgnore(teamsecret.FieldTeamID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(teamsecret.FieldName)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamSecret.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamSecretUpsertOne) Ignore() *TeamSecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamSecretUpsertOne) DoNothing() *TeamSecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamSecretCreate.OnConflict
+// documentation for more info.
+func (u *TeamSecretUpsertOne) Update(set func(*TeamSecretUpsert)) *TeamSecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamSecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamSecretUpsertOne) SetUpdatedAt(v time.Time) *TeamSecretUpsertOne {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamSecretUpsertOne) UpdateUpdatedAt() *TeamSecretUpsertOne {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (u *TeamSecretUpsertOne) SetLatestVersion(v int32) *TeamSecretUpsertOne {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.SetLatestVersion(v)
+	})
+}
+
+// AddLatestVersion adds v to the "latest_version" field.
+func (u *TeamSecretUpsertOne) AddLatestVersion(v int32) *TeamSecretUpsertOne {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.AddLatestVersion(v)
+	})
+}
+
+// UpdateLatestVersion sets the "latest_version" field to the value that was provided on create.
+func (u *TeamSecretUpsertOne) UpdateLatestVersion() *TeamSecretUpsertOne {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.UpdateLatestVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamSecretUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamSecretCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamSecretUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamSecretUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: TeamSecretUpsertOne.ID is not supported by MySQL driver. Use TeamSecretUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamSecretUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamSecretCreateBulk is the builder for creating many TeamSecret entities in bulk.
+type TeamSecretCreateBulk struct {
+	config
+	err      error
+	builders []*TeamSecretCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamSecret entities in the database.
+func (tscb *TeamSecretCreateBulk) Save(ctx context.Context) ([]*TeamSecret, error) {
+	if tscb.err != nil {
+		return nil, tscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tscb.builders))
+	nodes := make([]*TeamSecret, len(tscb.builders))
+	mutators := make([]Mutator, len(tscb.builders))
+	for i := range tscb.builders {
+		func(i int, root context.Context) {
+			builder := tscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamSecretMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tscb *TeamSecretCreateBulk) SaveX(ctx context.Context) []*TeamSecret {
+	v, err := tscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tscb *TeamSecretCreateBulk) Exec(ctx context.Context) error {
+	_, err := tscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscb *TeamSecretCreateBulk) ExecX(ctx context.Context) {
+	if err := tscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamSecret.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamSecretUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tscb *TeamSecretCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamSecretUpsertBulk {
+	tscb.conflict = opts
+	return &TeamSecretUpsertBulk{
+		create: tscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamSecret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tscb *TeamSecretCreateBulk) OnConflictColumns(columns ...string) *TeamSecretUpsertBulk {
+	tscb.conflict = append(tscb.conflict, sql.ConflictColumns(columns...))
+	return &TeamSecretUpsertBulk{
+		create: tscb,
+	}
+}
+
+// TeamSecretUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamSecret nodes.
+type TeamSecretUpsertBulk struct {
+	create *TeamSecretCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamSecret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamsecret.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamSecretUpsertBulk) UpdateNewValues() *TeamSecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(teamsecret.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(teamsecret.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TeamID(); exists {
+				s.SetIgnore(teamsecret.FieldTeamID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(teamsecret.FieldName)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamSecret.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamSecretUpsertBulk) Ignore() *TeamSecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamSecretUpsertBulk) DoNothing() *TeamSecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamSecretCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamSecretUpsertBulk) Update(set func(*TeamSecretUpsert)) *TeamSecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamSecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamSecretUpsertBulk) SetUpdatedAt(v time.Time) *TeamSecretUpsertBulk {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamSecretUpsertBulk) UpdateUpdatedAt() *TeamSecretUpsertBulk {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (u *TeamSecretUpsertBulk) SetLatestVersion(v int32) *TeamSecretUpsertBulk {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.SetLatestVersion(v)
+	})
+}
+
+// AddLatestVersion adds v to the "latest_version" field.
+func (u *TeamSecretUpsertBulk) AddLatestVersion(v int32) *TeamSecretUpsertBulk {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.AddLatestVersion(v)
+	})
+}
+
+// UpdateLatestVersion sets the "latest_version" field to the value that was provided on create.
+func (u *TeamSecretUpsertBulk) UpdateLatestVersion() *TeamSecretUpsertBulk {
+	return u.Update(func(s *TeamSecretUpsert) {
+		s.UpdateLatestVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamSecretUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the TeamSecretCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamSecretCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamSecretUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecret"
+)
+
+// TeamSecretUpdate is the builder for updating TeamSecret entities.
+type TeamSecretUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TeamSecretMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TeamSecretUpdate builder.
+func (tsu *TeamSecretUpdate) Where(ps ...predicate.TeamSecret) *TeamSecretUpdate {
+	tsu.mutation.Where(ps...)
+	return tsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsu *TeamSecretUpdate) SetUpdatedAt(t time.Time) *TeamSecretUpdate {
+	tsu.mutation.SetUpdatedAt(t)
+	return tsu
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (tsu *TeamSecretUpdate) SetLatestVersion(i int32) *TeamSecretUpdate {
+	tsu.mutation.ResetLatestVersion()
+	tsu.mutation.SetLatestVersion(i)
+	return tsu
+}
+
+// SetNillableLatestVersion sets the "latest_version" field if the given value is not nil.
+func (tsu *TeamSecretUpdate) SetNillableLatestVersion(i *int32) *TeamSecretUpdate {
+	if i != nil {
+		tsu.SetLatestVersion(*i)
+	}
+	return tsu
+}
+
+// AddLatestVersion adds i to the "latest_version" field.
+func (tsu *TeamSecretUpdate) AddLatestVersion(i int32) *TeamSecretUpdate {
+	tsu.mutation.AddLatestVersion(i)
+	return tsu
+}
+
+// Mutation returns the TeamSecretMutation object of the builder.
+func (tsu *TeamSecretUpdate) Mutation() *TeamSecretMutation {
+	return tsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsu *TeamSecretUpdate) Save(ctx context.Context) (int, error) {
+	tsu.defaults()
+	return withHooks(ctx, tsu.sqlSave, tsu.mutation, tsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsu *TeamSecretUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsu *TeamSecretUpdate) Exec(ctx context.Context) error {
+	_, err := tsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsu *TeamSecretUpdate) ExecX(ctx context.Context) {
+	if err := tsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsu *TeamSecretUpdate) defaults() {
+	if _, ok := tsu.mutation.UpdatedAt(); !ok {
+		v := teamsecret.UpdateDefaultUpdatedAt()
+		tsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tsu *TeamSecretUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamSecretUpdate {
+	tsu.modifiers = append(tsu.modifiers, modifiers...)
+	return tsu
+}
+
+func (tsu *TeamSecretUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamsecret.Table, teamsecret.Columns, sqlgraph.NewFieldSpec(teamsecret.FieldID, field.TypeUUID))
+	if ps := tsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamsecret.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsu.mutation.LatestVersion(); ok {
+		_spec.SetField(teamsecret.FieldLatestVersion, field.TypeInt32, value)
+	}
+	if value, ok := tsu.mutation.AddedLatestVersion(); ok {
+		_spec.AddField(teamsecret.FieldLatestVersion, field.TypeInt32, value)
+	}
+	_spec.Node.Schema = tsu.schemaConfig.TeamSecret
+	ctx = internal.NewSchemaConfigContext(ctx, tsu.schemaConfig)
+	_spec.AddModifiers(tsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamsecret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsu.mutation.done = true
+	return n, nil
+}
+
+// TeamSecretUpdateOne is the builder for updating a single TeamSecret entity.
+type TeamSecretUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TeamSecretMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsuo *TeamSecretUpdateOne) SetUpdatedAt(t time.Time) *TeamSecretUpdateOne {
+	tsuo.mutation.SetUpdatedAt(t)
+	return tsuo
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (tsuo *TeamSecretUpdateOne) SetLatestVersion(i int32) *TeamSecretUpdateOne {
+	tsuo.mutation.ResetLatestVersion()
+	tsuo.mutation.SetLatestVersion(i)
+	return tsuo
+}
+
+// SetNillableLatestVersion sets the "latest_version" field if the given value is not nil.
+func (tsuo *TeamSecretUpdateOne) SetNillableLatestVersion(i *int32) *TeamSecretUpdateOne {
+	if i != nil {
+		tsuo.SetLatestVersion(*i)
+	}
+	return tsuo
+}
+
+// AddLatestVersion adds i to the "latest_version" field.
+func (tsuo *TeamSecretUpdateOne) AddLatestVersion(i int32) *TeamSecretUpdateOne {
+	tsuo.mutation.AddLatestVersion(i)
+	return tsuo
+}
+
+// Mutation returns the TeamSecretMutation object of the builder.
+func (tsuo *TeamSecretUpdateOne) Mutation() *TeamSecretMutation {
+	return tsuo.mutation
+}
+
+// Where appends a list predicates to the TeamSecretUpdate builder.
+func (tsuo *TeamSecretUpdateOne) Where(ps ...predicate.TeamSecret) *TeamSecretUpdateOne {
+	tsuo.mutation.Where(ps...)
+	return tsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsuo *TeamSecretUpdateOne) Select(field string, fields ...string) *TeamSecretUpdateOne {
+	tsuo.fields = append([]string{field}, fields...)
+	return tsuo
+}
+
+// Save executes the query and returns the updated TeamSecret entity.
+func (tsuo *TeamSecretUpdateOne) Save(ctx context.Context) (*TeamSecret, error) {
+	tsuo.defaults()
+	return withHooks(ctx, tsuo.sqlSave, tsuo.mutation, tsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsuo *TeamSecretUpdateOne) SaveX(ctx context.Context) *TeamSecret {
+	node, err := tsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsuo *TeamSecretUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsuo *TeamSecretUpdateOne) ExecX(ctx context.Context) {
+	if err := tsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsuo *TeamSecretUpdateOne) defaults() {
+	if _, ok := tsuo.mutation.UpdatedAt(); !ok {
+		v := teamsecret.UpdateDefaultUpdatedAt()
+		tsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tsuo *TeamSecretUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamSecretUpdateOne {
+	tsuo.modifiers = append(tsuo.modifiers, modifiers...)
+	return tsuo
+}
+
+func (tsuo *TeamSecretUpdateOne) sqlSave(ctx context.Context) (_node *TeamSecret, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamsecret.Table, teamsecret.Columns, sqlgraph.NewFieldSpec(teamsecret.FieldID, field.TypeUUID))
+	id, ok := tsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "TeamSecret.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamsecret.FieldID)
+		for _, f := range fields {
+			if !teamsecret.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != teamsecret.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamsecret.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsuo.mutation.LatestVersion(); ok {
+		_spec.SetField(teamsecret.FieldLatestVersion, field.TypeInt32, value)
+	}
+	if value, ok := tsuo.mutation.AddedLatestVersion(); ok {
+		_spec.AddField(teamsecret.FieldLatestVersion, field.TypeInt32, value)
+	}
+	_spec.Node.Schema = tsuo.schemaConfig.TeamSecret
+	ctx = internal.NewSchemaConfigContext(ctx, tsuo.schemaConfig)
+	_spec.AddModifiers(tsuo.modifiers...)
+	_node = &TeamSecret{config: tsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamsecret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+	"github.com/google/uuid"
+)
+
+// TeamSecretVersion is the model entity for the TeamSecretVersion schema.
+type TeamSecretVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// SecretID holds the value of the "secret_id" field.
+	SecretID uuid.UUID `json:"secret_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int32 `json:"version,omitempty"`
+	// KeyID holds the value of the "key_id" field.
+	KeyID string `json:"key_id,omitempty"`
+	// EncryptedKey holds the value of the "encrypted_key" field.
+	EncryptedKey []byte `json:"-"`
+	// Ciphertext holds the value of the "ciphertext" field.
+	Ciphertext   []byte `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamSecretVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teamsecretversion.FieldEncryptedKey, teamsecretversion.FieldCiphertext:
+			values[i] = new([]byte)
+		case teamsecretversion.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case teamsecretversion.FieldKeyID:
+			values[i] = new(sql.NullString)
+		case teamsecretversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case teamsecretversion.FieldID, teamsecretversion.FieldSecretID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamSecretVersion fields.
+func (tsv *TeamSecretVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teamsecretversion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tsv.ID = *value
+			}
+		case teamsecretversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tsv.CreatedAt = value.Time
+			}
+		case teamsecretversion.FieldSecretID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_id", values[i])
+			} else if value != nil {
+				tsv.SecretID = *value
+			}
+		case teamsecretversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				tsv.Version = int32(value.Int64)
+			}
+		case teamsecretversion.FieldKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				tsv.KeyID = value.String
+			}
+		case teamsecretversion.FieldEncryptedKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_key", values[i])
+			} else if value != nil {
+				tsv.EncryptedKey = *value
+			}
+		case teamsecretversion.FieldCiphertext:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ciphertext", values[i])
+			} else if value != nil {
+				tsv.Ciphertext = *value
+			}
+		default:
+			tsv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamSecretVersion.
+// This includes values selected through modifiers, order, etc.
+func (tsv *TeamSecretVersion) Value(name string) (ent.Value, error) {
+	return tsv.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TeamSecretVersion.
+// Note that you need to call TeamSecretVersion.Unwrap() before calling this method if this TeamSecretVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tsv *TeamSecretVersion) Update() *TeamSecretVersionUpdateOne {
+	return NewTeamSecretVersionClient(tsv.config).UpdateOne(tsv)
+}
+
+// Unwrap unwraps the TeamSecretVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tsv *TeamSecretVersion) Unwrap() *TeamSecretVersion {
+	_tx, ok := tsv.config.driver.(*txDriver)
+	if !ok {
+		panic("models: TeamSecretVersion is not a transactional entity")
+	}
+	tsv.config.driver = _tx.drv
+	return tsv
+}
+
+// String implements the fmt.Stringer.
+func (tsv *TeamSecretVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamSecretVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tsv.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tsv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("secret_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsv.SecretID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", tsv.Version))
+	builder.WriteString(", ")
+	builder.WriteString("key_id=")
+	builder.WriteString(tsv.KeyID)
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("ciphertext=<sensitive>")
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamSecretVersions is a parsable slice of TeamSecretVersion.
+type TeamSecretVersions []*TeamSecretVersion
@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teamsecretversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the teamsecretversion type in the database.
+	Label = "team_secret_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldSecretID holds the string denoting the secret_id field in the database.
+	FieldSecretID = "secret_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldKeyID holds the string denoting the key_id field in the database.
+	FieldKeyID = "key_id"
+	// FieldEncryptedKey holds the string denoting the encrypted_key field in the database.
+	FieldEncryptedKey = "encrypted_key"
+	// FieldCiphertext holds the string denoting the ciphertext field in the database.
+	FieldCiphertext = "ciphertext"
+	// Table holds the table name of the teamsecretversion in the database.
+	Table = "team_secret_versions"
+)
+
+// Columns holds all SQL columns for teamsecretversion fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldSecretID,
+	FieldVersion,
+	FieldKeyID,
+	FieldEncryptedKey,
+	FieldCiphertext,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the TeamSecretVersion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// BySecretID orders the results by the secret_id field.
+func BySecretID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecretID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByKeyID orders the results by the key_id field.
+func ByKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyID, opts...).ToFunc()
+}
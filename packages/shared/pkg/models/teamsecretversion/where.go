@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teamsecretversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SecretID applies equality check predicate on the "secret_id" field. It's identical to SecretIDEQ.
+func SecretID(v uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldSecretID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int32) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldKeyID, v))
+}
+
+// EncryptedKey applies equality check predicate on the "encrypted_key" field. It's identical to EncryptedKeyEQ.
+func EncryptedKey(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldEncryptedKey, v))
+}
+
+// Ciphertext applies equality check predicate on the "ciphertext" field. It's identical to CiphertextEQ.
+func Ciphertext(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldCiphertext, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// SecretIDEQ applies the EQ predicate on the "secret_id" field.
+func SecretIDEQ(v uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldSecretID, v))
+}
+
+// SecretIDNEQ applies the NEQ predicate on the "secret_id" field.
+func SecretIDNEQ(v uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNEQ(FieldSecretID, v))
+}
+
+// SecretIDIn applies the In predicate on the "secret_id" field.
+func SecretIDIn(vs ...uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldIn(FieldSecretID, vs...))
+}
+
+// SecretIDNotIn applies the NotIn predicate on the "secret_id" field.
+func SecretIDNotIn(vs ...uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNotIn(FieldSecretID, vs...))
+}
+
+// SecretIDGT applies the GT predicate on the "secret_id" field.
+func SecretIDGT(v uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGT(FieldSecretID, v))
+}
+
+// SecretIDGTE applies the GTE predicate on the "secret_id" field.
+func SecretIDGTE(v uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGTE(FieldSecretID, v))
+}
+
+// SecretIDLT applies the LT predicate on the "secret_id" field.
+func SecretIDLT(v uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLT(FieldSecretID, v))
+}
+
+// SecretIDLTE applies the LTE predicate on the "secret_id" field.
+func SecretIDLTE(v uuid.UUID) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLTE(FieldSecretID, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int32) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int32) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int32) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int32) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int32) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int32) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int32) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int32) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldContains(FieldKeyID, v))
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldHasPrefix(FieldKeyID, v))
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldHasSuffix(FieldKeyID, v))
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEqualFold(FieldKeyID, v))
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldContainsFold(FieldKeyID, v))
+}
+
+// EncryptedKeyEQ applies the EQ predicate on the "encrypted_key" field.
+func EncryptedKeyEQ(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyNEQ applies the NEQ predicate on the "encrypted_key" field.
+func EncryptedKeyNEQ(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNEQ(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyIn applies the In predicate on the "encrypted_key" field.
+func EncryptedKeyIn(vs ...[]byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldIn(FieldEncryptedKey, vs...))
+}
+
+// EncryptedKeyNotIn applies the NotIn predicate on the "encrypted_key" field.
+func EncryptedKeyNotIn(vs ...[]byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNotIn(FieldEncryptedKey, vs...))
+}
+
+// EncryptedKeyGT applies the GT predicate on the "encrypted_key" field.
+func EncryptedKeyGT(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGT(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyGTE applies the GTE predicate on the "encrypted_key" field.
+func EncryptedKeyGTE(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGTE(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyLT applies the LT predicate on the "encrypted_key" field.
+func EncryptedKeyLT(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLT(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyLTE applies the LTE predicate on the "encrypted_key" field.
+func EncryptedKeyLTE(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLTE(FieldEncryptedKey, v))
+}
+
+// CiphertextEQ applies the EQ predicate on the "ciphertext" field.
+func CiphertextEQ(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldEQ(FieldCiphertext, v))
+}
+
+// CiphertextNEQ applies the NEQ predicate on the "ciphertext" field.
+func CiphertextNEQ(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNEQ(FieldCiphertext, v))
+}
+
+// CiphertextIn applies the In predicate on the "ciphertext" field.
+func CiphertextIn(vs ...[]byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldIn(FieldCiphertext, vs...))
+}
+
+// CiphertextNotIn applies the NotIn predicate on the "ciphertext" field.
+func CiphertextNotIn(vs ...[]byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldNotIn(FieldCiphertext, vs...))
+}
+
+// CiphertextGT applies the GT predicate on the "ciphertext" field.
+func CiphertextGT(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGT(FieldCiphertext, v))
+}
+
+// CiphertextGTE applies the GTE predicate on the "ciphertext" field.
+func CiphertextGTE(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldGTE(FieldCiphertext, v))
+}
+
+// CiphertextLT applies the LT predicate on the "ciphertext" field.
+func CiphertextLT(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLT(FieldCiphertext, v))
+}
+
+// CiphertextLTE applies the LTE predicate on the "ciphertext" field.
+func CiphertextLTE(v []byte) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.FieldLTE(FieldCiphertext, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamSecretVersion) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamSecretVersion) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamSecretVersion) predicate.TeamSecretVersion {
+	return predicate.TeamSecretVersion(sql.NotPredicates(p))
+}
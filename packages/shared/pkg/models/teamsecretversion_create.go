@@ -0,0 +1,583 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+	"github.com/google/uuid"
+)
+
+// TeamSecretVersionCreate is the builder for creating a TeamSecretVersion entity.
+type TeamSecretVersionCreate struct {
+	config
+	mutation *TeamSecretVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsvc *TeamSecretVersionCreate) SetCreatedAt(t time.Time) *TeamSecretVersionCreate {
+	tsvc.mutation.SetCreatedAt(t)
+	return tsvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsvc *TeamSecretVersionCreate) SetNillableCreatedAt(t *time.Time) *TeamSecretVersionCreate {
+	if t != nil {
+		tsvc.SetCreatedAt(*t)
+	}
+	return tsvc
+}
+
+// SetSecretID sets the "secret_id" field.
+func (tsvc *TeamSecretVersionCreate) SetSecretID(u uuid.UUID) *TeamSecretVersionCreate {
+	tsvc.mutation.SetSecretID(u)
+	return tsvc
+}
+
+// SetVersion sets the "version" field.
+func (tsvc *TeamSecretVersionCreate) SetVersion(i int32) *TeamSecretVersionCreate {
+	tsvc.mutation.SetVersion(i)
+	return tsvc
+}
+
+// SetKeyID sets the "key_id" field.
+func (tsvc *TeamSecretVersionCreate) SetKeyID(s string) *TeamSecretVersionCreate {
+	tsvc.mutation.SetKeyID(s)
+	return tsvc
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (tsvc *TeamSecretVersionCreate) SetEncryptedKey(b []byte) *TeamSecretVersionCreate {
+	tsvc.mutation.SetEncryptedKey(b)
+	return tsvc
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (tsvc *TeamSecretVersionCreate) SetCiphertext(b []byte) *TeamSecretVersionCreate {
+	tsvc.mutation.SetCiphertext(b)
+	return tsvc
+}
+
+// SetID sets the "id" field.
+func (tsvc *TeamSecretVersionCreate) SetID(u uuid.UUID) *TeamSecretVersionCreate {
+	tsvc.mutation.SetID(u)
+	return tsvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tsvc *TeamSecretVersionCreate) SetNillableID(u *uuid.UUID) *TeamSecretVersionCreate {
+	if u != nil {
+		tsvc.SetID(*u)
+	}
+	return tsvc
+}
+
+// Mutation returns the TeamSecretVersionMutation object of the builder.
+func (tsvc *TeamSecretVersionCreate) Mutation() *TeamSecretVersionMutation {
+	return tsvc.mutation
+}
+
+// Save creates the TeamSecretVersion in the database.
+func (tsvc *TeamSecretVersionCreate) Save(ctx context.Context) (*TeamSecretVersion, error) {
+	tsvc.defaults()
+	return withHooks(ctx, tsvc.sqlSave, tsvc.mutation, tsvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsvc *TeamSecretVersionCreate) SaveX(ctx context.Context) *TeamSecretVersion {
+	v, err := tsvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsvc *TeamSecretVersionCreate) Exec(ctx context.Context) error {
+	_, err := tsvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsvc *TeamSecretVersionCreate) ExecX(ctx context.Context) {
+	if err := tsvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsvc *TeamSecretVersionCreate) defaults() {
+	if _, ok := tsvc.mutation.CreatedAt(); !ok {
+		v := teamsecretversion.DefaultCreatedAt()
+		tsvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tsvc.mutation.ID(); !ok {
+		v := teamsecretversion.DefaultID()
+		tsvc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsvc *TeamSecretVersionCreate) check() error {
+	if _, ok := tsvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "TeamSecretVersion.created_at"`)}
+	}
+	if _, ok := tsvc.mutation.SecretID(); !ok {
+		return &ValidationError{Name: "secret_id", err: errors.New(`models: missing required field "TeamSecretVersion.secret_id"`)}
+	}
+	if _, ok := tsvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`models: missing required field "TeamSecretVersion.version"`)}
+	}
+	if _, ok := tsvc.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`models: missing required field "TeamSecretVersion.key_id"`)}
+	}
+	if _, ok := tsvc.mutation.EncryptedKey(); !ok {
+		return &ValidationError{Name: "encrypted_key", err: errors.New(`models: missing required field "TeamSecretVersion.encrypted_key"`)}
+	}
+	if _, ok := tsvc.mutation.Ciphertext(); !ok {
+		return &ValidationError{Name: "ciphertext", err: errors.New(`models: missing required field "TeamSecretVersion.ciphertext"`)}
+	}
+	return nil
+}
+
+func (tsvc *TeamSecretVersionCreate) sqlSave(ctx context.Context) (*TeamSecretVersion, error) {
+	if err := tsvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tsvc.mutation.id = &_node.ID
+	tsvc.mutation.done = true
+	return _node, nil
+}
+
+func (tsvc *TeamSecretVersionCreate) createSpec() (*TeamSecretVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamSecretVersion{config: tsvc.config}
+		_spec = sqlgraph.NewCreateSpec(teamsecretversion.Table, sqlgraph.NewFieldSpec(teamsecretversion.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = tsvc.schemaConfig.TeamSecretVersion
+	_spec.OnConflict = tsvc.conflict
+	if id, ok := tsvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tsvc.mutation.CreatedAt(); ok {
+		_spec.SetField(teamsecretversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tsvc.mutation.SecretID(); ok {
+		_spec.SetField(teamsecretversion.FieldSecretID, field.TypeUUID, value)
+		_node.SecretID = value
+	}
+	if value, ok := tsvc.mutation.Version(); ok {
+		_spec.SetField(teamsecretversion.FieldVersion, field.TypeInt32, value)
+		_node.Version = value
+	}
+	if value, ok := tsvc.mutation.KeyID(); ok {
+		_spec.SetField(teamsecretversion.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := tsvc.mutation.EncryptedKey(); ok {
+		_spec.SetField(teamsecretversion.FieldEncryptedKey, field.TypeBytes, value)
+		_node.EncryptedKey = value
+	}
+	if value, ok := tsvc.mutation.Ciphertext(); ok {
+		_spec.SetField(teamsecretversion.FieldCiphertext, field.TypeBytes, value)
+		_node.Ciphertext = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamSecretVersion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamSecretVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tsvc *TeamSecretVersionCreate) OnConflict(opts ...sql.ConflictOption) *TeamSecretVersionUpsertOne {
+	tsvc.conflict = opts
+	return &TeamSecretVersionUpsertOne{
+		create: tsvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamSecretVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tsvc *TeamSecretVersionCreate) OnConflictColumns(columns ...string) *TeamSecretVersionUpsertOne {
+	tsvc.conflict = append(tsvc.conflict, sql.ConflictColumns(columns...))
+	return &TeamSecretVersionUpsertOne{
+		create: tsvc,
+	}
+}
+
+type (
+	// TeamSecretVersionUpsertOne is the builder for "upsert"-ing
+	//  one TeamSecretVersion node.
+	TeamSecretVersionUpsertOne struct {
+		create *TeamSecretVersionCreate
+	}
+
+	// TeamSecretVersionUpsert is the "OnConflict" setter.
+	TeamSecretVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TeamSecretVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamsecretversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamSecretVersionUpsertOne) UpdateNewValues() *TeamSecretVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(teamsecretversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(teamsecretversion.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.SecretID(); exists {
+			s.SetIgnore(teamsecretversion.FieldSecretID)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(teamsecretversion.FieldVersion)
+		}
+		if _, exists := u.create.mutation.KeyID(); exists {
+			s.SetIgnore(teamsecretversion.FieldKeyID)
+		}
+		if _, exists := u.create.mutation.EncryptedKey(); exists {
+			s.SetIgnore(teamsecretversion.FieldEncryptedKey)
+		}
+		if _, exists := u.create.mutation.Ciphertext(); exists {
+			s.SetIgnore(teamsecretversion.FieldCiphertext)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamSecretVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamSecretVersionUpsertOne) Ignore() *TeamSecretVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamSecretVersionUpsertOne) DoNothing() *TeamSecretVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamSecretVersionCreate.OnConflict
+// documentation for more info.
+func (u *TeamSecretVersionUpsertOne) Update(set func(*TeamSecretVersionUpsert)) *TeamSecretVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamSecretVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TeamSecretVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamSecretVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamSecretVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamSecretVersionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: TeamSecretVersionUpsertOne.ID is not supported by MySQL driver. Use TeamSecretVersionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamSecretVersionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamSecretVersionCreateBulk is the builder for creating many TeamSecretVersion entities in bulk.
+type TeamSecretVersionCreateBulk struct {
+	config
+	err      error
+	builders []*TeamSecretVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamSecretVersion entities in the database.
+func (tsvcb *TeamSecretVersionCreateBulk) Save(ctx context.Context) ([]*TeamSecretVersion, error) {
+	if tsvcb.err != nil {
+		return nil, tsvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tsvcb.builders))
+	nodes := make([]*TeamSecretVersion, len(tsvcb.builders))
+	mutators := make([]Mutator, len(tsvcb.builders))
+	for i := range tsvcb.builders {
+		func(i int, root context.Context) {
+			builder := tsvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamSecretVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tsvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tsvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tsvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tsvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsvcb *TeamSecretVersionCreateBulk) SaveX(ctx context.Context) []*TeamSecretVersion {
+	v, err := tsvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsvcb *TeamSecretVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := tsvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsvcb *TeamSecretVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := tsvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamSecretVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamSecretVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tsvcb *TeamSecretVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamSecretVersionUpsertBulk {
+	tsvcb.conflict = opts
+	return &TeamSecretVersionUpsertBulk{
+		create: tsvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamSecretVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tsvcb *TeamSecretVersionCreateBulk) OnConflictColumns(columns ...string) *TeamSecretVersionUpsertBulk {
+	tsvcb.conflict = append(tsvcb.conflict, sql.ConflictColumns(columns...))
+	return &TeamSecretVersionUpsertBulk{
+		create: tsvcb,
+	}
+}
+
+// TeamSecretVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamSecretVersion nodes.
+type TeamSecretVersionUpsertBulk struct {
+	create *TeamSecretVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamSecretVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamsecretversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamSecretVersionUpsertBulk) UpdateNewValues() *TeamSecretVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(teamsecretversion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(teamsecretversion.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.SecretID(); exists {
+				s.SetIgnore(teamsecretversion.FieldSecretID)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(teamsecretversion.FieldVersion)
+			}
+			if _, exists := b.mutation.KeyID(); exists {
+				s.SetIgnore(teamsecretversion.FieldKeyID)
+			}
+			if _, exists := b.mutation.EncryptedKey(); exists {
+				s.SetIgnore(teamsecretversion.FieldEncryptedKey)
+			}
+			if _, exists := b.mutation.Ciphertext(); exists {
+				s.SetIgnore(teamsecretversion.FieldCiphertext)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamSecretVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamSecretVersionUpsertBulk) Ignore() *TeamSecretVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamSecretVersionUpsertBulk) DoNothing() *TeamSecretVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamSecretVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamSecretVersionUpsertBulk) Update(set func(*TeamSecretVersionUpsert)) *TeamSecretVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamSecretVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TeamSecretVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the TeamSecretVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamSecretVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamSecretVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+)
+
+// TeamSecretVersionDelete is the builder for deleting a TeamSecretVersion entity.
+type TeamSecretVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamSecretVersionMutation
+}
+
+// Where appends a list predicates to the TeamSecretVersionDelete builder.
+func (tsvd *TeamSecretVersionDelete) Where(ps ...predicate.TeamSecretVersion) *TeamSecretVersionDelete {
+	tsvd.mutation.Where(ps...)
+	return tsvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsvd *TeamSecretVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsvd.sqlExec, tsvd.mutation, tsvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsvd *TeamSecretVersionDelete) ExecX(ctx context.Context) int {
+	n, err := tsvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsvd *TeamSecretVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teamsecretversion.Table, sqlgraph.NewFieldSpec(teamsecretversion.FieldID, field.TypeUUID))
+	_spec.Node.Schema = tsvd.schemaConfig.TeamSecretVersion
+	ctx = internal.NewSchemaConfigContext(ctx, tsvd.schemaConfig)
+	if ps := tsvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsvd.mutation.done = true
+	return affected, err
+}
+
+// TeamSecretVersionDeleteOne is the builder for deleting a single TeamSecretVersion entity.
+type TeamSecretVersionDeleteOne struct {
+	tsvd *TeamSecretVersionDelete
+}
+
+// Where appends a list predicates to the TeamSecretVersionDelete builder.
+func (tsvdo *TeamSecretVersionDeleteOne) Where(ps ...predicate.TeamSecretVersion) *TeamSecretVersionDeleteOne {
+	tsvdo.tsvd.mutation.Where(ps...)
+	return tsvdo
+}
+
+// Exec executes the deletion query.
+func (tsvdo *TeamSecretVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsvdo.tsvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teamsecretversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsvdo *TeamSecretVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := tsvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+	"github.com/google/uuid"
+)
+
+// TeamSecretVersionQuery is the builder for querying TeamSecretVersion entities.
+type TeamSecretVersionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []teamsecretversion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TeamSecretVersion
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TeamSecretVersionQuery builder.
+func (tsvq *TeamSecretVersionQuery) Where(ps ...predicate.TeamSecretVersion) *TeamSecretVersionQuery {
+	tsvq.predicates = append(tsvq.predicates, ps...)
+	return tsvq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsvq *TeamSecretVersionQuery) Limit(limit int) *TeamSecretVersionQuery {
+	tsvq.ctx.Limit = &limit
+	return tsvq
+}
+
+// Offset to start from.
+func (tsvq *TeamSecretVersionQuery) Offset(offset int) *TeamSecretVersionQuery {
+	tsvq.ctx.Offset = &offset
+	return tsvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsvq *TeamSecretVersionQuery) Unique(unique bool) *TeamSecretVersionQuery {
+	tsvq.ctx.Unique = &unique
+	return tsvq
+}
+
+// Order specifies how the records should be ordered.
+func (tsvq *TeamSecretVersionQuery) Order(o ...teamsecretversion.OrderOption) *TeamSecretVersionQuery {
+	tsvq.order = append(tsvq.order, o...)
+	return tsvq
+}
+
+// First returns the first TeamSecretVersion entity from the query.
+// Returns a *NotFoundError when no TeamSecretVersion was found.
+func (tsvq *TeamSecretVersionQuery) First(ctx context.Context) (*TeamSecretVersion, error) {
+	nodes, err := tsvq.Limit(1).All(setContextOp(ctx, tsvq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{teamsecretversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsvq *TeamSecretVersionQuery) FirstX(ctx context.Context) *TeamSecretVersion {
+	node, err := tsvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TeamSecretVersion ID from the query.
+// Returns a *NotFoundError when no TeamSecretVersion ID was found.
+func (tsvq *TeamSecretVersionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsvq.Limit(1).IDs(setContextOp(ctx, tsvq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{teamsecretversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsvq *TeamSecretVersionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tsvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TeamSecretVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TeamSecretVersion entity is found.
+// Returns a *NotFoundError when no TeamSecretVersion entities are found.
+func (tsvq *TeamSecretVersionQuery) Only(ctx context.Context) (*TeamSecretVersion, error) {
+	nodes, err := tsvq.Limit(2).All(setContextOp(ctx, tsvq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{teamsecretversion.Label}
+	default:
+		return nil, &NotSingularError{teamsecretversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsvq *TeamSecretVersionQuery) OnlyX(ctx context.Context) *TeamSecretVersion {
+	node, err := tsvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TeamSecretVersion ID in the query.
+// Returns a *NotSingularError when more than one TeamSecretVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsvq *TeamSecretVersionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsvq.Limit(2).IDs(setContextOp(ctx, tsvq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{teamsecretversion.Label}
+	default:
+		err = &NotSingularError{teamsecretversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsvq *TeamSecretVersionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tsvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TeamSecretVersions.
+func (tsvq *TeamSecretVersionQuery) All(ctx context.Context) ([]*TeamSecretVersion, error) {
+	ctx = setContextOp(ctx, tsvq.ctx, "All")
+	if err := tsvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TeamSecretVersion, *TeamSecretVersionQuery]()
+	return withInterceptors[[]*TeamSecretVersion](ctx, tsvq, qr, tsvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsvq *TeamSecretVersionQuery) AllX(ctx context.Context) []*TeamSecretVersion {
+	nodes, err := tsvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TeamSecretVersion IDs.
+func (tsvq *TeamSecretVersionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tsvq.ctx.Unique == nil && tsvq.path != nil {
+		tsvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsvq.ctx, "IDs")
+	if err = tsvq.Select(teamsecretversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsvq *TeamSecretVersionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tsvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsvq *TeamSecretVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsvq.ctx, "Count")
+	if err := tsvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsvq, querierCount[*TeamSecretVersionQuery](), tsvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsvq *TeamSecretVersionQuery) CountX(ctx context.Context) int {
+	count, err := tsvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsvq *TeamSecretVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsvq.ctx, "Exist")
+	switch _, err := tsvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsvq *TeamSecretVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TeamSecretVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsvq *TeamSecretVersionQuery) Clone() *TeamSecretVersionQuery {
+	if tsvq == nil {
+		return nil
+	}
+	return &TeamSecretVersionQuery{
+		config:     tsvq.config,
+		ctx:        tsvq.ctx.Clone(),
+		order:      append([]teamsecretversion.OrderOption{}, tsvq.order...),
+		inters:     append([]Interceptor{}, tsvq.inters...),
+		predicates: append([]predicate.TeamSecretVersion{}, tsvq.predicates...),
+		// clone intermediate query.
+		sql:  tsvq.sql.Clone(),
+		path: tsvq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TeamSecretVersion.Query().
+//		GroupBy(teamsecretversion.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (tsvq *TeamSecretVersionQuery) GroupBy(field string, fields ...string) *TeamSecretVersionGroupBy {
+	tsvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TeamSecretVersionGroupBy{build: tsvq}
+	grbuild.flds = &tsvq.ctx.Fields
+	grbuild.label = teamsecretversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TeamSecretVersion.Query().
+//		Select(teamsecretversion.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tsvq *TeamSecretVersionQuery) Select(fields ...string) *TeamSecretVersionSelect {
+	tsvq.ctx.Fields = append(tsvq.ctx.Fields, fields...)
+	sbuild := &TeamSecretVersionSelect{TeamSecretVersionQuery: tsvq}
+	sbuild.label = teamsecretversion.Label
+	sbuild.flds, sbuild.scan = &tsvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TeamSecretVersionSelect configured with the given aggregations.
+func (tsvq *TeamSecretVersionQuery) Aggregate(fns ...AggregateFunc) *TeamSecretVersionSelect {
+	return tsvq.Select().Aggregate(fns...)
+}
+
+func (tsvq *TeamSecretVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsvq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsvq.ctx.Fields {
+		if !teamsecretversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if tsvq.path != nil {
+		prev, err := tsvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsvq.sql = prev
+	}
+	return nil
+}
+
+func (tsvq *TeamSecretVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TeamSecretVersion, error) {
+	var (
+		nodes = []*TeamSecretVersion{}
+		_spec = tsvq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TeamSecretVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TeamSecretVersion{config: tsvq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = tsvq.schemaConfig.TeamSecretVersion
+	ctx = internal.NewSchemaConfigContext(ctx, tsvq.schemaConfig)
+	if len(tsvq.modifiers) > 0 {
+		_spec.Modifiers = tsvq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tsvq *TeamSecretVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsvq.querySpec()
+	_spec.Node.Schema = tsvq.schemaConfig.TeamSecretVersion
+	ctx = internal.NewSchemaConfigContext(ctx, tsvq.schemaConfig)
+	if len(tsvq.modifiers) > 0 {
+		_spec.Modifiers = tsvq.modifiers
+	}
+	_spec.Node.Columns = tsvq.ctx.Fields
+	if len(tsvq.ctx.Fields) > 0 {
+		_spec.Unique = tsvq.ctx.Unique != nil && *tsvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsvq.driver, _spec)
+}
+
+func (tsvq *TeamSecretVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(teamsecretversion.Table, teamsecretversion.Columns, sqlgraph.NewFieldSpec(teamsecretversion.FieldID, field.TypeUUID))
+	_spec.From = tsvq.sql
+	if unique := tsvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamsecretversion.FieldID)
+		for i := range fields {
+			if fields[i] != teamsecretversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tsvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsvq *TeamSecretVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsvq.driver.Dialect())
+	t1 := builder.Table(teamsecretversion.Table)
+	columns := tsvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = teamsecretversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsvq.sql != nil {
+		selector = tsvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsvq.ctx.Unique != nil && *tsvq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(tsvq.schemaConfig.TeamSecretVersion)
+	ctx = internal.NewSchemaConfigContext(ctx, tsvq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range tsvq.modifiers {
+		m(selector)
+	}
+	for _, p := range tsvq.predicates {
+		p(selector)
+	}
+	for _, p := range tsvq.order {
+		p(selector)
+	}
+	if offset := tsvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tsvq *TeamSecretVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *TeamSecretVersionSelect {
+	tsvq.modifiers = append(tsvq.modifiers, modifiers...)
+	return tsvq.Select()
+}
+
+// TeamSecretVersionGroupBy is the group-by builder for TeamSecretVersion entities.
+type TeamSecretVersionGroupBy struct {
+	selector
+	build *TeamSecretVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tsvgb *TeamSecretVersionGroupBy) Aggregate(fns ...AggregateFunc) *TeamSecretVersionGroupBy {
+	tsvgb.fns = append(tsvgb.fns, fns...)
+	return tsvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsvgb *TeamSecretVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsvgb.build.ctx, "GroupBy")
+	if err := tsvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamSecretVersionQuery, *TeamSecretVersionGroupBy](ctx, tsvgb.build, tsvgb, tsvgb.build.inters, v)
+}
+
+func (tsvgb *TeamSecretVersionGroupBy) sqlScan(ctx context.Context, root *TeamSecretVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tsvgb.fns))
+	for _, fn := range tsvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tsvgb.flds)+len(tsvgb.fns))
+		for _, f := range *tsvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tsvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TeamSecretVersionSelect is the builder for selecting fields of TeamSecretVersion entities.
+type TeamSecretVersionSelect struct {
+	*TeamSecretVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tsvs *TeamSecretVersionSelect) Aggregate(fns ...AggregateFunc) *TeamSecretVersionSelect {
+	tsvs.fns = append(tsvs.fns, fns...)
+	return tsvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsvs *TeamSecretVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsvs.ctx, "Select")
+	if err := tsvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamSecretVersionQuery, *TeamSecretVersionSelect](ctx, tsvs.TeamSecretVersionQuery, tsvs, tsvs.inters, v)
+}
+
+func (tsvs *TeamSecretVersionSelect) sqlScan(ctx context.Context, root *TeamSecretVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tsvs.fns))
+	for _, fn := range tsvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tsvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tsvs *TeamSecretVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *TeamSecretVersionSelect {
+	tsvs.modifiers = append(tsvs.modifiers, modifiers...)
+	return tsvs
+}
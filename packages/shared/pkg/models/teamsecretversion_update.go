@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamsecretversion"
+)
+
+// TeamSecretVersionUpdate is the builder for updating TeamSecretVersion entities.
+type TeamSecretVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TeamSecretVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TeamSecretVersionUpdate builder.
+func (tsvu *TeamSecretVersionUpdate) Where(ps ...predicate.TeamSecretVersion) *TeamSecretVersionUpdate {
+	tsvu.mutation.Where(ps...)
+	return tsvu
+}
+
+// Mutation returns the TeamSecretVersionMutation object of the builder.
+func (tsvu *TeamSecretVersionUpdate) Mutation() *TeamSecretVersionMutation {
+	return tsvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsvu *TeamSecretVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsvu.sqlSave, tsvu.mutation, tsvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsvu *TeamSecretVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsvu *TeamSecretVersionUpdate) Exec(ctx context.Context) error {
+	_, err := tsvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsvu *TeamSecretVersionUpdate) ExecX(ctx context.Context) {
+	if err := tsvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tsvu *TeamSecretVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamSecretVersionUpdate {
+	tsvu.modifiers = append(tsvu.modifiers, modifiers...)
+	return tsvu
+}
+
+func (tsvu *TeamSecretVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamsecretversion.Table, teamsecretversion.Columns, sqlgraph.NewFieldSpec(teamsecretversion.FieldID, field.TypeUUID))
+	if ps := tsvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = tsvu.schemaConfig.TeamSecretVersion
+	ctx = internal.NewSchemaConfigContext(ctx, tsvu.schemaConfig)
+	_spec.AddModifiers(tsvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tsvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamsecretversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsvu.mutation.done = true
+	return n, nil
+}
+
+// TeamSecretVersionUpdateOne is the builder for updating a single TeamSecretVersion entity.
+type TeamSecretVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TeamSecretVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the TeamSecretVersionMutation object of the builder.
+func (tsvuo *TeamSecretVersionUpdateOne) Mutation() *TeamSecretVersionMutation {
+	return tsvuo.mutation
+}
+
+// Where appends a list predicates to the TeamSecretVersionUpdate builder.
+func (tsvuo *TeamSecretVersionUpdateOne) Where(ps ...predicate.TeamSecretVersion) *TeamSecretVersionUpdateOne {
+	tsvuo.mutation.Where(ps...)
+	return tsvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsvuo *TeamSecretVersionUpdateOne) Select(field string, fields ...string) *TeamSecretVersionUpdateOne {
+	tsvuo.fields = append([]string{field}, fields...)
+	return tsvuo
+}
+
+// Save executes the query and returns the updated TeamSecretVersion entity.
+func (tsvuo *TeamSecretVersionUpdateOne) Save(ctx context.Context) (*TeamSecretVersion, error) {
+	return withHooks(ctx, tsvuo.sqlSave, tsvuo.mutation, tsvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsvuo *TeamSecretVersionUpdateOne) SaveX(ctx context.Context) *TeamSecretVersion {
+	node, err := tsvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsvuo *TeamSecretVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsvuo *TeamSecretVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := tsvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tsvuo *TeamSecretVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamSecretVersionUpdateOne {
+	tsvuo.modifiers = append(tsvuo.modifiers, modifiers...)
+	return tsvuo
+}
+
+func (tsvuo *TeamSecretVersionUpdateOne) sqlSave(ctx context.Context) (_node *TeamSecretVersion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamsecretversion.Table, teamsecretversion.Columns, sqlgraph.NewFieldSpec(teamsecretversion.FieldID, field.TypeUUID))
+	id, ok := tsvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "TeamSecretVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamsecretversion.FieldID)
+		for _, f := range fields {
+			if !teamsecretversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != teamsecretversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = tsvuo.schemaConfig.TeamSecretVersion
+	ctx = internal.NewSchemaConfigContext(ctx, tsvuo.schemaConfig)
+	_spec.AddModifiers(tsvuo.modifiers...)
+	_node = &TeamSecretVersion{config: tsvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamsecretversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsvuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+	"github.com/google/uuid"
+)
+
+// TeamTemplateStorage is the model entity for the TeamTemplateStorage schema.
+type TeamTemplateStorage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// EnvID holds the value of the "env_id" field.
+	EnvID string `json:"env_id,omitempty"`
+	// TemplateBytes holds the value of the "template_bytes" field.
+	TemplateBytes int64 `json:"template_bytes,omitempty"`
+	// SnapshotBytes holds the value of the "snapshot_bytes" field.
+	SnapshotBytes int64 `json:"snapshot_bytes,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamTemplateStorage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teamtemplatestorage.FieldID, teamtemplatestorage.FieldTemplateBytes, teamtemplatestorage.FieldSnapshotBytes:
+			values[i] = new(sql.NullInt64)
+		case teamtemplatestorage.FieldEnvID:
+			values[i] = new(sql.NullString)
+		case teamtemplatestorage.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case teamtemplatestorage.FieldTeamID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamTemplateStorage fields.
+func (tts *TeamTemplateStorage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teamtemplatestorage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tts.ID = int(value.Int64)
+		case teamtemplatestorage.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				tts.TeamID = *value
+			}
+		case teamtemplatestorage.FieldEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				tts.EnvID = value.String
+			}
+		case teamtemplatestorage.FieldTemplateBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field template_bytes", values[i])
+			} else if value.Valid {
+				tts.TemplateBytes = value.Int64
+			}
+		case teamtemplatestorage.FieldSnapshotBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot_bytes", values[i])
+			} else if value.Valid {
+				tts.SnapshotBytes = value.Int64
+			}
+		case teamtemplatestorage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tts.UpdatedAt = value.Time
+			}
+		default:
+			tts.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamTemplateStorage.
+// This includes values selected through modifiers, order, etc.
+func (tts *TeamTemplateStorage) Value(name string) (ent.Value, error) {
+	return tts.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TeamTemplateStorage.
+// Note that you need to call TeamTemplateStorage.Unwrap() before calling this method if this TeamTemplateStorage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tts *TeamTemplateStorage) Update() *TeamTemplateStorageUpdateOne {
+	return NewTeamTemplateStorageClient(tts.config).UpdateOne(tts)
+}
+
+// Unwrap unwraps the TeamTemplateStorage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tts *TeamTemplateStorage) Unwrap() *TeamTemplateStorage {
+	_tx, ok := tts.config.driver.(*txDriver)
+	if !ok {
+		panic("models: TeamTemplateStorage is not a transactional entity")
+	}
+	tts.config.driver = _tx.drv
+	return tts
+}
+
+// String implements the fmt.Stringer.
+func (tts *TeamTemplateStorage) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamTemplateStorage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tts.ID))
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", tts.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(tts.EnvID)
+	builder.WriteString(", ")
+	builder.WriteString("template_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", tts.TemplateBytes))
+	builder.WriteString(", ")
+	builder.WriteString("snapshot_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", tts.SnapshotBytes))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tts.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamTemplateStorages is a parsable slice of TeamTemplateStorage.
+type TeamTemplateStorages []*TeamTemplateStorage
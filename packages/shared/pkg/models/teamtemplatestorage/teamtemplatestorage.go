@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teamtemplatestorage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the teamtemplatestorage type in the database.
+	Label = "team_template_storage"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldTemplateBytes holds the string denoting the template_bytes field in the database.
+	FieldTemplateBytes = "template_bytes"
+	// FieldSnapshotBytes holds the string denoting the snapshot_bytes field in the database.
+	FieldSnapshotBytes = "snapshot_bytes"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the teamtemplatestorage in the database.
+	Table = "team_template_storage"
+)
+
+// Columns holds all SQL columns for teamtemplatestorage fields.
+var Columns = []string{
+	FieldID,
+	FieldTeamID,
+	FieldEnvID,
+	FieldTemplateBytes,
+	FieldSnapshotBytes,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTemplateBytes holds the default value on creation for the "template_bytes" field.
+	DefaultTemplateBytes int64
+	// DefaultSnapshotBytes holds the default value on creation for the "snapshot_bytes" field.
+	DefaultSnapshotBytes int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TeamTemplateStorage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// ByTemplateBytes orders the results by the template_bytes field.
+func ByTemplateBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplateBytes, opts...).ToFunc()
+}
+
+// BySnapshotBytes orders the results by the snapshot_bytes field.
+func BySnapshotBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnapshotBytes, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teamtemplatestorage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLTE(FieldID, id))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldTeamID, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldEnvID, v))
+}
+
+// TemplateBytes applies equality check predicate on the "template_bytes" field. It's identical to TemplateBytesEQ.
+func TemplateBytes(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldTemplateBytes, v))
+}
+
+// SnapshotBytes applies equality check predicate on the "snapshot_bytes" field. It's identical to SnapshotBytesEQ.
+func SnapshotBytes(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldSnapshotBytes, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v uuid.UUID) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v uuid.UUID) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v uuid.UUID) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v uuid.UUID) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLTE(FieldTeamID, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// EnvIDGT applies the GT predicate on the "env_id" field.
+func EnvIDGT(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGT(FieldEnvID, v))
+}
+
+// EnvIDGTE applies the GTE predicate on the "env_id" field.
+func EnvIDGTE(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGTE(FieldEnvID, v))
+}
+
+// EnvIDLT applies the LT predicate on the "env_id" field.
+func EnvIDLT(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLT(FieldEnvID, v))
+}
+
+// EnvIDLTE applies the LTE predicate on the "env_id" field.
+func EnvIDLTE(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLTE(FieldEnvID, v))
+}
+
+// EnvIDContains applies the Contains predicate on the "env_id" field.
+func EnvIDContains(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldContains(FieldEnvID, v))
+}
+
+// EnvIDHasPrefix applies the HasPrefix predicate on the "env_id" field.
+func EnvIDHasPrefix(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldHasPrefix(FieldEnvID, v))
+}
+
+// EnvIDHasSuffix applies the HasSuffix predicate on the "env_id" field.
+func EnvIDHasSuffix(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldHasSuffix(FieldEnvID, v))
+}
+
+// EnvIDEqualFold applies the EqualFold predicate on the "env_id" field.
+func EnvIDEqualFold(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEqualFold(FieldEnvID, v))
+}
+
+// EnvIDContainsFold applies the ContainsFold predicate on the "env_id" field.
+func EnvIDContainsFold(v string) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldContainsFold(FieldEnvID, v))
+}
+
+// TemplateBytesEQ applies the EQ predicate on the "template_bytes" field.
+func TemplateBytesEQ(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldTemplateBytes, v))
+}
+
+// TemplateBytesNEQ applies the NEQ predicate on the "template_bytes" field.
+func TemplateBytesNEQ(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNEQ(FieldTemplateBytes, v))
+}
+
+// TemplateBytesIn applies the In predicate on the "template_bytes" field.
+func TemplateBytesIn(vs ...int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldIn(FieldTemplateBytes, vs...))
+}
+
+// TemplateBytesNotIn applies the NotIn predicate on the "template_bytes" field.
+func TemplateBytesNotIn(vs ...int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNotIn(FieldTemplateBytes, vs...))
+}
+
+// TemplateBytesGT applies the GT predicate on the "template_bytes" field.
+func TemplateBytesGT(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGT(FieldTemplateBytes, v))
+}
+
+// TemplateBytesGTE applies the GTE predicate on the "template_bytes" field.
+func TemplateBytesGTE(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGTE(FieldTemplateBytes, v))
+}
+
+// TemplateBytesLT applies the LT predicate on the "template_bytes" field.
+func TemplateBytesLT(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLT(FieldTemplateBytes, v))
+}
+
+// TemplateBytesLTE applies the LTE predicate on the "template_bytes" field.
+func TemplateBytesLTE(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLTE(FieldTemplateBytes, v))
+}
+
+// SnapshotBytesEQ applies the EQ predicate on the "snapshot_bytes" field.
+func SnapshotBytesEQ(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldSnapshotBytes, v))
+}
+
+// SnapshotBytesNEQ applies the NEQ predicate on the "snapshot_bytes" field.
+func SnapshotBytesNEQ(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNEQ(FieldSnapshotBytes, v))
+}
+
+// SnapshotBytesIn applies the In predicate on the "snapshot_bytes" field.
+func SnapshotBytesIn(vs ...int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldIn(FieldSnapshotBytes, vs...))
+}
+
+// SnapshotBytesNotIn applies the NotIn predicate on the "snapshot_bytes" field.
+func SnapshotBytesNotIn(vs ...int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNotIn(FieldSnapshotBytes, vs...))
+}
+
+// SnapshotBytesGT applies the GT predicate on the "snapshot_bytes" field.
+func SnapshotBytesGT(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGT(FieldSnapshotBytes, v))
+}
+
+// SnapshotBytesGTE applies the GTE predicate on the "snapshot_bytes" field.
+func SnapshotBytesGTE(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGTE(FieldSnapshotBytes, v))
+}
+
+// SnapshotBytesLT applies the LT predicate on the "snapshot_bytes" field.
+func SnapshotBytesLT(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLT(FieldSnapshotBytes, v))
+}
+
+// SnapshotBytesLTE applies the LTE predicate on the "snapshot_bytes" field.
+func SnapshotBytesLTE(v int64) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLTE(FieldSnapshotBytes, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamTemplateStorage) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamTemplateStorage) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamTemplateStorage) predicate.TeamTemplateStorage {
+	return predicate.TeamTemplateStorage(sql.NotPredicates(p))
+}
@@ -0,0 +1,723 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+	"github.com/google/uuid"
+)
+
+// TeamTemplateStorageCreate is the builder for creating a TeamTemplateStorage entity.
+type TeamTemplateStorageCreate struct {
+	config
+	mutation *TeamTemplateStorageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTeamID sets the "team_id" field.
+func (ttsc *TeamTemplateStorageCreate) SetTeamID(u uuid.UUID) *TeamTemplateStorageCreate {
+	ttsc.mutation.SetTeamID(u)
+	return ttsc
+}
+
+// SetEnvID sets the "env_id" field.
+func (ttsc *TeamTemplateStorageCreate) SetEnvID(s string) *TeamTemplateStorageCreate {
+	ttsc.mutation.SetEnvID(s)
+	return ttsc
+}
+
+// SetTemplateBytes sets the "template_bytes" field.
+func (ttsc *TeamTemplateStorageCreate) SetTemplateBytes(i int64) *TeamTemplateStorageCreate {
+	ttsc.mutation.SetTemplateBytes(i)
+	return ttsc
+}
+
+// SetNillableTemplateBytes sets the "template_bytes" field if the given value is not nil.
+func (ttsc *TeamTemplateStorageCreate) SetNillableTemplateBytes(i *int64) *TeamTemplateStorageCreate {
+	if i != nil {
+		ttsc.SetTemplateBytes(*i)
+	}
+	return ttsc
+}
+
+// SetSnapshotBytes sets the "snapshot_bytes" field.
+func (ttsc *TeamTemplateStorageCreate) SetSnapshotBytes(i int64) *TeamTemplateStorageCreate {
+	ttsc.mutation.SetSnapshotBytes(i)
+	return ttsc
+}
+
+// SetNillableSnapshotBytes sets the "snapshot_bytes" field if the given value is not nil.
+func (ttsc *TeamTemplateStorageCreate) SetNillableSnapshotBytes(i *int64) *TeamTemplateStorageCreate {
+	if i != nil {
+		ttsc.SetSnapshotBytes(*i)
+	}
+	return ttsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttsc *TeamTemplateStorageCreate) SetUpdatedAt(t time.Time) *TeamTemplateStorageCreate {
+	ttsc.mutation.SetUpdatedAt(t)
+	return ttsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ttsc *TeamTemplateStorageCreate) SetNillableUpdatedAt(t *time.Time) *TeamTemplateStorageCreate {
+	if t != nil {
+		ttsc.SetUpdatedAt(*t)
+	}
+	return ttsc
+}
+
+// Mutation returns the TeamTemplateStorageMutation object of the builder.
+func (ttsc *TeamTemplateStorageCreate) Mutation() *TeamTemplateStorageMutation {
+	return ttsc.mutation
+}
+
+// Save creates the TeamTemplateStorage in the database.
+func (ttsc *TeamTemplateStorageCreate) Save(ctx context.Context) (*TeamTemplateStorage, error) {
+	ttsc.defaults()
+	return withHooks(ctx, ttsc.sqlSave, ttsc.mutation, ttsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ttsc *TeamTemplateStorageCreate) SaveX(ctx context.Context) *TeamTemplateStorage {
+	v, err := ttsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttsc *TeamTemplateStorageCreate) Exec(ctx context.Context) error {
+	_, err := ttsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttsc *TeamTemplateStorageCreate) ExecX(ctx context.Context) {
+	if err := ttsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttsc *TeamTemplateStorageCreate) defaults() {
+	if _, ok := ttsc.mutation.TemplateBytes(); !ok {
+		v := teamtemplatestorage.DefaultTemplateBytes
+		ttsc.mutation.SetTemplateBytes(v)
+	}
+	if _, ok := ttsc.mutation.SnapshotBytes(); !ok {
+		v := teamtemplatestorage.DefaultSnapshotBytes
+		ttsc.mutation.SetSnapshotBytes(v)
+	}
+	if _, ok := ttsc.mutation.UpdatedAt(); !ok {
+		v := teamtemplatestorage.DefaultUpdatedAt()
+		ttsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttsc *TeamTemplateStorageCreate) check() error {
+	if _, ok := ttsc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "TeamTemplateStorage.team_id"`)}
+	}
+	if _, ok := ttsc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`models: missing required field "TeamTemplateStorage.env_id"`)}
+	}
+	if _, ok := ttsc.mutation.TemplateBytes(); !ok {
+		return &ValidationError{Name: "template_bytes", err: errors.New(`models: missing required field "TeamTemplateStorage.template_bytes"`)}
+	}
+	if _, ok := ttsc.mutation.SnapshotBytes(); !ok {
+		return &ValidationError{Name: "snapshot_bytes", err: errors.New(`models: missing required field "TeamTemplateStorage.snapshot_bytes"`)}
+	}
+	if _, ok := ttsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`models: missing required field "TeamTemplateStorage.updated_at"`)}
+	}
+	return nil
+}
+
+func (ttsc *TeamTemplateStorageCreate) sqlSave(ctx context.Context) (*TeamTemplateStorage, error) {
+	if err := ttsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ttsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ttsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ttsc.mutation.id = &_node.ID
+	ttsc.mutation.done = true
+	return _node, nil
+}
+
+func (ttsc *TeamTemplateStorageCreate) createSpec() (*TeamTemplateStorage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamTemplateStorage{config: ttsc.config}
+		_spec = sqlgraph.NewCreateSpec(teamtemplatestorage.Table, sqlgraph.NewFieldSpec(teamtemplatestorage.FieldID, field.TypeInt))
+	)
+	_spec.Schema = ttsc.schemaConfig.TeamTemplateStorage
+	_spec.OnConflict = ttsc.conflict
+	if value, ok := ttsc.mutation.TeamID(); ok {
+		_spec.SetField(teamtemplatestorage.FieldTeamID, field.TypeUUID, value)
+		_node.TeamID = value
+	}
+	if value, ok := ttsc.mutation.EnvID(); ok {
+		_spec.SetField(teamtemplatestorage.FieldEnvID, field.TypeString, value)
+		_node.EnvID = value
+	}
+	if value, ok := ttsc.mutation.TemplateBytes(); ok {
+		_spec.SetField(teamtemplatestorage.FieldTemplateBytes, field.TypeInt64, value)
+		_node.TemplateBytes = value
+	}
+	if value, ok := ttsc.mutation.SnapshotBytes(); ok {
+		_spec.SetField(teamtemplatestorage.FieldSnapshotBytes, field.TypeInt64, value)
+		_node.SnapshotBytes = value
+	}
+	if value, ok := ttsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamtemplatestorage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamTemplateStorage.Create().
+//		SetTeamID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamTemplateStorageUpsert) {
+//			SetTeamID(v+v).
+//		}).
+//		Exec(ctx)
+func (ttsc *TeamTemplateStorageCreate) OnConflict(opts ...sql.ConflictOption) *TeamTemplateStorageUpsertOne {
+	ttsc.conflict = opts
+	return &TeamTemplateStorageUpsertOne{
+		create: ttsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamTemplateStorage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ttsc *TeamTemplateStorageCreate) OnConflictColumns(columns ...string) *TeamTemplateStorageUpsertOne {
+	ttsc.conflict = append(ttsc.conflict, sql.ConflictColumns(columns...))
+	return &TeamTemplateStorageUpsertOne{
+		create: ttsc,
+	}
+}
+
+type (
+	// TeamTemplateStorageUpsertOne is the builder for "upsert"-ing
+	//  one TeamTemplateStorage node.
+	TeamTemplateStorageUpsertOne struct {
+		create *TeamTemplateStorageCreate
+	}
+
+	// TeamTemplateStorageUpsert is the "OnConflict" setter.
+	TeamTemplateStorageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTeamID sets the "team_id" field.
+func (u *TeamTemplateStorageUpsert) SetTeamID(v uuid.UUID) *TeamTemplateStorageUpsert {
+	u.Set(teamtemplatestorage.FieldTeamID, v)
+	return u
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsert) UpdateTeamID() *TeamTemplateStorageUpsert {
+	u.SetExcluded(teamtemplatestorage.FieldTeamID)
+	return u
+}
+
+// SetTemplateBytes sets the "template_bytes" field.
+func (u *TeamTemplateStorageUpsert) SetTemplateBytes(v int64) *TeamTemplateStorageUpsert {
+	u.Set(teamtemplatestorage.FieldTemplateBytes, v)
+	return u
+}
+
+// UpdateTemplateBytes sets the "template_bytes" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsert) UpdateTemplateBytes() *TeamTemplateStorageUpsert {
+	u.SetExcluded(teamtemplatestorage.FieldTemplateBytes)
+	return u
+}
+
+// AddTemplateBytes adds v to the "template_bytes" field.
+func (u *TeamTemplateStorageUpsert) AddTemplateBytes(v int64) *TeamTemplateStorageUpsert {
+	u.Add(teamtemplatestorage.FieldTemplateBytes, v)
+	return u
+}
+
+// SetSnapshotBytes sets the "snapshot_bytes" field.
+func (u *TeamTemplateStorageUpsert) SetSnapshotBytes(v int64) *TeamTemplateStorageUpsert {
+	u.Set(teamtemplatestorage.FieldSnapshotBytes, v)
+	return u
+}
+
+// UpdateSnapshotBytes sets the "snapshot_bytes" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsert) UpdateSnapshotBytes() *TeamTemplateStorageUpsert {
+	u.SetExcluded(teamtemplatestorage.FieldSnapshotBytes)
+	return u
+}
+
+// AddSnapshotBytes adds v to the "snapshot_bytes" field.
+func (u *TeamTemplateStorageUpsert) AddSnapshotBytes(v int64) *TeamTemplateStorageUpsert {
+	u.Add(teamtemplatestorage.FieldSnapshotBytes, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamTemplateStorageUpsert) SetUpdatedAt(v time.Time) *TeamTemplateStorageUpsert {
+	u.Set(teamtemplatestorage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsert) UpdateUpdatedAt() *TeamTemplateStorageUpsert {
+	u.SetExcluded(teamtemplatestorage.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TeamTemplateStorage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TeamTemplateStorageUpsertOne) UpdateNewValues() *TeamTemplateStorageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.EnvID(); exists {
+			s.SetIgnore(teamtemplatestorage.FieldEnvID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamTemplateStorage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamTemplateStorageUpsertOne) Ignore() *TeamTemplateStorageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamTemplateStorageUpsertOne) DoNothing() *TeamTemplateStorageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamTemplateStorageCreate.OnConflict
+// documentation for more info.
+func (u *TeamTemplateStorageUpsertOne) Update(set func(*TeamTemplateStorageUpsert)) *TeamTemplateStorageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamTemplateStorageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *TeamTemplateStorageUpsertOne) SetTeamID(v uuid.UUID) *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsertOne) UpdateTeamID() *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetTemplateBytes sets the "template_bytes" field.
+func (u *TeamTemplateStorageUpsertOne) SetTemplateBytes(v int64) *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.SetTemplateBytes(v)
+	})
+}
+
+// AddTemplateBytes adds v to the "template_bytes" field.
+func (u *TeamTemplateStorageUpsertOne) AddTemplateBytes(v int64) *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.AddTemplateBytes(v)
+	})
+}
+
+// UpdateTemplateBytes sets the "template_bytes" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsertOne) UpdateTemplateBytes() *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.UpdateTemplateBytes()
+	})
+}
+
+// SetSnapshotBytes sets the "snapshot_bytes" field.
+func (u *TeamTemplateStorageUpsertOne) SetSnapshotBytes(v int64) *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.SetSnapshotBytes(v)
+	})
+}
+
+// AddSnapshotBytes adds v to the "snapshot_bytes" field.
+func (u *TeamTemplateStorageUpsertOne) AddSnapshotBytes(v int64) *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.AddSnapshotBytes(v)
+	})
+}
+
+// UpdateSnapshotBytes sets the "snapshot_bytes" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsertOne) UpdateSnapshotBytes() *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.UpdateSnapshotBytes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamTemplateStorageUpsertOne) SetUpdatedAt(v time.Time) *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsertOne) UpdateUpdatedAt() *TeamTemplateStorageUpsertOne {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamTemplateStorageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamTemplateStorageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamTemplateStorageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamTemplateStorageUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamTemplateStorageUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamTemplateStorageCreateBulk is the builder for creating many TeamTemplateStorage entities in bulk.
+type TeamTemplateStorageCreateBulk struct {
+	config
+	err      error
+	builders []*TeamTemplateStorageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamTemplateStorage entities in the database.
+func (ttscb *TeamTemplateStorageCreateBulk) Save(ctx context.Context) ([]*TeamTemplateStorage, error) {
+	if ttscb.err != nil {
+		return nil, ttscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ttscb.builders))
+	nodes := make([]*TeamTemplateStorage, len(ttscb.builders))
+	mutators := make([]Mutator, len(ttscb.builders))
+	for i := range ttscb.builders {
+		func(i int, root context.Context) {
+			builder := ttscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamTemplateStorageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ttscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ttscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ttscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ttscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttscb *TeamTemplateStorageCreateBulk) SaveX(ctx context.Context) []*TeamTemplateStorage {
+	v, err := ttscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttscb *TeamTemplateStorageCreateBulk) Exec(ctx context.Context) error {
+	_, err := ttscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttscb *TeamTemplateStorageCreateBulk) ExecX(ctx context.Context) {
+	if err := ttscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamTemplateStorage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamTemplateStorageUpsert) {
+//			SetTeamID(v+v).
+//		}).
+//		Exec(ctx)
+func (ttscb *TeamTemplateStorageCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamTemplateStorageUpsertBulk {
+	ttscb.conflict = opts
+	return &TeamTemplateStorageUpsertBulk{
+		create: ttscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamTemplateStorage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ttscb *TeamTemplateStorageCreateBulk) OnConflictColumns(columns ...string) *TeamTemplateStorageUpsertBulk {
+	ttscb.conflict = append(ttscb.conflict, sql.ConflictColumns(columns...))
+	return &TeamTemplateStorageUpsertBulk{
+		create: ttscb,
+	}
+}
+
+// TeamTemplateStorageUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamTemplateStorage nodes.
+type TeamTemplateStorageUpsertBulk struct {
+	create *TeamTemplateStorageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamTemplateStorage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TeamTemplateStorageUpsertBulk) UpdateNewValues() *TeamTemplateStorageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.EnvID(); exists {
+				s.SetIgnore(teamtemplatestorage.FieldEnvID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamTemplateStorage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamTemplateStorageUpsertBulk) Ignore() *TeamTemplateStorageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamTemplateStorageUpsertBulk) DoNothing() *TeamTemplateStorageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamTemplateStorageCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamTemplateStorageUpsertBulk) Update(set func(*TeamTemplateStorageUpsert)) *TeamTemplateStorageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamTemplateStorageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *TeamTemplateStorageUpsertBulk) SetTeamID(v uuid.UUID) *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsertBulk) UpdateTeamID() *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetTemplateBytes sets the "template_bytes" field.
+func (u *TeamTemplateStorageUpsertBulk) SetTemplateBytes(v int64) *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.SetTemplateBytes(v)
+	})
+}
+
+// AddTemplateBytes adds v to the "template_bytes" field.
+func (u *TeamTemplateStorageUpsertBulk) AddTemplateBytes(v int64) *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.AddTemplateBytes(v)
+	})
+}
+
+// UpdateTemplateBytes sets the "template_bytes" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsertBulk) UpdateTemplateBytes() *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.UpdateTemplateBytes()
+	})
+}
+
+// SetSnapshotBytes sets the "snapshot_bytes" field.
+func (u *TeamTemplateStorageUpsertBulk) SetSnapshotBytes(v int64) *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.SetSnapshotBytes(v)
+	})
+}
+
+// AddSnapshotBytes adds v to the "snapshot_bytes" field.
+func (u *TeamTemplateStorageUpsertBulk) AddSnapshotBytes(v int64) *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.AddSnapshotBytes(v)
+	})
+}
+
+// UpdateSnapshotBytes sets the "snapshot_bytes" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsertBulk) UpdateSnapshotBytes() *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.UpdateSnapshotBytes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamTemplateStorageUpsertBulk) SetUpdatedAt(v time.Time) *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamTemplateStorageUpsertBulk) UpdateUpdatedAt() *TeamTemplateStorageUpsertBulk {
+	return u.Update(func(s *TeamTemplateStorageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamTemplateStorageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the TeamTemplateStorageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamTemplateStorageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamTemplateStorageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+)
+
+// TeamTemplateStorageDelete is the builder for deleting a TeamTemplateStorage entity.
+type TeamTemplateStorageDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamTemplateStorageMutation
+}
+
+// Where appends a list predicates to the TeamTemplateStorageDelete builder.
+func (ttsd *TeamTemplateStorageDelete) Where(ps ...predicate.TeamTemplateStorage) *TeamTemplateStorageDelete {
+	ttsd.mutation.Where(ps...)
+	return ttsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ttsd *TeamTemplateStorageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ttsd.sqlExec, ttsd.mutation, ttsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttsd *TeamTemplateStorageDelete) ExecX(ctx context.Context) int {
+	n, err := ttsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ttsd *TeamTemplateStorageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teamtemplatestorage.Table, sqlgraph.NewFieldSpec(teamtemplatestorage.FieldID, field.TypeInt))
+	_spec.Node.Schema = ttsd.schemaConfig.TeamTemplateStorage
+	ctx = internal.NewSchemaConfigContext(ctx, ttsd.schemaConfig)
+	if ps := ttsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ttsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ttsd.mutation.done = true
+	return affected, err
+}
+
+// TeamTemplateStorageDeleteOne is the builder for deleting a single TeamTemplateStorage entity.
+type TeamTemplateStorageDeleteOne struct {
+	ttsd *TeamTemplateStorageDelete
+}
+
+// Where appends a list predicates to the TeamTemplateStorageDelete builder.
+func (ttsdo *TeamTemplateStorageDeleteOne) Where(ps ...predicate.TeamTemplateStorage) *TeamTemplateStorageDeleteOne {
+	ttsdo.ttsd.mutation.Where(ps...)
+	return ttsdo
+}
+
+// Exec executes the deletion query.
+func (ttsdo *TeamTemplateStorageDeleteOne) Exec(ctx context.Context) error {
+	n, err := ttsdo.ttsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teamtemplatestorage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttsdo *TeamTemplateStorageDeleteOne) ExecX(ctx context.Context) {
+	if err := ttsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
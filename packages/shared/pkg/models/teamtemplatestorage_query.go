@@ -0,0 +1,556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+)
+
+// TeamTemplateStorageQuery is the builder for querying TeamTemplateStorage entities.
+type TeamTemplateStorageQuery struct {
+	config
+	ctx        *QueryContext
+	order      []teamtemplatestorage.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TeamTemplateStorage
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TeamTemplateStorageQuery builder.
+func (ttsq *TeamTemplateStorageQuery) Where(ps ...predicate.TeamTemplateStorage) *TeamTemplateStorageQuery {
+	ttsq.predicates = append(ttsq.predicates, ps...)
+	return ttsq
+}
+
+// Limit the number of records to be returned by this query.
+func (ttsq *TeamTemplateStorageQuery) Limit(limit int) *TeamTemplateStorageQuery {
+	ttsq.ctx.Limit = &limit
+	return ttsq
+}
+
+// Offset to start from.
+func (ttsq *TeamTemplateStorageQuery) Offset(offset int) *TeamTemplateStorageQuery {
+	ttsq.ctx.Offset = &offset
+	return ttsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ttsq *TeamTemplateStorageQuery) Unique(unique bool) *TeamTemplateStorageQuery {
+	ttsq.ctx.Unique = &unique
+	return ttsq
+}
+
+// Order specifies how the records should be ordered.
+func (ttsq *TeamTemplateStorageQuery) Order(o ...teamtemplatestorage.OrderOption) *TeamTemplateStorageQuery {
+	ttsq.order = append(ttsq.order, o...)
+	return ttsq
+}
+
+// First returns the first TeamTemplateStorage entity from the query.
+// Returns a *NotFoundError when no TeamTemplateStorage was found.
+func (ttsq *TeamTemplateStorageQuery) First(ctx context.Context) (*TeamTemplateStorage, error) {
+	nodes, err := ttsq.Limit(1).All(setContextOp(ctx, ttsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{teamtemplatestorage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ttsq *TeamTemplateStorageQuery) FirstX(ctx context.Context) *TeamTemplateStorage {
+	node, err := ttsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TeamTemplateStorage ID from the query.
+// Returns a *NotFoundError when no TeamTemplateStorage ID was found.
+func (ttsq *TeamTemplateStorageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ttsq.Limit(1).IDs(setContextOp(ctx, ttsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{teamtemplatestorage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ttsq *TeamTemplateStorageQuery) FirstIDX(ctx context.Context) int {
+	id, err := ttsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TeamTemplateStorage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TeamTemplateStorage entity is found.
+// Returns a *NotFoundError when no TeamTemplateStorage entities are found.
+func (ttsq *TeamTemplateStorageQuery) Only(ctx context.Context) (*TeamTemplateStorage, error) {
+	nodes, err := ttsq.Limit(2).All(setContextOp(ctx, ttsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{teamtemplatestorage.Label}
+	default:
+		return nil, &NotSingularError{teamtemplatestorage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ttsq *TeamTemplateStorageQuery) OnlyX(ctx context.Context) *TeamTemplateStorage {
+	node, err := ttsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TeamTemplateStorage ID in the query.
+// Returns a *NotSingularError when more than one TeamTemplateStorage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ttsq *TeamTemplateStorageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ttsq.Limit(2).IDs(setContextOp(ctx, ttsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{teamtemplatestorage.Label}
+	default:
+		err = &NotSingularError{teamtemplatestorage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ttsq *TeamTemplateStorageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ttsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TeamTemplateStorages.
+func (ttsq *TeamTemplateStorageQuery) All(ctx context.Context) ([]*TeamTemplateStorage, error) {
+	ctx = setContextOp(ctx, ttsq.ctx, "All")
+	if err := ttsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TeamTemplateStorage, *TeamTemplateStorageQuery]()
+	return withInterceptors[[]*TeamTemplateStorage](ctx, ttsq, qr, ttsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ttsq *TeamTemplateStorageQuery) AllX(ctx context.Context) []*TeamTemplateStorage {
+	nodes, err := ttsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TeamTemplateStorage IDs.
+func (ttsq *TeamTemplateStorageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ttsq.ctx.Unique == nil && ttsq.path != nil {
+		ttsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ttsq.ctx, "IDs")
+	if err = ttsq.Select(teamtemplatestorage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ttsq *TeamTemplateStorageQuery) IDsX(ctx context.Context) []int {
+	ids, err := ttsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ttsq *TeamTemplateStorageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ttsq.ctx, "Count")
+	if err := ttsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ttsq, querierCount[*TeamTemplateStorageQuery](), ttsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ttsq *TeamTemplateStorageQuery) CountX(ctx context.Context) int {
+	count, err := ttsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ttsq *TeamTemplateStorageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ttsq.ctx, "Exist")
+	switch _, err := ttsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ttsq *TeamTemplateStorageQuery) ExistX(ctx context.Context) bool {
+	exist, err := ttsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TeamTemplateStorageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ttsq *TeamTemplateStorageQuery) Clone() *TeamTemplateStorageQuery {
+	if ttsq == nil {
+		return nil
+	}
+	return &TeamTemplateStorageQuery{
+		config:     ttsq.config,
+		ctx:        ttsq.ctx.Clone(),
+		order:      append([]teamtemplatestorage.OrderOption{}, ttsq.order...),
+		inters:     append([]Interceptor{}, ttsq.inters...),
+		predicates: append([]predicate.TeamTemplateStorage{}, ttsq.predicates...),
+		// clone intermediate query.
+		sql:  ttsq.sql.Clone(),
+		path: ttsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TeamID uuid.UUID `json:"team_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TeamTemplateStorage.Query().
+//		GroupBy(teamtemplatestorage.FieldTeamID).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (ttsq *TeamTemplateStorageQuery) GroupBy(field string, fields ...string) *TeamTemplateStorageGroupBy {
+	ttsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TeamTemplateStorageGroupBy{build: ttsq}
+	grbuild.flds = &ttsq.ctx.Fields
+	grbuild.label = teamtemplatestorage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TeamID uuid.UUID `json:"team_id,omitempty"`
+//	}
+//
+//	client.TeamTemplateStorage.Query().
+//		Select(teamtemplatestorage.FieldTeamID).
+//		Scan(ctx, &v)
+func (ttsq *TeamTemplateStorageQuery) Select(fields ...string) *TeamTemplateStorageSelect {
+	ttsq.ctx.Fields = append(ttsq.ctx.Fields, fields...)
+	sbuild := &TeamTemplateStorageSelect{TeamTemplateStorageQuery: ttsq}
+	sbuild.label = teamtemplatestorage.Label
+	sbuild.flds, sbuild.scan = &ttsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TeamTemplateStorageSelect configured with the given aggregations.
+func (ttsq *TeamTemplateStorageQuery) Aggregate(fns ...AggregateFunc) *TeamTemplateStorageSelect {
+	return ttsq.Select().Aggregate(fns...)
+}
+
+func (ttsq *TeamTemplateStorageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ttsq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ttsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ttsq.ctx.Fields {
+		if !teamtemplatestorage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if ttsq.path != nil {
+		prev, err := ttsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ttsq.sql = prev
+	}
+	return nil
+}
+
+func (ttsq *TeamTemplateStorageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TeamTemplateStorage, error) {
+	var (
+		nodes = []*TeamTemplateStorage{}
+		_spec = ttsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TeamTemplateStorage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TeamTemplateStorage{config: ttsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = ttsq.schemaConfig.TeamTemplateStorage
+	ctx = internal.NewSchemaConfigContext(ctx, ttsq.schemaConfig)
+	if len(ttsq.modifiers) > 0 {
+		_spec.Modifiers = ttsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ttsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ttsq *TeamTemplateStorageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ttsq.querySpec()
+	_spec.Node.Schema = ttsq.schemaConfig.TeamTemplateStorage
+	ctx = internal.NewSchemaConfigContext(ctx, ttsq.schemaConfig)
+	if len(ttsq.modifiers) > 0 {
+		_spec.Modifiers = ttsq.modifiers
+	}
+	_spec.Node.Columns = ttsq.ctx.Fields
+	if len(ttsq.ctx.Fields) > 0 {
+		_spec.Unique = ttsq.ctx.Unique != nil && *ttsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ttsq.driver, _spec)
+}
+
+func (ttsq *TeamTemplateStorageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(teamtemplatestorage.Table, teamtemplatestorage.Columns, sqlgraph.NewFieldSpec(teamtemplatestorage.FieldID, field.TypeInt))
+	_spec.From = ttsq.sql
+	if unique := ttsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ttsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ttsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamtemplatestorage.FieldID)
+		for i := range fields {
+			if fields[i] != teamtemplatestorage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ttsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ttsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ttsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ttsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ttsq *TeamTemplateStorageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ttsq.driver.Dialect())
+	t1 := builder.Table(teamtemplatestorage.Table)
+	columns := ttsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = teamtemplatestorage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ttsq.sql != nil {
+		selector = ttsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ttsq.ctx.Unique != nil && *ttsq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(ttsq.schemaConfig.TeamTemplateStorage)
+	ctx = internal.NewSchemaConfigContext(ctx, ttsq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range ttsq.modifiers {
+		m(selector)
+	}
+	for _, p := range ttsq.predicates {
+		p(selector)
+	}
+	for _, p := range ttsq.order {
+		p(selector)
+	}
+	if offset := ttsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ttsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ttsq *TeamTemplateStorageQuery) Modify(modifiers ...func(s *sql.Selector)) *TeamTemplateStorageSelect {
+	ttsq.modifiers = append(ttsq.modifiers, modifiers...)
+	return ttsq.Select()
+}
+
+// TeamTemplateStorageGroupBy is the group-by builder for TeamTemplateStorage entities.
+type TeamTemplateStorageGroupBy struct {
+	selector
+	build *TeamTemplateStorageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ttsgb *TeamTemplateStorageGroupBy) Aggregate(fns ...AggregateFunc) *TeamTemplateStorageGroupBy {
+	ttsgb.fns = append(ttsgb.fns, fns...)
+	return ttsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ttsgb *TeamTemplateStorageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ttsgb.build.ctx, "GroupBy")
+	if err := ttsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamTemplateStorageQuery, *TeamTemplateStorageGroupBy](ctx, ttsgb.build, ttsgb, ttsgb.build.inters, v)
+}
+
+func (ttsgb *TeamTemplateStorageGroupBy) sqlScan(ctx context.Context, root *TeamTemplateStorageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ttsgb.fns))
+	for _, fn := range ttsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ttsgb.flds)+len(ttsgb.fns))
+		for _, f := range *ttsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ttsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ttsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TeamTemplateStorageSelect is the builder for selecting fields of TeamTemplateStorage entities.
+type TeamTemplateStorageSelect struct {
+	*TeamTemplateStorageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ttss *TeamTemplateStorageSelect) Aggregate(fns ...AggregateFunc) *TeamTemplateStorageSelect {
+	ttss.fns = append(ttss.fns, fns...)
+	return ttss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ttss *TeamTemplateStorageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ttss.ctx, "Select")
+	if err := ttss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamTemplateStorageQuery, *TeamTemplateStorageSelect](ctx, ttss.TeamTemplateStorageQuery, ttss, ttss.inters, v)
+}
+
+func (ttss *TeamTemplateStorageSelect) sqlScan(ctx context.Context, root *TeamTemplateStorageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ttss.fns))
+	for _, fn := range ttss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ttss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ttss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ttss *TeamTemplateStorageSelect) Modify(modifiers ...func(s *sql.Selector)) *TeamTemplateStorageSelect {
+	ttss.modifiers = append(ttss.modifiers, modifiers...)
+	return ttss
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamtemplatestorage"
+	"github.com/google/uuid"
+)
+
+// TeamTemplateStorageUpdate is the builder for updating TeamTemplateStorage entities.
+type TeamTemplateStorageUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TeamTemplateStorageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TeamTemplateStorageUpdate builder.
+func (ttsu *TeamTemplateStorageUpdate) Where(ps ...predicate.TeamTemplateStorage) *TeamTemplateStorageUpdate {
+	ttsu.mutation.Where(ps...)
+	return ttsu
+}
+
+// SetTeamID sets the "team_id" field.
+func (ttsu *TeamTemplateStorageUpdate) SetTeamID(u uuid.UUID) *TeamTemplateStorageUpdate {
+	ttsu.mutation.SetTeamID(u)
+	return ttsu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (ttsu *TeamTemplateStorageUpdate) SetNillableTeamID(u *uuid.UUID) *TeamTemplateStorageUpdate {
+	if u != nil {
+		ttsu.SetTeamID(*u)
+	}
+	return ttsu
+}
+
+// SetTemplateBytes sets the "template_bytes" field.
+func (ttsu *TeamTemplateStorageUpdate) SetTemplateBytes(i int64) *TeamTemplateStorageUpdate {
+	ttsu.mutation.ResetTemplateBytes()
+	ttsu.mutation.SetTemplateBytes(i)
+	return ttsu
+}
+
+// SetNillableTemplateBytes sets the "template_bytes" field if the given value is not nil.
+func (ttsu *TeamTemplateStorageUpdate) SetNillableTemplateBytes(i *int64) *TeamTemplateStorageUpdate {
+	if i != nil {
+		ttsu.SetTemplateBytes(*i)
+	}
+	return ttsu
+}
+
+// AddTemplateBytes adds i to the "template_bytes" field.
+func (ttsu *TeamTemplateStorageUpdate) AddTemplateBytes(i int64) *TeamTemplateStorageUpdate {
+	ttsu.mutation.AddTemplateBytes(i)
+	return ttsu
+}
+
+// SetSnapshotBytes sets the "snapshot_bytes" field.
+func (ttsu *TeamTemplateStorageUpdate) SetSnapshotBytes(i int64) *TeamTemplateStorageUpdate {
+	ttsu.mutation.ResetSnapshotBytes()
+	ttsu.mutation.SetSnapshotBytes(i)
+	return ttsu
+}
+
+// SetNillableSnapshotBytes sets the "snapshot_bytes" field if the given value is not nil.
+func (ttsu *TeamTemplateStorageUpdate) SetNillableSnapshotBytes(i *int64) *TeamTemplateStorageUpdate {
+	if i != nil {
+		ttsu.SetSnapshotBytes(*i)
+	}
+	return ttsu
+}
+
+// AddSnapshotBytes adds i to the "snapshot_bytes" field.
+func (ttsu *TeamTemplateStorageUpdate) AddSnapshotBytes(i int64) *TeamTemplateStorageUpdate {
+	ttsu.mutation.AddSnapshotBytes(i)
+	return ttsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttsu *TeamTemplateStorageUpdate) SetUpdatedAt(t time.Time) *TeamTemplateStorageUpdate {
+	ttsu.mutation.SetUpdatedAt(t)
+	return ttsu
+}
+
+// Mutation returns the TeamTemplateStorageMutation object of the builder.
+func (ttsu *TeamTemplateStorageUpdate) Mutation() *TeamTemplateStorageMutation {
+	return ttsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ttsu *TeamTemplateStorageUpdate) Save(ctx context.Context) (int, error) {
+	ttsu.defaults()
+	return withHooks(ctx, ttsu.sqlSave, ttsu.mutation, ttsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttsu *TeamTemplateStorageUpdate) SaveX(ctx context.Context) int {
+	affected, err := ttsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ttsu *TeamTemplateStorageUpdate) Exec(ctx context.Context) error {
+	_, err := ttsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttsu *TeamTemplateStorageUpdate) ExecX(ctx context.Context) {
+	if err := ttsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttsu *TeamTemplateStorageUpdate) defaults() {
+	if _, ok := ttsu.mutation.UpdatedAt(); !ok {
+		v := teamtemplatestorage.UpdateDefaultUpdatedAt()
+		ttsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttsu *TeamTemplateStorageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamTemplateStorageUpdate {
+	ttsu.modifiers = append(ttsu.modifiers, modifiers...)
+	return ttsu
+}
+
+func (ttsu *TeamTemplateStorageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamtemplatestorage.Table, teamtemplatestorage.Columns, sqlgraph.NewFieldSpec(teamtemplatestorage.FieldID, field.TypeInt))
+	if ps := ttsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttsu.mutation.TeamID(); ok {
+		_spec.SetField(teamtemplatestorage.FieldTeamID, field.TypeUUID, value)
+	}
+	if value, ok := ttsu.mutation.TemplateBytes(); ok {
+		_spec.SetField(teamtemplatestorage.FieldTemplateBytes, field.TypeInt64, value)
+	}
+	if value, ok := ttsu.mutation.AddedTemplateBytes(); ok {
+		_spec.AddField(teamtemplatestorage.FieldTemplateBytes, field.TypeInt64, value)
+	}
+	if value, ok := ttsu.mutation.SnapshotBytes(); ok {
+		_spec.SetField(teamtemplatestorage.FieldSnapshotBytes, field.TypeInt64, value)
+	}
+	if value, ok := ttsu.mutation.AddedSnapshotBytes(); ok {
+		_spec.AddField(teamtemplatestorage.FieldSnapshotBytes, field.TypeInt64, value)
+	}
+	if value, ok := ttsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamtemplatestorage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = ttsu.schemaConfig.TeamTemplateStorage
+	ctx = internal.NewSchemaConfigContext(ctx, ttsu.schemaConfig)
+	_spec.AddModifiers(ttsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ttsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamtemplatestorage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ttsu.mutation.done = true
+	return n, nil
+}
+
+// TeamTemplateStorageUpdateOne is the builder for updating a single TeamTemplateStorage entity.
+type TeamTemplateStorageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TeamTemplateStorageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTeamID sets the "team_id" field.
+func (ttsuo *TeamTemplateStorageUpdateOne) SetTeamID(u uuid.UUID) *TeamTemplateStorageUpdateOne {
+	ttsuo.mutation.SetTeamID(u)
+	return ttsuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (ttsuo *TeamTemplateStorageUpdateOne) SetNillableTeamID(u *uuid.UUID) *TeamTemplateStorageUpdateOne {
+	if u != nil {
+		ttsuo.SetTeamID(*u)
+	}
+	return ttsuo
+}
+
+// SetTemplateBytes sets the "template_bytes" field.
+func (ttsuo *TeamTemplateStorageUpdateOne) SetTemplateBytes(i int64) *TeamTemplateStorageUpdateOne {
+	ttsuo.mutation.ResetTemplateBytes()
+	ttsuo.mutation.SetTemplateBytes(i)
+	return ttsuo
+}
+
+// SetNillableTemplateBytes sets the "template_bytes" field if the given value is not nil.
+func (ttsuo *TeamTemplateStorageUpdateOne) SetNillableTemplateBytes(i *int64) *TeamTemplateStorageUpdateOne {
+	if i != nil {
+		ttsuo.SetTemplateBytes(*i)
+	}
+	return ttsuo
+}
+
+// AddTemplateBytes adds i to the "template_bytes" field.
+func (ttsuo *TeamTemplateStorageUpdateOne) AddTemplateBytes(i int64) *TeamTemplateStorageUpdateOne {
+	ttsuo.mutation.AddTemplateBytes(i)
+	return ttsuo
+}
+
+// SetSnapshotBytes sets the "snapshot_bytes" field.
+func (ttsuo *TeamTemplateStorageUpdateOne) SetSnapshotBytes(i int64) *TeamTemplateStorageUpdateOne {
+	ttsuo.mutation.ResetSnapshotBytes()
+	ttsuo.mutation.SetSnapshotBytes(i)
+	return ttsuo
+}
+
+// SetNillableSnapshotBytes sets the "snapshot_bytes" field if the given value is not nil.
+func (ttsuo *TeamTemplateStorageUpdateOne) SetNillableSnapshotBytes(i *int64) *TeamTemplateStorageUpdateOne {
+	if i != nil {
+		ttsuo.SetSnapshotBytes(*i)
+	}
+	return ttsuo
+}
+
+// AddSnapshotBytes adds i to the "snapshot_bytes" field.
+func (ttsuo *TeamTemplateStorageUpdateOne) AddSnapshotBytes(i int64) *TeamTemplateStorageUpdateOne {
+	ttsuo.mutation.AddSnapshotBytes(i)
+	return ttsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttsuo *TeamTemplateStorageUpdateOne) SetUpdatedAt(t time.Time) *TeamTemplateStorageUpdateOne {
+	ttsuo.mutation.SetUpdatedAt(t)
+	return ttsuo
+}
+
+// Mutation returns the TeamTemplateStorageMutation object of the builder.
+func (ttsuo *TeamTemplateStorageUpdateOne) Mutation() *TeamTemplateStorageMutation {
+	return ttsuo.mutation
+}
+
+// Where appends a list predicates to the TeamTemplateStorageUpdate builder.
+func (ttsuo *TeamTemplateStorageUpdateOne) Where(ps ...predicate.TeamTemplateStorage) *TeamTemplateStorageUpdateOne {
+	ttsuo.mutation.Where(ps...)
+	return ttsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ttsuo *TeamTemplateStorageUpdateOne) Select(field string, fields ...string) *TeamTemplateStorageUpdateOne {
+	ttsuo.fields = append([]string{field}, fields...)
+	return ttsuo
+}
+
+// Save executes the query and returns the updated TeamTemplateStorage entity.
+func (ttsuo *TeamTemplateStorageUpdateOne) Save(ctx context.Context) (*TeamTemplateStorage, error) {
+	ttsuo.defaults()
+	return withHooks(ctx, ttsuo.sqlSave, ttsuo.mutation, ttsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttsuo *TeamTemplateStorageUpdateOne) SaveX(ctx context.Context) *TeamTemplateStorage {
+	node, err := ttsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ttsuo *TeamTemplateStorageUpdateOne) Exec(ctx context.Context) error {
+	_, err := ttsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttsuo *TeamTemplateStorageUpdateOne) ExecX(ctx context.Context) {
+	if err := ttsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttsuo *TeamTemplateStorageUpdateOne) defaults() {
+	if _, ok := ttsuo.mutation.UpdatedAt(); !ok {
+		v := teamtemplatestorage.UpdateDefaultUpdatedAt()
+		ttsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttsuo *TeamTemplateStorageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamTemplateStorageUpdateOne {
+	ttsuo.modifiers = append(ttsuo.modifiers, modifiers...)
+	return ttsuo
+}
+
+func (ttsuo *TeamTemplateStorageUpdateOne) sqlSave(ctx context.Context) (_node *TeamTemplateStorage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamtemplatestorage.Table, teamtemplatestorage.Columns, sqlgraph.NewFieldSpec(teamtemplatestorage.FieldID, field.TypeInt))
+	id, ok := ttsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "TeamTemplateStorage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ttsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamtemplatestorage.FieldID)
+		for _, f := range fields {
+			if !teamtemplatestorage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != teamtemplatestorage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ttsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttsuo.mutation.TeamID(); ok {
+		_spec.SetField(teamtemplatestorage.FieldTeamID, field.TypeUUID, value)
+	}
+	if value, ok := ttsuo.mutation.TemplateBytes(); ok {
+		_spec.SetField(teamtemplatestorage.FieldTemplateBytes, field.TypeInt64, value)
+	}
+	if value, ok := ttsuo.mutation.AddedTemplateBytes(); ok {
+		_spec.AddField(teamtemplatestorage.FieldTemplateBytes, field.TypeInt64, value)
+	}
+	if value, ok := ttsuo.mutation.SnapshotBytes(); ok {
+		_spec.SetField(teamtemplatestorage.FieldSnapshotBytes, field.TypeInt64, value)
+	}
+	if value, ok := ttsuo.mutation.AddedSnapshotBytes(); ok {
+		_spec.AddField(teamtemplatestorage.FieldSnapshotBytes, field.TypeInt64, value)
+	}
+	if value, ok := ttsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamtemplatestorage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = ttsuo.schemaConfig.TeamTemplateStorage
+	ctx = internal.NewSchemaConfigContext(ctx, ttsuo.schemaConfig)
+	_spec.AddModifiers(ttsuo.modifiers...)
+	_node = &TeamTemplateStorage{config: ttsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ttsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamtemplatestorage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ttsuo.mutation.done = true
+	return _node, nil
+}
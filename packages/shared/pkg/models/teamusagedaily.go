@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
+	"github.com/google/uuid"
+)
+
+// TeamUsageDaily is the model entity for the TeamUsageDaily schema.
+type TeamUsageDaily struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day time.Time `json:"day,omitempty"`
+	// SandboxesStarted holds the value of the "sandboxes_started" field.
+	SandboxesStarted int64 `json:"sandboxes_started,omitempty"`
+	// SandboxesResumed holds the value of the "sandboxes_resumed" field.
+	SandboxesResumed int64 `json:"sandboxes_resumed,omitempty"`
+	// ResumeP95Ms holds the value of the "resume_p95_ms" field.
+	ResumeP95Ms *int64 `json:"resume_p95_ms,omitempty"`
+	// Builds holds the value of the "builds" field.
+	Builds int64 `json:"builds,omitempty"`
+	// BuildSeconds holds the value of the "build_seconds" field.
+	BuildSeconds int64 `json:"build_seconds,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamUsageDaily) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teamusagedaily.FieldID, teamusagedaily.FieldSandboxesStarted, teamusagedaily.FieldSandboxesResumed, teamusagedaily.FieldResumeP95Ms, teamusagedaily.FieldBuilds, teamusagedaily.FieldBuildSeconds:
+			values[i] = new(sql.NullInt64)
+		case teamusagedaily.FieldDay, teamusagedaily.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case teamusagedaily.FieldTeamID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamUsageDaily fields.
+func (tud *TeamUsageDaily) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teamusagedaily.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tud.ID = int(value.Int64)
+		case teamusagedaily.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				tud.TeamID = *value
+			}
+		case teamusagedaily.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				tud.Day = value.Time
+			}
+		case teamusagedaily.FieldSandboxesStarted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sandboxes_started", values[i])
+			} else if value.Valid {
+				tud.SandboxesStarted = value.Int64
+			}
+		case teamusagedaily.FieldSandboxesResumed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sandboxes_resumed", values[i])
+			} else if value.Valid {
+				tud.SandboxesResumed = value.Int64
+			}
+		case teamusagedaily.FieldResumeP95Ms:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_p95_ms", values[i])
+			} else if value.Valid {
+				tud.ResumeP95Ms = new(int64)
+				*tud.ResumeP95Ms = value.Int64
+			}
+		case teamusagedaily.FieldBuilds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field builds", values[i])
+			} else if value.Valid {
+				tud.Builds = value.Int64
+			}
+		case teamusagedaily.FieldBuildSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field build_seconds", values[i])
+			} else if value.Valid {
+				tud.BuildSeconds = value.Int64
+			}
+		case teamusagedaily.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tud.UpdatedAt = value.Time
+			}
+		default:
+			tud.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamUsageDaily.
+// This includes values selected through modifiers, order, etc.
+func (tud *TeamUsageDaily) Value(name string) (ent.Value, error) {
+	return tud.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TeamUsageDaily.
+// Note that you need to call TeamUsageDaily.Unwrap() before calling this method if this TeamUsageDaily
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tud *TeamUsageDaily) Update() *TeamUsageDailyUpdateOne {
+	return NewTeamUsageDailyClient(tud.config).UpdateOne(tud)
+}
+
+// Unwrap unwraps the TeamUsageDaily entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tud *TeamUsageDaily) Unwrap() *TeamUsageDaily {
+	_tx, ok := tud.config.driver.(*txDriver)
+	if !ok {
+		panic("models: TeamUsageDaily is not a transactional entity")
+	}
+	tud.config.driver = _tx.drv
+	return tud
+}
+
+// String implements the fmt.Stringer.
+func (tud *TeamUsageDaily) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamUsageDaily(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tud.ID))
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", tud.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(tud.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("sandboxes_started=")
+	builder.WriteString(fmt.Sprintf("%v", tud.SandboxesStarted))
+	builder.WriteString(", ")
+	builder.WriteString("sandboxes_resumed=")
+	builder.WriteString(fmt.Sprintf("%v", tud.SandboxesResumed))
+	builder.WriteString(", ")
+	if v := tud.ResumeP95Ms; v != nil {
+		builder.WriteString("resume_p95_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("builds=")
+	builder.WriteString(fmt.Sprintf("%v", tud.Builds))
+	builder.WriteString(", ")
+	builder.WriteString("build_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", tud.BuildSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tud.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamUsageDailies is a parsable slice of TeamUsageDaily.
+type TeamUsageDailies []*TeamUsageDaily
@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teamusagedaily
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the teamusagedaily type in the database.
+	Label = "team_usage_daily"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldSandboxesStarted holds the string denoting the sandboxes_started field in the database.
+	FieldSandboxesStarted = "sandboxes_started"
+	// FieldSandboxesResumed holds the string denoting the sandboxes_resumed field in the database.
+	FieldSandboxesResumed = "sandboxes_resumed"
+	// FieldResumeP95Ms holds the string denoting the resume_p95_ms field in the database.
+	FieldResumeP95Ms = "resume_p95_ms"
+	// FieldBuilds holds the string denoting the builds field in the database.
+	FieldBuilds = "builds"
+	// FieldBuildSeconds holds the string denoting the build_seconds field in the database.
+	FieldBuildSeconds = "build_seconds"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the teamusagedaily in the database.
+	Table = "team_usage_daily"
+)
+
+// Columns holds all SQL columns for teamusagedaily fields.
+var Columns = []string{
+	FieldID,
+	FieldTeamID,
+	FieldDay,
+	FieldSandboxesStarted,
+	FieldSandboxesResumed,
+	FieldResumeP95Ms,
+	FieldBuilds,
+	FieldBuildSeconds,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSandboxesStarted holds the default value on creation for the "sandboxes_started" field.
+	DefaultSandboxesStarted int64
+	// DefaultSandboxesResumed holds the default value on creation for the "sandboxes_resumed" field.
+	DefaultSandboxesResumed int64
+	// DefaultBuilds holds the default value on creation for the "builds" field.
+	DefaultBuilds int64
+	// DefaultBuildSeconds holds the default value on creation for the "build_seconds" field.
+	DefaultBuildSeconds int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TeamUsageDaily queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// BySandboxesStarted orders the results by the sandboxes_started field.
+func BySandboxesStarted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSandboxesStarted, opts...).ToFunc()
+}
+
+// BySandboxesResumed orders the results by the sandboxes_resumed field.
+func BySandboxesResumed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSandboxesResumed, opts...).ToFunc()
+}
+
+// ByResumeP95Ms orders the results by the resume_p95_ms field.
+func ByResumeP95Ms(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumeP95Ms, opts...).ToFunc()
+}
+
+// ByBuilds orders the results by the builds field.
+func ByBuilds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuilds, opts...).ToFunc()
+}
+
+// ByBuildSeconds orders the results by the build_seconds field.
+func ByBuildSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildSeconds, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
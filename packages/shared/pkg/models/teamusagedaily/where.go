@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teamusagedaily
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLTE(FieldID, id))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldTeamID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldDay, v))
+}
+
+// SandboxesStarted applies equality check predicate on the "sandboxes_started" field. It's identical to SandboxesStartedEQ.
+func SandboxesStarted(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldSandboxesStarted, v))
+}
+
+// SandboxesResumed applies equality check predicate on the "sandboxes_resumed" field. It's identical to SandboxesResumedEQ.
+func SandboxesResumed(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldSandboxesResumed, v))
+}
+
+// ResumeP95Ms applies equality check predicate on the "resume_p95_ms" field. It's identical to ResumeP95MsEQ.
+func ResumeP95Ms(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldResumeP95Ms, v))
+}
+
+// Builds applies equality check predicate on the "builds" field. It's identical to BuildsEQ.
+func Builds(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldBuilds, v))
+}
+
+// BuildSeconds applies equality check predicate on the "build_seconds" field. It's identical to BuildSecondsEQ.
+func BuildSeconds(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldBuildSeconds, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v uuid.UUID) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v uuid.UUID) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v uuid.UUID) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v uuid.UUID) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLTE(FieldTeamID, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLTE(FieldDay, v))
+}
+
+// SandboxesStartedEQ applies the EQ predicate on the "sandboxes_started" field.
+func SandboxesStartedEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldSandboxesStarted, v))
+}
+
+// SandboxesStartedNEQ applies the NEQ predicate on the "sandboxes_started" field.
+func SandboxesStartedNEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNEQ(FieldSandboxesStarted, v))
+}
+
+// SandboxesStartedIn applies the In predicate on the "sandboxes_started" field.
+func SandboxesStartedIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIn(FieldSandboxesStarted, vs...))
+}
+
+// SandboxesStartedNotIn applies the NotIn predicate on the "sandboxes_started" field.
+func SandboxesStartedNotIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotIn(FieldSandboxesStarted, vs...))
+}
+
+// SandboxesStartedGT applies the GT predicate on the "sandboxes_started" field.
+func SandboxesStartedGT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGT(FieldSandboxesStarted, v))
+}
+
+// SandboxesStartedGTE applies the GTE predicate on the "sandboxes_started" field.
+func SandboxesStartedGTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGTE(FieldSandboxesStarted, v))
+}
+
+// SandboxesStartedLT applies the LT predicate on the "sandboxes_started" field.
+func SandboxesStartedLT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLT(FieldSandboxesStarted, v))
+}
+
+// SandboxesStartedLTE applies the LTE predicate on the "sandboxes_started" field.
+func SandboxesStartedLTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLTE(FieldSandboxesStarted, v))
+}
+
+// SandboxesResumedEQ applies the EQ predicate on the "sandboxes_resumed" field.
+func SandboxesResumedEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldSandboxesResumed, v))
+}
+
+// SandboxesResumedNEQ applies the NEQ predicate on the "sandboxes_resumed" field.
+func SandboxesResumedNEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNEQ(FieldSandboxesResumed, v))
+}
+
+// SandboxesResumedIn applies the In predicate on the "sandboxes_resumed" field.
+func SandboxesResumedIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIn(FieldSandboxesResumed, vs...))
+}
+
+// SandboxesResumedNotIn applies the NotIn predicate on the "sandboxes_resumed" field.
+func SandboxesResumedNotIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotIn(FieldSandboxesResumed, vs...))
+}
+
+// SandboxesResumedGT applies the GT predicate on the "sandboxes_resumed" field.
+func SandboxesResumedGT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGT(FieldSandboxesResumed, v))
+}
+
+// SandboxesResumedGTE applies the GTE predicate on the "sandboxes_resumed" field.
+func SandboxesResumedGTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGTE(FieldSandboxesResumed, v))
+}
+
+// SandboxesResumedLT applies the LT predicate on the "sandboxes_resumed" field.
+func SandboxesResumedLT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLT(FieldSandboxesResumed, v))
+}
+
+// SandboxesResumedLTE applies the LTE predicate on the "sandboxes_resumed" field.
+func SandboxesResumedLTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLTE(FieldSandboxesResumed, v))
+}
+
+// ResumeP95MsEQ applies the EQ predicate on the "resume_p95_ms" field.
+func ResumeP95MsEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldResumeP95Ms, v))
+}
+
+// ResumeP95MsNEQ applies the NEQ predicate on the "resume_p95_ms" field.
+func ResumeP95MsNEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNEQ(FieldResumeP95Ms, v))
+}
+
+// ResumeP95MsIn applies the In predicate on the "resume_p95_ms" field.
+func ResumeP95MsIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIn(FieldResumeP95Ms, vs...))
+}
+
+// ResumeP95MsNotIn applies the NotIn predicate on the "resume_p95_ms" field.
+func ResumeP95MsNotIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotIn(FieldResumeP95Ms, vs...))
+}
+
+// ResumeP95MsGT applies the GT predicate on the "resume_p95_ms" field.
+func ResumeP95MsGT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGT(FieldResumeP95Ms, v))
+}
+
+// ResumeP95MsGTE applies the GTE predicate on the "resume_p95_ms" field.
+func ResumeP95MsGTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGTE(FieldResumeP95Ms, v))
+}
+
+// ResumeP95MsLT applies the LT predicate on the "resume_p95_ms" field.
+func ResumeP95MsLT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLT(FieldResumeP95Ms, v))
+}
+
+// ResumeP95MsLTE applies the LTE predicate on the "resume_p95_ms" field.
+func ResumeP95MsLTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLTE(FieldResumeP95Ms, v))
+}
+
+// ResumeP95MsIsNil applies the IsNil predicate on the "resume_p95_ms" field.
+func ResumeP95MsIsNil() predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIsNull(FieldResumeP95Ms))
+}
+
+// ResumeP95MsNotNil applies the NotNil predicate on the "resume_p95_ms" field.
+func ResumeP95MsNotNil() predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotNull(FieldResumeP95Ms))
+}
+
+// BuildsEQ applies the EQ predicate on the "builds" field.
+func BuildsEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldBuilds, v))
+}
+
+// BuildsNEQ applies the NEQ predicate on the "builds" field.
+func BuildsNEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNEQ(FieldBuilds, v))
+}
+
+// BuildsIn applies the In predicate on the "builds" field.
+func BuildsIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIn(FieldBuilds, vs...))
+}
+
+// BuildsNotIn applies the NotIn predicate on the "builds" field.
+func BuildsNotIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotIn(FieldBuilds, vs...))
+}
+
+// BuildsGT applies the GT predicate on the "builds" field.
+func BuildsGT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGT(FieldBuilds, v))
+}
+
+// BuildsGTE applies the GTE predicate on the "builds" field.
+func BuildsGTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGTE(FieldBuilds, v))
+}
+
+// BuildsLT applies the LT predicate on the "builds" field.
+func BuildsLT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLT(FieldBuilds, v))
+}
+
+// BuildsLTE applies the LTE predicate on the "builds" field.
+func BuildsLTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLTE(FieldBuilds, v))
+}
+
+// BuildSecondsEQ applies the EQ predicate on the "build_seconds" field.
+func BuildSecondsEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldBuildSeconds, v))
+}
+
+// BuildSecondsNEQ applies the NEQ predicate on the "build_seconds" field.
+func BuildSecondsNEQ(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNEQ(FieldBuildSeconds, v))
+}
+
+// BuildSecondsIn applies the In predicate on the "build_seconds" field.
+func BuildSecondsIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIn(FieldBuildSeconds, vs...))
+}
+
+// BuildSecondsNotIn applies the NotIn predicate on the "build_seconds" field.
+func BuildSecondsNotIn(vs ...int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotIn(FieldBuildSeconds, vs...))
+}
+
+// BuildSecondsGT applies the GT predicate on the "build_seconds" field.
+func BuildSecondsGT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGT(FieldBuildSeconds, v))
+}
+
+// BuildSecondsGTE applies the GTE predicate on the "build_seconds" field.
+func BuildSecondsGTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGTE(FieldBuildSeconds, v))
+}
+
+// BuildSecondsLT applies the LT predicate on the "build_seconds" field.
+func BuildSecondsLT(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLT(FieldBuildSeconds, v))
+}
+
+// BuildSecondsLTE applies the LTE predicate on the "build_seconds" field.
+func BuildSecondsLTE(v int64) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLTE(FieldBuildSeconds, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamUsageDaily) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamUsageDaily) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamUsageDaily) predicate.TeamUsageDaily {
+	return predicate.TeamUsageDaily(sql.NotPredicates(p))
+}
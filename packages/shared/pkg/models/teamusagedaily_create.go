@@ -0,0 +1,957 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
+	"github.com/google/uuid"
+)
+
+// TeamUsageDailyCreate is the builder for creating a TeamUsageDaily entity.
+type TeamUsageDailyCreate struct {
+	config
+	mutation *TeamUsageDailyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTeamID sets the "team_id" field.
+func (tudc *TeamUsageDailyCreate) SetTeamID(u uuid.UUID) *TeamUsageDailyCreate {
+	tudc.mutation.SetTeamID(u)
+	return tudc
+}
+
+// SetDay sets the "day" field.
+func (tudc *TeamUsageDailyCreate) SetDay(t time.Time) *TeamUsageDailyCreate {
+	tudc.mutation.SetDay(t)
+	return tudc
+}
+
+// SetSandboxesStarted sets the "sandboxes_started" field.
+func (tudc *TeamUsageDailyCreate) SetSandboxesStarted(i int64) *TeamUsageDailyCreate {
+	tudc.mutation.SetSandboxesStarted(i)
+	return tudc
+}
+
+// SetNillableSandboxesStarted sets the "sandboxes_started" field if the given value is not nil.
+func (tudc *TeamUsageDailyCreate) SetNillableSandboxesStarted(i *int64) *TeamUsageDailyCreate {
+	if i != nil {
+		tudc.SetSandboxesStarted(*i)
+	}
+	return tudc
+}
+
+// SetSandboxesResumed sets the "sandboxes_resumed" field.
+func (tudc *TeamUsageDailyCreate) SetSandboxesResumed(i int64) *TeamUsageDailyCreate {
+	tudc.mutation.SetSandboxesResumed(i)
+	return tudc
+}
+
+// SetNillableSandboxesResumed sets the "sandboxes_resumed" field if the given value is not nil.
+func (tudc *TeamUsageDailyCreate) SetNillableSandboxesResumed(i *int64) *TeamUsageDailyCreate {
+	if i != nil {
+		tudc.SetSandboxesResumed(*i)
+	}
+	return tudc
+}
+
+// SetResumeP95Ms sets the "resume_p95_ms" field.
+func (tudc *TeamUsageDailyCreate) SetResumeP95Ms(i int64) *TeamUsageDailyCreate {
+	tudc.mutation.SetResumeP95Ms(i)
+	return tudc
+}
+
+// SetNillableResumeP95Ms sets the "resume_p95_ms" field if the given value is not nil.
+func (tudc *TeamUsageDailyCreate) SetNillableResumeP95Ms(i *int64) *TeamUsageDailyCreate {
+	if i != nil {
+		tudc.SetResumeP95Ms(*i)
+	}
+	return tudc
+}
+
+// SetBuilds sets the "builds" field.
+func (tudc *TeamUsageDailyCreate) SetBuilds(i int64) *TeamUsageDailyCreate {
+	tudc.mutation.SetBuilds(i)
+	return tudc
+}
+
+// SetNillableBuilds sets the "builds" field if the given value is not nil.
+func (tudc *TeamUsageDailyCreate) SetNillableBuilds(i *int64) *TeamUsageDailyCreate {
+	if i != nil {
+		tudc.SetBuilds(*i)
+	}
+	return tudc
+}
+
+// SetBuildSeconds sets the "build_seconds" field.
+func (tudc *TeamUsageDailyCreate) SetBuildSeconds(i int64) *TeamUsageDailyCreate {
+	tudc.mutation.SetBuildSeconds(i)
+	return tudc
+}
+
+// SetNillableBuildSeconds sets the "build_seconds" field if the given value is not nil.
+func (tudc *TeamUsageDailyCreate) SetNillableBuildSeconds(i *int64) *TeamUsageDailyCreate {
+	if i != nil {
+		tudc.SetBuildSeconds(*i)
+	}
+	return tudc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tudc *TeamUsageDailyCreate) SetUpdatedAt(t time.Time) *TeamUsageDailyCreate {
+	tudc.mutation.SetUpdatedAt(t)
+	return tudc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tudc *TeamUsageDailyCreate) SetNillableUpdatedAt(t *time.Time) *TeamUsageDailyCreate {
+	if t != nil {
+		tudc.SetUpdatedAt(*t)
+	}
+	return tudc
+}
+
+// Mutation returns the TeamUsageDailyMutation object of the builder.
+func (tudc *TeamUsageDailyCreate) Mutation() *TeamUsageDailyMutation {
+	return tudc.mutation
+}
+
+// Save creates the TeamUsageDaily in the database.
+func (tudc *TeamUsageDailyCreate) Save(ctx context.Context) (*TeamUsageDaily, error) {
+	tudc.defaults()
+	return withHooks(ctx, tudc.sqlSave, tudc.mutation, tudc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tudc *TeamUsageDailyCreate) SaveX(ctx context.Context) *TeamUsageDaily {
+	v, err := tudc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tudc *TeamUsageDailyCreate) Exec(ctx context.Context) error {
+	_, err := tudc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tudc *TeamUsageDailyCreate) ExecX(ctx context.Context) {
+	if err := tudc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tudc *TeamUsageDailyCreate) defaults() {
+	if _, ok := tudc.mutation.SandboxesStarted(); !ok {
+		v := teamusagedaily.DefaultSandboxesStarted
+		tudc.mutation.SetSandboxesStarted(v)
+	}
+	if _, ok := tudc.mutation.SandboxesResumed(); !ok {
+		v := teamusagedaily.DefaultSandboxesResumed
+		tudc.mutation.SetSandboxesResumed(v)
+	}
+	if _, ok := tudc.mutation.Builds(); !ok {
+		v := teamusagedaily.DefaultBuilds
+		tudc.mutation.SetBuilds(v)
+	}
+	if _, ok := tudc.mutation.BuildSeconds(); !ok {
+		v := teamusagedaily.DefaultBuildSeconds
+		tudc.mutation.SetBuildSeconds(v)
+	}
+	if _, ok := tudc.mutation.UpdatedAt(); !ok {
+		v := teamusagedaily.DefaultUpdatedAt()
+		tudc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tudc *TeamUsageDailyCreate) check() error {
+	if _, ok := tudc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "TeamUsageDaily.team_id"`)}
+	}
+	if _, ok := tudc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`models: missing required field "TeamUsageDaily.day"`)}
+	}
+	if _, ok := tudc.mutation.SandboxesStarted(); !ok {
+		return &ValidationError{Name: "sandboxes_started", err: errors.New(`models: missing required field "TeamUsageDaily.sandboxes_started"`)}
+	}
+	if _, ok := tudc.mutation.SandboxesResumed(); !ok {
+		return &ValidationError{Name: "sandboxes_resumed", err: errors.New(`models: missing required field "TeamUsageDaily.sandboxes_resumed"`)}
+	}
+	if _, ok := tudc.mutation.Builds(); !ok {
+		return &ValidationError{Name: "builds", err: errors.New(`models: missing required field "TeamUsageDaily.builds"`)}
+	}
+	if _, ok := tudc.mutation.BuildSeconds(); !ok {
+		return &ValidationError{Name: "build_seconds", err: errors.New(`models: missing required field "TeamUsageDaily.build_seconds"`)}
+	}
+	if _, ok := tudc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`models: missing required field "TeamUsageDaily.updated_at"`)}
+	}
+	return nil
+}
+
+func (tudc *TeamUsageDailyCreate) sqlSave(ctx context.Context) (*TeamUsageDaily, error) {
+	if err := tudc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tudc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tudc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tudc.mutation.id = &_node.ID
+	tudc.mutation.done = true
+	return _node, nil
+}
+
+func (tudc *TeamUsageDailyCreate) createSpec() (*TeamUsageDaily, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamUsageDaily{config: tudc.config}
+		_spec = sqlgraph.NewCreateSpec(teamusagedaily.Table, sqlgraph.NewFieldSpec(teamusagedaily.FieldID, field.TypeInt))
+	)
+	_spec.Schema = tudc.schemaConfig.TeamUsageDaily
+	_spec.OnConflict = tudc.conflict
+	if value, ok := tudc.mutation.TeamID(); ok {
+		_spec.SetField(teamusagedaily.FieldTeamID, field.TypeUUID, value)
+		_node.TeamID = value
+	}
+	if value, ok := tudc.mutation.Day(); ok {
+		_spec.SetField(teamusagedaily.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := tudc.mutation.SandboxesStarted(); ok {
+		_spec.SetField(teamusagedaily.FieldSandboxesStarted, field.TypeInt64, value)
+		_node.SandboxesStarted = value
+	}
+	if value, ok := tudc.mutation.SandboxesResumed(); ok {
+		_spec.SetField(teamusagedaily.FieldSandboxesResumed, field.TypeInt64, value)
+		_node.SandboxesResumed = value
+	}
+	if value, ok := tudc.mutation.ResumeP95Ms(); ok {
+		_spec.SetField(teamusagedaily.FieldResumeP95Ms, field.TypeInt64, value)
+		_node.ResumeP95Ms = &value
+	}
+	if value, ok := tudc.mutation.Builds(); ok {
+		_spec.SetField(teamusagedaily.FieldBuilds, field.TypeInt64, value)
+		_node.Builds = value
+	}
+	if value, ok := tudc.mutation.BuildSeconds(); ok {
+		_spec.SetField(teamusagedaily.FieldBuildSeconds, field.TypeInt64, value)
+		_node.BuildSeconds = value
+	}
+	if value, ok := tudc.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamusagedaily.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamUsageDaily.Create().
+//		SetTeamID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamUsageDailyUpsert) {
+//			SetTeamID(v+v).
+//		}).
+//		Exec(ctx)
+func (tudc *TeamUsageDailyCreate) OnConflict(opts ...sql.ConflictOption) *TeamUsageDailyUpsertOne {
+	tudc.conflict = opts
+	return &TeamUsageDailyUpsertOne{
+		create: tudc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamUsageDaily.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tudc *TeamUsageDailyCreate) OnConflictColumns(columns ...string) *TeamUsageDailyUpsertOne {
+	tudc.conflict = append(tudc.conflict, sql.ConflictColumns(columns...))
+	return &TeamUsageDailyUpsertOne{
+		create: tudc,
+	}
+}
+
+type (
+	// TeamUsageDailyUpsertOne is the builder for "upsert"-ing
+	//  one TeamUsageDaily node.
+	TeamUsageDailyUpsertOne struct {
+		create *TeamUsageDailyCreate
+	}
+
+	// TeamUsageDailyUpsert is the "OnConflict" setter.
+	TeamUsageDailyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSandboxesStarted sets the "sandboxes_started" field.
+func (u *TeamUsageDailyUpsert) SetSandboxesStarted(v int64) *TeamUsageDailyUpsert {
+	u.Set(teamusagedaily.FieldSandboxesStarted, v)
+	return u
+}
+
+// UpdateSandboxesStarted sets the "sandboxes_started" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsert) UpdateSandboxesStarted() *TeamUsageDailyUpsert {
+	u.SetExcluded(teamusagedaily.FieldSandboxesStarted)
+	return u
+}
+
+// AddSandboxesStarted adds v to the "sandboxes_started" field.
+func (u *TeamUsageDailyUpsert) AddSandboxesStarted(v int64) *TeamUsageDailyUpsert {
+	u.Add(teamusagedaily.FieldSandboxesStarted, v)
+	return u
+}
+
+// SetSandboxesResumed sets the "sandboxes_resumed" field.
+func (u *TeamUsageDailyUpsert) SetSandboxesResumed(v int64) *TeamUsageDailyUpsert {
+	u.Set(teamusagedaily.FieldSandboxesResumed, v)
+	return u
+}
+
+// UpdateSandboxesResumed sets the "sandboxes_resumed" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsert) UpdateSandboxesResumed() *TeamUsageDailyUpsert {
+	u.SetExcluded(teamusagedaily.FieldSandboxesResumed)
+	return u
+}
+
+// AddSandboxesResumed adds v to the "sandboxes_resumed" field.
+func (u *TeamUsageDailyUpsert) AddSandboxesResumed(v int64) *TeamUsageDailyUpsert {
+	u.Add(teamusagedaily.FieldSandboxesResumed, v)
+	return u
+}
+
+// SetResumeP95Ms sets the "resume_p95_ms" field.
+func (u *TeamUsageDailyUpsert) SetResumeP95Ms(v int64) *TeamUsageDailyUpsert {
+	u.Set(teamusagedaily.FieldResumeP95Ms, v)
+	return u
+}
+
+// UpdateResumeP95Ms sets the "resume_p95_ms" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsert) UpdateResumeP95Ms() *TeamUsageDailyUpsert {
+	u.SetExcluded(teamusagedaily.FieldResumeP95Ms)
+	return u
+}
+
+// AddResumeP95Ms adds v to the "resume_p95_ms" field.
+func (u *TeamUsageDailyUpsert) AddResumeP95Ms(v int64) *TeamUsageDailyUpsert {
+	u.Add(teamusagedaily.FieldResumeP95Ms, v)
+	return u
+}
+
+// ClearResumeP95Ms clears the value of the "resume_p95_ms" field.
+func (u *TeamUsageDailyUpsert) ClearResumeP95Ms() *TeamUsageDailyUpsert {
+	u.SetNull(teamusagedaily.FieldResumeP95Ms)
+	return u
+}
+
+// SetBuilds sets the "builds" field.
+func (u *TeamUsageDailyUpsert) SetBuilds(v int64) *TeamUsageDailyUpsert {
+	u.Set(teamusagedaily.FieldBuilds, v)
+	return u
+}
+
+// UpdateBuilds sets the "builds" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsert) UpdateBuilds() *TeamUsageDailyUpsert {
+	u.SetExcluded(teamusagedaily.FieldBuilds)
+	return u
+}
+
+// AddBuilds adds v to the "builds" field.
+func (u *TeamUsageDailyUpsert) AddBuilds(v int64) *TeamUsageDailyUpsert {
+	u.Add(teamusagedaily.FieldBuilds, v)
+	return u
+}
+
+// SetBuildSeconds sets the "build_seconds" field.
+func (u *TeamUsageDailyUpsert) SetBuildSeconds(v int64) *TeamUsageDailyUpsert {
+	u.Set(teamusagedaily.FieldBuildSeconds, v)
+	return u
+}
+
+// UpdateBuildSeconds sets the "build_seconds" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsert) UpdateBuildSeconds() *TeamUsageDailyUpsert {
+	u.SetExcluded(teamusagedaily.FieldBuildSeconds)
+	return u
+}
+
+// AddBuildSeconds adds v to the "build_seconds" field.
+func (u *TeamUsageDailyUpsert) AddBuildSeconds(v int64) *TeamUsageDailyUpsert {
+	u.Add(teamusagedaily.FieldBuildSeconds, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamUsageDailyUpsert) SetUpdatedAt(v time.Time) *TeamUsageDailyUpsert {
+	u.Set(teamusagedaily.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsert) UpdateUpdatedAt() *TeamUsageDailyUpsert {
+	u.SetExcluded(teamusagedaily.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TeamUsageDaily.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TeamUsageDailyUpsertOne) UpdateNewValues() *TeamUsageDailyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.TeamID(); exists {
+			s.SetIgnore(teamusagedaily.FieldTeamID)
+		}
+		if _, exists := u.create.mutation.Day(); exists {
+			s.SetIgnore(teamusagedaily.FieldDay)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamUsageDaily.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamUsageDailyUpsertOne) Ignore() *TeamUsageDailyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamUsageDailyUpsertOne) DoNothing() *TeamUsageDailyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamUsageDailyCreate.OnConflict
+// documentation for more info.
+func (u *TeamUsageDailyUpsertOne) Update(set func(*TeamUsageDailyUpsert)) *TeamUsageDailyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamUsageDailyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSandboxesStarted sets the "sandboxes_started" field.
+func (u *TeamUsageDailyUpsertOne) SetSandboxesStarted(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetSandboxesStarted(v)
+	})
+}
+
+// AddSandboxesStarted adds v to the "sandboxes_started" field.
+func (u *TeamUsageDailyUpsertOne) AddSandboxesStarted(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddSandboxesStarted(v)
+	})
+}
+
+// UpdateSandboxesStarted sets the "sandboxes_started" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertOne) UpdateSandboxesStarted() *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateSandboxesStarted()
+	})
+}
+
+// SetSandboxesResumed sets the "sandboxes_resumed" field.
+func (u *TeamUsageDailyUpsertOne) SetSandboxesResumed(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetSandboxesResumed(v)
+	})
+}
+
+// AddSandboxesResumed adds v to the "sandboxes_resumed" field.
+func (u *TeamUsageDailyUpsertOne) AddSandboxesResumed(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddSandboxesResumed(v)
+	})
+}
+
+// UpdateSandboxesResumed sets the "sandboxes_resumed" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertOne) UpdateSandboxesResumed() *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateSandboxesResumed()
+	})
+}
+
+// SetResumeP95Ms sets the "resume_p95_ms" field.
+func (u *TeamUsageDailyUpsertOne) SetResumeP95Ms(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetResumeP95Ms(v)
+	})
+}
+
+// AddResumeP95Ms adds v to the "resume_p95_ms" field.
+func (u *TeamUsageDailyUpsertOne) AddResumeP95Ms(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddResumeP95Ms(v)
+	})
+}
+
+// UpdateResumeP95Ms sets the "resume_p95_ms" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertOne) UpdateResumeP95Ms() *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateResumeP95Ms()
+	})
+}
+
+// ClearResumeP95Ms clears the value of the "resume_p95_ms" field.
+func (u *TeamUsageDailyUpsertOne) ClearResumeP95Ms() *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.ClearResumeP95Ms()
+	})
+}
+
+// SetBuilds sets the "builds" field.
+func (u *TeamUsageDailyUpsertOne) SetBuilds(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetBuilds(v)
+	})
+}
+
+// AddBuilds adds v to the "builds" field.
+func (u *TeamUsageDailyUpsertOne) AddBuilds(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddBuilds(v)
+	})
+}
+
+// UpdateBuilds sets the "builds" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertOne) UpdateBuilds() *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateBuilds()
+	})
+}
+
+// SetBuildSeconds sets the "build_seconds" field.
+func (u *TeamUsageDailyUpsertOne) SetBuildSeconds(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetBuildSeconds(v)
+	})
+}
+
+// AddBuildSeconds adds v to the "build_seconds" field.
+func (u *TeamUsageDailyUpsertOne) AddBuildSeconds(v int64) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddBuildSeconds(v)
+	})
+}
+
+// UpdateBuildSeconds sets the "build_seconds" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertOne) UpdateBuildSeconds() *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateBuildSeconds()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamUsageDailyUpsertOne) SetUpdatedAt(v time.Time) *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertOne) UpdateUpdatedAt() *TeamUsageDailyUpsertOne {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamUsageDailyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamUsageDailyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamUsageDailyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamUsageDailyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamUsageDailyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamUsageDailyCreateBulk is the builder for creating many TeamUsageDaily entities in bulk.
+type TeamUsageDailyCreateBulk struct {
+	config
+	err      error
+	builders []*TeamUsageDailyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamUsageDaily entities in the database.
+func (tudcb *TeamUsageDailyCreateBulk) Save(ctx context.Context) ([]*TeamUsageDaily, error) {
+	if tudcb.err != nil {
+		return nil, tudcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tudcb.builders))
+	nodes := make([]*TeamUsageDaily, len(tudcb.builders))
+	mutators := make([]Mutator, len(tudcb.builders))
+	for i := range tudcb.builders {
+		func(i int, root context.Context) {
+			builder := tudcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamUsageDailyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tudcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tudcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tudcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tudcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tudcb *TeamUsageDailyCreateBulk) SaveX(ctx context.Context) []*TeamUsageDaily {
+	v, err := tudcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tudcb *TeamUsageDailyCreateBulk) Exec(ctx context.Context) error {
+	_, err := tudcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tudcb *TeamUsageDailyCreateBulk) ExecX(ctx context.Context) {
+	if err := tudcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamUsageDaily.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamUsageDailyUpsert) {
+//			SetTeamID(v+v).
+//		}).
+//		Exec(ctx)
+func (tudcb *TeamUsageDailyCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamUsageDailyUpsertBulk {
+	tudcb.conflict = opts
+	return &TeamUsageDailyUpsertBulk{
+		create: tudcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamUsageDaily.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tudcb *TeamUsageDailyCreateBulk) OnConflictColumns(columns ...string) *TeamUsageDailyUpsertBulk {
+	tudcb.conflict = append(tudcb.conflict, sql.ConflictColumns(columns...))
+	return &TeamUsageDailyUpsertBulk{
+		create: tudcb,
+	}
+}
+
+// TeamUsageDailyUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamUsageDaily nodes.
+type TeamUsageDailyUpsertBulk struct {
+	create *TeamUsageDailyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamUsageDaily.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TeamUsageDailyUpsertBulk) UpdateNewValues() *TeamUsageDailyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.TeamID(); exists {
+				s.SetIgnore(teamusagedaily.FieldTeamID)
+			}
+			if _, exists := b.mutation.Day(); exists {
+				s.SetIgnore(teamusagedaily.FieldDay)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamUsageDaily.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamUsageDailyUpsertBulk) Ignore() *TeamUsageDailyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamUsageDailyUpsertBulk) DoNothing() *TeamUsageDailyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamUsageDailyCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamUsageDailyUpsertBulk) Update(set func(*TeamUsageDailyUpsert)) *TeamUsageDailyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamUsageDailyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSandboxesStarted sets the "sandboxes_started" field.
+func (u *TeamUsageDailyUpsertBulk) SetSandboxesStarted(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetSandboxesStarted(v)
+	})
+}
+
+// AddSandboxesStarted adds v to the "sandboxes_started" field.
+func (u *TeamUsageDailyUpsertBulk) AddSandboxesStarted(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddSandboxesStarted(v)
+	})
+}
+
+// UpdateSandboxesStarted sets the "sandboxes_started" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertBulk) UpdateSandboxesStarted() *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateSandboxesStarted()
+	})
+}
+
+// SetSandboxesResumed sets the "sandboxes_resumed" field.
+func (u *TeamUsageDailyUpsertBulk) SetSandboxesResumed(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetSandboxesResumed(v)
+	})
+}
+
+// AddSandboxesResumed adds v to the "sandboxes_resumed" field.
+func (u *TeamUsageDailyUpsertBulk) AddSandboxesResumed(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddSandboxesResumed(v)
+	})
+}
+
+// UpdateSandboxesResumed sets the "sandboxes_resumed" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertBulk) UpdateSandboxesResumed() *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateSandboxesResumed()
+	})
+}
+
+// SetResumeP95Ms sets the "resume_p95_ms" field.
+func (u *TeamUsageDailyUpsertBulk) SetResumeP95Ms(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetResumeP95Ms(v)
+	})
+}
+
+// AddResumeP95Ms adds v to the "resume_p95_ms" field.
+func (u *TeamUsageDailyUpsertBulk) AddResumeP95Ms(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddResumeP95Ms(v)
+	})
+}
+
+// UpdateResumeP95Ms sets the "resume_p95_ms" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertBulk) UpdateResumeP95Ms() *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateResumeP95Ms()
+	})
+}
+
+// ClearResumeP95Ms clears the value of the "resume_p95_ms" field.
+func (u *TeamUsageDailyUpsertBulk) ClearResumeP95Ms() *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.ClearResumeP95Ms()
+	})
+}
+
+// SetBuilds sets the "builds" field.
+func (u *TeamUsageDailyUpsertBulk) SetBuilds(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetBuilds(v)
+	})
+}
+
+// AddBuilds adds v to the "builds" field.
+func (u *TeamUsageDailyUpsertBulk) AddBuilds(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddBuilds(v)
+	})
+}
+
+// UpdateBuilds sets the "builds" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertBulk) UpdateBuilds() *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateBuilds()
+	})
+}
+
+// SetBuildSeconds sets the "build_seconds" field.
+func (u *TeamUsageDailyUpsertBulk) SetBuildSeconds(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetBuildSeconds(v)
+	})
+}
+
+// AddBuildSeconds adds v to the "build_seconds" field.
+func (u *TeamUsageDailyUpsertBulk) AddBuildSeconds(v int64) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.AddBuildSeconds(v)
+	})
+}
+
+// UpdateBuildSeconds sets the "build_seconds" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertBulk) UpdateBuildSeconds() *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateBuildSeconds()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamUsageDailyUpsertBulk) SetUpdatedAt(v time.Time) *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamUsageDailyUpsertBulk) UpdateUpdatedAt() *TeamUsageDailyUpsertBulk {
+	return u.Update(func(s *TeamUsageDailyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamUsageDailyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the TeamUsageDailyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamUsageDailyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamUsageDailyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
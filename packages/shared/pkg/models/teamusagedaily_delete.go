@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
+)
+
+// TeamUsageDailyDelete is the builder for deleting a TeamUsageDaily entity.
+type TeamUsageDailyDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamUsageDailyMutation
+}
+
+// Where appends a list predicates to the TeamUsageDailyDelete builder.
+func (tudd *TeamUsageDailyDelete) Where(ps ...predicate.TeamUsageDaily) *TeamUsageDailyDelete {
+	tudd.mutation.Where(ps...)
+	return tudd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tudd *TeamUsageDailyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tudd.sqlExec, tudd.mutation, tudd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tudd *TeamUsageDailyDelete) ExecX(ctx context.Context) int {
+	n, err := tudd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tudd *TeamUsageDailyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teamusagedaily.Table, sqlgraph.NewFieldSpec(teamusagedaily.FieldID, field.TypeInt))
+	_spec.Node.Schema = tudd.schemaConfig.TeamUsageDaily
+	ctx = internal.NewSchemaConfigContext(ctx, tudd.schemaConfig)
+	if ps := tudd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tudd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tudd.mutation.done = true
+	return affected, err
+}
+
+// TeamUsageDailyDeleteOne is the builder for deleting a single TeamUsageDaily entity.
+type TeamUsageDailyDeleteOne struct {
+	tudd *TeamUsageDailyDelete
+}
+
+// Where appends a list predicates to the TeamUsageDailyDelete builder.
+func (tuddo *TeamUsageDailyDeleteOne) Where(ps ...predicate.TeamUsageDaily) *TeamUsageDailyDeleteOne {
+	tuddo.tudd.mutation.Where(ps...)
+	return tuddo
+}
+
+// Exec executes the deletion query.
+func (tuddo *TeamUsageDailyDeleteOne) Exec(ctx context.Context) error {
+	n, err := tuddo.tudd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teamusagedaily.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuddo *TeamUsageDailyDeleteOne) ExecX(ctx context.Context) {
+	if err := tuddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
+)
+
+// TeamUsageDailyQuery is the builder for querying TeamUsageDaily entities.
+type TeamUsageDailyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []teamusagedaily.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TeamUsageDaily
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TeamUsageDailyQuery builder.
+func (tudq *TeamUsageDailyQuery) Where(ps ...predicate.TeamUsageDaily) *TeamUsageDailyQuery {
+	tudq.predicates = append(tudq.predicates, ps...)
+	return tudq
+}
+
+// Limit the number of records to be returned by this query.
+func (tudq *TeamUsageDailyQuery) Limit(limit int) *TeamUsageDailyQuery {
+	tudq.ctx.Limit = &limit
+	return tudq
+}
+
+// Offset to start from.
+func (tudq *TeamUsageDailyQuery) Offset(offset int) *TeamUsageDailyQuery {
+	tudq.ctx.Offset = &offset
+	return tudq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tudq *TeamUsageDailyQuery) Unique(unique bool) *TeamUsageDailyQuery {
+	tudq.ctx.Unique = &unique
+	return tudq
+}
+
+// Order specifies how the records should be ordered.
+func (tudq *TeamUsageDailyQuery) Order(o ...teamusagedaily.OrderOption) *TeamUsageDailyQuery {
+	tudq.order = append(tudq.order, o...)
+	return tudq
+}
+
+// First returns the first TeamUsageDaily entity from the query.
+// Returns a *NotFoundError when no TeamUsageDaily was found.
+func (tudq *TeamUsageDailyQuery) First(ctx context.Context) (*TeamUsageDaily, error) {
+	nodes, err := tudq.Limit(1).All(setContextOp(ctx, tudq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{teamusagedaily.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tudq *TeamUsageDailyQuery) FirstX(ctx context.Context) *TeamUsageDaily {
+	node, err := tudq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TeamUsageDaily ID from the query.
+// Returns a *NotFoundError when no TeamUsageDaily ID was found.
+func (tudq *TeamUsageDailyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tudq.Limit(1).IDs(setContextOp(ctx, tudq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{teamusagedaily.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tudq *TeamUsageDailyQuery) FirstIDX(ctx context.Context) int {
+	id, err := tudq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TeamUsageDaily entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TeamUsageDaily entity is found.
+// Returns a *NotFoundError when no TeamUsageDaily entities are found.
+func (tudq *TeamUsageDailyQuery) Only(ctx context.Context) (*TeamUsageDaily, error) {
+	nodes, err := tudq.Limit(2).All(setContextOp(ctx, tudq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{teamusagedaily.Label}
+	default:
+		return nil, &NotSingularError{teamusagedaily.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tudq *TeamUsageDailyQuery) OnlyX(ctx context.Context) *TeamUsageDaily {
+	node, err := tudq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TeamUsageDaily ID in the query.
+// Returns a *NotSingularError when more than one TeamUsageDaily ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tudq *TeamUsageDailyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tudq.Limit(2).IDs(setContextOp(ctx, tudq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{teamusagedaily.Label}
+	default:
+		err = &NotSingularError{teamusagedaily.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tudq *TeamUsageDailyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tudq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TeamUsageDailies.
+func (tudq *TeamUsageDailyQuery) All(ctx context.Context) ([]*TeamUsageDaily, error) {
+	ctx = setContextOp(ctx, tudq.ctx, "All")
+	if err := tudq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TeamUsageDaily, *TeamUsageDailyQuery]()
+	return withInterceptors[[]*TeamUsageDaily](ctx, tudq, qr, tudq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tudq *TeamUsageDailyQuery) AllX(ctx context.Context) []*TeamUsageDaily {
+	nodes, err := tudq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TeamUsageDaily IDs.
+func (tudq *TeamUsageDailyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tudq.ctx.Unique == nil && tudq.path != nil {
+		tudq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tudq.ctx, "IDs")
+	if err = tudq.Select(teamusagedaily.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tudq *TeamUsageDailyQuery) IDsX(ctx context.Context) []int {
+	ids, err := tudq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tudq *TeamUsageDailyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tudq.ctx, "Count")
+	if err := tudq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tudq, querierCount[*TeamUsageDailyQuery](), tudq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tudq *TeamUsageDailyQuery) CountX(ctx context.Context) int {
+	count, err := tudq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tudq *TeamUsageDailyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tudq.ctx, "Exist")
+	switch _, err := tudq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tudq *TeamUsageDailyQuery) ExistX(ctx context.Context) bool {
+	exist, err := tudq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TeamUsageDailyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tudq *TeamUsageDailyQuery) Clone() *TeamUsageDailyQuery {
+	if tudq == nil {
+		return nil
+	}
+	return &TeamUsageDailyQuery{
+		config:     tudq.config,
+		ctx:        tudq.ctx.Clone(),
+		order:      append([]teamusagedaily.OrderOption{}, tudq.order...),
+		inters:     append([]Interceptor{}, tudq.inters...),
+		predicates: append([]predicate.TeamUsageDaily{}, tudq.predicates...),
+		// clone intermediate query.
+		sql:  tudq.sql.Clone(),
+		path: tudq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TeamID uuid.UUID `json:"team_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TeamUsageDaily.Query().
+//		GroupBy(teamusagedaily.FieldTeamID).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (tudq *TeamUsageDailyQuery) GroupBy(field string, fields ...string) *TeamUsageDailyGroupBy {
+	tudq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TeamUsageDailyGroupBy{build: tudq}
+	grbuild.flds = &tudq.ctx.Fields
+	grbuild.label = teamusagedaily.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TeamID uuid.UUID `json:"team_id,omitempty"`
+//	}
+//
+//	client.TeamUsageDaily.Query().
+//		Select(teamusagedaily.FieldTeamID).
+//		Scan(ctx, &v)
+func (tudq *TeamUsageDailyQuery) Select(fields ...string) *TeamUsageDailySelect {
+	tudq.ctx.Fields = append(tudq.ctx.Fields, fields...)
+	sbuild := &TeamUsageDailySelect{TeamUsageDailyQuery: tudq}
+	sbuild.label = teamusagedaily.Label
+	sbuild.flds, sbuild.scan = &tudq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TeamUsageDailySelect configured with the given aggregations.
+func (tudq *TeamUsageDailyQuery) Aggregate(fns ...AggregateFunc) *TeamUsageDailySelect {
+	return tudq.Select().Aggregate(fns...)
+}
+
+func (tudq *TeamUsageDailyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tudq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tudq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tudq.ctx.Fields {
+		if !teamusagedaily.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if tudq.path != nil {
+		prev, err := tudq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tudq.sql = prev
+	}
+	return nil
+}
+
+func (tudq *TeamUsageDailyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TeamUsageDaily, error) {
+	var (
+		nodes = []*TeamUsageDaily{}
+		_spec = tudq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TeamUsageDaily).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TeamUsageDaily{config: tudq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = tudq.schemaConfig.TeamUsageDaily
+	ctx = internal.NewSchemaConfigContext(ctx, tudq.schemaConfig)
+	if len(tudq.modifiers) > 0 {
+		_spec.Modifiers = tudq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tudq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tudq *TeamUsageDailyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tudq.querySpec()
+	_spec.Node.Schema = tudq.schemaConfig.TeamUsageDaily
+	ctx = internal.NewSchemaConfigContext(ctx, tudq.schemaConfig)
+	if len(tudq.modifiers) > 0 {
+		_spec.Modifiers = tudq.modifiers
+	}
+	_spec.Node.Columns = tudq.ctx.Fields
+	if len(tudq.ctx.Fields) > 0 {
+		_spec.Unique = tudq.ctx.Unique != nil && *tudq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tudq.driver, _spec)
+}
+
+func (tudq *TeamUsageDailyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(teamusagedaily.Table, teamusagedaily.Columns, sqlgraph.NewFieldSpec(teamusagedaily.FieldID, field.TypeInt))
+	_spec.From = tudq.sql
+	if unique := tudq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tudq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tudq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamusagedaily.FieldID)
+		for i := range fields {
+			if fields[i] != teamusagedaily.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tudq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tudq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tudq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tudq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tudq *TeamUsageDailyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tudq.driver.Dialect())
+	t1 := builder.Table(teamusagedaily.Table)
+	columns := tudq.ctx.Fields
+	if len(columns) == 0 {
+		columns = teamusagedaily.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tudq.sql != nil {
+		selector = tudq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tudq.ctx.Unique != nil && *tudq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(tudq.schemaConfig.TeamUsageDaily)
+	ctx = internal.NewSchemaConfigContext(ctx, tudq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range tudq.modifiers {
+		m(selector)
+	}
+	for _, p := range tudq.predicates {
+		p(selector)
+	}
+	for _, p := range tudq.order {
+		p(selector)
+	}
+	if offset := tudq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tudq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tudq *TeamUsageDailyQuery) Modify(modifiers ...func(s *sql.Selector)) *TeamUsageDailySelect {
+	tudq.modifiers = append(tudq.modifiers, modifiers...)
+	return tudq.Select()
+}
+
+// TeamUsageDailyGroupBy is the group-by builder for TeamUsageDaily entities.
+type TeamUsageDailyGroupBy struct {
+	selector
+	build *TeamUsageDailyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tudgb *TeamUsageDailyGroupBy) Aggregate(fns ...AggregateFunc) *TeamUsageDailyGroupBy {
+	tudgb.fns = append(tudgb.fns, fns...)
+	return tudgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tudgb *TeamUsageDailyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tudgb.build.ctx, "GroupBy")
+	if err := tudgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamUsageDailyQuery, *TeamUsageDailyGroupBy](ctx, tudgb.build, tudgb, tudgb.build.inters, v)
+}
+
+func (tudgb *TeamUsageDailyGroupBy) sqlScan(ctx context.Context, root *TeamUsageDailyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tudgb.fns))
+	for _, fn := range tudgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tudgb.flds)+len(tudgb.fns))
+		for _, f := range *tudgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tudgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tudgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TeamUsageDailySelect is the builder for selecting fields of TeamUsageDaily entities.
+type TeamUsageDailySelect struct {
+	*TeamUsageDailyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tuds *TeamUsageDailySelect) Aggregate(fns ...AggregateFunc) *TeamUsageDailySelect {
+	tuds.fns = append(tuds.fns, fns...)
+	return tuds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tuds *TeamUsageDailySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tuds.ctx, "Select")
+	if err := tuds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamUsageDailyQuery, *TeamUsageDailySelect](ctx, tuds.TeamUsageDailyQuery, tuds, tuds.inters, v)
+}
+
+func (tuds *TeamUsageDailySelect) sqlScan(ctx context.Context, root *TeamUsageDailyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tuds.fns))
+	for _, fn := range tuds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tuds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tuds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tuds *TeamUsageDailySelect) Modify(modifiers ...func(s *sql.Selector)) *TeamUsageDailySelect {
+	tuds.modifiers = append(tuds.modifiers, modifiers...)
+	return tuds
+}
@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamusagedaily"
+)
+
+// TeamUsageDailyUpdate is the builder for updating TeamUsageDaily entities.
+type TeamUsageDailyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TeamUsageDailyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TeamUsageDailyUpdate builder.
+func (tudu *TeamUsageDailyUpdate) Where(ps ...predicate.TeamUsageDaily) *TeamUsageDailyUpdate {
+	tudu.mutation.Where(ps...)
+	return tudu
+}
+
+// SetSandboxesStarted sets the "sandboxes_started" field.
+func (tudu *TeamUsageDailyUpdate) SetSandboxesStarted(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.ResetSandboxesStarted()
+	tudu.mutation.SetSandboxesStarted(i)
+	return tudu
+}
+
+// SetNillableSandboxesStarted sets the "sandboxes_started" field if the given value is not nil.
+func (tudu *TeamUsageDailyUpdate) SetNillableSandboxesStarted(i *int64) *TeamUsageDailyUpdate {
+	if i != nil {
+		tudu.SetSandboxesStarted(*i)
+	}
+	return tudu
+}
+
+// AddSandboxesStarted adds i to the "sandboxes_started" field.
+func (tudu *TeamUsageDailyUpdate) AddSandboxesStarted(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.AddSandboxesStarted(i)
+	return tudu
+}
+
+// SetSandboxesResumed sets the "sandboxes_resumed" field.
+func (tudu *TeamUsageDailyUpdate) SetSandboxesResumed(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.ResetSandboxesResumed()
+	tudu.mutation.SetSandboxesResumed(i)
+	return tudu
+}
+
+// SetNillableSandboxesResumed sets the "sandboxes_resumed" field if the given value is not nil.
+func (tudu *TeamUsageDailyUpdate) SetNillableSandboxesResumed(i *int64) *TeamUsageDailyUpdate {
+	if i != nil {
+		tudu.SetSandboxesResumed(*i)
+	}
+	return tudu
+}
+
+// AddSandboxesResumed adds i to the "sandboxes_resumed" field.
+func (tudu *TeamUsageDailyUpdate) AddSandboxesResumed(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.AddSandboxesResumed(i)
+	return tudu
+}
+
+// SetResumeP95Ms sets the "resume_p95_ms" field.
+func (tudu *TeamUsageDailyUpdate) SetResumeP95Ms(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.ResetResumeP95Ms()
+	tudu.mutation.SetResumeP95Ms(i)
+	return tudu
+}
+
+// SetNillableResumeP95Ms sets the "resume_p95_ms" field if the given value is not nil.
+func (tudu *TeamUsageDailyUpdate) SetNillableResumeP95Ms(i *int64) *TeamUsageDailyUpdate {
+	if i != nil {
+		tudu.SetResumeP95Ms(*i)
+	}
+	return tudu
+}
+
+// AddResumeP95Ms adds i to the "resume_p95_ms" field.
+func (tudu *TeamUsageDailyUpdate) AddResumeP95Ms(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.AddResumeP95Ms(i)
+	return tudu
+}
+
+// ClearResumeP95Ms clears the value of the "resume_p95_ms" field.
+func (tudu *TeamUsageDailyUpdate) ClearResumeP95Ms() *TeamUsageDailyUpdate {
+	tudu.mutation.ClearResumeP95Ms()
+	return tudu
+}
+
+// SetBuilds sets the "builds" field.
+func (tudu *TeamUsageDailyUpdate) SetBuilds(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.ResetBuilds()
+	tudu.mutation.SetBuilds(i)
+	return tudu
+}
+
+// SetNillableBuilds sets the "builds" field if the given value is not nil.
+func (tudu *TeamUsageDailyUpdate) SetNillableBuilds(i *int64) *TeamUsageDailyUpdate {
+	if i != nil {
+		tudu.SetBuilds(*i)
+	}
+	return tudu
+}
+
+// AddBuilds adds i to the "builds" field.
+func (tudu *TeamUsageDailyUpdate) AddBuilds(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.AddBuilds(i)
+	return tudu
+}
+
+// SetBuildSeconds sets the "build_seconds" field.
+func (tudu *TeamUsageDailyUpdate) SetBuildSeconds(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.ResetBuildSeconds()
+	tudu.mutation.SetBuildSeconds(i)
+	return tudu
+}
+
+// SetNillableBuildSeconds sets the "build_seconds" field if the given value is not nil.
+func (tudu *TeamUsageDailyUpdate) SetNillableBuildSeconds(i *int64) *TeamUsageDailyUpdate {
+	if i != nil {
+		tudu.SetBuildSeconds(*i)
+	}
+	return tudu
+}
+
+// AddBuildSeconds adds i to the "build_seconds" field.
+func (tudu *TeamUsageDailyUpdate) AddBuildSeconds(i int64) *TeamUsageDailyUpdate {
+	tudu.mutation.AddBuildSeconds(i)
+	return tudu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tudu *TeamUsageDailyUpdate) SetUpdatedAt(t time.Time) *TeamUsageDailyUpdate {
+	tudu.mutation.SetUpdatedAt(t)
+	return tudu
+}
+
+// Mutation returns the TeamUsageDailyMutation object of the builder.
+func (tudu *TeamUsageDailyUpdate) Mutation() *TeamUsageDailyMutation {
+	return tudu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tudu *TeamUsageDailyUpdate) Save(ctx context.Context) (int, error) {
+	tudu.defaults()
+	return withHooks(ctx, tudu.sqlSave, tudu.mutation, tudu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tudu *TeamUsageDailyUpdate) SaveX(ctx context.Context) int {
+	affected, err := tudu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tudu *TeamUsageDailyUpdate) Exec(ctx context.Context) error {
+	_, err := tudu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tudu *TeamUsageDailyUpdate) ExecX(ctx context.Context) {
+	if err := tudu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tudu *TeamUsageDailyUpdate) defaults() {
+	if _, ok := tudu.mutation.UpdatedAt(); !ok {
+		v := teamusagedaily.UpdateDefaultUpdatedAt()
+		tudu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tudu *TeamUsageDailyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamUsageDailyUpdate {
+	tudu.modifiers = append(tudu.modifiers, modifiers...)
+	return tudu
+}
+
+func (tudu *TeamUsageDailyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamusagedaily.Table, teamusagedaily.Columns, sqlgraph.NewFieldSpec(teamusagedaily.FieldID, field.TypeInt))
+	if ps := tudu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tudu.mutation.SandboxesStarted(); ok {
+		_spec.SetField(teamusagedaily.FieldSandboxesStarted, field.TypeInt64, value)
+	}
+	if value, ok := tudu.mutation.AddedSandboxesStarted(); ok {
+		_spec.AddField(teamusagedaily.FieldSandboxesStarted, field.TypeInt64, value)
+	}
+	if value, ok := tudu.mutation.SandboxesResumed(); ok {
+		_spec.SetField(teamusagedaily.FieldSandboxesResumed, field.TypeInt64, value)
+	}
+	if value, ok := tudu.mutation.AddedSandboxesResumed(); ok {
+		_spec.AddField(teamusagedaily.FieldSandboxesResumed, field.TypeInt64, value)
+	}
+	if value, ok := tudu.mutation.ResumeP95Ms(); ok {
+		_spec.SetField(teamusagedaily.FieldResumeP95Ms, field.TypeInt64, value)
+	}
+	if value, ok := tudu.mutation.AddedResumeP95Ms(); ok {
+		_spec.AddField(teamusagedaily.FieldResumeP95Ms, field.TypeInt64, value)
+	}
+	if tudu.mutation.ResumeP95MsCleared() {
+		_spec.ClearField(teamusagedaily.FieldResumeP95Ms, field.TypeInt64)
+	}
+	if value, ok := tudu.mutation.Builds(); ok {
+		_spec.SetField(teamusagedaily.FieldBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tudu.mutation.AddedBuilds(); ok {
+		_spec.AddField(teamusagedaily.FieldBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tudu.mutation.BuildSeconds(); ok {
+		_spec.SetField(teamusagedaily.FieldBuildSeconds, field.TypeInt64, value)
+	}
+	if value, ok := tudu.mutation.AddedBuildSeconds(); ok {
+		_spec.AddField(teamusagedaily.FieldBuildSeconds, field.TypeInt64, value)
+	}
+	if value, ok := tudu.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamusagedaily.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = tudu.schemaConfig.TeamUsageDaily
+	ctx = internal.NewSchemaConfigContext(ctx, tudu.schemaConfig)
+	_spec.AddModifiers(tudu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tudu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamusagedaily.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tudu.mutation.done = true
+	return n, nil
+}
+
+// TeamUsageDailyUpdateOne is the builder for updating a single TeamUsageDaily entity.
+type TeamUsageDailyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TeamUsageDailyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetSandboxesStarted sets the "sandboxes_started" field.
+func (tuduo *TeamUsageDailyUpdateOne) SetSandboxesStarted(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.ResetSandboxesStarted()
+	tuduo.mutation.SetSandboxesStarted(i)
+	return tuduo
+}
+
+// SetNillableSandboxesStarted sets the "sandboxes_started" field if the given value is not nil.
+func (tuduo *TeamUsageDailyUpdateOne) SetNillableSandboxesStarted(i *int64) *TeamUsageDailyUpdateOne {
+	if i != nil {
+		tuduo.SetSandboxesStarted(*i)
+	}
+	return tuduo
+}
+
+// AddSandboxesStarted adds i to the "sandboxes_started" field.
+func (tuduo *TeamUsageDailyUpdateOne) AddSandboxesStarted(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.AddSandboxesStarted(i)
+	return tuduo
+}
+
+// SetSandboxesResumed sets the "sandboxes_resumed" field.
+func (tuduo *TeamUsageDailyUpdateOne) SetSandboxesResumed(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.ResetSandboxesResumed()
+	tuduo.mutation.SetSandboxesResumed(i)
+	return tuduo
+}
+
+// SetNillableSandboxesResumed sets the "sandboxes_resumed" field if the given value is not nil.
+func (tuduo *TeamUsageDailyUpdateOne) SetNillableSandboxesResumed(i *int64) *TeamUsageDailyUpdateOne {
+	if i != nil {
+		tuduo.SetSandboxesResumed(*i)
+	}
+	return tuduo
+}
+
+// AddSandboxesResumed adds i to the "sandboxes_resumed" field.
+func (tuduo *TeamUsageDailyUpdateOne) AddSandboxesResumed(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.AddSandboxesResumed(i)
+	return tuduo
+}
+
+// SetResumeP95Ms sets the "resume_p95_ms" field.
+func (tuduo *TeamUsageDailyUpdateOne) SetResumeP95Ms(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.ResetResumeP95Ms()
+	tuduo.mutation.SetResumeP95Ms(i)
+	return tuduo
+}
+
+// SetNillableResumeP95Ms sets the "resume_p95_ms" field if the given value is not nil.
+func (tuduo *TeamUsageDailyUpdateOne) SetNillableResumeP95Ms(i *int64) *TeamUsageDailyUpdateOne {
+	if i != nil {
+		tuduo.SetResumeP95Ms(*i)
+	}
+	return tuduo
+}
+
+// AddResumeP95Ms adds i to the "resume_p95_ms" field.
+func (tuduo *TeamUsageDailyUpdateOne) AddResumeP95Ms(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.AddResumeP95Ms(i)
+	return tuduo
+}
+
+// ClearResumeP95Ms clears the value of the "resume_p95_ms" field.
+func (tuduo *TeamUsageDailyUpdateOne) ClearResumeP95Ms() *TeamUsageDailyUpdateOne {
+	tuduo.mutation.ClearResumeP95Ms()
+	return tuduo
+}
+
+// SetBuilds sets the "builds" field.
+func (tuduo *TeamUsageDailyUpdateOne) SetBuilds(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.ResetBuilds()
+	tuduo.mutation.SetBuilds(i)
+	return tuduo
+}
+
+// SetNillableBuilds sets the "builds" field if the given value is not nil.
+func (tuduo *TeamUsageDailyUpdateOne) SetNillableBuilds(i *int64) *TeamUsageDailyUpdateOne {
+	if i != nil {
+		tuduo.SetBuilds(*i)
+	}
+	return tuduo
+}
+
+// AddBuilds adds i to the "builds" field.
+func (tuduo *TeamUsageDailyUpdateOne) AddBuilds(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.AddBuilds(i)
+	return tuduo
+}
+
+// SetBuildSeconds sets the "build_seconds" field.
+func (tuduo *TeamUsageDailyUpdateOne) SetBuildSeconds(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.ResetBuildSeconds()
+	tuduo.mutation.SetBuildSeconds(i)
+	return tuduo
+}
+
+// SetNillableBuildSeconds sets the "build_seconds" field if the given value is not nil.
+func (tuduo *TeamUsageDailyUpdateOne) SetNillableBuildSeconds(i *int64) *TeamUsageDailyUpdateOne {
+	if i != nil {
+		tuduo.SetBuildSeconds(*i)
+	}
+	return tuduo
+}
+
+// AddBuildSeconds adds i to the "build_seconds" field.
+func (tuduo *TeamUsageDailyUpdateOne) AddBuildSeconds(i int64) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.AddBuildSeconds(i)
+	return tuduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuduo *TeamUsageDailyUpdateOne) SetUpdatedAt(t time.Time) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.SetUpdatedAt(t)
+	return tuduo
+}
+
+// Mutation returns the TeamUsageDailyMutation object of the builder.
+func (tuduo *TeamUsageDailyUpdateOne) Mutation() *TeamUsageDailyMutation {
+	return tuduo.mutation
+}
+
+// Where appends a list predicates to the TeamUsageDailyUpdate builder.
+func (tuduo *TeamUsageDailyUpdateOne) Where(ps ...predicate.TeamUsageDaily) *TeamUsageDailyUpdateOne {
+	tuduo.mutation.Where(ps...)
+	return tuduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuduo *TeamUsageDailyUpdateOne) Select(field string, fields ...string) *TeamUsageDailyUpdateOne {
+	tuduo.fields = append([]string{field}, fields...)
+	return tuduo
+}
+
+// Save executes the query and returns the updated TeamUsageDaily entity.
+func (tuduo *TeamUsageDailyUpdateOne) Save(ctx context.Context) (*TeamUsageDaily, error) {
+	tuduo.defaults()
+	return withHooks(ctx, tuduo.sqlSave, tuduo.mutation, tuduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuduo *TeamUsageDailyUpdateOne) SaveX(ctx context.Context) *TeamUsageDaily {
+	node, err := tuduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuduo *TeamUsageDailyUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuduo *TeamUsageDailyUpdateOne) ExecX(ctx context.Context) {
+	if err := tuduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tuduo *TeamUsageDailyUpdateOne) defaults() {
+	if _, ok := tuduo.mutation.UpdatedAt(); !ok {
+		v := teamusagedaily.UpdateDefaultUpdatedAt()
+		tuduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tuduo *TeamUsageDailyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamUsageDailyUpdateOne {
+	tuduo.modifiers = append(tuduo.modifiers, modifiers...)
+	return tuduo
+}
+
+func (tuduo *TeamUsageDailyUpdateOne) sqlSave(ctx context.Context) (_node *TeamUsageDaily, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamusagedaily.Table, teamusagedaily.Columns, sqlgraph.NewFieldSpec(teamusagedaily.FieldID, field.TypeInt))
+	id, ok := tuduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "TeamUsageDaily.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamusagedaily.FieldID)
+		for _, f := range fields {
+			if !teamusagedaily.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != teamusagedaily.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuduo.mutation.SandboxesStarted(); ok {
+		_spec.SetField(teamusagedaily.FieldSandboxesStarted, field.TypeInt64, value)
+	}
+	if value, ok := tuduo.mutation.AddedSandboxesStarted(); ok {
+		_spec.AddField(teamusagedaily.FieldSandboxesStarted, field.TypeInt64, value)
+	}
+	if value, ok := tuduo.mutation.SandboxesResumed(); ok {
+		_spec.SetField(teamusagedaily.FieldSandboxesResumed, field.TypeInt64, value)
+	}
+	if value, ok := tuduo.mutation.AddedSandboxesResumed(); ok {
+		_spec.AddField(teamusagedaily.FieldSandboxesResumed, field.TypeInt64, value)
+	}
+	if value, ok := tuduo.mutation.ResumeP95Ms(); ok {
+		_spec.SetField(teamusagedaily.FieldResumeP95Ms, field.TypeInt64, value)
+	}
+	if value, ok := tuduo.mutation.AddedResumeP95Ms(); ok {
+		_spec.AddField(teamusagedaily.FieldResumeP95Ms, field.TypeInt64, value)
+	}
+	if tuduo.mutation.ResumeP95MsCleared() {
+		_spec.ClearField(teamusagedaily.FieldResumeP95Ms, field.TypeInt64)
+	}
+	if value, ok := tuduo.mutation.Builds(); ok {
+		_spec.SetField(teamusagedaily.FieldBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tuduo.mutation.AddedBuilds(); ok {
+		_spec.AddField(teamusagedaily.FieldBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tuduo.mutation.BuildSeconds(); ok {
+		_spec.SetField(teamusagedaily.FieldBuildSeconds, field.TypeInt64, value)
+	}
+	if value, ok := tuduo.mutation.AddedBuildSeconds(); ok {
+		_spec.AddField(teamusagedaily.FieldBuildSeconds, field.TypeInt64, value)
+	}
+	if value, ok := tuduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(teamusagedaily.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = tuduo.schemaConfig.TeamUsageDaily
+	ctx = internal.NewSchemaConfigContext(ctx, tuduo.schemaConfig)
+	_spec.AddModifiers(tuduo.modifiers...)
+	_node = &TeamUsageDaily{config: tuduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamusagedaily.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuduo.mutation.done = true
+	return _node, nil
+}
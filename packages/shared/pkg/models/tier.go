@@ -24,6 +24,12 @@ type Tier struct {
 	ConcurrentInstances int64 `json:"concurrent_instances,omitempty"`
 	// MaxLengthHours holds the value of the "max_length_hours" field.
 	MaxLengthHours int64 `json:"max_length_hours,omitempty"`
+	// VcpuHourPriceUsd holds the value of the "vcpu_hour_price_usd" field.
+	VcpuHourPriceUsd float64 `json:"vcpu_hour_price_usd,omitempty"`
+	// RAMGibHourPriceUsd holds the value of the "ram_gib_hour_price_usd" field.
+	RAMGibHourPriceUsd float64 `json:"ram_gib_hour_price_usd,omitempty"`
+	// Whether the team can start the high priority sandboxes that preempt the low priority ones
+	HighPriority bool `json:"high_priority,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TierQuery when eager-loading is set.
 	Edges        TierEdges `json:"edges"`
@@ -53,6 +59,10 @@ func (*Tier) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case tier.FieldHighPriority:
+			values[i] = new(sql.NullBool)
+		case tier.FieldVcpuHourPriceUsd, tier.FieldRAMGibHourPriceUsd:
+			values[i] = new(sql.NullFloat64)
 		case tier.FieldDiskMB, tier.FieldConcurrentInstances, tier.FieldMaxLengthHours:
 			values[i] = new(sql.NullInt64)
 		case tier.FieldID, tier.FieldName:
@@ -102,6 +112,24 @@ func (t *Tier) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.MaxLengthHours = value.Int64
 			}
+		case tier.FieldVcpuHourPriceUsd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field vcpu_hour_price_usd", values[i])
+			} else if value.Valid {
+				t.VcpuHourPriceUsd = value.Float64
+			}
+		case tier.FieldRAMGibHourPriceUsd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ram_gib_hour_price_usd", values[i])
+			} else if value.Valid {
+				t.RAMGibHourPriceUsd = value.Float64
+			}
+		case tier.FieldHighPriority:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field high_priority", values[i])
+			} else if value.Valid {
+				t.HighPriority = value.Bool
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -154,6 +182,15 @@ func (t *Tier) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("max_length_hours=")
 	builder.WriteString(fmt.Sprintf("%v", t.MaxLengthHours))
+	builder.WriteString(", ")
+	builder.WriteString("vcpu_hour_price_usd=")
+	builder.WriteString(fmt.Sprintf("%v", t.VcpuHourPriceUsd))
+	builder.WriteString(", ")
+	builder.WriteString("ram_gib_hour_price_usd=")
+	builder.WriteString(fmt.Sprintf("%v", t.RAMGibHourPriceUsd))
+	builder.WriteString(", ")
+	builder.WriteString("high_priority=")
+	builder.WriteString(fmt.Sprintf("%v", t.HighPriority))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -20,6 +20,12 @@ const (
 	FieldConcurrentInstances = "concurrent_instances"
 	// FieldMaxLengthHours holds the string denoting the max_length_hours field in the database.
 	FieldMaxLengthHours = "max_length_hours"
+	// FieldVcpuHourPriceUsd holds the string denoting the vcpu_hour_price_usd field in the database.
+	FieldVcpuHourPriceUsd = "vcpu_hour_price_usd"
+	// FieldRAMGibHourPriceUsd holds the string denoting the ram_gib_hour_price_usd field in the database.
+	FieldRAMGibHourPriceUsd = "ram_gib_hour_price_usd"
+	// FieldHighPriority holds the string denoting the high_priority field in the database.
+	FieldHighPriority = "high_priority"
 	// EdgeTeams holds the string denoting the teams edge name in mutations.
 	EdgeTeams = "teams"
 	// Table holds the table name of the tier in the database.
@@ -40,6 +46,9 @@ var Columns = []string{
 	FieldDiskMB,
 	FieldConcurrentInstances,
 	FieldMaxLengthHours,
+	FieldVcpuHourPriceUsd,
+	FieldRAMGibHourPriceUsd,
+	FieldHighPriority,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -52,6 +61,15 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultVcpuHourPriceUsd holds the default value on creation for the "vcpu_hour_price_usd" field.
+	DefaultVcpuHourPriceUsd float64
+	// DefaultRAMGibHourPriceUsd holds the default value on creation for the "ram_gib_hour_price_usd" field.
+	DefaultRAMGibHourPriceUsd float64
+	// DefaultHighPriority holds the default value on creation for the "high_priority" field.
+	DefaultHighPriority bool
+)
+
 // OrderOption defines the ordering options for the Tier queries.
 type OrderOption func(*sql.Selector)
 
@@ -80,6 +98,21 @@ func ByMaxLengthHours(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxLengthHours, opts...).ToFunc()
 }
 
+// ByVcpuHourPriceUsd orders the results by the vcpu_hour_price_usd field.
+func ByVcpuHourPriceUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVcpuHourPriceUsd, opts...).ToFunc()
+}
+
+// ByRAMGibHourPriceUsd orders the results by the ram_gib_hour_price_usd field.
+func ByRAMGibHourPriceUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRAMGibHourPriceUsd, opts...).ToFunc()
+}
+
+// ByHighPriority orders the results by the high_priority field.
+func ByHighPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHighPriority, opts...).ToFunc()
+}
+
 // ByTeamsCount orders the results by teams count.
 func ByTeamsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -84,6 +84,21 @@ func MaxLengthHours(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldMaxLengthHours, v))
 }
 
+// VcpuHourPriceUsd applies equality check predicate on the "vcpu_hour_price_usd" field. It's identical to VcpuHourPriceUsdEQ.
+func VcpuHourPriceUsd(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldVcpuHourPriceUsd, v))
+}
+
+// RAMGibHourPriceUsd applies equality check predicate on the "ram_gib_hour_price_usd" field. It's identical to RAMGibHourPriceUsdEQ.
+func RAMGibHourPriceUsd(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldRAMGibHourPriceUsd, v))
+}
+
+// HighPriority applies equality check predicate on the "high_priority" field. It's identical to HighPriorityEQ.
+func HighPriority(v bool) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldHighPriority, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldName, v))
@@ -269,6 +284,96 @@ func MaxLengthHoursLTE(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldLTE(FieldMaxLengthHours, v))
 }
 
+// VcpuHourPriceUsdEQ applies the EQ predicate on the "vcpu_hour_price_usd" field.
+func VcpuHourPriceUsdEQ(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldVcpuHourPriceUsd, v))
+}
+
+// VcpuHourPriceUsdNEQ applies the NEQ predicate on the "vcpu_hour_price_usd" field.
+func VcpuHourPriceUsdNEQ(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldVcpuHourPriceUsd, v))
+}
+
+// VcpuHourPriceUsdIn applies the In predicate on the "vcpu_hour_price_usd" field.
+func VcpuHourPriceUsdIn(vs ...float64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldVcpuHourPriceUsd, vs...))
+}
+
+// VcpuHourPriceUsdNotIn applies the NotIn predicate on the "vcpu_hour_price_usd" field.
+func VcpuHourPriceUsdNotIn(vs ...float64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldVcpuHourPriceUsd, vs...))
+}
+
+// VcpuHourPriceUsdGT applies the GT predicate on the "vcpu_hour_price_usd" field.
+func VcpuHourPriceUsdGT(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldVcpuHourPriceUsd, v))
+}
+
+// VcpuHourPriceUsdGTE applies the GTE predicate on the "vcpu_hour_price_usd" field.
+func VcpuHourPriceUsdGTE(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldVcpuHourPriceUsd, v))
+}
+
+// VcpuHourPriceUsdLT applies the LT predicate on the "vcpu_hour_price_usd" field.
+func VcpuHourPriceUsdLT(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldVcpuHourPriceUsd, v))
+}
+
+// VcpuHourPriceUsdLTE applies the LTE predicate on the "vcpu_hour_price_usd" field.
+func VcpuHourPriceUsdLTE(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldVcpuHourPriceUsd, v))
+}
+
+// RAMGibHourPriceUsdEQ applies the EQ predicate on the "ram_gib_hour_price_usd" field.
+func RAMGibHourPriceUsdEQ(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldRAMGibHourPriceUsd, v))
+}
+
+// RAMGibHourPriceUsdNEQ applies the NEQ predicate on the "ram_gib_hour_price_usd" field.
+func RAMGibHourPriceUsdNEQ(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldRAMGibHourPriceUsd, v))
+}
+
+// RAMGibHourPriceUsdIn applies the In predicate on the "ram_gib_hour_price_usd" field.
+func RAMGibHourPriceUsdIn(vs ...float64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldRAMGibHourPriceUsd, vs...))
+}
+
+// RAMGibHourPriceUsdNotIn applies the NotIn predicate on the "ram_gib_hour_price_usd" field.
+func RAMGibHourPriceUsdNotIn(vs ...float64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldRAMGibHourPriceUsd, vs...))
+}
+
+// RAMGibHourPriceUsdGT applies the GT predicate on the "ram_gib_hour_price_usd" field.
+func RAMGibHourPriceUsdGT(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldRAMGibHourPriceUsd, v))
+}
+
+// RAMGibHourPriceUsdGTE applies the GTE predicate on the "ram_gib_hour_price_usd" field.
+func RAMGibHourPriceUsdGTE(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldRAMGibHourPriceUsd, v))
+}
+
+// RAMGibHourPriceUsdLT applies the LT predicate on the "ram_gib_hour_price_usd" field.
+func RAMGibHourPriceUsdLT(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldRAMGibHourPriceUsd, v))
+}
+
+// RAMGibHourPriceUsdLTE applies the LTE predicate on the "ram_gib_hour_price_usd" field.
+func RAMGibHourPriceUsdLTE(v float64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldRAMGibHourPriceUsd, v))
+}
+
+// HighPriorityEQ applies the EQ predicate on the "high_priority" field.
+func HighPriorityEQ(v bool) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldHighPriority, v))
+}
+
+// HighPriorityNEQ applies the NEQ predicate on the "high_priority" field.
+func HighPriorityNEQ(v bool) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldHighPriority, v))
+}
+
 // HasTeams applies the HasEdge predicate on the "teams" edge.
 func HasTeams() predicate.Tier {
 	return predicate.Tier(func(s *sql.Selector) {
@@ -48,6 +48,48 @@ func (tc *TierCreate) SetMaxLengthHours(i int64) *TierCreate {
 	return tc
 }
 
+// SetVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field.
+func (tc *TierCreate) SetVcpuHourPriceUsd(f float64) *TierCreate {
+	tc.mutation.SetVcpuHourPriceUsd(f)
+	return tc
+}
+
+// SetNillableVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field if the given value is not nil.
+func (tc *TierCreate) SetNillableVcpuHourPriceUsd(f *float64) *TierCreate {
+	if f != nil {
+		tc.SetVcpuHourPriceUsd(*f)
+	}
+	return tc
+}
+
+// SetRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field.
+func (tc *TierCreate) SetRAMGibHourPriceUsd(f float64) *TierCreate {
+	tc.mutation.SetRAMGibHourPriceUsd(f)
+	return tc
+}
+
+// SetNillableRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field if the given value is not nil.
+func (tc *TierCreate) SetNillableRAMGibHourPriceUsd(f *float64) *TierCreate {
+	if f != nil {
+		tc.SetRAMGibHourPriceUsd(*f)
+	}
+	return tc
+}
+
+// SetHighPriority sets the "high_priority" field.
+func (tc *TierCreate) SetHighPriority(b bool) *TierCreate {
+	tc.mutation.SetHighPriority(b)
+	return tc
+}
+
+// SetNillableHighPriority sets the "high_priority" field if the given value is not nil.
+func (tc *TierCreate) SetNillableHighPriority(b *bool) *TierCreate {
+	if b != nil {
+		tc.SetHighPriority(*b)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TierCreate) SetID(s string) *TierCreate {
 	tc.mutation.SetID(s)
@@ -76,6 +118,7 @@ func (tc *TierCreate) Mutation() *TierMutation {
 
 // Save creates the Tier in the database.
 func (tc *TierCreate) Save(ctx context.Context) (*Tier, error) {
+	tc.defaults()
 	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
 }
 
@@ -101,6 +144,22 @@ func (tc *TierCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (tc *TierCreate) defaults() {
+	if _, ok := tc.mutation.VcpuHourPriceUsd(); !ok {
+		v := tier.DefaultVcpuHourPriceUsd
+		tc.mutation.SetVcpuHourPriceUsd(v)
+	}
+	if _, ok := tc.mutation.RAMGibHourPriceUsd(); !ok {
+		v := tier.DefaultRAMGibHourPriceUsd
+		tc.mutation.SetRAMGibHourPriceUsd(v)
+	}
+	if _, ok := tc.mutation.HighPriority(); !ok {
+		v := tier.DefaultHighPriority
+		tc.mutation.SetHighPriority(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (tc *TierCreate) check() error {
 	if _, ok := tc.mutation.Name(); !ok {
@@ -115,6 +174,15 @@ func (tc *TierCreate) check() error {
 	if _, ok := tc.mutation.MaxLengthHours(); !ok {
 		return &ValidationError{Name: "max_length_hours", err: errors.New(`models: missing required field "Tier.max_length_hours"`)}
 	}
+	if _, ok := tc.mutation.VcpuHourPriceUsd(); !ok {
+		return &ValidationError{Name: "vcpu_hour_price_usd", err: errors.New(`models: missing required field "Tier.vcpu_hour_price_usd"`)}
+	}
+	if _, ok := tc.mutation.RAMGibHourPriceUsd(); !ok {
+		return &ValidationError{Name: "ram_gib_hour_price_usd", err: errors.New(`models: missing required field "Tier.ram_gib_hour_price_usd"`)}
+	}
+	if _, ok := tc.mutation.HighPriority(); !ok {
+		return &ValidationError{Name: "high_priority", err: errors.New(`models: missing required field "Tier.high_priority"`)}
+	}
 	return nil
 }
 
@@ -168,6 +236,18 @@ func (tc *TierCreate) createSpec() (*Tier, *sqlgraph.CreateSpec) {
 		_spec.SetField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 		_node.MaxLengthHours = value
 	}
+	if value, ok := tc.mutation.VcpuHourPriceUsd(); ok {
+		_spec.SetField(tier.FieldVcpuHourPriceUsd, field.TypeFloat64, value)
+		_node.VcpuHourPriceUsd = value
+	}
+	if value, ok := tc.mutation.RAMGibHourPriceUsd(); ok {
+		_spec.SetField(tier.FieldRAMGibHourPriceUsd, field.TypeFloat64, value)
+		_node.RAMGibHourPriceUsd = value
+	}
+	if value, ok := tc.mutation.HighPriority(); ok {
+		_spec.SetField(tier.FieldHighPriority, field.TypeBool, value)
+		_node.HighPriority = value
+	}
 	if nodes := tc.mutation.TeamsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -303,6 +383,54 @@ func (u *TierUpsert) AddMaxLengthHours(v int64) *TierUpsert {
 	return u
 }
 
+// SetVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field.
+func (u *TierUpsert) SetVcpuHourPriceUsd(v float64) *TierUpsert {
+	u.Set(tier.FieldVcpuHourPriceUsd, v)
+	return u
+}
+
+// UpdateVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field to the value that was provided on create.
+func (u *TierUpsert) UpdateVcpuHourPriceUsd() *TierUpsert {
+	u.SetExcluded(tier.FieldVcpuHourPriceUsd)
+	return u
+}
+
+// AddVcpuHourPriceUsd adds v to the "vcpu_hour_price_usd" field.
+func (u *TierUpsert) AddVcpuHourPriceUsd(v float64) *TierUpsert {
+	u.Add(tier.FieldVcpuHourPriceUsd, v)
+	return u
+}
+
+// SetRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field.
+func (u *TierUpsert) SetRAMGibHourPriceUsd(v float64) *TierUpsert {
+	u.Set(tier.FieldRAMGibHourPriceUsd, v)
+	return u
+}
+
+// UpdateRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field to the value that was provided on create.
+func (u *TierUpsert) UpdateRAMGibHourPriceUsd() *TierUpsert {
+	u.SetExcluded(tier.FieldRAMGibHourPriceUsd)
+	return u
+}
+
+// AddRAMGibHourPriceUsd adds v to the "ram_gib_hour_price_usd" field.
+func (u *TierUpsert) AddRAMGibHourPriceUsd(v float64) *TierUpsert {
+	u.Add(tier.FieldRAMGibHourPriceUsd, v)
+	return u
+}
+
+// SetHighPriority sets the "high_priority" field.
+func (u *TierUpsert) SetHighPriority(v bool) *TierUpsert {
+	u.Set(tier.FieldHighPriority, v)
+	return u
+}
+
+// UpdateHighPriority sets the "high_priority" field to the value that was provided on create.
+func (u *TierUpsert) UpdateHighPriority() *TierUpsert {
+	u.SetExcluded(tier.FieldHighPriority)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -428,6 +556,62 @@ func (u *TierUpsertOne) UpdateMaxLengthHours() *TierUpsertOne {
 	})
 }
 
+// SetVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field.
+func (u *TierUpsertOne) SetVcpuHourPriceUsd(v float64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetVcpuHourPriceUsd(v)
+	})
+}
+
+// AddVcpuHourPriceUsd adds v to the "vcpu_hour_price_usd" field.
+func (u *TierUpsertOne) AddVcpuHourPriceUsd(v float64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddVcpuHourPriceUsd(v)
+	})
+}
+
+// UpdateVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateVcpuHourPriceUsd() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateVcpuHourPriceUsd()
+	})
+}
+
+// SetRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field.
+func (u *TierUpsertOne) SetRAMGibHourPriceUsd(v float64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetRAMGibHourPriceUsd(v)
+	})
+}
+
+// AddRAMGibHourPriceUsd adds v to the "ram_gib_hour_price_usd" field.
+func (u *TierUpsertOne) AddRAMGibHourPriceUsd(v float64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddRAMGibHourPriceUsd(v)
+	})
+}
+
+// UpdateRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateRAMGibHourPriceUsd() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateRAMGibHourPriceUsd()
+	})
+}
+
+// SetHighPriority sets the "high_priority" field.
+func (u *TierUpsertOne) SetHighPriority(v bool) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetHighPriority(v)
+	})
+}
+
+// UpdateHighPriority sets the "high_priority" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateHighPriority() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateHighPriority()
+	})
+}
+
 // Exec executes the query.
 func (u *TierUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -485,6 +669,7 @@ func (tcb *TierCreateBulk) Save(ctx context.Context) ([]*Tier, error) {
 	for i := range tcb.builders {
 		func(i int, root context.Context) {
 			builder := tcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*TierMutation)
 				if !ok {
@@ -719,6 +904,62 @@ func (u *TierUpsertBulk) UpdateMaxLengthHours() *TierUpsertBulk {
 	})
 }
 
+// SetVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field.
+func (u *TierUpsertBulk) SetVcpuHourPriceUsd(v float64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetVcpuHourPriceUsd(v)
+	})
+}
+
+// AddVcpuHourPriceUsd adds v to the "vcpu_hour_price_usd" field.
+func (u *TierUpsertBulk) AddVcpuHourPriceUsd(v float64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddVcpuHourPriceUsd(v)
+	})
+}
+
+// UpdateVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateVcpuHourPriceUsd() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateVcpuHourPriceUsd()
+	})
+}
+
+// SetRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field.
+func (u *TierUpsertBulk) SetRAMGibHourPriceUsd(v float64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetRAMGibHourPriceUsd(v)
+	})
+}
+
+// AddRAMGibHourPriceUsd adds v to the "ram_gib_hour_price_usd" field.
+func (u *TierUpsertBulk) AddRAMGibHourPriceUsd(v float64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddRAMGibHourPriceUsd(v)
+	})
+}
+
+// UpdateRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateRAMGibHourPriceUsd() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateRAMGibHourPriceUsd()
+	})
+}
+
+// SetHighPriority sets the "high_priority" field.
+func (u *TierUpsertBulk) SetHighPriority(v bool) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetHighPriority(v)
+	})
+}
+
+// UpdateHighPriority sets the "high_priority" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateHighPriority() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateHighPriority()
+	})
+}
+
 // Exec executes the query.
 func (u *TierUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
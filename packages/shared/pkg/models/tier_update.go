@@ -108,6 +108,62 @@ func (tu *TierUpdate) AddMaxLengthHours(i int64) *TierUpdate {
 	return tu
 }
 
+// SetVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field.
+func (tu *TierUpdate) SetVcpuHourPriceUsd(f float64) *TierUpdate {
+	tu.mutation.ResetVcpuHourPriceUsd()
+	tu.mutation.SetVcpuHourPriceUsd(f)
+	return tu
+}
+
+// SetNillableVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableVcpuHourPriceUsd(f *float64) *TierUpdate {
+	if f != nil {
+		tu.SetVcpuHourPriceUsd(*f)
+	}
+	return tu
+}
+
+// AddVcpuHourPriceUsd adds f to the "vcpu_hour_price_usd" field.
+func (tu *TierUpdate) AddVcpuHourPriceUsd(f float64) *TierUpdate {
+	tu.mutation.AddVcpuHourPriceUsd(f)
+	return tu
+}
+
+// SetRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field.
+func (tu *TierUpdate) SetRAMGibHourPriceUsd(f float64) *TierUpdate {
+	tu.mutation.ResetRAMGibHourPriceUsd()
+	tu.mutation.SetRAMGibHourPriceUsd(f)
+	return tu
+}
+
+// SetNillableRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableRAMGibHourPriceUsd(f *float64) *TierUpdate {
+	if f != nil {
+		tu.SetRAMGibHourPriceUsd(*f)
+	}
+	return tu
+}
+
+// AddRAMGibHourPriceUsd adds f to the "ram_gib_hour_price_usd" field.
+func (tu *TierUpdate) AddRAMGibHourPriceUsd(f float64) *TierUpdate {
+	tu.mutation.AddRAMGibHourPriceUsd(f)
+	return tu
+}
+
+// SetHighPriority sets the "high_priority" field.
+func (tu *TierUpdate) SetHighPriority(b bool) *TierUpdate {
+	tu.mutation.SetHighPriority(b)
+	return tu
+}
+
+// SetNillableHighPriority sets the "high_priority" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableHighPriority(b *bool) *TierUpdate {
+	if b != nil {
+		tu.SetHighPriority(*b)
+	}
+	return tu
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tu *TierUpdate) AddTeamIDs(ids ...uuid.UUID) *TierUpdate {
 	tu.mutation.AddTeamIDs(ids...)
@@ -212,6 +268,21 @@ func (tu *TierUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tu.mutation.VcpuHourPriceUsd(); ok {
+		_spec.SetField(tier.FieldVcpuHourPriceUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedVcpuHourPriceUsd(); ok {
+		_spec.AddField(tier.FieldVcpuHourPriceUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.RAMGibHourPriceUsd(); ok {
+		_spec.SetField(tier.FieldRAMGibHourPriceUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedRAMGibHourPriceUsd(); ok {
+		_spec.AddField(tier.FieldRAMGibHourPriceUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.HighPriority(); ok {
+		_spec.SetField(tier.FieldHighPriority, field.TypeBool, value)
+	}
 	if tu.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -361,6 +432,62 @@ func (tuo *TierUpdateOne) AddMaxLengthHours(i int64) *TierUpdateOne {
 	return tuo
 }
 
+// SetVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field.
+func (tuo *TierUpdateOne) SetVcpuHourPriceUsd(f float64) *TierUpdateOne {
+	tuo.mutation.ResetVcpuHourPriceUsd()
+	tuo.mutation.SetVcpuHourPriceUsd(f)
+	return tuo
+}
+
+// SetNillableVcpuHourPriceUsd sets the "vcpu_hour_price_usd" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableVcpuHourPriceUsd(f *float64) *TierUpdateOne {
+	if f != nil {
+		tuo.SetVcpuHourPriceUsd(*f)
+	}
+	return tuo
+}
+
+// AddVcpuHourPriceUsd adds f to the "vcpu_hour_price_usd" field.
+func (tuo *TierUpdateOne) AddVcpuHourPriceUsd(f float64) *TierUpdateOne {
+	tuo.mutation.AddVcpuHourPriceUsd(f)
+	return tuo
+}
+
+// SetRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field.
+func (tuo *TierUpdateOne) SetRAMGibHourPriceUsd(f float64) *TierUpdateOne {
+	tuo.mutation.ResetRAMGibHourPriceUsd()
+	tuo.mutation.SetRAMGibHourPriceUsd(f)
+	return tuo
+}
+
+// SetNillableRAMGibHourPriceUsd sets the "ram_gib_hour_price_usd" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableRAMGibHourPriceUsd(f *float64) *TierUpdateOne {
+	if f != nil {
+		tuo.SetRAMGibHourPriceUsd(*f)
+	}
+	return tuo
+}
+
+// AddRAMGibHourPriceUsd adds f to the "ram_gib_hour_price_usd" field.
+func (tuo *TierUpdateOne) AddRAMGibHourPriceUsd(f float64) *TierUpdateOne {
+	tuo.mutation.AddRAMGibHourPriceUsd(f)
+	return tuo
+}
+
+// SetHighPriority sets the "high_priority" field.
+func (tuo *TierUpdateOne) SetHighPriority(b bool) *TierUpdateOne {
+	tuo.mutation.SetHighPriority(b)
+	return tuo
+}
+
+// SetNillableHighPriority sets the "high_priority" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableHighPriority(b *bool) *TierUpdateOne {
+	if b != nil {
+		tuo.SetHighPriority(*b)
+	}
+	return tuo
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tuo *TierUpdateOne) AddTeamIDs(ids ...uuid.UUID) *TierUpdateOne {
 	tuo.mutation.AddTeamIDs(ids...)
@@ -495,6 +622,21 @@ func (tuo *TierUpdateOne) sqlSave(ctx context.Context) (_node *Tier, err error)
 	if value, ok := tuo.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tuo.mutation.VcpuHourPriceUsd(); ok {
+		_spec.SetField(tier.FieldVcpuHourPriceUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedVcpuHourPriceUsd(); ok {
+		_spec.AddField(tier.FieldVcpuHourPriceUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.RAMGibHourPriceUsd(); ok {
+		_spec.SetField(tier.FieldRAMGibHourPriceUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedRAMGibHourPriceUsd(); ok {
+		_spec.AddField(tier.FieldRAMGibHourPriceUsd, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.HighPriority(); ok {
+		_spec.SetField(tier.FieldHighPriority, field.TypeBool, value)
+	}
 	if tuo.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
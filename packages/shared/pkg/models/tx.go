@@ -20,12 +20,38 @@ type Tx struct {
 	EnvAlias *EnvAliasClient
 	// EnvBuild is the client for interacting with the EnvBuild builders.
 	EnvBuild *EnvBuildClient
+	// EnvBuildLog is the client for interacting with the EnvBuildLog builders.
+	EnvBuildLog *EnvBuildLogClient
+	// EnvRebuildSchedule is the client for interacting with the EnvRebuildSchedule builders.
+	EnvRebuildSchedule *EnvRebuildScheduleClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// Kernel is the client for interacting with the Kernel builders.
+	Kernel *KernelClient
+	// PinnedBuild is the client for interacting with the PinnedBuild builders.
+	PinnedBuild *PinnedBuildClient
+	// Sandbox is the client for interacting with the Sandbox builders.
+	Sandbox *SandboxClient
+	// SandboxStart is the client for interacting with the SandboxStart builders.
+	SandboxStart *SandboxStartClient
 	// Snapshot is the client for interacting with the Snapshot builders.
 	Snapshot *SnapshotClient
 	// Team is the client for interacting with the Team builders.
 	Team *TeamClient
 	// TeamAPIKey is the client for interacting with the TeamAPIKey builders.
 	TeamAPIKey *TeamAPIKeyClient
+	// TeamBudget is the client for interacting with the TeamBudget builders.
+	TeamBudget *TeamBudgetClient
+	// TeamRegistryCredential is the client for interacting with the TeamRegistryCredential builders.
+	TeamRegistryCredential *TeamRegistryCredentialClient
+	// TeamSecret is the client for interacting with the TeamSecret builders.
+	TeamSecret *TeamSecretClient
+	// TeamSecretVersion is the client for interacting with the TeamSecretVersion builders.
+	TeamSecretVersion *TeamSecretVersionClient
+	// TeamTemplateStorage is the client for interacting with the TeamTemplateStorage builders.
+	TeamTemplateStorage *TeamTemplateStorageClient
+	// TeamUsageDaily is the client for interacting with the TeamUsageDaily builders.
+	TeamUsageDaily *TeamUsageDailyClient
 	// Tier is the client for interacting with the Tier builders.
 	Tier *TierClient
 	// User is the client for interacting with the User builders.
@@ -167,9 +193,22 @@ func (tx *Tx) init() {
 	tx.Env = NewEnvClient(tx.config)
 	tx.EnvAlias = NewEnvAliasClient(tx.config)
 	tx.EnvBuild = NewEnvBuildClient(tx.config)
+	tx.EnvBuildLog = NewEnvBuildLogClient(tx.config)
+	tx.EnvRebuildSchedule = NewEnvRebuildScheduleClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.Kernel = NewKernelClient(tx.config)
+	tx.PinnedBuild = NewPinnedBuildClient(tx.config)
+	tx.Sandbox = NewSandboxClient(tx.config)
+	tx.SandboxStart = NewSandboxStartClient(tx.config)
 	tx.Snapshot = NewSnapshotClient(tx.config)
 	tx.Team = NewTeamClient(tx.config)
 	tx.TeamAPIKey = NewTeamAPIKeyClient(tx.config)
+	tx.TeamBudget = NewTeamBudgetClient(tx.config)
+	tx.TeamRegistryCredential = NewTeamRegistryCredentialClient(tx.config)
+	tx.TeamSecret = NewTeamSecretClient(tx.config)
+	tx.TeamSecretVersion = NewTeamSecretVersionClient(tx.config)
+	tx.TeamTemplateStorage = NewTeamTemplateStorageClient(tx.config)
+	tx.TeamUsageDaily = NewTeamUsageDailyClient(tx.config)
 	tx.Tier = NewTierClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UsersTeams = NewUsersTeamsClient(tx.config)
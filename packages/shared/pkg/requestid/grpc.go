@@ -0,0 +1,79 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// gRPC metadata keys are lowercase.
+var metadataKey = strings.ToLower(Header)
+
+func outgoingContext(ctx context.Context) context.Context {
+	requestID := FromContext(ctx)
+	if requestID == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, metadataKey, requestID)
+}
+
+func incomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	values := md.Get(metadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return ctx
+	}
+
+	ctx = WithRequestID(ctx, values[0])
+
+	SetSpanAttribute(ctx)
+	// The tags are added to the log fields by the grpc logging interceptors, no-op if the tags interceptor is not used.
+	grpc_ctxtags.Extract(ctx).Set(LogField, values[0])
+
+	return ctx
+}
+
+// UnaryClientInterceptor sends the request ID from the context in the call metadata.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor sends the request ID from the context in the stream metadata.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+// UnaryServerInterceptor adds the request ID from the call metadata to the handler context.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(incomingContext(ctx), req)
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor adds the request ID from the stream metadata to the handler context.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: incomingContext(ss.Context())})
+	}
+}
@@ -0,0 +1,57 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// Header is the HTTP header carrying the request ID between the services.
+	Header = "X-Request-ID"
+	// LogField is the name of the request ID field in the logs.
+	LogField = "request_id"
+
+	spanAttribute = "request.id"
+)
+
+type contextKey struct{}
+
+// WithRequestID returns a copy of the context with the request ID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	if requestID == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+// FromContext returns the request ID from the context or an empty string if there is none.
+func FromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKey{}).(string)
+
+	return requestID
+}
+
+// SetSpanAttribute adds the request ID to the span in the context, so the traces can be searched by it.
+func SetSpanAttribute(ctx context.Context) {
+	requestID := FromContext(ctx)
+	if requestID == "" {
+		return
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String(spanAttribute, requestID))
+}
+
+// InjectHeaders sets the request ID and the trace context from the context to the outgoing HTTP request headers.
+func InjectHeaders(ctx context.Context, header http.Header) {
+	if requestID := FromContext(ctx); requestID != "" {
+		header.Set(Header, requestID)
+	}
+
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
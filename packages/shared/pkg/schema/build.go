@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -18,6 +19,46 @@ const (
 	DefaultFirecrackerVersion = "v1.10.1_1fcdaec"
 )
 
+// ReadinessProbe checks the application in the sandbox is ready, either the command has to exit with zero code
+// or the HTTP endpoint in the sandbox has to respond with a success status.
+type ReadinessProbe struct {
+	Command        *string `json:"command,omitempty"`
+	HTTPPort       *int32  `json:"httpPort,omitempty"`
+	HTTPPath       *string `json:"httpPath,omitempty"`
+	TimeoutSeconds *int32  `json:"timeoutSeconds,omitempty"`
+}
+
+// LifecycleHook is a command executed in the sandbox via envd on the lifecycle event.
+// The failure policy is "ignore" (default), the failure is only logged, or "fail", the lifecycle operation fails.
+type LifecycleHook struct {
+	Command        string  `json:"command"`
+	TimeoutSeconds *int32  `json:"timeoutSeconds,omitempty"`
+	FailurePolicy  *string `json:"failurePolicy,omitempty"`
+}
+
+// LifecycleHooks are executed after the sandbox is restored from the snapshot and right before it is paused.
+type LifecycleHooks struct {
+	OnResume *LifecycleHook `json:"onResume,omitempty"`
+	OnPause  *LifecycleHook `json:"onPause,omitempty"`
+}
+
+// HardeningPolicy is the hardening of the processes started by envd, resolved from the level of the template and its overrides,
+// so the policy enforced in the sandbox is the one stored with the build.
+type HardeningPolicy struct {
+	Level           string   `json:"level"`
+	NoNewPrivileges bool     `json:"noNewPrivileges"`
+	ReadOnlyPaths   []string `json:"readOnlyPaths,omitempty"`
+	DeniedSyscalls  []string `json:"deniedSyscalls,omitempty"`
+}
+
+// EgressPolicy is the outbound traffic allowed from the sandboxes, resolved from the preset and the extra hosts,
+// so the policy enforced in the sandbox is the one stored with the build.
+type EgressPolicy struct {
+	Preset       string   `json:"preset"`
+	AllowAll     bool     `json:"allowAll"`
+	AllowedHosts []string `json:"allowedHosts,omitempty"`
+}
+
 type EnvBuild struct {
 	ent.Schema
 }
@@ -31,17 +72,47 @@ func (EnvBuild) Fields() []ent.Field {
 			),
 		field.Time("updated_at").Default(time.Now),
 		field.Time("finished_at").Optional().Nillable(),
+		// Last time the API building the template reported the build is still running, the build in the building status
+		// without the recent heartbeat was interrupted, e.g. by the restart of the API. Nil for the snapshots.
+		field.Time("heartbeat_at").Optional().Nillable(),
 		field.String("env_id").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
 		field.Enum("status").Values("waiting", "building", "failed", "success", "uploaded").Default("waiting").SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("dockerfile").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
 		field.String("start_cmd").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
+		field.JSON("readiness_probe", &ReadinessProbe{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.JSON("hooks", &LifecycleHooks{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.JSON("hardening", &HardeningPolicy{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.JSON("egress", &EgressPolicy{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
 		field.Int64("vcpu"),
 		field.Int64("ram_mb"),
 		field.Int64("free_disk_size_mb"),
 		field.Int64("total_disk_size_mb").Optional().Nillable(),
 		field.String("kernel_version").Default(DefaultKernelVersion).SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Strings("kernel_args").Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		// Block size of the rootfs diffs requested for the build, the template manager selects it by the rootfs layout if nil.
+		field.Int64("rootfs_block_size").Optional().Nillable(),
+		// References of the team secrets attached to the sandboxes of the build, in the NAME or NAME@VERSION format, the values are never stored with the build.
+		field.Strings("secrets").Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
 		field.String("firecracker_version").Default(DefaultFirecrackerVersion).SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("envd_version").SchemaType(map[string]string{dialect.Postgres: "text"}).Nillable().Optional(),
+		// The snapshot is uploaded to the storage in the background, until it's uploaded it can be resumed only on the node it was taken on.
+		// Nil for the template builds and the snapshots uploaded before they were marked as successful.
+		field.Enum("upload_status").Values("pending", "uploaded", "failed").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("snapshot_node_id").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
+		// Regions with the bucket replica the build was replicated to, the build is read there only after it was replicated.
+		field.Strings("replicated_regions").Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		// Build whose pushed Docker image the build was built from, nil for the builds of their own image.
+		field.UUID("source_build_id", uuid.UUID{}).Optional().Nillable(),
+		// Name of the resource variant of the build, nil for the builds that aren't variants.
+		field.String("variant").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
+		// Build the variant was built with from the same provisioned rootfs, the variant is selected only with its build.
+		field.UUID("variant_of", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (EnvBuild) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("variant_of"),
 	}
 }
 
@@ -30,6 +30,7 @@ func (Env) Fields() []ent.Field {
 		field.Int32("build_count").Default(1),
 		field.Int64("spawn_count").Default(0).Comment("Number of times the env was spawned"),
 		field.Time("last_spawned_at").Optional().Comment("Timestamp of the last time the env was spawned"),
+		field.JSON("allowed_regions", []string{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Comment("Regions the sandboxes of the env can run in, any region if empty"),
 	}
 }
 
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EnvBuildLog is the line of the template build log, the logs are stored when the build finishes and deleted after the retention of the team.
+type EnvBuildLog struct {
+	ent.Schema
+}
+
+func (EnvBuildLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("team_id", uuid.UUID{}).Immutable(),
+		field.String("env_id").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.UUID("build_id", uuid.UUID{}).Immutable(),
+		// Position of the line in the build log
+		field.Int32("line").Immutable(),
+		field.String("message").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+	}
+}
+
+func (EnvBuildLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("build_id", "line").Unique(),
+		index.Fields("team_id", "created_at"),
+	}
+}
+
+func (EnvBuildLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "env_build_logs"},
+	}
+}
+
+func (EnvBuildLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EnvRebuildSchedule is the schedule of the automatic rebuilds of the template from the image of its last build.
+// The template is served from the rebuilt build only once the rebuild succeeds, so its aliases are promoted to the new build on success only.
+type EnvRebuildSchedule struct {
+	ent.Schema
+}
+
+func (EnvRebuildSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Default(uuid.New).Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.String("env_id").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int32("interval_hours").Default(24),
+		field.Time("next_run_at"),
+		// Upgrade the packages of the image when rebuilding, so the template picks up the security updates of the distribution.
+		field.Bool("upgrade_packages").Default(true),
+		// URL the result of every rebuild is posted to, the rebuilds aren't notified about if nil.
+		field.String("webhook_url").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// Secret the notifications posted to the webhook are signed with, so the receiver can verify they come from the rebuilds.
+		field.String("webhook_secret").Optional().Nillable().Sensitive().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.UUID("last_build_id", uuid.UUID{}).Optional().Nillable(),
+		field.Enum("last_build_status").Values("building", "failed", "success").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+	}
+}
+
+func (EnvRebuildSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("env_id").Unique(),
+		index.Fields("next_run_at"),
+	}
+}
+
+func (EnvRebuildSchedule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "env_rebuild_schedules"},
+	}
+}
+
+func (EnvRebuildSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey is the key sent by the client with the request, so the retried request returns the response of the first request.
+// The key is unique per the authenticated team or user, the response is kept until the key expires.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Default(uuid.New).Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.Time("expires_at").Immutable(),
+		// ID of the team or the user the request was authenticated as.
+		field.UUID("owner_id", uuid.UUID{}).Immutable(),
+		field.String("key").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// Hash of the method, path and body of the request, the key can't be reused for a different request.
+		field.String("request_hash").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// The status code and the body of the response are empty while the request is in progress.
+		field.Int("status_code").Optional().Nillable(),
+		field.Bytes("response").Optional(),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
+
+func (IdempotencyKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+)
+
+// Kernel is the registered kernel binary, the ID is the kernel version (e.g. "vmlinux-6.1.102").
+type Kernel struct {
+	ent.Schema
+}
+
+func (Kernel) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		// Hex encoded SHA-256 checksum of the kernel binary.
+		field.String("checksum").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("description").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+	}
+}
+
+func (Kernel) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
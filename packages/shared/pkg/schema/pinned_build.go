@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PinnedBuild is the build whose files are never evicted from the node cache, on all nodes if the node ID is empty.
+type PinnedBuild struct {
+	ent.Schema
+}
+
+func (PinnedBuild) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Default(uuid.New).Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("build_id", uuid.UUID{}).Immutable(),
+		field.String("node_id").Immutable().Default("").SchemaType(map[string]string{dialect.Postgres: "text"}),
+	}
+}
+
+func (PinnedBuild) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("build_id", "node_id").Unique(),
+	}
+}
+
+func (PinnedBuild) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
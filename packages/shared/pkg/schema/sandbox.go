@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Sandbox is the persisted record of a sandbox the API started, so the sandbox list survives the API restarts.
+type Sandbox struct {
+	ent.Schema
+}
+
+func (Sandbox) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.UUID("team_id", uuid.UUID{}),
+		field.String("env_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.UUID("build_id", uuid.UUID{}),
+		field.String("alias").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("node_id").Optional().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("cluster_id").Optional().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Enum("state").Values("creating", "running", "lost"),
+		field.Time("started_at"),
+		field.Time("end_at"),
+		field.Int64("vcpu"),
+		field.Int64("ram_mb"),
+		field.JSON("metadata", map[string]string{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.JSON("labels", map[string]string{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+	}
+}
+
+func (Sandbox) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id"),
+		index.Fields("labels").Annotations(entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})),
+	}
+}
+
+func (Sandbox) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
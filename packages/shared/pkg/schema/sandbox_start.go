@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SandboxStart is the start or the resume of a sandbox with the time it took, the starts are rolled up to the daily usage of the team
+// and deleted after the usage rollup retention.
+type SandboxStart struct {
+	ent.Schema
+}
+
+func (SandboxStart) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("team_id", uuid.UUID{}).Immutable(),
+		field.String("env_id").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("sandbox_id").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Bool("resumed").Immutable(),
+		// Time from the request to the running sandbox experienced by the team
+		field.Int64("duration_ms").Immutable(),
+	}
+}
+
+func (SandboxStart) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
+
+func (SandboxStart) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sandbox_starts"},
+	}
+}
+
+func (SandboxStart) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
@@ -27,6 +27,12 @@ func (Team) Fields() []ent.Field {
 		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("tier").SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("email").MaxLen(255).SchemaType(map[string]string{dialect.Postgres: "character varying(255)"}),
+		// The snapshots of the team's sandboxes are encrypted with the team's data key before they are uploaded
+		field.Bool("encrypt_snapshots").Default(false).Annotations(entsql.Default("false")),
+		// The builds of the team's templates and snapshots are replicated to the bucket replicas in these regions
+		field.Strings("replication_regions").Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		// The build logs of the team's templates are deleted after this many days
+		field.Int32("build_log_retention_days").Default(30).Annotations(entsql.Default("30")),
 	}
 }
 
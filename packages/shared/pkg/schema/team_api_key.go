@@ -28,6 +28,8 @@ func (TeamAPIKey) Fields() []ent.Field {
 		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}).Default("Unnamed API Key"),
 		field.UUID("created_by", uuid.UUID{}).Nillable().Optional(),
 		field.Time("last_used").Nillable().Optional(),
+		// Scopes of the API key, the keys without the scopes have the access to everything.
+		field.Strings("scopes").Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
 	}
 }
 
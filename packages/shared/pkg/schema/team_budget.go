@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TeamBudget is the monthly budget of the team, the cost of the running sandboxes is accrued in the UTC month and the team
+// is warned and blocked from starting the sandboxes when the thresholds are crossed.
+type TeamBudget struct {
+	ent.Schema
+}
+
+func (TeamBudget) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Default(uuid.New).Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.UUID("team_id", uuid.UUID{}).Unique().Immutable(),
+		field.Float("monthly_limit_usd"),
+		// The team is warned when the accrued cost crosses the percentage of the limit
+		field.Int32("warning_percent").Default(80),
+		// The running sandboxes of the team are paused when the limit is crossed
+		field.Bool("pause_sandboxes").Default(false),
+		// Start of the UTC month the cost is accrued in
+		field.Time("period_start"),
+		field.Float("accrued_usd").Default(0),
+		// The cost is accrued until the time
+		field.Time("metered_at"),
+		field.Time("warned_at").Optional().Nillable(),
+		field.Time("exceeded_at").Optional().Nillable(),
+		// The budget isn't enforced until the time set by the admins
+		field.Time("override_until").Optional().Nillable(),
+		field.String("override_reason").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+	}
+}
+
+func (TeamBudget) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "team_budgets"},
+	}
+}
+
+func (TeamBudget) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TeamRegistryCredential is the credential of the team for the private container registry, it's used to pull the private images.
+// The password is stored encrypted like the values of the team secrets, the version is increased every time the credential is rotated.
+type TeamRegistryCredential struct {
+	ent.Schema
+}
+
+func (TeamRegistryCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Default(uuid.New).Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("team_id", uuid.UUID{}).Immutable(),
+		// Host of the registry, the Docker Hub aliases are stored as docker.io.
+		field.String("registry").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("username").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int32("version").Default(1),
+		field.String("key_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Bytes("encrypted_key").Sensitive(),
+		field.Bytes("ciphertext").Sensitive(),
+	}
+}
+
+func (TeamRegistryCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "registry").Unique(),
+	}
+}
+
+func (TeamRegistryCredential) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
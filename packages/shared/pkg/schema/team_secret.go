@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TeamSecret is the secret of the team that can be attached to the team sandboxes, the name is the name of the env var in the sandbox.
+// The values are stored encrypted in the versions of the secret.
+type TeamSecret struct {
+	ent.Schema
+}
+
+func (TeamSecret) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Default(uuid.New).Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("team_id", uuid.UUID{}).Immutable(),
+		field.String("name").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int32("latest_version").Default(1),
+	}
+}
+
+func (TeamSecret) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "name").Unique(),
+	}
+}
+
+func (TeamSecret) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
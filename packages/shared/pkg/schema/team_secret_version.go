@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TeamSecretVersion is the value of the team secret, encrypted with the data key that is encrypted by the key of the key manager.
+// The versions are never changed, setting the secret creates a new version.
+type TeamSecretVersion struct {
+	ent.Schema
+}
+
+func (TeamSecretVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Default(uuid.New).Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("secret_id", uuid.UUID{}).Immutable(),
+		field.Int32("version").Immutable(),
+		// Identifier of the key the data key is encrypted with.
+		field.String("key_id").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Bytes("encrypted_key").Immutable().Sensitive(),
+		field.Bytes("ciphertext").Immutable().Sensitive(),
+	}
+}
+
+func (TeamSecretVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("secret_id", "version").Unique(),
+	}
+}
+
+func (TeamSecretVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
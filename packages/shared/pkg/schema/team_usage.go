@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TeamUsageDaily is the usage of the team in a UTC day rolled up from the sandbox starts and the template builds.
+type TeamUsageDaily struct {
+	ent.Schema
+}
+
+func (TeamUsageDaily) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("team_id", uuid.UUID{}).Immutable(),
+		// Start of the UTC day
+		field.Time("day").Immutable(),
+		field.Int64("sandboxes_started").Default(0),
+		field.Int64("sandboxes_resumed").Default(0),
+		field.Int64("resume_p95_ms").Optional().Nillable(),
+		field.Int64("builds").Default(0),
+		field.Int64("build_seconds").Default(0),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (TeamUsageDaily) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "day").Unique(),
+	}
+}
+
+func (TeamUsageDaily) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "team_usage_daily"},
+	}
+}
+
+func (TeamUsageDaily) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
+
+// TeamTemplateStorage is the size of the stored builds of the team's template rolled up from the template bucket,
+// the snapshots of the paused sandboxes are counted with the template they were started from.
+type TeamTemplateStorage struct {
+	ent.Schema
+}
+
+func (TeamTemplateStorage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("team_id", uuid.UUID{}),
+		field.String("env_id").Unique().Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int64("template_bytes").Default(0),
+		field.Int64("snapshot_bytes").Default(0),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (TeamTemplateStorage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id"),
+	}
+}
+
+func (TeamTemplateStorage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "team_template_storage"},
+	}
+}
+
+func (TeamTemplateStorage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
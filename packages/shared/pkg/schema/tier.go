@@ -20,6 +20,10 @@ func (Tier) Fields() []ent.Field {
 		field.Int64("disk_mb").Annotations(entsql.Check("disk_mb > 0"), entsql.Default("512")),
 		field.Int64("concurrent_instances").Annotations(entsql.Check("concurrent_instances > 0")).Comment("The number of instances the team can run concurrently"),
 		field.Int64("max_length_hours"),
+		// Prices of the running sandboxes the budgets of the teams are metered with
+		field.Float("vcpu_hour_price_usd").Default(0.0504).Annotations(entsql.Default("0.0504")),
+		field.Float("ram_gib_hour_price_usd").Default(0.0162).Annotations(entsql.Default("0.0162")),
+		field.Bool("high_priority").Default(false).Annotations(entsql.Default("false")).Comment("Whether the team can start the high priority sandboxes that preempt the low priority ones"),
 	}
 }
 
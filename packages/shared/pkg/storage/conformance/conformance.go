@@ -0,0 +1,343 @@
+// Package conformance validates the storage objects the templates are stored in. The suite checks the semantics
+// the template cache relies on and measures the latencies of the ranged reads and the throughput of the uploads,
+// so the storage can be validated before it is used in production.
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	mathrand "math/rand"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/benchmark"
+)
+
+// Object is the object of the storage, the interface is implemented by gcs.Object.
+type Object interface {
+	WriteTo(dst io.Writer) (int64, error)
+	ReadFrom(src io.Reader) (int64, error)
+	ReadAt(b []byte, off int64) (int, error)
+	Size() (int64, error)
+	Exists() (bool, error)
+	Delete() error
+}
+
+// NewObjectFunc returns the object at the path of the storage, the object doesn't have to exist.
+type NewObjectFunc func(ctx context.Context, path string) Object
+
+type Config struct {
+	// Prefix is the path prefix of the objects created by the suite, the objects are deleted after the suite.
+	Prefix string
+	// ObjectSize is the size of the object the ranged reads and the upload throughput are measured with.
+	ObjectSize int64
+	// ChunkSize is the size of the ranged reads.
+	ChunkSize int64
+	// Reads is the number of the ranged reads measured.
+	Reads int
+}
+
+type CheckResult struct {
+	Name string
+	// Err is nil if the storage passed the check.
+	Err error
+}
+
+type Report struct {
+	Checks []CheckResult
+	// UploadThroughput is in bytes per second.
+	UploadThroughput float64
+	// Reads are the latencies of the ranged reads at the random offsets.
+	Reads benchmark.Distribution
+}
+
+// Failed returns the number of the failed checks.
+func (r *Report) Failed() int {
+	failed := 0
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+type check struct {
+	name string
+	run  func(ctx context.Context, newObject NewObjectFunc, path string) error
+}
+
+var checks = []check{
+	{name: "read after write", run: checkReadAfterWrite},
+	{name: "overwrite", run: checkOverwrite},
+	{name: "ranged read", run: checkRangedRead},
+	{name: "read across the end", run: checkReadAcrossEnd},
+	{name: "missing object", run: checkMissingObject},
+	{name: "delete", run: checkDelete},
+}
+
+// Run runs the checks and the measurements, every check uses its own object.
+func Run(ctx context.Context, newObject NewObjectFunc, cfg Config) (*Report, error) {
+	report := &Report{}
+
+	for i, c := range checks {
+		path := fmt.Sprintf("%s/check-%d", cfg.Prefix, i)
+
+		err := c.run(ctx, newObject, path)
+		cleanup(newObject(ctx, path))
+
+		report.Checks = append(report.Checks, CheckResult{Name: c.name, Err: err})
+	}
+
+	path := cfg.Prefix + "/benchmark"
+	defer cleanup(newObject(ctx, path))
+
+	throughput, err := measureUpload(newObject(ctx, path), cfg.ObjectSize)
+	if err != nil {
+		return report, fmt.Errorf("failed to measure upload throughput: %w", err)
+	}
+
+	report.UploadThroughput = throughput
+
+	reads, err := measureReads(ctx, newObject(ctx, path), cfg)
+	if err != nil {
+		return report, fmt.Errorf("failed to measure ranged reads: %w", err)
+	}
+
+	report.Reads = reads
+
+	return report, nil
+}
+
+func cleanup(object Object) {
+	exists, err := object.Exists()
+	if err == nil && exists {
+		_ = object.Delete()
+	}
+}
+
+func randomData(size int64) []byte {
+	data := make([]byte, size)
+	_, _ = rand.Read(data)
+
+	return data
+}
+
+func upload(object Object, data []byte) error {
+	_, err := object.ReadFrom(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+
+	return nil
+}
+
+func readAll(object Object) ([]byte, error) {
+	var buf bytes.Buffer
+
+	_, err := object.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// checkReadAfterWrite checks the uploaded object is immediately visible with its size and content.
+func checkReadAfterWrite(ctx context.Context, newObject NewObjectFunc, path string) error {
+	data := randomData(1 << 20)
+
+	err := upload(newObject(ctx, path), data)
+	if err != nil {
+		return err
+	}
+
+	object := newObject(ctx, path)
+
+	size, err := object.Size()
+	if err != nil {
+		return fmt.Errorf("failed to get size: %w", err)
+	}
+
+	if size != int64(len(data)) {
+		return fmt.Errorf("size is %d, expected %d", size, len(data))
+	}
+
+	read, err := readAll(object)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(read, data) {
+		return errors.New("read content differs from the uploaded one")
+	}
+
+	return nil
+}
+
+// checkOverwrite checks the reads see the new content right after the object is overwritten.
+func checkOverwrite(ctx context.Context, newObject NewObjectFunc, path string) error {
+	err := upload(newObject(ctx, path), randomData(64<<10))
+	if err != nil {
+		return err
+	}
+
+	data := randomData(128 << 10)
+
+	err = upload(newObject(ctx, path), data)
+	if err != nil {
+		return err
+	}
+
+	read, err := readAll(newObject(ctx, path))
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(read, data) {
+		return errors.New("read content is not the overwritten one")
+	}
+
+	return nil
+}
+
+// checkRangedRead checks the ranged reads return exactly the requested bytes.
+func checkRangedRead(ctx context.Context, newObject NewObjectFunc, path string) error {
+	data := randomData(1 << 20)
+
+	object := newObject(ctx, path)
+
+	err := upload(object, data)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range []struct{ off, length int64 }{{0, 4096}, {4096, 65536}, {12345, 1}, {int64(len(data)) - 4096, 4096}} {
+		b := make([]byte, r.length)
+
+		n, err := object.ReadAt(b, r.off)
+		if err != nil {
+			return fmt.Errorf("failed to read %d bytes at %d: %w", r.length, r.off, err)
+		}
+
+		if int64(n) != r.length || !bytes.Equal(b, data[r.off:r.off+r.length]) {
+			return fmt.Errorf("read of %d bytes at %d returned wrong content", r.length, r.off)
+		}
+	}
+
+	return nil
+}
+
+// checkReadAcrossEnd checks the read crossing the end of the object returns the bytes up to the end,
+// the last chunk of the template files is usually shorter than the chunk size.
+func checkReadAcrossEnd(ctx context.Context, newObject NewObjectFunc, path string) error {
+	data := randomData(10000)
+
+	object := newObject(ctx, path)
+
+	err := upload(object, data)
+	if err != nil {
+		return err
+	}
+
+	b := make([]byte, 4096)
+
+	n, err := object.ReadAt(b, 8192)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read across the end: %w", err)
+	}
+
+	if n != len(data)-8192 || !bytes.Equal(b[:n], data[8192:]) {
+		return fmt.Errorf("read across the end returned %d bytes, expected %d", n, len(data)-8192)
+	}
+
+	return nil
+}
+
+// checkMissingObject checks the missing object is reported as missing instead of empty.
+func checkMissingObject(ctx context.Context, newObject NewObjectFunc, path string) error {
+	object := newObject(ctx, path)
+
+	exists, err := object.Exists()
+	if err != nil {
+		return fmt.Errorf("failed to check existence: %w", err)
+	}
+
+	if exists {
+		return errors.New("missing object exists")
+	}
+
+	_, err = object.Size()
+	if err == nil {
+		return errors.New("size of missing object didn't fail")
+	}
+
+	return nil
+}
+
+// checkDelete checks the deleted object is immediately missing.
+func checkDelete(ctx context.Context, newObject NewObjectFunc, path string) error {
+	err := upload(newObject(ctx, path), randomData(4096))
+	if err != nil {
+		return err
+	}
+
+	err = newObject(ctx, path).Delete()
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	exists, err := newObject(ctx, path).Exists()
+	if err != nil {
+		return fmt.Errorf("failed to check existence: %w", err)
+	}
+
+	if exists {
+		return errors.New("deleted object exists")
+	}
+
+	return nil
+}
+
+func measureUpload(object Object, size int64) (float64, error) {
+	data := randomData(size)
+
+	start := time.Now()
+
+	err := upload(object, data)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(size) / time.Since(start).Seconds(), nil
+}
+
+// measureReads reads the chunks at the random chunk aligned offsets, the same way the template cache reads them.
+func measureReads(ctx context.Context, object Object, cfg Config) (benchmark.Distribution, error) {
+	chunks := max(cfg.ObjectSize/cfg.ChunkSize, 1)
+	durations := make([]time.Duration, 0, cfg.Reads)
+
+	b := make([]byte, cfg.ChunkSize)
+	for i := 0; i < cfg.Reads; i++ {
+		if ctx.Err() != nil {
+			return benchmark.Distribution{}, ctx.Err()
+		}
+
+		off := mathrand.Int63n(chunks) * cfg.ChunkSize
+
+		start := time.Now()
+
+		_, err := object.ReadAt(b, off)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return benchmark.Distribution{}, fmt.Errorf("failed to read chunk at %d: %w", off, err)
+		}
+
+		durations = append(durations, time.Since(start))
+	}
+
+	return benchmark.Distribute(durations), nil
+}
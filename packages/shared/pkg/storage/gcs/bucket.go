@@ -1,7 +1,15 @@
 package gcs
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
@@ -16,4 +24,78 @@ var (
 	templateBucketName = utils.RequiredEnv("TEMPLATE_BUCKET_NAME", "bucket for storing template files")
 
 	TemplateBucket = newBucket(templateBucketName)
+
+	// templateBucketReplicas are the replicas of the template bucket by the region, the builds are replicated
+	// to them from the template bucket, so the nodes in the region don't read the templates cross-region.
+	templateBucketReplicas = newReplicas(os.Getenv("TEMPLATE_BUCKET_REPLICAS"))
+
+	// LocalTemplateBucket is the bucket the templates are read from, the replica in the region of the service if there is one.
+	LocalTemplateBucket = RegionBucket(os.Getenv("REGION"))
 )
+
+// NewBucket returns the handle of the bucket configured by the service.
+func NewBucket(bucket string) *BucketHandle {
+	return newBucket(bucket)
+}
+
+// newReplicas parses the replicas in the region=bucket,region=bucket format.
+func newReplicas(value string) map[string]*BucketHandle {
+	replicas := make(map[string]*BucketHandle)
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		region, bucket, ok := strings.Cut(pair, "=")
+		if !ok || region == "" || bucket == "" {
+			panic(fmt.Sprintf("Environment variable \"TEMPLATE_BUCKET_REPLICAS\" has invalid replica '%s', the replicas must be in the region=bucket format.", pair))
+		}
+
+		replicas[region] = newBucket(bucket)
+	}
+
+	return replicas
+}
+
+// ReplicaBucket returns the replica of the template bucket in the region, false if the region has no replica.
+func ReplicaBucket(region string) (*BucketHandle, bool) {
+	bucket, ok := templateBucketReplicas[region]
+
+	return bucket, ok
+}
+
+// ReplicaRegions returns the sorted regions with a replica of the template bucket.
+func ReplicaRegions() []string {
+	regions := make([]string, 0, len(templateBucketReplicas))
+	for region := range templateBucketReplicas {
+		regions = append(regions, region)
+	}
+
+	slices.Sort(regions)
+
+	return regions
+}
+
+// RegionBucket returns the bucket the nodes in the region read the templates from, the template bucket if the region has no replica.
+func RegionBucket(region string) *BucketHandle {
+	if bucket, ok := ReplicaBucket(region); ok {
+		return bucket
+	}
+
+	return TemplateBucket
+}
+
+// Ping checks the bucket is reachable and the objects in it can be listed.
+func Ping(ctx context.Context, bucket *BucketHandle) error {
+	it := bucket.Objects(ctx, nil)
+	it.PageInfo().MaxSize = 1
+
+	_, err := it.Next()
+	if err != nil && !errors.Is(err, iterator.Done) {
+		return fmt.Errorf("failed to list objects in bucket '%s': %w", bucket.BucketName(), err)
+	}
+
+	return nil
+}
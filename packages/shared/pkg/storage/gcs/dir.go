@@ -31,3 +31,26 @@ func RemoveDir(ctx context.Context, bucket *BucketHandle, dir string) error {
 
 	return nil
 }
+
+// DirSize returns the total size of the objects in the dir.
+func DirSize(ctx context.Context, bucket *BucketHandle, dir string) (int64, error) {
+	objects := bucket.Objects(ctx, &storage.Query{
+		Prefix: dir + "/",
+	})
+
+	var size int64
+	for {
+		object, err := objects.Next()
+		if err == iterator.Done {
+			break
+		}
+
+		if err != nil {
+			return 0, fmt.Errorf("error when iterating over template objects: %w", err)
+		}
+
+		size += object.Size
+	}
+
+	return size, nil
+}
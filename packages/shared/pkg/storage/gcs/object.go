@@ -22,6 +22,8 @@ const (
 	maxAttempts       = 10
 )
 
+var ErrObjectNotExist = storage.ErrObjectNotExist
+
 type Object struct {
 	object *storage.ObjectHandle
 	ctx    context.Context
@@ -66,7 +68,13 @@ func (o *Object) WriteTo(dst io.Writer) (int64, error) {
 }
 
 func (o *Object) ReadFrom(src io.Reader) (int64, error) {
+	return o.ReadFromWithMetadata(src, nil)
+}
+
+// ReadFromWithMetadata uploads the object with the custom metadata, the metadata is returned by Attrs.
+func (o *Object) ReadFromWithMetadata(src io.Reader, metadata map[string]string) (int64, error) {
 	w := o.object.NewWriter(o.ctx)
+	w.Metadata = metadata
 
 	n, err := io.Copy(w, src)
 	if err != nil && !errors.Is(err, io.EOF) {
@@ -105,6 +113,11 @@ func (o *Object) ReadAt(b []byte, off int64) (n int, err error) {
 	ctx, cancel := context.WithTimeout(o.ctx, readTimeout)
 	defer cancel()
 
+	return o.ReadAtContext(ctx, b, off)
+}
+
+// ReadAtContext reads like ReadAt, but the read is bound only by the context, so the callers can set their own timeout.
+func (o *Object) ReadAtContext(ctx context.Context, b []byte, off int64) (n int, err error) {
 	// The file should not be gzip compressed
 	reader, err := o.object.NewRangeReader(ctx, off, int64(len(b)))
 	if err != nil {
@@ -132,15 +145,46 @@ func (o *Object) ReadAt(b []byte, off int64) (n int, err error) {
 }
 
 func (o *Object) Size() (int64, error) {
+	attrs, err := o.Attrs()
+	if err != nil {
+		return 0, err
+	}
+
+	return attrs.Size, nil
+}
+
+func (o *Object) Attrs() (*storage.ObjectAttrs, error) {
 	ctx, cancel := context.WithTimeout(o.ctx, operationTimeout)
 	defer cancel()
 
 	attrs, err := o.object.Attrs(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get GCS object (%s) attributes: %w", o.object.ObjectName(), err)
+		return nil, fmt.Errorf("failed to get GCS object (%s) attributes: %w", o.object.ObjectName(), err)
 	}
 
-	return attrs.Size, nil
+	return attrs, nil
+}
+
+// Exists returns false if the object doesn't exist.
+func (o *Object) Exists() (bool, error) {
+	_, err := o.Attrs()
+	if errors.Is(err, ErrObjectNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CopyFrom copies the source object with its metadata to the object, the object is copied by the storage without downloading it.
+func (o *Object) CopyFrom(src *Object) error {
+	_, err := o.object.CopierFrom(src.object).Run(o.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to copy GCS object (%s): %w", src.object.ObjectName(), err)
+	}
+
+	return nil
 }
 
 func (o *Object) Delete() error {
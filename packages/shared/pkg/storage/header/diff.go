@@ -11,6 +11,8 @@ const (
 	PageSize        = 2 << 11
 	HugepageSize    = 2 << 20
 	RootfsBlockSize = 2 << 11
+	// MaxRootfsBlockSize is the largest rootfs block size, the blocks must fit in the chunks fetched from the storage.
+	MaxRootfsBlockSize = HugepageSize
 )
 
 var (
@@ -18,6 +20,12 @@ var (
 	EmptyBlock    = make([]byte, RootfsBlockSize)
 )
 
+// ValidRootfsBlockSize returns true if the rootfs diffs can use the block size, the size must be a power of two
+// between the page size and the huge page size.
+func ValidRootfsBlockSize(size int64) bool {
+	return size >= RootfsBlockSize && size <= MaxRootfsBlockSize && size&(size-1) == 0
+}
+
 func CreateDiff(source io.ReaderAt, blockSize int64, dirty *bitset.BitSet, diff io.Writer) error {
 	b := make([]byte, blockSize)
 
@@ -0,0 +1,238 @@
+package layout
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	superblockOffset = 1024
+	superblockSize   = 1024
+	ext4Magic        = 0xEF53
+
+	incompat64Bit = 0x80
+
+	groupInodeUninit = 0x1
+
+	inodeModeTypeMask = 0xF000
+	inodeModeRegular  = 0x8000
+	inodeFlagExtents  = 0x80000
+	inodeBlockOffset  = 0x28
+	inodeBlockSize    = 60
+
+	extentMagic      = 0xF30A
+	extentHeaderSize = 12
+	extentEntrySize  = 12
+	// maxExtentDepth is the depth limit of the ext4 extent tree, the deeper trees are corrupted.
+	maxExtentDepth = 5
+	// uninitializedExtentLength is added to the length of the preallocated extents, the guest reads them as zeros,
+	// so they're not in the layout.
+	uninitializedExtentLength = 32768
+
+	defaultFirstInode = 11
+
+	// minFileSize is the size of the smallest file in the layout, the smaller files are read from the storage in one read.
+	minFileSize = 256 * 1024
+)
+
+type superblock struct {
+	blockSize       int64
+	firstDataBlock  int64
+	inodesCount     uint32
+	inodesPerGroup  uint32
+	inodeSize       int64
+	firstInode      uint32
+	groupDescSize   int64
+	groupDesc64Bits bool
+}
+
+func readSuperblock(r io.ReaderAt) (*superblock, error) {
+	buf := make([]byte, superblockSize)
+
+	_, err := r.ReadAt(buf, superblockOffset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read superblock: %w", err)
+	}
+
+	le := binary.LittleEndian
+
+	if le.Uint16(buf[0x38:]) != ext4Magic {
+		return nil, fmt.Errorf("not an ext4 filesystem")
+	}
+
+	sb := &superblock{
+		blockSize:      1024 << le.Uint32(buf[0x18:]),
+		firstDataBlock: int64(le.Uint32(buf[0x14:])),
+		inodesCount:    le.Uint32(buf[0x0:]),
+		inodesPerGroup: le.Uint32(buf[0x28:]),
+		inodeSize:      128,
+		firstInode:     defaultFirstInode,
+		groupDescSize:  32,
+	}
+
+	// The dynamic revision has the variable inode size
+	if le.Uint32(buf[0x4C:]) >= 1 {
+		sb.inodeSize = int64(le.Uint16(buf[0x58:]))
+		sb.firstInode = le.Uint32(buf[0x54:])
+	}
+
+	if le.Uint32(buf[0x60:])&incompat64Bit != 0 {
+		sb.groupDesc64Bits = true
+		sb.groupDescSize = int64(le.Uint16(buf[0xFE:]))
+	}
+
+	if sb.inodesPerGroup == 0 || sb.inodeSize < 128 || sb.groupDescSize < 32 {
+		return nil, fmt.Errorf("invalid superblock")
+	}
+
+	return sb, nil
+}
+
+// ParseExt4 returns the layout of the regular files of the ext4 filesystem, only the files with the extents are mapped.
+func ParseExt4(r io.ReaderAt) (*Layout, error) {
+	sb, err := readSuperblock(r)
+	if err != nil {
+		return nil, err
+	}
+
+	le := binary.LittleEndian
+
+	groups := (int64(sb.inodesCount) + int64(sb.inodesPerGroup) - 1) / int64(sb.inodesPerGroup)
+
+	descriptors := make([]byte, groups*sb.groupDescSize)
+
+	_, err = r.ReadAt(descriptors, (sb.firstDataBlock+1)*sb.blockSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read group descriptors: %w", err)
+	}
+
+	var files [][]Extent
+
+	table := make([]byte, int64(sb.inodesPerGroup)*sb.inodeSize)
+
+	for group := int64(0); group < groups; group++ {
+		desc := descriptors[group*sb.groupDescSize : (group+1)*sb.groupDescSize]
+
+		if le.Uint16(desc[0x12:])&groupInodeUninit != 0 {
+			continue
+		}
+
+		tableBlock := int64(le.Uint32(desc[0x8:]))
+		if sb.groupDesc64Bits && sb.groupDescSize >= 64 {
+			tableBlock |= int64(le.Uint32(desc[0x28:])) << 32
+		}
+
+		_, err := r.ReadAt(table, tableBlock*sb.blockSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read inode table of group %d: %w", group, err)
+		}
+
+		for i := int64(0); i < int64(sb.inodesPerGroup); i++ {
+			number := group*int64(sb.inodesPerGroup) + i + 1
+			if number < int64(sb.firstInode) {
+				continue
+			}
+
+			extents, err := parseInode(r, sb, table[i*sb.inodeSize:(i+1)*sb.inodeSize])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse inode %d: %w", number, err)
+			}
+
+			if extents != nil {
+				files = append(files, extents)
+			}
+		}
+	}
+
+	return New(files), nil
+}
+
+// parseInode returns the extents of the regular file, nil if the inode isn't a mapped file.
+func parseInode(r io.ReaderAt, sb *superblock, inode []byte) ([]Extent, error) {
+	le := binary.LittleEndian
+
+	mode := le.Uint16(inode[0x0:])
+	links := le.Uint16(inode[0x1A:])
+	flags := le.Uint32(inode[0x20:])
+
+	if links == 0 || mode&inodeModeTypeMask != inodeModeRegular || flags&inodeFlagExtents == 0 {
+		return nil, nil
+	}
+
+	size := int64(le.Uint32(inode[0x4:])) | int64(le.Uint32(inode[0x6C:]))<<32
+	if size < minFileSize {
+		return nil, nil
+	}
+
+	var extents []Extent
+
+	err := walkExtents(r, sb, inode[inodeBlockOffset:inodeBlockOffset+inodeBlockSize], maxExtentDepth, &extents)
+	if err != nil {
+		return nil, err
+	}
+
+	return extents, nil
+}
+
+// walkExtents appends the extents of the extent tree node to the extents in the order of the file data,
+// the adjacent extents are merged.
+func walkExtents(r io.ReaderAt, sb *superblock, node []byte, depthLimit int, extents *[]Extent) error {
+	le := binary.LittleEndian
+
+	if len(node) < extentHeaderSize || le.Uint16(node[0x0:]) != extentMagic {
+		return fmt.Errorf("invalid extent header")
+	}
+
+	entries := int(le.Uint16(node[0x2:]))
+	depth := int(le.Uint16(node[0x6:]))
+
+	if depth >= depthLimit {
+		return fmt.Errorf("extent tree too deep")
+	}
+
+	if extentHeaderSize+entries*extentEntrySize > len(node) {
+		return fmt.Errorf("invalid extent entries count %d", entries)
+	}
+
+	for i := 0; i < entries; i++ {
+		entry := node[extentHeaderSize+i*extentEntrySize:]
+
+		if depth > 0 {
+			leaf := int64(le.Uint32(entry[0x4:])) | int64(le.Uint16(entry[0x8:]))<<32
+
+			child := make([]byte, sb.blockSize)
+
+			_, err := r.ReadAt(child, leaf*sb.blockSize)
+			if err != nil {
+				return fmt.Errorf("failed to read extent tree block %d: %w", leaf, err)
+			}
+
+			err = walkExtents(r, sb, child, depth, extents)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		length := int64(le.Uint16(entry[0x4:]))
+		if length > uninitializedExtentLength {
+			continue
+		}
+
+		start := int64(le.Uint32(entry[0x8:])) | int64(le.Uint16(entry[0x6:]))<<32
+
+		e := Extent{Offset: start * sb.blockSize, Length: length * sb.blockSize}
+
+		if n := len(*extents); n > 0 && (*extents)[n-1].end() == e.Offset {
+			(*extents)[n-1].Length += e.Length
+
+			continue
+		}
+
+		*extents = append(*extents, e)
+	}
+
+	return nil
+}
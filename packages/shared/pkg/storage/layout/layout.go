@@ -0,0 +1,166 @@
+// Package layout maps the data of the files in the rootfs filesystem to the extents on the device. The layout is parsed
+// when the template is built and stored with the build, so the reads of a file on the device can fetch the rest of the file
+// from the storage at once.
+package layout
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const version uint64 = 1
+
+// Extent is a contiguous range of the file data on the device, in bytes.
+type Extent struct {
+	Offset int64
+	Length int64
+}
+
+func (e Extent) end() int64 {
+	return e.Offset + e.Length
+}
+
+type indexEntry struct {
+	Extent
+	file   int
+	extent int
+}
+
+// Layout is the layout of the regular files of the filesystem.
+type Layout struct {
+	// Files are the extents of the files, the extents of a file are in the order of the file data.
+	Files [][]Extent
+
+	// index is sorted by the offset of the extents, so the extent on the offset of the device is found by the binary search.
+	index []indexEntry
+}
+
+func New(files [][]Extent) *Layout {
+	var index []indexEntry
+
+	for file, extents := range files {
+		for extent, e := range extents {
+			index = append(index, indexEntry{Extent: e, file: file, extent: extent})
+		}
+	}
+
+	sort.Slice(index, func(i, j int) bool {
+		return index[i].Offset < index[j].Offset
+	})
+
+	return &Layout{
+		Files: files,
+		index: index,
+	}
+}
+
+// ReadAhead returns the extents of the file data that follow the offset on the device, up to the limit of bytes.
+// It returns nil if the offset isn't in the data of a file.
+func (l *Layout) ReadAhead(off, limit int64) []Extent {
+	i := sort.Search(len(l.index), func(i int) bool {
+		return l.index[i].end() > off
+	})
+
+	if i == len(l.index) || l.index[i].Offset > off {
+		return nil
+	}
+
+	entry := l.index[i]
+	extents := l.Files[entry.file]
+
+	var ahead []Extent
+
+	// The rest of the extent the offset is in
+	current := Extent{Offset: off, Length: min(entry.end()-off, limit)}
+	ahead = append(ahead, current)
+	limit -= current.Length
+
+	for _, e := range extents[entry.extent+1:] {
+		if limit <= 0 {
+			break
+		}
+
+		e.Length = min(e.Length, limit)
+		ahead = append(ahead, e)
+		limit -= e.Length
+	}
+
+	return ahead
+}
+
+// Serialize returns the binary layout, the files are written as the number of the extents followed by the extents.
+func Serialize(l *Layout) (io.Reader, error) {
+	var buf bytes.Buffer
+
+	err := binary.Write(&buf, binary.LittleEndian, []uint64{version, uint64(len(l.Files))})
+	if err != nil {
+		return nil, fmt.Errorf("failed to write layout metadata: %w", err)
+	}
+
+	for _, extents := range l.Files {
+		err := binary.Write(&buf, binary.LittleEndian, uint64(len(extents)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to write file extents count: %w", err)
+		}
+
+		err = binary.Write(&buf, binary.LittleEndian, extents)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write file extents: %w", err)
+		}
+	}
+
+	return &buf, nil
+}
+
+func Deserialize(in io.WriterTo) (*Layout, error) {
+	var buf bytes.Buffer
+
+	_, err := in.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write to buffer: %w", err)
+	}
+
+	reader := bytes.NewReader(buf.Bytes())
+
+	var metadata [2]uint64
+
+	err = binary.Read(reader, binary.LittleEndian, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read layout metadata: %w", err)
+	}
+
+	if metadata[0] != version {
+		return nil, fmt.Errorf("unsupported layout version %d", metadata[0])
+	}
+
+	// Each file takes at least the count of its extents, the count of the files can't be larger
+	if metadata[1] > uint64(reader.Len()/8) {
+		return nil, fmt.Errorf("invalid count of files %d", metadata[1])
+	}
+
+	files := make([][]Extent, metadata[1])
+	for i := range files {
+		var count uint64
+
+		err := binary.Read(reader, binary.LittleEndian, &count)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file extents count: %w", err)
+		}
+
+		if count > uint64(reader.Len()/16) {
+			return nil, fmt.Errorf("invalid count of file extents %d", count)
+		}
+
+		files[i] = make([]Extent, count)
+
+		err = binary.Read(reader, binary.LittleEndian, files[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file extents: %w", err)
+		}
+	}
+
+	return New(files), nil
+}
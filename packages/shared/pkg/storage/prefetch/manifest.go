@@ -0,0 +1,134 @@
+// Package prefetch ranks the blocks of the memfile by how often the resumes of the snapshot faulted them. The manifest is
+// stored with each snapshot and carries over the counts of the snapshot it was resumed from, so the blocks the sandbox
+// needs after every resume are prefetched first.
+package prefetch
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+const (
+	version uint64 = 1
+
+	// maxBlocks is the limit of the blocks in the manifest, the blocks faulted the least often are dropped.
+	maxBlocks = 256 * 1024
+)
+
+// Block is the index of the block in the memfile and the number of the resumes that faulted it.
+type Block struct {
+	Index  uint64
+	Faults uint64
+}
+
+// Manifest is the blocks of the memfile ordered by the rank, the most often faulted blocks first.
+type Manifest struct {
+	BlockSize uint64
+	Blocks    []Block
+}
+
+// New returns the manifest with the faulted blocks of the resume added to the counts of the previous manifest.
+// The previous manifest is ignored if it's nil or has a different block size.
+func New(blockSize int64, previous *Manifest, faulted *bitset.BitSet) *Manifest {
+	counts := make(map[uint64]uint64)
+
+	if previous != nil && previous.BlockSize == uint64(blockSize) {
+		for _, b := range previous.Blocks {
+			counts[b.Index] = b.Faults
+		}
+	}
+
+	for i, ok := faulted.NextSet(0); ok; i, ok = faulted.NextSet(i + 1) {
+		counts[uint64(i)]++
+	}
+
+	blocks := make([]Block, 0, len(counts))
+	for index, faults := range counts {
+		blocks = append(blocks, Block{Index: index, Faults: faults})
+	}
+
+	sortBlocks(blocks)
+
+	if len(blocks) > maxBlocks {
+		blocks = blocks[:maxBlocks]
+	}
+
+	return &Manifest{
+		BlockSize: uint64(blockSize),
+		Blocks:    blocks,
+	}
+}
+
+// sortBlocks sorts the blocks by the faults, the blocks with the same faults are in the order of the memfile.
+func sortBlocks(blocks []Block) {
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].Faults != blocks[j].Faults {
+			return blocks[i].Faults > blocks[j].Faults
+		}
+
+		return blocks[i].Index < blocks[j].Index
+	})
+}
+
+func Serialize(m *Manifest) (io.Reader, error) {
+	var buf bytes.Buffer
+
+	err := binary.Write(&buf, binary.LittleEndian, []uint64{version, m.BlockSize, uint64(len(m.Blocks))})
+	if err != nil {
+		return nil, fmt.Errorf("failed to write manifest metadata: %w", err)
+	}
+
+	err = binary.Write(&buf, binary.LittleEndian, m.Blocks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write manifest blocks: %w", err)
+	}
+
+	return &buf, nil
+}
+
+func Deserialize(in io.WriterTo) (*Manifest, error) {
+	var buf bytes.Buffer
+
+	_, err := in.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write to buffer: %w", err)
+	}
+
+	reader := bytes.NewReader(buf.Bytes())
+
+	var metadata [3]uint64
+
+	err = binary.Read(reader, binary.LittleEndian, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest metadata: %w", err)
+	}
+
+	if metadata[0] != version {
+		return nil, fmt.Errorf("unsupported manifest version %d", metadata[0])
+	}
+
+	if metadata[1] == 0 {
+		return nil, fmt.Errorf("invalid manifest block size")
+	}
+
+	if metadata[2] > maxBlocks || metadata[2] > uint64(reader.Len()/16) {
+		return nil, fmt.Errorf("invalid count of manifest blocks %d", metadata[2])
+	}
+
+	blocks := make([]Block, metadata[2])
+
+	err = binary.Read(reader, binary.LittleEndian, blocks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest blocks: %w", err)
+	}
+
+	return &Manifest{
+		BlockSize: metadata[1],
+		Blocks:    blocks,
+	}, nil
+}
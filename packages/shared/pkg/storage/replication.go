@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// ReplicateBuild copies the build from the source bucket to the replica, with the diffs of all builds the headers of the build
+// reference, so the build can be read from the replica alone. The diffs already in the replica aren't copied again.
+// The headers are copied last, so the build isn't readable from the replica before all the diffs it references are there.
+func ReplicateBuild(ctx context.Context, src, dst *gcs.BucketHandle, buildID string) error {
+	files := &TemplateFiles{BuildId: buildID}
+
+	headers := map[string]string{
+		files.StorageMemfileHeaderPath(): MemfileName,
+		files.StorageRootfsHeaderPath():  RootfsName,
+	}
+
+	diffs := []string{files.StorageSnapfilePath()}
+	copiedHeaders := make([]string, 0, len(headers))
+
+	for headerPath, diffName := range headers {
+		h, err := header.Deserialize(gcs.NewObject(ctx, src, headerPath))
+		if errors.Is(err, gcs.ErrObjectNotExist) {
+			// The builds without the header have the whole file in the build
+			diffs = append(diffs, fmt.Sprintf("%s/%s", buildID, diffName))
+
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to read header '%s': %w", headerPath, err)
+		}
+
+		referenced := make(map[uuid.UUID]struct{})
+		for _, mapping := range h.Mapping {
+			if mapping.BuildId == uuid.Nil {
+				continue
+			}
+
+			referenced[mapping.BuildId] = struct{}{}
+		}
+
+		for id := range referenced {
+			diffs = append(diffs, fmt.Sprintf("%s/%s", id, diffName))
+		}
+
+		copiedHeaders = append(copiedHeaders, headerPath)
+	}
+
+	for _, path := range diffs {
+		err := copyObject(ctx, src, dst, path, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The layout of the rootfs and the prefetch manifest of the memfile are only optimizations of the reads,
+	// the layout is missing for the older builds and the snapshots, the manifest for the template builds
+	for _, path := range []string{files.StorageRootfsLayoutPath(), files.StorageMemfilePrefetchPath()} {
+		exists, err := gcs.NewObject(ctx, src, path).Exists()
+		if err != nil {
+			return fmt.Errorf("failed to check optional object '%s': %w", path, err)
+		}
+
+		if !exists {
+			continue
+		}
+
+		err = copyObject(ctx, src, dst, path, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, path := range copiedHeaders {
+		err := copyObject(ctx, src, dst, path, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyObject copies the object to the replica, the object already in the replica is skipped if skipExisting is set.
+func copyObject(ctx context.Context, src, dst *gcs.BucketHandle, path string, skipExisting bool) error {
+	target := gcs.NewObject(ctx, dst, path)
+
+	if skipExisting {
+		exists, err := target.Exists()
+		if err != nil {
+			return fmt.Errorf("failed to check replica of '%s': %w", path, err)
+		}
+
+		if exists {
+			return nil
+		}
+	}
+
+	err := target.CopyFrom(gcs.NewObject(ctx, src, path))
+	if err != nil {
+		return fmt.Errorf("failed to replicate '%s': %w", path, err)
+	}
+
+	return nil
+}
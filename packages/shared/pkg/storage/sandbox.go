@@ -9,7 +9,7 @@ import (
 )
 
 const (
-	sandboxCacheDir = "/orchestrator/sandbox"
+	SandboxCacheDir = "/orchestrator/sandbox"
 )
 
 type SandboxFiles struct {
@@ -32,7 +32,7 @@ func (c *TemplateCacheFiles) NewSandboxFiles(sandboxID string) *SandboxFiles {
 }
 
 func (s *SandboxFiles) SandboxCacheRootfsPath() string {
-	return filepath.Join(sandboxCacheDir, fmt.Sprintf("rootfs-%s-%s.cow", s.SandboxID, s.randomID))
+	return filepath.Join(SandboxCacheDir, fmt.Sprintf("rootfs-%s-%s.cow", s.SandboxID, s.randomID))
 }
 
 func (s *SandboxFiles) SandboxFirecrackerSocketPath() string {
@@ -44,5 +44,10 @@ func (s *SandboxFiles) SandboxUffdSocketPath() string {
 }
 
 func (s *SandboxFiles) SandboxCacheRootfsLinkPath() string {
-	return filepath.Join(sandboxCacheDir, fmt.Sprintf("rootfs-%s-%s.link", s.SandboxID, s.randomID))
+	return filepath.Join(SandboxCacheDir, fmt.Sprintf("rootfs-%s-%s.link", s.SandboxID, s.randomID))
+}
+
+// SandboxJailerID is the ID of the sandbox's jail, it's unique same as the other sandbox files.
+func (s *SandboxFiles) SandboxJailerID() string {
+	return fmt.Sprintf("%s-%s", s.SandboxID, s.randomID)
 }
@@ -24,6 +24,7 @@ const (
 
 	FirecrackerVersionsDir = "/fc-versions"
 	FirecrackerBinaryName  = "firecracker"
+	JailerBinaryName       = "jailer"
 
 	buildDirName = "builds"
 
@@ -31,7 +32,9 @@ const (
 	RootfsName   = "rootfs.ext4"
 	SnapfileName = "snapfile"
 
-	HeaderSuffix = ".header"
+	HeaderSuffix   = ".header"
+	LayoutSuffix   = ".layout"
+	PrefetchSuffix = ".prefetch"
 )
 
 // Path to the directory where the kernel can be accessed inside when the dirs are mounted.
@@ -87,6 +90,11 @@ func (t *TemplateFiles) FirecrackerPath() string {
 	return filepath.Join(FirecrackerVersionsDir, t.FirecrackerVersion, FirecrackerBinaryName)
 }
 
+// JailerPath is the jailer released with the firecracker version, the jailer and firecracker must be from the same release.
+func (t *TemplateFiles) JailerPath() string {
+	return filepath.Join(FirecrackerVersionsDir, t.FirecrackerVersion, JailerBinaryName)
+}
+
 func (t *TemplateFiles) StorageDir() string {
 	return t.BuildId
 }
@@ -99,6 +107,11 @@ func (t *TemplateFiles) StorageMemfileHeaderPath() string {
 	return fmt.Sprintf("%s/%s%s", t.StorageDir(), MemfileName, HeaderSuffix)
 }
 
+// StorageMemfilePrefetchPath is the manifest of the memfile blocks faulted by the resumes, it's stored only with the snapshots.
+func (t *TemplateFiles) StorageMemfilePrefetchPath() string {
+	return fmt.Sprintf("%s/%s%s", t.StorageDir(), MemfileName, PrefetchSuffix)
+}
+
 func (t *TemplateFiles) StorageRootfsPath() string {
 	return fmt.Sprintf("%s/%s", t.StorageDir(), RootfsName)
 }
@@ -107,6 +120,11 @@ func (t *TemplateFiles) StorageRootfsHeaderPath() string {
 	return fmt.Sprintf("%s/%s%s", t.StorageDir(), RootfsName, HeaderSuffix)
 }
 
+// StorageRootfsLayoutPath is the layout of the files in the rootfs, it's stored only with the template builds.
+func (t *TemplateFiles) StorageRootfsLayoutPath() string {
+	return fmt.Sprintf("%s/%s%s", t.StorageDir(), RootfsName, LayoutSuffix)
+}
+
 func (t *TemplateFiles) StorageSnapfilePath() string {
 	return fmt.Sprintf("%s/%s", t.StorageDir(), SnapfileName)
 }
@@ -10,14 +10,27 @@ import (
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/layout"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/prefetch"
 )
 
+// DiffEncryption encrypts the diffs before they are uploaded, the returned metadata is stored with the object,
+// so the diff can be decrypted when it is read. The encryption must not change the offsets of the data.
+type DiffEncryption interface {
+	Encrypt(src io.Reader) (io.Reader, map[string]string, error)
+}
+
 type TemplateBuild struct {
 	files *TemplateFiles
 
 	memfileHeader *header.Header
 	rootfsHeader  *header.Header
 
+	encryption DiffEncryption
+
+	rootfsLayout    *layout.Layout
+	memfilePrefetch *prefetch.Manifest
+
 	bucket *gcs.BucketHandle
 }
 
@@ -34,6 +47,34 @@ func NewTemplateBuild(
 	}
 }
 
+// WithEncryption encrypts the memfile and rootfs diffs before the upload.
+func (t *TemplateBuild) WithEncryption(encryption DiffEncryption) *TemplateBuild {
+	t.encryption = encryption
+
+	return t
+}
+
+// WithRootfsHeader uploads the header of the rootfs with the build.
+func (t *TemplateBuild) WithRootfsHeader(h *header.Header) *TemplateBuild {
+	t.rootfsHeader = h
+
+	return t
+}
+
+// WithRootfsLayout uploads the layout of the files in the rootfs with the build.
+func (t *TemplateBuild) WithRootfsLayout(l *layout.Layout) *TemplateBuild {
+	t.rootfsLayout = l
+
+	return t
+}
+
+// WithMemfilePrefetch uploads the prefetch manifest of the memfile with the build.
+func (t *TemplateBuild) WithMemfilePrefetch(m *prefetch.Manifest) *TemplateBuild {
+	t.memfilePrefetch = m
+
+	return t
+}
+
 func (t *TemplateBuild) Remove(ctx context.Context) error {
 	err := gcs.RemoveDir(ctx, t.bucket, t.files.StorageDir())
 	if err != nil {
@@ -62,6 +103,15 @@ func (t *TemplateBuild) uploadMemfileHeader(ctx context.Context, h *header.Heade
 func (t *TemplateBuild) uploadMemfile(ctx context.Context, memfilePath string) error {
 	object := gcs.NewObject(ctx, t.bucket, t.files.StorageMemfilePath())
 
+	if t.encryption != nil {
+		err := t.uploadEncrypted(object, memfilePath)
+		if err != nil {
+			return fmt.Errorf("error when uploading encrypted memfile: %w", err)
+		}
+
+		return nil
+	}
+
 	err := object.UploadWithCli(ctx, memfilePath)
 	if err != nil {
 		return fmt.Errorf("error when uploading memfile: %w", err)
@@ -89,6 +139,15 @@ func (t *TemplateBuild) uploadRootfsHeader(ctx context.Context, h *header.Header
 func (t *TemplateBuild) uploadRootfs(ctx context.Context, rootfsPath string) error {
 	object := gcs.NewObject(ctx, t.bucket, t.files.StorageRootfsPath())
 
+	if t.encryption != nil {
+		err := t.uploadEncrypted(object, rootfsPath)
+		if err != nil {
+			return fmt.Errorf("error when uploading encrypted rootfs: %w", err)
+		}
+
+		return nil
+	}
+
 	err := object.UploadWithCli(ctx, rootfsPath)
 	if err != nil {
 		return fmt.Errorf("error when uploading rootfs: %w", err)
@@ -97,6 +156,60 @@ func (t *TemplateBuild) uploadRootfs(ctx context.Context, rootfsPath string) err
 	return nil
 }
 
+func (t *TemplateBuild) uploadRootfsLayout(ctx context.Context, l *layout.Layout) error {
+	object := gcs.NewObject(ctx, t.bucket, t.files.StorageRootfsLayoutPath())
+
+	serialized, err := layout.Serialize(l)
+	if err != nil {
+		return fmt.Errorf("error when serializing rootfs layout: %w", err)
+	}
+
+	_, err = object.ReadFrom(serialized)
+	if err != nil {
+		return fmt.Errorf("error when uploading rootfs layout: %w", err)
+	}
+
+	return nil
+}
+
+func (t *TemplateBuild) uploadMemfilePrefetch(ctx context.Context, m *prefetch.Manifest) error {
+	object := gcs.NewObject(ctx, t.bucket, t.files.StorageMemfilePrefetchPath())
+
+	serialized, err := prefetch.Serialize(m)
+	if err != nil {
+		return fmt.Errorf("error when serializing memfile prefetch manifest: %w", err)
+	}
+
+	_, err = object.ReadFrom(serialized)
+	if err != nil {
+		return fmt.Errorf("error when uploading memfile prefetch manifest: %w", err)
+	}
+
+	return nil
+}
+
+// uploadEncrypted streams the file through the encryption to the storage, the composite upload of the CLI is not used.
+func (t *TemplateBuild) uploadEncrypted(object *gcs.Object, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	encrypted, metadata, err := t.encryption.Encrypt(file)
+	if err != nil {
+		return err
+	}
+
+	_, err = object.ReadFromWithMetadata(encrypted, metadata)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Snapfile is small enough so we dont use composite upload.
 func (t *TemplateBuild) uploadSnapfile(ctx context.Context, snapfile io.Reader) error {
 	object := gcs.NewObject(ctx, t.bucket, t.files.StorageSnapfilePath())
@@ -143,6 +256,32 @@ func (t *TemplateBuild) Upload(
 		return nil
 	})
 
+	eg.Go(func() error {
+		if t.rootfsLayout == nil {
+			return nil
+		}
+
+		err := t.uploadRootfsLayout(ctx, t.rootfsLayout)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	eg.Go(func() error {
+		if t.memfilePrefetch == nil {
+			return nil
+		}
+
+		err := t.uploadMemfilePrefetch(ctx, t.memfilePrefetch)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
 	eg.Go(func() error {
 		if t.memfileHeader == nil {
 			return nil
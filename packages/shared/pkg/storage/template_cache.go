@@ -8,7 +8,7 @@ import (
 )
 
 const (
-	templateCacheDir = "/orchestrator/template"
+	TemplateCacheDir = "/orchestrator/template"
 	snapshotCacheDir = "/mnt/snapshot-cache"
 )
 
@@ -31,7 +31,7 @@ func (f *TemplateFiles) NewTemplateCacheFiles() (*TemplateCacheFiles, error) {
 }
 
 func (c *TemplateCacheFiles) CacheDir() string {
-	return filepath.Join(templateCacheDir, c.TemplateId, c.BuildId, "cache", c.CacheIdentifier)
+	return filepath.Join(TemplateCacheDir, c.TemplateId, c.BuildId, "cache", c.CacheIdentifier)
 }
 
 func (c *TemplateCacheFiles) CacheMemfileFullSnapshotPath() string {
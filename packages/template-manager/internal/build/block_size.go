@@ -0,0 +1,44 @@
+package build
+
+import (
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/layout"
+)
+
+// largeFilesDataRatio is the part of the file data in the large files above which the rootfs uses the large blocks.
+// The small files aren't in the layout, so the ratio is of the data of the files in the layout.
+const largeFilesDataRatio = 0.9
+
+// SelectRootfsBlockSize returns the block size of the rootfs diffs, the requested size if set. Otherwise the large blocks are used
+// when the file data is mostly in the files larger than the block, e.g. the models or the datasets read whole,
+// where one large fetch replaces many small ones. The small blocks are used otherwise, they keep the diffs of the small writes small.
+func (e *Env) SelectRootfsBlockSize(l *layout.Layout) int64 {
+	if e.RootfsBlockSizeOverride != 0 {
+		return e.RootfsBlockSizeOverride
+	}
+
+	if l == nil {
+		return header.RootfsBlockSize
+	}
+
+	var total, large int64
+
+	for _, extents := range l.Files {
+		var size int64
+		for _, extent := range extents {
+			size += extent.Length
+		}
+
+		total += size
+
+		if size >= header.MaxRootfsBlockSize {
+			large += size
+		}
+	}
+
+	if total == 0 || float64(large) < float64(total)*largeFilesDataRatio {
+		return header.RootfsBlockSize
+	}
+
+	return header.MaxRootfsBlockSize
+}
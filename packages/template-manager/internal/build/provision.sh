@@ -8,6 +8,11 @@ echo "BUILD_ID={{ .BuildID }}" >>/.e2b
 
 # We are downloading the packages manually
 apt-get update --download-only
+{{- if .UpgradePackages }}
+
+# Upgrade the packages of the image, so the rebuilt templates pick up the security updates
+DEBIAN_FRONTEND=noninteractive DEBCONF_NOWARNINGS=yes apt-get upgrade -y
+{{- end }}
 DEBIAN_FRONTEND=noninteractive DEBCONF_NOWARNINGS=yes apt-get install -y openssh-server sudo systemd socat chrony linuxptp iptables
 
 # Set up autologin.
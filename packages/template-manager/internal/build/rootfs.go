@@ -76,7 +76,9 @@ func NewRootfs(ctx context.Context, tracer trace.Tracer, env *Env, docker *clien
 	}
 
 	_, _ = env.BuildLogsWriter.Write([]byte("Pulling Docker image...\n"))
-	err := rootfs.pullDockerImage(childCtx, tracer)
+	err := env.runStep(childCtx, "pull", func(ctx context.Context) error {
+		return rootfs.pullDockerImage(ctx, tracer)
+	})
 	if err != nil {
 		errMsg := fmt.Errorf("error building docker image: %w", err)
 
@@ -86,7 +88,9 @@ func NewRootfs(ctx context.Context, tracer trace.Tracer, env *Env, docker *clien
 	}
 	_, _ = env.BuildLogsWriter.Write([]byte("Pulled Docker image.\n\n"))
 
-	err = rootfs.createRootfsFile(childCtx, tracer)
+	err = env.runStep(childCtx, "provisioning", func(ctx context.Context) error {
+		return rootfs.createRootfsFile(ctx, tracer)
+	})
 	if err != nil {
 		errMsg := fmt.Errorf("error creating rootfs file: %w", err)
 
@@ -159,8 +163,14 @@ func (r *Rootfs) cleanupDockerImage(ctx context.Context, tracer trace.Tracer) {
 	}
 }
 
+// dockerTag returns the tag of the Docker image pushed for the build, the rebuilds of the template use the image of the source build.
 func (r *Rootfs) dockerTag() string {
-	return fmt.Sprintf("%s-docker.pkg.dev/%s/%s/%s:%s", consts.GCPRegion, consts.GCPProject, consts.DockerRegistry, r.env.TemplateId, r.env.BuildId)
+	buildId := r.env.BuildId
+	if r.env.SourceBuildId != "" {
+		buildId = r.env.SourceBuildId
+	}
+
+	return fmt.Sprintf("%s-docker.pkg.dev/%s/%s/%s:%s", consts.GCPRegion, consts.GCPProject, consts.DockerRegistry, r.env.TemplateId, buildId)
 }
 
 func (r *Rootfs) createRootfsFile(ctx context.Context, tracer trace.Tracer) error {
@@ -219,17 +229,19 @@ func (r *Rootfs) createRootfsFile(ctx context.Context, tracer trace.Tracer) erro
 	var scriptDef bytes.Buffer
 
 	err = EnvInstanceTemplate.Execute(&scriptDef, struct {
-		EnvID       string
-		BuildID     string
-		StartCmd    string
-		FcAddress   string
-		MemoryLimit int
+		EnvID           string
+		BuildID         string
+		StartCmd        string
+		FcAddress       string
+		MemoryLimit     int
+		UpgradePackages bool
 	}{
-		FcAddress:   fcAddr,
-		EnvID:       r.env.TemplateId,
-		BuildID:     r.env.BuildId,
-		StartCmd:    strings.ReplaceAll(r.env.StartCmd, "'", "\\'"),
-		MemoryLimit: int(math.Min(float64(r.env.MemoryMB)/2, 512)),
+		FcAddress:       fcAddr,
+		EnvID:           r.env.TemplateId,
+		BuildID:         r.env.BuildId,
+		StartCmd:        strings.ReplaceAll(r.env.StartCmd, "'", "\\'"),
+		MemoryLimit:     int(math.Min(float64(r.env.MemoryMB)/2, 512)),
+		UpgradePackages: r.env.UpgradePackages,
 	})
 	if err != nil {
 		errMsg := fmt.Errorf("error executing provision script: %w", err)
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -74,6 +75,20 @@ func waitForSocket(socketPath string, timeout time.Duration) error {
 	}
 }
 
+// sleepContext waits for the duration, it returns early with the error if the context is done,
+// so the canceled build kills the Firecracker process (and the processes in the VM) right away.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func newFirecrackerClient(socketPath string) *client.Firecracker {
 	httpClient := client.NewHTTPClient(strfmt.NewFormats())
 
@@ -130,11 +145,19 @@ func NewSnapshot(ctx context.Context, tracer trace.Tracer, env *Env, network *FC
 
 	// Wait for all necessary things in FC to start
 	// TODO: Maybe init should signalize when it's ready?
-	time.Sleep(waitTimeForFCStart)
+	err = sleepContext(childCtx, waitTimeForFCStart)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for fc to start: %w", err)
+	}
+
 	telemetry.ReportEvent(childCtx, "waited for fc to start", attribute.Float64("seconds", float64(waitTimeForFCStart/time.Second)))
 
 	if env.StartCmd != "" {
-		time.Sleep(waitTimeForStartCmd)
+		err = sleepContext(childCtx, waitTimeForStartCmd)
+		if err != nil {
+			return nil, fmt.Errorf("error waiting for start command: %w", err)
+		}
+
 		telemetry.ReportEvent(childCtx, "waited for start command", attribute.Float64("seconds", float64(waitTimeForStartCmd/time.Second)))
 	}
 
@@ -271,7 +294,11 @@ func (s *Snapshot) configureFC(ctx context.Context, tracer trace.Tracer) error {
 	defer childSpan.End()
 
 	ip := fmt.Sprintf("%s::%s:%s:instance:eth0:off:8.8.8.8", fcAddr, fcTapAddress, fcMaskLong)
-	kernelArgs := fmt.Sprintf("quiet loglevel=1 ip=%s reboot=k panic=1 pci=off nomodules i8042.nokbd i8042.noaux ipv6.disable=1 random.trust_cpu=on", ip)
+	kernelArgs := fmt.Sprintf("console=ttyS0 quiet loglevel=1 ip=%s reboot=k panic=1 pci=off nomodules i8042.nokbd i8042.noaux ipv6.disable=1 random.trust_cpu=on", ip)
+	if len(s.env.KernelArgs) > 0 {
+		kernelArgs = fmt.Sprintf("%s %s", kernelArgs, strings.Join(s.env.KernelArgs, " "))
+	}
+
 	kernelImagePath := storage.KernelMountedPath
 	bootSourceConfig := operations.PutGuestBootSourceParams{
 		Context: childCtx,
@@ -388,6 +415,22 @@ func (s *Snapshot) configureFC(ctx context.Context, tracer trace.Tracer) error {
 
 	telemetry.ReportEvent(childCtx, "set fc mmds config")
 
+	// The virtio-rng device lets the guest kernel reseed its RNG from the host after the snapshot is restored
+	entropyConfig := operations.PutEntropyDeviceParams{
+		Context: childCtx,
+		Body:    &models.EntropyDevice{},
+	}
+
+	_, err = s.client.Operations.PutEntropyDevice(&entropyConfig)
+	if err != nil {
+		errMsg := fmt.Errorf("error setting fc entropy device: %w", err)
+		telemetry.ReportCriticalError(childCtx, errMsg)
+
+		return errMsg
+	}
+
+	telemetry.ReportEvent(childCtx, "set fc entropy device")
+
 	// We may need to sleep before start - previous configuration is processes asynchronously. How to do this sync or in one go?
 	time.Sleep(waitTimeForFCConfig)
 
@@ -3,16 +3,22 @@ package build
 import (
 	"context"
 	_ "embed"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"text/template"
+	"time"
 
 	"github.com/docker/docker/client"
 	docker "github.com/fsouza/go-dockerclient"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/layout"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -22,6 +28,9 @@ type Env struct {
 	// Command to run when building the env.
 	StartCmd string
 
+	// Additional kernel boot args in the key=value format.
+	KernelArgs []string
+
 	// The number of vCPUs to allocate to the VM.
 	VCpuCount int64
 
@@ -31,6 +40,21 @@ type Env struct {
 	// The amount of free disk to allocate to the VM, in MiB.
 	DiskSizeMB int64
 
+	// Block size of the rootfs diffs requested for the build, selected by the rootfs layout if zero.
+	RootfsBlockSizeOverride int64
+
+	// Build whose pushed Docker image the env is built from, the image of the own build if empty.
+	SourceBuildId string
+
+	// Upgrade the packages of the image when provisioning the env.
+	UpgradePackages bool
+
+	// Timeout of each step of the build, the steps aren't limited if zero.
+	StepTimeout time.Duration
+
+	// Resource variants snapshotted from the copies of the provisioned rootfs of the env, each variant is its own build.
+	Variants []*Env
+
 	// Path to the directory where the temporary files for the build are stored.
 	BuildLogsWriter io.Writer
 
@@ -47,6 +71,64 @@ func (e *Env) RootfsSizeMB() int64 {
 	return e.rootfsSize >> 20
 }
 
+// RootfsLayout returns the layout of the files in the built rootfs, the sandboxes read ahead the files by it.
+func (e *Env) RootfsLayout() (*layout.Layout, error) {
+	rootfs, err := os.Open(e.BuildRootfsPath())
+	if err != nil {
+		return nil, fmt.Errorf("error opening rootfs file: %w", err)
+	}
+
+	defer rootfs.Close()
+
+	return layout.ParseExt4(rootfs)
+}
+
+// RootfsHeader returns the header of the built rootfs with the block size of its diffs, the snapshots of the template
+// inherit the block size from the header. The rootfs is extended to the whole blocks, the diffs are made of the whole blocks.
+func (e *Env) RootfsHeader(blockSize int64) (*header.Header, error) {
+	id, err := uuid.Parse(e.BuildId)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing build id: %w", err)
+	}
+
+	if e.rootfsSize%blockSize != 0 {
+		size := header.TotalBlocks(e.rootfsSize, blockSize) * blockSize
+
+		err = os.Truncate(e.BuildRootfsPath(), size)
+		if err != nil {
+			return nil, fmt.Errorf("error extending rootfs to the block size: %w", err)
+		}
+
+		e.rootfsSize = size
+	}
+
+	return header.NewHeader(&header.Metadata{
+		Version:     1,
+		BlockSize:   uint64(blockSize),
+		Size:        uint64(e.rootfsSize),
+		Generation:  1,
+		BuildId:     id,
+		BaseBuildId: id,
+	}, nil), nil
+}
+
+// NewVariant returns the resource variant of the env built as its own build, the variant is built with the env.
+func (e *Env) NewVariant(buildID string, vCpuCount, memoryMB int64) *Env {
+	variant := *e
+	variant.TemplateFiles = storage.NewTemplateFiles(
+		e.TemplateId,
+		buildID,
+		e.KernelVersion,
+		e.FirecrackerVersion,
+		e.Hugepages(),
+	)
+	variant.VCpuCount = vCpuCount
+	variant.MemoryMB = memoryMB
+	variant.Variants = nil
+
+	return &variant
+}
+
 func (e *Env) Build(ctx context.Context, tracer trace.Tracer, docker *client.Client, legacyDocker *docker.Client) error {
 	childCtx, childSpan := tracer.Start(ctx, "build")
 	defer childSpan.End()
@@ -67,20 +149,53 @@ func (e *Env) Build(ctx context.Context, tracer trace.Tracer, docker *client.Cli
 		return errMsg
 	}
 
-	network, err := NewFCNetwork(childCtx, tracer, e)
+	// The rootfs is copied before the env is snapshotted, the snapshotted VM changes the rootfs
+	for _, variant := range e.Variants {
+		err = e.copyRootfs(childCtx, tracer, variant)
+		if err != nil {
+			errMsg := fmt.Errorf("error copying rootfs for variant build '%s' of env '%s': %w", variant.BuildId, e.TemplateId, err)
+			telemetry.ReportCriticalError(childCtx, errMsg)
+
+			return errMsg
+		}
+	}
+
+	err = e.snapshot(childCtx, tracer, rootfs)
+	if err != nil {
+		return err
+	}
+
+	for _, variant := range e.Variants {
+		_, _ = e.BuildLogsWriter.Write([]byte(fmt.Sprintf("Snapshotting variant with %d vCPUs and %d MB of memory\n", variant.VCpuCount, variant.MemoryMB)))
+
+		err = variant.snapshot(childCtx, tracer, rootfs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Env) snapshot(ctx context.Context, tracer trace.Tracer, rootfs *Rootfs) error {
+	network, err := NewFCNetwork(ctx, tracer, e)
 	if err != nil {
 		errMsg := fmt.Errorf("error network setup for FC while building env '%s' during build '%s': %w", e.TemplateId, e.BuildId, err)
-		telemetry.ReportCriticalError(childCtx, errMsg)
+		telemetry.ReportCriticalError(ctx, errMsg)
 
 		return errMsg
 	}
 
-	defer network.Cleanup(childCtx, tracer)
+	defer network.Cleanup(ctx, tracer)
+
+	err = e.runStep(ctx, "snapshot", func(ctx context.Context) error {
+		_, snapshotErr := NewSnapshot(ctx, tracer, e, network, rootfs)
 
-	_, err = NewSnapshot(childCtx, tracer, e, network, rootfs)
+		return snapshotErr
+	})
 	if err != nil {
 		errMsg := fmt.Errorf("error snapshot for env '%s' during build '%s': %w", e.TemplateId, e.BuildId, err)
-		telemetry.ReportCriticalError(childCtx, errMsg)
+		telemetry.ReportCriticalError(ctx, errMsg)
 
 		return errMsg
 	}
@@ -88,6 +203,48 @@ func (e *Env) Build(ctx context.Context, tracer trace.Tracer, docker *client.Cli
 	return nil
 }
 
+// copyRootfs copies the provisioned rootfs to the build directory of the variant, so the variant isn't pulled and provisioned again.
+// The copy is sparse like the rootfs, the free space of the rootfs isn't allocated.
+func (e *Env) copyRootfs(ctx context.Context, tracer trace.Tracer, variant *Env) error {
+	childCtx, childSpan := tracer.Start(ctx, "copy-rootfs")
+	defer childSpan.End()
+
+	err := os.MkdirAll(variant.BuildDir(), 0o777)
+	if err != nil {
+		return fmt.Errorf("error creating build dir: %w", err)
+	}
+
+	out, err := exec.CommandContext(childCtx, "cp", "--sparse=always", e.BuildRootfsPath(), variant.BuildRootfsPath()).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error copying rootfs: %w: %s", err, out)
+	}
+
+	variant.rootfsSize = e.rootfsSize
+
+	return nil
+}
+
+// runStep runs the step of the build limited by the step timeout. The step must stop when its context is done,
+// the resources of the step (the provisioning container, the Firecracker process) are cleaned up by the step itself.
+func (e *Env) runStep(ctx context.Context, name string, step func(ctx context.Context) error) error {
+	if e.StepTimeout == 0 {
+		return step(ctx)
+	}
+
+	stepCtx, cancel := context.WithTimeout(ctx, e.StepTimeout)
+	defer cancel()
+
+	err := step(stepCtx)
+	// The build could be canceled or timed out as a whole, only the timeout of the step is reported as the step timeout
+	if err != nil && errors.Is(stepCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		_, _ = e.BuildLogsWriter.Write([]byte(fmt.Sprintf("The %s step timed out after %s\n", name, e.StepTimeout)))
+
+		return fmt.Errorf("%s step timed out after %s: %w", name, e.StepTimeout, err)
+	}
+
+	return err
+}
+
 func (e *Env) Remove(ctx context.Context, tracer trace.Tracer) error {
 	childCtx, childSpan := tracer.Start(ctx, "move-to-env-dir")
 	defer childSpan.End()
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os/exec"
 	"strconv"
@@ -9,10 +10,15 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/kernel"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/e2b-dev/infra/packages/template-manager/internal/build"
 	"github.com/e2b-dev/infra/packages/template-manager/internal/build/writer"
@@ -37,8 +43,37 @@ func (s *serverStore) TemplateCreate(templateRequest *template_manager.TemplateC
 		attribute.Int64("env.memory_mb", int64(config.MemoryMB)),
 		attribute.Int64("env.vcpu_count", int64(config.VCpuCount)),
 		attribute.Bool("env.huge_pages", config.HugePages),
+		attribute.StringSlice("env.kernel.args", config.KernelArgs),
+		attribute.String("env.source_build.id", config.SourceBuildID),
+		attribute.Bool("env.upgrade_packages", config.UpgradePackages),
+		attribute.Int64("env.step_timeout_seconds", int64(config.StepTimeoutSeconds)),
+		attribute.Int64("env.build_timeout_seconds", int64(config.BuildTimeoutSeconds)),
+		attribute.Int("env.variants", len(config.Variants)),
 	)
 
+	// The build is canceled when the client cancels the call too, the defers below clean up the build in both cases
+	if config.BuildTimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+
+		childCtx, cancel = context.WithTimeout(childCtx, time.Duration(config.BuildTimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+
+	// The args are validated by the API too, this prevents overriding the args required by the sandbox
+	err := kernel.ValidateArgs(config.KernelArgs)
+	if err != nil {
+		telemetry.ReportCriticalError(childCtx, err)
+
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if config.RootfsBlockSize != 0 && !header.ValidRootfsBlockSize(int64(config.RootfsBlockSize)) {
+		err := fmt.Errorf("invalid rootfs block size %d", config.RootfsBlockSize)
+		telemetry.ReportCriticalError(childCtx, err)
+
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	logsWriter := writer.New(stream)
 	template := &build.Env{
 		TemplateFiles: storage.NewTemplateFiles(
@@ -51,27 +86,41 @@ func (s *serverStore) TemplateCreate(templateRequest *template_manager.TemplateC
 		VCpuCount:       int64(config.VCpuCount),
 		MemoryMB:        int64(config.MemoryMB),
 		StartCmd:        config.StartCommand,
+		KernelArgs:      config.KernelArgs,
 		DiskSizeMB:      int64(config.DiskSizeMB),
 		BuildLogsWriter: logsWriter,
+
+		RootfsBlockSizeOverride: int64(config.RootfsBlockSize),
+		SourceBuildId:           config.SourceBuildID,
+		UpgradePackages:         config.UpgradePackages,
+		StepTimeout:             time.Duration(config.StepTimeoutSeconds) * time.Second,
 	}
 
-	buildStorage := s.templateStorage.NewBuild(template.TemplateFiles)
+	for _, variant := range config.Variants {
+		template.Variants = append(template.Variants, template.NewVariant(variant.BuildID, int64(variant.VCpuCount), int64(variant.MemoryMB)))
+	}
 
-	var err error
+	builds := append([]*build.Env{template}, template.Variants...)
 
 	// Remove local template files if build fails
 	defer func() {
 		removeCtx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
 		defer cancel()
 
-		removeErr := template.Remove(removeCtx, s.tracer)
-		if removeErr != nil {
-			telemetry.ReportError(childCtx, removeErr)
+		for _, b := range builds {
+			removeErr := b.Remove(removeCtx, s.tracer)
+			if removeErr != nil {
+				telemetry.ReportError(childCtx, removeErr)
+			}
 		}
 	}()
 
 	err = template.Build(childCtx, s.tracer, s.dockerClient, s.legacyDockerClient)
 	if err != nil {
+		if errors.Is(childCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			_, _ = logsWriter.Write([]byte(fmt.Sprintf("The build timed out after %ds\n", config.BuildTimeoutSeconds)))
+		}
+
 		_, _ = logsWriter.Write([]byte(fmt.Sprintf("Error building environment: %v", err)))
 
 		telemetry.ReportCriticalError(childCtx, err)
@@ -79,55 +128,96 @@ func (s *serverStore) TemplateCreate(templateRequest *template_manager.TemplateC
 		return err
 	}
 
-	// Remove build files if build fails or times out
+	cmd := exec.Command(storage.HostEnvdPath, "-version")
+
+	out, err := cmd.Output()
+	if err != nil {
+		_, _ = logsWriter.Write([]byte(fmt.Sprintf("Error while getting envd version: %v", err)))
+
+		return err
+	}
+
+	// Remove the uploaded files of all the builds if any upload fails or times out, the variants are finished with the build
 	defer func() {
 		if err != nil {
 			removeCtx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
 			defer cancel()
 
-			removeErr := buildStorage.Remove(removeCtx)
-			if removeErr != nil {
-				telemetry.ReportError(childCtx, removeErr)
+			for _, b := range builds {
+				removeErr := s.templateStorage.NewBuild(b.TemplateFiles).Remove(removeCtx)
+				if removeErr != nil {
+					telemetry.ReportError(childCtx, removeErr)
+				}
 			}
 		}
 	}()
 
-	memfilePath := template.BuildMemfilePath()
-	rootfsPath := template.BuildRootfsPath()
+	for _, b := range builds {
+		err = s.uploadTemplate(childCtx, b)
+		if err != nil {
+			_, _ = logsWriter.Write([]byte(fmt.Sprintf("Error while uploading build files: %v", err)))
 
-	upload := buildStorage.Upload(
-		childCtx,
-		template.BuildSnapfilePath(),
-		&memfilePath,
-		&rootfsPath,
+			return err
+		}
+	}
+
+	// The variants share the rootfs of the build, so they have the same rootfs size and envd version
+	version := strings.TrimSpace(string(out))
+	trailerMetadata := metadata.Pairs(
+		storage.RootfsSizeKey, strconv.FormatInt(template.RootfsSizeMB(), 10),
+		storage.EnvdVersionKey, version,
 	)
 
-	cmd := exec.Command(storage.HostEnvdPath, "-version")
+	stream.SetTrailer(trailerMetadata)
 
-	out, err := cmd.Output()
+	telemetry.ReportEvent(childCtx, "Environment built")
+
+	return nil
+}
+
+// uploadTemplate uploads the files of the built template to the storage.
+func (s *serverStore) uploadTemplate(ctx context.Context, template *build.Env) error {
+	childCtx, childSpan := s.tracer.Start(ctx, "upload-template", trace.WithAttributes(
+		attribute.String("env.build.id", template.BuildId),
+	))
+	defer childSpan.End()
+
+	buildStorage := s.templateStorage.NewBuild(template.TemplateFiles)
+
+	rootfsLayout, layoutErr := template.RootfsLayout()
+	if layoutErr != nil {
+		// The layout only speeds up the reads of the rootfs, the build is usable without it
+		telemetry.ReportError(childCtx, fmt.Errorf("error parsing rootfs layout: %w", layoutErr))
+	} else {
+		buildStorage.WithRootfsLayout(rootfsLayout)
+	}
+
+	rootfsBlockSize := template.SelectRootfsBlockSize(rootfsLayout)
+	childSpan.SetAttributes(attribute.Int64("env.rootfs.block_size", rootfsBlockSize))
+
+	rootfsHeader, err := template.RootfsHeader(rootfsBlockSize)
 	if err != nil {
-		_, _ = logsWriter.Write([]byte(fmt.Sprintf("Error while getting envd version: %v", err)))
+		telemetry.ReportCriticalError(childCtx, err)
 
 		return err
 	}
 
-	uploadErr := <-upload
-	if uploadErr != nil {
-		errMsg := fmt.Sprintf("Error while uploading build files: %v", uploadErr)
-		_, _ = logsWriter.Write([]byte(errMsg))
+	buildStorage.WithRootfsHeader(rootfsHeader)
 
-		return uploadErr
-	}
+	memfilePath := template.BuildMemfilePath()
+	rootfsPath := template.BuildRootfsPath()
 
-	version := strings.TrimSpace(string(out))
-	trailerMetadata := metadata.Pairs(
-		storage.RootfsSizeKey, strconv.FormatInt(template.RootfsSizeMB(), 10),
-		storage.EnvdVersionKey, version,
+	err = <-buildStorage.Upload(
+		childCtx,
+		template.BuildSnapfilePath(),
+		&memfilePath,
+		&rootfsPath,
 	)
+	if err != nil {
+		telemetry.ReportCriticalError(childCtx, err)
 
-	stream.SetTrailer(trailerMetadata)
-
-	telemetry.ReportEvent(childCtx, "Environment built")
+		return fmt.Errorf("error uploading build '%s': %w", template.BuildId, err)
+	}
 
 	return nil
 }
@@ -42,7 +42,7 @@ variable "client_proxy_health_port" {
     port = number
     path = string
   })
-  default = {
+  default     = {
     name = "health"
     port = 3001
     path = "/health"
@@ -54,12 +54,50 @@ variable "client_proxy_port" {
     name = string
     port = number
   })
-  default = {
+  default     = {
     name = "session"
     port = 3002
   }
 }
 
+variable "client_proxy_limits" {
+  type = object({
+    max_body_size_mb          = number
+    max_response_rate_kb      = number
+    max_streams_per_sandbox   = number
+    max_requests_per_s_client = number
+    request_burst_per_client  = number
+  })
+  description = "Limits of the client proxy per sandbox, the response rate is per stream, the request rate is per client IP of the sandbox, 0 disables the rate, streams and requests limits"
+  default     = {
+    max_body_size_mb          = 1024
+    max_response_rate_kb      = 0
+    max_streams_per_sandbox   = 0
+    max_requests_per_s_client = 0
+    request_burst_per_client  = 0
+  }
+}
+
+variable "client_proxy_timeouts" {
+  type = object({
+    envd_idle_timeout_s    = number
+    sandbox_idle_timeout_s = number
+    tcp_keepalive_idle_s   = number
+  })
+  description = "Idle timeouts of the client proxy streams to envd and to the other sandbox ports, and the idle time before the TCP keepalive probes are sent"
+  default     = {
+    envd_idle_timeout_s    = 86400
+    sandbox_idle_timeout_s = 86400
+    tcp_keepalive_idle_s   = 60
+  }
+}
+
+variable "client_proxy_access_log_sample_percent" {
+  type        = number
+  description = "Percent of the client proxy requests of each sandbox in the access log, the server errors are always logged"
+  default     = 100
+}
+
 variable "session_proxy_service_name" {
   type    = string
   default = "session-proxy"
@@ -70,7 +108,7 @@ variable "session_proxy_port" {
     name = string
     port = number
   })
-  default = {
+  default     = {
     name = "session"
     port = 3003
   }
@@ -81,7 +119,7 @@ variable "logs_proxy_port" {
     name = string
     port = number
   })
-  default = {
+  default     = {
     name = "logs"
     port = 30006
   }
@@ -93,7 +131,7 @@ variable "logs_health_proxy_port" {
     port        = number
     health_path = string
   })
-  default = {
+  default     = {
     name        = "logs-health"
     port        = 44313
     health_path = "/health"
@@ -106,20 +144,40 @@ variable "api_port" {
     port        = number
     health_path = string
   })
-  default = {
+  default     = {
     name        = "api"
     port        = 50001
     health_path = "/health"
   }
 }
 
+variable "allowed_kernel_versions" {
+  type        = list(string)
+  description = "Kernel versions that can be selected for the templates in addition to the default one"
+  default     = []
+}
+
+variable "firecracker_canary" {
+  type = object({
+    version    = string
+    percentage = number
+    templates  = list(string)
+  })
+  description = "Firecracker version the new template builds are canaried on, the percentage of the templates and the templates always built with it"
+  default     = {
+    version    = ""
+    percentage = 0
+    templates  = []
+  }
+}
+
 variable "docker_reverse_proxy_port" {
   type = object({
     name        = string
     port        = number
     health_path = string
   })
-  default = {
+  default     = {
     name        = "docker-reverse-proxy"
     port        = 5000
     health_path = "/health"
@@ -131,7 +189,7 @@ variable "redis_port" {
     name = string
     port = number
   })
-  default = {
+  default     = {
     name = "redis"
     port = 6379
   }
@@ -147,6 +205,89 @@ variable "orchestrator_port" {
   default = 5008
 }
 
+variable "orchestrator_health_port" {
+  type    = number
+  default = 5012
+}
+
+variable "orchestrator_diagnostics_port" {
+  type    = number
+  default = 5013
+}
+
+variable "orchestrator_discovery" {
+  type        = string
+  description = "How the API discovers the orchestrators, \"nomad\" lists the Nomad nodes, \"consul\" watches the healthy instances in the Consul catalog"
+  default     = "nomad"
+
+  validation {
+    condition     = contains(["nomad", "consul"], var.orchestrator_discovery)
+    error_message = "The orchestrator discovery must be \"nomad\" or \"consul\"."
+  }
+}
+
+variable "storage_cache" {
+  type = object({
+    enabled     = bool
+    port        = number
+    max_size_gb = number
+  })
+  description = "Cache of the template files shared by the orchestrator nodes, so each file is fetched from the bucket once per cluster"
+  default     = {
+    enabled     = false
+    port        = 5010
+    max_size_gb = 100
+  }
+}
+
+variable "secrets_encryption" {
+  type = object({
+    kms = string
+    key = string
+  })
+  description = "KMS wrapping the data keys of the team secrets, the kms is local, gcp, aws or vault and the key is the KMS key of the secrets, the local KMS uses the keys from the secret manager"
+  default     = {
+    kms = "local"
+    key = ""
+  }
+}
+
+variable "snapshot_encryption" {
+  type = object({
+    kms = string
+    key = string
+  })
+  description = "KMS wrapping the data keys of the teams with the encrypted snapshots, the kms is gcp, aws or vault and the key is the KMS key of the snapshots"
+  default     = {
+    kms = ""
+    key = ""
+  }
+}
+
+variable "firecracker_jailer" {
+  type = object({
+    percentage = number
+    uid_base   = number
+  })
+  description = "Percentage (0-100) of the sandboxes started under the Firecracker jailer on the orchestrator nodes and the first UID of the jailed processes"
+  default     = {
+    percentage = 0
+    uid_base   = 200000
+  }
+}
+
+variable "eviction_policy" {
+  type        = string
+  description = "Policy selecting the sandboxes evicted (paused) from the orchestrator nodes that are over capacity: oldest-idle-first, lowest-priority-first or never"
+  default     = "oldest-idle-first"
+}
+
+variable "template_bucket_replicas" {
+  type        = map(string)
+  description = "Replicas of the template bucket by the region, the builds of the teams with the replication regions are replicated to them and the orchestrator nodes in the region read the templates from the replica"
+  default     = {}
+}
+
 variable "template_manager_port" {
   type    = number
   default = 5009
@@ -187,7 +328,7 @@ variable "prefix" {
 variable "labels" {
   description = "The labels to attach to resources created by this module"
   type        = map(string)
-  default = {
+  default     = {
     "app"       = "e2b"
     "terraform" = "true"
   }
@@ -203,12 +344,24 @@ variable "loki_service_port" {
     name = string
     port = number
   })
-  default = {
+  default     = {
     name = "loki"
     port = 3100
   }
 }
 
+variable "sandbox_logs_retention_days" {
+  type        = number
+  description = "The number of days the logs of the sandboxes are kept for, the logs are available after the sandbox is killed until then"
+  default     = 30
+}
+
+variable "sandbox_diagnostics_retention_days" {
+  type        = number
+  description = "The number of days the crash diagnostics of the sandboxes are kept for in the template bucket"
+  default     = 14
+}
+
 variable "template_bucket_location" {
   type        = string
   description = "The location of the FC template bucket"